@@ -35,6 +35,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/channels/pkg/api"
+	"k8s.io/kops/pkg/kubemanifest"
 
 	cmv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -197,6 +198,22 @@ func (a *Addon) updateAddon(ctx context.Context, k8sClient kubernetes.Interface,
 		return fmt.Errorf("error reading manifest: %w", err)
 	}
 
+	objects, err := kubemanifest.LoadObjectsFrom(data)
+	if err != nil {
+		return fmt.Errorf("error parsing manifest: %w", err)
+	}
+
+	// We label the objects as members of the applyset of the addon, so that we can prune them once they are removed from the manifest.
+	applySet := NewAddonApplySet(pruner.Client, pruner.RESTMapper, a.Name)
+	applySet.SetMembership(objects)
+	if err := applySet.Prepare(ctx, objects); err != nil {
+		return fmt.Errorf("error recording objects of addon %q: %w", a.Name, err)
+	}
+	data, err = objects.ToYAML()
+	if err != nil {
+		return err
+	}
+
 	var merr error
 	var applyError, pruneError error
 
@@ -222,6 +239,11 @@ func (a *Addon) updateAddon(ctx context.Context, k8sClient kubernetes.Interface,
 		return fmt.Errorf("error updating addon from %q: %w", manifestURL, merr)
 	}
 
+	// Only prune once all the objects are applied, so that we never remove an object that is still in use.
+	if err := applySet.Prune(ctx, objects); err != nil {
+		return fmt.Errorf("error pruning objects removed from addon %q: %w", a.Name, err)
+	}
+
 	if err := a.AddNeedsUpdateLabel(ctx, k8sClient, required); err != nil {
 		return fmt.Errorf("error adding needs-update label: %v", err)
 	}
@@ -0,0 +1,321 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channels
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/kubemanifest"
+)
+
+// The labels and annotations of the ApplySet specification (KEP-3659),
+// so that the objects of an addon can also be inspected with kubectl.
+const (
+	applySetIDLabel              = "applyset.kubernetes.io/id"
+	applySetPartOfLabel          = "applyset.kubernetes.io/part-of"
+	applySetToolingAnnotation    = "applyset.kubernetes.io/tooling"
+	applySetGroupKindsAnnotation = "applyset.kubernetes.io/contains-group-kinds"
+	applySetNamespacesAnnotation = "applyset.kubernetes.io/additional-namespaces"
+)
+
+// applySetParentNamespace is the namespace of the secrets that record the objects of each addon.
+const applySetParentNamespace = "kube-system"
+
+// applySetNeverPruneGroupKinds are the kinds that we never delete, because deleting them also deletes other objects.
+var applySetNeverPruneGroupKinds = map[schema.GroupKind]bool{
+	{Group: "", Kind: "Namespace"}:                                    true,
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}: true,
+}
+
+var secretGVR = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "secrets"}
+
+// AddonApplySet records the objects applied for an addon in a parent secret,
+// so that objects removed from a later version of the addon can be pruned.
+type AddonApplySet struct {
+	Client     dynamic.Interface
+	RESTMapper meta.RESTMapper
+
+	// ParentName is the name of the secret that records the objects of the addon.
+	ParentName string
+}
+
+// NewAddonApplySet builds the AddonApplySet for the named addon.
+func NewAddonApplySet(client dynamic.Interface, restMapper meta.RESTMapper, addonName string) *AddonApplySet {
+	return &AddonApplySet{
+		Client:     client,
+		RESTMapper: restMapper,
+		ParentName: "kops-addon-" + strings.ToLower(addonName),
+	}
+}
+
+// ID returns the ID of the ApplySet, which is used as the value of the part-of label of the objects.
+// The format is the one defined by the ApplySet specification, which kubectl also uses.
+func (s *AddonApplySet) ID() string {
+	hash := sha256.Sum256([]byte(s.ParentName + "." + applySetParentNamespace + ".Secret."))
+	return "applyset-" + base64.RawURLEncoding.EncodeToString(hash[:]) + "-v1"
+}
+
+// SetMembership labels the objects as members of the ApplySet.
+func (s *AddonApplySet) SetMembership(objects kubemanifest.ObjectList) {
+	for _, object := range objects {
+		u := object.ToUnstructured()
+		labels := u.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[applySetPartOfLabel] = s.ID()
+		u.SetLabels(labels)
+	}
+}
+
+// applySetMembers are the kinds and namespaces of the objects of an ApplySet.
+type applySetMembers struct {
+	groupKinds sets.Set[schema.GroupKind]
+	namespaces sets.Set[string]
+}
+
+func newApplySetMembers(objects kubemanifest.ObjectList) *applySetMembers {
+	m := &applySetMembers{
+		groupKinds: sets.New[schema.GroupKind](),
+		namespaces: sets.New[string](),
+	}
+	for _, object := range objects {
+		m.groupKinds.Insert(object.GroupVersionKind().GroupKind())
+		if ns := object.GetNamespace(); ns != "" {
+			m.namespaces.Insert(ns)
+		}
+	}
+	return m
+}
+
+// parseApplySetMembers reads the members recorded in the annotations of the parent.
+func parseApplySetMembers(annotations map[string]string) *applySetMembers {
+	m := &applySetMembers{
+		groupKinds: sets.New[schema.GroupKind](),
+		namespaces: sets.New[string](),
+	}
+	for _, s := range strings.Split(annotations[applySetGroupKindsAnnotation], ",") {
+		if s != "" {
+			m.groupKinds.Insert(schema.ParseGroupKind(s))
+		}
+	}
+	for _, s := range strings.Split(annotations[applySetNamespacesAnnotation], ",") {
+		if s != "" {
+			m.namespaces.Insert(s)
+		}
+	}
+	return m
+}
+
+// annotations returns the annotations that record the members in the parent.
+func (m *applySetMembers) annotations() map[string]string {
+	var groupKinds []string
+	for gk := range m.groupKinds {
+		groupKinds = append(groupKinds, gk.String())
+	}
+	sort.Strings(groupKinds)
+
+	// The namespace of the parent is implicitly part of the ApplySet
+	namespaces := sets.List(m.namespaces.Clone().Delete(applySetParentNamespace))
+
+	return map[string]string{
+		applySetToolingAnnotation:    "kops/v1",
+		applySetGroupKindsAnnotation: strings.Join(groupKinds, ","),
+		applySetNamespacesAnnotation: strings.Join(namespaces, ","),
+	}
+}
+
+func (m *applySetMembers) union(other *applySetMembers) *applySetMembers {
+	return &applySetMembers{
+		groupKinds: m.groupKinds.Union(other.groupKinds),
+		namespaces: m.namespaces.Union(other.namespaces),
+	}
+}
+
+// NormalizeNamespaces sets the namespace of the objects as the apiserver does:
+// cluster-scoped objects have no namespace, even when the manifest sets one,
+// and namespaced objects without a namespace are in the default namespace.
+// Objects of kinds that are not yet served by the cluster are left unchanged.
+func (s *AddonApplySet) NormalizeNamespaces(objects kubemanifest.ObjectList) error {
+	for _, object := range objects {
+		gvk := object.GroupVersionKind()
+		restMapping, err := s.RESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return fmt.Errorf("unable to find resource for %s: %w", gvk.GroupKind(), err)
+		}
+		u := object.ToUnstructured()
+		switch restMapping.Scope.Name() {
+		case meta.RESTScopeNameRoot:
+			u.SetNamespace("")
+		case meta.RESTScopeNameNamespace:
+			if u.GetNamespace() == "" {
+				u.SetNamespace(metav1.NamespaceDefault)
+			}
+		}
+	}
+	return nil
+}
+
+// Prepare records the objects in the parent before they are applied,
+// so that they are tracked even if applying only partially succeeds.
+// The namespaces of the objects are normalized, so they should be applied after Prepare.
+func (s *AddonApplySet) Prepare(ctx context.Context, objects kubemanifest.ObjectList) error {
+	if err := s.NormalizeNamespaces(objects); err != nil {
+		return err
+	}
+	previous, err := s.readMembers(ctx)
+	if err != nil {
+		return err
+	}
+	return s.writeMembers(ctx, previous.union(newApplySetMembers(objects)))
+}
+
+// Prune deletes the members of the ApplySet that are not in objects,
+// and then records only the kinds and namespaces of objects in the parent.
+func (s *AddonApplySet) Prune(ctx context.Context, objects kubemanifest.ObjectList) error {
+	// The objects we list have the namespace set by the apiserver, so the objects we keep must match it
+	if err := s.NormalizeNamespaces(objects); err != nil {
+		return err
+	}
+	previous, err := s.readMembers(ctx)
+	if err != nil {
+		return err
+	}
+	current := newApplySetMembers(objects)
+	members := previous.union(current)
+
+	keep := sets.New[string]()
+	for _, object := range objects {
+		keep.Insert(applySetObjectKey(object.GroupVersionKind().GroupKind(), object.GetNamespace(), object.GetName()))
+	}
+
+	namespaces := sets.List(members.namespaces.Clone().Insert(applySetParentNamespace))
+	listOptions := metav1.ListOptions{LabelSelector: applySetPartOfLabel + "=" + s.ID()}
+
+	for _, gk := range sortedGroupKinds(members.groupKinds) {
+		if applySetNeverPruneGroupKinds[gk] {
+			continue
+		}
+		restMapping, err := s.RESTMapper.RESTMapping(gk)
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				klog.Warningf("not pruning objects of kind %v, which is not served by the cluster", gk)
+				continue
+			}
+			return fmt.Errorf("unable to find resource for %s: %w", gk, err)
+		}
+
+		var resources []dynamic.ResourceInterface
+		if restMapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			for _, namespace := range namespaces {
+				resources = append(resources, s.Client.Resource(restMapping.Resource).Namespace(namespace))
+			}
+		} else {
+			resources = append(resources, s.Client.Resource(restMapping.Resource))
+		}
+
+		for _, resource := range resources {
+			actualObjects, err := resource.List(ctx, listOptions)
+			if err != nil {
+				return fmt.Errorf("error listing objects of kind %s: %w", gk, err)
+			}
+			for _, actualObject := range applySetObjectsToPrune(gk, actualObjects.Items, keep) {
+				klog.Infof("pruning %s %s/%s", gk, actualObject.GetNamespace(), actualObject.GetName())
+				if err := resource.Delete(ctx, actualObject.GetName(), metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+					return fmt.Errorf("failed to delete %s %s/%s: %w", gk, actualObject.GetNamespace(), actualObject.GetName(), err)
+				}
+			}
+		}
+	}
+
+	return s.writeMembers(ctx, current)
+}
+
+// applySetObjectsToPrune returns the objects that are not in keep.
+func applySetObjectsToPrune(gk schema.GroupKind, actualObjects []unstructured.Unstructured, keep sets.Set[string]) []*unstructured.Unstructured {
+	var prune []*unstructured.Unstructured
+	for i := range actualObjects {
+		actualObject := &actualObjects[i]
+		if keep.Has(applySetObjectKey(gk, actualObject.GetNamespace(), actualObject.GetName())) {
+			continue
+		}
+		prune = append(prune, actualObject)
+	}
+	return prune
+}
+
+func applySetObjectKey(gk schema.GroupKind, namespace, name string) string {
+	return gk.String() + ":" + namespace + "/" + name
+}
+
+func sortedGroupKinds(groupKinds sets.Set[schema.GroupKind]) []schema.GroupKind {
+	l := groupKinds.UnsortedList()
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].Group != l[j].Group {
+			return l[i].Group < l[j].Group
+		}
+		return l[i].Kind < l[j].Kind
+	})
+	return l
+}
+
+// readMembers reads the members recorded in the parent; the parent does not exist before the first apply.
+func (s *AddonApplySet) readMembers(ctx context.Context) (*applySetMembers, error) {
+	parent, err := s.Client.Resource(secretGVR).Namespace(applySetParentNamespace).Get(ctx, s.ParentName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return parseApplySetMembers(nil), nil
+		}
+		return nil, fmt.Errorf("error reading applyset parent %s/%s: %w", applySetParentNamespace, s.ParentName, err)
+	}
+	return parseApplySetMembers(parent.GetAnnotations()), nil
+}
+
+func (s *AddonApplySet) writeMembers(ctx context.Context, members *applySetMembers) error {
+	parent := &unstructured.Unstructured{}
+	parent.SetAPIVersion("v1")
+	parent.SetKind("Secret")
+	parent.SetNamespace(applySetParentNamespace)
+	parent.SetName(s.ParentName)
+	parent.SetLabels(map[string]string{
+		applySetIDLabel:                s.ID(),
+		"app.kubernetes.io/managed-by": "kops",
+	})
+	parent.SetAnnotations(members.annotations())
+
+	_, err := s.Client.Resource(secretGVR).Namespace(applySetParentNamespace).Apply(ctx, s.ParentName, parent, metav1.ApplyOptions{FieldManager: "kops", Force: true})
+	if err != nil {
+		return fmt.Errorf("error writing applyset parent %s/%s: %w", applySetParentNamespace, s.ParentName, err)
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channels
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/kops/pkg/kubemanifest"
+)
+
+const testApplySetManifest = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller
+  namespace: kube-system
+  labels:
+    app: controller
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller
+  namespace: addon-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: controller
+`
+
+func TestAddonApplySetID(t *testing.T) {
+	s := NewAddonApplySet(nil, nil, "Networking.Example.com")
+	if s.ParentName != "kops-addon-networking.example.com" {
+		t.Errorf("unexpected parent name %q", s.ParentName)
+	}
+
+	id := s.ID()
+	if !strings.HasPrefix(id, "applyset-") || !strings.HasSuffix(id, "-v1") {
+		t.Errorf("unexpected applyset ID %q", id)
+	}
+	// The ID is used as a label value, which is limited to 63 characters
+	if len(id) > 63 {
+		t.Errorf("applyset ID %q is too long", id)
+	}
+	if other := NewAddonApplySet(nil, nil, "other.example.com").ID(); other == id {
+		t.Errorf("expected different addons to have different IDs")
+	}
+}
+
+func TestAddonApplySetMembership(t *testing.T) {
+	objects, err := kubemanifest.LoadObjectsFrom([]byte(testApplySetManifest))
+	if err != nil {
+		t.Fatalf("error parsing manifest: %v", err)
+	}
+
+	s := NewAddonApplySet(nil, nil, "networking.example.com")
+	s.SetMembership(objects)
+	for _, object := range objects {
+		labels := object.ToUnstructured().GetLabels()
+		if labels[applySetPartOfLabel] != s.ID() {
+			t.Errorf("expected %s %q to be part of the applyset, labels were %v", object.Kind(), object.GetName(), labels)
+		}
+	}
+	if labels := objects[0].ToUnstructured().GetLabels(); labels["app"] != "controller" {
+		t.Errorf("expected existing labels to be kept, labels were %v", labels)
+	}
+
+	annotations := newApplySetMembers(objects).annotations()
+	expected := map[string]string{
+		applySetToolingAnnotation:    "kops/v1",
+		applySetGroupKindsAnnotation: "ClusterRole.rbac.authorization.k8s.io,Deployment.apps,ServiceAccount",
+		applySetNamespacesAnnotation: "addon-system",
+	}
+	if !reflect.DeepEqual(annotations, expected) {
+		t.Errorf("unexpected annotations:\n  got:  %v\n  want: %v", annotations, expected)
+	}
+
+	parsed := parseApplySetMembers(annotations)
+	expectedGroupKinds := sets.New(
+		schema.GroupKind{Group: "apps", Kind: "Deployment"},
+		schema.GroupKind{Group: "", Kind: "ServiceAccount"},
+		schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+	)
+	if !parsed.groupKinds.Equal(expectedGroupKinds) {
+		t.Errorf("unexpected group kinds %v", parsed.groupKinds)
+	}
+	if !parsed.namespaces.Equal(sets.New("addon-system")) {
+		t.Errorf("unexpected namespaces %v", parsed.namespaces)
+	}
+}
+
+func TestApplySetObjectsToPrune(t *testing.T) {
+	gk := schema.GroupKind{Group: "apps", Kind: "Deployment"}
+	keep := sets.New(applySetObjectKey(gk, "kube-system", "controller"))
+
+	newObject := func(namespace, name string) unstructured.Unstructured {
+		u := unstructured.Unstructured{}
+		u.SetNamespace(namespace)
+		u.SetName(name)
+		return u
+	}
+	actual := []unstructured.Unstructured{
+		newObject("kube-system", "controller"),
+		newObject("kube-system", "old-controller"),
+		newObject("addon-system", "controller"),
+	}
+
+	var pruned []string
+	for _, u := range applySetObjectsToPrune(gk, actual, keep) {
+		pruned = append(pruned, u.GetNamespace()+"/"+u.GetName())
+	}
+	expected := []string{"kube-system/old-controller", "addon-system/controller"}
+	if !reflect.DeepEqual(pruned, expected) {
+		t.Errorf("unexpected pruned objects:\n  got:  %v\n  want: %v", pruned, expected)
+	}
+}
+
+// testPruneManifest sets a namespace on cluster-scoped objects, as some addons do, and omits it on a namespaced object.
+const testPruneManifest = `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: node-driver-registrar
+  namespace: kube-system
+---
+apiVersion: storage.k8s.io/v1
+kind: StorageClass
+metadata:
+  name: sbs-default
+  namespace: kube-system
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`
+
+func TestAddonApplySetPrune(t *testing.T) {
+	ctx := context.Background()
+
+	clusterRoleGVR := schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}
+	storageClassGVR := schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"}
+	configMapGVR := schema.GroupVersionResource{Group: "", Version: "v1", Resource: "configmaps"}
+
+	restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{clusterRoleGVR.GroupVersion(), storageClassGVR.GroupVersion(), configMapGVR.GroupVersion()})
+	restMapper.Add(clusterRoleGVR.GroupVersion().WithKind("ClusterRole"), meta.RESTScopeRoot)
+	restMapper.Add(storageClassGVR.GroupVersion().WithKind("StorageClass"), meta.RESTScopeRoot)
+	restMapper.Add(configMapGVR.GroupVersion().WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	restMapper.Add(secretGVR.GroupVersion().WithKind("Secret"), meta.RESTScopeNamespace)
+
+	applySet := NewAddonApplySet(nil, restMapper, "csi.example.com")
+	newObject := func(gvr schema.GroupVersionResource, kind, namespace, name string) runtime.Object {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(gvr.GroupVersion().String())
+		u.SetKind(kind)
+		u.SetNamespace(namespace)
+		u.SetName(name)
+		u.SetLabels(map[string]string{applySetPartOfLabel: applySet.ID()})
+		return u
+	}
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			clusterRoleGVR:  "ClusterRoleList",
+			storageClassGVR: "StorageClassList",
+			configMapGVR:    "ConfigMapList",
+			secretGVR:       "SecretList",
+		},
+		newObject(clusterRoleGVR, "ClusterRole", "", "node-driver-registrar"),
+		newObject(clusterRoleGVR, "ClusterRole", "", "removed"),
+		newObject(storageClassGVR, "StorageClass", "", "sbs-default"),
+		newObject(configMapGVR, "ConfigMap", "default", "config"),
+		newObject(configMapGVR, "ConfigMap", "default", "removed"),
+	)
+	// The fake client does not implement server-side apply, which is used to write the parent
+	client.PrependReactor("patch", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &unstructured.Unstructured{}, nil
+	})
+	applySet.Client = client
+
+	objects, err := kubemanifest.LoadObjectsFrom([]byte(testPruneManifest))
+	if err != nil {
+		t.Fatalf("error parsing manifest: %v", err)
+	}
+	if err := applySet.Prune(ctx, objects); err != nil {
+		t.Fatalf("error pruning: %v", err)
+	}
+
+	grid := []struct {
+		gvr       schema.GroupVersionResource
+		namespace string
+		name      string
+		exists    bool
+	}{
+		{gvr: clusterRoleGVR, name: "node-driver-registrar", exists: true},
+		{gvr: clusterRoleGVR, name: "removed", exists: false},
+		{gvr: storageClassGVR, name: "sbs-default", exists: true},
+		{gvr: configMapGVR, namespace: "default", name: "config", exists: true},
+		{gvr: configMapGVR, namespace: "default", name: "removed", exists: false},
+	}
+	for _, g := range grid {
+		_, err := client.Resource(g.gvr).Namespace(g.namespace).Get(ctx, g.name, metav1.GetOptions{})
+		if g.exists && err != nil {
+			t.Errorf("expected %s %s/%s to be kept, got %v", g.gvr.Resource, g.namespace, g.name, err)
+		}
+		if !g.exists && !apierrors.IsNotFound(err) {
+			t.Errorf("expected %s %s/%s to be pruned, got %v", g.gvr.Resource, g.namespace, g.name, err)
+		}
+	}
+
+	for _, object := range objects {
+		if object.Kind() == "ConfigMap" && object.GetNamespace() != "default" {
+			t.Errorf("expected the namespace of the ConfigMap to be normalized, was %q", object.GetNamespace())
+		}
+		if object.Kind() != "ConfigMap" && object.GetNamespace() != "" {
+			t.Errorf("expected the namespace of %s %q to be removed, was %q", object.Kind(), object.GetName(), object.GetNamespace())
+		}
+	}
+}
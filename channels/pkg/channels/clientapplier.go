@@ -44,18 +44,14 @@ func (p *ClientApplier) Apply(ctx context.Context, manifest []byte) error {
 		FieldManager: "kops",
 	}
 
-	// We force to overcome errors like: Apply failed with 1 conflict: conflict with "kubectl-client-side-apply" using apps/v1: .spec.template.spec.containers[name="foo"].image
-	// TODO: How to handle this better?   In a controller we don't have a choice and have to force eventually.
-	// But we could do something like try first without forcing, log the conflict if there is one, and then force.
-	// This would mean that if there was a loop we could log/detect it.
-	// We could even do things like back-off on the force apply.
-	force := true
-	patchOptions.Force = &force
-
+	// Fields owned by other managers, for example after kubectl apply or kubectl edit, cause conflicts like:
+	// Apply failed with 1 conflict: conflict with "kubectl-client-side-apply" using apps/v1: .spec.template.spec.containers[name="foo"].image
+	// We log the conflict and then force, because the manifest of the addon is the source of truth for the fields it sets.
 	s, err := applyset.New(applyset.Options{
-		RESTMapper:   p.RESTMapper,
-		Client:       p.Client,
-		PatchOptions: patchOptions,
+		RESTMapper:      p.RESTMapper,
+		Client:          p.Client,
+		PatchOptions:    patchOptions,
+		ForceOnConflict: true,
 	})
 	if err != nil {
 		return err
@@ -74,7 +70,7 @@ func (p *ClientApplier) Apply(ctx context.Context, manifest []byte) error {
 		return fmt.Errorf("failed to apply objects: %w", err)
 	}
 
-	// TODO: Implement pruning
+	// Objects removed from the manifest are pruned by the AddonApplySet of the addon
 
 	if !results.AllApplied() {
 		return fmt.Errorf("not all objects were applied")
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channels
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/multierr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/applylib/applyset"
+	"k8s.io/kops/pkg/kubemanifest"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+// DriftEnforcer re-applies the manifests of installed addons,
+// so that changes made to their objects outside of kops are reverted.
+type DriftEnforcer struct {
+	Client     dynamic.Interface
+	RESTMapper meta.RESTMapper
+}
+
+// EnforcedResource is a kind of object in the manifests of the addons managed by kops.
+type EnforcedResource struct {
+	Group    string
+	Kind     string
+	Resource string
+}
+
+// EnforcedResources are the kinds of objects in the manifests of the addons managed by kops.
+// kops-controller is only granted access to these kinds, so objects of other kinds are left to kops-channels.
+var EnforcedResources = []EnforcedResource{
+	{Group: "", Kind: "ConfigMap", Resource: "configmaps"},
+	{Group: "", Kind: "Endpoints", Resource: "endpoints"},
+	{Group: "", Kind: "LimitRange", Resource: "limitranges"},
+	{Group: "", Kind: "Namespace", Resource: "namespaces"},
+	{Group: "", Kind: "Secret", Resource: "secrets"},
+	{Group: "", Kind: "Service", Resource: "services"},
+	{Group: "", Kind: "ServiceAccount", Resource: "serviceaccounts"},
+	{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration", Resource: "mutatingwebhookconfigurations"},
+	{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration", Resource: "validatingwebhookconfigurations"},
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition", Resource: "customresourcedefinitions"},
+	{Group: "apiregistration.k8s.io", Kind: "APIService", Resource: "apiservices"},
+	{Group: "apps", Kind: "DaemonSet", Resource: "daemonsets"},
+	{Group: "apps", Kind: "Deployment", Resource: "deployments"},
+	{Group: "apps", Kind: "StatefulSet", Resource: "statefulsets"},
+	{Group: "cert-manager.io", Kind: "Certificate", Resource: "certificates"},
+	{Group: "cert-manager.io", Kind: "Issuer", Resource: "issuers"},
+	{Group: "elbv2.k8s.aws", Kind: "IngressClassParams", Resource: "ingressclassparams"},
+	{Group: "gateway.networking.k8s.io", Kind: "GatewayClass", Resource: "gatewayclasses"},
+	{Group: "iamauthenticator.k8s.aws", Kind: "IAMIdentityMapping", Resource: "iamidentitymappings"},
+	{Group: "karpenter.k8s.aws", Kind: "EC2NodeClass", Resource: "ec2nodeclasses"},
+	{Group: "karpenter.sh", Kind: "NodePool", Resource: "nodepools"},
+	{Group: "networking.k8s.io", Kind: "IngressClass", Resource: "ingressclasses"},
+	{Group: "node.k8s.io", Kind: "RuntimeClass", Resource: "runtimeclasses"},
+	{Group: "policy", Kind: "PodDisruptionBudget", Resource: "poddisruptionbudgets"},
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole", Resource: "clusterroles"},
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding", Resource: "clusterrolebindings"},
+	{Group: "rbac.authorization.k8s.io", Kind: "Role", Resource: "roles"},
+	{Group: "rbac.authorization.k8s.io", Kind: "RoleBinding", Resource: "rolebindings"},
+	{Group: "scheduling.k8s.io", Kind: "PriorityClass", Resource: "priorityclasses"},
+	{Group: "snapshot.storage.k8s.io", Kind: "VolumeSnapshotClass", Resource: "volumesnapshotclasses"},
+	{Group: "storage.k8s.io", Kind: "CSIDriver", Resource: "csidrivers"},
+	{Group: "storage.k8s.io", Kind: "StorageClass", Resource: "storageclasses"},
+}
+
+// isEnforced returns true if objects of the kind are re-applied by the DriftEnforcer.
+func isEnforced(gk schema.GroupKind) bool {
+	for _, r := range EnforcedResources {
+		if r.Group == gk.Group && r.Kind == gk.Kind {
+			return true
+		}
+	}
+	return false
+}
+
+// DriftedObject is an object of an addon that did not match the manifest of the addon, and was re-applied.
+type DriftedObject struct {
+	// Object is the object as it was re-applied.
+	Object *unstructured.Unstructured
+	// Recreated is true if the object had been deleted.
+	Recreated bool
+}
+
+// IsInstalled returns true if existingVersion is the version of the addon, so that its manifest is the one applied in the cluster.
+func (a *Addon) IsInstalled(existingVersion *ChannelVersion) bool {
+	if existingVersion == nil {
+		return false
+	}
+	newVersion := a.ChannelVersion()
+	return existingVersion.Id == newVersion.Id && existingVersion.ManifestHash == newVersion.ManifestHash
+}
+
+// Enforce re-applies the objects of the addon that no longer match its manifest.
+// The objects are built and applied as when the addon is updated, so that re-applying an object that has not changed is a no-op.
+func (e *DriftEnforcer) Enforce(ctx context.Context, vfsContext *vfs.VFSContext, addon *Addon) ([]*DriftedObject, error) {
+	manifestURL, err := addon.GetManifestFullUrl()
+	if err != nil {
+		return nil, err
+	}
+	data, err := vfsContext.ReadFile(manifestURL.String())
+	if err != nil {
+		return nil, fmt.Errorf("error reading manifest %q: %w", manifestURL, err)
+	}
+	objects, err := kubemanifest.LoadObjectsFrom(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing manifest %q: %w", manifestURL, err)
+	}
+	applySet := NewAddonApplySet(e.Client, e.RESTMapper, addon.Name)
+	applySet.SetMembership(objects)
+	if err := applySet.NormalizeNamespaces(objects); err != nil {
+		return nil, err
+	}
+
+	client := applyset.NewUnstructuredClient(applyset.Options{
+		Client:     e.Client,
+		RESTMapper: e.RESTMapper,
+	})
+
+	var drifted []*DriftedObject
+	var merr error
+	for _, object := range objects {
+		if gk := object.GroupVersionKind().GroupKind(); !isEnforced(gk) {
+			klog.V(2).Infof("not enforcing %s %s/%s of addon %q, kind is not enforced", gk, object.GetNamespace(), object.GetName(), addon.Name)
+			continue
+		}
+		d, err := e.enforceObject(ctx, client, object.ToUnstructured())
+		if err != nil {
+			merr = multierr.Append(merr, fmt.Errorf("error enforcing %s %s/%s: %w", object.Kind(), object.GetNamespace(), object.GetName(), err))
+			continue
+		}
+		if d != nil {
+			drifted = append(drifted, d)
+		}
+	}
+	return drifted, merr
+}
+
+// enforceObject re-applies an object if it is missing, or if applying it would change it.
+func (e *DriftEnforcer) enforceObject(ctx context.Context, client *applyset.UnstructuredClient, desired *unstructured.Unstructured) (*DriftedObject, error) {
+	gvk := desired.GroupVersionKind()
+	nn := types.NamespacedName{Namespace: desired.GetNamespace(), Name: desired.GetName()}
+
+	current, err := client.Get(ctx, gvk, nn)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		current = nil
+	}
+
+	j, err := json.Marshal(desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal object to JSON: %w", err)
+	}
+	force := true
+	patchOptions := metav1.PatchOptions{
+		FieldManager: "kops",
+		Force:        &force,
+	}
+
+	if current != nil {
+		// Fields changed with kubectl edit are taken over, so that applying the manifest also removes the fields it does not set
+		managedFields := &applyset.ManagedFieldsMigrator{
+			NewManager: "kops",
+			Client:     client,
+		}
+		if err := managedFields.Migrate(ctx, current); err != nil {
+			return nil, err
+		}
+
+		dryRunOptions := patchOptions
+		dryRunOptions.DryRun = []string{metav1.DryRunAll}
+		wouldApply, err := client.Patch(ctx, gvk, nn, types.ApplyPatchType, j, dryRunOptions)
+		if err != nil {
+			return nil, fmt.Errorf("error from dry-run apply: %w", err)
+		}
+		if !hasDrifted(current, wouldApply) {
+			return nil, nil
+		}
+	}
+
+	applied, err := client.Patch(ctx, gvk, nn, types.ApplyPatchType, j, patchOptions)
+	if err != nil {
+		return nil, fmt.Errorf("error from apply: %w", err)
+	}
+	klog.Infof("re-applied %s %s/%s, which did not match its manifest", gvk.Kind, nn.Namespace, nn.Name)
+	return &DriftedObject{Object: applied, Recreated: current == nil}, nil
+}
+
+// hasDrifted returns true if applying the manifest changes the object, ignoring the fields maintained by the apiserver and the status.
+func hasDrifted(current, wouldApply *unstructured.Unstructured) bool {
+	return !equality.Semantic.DeepEqual(comparableContent(current), comparableContent(wouldApply))
+}
+
+func comparableContent(u *unstructured.Unstructured) map[string]any {
+	u = u.DeepCopy()
+	unstructured.RemoveNestedField(u.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(u.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(u.Object, "metadata", "generation")
+	unstructured.RemoveNestedField(u.Object, "status")
+	return u.Object
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channels
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/kops/channels/pkg/api"
+	"k8s.io/kops/pkg/kubemanifest"
+)
+
+func TestAddonIsInstalled(t *testing.T) {
+	addon := &Addon{
+		Name:        "dns-controller.addons.k8s.io",
+		ChannelName: "bootstrap",
+		Spec: &api.AddonSpec{
+			Id:           "k8s-1.12",
+			ManifestHash: "abc",
+		},
+	}
+
+	grid := []struct {
+		name     string
+		existing *ChannelVersion
+		expected bool
+	}{
+		{
+			name:     "not installed",
+			existing: nil,
+			expected: false,
+		},
+		{
+			name:     "same version",
+			existing: &ChannelVersion{Id: "k8s-1.12", ManifestHash: "abc"},
+			expected: true,
+		},
+		{
+			name:     "update pending",
+			existing: &ChannelVersion{Id: "k8s-1.12", ManifestHash: "def"},
+			expected: false,
+		},
+		{
+			name:     "different id",
+			existing: &ChannelVersion{Id: "k8s-1.11", ManifestHash: "abc"},
+			expected: false,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			if actual := addon.IsInstalled(g.existing); actual != g.expected {
+				t.Errorf("expected %v, got %v", g.expected, actual)
+			}
+		})
+	}
+}
+
+func TestHasDrifted(t *testing.T) {
+	current := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":            "coredns",
+			"namespace":       "kube-system",
+			"resourceVersion": "100",
+			"managedFields":   []any{map[string]any{"manager": "kops"}},
+		},
+		"data": map[string]any{
+			"Corefile": ".:53 {}",
+		},
+	}}
+
+	unchanged := current.DeepCopy()
+	unstructured.SetNestedField(unchanged.Object, "101", "metadata", "resourceVersion")
+	unstructured.SetNestedSlice(unchanged.Object, []any{map[string]any{"manager": "kops", "time": "now"}}, "metadata", "managedFields")
+	if hasDrifted(current, unchanged) {
+		t.Errorf("expected no drift when only metadata maintained by the apiserver changes")
+	}
+
+	changed := current.DeepCopy()
+	unstructured.SetNestedField(changed.Object, ".:53 { forward . /etc/resolv.conf }", "data", "Corefile")
+	if !hasDrifted(current, changed) {
+		t.Errorf("expected drift when the data changes")
+	}
+
+	withStatus := current.DeepCopy()
+	unstructured.SetNestedField(withStatus.Object, int64(2), "status", "replicas")
+	if hasDrifted(current, withStatus) {
+		t.Errorf("expected no drift when only the status changes")
+	}
+}
+
+// TestEnforcedResources checks that the kinds of objects in the addon manifests of the integration tests are all enforced,
+// so that kops-controller is granted access to them.
+func TestEnforcedResources(t *testing.T) {
+	files, err := filepath.Glob("../../../tests/integration/update_cluster/*/data/aws_s3_object_*-addons-*_content")
+	if err != nil {
+		t.Fatalf("error listing addon manifests: %v", err)
+	}
+	if len(files) == 0 {
+		t.Fatalf("no addon manifests found")
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file, "-addons-bootstrap_content") {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("error reading %s: %v", file, err)
+		}
+		objects, err := kubemanifest.LoadObjectsFrom(data)
+		if err != nil {
+			t.Fatalf("error parsing %s: %v", file, err)
+		}
+		for _, object := range objects {
+			if gk := object.GroupVersionKind().GroupKind(); !isEnforced(gk) {
+				t.Errorf("%s: kind %s of %s is not in EnforcedResources", filepath.Base(file), gk, object.GetName())
+			}
+		}
+	}
+}
@@ -359,3 +359,43 @@ func (m *MockAutoscaling) DescribeLifecycleHooks(ctx context.Context, input *aut
 	}
 	return response, nil
 }
+
+func (m *MockAutoscaling) DeleteLifecycleHook(ctx context.Context, input *autoscaling.DeleteLifecycleHookInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DeleteLifecycleHookOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	name := *input.AutoScalingGroupName + "::" + *input.LifecycleHookName
+	if m.LifecycleHooks[name] == nil {
+		return nil, fmt.Errorf("lifecycle hook %q not found", *input.LifecycleHookName)
+	}
+	delete(m.LifecycleHooks, name)
+
+	return &autoscaling.DeleteLifecycleHookOutput{}, nil
+}
+
+func (m *MockAutoscaling) CompleteLifecycleAction(ctx context.Context, input *autoscaling.CompleteLifecycleActionInput, optFns ...func(*autoscaling.Options)) (*autoscaling.CompleteLifecycleActionOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	group := m.Groups[aws.ToString(input.AutoScalingGroupName)]
+	if group == nil {
+		return nil, fmt.Errorf("autoscaling group %q not found", aws.ToString(input.AutoScalingGroupName))
+	}
+	for i := range group.Instances {
+		instance := &group.Instances[i]
+		if aws.ToString(instance.InstanceId) != aws.ToString(input.InstanceId) {
+			continue
+		}
+		switch instance.LifecycleState {
+		case autoscalingtypes.LifecycleStateTerminatingWait:
+			instance.LifecycleState = autoscalingtypes.LifecycleStateTerminatingProceed
+		case autoscalingtypes.LifecycleStatePendingWait:
+			instance.LifecycleState = autoscalingtypes.LifecycleStatePendingProceed
+		default:
+			return nil, fmt.Errorf("instance %q is not waiting on a lifecycle action", aws.ToString(input.InstanceId))
+		}
+		return &autoscaling.CompleteLifecycleActionOutput{}, nil
+	}
+
+	return nil, fmt.Errorf("instance %q not found in autoscaling group %q", aws.ToString(input.InstanceId), aws.ToString(input.AutoScalingGroupName))
+}
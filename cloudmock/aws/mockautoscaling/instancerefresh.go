@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockautoscaling
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"k8s.io/klog/v2"
+)
+
+func isActiveInstanceRefresh(refresh *autoscalingtypes.InstanceRefresh) bool {
+	switch refresh.Status {
+	case autoscalingtypes.InstanceRefreshStatusPending,
+		autoscalingtypes.InstanceRefreshStatusInProgress,
+		autoscalingtypes.InstanceRefreshStatusBaking:
+		return true
+	}
+	return false
+}
+
+func (m *MockAutoscaling) StartInstanceRefresh(ctx context.Context, input *autoscaling.StartInstanceRefreshInput, optFns ...func(*autoscaling.Options)) (*autoscaling.StartInstanceRefreshOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.V(2).Infof("Mock StartInstanceRefresh %v", input)
+
+	name := aws.ToString(input.AutoScalingGroupName)
+	if m.Groups[name] == nil {
+		return nil, fmt.Errorf("autoscaling group %q not found", name)
+	}
+	for _, refresh := range m.InstanceRefreshes[name] {
+		if isActiveInstanceRefresh(refresh) {
+			return nil, &autoscalingtypes.InstanceRefreshInProgressFault{Message: aws.String("an instance refresh is already in progress")}
+		}
+	}
+
+	if m.InstanceRefreshes == nil {
+		m.InstanceRefreshes = make(map[string][]*autoscalingtypes.InstanceRefresh)
+	}
+	id := fmt.Sprintf("%s-refresh-%d", name, len(m.InstanceRefreshes[name])+1)
+	m.InstanceRefreshes[name] = append(m.InstanceRefreshes[name], &autoscalingtypes.InstanceRefresh{
+		AutoScalingGroupName: input.AutoScalingGroupName,
+		InstanceRefreshId:    aws.String(id),
+		Preferences:          input.Preferences,
+		Status:               autoscalingtypes.InstanceRefreshStatusPending,
+	})
+
+	return &autoscaling.StartInstanceRefreshOutput{InstanceRefreshId: aws.String(id)}, nil
+}
+
+func (m *MockAutoscaling) DescribeInstanceRefreshes(ctx context.Context, input *autoscaling.DescribeInstanceRefreshesInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeInstanceRefreshesOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	output := &autoscaling.DescribeInstanceRefreshesOutput{}
+	refreshes := m.InstanceRefreshes[aws.ToString(input.AutoScalingGroupName)]
+	// Like AWS, return the most recent instance refresh first.
+	for i := len(refreshes) - 1; i >= 0; i-- {
+		refresh := refreshes[i]
+		if len(input.InstanceRefreshIds) != 0 && !slices.Contains(input.InstanceRefreshIds, aws.ToString(refresh.InstanceRefreshId)) {
+			continue
+		}
+		output.InstanceRefreshes = append(output.InstanceRefreshes, *refresh)
+	}
+	return output, nil
+}
+
+func (m *MockAutoscaling) CancelInstanceRefresh(ctx context.Context, input *autoscaling.CancelInstanceRefreshInput, optFns ...func(*autoscaling.Options)) (*autoscaling.CancelInstanceRefreshOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.V(2).Infof("Mock CancelInstanceRefresh %v", input)
+
+	for _, refresh := range m.InstanceRefreshes[aws.ToString(input.AutoScalingGroupName)] {
+		if isActiveInstanceRefresh(refresh) {
+			refresh.Status = autoscalingtypes.InstanceRefreshStatusCancelled
+			return &autoscaling.CancelInstanceRefreshOutput{InstanceRefreshId: refresh.InstanceRefreshId}, nil
+		}
+	}
+	return nil, &autoscalingtypes.ActiveInstanceRefreshNotFoundFault{Message: aws.String("no active instance refresh")}
+}
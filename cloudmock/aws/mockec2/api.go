@@ -62,6 +62,8 @@ type MockEC2 struct {
 
 	NatGateways map[string]*ec2types.NatGateway
 
+	InstanceTypeOfferings []ec2types.InstanceTypeOffering
+
 	idsMutex sync.Mutex
 	ids      map[string]*idAllocator
 }
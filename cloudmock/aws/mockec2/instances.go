@@ -18,6 +18,8 @@ package mockec2
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -35,6 +37,66 @@ func (m *MockEC2) DescribeInstanceTypes(ctx context.Context, request *ec2.Descri
 	return &ec2.DescribeInstanceTypesOutput{}, nil
 }
 
+func (m *MockEC2) DescribeInstanceTypeOfferings(ctx context.Context, request *ec2.DescribeInstanceTypeOfferingsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstanceTypeOfferingsOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	locationType := request.LocationType
+	if locationType == "" {
+		locationType = ec2types.LocationTypeRegion
+	}
+
+	offerings := m.InstanceTypeOfferings
+	if offerings == nil {
+		// Unless offerings were set, every instance type that is asked for is offered in every location that is asked for
+		var instanceTypes, locations []string
+		for _, filter := range request.Filters {
+			switch aws.ToString(filter.Name) {
+			case "instance-type":
+				instanceTypes = filter.Values
+			case "location":
+				locations = filter.Values
+			}
+		}
+		for _, instanceType := range instanceTypes {
+			for _, location := range locations {
+				offerings = append(offerings, ec2types.InstanceTypeOffering{
+					InstanceType: ec2types.InstanceType(instanceType),
+					Location:     aws.String(location),
+					LocationType: locationType,
+				})
+			}
+		}
+	}
+
+	response := &ec2.DescribeInstanceTypeOfferingsOutput{}
+	for _, offering := range offerings {
+		if offering.LocationType != locationType {
+			continue
+		}
+		allFiltersMatch := true
+		for _, filter := range request.Filters {
+			var value string
+			switch aws.ToString(filter.Name) {
+			case "instance-type":
+				value = string(offering.InstanceType)
+			case "location":
+				value = aws.ToString(offering.Location)
+			default:
+				return nil, fmt.Errorf("unknown filter name: %q", aws.ToString(filter.Name))
+			}
+			if !slices.Contains(filter.Values, value) {
+				allFiltersMatch = false
+				break
+			}
+		}
+		if allFiltersMatch {
+			response.InstanceTypeOfferings = append(response.InstanceTypeOfferings, offering)
+		}
+	}
+	return response, nil
+}
+
 func (m *MockEC2) GetInstanceTypesFromInstanceRequirements(ctx context.Context, request *ec2.GetInstanceTypesFromInstanceRequirementsInput, optFns ...func(*ec2.Options)) (*ec2.GetInstanceTypesFromInstanceRequirementsOutput, error) {
 	return &ec2.GetInstanceTypesFromInstanceRequirementsOutput{
 		InstanceTypes: []ec2types.InstanceTypeInfoFromInstanceRequirements{
@@ -246,6 +246,9 @@ func responseLaunchTemplateData(req *ec2types.RequestLaunchTemplateData) *ec2typ
 	if req.CreditSpecification != nil {
 		resp.CreditSpecification = &ec2types.CreditSpecification{CpuCredits: req.CreditSpecification.CpuCredits}
 	}
+	if req.HibernationOptions != nil {
+		resp.HibernationOptions = &ec2types.LaunchTemplateHibernationOptions{Configured: req.HibernationOptions.Configured}
+	}
 	if req.IamInstanceProfile != nil {
 		resp.IamInstanceProfile = &ec2types.LaunchTemplateIamInstanceProfileSpecification{
 			Arn:  req.IamInstanceProfile.Arn,
@@ -19,6 +19,8 @@ package mockec2
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -137,7 +139,47 @@ func (m *MockEC2) DescribeSecurityGroups(ctx context.Context, request *ec2.Descr
 }
 
 func (m *MockEC2) RevokeSecurityGroupEgress(ctx context.Context, request *ec2.RevokeSecurityGroupEgressInput, optFns ...func(*ec2.Options)) (*ec2.RevokeSecurityGroupEgressOutput, error) {
-	panic("Not implemented")
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.Infof("RevokeSecurityGroupEgress: %v", request)
+
+	if aws.ToString(request.GroupId) == "" {
+		return nil, fmt.Errorf("GroupId not specified")
+	}
+
+	if request.DryRun != nil {
+		klog.Fatalf("DryRun")
+	}
+
+	sg := m.SecurityGroups[*request.GroupId]
+	if sg == nil {
+		return nil, fmt.Errorf("SecurityGroup not found")
+	}
+
+	if len(request.SecurityGroupRuleIds) == 0 {
+		klog.Fatalf("RevokeSecurityGroupEgress without SecurityGroupRuleIds not implemented")
+	}
+	if err := m.revokeSecurityGroupRules(*request.GroupId, request.SecurityGroupRuleIds); err != nil {
+		return nil, err
+	}
+
+	response := &ec2.RevokeSecurityGroupEgressOutput{}
+	return response, nil
+}
+
+// revokeSecurityGroupRules removes the rules with the given IDs from the security group.
+func (m *MockEC2) revokeSecurityGroupRules(groupID string, ruleIDs []string) error {
+	for _, id := range ruleIDs {
+		rule := m.SecurityGroupRules[id]
+		if rule == nil || aws.ToString(rule.GroupId) != groupID {
+			return fmt.Errorf("SecurityGroupRule %q not found", id)
+		}
+	}
+	for _, id := range ruleIDs {
+		delete(m.SecurityGroupRules, id)
+	}
+	return nil
 }
 
 func (m *MockEC2) RevokeSecurityGroupIngress(ctx context.Context, request *ec2.RevokeSecurityGroupIngressInput, optFns ...func(*ec2.Options)) (*ec2.RevokeSecurityGroupIngressOutput, error) {
@@ -162,6 +204,13 @@ func (m *MockEC2) RevokeSecurityGroupIngress(ctx context.Context, request *ec2.R
 		return nil, fmt.Errorf("SecurityGroup not found")
 	}
 
+	if len(request.SecurityGroupRuleIds) != 0 {
+		if err := m.revokeSecurityGroupRules(*request.GroupId, request.SecurityGroupRuleIds); err != nil {
+			return nil, err
+		}
+		return &ec2.RevokeSecurityGroupIngressOutput{}, nil
+	}
+
 	klog.Warningf("RevokeSecurityGroupIngress mock not implemented - does not actually revoke permissions")
 
 	response := &ec2.RevokeSecurityGroupIngressOutput{}
@@ -384,15 +433,37 @@ func (m *MockEC2) DescribeSecurityGroupRules(ctx context.Context, request *ec2.D
 
 	rules := []ec2types.SecurityGroupRule{}
 
-	sgid := ""
-	for _, filter := range request.Filters {
-		if aws.ToString(filter.Name) == "group-id" {
-			sgid = filter.Values[0]
+	for _, rule := range m.SecurityGroupRules {
+		allFiltersMatch := true
+		for _, filter := range request.Filters {
+			match := false
+			name := aws.ToString(filter.Name)
+			switch {
+			case name == "group-id":
+				match = slices.Contains(filter.Values, aws.ToString(rule.GroupId))
+			case strings.HasPrefix(name, "tag:"):
+				for _, tag := range rule.Tags {
+					if aws.ToString(tag.Key) == name[4:] && slices.Contains(filter.Values, aws.ToString(tag.Value)) {
+						match = true
+					}
+				}
+			case name == "tag-key":
+				for _, tag := range rule.Tags {
+					if slices.Contains(filter.Values, aws.ToString(tag.Key)) {
+						match = true
+					}
+				}
+			default:
+				return nil, fmt.Errorf("unknown filter name: %q", name)
+			}
+
+			if !match {
+				allFiltersMatch = false
+				break
+			}
 		}
-	}
 
-	for _, rule := range m.SecurityGroupRules {
-		if aws.ToString(rule.GroupId) == sgid {
+		if allFiltersMatch {
 			rules = append(rules, *rule)
 		}
 	}
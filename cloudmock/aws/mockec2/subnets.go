@@ -72,6 +72,10 @@ func (m *MockEC2) CreateSubnetWithId(request *ec2.CreateSubnetInput, id string)
 		},
 	}
 
+	if aws.ToBool(request.Ipv6Native) {
+		subnet.Ipv6Native = request.Ipv6Native
+	}
+
 	if request.Ipv6CidrBlock != nil {
 		subnet.Ipv6CidrBlockAssociationSet = []ec2types.SubnetIpv6CidrBlockAssociation{
 			{
@@ -232,6 +236,9 @@ func (m *MockEC2) ModifySubnetAttribute(ctx context.Context, request *ec2.Modify
 	if request.AssignIpv6AddressOnCreation != nil {
 		subnet.main.AssignIpv6AddressOnCreation = request.AssignIpv6AddressOnCreation.Value
 	}
+	if request.EnableDns64 != nil {
+		subnet.main.EnableDns64 = request.EnableDns64.Value
+	}
 	if request.EnableResourceNameDnsAAAARecordOnLaunch != nil {
 		subnet.main.PrivateDnsNameOptionsOnLaunch.EnableResourceNameDnsAAAARecord = request.EnableResourceNameDnsAAAARecordOnLaunch.Value
 	}
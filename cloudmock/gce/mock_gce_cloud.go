@@ -17,6 +17,7 @@ limitations under the License.
 package gce
 
 import (
+	"context"
 	"fmt"
 
 	"google.golang.org/api/cloudresourcemanager/v1"
@@ -137,12 +138,12 @@ func (c *MockGCECloud) WaitForOp(op *compute.Operation) error {
 }
 
 // FindClusterStatus implements GCECloud::FindClusterStatus
-func (c *MockGCECloud) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+func (c *MockGCECloud) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
 	return nil, fmt.Errorf("MockGCECloud::FindClusterStatus not implemented")
 }
 
 // GetApiIngressStatus implements GCECloud::GetApiIngressStatus
-func (c *MockGCECloud) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+func (c *MockGCECloud) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
 	return nil, fmt.Errorf("MockGCECloud::GetApiIngressStatus not implemented")
 }
 
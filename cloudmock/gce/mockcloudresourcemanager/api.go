@@ -59,6 +59,9 @@ func (s *mockCloudResourceManagerService) RoundTrip(request *http.Request) (*htt
 
 	pathTokens := strings.Split(strings.TrimPrefix(url.Path, "/"), "/")
 	if len(pathTokens) >= 1 && pathTokens[0] == "v1" {
+		if len(pathTokens) == 3 && pathTokens[1] == "projects" && request.Method == "GET" {
+			return s.projects.get(pathTokens[2])
+		}
 		if len(pathTokens) >= 3 && pathTokens[1] == "projects" {
 			projectTokens := strings.Split(pathTokens[2], ":")
 			if len(projectTokens) == 2 {
@@ -38,6 +38,18 @@ func (s *projects) Init() {
 	s.projectBindings = make(map[string]*cloudresourcemanager.Policy)
 }
 
+// mockProjectNumber is the number of all mock projects
+const mockProjectNumber = 123456789012
+
+func (s *projects) get(projectID string) (*http.Response, error) {
+	project := &cloudresourcemanager.Project{
+		ProjectId:      projectID,
+		ProjectNumber:  mockProjectNumber,
+		LifecycleState: "ACTIVE",
+	}
+	return gcphttp.OKResponse(project)
+}
+
 func (s *projects) getIAMPolicy(projectID string, request *http.Request) (*http.Response, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
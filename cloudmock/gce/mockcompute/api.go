@@ -44,6 +44,9 @@ type MockClient struct {
 	targetPoolClient           *targetPoolClient
 
 	diskClient *diskClient
+
+	machineTypeClient *machineTypeClient
+	imageClient       *imageClient
 }
 
 var _ gce.ComputeClient = &MockClient{}
@@ -73,6 +76,9 @@ func NewMockClient(project string) *MockClient {
 		targetPoolClient:           newTargetPoolClient(),
 
 		diskClient: newDiskClient(),
+
+		machineTypeClient: newMachineTypeClient(),
+		imageClient:       newImageClient(),
 	}
 }
 
@@ -181,6 +187,25 @@ func (c *MockClient) Disks() gce.DiskClient {
 	return c.diskClient
 }
 
+func (c *MockClient) MachineTypes() gce.MachineTypeClient {
+	return c.machineTypeClient
+}
+
+// SetMachineTypes sets the machine types available in a zone.
+// Any machine type is available in the zones that machine types were not set for.
+func (c *MockClient) SetMachineTypes(zone string, names ...string) {
+	c.machineTypeClient.setMachineTypes(zone, names)
+}
+
+func (c *MockClient) Images() gce.ImageClient {
+	return c.imageClient
+}
+
+// SetImageFamily sets the name of the latest image of an image family.
+func (c *MockClient) SetImageFamily(project, family, name string) {
+	c.imageClient.setImageFamily(project, family, name)
+}
+
 func notFoundError() error {
 	return &googleapi.Error{
 		Code: 404,
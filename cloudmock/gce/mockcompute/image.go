@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockcompute
+
+import (
+	"context"
+	"sync"
+
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+type imageClient struct {
+	// families are the names of the latest image of each image family, keyed by project and family.
+	families map[string]string
+	sync.Mutex
+}
+
+var _ gce.ImageClient = &imageClient{}
+
+func newImageClient() *imageClient {
+	return &imageClient{
+		families: map[string]string{},
+	}
+}
+
+func (c *imageClient) setImageFamily(project, family, name string) {
+	c.Lock()
+	defer c.Unlock()
+	c.families[project+"/"+family] = name
+}
+
+func (c *imageClient) GetFromFamily(ctx context.Context, project, family string) (*compute.Image, error) {
+	c.Lock()
+	defer c.Unlock()
+	name, ok := c.families[project+"/"+family]
+	if !ok {
+		return nil, notFoundError()
+	}
+	return &compute.Image{
+		Name:     name,
+		Family:   family,
+		SelfLink: "https://www.googleapis.com/compute/v1/projects/" + project + "/global/images/" + name,
+	}, nil
+}
@@ -178,6 +178,10 @@ func (c *instanceGroupManagerClient) RecreateInstances(project, zone, name, id s
 	return doneOperation(), nil
 }
 
+func (c *instanceGroupManagerClient) ApplyUpdatesToInstances(project, zone, name string, req *compute.InstanceGroupManagersApplyUpdatesRequest) (*compute.Operation, error) {
+	return doneOperation(), nil
+}
+
 func (c *instanceGroupManagerClient) SetTargetPools(project, zone, name string, targetPools []string) (*compute.Operation, error) {
 	return doneOperation(), nil
 }
@@ -186,6 +190,22 @@ func (c *instanceGroupManagerClient) SetInstanceTemplate(project, zone, name, in
 	return doneOperation(), nil
 }
 
+func (c *instanceGroupManagerClient) Patch(project, zone, name string, patch *compute.InstanceGroupManager) (*compute.Operation, error) {
+	c.Lock()
+	defer c.Unlock()
+	igm, ok := c.instanceGroupManagers[project][zone][name]
+	if !ok {
+		return nil, notFoundError()
+	}
+	if patch.InstanceFlexibilityPolicy != nil {
+		igm.InstanceFlexibilityPolicy = patch.InstanceFlexibilityPolicy
+	}
+	if patch.UpdatePolicy != nil {
+		igm.UpdatePolicy = patch.UpdatePolicy
+	}
+	return doneOperation(), nil
+}
+
 func (c *instanceGroupManagerClient) Resize(project, zone, name string, newSize int64) (*compute.Operation, error) {
 	go func() {
 		if newSize == 0 {
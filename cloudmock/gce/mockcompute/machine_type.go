@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockcompute
+
+import (
+	"context"
+	"sync"
+
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+type machineTypeClient struct {
+	// machineTypes are the names of the machine types available, keyed by zone.
+	machineTypes map[string][]string
+	sync.Mutex
+}
+
+var _ gce.MachineTypeClient = &machineTypeClient{}
+
+func newMachineTypeClient() *machineTypeClient {
+	return &machineTypeClient{
+		machineTypes: map[string][]string{},
+	}
+}
+
+func (c *machineTypeClient) setMachineTypes(zone string, names []string) {
+	c.Lock()
+	defer c.Unlock()
+	c.machineTypes[zone] = names
+}
+
+func (c *machineTypeClient) Get(ctx context.Context, project, zone, name string) (*compute.MachineType, error) {
+	c.Lock()
+	defer c.Unlock()
+	names, ok := c.machineTypes[zone]
+	if !ok {
+		return &compute.MachineType{Name: name, Zone: zone}, nil
+	}
+	for _, n := range names {
+		if n == name {
+			return &compute.MachineType{Name: name, Zone: zone}, nil
+		}
+	}
+	return nil, notFoundError()
+}
+
+func (c *machineTypeClient) List(ctx context.Context, project, zone string) ([]*compute.MachineType, error) {
+	c.Lock()
+	defer c.Unlock()
+	var l []*compute.MachineType
+	for _, name := range c.machineTypes[zone] {
+		l = append(l, &compute.MachineType{Name: name, Zone: zone})
+	}
+	return l, nil
+}
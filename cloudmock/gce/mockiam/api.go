@@ -23,7 +23,8 @@ import (
 
 // MockClient represents a mocked IAM client.
 type MockClient struct {
-	serviceAccounts *serviceAccountClient
+	serviceAccounts       *serviceAccountClient
+	workloadIdentityPools *workloadIdentityPoolClient
 }
 
 var _ gce.IamClient = &MockClient{}
@@ -31,7 +32,8 @@ var _ gce.IamClient = &MockClient{}
 // NewMockClient creates a new mock client.
 func NewMockClient(project string) *MockClient {
 	return &MockClient{
-		serviceAccounts: newServiceAccounts(project),
+		serviceAccounts:       newServiceAccounts(project),
+		workloadIdentityPools: newWorkloadIdentityPools(),
 	}
 }
 
@@ -39,6 +41,10 @@ func (c *MockClient) ServiceAccounts() gce.ServiceAccountClient {
 	return c.serviceAccounts
 }
 
+func (c *MockClient) WorkloadIdentityPools() gce.WorkloadIdentityPoolClient {
+	return c.workloadIdentityPools
+}
+
 func notFoundError() error {
 	return &googleapi.Error{
 		Code: 404,
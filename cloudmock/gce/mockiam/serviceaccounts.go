@@ -29,7 +29,9 @@ import (
 type serviceAccountClient struct {
 	// serviceaccounts are keyed by name.
 	serviceaccounts map[string]*iam.ServiceAccount
-	project         string
+	// policies are keyed by service account name.
+	policies map[string]*iam.Policy
+	project  string
 	sync.Mutex
 }
 
@@ -38,6 +40,7 @@ var _ gce.ServiceAccountClient = &serviceAccountClient{}
 func newServiceAccounts(project string) *serviceAccountClient {
 	return &serviceAccountClient{
 		serviceaccounts: map[string]*iam.ServiceAccount{},
+		policies:        map[string]*iam.Policy{},
 		project:         project,
 	}
 }
@@ -91,3 +94,26 @@ func (s *serviceAccountClient) List(ctx context.Context, project string) ([]*iam
 	}
 	return r, nil
 }
+
+func (s *serviceAccountClient) GetIamPolicy(ctx context.Context, name string) (*iam.Policy, error) {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.serviceaccounts[name]; !ok {
+		return nil, notFoundError()
+	}
+	policy, ok := s.policies[name]
+	if !ok {
+		return &iam.Policy{}, nil
+	}
+	return policy, nil
+}
+
+func (s *serviceAccountClient) SetIamPolicy(ctx context.Context, name string, policy *iam.Policy) (*iam.Policy, error) {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.serviceaccounts[name]; !ok {
+		return nil, notFoundError()
+	}
+	s.policies[name] = policy
+	return policy, nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockiam
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/api/iam/v1"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+type workloadIdentityPoolClient struct {
+	// pools are keyed by name.
+	pools map[string]*iam.WorkloadIdentityPool
+	// providers are keyed by name.
+	providers map[string]*iam.WorkloadIdentityPoolProvider
+	sync.Mutex
+}
+
+var _ gce.WorkloadIdentityPoolClient = &workloadIdentityPoolClient{}
+
+func newWorkloadIdentityPools() *workloadIdentityPoolClient {
+	return &workloadIdentityPoolClient{
+		pools:     map[string]*iam.WorkloadIdentityPool{},
+		providers: map[string]*iam.WorkloadIdentityPoolProvider{},
+	}
+}
+
+func (c *workloadIdentityPoolClient) Get(ctx context.Context, name string) (*iam.WorkloadIdentityPool, error) {
+	c.Lock()
+	defer c.Unlock()
+	pool, ok := c.pools[name]
+	if !ok {
+		return nil, notFoundError()
+	}
+	return pool, nil
+}
+
+func (c *workloadIdentityPoolClient) Create(ctx context.Context, parent string, poolID string, pool *iam.WorkloadIdentityPool) error {
+	c.Lock()
+	defer c.Unlock()
+	pool.Name = parent + "/workloadIdentityPools/" + poolID
+	pool.State = "ACTIVE"
+	c.pools[pool.Name] = pool
+	return nil
+}
+
+func (c *workloadIdentityPoolClient) Undelete(ctx context.Context, name string) error {
+	c.Lock()
+	defer c.Unlock()
+	pool, ok := c.pools[name]
+	if !ok {
+		return notFoundError()
+	}
+	pool.State = "ACTIVE"
+	return nil
+}
+
+func (c *workloadIdentityPoolClient) Delete(ctx context.Context, name string) error {
+	c.Lock()
+	defer c.Unlock()
+	pool, ok := c.pools[name]
+	if !ok {
+		return notFoundError()
+	}
+	pool.State = "DELETED"
+	return nil
+}
+
+func (c *workloadIdentityPoolClient) GetProvider(ctx context.Context, name string) (*iam.WorkloadIdentityPoolProvider, error) {
+	c.Lock()
+	defer c.Unlock()
+	provider, ok := c.providers[name]
+	if !ok {
+		return nil, notFoundError()
+	}
+	return provider, nil
+}
+
+func (c *workloadIdentityPoolClient) CreateProvider(ctx context.Context, parent string, providerID string, provider *iam.WorkloadIdentityPoolProvider) error {
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.pools[parent]; !ok {
+		return notFoundError()
+	}
+	provider.Name = parent + "/providers/" + providerID
+	provider.State = "ACTIVE"
+	c.providers[provider.Name] = provider
+	return nil
+}
+
+func (c *workloadIdentityPoolClient) UpdateProvider(ctx context.Context, name string, provider *iam.WorkloadIdentityPoolProvider, updateMask string) error {
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.providers[name]; !ok {
+		return notFoundError()
+	}
+	provider.Name = name
+	provider.State = "ACTIVE"
+	c.providers[name] = provider
+	return nil
+}
+
+func (c *workloadIdentityPoolClient) UndeleteProvider(ctx context.Context, name string) error {
+	c.Lock()
+	defer c.Unlock()
+	provider, ok := c.providers[name]
+	if !ok {
+		return notFoundError()
+	}
+	provider.State = "ACTIVE"
+	return nil
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/go-logr/logr"
+	"go.uber.org/multierr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/kops/channels/pkg/channels"
+	"k8s.io/kops/cmd/kops-controller/pkg/config"
+	"k8s.io/kops/util/pkg/vfs"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// addonsEnforceInterval is how often the objects of the managed addons are checked for drift.
+const addonsEnforceInterval = 5 * time.Minute
+
+// NewAddonsController is the constructor for an AddonsController
+func NewAddonsController(mgr manager.Manager, vfsContext *vfs.VFSContext, opt *config.AddonsOptions) (*AddonsController, error) {
+	if opt.Channel == "" {
+		return nil, fmt.Errorf("addons channel must be set")
+	}
+	channelLocation, err := url.Parse(opt.Channel)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse addons channel %q: %w", opt.Channel, err)
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error building kubernetes client: %w", err)
+	}
+	dynamicClient, err := dynamic.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error building dynamic client: %w", err)
+	}
+
+	c := &AddonsController{
+		log:             ctrl.Log.WithName("controllers").WithName("Addons"),
+		vfsContext:      vfsContext,
+		channel:         opt.Channel,
+		channelLocation: channelLocation,
+		kubeClient:      kubeClient,
+		enforcer: &channels.DriftEnforcer{
+			Client:     dynamicClient,
+			RESTMapper: mgr.GetRESTMapper(),
+		},
+		recorder: mgr.GetEventRecorder("kops-controller"),
+	}
+	return c, nil
+}
+
+// AddonsController continuously enforces the manifests of the managed addons in the bootstrap channel.
+// kops-channels installs and updates the addons; once an addon version is installed,
+// the controller re-applies the objects that were changed or deleted outside of kOps and records an event for each of them.
+//
+// Installing and updating stay in kops-channels, which runs on the control plane nodes before kops-controller exists:
+// kops-controller is itself one of the addons, and the CNI and CRDs it depends on are installed alongside it.
+// Updates also delete the objects removed from an addon and mark the nodes that need a rolling update,
+// which needs broader access than re-applying objects of the kinds in channels.EnforcedResources.
+type AddonsController struct {
+	// log is a logr
+	log logr.Logger
+
+	// vfsContext is used to read the channel and the manifests from the state store
+	vfsContext *vfs.VFSContext
+
+	// channel is the location of the bootstrap channel
+	channel string
+	// channelLocation is the parsed location of the bootstrap channel
+	channelLocation *url.URL
+
+	// kubeClient is used to discover the kubernetes version and to read the installed addon versions
+	kubeClient kubernetes.Interface
+
+	// enforcer re-applies the objects that drifted from their manifests
+	enforcer *channels.DriftEnforcer
+
+	// recorder records an event for each object that is re-applied
+	recorder events.EventRecorder
+}
+
+var _ manager.LeaderElectionRunnable = &AddonsController{}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (c *AddonsController) NeedLeaderElection() bool {
+	return true
+}
+
+// Start enforces the addons until the context is done.
+func (c *AddonsController) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := c.enforce(ctx); err != nil {
+			c.log.Error(err, "error enforcing addons")
+		}
+	}, addonsEnforceInterval)
+	return nil
+}
+
+// The access to the objects of the addons is limited to channels.EnforcedResources, and granted by the kops-controller addon.
+// +kubebuilder:rbac:groups=,resources=namespaces,verbs=list
+// enforce re-applies the drifted objects of the installed addons.
+func (c *AddonsController) enforce(ctx context.Context) error {
+	versionInfo, err := c.kubeClient.Discovery().ServerVersion()
+	if err != nil {
+		return fmt.Errorf("error querying kubernetes version: %w", err)
+	}
+	kubernetesVersion, err := semver.ParseTolerant(versionInfo.GitVersion)
+	if err != nil {
+		return fmt.Errorf("cannot parse kubernetes version %q: %w", versionInfo.GitVersion, err)
+	}
+	// Remove Pre, as it makes semver comparisons impractical
+	kubernetesVersion.Pre = nil
+
+	addons, err := channels.LoadAddons(c.vfsContext, c.channel, c.channelLocation)
+	if err != nil {
+		return fmt.Errorf("error loading channel %q: %w", c.channel, err)
+	}
+	menu, err := addons.GetCurrent(kubernetesVersion)
+	if err != nil {
+		return fmt.Errorf("error processing versions in %q: %w", c.channel, err)
+	}
+
+	// The installed versions are recorded in annotations on the namespaces of the addons
+	namespaces, err := c.kubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing namespaces: %w", err)
+	}
+	installedVersions := make(map[string]*channels.ChannelVersion)
+	for i := range namespaces.Items {
+		ns := &namespaces.Items[i]
+		for name, version := range channels.FindChannelVersions(ns) {
+			installedVersions[ns.Name+":"+name] = version
+		}
+	}
+
+	var names []string
+	for name := range menu.Addons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var merr error
+	for _, name := range names {
+		addon := menu.Addons[name]
+
+		if !addon.IsInstalled(installedVersions[addon.GetNamespace()+":"+addon.Name]) {
+			// kops-channels has not applied this version yet; it prunes and labels the objects when it does
+			c.log.V(2).Info("skipping addon that is not installed at the channel version", "addon", addon.Name)
+			continue
+		}
+
+		drifted, err := c.enforcer.Enforce(ctx, c.vfsContext, addon)
+		for _, d := range drifted {
+			c.recordDrift(addon.Name, d)
+		}
+		if err != nil {
+			merr = multierr.Append(merr, fmt.Errorf("error enforcing addon %q: %w", addon.Name, err))
+		}
+	}
+	return merr
+}
+
+// recordDrift records an event for an object that was re-applied.
+func (c *AddonsController) recordDrift(addonName string, d *channels.DriftedObject) {
+	ref := &corev1.ObjectReference{
+		APIVersion: d.Object.GetAPIVersion(),
+		Kind:       d.Object.GetKind(),
+		Namespace:  d.Object.GetNamespace(),
+		Name:       d.Object.GetName(),
+		UID:        d.Object.GetUID(),
+	}
+	if d.Recreated {
+		c.recorder.Eventf(ref, nil, corev1.EventTypeWarning, "AddonObjectRecreated", "EnforceAddon", "Recreated %s %s of addon %q, which was deleted", ref.Kind, ref.Name, addonName)
+	} else {
+		c.recorder.Eventf(ref, nil, corev1.EventTypeWarning, "AddonDriftReverted", "EnforceAddon", "Reverted changes made outside of kOps to %s %s of addon %q", ref.Kind, ref.Name, addonName)
+	}
+}
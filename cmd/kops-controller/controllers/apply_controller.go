@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/kops/cmd/kops-controller/pkg/config"
+	"k8s.io/kops/pkg/apis/kops/util"
+	"k8s.io/kops/pkg/applyloop"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/clusterlock"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/util/pkg/vfs"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// applyLeaseDuration is how long the apply lease is valid without being renewed.
+	applyLeaseDuration = 5 * time.Minute
+	// applyPollInterval is how often the state store is checked for spec changes.
+	applyPollInterval = 30 * time.Second
+)
+
+// NewApplyController is the constructor for an ApplyController
+func NewApplyController(mgr manager.Manager, vfsContext *vfs.VFSContext, clusterName string, opt *config.ApplyOptions) (*ApplyController, error) {
+	if opt.StateStore == "" {
+		return nil, fmt.Errorf("state store must be set")
+	}
+	if opt.Interval.Duration <= 0 {
+		return nil, fmt.Errorf("apply interval must be positive")
+	}
+	basePath, err := vfsContext.BuildVfsPath(opt.StateStore)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse state store %q: %w", opt.StateStore, err)
+	}
+
+	c := &ApplyController{
+		log:         ctrl.Log.WithName("controllers").WithName("Apply"),
+		client:      mgr.GetClient(),
+		clientset:   vfsclientset.NewVFSClientset(vfsContext, basePath),
+		clusterName: clusterName,
+		interval:    opt.Interval.Duration,
+		recorder:    mgr.GetEventRecorder("kops-controller"),
+	}
+	return c, nil
+}
+
+// ApplyController continuously applies the cluster and instance group specs in the state store, as kops update cluster --yes --watch does:
+// whenever they change, and every interval to revert drift in the cloud.
+// It coordinates with the kops processes applying the same cluster through the apply lease and the cluster lock in the state store,
+// records the outcome of each apply there as status conditions, and records an event on the kops-controller ConfigMap.
+// Changes to instance groups outside their maintenance windows are deferred.
+type ApplyController struct {
+	// log is a logr
+	log logr.Logger
+
+	// client is used to read the kubelet versions of the control plane nodes
+	client client.Client
+
+	// clientset reads and writes the cluster in the state store
+	clientset simple.Clientset
+
+	// clusterName is the name of the cluster to apply
+	clusterName string
+
+	// interval is how often unchanged specs are applied
+	interval time.Duration
+
+	// recorder records an event for each apply
+	recorder events.EventRecorder
+}
+
+var _ manager.LeaderElectionRunnable = &ApplyController{}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (c *ApplyController) NeedLeaderElection() bool {
+	return true
+}
+
+// Start applies the cluster until the context is done.
+func (c *ApplyController) Start(ctx context.Context) error {
+	var configBase vfs.Path
+	err := wait.PollUntilContextCancel(ctx, applyPollInterval, true, func(ctx context.Context) (bool, error) {
+		cluster, err := c.clientset.GetCluster(ctx, c.clusterName)
+		if err == nil {
+			configBase, err = c.clientset.ConfigBaseFor(cluster)
+		}
+		if err != nil {
+			c.log.Error(err, "error reading cluster from the state store")
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		// The context is done
+		return nil
+	}
+
+	loop := &applyloop.Loop{
+		Lease:        applyloop.NewLease(configBase, clusterlock.HolderIdentity(), applyLeaseDuration),
+		StatusPath:   applyloop.StatusPath(configBase),
+		Interval:     c.interval,
+		PollInterval: applyPollInterval,
+		SpecHash: func(ctx context.Context) (string, error) {
+			return applyloop.SpecHash(ctx, c.clientset, c.clusterName)
+		},
+		Apply: c.apply,
+	}
+	return loop.Run(ctx)
+}
+
+// apply applies the specs of the cluster, as kops update cluster --yes does.
+func (c *ApplyController) apply(ctx context.Context) error {
+	err := c.applyCluster(ctx)
+	if err != nil {
+		c.recordEvent(corev1.EventTypeWarning, "ApplyFailed", err.Error())
+	} else {
+		c.recordEvent(corev1.EventTypeNormal, "Applied", "Applied the cluster and instance group specs")
+	}
+	return err
+}
+
+func (c *ApplyController) applyCluster(ctx context.Context) error {
+	cluster, err := c.clientset.GetCluster(ctx, c.clusterName)
+	if err != nil {
+		return fmt.Errorf("error reading cluster %q: %w", c.clusterName, err)
+	}
+	filter, err := applyloop.MaintenanceWindowFilter(cluster, time.Now())
+	if err != nil {
+		return err
+	}
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+
+	controlPlaneRunningVersion, err := c.controlPlaneRunningVersion(ctx, cluster.Spec.KubernetesVersion)
+	if err != nil {
+		c.log.V(2).Info("error checking control plane running version, assuming no k8s upgrade in progress", "error", err)
+		controlPlaneRunningVersion = cluster.Spec.KubernetesVersion
+	}
+
+	applyCmd := &cloudup.ApplyClusterCmd{
+		Cloud:                      cloud,
+		Clientset:                  c.clientset,
+		Cluster:                    cluster,
+		InstanceGroupFilter:        filter,
+		TargetName:                 cloudup.TargetDirect,
+		DeletionProcessing:         fi.DeletionProcessingModeDeleteIfNotDeferrred,
+		ControlPlaneRunningVersion: controlPlaneRunningVersion,
+	}
+	if _, err := applyCmd.Run(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// +kubebuilder:rbac:groups=,resources=nodes,verbs=list
+
+// controlPlaneRunningVersion returns the oldest kubelet version of the control plane nodes, if it is older than version,
+// so that nodes are not configured with a kubelet newer than the control plane while it is being upgraded.
+func (c *ApplyController) controlPlaneRunningVersion(ctx context.Context, version string) (string, error) {
+	minVersion, err := util.ParseKubernetesVersion(version)
+	if err != nil {
+		return version, fmt.Errorf("cannot parse kubernetes version %q: %w", version, err)
+	}
+	nodes := &corev1.NodeList{}
+	if err := c.client.List(ctx, nodes, client.HasLabels{"node-role.kubernetes.io/control-plane"}); err != nil {
+		return version, fmt.Errorf("cannot list control plane nodes: %w", err)
+	}
+	for _, node := range nodes.Items {
+		kubeletVersion, err := util.ParseKubernetesVersion(node.Status.NodeInfo.KubeletVersion)
+		if err != nil {
+			return version, fmt.Errorf("cannot parse kubelet version %q of node %q: %w", node.Status.NodeInfo.KubeletVersion, node.Name, err)
+		}
+		if kubeletVersion.LT(*minVersion) {
+			version = node.Status.NodeInfo.KubeletVersion
+			minVersion = kubeletVersion
+		}
+	}
+	return strings.TrimPrefix(version, "v"), nil
+}
+
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,namespace=kube-system,verbs=create;patch
+
+// recordEvent records an event on the kops-controller ConfigMap, which configures the apply.
+func (c *ApplyController) recordEvent(eventType, reason, note string) {
+	regarding := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "kube-system",
+			Name:      "kops-controller",
+		},
+	}
+	c.recorder.Eventf(regarding, nil, eventType, reason, "Apply", "%s", note)
+}
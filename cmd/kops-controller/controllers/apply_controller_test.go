@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// fakeNodeLister lists a fixed set of nodes, filtered by the label selector.
+type fakeNodeLister struct {
+	client.Client
+
+	nodes []corev1.Node
+}
+
+func (c *fakeNodeLister) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOptions := &client.ListOptions{}
+	listOptions.ApplyOptions(opts)
+	selector := listOptions.LabelSelector
+	if selector == nil {
+		selector = labels.Everything()
+	}
+	nodeList := list.(*corev1.NodeList)
+	for _, node := range c.nodes {
+		if selector.Matches(labels.Set(node.Labels)) {
+			nodeList.Items = append(nodeList.Items, node)
+		}
+	}
+	return nil
+}
+
+func buildNode(name string, controlPlane bool, kubeletVersion string) corev1.Node {
+	node := corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}},
+		Status:     corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{KubeletVersion: kubeletVersion}},
+	}
+	if controlPlane {
+		node.Labels["node-role.kubernetes.io/control-plane"] = ""
+	}
+	return node
+}
+
+func TestControlPlaneRunningVersion(t *testing.T) {
+	grid := []struct {
+		desc     string
+		nodes    []corev1.Node
+		expected string
+	}{
+		{
+			desc:     "no nodes",
+			expected: "1.35.0",
+		},
+		{
+			desc: "control plane running the cluster version",
+			nodes: []corev1.Node{
+				buildNode("control-plane-a", true, "v1.35.0"),
+			},
+			expected: "1.35.0",
+		},
+		{
+			desc: "control plane not yet upgraded",
+			nodes: []corev1.Node{
+				buildNode("control-plane-a", true, "v1.34.2"),
+			},
+			expected: "1.34.2",
+		},
+		{
+			desc: "control plane partially upgraded",
+			nodes: []corev1.Node{
+				buildNode("control-plane-a", true, "v1.35.0"),
+				buildNode("control-plane-b", true, "v1.34.2"),
+				buildNode("control-plane-c", true, "v1.34.3"),
+			},
+			expected: "1.34.2",
+		},
+		{
+			desc: "older worker nodes are ignored",
+			nodes: []corev1.Node{
+				buildNode("control-plane-a", true, "v1.35.0"),
+				buildNode("node-a", false, "v1.34.0"),
+			},
+			expected: "1.35.0",
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			c := &ApplyController{client: &fakeNodeLister{nodes: g.nodes}}
+			actual, err := c.controlPlaneRunningVersion(context.Background(), "1.35.0")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != g.expected {
+				t.Errorf("expected %q, got %q", g.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops-controller/pkg/config"
+	kopsapi "k8s.io/kops/pkg/apis/kops/v1alpha2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// AnnotationHostStaleSince marks Hosts whose machine has not bootstrapped or heartbeated within the TTL.
+const AnnotationHostStaleSince = "kops.k8s.io/stale-since"
+
+// NewHostGCReconciler is the constructor for a HostGCReconciler
+func NewHostGCReconciler(mgr manager.Manager, opt *config.HostGCOptions) (*HostGCReconciler, error) {
+	if opt.TTL.Duration <= 0 {
+		return nil, fmt.Errorf("host garbage collection TTL must be positive")
+	}
+	r := &HostGCReconciler{
+		client:      mgr.GetClient(),
+		log:         ctrl.Log.WithName("controllers").WithName("HostGC"),
+		ttl:         opt.TTL.Duration,
+		deleteStale: opt.DeleteStale,
+	}
+	return r, nil
+}
+
+// HostGCReconciler observes Host objects of bare-metal machines, marking the Hosts whose machines
+// have not bootstrapped or heartbeated within the TTL, and optionally deleting them and their Nodes.
+type HostGCReconciler struct {
+	// client is the controller-runtime client
+	client client.Client
+
+	// log is a logr
+	log logr.Logger
+
+	// ttl is how long a machine may go without bootstrapping or heartbeating before its Host is stale
+	ttl time.Duration
+
+	// deleteStale enables deleting stale Hosts and their Nodes
+	deleteStale bool
+}
+
+// +kubebuilder:rbac:groups=kops.k8s.io,resources=hosts,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=,resources=nodes,verbs=get;list;watch;delete
+// Reconcile marks or deletes a Host when its machine is gone.
+func (r *HostGCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	_ = r.log.WithValues("hostgccontroller", req.NamespacedName)
+
+	host := &kopsapi.Host{}
+	if err := r.client.Get(ctx, req.NamespacedName, host); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if !host.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// The Node has the same name as the Host
+	var node *corev1.Node
+	{
+		n := &corev1.Node{}
+		err := r.client.Get(ctx, types.NamespacedName{Name: host.Name}, n)
+		if err == nil {
+			node = n
+		} else if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error getting node %q: %w", host.Name, err)
+		}
+	}
+
+	now := time.Now()
+	idle := now.Sub(hostLastSeen(host, node))
+	if idle < r.ttl {
+		if _, found := host.Annotations[AnnotationHostStaleSince]; found {
+			patch := client.MergeFrom(host.DeepCopy())
+			delete(host.Annotations, AnnotationHostStaleSince)
+			if err := r.client.Patch(ctx, host, patch); err != nil {
+				return ctrl.Result{}, fmt.Errorf("error unmarking host %q: %w", host.Name, err)
+			}
+			klog.Infof("host %q is no longer stale", host.Name)
+		}
+		return ctrl.Result{RequeueAfter: r.ttl - idle}, nil
+	}
+
+	if _, found := host.Annotations[AnnotationHostStaleSince]; !found {
+		patch := client.MergeFrom(host.DeepCopy())
+		if host.Annotations == nil {
+			host.Annotations = make(map[string]string)
+		}
+		host.Annotations[AnnotationHostStaleSince] = now.UTC().Format(time.RFC3339)
+		if err := r.client.Patch(ctx, host, patch); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error marking host %q as stale: %w", host.Name, err)
+		}
+		klog.Infof("marked host %q as stale, its machine has not been seen for %v", host.Name, idle.Round(time.Second))
+	}
+
+	if !r.deleteStale {
+		// Check again later, in case the machine comes back
+		return ctrl.Result{RequeueAfter: r.ttl}, nil
+	}
+
+	if node != nil {
+		if err := r.client.Delete(ctx, node); err != nil && !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error deleting node %q: %w", node.Name, err)
+		}
+		klog.Infof("deleted node %q of stale host", node.Name)
+	}
+	if err := r.client.Delete(ctx, host); err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, fmt.Errorf("error deleting host %q: %w", host.Name, err)
+	}
+	klog.Infof("deleted stale host %q", host.Name)
+
+	return ctrl.Result{}, nil
+}
+
+func (r *HostGCReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("hostgc").
+		For(&kopsapi.Host{}).
+		Complete(r)
+}
+
+// hostLastSeen returns the last time there was a sign of life from the machine of the host:
+// the creation of the Host or of its Node, or the last heartbeat of the Node.
+func hostLastSeen(host *kopsapi.Host, node *corev1.Node) time.Time {
+	lastSeen := host.CreationTimestamp.Time
+	if node == nil {
+		return lastSeen
+	}
+	if node.CreationTimestamp.After(lastSeen) {
+		lastSeen = node.CreationTimestamp.Time
+	}
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady && condition.LastHeartbeatTime.After(lastSeen) {
+			lastSeen = condition.LastHeartbeatTime.Time
+		}
+	}
+	return lastSeen
+}
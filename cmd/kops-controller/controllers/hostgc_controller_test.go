@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kopsapi "k8s.io/kops/pkg/apis/kops/v1alpha2"
+)
+
+func TestHostLastSeen(t *testing.T) {
+	hostCreated := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	nodeCreated := hostCreated.Add(time.Hour)
+	heartbeat := hostCreated.Add(48 * time.Hour)
+
+	host := &kopsapi.Host{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(hostCreated)},
+	}
+
+	grid := []struct {
+		name     string
+		node     *corev1.Node
+		expected time.Time
+	}{
+		{
+			name:     "not bootstrapped",
+			expected: hostCreated,
+		},
+		{
+			name: "never ready",
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(nodeCreated)},
+			},
+			expected: nodeCreated,
+		},
+		{
+			name: "heartbeat",
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(nodeCreated)},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{Type: corev1.NodeMemoryPressure, LastHeartbeatTime: metav1.NewTime(heartbeat.Add(time.Hour))},
+						{Type: corev1.NodeReady, LastHeartbeatTime: metav1.NewTime(heartbeat)},
+					},
+				},
+			},
+			expected: heartbeat,
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			actual := hostLastSeen(host, g.node)
+			if !actual.Equal(g.expected) {
+				t.Errorf("expected %v, got %v", g.expected, actual)
+			}
+		})
+	}
+}
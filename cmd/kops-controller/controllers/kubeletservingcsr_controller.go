@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	certificatesv1client "k8s.io/client-go/kubernetes/typed/certificates/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops-controller/pkg/certificatenames"
+	"k8s.io/kops/pkg/pki"
+	"k8s.io/kops/pkg/rbac"
+	"k8s.io/kops/upup/pkg/fi"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// kubeletServingCertificateValidity is the maximum validity of the kubelet serving certificates we sign.
+const kubeletServingCertificateValidity = 365 * 24 * time.Hour
+
+// NewKubeletServingCSRReconciler is the constructor for a KubeletServingCSRReconciler
+func NewKubeletServingCSRReconciler(mgr manager.Manager, keystore pki.Keystore) (*KubeletServingCSRReconciler, error) {
+	r := &KubeletServingCSRReconciler{
+		client:    mgr.GetClient(),
+		apiReader: mgr.GetAPIReader(),
+		log:       ctrl.Log.WithName("controllers").WithName("KubeletServingCSR"),
+		keystore:  keystore,
+	}
+
+	certificatesClient, err := certificatesv1client.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error building certificates client: %w", err)
+	}
+	r.certificatesClient = certificatesClient
+
+	return r, nil
+}
+
+// KubeletServingCSRReconciler approves and signs the CertificateSigningRequests for kubelet serving certificates,
+// when the requested names match the names verified when the node bootstrapped.
+type KubeletServingCSRReconciler struct {
+	// client is the controller-runtime client
+	client client.Client
+
+	// apiReader reads the recorded certificate names without caching all ConfigMaps
+	apiReader client.Reader
+
+	// log is a logr
+	log logr.Logger
+
+	// certificatesClient is a client-go client for approving and signing requests
+	certificatesClient *certificatesv1client.CertificatesV1Client
+
+	// keystore holds the CA used for signing
+	keystore pki.Keystore
+}
+
+// +kubebuilder:rbac:groups=certificates.k8s.io,resources=certificatesigningrequests,verbs=get;list;watch
+// +kubebuilder:rbac:groups=certificates.k8s.io,resources=certificatesigningrequests/approval;certificatesigningrequests/status,verbs=update
+// Reconcile approves and signs a kubelet serving CertificateSigningRequest.
+func (r *KubeletServingCSRReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	_ = r.log.WithValues("kubeletservingcsrcontroller", req.NamespacedName)
+
+	csr := &certificatesv1.CertificateSigningRequest{}
+	if err := r.client.Get(ctx, req.NamespacedName, csr); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if csr.Spec.SignerName != certificatesv1.KubeletServingSignerName || len(csr.Status.Certificate) != 0 || isDenied(csr) {
+		return ctrl.Result{}, nil
+	}
+
+	request, err := parseCSR(csr.Spec.Request)
+	if err != nil {
+		klog.Infof("ignoring kubelet serving csr %q: %v", csr.Name, err)
+		return ctrl.Result{}, nil
+	}
+
+	nodeName := strings.TrimPrefix(csr.Spec.Username, "system:node:")
+	allowedNames, err := certificatenames.Get(ctx, r.apiReader, nodeName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if allowedNames == nil {
+		klog.Infof("ignoring kubelet serving csr %q: no certificate names recorded for node %q", csr.Name, nodeName)
+		return ctrl.Result{}, nil
+	}
+
+	if err := validateKubeletServingCSR(csr, request, allowedNames); err != nil {
+		klog.Infof("ignoring kubelet serving csr %q: %v", csr.Name, err)
+		return ctrl.Result{}, nil
+	}
+
+	if !isApproved(csr) {
+		csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+			Type:           certificatesv1.CertificateApproved,
+			Status:         corev1.ConditionTrue,
+			Reason:         "KopsControllerApprove",
+			Message:        "Auto approving kubelet serving certificate after matching the names verified at bootstrap.",
+			LastUpdateTime: metav1.Now(),
+		})
+		csr, err = r.certificatesClient.CertificateSigningRequests().UpdateApproval(ctx, csr.Name, csr, metav1.UpdateOptions{})
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("error approving csr %q: %w", req.Name, err)
+		}
+		klog.Infof("approved kubelet serving csr %q for node %q", csr.Name, nodeName)
+	}
+
+	validity := kubeletServingCertificateValidity
+	if csr.Spec.ExpirationSeconds != nil {
+		validity = min(validity, time.Duration(*csr.Spec.ExpirationSeconds)*time.Second)
+	}
+	var alternateNames []string
+	alternateNames = append(alternateNames, request.DNSNames...)
+	for _, ip := range request.IPAddresses {
+		alternateNames = append(alternateNames, ip.String())
+	}
+	cert, _, _, err := pki.IssueCert(ctx, &pki.IssueCertRequest{
+		Signer:         fi.CertificateIDCA,
+		Type:           "server",
+		Subject:        pkix.Name{CommonName: request.Subject.CommonName, Organization: request.Subject.Organization},
+		AlternateNames: alternateNames,
+		PublicKey:      request.PublicKey,
+		Validity:       validity,
+	}, r.keystore)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error signing csr %q: %w", csr.Name, err)
+	}
+	certBytes, err := cert.AsBytes()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	csr.Status.Certificate = certBytes
+	if _, err := r.certificatesClient.CertificateSigningRequests().UpdateStatus(ctx, csr, metav1.UpdateOptions{}); err != nil {
+		if apierrors.IsConflict(err) {
+			// Most likely signed concurrently by kube-controller-manager, the next reconcile will tell
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("error signing csr %q: %w", csr.Name, err)
+	}
+	klog.Infof("signed kubelet serving csr %q for node %q", csr.Name, nodeName)
+
+	return ctrl.Result{}, nil
+}
+
+func (r *KubeletServingCSRReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("kubeletservingcsr").
+		For(&certificatesv1.CertificateSigningRequest{}).
+		Complete(r)
+}
+
+func parseCSR(pemBytes []byte) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, fmt.Errorf("request is not a PEM encoded certificate request")
+	}
+	request, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate request: %w", err)
+	}
+	if err := request.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("checking certificate request signature: %w", err)
+	}
+	return request, nil
+}
+
+// validateKubeletServingCSR checks that the request was made by the node it is for,
+// and that it only asks for server usages and for names the node was verified to use.
+func validateKubeletServingCSR(csr *certificatesv1.CertificateSigningRequest, request *x509.CertificateRequest, allowedNames []string) error {
+	if !strings.HasPrefix(csr.Spec.Username, "system:node:") || csr.Spec.Username == "system:node:" {
+		return fmt.Errorf("requester %q is not a node", csr.Spec.Username)
+	}
+	if !slices.Contains(csr.Spec.Groups, rbac.NodesGroup) {
+		return fmt.Errorf("requester %q is not in group %q", csr.Spec.Username, rbac.NodesGroup)
+	}
+	if request.Subject.CommonName != csr.Spec.Username {
+		return fmt.Errorf("subject common name %q does not match requester %q", request.Subject.CommonName, csr.Spec.Username)
+	}
+	if !slices.Equal(request.Subject.Organization, []string{rbac.NodesGroup}) {
+		return fmt.Errorf("subject organization %v is not [%s]", request.Subject.Organization, rbac.NodesGroup)
+	}
+	if len(request.EmailAddresses) != 0 || len(request.URIs) != 0 {
+		return fmt.Errorf("email and URI alternate names are not allowed")
+	}
+	if len(request.DNSNames) == 0 && len(request.IPAddresses) == 0 {
+		return fmt.Errorf("no alternate names requested")
+	}
+
+	allowedUsages := sets.New(certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, certificatesv1.UsageServerAuth)
+	for _, usage := range csr.Spec.Usages {
+		if !allowedUsages.Has(usage) {
+			return fmt.Errorf("usage %q is not allowed", usage)
+		}
+	}
+	if !slices.Contains(csr.Spec.Usages, certificatesv1.UsageServerAuth) {
+		return fmt.Errorf("usage %q is required", certificatesv1.UsageServerAuth)
+	}
+
+	allowed := sets.New(allowedNames...)
+	for _, name := range request.DNSNames {
+		if !allowed.Has(name) {
+			return fmt.Errorf("DNS name %q was not verified for the node", name)
+		}
+	}
+	for _, ip := range request.IPAddresses {
+		if !allowed.Has(ip.String()) {
+			return fmt.Errorf("IP address %q was not verified for the node", ip)
+		}
+	}
+
+	return nil
+}
+
+func isApproved(csr *certificatesv1.CertificateSigningRequest) bool {
+	for _, condition := range csr.Status.Conditions {
+		if condition.Type == certificatesv1.CertificateApproved {
+			return true
+		}
+	}
+	return false
+}
+
+func isDenied(csr *certificatesv1.CertificateSigningRequest) bool {
+	for _, condition := range csr.Status.Conditions {
+		if condition.Type == certificatesv1.CertificateDenied || condition.Type == certificatesv1.CertificateFailed {
+			return true
+		}
+	}
+	return false
+}
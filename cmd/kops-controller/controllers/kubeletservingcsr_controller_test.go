@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net"
+	"testing"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+)
+
+func TestValidateKubeletServingCSR(t *testing.T) {
+	allowedNames := []string{"ip-10-0-0-1.ec2.internal", "10.0.0.1"}
+
+	grid := []struct {
+		name        string
+		username    string
+		groups      []string
+		subject     pkix.Name
+		dnsNames    []string
+		ips         []string
+		usages      []certificatesv1.KeyUsage
+		expectError bool
+	}{
+		{
+			name:     "valid",
+			username: "system:node:ip-10-0-0-1.ec2.internal",
+			groups:   []string{"system:nodes", "system:authenticated"},
+			subject:  pkix.Name{CommonName: "system:node:ip-10-0-0-1.ec2.internal", Organization: []string{"system:nodes"}},
+			dnsNames: []string{"ip-10-0-0-1.ec2.internal"},
+			ips:      []string{"10.0.0.1"},
+			usages:   []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth},
+		},
+		{
+			name:        "unverified IP",
+			username:    "system:node:ip-10-0-0-1.ec2.internal",
+			groups:      []string{"system:nodes"},
+			subject:     pkix.Name{CommonName: "system:node:ip-10-0-0-1.ec2.internal", Organization: []string{"system:nodes"}},
+			ips:         []string{"10.0.0.2"},
+			usages:      []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth},
+			expectError: true,
+		},
+		{
+			name:        "unverified DNS name",
+			username:    "system:node:ip-10-0-0-1.ec2.internal",
+			groups:      []string{"system:nodes"},
+			subject:     pkix.Name{CommonName: "system:node:ip-10-0-0-1.ec2.internal", Organization: []string{"system:nodes"}},
+			dnsNames:    []string{"kubernetes.default"},
+			usages:      []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth},
+			expectError: true,
+		},
+		{
+			name:        "other node",
+			username:    "system:node:ip-10-0-0-2.ec2.internal",
+			groups:      []string{"system:nodes"},
+			subject:     pkix.Name{CommonName: "system:node:ip-10-0-0-1.ec2.internal", Organization: []string{"system:nodes"}},
+			ips:         []string{"10.0.0.1"},
+			usages:      []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth},
+			expectError: true,
+		},
+		{
+			name:        "not a node",
+			username:    "system:serviceaccount:default:default",
+			groups:      []string{"system:serviceaccounts"},
+			subject:     pkix.Name{CommonName: "system:serviceaccount:default:default", Organization: []string{"system:nodes"}},
+			ips:         []string{"10.0.0.1"},
+			usages:      []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth},
+			expectError: true,
+		},
+		{
+			name:        "client usage",
+			username:    "system:node:ip-10-0-0-1.ec2.internal",
+			groups:      []string{"system:nodes"},
+			subject:     pkix.Name{CommonName: "system:node:ip-10-0-0-1.ec2.internal", Organization: []string{"system:nodes"}},
+			ips:         []string{"10.0.0.1"},
+			usages:      []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth, certificatesv1.UsageClientAuth},
+			expectError: true,
+		},
+		{
+			name:        "no alternate names",
+			username:    "system:node:ip-10-0-0-1.ec2.internal",
+			groups:      []string{"system:nodes"},
+			subject:     pkix.Name{CommonName: "system:node:ip-10-0-0-1.ec2.internal", Organization: []string{"system:nodes"}},
+			usages:      []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth},
+			expectError: true,
+		},
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			template := &x509.CertificateRequest{
+				Subject:  g.subject,
+				DNSNames: g.dnsNames,
+			}
+			for _, ip := range g.ips {
+				template.IPAddresses = append(template.IPAddresses, net.ParseIP(ip))
+			}
+			der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+			if err != nil {
+				t.Fatalf("creating certificate request: %v", err)
+			}
+			request, err := parseCSR(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+			if err != nil {
+				t.Fatalf("parsing certificate request: %v", err)
+			}
+
+			csr := &certificatesv1.CertificateSigningRequest{
+				Spec: certificatesv1.CertificateSigningRequestSpec{
+					SignerName: certificatesv1.KubeletServingSignerName,
+					Username:   g.username,
+					Groups:     g.groups,
+					Usages:     g.usages,
+				},
+			}
+			err = validateKubeletServingCSR(csr, request, allowedNames)
+			if g.expectError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !g.expectError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
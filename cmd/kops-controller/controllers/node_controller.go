@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -28,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops-controller/pkg/certificatenames"
 	"k8s.io/kops/pkg/nodeidentity"
 	"k8s.io/kops/pkg/nodelabels"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -35,12 +37,19 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
+// nodeLabelsResyncPeriod is how often the labels built from the cloud tags of instances are synced,
+// as changes to the tags don't trigger a reconcile of the node.
+// The resync identifies all the nodes at once, so that identifiers that support it can batch their cloud API calls.
+const nodeLabelsResyncPeriod = 10 * time.Minute
+
 // NewNodeReconciler is the constructor for a NodeReconciler
-func NewNodeReconciler(mgr manager.Manager, identifier nodeidentity.Identifier) (*NodeReconciler, error) {
+func NewNodeReconciler(mgr manager.Manager, identifier nodeidentity.Identifier, labelOptions *nodeidentity.LabelOptions, forgetCertificateNames bool) (*NodeReconciler, error) {
 	r := &NodeReconciler{
-		client:     mgr.GetClient(),
-		log:        ctrl.Log.WithName("controllers").WithName("Node"),
-		identifier: identifier,
+		client:                 mgr.GetClient(),
+		log:                    ctrl.Log.WithName("controllers").WithName("Node"),
+		identifier:             identifier,
+		labelOptions:           labelOptions,
+		forgetCertificateNames: forgetCertificateNames,
 	}
 
 	coreClient, err := corev1client.NewForConfig(mgr.GetConfig())
@@ -61,11 +70,17 @@ type NodeReconciler struct {
 	// log is a logr
 	log logr.Logger
 
-	// coreV1Client is a client-go client for patching nodes
-	coreV1Client *corev1client.CoreV1Client
+	// coreV1Client is a client-go client for listing and patching nodes
+	coreV1Client corev1client.CoreV1Interface
 
 	// identifier is a provider that can securely map node ProviderIDs to labels
 	identifier nodeidentity.Identifier
+
+	// labelOptions configures the labels built from the cloud metadata of instances
+	labelOptions *nodeidentity.LabelOptions
+
+	// forgetCertificateNames removes the certificate names recorded at bootstrap when a node is deleted
+	forgetCertificateNames bool
 }
 
 // +kubebuilder:rbac:groups=,resources=nodes,verbs=get;list;watch;patch
@@ -80,6 +95,11 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 			// we'll ignore not-found errors, since they can't be fixed by an immediate
 			// requeue (we'll need to wait for a new notification), and we can get them
 			// on deleted requests.
+			if r.forgetCertificateNames {
+				if err := certificatenames.Forget(ctx, r.client, req.Name); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
 			return ctrl.Result{}, nil
 		}
 		return ctrl.Result{}, err
@@ -90,7 +110,23 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		return ctrl.Result{}, fmt.Errorf("error identifying node %q: %v", node.Name, err)
 	}
 
-	labels := info.Labels
+	if err := r.syncLabels(ctx, node, info); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// syncLabels patches the labels of the node to match the labels built from its identity.
+func (r *NodeReconciler) syncLabels(ctx context.Context, node *corev1.Node, info *nodeidentity.Info) error {
+	labels := make(map[string]string)
+	for k, v := range r.labelOptions.BuildLabels(info) {
+		labels[k] = v
+	}
+	// Labels from the identifier take precedence over the configured node identity labels
+	for k, v := range info.Labels {
+		labels[k] = v
+	}
+	managedLabels := r.labelOptions.ManagedLabels()
 
 	updateLabels := make(map[string]string)
 	for k, v := range labels {
@@ -108,23 +144,91 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 			if _, found := labels[k]; !found {
 				deleteLabels[k] = struct{}{}
 			}
+		default:
+			if _, managed := managedLabels[k]; managed {
+				if _, found := labels[k]; !found {
+					deleteLabels[k] = struct{}{}
+				}
+			}
 		}
 	}
 
 	if len(updateLabels) == 0 && len(deleteLabels) == 0 {
 		klog.V(4).Infof("no label changes needed for %s", node.Name)
-		return ctrl.Result{}, nil
+		return nil
 	}
 
 	if err := patchNodeLabels(r.coreV1Client, ctx, node, updateLabels, deleteLabels); err != nil {
 		klog.Warningf("failed to patch node labels on %s: %v", node.Name, err)
-		return ctrl.Result{}, err
+		return err
 	}
 
-	return ctrl.Result{}, nil
+	return nil
+}
+
+// resyncLabels syncs the labels of all nodes from fresh cloud metadata, bypassing the cache of the identifier.
+func (r *NodeReconciler) resyncLabels(ctx context.Context) error {
+	nodeList, err := r.coreV1Client.Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing nodes: %w", err)
+	}
+	nodes := make([]*corev1.Node, 0, len(nodeList.Items))
+	for i := range nodeList.Items {
+		nodes = append(nodes, &nodeList.Items[i])
+	}
+
+	var infos map[string]*nodeidentity.Info
+	if batchIdentifier, ok := r.identifier.(nodeidentity.BatchIdentifier); ok {
+		infos, err = batchIdentifier.IdentifyNodes(ctx, nodes)
+		if err != nil {
+			return fmt.Errorf("error identifying nodes: %w", err)
+		}
+	} else {
+		infos = make(map[string]*nodeidentity.Info)
+		for _, node := range nodes {
+			info, err := r.identifier.IdentifyNode(nodeidentity.WithoutCache(ctx), node)
+			if err != nil {
+				klog.Warningf("error identifying node %q: %v", node.Name, err)
+				continue
+			}
+			infos[node.Name] = info
+		}
+	}
+
+	for _, node := range nodes {
+		info, found := infos[node.Name]
+		if !found {
+			continue
+		}
+		if err := r.syncLabels(ctx, node, info); err != nil {
+			klog.Warningf("error syncing labels of node %q: %v", node.Name, err)
+		}
+	}
+	return nil
 }
 
 func (r *NodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.labelOptions != nil && len(r.labelOptions.CloudTags) != 0 {
+		// The nodes are all reconciled when we start, so the first resync is after a full period
+		resync := manager.RunnableFunc(func(ctx context.Context) error {
+			ticker := time.NewTicker(nodeLabelsResyncPeriod)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+					if err := r.resyncLabels(ctx); err != nil {
+						klog.Warningf("failed to resync node labels: %v", err)
+					}
+				}
+			}
+		})
+		if err := mgr.Add(resync); err != nil {
+			return err
+		}
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("node").
 		For(&corev1.Node{}).
@@ -141,7 +245,7 @@ type nodePatchMetadata struct {
 }
 
 // patchNodeLabels patches the node labels to set the specified labels
-func patchNodeLabels(client *corev1client.CoreV1Client, ctx context.Context, node *corev1.Node, setLabels map[string]string, deleteLabels map[string]struct{}) error {
+func patchNodeLabels(client corev1client.CoreV1Interface, ctx context.Context, node *corev1.Node, setLabels map[string]string, deleteLabels map[string]struct{}) error {
 	nodePatchMetadata := &nodePatchMetadata{
 		Labels: make(map[string]*string),
 	}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kops/pkg/nodeidentity"
+)
+
+// fakeIdentifier identifies nodes from a fixed map of cloud tags, keyed by provider ID.
+type fakeIdentifier struct {
+	cloudTags map[string]map[string]string
+
+	identifyNodeCalls  int
+	identifyNodesCalls int
+	cachedLookups      int
+}
+
+func (f *fakeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*nodeidentity.Info, error) {
+	f.identifyNodeCalls++
+	if !nodeidentity.CacheBypassed(ctx) {
+		f.cachedLookups++
+	}
+	tags, found := f.cloudTags[node.Spec.ProviderID]
+	if !found {
+		return nil, fmt.Errorf("instance %q not found", node.Spec.ProviderID)
+	}
+	return &nodeidentity.Info{InstanceID: node.Spec.ProviderID, CloudTags: tags}, nil
+}
+
+func (f *fakeIdentifier) calls() (int, int, int) {
+	return f.identifyNodeCalls, f.identifyNodesCalls, f.cachedLookups
+}
+
+// fakeBatchIdentifier also identifies nodes in batches.
+type fakeBatchIdentifier struct {
+	fakeIdentifier
+}
+
+func (f *fakeBatchIdentifier) IdentifyNodes(ctx context.Context, nodes []*corev1.Node) (map[string]*nodeidentity.Info, error) {
+	f.identifyNodesCalls++
+	infos := make(map[string]*nodeidentity.Info)
+	for _, node := range nodes {
+		if tags, found := f.cloudTags[node.Spec.ProviderID]; found {
+			infos[node.Name] = &nodeidentity.Info{InstanceID: node.Spec.ProviderID, CloudTags: tags}
+		}
+	}
+	return infos, nil
+}
+
+func TestNodeReconcilerResyncLabels(t *testing.T) {
+	cloudTags := map[string]map[string]string{
+		"aws:///us-test-1a/i-team-changed": {"team": "payments"},
+		"aws:///us-test-1a/i-team-removed": {},
+	}
+
+	grid := []struct {
+		desc       string
+		identifier interface {
+			nodeidentity.Identifier
+			calls() (identifyNode, identifyNodes, cachedLookups int)
+		}
+		expectIdentifyNode  int
+		expectIdentifyNodes int
+	}{
+		{
+			desc:                "batch identifier",
+			identifier:          &fakeBatchIdentifier{fakeIdentifier{cloudTags: cloudTags}},
+			expectIdentifyNodes: 1,
+		},
+		{
+			desc:               "identifier without batching",
+			identifier:         &fakeIdentifier{cloudTags: cloudTags},
+			expectIdentifyNode: 3,
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			ctx := context.Background()
+
+			kubeClient := fake.NewClientset(
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-team-changed", Labels: map[string]string{"example.com/team": "checkout"}},
+					Spec:       corev1.NodeSpec{ProviderID: "aws:///us-test-1a/i-team-changed"},
+				},
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-team-removed", Labels: map[string]string{"example.com/team": "checkout"}},
+					Spec:       corev1.NodeSpec{ProviderID: "aws:///us-test-1a/i-team-removed"},
+				},
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-unknown", Labels: map[string]string{"example.com/team": "checkout"}},
+					Spec:       corev1.NodeSpec{ProviderID: "aws:///us-test-1a/i-unknown"},
+				},
+			)
+
+			r := &NodeReconciler{
+				coreV1Client: kubeClient.CoreV1(),
+				identifier:   g.identifier,
+				labelOptions: &nodeidentity.LabelOptions{
+					CloudTags: map[string]string{"team": "example.com/team"},
+				},
+			}
+
+			if err := r.resyncLabels(ctx); err != nil {
+				t.Fatalf("resyncLabels failed: %v", err)
+			}
+
+			identifyNode, identifyNodes, cachedLookups := g.identifier.calls()
+			if identifyNode != g.expectIdentifyNode || identifyNodes != g.expectIdentifyNodes {
+				t.Errorf("identified nodes %d times and in batches %d times, expected %d and %d", identifyNode, identifyNodes, g.expectIdentifyNode, g.expectIdentifyNodes)
+			}
+			if cachedLookups != 0 {
+				t.Errorf("resync used the cache of the identifier for %d nodes", cachedLookups)
+			}
+
+			expectedLabels := map[string]string{
+				"node-team-changed": "payments",
+				"node-team-removed": "",
+				// Nodes that can't be identified keep their labels
+				"node-unknown": "checkout",
+			}
+			for nodeName, expected := range expectedLabels {
+				node, err := kubeClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("getting node %q: %v", nodeName, err)
+				}
+				if actual := node.Labels["example.com/team"]; actual != expected {
+					t.Errorf("node %q has team label %q, expected %q", nodeName, actual, expected)
+				}
+			}
+		})
+	}
+}
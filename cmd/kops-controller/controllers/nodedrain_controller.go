@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops-controller/pkg/config"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/awsinterfaces"
+	"k8s.io/kops/util/pkg/awslog"
+	"k8s.io/kubectl/pkg/drain"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// nodeDrainPollInterval is how often the autoscaling groups are checked for instances held by the lifecycle hook.
+// Spot interruptions only leave two minutes to drain, so this is kept short.
+const nodeDrainPollInterval = 10 * time.Second
+
+// NewNodeDrainController is the constructor for a NodeDrainController
+func NewNodeDrainController(ctx context.Context, mgr manager.Manager, clusterName string, opt *config.NodeDrainOptions) (*NodeDrainController, error) {
+	awsConfig, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(opt.Region), awslog.WithAWSLogger())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config: %w", err)
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error building kubernetes client: %w", err)
+	}
+
+	c := &NodeDrainController{
+		log:          ctrl.Log.WithName("controllers").WithName("NodeDrain"),
+		clusterName:  clusterName,
+		kubeClient:   kubeClient,
+		autoscaling:  autoscaling.NewFromConfig(awsConfig),
+		drainTimeout: opt.DrainTimeout.Duration,
+		draining:     make(map[string]bool),
+	}
+	return c, nil
+}
+
+// NodeDrainController watches the autoscaling groups of the cluster for instances held by the graceful node drain
+// lifecycle hook, cordons and drains their nodes and then lets the autoscaling groups terminate the instances.
+type NodeDrainController struct {
+	// log is a logr
+	log logr.Logger
+
+	// clusterName is the name of the cluster, used to find its autoscaling groups
+	clusterName string
+
+	// kubeClient is used to cordon and drain nodes
+	kubeClient kubernetes.Interface
+
+	// autoscaling is the AWS autoscaling client
+	autoscaling awsinterfaces.AutoScalingAPI
+
+	// drainTimeout is the maximum time to wait for a node to drain
+	drainTimeout time.Duration
+
+	// mutex protects draining
+	mutex sync.Mutex
+	// draining holds the ids of the instances whose nodes are being drained
+	draining map[string]bool
+}
+
+var _ manager.LeaderElectionRunnable = &NodeDrainController{}
+
+// terminatingInstance is an instance held by the graceful node drain lifecycle hook.
+type terminatingInstance struct {
+	autoscalingGroupName string
+	instanceID           string
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (c *NodeDrainController) NeedLeaderElection() bool {
+	return true
+}
+
+// Start polls the autoscaling groups until the context is done.
+func (c *NodeDrainController) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := c.poll(ctx); err != nil {
+			c.log.Error(err, "error checking for terminating instances")
+		}
+	}, nodeDrainPollInterval)
+	return nil
+}
+
+// poll starts draining the nodes of the newly found terminating instances.
+func (c *NodeDrainController) poll(ctx context.Context) error {
+	instances, err := c.findTerminatingInstances(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, instance := range instances {
+		if c.draining[instance.instanceID] {
+			continue
+		}
+		c.draining[instance.instanceID] = true
+
+		go func() {
+			defer func() {
+				c.mutex.Lock()
+				defer c.mutex.Unlock()
+				delete(c.draining, instance.instanceID)
+			}()
+			if err := c.drainAndComplete(ctx, instance); err != nil {
+				c.log.Error(err, "error draining terminating instance", "instance", instance.instanceID)
+			}
+		}()
+	}
+	return nil
+}
+
+// findTerminatingInstances returns the instances of the cluster that wait on the graceful node drain lifecycle hook.
+func (c *NodeDrainController) findTerminatingInstances(ctx context.Context) ([]terminatingInstance, error) {
+	request := &autoscaling.DescribeAutoScalingGroupsInput{
+		Filters: []autoscalingtypes.Filter{
+			{
+				Name:   aws.String("tag:" + awsup.TagClusterName),
+				Values: []string{c.clusterName},
+			},
+		},
+	}
+	var instances []terminatingInstance
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(c.autoscaling, request)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error listing autoscaling groups: %w", err)
+		}
+		for _, group := range page.AutoScalingGroups {
+			var waiting []string
+			for _, instance := range group.Instances {
+				if instance.LifecycleState == autoscalingtypes.LifecycleStateTerminatingWait {
+					waiting = append(waiting, aws.ToString(instance.InstanceId))
+				}
+			}
+			if len(waiting) == 0 {
+				continue
+			}
+
+			// Instances can also wait on other lifecycle hooks, such as the one of kops rolling-update.
+			groupName := aws.ToString(group.AutoScalingGroupName)
+			hooks, err := c.autoscaling.DescribeLifecycleHooks(ctx, &autoscaling.DescribeLifecycleHooksInput{
+				AutoScalingGroupName: aws.String(groupName),
+				LifecycleHookNames:   []string{awsup.GracefulNodeDrainLifecycleHookName},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error describing lifecycle hooks of autoscaling group %q: %w", groupName, err)
+			}
+			if len(hooks.LifecycleHooks) == 0 {
+				continue
+			}
+
+			for _, id := range waiting {
+				instances = append(instances, terminatingInstance{autoscalingGroupName: groupName, instanceID: id})
+			}
+		}
+	}
+	return instances, nil
+}
+
+// drainAndComplete drains the node of the instance, if there is one, and completes the lifecycle action.
+// The lifecycle action is also completed when draining fails, as the instance is terminated anyway once the hook times out.
+func (c *NodeDrainController) drainAndComplete(ctx context.Context, instance terminatingInstance) error {
+	node, err := c.findNode(ctx, instance.instanceID)
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		klog.Infof("no node found for terminating instance %q", instance.instanceID)
+	} else {
+		klog.Infof("draining node %q of terminating instance %q", node.Name, instance.instanceID)
+		if err := c.drainNode(ctx, node); err != nil {
+			klog.Warningf("error draining node %q, terminating instance %q anyway: %v", node.Name, instance.instanceID, err)
+		} else {
+			klog.Infof("drained node %q", node.Name)
+		}
+	}
+
+	_, err = c.autoscaling.CompleteLifecycleAction(ctx, &autoscaling.CompleteLifecycleActionInput{
+		AutoScalingGroupName:  aws.String(instance.autoscalingGroupName),
+		InstanceId:            aws.String(instance.instanceID),
+		LifecycleActionResult: aws.String("CONTINUE"),
+		LifecycleHookName:     aws.String(awsup.GracefulNodeDrainLifecycleHookName),
+	})
+	if err != nil {
+		return fmt.Errorf("error completing lifecycle action of instance %q: %w", instance.instanceID, err)
+	}
+	return nil
+}
+
+// findNode returns the node of the instance, or nil if it has none.
+func (c *NodeDrainController) findNode(ctx context.Context, instanceID string) (*corev1.Node, error) {
+	nodes, err := c.kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing nodes: %w", err)
+	}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if strings.HasSuffix(node.Spec.ProviderID, "/"+instanceID) {
+			return node, nil
+		}
+	}
+	return nil, nil
+}
+
+// drainNode cordons and drains the node.
+func (c *NodeDrainController) drainNode(ctx context.Context, node *corev1.Node) error {
+	helper := &drain.Helper{
+		Ctx:                 ctx,
+		Client:              c.kubeClient,
+		Force:               true,
+		GracePeriodSeconds:  -1,
+		IgnoreAllDaemonSets: true,
+		Out:                 os.Stdout,
+		ErrOut:              os.Stderr,
+		Timeout:             c.drainTimeout,
+
+		// We want to proceed even when pods are using emptyDir volumes
+		DeleteEmptyDirData: true,
+	}
+
+	if err := drain.RunCordonOrUncordon(helper, node, true); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error cordoning node: %w", err)
+	}
+
+	if err := drain.RunNodeDrain(helper, node.Name); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error draining node: %w", err)
+	}
+
+	return nil
+}
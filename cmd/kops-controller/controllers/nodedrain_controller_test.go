@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cloudmock/aws/mockautoscaling"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+func TestNodeDrainController(t *testing.T) {
+	ctx := context.Background()
+
+	mockAutoscaling := &mockautoscaling.MockAutoscaling{
+		Groups: map[string]*autoscalingtypes.AutoScalingGroup{
+			"nodes": {
+				AutoScalingGroupName: aws.String("nodes"),
+				Instances: []autoscalingtypes.Instance{
+					{InstanceId: aws.String("i-terminating"), LifecycleState: autoscalingtypes.LifecycleStateTerminatingWait},
+					{InstanceId: aws.String("i-nodeless"), LifecycleState: autoscalingtypes.LifecycleStateTerminatingWait},
+					{InstanceId: aws.String("i-inservice"), LifecycleState: autoscalingtypes.LifecycleStateInService},
+				},
+			},
+			"unhooked": {
+				AutoScalingGroupName: aws.String("unhooked"),
+				Instances: []autoscalingtypes.Instance{
+					{InstanceId: aws.String("i-unhooked"), LifecycleState: autoscalingtypes.LifecycleStateTerminatingWait},
+				},
+			},
+		},
+	}
+	if _, err := mockAutoscaling.PutLifecycleHook(ctx, &autoscaling.PutLifecycleHookInput{
+		AutoScalingGroupName: aws.String("nodes"),
+		LifecycleHookName:    aws.String(awsup.GracefulNodeDrainLifecycleHookName),
+		LifecycleTransition:  aws.String("autoscaling:EC2_INSTANCE_TERMINATING"),
+	}); err != nil {
+		t.Fatalf("error putting lifecycle hook: %v", err)
+	}
+
+	kubeClient := fake.NewClientset(
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-terminating"},
+			Spec:       corev1.NodeSpec{ProviderID: "aws:///us-test-1a/i-terminating"},
+		},
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-inservice"},
+			Spec:       corev1.NodeSpec{ProviderID: "aws:///us-test-1a/i-inservice"},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "workload", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "node-terminating"},
+		},
+	)
+	// Without the eviction subresource, the drain deletes the pods
+	kubeClient.Resources = []*metav1.APIResourceList{
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "pods", Kind: "Pod"}}},
+	}
+
+	c := &NodeDrainController{
+		log:         klog.Background(),
+		clusterName: "minimal.example.com",
+		kubeClient:  kubeClient,
+		autoscaling: mockAutoscaling,
+		draining:    make(map[string]bool),
+	}
+
+	instances, err := c.findTerminatingInstances(ctx)
+	if err != nil {
+		t.Fatalf("error finding terminating instances: %v", err)
+	}
+	var ids []string
+	for _, instance := range instances {
+		ids = append(ids, instance.instanceID)
+	}
+	if len(ids) != 2 || ids[0] != "i-terminating" || ids[1] != "i-nodeless" {
+		t.Fatalf("expected the instances waiting on the hook, got %v", ids)
+	}
+
+	for _, instance := range instances {
+		if err := c.drainAndComplete(ctx, instance); err != nil {
+			t.Fatalf("error draining instance %q: %v", instance.instanceID, err)
+		}
+	}
+
+	node, err := kubeClient.CoreV1().Nodes().Get(ctx, "node-terminating", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("error getting node: %v", err)
+	}
+	if !node.Spec.Unschedulable {
+		t.Errorf("expected the node of the terminating instance to be cordoned")
+	}
+	if _, err := kubeClient.CoreV1().Pods("default").Get(ctx, "workload", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the pod of the terminating instance to be removed, got %v", err)
+	}
+	node, err = kubeClient.CoreV1().Nodes().Get(ctx, "node-inservice", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("error getting node: %v", err)
+	}
+	if node.Spec.Unschedulable {
+		t.Errorf("expected the node of the in service instance not to be cordoned")
+	}
+
+	expectedStates := map[string]autoscalingtypes.LifecycleState{
+		"i-terminating": autoscalingtypes.LifecycleStateTerminatingProceed,
+		"i-nodeless":    autoscalingtypes.LifecycleStateTerminatingProceed,
+		"i-inservice":   autoscalingtypes.LifecycleStateInService,
+		"i-unhooked":    autoscalingtypes.LifecycleStateTerminatingWait,
+	}
+	for _, group := range mockAutoscaling.Groups {
+		for _, instance := range group.Instances {
+			id := aws.ToString(instance.InstanceId)
+			if instance.LifecycleState != expectedStates[id] {
+				t.Errorf("expected instance %q to be %q, got %q", id, expectedStates[id], instance.LifecycleState)
+			}
+		}
+	}
+}
@@ -22,17 +22,22 @@ import (
 	"fmt"
 	"os"
 
+	certificatesv1 "k8s.io/api/certificates/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/klog/v2"
 	"k8s.io/klog/v2/klogr"
+	"k8s.io/kops"
 	bootstrapapi "k8s.io/kops/clusterapi/bootstrap/kops/api/v1beta1"
 	controlplaneapi "k8s.io/kops/clusterapi/controlplane/kops/api/v1beta1"
 	"k8s.io/kops/cmd/kops-controller/controllers"
 	"k8s.io/kops/cmd/kops-controller/pkg/config"
+	"k8s.io/kops/cmd/kops-controller/pkg/interruption"
+	"k8s.io/kops/cmd/kops-controller/pkg/scaling"
 	"k8s.io/kops/cmd/kops-controller/pkg/server"
 	"k8s.io/kops/pkg/apis/kops/v1alpha2"
 	"k8s.io/kops/pkg/bootstrap"
@@ -51,6 +56,8 @@ import (
 	nodeidentitymetal "k8s.io/kops/pkg/nodeidentity/metal"
 	nodeidentityos "k8s.io/kops/pkg/nodeidentity/openstack"
 	nodeidentityscw "k8s.io/kops/pkg/nodeidentity/scaleway"
+	"k8s.io/kops/pkg/otel/otelsetup"
+	instancegroupspb "k8s.io/kops/proto/kops/instancegroups/v1"
 	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
 	"k8s.io/kops/upup/pkg/fi/cloudup/do"
 	"k8s.io/kops/upup/pkg/fi/cloudup/gce/tpm/gcetpmverifier"
@@ -92,8 +99,22 @@ func main() {
 	configPath := "/etc/kubernetes/kops-controller/config.yaml"
 	flag.StringVar(&configPath, "conf", configPath, "Location of yaml configuration file")
 
+	// The interruption handler runs on every node from the same image, without the configuration file
+	interruptionHandler := false
+	flag.BoolVar(&interruptionHandler, "interruption-handler", interruptionHandler, "Run as the per-node interruption handler instead of the controller")
+	var interruptionOptions interruption.Options
+	interruptionOptions.AddFlags(flag.CommandLine)
+
 	flag.Parse()
 
+	if interruptionHandler {
+		ctrl.SetLogger(klogr.New())
+		if err := interruption.Run(ctrl.SetupSignalHandler(), ctrl.GetConfigOrDie(), &interruptionOptions); err != nil {
+			klog.Fatalf("error running interruption handler: %v", err)
+		}
+		return
+	}
+
 	if configPath == "" {
 		klog.Fatalf("must specify --conf")
 	}
@@ -110,10 +131,22 @@ func main() {
 		if err := yaml.Unmarshal(b, &opt); err != nil {
 			klog.Fatalf("failed to parse configuration file %q: %v", configPath, err)
 		}
+		opt.PopulateClusterNames()
 	}
 
 	ctrl.SetLogger(klogr.New())
 
+	otelShutdown, err := otelsetup.SetupOTelSDK(ctx, "kops-controller", kops.Version, opt.TracesEndpoint)
+	if err != nil {
+		setupLog.Error(err, "error setting up OpenTelemetry")
+		os.Exit(1)
+	}
+	defer func() {
+		if err := otelShutdown(context.Background()); err != nil {
+			klog.Warningf("error shutting down OpenTelemetry: %v", err)
+		}
+	}()
+
 	scheme, err := buildScheme(&opt)
 	if err != nil {
 		setupLog.Error(err, "error building scheme")
@@ -145,6 +178,7 @@ func main() {
 	}
 
 	var clientset simple.Clientset
+	var srv *server.Server
 
 	if opt.Server != nil {
 		var verifiers []bootstrap.Verifier
@@ -238,12 +272,20 @@ func main() {
 
 		verifier := bootstrap.NewChainVerifier(verifiers...)
 
-		srv, err := server.NewServer(vfsContext, &opt, verifier, uncachedClient)
+		srv, err = server.NewServer(vfsContext, &opt, verifier, uncachedClient)
 		if err != nil {
 			setupLog.Error(err, "unable to create server")
 			os.Exit(1)
 		}
 		clientset = srv.GetClientset()
+
+		if opt.Server.ScaleInstanceGroups {
+			if err := addScalingService(ctx, mgr, &opt, srv); err != nil {
+				setupLog.Error(err, "unable to create instance group scaling service")
+				os.Exit(1)
+			}
+		}
+
 		mgr.Add(srv)
 	}
 
@@ -265,6 +307,45 @@ func main() {
 		os.Exit(1)
 	}
 
+	if opt.HostGC != nil {
+		if err := addHostGCController(mgr, opt.HostGC); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "HostGCController")
+			os.Exit(1)
+		}
+	}
+
+	if opt.NodeDrain != nil {
+		if err := addNodeDrainController(ctx, mgr, &opt); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "NodeDrainController")
+			os.Exit(1)
+		}
+	}
+
+	if opt.Addons != nil {
+		if err := addAddonsController(mgr, vfsContext, opt.Addons); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AddonsController")
+			os.Exit(1)
+		}
+	}
+
+	if opt.Apply != nil {
+		if err := addApplyController(mgr, vfsContext, &opt); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ApplyController")
+			os.Exit(1)
+		}
+	}
+
+	if opt.SignKubeletServingCertificates {
+		if srv == nil {
+			setupLog.Error(fmt.Errorf("server is not configured"), "signing kubelet serving certificates")
+			os.Exit(1)
+		}
+		if err := addKubeletServingCSRController(mgr, srv); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "KubeletServingCSRController")
+			os.Exit(1)
+		}
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	if opt.CAPI.IsEnabled() {
@@ -293,6 +374,11 @@ func buildScheme(opt *config.Options) (*runtime.Scheme, error) {
 	if err := v1alpha2.AddToScheme(scheme); err != nil {
 		return nil, fmt.Errorf("error registering kops/v1alpha2 API: %v", err)
 	}
+	if opt.SignKubeletServingCertificates {
+		if err := certificatesv1.AddToScheme(scheme); err != nil {
+			return nil, fmt.Errorf("error registering certificatesv1: %v", err)
+		}
+	}
 	// Needed so that the leader-election system can post events
 	if err := coordinationv1.AddToScheme(scheme); err != nil {
 		return nil, fmt.Errorf("error registering coordinationv1: %v", err)
@@ -379,7 +465,7 @@ func addNodeController(ctx context.Context, mgr manager.Manager, opt *config.Opt
 		return fmt.Errorf("identifier for cloud %q not implemented", opt.Cloud)
 	}
 
-	nodeController, err := controllers.NewNodeReconciler(mgr, identifier)
+	nodeController, err := controllers.NewNodeReconciler(mgr, identifier, opt.NodeIdentityLabels, opt.SignKubeletServingCertificates)
 	if err != nil {
 		return err
 	}
@@ -390,6 +476,72 @@ func addNodeController(ctx context.Context, mgr manager.Manager, opt *config.Opt
 	return nil
 }
 
+func addHostGCController(mgr manager.Manager, opt *config.HostGCOptions) error {
+	controller, err := controllers.NewHostGCReconciler(mgr, opt)
+	if err != nil {
+		return err
+	}
+	return controller.SetupWithManager(mgr)
+}
+
+func addNodeDrainController(ctx context.Context, mgr manager.Manager, opt *config.Options) error {
+	if opt.Cloud != "aws" {
+		return fmt.Errorf("draining nodes of terminating instances is not supported on cloud %q", opt.Cloud)
+	}
+	controller, err := controllers.NewNodeDrainController(ctx, mgr, opt.ClusterName, opt.NodeDrain)
+	if err != nil {
+		return err
+	}
+	return mgr.Add(controller)
+}
+
+func addAddonsController(mgr manager.Manager, vfsContext *vfs.VFSContext, opt *config.AddonsOptions) error {
+	controller, err := controllers.NewAddonsController(mgr, vfsContext, opt)
+	if err != nil {
+		return err
+	}
+	return mgr.Add(controller)
+}
+
+func addApplyController(mgr manager.Manager, vfsContext *vfs.VFSContext, opt *config.Options) error {
+	controller, err := controllers.NewApplyController(mgr, vfsContext, opt.ClusterName, opt.Apply)
+	if err != nil {
+		return err
+	}
+	return mgr.Add(controller)
+}
+
+func addKubeletServingCSRController(mgr manager.Manager, srv *server.Server) error {
+	controller, err := controllers.NewKubeletServingCSRReconciler(mgr, srv.GetKeystore())
+	if err != nil {
+		return err
+	}
+	return controller.SetupWithManager(mgr)
+}
+
+func addScalingService(ctx context.Context, mgr manager.Manager, opt *config.Options, srv *server.Server) error {
+	var scaler scaling.Scaler
+	var err error
+	switch opt.Cloud {
+	case "aws":
+		scaler, err = scaling.NewAWSScaler(ctx, opt.ClusterName, opt.Server.Provider.AWS.Region)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("scaling instance groups is not supported on cloud %q", opt.Cloud)
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("error building kubernetes client: %w", err)
+	}
+
+	service := scaling.NewService(opt.ClusterName, srv.GetClientset(), kubeClient, scaler, mgr.GetEventRecorder("kops-controller"))
+	instancegroupspb.RegisterInstanceGroupServiceServer(srv, service)
+	return nil
+}
+
 func addGossipController(mgr manager.Manager, opt *config.Options) error {
 	if opt.Discovery == nil || !opt.Discovery.Enabled {
 		return nil
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatenames
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConfigMapNamespace is the namespace of the ConfigMap recording the certificate names of nodes.
+	ConfigMapNamespace = "kube-system"
+	// ConfigMapName is the name of the ConfigMap recording the certificate names of nodes.
+	ConfigMapName = "kops-controller-certificate-names"
+)
+
+// Record stores the alternate names a node was verified to use for certificates when it bootstrapped.
+func Record(ctx context.Context, c client.Client, nodeName string, names []string) error {
+	patch := map[string]any{
+		"data": map[string]string{
+			nodeName: strings.Join(names, ","),
+		},
+	}
+	patchJSON, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("building certificate names patch: %w", err)
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ConfigMapNamespace,
+			Name:      ConfigMapName,
+		},
+	}
+	err = c.Patch(ctx, configMap, client.RawPatch(types.MergePatchType, patchJSON))
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("patching configmap %s/%s: %w", ConfigMapNamespace, ConfigMapName, err)
+	}
+
+	configMap.Data = map[string]string{
+		nodeName: strings.Join(names, ","),
+	}
+	if err := c.Create(ctx, configMap); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			// Created concurrently by another bootstrap request
+			return Record(ctx, c, nodeName, names)
+		}
+		return fmt.Errorf("creating configmap %s/%s: %w", ConfigMapNamespace, ConfigMapName, err)
+	}
+	return nil
+}
+
+// Forget removes the alternate names recorded for a node, so that the ConfigMap doesn't grow as nodes are replaced.
+func Forget(ctx context.Context, c client.Client, nodeName string) error {
+	patch := map[string]any{
+		"data": map[string]any{
+			nodeName: nil,
+		},
+	}
+	patchJSON, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("building certificate names patch: %w", err)
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ConfigMapNamespace,
+			Name:      ConfigMapName,
+		},
+	}
+	if err := c.Patch(ctx, configMap, client.RawPatch(types.MergePatchType, patchJSON)); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("patching configmap %s/%s: %w", ConfigMapNamespace, ConfigMapName, err)
+	}
+	return nil
+}
+
+// Get returns the alternate names recorded for the node, or nil if none were recorded.
+func Get(ctx context.Context, r client.Reader, nodeName string) ([]string, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: ConfigMapNamespace, Name: ConfigMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("getting configmap %s/%s: %w", ConfigMapNamespace, ConfigMapName, err)
+	}
+
+	value := configMap.Data[nodeName]
+	if value == "" {
+		return nil, nil
+	}
+	return strings.Split(value, ","), nil
+}
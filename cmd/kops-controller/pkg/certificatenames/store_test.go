@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatenames
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// fakeClient stores the certificate names ConfigMap, applying merge patches to its data.
+type fakeClient struct {
+	client.Client
+
+	configMap *corev1.ConfigMap
+}
+
+func (c *fakeClient) Get(ctx context.Context, key types.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+	if c.configMap == nil {
+		return apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, key.Name)
+	}
+	c.configMap.DeepCopyInto(obj.(*corev1.ConfigMap))
+	return nil
+}
+
+func (c *fakeClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if c.configMap != nil {
+		return apierrors.NewAlreadyExists(schema.GroupResource{Resource: "configmaps"}, obj.GetName())
+	}
+	c.configMap = obj.(*corev1.ConfigMap).DeepCopy()
+	return nil
+}
+
+func (c *fakeClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if c.configMap == nil {
+		return apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, obj.GetName())
+	}
+	data, err := patch.Data(obj)
+	if err != nil {
+		return err
+	}
+	var p struct {
+		Data map[string]*string `json:"data"`
+	}
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	for k, v := range p.Data {
+		if v == nil {
+			delete(c.configMap.Data, k)
+		} else {
+			c.configMap.Data[k] = *v
+		}
+	}
+	return nil
+}
+
+func TestRecordAndForget(t *testing.T) {
+	ctx := context.Background()
+	c := &fakeClient{}
+
+	// Forgetting a node before anything was recorded is a no-op
+	if err := Forget(ctx, c, "node-a"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := Record(ctx, c, "node-a", []string{"node-a", "10.0.0.1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := Record(ctx, c, "node-b", []string{"node-b", "10.0.0.2"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := Forget(ctx, c, "node-a"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{"node-b": "node-b,10.0.0.2"}
+	if !reflect.DeepEqual(c.configMap.Data, expected) {
+		t.Errorf("expected %v, got %v", expected, c.configMap.Data)
+	}
+
+	names, err := Get(ctx, c, "node-a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if names != nil {
+		t.Errorf("expected no names for forgotten node, got %v", names)
+	}
+	names, err = Get(ctx, c, "node-b")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(names, []string{"node-b", "10.0.0.2"}) {
+		t.Errorf("unexpected names for node-b: %v", names)
+	}
+}
@@ -17,8 +17,10 @@ limitations under the License.
 package config
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kops/pkg/bootstrap/awsbootstrap"
 	"k8s.io/kops/pkg/bootstrap/pkibootstrap"
+	"k8s.io/kops/pkg/nodeidentity"
 	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
 	"k8s.io/kops/upup/pkg/fi/cloudup/do"
 	gcetpm "k8s.io/kops/upup/pkg/fi/cloudup/gce/tpm"
@@ -44,11 +46,80 @@ type Options struct {
 
 	// CAPI configures Cluster API (CAPI) support.
 	CAPI *CAPIOptions `json:"capi,omitempty"`
+
+	// SignKubeletServingCertificates enables approving and signing the CertificateSigningRequests for kubelet serving certificates,
+	// restricted to the names verified when the node bootstrapped.
+	SignKubeletServingCertificates bool `json:"signKubeletServingCertificates,omitempty"`
+
+	// HostGC configures the garbage collection of the Host and Node objects of removed bare-metal machines.
+	HostGC *HostGCOptions `json:"hostGC,omitempty"`
+
+	// NodeIdentityLabels configures the node labels built from the cloud metadata of instances.
+	NodeIdentityLabels *nodeidentity.LabelOptions `json:"nodeIdentityLabels,omitempty"`
+
+	// NodeDrain configures draining the nodes of instances held by the graceful node drain lifecycle hook.
+	NodeDrain *NodeDrainOptions `json:"nodeDrain,omitempty"`
+
+	// TracesEndpoint is the OTLP/HTTP endpoint to export traces to.
+	TracesEndpoint string `json:"tracesEndpoint,omitempty"`
+
+	// Addons configures enforcing the manifests of the managed addons.
+	Addons *AddonsOptions `json:"addons,omitempty"`
+
+	// Apply configures continuously applying the cluster.
+	Apply *ApplyOptions `json:"apply,omitempty"`
 }
 
 func (o *Options) PopulateDefaults() {
 }
 
+// PopulateClusterNames sets the cluster name of the verifiers that check cluster membership from the cluster name of kops-controller,
+// as configurations written by older versions of kops don't set it.
+func (o *Options) PopulateClusterNames() {
+	if o.Server == nil {
+		return
+	}
+	if p := o.Server.Provider.Hetzner; p != nil && p.ClusterName == "" {
+		p.ClusterName = o.ClusterName
+	}
+	if p := o.Server.Provider.DigitalOcean; p != nil && p.ClusterName == "" {
+		p.ClusterName = o.ClusterName
+	}
+	if p := o.Server.Provider.Scaleway; p != nil && p.ClusterName == "" {
+		p.ClusterName = o.ClusterName
+	}
+}
+
+// HostGCOptions configures the garbage collection of the Host and Node objects of removed bare-metal machines.
+type HostGCOptions struct {
+	// TTL is how long a machine may go without bootstrapping or heartbeating before its Host is marked as stale.
+	TTL metav1.Duration `json:"ttl"`
+	// DeleteStale enables deleting the stale Hosts and their Nodes.
+	DeleteStale bool `json:"deleteStale,omitempty"`
+}
+
+// NodeDrainOptions configures draining the nodes of instances held by the graceful node drain lifecycle hook.
+type NodeDrainOptions struct {
+	// Region is the AWS region of the autoscaling groups.
+	Region string `json:"region"`
+	// DrainTimeout is the maximum time to wait for a node to drain before completing the lifecycle action anyway.
+	DrainTimeout metav1.Duration `json:"drainTimeout"`
+}
+
+// AddonsOptions configures enforcing the manifests of the managed addons.
+type AddonsOptions struct {
+	// Channel is the location of the bootstrap channel, which lists the managed addons.
+	Channel string `json:"channel"`
+}
+
+// ApplyOptions configures continuously applying the cluster.
+type ApplyOptions struct {
+	// StateStore is the location of the state store that holds the cluster, as passed to kops with --state.
+	StateStore string `json:"stateStore"`
+	// Interval is how often the cluster is applied even if its specs did not change.
+	Interval metav1.Duration `json:"interval"`
+}
+
 type CAPIOptions struct {
 	// Enabled specifies whether CAPI support is enabled.
 	Enabled *bool `json:"enabled,omitempty"`
@@ -76,6 +147,10 @@ type ServerOptions struct {
 	ServerKeyPath string `json:"serverKeyPath,omitempty"`
 	// ServerCertificatePath is the path to our TLS serving certificate.
 	ServerCertificatePath string `json:"serverCertificatePath,omitempty"`
+	// TLSMinVersion is the minimum TLS version of the server, defaulting to VersionTLS12.
+	TLSMinVersion string `json:"tlsMinVersion,omitempty"`
+	// TLSCipherSuites are the allowed TLS 1.2 cipher suites of the server.
+	TLSCipherSuites []string `json:"tlsCipherSuites,omitempty"`
 
 	// CABasePath is a base of the path to CA certificate and key files.
 	CABasePath string `json:"caBasePath"`
@@ -83,6 +158,11 @@ type ServerOptions struct {
 	SigningCAs []string `json:"signingCAs"`
 	// CertNames is the list of active certificate names.
 	CertNames []string `json:"certNames"`
+	// RequireClientCertificates requires nodes that have already registered to present their kops-controller-client certificate.
+	RequireClientCertificates bool `json:"requireClientCertificates,omitempty"`
+
+	// ScaleInstanceGroups enables the gRPC API for scaling instance groups through the cloud provider.
+	ScaleInstanceGroups bool `json:"scaleInstanceGroups,omitempty"`
 }
 
 type ServerProviderOptions struct {
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interruption
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const awsMetadataEndpoint = "http://169.254.169.254"
+
+// awsTokenTTL is how long the IMDSv2 session tokens are valid.
+const awsTokenTTL = 6 * time.Hour
+
+// awsWatcher reads spot interruption notices and scheduled events from the EC2 instance metadata service.
+type awsWatcher struct {
+	endpoint string
+	client   *http.Client
+
+	token        string
+	tokenExpires time.Time
+}
+
+var _ Watcher = &awsWatcher{}
+
+// NewAWSWatcher builds a Watcher reading the EC2 instance metadata service.
+func NewAWSWatcher(endpoint string) Watcher {
+	return &awsWatcher{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// awsSpotInstanceAction is the spot interruption notice.
+type awsSpotInstanceAction struct {
+	Action string `json:"action"`
+	Time   string `json:"time"`
+}
+
+// awsScheduledEvent is a scheduled maintenance event.
+type awsScheduledEvent struct {
+	Code        string `json:"Code"`
+	Description string `json:"Description"`
+	EventID     string `json:"EventId"`
+	NotBefore   string `json:"NotBefore"`
+	State       string `json:"State"`
+}
+
+// awsScheduledEventTimeFormat is the format of the times of scheduled events.
+const awsScheduledEventTimeFormat = "2 Jan 2006 15:04:05 GMT"
+
+// Poll implements Watcher.
+func (w *awsWatcher) Poll(ctx context.Context) ([]Event, error) {
+	var events []Event
+
+	body, found, err := w.get(ctx, "/latest/meta-data/spot/instance-action")
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		action := &awsSpotInstanceAction{}
+		if err := json.Unmarshal(body, action); err != nil {
+			return nil, fmt.Errorf("error parsing spot instance action %q: %w", string(body), err)
+		}
+		event := Event{
+			ID:          "spot-" + action.Time,
+			Kind:        EventKindSpotInterruption,
+			Description: fmt.Sprintf("spot instance %s", action.Action),
+		}
+		if t, err := time.Parse(time.RFC3339, action.Time); err == nil {
+			event.NotBefore = t
+		}
+		events = append(events, event)
+	}
+
+	body, found, err = w.get(ctx, "/latest/meta-data/events/maintenance/scheduled")
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		var scheduledEvents []awsScheduledEvent
+		if err := json.Unmarshal(body, &scheduledEvents); err != nil {
+			return nil, fmt.Errorf("error parsing scheduled events %q: %w", string(body), err)
+		}
+		for _, scheduledEvent := range scheduledEvents {
+			// Canceled and completed events are kept in the list for a while
+			if scheduledEvent.State != "active" {
+				continue
+			}
+			event := Event{
+				ID:          scheduledEvent.EventID,
+				Kind:        EventKindMaintenance,
+				Description: fmt.Sprintf("%s: %s", scheduledEvent.Code, scheduledEvent.Description),
+			}
+			if t, err := time.Parse(awsScheduledEventTimeFormat, scheduledEvent.NotBefore); err == nil {
+				event.NotBefore = t
+			}
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+// get reads a path of the metadata service, returning false if it is not found.
+func (w *awsWatcher) get(ctx context.Context, path string) ([]byte, bool, error) {
+	token, err := w.getToken(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, w.endpoint+path, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	req.Header.Set("X-aws-ec2-metadata-token", token)
+	body, found, err := doMetadataRequest(w.client, req)
+	if err != nil {
+		// The token may have been invalidated
+		w.token = ""
+	}
+	return body, found, err
+}
+
+// getToken returns an IMDSv2 session token.
+func (w *awsWatcher) getToken(ctx context.Context) (string, error) {
+	if w.token != "" && time.Now().Before(w.tokenExpires) {
+		return w.token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, w.endpoint+"/latest/api/token", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-aws-ec2-metadata-token-ttl-seconds", fmt.Sprintf("%d", int(awsTokenTTL.Seconds())))
+	body, found, err := doMetadataRequest(w.client, req)
+	if err != nil {
+		return "", fmt.Errorf("error getting metadata token: %w", err)
+	}
+	if !found {
+		return "", fmt.Errorf("error getting metadata token: not found")
+	}
+
+	w.token = strings.TrimSpace(string(body))
+	// Renew the token well before it expires
+	w.tokenExpires = time.Now().Add(awsTokenTTL / 2)
+	return w.token, nil
+}
+
+// doMetadataRequest sends a request to a metadata service, returning false if the path is not found.
+func doMetadataRequest(client *http.Client, req *http.Request) ([]byte, bool, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, false, fmt.Errorf("error querying %s: %w", req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, fmt.Errorf("error reading response from %s: %w", req.URL.Path, err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return body, true, nil
+	case http.StatusNotFound:
+		return nil, false, nil
+	default:
+		return nil, false, fmt.Errorf("unexpected status %q querying %s", resp.Status, req.URL.Path)
+	}
+}
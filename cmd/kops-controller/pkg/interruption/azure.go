@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interruption
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const azureMetadataEndpoint = "http://169.254.169.254"
+
+// azureWatcher reads scheduled events from the Azure instance metadata service.
+type azureWatcher struct {
+	endpoint string
+	client   *http.Client
+
+	// vmName is the name of the virtual machine, as listed in the resources of the events
+	vmName string
+}
+
+var _ Watcher = &azureWatcher{}
+
+// NewAzureWatcher builds a Watcher reading the Azure instance metadata service.
+func NewAzureWatcher(endpoint string) Watcher {
+	return &azureWatcher{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// azureScheduledEvents is the document of the scheduled events.
+type azureScheduledEvents struct {
+	Events []azureScheduledEvent `json:"Events"`
+}
+
+// azureScheduledEvent is a scheduled event.
+type azureScheduledEvent struct {
+	EventID      string   `json:"EventId"`
+	EventType    string   `json:"EventType"`
+	ResourceType string   `json:"ResourceType"`
+	Resources    []string `json:"Resources"`
+	EventStatus  string   `json:"EventStatus"`
+	NotBefore    string   `json:"NotBefore"`
+	Description  string   `json:"Description"`
+}
+
+// Poll implements Watcher.
+func (w *azureWatcher) Poll(ctx context.Context) ([]Event, error) {
+	if w.vmName == "" {
+		body, err := w.get(ctx, "/metadata/instance/compute/name?api-version=2021-02-01&format=text")
+		if err != nil {
+			return nil, err
+		}
+		w.vmName = strings.TrimSpace(string(body))
+	}
+
+	body, err := w.get(ctx, "/metadata/scheduledevents?api-version=2020-07-01")
+	if err != nil {
+		return nil, err
+	}
+	scheduledEvents := &azureScheduledEvents{}
+	if err := json.Unmarshal(body, scheduledEvents); err != nil {
+		return nil, fmt.Errorf("error parsing scheduled events %q: %w", string(body), err)
+	}
+
+	var events []Event
+	for _, scheduledEvent := range scheduledEvents.Events {
+		if scheduledEvent.ResourceType != "VirtualMachine" || !slices.Contains(scheduledEvent.Resources, w.vmName) {
+			continue
+		}
+		if scheduledEvent.EventStatus != "Scheduled" && scheduledEvent.EventStatus != "Started" {
+			continue
+		}
+		event := Event{
+			ID:          scheduledEvent.EventID,
+			Description: fmt.Sprintf("%s: %s", scheduledEvent.EventType, scheduledEvent.Description),
+		}
+		switch scheduledEvent.EventType {
+		case "Preempt":
+			event.Kind = EventKindSpotInterruption
+		case "Terminate", "Reboot", "Redeploy":
+			event.Kind = EventKindMaintenance
+		default:
+			// Freeze only pauses the virtual machine for a few seconds
+			continue
+		}
+		if t, err := time.Parse(time.RFC1123, scheduledEvent.NotBefore); err == nil {
+			event.NotBefore = t
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// get reads a path of the instance metadata service.
+func (w *azureWatcher) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, w.endpoint+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata", "true")
+	body, found, err := doMetadataRequest(w.client, req)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("metadata %s not found", req.URL.Path)
+	}
+	return body, nil
+}
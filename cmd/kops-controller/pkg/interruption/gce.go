@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interruption
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const gceMetadataEndpoint = "http://metadata.google.internal"
+
+// gceWatcher reads preemption notices and maintenance events from the GCE metadata server.
+type gceWatcher struct {
+	endpoint string
+	client   *http.Client
+}
+
+var _ Watcher = &gceWatcher{}
+
+// NewGCEWatcher builds a Watcher reading the GCE metadata server.
+func NewGCEWatcher(endpoint string) Watcher {
+	return &gceWatcher{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// Poll implements Watcher.
+func (w *gceWatcher) Poll(ctx context.Context) ([]Event, error) {
+	var events []Event
+
+	preempted, err := w.get(ctx, "/computeMetadata/v1/instance/preempted")
+	if err != nil {
+		return nil, err
+	}
+	if preempted == "TRUE" {
+		events = append(events, Event{
+			ID:          "preempted",
+			Kind:        EventKindSpotInterruption,
+			Description: "instance preempted",
+		})
+	}
+
+	maintenanceEvent, err := w.get(ctx, "/computeMetadata/v1/instance/maintenance-event")
+	if err != nil {
+		return nil, err
+	}
+	// Instances are live migrated for MIGRATE_ON_HOST_MAINTENANCE, so only TERMINATE_ON_HOST_MAINTENANCE needs a drain
+	if maintenanceEvent == "TERMINATE_ON_HOST_MAINTENANCE" {
+		events = append(events, Event{
+			ID:          "maintenance-" + maintenanceEvent,
+			Kind:        EventKindMaintenance,
+			Description: "instance terminated for host maintenance",
+		})
+	}
+
+	return events, nil
+}
+
+// get reads a value of the metadata server.
+func (w *gceWatcher) get(ctx context.Context, path string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, w.endpoint+path, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	body, found, err := doMetadataRequest(w.client, req)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", fmt.Errorf("metadata %s not found", path)
+	}
+	return strings.TrimSpace(string(body)), nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interruption
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/kubectl/pkg/drain"
+)
+
+// pollInterval is how often the metadata service is checked for interruption notices.
+// Spot interruptions only leave two minutes to drain, so this is kept short.
+const pollInterval = 5 * time.Second
+
+// Handler drains the node when the instance receives an interruption notice.
+type Handler struct {
+	kubeClient kubernetes.Interface
+	watcher    Watcher
+
+	nodeName               string
+	drainMaintenanceEvents bool
+	drainTimeout           time.Duration
+
+	// seen holds the ids of the events that have been received
+	seen map[string]bool
+	// handled holds the ids of the events that have been handled
+	handled map[string]bool
+}
+
+// NewHandler is the constructor for a Handler
+func NewHandler(kubeClient kubernetes.Interface, watcher Watcher, opt *Options) *Handler {
+	return &Handler{
+		kubeClient:             kubeClient,
+		watcher:                watcher,
+		nodeName:               opt.NodeName,
+		drainMaintenanceEvents: opt.DrainMaintenanceEvents,
+		drainTimeout:           opt.DrainTimeout,
+		seen:                   make(map[string]bool),
+		handled:                make(map[string]bool),
+	}
+}
+
+// Run polls for interruption notices until the context is done.
+func (h *Handler) Run(ctx context.Context) {
+	wait.UntilWithContext(ctx, h.poll, pollInterval)
+}
+
+// poll handles the interruption notices that have not been handled yet.
+func (h *Handler) poll(ctx context.Context) {
+	events, err := h.watcher.Poll(ctx)
+	if err != nil {
+		pollErrorsTotal.Inc()
+		klog.Warningf("error reading interruption notices: %v", err)
+		return
+	}
+
+	for _, event := range events {
+		if h.handled[event.ID] {
+			continue
+		}
+		if err := h.handle(ctx, event); err != nil {
+			// Try again on the next poll
+			klog.Warningf("error handling interruption notice %q: %v", event.ID, err)
+			continue
+		}
+		h.handled[event.ID] = true
+	}
+}
+
+// handle drains the node for an interruption notice.
+func (h *Handler) handle(ctx context.Context, event Event) error {
+	if !h.seen[event.ID] {
+		h.seen[event.ID] = true
+		eventsTotal.WithLabelValues(string(event.Kind)).Inc()
+		if event.NotBefore.IsZero() {
+			klog.Infof("received %s notice %q: %s", event.Kind, event.ID, event.Description)
+		} else {
+			klog.Infof("received %s notice %q: %s, not before %v", event.Kind, event.ID, event.Description, event.NotBefore)
+		}
+	}
+
+	if event.Kind == EventKindMaintenance && !h.drainMaintenanceEvents {
+		klog.Infof("not draining node %q for maintenance event %q", h.nodeName, event.ID)
+		return nil
+	}
+
+	if err := h.drainNode(ctx); err != nil {
+		drainsTotal.WithLabelValues("failure").Inc()
+		return err
+	}
+	drainsTotal.WithLabelValues("success").Inc()
+	klog.Infof("drained node %q for %s notice %q", h.nodeName, event.Kind, event.ID)
+	return nil
+}
+
+// drainNode cordons and drains the node.
+func (h *Handler) drainNode(ctx context.Context) error {
+	node, err := h.kubeClient.CoreV1().Nodes().Get(ctx, h.nodeName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting node %q: %w", h.nodeName, err)
+	}
+
+	helper := &drain.Helper{
+		Ctx:                 ctx,
+		Client:              h.kubeClient,
+		Force:               true,
+		GracePeriodSeconds:  -1,
+		IgnoreAllDaemonSets: true,
+		Out:                 os.Stdout,
+		ErrOut:              os.Stderr,
+		Timeout:             h.drainTimeout,
+
+		// We want to proceed even when pods are using emptyDir volumes
+		DeleteEmptyDirData: true,
+	}
+
+	if err := drain.RunCordonOrUncordon(helper, node, true); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error cordoning node: %w", err)
+	}
+
+	if err := drain.RunNodeDrain(helper, node.Name); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error draining node: %w", err)
+	}
+
+	return nil
+}
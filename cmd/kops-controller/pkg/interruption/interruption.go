@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interruption
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+// EventKind is the kind of an interruption notice.
+type EventKind string
+
+const (
+	// EventKindSpotInterruption is the reclaim of a spot or preemptible instance.
+	EventKindSpotInterruption EventKind = "SpotInterruption"
+	// EventKindMaintenance is a scheduled maintenance event that reboots, stops or terminates the instance.
+	EventKindMaintenance EventKind = "Maintenance"
+)
+
+// Event is a notice that the instance is about to be interrupted.
+type Event struct {
+	// ID identifies the event, so that it is only handled once.
+	ID string
+	// Kind is the kind of the event.
+	Kind EventKind
+	// Description describes the event.
+	Description string
+	// NotBefore is the earliest time the instance is interrupted, if known.
+	NotBefore time.Time
+}
+
+// Watcher reads the interruption notices of the instance from the cloud metadata service.
+type Watcher interface {
+	// Poll returns the pending interruption notices of the instance.
+	Poll(ctx context.Context) ([]Event, error)
+}
+
+// Options configures the interruption handler.
+type Options struct {
+	// Cloud is the cloud provider of the instance.
+	Cloud string
+	// NodeName is the name of the node running on the instance.
+	NodeName string
+	// DrainMaintenanceEvents enables draining the node for scheduled maintenance events.
+	DrainMaintenanceEvents bool
+	// DrainTimeout is the maximum time to wait for the node to drain.
+	DrainTimeout time.Duration
+	// MetricsListen is the address on which to listen for Prometheus metrics, if set.
+	MetricsListen string
+}
+
+// AddFlags adds the flags of the interruption handler to the flag set.
+func (o *Options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Cloud, "interruption-handler-cloud", o.Cloud, "Cloud provider of the instance: aws, gce or azure")
+	fs.StringVar(&o.NodeName, "interruption-handler-node-name", os.Getenv("NODE_NAME"), "Name of the node running on the instance")
+	fs.BoolVar(&o.DrainMaintenanceEvents, "interruption-handler-drain-maintenance-events", true, "Drain the node for scheduled maintenance events")
+	fs.DurationVar(&o.DrainTimeout, "interruption-handler-drain-timeout", 90*time.Second, "Maximum time to wait for the node to drain")
+	fs.StringVar(&o.MetricsListen, "interruption-handler-metrics-listen", "", "The address on which to listen for Prometheus metrics")
+}
+
+// NewWatcher builds the Watcher for the cloud provider.
+func NewWatcher(cloud string) (Watcher, error) {
+	switch cloud {
+	case "aws":
+		return NewAWSWatcher(awsMetadataEndpoint), nil
+	case "gce":
+		return NewGCEWatcher(gceMetadataEndpoint), nil
+	case "azure":
+		return NewAzureWatcher(azureMetadataEndpoint), nil
+	default:
+		return nil, fmt.Errorf("interruption handler is not supported on cloud %q", cloud)
+	}
+}
+
+// Run runs the interruption handler until the context is done.
+func Run(ctx context.Context, kubeConfig *rest.Config, opt *Options) error {
+	if opt.NodeName == "" {
+		return fmt.Errorf("node name must be set")
+	}
+
+	watcher, err := NewWatcher(opt.Cloud)
+	if err != nil {
+		return err
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return fmt.Errorf("error building kubernetes client: %w", err)
+	}
+
+	if opt.MetricsListen != "" {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", promhttp.Handler())
+			klog.Fatal(http.ListenAndServe(opt.MetricsListen, mux))
+		}()
+	}
+
+	handler := NewHandler(kubeClient, watcher, opt)
+	klog.Infof("watching %s instance metadata for interruption notices of node %q", opt.Cloud, opt.NodeName)
+	handler.Run(ctx)
+	return nil
+}
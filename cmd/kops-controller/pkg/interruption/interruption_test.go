@@ -0,0 +1,295 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interruption
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newMetadataServer serves fixed responses for the paths, checking the header is set.
+func newMetadataServer(t *testing.T, header string, responses map[string]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(header) == "" {
+			t.Errorf("expected header %q to be set querying %s", header, r.URL.Path)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		response, found := responses[r.Method+" "+r.URL.Path]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAWSWatcher(t *testing.T) {
+	grid := []struct {
+		description string
+		responses   map[string]string
+		expected    []Event
+	}{
+		{
+			description: "no notices",
+		},
+		{
+			description: "spot interruption",
+			responses: map[string]string{
+				"GET /latest/meta-data/spot/instance-action": `{"action": "terminate", "time": "2026-10-17T08:22:00Z"}`,
+			},
+			expected: []Event{
+				{
+					ID:          "spot-2026-10-17T08:22:00Z",
+					Kind:        EventKindSpotInterruption,
+					Description: "spot instance terminate",
+					NotBefore:   time.Date(2026, 10, 17, 8, 22, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			description: "scheduled events",
+			responses: map[string]string{
+				"GET /latest/meta-data/events/maintenance/scheduled": `[
+					{"Code": "system-reboot", "Description": "scheduled reboot", "EventId": "instance-event-1", "NotBefore": "21 Oct 2026 09:00:00 GMT", "State": "active"},
+					{"Code": "instance-stop", "Description": "scheduled stop", "EventId": "instance-event-2", "NotBefore": "22 Oct 2026 09:00:00 GMT", "State": "canceled"}
+				]`,
+			},
+			expected: []Event{
+				{
+					ID:          "instance-event-1",
+					Kind:        EventKindMaintenance,
+					Description: "system-reboot: scheduled reboot",
+					NotBefore:   time.Date(2026, 10, 21, 9, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.description, func(t *testing.T) {
+			responses := map[string]string{
+				"PUT /latest/api/token": "token",
+			}
+			for path, response := range g.responses {
+				responses[path] = response
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet && r.Header.Get("X-aws-ec2-metadata-token") != "token" {
+					t.Errorf("expected token to be set querying %s", r.URL.Path)
+				}
+				response, found := responses[r.Method+" "+r.URL.Path]
+				if !found {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Write([]byte(response))
+			}))
+			defer server.Close()
+
+			events, err := NewAWSWatcher(server.URL).Poll(context.Background())
+			if err != nil {
+				t.Fatalf("error polling: %v", err)
+			}
+			if !reflect.DeepEqual(events, g.expected) {
+				t.Errorf("expected %+v, got %+v", g.expected, events)
+			}
+		})
+	}
+}
+
+func TestGCEWatcher(t *testing.T) {
+	grid := []struct {
+		description      string
+		preempted        string
+		maintenanceEvent string
+		expectedKinds    []EventKind
+	}{
+		{
+			description:      "no notices",
+			preempted:        "FALSE",
+			maintenanceEvent: "NONE",
+		},
+		{
+			description:      "preempted",
+			preempted:        "TRUE",
+			maintenanceEvent: "NONE",
+			expectedKinds:    []EventKind{EventKindSpotInterruption},
+		},
+		{
+			description:      "live migration",
+			preempted:        "FALSE",
+			maintenanceEvent: "MIGRATE_ON_HOST_MAINTENANCE",
+		},
+		{
+			description:      "terminate for maintenance",
+			preempted:        "FALSE",
+			maintenanceEvent: "TERMINATE_ON_HOST_MAINTENANCE",
+			expectedKinds:    []EventKind{EventKindMaintenance},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.description, func(t *testing.T) {
+			server := newMetadataServer(t, "Metadata-Flavor", map[string]string{
+				"GET /computeMetadata/v1/instance/preempted":         g.preempted,
+				"GET /computeMetadata/v1/instance/maintenance-event": g.maintenanceEvent,
+			})
+
+			events, err := NewGCEWatcher(server.URL).Poll(context.Background())
+			if err != nil {
+				t.Fatalf("error polling: %v", err)
+			}
+			var kinds []EventKind
+			for _, event := range events {
+				kinds = append(kinds, event.Kind)
+			}
+			if !reflect.DeepEqual(kinds, g.expectedKinds) {
+				t.Errorf("expected %v, got %v", g.expectedKinds, kinds)
+			}
+		})
+	}
+}
+
+func TestAzureWatcher(t *testing.T) {
+	server := newMetadataServer(t, "Metadata", map[string]string{
+		"GET /metadata/instance/compute/name": "nodes-vmss_0",
+		"GET /metadata/scheduledevents": `{
+			"DocumentIncarnation": 2,
+			"Events": [
+				{"EventId": "preempt", "EventType": "Preempt", "ResourceType": "VirtualMachine", "Resources": ["nodes-vmss_0"], "EventStatus": "Scheduled", "NotBefore": "Sat, 17 Oct 2026 08:22:00 GMT"},
+				{"EventId": "freeze", "EventType": "Freeze", "ResourceType": "VirtualMachine", "Resources": ["nodes-vmss_0"], "EventStatus": "Scheduled"},
+				{"EventId": "redeploy", "EventType": "Redeploy", "ResourceType": "VirtualMachine", "Resources": ["nodes-vmss_0"], "EventStatus": "Started"},
+				{"EventId": "other", "EventType": "Terminate", "ResourceType": "VirtualMachine", "Resources": ["nodes-vmss_1"], "EventStatus": "Scheduled"}
+			]
+		}`,
+	})
+
+	events, err := NewAzureWatcher(server.URL).Poll(context.Background())
+	if err != nil {
+		t.Fatalf("error polling: %v", err)
+	}
+	expected := []Event{
+		{
+			ID:          "preempt",
+			Kind:        EventKindSpotInterruption,
+			Description: "Preempt: ",
+			NotBefore:   time.Date(2026, 10, 17, 8, 22, 0, 0, time.FixedZone("GMT", 0)),
+		},
+		{
+			ID:          "redeploy",
+			Kind:        EventKindMaintenance,
+			Description: "Redeploy: ",
+		},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("expected %+v, got %+v", expected, events)
+	}
+	for i := range expected {
+		if events[i].ID != expected[i].ID || events[i].Kind != expected[i].Kind || !events[i].NotBefore.Equal(expected[i].NotBefore) {
+			t.Errorf("expected %+v, got %+v", expected[i], events[i])
+		}
+	}
+}
+
+// fakeWatcher returns fixed events.
+type fakeWatcher struct {
+	events []Event
+}
+
+func (w *fakeWatcher) Poll(ctx context.Context) ([]Event, error) {
+	return w.events, nil
+}
+
+func TestHandler(t *testing.T) {
+	grid := []struct {
+		description            string
+		event                  Event
+		drainMaintenanceEvents bool
+		expectDrain            bool
+	}{
+		{
+			description: "spot interruption",
+			event:       Event{ID: "spot", Kind: EventKindSpotInterruption},
+			expectDrain: true,
+		},
+		{
+			description:            "maintenance event",
+			event:                  Event{ID: "maintenance", Kind: EventKindMaintenance},
+			drainMaintenanceEvents: true,
+			expectDrain:            true,
+		},
+		{
+			description: "maintenance event not drained",
+			event:       Event{ID: "maintenance", Kind: EventKindMaintenance},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.description, func(t *testing.T) {
+			ctx := context.Background()
+
+			kubeClient := fake.NewClientset(
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "workload", Namespace: "default"},
+					Spec:       corev1.PodSpec{NodeName: "node"},
+				},
+			)
+			// Without the eviction subresource, the drain deletes the pods
+			kubeClient.Resources = []*metav1.APIResourceList{
+				{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "pods", Kind: "Pod"}}},
+			}
+
+			h := NewHandler(kubeClient, &fakeWatcher{events: []Event{g.event}}, &Options{
+				NodeName:               "node",
+				DrainMaintenanceEvents: g.drainMaintenanceEvents,
+				DrainTimeout:           time.Minute,
+			})
+			h.poll(ctx)
+
+			if !h.handled[g.event.ID] {
+				t.Errorf("expected event %q to be handled", g.event.ID)
+			}
+			node, err := kubeClient.CoreV1().Nodes().Get(ctx, "node", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("error getting node: %v", err)
+			}
+			if node.Spec.Unschedulable != g.expectDrain {
+				t.Errorf("expected node unschedulable to be %v, got %v", g.expectDrain, node.Spec.Unschedulable)
+			}
+			_, err = kubeClient.CoreV1().Pods("default").Get(ctx, "workload", metav1.GetOptions{})
+			if g.expectDrain && !apierrors.IsNotFound(err) {
+				t.Errorf("expected the pod to be removed, got %v", err)
+			}
+			if !g.expectDrain && err != nil {
+				t.Errorf("expected the pod to be kept, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interruption
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	eventsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "kops",
+		Subsystem: "interruption_handler",
+		Name:      "events_total",
+		Help:      "Number of interruption notices received, by kind.",
+	}, []string{"kind"})
+
+	drainsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "kops",
+		Subsystem: "interruption_handler",
+		Name:      "drains_total",
+		Help:      "Number of node drains started for interruption notices, by result.",
+	}, []string{"result"})
+
+	pollErrorsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "kops",
+		Subsystem: "interruption_handler",
+		Name:      "poll_errors_total",
+		Help:      "Number of errors reading the interruption notices from the metadata service.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(eventsTotal, drainsTotal, pollErrorsTotal)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/awslog"
+)
+
+// awsTagInstanceGroupName is the tag kOps sets on the autoscaling group of an instance group.
+const awsTagInstanceGroupName = "kops.k8s.io/instancegroup"
+
+type awsScaler struct {
+	clusterName string
+	autoscaling *autoscaling.Client
+}
+
+var _ Scaler = &awsScaler{}
+
+// NewAWSScaler builds a Scaler that sets the desired capacity of the autoscaling groups of instance groups.
+func NewAWSScaler(ctx context.Context, clusterName string, region string) (Scaler, error) {
+	config, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(region), awslog.WithAWSLogger())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config: %w", err)
+	}
+
+	return &awsScaler{
+		clusterName: clusterName,
+		autoscaling: autoscaling.NewFromConfig(config),
+	}, nil
+}
+
+// SetDesiredSize implements Scaler.
+func (s *awsScaler) SetDesiredSize(ctx context.Context, ig *kops.InstanceGroup, size int32) (int32, error) {
+	request := &autoscaling.DescribeAutoScalingGroupsInput{
+		Filters: []autoscalingtypes.Filter{
+			{
+				Name:   aws.String("tag:" + awsup.TagClusterName),
+				Values: []string{s.clusterName},
+			},
+			{
+				Name:   aws.String("tag:" + awsTagInstanceGroupName),
+				Values: []string{ig.Name},
+			},
+		},
+	}
+	var groups []autoscalingtypes.AutoScalingGroup
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(s.autoscaling, request)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("error listing autoscaling groups: %w", err)
+		}
+		groups = append(groups, page.AutoScalingGroups...)
+	}
+	if len(groups) != 1 {
+		return 0, fmt.Errorf("found %d autoscaling groups for instance group %q, expected 1", len(groups), ig.Name)
+	}
+	group := groups[0]
+
+	_, err := s.autoscaling.SetDesiredCapacity(ctx, &autoscaling.SetDesiredCapacityInput{
+		AutoScalingGroupName: group.AutoScalingGroupName,
+		DesiredCapacity:      aws.Int32(size),
+		HonorCooldown:        aws.Bool(false),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error setting desired capacity of autoscaling group %q: %w", aws.ToString(group.AutoScalingGroupName), err)
+	}
+
+	return aws.ToInt32(group.DesiredCapacity), nil
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/v1alpha2"
+	"k8s.io/kops/pkg/client/simple"
+	pb "k8s.io/kops/proto/kops/instancegroups/v1"
+)
+
+const (
+	// EventReasonScaled is the reason of the audit event recorded when an instance group is scaled.
+	EventReasonScaled = "Scaled"
+	// EventReasonScaleFailed is the reason of the audit event recorded when scaling an instance group failed.
+	EventReasonScaleFailed = "ScaleFailed"
+)
+
+// Scaler changes the size of instance groups through the cloud provider.
+type Scaler interface {
+	// SetDesiredSize sets the desired number of instances of the instance group, and returns the previous desired number.
+	SetDesiredSize(ctx context.Context, ig *kops.InstanceGroup, size int32) (int32, error)
+}
+
+// Service implements the InstanceGroupService, which scales instance groups on behalf of in-cluster tools.
+// Callers authenticate with a Kubernetes bearer token, and must be allowed to update the instancegroups/scale
+// subresource of the instance group in the kops.k8s.io API group.
+type Service struct {
+	pb.UnimplementedInstanceGroupServiceServer
+
+	clusterName string
+
+	// clientset reads the instance groups from the state store
+	clientset simple.Clientset
+
+	// kubeClient reviews the tokens and the permissions of callers
+	kubeClient kubernetes.Interface
+
+	// scaler applies the requests through the cloud provider
+	scaler Scaler
+
+	// recorder records the audit events
+	recorder events.EventRecorder
+}
+
+var _ pb.InstanceGroupServiceServer = &Service{}
+
+// NewService is the constructor for a Service.
+func NewService(clusterName string, clientset simple.Clientset, kubeClient kubernetes.Interface, scaler Scaler, recorder events.EventRecorder) *Service {
+	return &Service{
+		clusterName: clusterName,
+		clientset:   clientset,
+		kubeClient:  kubeClient,
+		scaler:      scaler,
+		recorder:    recorder,
+	}
+}
+
+// ScaleInstanceGroup sets the desired number of instances of an instance group.
+func (s *Service) ScaleInstanceGroup(ctx context.Context, req *pb.ScaleInstanceGroupRequest) (*pb.ScaleInstanceGroupResponse, error) {
+	if req.GetInstanceGroup() == "" {
+		return nil, status.Error(codes.InvalidArgument, "instance_group is required")
+	}
+	if req.GetDesiredSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "desired_size must not be negative")
+	}
+
+	user, err := s.authorize(ctx, req.GetInstanceGroup())
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := s.clientset.GetCluster(ctx, s.clusterName)
+	if err != nil {
+		klog.Warningf("error getting cluster %q: %v", s.clusterName, err)
+		return nil, status.Error(codes.Internal, "error getting cluster")
+	}
+	ig, err := s.clientset.InstanceGroupsFor(cluster).Get(ctx, req.GetInstanceGroup(), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "instance group %q not found", req.GetInstanceGroup())
+		}
+		klog.Warningf("error getting instance group %q: %v", req.GetInstanceGroup(), err)
+		return nil, status.Error(codes.Internal, "error getting instance group")
+	}
+
+	// The size of the control plane and of the bastions is not something to leave to autoscalers.
+	if ig.Spec.Role != kops.InstanceGroupRoleNode {
+		return nil, status.Errorf(codes.FailedPrecondition, "instance group %q has role %q, only instance groups with role %q can be scaled", ig.Name, ig.Spec.Role, kops.InstanceGroupRoleNode)
+	}
+	if ig.Spec.MinSize != nil && req.GetDesiredSize() < *ig.Spec.MinSize {
+		return nil, status.Errorf(codes.OutOfRange, "desired_size %d is less than the minSize %d of instance group %q", req.GetDesiredSize(), *ig.Spec.MinSize, ig.Name)
+	}
+	if ig.Spec.MaxSize != nil && req.GetDesiredSize() > *ig.Spec.MaxSize {
+		return nil, status.Errorf(codes.OutOfRange, "desired_size %d is greater than the maxSize %d of instance group %q", req.GetDesiredSize(), *ig.Spec.MaxSize, ig.Name)
+	}
+
+	previousSize, err := s.scaler.SetDesiredSize(ctx, ig, req.GetDesiredSize())
+	if err != nil {
+		klog.Warningf("%s failed to scale instance group %q to %d: %v", user, ig.Name, req.GetDesiredSize(), err)
+		s.recordEvent(ig.Name, corev1.EventTypeWarning, EventReasonScaleFailed, "%s failed to scale instance group to %d: %v (reason: %q)", user, req.GetDesiredSize(), err, req.GetReason())
+		return nil, status.Errorf(codes.Internal, "error scaling instance group %q", ig.Name)
+	}
+
+	klog.Infof("%s scaled instance group %q from %d to %d (reason: %q)", user, ig.Name, previousSize, req.GetDesiredSize(), req.GetReason())
+	s.recordEvent(ig.Name, corev1.EventTypeNormal, EventReasonScaled, "%s scaled instance group from %d to %d (reason: %q)", user, previousSize, req.GetDesiredSize(), req.GetReason())
+
+	return &pb.ScaleInstanceGroupResponse{
+		PreviousSize: previousSize,
+		DesiredSize:  req.GetDesiredSize(),
+	}, nil
+}
+
+// authorize authenticates the bearer token of the request, and checks that the caller may scale the instance group.
+// It returns the name of the caller.
+func (s *Service) authorize(ctx context.Context, igName string) (string, error) {
+	token := bearerToken(ctx)
+	if token == "" {
+		return "", status.Error(codes.Unauthenticated, "bearer token is required")
+	}
+
+	tokenReview := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token: token,
+		},
+	}
+	tokenReview, err := s.kubeClient.AuthenticationV1().TokenReviews().Create(ctx, tokenReview, metav1.CreateOptions{})
+	if err != nil {
+		klog.Warningf("error reviewing token: %v", err)
+		return "", status.Error(codes.Internal, "error reviewing token")
+	}
+	if !tokenReview.Status.Authenticated {
+		return "", status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+	userInfo := tokenReview.Status.User
+
+	extra := make(map[string]authorizationv1.ExtraValue)
+	for k, v := range userInfo.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	accessReview := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:       kops.GroupName,
+				Resource:    "instancegroups",
+				Subresource: "scale",
+				Verb:        "update",
+				Name:        igName,
+			},
+			User:   userInfo.Username,
+			Groups: userInfo.Groups,
+			UID:    userInfo.UID,
+			Extra:  extra,
+		},
+	}
+	accessReview, err = s.kubeClient.AuthorizationV1().SubjectAccessReviews().Create(ctx, accessReview, metav1.CreateOptions{})
+	if err != nil {
+		klog.Warningf("error reviewing access of %q: %v", userInfo.Username, err)
+		return "", status.Error(codes.Internal, "error reviewing access")
+	}
+	if !accessReview.Status.Allowed {
+		klog.Infof("%s is not allowed to scale instance group %q", userInfo.Username, igName)
+		return "", status.Errorf(codes.PermissionDenied, "%s is not allowed to scale instance group %q", userInfo.Username, igName)
+	}
+
+	return userInfo.Username, nil
+}
+
+// recordEvent records an audit event for the instance group.
+func (s *Service) recordEvent(igName string, eventType, reason, note string, args ...interface{}) {
+	ref := &corev1.ObjectReference{
+		APIVersion: v1alpha2.SchemeGroupVersion.String(),
+		Kind:       "InstanceGroup",
+		Namespace:  metav1.NamespaceSystem,
+		Name:       igName,
+	}
+	s.recorder.Eventf(ref, nil, eventType, reason, "ScaleInstanceGroup", note, args...)
+}
+
+// bearerToken returns the bearer token from the authorization metadata of the request.
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get("authorization") {
+		if token, found := strings.CutPrefix(value, "Bearer "); found {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
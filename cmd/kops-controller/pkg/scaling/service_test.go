@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaling
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/testutils"
+	pb "k8s.io/kops/proto/kops/instancegroups/v1"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+type fakeScaler struct {
+	sizes map[string]int32
+}
+
+func (s *fakeScaler) SetDesiredSize(ctx context.Context, ig *kops.InstanceGroup, size int32) (int32, error) {
+	previous := s.sizes[ig.Name]
+	s.sizes[ig.Name] = size
+	return previous, nil
+}
+
+func TestScaleInstanceGroup(t *testing.T) {
+	t.Setenv("SKIP_REGION_CHECK", "1")
+	ctx := context.Background()
+
+	vfsContext := vfs.NewVFSContext()
+	vfsContext.ResetMemfsContext(true)
+	basePath, err := vfsContext.BuildVfsPath("memfs://tests")
+	if err != nil {
+		t.Fatalf("error building vfspath: %v", err)
+	}
+	clientset := vfsclientset.NewVFSClientset(vfsContext, basePath)
+
+	cluster, err := clientset.CreateCluster(ctx, testutils.BuildMinimalClusterAWS("minimal.example.com"))
+	if err != nil {
+		t.Fatalf("error creating cluster: %v", err)
+	}
+	nodes := testutils.BuildMinimalNodeInstanceGroup("nodes", "subnet-us-test-1a")
+	nodes.Spec.MinSize = new(int32(1))
+	nodes.Spec.MaxSize = new(int32(5))
+	controlPlane := testutils.BuildMinimalMasterInstanceGroup("subnet-us-test-1a")
+	for _, ig := range []*kops.InstanceGroup{&nodes, &controlPlane} {
+		if _, err := clientset.InstanceGroupsFor(cluster).Create(ctx, ig, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("error creating instance group: %v", err)
+		}
+	}
+
+	kubeClient := fake.NewClientset()
+	kubeClient.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		switch review.Spec.Token {
+		case "autoscaler-token":
+			review.Status.Authenticated = true
+			review.Status.User.Username = "system:serviceaccount:kube-system:autoscaler"
+		case "other-token":
+			review.Status.Authenticated = true
+			review.Status.User.Username = "system:serviceaccount:default:other"
+		}
+		return true, review, nil
+	})
+	kubeClient.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = review.Spec.User == "system:serviceaccount:kube-system:autoscaler" &&
+			attributes.Group == "kops.k8s.io" && attributes.Resource == "instancegroups" && attributes.Subresource == "scale" && attributes.Verb == "update"
+		return true, review, nil
+	})
+
+	scaler := &fakeScaler{sizes: map[string]int32{"nodes": 2, "master-subnet-us-test-1a": 1}}
+	recorder := events.NewFakeRecorder(10)
+	service := NewService(cluster.Name, clientset, kubeClient, scaler, recorder)
+
+	grid := []struct {
+		name          string
+		token         string
+		request       *pb.ScaleInstanceGroupRequest
+		expectedCode  codes.Code
+		expectedSizes map[string]int32
+		expectedEvent string
+	}{
+		{
+			name:         "no token",
+			request:      &pb.ScaleInstanceGroupRequest{InstanceGroup: "nodes", DesiredSize: 3},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "invalid token",
+			token:        "invalid-token",
+			request:      &pb.ScaleInstanceGroupRequest{InstanceGroup: "nodes", DesiredSize: 3},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "not allowed",
+			token:        "other-token",
+			request:      &pb.ScaleInstanceGroupRequest{InstanceGroup: "nodes", DesiredSize: 3},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "missing instance group",
+			token:        "autoscaler-token",
+			request:      &pb.ScaleInstanceGroupRequest{InstanceGroup: "missing", DesiredSize: 3},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "control plane",
+			token:        "autoscaler-token",
+			request:      &pb.ScaleInstanceGroupRequest{InstanceGroup: "master-subnet-us-test-1a", DesiredSize: 1},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "above maxSize",
+			token:        "autoscaler-token",
+			request:      &pb.ScaleInstanceGroupRequest{InstanceGroup: "nodes", DesiredSize: 6},
+			expectedCode: codes.OutOfRange,
+		},
+		{
+			name:         "below minSize",
+			token:        "autoscaler-token",
+			request:      &pb.ScaleInstanceGroupRequest{InstanceGroup: "nodes", DesiredSize: 0},
+			expectedCode: codes.OutOfRange,
+		},
+		{
+			name:          "scaled",
+			token:         "autoscaler-token",
+			request:       &pb.ScaleInstanceGroupRequest{InstanceGroup: "nodes", DesiredSize: 4, Reason: "load test"},
+			expectedCode:  codes.OK,
+			expectedSizes: map[string]int32{"nodes": 4, "master-subnet-us-test-1a": 1},
+			expectedEvent: `Normal Scaled system:serviceaccount:kube-system:autoscaler scaled instance group from 2 to 4 (reason: "load test")`,
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			ctx := ctx
+			if g.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+g.token))
+			}
+
+			response, err := service.ScaleInstanceGroup(ctx, g.request)
+			if code := status.Code(err); code != g.expectedCode {
+				t.Fatalf("expected code %v, got %v (err %v)", g.expectedCode, code, err)
+			}
+			if err != nil {
+				select {
+				case event := <-recorder.Events:
+					t.Errorf("unexpected event %q", event)
+				default:
+				}
+				return
+			}
+
+			if response.DesiredSize != g.request.DesiredSize {
+				t.Errorf("expected desired size %d, got %d", g.request.DesiredSize, response.DesiredSize)
+			}
+			for name, size := range g.expectedSizes {
+				if scaler.sizes[name] != size {
+					t.Errorf("expected size %d for instance group %q, got %d", size, name, scaler.sizes[name])
+				}
+			}
+			select {
+			case event := <-recorder.Events:
+				if event != g.expectedEvent {
+					t.Errorf("expected event %q, got %q", g.expectedEvent, event)
+				}
+			default:
+				t.Errorf("expected event %q, got none", g.expectedEvent)
+			}
+		})
+	}
+}
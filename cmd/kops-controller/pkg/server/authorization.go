@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/bootstrap"
+)
+
+// certNamesForRole returns the certificates that nodes of an instance group role may request when bootstrapping.
+// Control plane nodes issue their own certificates, and bastions are not part of the cluster,
+// so they are not allowed to bootstrap through kops-controller.
+func certNamesForRole(role kops.InstanceGroupRole) sets.Set[string] {
+	switch role {
+	case kops.InstanceGroupRoleNode, kops.InstanceGroupRoleAPIServer:
+		return sets.New("kubelet", "kubelet-server", "kube-proxy", "kube-router", "etcd-client-cilium", "kops-controller-client")
+	default:
+		return sets.New[string]()
+	}
+}
+
+// authorizeCertNames returns an error if nodes of the role may not bootstrap, or may not request one of the certificates.
+func authorizeCertNames(role kops.InstanceGroupRole, names []string) error {
+	allowed := certNamesForRole(role)
+	if allowed.Len() == 0 {
+		return fmt.Errorf("role %q is not allowed to bootstrap", role)
+	}
+	for _, name := range names {
+		if !allowed.Has(name) {
+			return fmt.Errorf("certificate %q not allowed for role %q", name, role)
+		}
+	}
+	return nil
+}
+
+// getInstanceGroupRole returns the role of the instance group the verified node is a member of.
+func (s *Server) getInstanceGroupRole(ctx context.Context, id *bootstrap.VerifyResult) (kops.InstanceGroupRole, error) {
+	if id.InstanceGroupName == "" {
+		if id.CAPIMachine != nil {
+			// Instance groups built from Cluster API Machines always have role Node
+			return kops.InstanceGroupRoleNode, nil
+		}
+		return "", fmt.Errorf("did not find instance group for node %q", id.NodeName)
+	}
+
+	cluster, err := s.clientset.GetCluster(ctx, s.opt.ClusterName)
+	if err != nil {
+		return "", fmt.Errorf("getting cluster %q: %w", s.opt.ClusterName, err)
+	}
+	ig, err := s.clientset.InstanceGroupsFor(cluster).Get(ctx, id.InstanceGroupName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("getting instance group %q: %w", id.InstanceGroupName, err)
+	}
+	return ig.Spec.Role, nil
+}
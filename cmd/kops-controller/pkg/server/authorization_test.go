@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func TestAuthorizeCertNames(t *testing.T) {
+	nodeCerts := []string{"kubelet", "kubelet-server", "kube-proxy", "kube-router", "etcd-client-cilium", "kops-controller-client"}
+
+	grid := []struct {
+		name        string
+		role        kops.InstanceGroupRole
+		certNames   []string
+		expectError string
+	}{
+		{
+			name:      "node",
+			role:      kops.InstanceGroupRoleNode,
+			certNames: nodeCerts,
+		},
+		{
+			name:      "apiserver",
+			role:      kops.InstanceGroupRoleAPIServer,
+			certNames: nodeCerts,
+		},
+		{
+			name: "node without certificates",
+			role: kops.InstanceGroupRoleNode,
+		},
+		{
+			name:        "node requesting a control plane certificate",
+			role:        kops.InstanceGroupRoleNode,
+			certNames:   []string{"kubelet", "kube-controller-manager"},
+			expectError: `certificate "kube-controller-manager" not allowed for role "Node"`,
+		},
+		{
+			name:        "apiserver requesting an etcd certificate",
+			role:        kops.InstanceGroupRoleAPIServer,
+			certNames:   []string{"etcd-manager-main"},
+			expectError: `certificate "etcd-manager-main" not allowed for role "APIServer"`,
+		},
+		{
+			name:        "control plane",
+			role:        kops.InstanceGroupRoleControlPlane,
+			certNames:   []string{"kubelet"},
+			expectError: `role "ControlPlane" is not allowed to bootstrap`,
+		},
+		{
+			name:        "control plane without certificates",
+			role:        kops.InstanceGroupRoleControlPlane,
+			expectError: `role "ControlPlane" is not allowed to bootstrap`,
+		},
+		{
+			name:        "bastion",
+			role:        kops.InstanceGroupRoleBastion,
+			certNames:   []string{"kubelet"},
+			expectError: `role "Bastion" is not allowed to bootstrap`,
+		},
+		{
+			name:        "unknown role",
+			role:        "",
+			certNames:   []string{"kubelet"},
+			expectError: `role "" is not allowed to bootstrap`,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			err := authorizeCertNames(g.role, g.certNames)
+			if g.expectError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error %q, got none", g.expectError)
+			}
+			if err.Error() != g.expectError {
+				t.Errorf("expected error %q, got %q", g.expectError, err.Error())
+			}
+		})
+	}
+}
+
+func TestCertNamesForRole(t *testing.T) {
+	grid := []struct {
+		role     kops.InstanceGroupRole
+		expected []string
+	}{
+		{
+			role:     kops.InstanceGroupRoleNode,
+			expected: []string{"etcd-client-cilium", "kops-controller-client", "kube-proxy", "kube-router", "kubelet", "kubelet-server"},
+		},
+		{
+			role:     kops.InstanceGroupRoleAPIServer,
+			expected: []string{"etcd-client-cilium", "kops-controller-client", "kube-proxy", "kube-router", "kubelet", "kubelet-server"},
+		},
+		{
+			role: kops.InstanceGroupRoleControlPlane,
+		},
+		{
+			role: kops.InstanceGroupRoleBastion,
+		},
+	}
+	for _, g := range grid {
+		t.Run(string(g.role), func(t *testing.T) {
+			actual := sets.List(certNamesForRole(g.role))
+			if len(actual) != len(g.expected) || (len(actual) != 0 && !reflect.DeepEqual(actual, g.expected)) {
+				t.Errorf("expected %v, got %v", g.expected, actual)
+			}
+		})
+	}
+}
@@ -28,13 +28,22 @@ import (
 	"io"
 	"net/http"
 	"runtime/debug"
+	"strings"
 	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	cliflag "k8s.io/component-base/cli/flag"
 	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops-controller/pkg/certificatenames"
 	"k8s.io/kops/cmd/kops-controller/pkg/config"
 	"k8s.io/kops/cmd/kops-controller/pkg/controllerclientset"
 	"k8s.io/kops/pkg/apis/kops"
@@ -51,6 +60,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
+var tracer = otel.Tracer("k8s.io/kops/cmd/kops-controller/pkg/server")
+
 type Server struct {
 	opt         *config.Options
 	certNames   sets.Set[string]
@@ -70,17 +81,37 @@ type Server struct {
 
 	// challengeClient performs our callback-challenge into the node
 	challengeClient *bootstrap.ChallengeClient
+
+	// grpcServer serves the gRPC services registered with the server, on the same listener
+	grpcServer *grpc.Server
 }
 
 var _ manager.LeaderElectionRunnable = &Server{}
+var _ grpc.ServiceRegistrar = &Server{}
 
 func NewServer(vfsContext *vfs.VFSContext, opt *config.Options, verifier bootstrap.Verifier, uncachedClient client.Client) (*Server, error) {
+	minVersion, err := cliflag.TLSVersion(opt.Server.TLSMinVersion)
+	if err != nil {
+		return nil, fmt.Errorf("parsing TLS min version: %w", err)
+	}
+	cipherSuites, err := cliflag.TLSCipherSuites(opt.Server.TLSCipherSuites)
+	if err != nil {
+		return nil, fmt.Errorf("parsing TLS cipher suites: %w", err)
+	}
+
 	server := &http.Server{
 		Addr: opt.Server.Listen,
 		TLSConfig: &tls.Config{
-			MinVersion: tls.VersionTLS12,
+			MinVersion:   minVersion,
+			CipherSuites: cipherSuites,
 		},
 	}
+	if opt.Server.RequireClientCertificates {
+		// Nodes only have a client certificate after their first bootstrap, and a certificate issued
+		// by a CA that has since been rotated must not fail the handshake, so certificates are
+		// requested here and verified by the bootstrap handler.
+		server.TLSConfig.ClientAuth = tls.RequestClientCert
+	}
 
 	s := &Server{
 		opt:            opt,
@@ -121,16 +152,36 @@ func NewServer(vfsContext *vfs.VFSContext, opt *config.Options, verifier bootstr
 
 	r := http.NewServeMux()
 	r.Handle("/healthz", http.HandlerFunc(healthCheck))
-	r.Handle("/bootstrap", http.HandlerFunc(s.bootstrap))
-	server.Handler = recovery(r)
+	r.Handle("/bootstrap", otelhttp.NewHandler(http.HandlerFunc(s.bootstrap), "bootstrap"))
+	server.Handler = recovery(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if s.grpcServer != nil && req.ProtoMajor == 2 && strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc") {
+			s.grpcServer.ServeHTTP(w, req)
+			return
+		}
+		r.ServeHTTP(w, req)
+	}))
 
 	return s, nil
 }
 
+// RegisterService registers a gRPC service, which is served alongside the bootstrap API.
+// Services must be registered before the server is started.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	if s.grpcServer == nil {
+		s.grpcServer = grpc.NewServer()
+	}
+	s.grpcServer.RegisterService(desc, impl)
+}
+
 func (s *Server) GetClientset() simple.Clientset {
 	return s.clientset
 }
 
+// GetKeystore returns the keystore holding the CAs the server signs certificates with.
+func (s *Server) GetKeystore() pki.Keystore {
+	return s.keystore
+}
+
 func (s *Server) NeedLeaderElection() bool {
 	return false
 }
@@ -177,7 +228,9 @@ func (s *Server) bootstrap(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	id, err := s.verifier.VerifyToken(ctx, r, r.Header.Get("Authorization"), body)
+	verifyCtx, verifySpan := tracer.Start(ctx, "VerifyToken")
+	id, err := s.verifier.VerifyToken(verifyCtx, r, r.Header.Get("Authorization"), body)
+	verifySpan.End()
 	if err != nil {
 		// means that we should exit nodeup gracefully
 		if err == bootstrap.ErrAlreadyExists {
@@ -192,10 +245,25 @@ func (s *Server) bootstrap(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.String("kops.node_name", id.NodeName),
+		attribute.String("kops.instance_group", id.InstanceGroupName),
+	)
+
 	// Once the node is registered, we don't allow further registrations, this protects against a pod or escaped workload attempting to impersonate the node.
 	{
 		node := &corev1.Node{}
 		err := s.uncachedClient.Get(ctx, types.NamespacedName{Name: id.NodeName}, node)
+		if err == nil && s.opt.Server.RequireClientCertificates {
+			// A registered node bootstrapping again must also prove it holds the certificate it was issued,
+			// so that the cloud identity alone is not enough to obtain credentials for the node.
+			if err := s.verifyClientCertificate(r, id.NodeName); err != nil {
+				klog.Infof("bootstrap %s node %q client certificate err: %v", r.RemoteAddr, id.NodeName, err)
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte("failed to verify client certificate"))
+				return
+			}
+		}
 		if err == nil {
 			for _, condition := range node.Status.Conditions {
 				if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
@@ -214,6 +282,22 @@ func (s *Server) bootstrap(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// The certificates a node may request depend on the role of its instance group,
+	// so that a compromised worker can't request the certificates of other roles.
+	role, err := s.getInstanceGroupRole(ctx, id)
+	if err != nil {
+		klog.Infof("bootstrap %s error determining role of node %q: %v", r.RemoteAddr, id.NodeName, err)
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("failed to verify token"))
+		return
+	}
+	if err := authorizeCertNames(role, nil); err != nil {
+		klog.Infof("bootstrap %s node %q: %v", r.RemoteAddr, id.NodeName, err)
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("failed to verify token"))
+		return
+	}
+
 	req := &nodeup.BootstrapRequest{}
 	if err := json.Unmarshal(body, req); err != nil {
 		klog.Infof("bootstrap %s decode err: %v", r.RemoteAddr, err)
@@ -229,6 +313,13 @@ func (s *Server) bootstrap(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := authorizeCertNames(role, sets.List(sets.KeySet(req.Certs))); err != nil {
+		klog.Infof("bootstrap %s node %q: %v", r.RemoteAddr, id.NodeName, err)
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = fmt.Fprintf(w, "%v", err)
+		return
+	}
+
 	if model.UseChallengeCallback(kops.CloudProviderID(s.opt.Cloud)) {
 		if id.ChallengeEndpoint == "" {
 			klog.Infof("cannot determine endpoint for bootstrap callback challenge from %q", r.RemoteAddr)
@@ -244,6 +335,26 @@ func (s *Server) bootstrap(w http.ResponseWriter, r *http.Request) {
 		}
 
 		klog.Infof("performed successful callback challenge with %s; identified as %s", id.ChallengeEndpoint, id.NodeName)
+
+		if id.PinKey != nil {
+			if err := id.PinKey(ctx); err != nil {
+				klog.Infof("bootstrap %s failed to pin key of node %q: %v", r.RemoteAddr, id.NodeName, err)
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte("internal error"))
+				return
+			}
+			klog.Infof("pinned key of node %q", id.NodeName)
+		}
+	}
+
+	// The names are recorded so that the CertificateSigningRequests for the kubelet serving certificate can be verified later
+	if s.opt.SignKubeletServingCertificates && len(id.CertificateNames) > 0 {
+		if err := certificatenames.Record(ctx, s.uncachedClient, id.NodeName, id.CertificateNames); err != nil {
+			klog.Infof("bootstrap %s failed to record certificate names for %q: %v", r.RemoteAddr, id.NodeName, err)
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte("internal error"))
+			return
+		}
 	}
 
 	resp := &nodeup.BootstrapResponse{
@@ -335,6 +446,11 @@ func (s *Server) issueCert(ctx context.Context, name string, pubKey string, id *
 		issueReq.Subject = pkix.Name{
 			CommonName: rbac.KubeRouter,
 		}
+	case "kops-controller-client":
+		// The certificate only authenticates the node to kops-controller; it has no groups, so grants no access to the API
+		issueReq.Subject = pkix.Name{
+			CommonName: kopsControllerClientCommonName(id.NodeName),
+		}
 	default:
 		return "", fmt.Errorf("unexpected key name")
 	}
@@ -354,6 +470,44 @@ func (s *Server) issueCert(ctx context.Context, name string, pubKey string, id *
 	return cert.AsString()
 }
 
+// kopsControllerClientCommonName returns the common name of the kops-controller-client certificate of a node.
+func kopsControllerClientCommonName(nodeName string) string {
+	return "kops-controller-client:" + nodeName
+}
+
+// verifyClientCertificate verifies that the request presented the kops-controller-client certificate issued to the node.
+func (s *Server) verifyClientCertificate(r *http.Request, nodeName string) error {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return fmt.Errorf("no client certificate presented")
+	}
+
+	keyset, err := s.keystore.FindKeyset(r.Context(), fi.CertificateIDCA)
+	if err != nil || keyset == nil {
+		return fmt.Errorf("finding %q keyset: %v", fi.CertificateIDCA, err)
+	}
+	roots := x509.NewCertPool()
+	for _, item := range keyset.Items {
+		roots.AddCert(item.Certificate.Certificate)
+	}
+
+	leaf := r.TLS.PeerCertificates[0]
+	intermediates := x509.NewCertPool()
+	for _, cert := range r.TLS.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return fmt.Errorf("verifying client certificate: %w", err)
+	}
+	if leaf.Subject.CommonName != kopsControllerClientCommonName(nodeName) {
+		return fmt.Errorf("client certificate was issued to %q", leaf.Subject.CommonName)
+	}
+	return nil
+}
+
 // recovery is responsible for ensuring we don't exit on a panic.
 func recovery(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/admission"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func run(ctx context.Context) error {
+	listen := ":8443"
+	tlsCertFile := ""
+	tlsKeyFile := ""
+	stateStore := os.Getenv("KOPS_STATE_STORE")
+
+	flag.StringVar(&listen, "listen", listen, "address to serve the webhook on")
+	flag.StringVar(&tlsCertFile, "tls-cert-file", tlsCertFile, "path to the serving certificate")
+	flag.StringVar(&tlsKeyFile, "tls-private-key-file", tlsKeyFile, "path to the serving private key")
+	flag.StringVar(&stateStore, "state", stateStore, "state store used to look up the cluster of an instance group (optional)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage:\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "  %s [flags]             serve the validating webhook\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "  %s [flags] FILE...     validate manifests and exit\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	klog.InitFlags(nil)
+	flag.Parse()
+
+	validator := &admission.Validator{VFSContext: vfs.Context}
+	if stateStore != "" {
+		basePath, err := vfs.Context.BuildVfsPath(stateStore)
+		if err != nil {
+			return fmt.Errorf("error building state store path for %q: %w", stateStore, err)
+		}
+		validator.Clusters = vfsclientset.NewVFSClientset(vfs.Context, basePath)
+	}
+
+	if flag.NArg() != 0 {
+		return validateFiles(ctx, validator, os.Stdout, flag.Args())
+	}
+
+	if tlsCertFile == "" || tlsKeyFile == "" {
+		return fmt.Errorf("--tls-cert-file and --tls-private-key-file are required to serve the webhook")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/validate", validator)
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	})
+
+	server := &http.Server{
+		Addr:              listen,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	klog.Infof("listening on %s", listen)
+
+	if err := server.ListenAndServeTLS(tlsCertFile, tlsKeyFile); err != nil {
+		return fmt.Errorf("error listening on %q: %w", listen, err)
+	}
+
+	return fmt.Errorf("unexpected return from ListenAndServeTLS")
+}
+
+// validateFiles validates the Clusters and InstanceGroups in the given manifests,
+// returning an error if any of them is invalid.
+func validateFiles(ctx context.Context, validator *admission.Validator, out io.Writer, files []string) error {
+	invalid := 0
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("error reading %q: %w", file, err)
+		}
+		results, err := validator.ValidateManifest(ctx, data)
+		if err != nil {
+			return fmt.Errorf("error validating %q: %w", file, err)
+		}
+		for _, result := range results {
+			if len(result.Errors) == 0 {
+				fmt.Fprintf(out, "%s: %s %q is valid\n", file, result.Kind, result.Name)
+				continue
+			}
+			invalid++
+			fmt.Fprintf(out, "%s: %s %q is invalid:\n", file, result.Kind, result.Name)
+			for _, err := range result.Errors {
+				fmt.Fprintf(out, "  %v\n", err)
+			}
+		}
+	}
+	if invalid != 0 {
+		return fmt.Errorf("found %d invalid object(s)", invalid)
+	}
+	return nil
+}
+
+func main() {
+	if err := run(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,450 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kops/cmd/kops/util"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/diff"
+	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/pkg/kubeconfig"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/util/pkg/text"
+	"k8s.io/kops/util/pkg/vfs"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	applyLong = templates.LongDesc(i18n.T(`
+	Apply the Cluster and InstanceGroup manifests of one or more clusters.
+
+	The manifests are read from files, or from all the .yaml, .yml and .json files of directories.
+	They are compared with the state store to find the clusters that changed. With --yes, the
+	manifests of each changed cluster are written to the state store, creating the clusters that
+	do not exist yet, and the cluster is updated, or reconciled with --reconcile. A summary of
+	the result for each cluster is printed at the end.
+
+	Clusters and instance groups that are in the state store but not in the manifests are left unchanged.`))
+
+	applyExample = templates.Examples(i18n.T(`
+	# Show which clusters would change
+	kops apply -f clusters/
+
+	# Apply the changes and update the changed clusters
+	kops apply -f clusters/ --yes
+
+	# Apply the changes and reconcile the changed clusters, rolling their instances
+	kops apply -f clusters/ --yes --reconcile
+	`))
+
+	applyShort = i18n.T(`Apply cluster manifests and update the clusters that changed.`)
+)
+
+// ApplyOptions is the options for the apply command
+type ApplyOptions struct {
+	// Filenames are the files or directories containing the manifests
+	Filenames []string
+	// Yes applies the changes; without it only the changes are shown
+	Yes bool
+	// Reconcile reconciles the changed clusters instead of updating them
+	Reconcile bool
+	// IgnoreMaintenanceWindow rolls instance groups with Reconcile even outside the clusters' maintenance windows
+	IgnoreMaintenanceWindow bool
+
+	kubeconfig.CreateKubecfgOptions
+}
+
+// applyCluster holds the manifests of one cluster and how they differ from the state store
+type applyCluster struct {
+	Name string
+	// Cluster is the Cluster manifest, if any
+	Cluster *kopsapi.Cluster
+	// InstanceGroups are the InstanceGroup manifests
+	InstanceGroups []*kopsapi.InstanceGroup
+
+	// New is true if the cluster is not in the state store
+	New bool
+	// Changes are the differences with the state store, for each changed object
+	Changes []applyChange
+}
+
+// applyChange is the difference between a manifest and the object in the state store
+type applyChange struct {
+	Object string
+	Diff   string
+}
+
+// NewCmdApply returns a new apply command
+func NewCmdApply(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "apply {-f FILENAME}...",
+		Short:             applyShort,
+		Long:              applyLong,
+		Example:           applyExample,
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunApply(cmd.Context(), f, out, options)
+		},
+	}
+	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "A list of one or more files or directories separated by a comma.")
+	cmd.MarkFlagRequired("filename")
+	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Apply the changes, without --yes apply only shows the changes")
+	cmd.Flags().BoolVar(&options.Reconcile, "reconcile", options.Reconcile, "Reconcile the changed clusters by updating and rolling the control plane and nodes sequentially")
+	cmd.Flags().BoolVar(&options.IgnoreMaintenanceWindow, "ignore-maintenance-window", options.IgnoreMaintenanceWindow, "Roll instance groups with --reconcile even outside the maintenance windows of the clusters")
+	options.CreateKubecfgOptions.AddCommonFlags(cmd.Flags())
+
+	return cmd
+}
+
+// RunApply processes the apply command
+func RunApply(ctx context.Context, f *util.Factory, out io.Writer, options *ApplyOptions) error {
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	clusters, err := readApplyManifests(f.VFSContext(), options.Filenames)
+	if err != nil {
+		return err
+	}
+	if len(clusters) == 0 {
+		return fmt.Errorf("no Cluster or InstanceGroup manifests found")
+	}
+
+	var changed []*applyCluster
+	for _, c := range clusters {
+		if err := computeApplyChanges(ctx, clientset, c); err != nil {
+			return err
+		}
+		switch {
+		case c.New:
+			fmt.Fprintf(out, "cluster %s: new\n", c.Name)
+		case len(c.Changes) == 0:
+			fmt.Fprintf(out, "cluster %s: no changes\n", c.Name)
+			continue
+		default:
+			fmt.Fprintf(out, "cluster %s: changed\n", c.Name)
+		}
+		for _, change := range c.Changes {
+			fmt.Fprintf(out, "  %s\n", change.Object)
+			if !options.Yes {
+				fmt.Fprintf(out, "%s\n", change.Diff)
+			}
+		}
+		changed = append(changed, c)
+	}
+
+	if len(changed) == 0 {
+		fmt.Fprintf(out, "\nNo changes to apply\n")
+		return nil
+	}
+	if !options.Yes {
+		fmt.Fprintf(out, "\nMust specify --yes to apply changes to %d cluster(s)\n", len(changed))
+		return nil
+	}
+
+	results := make(map[string]error)
+	for _, c := range changed {
+		fmt.Fprintf(out, "\nApplying cluster %s\n", c.Name)
+		results[c.Name] = applyClusterManifests(ctx, f, out, clientset, c, options)
+		if results[c.Name] != nil {
+			fmt.Fprintf(out, "Error applying cluster %s: %v\n", c.Name, results[c.Name])
+		}
+	}
+
+	failed := 0
+	fmt.Fprintf(out, "\nSummary:\n")
+	for _, c := range clusters {
+		err, applied := results[c.Name]
+		switch {
+		case !applied:
+			fmt.Fprintf(out, "  %s: unchanged\n", c.Name)
+		case err != nil:
+			fmt.Fprintf(out, "  %s: failed: %v\n", c.Name, err)
+			failed++
+		case c.New:
+			fmt.Fprintf(out, "  %s: created\n", c.Name)
+		default:
+			fmt.Fprintf(out, "  %s: updated\n", c.Name)
+		}
+	}
+	if failed != 0 {
+		return fmt.Errorf("failed to apply %d of %d changed cluster(s)", failed, len(changed))
+	}
+	return nil
+}
+
+// readApplyManifests reads the Cluster and InstanceGroup manifests, grouped by cluster and sorted by cluster name
+func readApplyManifests(vfsContext *vfs.VFSContext, filenames []string) ([]*applyCluster, error) {
+	var files []string
+	for _, filename := range filenames {
+		expanded, err := expandManifestFiles(filename)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, expanded...)
+	}
+
+	clusters := make(map[string]*applyCluster)
+	getCluster := func(name string) *applyCluster {
+		if clusters[name] == nil {
+			clusters[name] = &applyCluster{Name: name}
+		}
+		return clusters[name]
+	}
+
+	for _, file := range files {
+		contents, err := vfsContext.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading file %q: %v", file, err)
+		}
+		for _, section := range text.SplitContentToSections(contents) {
+			if len(strings.TrimSpace(string(section))) == 0 {
+				continue
+			}
+			o, gvk, err := kopscodecs.Decode(section, nil)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing file %q: %v", file, err)
+			}
+
+			switch v := o.(type) {
+			case *kopsapi.Cluster:
+				c := getCluster(v.ObjectMeta.Name)
+				if c.Cluster != nil {
+					return nil, fmt.Errorf("cluster %q is defined more than once, in %q", v.ObjectMeta.Name, file)
+				}
+				c.Cluster = v
+
+			case *kopsapi.InstanceGroup:
+				clusterName := v.ObjectMeta.Labels[kopsapi.LabelClusterName]
+				if clusterName == "" {
+					return nil, fmt.Errorf("must specify %q label with cluster name to apply instanceGroup %q in %q", kopsapi.LabelClusterName, v.ObjectMeta.Name, file)
+				}
+				c := getCluster(clusterName)
+				if slices.ContainsFunc(c.InstanceGroups, func(ig *kopsapi.InstanceGroup) bool { return ig.ObjectMeta.Name == v.ObjectMeta.Name }) {
+					return nil, fmt.Errorf("instanceGroup %q of cluster %q is defined more than once, in %q", v.ObjectMeta.Name, clusterName, file)
+				}
+				c.InstanceGroups = append(c.InstanceGroups, v)
+
+			default:
+				return nil, fmt.Errorf("unhandled kind %q in %s, only Cluster and InstanceGroup can be applied", gvk, file)
+			}
+		}
+	}
+
+	var result []*applyCluster
+	for _, name := range slices.Sorted(maps.Keys(clusters)) {
+		result = append(result, clusters[name])
+	}
+	return result, nil
+}
+
+// expandManifestFiles returns the manifest files of filename, which can be a file or a local directory
+func expandManifestFiles(filename string) ([]string, error) {
+	stat, err := os.Stat(filename)
+	if err != nil || !stat.IsDir() {
+		// Files are read through the VFS context, so that they can also be remote
+		return []string{filename}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(filename, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != filename && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch filepath.Ext(path) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory %q: %v", filename, err)
+	}
+	return files, nil
+}
+
+// computeApplyChanges compares the manifests of the cluster with the state store
+func computeApplyChanges(ctx context.Context, clientset simple.Clientset, c *applyCluster) error {
+	live, err := clientset.GetCluster(ctx, c.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error fetching cluster %q: %v", c.Name, err)
+		}
+		live = nil
+	}
+
+	if live == nil {
+		if c.Cluster == nil {
+			return fmt.Errorf("cluster %q of the instance groups is not in the state store or in the manifests", c.Name)
+		}
+		c.New = true
+		rendered, err := diffableYAML(c.Cluster)
+		if err != nil {
+			return err
+		}
+		c.Changes = append(c.Changes, applyChange{Object: "cluster " + c.Name + " (new)", Diff: diff.FormatDiff("", rendered)})
+		for _, ig := range c.InstanceGroups {
+			rendered, err := diffableYAML(ig)
+			if err != nil {
+				return err
+			}
+			c.Changes = append(c.Changes, applyChange{Object: "instanceGroup " + ig.ObjectMeta.Name + " (new)", Diff: diff.FormatDiff("", rendered)})
+		}
+		return nil
+	}
+
+	if c.Cluster != nil {
+		change, err := compareApplyObject(live, c.Cluster)
+		if err != nil {
+			return err
+		}
+		if change != "" {
+			c.Changes = append(c.Changes, applyChange{Object: "cluster " + c.Name, Diff: change})
+		}
+	}
+
+	for _, ig := range c.InstanceGroups {
+		liveIG, err := clientset.InstanceGroupsFor(live).Get(ctx, ig.ObjectMeta.Name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("error fetching instanceGroup %q of cluster %q: %v", ig.ObjectMeta.Name, c.Name, err)
+			}
+			rendered, err := diffableYAML(ig)
+			if err != nil {
+				return err
+			}
+			c.Changes = append(c.Changes, applyChange{Object: "instanceGroup " + ig.ObjectMeta.Name + " (new)", Diff: diff.FormatDiff("", rendered)})
+			continue
+		}
+		change, err := compareApplyObject(liveIG, ig)
+		if err != nil {
+			return err
+		}
+		if change != "" {
+			c.Changes = append(c.Changes, applyChange{Object: "instanceGroup " + ig.ObjectMeta.Name, Diff: change})
+		}
+	}
+
+	return nil
+}
+
+// compareApplyObject returns the differences between the object in the state store and the manifest, or "" if they are the same
+func compareApplyObject(live, manifest runtime.Object) (string, error) {
+	current, err := diffableYAML(live)
+	if err != nil {
+		return "", err
+	}
+	desired, err := diffableYAML(manifest)
+	if err != nil {
+		return "", err
+	}
+	if current == desired {
+		return "", nil
+	}
+	return diff.FormatDiff(current, desired), nil
+}
+
+// applyClusterManifests writes the manifests of the cluster to the state store, then updates or reconciles the cluster
+func applyClusterManifests(ctx context.Context, f *util.Factory, out io.Writer, clientset simple.Clientset, c *applyCluster, options *ApplyOptions) error {
+	if c.Cluster != nil {
+		cloud, err := cloudup.BuildCloud(c.Cluster)
+		if err != nil {
+			return err
+		}
+		if c.New {
+			if err := cloudup.PerformAssignments(c.Cluster, f.VFSContext(), cloud); err != nil {
+				return fmt.Errorf("error populating configuration: %w", err)
+			}
+			if _, err := clientset.CreateCluster(ctx, c.Cluster); err != nil {
+				return fmt.Errorf("error creating cluster: %w", err)
+			}
+		} else {
+			status, err := cloud.FindClusterStatus(ctx, c.Cluster)
+			if err != nil {
+				return err
+			}
+			if _, err := clientset.UpdateCluster(ctx, c.Cluster, status); err != nil {
+				return fmt.Errorf("error replacing cluster: %w", err)
+			}
+		}
+	}
+
+	cluster, err := clientset.GetCluster(ctx, c.Name)
+	if err != nil {
+		return fmt.Errorf("error fetching cluster %q: %w", c.Name, err)
+	}
+	igClient := clientset.InstanceGroupsFor(cluster)
+	for _, ig := range c.InstanceGroups {
+		if _, err := igClient.Get(ctx, ig.ObjectMeta.Name, metav1.GetOptions{}); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("error fetching instanceGroup %q: %w", ig.ObjectMeta.Name, err)
+			}
+			if _, err := igClient.Create(ctx, ig, metav1.CreateOptions{}); err != nil {
+				return fmt.Errorf("error creating instanceGroup %q: %w", ig.ObjectMeta.Name, err)
+			}
+		} else {
+			if _, err := igClient.Update(ctx, ig, metav1.UpdateOptions{}); err != nil {
+				return fmt.Errorf("error replacing instanceGroup %q: %w", ig.ObjectMeta.Name, err)
+			}
+		}
+	}
+
+	if options.Reconcile {
+		opt := &ReconcileClusterOptions{}
+		opt.InitDefaults()
+		opt.ClusterName = c.Name
+		opt.Yes = true
+		opt.IgnoreMaintenanceWindow = options.IgnoreMaintenanceWindow
+		opt.CreateKubecfgOptions = options.CreateKubecfgOptions
+		return RunReconcileCluster(ctx, f, out, opt)
+	}
+
+	opt := &UpdateClusterOptions{}
+	opt.InitDefaults()
+	opt.ClusterName = c.Name
+	opt.Yes = true
+	// Exporting the kubeconfig of each cluster of a fleet would keep switching the current context
+	opt.CreateKubecfg = false
+	_, err = RunUpdateCluster(ctx, f, out, opt)
+	return err
+}
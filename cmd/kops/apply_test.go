@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/pkg/testutils"
+)
+
+func TestRunApplyShowsChanges(t *testing.T) {
+	h := testutils.NewIntegrationTestHarness(t)
+	defer h.Close()
+
+	ctx := context.Background()
+	f := util.NewFactory(&util.FactoryOptions{
+		RegistryPath: "memfs://tests",
+	})
+	clientset, err := f.KopsClient()
+	if err != nil {
+		t.Fatalf("error getting clientset: %v", err)
+	}
+
+	// Two clusters in the state store: one unchanged, one with a changed instance group
+	var manifests []runtime.Object
+	for _, name := range []string{"unchanged.example.com", "changed.example.com"} {
+		cluster := testutils.BuildMinimalClusterAWS(name)
+		cluster, err = clientset.CreateCluster(ctx, cluster)
+		if err != nil {
+			t.Fatalf("error creating cluster: %v", err)
+		}
+		ig := testutils.BuildMinimalNodeInstanceGroup("nodes", "subnet-us-test-1a")
+		if _, err := clientset.InstanceGroupsFor(cluster).Create(ctx, &ig, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("error creating instance group: %v", err)
+		}
+		created, err := clientset.InstanceGroupsFor(cluster).Get(ctx, "nodes", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("error getting instance group: %v", err)
+		}
+		if name == "changed.example.com" {
+			created.Spec.MaxSize = new(int32(5))
+		}
+		manifests = append(manifests, cluster, created)
+	}
+	// One cluster that is not in the state store
+	manifests = append(manifests, testutils.BuildMinimalClusterAWS("new.example.com"))
+
+	dir := t.TempDir()
+	for i, obj := range manifests {
+		b, err := kopscodecs.ToVersionedYaml(obj)
+		if err != nil {
+			t.Fatalf("error converting to yaml: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, string(rune('a'+i))+".yaml"), b, 0o644); err != nil {
+			t.Fatalf("error writing manifest: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a manifest"), 0o644); err != nil {
+		t.Fatalf("error writing file: %v", err)
+	}
+
+	var stdout bytes.Buffer
+	if err := RunApply(ctx, f, &stdout, &ApplyOptions{Filenames: []string{dir}}); err != nil {
+		t.Fatalf("error running apply: %v", err)
+	}
+
+	out := stdout.String()
+	for _, expected := range []string{
+		"cluster changed.example.com: changed\n  instanceGroup nodes\n",
+		"+   maxSize: 5",
+		"cluster new.example.com: new\n  cluster new.example.com (new)\n",
+		"cluster unchanged.example.com: no changes\n",
+		"Must specify --yes to apply changes to 2 cluster(s)",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, out)
+		}
+	}
+	if strings.Contains(out, "cluster changed.example.com\n") {
+		t.Errorf("unexpected change to the cluster object:\n%s", out)
+	}
+}
+
+func TestReadApplyManifestsErrors(t *testing.T) {
+	h := testutils.NewIntegrationTestHarness(t)
+	defer h.Close()
+
+	f := util.NewFactory(&util.FactoryOptions{
+		RegistryPath: "memfs://tests",
+	})
+
+	ig := testutils.BuildMinimalNodeInstanceGroup("nodes", "subnet-us-test-1a")
+	igYAML, err := kopscodecs.ToVersionedYaml(&ig)
+	if err != nil {
+		t.Fatalf("error converting to yaml: %v", err)
+	}
+	ig.ObjectMeta.Labels = map[string]string{kops.LabelClusterName: "example.com"}
+	labelledYAML, err := kopscodecs.ToVersionedYaml(&ig)
+	if err != nil {
+		t.Fatalf("error converting to yaml: %v", err)
+	}
+
+	for manifest, expected := range map[string]string{
+		string(igYAML): "must specify \"kops.k8s.io/cluster\" label",
+		string(labelledYAML) + "\n---\n" + string(labelledYAML):                             "is defined more than once",
+		"apiVersion: kops.k8s.io/v1alpha2\nkind: SSHCredential\nmetadata:\n  name: admin\n": "only Cluster and InstanceGroup can be applied",
+	} {
+		path := filepath.Join(t.TempDir(), "manifest.yaml")
+		if err := os.WriteFile(path, []byte(manifest), 0o644); err != nil {
+			t.Fatalf("error writing manifest: %v", err)
+		}
+		_, err := readApplyManifests(f.VFSContext(), []string{path})
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error containing %q, got %v", expected, err)
+		}
+	}
+}
@@ -1,5 +1,5 @@
 /*
-Copyright 2020 The Kubernetes Authors.
+Copyright 2026 The Kubernetes Authors.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -14,16 +14,26 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package commandutils
+package main
 
 import (
-	"fmt"
-	"os"
+	"io"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kubectl/pkg/util/i18n"
 )
 
-// ExitWithError will terminate execution with an error result
-// It prints the error to stderr and exits with a non-zero exit code
-func ExitWithError(err error) {
-	fmt.Fprintf(os.Stderr, "\n%v\n", err)
-	os.Exit(1)
+var cloneShort = i18n.T(`Clone a resource.`)
+
+func NewCmdClone(f *util.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clone",
+		Short: cloneShort,
+	}
+
+	// create subcommands
+	cmd.AddCommand(NewCmdCloneCluster(f, out))
+
+	return cmd
 }
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/apis/kops/registry"
+	"k8s.io/kops/pkg/apis/kops/validation"
+	"k8s.io/kops/pkg/commands"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	cloneClusterLong = templates.LongDesc(i18n.T(`
+	Create a new cluster from the spec, instance groups and additional objects of an existing cluster.
+
+	Occurrences of the name of the existing cluster in the copied objects, such as in DNS names,
+	state store paths and cloud labels, are replaced with the name of the new cluster.
+	The keys, secrets and SSH public keys of the existing cluster are not copied; the new cluster
+	gets its own keys when it is first updated.
+
+	Use --network-cidr to give the new cluster a different network. The subnets keep their position
+	within the network.`))
+
+	cloneClusterExample = templates.Examples(i18n.T(`
+	# Create a staging cluster from a production cluster, in a different network
+	kops clone cluster prod.example.com --new-name staging.example.com \
+	  --network-cidr 10.1.0.0/16 --cloud-labels Environment=staging
+
+	# Preview the new cluster without creating it
+	kops clone cluster prod.example.com --new-name staging.example.com --dry-run -o yaml
+	`))
+
+	cloneClusterShort = i18n.T(`Create a new cluster from an existing cluster.`)
+)
+
+type CloneClusterOptions struct {
+	ClusterName string
+
+	// NewName is the name of the new cluster
+	NewName string
+	// NetworkCIDR is the network CIDR of the new cluster
+	NetworkCIDR string
+	// DNSZone is the DNS zone of the new cluster
+	DNSZone string
+	// CloudLabels are cloud labels to set on the new cluster, in the format of kops create cluster --cloud-labels
+	CloudLabels string
+
+	// DryRun prints the new cluster instead of creating it
+	DryRun bool
+	// Output is the output format used with DryRun
+	Output string
+}
+
+func NewCmdCloneCluster(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &CloneClusterOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "cluster [CLUSTER]",
+		Short:             cloneClusterShort,
+		Long:              cloneClusterLong,
+		Example:           cloneClusterExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunCloneCluster(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.NewName, "new-name", options.NewName, "Name of the new cluster")
+	cmd.MarkFlagRequired("new-name")
+	cmd.RegisterFlagCompletionFunc("new-name", cobra.NoFileCompletions)
+	cmd.Flags().StringVar(&options.NetworkCIDR, "network-cidr", options.NetworkCIDR, "IPv4 network CIDR of the new cluster. Subnets keep their position within the network.")
+	cmd.RegisterFlagCompletionFunc("network-cidr", cobra.NoFileCompletions)
+	cmd.Flags().StringVar(&options.DNSZone, "dns-zone", options.DNSZone, "DNS hosted zone of the new cluster (defaults to the DNS zone of the existing cluster)")
+	cmd.RegisterFlagCompletionFunc("dns-zone", cobra.NoFileCompletions)
+	cmd.Flags().StringVar(&options.CloudLabels, "cloud-labels", options.CloudLabels, "A list of key/value pairs to add to or replace in the cloud labels of the new cluster (for example \"Environment=staging,Team=Some Team\").")
+	cmd.RegisterFlagCompletionFunc("cloud-labels", cobra.NoFileCompletions)
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", options.DryRun, "If true, only print the objects of the new cluster, without creating it.")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", OutputYaml, "Output format. One of json or yaml. Used with the --dry-run flag.")
+	cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{OutputJSON, OutputYaml}, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	return cmd
+}
+
+func RunCloneCluster(ctx context.Context, f *util.Factory, out io.Writer, options *CloneClusterOptions) error {
+	cloneOptions := &commands.CloneClusterOptions{
+		NewName:     options.NewName,
+		NetworkCIDR: options.NetworkCIDR,
+		DNSZone:     options.DNSZone,
+	}
+	if options.CloudLabels != "" {
+		cloudLabels, err := parseCloudLabels(options.CloudLabels)
+		if err != nil {
+			return fmt.Errorf("error parsing cloud labels: %w", err)
+		}
+		cloneOptions.CloudLabels = cloudLabels
+	}
+
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	instanceGroups, err := commands.ReadAllInstanceGroups(ctx, clientset, cluster)
+	if err != nil {
+		return err
+	}
+
+	addons, err := clientset.AddonsFor(cluster).List(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading additional objects: %w", err)
+	}
+
+	newCluster, newInstanceGroups, err := commands.CloneCluster(cluster, instanceGroups, cloneOptions)
+	if err != nil {
+		return err
+	}
+
+	if options.DryRun {
+		var obj []runtime.Object
+		obj = append(obj, newCluster)
+		for _, ig := range newInstanceGroups {
+			obj = append(obj, ig)
+		}
+		for _, o := range addons {
+			obj = append(obj, o.ToUnstructured())
+		}
+
+		switch options.Output {
+		case OutputYaml:
+			return fullOutputYAML(out, obj...)
+		case OutputJSON:
+			return fullOutputJSON(out, true, obj...)
+		default:
+			return fmt.Errorf("unsupported output type %q", options.Output)
+		}
+	}
+
+	if _, err := clientset.GetCluster(ctx, newCluster.ObjectMeta.Name); err == nil {
+		return fmt.Errorf("cluster %q already exists", newCluster.ObjectMeta.Name)
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	if err := validation.DeepValidate(newCluster, newInstanceGroups, false, clientset.VFSContext(), nil); err != nil {
+		return err
+	}
+
+	if err := registry.CreateClusterConfig(ctx, clientset, newCluster, newInstanceGroups, addons); err != nil {
+		return fmt.Errorf("error writing cluster configuration: %w", err)
+	}
+
+	fmt.Fprintf(out, "Created cluster %q from cluster %q\n", newCluster.ObjectMeta.Name, cluster.ObjectMeta.Name)
+	fmt.Fprintf(out, "\nAdd an SSH public key if the cloud provider requires one: kops create sshpublickey %s -i ~/.ssh/id_rsa.pub\n", newCluster.ObjectMeta.Name)
+	fmt.Fprintf(out, "Then review the cluster with: kops edit cluster --name %s\n", newCluster.ObjectMeta.Name)
+	fmt.Fprintf(out, "and create it with: kops update cluster --name %s --yes --admin\n", newCluster.ObjectMeta.Name)
+	return nil
+}
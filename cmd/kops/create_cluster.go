@@ -56,7 +56,9 @@ import (
 	"k8s.io/kops/pkg/zones"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
 	"k8s.io/kops/upup/pkg/fi/utils"
+	"k8s.io/kops/util/pkg/vfs"
 )
 
 type CreateClusterOptions struct {
@@ -245,6 +247,8 @@ func NewCmdCreateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 		return nil, cobra.ShellCompDirectiveNoFileComp
 	})
 
+	cmd.Flags().BoolVar(&options.PodIdentityWebhook, "pod-identity-webhook", options.PodIdentityWebhook, "Deploy the EKS Pod Identity Webhook, so that Pods use IRSA credentials without changing their specs. Requires --discovery-store, whose S3 bucket is created if it does not exist. Enables cert-manager.")
+
 	if featureflag.DiscoveryService.Enabled() {
 		cmd.Flags().StringVar(&options.PublicDiscoveryServiceURL, "discovery-service", options.PublicDiscoveryServiceURL, "A URL to a server implementing public OIDC discovery. Enables IRSA in AWS.")
 		cmd.RegisterFlagCompletionFunc("discovery-service", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -284,10 +288,9 @@ func NewCmdCreateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().StringSliceVar(&options.KubernetesFeatureGates, "kubernetes-feature-gates", options.KubernetesFeatureGates, "List of Kubernetes feature gates to enable/disable")
 	cmd.RegisterFlagCompletionFunc("kubernetes-version", completeKubernetesFeatureGates)
 
-	cmd.Flags().StringVar(&options.ContainerRuntime, "container-runtime", options.ContainerRuntime, "Container runtime to use: containerd")
-	cmd.Flags().MarkDeprecated("container-runtime", "containerd is the only supported value")
+	cmd.Flags().StringVar(&options.ContainerRuntime, "container-runtime", options.ContainerRuntime, "Container runtime to use: containerd, crio")
 	cmd.RegisterFlagCompletionFunc("container-runtime", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		return []string{"containerd"}, cobra.ShellCompDirectiveNoFileComp
+		return []string{api.ContainerRuntimeContainerd, api.ContainerRuntimeCRIO}, cobra.ShellCompDirectiveNoFileComp
 	})
 
 	cmd.Flags().StringVar(&sshPublicKey, "ssh-public-key", sshPublicKey, "SSH public key to use")
@@ -686,6 +689,10 @@ func RunCreateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Cr
 		cluster.Spec.DNSZone = c.DNSZone
 	}
 
+	if c.ContainerRuntime != "" {
+		cluster.Spec.ContainerRuntime = c.ContainerRuntime
+	}
+
 	for i, cidr := range c.NetworkCIDRs {
 		if i == 0 {
 			cluster.Spec.Networking.NetworkCIDR = cidr
@@ -727,6 +734,13 @@ func RunCreateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Cr
 		return err
 	}
 
+	// The discovery store must exist before the service account issuer can be derived from it
+	if c.PodIdentityWebhook && !c.DryRun {
+		if err := createDiscoveryStoreBucket(ctx, clientset.VFSContext(), cluster, cloud); err != nil {
+			return err
+		}
+	}
+
 	err = cloudup.PerformAssignments(cluster, clientset.VFSContext(), cloud)
 	if err != nil {
 		return fmt.Errorf("error populating configuration: %v", err)
@@ -777,6 +791,11 @@ func RunCreateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Cr
 		if err != nil {
 			return fmt.Errorf("validation of the full cluster and instance group specs failed: %w", err)
 		}
+
+		err = validation.ValidateMachineTypeAvailability(ctx, fullCluster, fullInstanceGroups, cloud).ToAggregate()
+		if err != nil {
+			return fmt.Errorf("validation of the machine types failed: %w", err)
+		}
 	}
 
 	if c.DryRun {
@@ -933,6 +952,37 @@ func RunCreateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Cr
 // checkProjectFlag rejects an explicitly empty --project flag. An empty value usually comes from
 // an unset environment variable (e.g. --project=$PROJECT); silently accepting it would fall back
 // to the gcloud default project, which may not be the intended one.
+// createDiscoveryStoreBucket creates the S3 bucket of the discovery store, if it does not exist yet.
+func createDiscoveryStoreBucket(ctx context.Context, vfsContext *vfs.VFSContext, cluster *api.Cluster, cloud fi.Cloud) error {
+	said := cluster.Spec.ServiceAccountIssuerDiscovery
+	if said == nil || said.DiscoveryStore == "" {
+		return nil
+	}
+	discoveryStore := said.DiscoveryStore
+	p, err := vfsContext.BuildVfsPath(discoveryStore)
+	if err != nil {
+		return fmt.Errorf("building VFS path for %q: %w", discoveryStore, err)
+	}
+	s3Path, ok := p.(*vfs.S3Path)
+	if !ok {
+		return nil
+	}
+
+	awsCloud := cloud.(awsup.AWSCloud)
+	_, partition, err := awsCloud.AccountInfo(ctx)
+	if err != nil {
+		return err
+	}
+	created, err := s3Path.CreatePublicBucket(ctx, awsCloud.Region(), partition)
+	if err != nil {
+		return err
+	}
+	if created {
+		klog.Infof("Created bucket %q for the discovery store", s3Path.Bucket())
+	}
+	return nil
+}
+
 func checkProjectFlag(flagSet bool, project string) error {
 	if flagSet && project == "" {
 		return fmt.Errorf("--project cannot be empty; specify a project or omit the flag to use the gcloud default project")
@@ -290,6 +290,11 @@ func RunCreateInstanceGroup(ctx context.Context, f *util.Factory, out io.Writer,
 			return err
 		}
 
+		err = validation.ValidateMachineTypeAvailability(ctx, cluster, []*kopsapi.InstanceGroup{group}, cloud).ToAggregate()
+		if err != nil {
+			return err
+		}
+
 		ig = group
 	}
 
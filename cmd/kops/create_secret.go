@@ -33,9 +33,11 @@ func NewCmdCreateSecret(f *util.Factory, out io.Writer) *cobra.Command {
 	}
 
 	// create subcommands
+	cmd.AddCommand(NewCmdCreateSecretAuditWebhookToken(f, out))
 	cmd.AddCommand(NewCmdCreateSecretCiliumPassword(f, out))
 	cmd.AddCommand(NewCmdCreateSecretDockerConfig(f, out))
 	cmd.AddCommand(NewCmdCreateSecretEncryptionConfig(f, out))
+	cmd.AddCommand(NewCmdCreateSecretEtcdBackupCredentials(f, out))
 
 	sshPublicKey := NewCmdCreateSSHPublicKey(f, out)
 	sshPublicKey.Hidden = true
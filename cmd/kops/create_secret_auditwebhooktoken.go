@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/model/components"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	createSecretAuditWebhookTokenLong = templates.LongDesc(i18n.T(`
+	Create the bearer token kube-apiserver sends to the audit webhook server
+	configured with kubeAPIServer.auditWebhook, and store it in the state store.
+	The file must contain the token on a single line.`))
+
+	createSecretAuditWebhookTokenExample = templates.Examples(i18n.T(`
+	# Create the audit webhook token.
+	kops create secret auditwebhooktoken -f token.txt \
+		--name k8s-cluster.example.com --state s3://my-state-store
+
+	# Replace the existing audit webhook token.
+	kops create secret auditwebhooktoken -f token.txt --force \
+		--name k8s-cluster.example.com --state s3://my-state-store
+	`))
+
+	createSecretAuditWebhookTokenShort = i18n.T(`Create the bearer token for the audit webhook.`)
+)
+
+type CreateSecretAuditWebhookTokenOptions struct {
+	ClusterName string
+	TokenPath   string
+	Force       bool
+}
+
+func NewCmdCreateSecretAuditWebhookToken(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &CreateSecretAuditWebhookTokenOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "auditwebhooktoken [CLUSTER] -f FILENAME",
+		Short:             createSecretAuditWebhookTokenShort,
+		Long:              createSecretAuditWebhookTokenLong,
+		Example:           createSecretAuditWebhookTokenExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunCreateSecretAuditWebhookToken(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.TokenPath, "filename", "f", "", "Path to the file containing the audit webhook token")
+	cmd.MarkFlagRequired("filename")
+	cmd.Flags().BoolVar(&options.Force, "force", options.Force, "Force replace the secret if it already exists")
+
+	return cmd
+}
+
+func RunCreateSecretAuditWebhookToken(ctx context.Context, f commandutils.Factory, out io.Writer, options *CreateSecretAuditWebhookTokenOptions) error {
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	secretStore, err := clientset.SecretStore(cluster)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if options.TokenPath == "-" {
+		data, err = ConsumeStdin()
+		if err != nil {
+			return fmt.Errorf("reading audit webhook token from stdin: %v", err)
+		}
+	} else {
+		data, err = os.ReadFile(options.TokenPath)
+		if err != nil {
+			return fmt.Errorf("reading audit webhook token %v: %v", options.TokenPath, err)
+		}
+	}
+
+	token, err := components.ParseAuditWebhookToken(data)
+	if err != nil {
+		return err
+	}
+
+	secret := &fi.Secret{
+		Data: []byte(token),
+	}
+
+	if !options.Force {
+		_, created, err := secretStore.GetOrCreateSecret(ctx, components.AuditWebhookTokenSecretName, secret)
+		if err != nil {
+			return fmt.Errorf("error adding audit webhook token secret: %v", err)
+		}
+		if !created {
+			return fmt.Errorf("failed to create the audit webhook token secret as it already exists. Pass the `--force` flag to replace an existing secret")
+		}
+	} else {
+		_, err := secretStore.ReplaceSecret(components.AuditWebhookTokenSecretName, secret)
+		if err != nil {
+			return fmt.Errorf("updating audit webhook token secret: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/model/components/etcdmanager"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	createSecretEtcdBackupCredentialsLong = templates.LongDesc(i18n.T(`
+	Create the credentials etcd-manager uses for etcd backup stores configured with backups.s3,
+	and store them in the state store.
+	The file must set accessKeyID and secretAccessKey.`))
+
+	createSecretEtcdBackupCredentialsExample = templates.Examples(i18n.T(`
+	# Create the etcd backup credentials.
+	kops create secret etcdbackupcredentials -f credentials.yaml \
+		--name k8s-cluster.example.com --state s3://my-state-store
+
+	# Replace existing etcd backup credentials.
+	kops create secret etcdbackupcredentials -f credentials.yaml --force \
+		--name k8s-cluster.example.com --state s3://my-state-store
+	`))
+
+	createSecretEtcdBackupCredentialsShort = i18n.T(`Create the credentials for etcd backup stores.`)
+)
+
+type CreateSecretEtcdBackupCredentialsOptions struct {
+	ClusterName     string
+	CredentialsPath string
+	Force           bool
+}
+
+func NewCmdCreateSecretEtcdBackupCredentials(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &CreateSecretEtcdBackupCredentialsOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "etcdbackupcredentials [CLUSTER] -f FILENAME",
+		Short:             createSecretEtcdBackupCredentialsShort,
+		Long:              createSecretEtcdBackupCredentialsLong,
+		Example:           createSecretEtcdBackupCredentialsExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunCreateSecretEtcdBackupCredentials(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.CredentialsPath, "filename", "f", "", "Path to the etcd backup credentials file")
+	cmd.MarkFlagRequired("filename")
+	cmd.RegisterFlagCompletionFunc("filename", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"yaml", "json"}, cobra.ShellCompDirectiveFilterFileExt
+	})
+	cmd.Flags().BoolVar(&options.Force, "force", options.Force, "Force replace the secret if it already exists")
+
+	return cmd
+}
+
+func RunCreateSecretEtcdBackupCredentials(ctx context.Context, f commandutils.Factory, out io.Writer, options *CreateSecretEtcdBackupCredentialsOptions) error {
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	secretStore, err := clientset.SecretStore(cluster)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if options.CredentialsPath == "-" {
+		data, err = ConsumeStdin()
+		if err != nil {
+			return fmt.Errorf("reading etcd backup credentials from stdin: %v", err)
+		}
+	} else {
+		data, err = os.ReadFile(options.CredentialsPath)
+		if err != nil {
+			return fmt.Errorf("reading etcd backup credentials %v: %v", options.CredentialsPath, err)
+		}
+	}
+
+	if _, err := etcdmanager.ParseBackupCredentials(data); err != nil {
+		return err
+	}
+
+	secret := &fi.Secret{
+		Data: data,
+	}
+
+	if !options.Force {
+		_, created, err := secretStore.GetOrCreateSecret(ctx, etcdmanager.BackupCredentialsSecretName, secret)
+		if err != nil {
+			return fmt.Errorf("error adding etcd backup credentials secret: %v", err)
+		}
+		if !created {
+			return fmt.Errorf("failed to create the etcd backup credentials secret as it already exists. Pass the `--force` flag to replace an existing secret")
+		}
+	} else {
+		_, err := secretStore.ReplaceSecret(etcdmanager.BackupCredentialsSecretName, secret)
+		if err != nil {
+			return fmt.Errorf("updating etcd backup credentials secret: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -40,14 +42,19 @@ import (
 )
 
 type DeleteClusterOptions struct {
-	Yes         bool
-	Region      string
-	External    bool
-	Unregister  bool
-	ClusterName string
-	wait        time.Duration
-	count       int
-	interval    time.Duration
+	Yes          bool
+	Region       string
+	External     bool
+	Unregister   bool
+	PruneOrphans bool
+	// DryRun prints the deletion plan instead of deleting the cluster
+	DryRun bool
+	// ExcludeResources are filters of the form TYPE:VALUE for the resources that should not be deleted
+	ExcludeResources []string
+	ClusterName      string
+	wait             time.Duration
+	count            int
+	interval         time.Duration
 }
 
 func (o *DeleteClusterOptions) InitDefaults() {
@@ -60,6 +67,11 @@ var (
 	deleteClusterLong = templates.LongDesc(i18n.T(`
 	Deletes a Kubernetes cluster and all associated resources.  Resources include instancegroups,
 	secrets, and the state store.  There is no "UNDO" for this command.
+
+	Once the cluster resources are deleted, kOps looks in every region for resources that
+	are still tagged for the cluster, such as load balancers created for Services or volumes
+	created for PersistentVolumes, and reports them with their estimated monthly cost.
+	Specify --prune-orphans to delete them as well.
 	`))
 
 	deleteClusterExample = templates.Examples(i18n.T(`
@@ -67,6 +79,15 @@ var (
 	# The --yes option runs the command immediately.
 	kops delete cluster --name=k8s.cluster.site --yes
 
+	# Show the order in which resources would be deleted, and why they belong to the cluster.
+	kops delete cluster --name=k8s.cluster.site --dry-run
+
+	# Delete a cluster, but keep a volume and all the load balancers.
+	kops delete cluster --name=k8s.cluster.site --yes --exclude-resource volume:vol-0123456789abcdef0 --exclude-resource 'load-balancer:*'
+
+	# Delete a cluster, including the resources left behind by Kubernetes controllers.
+	kops delete cluster --name=k8s.cluster.site --yes --prune-orphans
+
 	`))
 
 	deleteClusterShort = i18n.T("Delete a cluster.")
@@ -91,6 +112,9 @@ func NewCmdDeleteCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Specify --yes to delete the cluster")
 	cmd.Flags().BoolVar(&options.Unregister, "unregister", options.Unregister, "Don't delete cloud resources, just unregister the cluster")
 	cmd.Flags().BoolVar(&options.External, "external", options.External, "Delete an external cluster")
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", options.DryRun, "If true, only print the resources that would be deleted, in dependency order, and why they belong to the cluster")
+	cmd.Flags().StringSliceVar(&options.ExcludeResources, "exclude-resource", options.ExcludeResources, "Resources to keep, as TYPE:VALUE where VALUE matches the ID or name. Both parts can use shell patterns. Resources that depend on them are also kept")
+	cmd.Flags().BoolVar(&options.PruneOrphans, "prune-orphans", options.PruneOrphans, "Delete resources still tagged for the cluster in any region once the cluster has been deleted")
 
 	cmd.Flags().StringVar(&options.Region, "region", options.Region, "External cluster's cloud region")
 	cmd.RegisterFlagCompletionFunc("region", completeRegion)
@@ -165,6 +189,19 @@ func RunDeleteCluster(ctx context.Context, f *util.Factory, out io.Writer, optio
 			clusterResources[k] = resource
 		}
 
+		excluded, err := resourceops.ExcludeResources(clusterResources, options.ExcludeResources)
+		if err != nil {
+			return err
+		}
+
+		if options.DryRun {
+			return renderDeletionPlan(out, clusterName, allResources, clusterResources, excluded)
+		}
+
+		if len(excluded) != 0 {
+			fmt.Fprintf(out, "Excluding %d resources from deletion; use --dry-run to see them\n\n", len(excluded))
+		}
+
 		if len(clusterResources) == 0 {
 			fmt.Fprintf(out, "No cloud resources to delete\n")
 		} else {
@@ -202,6 +239,16 @@ func RunDeleteCluster(ctx context.Context, f *util.Factory, out io.Writer, optio
 				return err
 			}
 		}
+
+		if options.Yes {
+			if err := sweepOrphanedResources(ctx, cloud, clusterName, out, options); err != nil {
+				return err
+			}
+		}
+	}
+
+	if options.DryRun {
+		return nil
 	}
 
 	if !options.External {
@@ -234,6 +281,148 @@ func RunDeleteCluster(ctx context.Context, f *util.Factory, out io.Writer, optio
 	return nil
 }
 
+// plannedResource is a row of the deletion plan
+type plannedResource struct {
+	Phase    string
+	Resource *resources.Resource
+	Reason   string
+}
+
+// renderDeletionPlan prints the resources that would be deleted, in the order they would be deleted,
+// followed by the resources that were found but will not be deleted
+func renderDeletionPlan(out io.Writer, clusterName string, allResources, clusterResources map[string]*resources.Resource, excluded map[string]string) error {
+	reasonOf := func(r *resources.Resource) string {
+		if r.Reason == "" {
+			return "-"
+		}
+		return r.Reason
+	}
+
+	t := &tables.Table{}
+	t.AddColumn("PHASE", func(p *plannedResource) string {
+		return p.Phase
+	})
+	t.AddColumn("TYPE", func(p *plannedResource) string {
+		return p.Resource.Type
+	})
+	t.AddColumn("ID", func(p *plannedResource) string {
+		return p.Resource.ID
+	})
+	t.AddColumn("NAME", func(p *plannedResource) string {
+		return p.Resource.Name
+	})
+	t.AddColumn("REASON", func(p *plannedResource) string {
+		return p.Reason
+	})
+
+	var planned []*plannedResource
+	for i, phase := range resourceops.PlanDeletion(clusterResources) {
+		for _, r := range phase {
+			planned = append(planned, &plannedResource{Phase: strconv.Itoa(i + 1), Resource: r, Reason: reasonOf(r)})
+		}
+	}
+	if len(planned) == 0 {
+		fmt.Fprintf(out, "No cloud resources to delete\n")
+	} else {
+		fmt.Fprintf(out, "Resources that would be deleted for cluster %q, in order:\n\n", clusterName)
+		if err := t.Render(planned, out, "PHASE", "TYPE", "NAME", "ID", "REASON"); err != nil {
+			return err
+		}
+	}
+
+	var kept []*plannedResource
+	for k, r := range allResources {
+		if r.Shared {
+			kept = append(kept, &plannedResource{Resource: r, Reason: "shared"})
+		} else if reason, found := excluded[k]; found {
+			kept = append(kept, &plannedResource{Resource: r, Reason: reason})
+		}
+	}
+	if len(kept) != 0 {
+		sort.Slice(kept, func(i, j int) bool {
+			if kept[i].Resource.Type != kept[j].Resource.Type {
+				return kept[i].Resource.Type < kept[j].Resource.Type
+			}
+			return kept[i].Resource.ID < kept[j].Resource.ID
+		})
+		fmt.Fprintf(out, "\nResources that would not be deleted:\n\n")
+		if err := t.Render(kept, out, "TYPE", "NAME", "ID", "REASON"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// orphanedResource is a resource found by sweepOrphanedResources
+type orphanedResource struct {
+	Region   string
+	Resource *resources.Resource
+	Cost     string
+}
+
+// sweepOrphanedResources reports the resources that are still tagged for the cluster once it has been deleted,
+// and deletes them if --prune-orphans is specified
+func sweepOrphanedResources(ctx context.Context, cloud fi.Cloud, clusterName string, out io.Writer, options *DeleteClusterOptions) error {
+	klog.Info("Looking for orphaned cloud resources")
+	orphans, err := resourceops.ListOrphanedResources(ctx, cloud, clusterName)
+	if err != nil {
+		return fmt.Errorf("error looking for orphaned resources: %w", err)
+	}
+
+	var l []*orphanedResource
+	total := 0.0
+	for _, o := range orphans {
+		for _, r := range o.Resources {
+			cost := "-"
+			if c, ok := resourceops.EstimateMonthlyCost(cloud, r); ok {
+				cost = fmt.Sprintf("$%.2f", c)
+				total += c
+			}
+			l = append(l, &orphanedResource{Region: o.Region, Resource: r, Cost: cost})
+		}
+	}
+	if len(l) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(out, "\nFound resources still tagged for the cluster:\n\n")
+
+	t := &tables.Table{}
+	t.AddColumn("REGION", func(o *orphanedResource) string {
+		return o.Region
+	})
+	t.AddColumn("TYPE", func(o *orphanedResource) string {
+		return o.Resource.Type
+	})
+	t.AddColumn("ID", func(o *orphanedResource) string {
+		return o.Resource.ID
+	})
+	t.AddColumn("NAME", func(o *orphanedResource) string {
+		return o.Resource.Name
+	})
+	t.AddColumn("MONTHLY COST", func(o *orphanedResource) string {
+		return o.Cost
+	})
+	if err := t.Render(l, out, "REGION", "TYPE", "NAME", "ID", "MONTHLY COST"); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "\nEstimated monthly cost: $%.2f\n", total)
+
+	if !options.PruneOrphans {
+		fmt.Fprintf(out, "\nSpecify --prune-orphans to delete these resources\n")
+		return nil
+	}
+
+	fmt.Fprintf(out, "\n")
+	for _, o := range orphans {
+		if err := resourceops.DeleteResources(o.Cloud, o.Resources, options.count, options.interval, options.wait); err != nil {
+			return fmt.Errorf("error deleting orphaned resources in %q: %w", o.Region, err)
+		}
+	}
+	return nil
+}
+
 func completeRegion(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	// TODO call into cloud provider(s) to get list of valid regions
 	return nil, cobra.ShellCompDirectiveNoFileComp
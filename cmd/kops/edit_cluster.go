@@ -275,7 +275,7 @@ func updateCluster(ctx context.Context, clientset simple.Clientset, oldCluster,
 	}
 
 	// Retrieve the current status of the cluster.  This will eventually be part of the cluster object.
-	status, err := cloud.FindClusterStatus(oldCluster)
+	status, err := cloud.FindClusterStatus(ctx, oldCluster)
 	if err != nil {
 		return "", err
 	}
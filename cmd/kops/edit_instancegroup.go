@@ -306,6 +306,11 @@ func updateInstanceGroup(ctx context.Context, clientset simple.Clientset, channe
 		return fmt.Sprintf("validation failed: %s", err), nil
 	}
 
+	err = validation.ValidateMachineTypeAvailability(ctx, fullCluster, []*api.InstanceGroup{fullGroup}, cloud).ToAggregate()
+	if err != nil {
+		return fmt.Sprintf("validation failed: %s", err), nil
+	}
+
 	// Note we perform as much validation as we can, before writing a bad config
 	_, err = clientset.InstanceGroupsFor(cluster).Update(ctx, newGroup, metav1.UpdateOptions{})
 	return "", err
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+// Exit codes returned by kops, so that automation can branch on the kind of failure.
+// See docs/continuous_integration.md.
+const (
+	exitCodeError = 1
+	// exitCodeValidationFailed is returned by `kops validate cluster` when the cluster is not healthy
+	exitCodeValidationFailed    = 2
+	exitCodeUserConfig          = 3
+	exitCodeAuth                = 4
+	exitCodeQuota               = 5
+	exitCodeEventualConsistency = 6
+	exitCodeTransient           = 7
+	exitCodeStateStore          = 8
+)
+
+// Formats for printing the error returned by a command, selected with --error-output.
+const (
+	errorOutputText = "text"
+	errorOutputJSON = "json"
+)
+
+// errValidationFailed is returned by `kops validate cluster` when the cluster is not healthy.
+var errValidationFailed = errors.New("cluster validation failed")
+
+// reasonValidationFailed is the reason reported in the JSON output for errValidationFailed.
+const reasonValidationFailed fi.ErrorReason = "ValidationFailed"
+
+// errorOutput is the JSON representation of the error returned by a command.
+type errorOutput struct {
+	Error       string         `json:"error"`
+	Reason      fi.ErrorReason `json:"reason,omitempty"`
+	Remediation string         `json:"remediation,omitempty"`
+	ExitCode    int            `json:"exitCode"`
+}
+
+// exitCodeForError returns the process exit code for an error returned by a command.
+func exitCodeForError(err error) int {
+	if errors.Is(err, errValidationFailed) {
+		return exitCodeValidationFailed
+	}
+
+	classified := fi.ClassifyError(err)
+	if classified == nil {
+		return exitCodeError
+	}
+
+	switch classified.Reason {
+	case fi.ErrorReasonUserConfig:
+		return exitCodeUserConfig
+	case fi.ErrorReasonAuth:
+		return exitCodeAuth
+	case fi.ErrorReasonQuota:
+		return exitCodeQuota
+	case fi.ErrorReasonEventualConsistency:
+		return exitCodeEventualConsistency
+	case fi.ErrorReasonTransient:
+		return exitCodeTransient
+	case fi.ErrorReasonStateStore:
+		return exitCodeStateStore
+	default:
+		return exitCodeError
+	}
+}
+
+// printRemediation prints the remediation hint for an error returned by a command, if it has one.
+func printRemediation(w io.Writer, err error) {
+	classified := fi.ClassifyError(err)
+	if classified == nil || classified.Remediation == "" {
+		return
+	}
+	fmt.Fprintf(w, "Hint (%s): %s\n", classified.Reason, classified.Remediation)
+}
+
+// validateErrorOutput checks the value of the --error-output flag.
+func validateErrorOutput(format string) error {
+	switch format {
+	case "", errorOutputText, errorOutputJSON:
+		return nil
+	default:
+		return fmt.Errorf("unknown error output format %q, must be one of %s|%s", format, errorOutputText, errorOutputJSON)
+	}
+}
+
+// printError prints the error returned by a command in the given format, together with its remediation hint.
+func printError(w io.Writer, err error, format string) {
+	if format != errorOutputJSON {
+		fmt.Fprintf(w, "Error: %v\n", err)
+		printRemediation(w, err)
+		return
+	}
+
+	output := errorOutput{
+		Error:    err.Error(),
+		ExitCode: exitCodeForError(err),
+	}
+	if errors.Is(err, errValidationFailed) {
+		output.Reason = reasonValidationFailed
+	} else if classified := fi.ClassifyError(err); classified != nil {
+		output.Reason = classified.Reason
+		output.Remediation = classified.Remediation
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(output); err != nil {
+		fmt.Fprintf(w, "Error: %v\n", output.Error)
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func TestExitCodeForError(t *testing.T) {
+	userConfigError := fmt.Errorf("error populating cluster spec: %w", fi.NewUserConfigError(errors.New("spec.kubernetesVersion: Required value"), "Fix the cluster spec with `kops edit cluster`."))
+
+	grid := []struct {
+		err      error
+		expected int
+		hint     string
+	}{
+		{
+			err:      errors.New("unclassified"),
+			expected: exitCodeError,
+		},
+		{
+			err:      userConfigError,
+			expected: exitCodeUserConfig,
+			hint:     "Hint (UserConfig): Fix the cluster spec with `kops edit cluster`.\n",
+		},
+		{
+			err:      errValidationFailed,
+			expected: exitCodeValidationFailed,
+		},
+		{
+			err:      fmt.Errorf("error reading cluster configuration %q: %w", "example.com", fi.NewStateStoreError(errors.New("error reading s3://bucket/example.com/config: NoSuchBucket"), "Check the state store.")),
+			expected: exitCodeStateStore,
+			hint:     "Hint (StateStore): Check the state store.\n",
+		},
+		{
+			err:      fi.NewClassifiedError(fi.ErrorReasonTransient, errors.New("InternalError"), ""),
+			expected: exitCodeTransient,
+		},
+		{
+			err:      fmt.Errorf("deadline exceeded: %w", fi.NewTryAgainLaterError("waiting for the IAM Instance Profile to be propagated")),
+			expected: exitCodeEventualConsistency,
+			hint:     "Hint (EventualConsistency): Wait a few minutes and run the command again.\n",
+		},
+	}
+
+	for _, g := range grid {
+		if actual := exitCodeForError(g.err); actual != g.expected {
+			t.Errorf("unexpected exit code for %v: expected %d, got %d", g.err, g.expected, actual)
+		}
+
+		var b bytes.Buffer
+		printRemediation(&b, g.err)
+		if b.String() != g.hint {
+			t.Errorf("unexpected hint for %v: expected %q, got %q", g.err, g.hint, b.String())
+		}
+	}
+}
+
+func TestPrintError(t *testing.T) {
+	userConfigError := fmt.Errorf("error populating cluster spec: %w", fi.NewUserConfigError(errors.New("spec.kubernetesVersion: Required value"), "Fix the cluster spec with `kops edit cluster`."))
+
+	grid := []struct {
+		err      error
+		format   string
+		expected string
+	}{
+		{
+			err:      userConfigError,
+			format:   errorOutputText,
+			expected: "Error: error populating cluster spec: spec.kubernetesVersion: Required value\nHint (UserConfig): Fix the cluster spec with `kops edit cluster`.\n",
+		},
+		{
+			err:      userConfigError,
+			format:   errorOutputJSON,
+			expected: `{"error":"error populating cluster spec: spec.kubernetesVersion: Required value","reason":"UserConfig","remediation":"Fix the cluster spec with ` + "`kops edit cluster`" + `.","exitCode":3}` + "\n",
+		},
+		{
+			err:      errValidationFailed,
+			format:   errorOutputJSON,
+			expected: `{"error":"cluster validation failed","reason":"ValidationFailed","exitCode":2}` + "\n",
+		},
+		{
+			err:      errors.New("unclassified"),
+			format:   errorOutputJSON,
+			expected: `{"error":"unclassified","exitCode":1}` + "\n",
+		},
+	}
+
+	for _, g := range grid {
+		var b bytes.Buffer
+		printError(&b, g.err, g.format)
+		if b.String() != g.expected {
+			t.Errorf("unexpected %s output for %v: expected %q, got %q", g.format, g.err, g.expected, b.String())
+		}
+	}
+
+	if err := validateErrorOutput("yaml"); err == nil {
+		t.Errorf("expected an error for an unknown error output format")
+	}
+}
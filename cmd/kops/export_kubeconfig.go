@@ -17,13 +17,17 @@ limitations under the License.
 package main
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"os"
 
+	"filippo.io/age"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/kops/cmd/kops/util"
 	kopsapi "k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/commands/commandutils"
@@ -48,6 +52,9 @@ var (
 
 	# export using the internal DNS name, bypassing the cloud load balancer
 	kops export kubeconfig k8s-cluster.example.com --internal
+
+	# export an admin kubeconfig encrypted to an age recipient, to be decrypted with "kops helpers decrypt-kubeconfig"
+	kops export kubeconfig k8s-cluster.example.com --admin --kubeconfig admin.kubeconfig.age --encrypt-to age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p
 	`))
 
 	exportKubeconfigShort = i18n.T(`Export kubeconfig.`)
@@ -57,6 +64,9 @@ type ExportKubeconfigOptions struct {
 	ClusterName    string
 	KubeConfigPath string
 	all            bool
+	// EncryptTo lists the age recipients the exported kubeconfig is encrypted to.
+	// When set, a standalone kubeconfig is written instead of merging into an existing one.
+	EncryptTo []string
 	kubeconfig.CreateKubecfgOptions
 }
 
@@ -73,6 +83,14 @@ func NewCmdExportKubeconfig(f *util.Factory, out io.Writer) *cobra.Command {
 			if options.Admin != 0 && options.User != "" {
 				return fmt.Errorf("cannot use both --admin and --user")
 			}
+			if len(options.EncryptTo) != 0 {
+				if options.User != "" {
+					return fmt.Errorf("cannot use both --encrypt-to and --user")
+				}
+				if options.KubeConfigPath == "" {
+					return fmt.Errorf("--kubeconfig must be specified when using --encrypt-to")
+				}
+			}
 			if options.all {
 				if len(args) != 0 {
 					return fmt.Errorf("cannot use both --all flag and positional arguments")
@@ -97,6 +115,7 @@ func NewCmdExportKubeconfig(f *util.Factory, out io.Writer) *cobra.Command {
 
 	cmd.Flags().BoolVar(&options.Internal, "internal", options.Internal, "Use the cluster's internal DNS name")
 	cmd.Flags().BoolVar(&options.UseKopsAuthenticationPlugin, "auth-plugin", options.UseKopsAuthenticationPlugin, "Use the kOps authentication plugin")
+	cmd.Flags().StringSliceVar(&options.EncryptTo, "encrypt-to", options.EncryptTo, "Encrypt the kubeconfig to the specified age recipients (age1... or age plugin recipients) and write it as a standalone file")
 
 	options.CreateKubecfgOptions.AddFlagsForExport(cmd.Flags())
 
@@ -104,6 +123,16 @@ func NewCmdExportKubeconfig(f *util.Factory, out io.Writer) *cobra.Command {
 }
 
 func RunExportKubeconfig(ctx context.Context, f *util.Factory, out io.Writer, options *ExportKubeconfigOptions, args []string) error {
+	// Check the recipients before issuing any credentials
+	var recipients []age.Recipient
+	if len(options.EncryptTo) != 0 {
+		r, err := kubeconfig.ParseRecipients(options.EncryptTo)
+		if err != nil {
+			return err
+		}
+		recipients = r
+	}
+
 	clientset, err := f.KopsClient()
 	if err != nil {
 		return err
@@ -126,6 +155,7 @@ func RunExportKubeconfig(ctx context.Context, f *util.Factory, out io.Writer, op
 		clusterList = append(clusterList, cluster)
 	}
 
+	encryptedConfig := clientcmdapi.NewConfig()
 	for _, cluster := range clusterList {
 		keyStore, err := clientset.KeyStore(cluster)
 		if err != nil {
@@ -153,11 +183,35 @@ func RunExportKubeconfig(ctx context.Context, f *util.Factory, out io.Writer, op
 			return err
 		}
 
+		if recipients != nil {
+			if err := conf.AddTo(encryptedConfig); err != nil {
+				return err
+			}
+			continue
+		}
+
 		if err := conf.WriteKubecfg(buildPathOptions(options)); err != nil {
 			return err
 		}
 	}
 
+	if recipients != nil {
+		return writeEncryptedKubeconfig(out, options.KubeConfigPath, encryptedConfig, recipients)
+	}
+
+	return nil
+}
+
+func writeEncryptedKubeconfig(out io.Writer, path string, config *clientcmdapi.Config, recipients []age.Recipient) error {
+	var b bytes.Buffer
+	if err := kubeconfig.EncryptKubecfg(&b, config, recipients); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, b.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("error writing encrypted kubeconfig %q: %w", path, err)
+	}
+
+	fmt.Fprintf(out, "Wrote encrypted kubeconfig to %s\n", path)
 	return nil
 }
 
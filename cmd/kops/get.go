@@ -17,12 +17,18 @@ limitations under the License.
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"strings"
+	"text/template"
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/cmd/kops/util"
 	"k8s.io/kops/pkg/commands/commandutils"
@@ -39,6 +45,12 @@ const (
 	OutputYaml  = "yaml"
 	OutputTable = "table"
 	OutputJSON  = "json"
+
+	OutputJSONPath        = "jsonpath"
+	OutputJSONPathFile    = "jsonpath-file"
+	OutputGoTemplate      = "go-template"
+	OutputGoTemplateFile  = "go-template-file"
+	outputTemplateFormats = OutputJSONPath + "=..., " + OutputJSONPathFile + "=..., " + OutputGoTemplate + "=..., " + OutputGoTemplateFile + "=..."
 )
 
 func NewCmdGet(f *util.Factory, out io.Writer) *cobra.Command {
@@ -56,15 +68,16 @@ func NewCmdGet(f *util.Factory, out io.Writer) *cobra.Command {
 		},
 	}
 
-	cmd.PersistentFlags().StringVarP(&options.Output, "output", "o", options.Output, "output format. One of: table, yaml, json")
+	cmd.PersistentFlags().StringVarP(&options.Output, "output", "o", options.Output, "output format. One of: table, yaml, json, "+outputTemplateFormats)
 	cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		return []string{OutputTable, OutputJSON, OutputYaml}, cobra.ShellCompDirectiveNoFileComp
+		return []string{OutputTable, OutputJSON, OutputYaml, OutputJSONPath + "=", OutputJSONPathFile + "=", OutputGoTemplate + "=", OutputGoTemplateFile + "="}, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
 	})
 
 	// create subcommands
 	cmd.AddCommand(NewCmdGetAll(f, out, options))
 	cmd.AddCommand(NewCmdGetAssets(f, out, options))
 	cmd.AddCommand(NewCmdGetCluster(f, out, options))
+	cmd.AddCommand(NewCmdGetClusterStatus(f, out, options))
 	cmd.AddCommand(NewCmdGetInstanceGroups(f, out, options))
 	cmd.AddCommand(NewCmdGetInstances(f, out, options))
 	cmd.AddCommand(NewCmdGetKeypairs(f, out, options))
@@ -118,3 +131,91 @@ func marshalJSON(obj runtime.Object) ([]byte, error) {
 	}
 	return j, nil
 }
+
+// isTemplateOutput returns whether the output format applies a JSONPath expression or a Go template.
+func isTemplateOutput(output string) bool {
+	format, _, found := strings.Cut(output, "=")
+	if !found {
+		return false
+	}
+	switch format {
+	case OutputJSONPath, OutputJSONPathFile, OutputGoTemplate, OutputGoTemplateFile:
+		return true
+	}
+	return false
+}
+
+// outputTemplate runs a get command with JSON output, and prints its output through the
+// JSONPath expression or Go template of the output format, like kubectl does.
+func outputTemplate(out io.Writer, options *GetOptions, run func(out io.Writer) error) error {
+	output := options.Output
+	printer, err := parseOutputTemplate(output)
+	if err != nil {
+		return err
+	}
+
+	// Commands such as get clusterstatus --fail-on-drift output their result before failing
+	var b bytes.Buffer
+	options.Output = OutputJSON
+	runErr := run(&b)
+	options.Output = output
+	if b.Len() == 0 {
+		return runErr
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(b.Bytes(), &data); err != nil {
+		if runErr != nil {
+			return runErr
+		}
+		return fmt.Errorf("error parsing JSON output: %w", err)
+	}
+	if err := printer(out, data); err != nil {
+		return err
+	}
+	return runErr
+}
+
+func parseOutputTemplate(output string) (func(out io.Writer, data interface{}) error, error) {
+	format, text, _ := strings.Cut(output, "=")
+	switch format {
+	case OutputJSONPathFile, OutputGoTemplateFile:
+		b, err := os.ReadFile(text)
+		if err != nil {
+			return nil, fmt.Errorf("error reading template file: %w", err)
+		}
+		text = string(b)
+	}
+	if text == "" {
+		return nil, fmt.Errorf("output format %q requires a template", format)
+	}
+
+	switch format {
+	case OutputJSONPath, OutputJSONPathFile:
+		// Like kubectl, accept expressions without the enclosing braces
+		if !strings.Contains(text, "{") {
+			text = "{" + strings.TrimPrefix(text, "$") + "}"
+		}
+		j := jsonpath.New("output").AllowMissingKeys(true)
+		if err := j.Parse(text); err != nil {
+			return nil, fmt.Errorf("error parsing jsonpath %q: %w", text, err)
+		}
+		return func(out io.Writer, data interface{}) error {
+			if err := j.Execute(out, data); err != nil {
+				return fmt.Errorf("error executing jsonpath %q: %w", text, err)
+			}
+			return nil
+		}, nil
+	default:
+		t, err := template.New("output").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing go-template: %w", err)
+		}
+		return func(out io.Writer, data interface{}) error {
+			if err := t.Execute(out, data); err != nil {
+				return fmt.Errorf("error executing go-template: %w", err)
+			}
+			return nil
+		}, nil
+	}
+}
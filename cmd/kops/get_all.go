@@ -21,21 +21,29 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
 	"k8s.io/kops/cmd/kops/util"
 	api "k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/sshcredentials"
+	"k8s.io/kops/util/pkg/tables"
 	"k8s.io/kubectl/pkg/util/i18n"
 	"k8s.io/kubectl/pkg/util/templates"
 )
 
 var (
 	getAllLong = templates.LongDesc(i18n.T(`
-	Display all resources for a cluster.`))
+	Display all resources for a cluster.
+
+	In YAML format, the cluster, its instance groups, its SSH public keys and its addons are
+	written in that order as a single document stream, which can be applied again with
+	kops replace -f or kops create -f.`))
 
 	getAllExample = templates.Examples(i18n.T(`
 	# Get a cluster, its instance groups, and its addons
@@ -43,6 +51,10 @@ var (
 
 	# Get a cluster, its instance groups, and its addons in YAML format
 	kops get all k8s-cluster.example.com -o yaml
+
+	# Back up a cluster, and restore it from the backup
+	kops get all k8s-cluster.example.com -o yaml > k8s-cluster.example.com.yaml
+	kops replace -f k8s-cluster.example.com.yaml --force
 	`))
 
 	getAllShort = i18n.T(`Display all resources for a cluster.`)
@@ -73,6 +85,12 @@ func NewCmdGetAll(f *util.Factory, out io.Writer, getOptions *GetOptions) *cobra
 }
 
 func RunGetAll(ctx context.Context, f commandutils.Factory, out io.Writer, options *GetAllOptions) error {
+	if isTemplateOutput(options.Output) {
+		return outputTemplate(out, options.GetOptions, func(out io.Writer) error {
+			return RunGetAll(ctx, f, out, options)
+		})
+	}
+
 	client, err := f.KopsClient()
 	if err != nil {
 		return err
@@ -100,6 +118,27 @@ func RunGetAll(ctx context.Context, f commandutils.Factory, out io.Writer, optio
 	for i := range igList.Items {
 		instancegroups = append(instancegroups, &igList.Items[i])
 	}
+	sort.Slice(instancegroups, func(i, j int) bool {
+		return instancegroups[i].ObjectMeta.Name < instancegroups[j].ObjectMeta.Name
+	})
+
+	sshCredentialStore, err := client.SSHCredentialStore(cluster)
+	if err != nil {
+		return err
+	}
+	sshCredentials, err := sshCredentialStore.FindSSHPublicKeys()
+	if err != nil {
+		return fmt.Errorf("listing SSH credentials: %w", err)
+	}
+	for i, sshCredential := range sshCredentials {
+		// kops create -f and kops replace -f find the cluster of an SSH credential by its label
+		sshCredential = sshCredential.DeepCopy()
+		if sshCredential.ObjectMeta.Labels == nil {
+			sshCredential.ObjectMeta.Labels = make(map[string]string)
+		}
+		sshCredential.ObjectMeta.Labels[api.LabelClusterName] = cluster.ObjectMeta.Name
+		sshCredentials[i] = sshCredential
+	}
 
 	var addonObjects []*unstructured.Unstructured
 	{
@@ -118,6 +157,9 @@ func RunGetAll(ctx context.Context, f commandutils.Factory, out io.Writer, optio
 		for _, group := range instancegroups {
 			allObjects = append(allObjects, group)
 		}
+		for _, sshCredential := range sshCredentials {
+			allObjects = append(allObjects, sshCredential)
+		}
 		for _, additionalObject := range addonObjects {
 			allObjects = append(allObjects, additionalObject)
 		}
@@ -148,6 +190,13 @@ func RunGetAll(ctx context.Context, f commandutils.Factory, out io.Writer, optio
 		if err != nil {
 			return err
 		}
+		if len(sshCredentials) != 0 {
+			fmt.Fprintf(out, "\nSSH Public Keys\n")
+			err = sshCredentialsOutputTable(sshCredentials, out)
+			if err != nil {
+				return err
+			}
+		}
 		if len(addonObjects) != 0 {
 			fmt.Fprintf(out, "\nAddon Objects\n")
 			err = addonsOutputTable(cluster, addonObjects, out)
@@ -162,3 +211,15 @@ func RunGetAll(ctx context.Context, f commandutils.Factory, out io.Writer, optio
 
 	return nil
 }
+
+func sshCredentialsOutputTable(sshCredentials []*api.SSHCredential, out io.Writer) error {
+	t := &tables.Table{}
+	t.AddColumn("ID", func(c *api.SSHCredential) string {
+		id, err := sshcredentials.Fingerprint(c.Spec.PublicKey)
+		if err != nil {
+			klog.Warningf("unable to compute fingerprint for public key")
+		}
+		return id
+	})
+	return t.Render(sshCredentials, out, "ID")
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/util/pkg/text"
+)
+
+func TestGetAllRoundTrip(t *testing.T) {
+	t.Setenv("SKIP_REGION_CHECK", "1")
+	ctx := context.Background()
+
+	clusterName := "test.k8s.io"
+	cluster := testutils.BuildMinimalClusterAWS(clusterName)
+	nodes := testutils.BuildMinimalNodeInstanceGroup("nodes", "subnet-us-test-1a")
+	controlPlane := testutils.BuildMinimalMasterInstanceGroup("subnet-us-test-1a")
+
+	testutils.NewIntegrationTestHarness(t).SetupMockAWS()
+
+	factoryOptions := &util.FactoryOptions{}
+	factoryOptions.RegistryPath = "memfs://tests"
+	factory := util.NewFactory(factoryOptions)
+	clientSet, err := factory.KopsClient()
+	if err != nil {
+		t.Fatalf("could not create clientset: %v", err)
+	}
+
+	cluster, err = clientSet.CreateCluster(ctx, cluster)
+	if err != nil {
+		t.Fatalf("could not create cluster: %v", err)
+	}
+	for _, ig := range []*kopsapi.InstanceGroup{&nodes, &controlPlane} {
+		if _, err := clientSet.InstanceGroupsFor(cluster).Create(ctx, ig, v1.CreateOptions{}); err != nil {
+			t.Fatalf("could not create instance group: %v", err)
+		}
+	}
+	publicKey, err := os.ReadFile("../../tests/integration/update_cluster/aws-lb-controller/id_rsa.pub")
+	if err != nil {
+		t.Fatalf("could not read public key: %v", err)
+	}
+	sshCredentialStore, err := clientSet.SSHCredentialStore(cluster)
+	if err != nil {
+		t.Fatalf("could not get SSH credential store: %v", err)
+	}
+	if err := sshCredentialStore.AddSSHPublicKey(ctx, publicKey); err != nil {
+		t.Fatalf("could not add SSH public key: %v", err)
+	}
+
+	var stdout bytes.Buffer
+	getOptions := &GetAllOptions{GetOptions: &GetOptions{ClusterName: clusterName, Output: OutputYaml}}
+	if err := RunGetAll(ctx, factory, &stdout, getOptions); err != nil {
+		t.Fatalf("could not get all: %v", err)
+	}
+	backup := stdout.Bytes()
+
+	var objects []string
+	for _, section := range text.SplitContentToSections(backup) {
+		o, gvk, err := kopscodecs.Decode(section, nil)
+		if err != nil {
+			t.Fatalf("could not decode %q: %v", section, err)
+		}
+		name := gvk.Kind + "/" + o.(v1.Object).GetName()
+		if sshCredential, ok := o.(*kopsapi.SSHCredential); ok {
+			name += " " + sshCredential.ObjectMeta.Labels[kopsapi.LabelClusterName]
+		}
+		objects = append(objects, name)
+	}
+	expected := []string{
+		"Cluster/" + clusterName,
+		"InstanceGroup/master-subnet-us-test-1a",
+		"InstanceGroup/nodes",
+		"SSHCredential/admin " + clusterName,
+	}
+	if len(objects) != len(expected) {
+		t.Fatalf("expected objects %v, got %v", expected, objects)
+	}
+	for i := range expected {
+		if objects[i] != expected[i] {
+			t.Fatalf("expected objects %v, got %v", expected, objects)
+		}
+	}
+
+	// Change an instance group, and replace it with the backup
+	nodes.Spec.MaxSize = new(int32(10))
+	if _, err := clientSet.InstanceGroupsFor(cluster).Update(ctx, &nodes, v1.UpdateOptions{}); err != nil {
+		t.Fatalf("could not update instance group: %v", err)
+	}
+
+	backupPath := "memfs://tests/backup.yaml"
+	p, err := factory.VFSContext().BuildVfsPath(backupPath)
+	if err != nil {
+		t.Fatalf("could not build backup path: %v", err)
+	}
+	if err := p.WriteFile(ctx, bytes.NewReader(backup), nil); err != nil {
+		t.Fatalf("could not write backup: %v", err)
+	}
+	if err := RunReplace(ctx, factory, &stdout, &ReplaceOptions{Filenames: []string{backupPath}}); err != nil {
+		t.Fatalf("could not replace from backup: %v", err)
+	}
+
+	storedIG, err := clientSet.InstanceGroupsFor(cluster).Get(ctx, "nodes", v1.GetOptions{})
+	if err != nil {
+		t.Fatalf("could not get instance group: %v", err)
+	}
+	if storedIG.Spec.MaxSize != nil {
+		t.Errorf("expected the instance group to be restored without maxSize, got %d", *storedIG.Spec.MaxSize)
+	}
+}
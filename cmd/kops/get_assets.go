@@ -101,6 +101,12 @@ func NewCmdGetAssets(f *util.Factory, out io.Writer, getOptions *GetOptions) *co
 }
 
 func RunGetAssets(ctx context.Context, f *util.Factory, out io.Writer, options *GetAssetsOptions) error {
+	if isTemplateOutput(options.Output) {
+		return outputTemplate(out, options.GetOptions, func(out io.Writer) error {
+			return RunGetAssets(ctx, f, out, options)
+		})
+	}
+
 	updateClusterResults, err := RunUpdateCluster(ctx, f, out, &UpdateClusterOptions{
 		CoreUpdateClusterOptions: CoreUpdateClusterOptions{
 			Target:      cloudup.TargetDryRun,
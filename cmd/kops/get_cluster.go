@@ -54,6 +54,9 @@ var (
 
 	# Save a cluster desired configuration to YAML file
 	kops get cluster k8s-cluster.example.com -o yaml > cluster-desired-config.yaml
+
+	# Get the Kubernetes version of a cluster
+	kops get cluster k8s-cluster.example.com -o jsonpath='{.spec.kubernetesVersion}'
 	`))
 
 	getClusterShort = i18n.T(`Get one or many clusters.`)
@@ -119,6 +122,12 @@ func NewCmdGetCluster(f *util.Factory, out io.Writer, getOptions *GetOptions) *c
 }
 
 func RunGetClusters(ctx context.Context, f commandutils.Factory, out io.Writer, options *GetClusterOptions) error {
+	if isTemplateOutput(options.Output) {
+		return outputTemplate(out, options.GetOptions, func(out io.Writer) error {
+			return RunGetClusters(ctx, f, out, options)
+		})
+	}
+
 	client, err := f.KopsClient()
 	if err != nil {
 		return err
@@ -163,7 +172,10 @@ func RunGetClusters(ctx context.Context, f commandutils.Factory, out io.Writer,
 			return err
 		}
 
-		fmt.Fprint(out, get_cluster_full_warning)
+		// The warning would make the JSON output invalid
+		if options.Output != OutputJSON {
+			fmt.Fprint(out, get_cluster_full_warning)
+		}
 	}
 
 	var obj []runtime.Object
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/pretty"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	getClusterStatusLong = pretty.LongDesc(i18n.T(`
+	Display the drift between the cluster spec and the cloud resources of a cluster.
+
+	The cluster spec and instance groups are expanded and compared against the resources
+	that exist in the cloud, in the same way as ` + pretty.Bash("kops update cluster") + `, and the resources
+	that would be created, modified or deleted are reported. No changes are applied.`))
+
+	getClusterStatusExample = templates.Examples(i18n.T(`
+	# Display the drifted resources of a cluster
+	kops get clusterstatus k8s-cluster.example.com
+
+	# Fail when the cluster has drifted, for example from a scheduled job
+	kops get clusterstatus k8s-cluster.example.com --fail-on-drift -o json
+	`))
+
+	getClusterStatusShort = i18n.T(`Display the drift between the cluster spec and the cloud.`)
+)
+
+type GetClusterStatusOptions struct {
+	*GetOptions
+
+	// FailOnDrift makes the command fail if any resource has drifted.
+	FailOnDrift bool
+}
+
+// ClusterStatus is the drift of a cluster from its spec.
+type ClusterStatus struct {
+	// Cluster is the name of the cluster.
+	Cluster string `json:"cluster"`
+	// Drifted is true if any resource differs from the spec.
+	Drifted bool `json:"drifted"`
+	// Resources are the resources that differ from the spec.
+	Resources []*fi.ResourceDrift `json:"resources,omitempty"`
+}
+
+func NewCmdGetClusterStatus(f *util.Factory, out io.Writer, getOptions *GetOptions) *cobra.Command {
+	options := GetClusterStatusOptions{
+		GetOptions: getOptions,
+	}
+
+	cmd := &cobra.Command{
+		Use:               "clusterstatus [CLUSTER]",
+		Short:             getClusterStatusShort,
+		Long:              getClusterStatusLong,
+		Example:           getClusterStatusExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunGetClusterStatus(cmd.Context(), f, out, &options)
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.FailOnDrift, "fail-on-drift", options.FailOnDrift, "Exit with an error if the cluster has drifted from its spec")
+
+	return cmd
+}
+
+func RunGetClusterStatus(ctx context.Context, f *util.Factory, out io.Writer, options *GetClusterStatusOptions) error {
+	if isTemplateOutput(options.Output) {
+		return outputTemplate(out, options.GetOptions, func(out io.Writer) error {
+			return RunGetClusterStatus(ctx, f, out, options)
+		})
+	}
+
+	// The dry-run report and hints are replaced by our own output.
+	updateClusterResults, err := RunUpdateCluster(ctx, f, io.Discard, &UpdateClusterOptions{
+		CoreUpdateClusterOptions: CoreUpdateClusterOptions{
+			Target:       cloudup.TargetDryRun,
+			ClusterName:  options.ClusterName,
+			DryRunReport: io.Discard,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	target, ok := updateClusterResults.Target.(*fi.CloudupDryRunTarget)
+	if !ok {
+		return fmt.Errorf("unexpected target type %T", updateClusterResults.Target)
+	}
+	drift, err := target.Drift(updateClusterResults.TaskMap)
+	if err != nil {
+		return fmt.Errorf("error computing drift: %w", err)
+	}
+
+	status := &ClusterStatus{
+		Cluster:   updateClusterResults.Cluster.Name,
+		Drifted:   len(drift) != 0,
+		Resources: drift,
+	}
+
+	switch options.Output {
+	case OutputTable:
+		if err := clusterStatusOutputTable(status, out); err != nil {
+			return err
+		}
+	case OutputYaml:
+		y, err := yaml.Marshal(status)
+		if err != nil {
+			return fmt.Errorf("unable to marshal YAML: %v", err)
+		}
+		if _, err := out.Write(y); err != nil {
+			return fmt.Errorf("error writing to output: %v", err)
+		}
+	case OutputJSON:
+		j, err := json.Marshal(status)
+		if err != nil {
+			return fmt.Errorf("unable to marshal JSON: %v", err)
+		}
+		if _, err := out.Write(j); err != nil {
+			return fmt.Errorf("error writing to output: %v", err)
+		}
+	default:
+		return fmt.Errorf("unsupported output format: %q", options.Output)
+	}
+
+	if options.FailOnDrift && status.Drifted {
+		return fmt.Errorf("cluster %q has %d resources that drifted from its spec", status.Cluster, len(status.Resources))
+	}
+
+	return nil
+}
+
+func clusterStatusOutputTable(status *ClusterStatus, out io.Writer) error {
+	if !status.Drifted {
+		_, err := fmt.Fprintf(out, "No drift detected for cluster %q\n", status.Cluster)
+		return err
+	}
+
+	t := &tables.Table{}
+	t.AddColumn("TYPE", func(d *fi.ResourceDrift) string {
+		return d.Type
+	})
+	t.AddColumn("NAME", func(d *fi.ResourceDrift) string {
+		return d.Name
+	})
+	t.AddColumn("ACTION", func(d *fi.ResourceDrift) string {
+		return d.Action
+	})
+	t.AddColumn("FIELDS", func(d *fi.ResourceDrift) string {
+		var fields []string
+		for _, field := range d.Fields {
+			fields = append(fields, field.Name)
+		}
+		return strings.Join(fields, ",")
+	})
+
+	return t.Render(status.Resources, out, "TYPE", "NAME", "ACTION", "FIELDS")
+}
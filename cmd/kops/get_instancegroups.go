@@ -47,6 +47,10 @@ var (
 
 	# Save a cluster's instancegroups desired configuration to YAML file
 	kops get instancegroups --name k8s-cluster.example.com -o yaml > instancegroups-desired-config.yaml
+
+	# List the machine type of each instance group of a cluster
+	kops get instancegroups --name k8s-cluster.example.com \
+	  -o go-template='{{range .}}{{.metadata.name}} {{.spec.machineType}}{{"\n"}}{{end}}'
 	`))
 
 	getInstancegroupsShort = i18n.T(`Get one or many instance groups.`)
@@ -89,6 +93,12 @@ func NewCmdGetInstanceGroups(f *util.Factory, out io.Writer, getOptions *GetOpti
 }
 
 func RunGetInstanceGroups(ctx context.Context, f commandutils.Factory, out io.Writer, options *GetInstanceGroupsOptions) error {
+	if isTemplateOutput(options.Output) {
+		return outputTemplate(out, options.GetOptions, func(out io.Writer) error {
+			return RunGetInstanceGroups(ctx, f, out, options)
+		})
+	}
+
 	clientset, err := f.KopsClient()
 	if err != nil {
 		return err
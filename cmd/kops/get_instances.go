@@ -18,9 +18,11 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"k8s.io/client-go/kubernetes"
@@ -48,6 +50,13 @@ var (
 	getInstancesExample = templates.Examples(i18n.T(`
 	# Display all instances.
 	kops get instances
+
+	# Display the first 500 instances as JSON, and then the next 500.
+	kops get instances -o json --limit 500
+	kops get instances -o json --limit 500 --continue <continue token from the previous output>
+
+	# Display the IDs of the instances that need to be updated.
+	kops get instances -o jsonpath='{range [?(@.status=="NeedsUpdate")]}{.id}{"\n"}{end}'
 	`))
 
 	getInstancesShort = i18n.T(`Display cluster instances.`)
@@ -65,9 +74,21 @@ type renderableCloudInstance struct {
 	State         string   `json:"state"`
 }
 
+// renderableCloudInstanceList is a page of instances, returned when the output is limited.
+type renderableCloudInstanceList struct {
+	Items []*renderableCloudInstance `json:"items"`
+	// Continue is set when there may be more instances, and is passed with --continue to get them.
+	Continue string `json:"continue,omitempty"`
+}
+
 type GetInstancesOptions struct {
 	*GetOptions
 	kubeconfig.CreateKubecfgOptions
+
+	// Limit is the maximum number of instances to output as JSON or YAML; 0 outputs all instances.
+	Limit int
+	// Continue is the token returned with the previous page of instances.
+	Continue string
 }
 
 func NewCmdGetInstances(f *util.Factory, out io.Writer, options *GetOptions) *cobra.Command {
@@ -86,11 +107,33 @@ func NewCmdGetInstances(f *util.Factory, out io.Writer, options *GetOptions) *co
 	}
 
 	opt.CreateKubecfgOptions.AddCommonFlags(cmd.Flags())
+	cmd.Flags().IntVar(&opt.Limit, "limit", opt.Limit, "Maximum number of instances to output as JSON or YAML, together with a token to continue from")
+	cmd.Flags().StringVar(&opt.Continue, "continue", opt.Continue, "Continue from the token returned with a previous page of instances")
 
 	return cmd
 }
 
+// instanceGroupInstancesFunc is called with the instances of each instance group, and returns whether to continue with the next instance group.
+type instanceGroupInstancesFunc func(ig *kops.InstanceGroup, instances []*cloudinstances.CloudInstance) (bool, error)
+
 func RunGetInstances(ctx context.Context, f *util.Factory, out io.Writer, options *GetInstancesOptions) error {
+	if isTemplateOutput(options.Output) {
+		return outputTemplate(out, options.GetOptions, func(out io.Writer) error {
+			return RunGetInstances(ctx, f, out, options)
+		})
+	}
+
+	if options.Limit < 0 {
+		return fmt.Errorf("--limit must not be negative")
+	}
+	if (options.Limit != 0 || options.Continue != "") && options.Output == OutputTable {
+		return fmt.Errorf("--limit and --continue are only supported with JSON or YAML output")
+	}
+	continueFrom, err := decodeInstancesContinue(options.Continue)
+	if err != nil {
+		return err
+	}
+
 	clientset, err := f.KopsClient()
 	if err != nil {
 		return err
@@ -139,38 +182,106 @@ func RunGetInstances(ctx context.Context, f *util.Factory, out io.Writer, option
 	for i := range igList.Items {
 		instanceGroups = append(instanceGroups, &igList.Items[i])
 	}
+	sort.Slice(instanceGroups, func(i, j int) bool {
+		return instanceGroups[i].ObjectMeta.Name < instanceGroups[j].ObjectMeta.Name
+	})
 
-	var cloudInstances []*cloudinstances.CloudInstance
-
-	cloudGroups, err := cloud.GetCloudGroups(cluster, instanceGroups, false, nodeList.Items)
-	if err != nil {
-		return err
-	}
-
-	for _, cg := range cloudGroups {
-		cloudInstances = append(cloudInstances, cg.Ready...)
-		cloudInstances = append(cloudInstances, cg.NeedUpdate...)
-		cg.AdjustNeedUpdate()
+	// The instances are queried one instance group at a time, so that they can be written
+	// without waiting for, or holding in memory, the instances of the whole cluster.
+	forEachInstanceGroup := func(fn instanceGroupInstancesFunc) error {
+		for _, ig := range instanceGroups {
+			if continueFrom != nil && ig.ObjectMeta.Name < continueFrom.InstanceGroup {
+				continue
+			}
+			cloudGroups, err := cloud.GetCloudGroups(cluster, []*kops.InstanceGroup{ig}, false, nodeList.Items)
+			if err != nil {
+				return err
+			}
+
+			var cloudInstances []*cloudinstances.CloudInstance
+			for _, cg := range cloudGroups {
+				cloudInstances = append(cloudInstances, cg.Ready...)
+				cloudInstances = append(cloudInstances, cg.NeedUpdate...)
+				cg.AdjustNeedUpdate()
+			}
+			sort.Slice(cloudInstances, func(i, j int) bool {
+				return cloudInstances[i].ID < cloudInstances[j].ID
+			})
+
+			more, err := fn(ig, cloudInstances)
+			if err != nil || !more {
+				return err
+			}
+		}
+		return nil
 	}
 
 	switch options.Output {
 	case OutputTable:
-		return instanceOutputTable(cloudInstances, out)
-	case OutputYaml:
-		y, err := yaml.Marshal(asRenderable(cloudInstances))
-		if err != nil {
-			return fmt.Errorf("unable to marshal YAML: %v", err)
+		return instanceOutputTable(forEachInstanceGroup, out)
+	case OutputYaml, OutputJSON:
+		if options.Limit != 0 || options.Continue != "" {
+			list := &renderableCloudInstanceList{
+				Items: []*renderableCloudInstance{},
+			}
+			err := forEachInstanceGroup(func(ig *kops.InstanceGroup, instances []*cloudinstances.CloudInstance) (bool, error) {
+				for _, instance := range instances {
+					if !continueFrom.includes(ig.ObjectMeta.Name, instance.ID) {
+						continue
+					}
+					list.Items = append(list.Items, asRenderable(instance))
+					if len(list.Items) == options.Limit {
+						list.Continue = encodeInstancesContinue(ig.ObjectMeta.Name, instance.ID)
+						return false, nil
+					}
+				}
+				return true, nil
+			})
+			if err != nil {
+				return err
+			}
+			return writeInstances(out, options.Output, list)
 		}
-		if _, err := out.Write(y); err != nil {
+
+		if options.Output == OutputYaml {
+			var renderables []*renderableCloudInstance
+			err := forEachInstanceGroup(func(ig *kops.InstanceGroup, instances []*cloudinstances.CloudInstance) (bool, error) {
+				for _, instance := range instances {
+					renderables = append(renderables, asRenderable(instance))
+				}
+				return true, nil
+			})
+			if err != nil {
+				return err
+			}
+			return writeInstances(out, options.Output, renderables)
+		}
+
+		// The JSON array is written one instance group at a time
+		if _, err := io.WriteString(out, "["); err != nil {
 			return fmt.Errorf("error writing to output: %v", err)
 		}
-		return nil
-	case OutputJSON:
-		j, err := json.Marshal(asRenderable(cloudInstances))
+		first := true
+		err := forEachInstanceGroup(func(ig *kops.InstanceGroup, instances []*cloudinstances.CloudInstance) (bool, error) {
+			for _, instance := range instances {
+				j, err := json.Marshal(asRenderable(instance))
+				if err != nil {
+					return false, fmt.Errorf("unable to marshal JSON: %v", err)
+				}
+				if !first {
+					j = append([]byte{','}, j...)
+				}
+				first = false
+				if _, err := out.Write(j); err != nil {
+					return false, fmt.Errorf("error writing to output: %v", err)
+				}
+			}
+			return true, nil
+		})
 		if err != nil {
-			return fmt.Errorf("unable to marshal JSON: %v", err)
+			return err
 		}
-		if _, err := out.Write(j); err != nil {
+		if _, err := io.WriteString(out, "]"); err != nil {
 			return fmt.Errorf("error writing to output: %v", err)
 		}
 		return nil
@@ -179,7 +290,61 @@ func RunGetInstances(ctx context.Context, f *util.Factory, out io.Writer, option
 	}
 }
 
-func instanceOutputTable(instances []*cloudinstances.CloudInstance, out io.Writer) error {
+func writeInstances(out io.Writer, output string, v interface{}) error {
+	var b []byte
+	var err error
+	if output == OutputYaml {
+		b, err = yaml.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("unable to marshal YAML: %v", err)
+		}
+	} else {
+		b, err = json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("unable to marshal JSON: %v", err)
+		}
+	}
+	if _, err := out.Write(b); err != nil {
+		return fmt.Errorf("error writing to output: %v", err)
+	}
+	return nil
+}
+
+// instancesContinue is the position after the last instance of a page; instances are ordered by instance group and then by ID.
+type instancesContinue struct {
+	InstanceGroup string `json:"instanceGroup"`
+	ID            string `json:"id"`
+}
+
+func encodeInstancesContinue(instanceGroup, id string) string {
+	b, _ := json.Marshal(&instancesContinue{InstanceGroup: instanceGroup, ID: id})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeInstancesContinue(token string) (*instancesContinue, error) {
+	if token == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid continue token %q", token)
+	}
+	c := &instancesContinue{}
+	if err := json.Unmarshal(b, c); err != nil || c.InstanceGroup == "" {
+		return nil, fmt.Errorf("invalid continue token %q", token)
+	}
+	return c, nil
+}
+
+// includes returns whether the instance comes after the continue position.
+func (c *instancesContinue) includes(instanceGroup, id string) bool {
+	if c == nil || instanceGroup > c.InstanceGroup {
+		return true
+	}
+	return instanceGroup == c.InstanceGroup && id > c.ID
+}
+
+func instanceOutputTable(forEachInstanceGroup func(fn instanceGroupInstancesFunc) error, out io.Writer) error {
 	fmt.Println("")
 	t := &tables.Table{}
 	t.AddColumn("ID", func(i *cloudinstances.CloudInstance) string {
@@ -216,25 +381,32 @@ func instanceOutputTable(instances []*cloudinstances.CloudInstance, out io.Write
 	})
 
 	columns := []string{"ID", "NODE-NAME", "STATUS", "ROLES", "STATE", "INTERNAL-IP", "EXTERNAL-IP", "INSTANCE-GROUP", "MACHINE-TYPE"}
-	return t.Render(instances, out, columns...)
+	stream, err := t.Stream(out, columns...)
+	if err != nil {
+		return err
+	}
+	err = forEachInstanceGroup(func(ig *kops.InstanceGroup, instances []*cloudinstances.CloudInstance) (bool, error) {
+		return true, stream.Write(instances)
+	})
+	if err != nil {
+		return err
+	}
+	return stream.Close()
 }
 
-func asRenderable(instances []*cloudinstances.CloudInstance) []*renderableCloudInstance {
-	arr := make([]*renderableCloudInstance, len(instances))
-	for i, ci := range instances {
-		arr[i] = &renderableCloudInstance{
-			ID:            ci.ID,
-			Status:        ci.Status,
-			Roles:         ci.Roles,
-			InternalIP:    ci.PrivateIP,
-			ExternalIP:    ci.ExternalIP,
-			InstanceGroup: ci.CloudInstanceGroup.HumanName,
-			MachineType:   ci.MachineType,
-			State:         string(ci.State),
-		}
-		if ci.Node != nil {
-			arr[i].NodeName = ci.Node.Name
-		}
+func asRenderable(ci *cloudinstances.CloudInstance) *renderableCloudInstance {
+	r := &renderableCloudInstance{
+		ID:            ci.ID,
+		Status:        ci.Status,
+		Roles:         ci.Roles,
+		InternalIP:    ci.PrivateIP,
+		ExternalIP:    ci.ExternalIP,
+		InstanceGroup: ci.CloudInstanceGroup.HumanName,
+		MachineType:   ci.MachineType,
+		State:         string(ci.State),
+	}
+	if ci.Node != nil {
+		r.NodeName = ci.Node.Name
 	}
-	return arr
+	return r
 }
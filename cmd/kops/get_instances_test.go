@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+)
+
+func TestInstancesContinue(t *testing.T) {
+	c, err := decodeInstancesContinue(encodeInstancesContinue("nodes-b", "i-2"))
+	if err != nil {
+		t.Fatalf("unexpected error decoding continue token: %v", err)
+	}
+	if c.InstanceGroup != "nodes-b" || c.ID != "i-2" {
+		t.Fatalf("unexpected continue position %+v", c)
+	}
+
+	grid := []struct {
+		instanceGroup string
+		id            string
+		expected      bool
+	}{
+		{instanceGroup: "nodes-a", id: "i-3", expected: false},
+		{instanceGroup: "nodes-b", id: "i-1", expected: false},
+		{instanceGroup: "nodes-b", id: "i-2", expected: false},
+		{instanceGroup: "nodes-b", id: "i-3", expected: true},
+		{instanceGroup: "nodes-c", id: "i-1", expected: true},
+	}
+	for _, g := range grid {
+		if actual := c.includes(g.instanceGroup, g.id); actual != g.expected {
+			t.Errorf("includes(%q, %q): expected %v, got %v", g.instanceGroup, g.id, g.expected, actual)
+		}
+	}
+
+	var none *instancesContinue
+	if !none.includes("nodes-a", "i-1") {
+		t.Errorf("expected all instances to be included without a continue token")
+	}
+
+	for _, token := range []string{"not base64!", "bm90IGpzb24", "e30"} {
+		if _, err := decodeInstancesContinue(token); err == nil {
+			t.Errorf("expected error decoding continue token %q", token)
+		}
+	}
+}
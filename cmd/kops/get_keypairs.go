@@ -42,7 +42,10 @@ var (
 	kops get keypairs kubernetes-ca
 
 	# List the service-account keypairs, including distrusted ones.
-	kops get keypairs service-account --distrusted`))
+	kops get keypairs service-account --distrusted
+
+	# Display the expiry date of the primary cluster CA keypair.
+	kops get keypairs kubernetes-ca -o jsonpath='{[?(@.isPrimary==true)].notAfter}'`))
 
 	getKeypairShort = i18n.T(`Get one or many keypairs.`)
 )
@@ -165,6 +168,12 @@ func listKeypairs(keyStore fi.CAStore, names []string, includeDistrusted bool) (
 }
 
 func RunGetKeypairs(ctx context.Context, f commandutils.Factory, out io.Writer, options *GetKeypairsOptions) error {
+	if isTemplateOutput(options.Output) {
+		return outputTemplate(out, options.GetOptions, func(out io.Writer) error {
+			return RunGetKeypairs(ctx, f, out, options)
+		})
+	}
+
 	clientset, err := f.KopsClient()
 	if err != nil {
 		return err
@@ -99,6 +99,12 @@ func listSecrets(secretStore fi.SecretStore, names []string) ([]string, error) {
 }
 
 func RunGetSecrets(ctx context.Context, f *util.Factory, out io.Writer, options *GetSecretsOptions) error {
+	if isTemplateOutput(options.Output) {
+		return outputTemplate(out, options.GetOptions, func(out io.Writer) error {
+			return RunGetSecrets(ctx, f, out, options)
+		})
+	}
+
 	switch strings.ToLower(options.Type) {
 	case "", "secret":
 	// OK
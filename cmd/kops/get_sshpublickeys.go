@@ -70,6 +70,12 @@ type SSHKeyItem struct {
 }
 
 func RunGetSSHPublicKeys(ctx context.Context, f *util.Factory, out io.Writer, options *GetSSHPublicKeysOptions) error {
+	if isTemplateOutput(options.Output) {
+		return outputTemplate(out, options.GetOptions, func(out io.Writer) error {
+			return RunGetSSHPublicKeys(ctx, f, out, options)
+		})
+	}
+
 	clientset, err := f.KopsClient()
 	if err != nil {
 		return err
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOutputTemplate(t *testing.T) {
+	const instances = `[{"id":"i-1","instanceGroup":"control-plane-us-east-1a","roles":["control-plane"]},{"id":"i-2","instanceGroup":"nodes-us-east-1a","roles":["node"]}]`
+
+	templateFile := filepath.Join(t.TempDir(), "template")
+	if err := os.WriteFile(templateFile, []byte(`{{range .}}{{.instanceGroup}} {{end}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	grid := []struct {
+		output      string
+		expected    string
+		expectedErr string
+	}{
+		{
+			output:   "jsonpath={[*].id}",
+			expected: "i-1 i-2",
+		},
+		{
+			output:   "jsonpath=[0].roles[0]",
+			expected: "control-plane",
+		},
+		{
+			output:   `jsonpath={range [*]}{.id}{"\t"}{.instanceGroup}{"\n"}{end}`,
+			expected: "i-1\tcontrol-plane-us-east-1a\ni-2\tnodes-us-east-1a\n",
+		},
+		{
+			output:   `jsonpath={range [?(@.instanceGroup=="nodes-us-east-1a")]}{.id}{"\n"}{end}`,
+			expected: "i-2\n",
+		},
+		{
+			output:   "jsonpath={[*].missing}",
+			expected: "",
+		},
+		{
+			output:   `go-template={{range .}}{{.id}}{{"\n"}}{{end}}`,
+			expected: "i-1\ni-2\n",
+		},
+		{
+			output:   "go-template-file=" + templateFile,
+			expected: "control-plane-us-east-1a nodes-us-east-1a ",
+		},
+		{
+			output:      "jsonpath=",
+			expectedErr: `output format "jsonpath" requires a template`,
+		},
+		{
+			output:      "go-template={{.id",
+			expectedErr: "error parsing go-template: template: output:1: unclosed action",
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.output, func(t *testing.T) {
+			if !isTemplateOutput(g.output) {
+				t.Fatalf("%q is not a template output", g.output)
+			}
+
+			options := &GetOptions{Output: g.output}
+			var out bytes.Buffer
+			err := outputTemplate(&out, options, func(out io.Writer) error {
+				if options.Output != OutputJSON {
+					return fmt.Errorf("unexpected output format %q", options.Output)
+				}
+				_, err := io.WriteString(out, instances)
+				return err
+			})
+			if g.expectedErr != "" {
+				if err == nil || err.Error() != g.expectedErr {
+					t.Fatalf("expected error %q, got %v", g.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.String() != g.expected {
+				t.Errorf("expected output %q, got %q", g.expected, out.String())
+			}
+			if options.Output != g.output {
+				t.Errorf("output format was not restored, got %q", options.Output)
+			}
+		})
+	}
+}
+
+func TestIsTemplateOutput(t *testing.T) {
+	for _, output := range []string{OutputTable, OutputJSON, OutputYaml, "jsonpath", "custom-columns=NAME:.id"} {
+		if isTemplateOutput(output) {
+			t.Errorf("%q is not a template output", output)
+		}
+	}
+}
@@ -20,17 +20,31 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/spf13/viper"
 	"k8s.io/kops"
 	"k8s.io/kops/pkg/assets"
+	"k8s.io/kops/pkg/otel/otelsetup"
 )
 
 func main() {
-	ctx := context.Background()
-	if err := run(ctx); err != nil {
-		os.Exit(1)
+	// Ctrl-C and SIGTERM cancel the context, so that commands stop their cloud API calls and return.
+	// Once the context is cancelled, a second signal terminates kOps immediately.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	err := run(ctx)
+	stop()
+	if err != nil {
+		printError(os.Stderr, err, viper.GetString("KOPS_ERROR_OUTPUT"))
+		os.Exit(exitCodeForError(err))
 	}
 }
 
@@ -48,9 +62,8 @@ func run(ctx context.Context) error {
 		serviceVersion += ".git-" + kops.GitVersion
 	}
 
-	otelShutdown, err := setupOTelSDK(ctx, serviceName, serviceVersion)
+	otelShutdown, err := otelsetup.SetupOTelSDK(ctx, serviceName, serviceVersion, "")
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		return err
 	}
 	// Handle shutdown properly so nothing leaks.
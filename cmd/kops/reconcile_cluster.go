@@ -24,6 +24,7 @@ import (
 
 	"github.com/spf13/cobra"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
 	"k8s.io/kops/cmd/kops/util"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/commands/commandutils"
@@ -49,6 +50,9 @@ var (
 type ReconcileClusterOptions struct {
 	CoreUpdateClusterOptions
 
+	// IgnoreMaintenanceWindow rolls instance groups even outside the cluster's maintenance windows.
+	IgnoreMaintenanceWindow bool
+
 	kubeconfig.CreateKubecfgOptions
 }
 
@@ -91,6 +95,8 @@ func NewCmdReconcileCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	// cmd.Flags().BoolVar(&options.Internal, "internal", options.Internal, "Use the cluster's internal DNS name. Implies --create-kube-config")
 
 	cmd.Flags().BoolVar(&options.AllowKopsDowngrade, "allow-kops-downgrade", options.AllowKopsDowngrade, "Allow an older version of kOps to update the cluster than last used")
+	cmd.Flags().BoolVar(&options.ForceUnlock, "force-unlock", options.ForceUnlock, "Remove the lock of the cluster held by another kops process, if that process is known to have stopped")
+	cmd.Flags().BoolVar(&options.IgnoreMaintenanceWindow, "ignore-maintenance-window", options.IgnoreMaintenanceWindow, "Roll instance groups even outside the maintenance windows of the cluster")
 
 	// These flags from the update command are not obviously needed by reconcile, though we can add them if needed:
 	//
@@ -135,6 +141,28 @@ func RunReconcileCluster(ctx context.Context, f *util.Factory, out io.Writer, op
 		return nil
 	}
 
+	// Hold the lock across all the steps, so that another kops process can't interleave with them
+	{
+		clientset, err := f.KopsClient()
+		if err != nil {
+			return err
+		}
+		cluster, err := GetCluster(ctx, f, c.ClusterName)
+		if err != nil {
+			return err
+		}
+		lock, err := lockCluster(ctx, clientset, cluster, c.ForceUnlock)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := lock.Release(ctx); err != nil {
+				klog.Warningf("%v", err)
+			}
+		}()
+		c.ForceUnlock = false
+	}
+
 	fmt.Fprintf(out, "Updating control plane configuration\n")
 	{
 		opt := *c
@@ -184,6 +212,7 @@ func RunReconcileCluster(ctx context.Context, f *util.Factory, out io.Writer, op
 			string(kops.InstanceGroupRoleControlPlane),
 		}
 		opt.Yes = c.Yes
+		opt.IgnoreMaintenanceWindow = options.IgnoreMaintenanceWindow
 		if err := RunRollingUpdateCluster(ctx, f, out, opt); err != nil {
 			return err
 		}
@@ -209,6 +238,7 @@ func RunReconcileCluster(ctx context.Context, f *util.Factory, out io.Writer, op
 		// Do all roles this time, though we only expect changes to node & bastion roles
 		opt.InstanceGroupRoles = nil
 		opt.Yes = c.Yes
+		opt.IgnoreMaintenanceWindow = options.IgnoreMaintenanceWindow
 		if err := RunRollingUpdateCluster(ctx, f, out, opt); err != nil {
 			return err
 		}
@@ -20,14 +20,22 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/cmd/kops/util"
 	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/validation"
+	"k8s.io/kops/pkg/assets"
+	"k8s.io/kops/pkg/client/simple"
 	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/pkg/kubemanifest"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
 	"k8s.io/kops/util/pkg/text"
 	"k8s.io/kubectl/pkg/util/i18n"
@@ -47,6 +55,10 @@ var (
 
 		# Note, if the resource does not exist the command will error, use --force to provision resource
 		kops replace -f my-cluster.yaml --force
+
+		# Check the cluster against the cloud, for example that its subnets exist and its
+		# machine types are offered in its zones, without replacing it
+		kops replace -f my-cluster.yaml --dry-run
 		`))
 
 	replaceShort = i18n.T(`Replace cluster resources.`)
@@ -58,6 +70,17 @@ type ReplaceOptions struct {
 	Filenames []string
 	// Force causes any missing rescources to be created.
 	Force bool
+	// ValidateCloud validates the clusters and instance groups against the cloud before replacing any resource.
+	ValidateCloud bool
+	// DryRun only validates the resources against the cloud, without replacing them.
+	DryRun bool
+}
+
+// replaceObject is a resource decoded from one of the files
+type replaceObject struct {
+	Object   runtime.Object
+	GVK      *schema.GroupVersionKind
+	Filename string
 }
 
 // NewCmdReplace returns a new replace command
@@ -78,6 +101,8 @@ func NewCmdReplace(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "A list of one or more files separated by a comma.")
 	cmd.MarkFlagRequired("filename")
 	cmd.Flags().BoolVarP(&options.Force, "force", "", false, "Force any changes, which will also create any non-existing resource")
+	cmd.Flags().BoolVar(&options.ValidateCloud, "validate-cloud", options.ValidateCloud, "Validate the clusters and instance groups against the cloud, such as that subnets exist and machine types are offered in their zones, before replacing any resource")
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", options.DryRun, "Only validate the resources against the cloud, without replacing them. Implies --validate-cloud")
 
 	return cmd
 }
@@ -91,6 +116,7 @@ func RunReplace(ctx context.Context, f *util.Factory, out io.Writer, c *ReplaceO
 
 	vfsContext := f.VFSContext()
 
+	var objects []replaceObject
 	for _, f := range c.Filenames {
 		var contents []byte
 		if f == "-" {
@@ -111,119 +137,267 @@ func RunReplace(ctx context.Context, f *util.Factory, out io.Writer, c *ReplaceO
 			if err != nil {
 				return fmt.Errorf("error parsing file %q: %v", f, err)
 			}
+			objects = append(objects, replaceObject{Object: o, GVK: gvk, Filename: f})
+		}
+	}
 
-			switch v := o.(type) {
-			case *kopsapi.Cluster:
-				{
-					// Retrieve the current status of the cluster.  This will eventually be part of the cluster object.
-					cloud, err := cloudup.BuildCloud(v)
-					if err != nil {
-						return err
-					}
-					status, err := cloud.FindClusterStatus(v)
-					if err != nil {
-						return err
-					}
+	if c.ValidateCloud || c.DryRun {
+		if err := validateReplaceWithCloud(ctx, clientset, objects); err != nil {
+			return err
+		}
+		if c.DryRun {
+			fmt.Fprintf(out, "Validation of %d resources succeeded; not replacing them with --dry-run\n", len(objects))
+			return nil
+		}
+	}
 
-					// Check if the cluster exists already
-					clusterName := v.Name
-					cluster, err := clientset.GetCluster(ctx, clusterName)
-					if err != nil {
-						if errors.IsNotFound(err) {
-							cluster = nil
-						} else {
-							return fmt.Errorf("error fetching cluster %q: %v", clusterName, err)
-						}
-					}
-					if cluster == nil {
-						if !c.Force {
-							return fmt.Errorf("cluster %v does not exist (try adding --force flag)", clusterName)
-						}
-
-						err = cloudup.PerformAssignments(v, vfsContext, cloud)
-						if err != nil {
-							return fmt.Errorf("error populating configuration: %w", err)
-						}
-
-						_, err = clientset.CreateCluster(ctx, v)
-						if err != nil {
-							return fmt.Errorf("error creating cluster: %v", err)
-						}
-					} else {
-						_, err = clientset.UpdateCluster(ctx, v, status)
-						if err != nil {
-							return fmt.Errorf("error replacing cluster: %v", err)
-						}
-					}
-				}
+	var addons kubemanifest.ObjectList
+	var clusterNames []string
+	for _, object := range objects {
+		o, gvk, f := object.Object, object.GVK, object.Filename
 
-			case *kopsapi.InstanceGroup:
-				clusterName := v.ObjectMeta.Labels[kopsapi.LabelClusterName]
-				if clusterName == "" {
-					return fmt.Errorf("must specify %q label with cluster name to replace instanceGroup", kopsapi.LabelClusterName)
+		switch v := o.(type) {
+		case *kopsapi.Cluster:
+			{
+				// Retrieve the current status of the cluster.  This will eventually be part of the cluster object.
+				cloud, err := cloudup.BuildCloud(v)
+				if err != nil {
+					return err
 				}
-				cluster, err := clientset.GetCluster(ctx, clusterName)
+				status, err := cloud.FindClusterStatus(ctx, v)
 				if err != nil {
-					if errors.IsNotFound(err) {
-						return fmt.Errorf("cluster %q not found", clusterName)
-					}
-					return fmt.Errorf("error fetching cluster %q: %v", clusterName, err)
+					return err
 				}
-				// check if the instancegroup exists already
-				igName := v.ObjectMeta.Name
-				ig, err := clientset.InstanceGroupsFor(cluster).Get(ctx, igName, metav1.GetOptions{})
+
+				// Check if the cluster exists already
+				clusterName := v.Name
+				clusterNames = append(clusterNames, clusterName)
+				cluster, err := clientset.GetCluster(ctx, clusterName)
 				if err != nil {
 					if errors.IsNotFound(err) {
-						if !c.Force {
-							return fmt.Errorf("instanceGroup: %v does not exist (try adding --force flag)", igName)
-						}
+						cluster = nil
 					} else {
-						return fmt.Errorf("unable to check for instanceGroup: %v", err)
+						return fmt.Errorf("error fetching cluster %q: %v", clusterName, err)
 					}
 				}
-				switch ig {
-				case nil:
-					klog.Infof("instanceGroup: %v was not found, creating resource now", igName)
-					_, err = clientset.InstanceGroupsFor(cluster).Create(ctx, v, metav1.CreateOptions{})
+				if cluster == nil {
+					if !c.Force {
+						return fmt.Errorf("cluster %v does not exist (try adding --force flag)", clusterName)
+					}
+
+					err = cloudup.PerformAssignments(v, vfsContext, cloud)
 					if err != nil {
-						return fmt.Errorf("error creating instanceGroup: %v", err)
+						return fmt.Errorf("error populating configuration: %w", err)
 					}
-				default:
-					_, err = clientset.InstanceGroupsFor(cluster).Update(ctx, v, metav1.UpdateOptions{})
+
+					_, err = clientset.CreateCluster(ctx, v)
 					if err != nil {
-						return fmt.Errorf("error replacing instanceGroup: %v", err)
+						return fmt.Errorf("error creating cluster: %v", err)
+					}
+				} else {
+					_, err = clientset.UpdateCluster(ctx, v, status)
+					if err != nil {
+						return fmt.Errorf("error replacing cluster: %v", err)
 					}
 				}
-			case *kopsapi.SSHCredential:
-				clusterName := v.ObjectMeta.Labels[kopsapi.LabelClusterName]
-				if clusterName == "" {
-					return fmt.Errorf("must specify %q label with cluster name to replace SSHCredential", kopsapi.LabelClusterName)
+			}
+
+		case *kopsapi.InstanceGroup:
+			clusterName := v.ObjectMeta.Labels[kopsapi.LabelClusterName]
+			if clusterName == "" {
+				return fmt.Errorf("must specify %q label with cluster name to replace instanceGroup", kopsapi.LabelClusterName)
+			}
+			cluster, err := clientset.GetCluster(ctx, clusterName)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return fmt.Errorf("cluster %q not found", clusterName)
 				}
-				if v.Spec.PublicKey == "" {
-					return fmt.Errorf("spec.PublicKey is required")
+				return fmt.Errorf("error fetching cluster %q: %v", clusterName, err)
+			}
+			// check if the instancegroup exists already
+			igName := v.ObjectMeta.Name
+			ig, err := clientset.InstanceGroupsFor(cluster).Get(ctx, igName, metav1.GetOptions{})
+			if err != nil {
+				if errors.IsNotFound(err) {
+					if !c.Force {
+						return fmt.Errorf("instanceGroup: %v does not exist (try adding --force flag)", igName)
+					}
+				} else {
+					return fmt.Errorf("unable to check for instanceGroup: %v", err)
 				}
-
-				cluster, err := clientset.GetCluster(ctx, clusterName)
+			}
+			switch ig {
+			case nil:
+				klog.Infof("instanceGroup: %v was not found, creating resource now", igName)
+				_, err = clientset.InstanceGroupsFor(cluster).Create(ctx, v, metav1.CreateOptions{})
 				if err != nil {
-					return err
+					return fmt.Errorf("error creating instanceGroup: %v", err)
 				}
-
-				sshCredentialStore, err := clientset.SSHCredentialStore(cluster)
+			default:
+				_, err = clientset.InstanceGroupsFor(cluster).Update(ctx, v, metav1.UpdateOptions{})
 				if err != nil {
-					return err
+					return fmt.Errorf("error replacing instanceGroup: %v", err)
 				}
+			}
+		case *kopsapi.SSHCredential:
+			clusterName := v.ObjectMeta.Labels[kopsapi.LabelClusterName]
+			if clusterName == "" {
+				return fmt.Errorf("must specify %q label with cluster name to replace SSHCredential", kopsapi.LabelClusterName)
+			}
+			if v.Spec.PublicKey == "" {
+				return fmt.Errorf("spec.PublicKey is required")
+			}
 
-				sshKeyArr := []byte(v.Spec.PublicKey)
-				err = sshCredentialStore.AddSSHPublicKey(ctx, sshKeyArr)
-				if err != nil {
-					return fmt.Errorf("error replacing SSHCredential: %v", err)
-				}
-			default:
-				klog.V(2).Infof("Type of object was %T", v)
-				return fmt.Errorf("unhandled kind %q in %q", gvk, f)
+			cluster, err := clientset.GetCluster(ctx, clusterName)
+			if err != nil {
+				return err
+			}
+
+			sshCredentialStore, err := clientset.SSHCredentialStore(cluster)
+			if err != nil {
+				return err
+			}
+
+			sshKeyArr := []byte(v.Spec.PublicKey)
+			err = sshCredentialStore.AddSSHPublicKey(ctx, sshKeyArr)
+			if err != nil {
+				return fmt.Errorf("error replacing SSHCredential: %v", err)
 			}
+		case *unstructured.Unstructured:
+			addons = append(addons, kubemanifest.NewObject(v.Object))
+		default:
+			klog.V(2).Infof("Type of object was %T", v)
+			return fmt.Errorf("unhandled kind %q in %q", gvk, f)
+		}
+	}
+
+	// As with kops create -f, addons are replaced for the single cluster in the files
+	if len(addons) != 0 {
+		if len(clusterNames) > 1 {
+			return fmt.Errorf("cannot specify additional objects when multiple clusters are replaced")
+		}
+		if len(clusterNames) == 0 {
+			return fmt.Errorf("must specify a cluster when replacing additional objects")
+		}
+		cluster, err := clientset.GetCluster(ctx, clusterNames[0])
+		if err != nil {
+			return fmt.Errorf("error fetching cluster %q: %v", clusterNames[0], err)
+		}
+		if err := clientset.AddonsFor(cluster).Replace(addons); err != nil {
+			return fmt.Errorf("error writing additional objects: %v", err)
 		}
 	}
 
 	return nil
 }
+
+// validateReplaceWithCloud validates the clusters and instance groups as they would be after the replace,
+// including lookups in the cloud, so that errors are caught before the resources are persisted.
+func validateReplaceWithCloud(ctx context.Context, clientset simple.Clientset, objects []replaceObject) error {
+	var clusterNames []string
+	clusters := make(map[string]*kopsapi.Cluster)
+	instanceGroups := make(map[string]map[string]*kopsapi.InstanceGroup)
+	addClusterName := func(clusterName string) {
+		if _, found := instanceGroups[clusterName]; !found {
+			clusterNames = append(clusterNames, clusterName)
+			instanceGroups[clusterName] = make(map[string]*kopsapi.InstanceGroup)
+		}
+	}
+	for _, object := range objects {
+		switch v := object.Object.(type) {
+		case *kopsapi.Cluster:
+			addClusterName(v.ObjectMeta.Name)
+			clusters[v.ObjectMeta.Name] = v.DeepCopy()
+		case *kopsapi.InstanceGroup:
+			clusterName := v.ObjectMeta.Labels[kopsapi.LabelClusterName]
+			if clusterName == "" {
+				return fmt.Errorf("must specify %q label with cluster name to replace instanceGroup", kopsapi.LabelClusterName)
+			}
+			addClusterName(clusterName)
+			instanceGroups[clusterName][v.ObjectMeta.Name] = v.DeepCopy()
+		}
+	}
+
+	for _, clusterName := range clusterNames {
+		stored, err := clientset.GetCluster(ctx, clusterName)
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return fmt.Errorf("error fetching cluster %q: %v", clusterName, err)
+			}
+			stored = nil
+		}
+		cluster := clusters[clusterName]
+		if cluster == nil {
+			if stored == nil {
+				return fmt.Errorf("cluster %q not found", clusterName)
+			}
+			cluster = stored
+		}
+
+		groups := instanceGroups[clusterName]
+		if stored != nil {
+			list, err := clientset.InstanceGroupsFor(stored).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("error listing instance groups of cluster %q: %w", clusterName, err)
+			}
+			for i := range list.Items {
+				ig := &list.Items[i]
+				if groups[ig.ObjectMeta.Name] == nil {
+					groups[ig.ObjectMeta.Name] = ig
+				}
+			}
+		}
+
+		var groupList []*kopsapi.InstanceGroup
+		for _, ig := range groups {
+			groupList = append(groupList, ig)
+		}
+		sort.Slice(groupList, func(i, j int) bool {
+			return groupList[i].ObjectMeta.Name < groupList[j].ObjectMeta.Name
+		})
+
+		if err := validateClusterWithCloud(ctx, clientset, cluster, groupList); err != nil {
+			return fmt.Errorf("validation of cluster %q failed: %w", clusterName, err)
+		}
+	}
+	return nil
+}
+
+// validateClusterWithCloud populates the full specs of the cluster and its instance groups, then validates them
+// with lookups in the cloud.
+func validateClusterWithCloud(ctx context.Context, clientset simple.Clientset, cluster *kopsapi.Cluster, instanceGroups []*kopsapi.InstanceGroup) error {
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+
+	err = cloudup.PerformAssignments(cluster, clientset.VFSContext(), cloud)
+	if err != nil {
+		return fmt.Errorf("error populating configuration: %w", err)
+	}
+
+	assetBuilder := assets.NewAssetBuilder(clientset.VFSContext(), cluster.Spec.Assets, false)
+	fullCluster, err := cloudup.PopulateClusterSpec(ctx, clientset, cluster, instanceGroups, cloud, assetBuilder)
+	if err != nil {
+		return fmt.Errorf("error populating cluster spec: %w", err)
+	}
+
+	channel, err := cloudup.ChannelForCluster(clientset.VFSContext(), fullCluster)
+	if err != nil {
+		return err
+	}
+
+	var fullGroups []*kopsapi.InstanceGroup
+	for _, ig := range instanceGroups {
+		fullGroup, err := cloudup.PopulateInstanceGroupSpec(fullCluster, ig, cloud, channel)
+		if err != nil {
+			return fmt.Errorf("error populating spec of instance group %q: %w", ig.ObjectMeta.Name, err)
+		}
+		fullGroups = append(fullGroups, fullGroup)
+	}
+
+	if err := validation.DeepValidate(fullCluster, fullGroups, true, clientset.VFSContext(), cloud); err != nil {
+		return err
+	}
+	return validation.ValidateCloudResources(ctx, fullCluster, fullGroups, cloud).ToAggregate()
+}
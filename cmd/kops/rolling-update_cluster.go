@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -32,12 +33,14 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/klog/v2"
 	"k8s.io/kops/cmd/kops/util"
 	kopsapi "k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/cloudinstances"
 	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/instancegroups"
 	"k8s.io/kops/pkg/kubeconfig"
+	"k8s.io/kops/pkg/maintenancewindow"
 	"k8s.io/kops/pkg/pretty"
 	"k8s.io/kops/pkg/validation"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
@@ -89,6 +92,19 @@ var (
 		# Update only the "nodes-1a" instance group of the k8s-cluster.example.com kOps cluster.
 		kops rolling-update cluster k8s-cluster.example.com --yes \
 		  --instance-group nodes-1a
+
+		# Let ASG instance refreshes replace the worker nodes of the k8s-cluster.example.com kOps cluster,
+		# pausing for 10 minutes after replacing 20% and 50% of the instances.
+		kops rolling-update cluster k8s-cluster.example.com --yes \
+		  --instance-refresh \
+		  --instance-refresh-checkpoints 20,50 \
+		  --instance-refresh-checkpoint-delay 10m
+
+		# Resize the control plane nodes of the k8s-cluster.example.com kOps cluster by restarting them
+		# when their machine type is the only change.
+		kops rolling-update cluster k8s-cluster.example.com --yes \
+		  --instance-group-roles control-plane \
+		  --in-place-resize
 		`))
 
 	rollingupdateShort = i18n.T(`Rolling update a cluster.`)
@@ -134,6 +150,12 @@ type RollingUpdateOptions struct {
 	// Interactive rolling-update prompts user to continue after each instances is updated.
 	Interactive bool
 
+	// ForceUnlock removes the lock of the cluster held by another kops process.
+	ForceUnlock bool
+
+	// IgnoreMaintenanceWindow updates instance groups even outside the cluster's maintenance windows.
+	IgnoreMaintenanceWindow bool
+
 	ClusterName string
 
 	// InstanceGroups is the list of instance groups to rolling-update;
@@ -209,6 +231,10 @@ func NewCmdRollingUpdateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().DurationVar(&options.BastionInterval, "bastion-interval", options.BastionInterval, "Time to wait between restarting bastions")
 	cmd.Flags().DurationVar(&options.PostDrainDelay, "post-drain-delay", options.PostDrainDelay, "Time to wait after draining each node")
 	cmd.Flags().BoolVarP(&options.Interactive, "interactive", "i", options.Interactive, "Prompt to continue after each instance is updated")
+	cmd.Flags().BoolVar(&options.InstanceRefresh, "instance-refresh", options.InstanceRefresh, "Replace the instances of worker instance groups using an ASG instance refresh (AWS only)")
+	cmd.Flags().Int32SliceVar(&options.InstanceRefreshCheckpoints, "instance-refresh-checkpoints", options.InstanceRefreshCheckpoints, "Percentages of replaced instances at which an instance refresh pauses")
+	cmd.Flags().DurationVar(&options.InstanceRefreshCheckpointDelay, "instance-refresh-checkpoint-delay", options.InstanceRefreshCheckpointDelay, "Time an instance refresh pauses at each checkpoint")
+	cmd.Flags().BoolVar(&options.InPlaceResize, "in-place-resize", options.InPlaceResize, "Resize control plane instances whose only change is their machine type by restarting them instead of replacing them (AWS and GCE only)")
 	cmd.Flags().StringSliceVar(&options.InstanceGroups, "instance-group", options.InstanceGroups, "Instance groups to update (defaults to all if not specified)")
 	cmd.RegisterFlagCompletionFunc("instance-group", completeInstanceGroup(f, &options.InstanceGroups, &options.InstanceGroupRoles))
 	cmd.Flags().StringSliceVar(&options.InstanceGroupRoles, "instance-group-roles", options.InstanceGroupRoles, "Instance group roles to update ("+strings.Join(allRoles, ",")+")")
@@ -218,6 +244,8 @@ func NewCmdRollingUpdateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 
 	cmd.Flags().BoolVar(&options.FailOnDrainError, "fail-on-drain-error", true, "Fail if draining a node fails")
 	cmd.Flags().BoolVar(&options.FailOnValidate, "fail-on-validate-error", true, "Fail if the cluster fails to validate")
+	cmd.Flags().BoolVar(&options.ForceUnlock, "force-unlock", options.ForceUnlock, "Remove the lock of the cluster held by another kops process, if that process is known to have stopped")
+	cmd.Flags().BoolVar(&options.IgnoreMaintenanceWindow, "ignore-maintenance-window", options.IgnoreMaintenanceWindow, "Update instance groups even outside the maintenance windows of the cluster")
 
 	options.CreateKubecfgOptions.AddCommonFlags(cmd.Flags())
 
@@ -245,6 +273,16 @@ func RunRollingUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer
 		return err
 	}
 
+	if err := options.ValidateInstanceRefresh(cluster); err != nil {
+		return err
+	}
+	if options.InstanceRefresh && options.Interactive {
+		return fmt.Errorf("--interactive cannot be used together with --instance-refresh")
+	}
+	if err := options.ValidateInPlaceResize(cluster); err != nil {
+		return err
+	}
+
 	var nodes []v1.Node
 	var k8sClient kubernetes.Interface
 	if !options.CloudOnly {
@@ -436,6 +474,12 @@ func RunRollingUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer
 		}
 	}
 
+	if !options.IgnoreMaintenanceWindow {
+		if err := skipGroupsOutsideMaintenanceWindows(out, cluster, groups, options.Force, time.Now()); err != nil {
+			return err
+		}
+	}
+
 	needUpdate := false
 	for _, group := range groups {
 		if len(group.NeedUpdate) != 0 {
@@ -453,6 +497,16 @@ func RunRollingUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer
 		return nil
 	}
 
+	lock, err := lockCluster(ctx, clientset, cluster, options.ForceUnlock)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := lock.Release(context.WithoutCancel(ctx)); err != nil {
+			klog.Warningf("%v", err)
+		}
+	}()
+
 	var clusterValidator validation.ClusterValidator
 	if !options.CloudOnly {
 		restConfig, err := f.RESTConfig(ctx, cluster, options.CreateKubecfgOptions)
@@ -470,6 +524,41 @@ func RunRollingUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer
 	return d.RollingUpdate(ctx, groups, list)
 }
 
+// skipGroupsOutsideMaintenanceWindows removes the groups whose instance groups are outside their maintenance windows at now,
+// reporting the ones that would otherwise have been updated.
+func skipGroupsOutsideMaintenanceWindows(out io.Writer, cluster *kopsapi.Cluster, groups map[string]*cloudinstances.CloudInstanceGroup, force bool, now time.Time) error {
+	windows, err := maintenancewindow.ForCluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		group := groups[name]
+		igName := group.InstanceGroup.ObjectMeta.Name
+		open, next := maintenancewindow.Check(windows, igName, now)
+		if open {
+			continue
+		}
+		delete(groups, name)
+		if len(group.NeedUpdate) == 0 && !force {
+			continue
+		}
+		if next.IsZero() {
+			fmt.Fprintf(out, "\nNot updating instance group %q, which is outside its maintenance windows; none of them is scheduled to open.\n", igName)
+		} else {
+			fmt.Fprintf(out, "\nNot updating instance group %q, which is outside its maintenance windows; the next one opens at %s.\n", igName, next.Format(time.RFC3339))
+		}
+		fmt.Fprintf(out, "Use --ignore-maintenance-window to update it now.\n")
+	}
+	return nil
+}
+
 func completeInstanceGroup(f commandutils.Factory, selectedInstanceGroups *[]string, selectedInstanceGroupRoles *[]string) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		ctx := cmd.Context()
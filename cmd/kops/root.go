@@ -39,6 +39,9 @@ import (
 	"k8s.io/kops/pkg/client/simple"
 	"k8s.io/kops/pkg/commands"
 	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
 	"k8s.io/kubectl/pkg/util/i18n"
 	"k8s.io/kubectl/pkg/util/templates"
 )
@@ -85,8 +88,11 @@ var rootCommand = RootCmd{
 		Use:   "kops",
 		Short: rootShort,
 		Long:  rootLong,
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		// Errors are printed by main, in the format selected with --error-output.
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
+			return validateErrorOutput(viper.GetString("KOPS_ERROR_OUTPUT"))
 		},
 	},
 }
@@ -97,7 +103,23 @@ func Execute(ctx context.Context) error {
 
 	goflag.Set("logtostderr", "true")
 	goflag.CommandLine.Parse([]string{})
-	return rootCommand.cobraCommand.ExecuteContext(ctx)
+	err := rootCommand.cobraCommand.ExecuteContext(ctx)
+	reportCloudAPIMetrics(os.Stderr)
+	return err
+}
+
+// reportCloudAPIMetrics prints the cloud API call counters collected during the command,
+// if requested, and warns when the cloud provider throttled us.
+func reportCloudAPIMetrics(w io.Writer) {
+	metrics := awsup.DefaultAPIMetrics()
+	if viper.GetBool("KOPS_CLOUD_API_METRICS") || viper.GetBool("KOPS_LOG_CLOUD_REQUESTS") {
+		fmt.Fprintf(w, "\nAWS API calls:\n")
+		if err := metrics.Write(w); err != nil {
+			klog.Warningf("error writing API metrics: %v", err)
+		}
+	} else if throttled := metrics.Throttled(); throttled > 0 {
+		klog.Warningf("AWS throttled %d API requests; use --cloud-api-metrics for details", throttled)
+	}
 }
 
 func init() {
@@ -162,11 +184,32 @@ func NewCmdRoot(f *util.Factory, out io.Writer) *cobra.Command {
 	viper.BindEnv("KOPS_STATE_STORE")
 	// TODO implement completion against VFS
 
+	cmd.PersistentFlags().Duration("cloud-cache-ttl", 0, "How long to cache cloud lookups (images, instance types, zones, VPC subnets) on disk, shared between commands. Overrides KOPS_CLOUD_CACHE_TTL environment variable")
+	viper.BindPFlag("KOPS_CLOUD_CACHE_TTL", cmd.PersistentFlags().Lookup("cloud-cache-ttl"))
+	viper.BindEnv("KOPS_CLOUD_CACHE_TTL")
+
+	cmd.PersistentFlags().Bool("cloud-api-metrics", false, "Print the number of cloud API calls, retries and throttled requests made by the command. Overrides KOPS_CLOUD_API_METRICS environment variable")
+	viper.BindPFlag("KOPS_CLOUD_API_METRICS", cmd.PersistentFlags().Lookup("cloud-api-metrics"))
+	viper.BindEnv("KOPS_CLOUD_API_METRICS")
+
+	cmd.PersistentFlags().Bool("log-cloud-requests", false, "Log each cloud API call, with its duration and result, and print a summary when the command finishes. Credentials and secrets are redacted. Overrides KOPS_LOG_CLOUD_REQUESTS environment variable")
+	viper.BindPFlag("KOPS_LOG_CLOUD_REQUESTS", cmd.PersistentFlags().Lookup("log-cloud-requests"))
+	viper.BindEnv("KOPS_LOG_CLOUD_REQUESTS")
+
+	cmd.PersistentFlags().String("error-output", errorOutputText, "Format for printing the error when the command fails. One of text|json. Overrides KOPS_ERROR_OUTPUT environment variable")
+	viper.BindPFlag("KOPS_ERROR_OUTPUT", cmd.PersistentFlags().Lookup("error-output"))
+	viper.BindEnv("KOPS_ERROR_OUTPUT")
+	cmd.RegisterFlagCompletionFunc("error-output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{errorOutputText, errorOutputJSON}, cobra.ShellCompDirectiveNoFileComp
+	})
+
 	defaultClusterName := os.Getenv("KOPS_CLUSTER_NAME")
 	cmd.PersistentFlags().StringVarP(&rootCommand.clusterName, "name", "", defaultClusterName, "Name of cluster. Overrides KOPS_CLUSTER_NAME environment variable")
 	cmd.RegisterFlagCompletionFunc("name", commandutils.CompleteClusterName(rootCommand.factory, false, false))
 
 	// create subcommands
+	cmd.AddCommand(NewCmdApply(f, out))
+	cmd.AddCommand(NewCmdClone(f, out))
 	cmd.AddCommand(NewCmdCreate(f, out))
 	cmd.AddCommand(NewCmdDelete(f, out))
 	cmd.AddCommand(NewCmdDistrust(f, out))
@@ -179,6 +222,7 @@ func NewCmdRoot(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.AddCommand(NewCmdReconcile(f, out))
 	cmd.AddCommand(NewCmdReplace(f, out))
 	cmd.AddCommand(NewCmdRollingUpdate(f, out))
+	cmd.AddCommand(NewCmdRotate(f, out))
 	cmd.AddCommand(NewCmdToolbox(f, out))
 	cmd.AddCommand(NewCmdTrust(f, out))
 	cmd.AddCommand(NewCmdUpdate(f, out))
@@ -223,6 +267,19 @@ func initConfig() {
 
 	// Tolerate multiple slashes at end
 	rootCommand.RegistryPath = strings.TrimSuffix(rootCommand.RegistryPath, "/")
+
+	if ttl := viper.GetDuration("KOPS_CLOUD_CACHE_TTL"); ttl > 0 {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			klog.Warningf("not caching cloud lookups: %v", err)
+		} else {
+			cloudup.CloudCache = fi.NewDiskCache(filepath.Join(cacheDir, "kops", "cloud"), ttl)
+		}
+	}
+
+	if viper.GetBool("KOPS_LOG_CLOUD_REQUESTS") {
+		awsup.APIRequestLogger = awsup.NewRequestLogger(os.Stderr)
+	}
 }
 
 func (c *RootCmd) AddCommand(cmd *cobra.Command) {
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/kubeconfig"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	rotateEncryptionKeyLong = templates.LongDesc(i18n.T(`
+	Rewrite the resources that are encrypted at rest, so that kube-apiserver encrypts them
+	again with the current key.
+
+	Run this after the KMS key in kmsEncryption.keyID has been changed or rotated, or after
+	the encryptionconfig secret has been replaced, once the cluster has been updated and the
+	control plane has been rolled. When the command completes, the keys in
+	kmsEncryption.previousKeyIDs are no longer needed and can be removed.`))
+
+	rotateEncryptionKeyExample = templates.Examples(i18n.T(`
+	# Re-encrypt the resources configured in kmsEncryption
+	kops rotate encryption-key --name k8s-cluster.example.com --state s3://my-state-store
+
+	# Re-encrypt secrets and configmaps
+	kops rotate encryption-key --name k8s-cluster.example.com --resource secrets --resource configmaps
+	`))
+
+	rotateEncryptionKeyShort = i18n.T(`Re-encrypt resources after an encryption key change.`)
+)
+
+type RotateEncryptionKeyOptions struct {
+	ClusterName string
+
+	// Resources are the resources to rewrite. Defaults to the resources encrypted by kmsEncryption.
+	Resources []string
+
+	kubeconfig.CreateKubecfgOptions
+}
+
+func NewCmdRotateEncryptionKey(f commandutils.Factory, out io.Writer) *cobra.Command {
+	options := &RotateEncryptionKeyOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "encryption-key [CLUSTER]",
+		Short:             rotateEncryptionKeyShort,
+		Long:              rotateEncryptionKeyLong,
+		Example:           rotateEncryptionKeyExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunRotateEncryptionKey(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&options.Resources, "resource", options.Resources, "Resource to re-encrypt, such as secrets or configmaps (may be repeated)")
+	cmd.RegisterFlagCompletionFunc("resource", cobra.NoFileCompletions)
+	options.CreateKubecfgOptions.AddCommonFlags(cmd.Flags())
+
+	return cmd
+}
+
+func RunRotateEncryptionKey(ctx context.Context, f commandutils.Factory, out io.Writer, options *RotateEncryptionKeyOptions) error {
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	kms := cluster.Spec.KMSEncryption
+	if kms == nil && !fi.ValueOf(cluster.Spec.EncryptionConfig) {
+		return fmt.Errorf("encryption at rest is not enabled for cluster %q", cluster.ObjectMeta.Name)
+	}
+
+	resources := options.Resources
+	if len(resources) == 0 && kms != nil {
+		resources = kms.Resources
+	}
+	if len(resources) == 0 {
+		resources = []string{"secrets"}
+	}
+
+	restConfig, err := f.RESTConfig(ctx, cluster, options.CreateKubecfgOptions)
+	if err != nil {
+		return fmt.Errorf("getting rest config: %w", err)
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("building discovery client: %w", err)
+	}
+	groupResources, err := restmapper.GetAPIGroupResources(discoveryClient)
+	if err != nil {
+		return fmt.Errorf("discovering API resources: %w", err)
+	}
+	mapper := restmapper.NewDiscoveryRESTMapper(groupResources)
+
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("building dynamic client: %w", err)
+	}
+
+	for _, resource := range resources {
+		gvr, err := mapper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+		if err != nil {
+			return fmt.Errorf("resolving resource %q: %w", resource, err)
+		}
+
+		count, err := rewriteResources(ctx, dynamicClient, gvr)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Re-encrypted %d %s\n", count, gvr.GroupResource())
+	}
+
+	return nil
+}
+
+// rewriteResources updates every object of the resource without changes.
+// kube-apiserver stores again the objects that are encrypted with a key other than the current one.
+func rewriteResources(ctx context.Context, client dynamic.Interface, gvr schema.GroupVersionResource) (int, error) {
+	count := 0
+	continueToken := ""
+	for {
+		list, err := client.Resource(gvr).List(ctx, metav1.ListOptions{Limit: 500, Continue: continueToken})
+		if err != nil {
+			return count, fmt.Errorf("listing %s: %w", gvr.GroupResource(), err)
+		}
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if _, err := client.Resource(gvr).Namespace(obj.GetNamespace()).Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+				if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+					// The object was deleted, or has been written again since it was listed
+					continue
+				}
+				return count, fmt.Errorf("updating %s %s/%s: %w", gvr.GroupResource(), obj.GetNamespace(), obj.GetName(), err)
+			}
+			count++
+		}
+
+		continueToken = list.GetContinue()
+		if continueToken == "" {
+			return count, nil
+		}
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestRewriteResources(t *testing.T) {
+	secrets := schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+
+	newSecret := func(namespace, name string) runtime.Object {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("Secret")
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{secrets: "SecretList"},
+		newSecret("default", "a"),
+		newSecret("kube-system", "b"),
+	)
+
+	count, err := rewriteResources(context.Background(), client, secrets)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 objects to be rewritten, got %d", count)
+	}
+
+	updated := map[string]bool{}
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "update" {
+			updated[action.GetNamespace()] = true
+		}
+	}
+	for _, namespace := range []string{"default", "kube-system"} {
+		if !updated[namespace] {
+			t.Errorf("expected secret in namespace %q to be updated", namespace)
+		}
+	}
+}
@@ -20,21 +20,27 @@ import (
 	"io"
 
 	"github.com/spf13/cobra"
-	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/cmd/kops/util"
 	"k8s.io/kops/pkg/commands/toolbox"
 	"k8s.io/kubectl/pkg/util/i18n"
 )
 
 var toolboxShort = i18n.T(`Miscellaneous, experimental, or infrequently used commands.`)
 
-func NewCmdToolbox(f commandutils.Factory, out io.Writer) *cobra.Command {
+func NewCmdToolbox(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "toolbox",
 		Short: toolboxShort,
 	}
 
+	cmd.AddCommand(NewCmdToolboxBuildImage(f, out))
+	cmd.AddCommand(NewCmdToolboxCost(f, out))
 	cmd.AddCommand(NewCmdToolboxDump(f, out))
 	cmd.AddCommand(NewCmdToolboxEnroll(f, out))
+	cmd.AddCommand(NewCmdToolboxEtcd(f, out))
+	cmd.AddCommand(NewCmdToolboxExportClusterBundle(f, out))
+	cmd.AddCommand(NewCmdToolboxImportClusterBundle(f, out))
+	cmd.AddCommand(NewCmdToolboxTags(f, out))
 	cmd.AddCommand(NewCmdToolboxTemplate(f, out))
 	cmd.AddCommand(NewCmdToolboxInstanceSelector(f, out))
 	cmd.AddCommand(NewCmdToolboxAddons(out))
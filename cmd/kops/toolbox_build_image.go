@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/pkg/commands"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+func NewCmdToolboxBuildImage(f commandutils.Factory, out io.Writer) *cobra.Command {
+	options := &commands.ToolboxBuildImageOptions{}
+	options.InitDefaults()
+
+	cmd := &cobra.Command{
+		Use:   "build-image [CLUSTER]",
+		Short: i18n.T(`Build a node image with the cluster assets preloaded`),
+		Long: templates.LongDesc(i18n.T(`
+			Builds an image for an instance group with Packer, starting from the current image of
+			the instance group, with its files (such as kubelet and containerd) and container
+			images preloaded. Nodes using the image boot faster and don't depend on the asset
+			repositories being available.
+
+			The files are stored in spec.assets.nodeDownloads.cacheDirectory, which must be set.
+
+			Without --yes, only writes the Packer template. With --yes, runs Packer and sets the
+			image of the instance group to the new image. AWS only.`)),
+		Example: templates.Examples(i18n.T(`
+			# Write the Packer template, to review or build it yourself
+			kops toolbox build-image --name k8s-cluster.example.com --instance-group nodes --out ./image
+
+			# Build the image and use it for the instance group
+			kops toolbox build-image --name k8s-cluster.example.com --instance-group nodes --yes
+		`)),
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return commands.RunToolboxBuildImage(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.InstanceGroup, "instance-group", options.InstanceGroup, "Name of the instance group to build the image for")
+	cmd.RegisterFlagCompletionFunc("instance-group", completeInstanceGroup(f, nil, nil))
+	cmd.Flags().StringVar(&options.SSHUsername, "ssh-username", options.SSHUsername, "User to connect to the build instance as; guessed from the source image by default")
+	cmd.Flags().StringVar(&options.SubnetID, "subnet-id", options.SubnetID, "Subnet to launch the build instance in; the default VPC is used by default")
+	cmd.Flags().StringVar(&options.Out, "out", options.Out, "Directory to write the Packer template to; a temporary directory by default")
+	cmd.Flags().StringVar(&options.Packer, "packer", options.Packer, "Packer binary to run")
+	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Build the image and update the instance group")
+
+	return cmd
+}
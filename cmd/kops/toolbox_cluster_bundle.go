@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"filippo.io/age"
+	"github.com/spf13/cobra"
+	"k8s.io/klog/v2"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/clusterbundle"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/kubeconfig"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	toolboxExportClusterBundleLong = templates.LongDesc(i18n.T(`
+	Export the state of a cluster to a single archive.
+
+	The bundle holds the cluster spec, instance groups, additional objects, keysets, secrets
+	and SSH public keys of the cluster, and the location of its latest etcd backups.
+	The etcd backups themselves are not part of the bundle.
+
+	Keysets and secrets include private keys. Use --encrypt-to to encrypt them with age
+	(https://age-encryption.org); otherwise they are written in plaintext.`))
+
+	toolboxExportClusterBundleExample = templates.Examples(i18n.T(`
+	# Export a cluster, encrypting its keys and secrets
+	kops toolbox export-cluster-bundle --name k8s-cluster.example.com --output k8s-cluster.tar.gz --encrypt-to age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p
+	`))
+
+	toolboxExportClusterBundleShort = i18n.T(`Export the state of a cluster to a bundle`)
+
+	toolboxImportClusterBundleLong = templates.LongDesc(i18n.T(`
+	Import a cluster bundle written by kops toolbox export-cluster-bundle into the state store.
+
+	The cluster must not already exist in the state store. Locations in the state store the
+	bundle was exported from are moved to the new state store.`))
+
+	toolboxImportClusterBundleExample = templates.Examples(i18n.T(`
+	# Import an encrypted bundle into a new state store
+	kops toolbox import-cluster-bundle k8s-cluster.tar.gz --identity key.txt --state s3://new-state-store
+	`))
+
+	toolboxImportClusterBundleShort = i18n.T(`Import a cluster bundle into the state store`)
+)
+
+type ToolboxExportClusterBundleOptions struct {
+	ClusterName string
+
+	// Output is the file to write the bundle to, or "-" to write it to stdout
+	Output string
+
+	// EncryptTo are age recipients to encrypt keysets and secrets to
+	EncryptTo []string
+}
+
+type ToolboxImportClusterBundleOptions struct {
+	// Filename is the bundle to import, or "-" to read it from stdin
+	Filename string
+
+	// IdentityFiles are age identity files holding the keys to decrypt the bundle with
+	IdentityFiles []string
+}
+
+func NewCmdToolboxExportClusterBundle(f commandutils.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxExportClusterBundleOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "export-cluster-bundle [CLUSTER]",
+		Short:             toolboxExportClusterBundleShort,
+		Long:              toolboxExportClusterBundleLong,
+		Example:           toolboxExportClusterBundleExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunToolboxExportClusterBundle(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "File to write the bundle to, or - for stdout")
+	cmd.MarkFlagRequired("output")
+	cmd.Flags().StringSliceVar(&options.EncryptTo, "encrypt-to", options.EncryptTo, "Encrypt keysets and secrets to the specified age recipients (age1... or age plugin recipients)")
+	cmd.RegisterFlagCompletionFunc("encrypt-to", cobra.NoFileCompletions)
+
+	return cmd
+}
+
+func NewCmdToolboxImportClusterBundle(f commandutils.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxImportClusterBundleOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "import-cluster-bundle FILE",
+		Short:   toolboxImportClusterBundleShort,
+		Long:    toolboxImportClusterBundleLong,
+		Example: toolboxImportClusterBundleExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Filename = args[0]
+			return RunToolboxImportClusterBundle(cmd.Context(), f, cmd.InOrStdin(), out, options)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&options.IdentityFiles, "identity", "i", options.IdentityFiles, "age identity file to decrypt the bundle with (may be repeated)")
+
+	return cmd
+}
+
+func RunToolboxExportClusterBundle(ctx context.Context, f commandutils.Factory, out io.Writer, options *ToolboxExportClusterBundleOptions) error {
+	var recipients []age.Recipient
+	if len(options.EncryptTo) != 0 {
+		var err error
+		recipients, err = kubeconfig.ParseRecipients(options.EncryptTo)
+		if err != nil {
+			return err
+		}
+	} else {
+		klog.Warningf("the bundle contains private keys and secrets in plaintext; use --encrypt-to to encrypt them")
+	}
+
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	exportOptions := &clusterbundle.ExportOptions{
+		Recipients:  recipients,
+		EtcdBackups: etcdBackupReferences(ctx, f, cluster),
+		Now:         time.Now(),
+	}
+
+	w := out
+	if options.Output != "-" {
+		// Don't overwrite an existing bundle, and keep the private keys readable only by the user
+		file, err := os.OpenFile(options.Output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if err != nil {
+			return fmt.Errorf("error creating bundle: %w", err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	manifest, err := clusterbundle.Export(ctx, clientset, cluster, w, exportOptions)
+	if err != nil {
+		if options.Output != "-" {
+			os.Remove(options.Output)
+		}
+		return err
+	}
+
+	if options.Output != "-" {
+		fmt.Fprintf(out, "Exported cluster %q to %s\n", manifest.ClusterName, options.Output)
+	}
+	return nil
+}
+
+// etcdBackupReferences returns the latest backups of the etcd clusters, or nothing if they cannot be determined
+func etcdBackupReferences(ctx context.Context, f commandutils.Factory, cluster *kopsapi.Cluster) []clusterbundle.EtcdBackupReference {
+	etcdClusters, stores, err := etcdBackupStores(ctx, f, cluster, nil)
+	if err != nil {
+		klog.Warningf("not recording etcd backups in the bundle: %v", err)
+		return nil
+	}
+
+	var references []clusterbundle.EtcdBackupReference
+	for _, etcdCluster := range etcdClusters {
+		reference := clusterbundle.EtcdBackupReference{
+			EtcdCluster: etcdCluster.Name,
+			BackupStore: etcdCluster.Backups.BackupStore,
+		}
+		backups, err := stores[etcdCluster.Name].ListBackups(ctx)
+		if err != nil {
+			klog.Warningf("unable to list backups of etcd cluster %q: %v", etcdCluster.Name, err)
+		} else if len(backups) != 0 {
+			reference.LatestBackup = backups[len(backups)-1].Name
+		}
+		references = append(references, reference)
+	}
+	return references
+}
+
+func RunToolboxImportClusterBundle(ctx context.Context, f commandutils.Factory, in io.Reader, out io.Writer, options *ToolboxImportClusterBundleOptions) error {
+	var identities []age.Identity
+	for _, identityFile := range options.IdentityFiles {
+		file, err := os.Open(identityFile)
+		if err != nil {
+			return fmt.Errorf("error opening identity file: %w", err)
+		}
+		ids, err := kubeconfig.ParseIdentities(file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("error reading identity file %q: %w", identityFile, err)
+		}
+		identities = append(identities, ids...)
+	}
+
+	src := in
+	if options.Filename != "-" {
+		file, err := os.Open(options.Filename)
+		if err != nil {
+			return fmt.Errorf("error opening bundle: %w", err)
+		}
+		defer file.Close()
+		src = file
+	}
+
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	manifest, cluster, err := clusterbundle.Import(ctx, clientset, src, &clusterbundle.ImportOptions{Identities: identities})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Imported cluster %q, exported by kOps %s at %s\n", cluster.ObjectMeta.Name, manifest.KopsVersion, manifest.Created.Format(time.RFC3339))
+	if len(manifest.EtcdBackups) != 0 {
+		fmt.Fprintf(out, "\nThe etcd backups were not imported. When exported, they were in:\n")
+		for _, reference := range manifest.EtcdBackups {
+			if reference.LatestBackup != "" {
+				fmt.Fprintf(out, "  %s: %s (latest backup %s)\n", reference.EtcdCluster, reference.BackupStore, reference.LatestBackup)
+			} else {
+				fmt.Fprintf(out, "  %s: %s\n", reference.EtcdCluster, reference.BackupStore)
+			}
+		}
+	}
+	fmt.Fprintf(out, "\nTo move the cluster to this state store, run: kops update cluster --name %s --yes\n", cluster.ObjectMeta.Name)
+	fmt.Fprintf(out, "and then: kops rolling-update cluster --name %s --yes\n", cluster.ObjectMeta.Name)
+	return nil
+}
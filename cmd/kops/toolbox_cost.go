@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	toolboxCostShort = i18n.T(`Estimate the cost of a cluster`)
+
+	toolboxCostEstimateLong = templates.LongDesc(i18n.T(`
+	Estimate the monthly cost of the cloud resources of a cluster, as described by its spec.
+
+	The cluster spec and instance groups are expanded into cloud resources in the same way as
+	kops update cluster. Instances are priced with the cloud pricing API at the on-demand rate,
+	so the estimate is an upper bound for instance groups using spot instances. Volumes, load
+	balancers, NAT gateways and elastic IPs are priced with approximate list prices.
+
+	To see how the cost changes when applying the spec, use kops update cluster --cost-estimate.
+	Only AWS is supported.`))
+
+	toolboxCostEstimateExample = templates.Examples(i18n.T(`
+	# Estimate the monthly cost of a cluster
+	kops toolbox cost estimate --name k8s-cluster.example.com
+
+	# Estimate the monthly cost of a cluster, as JSON
+	kops toolbox cost estimate --name k8s-cluster.example.com -o json
+	`))
+
+	toolboxCostEstimateShort = i18n.T(`Estimate the monthly cost of a cluster`)
+)
+
+type ToolboxCostEstimateOptions struct {
+	ClusterName string
+	Output      string
+}
+
+func NewCmdToolboxCost(f *util.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cost",
+		Short: toolboxCostShort,
+	}
+
+	cmd.AddCommand(NewCmdToolboxCostEstimate(f, out))
+
+	return cmd
+}
+
+func NewCmdToolboxCostEstimate(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxCostEstimateOptions{
+		Output: OutputTable,
+	}
+
+	cmd := &cobra.Command{
+		Use:               "estimate [CLUSTER]",
+		Short:             toolboxCostEstimateShort,
+		Long:              toolboxCostEstimateLong,
+		Example:           toolboxCostEstimateExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunToolboxCostEstimate(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "Output format. One of: table, yaml, json")
+	cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{OutputTable, OutputJSON, OutputYaml}, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	return cmd
+}
+
+func RunToolboxCostEstimate(ctx context.Context, f *util.Factory, out io.Writer, options *ToolboxCostEstimateOptions) error {
+	// The dry-run report and hints are replaced by our own output.
+	updateClusterResults, err := RunUpdateCluster(ctx, f, io.Discard, &UpdateClusterOptions{
+		CoreUpdateClusterOptions: CoreUpdateClusterOptions{
+			Target:       cloudup.TargetDryRun,
+			ClusterName:  options.ClusterName,
+			DryRunReport: io.Discard,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	cloud, err := cloudup.BuildCloud(updateClusterResults.Cluster)
+	if err != nil {
+		return err
+	}
+	estimator, err := newCostEstimator(ctx, cloud)
+	if err != nil {
+		return err
+	}
+	estimate, err := estimator.Estimate(ctx, updateClusterResults.TaskMap)
+	if err != nil {
+		return fmt.Errorf("error estimating cost: %w", err)
+	}
+
+	switch options.Output {
+	case OutputTable:
+		return costEstimateOutputTable(estimate, out)
+	case OutputYaml:
+		y, err := yaml.Marshal(estimate)
+		if err != nil {
+			return fmt.Errorf("unable to marshal YAML: %v", err)
+		}
+		_, err = out.Write(y)
+		return err
+	case OutputJSON:
+		j, err := json.Marshal(estimate)
+		if err != nil {
+			return fmt.Errorf("unable to marshal JSON: %v", err)
+		}
+		_, err = out.Write(j)
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %q", options.Output)
+	}
+}
+
+// newCostEstimator builds a cost estimator for the cloud, which must be AWS.
+func newCostEstimator(ctx context.Context, cloud fi.Cloud) (*cloudup.CostEstimator, error) {
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return nil, fmt.Errorf("cost estimates are only supported on AWS, not %s", cloud.ProviderID())
+	}
+	return cloudup.NewCostEstimator(ctx, awsCloud)
+}
+
+func costEstimateOutputTable(estimate *cloudup.CostEstimate, out io.Writer) error {
+	igs := &tables.Table{}
+	igs.AddColumn("INSTANCE GROUP", func(ig *cloudup.InstanceGroupCost) string {
+		return ig.Name
+	})
+	igs.AddColumn("INSTANCE TYPE", func(ig *cloudup.InstanceGroupCost) string {
+		return ig.InstanceType
+	})
+	igs.AddColumn("MIN", func(ig *cloudup.InstanceGroupCost) string {
+		return fmt.Sprintf("%d", ig.MinSize)
+	})
+	igs.AddColumn("MAX", func(ig *cloudup.InstanceGroupCost) string {
+		return fmt.Sprintf("%d", ig.MaxSize)
+	})
+	igs.AddColumn("PER INSTANCE", func(ig *cloudup.InstanceGroupCost) string {
+		return formatCost(ig.InstanceMonthlyCost)
+	})
+	igs.AddColumn("MONTHLY COST", func(ig *cloudup.InstanceGroupCost) string {
+		return formatCostRange(ig.MinMonthlyCost, ig.MaxMonthlyCost)
+	})
+	if err := igs.Render(estimate.InstanceGroups, out, "INSTANCE GROUP", "INSTANCE TYPE", "MIN", "MAX", "PER INSTANCE", "MONTHLY COST"); err != nil {
+		return err
+	}
+
+	if len(estimate.Resources) != 0 {
+		fmt.Fprintf(out, "\n")
+		resources := &tables.Table{}
+		resources.AddColumn("TYPE", func(r *cloudup.ResourceCost) string {
+			return r.Type
+		})
+		resources.AddColumn("NAME", func(r *cloudup.ResourceCost) string {
+			return r.Name
+		})
+		resources.AddColumn("MONTHLY COST", func(r *cloudup.ResourceCost) string {
+			return formatCost(r.MonthlyCost)
+		})
+		if err := resources.Render(estimate.Resources, out, "TYPE", "NAME", "MONTHLY COST"); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(out, "\nEstimated monthly cost: %s\n", formatCostRange(estimate.MinMonthlyCost, estimate.MaxMonthlyCost))
+	return err
+}
+
+// writeCostChange prints the estimated monthly cost of the cluster before and after applying the changes of a dry-run.
+func writeCostChange(ctx context.Context, out io.Writer, cloud fi.Cloud, target *fi.CloudupDryRunTarget, taskMap map[string]fi.CloudupTask) error {
+	estimator, err := newCostEstimator(ctx, cloud)
+	if err != nil {
+		return err
+	}
+	current, err := estimator.Estimate(ctx, target.ActualTasks(taskMap))
+	if err != nil {
+		return fmt.Errorf("error estimating current cost: %w", err)
+	}
+	proposed, err := estimator.Estimate(ctx, taskMap)
+	if err != nil {
+		return fmt.Errorf("error estimating proposed cost: %w", err)
+	}
+
+	changes := cloudup.DiffCostEstimates(current, proposed)
+	if len(changes) != 0 {
+		fmt.Fprintf(out, "\nEstimated monthly cost changes:\n\n")
+		t := &tables.Table{}
+		t.AddColumn("TYPE", func(c *cloudup.CostChange) string {
+			return c.Type
+		})
+		t.AddColumn("NAME", func(c *cloudup.CostChange) string {
+			return c.Name
+		})
+		t.AddColumn("CURRENT", func(c *cloudup.CostChange) string {
+			return formatCostRange(c.CurrentMinMonthlyCost, c.CurrentMaxMonthlyCost)
+		})
+		t.AddColumn("PROPOSED", func(c *cloudup.CostChange) string {
+			return formatCostRange(c.ProposedMinMonthlyCost, c.ProposedMaxMonthlyCost)
+		})
+		t.AddColumn("CHANGE", func(c *cloudup.CostChange) string {
+			return formatCostDelta(c.ProposedMinMonthlyCost-c.CurrentMinMonthlyCost, c.ProposedMaxMonthlyCost-c.CurrentMaxMonthlyCost)
+		})
+		if err := t.Render(changes, out, "TYPE", "NAME", "CURRENT", "PROPOSED", "CHANGE"); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "\nEstimated monthly cost: %s -> %s (%s)\n\n",
+		formatCostRange(current.MinMonthlyCost, current.MaxMonthlyCost),
+		formatCostRange(proposed.MinMonthlyCost, proposed.MaxMonthlyCost),
+		formatCostDelta(proposed.MinMonthlyCost-current.MinMonthlyCost, proposed.MaxMonthlyCost-current.MaxMonthlyCost))
+	return nil
+}
+
+func formatCost(cost float64) string {
+	return fmt.Sprintf("$%.2f", cost)
+}
+
+// formatCostRange formats the cost of a cluster or instance group between its min and max size.
+func formatCostRange(minCost, maxCost float64) string {
+	if minCost == maxCost {
+		return formatCost(minCost)
+	}
+	return formatCost(minCost) + " - " + formatCost(maxCost)
+}
+
+// formatCostDelta formats a change in cost, with its sign.
+func formatCostDelta(minDelta, maxDelta float64) string {
+	format := func(delta float64) string {
+		if delta < 0 {
+			return "-" + formatCost(math.Abs(delta))
+		}
+		return "+" + formatCost(delta)
+	}
+	if minDelta == maxDelta {
+		return format(minDelta)
+	}
+	return format(minDelta) + " - " + format(maxDelta)
+}
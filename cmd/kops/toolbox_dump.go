@@ -28,6 +28,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2instanceconnect"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/spf13/cobra"
 	"golang.org/x/crypto/ssh"
 	"golang.org/x/crypto/ssh/agent"
@@ -37,6 +38,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/commands"
 	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/dump"
 	"k8s.io/kops/pkg/resources"
@@ -75,6 +77,9 @@ type ToolboxDumpOptions struct {
 
 	// CloudResources controls whether we dump the cloud resources
 	CloudResources bool
+
+	// Transport is how commands are run on nodes: ssh, or ssm for AWS Systems Manager
+	Transport string
 }
 
 func (o *ToolboxDumpOptions) InitDefaults() {
@@ -85,6 +90,7 @@ func (o *ToolboxDumpOptions) InitDefaults() {
 	o.NodeDumpTimeout = time.Minute
 	o.K8sResources = k8sResources != ""
 	o.CloudResources = true
+	o.Transport = commands.NodeTransportSSH
 }
 
 func NewCmdToolboxDump(f commandutils.Factory, out io.Writer) *cobra.Command {
@@ -117,11 +123,19 @@ func NewCmdToolboxDump(f commandutils.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().StringVar(&options.PrivateKey, "private-key", options.PrivateKey, "File containing private key to use for SSH access to instances")
 	cmd.Flags().StringVar(&options.SSHUser, "ssh-user", options.SSHUser, "The remote user for SSH access to instances")
 	cmd.RegisterFlagCompletionFunc("ssh-user", cobra.NoFileCompletions)
+	cmd.Flags().StringVar(&options.Transport, "transport", options.Transport, "How to run commands on nodes when collecting logs.  One of ssh or ssm (AWS Systems Manager, for nodes without SSH access)")
+	cmd.RegisterFlagCompletionFunc("transport", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{commands.NodeTransportSSH, commands.NodeTransportSSM}, cobra.ShellCompDirectiveNoFileComp
+	})
 
 	return cmd
 }
 
 func RunToolboxDump(ctx context.Context, f commandutils.Factory, out io.Writer, options *ToolboxDumpOptions) error {
+	if options.Transport != commands.NodeTransportSSH && options.Transport != commands.NodeTransportSSM {
+		return fmt.Errorf("unsupported transport %q; must be %s or %s", options.Transport, commands.NodeTransportSSH, commands.NodeTransportSSM)
+	}
+
 	clientset, err := f.KopsClient()
 	if err != nil {
 		return err
@@ -155,23 +169,25 @@ func RunToolboxDump(ctx context.Context, f commandutils.Factory, out io.Writer,
 	}
 
 	if options.Dir != "" {
-		privateKeyPath := options.PrivateKey
-		if strings.HasPrefix(privateKeyPath, "~/") {
-			privateKeyPath = filepath.Join(os.Getenv("HOME"), privateKeyPath[2:])
-		}
-		key, err := os.ReadFile(privateKeyPath)
-		if err != nil {
-			return fmt.Errorf("reading private key %q: %v", privateKeyPath, err)
+		awsCloud, isAWS := cloud.(awsup.AWSCloud)
+		if options.Transport == commands.NodeTransportSSM && !isAWS {
+			return fmt.Errorf("transport %q is only supported on AWS", options.Transport)
 		}
 
-		parsedKey, err := ssh.ParseRawPrivateKey(key)
-		if err != nil {
-			return fmt.Errorf("parsing private key %q: %v", privateKeyPath, err)
-		}
+		var sshConfig *ssh.ClientConfig
+		var keyRing agent.Agent
+		var signer ssh.Signer
+		if options.Transport == commands.NodeTransportSSH {
+			sshConfig, keyRing, signer, err = buildNodeSSHConfig(options.PrivateKey, options.SSHUser)
+			if err != nil {
+				return err
+			}
+			defer func(keyRing agent.Agent) {
+				_ = keyRing.RemoveAll()
+			}(keyRing)
 
-		signer, err := ssh.NewSignerFromKey(parsedKey)
-		if err != nil {
-			return fmt.Errorf("creating signer for private key %q: %v", privateKeyPath, err)
+			klog.Infof("will SSH using username %q", sshConfig.User)
+			klog.Infof("ssh auth methods %v", sshConfig.Auth)
 		}
 
 		contextName := cluster.ObjectMeta.Name
@@ -199,29 +215,6 @@ func RunToolboxDump(ctx context.Context, f commandutils.Factory, out io.Writer,
 			}
 		}
 
-		sshConfig := &ssh.ClientConfig{
-			Config: ssh.Config{},
-			User:   options.SSHUser,
-			Auth: []ssh.AuthMethod{
-				ssh.PublicKeys(signer),
-			},
-			HostKeyCallback: ssh.InsecureIgnoreHostKey(), //nolint:gosec // toolbox dump connects to cluster nodes without managed host keys.
-		}
-
-		klog.Infof("will SSH using username %q", sshConfig.User)
-		klog.Infof("ssh auth methods %v", sshConfig.Auth)
-
-		keyRing := agent.NewKeyring()
-		defer func(keyRing agent.Agent) {
-			_ = keyRing.RemoveAll()
-		}(keyRing)
-		err = keyRing.Add(agent.AddedKey{
-			PrivateKey: parsedKey,
-		})
-		if err != nil {
-			return fmt.Errorf("adding key to SSH agent: %w", err)
-		}
-
 		// look for a bastion instance and use it if exists
 		// Prefer a bastion load balancer if exists
 		bastionAddress := ""
@@ -254,7 +247,7 @@ func RunToolboxDump(ctx context.Context, f commandutils.Factory, out io.Writer,
 		// field). When Karpenter is enabled, grant short-lived access via EC2 Instance Connect
 		// before connecting, using the public key matching --private-key. A no-op without the agent.
 		karpenterEnabled := cluster.Spec.Karpenter != nil && cluster.Spec.Karpenter.Enabled
-		if awsCloud, ok := cloud.(awsup.AWSCloud); ok && karpenterEnabled {
+		if isAWS && karpenterEnabled && options.Transport == commands.NodeTransportSSH {
 			publicKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey())))
 			eicClient := ec2instanceconnect.NewFromConfig(awsCloud.Config())
 			sshUser := options.SSHUser
@@ -268,6 +261,14 @@ func RunToolboxDump(ctx context.Context, f commandutils.Factory, out io.Writer,
 			})
 		}
 
+		// Systems Manager reaches nodes through the SSM agent, so neither SSH daemons nor bastions are needed.
+		if options.Transport == commands.NodeTransportSSM {
+			ssmClient := ssm.NewFromConfig(awsCloud.Config())
+			dumper.SetInstanceCommandRunner(func(ctx context.Context, instanceID string, command string, stdout io.Writer, stderr io.Writer) error {
+				return awsup.NewSSMCommandRunner(ssmClient, instanceID).Run(ctx, command, stdout, stderr)
+			})
+		}
+
 		if err := dumper.DumpAllNodes(ctx, nodes, options.MaxNodes, cloudResources); err != nil {
 			klog.Warningf("error dumping nodes: %v", err)
 		}
@@ -321,3 +322,45 @@ func RunToolboxDump(ctx context.Context, f commandutils.Factory, out io.Writer,
 	}
 	return nil
 }
+
+// buildNodeSSHConfig loads the private key used for SSH access to instances, returning the SSH client config
+// and an agent keyring holding the key, which is forwarded when connecting through a bastion.
+func buildNodeSSHConfig(privateKey, sshUser string) (*ssh.ClientConfig, agent.Agent, ssh.Signer, error) {
+	privateKeyPath := privateKey
+	if strings.HasPrefix(privateKeyPath, "~/") {
+		privateKeyPath = filepath.Join(os.Getenv("HOME"), privateKeyPath[2:])
+	}
+	key, err := os.ReadFile(privateKeyPath)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("reading private key %q: %v", privateKeyPath, err)
+	}
+
+	parsedKey, err := ssh.ParseRawPrivateKey(key)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("parsing private key %q: %v", privateKeyPath, err)
+	}
+
+	signer, err := ssh.NewSignerFromKey(parsedKey)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("creating signer for private key %q: %v", privateKeyPath, err)
+	}
+
+	sshConfig := &ssh.ClientConfig{
+		Config: ssh.Config{},
+		User:   sshUser,
+		Auth: []ssh.AuthMethod{
+			ssh.PublicKeys(signer),
+		},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(), //nolint:gosec // kops connects to cluster nodes without managed host keys.
+	}
+
+	keyRing := agent.NewKeyring()
+	err = keyRing.Add(agent.AddedKey{
+		PrivateKey: parsedKey,
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("adding key to SSH agent: %w", err)
+	}
+
+	return sshConfig, keyRing, signer, nil
+}
@@ -34,9 +34,19 @@ func NewCmdToolboxEnroll(f commandutils.Factory, out io.Writer) *cobra.Command {
 		Use:   "enroll [CLUSTER]",
 		Short: i18n.T(`Add machine to cluster`),
 		Long: templates.LongDesc(i18n.T(`
-			Adds an individual machine to the cluster.`)),
+			Adds an individual machine to the cluster.
+
+			Before enrolling the machine, checks that it meets the OS prerequisites, such as
+			cgroup v2, disabled swap, time synchronization, free disk space and free ports.
+
+			After running nodeup on the machine, waits for the node to register and become Ready.
+			If it does not, the state of kubelet and containerd on the machine is reported and
+			the command fails.`)),
 		Example: templates.Examples(i18n.T(`
 			kops toolbox enroll --name k8s-cluster.example.com
+
+			# Enroll an AWS instance without SSH access, through AWS Systems Manager
+			kops toolbox enroll --cluster k8s-cluster.example.com --instance-group nodes --transport ssm --host i-0123456789abcdef0
 		`)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return commands.RunToolboxEnroll(cmd.Context(), f, out, options)
@@ -44,13 +54,30 @@ func NewCmdToolboxEnroll(f commandutils.Factory, out io.Writer) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&options.ClusterName, "cluster", options.ClusterName, "Name of cluster to join")
+	cmd.RegisterFlagCompletionFunc("cluster", commandutils.CompleteClusterName(f, false, false))
 	cmd.Flags().StringVar(&options.InstanceGroup, "instance-group", options.InstanceGroup, "Name of instance-group to join")
+	cmd.RegisterFlagCompletionFunc("instance-group", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		var clusterArgs []string
+		if options.ClusterName != "" {
+			clusterArgs = []string{options.ClusterName}
+		}
+		return completeInstanceGroup(f, nil, nil)(cmd, clusterArgs, toComplete)
+	})
 	cmd.Flags().StringSliceVar(&options.PodCIDRs, "pod-cidr", options.PodCIDRs, "IP Address range to use for pods that run on this node")
 
-	cmd.Flags().StringVar(&options.Host, "host", options.Host, "IP/hostname for machine to add")
+	cmd.Flags().StringVar(&options.Host, "host", options.Host, "IP/hostname for machine to add, or its instance ID with the ssm transport")
+	cmd.Flags().StringVar(&options.Transport, "transport", options.Transport, "How to run commands on the machine.  One of ssh or ssm (AWS Systems Manager, for instances without SSH access)")
+	cmd.RegisterFlagCompletionFunc("transport", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{commands.NodeTransportSSH, commands.NodeTransportSSM}, cobra.ShellCompDirectiveNoFileComp
+	})
+	cmd.Flags().StringVar(&options.AWSRegion, "aws-region", options.AWSRegion, "AWS region of the instance with the ssm transport; defaults to the region of the AWS environment")
 	cmd.Flags().StringVar(&options.SSHUser, "ssh-user", options.SSHUser, "user for ssh")
 	cmd.Flags().IntVar(&options.SSHPort, "ssh-port", options.SSHPort, "port for ssh")
 
+	cmd.Flags().DurationVar(&options.VerifyTimeout, "verify-timeout", options.VerifyTimeout, "time to wait for the node to become Ready after enrolling it; 0 to skip the verification")
+
+	cmd.Flags().BoolVar(&options.SkipPreflightChecks, "skip-preflight-checks", options.SkipPreflightChecks, "don't check the OS prerequisites of the machine before enrolling it")
+
 	cmd.Flags().BoolVar(&options.BuildHost, "build-host", options.BuildHost, "only build the host resource, don't apply it or enroll the node")
 
 	options.CreateKubecfgOptions.AddCommonFlags(cmd.Flags())
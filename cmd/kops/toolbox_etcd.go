@@ -0,0 +1,599 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/dump"
+	"k8s.io/kops/pkg/etcdbackup"
+	"k8s.io/kops/pkg/kubeconfig"
+	"k8s.io/kops/pkg/wellknownports"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	toolboxEtcdShort = i18n.T(`Back up and restore the etcd clusters managed by etcd-manager`)
+
+	toolboxEtcdBackupLong = templates.LongDesc(i18n.T(`
+	List the backups in the etcd-manager backup store, or take an immediate backup.
+
+	An immediate backup is taken by running etcdctl inside etcd-manager on a control plane node,
+	over SSH. The snapshot is stored in the backup store alongside the periodic backups,
+	so it can be restored with kops toolbox etcd restore.`))
+
+	toolboxEtcdBackupExample = templates.Examples(i18n.T(`
+	# List the backups of all etcd clusters
+	kops toolbox etcd backup --name k8s-cluster.example.com --list
+
+	# Take a backup of the main and events etcd clusters now
+	kops toolbox etcd backup --name k8s-cluster.example.com --etcd-cluster main --etcd-cluster events
+	`))
+
+	toolboxEtcdBackupShort = i18n.T(`List etcd backups or take an immediate backup`)
+
+	toolboxEtcdRestoreLong = templates.LongDesc(i18n.T(`
+	Restore an etcd cluster from a backup in the etcd-manager backup store.
+
+	A restore command is added to the backup store, and etcd-manager is restarted on every
+	control plane node, over SSH, so that it picks up the command. etcd-manager then creates
+	a new etcd cluster from the backup.
+
+	The restore causes downtime for the API server, cannot be undone, and discards all
+	changes made after the backup was taken.`))
+
+	toolboxEtcdRestoreExample = templates.Examples(i18n.T(`
+	# Preview the restore of the main etcd cluster
+	kops toolbox etcd restore --name k8s-cluster.example.com --backup 2026-01-02T03:04:05Z-000001
+
+	# Restore the events etcd cluster
+	kops toolbox etcd restore --name k8s-cluster.example.com --etcd-cluster events --backup 2026-01-02T03:04:05Z-000001 --yes
+	`))
+
+	toolboxEtcdRestoreShort = i18n.T(`Restore an etcd cluster from a backup`)
+)
+
+// etcdClientPorts are the ports etcd listens on for clients, for the etcd clusters using the kube-apiserver client CA
+var etcdClientPorts = map[string]int{
+	"main":   wellknownports.EtcdMainClientPort,
+	"events": wellknownports.EtcdEventsClientPort,
+	"leases": wellknownports.EtcdLeasesClientPort,
+}
+
+type ToolboxEtcdOptions struct {
+	ClusterName string
+
+	// EtcdClusters are the names of the etcd clusters to operate on
+	EtcdClusters []string
+
+	PrivateKey string
+	SSHUser    string
+	// Bastion is the address of a host to connect to the control plane nodes through
+	Bastion string
+
+	kubeconfig.CreateKubecfgOptions
+}
+
+func (o *ToolboxEtcdOptions) InitDefaults() {
+	o.PrivateKey = "~/.ssh/id_rsa"
+	o.SSHUser = "ubuntu"
+}
+
+func (o *ToolboxEtcdOptions) addFlags(f commandutils.Factory, cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&o.EtcdClusters, "etcd-cluster", o.EtcdClusters, "Name of the etcd cluster (may be repeated)")
+	cmd.RegisterFlagCompletionFunc("etcd-cluster", completeEtcdCluster(f, &o.EtcdClusters))
+	cmd.Flags().StringVar(&o.PrivateKey, "private-key", o.PrivateKey, "File containing private key to use for SSH access to instances")
+	cmd.Flags().StringVar(&o.SSHUser, "ssh-user", o.SSHUser, "The remote user for SSH access to instances")
+	cmd.RegisterFlagCompletionFunc("ssh-user", cobra.NoFileCompletions)
+	cmd.Flags().StringVar(&o.Bastion, "bastion", o.Bastion, "Address of a bastion to connect to the control plane nodes through")
+	cmd.RegisterFlagCompletionFunc("bastion", cobra.NoFileCompletions)
+	o.CreateKubecfgOptions.AddCommonFlags(cmd.Flags())
+}
+
+type ToolboxEtcdBackupOptions struct {
+	ToolboxEtcdOptions
+
+	// List lists the existing backups instead of taking a backup
+	List bool
+}
+
+type ToolboxEtcdRestoreOptions struct {
+	ToolboxEtcdOptions
+
+	// Backup is the name of the backup to restore
+	Backup string
+	// Restart restarts etcd-manager on the control plane nodes, so that it picks up the restore command
+	Restart bool
+	Yes     bool
+}
+
+func NewCmdToolboxEtcd(f commandutils.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "etcd",
+		Short: toolboxEtcdShort,
+	}
+
+	cmd.AddCommand(NewCmdToolboxEtcdBackup(f, out))
+	cmd.AddCommand(NewCmdToolboxEtcdRestore(f, out))
+
+	return cmd
+}
+
+func NewCmdToolboxEtcdBackup(f commandutils.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxEtcdBackupOptions{}
+	options.InitDefaults()
+
+	cmd := &cobra.Command{
+		Use:               "backup [CLUSTER]",
+		Short:             toolboxEtcdBackupShort,
+		Long:              toolboxEtcdBackupLong,
+		Example:           toolboxEtcdBackupExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunToolboxEtcdBackup(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.List, "list", options.List, "List the backups instead of taking a backup")
+	options.addFlags(f, cmd)
+
+	return cmd
+}
+
+func NewCmdToolboxEtcdRestore(f commandutils.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxEtcdRestoreOptions{}
+	options.InitDefaults()
+	options.EtcdClusters = []string{"main"}
+	options.Restart = true
+
+	cmd := &cobra.Command{
+		Use:               "restore [CLUSTER]",
+		Short:             toolboxEtcdRestoreShort,
+		Long:              toolboxEtcdRestoreLong,
+		Example:           toolboxEtcdRestoreExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunToolboxEtcdRestore(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Backup, "backup", options.Backup, "Name of the backup to restore, as shown by kops toolbox etcd backup --list")
+	cmd.MarkFlagRequired("backup")
+	cmd.RegisterFlagCompletionFunc("backup", completeEtcdBackup(f, options))
+	cmd.Flags().BoolVar(&options.Restart, "restart", options.Restart, "Restart etcd-manager on the control plane nodes to start the restore")
+	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Restore the backup; without this flag the restore is only previewed")
+	options.addFlags(f, cmd)
+
+	return cmd
+}
+
+// etcdBackupStores returns the backup stores of the selected etcd clusters, keyed by etcd cluster name
+func etcdBackupStores(ctx context.Context, f commandutils.Factory, cluster *kopsapi.Cluster, names []string) ([]*kopsapi.EtcdClusterSpec, map[string]*etcdbackup.Store, error) {
+	// The backup store is only set in the completed cluster spec
+	fullSpecs, err := fullClusterSpecs(ctx, f.VFSContext(), []*kopsapi.Cluster{cluster})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var etcdClusters []*kopsapi.EtcdClusterSpec
+	for i := range fullSpecs[0].Spec.EtcdClusters {
+		etcdCluster := &fullSpecs[0].Spec.EtcdClusters[i]
+		if etcdCluster.IsExternal() && !slices.Contains(names, etcdCluster.Name) {
+			// Backups of external etcd clusters are not managed by kOps
+			continue
+		}
+		if len(names) == 0 || slices.Contains(names, etcdCluster.Name) {
+			etcdClusters = append(etcdClusters, etcdCluster)
+		}
+	}
+	for _, name := range names {
+		if !slices.ContainsFunc(etcdClusters, func(etcdCluster *kopsapi.EtcdClusterSpec) bool { return etcdCluster.Name == name }) {
+			return nil, nil, fmt.Errorf("etcd cluster %q not found in cluster %q", name, cluster.ObjectMeta.Name)
+		}
+	}
+
+	stores := make(map[string]*etcdbackup.Store)
+	for _, etcdCluster := range etcdClusters {
+		if etcdCluster.IsExternal() {
+			return nil, nil, fmt.Errorf("etcd cluster %q is not managed by kOps", etcdCluster.Name)
+		}
+		if etcdCluster.Backups == nil || etcdCluster.Backups.BackupStore == "" {
+			return nil, nil, fmt.Errorf("etcd cluster %q does not have a backup store", etcdCluster.Name)
+		}
+		base, err := f.VFSContext().BuildVfsPath(etcdCluster.Backups.BackupStore)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing backup store for etcd cluster %q: %w", etcdCluster.Name, err)
+		}
+		stores[etcdCluster.Name] = etcdbackup.NewStore(base)
+	}
+	return etcdClusters, stores, nil
+}
+
+// completeEtcdCluster completes the names of the etcd clusters managed by kOps
+func completeEtcdCluster(f commandutils.Factory, selectedEtcdClusters *[]string) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		commandutils.ConfigureKlogForCompletion()
+
+		cluster, _, completions, directive := GetClusterForCompletion(cmd.Context(), f, args)
+		if cluster == nil {
+			return completions, directive
+		}
+
+		var names []string
+		for _, etcdCluster := range cluster.Spec.EtcdClusters {
+			if !etcdCluster.IsExternal() && !slices.Contains(*selectedEtcdClusters, etcdCluster.Name) {
+				names = append(names, etcdCluster.Name)
+			}
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeEtcdBackup completes the names of the backups of the etcd cluster to restore
+func completeEtcdBackup(f commandutils.Factory, options *ToolboxEtcdRestoreOptions) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		ctx := cmd.Context()
+
+		commandutils.ConfigureKlogForCompletion()
+
+		cluster, _, completions, directive := GetClusterForCompletion(ctx, f, args)
+		if cluster == nil {
+			return completions, directive
+		}
+		if len(options.EtcdClusters) != 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		_, stores, err := etcdBackupStores(ctx, f, cluster, options.EtcdClusters)
+		if err != nil {
+			return commandutils.CompletionError("getting backup store", err)
+		}
+		backups, err := stores[options.EtcdClusters[0]].ListBackups(ctx)
+		if err != nil {
+			return commandutils.CompletionError("listing backups", err)
+		}
+
+		var names []string
+		for _, backup := range backups {
+			names = append(names, backup.Name)
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+type etcdBackupRow struct {
+	EtcdCluster string
+	*etcdbackup.Backup
+}
+
+func RunToolboxEtcdBackup(ctx context.Context, f commandutils.Factory, out io.Writer, options *ToolboxEtcdBackupOptions) error {
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	etcdClusters, stores, err := etcdBackupStores(ctx, f, cluster, options.EtcdClusters)
+	if err != nil {
+		return err
+	}
+
+	if options.List {
+		var rows []*etcdBackupRow
+		for _, etcdCluster := range etcdClusters {
+			backups, err := stores[etcdCluster.Name].ListBackups(ctx)
+			if err != nil {
+				return err
+			}
+			for _, backup := range backups {
+				rows = append(rows, &etcdBackupRow{EtcdCluster: etcdCluster.Name, Backup: backup})
+			}
+		}
+		if len(rows) == 0 {
+			fmt.Fprintf(out, "No backups found\n")
+			return nil
+		}
+
+		t := &tables.Table{}
+		t.AddColumn("ETCD-CLUSTER", func(r *etcdBackupRow) string {
+			return r.EtcdCluster
+		})
+		t.AddColumn("NAME", func(r *etcdBackupRow) string {
+			return r.Name
+		})
+		t.AddColumn("ETCD-VERSION", func(r *etcdBackupRow) string {
+			return r.Info.EtcdVersion
+		})
+		t.AddColumn("TIME", func(r *etcdBackupRow) string {
+			if r.Info.Time().IsZero() {
+				return ""
+			}
+			return r.Info.Time().UTC().Format(time.RFC3339)
+		})
+		return t.Render(rows, out, "ETCD-CLUSTER", "NAME", "ETCD-VERSION", "TIME")
+	}
+
+	for _, etcdCluster := range etcdClusters {
+		if _, found := etcdClientPorts[etcdCluster.Name]; !found {
+			return fmt.Errorf("taking a backup of etcd cluster %q is not supported", etcdCluster.Name)
+		}
+	}
+
+	runner, hosts, cleanup, err := buildControlPlaneRunner(ctx, f, cluster, &options.ToolboxEtcdOptions)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	for _, etcdCluster := range etcdClusters {
+		name, err := backupEtcdCluster(ctx, runner, hosts, etcdCluster, stores[etcdCluster.Name])
+		if err != nil {
+			return fmt.Errorf("error backing up etcd cluster %q: %w", etcdCluster.Name, err)
+		}
+		fmt.Fprintf(out, "Created backup %q of etcd cluster %q\n", name, etcdCluster.Name)
+	}
+	return nil
+}
+
+// backupEtcdCluster takes a snapshot of the etcd cluster from the first control plane node where it succeeds,
+// and adds it to the backup store
+func backupEtcdCluster(ctx context.Context, runner *dump.NodeCommandRunner, hosts []string, etcdCluster *kopsapi.EtcdClusterSpec, store *etcdbackup.Store) (string, error) {
+	tmpFile, err := os.CreateTemp("", "etcd-backup-"+etcdCluster.Name)
+	if err != nil {
+		return "", fmt.Errorf("error creating temp file: %w", err)
+	}
+	defer func() {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+	}()
+
+	script := etcdSnapshotScript(etcdCluster.Name, etcdCluster.Version, etcdClientPorts[etcdCluster.Name])
+	var errs []error
+	snapshotted := false
+	for _, host := range hosts {
+		if err := tmpFile.Truncate(0); err != nil {
+			return "", err
+		}
+		if _, err := tmpFile.Seek(0, io.SeekStart); err != nil {
+			return "", err
+		}
+		var stderr bytes.Buffer
+		if err := runner.Run(ctx, host, script, tmpFile, &stderr); err != nil {
+			klog.Warningf("error taking snapshot on %q: %v: %s", host, err, stderr.String())
+			errs = append(errs, fmt.Errorf("%s: %w", host, err))
+			continue
+		}
+		snapshotted = true
+		break
+	}
+	if !snapshotted {
+		return "", fmt.Errorf("unable to take a snapshot on any control plane node: %v", errs)
+	}
+
+	if _, err := tmpFile.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	info := &etcdbackup.BackupInfo{
+		EtcdVersion: etcdCluster.Version,
+		ClusterSpec: &etcdbackup.ClusterSpec{
+			MemberCount: int32(len(etcdCluster.Members)),
+			EtcdVersion: etcdCluster.Version,
+		},
+	}
+	return store.AddBackup(ctx, info, tmpFile, time.Now())
+}
+
+// etcdSnapshotScript returns a shell command that snapshots the etcd cluster using etcdctl inside etcd-manager,
+// writing the gzipped snapshot to stdout.
+// etcd-manager mounts the host filesystem under /rootfs, which is how it reads the kube-apiserver client certificate.
+func etcdSnapshotScript(etcdClusterName string, etcdVersion string, clientPort int) string {
+	snapshotPath := "/tmp/kops-etcd-" + etcdClusterName + ".db"
+	etcdctl := []string{
+		"sudo crictl exec \"$ctr\" env ETCDCTL_API=3",
+		"/opt/etcd-v" + etcdVersion + "/etcdctl",
+		fmt.Sprintf("--endpoints=https://127.0.0.1:%d", clientPort),
+		"--cacert=/rootfs/srv/kubernetes/kube-apiserver/etcd-ca.crt",
+		"--cert=/rootfs/srv/kubernetes/kube-apiserver/etcd-client.crt",
+		"--key=/rootfs/srv/kubernetes/kube-apiserver/etcd-client.key",
+		"snapshot save /rootfs" + snapshotPath,
+	}
+	return strings.Join([]string{
+		"set -e",
+		findEtcdManagerContainerScript(etcdClusterName),
+		strings.Join(etcdctl, " ") + " >&2",
+		"sudo gzip -c " + snapshotPath,
+		"sudo rm -f " + snapshotPath,
+	}, "; ")
+}
+
+// findEtcdManagerContainerScript returns a shell command that sets $ctr to the etcd-manager container of the etcd cluster
+func findEtcdManagerContainerScript(etcdClusterName string) string {
+	return strings.Join([]string{
+		"pod=$(sudo crictl pods -q --state ready --name '^etcd-manager-" + etcdClusterName + "-' | head -n 1)",
+		"test -n \"$pod\" || { echo 'etcd-manager pod not found' >&2; exit 1; }",
+		"ctr=$(sudo crictl ps -q --pod \"$pod\" --name '^etcd-manager$' | head -n 1)",
+		"test -n \"$ctr\" || { echo 'etcd-manager container not found' >&2; exit 1; }",
+	}, "; ")
+}
+
+func RunToolboxEtcdRestore(ctx context.Context, f commandutils.Factory, out io.Writer, options *ToolboxEtcdRestoreOptions) error {
+	if len(options.EtcdClusters) != 1 {
+		return fmt.Errorf("exactly one --etcd-cluster must be specified")
+	}
+
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	etcdClusters, stores, err := etcdBackupStores(ctx, f, cluster, options.EtcdClusters)
+	if err != nil {
+		return err
+	}
+	etcdCluster := etcdClusters[0]
+	store := stores[etcdCluster.Name]
+
+	info, err := store.LoadInfo(ctx, options.Backup)
+	if err != nil {
+		return fmt.Errorf("backup %q not found for etcd cluster %q: %w", options.Backup, etcdCluster.Name, err)
+	}
+	clusterSpec := info.ClusterSpec
+	if clusterSpec == nil {
+		clusterSpec = &etcdbackup.ClusterSpec{
+			MemberCount: int32(len(etcdCluster.Members)),
+			EtcdVersion: etcdCluster.Version,
+		}
+	}
+
+	if !options.Yes {
+		fmt.Fprintf(out, "Will restore backup %q of etcd cluster %q, taken with etcd %s, onto %d members\n", options.Backup, etcdCluster.Name, info.EtcdVersion, clusterSpec.MemberCount)
+		fmt.Fprintf(out, "The API server will be unavailable during the restore, and all changes made since the backup will be lost.\n")
+		fmt.Fprintf(out, "\nMust specify --yes to restore\n")
+		return nil
+	}
+
+	if err := store.AddRestoreCommand(ctx, options.Backup, clusterSpec, time.Now()); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Added restore command for backup %q of etcd cluster %q\n", options.Backup, etcdCluster.Name)
+
+	if !options.Restart {
+		fmt.Fprintf(out, "Restart etcd-manager for etcd cluster %q on all control plane nodes to start the restore.\n", etcdCluster.Name)
+		return nil
+	}
+
+	runner, hosts, cleanup, err := buildControlPlaneRunner(ctx, f, cluster, &options.ToolboxEtcdOptions)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	// kubelet restarts the stopped container, and etcd-manager picks up the restore command when it starts
+	script := strings.Join([]string{
+		"set -e",
+		findEtcdManagerContainerScript(etcdCluster.Name),
+		"sudo crictl stop \"$ctr\"",
+	}, "; ")
+	var errs []error
+	for _, host := range hosts {
+		var stderr bytes.Buffer
+		if err := runner.Run(ctx, host, script, io.Discard, &stderr); err != nil {
+			klog.Warningf("error restarting etcd-manager on %q: %v: %s", host, err, stderr.String())
+			errs = append(errs, fmt.Errorf("%s: %w", host, err))
+			continue
+		}
+		fmt.Fprintf(out, "Restarted etcd-manager on %s\n", host)
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("error restarting etcd-manager, restart it manually on the remaining control plane nodes to start the restore: %v", errs)
+	}
+
+	fmt.Fprintf(out, "\nThe restore is in progress; follow it in the etcd-manager logs on the control plane nodes.\n")
+	fmt.Fprintf(out, "Once the API server is available, run kops rolling-update cluster --force --yes to resync the nodes.\n")
+	return nil
+}
+
+// buildControlPlaneRunner returns a runner for SSH commands and the addresses of the ready control plane nodes.
+// The returned cleanup function must be called once the runner is no longer needed.
+func buildControlPlaneRunner(ctx context.Context, f commandutils.Factory, cluster *kopsapi.Cluster, options *ToolboxEtcdOptions) (*dump.NodeCommandRunner, []string, func(), error) {
+	restConfig, err := f.RESTConfig(ctx, cluster, options.CreateKubecfgOptions)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("getting rest config: %w", err)
+	}
+	k8sClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("building kubernetes client: %w", err)
+	}
+	nodes, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+		LabelSelector: "node-role.kubernetes.io/control-plane",
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error listing control plane nodes: %w", err)
+	}
+
+	hosts := controlPlaneAddresses(nodes.Items, options.Bastion != "")
+	if len(hosts) == 0 {
+		return nil, nil, nil, fmt.Errorf("no ready control plane nodes found")
+	}
+
+	sshConfig, keyRing, _, err := buildNodeSSHConfig(options.PrivateKey, options.SSHUser)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	cleanup := func() {
+		_ = keyRing.RemoveAll()
+	}
+
+	return dump.NewNodeCommandRunner(options.Bastion, sshConfig, keyRing), hosts, cleanup, nil
+}
+
+// controlPlaneAddresses returns the addresses of the ready nodes; internal addresses are used through a bastion,
+// otherwise external addresses are preferred
+func controlPlaneAddresses(nodes []corev1.Node, useBastion bool) []string {
+	var hosts []string
+	for i := range nodes {
+		node := &nodes[i]
+		ready := false
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+				ready = true
+			}
+		}
+		if !ready {
+			continue
+		}
+
+		var internalIP, externalIP string
+		for _, address := range node.Status.Addresses {
+			switch address.Type {
+			case corev1.NodeInternalIP:
+				if internalIP == "" {
+					internalIP = address.Address
+				}
+			case corev1.NodeExternalIP:
+				if externalIP == "" {
+					externalIP = address.Address
+				}
+			}
+		}
+		host := internalIP
+		if !useBastion && externalIP != "" {
+			host = externalIP
+		}
+		if host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestControlPlaneAddresses(t *testing.T) {
+	node := func(ready corev1.ConditionStatus, addresses ...corev1.NodeAddress) corev1.Node {
+		return corev1.Node{
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+				Addresses:  addresses,
+			},
+		}
+	}
+	nodes := []corev1.Node{
+		node(corev1.ConditionTrue,
+			corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.1"},
+			corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "203.0.113.1"}),
+		node(corev1.ConditionTrue,
+			corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.2"}),
+		node(corev1.ConditionFalse,
+			corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.3"}),
+	}
+
+	if got, want := controlPlaneAddresses(nodes, false), []string{"203.0.113.1", "10.0.0.2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected addresses without bastion: got %v, want %v", got, want)
+	}
+	if got, want := controlPlaneAddresses(nodes, true), []string{"10.0.0.1", "10.0.0.2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected addresses with bastion: got %v, want %v", got, want)
+	}
+}
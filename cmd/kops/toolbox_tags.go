@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/resources/ops"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	toolboxTagsShort = i18n.T(`Work with the tags of the cloud resources of a cluster`)
+
+	toolboxTagsVerifyLong = templates.LongDesc(i18n.T(`
+	Verify that the cloud resources of a cluster comply with the tag policy of the cluster.
+
+	The resources owned by the cluster are listed in the same way as kops delete cluster,
+	and every resource that is missing a required tag, or has a tag with a value that is not
+	allowed, is reported. The command fails if any resource does not comply.
+
+	Resources whose tags cannot be listed are counted but not verified.`))
+
+	toolboxTagsVerifyExample = templates.Examples(i18n.T(`
+	# Verify the tags of the resources of a cluster
+	kops toolbox tags verify --name k8s-cluster.example.com
+	`))
+
+	toolboxTagsVerifyShort = i18n.T(`Verify the tags of the cloud resources of a cluster`)
+)
+
+type ToolboxTagsVerifyOptions struct {
+	ClusterName string
+}
+
+func NewCmdToolboxTags(f *util.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tags",
+		Short: toolboxTagsShort,
+	}
+
+	cmd.AddCommand(NewCmdToolboxTagsVerify(f, out))
+
+	return cmd
+}
+
+func NewCmdToolboxTagsVerify(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxTagsVerifyOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "verify [CLUSTER]",
+		Short:             toolboxTagsVerifyShort,
+		Long:              toolboxTagsVerifyLong,
+		Example:           toolboxTagsVerifyExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunToolboxTagsVerify(cmd.Context(), f, out, options)
+		},
+	}
+
+	return cmd
+}
+
+func RunToolboxTagsVerify(ctx context.Context, f *util.Factory, out io.Writer, options *ToolboxTagsVerifyOptions) error {
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+	if cluster.Spec.TagPolicy == nil {
+		return fmt.Errorf("cluster %q does not have a tag policy; set spec.tagPolicy with kops edit cluster", cluster.Name)
+	}
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+	resourceMap, err := ops.ListResources(cloud, cluster)
+	if err != nil {
+		return err
+	}
+
+	violations, unverified, err := ops.VerifyTags(cluster, resourceMap)
+	if err != nil {
+		return err
+	}
+
+	if len(violations) != 0 {
+		t := &tables.Table{}
+		t.AddColumn("TYPE", func(v *ops.TagViolation) string {
+			return v.Resource.Type
+		})
+		t.AddColumn("NAME", func(v *ops.TagViolation) string {
+			return v.Resource.Name
+		})
+		t.AddColumn("ID", func(v *ops.TagViolation) string {
+			return v.Resource.ID
+		})
+		t.AddColumn("PROBLEMS", func(v *ops.TagViolation) string {
+			return strings.Join(v.Problems, "; ")
+		})
+		if err := t.Render(violations, out, "TYPE", "NAME", "ID", "PROBLEMS"); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "\n")
+	}
+
+	if len(unverified) != 0 {
+		fmt.Fprintf(out, "%d resources were not verified because their tags could not be listed\n", len(unverified))
+	}
+
+	if len(violations) != 0 {
+		return fmt.Errorf("%d resources do not comply with the tag policy of cluster %q", len(violations), cluster.Name)
+	}
+	fmt.Fprintf(out, "All verified resources comply with the tag policy of cluster %q\n", cluster.Name)
+	return nil
+}
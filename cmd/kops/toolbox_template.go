@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -25,16 +26,23 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/kubectl/pkg/util/i18n"
 	"k8s.io/kubectl/pkg/util/templates"
 	"sigs.k8s.io/yaml"
 
 	kopsapi "k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/diff"
+	"k8s.io/kops/pkg/kopscodecs"
 	"k8s.io/kops/pkg/try"
 	"k8s.io/kops/pkg/util/templater"
 	"k8s.io/kops/third_party/forked/helmstrvals"
 	"k8s.io/kops/upup/pkg/fi/utils"
+	"k8s.io/kops/util/pkg/text"
 )
 
 var (
@@ -50,6 +58,11 @@ var (
 		--snippets file_or_directory --snippets=another.dir \
 		--template file_or_directory --template=directory  \
 		--output cluster.yaml
+
+	# Validate the values against a JSON Schema, and show the changes to the clusters in the state store
+	kops toolbox template \
+		--values values.yaml --values-schema values.schema.yaml \
+		--template cluster.yaml --diff
 	`))
 
 	toolboxTemplatingShort = i18n.T(`Generate cluster.yaml from template`)
@@ -67,6 +80,8 @@ type ToolboxTemplateOptions struct {
 	values        []string
 	stringValues  []string
 	channel       string
+	valuesSchema  string
+	diff          bool
 }
 
 // NewCmdToolboxTemplate returns a new templating command.
@@ -83,7 +98,7 @@ func NewCmdToolboxTemplate(f commandutils.Factory, out io.Writer) *cobra.Command
 		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
 		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return RunToolBoxTemplate(f, out, options)
+			return RunToolBoxTemplate(cmd.Context(), f, out, options)
 		},
 	}
 
@@ -91,6 +106,10 @@ func NewCmdToolboxTemplate(f commandutils.Factory, out io.Writer) *cobra.Command
 	cmd.RegisterFlagCompletionFunc("values", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return []string{"yaml", "json"}, cobra.ShellCompDirectiveFilterFileExt
 	})
+	cmd.Flags().StringVar(&options.valuesSchema, "values-schema", options.valuesSchema, "Path to a JSON Schema, in YAML or JSON, to validate the values against and to take default values from")
+	cmd.RegisterFlagCompletionFunc("values-schema", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"yaml", "json"}, cobra.ShellCompDirectiveFilterFileExt
+	})
 	cmd.Flags().StringArrayVar(&options.values, "set", options.values, "Set values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
 	cmd.RegisterFlagCompletionFunc("set", cobra.NoFileCompletions)
 	cmd.Flags().StringArrayVar(&options.stringValues, "set-string", options.stringValues, "Set STRING values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
@@ -107,14 +126,16 @@ func NewCmdToolboxTemplate(f commandutils.Factory, out io.Writer) *cobra.Command
 	cmd.RegisterFlagCompletionFunc("config-value", cobra.NoFileCompletions)
 	cmd.Flags().BoolVar(&options.failOnMissing, "fail-on-missing", true, "Fail on referencing unset variables in templates")
 	cmd.Flags().BoolVar(&options.formatYAML, "format-yaml", false, "Attempt to format the generated yaml content before output")
+	cmd.Flags().BoolVar(&options.diff, "diff", false, "Show the differences between the generated clusters and instance groups and those in the state store, instead of the generated content")
 
 	return cmd
 }
 
 // RunToolBoxTemplate is the action for the command
-func RunToolBoxTemplate(f commandutils.Factory, out io.Writer, options *ToolboxTemplateOptions) error {
+func RunToolBoxTemplate(ctx context.Context, f commandutils.Factory, out io.Writer, options *ToolboxTemplateOptions) error {
 	// @step: read in the configuration if any
-	context, err := newTemplateContext(options.configPath, options.values, options.stringValues)
+	sources := make(templater.ValueSources)
+	context, err := newTemplateContext(options.configPath, options.values, options.stringValues, sources)
 	if err != nil {
 		return err
 	}
@@ -125,6 +146,14 @@ func RunToolBoxTemplate(f commandutils.Factory, out io.Writer, options *ToolboxT
 		options.ClusterName = value
 	} else {
 		context["clusterName"] = options.ClusterName
+		sources["clusterName"] = "cluster name argument"
+	}
+
+	// @step: apply the defaults and validate the values against the schema
+	if options.valuesSchema != "" {
+		if err := validateTemplateContext(utils.ExpandPath(options.valuesSchema), context, sources); err != nil {
+			return err
+		}
 	}
 
 	// @check if we are just rendering the config value
@@ -174,12 +203,7 @@ func RunToolBoxTemplate(f commandutils.Factory, out io.Writer, options *ToolboxT
 	r := templater.NewTemplater(channel)
 	var documents []string
 	for _, x := range templates {
-		content, err := os.ReadFile(x)
-		if err != nil {
-			return fmt.Errorf("unable to read template: %s, error: %s", x, err)
-		}
-
-		rendered, err := r.Render(string(content), context, snippets, options.failOnMissing)
+		rendered, err := r.RenderFile(x, context, snippets, options.failOnMissing)
 		if err != nil {
 			return fmt.Errorf("unable to render template: %s, error: %s", x, err)
 		}
@@ -213,6 +237,14 @@ func RunToolBoxTemplate(f commandutils.Factory, out io.Writer, options *ToolboxT
 
 	iowriter := out
 	// @check if we are writing to a file rather than stdout
+	if options.diff {
+		if err := diffTemplateWithStateStore(ctx, f, out, content); err != nil {
+			return err
+		}
+		if options.outputPath == "" {
+			return nil
+		}
+	}
 	if options.outputPath != "" {
 		w, err := os.OpenFile(utils.ExpandPath(options.outputPath), os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0o660)
 		if err != nil {
@@ -229,8 +261,9 @@ func RunToolBoxTemplate(f commandutils.Factory, out io.Writer, options *ToolboxT
 	return nil
 }
 
-// newTemplateContext is responsible for loading the --values and build a context for the template
-func newTemplateContext(files []string, values []string, stringValues []string) (map[string]interface{}, error) {
+// newTemplateContext is responsible for loading the --values and build a context for the template.
+// The file or flag setting each value is recorded in sources.
+func newTemplateContext(files []string, values []string, stringValues []string, sources templater.ValueSources) (map[string]interface{}, error) {
 	context := make(map[string]interface{})
 
 	for _, x := range files {
@@ -249,6 +282,7 @@ func newTemplateContext(files []string, values []string, stringValues []string)
 				return nil, fmt.Errorf("unable decode the configuration file: %s, error: %v", j, err)
 			}
 			context = mergeMaps(context, ctx)
+			sources.Record(ctx, j)
 		}
 	}
 
@@ -257,6 +291,10 @@ func newTemplateContext(files []string, values []string, stringValues []string)
 		if err := helmstrvals.ParseInto(value, context); err != nil {
 			return nil, fmt.Errorf("failed parsing --set data: %s", err)
 		}
+		set := make(map[string]interface{})
+		if err := helmstrvals.ParseInto(value, set); err == nil {
+			sources.Record(set, "--set "+value)
+		}
 	}
 
 	// User specified a value via --set-string
@@ -264,11 +302,141 @@ func newTemplateContext(files []string, values []string, stringValues []string)
 		if err := helmstrvals.ParseIntoString(value, context); err != nil {
 			return nil, fmt.Errorf("failed parsing --set-string data: %s", err)
 		}
+		set := make(map[string]interface{})
+		if err := helmstrvals.ParseIntoString(value, set); err == nil {
+			sources.Record(set, "--set-string "+value)
+		}
 	}
 
 	return context, nil
 }
 
+// validateTemplateContext applies the default values of the schema to the context and validates it against the schema.
+// Each error points at the offending value and the file or flag that set it.
+func validateTemplateContext(schemaPath string, context map[string]interface{}, sources templater.ValueSources) error {
+	data, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return fmt.Errorf("unable to read values schema: %s, error: %v", schemaPath, err)
+	}
+	schema, err := templater.ParseValuesSchema(data)
+	if err != nil {
+		return fmt.Errorf("unable to parse values schema: %s, error: %v", schemaPath, err)
+	}
+
+	schema.ApplyDefaults(context)
+
+	errs := schema.Validate(context)
+	if len(errs) == 0 {
+		return nil
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "values do not match the schema %s:", schemaPath)
+	for _, err := range errs {
+		if source, found := sources.Lookup(err.Field); found {
+			fmt.Fprintf(&b, "\n  %s: %v", source, err)
+		} else {
+			fmt.Fprintf(&b, "\n  %v", err)
+		}
+	}
+	return fmt.Errorf("%s", b.String())
+}
+
+// diffTemplateWithStateStore prints the differences between the rendered clusters and instance groups and those in the state store
+func diffTemplateWithStateStore(ctx context.Context, f commandutils.Factory, out io.Writer, content string) error {
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	for _, section := range text.SplitContentToSections([]byte(content)) {
+		if len(strings.TrimSpace(string(section))) == 0 {
+			continue
+		}
+		obj, gvk, err := kopscodecs.Decode(section, nil)
+		if err != nil {
+			return fmt.Errorf("error parsing rendered template: %v", err)
+		}
+
+		var name string
+		var live runtime.Object
+		switch v := obj.(type) {
+		case *kopsapi.Cluster:
+			name = "cluster " + v.ObjectMeta.Name
+			cluster, err := clientset.GetCluster(ctx, v.ObjectMeta.Name)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("error fetching cluster %q: %v", v.ObjectMeta.Name, err)
+			}
+			if err == nil {
+				live = cluster
+			}
+
+		case *kopsapi.InstanceGroup:
+			clusterName := v.ObjectMeta.Labels[kopsapi.LabelClusterName]
+			if clusterName == "" {
+				return fmt.Errorf("must specify %q label with cluster name to compare instanceGroup %q", kopsapi.LabelClusterName, v.ObjectMeta.Name)
+			}
+			name = "instanceGroup " + clusterName + "/" + v.ObjectMeta.Name
+			cluster, err := clientset.GetCluster(ctx, clusterName)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("error fetching cluster %q: %v", clusterName, err)
+			}
+			if err == nil {
+				ig, err := clientset.InstanceGroupsFor(cluster).Get(ctx, v.ObjectMeta.Name, metav1.GetOptions{})
+				if err != nil && !apierrors.IsNotFound(err) {
+					return fmt.Errorf("error fetching instanceGroup %q: %v", v.ObjectMeta.Name, err)
+				}
+				if err == nil {
+					live = ig
+				}
+			}
+
+		default:
+			fmt.Fprintf(out, "%s: not compared, only clusters and instance groups are compared with the state store\n\n", gvk.Kind)
+			continue
+		}
+
+		rendered, err := diffableYAML(obj)
+		if err != nil {
+			return err
+		}
+		if live == nil {
+			fmt.Fprintf(out, "%s: not in the state store\n%s\n", name, diff.FormatDiff("", rendered))
+			continue
+		}
+		current, err := diffableYAML(live)
+		if err != nil {
+			return err
+		}
+		if current == rendered {
+			fmt.Fprintf(out, "%s: no changes\n\n", name)
+			continue
+		}
+		fmt.Fprintf(out, "%s: changed\n%s\n", name, diff.FormatDiff(current, rendered))
+	}
+
+	return nil
+}
+
+// diffableYAML returns the versioned YAML of the object, without the metadata set by the state store
+func diffableYAML(obj runtime.Object) (string, error) {
+	obj = obj.DeepCopyObject()
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+	accessor.SetCreationTimestamp(metav1.Time{})
+	accessor.SetGeneration(0)
+	accessor.SetResourceVersion("")
+	accessor.SetUID("")
+	accessor.SetManagedFields(nil)
+
+	b, err := kopscodecs.ToVersionedYaml(obj)
+	if err != nil {
+		return "", fmt.Errorf("error converting %T to yaml: %v", obj, err)
+	}
+	return string(b), nil
+}
+
 // expandFiles is responsible for resolving any references to directories
 func expandFiles(path string) ([]string, error) {
 	// @check if the path is a directory, if not we can return straight away
@@ -17,12 +17,63 @@ limitations under the License.
 package main
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"k8s.io/kops/pkg/util/templater"
 )
 
 func TestNewTemplateContext(t *testing.T) {
-	context, _ := newTemplateContext([]string{"test/values.yaml"}, []string{"Foo=baz"}, []string{})
+	context, _ := newTemplateContext([]string{"test/values.yaml"}, []string{"Foo=baz"}, []string{}, make(templater.ValueSources))
 	if context["Foo"] != "baz" {
 		t.Errorf("Got %v, expected baz", context["foo"])
 	}
 }
+
+func TestValidateTemplateContext(t *testing.T) {
+	dir := t.TempDir()
+	valuesPath := filepath.Join(dir, "values.yaml")
+	schemaPath := filepath.Join(dir, "values.schema.yaml")
+	if err := os.WriteFile(valuesPath, []byte("nodes:\n  maxSize: three\n"), 0o644); err != nil {
+		t.Fatalf("error writing values: %v", err)
+	}
+	schema := `
+type: object
+properties:
+  nodes:
+    type: object
+    properties:
+      maxSize:
+        type: integer
+      minSize:
+        type: integer
+        default: 1
+`
+	if err := os.WriteFile(schemaPath, []byte(schema), 0o644); err != nil {
+		t.Fatalf("error writing schema: %v", err)
+	}
+
+	sources := make(templater.ValueSources)
+	context, err := newTemplateContext([]string{valuesPath}, nil, nil, sources)
+	if err != nil {
+		t.Fatalf("error building context: %v", err)
+	}
+	err = validateTemplateContext(schemaPath, context, sources)
+	expected := valuesPath + `: nodes.maxSize: Invalid value: "three": must be of type integer`
+	if err == nil || !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected error containing %q, got %v", expected, err)
+	}
+
+	context, err = newTemplateContext([]string{valuesPath}, []string{"nodes.maxSize=3"}, nil, sources)
+	if err != nil {
+		t.Fatalf("error building context: %v", err)
+	}
+	if err := validateTemplateContext(schemaPath, context, sources); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if minSize := context["nodes"].(map[string]interface{})["minSize"]; minSize != float64(1) {
+		t.Errorf("expected default minSize 1, got %v", minSize)
+	}
+}
@@ -22,7 +22,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -35,12 +37,15 @@ import (
 	"k8s.io/kops/pkg/apis/kops"
 	apisutil "k8s.io/kops/pkg/apis/kops/util"
 	"k8s.io/kops/pkg/assets"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/clusterlock"
 	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/kubeconfig"
 	"k8s.io/kops/pkg/predicates"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
 	"k8s.io/kops/upup/pkg/fi/utils"
+	"k8s.io/kops/util/pkg/tables"
 	"k8s.io/kubectl/pkg/util/i18n"
 	"k8s.io/kubectl/pkg/util/templates"
 )
@@ -68,6 +73,16 @@ type UpdateClusterOptions struct {
 	// Reconcile is true if we should reconcile the cluster by rolling the control plane and nodes sequentially
 	Reconcile bool
 
+	// Watch is true if we should keep applying the cluster whenever its specs change, and every WatchInterval
+	Watch bool
+	// WatchInterval is how often the cluster is applied in watch mode even if its specs did not change
+	WatchInterval time.Duration
+	// IgnoreMaintenanceWindow applies changes to instance groups in watch mode even outside the cluster's maintenance windows
+	IgnoreMaintenanceWindow bool
+	// PatchImages checks the instance groups with image patching for newer images, applies them,
+	// and rolls them out to the instance groups that enable rolling updates
+	PatchImages bool
+
 	kubeconfig.CreateKubecfgOptions
 	CoreUpdateClusterOptions
 }
@@ -91,6 +106,8 @@ type CoreUpdateClusterOptions struct {
 	IgnoreKubeletVersionSkew bool
 	// GetAssets is whether this is invoked from the CmdGetAssets.
 	GetAssets bool
+	// DryRunReport is where the report of the changes is written on a dry-run; defaults to stdout.
+	DryRunReport io.Writer
 
 	ClusterName string
 
@@ -113,6 +130,26 @@ type CoreUpdateClusterOptions struct {
 	// The goal is that the cluster can keep running even during more disruptive
 	// infrastructure changes.
 	Prune bool
+
+	// CapacityReport is true if we should print how the planned on-demand capacity maps onto existing reservations and Savings Plans.
+	CapacityReport bool
+
+	// AllowUnknownOnDemandPrices is true if the onDemandMaxPrice guardrail should skip instance types whose price cannot be looked up.
+	AllowUnknownOnDemandPrices bool
+
+	// CostEstimate is true if we should print how the estimated monthly cost changes on a dry-run.
+	CostEstimate bool
+
+	// ForceUnlock is true if we should remove the lock of the cluster held by another kops process.
+	ForceUnlock bool
+
+	// TaskGraph is the path of a file to write the dependency graph of the tasks to, in Graphviz DOT format.
+	TaskGraph string
+	// TaskTimings is true if we should print how long each task took to run.
+	TaskTimings bool
+
+	// instanceGroupFilter further restricts which instance groups we will update, if set.
+	instanceGroupFilter predicates.Predicate[*kops.InstanceGroup]
 }
 
 func (o *UpdateClusterOptions) InitDefaults() {
@@ -120,6 +157,9 @@ func (o *UpdateClusterOptions) InitDefaults() {
 
 	o.Reconcile = false
 
+	o.Watch = false
+	o.WatchInterval = 10 * time.Minute
+
 	// By default we export a kubecfg, but it doesn't have a static/eternal credential in it any more.
 	o.CreateKubecfg = true
 }
@@ -154,6 +194,12 @@ func NewCmdUpdateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
 		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if options.Watch {
+				return RunUpdateClusterWatch(cmd.Context(), f, out, options)
+			}
+			if options.PatchImages {
+				return RunUpdateClusterPatchImages(cmd.Context(), f, out, options)
+			}
 			_, err := RunUpdateCluster(cmd.Context(), f, out, options)
 			return err
 		},
@@ -191,11 +237,73 @@ func NewCmdUpdateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.RegisterFlagCompletionFunc("lifecycle-overrides", completeLifecycleOverrides)
 
 	cmd.Flags().BoolVar(&options.Prune, "prune", options.Prune, "Delete old revisions of cloud resources that were needed during an upgrade")
+	cmd.Flags().BoolVar(&options.CapacityReport, "capacity-report", options.CapacityReport, "Print how the planned on-demand capacity maps onto the account's reserved instances and Savings Plans (AWS only)")
+	cmd.Flags().BoolVar(&options.AllowUnknownOnDemandPrices, "allow-unknown-on-demand-prices", options.AllowUnknownOnDemandPrices, "Skip the onDemandMaxPrice check of instance types whose on-demand price cannot be looked up, instead of failing (AWS only)")
+	cmd.Flags().BoolVar(&options.CostEstimate, "cost-estimate", options.CostEstimate, "Print how the estimated monthly cost of the cluster would change, on a dry-run (AWS only)")
 	cmd.Flags().BoolVar(&options.IgnoreKubeletVersionSkew, "ignore-kubelet-version-skew", options.IgnoreKubeletVersionSkew, "Setting this to true will force updating the kubernetes version on all instance groups, regardles of which control plane version is running")
+	cmd.Flags().StringVar(&options.TaskGraph, "task-graph", options.TaskGraph, "Path to write the dependency graph of the tasks to, in Graphviz DOT format")
+	cmd.MarkFlagFilename("task-graph", "dot")
+	cmd.Flags().BoolVar(&options.TaskTimings, "task-timings", options.TaskTimings, "Print how long each task took to run")
+	cmd.Flags().BoolVar(&options.ForceUnlock, "force-unlock", options.ForceUnlock, "Remove the lock of the cluster held by another kops process, if that process is known to have stopped")
+	cmd.Flags().BoolVar(&options.Watch, "watch", options.Watch, "Keep running, applying the cluster whenever its specs change in the state store and every --watch-interval. Requires --yes")
+	cmd.Flags().DurationVar(&options.WatchInterval, "watch-interval", options.WatchInterval, "How often to apply the cluster with --watch even if its specs did not change, to revert drift")
+	cmd.Flags().BoolVar(&options.IgnoreMaintenanceWindow, "ignore-maintenance-window", options.IgnoreMaintenanceWindow, "Apply changes to instance groups with --watch even outside the maintenance windows of the cluster")
+	cmd.Flags().BoolVar(&options.PatchImages, "patch-images", options.PatchImages, "Check the instance groups with imagePatching for newer images, apply them and roll them out to the instance groups with rollingUpdate enabled; with --watch, on every apply. Requires --yes")
 
 	return cmd
 }
 
+// lockCluster locks the cluster in the state store, first removing the lock held by another process if forceUnlock is set.
+func lockCluster(ctx context.Context, clientset simple.Clientset, cluster *kops.Cluster, forceUnlock bool) (*clusterlock.Lock, error) {
+	configBase, err := clientset.ConfigBaseFor(cluster)
+	if err != nil {
+		return nil, err
+	}
+	if forceUnlock {
+		if err := clusterlock.ForceUnlock(ctx, configBase); err != nil {
+			return nil, err
+		}
+	}
+	return clusterlock.Acquire(ctx, configBase)
+}
+
+// printTaskTimings prints how long each task took to run, slowest first.
+func printTaskTimings(out io.Writer, timings *fi.TaskTimings) error {
+	list := timings.List()
+	if len(list) == 0 {
+		return nil
+	}
+	start := list[0].Start
+	for _, timing := range list {
+		if timing.Start.Before(start) {
+			start = timing.Start
+		}
+	}
+
+	t := &tables.Table{}
+	t.AddColumn("TASK", func(timing *fi.TaskTiming) string {
+		return timing.Key
+	})
+	t.AddColumn("DURATION", func(timing *fi.TaskTiming) string {
+		return timing.Duration.Round(time.Millisecond).String()
+	})
+	t.AddColumn("ATTEMPTS", func(timing *fi.TaskTiming) string {
+		return strconv.Itoa(timing.Attempts)
+	})
+	t.AddColumn("STARTED", func(timing *fi.TaskTiming) string {
+		return "+" + timing.Start.Sub(start).Round(time.Millisecond).String()
+	})
+	t.AddColumn("RESULT", func(timing *fi.TaskTiming) string {
+		if timing.Succeeded {
+			return "succeeded"
+		}
+		return "failed"
+	})
+
+	fmt.Fprintf(out, "\nTask timings:\n")
+	return t.Render(list, out, "TASK", "DURATION", "ATTEMPTS", "STARTED", "RESULT")
+}
+
 type UpdateClusterResults struct {
 	// Target is the fi.Target we will operate against.  This can be used to get dryrun results (primarily for tests)
 	Target fi.CloudupTarget
@@ -349,6 +457,9 @@ func RunUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Up
 	} else if len(c.InstanceGroupRoles) != 0 {
 		instanceGroupFilters = append(instanceGroupFilters, matchInstanceGroupRoles(c.InstanceGroupRoles))
 	}
+	if c.instanceGroupFilter != nil {
+		instanceGroupFilters = append(instanceGroupFilters, c.instanceGroupFilter)
+	}
 
 	cloud, err := cloudup.BuildCloud(cluster)
 	if err != nil {
@@ -364,24 +475,49 @@ func RunUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Up
 			klog.V(2).Infof("found control plane running version: %v", minControlPlaneRunningVersion)
 		}
 	}
+	runTasksOptions := c.RunTasksOptions
+	if c.TaskTimings {
+		runTasksOptions.Timings = &fi.TaskTimings{}
+	}
+
+	var taskGraph io.Writer
+	if c.TaskGraph != "" {
+		f, err := os.Create(c.TaskGraph)
+		if err != nil {
+			return nil, fmt.Errorf("error creating task graph file: %w", err)
+		}
+		defer f.Close()
+		taskGraph = f
+	}
+
 	applyCmd := &cloudup.ApplyClusterCmd{
 		Cloud:                      cloud,
 		Clientset:                  clientset,
 		Cluster:                    cluster,
 		DryRun:                     isDryrun,
 		AllowKopsDowngrade:         c.AllowKopsDowngrade,
-		RunTasksOptions:            &c.RunTasksOptions,
+		RunTasksOptions:            &runTasksOptions,
 		OutDir:                     c.OutDir,
 		InstanceGroupFilter:        predicates.AllOf(instanceGroupFilters...),
 		Phase:                      phase,
 		TargetName:                 targetName,
 		LifecycleOverrides:         lifecycleOverrideMap,
 		GetAssets:                  c.GetAssets,
+		DryRunReport:               c.DryRunReport,
 		DeletionProcessing:         deletionProcessing,
 		ControlPlaneRunningVersion: minControlPlaneRunningVersion,
+		CapacityReport:             c.CapacityReport,
+		AllowUnknownOnDemandPrices: c.AllowUnknownOnDemandPrices,
+		ForceUnlock:                c.ForceUnlock,
+		TaskGraph:                  taskGraph,
 	}
 
 	applyResults, err := applyCmd.Run(ctx)
+	if runTasksOptions.Timings != nil {
+		if err := printTaskTimings(out, runTasksOptions.Timings); err != nil {
+			klog.Warningf("error printing task timings: %v", err)
+		}
+	}
 	if err != nil {
 		return results, err
 	}
@@ -394,6 +530,11 @@ func RunUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Up
 
 	if isDryrun && !c.GetAssets {
 		target := applyCmd.Target.(*fi.CloudupDryRunTarget)
+		if c.CostEstimate {
+			if err := writeCostChange(ctx, out, cloud, target, applyCmd.TaskMap); err != nil {
+				return nil, err
+			}
+		}
 		if target.HasChanges() {
 			fmt.Fprintf(out, "Must specify --yes to apply changes\n")
 		} else {
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/imagepatching"
+	"k8s.io/kops/pkg/maintenancewindow"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+// RunUpdateClusterPatchImages checks the instance groups with image patching for newer images once, as from a cron job.
+// If any is found, it applies the cluster and rolls the newer images out to the instance groups that enable rolling updates.
+func RunUpdateClusterPatchImages(ctx context.Context, f *util.Factory, out io.Writer, c *UpdateClusterOptions) error {
+	if !c.Yes {
+		return fmt.Errorf("--patch-images requires --yes")
+	}
+	if c.Target != cloudup.TargetDirect {
+		return fmt.Errorf("--patch-images is only supported with --target=%s", cloudup.TargetDirect)
+	}
+
+	opt := *c
+	opt.PatchImages = false
+	return patchImages(ctx, f, out, &opt, false)
+}
+
+// patchImages checks the instance groups with image patching that are due for newer images, and applies the cluster with c
+// if any is found, or always with alwaysApply. It then runs a rolling update of the instance groups that are pending one
+// and inside their maintenance windows.
+func patchImages(ctx context.Context, f *util.Factory, out io.Writer, c *UpdateClusterOptions, alwaysApply bool) error {
+	now := time.Now()
+
+	cluster, err := GetCluster(ctx, f, c.ClusterName)
+	if err != nil {
+		return err
+	}
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+	configBase, err := clientset.ConfigBaseFor(cluster)
+	if err != nil {
+		return err
+	}
+	list, err := clientset.InstanceGroupsFor(cluster).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error reading instance groups: %w", err)
+	}
+	var groups []*kops.InstanceGroup
+	for i := range list.Items {
+		groups = append(groups, &list.Items[i])
+	}
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+	resolve, err := imagepatching.NewResolver(cloud)
+	if err != nil {
+		return err
+	}
+
+	statusPath := imagepatching.StatusPath(configBase)
+	status, err := imagepatching.ReadStatus(ctx, statusPath)
+	if err != nil {
+		return err
+	}
+
+	// Newer images are applied even if some could not be resolved
+	updates, checkErr := imagepatching.Check(ctx, cluster.GetCloudProvider(), groups, resolve, status, now)
+	for _, update := range updates {
+		fmt.Fprintf(out, "Found newer image %q for instance group %q, replacing %q\n", update.Image, update.InstanceGroup, update.PreviousImage)
+	}
+
+	if len(updates) != 0 || alwaysApply {
+		if _, err := RunUpdateCluster(ctx, f, out, c); err != nil {
+			return err
+		}
+	}
+	if err := imagepatching.WriteStatus(ctx, statusPath, status); err != nil {
+		return err
+	}
+
+	if err := rollingUpdatePatchedImages(ctx, f, out, c, cluster, status, statusPath, now); err != nil {
+		return err
+	}
+	return checkErr
+}
+
+// rollingUpdatePatchedImages runs a rolling update of the instance groups that are pending one after a newer image was applied,
+// recording in the status that they were updated.
+func rollingUpdatePatchedImages(ctx context.Context, f *util.Factory, out io.Writer, c *UpdateClusterOptions, cluster *kops.Cluster, status *imagepatching.Status, statusPath vfs.Path, now time.Time) error {
+	pending := imagepatching.PendingRollingUpdates(status)
+	if len(pending) == 0 {
+		return nil
+	}
+
+	var instanceGroups []string
+	if c.IgnoreMaintenanceWindow {
+		instanceGroups = pending
+	} else {
+		windows, err := maintenancewindow.ForCluster(cluster)
+		if err != nil {
+			return err
+		}
+		for _, name := range pending {
+			if open, next := maintenancewindow.Check(windows, name, now); open {
+				instanceGroups = append(instanceGroups, name)
+			} else {
+				klog.Infof("deferring the rolling update of instance group %q to its next maintenance window at %s", name, next.Format(time.RFC3339))
+			}
+		}
+	}
+	if len(instanceGroups) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(out, "Rolling out newer images to instance groups %q\n", instanceGroups)
+	options := &RollingUpdateOptions{}
+	options.InitDefaults()
+	options.ClusterName = c.ClusterName
+	options.InstanceGroups = instanceGroups
+	options.Yes = true
+	options.IgnoreMaintenanceWindow = true
+	if err := RunRollingUpdateCluster(ctx, f, out, options); err != nil {
+		return err
+	}
+
+	for _, name := range instanceGroups {
+		status.InstanceGroups[name].PendingRollingUpdate = false
+	}
+	return imagepatching.WriteStatus(ctx, statusPath, status)
+}
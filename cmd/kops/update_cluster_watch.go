@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/applyloop"
+	"k8s.io/kops/pkg/clusterlock"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+)
+
+const (
+	// watchLeaseDuration is how long the apply lease is valid without being renewed.
+	watchLeaseDuration = 5 * time.Minute
+	// watchPollInterval is how often the state store is checked for spec changes.
+	watchPollInterval = 30 * time.Second
+)
+
+// RunUpdateClusterWatch keeps applying the cluster until interrupted: whenever the cluster or instance group specs change
+// in the state store, and every WatchInterval to revert drift. Concurrent watchers of the same cluster coordinate
+// through a lease in the state store, and the outcome of each apply is recorded there as status conditions.
+func RunUpdateClusterWatch(ctx context.Context, f *util.Factory, out io.Writer, c *UpdateClusterOptions) error {
+	if !c.Yes {
+		return fmt.Errorf("--watch requires --yes")
+	}
+	if c.Target != cloudup.TargetDirect {
+		return fmt.Errorf("--watch is only supported with --target=%s", cloudup.TargetDirect)
+	}
+	if c.WatchInterval <= 0 {
+		return fmt.Errorf("--watch-interval must be positive")
+	}
+
+	cluster, err := GetCluster(ctx, f, c.ClusterName)
+	if err != nil {
+		return err
+	}
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+	configBase, err := clientset.ConfigBaseFor(cluster)
+	if err != nil {
+		return err
+	}
+
+	holderIdentity := clusterlock.HolderIdentity()
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	loop := &applyloop.Loop{
+		Lease:        applyloop.NewLease(configBase, holderIdentity, watchLeaseDuration),
+		StatusPath:   applyloop.StatusPath(configBase),
+		Interval:     c.WatchInterval,
+		PollInterval: watchPollInterval,
+		SpecHash: func(ctx context.Context) (string, error) {
+			return applyloop.SpecHash(ctx, clientset, c.ClusterName)
+		},
+		Apply: func(ctx context.Context) error {
+			opt := *c
+			opt.Watch = false
+			// Exporting the kubeconfig is left to an interactive kops update cluster
+			opt.CreateKubecfg = false
+			if !c.IgnoreMaintenanceWindow {
+				cluster, err := GetCluster(ctx, f, c.ClusterName)
+				if err != nil {
+					return err
+				}
+				filter, err := applyloop.MaintenanceWindowFilter(cluster, time.Now())
+				if err != nil {
+					return err
+				}
+				opt.instanceGroupFilter = filter
+			}
+			if c.PatchImages {
+				return patchImages(ctx, f, out, &opt, true)
+			}
+			_, err := RunUpdateCluster(ctx, f, out, &opt)
+			return err
+		},
+	}
+
+	fmt.Fprintf(out, "Watching cluster %q as %q; status is written to %s\n", cluster.ObjectMeta.Name, holderIdentity, loop.StatusPath)
+	return loop.Run(ctx)
+}
@@ -21,18 +21,23 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/blang/semver/v4"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"k8s.io/kops"
 	"k8s.io/kops/cmd/kops/util"
 	kopsapi "k8s.io/kops/pkg/apis/kops"
+	kopsmodel "k8s.io/kops/pkg/apis/kops/model"
 	kopsutil "k8s.io/kops/pkg/apis/kops/util"
 	"k8s.io/kops/pkg/commands"
 	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/model/components"
 	"k8s.io/kops/pkg/pretty"
+	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
 	"k8s.io/kops/util/pkg/tables"
 	"k8s.io/kubectl/pkg/util/i18n"
@@ -49,6 +54,9 @@ var (
 	upgradeClusterExample = templates.Examples(i18n.T(`
 	# Upgrade a cluster's Kubernetes version.
 	kops upgrade cluster k8s-cluster.example.com --yes --state=s3://my-state-store
+
+	# Upgrade only Kubernetes, keeping etcd, containerd and the addons at their current versions.
+	kops upgrade cluster k8s-cluster.example.com --components kubernetes --yes
 	`))
 
 	upgradeClusterShort = i18n.T("Upgrade a kubernetes cluster.")
@@ -60,8 +68,20 @@ type UpgradeClusterOptions struct {
 	Channel     string
 	// KubernetesVersion is the k8s version to use for upgrade.
 	KubernetesVersion string
+	// Components limits the upgrade to the listed components; the other components are kept at their current versions.
+	Components []string
 }
 
+const (
+	upgradeComponentKubernetes = "kubernetes"
+	upgradeComponentEtcd       = "etcd"
+	upgradeComponentContainerd = "containerd"
+	upgradeComponentAddons     = "addons"
+)
+
+// upgradeComponents are the components that can be selected with --components
+var upgradeComponents = []string{upgradeComponentKubernetes, upgradeComponentEtcd, upgradeComponentContainerd, upgradeComponentAddons}
+
 func NewCmdUpgradeCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	options := &UpgradeClusterOptions{}
 
@@ -84,20 +104,34 @@ func NewCmdUpgradeCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.RegisterFlagCompletionFunc("channel", completeChannel)
 	cmd.Flags().StringVar(&options.KubernetesVersion, "kubernetes-version", "", "Kubernetes version to use for upgrade")
 	cmd.RegisterFlagCompletionFunc("kubernetes-version", completeKubernetesVersion)
+	cmd.Flags().StringSliceVar(&options.Components, "components", options.Components, "Components to upgrade, from "+strings.Join(upgradeComponents, ", ")+". Components that are not listed are kept at their current version. Defaults to all components.")
+	cmd.RegisterFlagCompletionFunc("components", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return upgradeComponents, cobra.ShellCompDirectiveNoFileComp
+	})
 
 	return cmd
 }
 
 type upgradeAction struct {
-	Item     string
-	Property string
-	Old      string
-	New      string
+	Component string
+	Item      string
+	Property  string
+	Old       string
+	New       string
 
+	// apply changes the cluster spec; it is nil for versions that follow from other changes or from the kOps defaults
 	apply func()
 }
 
 func RunUpgradeCluster(ctx context.Context, f *util.Factory, out io.Writer, options *UpgradeClusterOptions) error {
+	selected := sets.New(upgradeComponents...)
+	if len(options.Components) != 0 {
+		selected = sets.New(options.Components...)
+		if unknown := selected.Difference(sets.New(upgradeComponents...)); unknown.Len() != 0 {
+			return fmt.Errorf("unknown components %q, must be one of %s", sets.List(unknown), strings.Join(upgradeComponents, ", "))
+		}
+	}
+
 	cluster, err := GetCluster(ctx, f, options.ClusterName)
 	if err != nil {
 		return err
@@ -128,10 +162,11 @@ func RunUpgradeCluster(ctx context.Context, f *util.Factory, out io.Writer, opti
 	var actions []*upgradeAction
 	if channelLocation != cluster.Spec.Channel {
 		actions = append(actions, &upgradeAction{
-			Item:     "Cluster",
-			Property: "Channel",
-			Old:      cluster.Spec.Channel,
-			New:      channelLocation,
+			Component: "cluster",
+			Item:      "Cluster",
+			Property:  "Channel",
+			Old:       cluster.Spec.Channel,
+			New:       channelLocation,
 			apply: func() {
 				cluster.Spec.Channel = channelLocation
 			},
@@ -208,12 +243,13 @@ func RunUpgradeCluster(ctx context.Context, f *util.Factory, out io.Writer, opti
 		proposedKubernetesVersion = currentKubernetesVersion
 	}
 
-	if proposedKubernetesVersion != nil && currentKubernetesVersion != nil && currentKubernetesVersion.NE(*proposedKubernetesVersion) {
+	if proposedKubernetesVersion != nil && currentKubernetesVersion != nil && currentKubernetesVersion.NE(*proposedKubernetesVersion) && selected.Has(upgradeComponentKubernetes) {
 		actions = append(actions, &upgradeAction{
-			Item:     "Cluster",
-			Property: "KubernetesVersion",
-			Old:      cluster.Spec.KubernetesVersion,
-			New:      proposedKubernetesVersion.String(),
+			Component: upgradeComponentKubernetes,
+			Item:      "Cluster",
+			Property:  "KubernetesVersion",
+			Old:       cluster.Spec.KubernetesVersion,
+			New:       proposedKubernetesVersion.String(),
 			apply: func() {
 				cluster.Spec.KubernetesVersion = proposedKubernetesVersion.String()
 			},
@@ -221,17 +257,35 @@ func RunUpgradeCluster(ctx context.Context, f *util.Factory, out io.Writer, opti
 	}
 
 	// For further calculations, default to the current kubernetes version
-	if proposedKubernetesVersion == nil {
+	if proposedKubernetesVersion == nil || !selected.Has(upgradeComponentKubernetes) {
 		proposedKubernetesVersion = currentKubernetesVersion
 	}
 
+	// CRI-O releases track Kubernetes minor versions, so a pinned CRI-O version has to follow the cluster
+	if cluster.UsesCRIO() && cluster.Spec.CRIO != nil && cluster.Spec.CRIO.Version != nil && proposedKubernetesVersion != nil {
+		crioVersion, err := semver.ParseTolerant(*cluster.Spec.CRIO.Version)
+		if err == nil && (crioVersion.Major != proposedKubernetesVersion.Major || crioVersion.Minor != proposedKubernetesVersion.Minor) {
+			proposedCRIOVersion := fmt.Sprintf("%d.%d.0", proposedKubernetesVersion.Major, proposedKubernetesVersion.Minor)
+			actions = append(actions, &upgradeAction{
+				Component: upgradeComponentKubernetes,
+				Item:      "Cluster",
+				Property:  "CRIO.Version",
+				Old:       *cluster.Spec.CRIO.Version,
+				New:       proposedCRIOVersion,
+				apply: func() {
+					cluster.Spec.CRIO.Version = &proposedCRIOVersion
+				},
+			})
+		}
+	}
+
 	cloud, err := cloudup.BuildCloud(cluster)
 	if err != nil {
 		return err
 	}
 
 	// Prompt to upgrade image
-	if proposedKubernetesVersion != nil {
+	if proposedKubernetesVersion != nil && selected.Has(upgradeComponentKubernetes) {
 		for _, ig := range instanceGroups {
 			architecture, err := cloudup.MachineArchitecture(cloud, ig.Spec.MachineType)
 			if err != nil {
@@ -247,10 +301,11 @@ func RunUpgradeCluster(ctx context.Context, f *util.Factory, out io.Writer, opti
 				if ig.Spec.Image != image.Name {
 					target := ig
 					actions = append(actions, &upgradeAction{
-						Item:     "InstanceGroup/" + target.ObjectMeta.Name,
-						Property: "Image",
-						Old:      target.Spec.Image,
-						New:      image.Name,
+						Component: upgradeComponentKubernetes,
+						Item:      "InstanceGroup/" + target.ObjectMeta.Name,
+						Property:  "Image",
+						Old:       target.Spec.Image,
+						New:       image.Name,
 						apply: func() {
 							target.Spec.Image = image.Name
 						},
@@ -262,15 +317,46 @@ func RunUpgradeCluster(ctx context.Context, f *util.Factory, out io.Writer, opti
 		}
 	}
 
-	if len(actions) == 0 {
+	// Compare the component versions that kOps sets by default with the versions of the last update
+	var holds []*upgradeAction
+	if proposedKubernetesVersion != nil {
+		applied, err := fullClusterSpecs(ctx, f.VFSContext(), []*kopsapi.Cluster{cluster})
+		if err != nil {
+			klog.Warningf("unable to read the applied cluster spec, so changes to etcd, containerd and addon versions are not shown: %v", err)
+		} else {
+			defaulted, defaultedHolds := defaultedComponentActions(cluster, applied[0], *proposedKubernetesVersion, selected)
+			actions = append(actions, defaulted...)
+			holds = defaultedHolds
+		}
+	}
+
+	var breakingChanges []kopsapi.BreakingChangeSpec
+	if currentKubernetesVersion != nil && proposedKubernetesVersion != nil {
+		for _, breakingChange := range kopsapi.FindBreakingChanges(channel.Spec.KubernetesVersions, *currentKubernetesVersion, *proposedKubernetesVersion) {
+			if breakingChange.Component == "" || selected.Has(breakingChange.Component) {
+				breakingChanges = append(breakingChanges, breakingChange)
+			}
+		}
+	}
+
+	notes := pinnedAddonVersionNotes(cluster)
+	for _, hold := range holds {
+		notes = append(notes, fmt.Sprintf("%s is kept at %s by setting %s, as %s is not being upgraded.", hold.Item, hold.New, hold.Property, hold.Component))
+	}
+
+	if len(actions) == 0 && len(holds) == 0 {
 		// TODO: Allow --force option to force even if not needed?
 		// Note stderr - we try not to print to stdout if no update is needed
+		printUpgradeNotes(os.Stderr, notes)
 		fmt.Fprintf(os.Stderr, "\nNo upgrade required\n")
 		return nil
 	}
 
 	{
 		t := &tables.Table{}
+		t.AddColumn("COMPONENT", func(a *upgradeAction) string {
+			return a.Component
+		})
 		t.AddColumn("ITEM", func(a *upgradeAction) string {
 			return a.Item
 		})
@@ -284,18 +370,22 @@ func RunUpgradeCluster(ctx context.Context, f *util.Factory, out io.Writer, opti
 			return a.New
 		})
 
-		err := t.Render(actions, out, "ITEM", "PROPERTY", "OLD", "NEW")
+		err := t.Render(actions, out, "COMPONENT", "ITEM", "PROPERTY", "OLD", "NEW")
 		if err != nil {
 			return err
 		}
 	}
+	printBreakingChanges(out, breakingChanges)
+	printUpgradeNotes(out, notes)
 
 	if !options.Yes {
 		fmt.Printf("\nMust specify --yes to perform upgrade\n")
 		return nil
 	}
-	for _, action := range actions {
-		action.apply()
+	for _, action := range append(actions, holds...) {
+		if action.apply != nil {
+			action.apply()
+		}
 	}
 
 	if err := commands.UpdateCluster(ctx, clientset, cluster, instanceGroups); err != nil {
@@ -321,3 +411,128 @@ func completeChannel(cmd *cobra.Command, args []string, toComplete string) ([]st
 	// TODO implement completion against VFS
 	return []string{"alpha", "stable"}, cobra.ShellCompDirectiveNoFileComp
 }
+
+// pinnedAddonVersionNotes reports the bundled addons whose version is pinned to a different version than the one this version of kOps deploys.
+// The upgrade does not change pinned versions, so that they are only upgraded when the user chooses to.
+func pinnedAddonVersionNotes(cluster *kopsapi.Cluster) []string {
+	var notes []string
+	addNote := func(field string, pinned *string, bundled string) {
+		if pinned == nil || *pinned == bundled {
+			return
+		}
+		notes = append(notes, fmt.Sprintf("%s is pinned to %q; kOps %s deploys %q. Remove %s to upgrade it.", field, *pinned, kops.Version, bundled, field))
+	}
+	if ms := cluster.Spec.MetricsServer; ms != nil && fi.ValueOf(ms.Enabled) && ms.Image == nil {
+		addNote("spec.metricsServer.version", ms.Version, components.MetricsServerVersion)
+	}
+	if vpa := cluster.Spec.VerticalPodAutoscaler; vpa != nil && fi.ValueOf(vpa.Enabled) {
+		addNote("spec.verticalPodAutoscaler.version", vpa.Version, components.VerticalPodAutoscalerVersion)
+	}
+	return notes
+}
+
+// defaultedComponentActions reports the changes to the versions of etcd, containerd and the addons that kOps sets by default,
+// by comparing the applied cluster spec with the defaults for the target kubernetes version.
+// Components that are not selected are held at their applied versions, by pinning the version in the cluster spec.
+func defaultedComponentActions(cluster *kopsapi.Cluster, applied *kopsapi.Cluster, kubernetesVersion semver.Version, selected sets.Set[string]) (actions []*upgradeAction, holds []*upgradeAction) {
+	addChange := func(component, item, property, field, oldVersion, newVersion string, hold func()) {
+		if oldVersion == "" || oldVersion == newVersion {
+			return
+		}
+		if selected.Has(component) {
+			actions = append(actions, &upgradeAction{
+				Component: component,
+				Item:      item,
+				Property:  property,
+				Old:       oldVersion,
+				New:       newVersion,
+			})
+		} else {
+			holds = append(holds, &upgradeAction{
+				Component: component,
+				Item:      item,
+				Property:  field,
+				New:       oldVersion,
+				apply:     hold,
+			})
+		}
+	}
+
+	if targetVersion, err := kopsmodel.ParseKubernetesVersion(kubernetesVersion.String()); err == nil {
+		for i := range cluster.Spec.EtcdClusters {
+			etcdCluster := &cluster.Spec.EtcdClusters[i]
+			if etcdCluster.Version != "" || etcdCluster.IsExternal() {
+				continue
+			}
+			for _, appliedEtcdCluster := range applied.Spec.EtcdClusters {
+				if appliedEtcdCluster.Name != etcdCluster.Name {
+					continue
+				}
+				appliedVersion := appliedEtcdCluster.Version
+				addChange(upgradeComponentEtcd, "EtcdCluster/"+etcdCluster.Name, "Version", fmt.Sprintf("spec.etcdClusters[%s].version", etcdCluster.Name),
+					appliedVersion, components.RecommendedEtcdVersion(*targetVersion), func() {
+						etcdCluster.Version = appliedVersion
+					})
+			}
+		}
+	}
+
+	if !cluster.UsesCRIO() && (cluster.Spec.Containerd == nil || fi.ValueOf(cluster.Spec.Containerd.Version) == "") && applied.Spec.Containerd != nil {
+		appliedContainerd := applied.Spec.Containerd
+		addChange(upgradeComponentContainerd, "Cluster", "Containerd.Version", "spec.containerd.version",
+			fi.ValueOf(appliedContainerd.Version), components.DefaultContainerdVersion, func() {
+				if cluster.Spec.Containerd == nil {
+					cluster.Spec.Containerd = &kopsapi.ContainerdConfig{}
+				}
+				cluster.Spec.Containerd.Version = appliedContainerd.Version
+				// runc is only defaulted together with the containerd version
+				if cluster.Spec.Containerd.Runc == nil {
+					cluster.Spec.Containerd.Runc = appliedContainerd.Runc
+				}
+			})
+	}
+
+	if ms := cluster.Spec.MetricsServer; ms != nil && fi.ValueOf(ms.Enabled) && ms.Image == nil && ms.Version == nil && applied.Spec.MetricsServer != nil {
+		appliedVersion := applied.Spec.MetricsServer.Version
+		addChange(upgradeComponentAddons, "Addon/metrics-server", "Version", "spec.metricsServer.version",
+			fi.ValueOf(appliedVersion), components.MetricsServerVersion, func() {
+				ms.Version = appliedVersion
+			})
+	}
+	if vpa := cluster.Spec.VerticalPodAutoscaler; vpa != nil && fi.ValueOf(vpa.Enabled) && vpa.Version == nil && applied.Spec.VerticalPodAutoscaler != nil {
+		appliedVersion := applied.Spec.VerticalPodAutoscaler.Version
+		addChange(upgradeComponentAddons, "Addon/vertical-pod-autoscaler", "Version", "spec.verticalPodAutoscaler.version",
+			fi.ValueOf(appliedVersion), components.VerticalPodAutoscalerVersion, func() {
+				vpa.Version = appliedVersion
+			})
+	}
+
+	return actions, holds
+}
+
+func printBreakingChanges(w io.Writer, breakingChanges []kopsapi.BreakingChangeSpec) {
+	if len(breakingChanges) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\nBreaking changes:\n")
+	for _, breakingChange := range breakingChanges {
+		component := breakingChange.Component
+		if component == "" {
+			component = upgradeComponentKubernetes
+		}
+		fmt.Fprintf(w, "  * [%s] %s\n", component, breakingChange.Description)
+		if breakingChange.URL != "" {
+			fmt.Fprintf(w, "    See %s\n", breakingChange.URL)
+		}
+	}
+}
+
+func printUpgradeNotes(w io.Writer, notes []string) {
+	if len(notes) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\nNotes:\n")
+	for _, note := range notes {
+		fmt.Fprintf(w, "  * %s\n", note)
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/model/components"
+)
+
+func TestPinnedAddonVersionNotes(t *testing.T) {
+	cluster := &kopsapi.Cluster{
+		Spec: kopsapi.ClusterSpec{
+			MetricsServer: &kopsapi.MetricsServerConfig{
+				Enabled: new(true),
+				Version: new(components.MetricsServerVersion),
+			},
+			VerticalPodAutoscaler: &kopsapi.VerticalPodAutoscalerConfig{
+				Enabled: new(true),
+				Version: new("1.0.0"),
+			},
+		},
+	}
+
+	notes := pinnedAddonVersionNotes(cluster)
+	if len(notes) != 1 || !strings.HasPrefix(notes[0], `spec.verticalPodAutoscaler.version is pinned to "1.0.0"`) {
+		t.Errorf("unexpected notes %q", notes)
+	}
+
+	cluster.Spec.VerticalPodAutoscaler.Version = nil
+	if notes := pinnedAddonVersionNotes(cluster); len(notes) != 0 {
+		t.Errorf("expected no notes without pinned versions, got %q", notes)
+	}
+}
+
+func TestDefaultedComponentActions(t *testing.T) {
+	newCluster := func() *kopsapi.Cluster {
+		return &kopsapi.Cluster{
+			Spec: kopsapi.ClusterSpec{
+				EtcdClusters: []kopsapi.EtcdClusterSpec{
+					{Name: "main"},
+					{Name: "events", Version: "3.5.9"},
+				},
+				MetricsServer: &kopsapi.MetricsServerConfig{
+					Enabled: new(true),
+				},
+			},
+		}
+	}
+	applied := &kopsapi.Cluster{
+		Spec: kopsapi.ClusterSpec{
+			EtcdClusters: []kopsapi.EtcdClusterSpec{
+				{Name: "main", Version: components.LatestEtcd35Version},
+				{Name: "events", Version: "3.5.9"},
+			},
+			Containerd: &kopsapi.ContainerdConfig{
+				Version: new("1.7.0"),
+				Runc:    &kopsapi.Runc{Version: new("1.1.0")},
+			},
+			MetricsServer: &kopsapi.MetricsServerConfig{
+				Enabled: new(true),
+				Version: new(components.MetricsServerVersion),
+			},
+		},
+	}
+	kubernetesVersion := semver.MustParse("1.34.1")
+
+	cluster := newCluster()
+	actions, holds := defaultedComponentActions(cluster, applied, kubernetesVersion, sets.New(upgradeComponents...))
+	if len(holds) != 0 {
+		t.Errorf("expected no holds when upgrading all components, got %d", len(holds))
+	}
+	var changes []string
+	for _, action := range actions {
+		changes = append(changes, action.Component+" "+action.Item+" "+action.Old+" -> "+action.New)
+	}
+	expected := []string{
+		"etcd EtcdCluster/main " + components.LatestEtcd35Version + " -> " + components.LatestEtcd36Version,
+		"containerd Cluster 1.7.0 -> " + components.DefaultContainerdVersion,
+	}
+	if strings.Join(changes, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected actions\nactual:   %q\nexpected: %q", changes, expected)
+	}
+
+	cluster = newCluster()
+	actions, holds = defaultedComponentActions(cluster, applied, kubernetesVersion, sets.New(upgradeComponentKubernetes))
+	if len(actions) != 0 {
+		t.Errorf("expected no actions for components that are not upgraded, got %d", len(actions))
+	}
+	if len(holds) != 2 {
+		t.Fatalf("expected etcd and containerd to be held, got %d holds", len(holds))
+	}
+	for _, hold := range holds {
+		hold.apply()
+	}
+	if cluster.Spec.EtcdClusters[0].Version != components.LatestEtcd35Version {
+		t.Errorf("expected etcd to be pinned to %q, got %q", components.LatestEtcd35Version, cluster.Spec.EtcdClusters[0].Version)
+	}
+	if containerd := cluster.Spec.Containerd; containerd == nil || *containerd.Version != "1.7.0" || *containerd.Runc.Version != "1.1.0" {
+		t.Errorf("expected containerd and runc to be pinned, got %+v", containerd)
+	}
+}
+
+func TestFindBreakingChanges(t *testing.T) {
+	versions := []kopsapi.KubernetesVersionSpec{
+		{Range: ">=1.35.0", RecommendedVersion: "1.35.1"},
+		{Range: ">=1.34.0", RecommendedVersion: "1.34.1", BreakingChanges: []kopsapi.BreakingChangeSpec{{Component: "etcd", Description: "etcd 3.6"}}},
+		{Range: ">=1.33.0", RecommendedVersion: "1.33.1", BreakingChanges: []kopsapi.BreakingChangeSpec{{Description: "1.33"}}},
+	}
+
+	grid := []struct {
+		from, to string
+		expected int
+	}{
+		{from: "1.33.0", to: "1.33.5", expected: 0},
+		{from: "1.33.0", to: "1.34.0", expected: 1},
+		{from: "1.32.0", to: "1.35.0", expected: 2},
+		{from: "1.34.0", to: "1.35.0", expected: 0},
+	}
+	for _, g := range grid {
+		changes := kopsapi.FindBreakingChanges(versions, semver.MustParse(g.from), semver.MustParse(g.to))
+		if len(changes) != g.expected {
+			t.Errorf("upgrade from %s to %s: expected %d breaking changes, got %v", g.from, g.to, g.expected, changes)
+		}
+	}
+}
@@ -38,6 +38,7 @@ import (
 	"k8s.io/kops/pkg/client/simple/api"
 	"k8s.io/kops/pkg/client/simple/vfsclientset"
 	"k8s.io/kops/pkg/kubeconfig"
+	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
 	"k8s.io/kops/util/pkg/vfs"
 	gceacls "k8s.io/kops/util/pkg/vfs/acls/gce"
@@ -92,6 +93,8 @@ You can find the supported stores in https://kops.sigs.k8s.io/state.`
 Please use a valid state store when setting --state or KOPS_STATE_STORE env var.
 For example, a valid value follows the format s3://<bucket>.
 Trailing slash will be trimmed.`
+
+	stateStoreRemediation = "See https://kops.sigs.k8s.io/state for the supported state stores."
 )
 
 func (f *Factory) KopsClient() (simple.Clientset, error) {
@@ -99,7 +102,7 @@ func (f *Factory) KopsClient() (simple.Clientset, error) {
 		registryPath := f.options.RegistryPath
 		klog.V(2).Infof("state store %s", registryPath)
 		if registryPath == "" {
-			return nil, field.Required(field.NewPath("State Store"), STATE_ERROR)
+			return nil, fi.NewStateStoreError(field.Required(field.NewPath("State Store"), STATE_ERROR), stateStoreRemediation)
 		}
 
 		// We recognize a `k8s` scheme; this might change in future so we won't document it yet
@@ -139,11 +142,11 @@ func (f *Factory) KopsClient() (simple.Clientset, error) {
 		} else {
 			basePath, err := f.VFSContext().BuildVfsPath(registryPath)
 			if err != nil {
-				return nil, fmt.Errorf("error building path for %q: %v", registryPath, err)
+				return nil, fi.NewStateStoreError(fmt.Errorf("error building path for %q: %v", registryPath, err), stateStoreRemediation)
 			}
 
 			if !vfs.IsClusterReadable(basePath) {
-				return nil, field.Invalid(field.NewPath("State Store"), registryPath, INVALID_STATE_ERROR)
+				return nil, fi.NewStateStoreError(field.Invalid(field.NewPath("State Store"), registryPath, INVALID_STATE_ERROR), stateStoreRemediation)
 			}
 
 			f.clientset = vfsclientset.NewVFSClientset(f.VFSContext(), basePath)
@@ -21,13 +21,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"os"
+	"sort"
 	"strings"
 	"time"
 
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awstasks"
 	"k8s.io/kubectl/pkg/util/i18n"
 	"k8s.io/kubectl/pkg/util/templates"
 
@@ -50,12 +52,20 @@ var (
 		2. All worker nodes are running and have "Ready" status.
 		3. All control plane nodes have the expected pods.
 		4. All pods with a critical priority are running and have "Ready" status.
+
+		With --cloud-resources, it also checks the health of the cloud resources backing
+		the cluster (AWS only): the API load balancer targets, suspended autoscaling processes,
+		volumes in the error state or detached etcd volumes, and security group rules that
+		differ from the cluster spec.
 		`))
 
 	validateClusterExample = templates.Examples(i18n.T(`
 	# Validate the cluster set as the current context of the kube config.
 	# Kops will try for 10 minutes to validate the cluster 3 times.
-	kops validate cluster --wait 10m --count 3`))
+	kops validate cluster --wait 10m --count 3
+
+	# Also validate the health of the cluster's cloud resources.
+	kops validate cluster --cloud-resources`))
 
 	validateClusterShort = i18n.T(`Validate a kOps cluster.`)
 )
@@ -71,6 +81,9 @@ type ValidateClusterOptions struct {
 
 	MaxUnreadyNodes int
 
+	// CloudResources is true if we should also check the health of the cloud resources backing the cluster
+	CloudResources bool
+
 	// filterInstanceGroups is a function that returns true if the instance group should be validated
 	filterInstanceGroups func(ig *kopsapi.InstanceGroup) bool
 
@@ -106,7 +119,7 @@ func NewCmdValidateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 			// We want the validate command to exit non-zero if validation found a problem,
 			// even if we didn't really hit an error during validation.
 			if len(result.Failures) != 0 {
-				os.Exit(2)
+				return errValidationFailed
 			}
 			return nil
 		},
@@ -121,6 +134,7 @@ func NewCmdValidateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().DurationVar(&options.interval, "interval", options.interval, "Time in duration to wait between validation attempts")
 	cmd.Flags().StringVar(&options.kubeconfig, "kubeconfig", "", "Path to the kubeconfig file")
 	cmd.Flags().IntVar(&options.MaxUnreadyNodes, "max-unready-nodes", options.MaxUnreadyNodes, "The maximum number of non-ready worker nodes tolerated during validation")
+	cmd.Flags().BoolVar(&options.CloudResources, "cloud-resources", options.CloudResources, "Also check the health of the cloud resources backing the cluster (AWS only)")
 
 	options.CreateKubecfgOptions.AddCommonFlags(cmd.Flags())
 
@@ -153,8 +167,10 @@ func RunValidateCluster(ctx context.Context, f *util.Factory, out io.Writer, opt
 	}
 
 	var instanceGroups []kopsapi.InstanceGroup
-	for _, ig := range list.Items {
+	var instanceGroupPointers []*kopsapi.InstanceGroup
+	for i, ig := range list.Items {
 		instanceGroups = append(instanceGroups, ig)
+		instanceGroupPointers = append(instanceGroupPointers, &list.Items[i])
 		klog.V(2).Infof("instance group: %#v\n\n", ig.Spec)
 	}
 
@@ -184,6 +200,15 @@ func RunValidateCluster(ctx context.Context, f *util.Factory, out io.Writer, opt
 		return nil, fmt.Errorf("unexpected error creating validatior: %v", err)
 	}
 
+	// The security group drift needs a full dry-run update, so it is computed once rather than on every attempt.
+	var driftFailures []*validation.ValidationError
+	if options.CloudResources {
+		driftFailures, err = securityGroupDrift(ctx, f, cluster)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	consecutive := 0
 	for {
 		if options.wait > 0 && time.Now().After(timeout) && consecutive == 0 {
@@ -191,6 +216,10 @@ func RunValidateCluster(ctx context.Context, f *util.Factory, out io.Writer, opt
 		}
 
 		result, err := validator.Validate(ctx)
+		if err == nil && options.CloudResources {
+			err = result.ValidateCloudResources(ctx, cluster, cloud, instanceGroupPointers)
+			result.Failures = append(result.Failures, driftFailures...)
+		}
 		if err != nil {
 			consecutive = 0
 			if options.wait > 0 {
@@ -300,6 +329,21 @@ func validateClusterOutputTable(result *validation.ValidationCluster, cluster *k
 		}
 	}
 
+	if len(result.EtcdDatabases) != 0 {
+		etcdTable := &tables.Table{}
+		etcdTable.AddColumn("STORAGE CLUSTER ID", func(d *validation.ValidationEtcdDatabase) string {
+			return d.StorageClusterID
+		})
+		etcdTable.AddColumn("SIZE", func(d *validation.ValidationEtcdDatabase) string {
+			return validation.FormatBytes(d.SizeBytes)
+		})
+
+		fmt.Fprintln(out, "\nETCD DATABASES")
+		if err := etcdTable.Render(result.EtcdDatabases, out, "STORAGE CLUSTER ID", "SIZE"); err != nil {
+			return fmt.Errorf("cannot render etcd databases for %q: %v", cluster.Name, err)
+		}
+	}
+
 	if len(result.Failures) != 0 {
 		failuresTable := &tables.Table{}
 		failuresTable.AddColumn("KIND", func(e *validation.ValidationError) string {
@@ -311,9 +355,20 @@ func validateClusterOutputTable(result *validation.ValidationCluster, cluster *k
 		failuresTable.AddColumn("MESSAGE", func(e *validation.ValidationError) string {
 			return e.Message
 		})
+		failuresTable.AddColumn("REMEDIATION", func(e *validation.ValidationError) string {
+			return e.Remediation
+		})
+
+		columns := []string{"KIND", "NAME", "MESSAGE"}
+		for _, failure := range result.Failures {
+			if failure.Remediation != "" {
+				columns = append(columns, "REMEDIATION")
+				break
+			}
+		}
 
 		fmt.Fprintln(out, "\nVALIDATION ERRORS")
-		if err := failuresTable.Render(result.Failures, out, "KIND", "NAME", "MESSAGE"); err != nil {
+		if err := failuresTable.Render(result.Failures, out, columns...); err != nil {
 			return fmt.Errorf("error rendering failures table: %v", err)
 		}
 
@@ -324,3 +379,65 @@ func validateClusterOutputTable(result *validation.ValidationCluster, cluster *k
 
 	return nil
 }
+
+// securityGroupDrift returns failures for security group rules that differ from the cluster spec.
+func securityGroupDrift(ctx context.Context, f *util.Factory, cluster *kopsapi.Cluster) ([]*validation.ValidationError, error) {
+	if cluster.GetCloudProvider() != kopsapi.CloudProviderAWS {
+		return nil, nil
+	}
+
+	// A dry-run update compares the security group rules in the cloud with the ones the cluster spec requires.
+	updateResults, err := RunCoreUpdateCluster(ctx, f, io.Discard, &CoreUpdateClusterOptions{
+		Target:      cloudup.TargetDryRun,
+		ClusterName: cluster.ObjectMeta.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("computing security group drift: %w", err)
+	}
+	target, ok := updateResults.Target.(*fi.CloudupDryRunTarget)
+	if !ok {
+		return nil, fmt.Errorf("unexpected target type %T", updateResults.Target)
+	}
+
+	var failures []*validation.ValidationError
+
+	creates, updates := target.Changes()
+	var drifted []string
+	for name, task := range creates {
+		if _, ok := task.(*awstasks.SecurityGroupRule); ok {
+			drifted = append(drifted, name)
+		}
+	}
+	for name, task := range updates {
+		if _, ok := task.(*awstasks.SecurityGroupRule); ok {
+			drifted = append(drifted, name)
+		}
+	}
+	sort.Strings(drifted)
+	for _, name := range drifted {
+		failures = append(failures, &validation.ValidationError{
+			Kind:        "SecurityGroupRule",
+			Name:        name,
+			Message:     fmt.Sprintf("security group rule %q is missing or differs from the cluster spec", name),
+			Remediation: "Run `kops update cluster --yes` to restore the rule.",
+		})
+	}
+
+	var extra []string
+	for _, deletion := range target.DeletionTasks() {
+		if awstasks.IsSecurityGroupRuleDeletion(deletion) {
+			extra = append(extra, deletion.Item())
+		}
+	}
+	sort.Strings(extra)
+	for _, item := range extra {
+		failures = append(failures, &validation.ValidationError{
+			Kind:        "SecurityGroupRule",
+			Name:        item,
+			Message:     fmt.Sprintf("security group rule %q was added outside of the cluster spec", item),
+			Remediation: "Run `kops update cluster --prune --yes` to remove it.",
+		})
+	}
+
+	return failures, nil
+}
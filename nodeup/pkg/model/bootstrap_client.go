@@ -102,7 +102,7 @@ func (b BootstrapClientBuilder) Build(c *fi.NodeupModelBuilderContext) error {
 		authenticator = a
 
 	case kops.CloudProviderMetal:
-		a, err := pkibootstrap.NewAuthenticatorFromFile("/etc/kubernetes/kops/pki/machine/private.pem")
+		a, err := pkibootstrap.NewAuthenticatorFromFile(pkibootstrap.MachineKeyPath)
 		if err != nil {
 			return err
 		}
@@ -119,6 +119,29 @@ func (b BootstrapClientBuilder) Build(c *fi.NodeupModelBuilderContext) error {
 	}
 
 	bootstrapClient := kopscontrollerclient.New(authenticator, []byte(b.NodeupConfig.CAs[fi.CertificateIDCA]), baseURL)
+	bootstrapClient.PinCAs(b.NodeupConfig.KopsControllerCAPins)
+	bootstrapClient.UseClientCertificate(kopscontrollerclient.ClientCertificatePath, kopscontrollerclient.ClientKeyPath)
+
+	// The client certificate is renewed on every run, and authenticates the node when it calls kops-controller again
+	if b.NodeupConfig.KopsControllerClientCertificate {
+		cert, key, err := b.GetBootstrapCert("kops-controller-client", fi.CertificateIDCA)
+		if err != nil {
+			return err
+		}
+		c.AddTask(&nodetasks.File{
+			Path:     kopscontrollerclient.ClientCertificatePath,
+			Contents: cert,
+			Type:     nodetasks.FileType_File,
+			Mode:     new("0644"),
+		})
+		c.AddTask(&nodetasks.File{
+			Path:     kopscontrollerclient.ClientKeyPath,
+			Contents: key,
+			Type:     nodetasks.FileType_File,
+			Mode:     new("0600"),
+		})
+	}
+
 	bootstrapClientTask := &nodetasks.BootstrapClientTask{
 		Client:     bootstrapClient,
 		Certs:      b.bootstrapCerts,
@@ -18,6 +18,7 @@ package model
 
 import (
 	_ "embed"
+	"encoding/base64"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
@@ -32,6 +33,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/flagbuilder"
+	"k8s.io/kops/pkg/kubemanifest"
 	"k8s.io/kops/pkg/systemd"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
@@ -52,6 +54,10 @@ var _ fi.NodeupModelBuilder = &ContainerdBuilder{}
 
 // Build is responsible for configuring the containerd daemon
 func (b *ContainerdBuilder) Build(c *fi.NodeupModelBuilderContext) error {
+	if b.UsesCRIO() {
+		klog.Infof("CRI-O is the container runtime; won't install containerd")
+		return nil
+	}
 	if b.skipInstall() {
 		klog.Infof("SkipInstall is set to true; won't install containerd")
 		return nil
@@ -320,6 +326,10 @@ func (b *ContainerdBuilder) buildSysconfigFile(c *fi.NodeupModelBuilderContext)
 	lines := []string{
 		"CONTAINERD_OPTS=" + flagsString,
 	}
+	if b.NodeupConfig.FIPSMode {
+		// containerd passes its environment on to the shims and runc
+		lines = append(lines, "GODEBUG="+kubemanifest.GoFIPSModeGODEBUG)
+	}
 	contents := strings.Join(lines, "\n")
 
 	c.AddTask(&nodetasks.File{
@@ -550,7 +560,7 @@ func (b *ContainerdBuilder) buildContainerdConfigV2() (string, error) {
 	if containerd.SandboxImage != nil {
 		config.SetPath([]string{"plugins", "io.containerd.grpc.v1.cri", "sandbox_image"}, fi.ValueOf(containerd.SandboxImage))
 	}
-	if len(containerd.RegistryMirrors) > 0 {
+	if hasRegistryHosts(containerd) {
 		config.SetPath([]string{"plugins", "io.containerd.grpc.v1.cri", "registry", "config_path"}, containerdRegistryDirPath)
 	}
 	config.SetPath([]string{"plugins", "io.containerd.grpc.v1.cri", "containerd", "default_runtime_name"}, "runc")
@@ -609,7 +619,7 @@ func (b *ContainerdBuilder) buildContainerdConfigV3() (string, error) {
 		// under pinned_images.sandbox.
 		config.SetPath([]string{"plugins", "io.containerd.cri.v1.images", "pinned_images", "sandbox"}, fi.ValueOf(containerd.SandboxImage))
 	}
-	if len(containerd.RegistryMirrors) > 0 {
+	if hasRegistryHosts(containerd) {
 		config.SetPath([]string{"plugins", "io.containerd.cri.v1.images", "registry", "config_path"}, containerdRegistryDirPath)
 	}
 	config.SetPath([]string{"plugins", "io.containerd.cri.v1.runtime", "containerd", "default_runtime_name"}, "runc")
@@ -747,37 +757,60 @@ func (b *ContainerdBuilder) buildGVisorShimConfig(c *fi.NodeupModelBuilderContex
 	})
 }
 
-// buildRegistryHosts emits one hosts.toml per RegistryMirrors entry under containerdRegistryDirPath.
+// buildRegistryHosts emits one hosts.toml per RegistryMirrors or Registries entry under containerdRegistryDirPath.
 // The directory is referenced by registry.config_path in the main containerd config; the
-// emit-files-iff-mirrors-non-empty condition here must stay in sync with the registry.config_path
+// emit-files-iff-hasRegistryHosts condition here must stay in sync with the registry.config_path
 // emission in buildContainerdConfigV2/V3.
 // containerd watches this directory at runtime, so no daemon reload is needed when a hosts.toml changes.
 // containerd uses host declaration order as mirror priority, so endpoints are emitted in the order
 // the user provided them rather than sorted (go-toml's Tree.String alphabetizes subtables).
 // Format reference: https://github.com/containerd/containerd/blob/main/docs/hosts.md
 func (b *ContainerdBuilder) buildRegistryHosts(c *fi.NodeupModelBuilderContext) error {
+	containerd := b.NodeupConfig.ContainerdConfig
 	// ConfigOverride is a complete bypass of the generated containerd config; honour
 	// that contract by also skipping the registry hosts.toml files it would reference.
-	if b.NodeupConfig.ContainerdConfig.ConfigOverride != nil {
+	if containerd.ConfigOverride != nil {
 		return nil
 	}
-	mirrors := b.NodeupConfig.ContainerdConfig.RegistryMirrors
-	if len(mirrors) == 0 {
+	if !hasRegistryHosts(containerd) {
 		return nil
 	}
 
+	auths, err := b.registryAuths(containerd.Registries)
+	if err != nil {
+		return err
+	}
+
 	// Sort names so emission order is stable for golden tests and reproducible diffs.
-	names := make([]string, 0, len(mirrors))
-	for name := range mirrors {
+	var names []string
+	for name := range containerd.RegistryMirrors {
 		names = append(names, name)
 	}
+	for name := range containerd.Registries {
+		if _, found := containerd.RegistryMirrors[name]; !found {
+			names = append(names, name)
+		}
+	}
 	sort.Strings(names)
 
 	for _, name := range names {
 		var buf strings.Builder
-		for _, endpoint := range mirrors[name] {
+		hasAuth := false
+		if registry, found := containerd.Registries[name]; found {
+			fmt.Fprintf(&buf, "server = %q\n", "https://"+name)
+			writeRegistryHostSettings(&buf, "", "header", name, registry, auths)
+			buf.WriteString("\n")
+			hasAuth = hasAuth || registry.UseDockerConfigAuth
+		}
+		for _, endpoint := range containerd.RegistryMirrors[name] {
 			fmt.Fprintf(&buf, "[host.%q]\n", endpoint)
-			buf.WriteString("  capabilities = [\"pull\", \"resolve\"]\n\n")
+			buf.WriteString("  capabilities = [\"pull\", \"resolve\"]\n")
+			host := registryHost(endpoint)
+			if registry, found := containerd.Registries[host]; found {
+				writeRegistryHostSettings(&buf, "  ", fmt.Sprintf("host.%q.header", endpoint), host, registry, auths)
+				hasAuth = hasAuth || registry.UseDockerConfigAuth
+			}
+			buf.WriteString("\n")
 		}
 		// containerd uses the special "_default" directory as the catch-all namespace.
 		// Translate the kops convention of "*" so users don't end up with a literal
@@ -786,11 +819,110 @@ func (b *ContainerdBuilder) buildRegistryHosts(c *fi.NodeupModelBuilderContext)
 		if dir == "*" {
 			dir = "_default"
 		}
-		c.AddTask(&nodetasks.File{
+		file := &nodetasks.File{
 			Path:     filepath.Join(containerdRegistryDirPath, dir, "hosts.toml"),
 			Contents: fi.NewStringResource(buf.String()),
 			Type:     nodetasks.FileType_File,
-		})
+		}
+		if hasAuth {
+			// The file carries registry credentials.
+			file.Mode = s("0600")
+		}
+		c.AddTask(file)
+
+		if registry, found := containerd.Registries[name]; found && registry.CA != "" {
+			c.AddTask(&nodetasks.File{
+				Path:     registryCAPath(name),
+				Contents: fi.NewStringResource(registry.CA),
+				Type:     nodetasks.FileType_File,
+			})
+		}
 	}
 	return nil
 }
+
+// hasRegistryHosts returns true if containerd should be pointed at per-registry hosts.toml files.
+func hasRegistryHosts(containerd *kops.ContainerdConfig) bool {
+	return len(containerd.RegistryMirrors) > 0 || len(containerd.Registries) > 0
+}
+
+// writeRegistryHostSettings writes the TLS and authentication settings of a registry host.
+// headerTable is the name of the TOML table holding the HTTP headers sent to the host.
+func writeRegistryHostSettings(buf *strings.Builder, indent string, headerTable string, name string, registry kops.ContainerdRegistryConfig, auths map[string]string) {
+	if registry.CA != "" {
+		fmt.Fprintf(buf, "%sca = %q\n", indent, registryCAPath(name))
+	}
+	if registry.SkipVerify {
+		fmt.Fprintf(buf, "%sskip_verify = true\n", indent)
+	}
+	if registry.UseDockerConfigAuth {
+		fmt.Fprintf(buf, "\n%s[%s]\n", indent, headerTable)
+		fmt.Fprintf(buf, "%s  Authorization = %q\n", indent, "Basic "+auths[name])
+	}
+}
+
+// registryCAPath returns the path of the CA bundle for a registry.
+func registryCAPath(name string) string {
+	return filepath.Join(containerdRegistryDirPath, name, "ca.crt")
+}
+
+// registryHost returns the host (and port) of a registry endpoint URL.
+func registryHost(endpoint string) string {
+	host := endpoint
+	if i := strings.Index(host, "://"); i != -1 {
+		host = host[i+3:]
+	}
+	if i := strings.Index(host, "/"); i != -1 {
+		host = host[:i]
+	}
+	return host
+}
+
+// registryAuths returns the base64-encoded basic auth credentials of every registry using UseDockerConfigAuth,
+// read from the dockerconfig secret.
+func (b *ContainerdBuilder) registryAuths(registries map[string]kops.ContainerdRegistryConfig) (map[string]string, error) {
+	auths := make(map[string]string)
+
+	var names []string
+	for name, registry := range registries {
+		if registry.UseDockerConfigAuth {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return auths, nil
+	}
+
+	if b.SecretStore == nil {
+		return nil, fmt.Errorf("secret store is required for registry credentials")
+	}
+	secret, err := b.SecretStore.Secret("dockerconfig")
+	if err != nil {
+		return nil, fmt.Errorf("reading registry credentials: %w", err)
+	}
+
+	var dockerConfig struct {
+		Auths map[string]struct {
+			Auth     string `json:"auth"`
+			Username string `json:"username"`
+			Password string `json:"password"`
+		} `json:"auths"`
+	}
+	if err := json.Unmarshal(secret.Data, &dockerConfig); err != nil {
+		return nil, fmt.Errorf("parsing dockerconfig secret: %w", err)
+	}
+	for key, entry := range dockerConfig.Auths {
+		auth := entry.Auth
+		if auth == "" && entry.Username != "" {
+			auth = base64.StdEncoding.EncodeToString([]byte(entry.Username + ":" + entry.Password))
+		}
+		auths[registryHost(key)] = auth
+	}
+
+	for _, name := range names {
+		if auths[name] == "" {
+			return nil, fmt.Errorf("dockerconfig secret has no credentials for registry %q", name)
+		}
+	}
+	return auths, nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/pelletier/go-toml"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/apis/nodeup"
+	"k8s.io/kops/pkg/configserver"
 	"k8s.io/kops/pkg/diff"
 	"k8s.io/kops/pkg/flagbuilder"
 	"k8s.io/kops/pkg/testutils"
@@ -52,6 +53,10 @@ func TestContainerdBuilder_V3(t *testing.T) {
 	runContainerdBuilderTest(t, "v3", distributions.DistributionUbuntu2604)
 }
 
+func TestContainerdBuilder_Registries(t *testing.T) {
+	runContainerdBuilderTest(t, "registries", distributions.DistributionUbuntu2604)
+}
+
 func TestContainerdBuilder_BuildFlags(t *testing.T) {
 	grid := []struct {
 		config   kops.ContainerdConfig
@@ -152,6 +157,9 @@ func runContainerdBuilderTest(t *testing.T, key string, distro distributions.Dis
 	}
 
 	nodeUpModelContext.Distribution = distro
+	nodeUpModelContext.SecretStore = configserver.NewSecretStore(map[string][]byte{
+		"dockerconfig": []byte(`{"auths":{"https://mirror.example.com/v2/":{"auth":"dXNlcjpwYXNzd29yZA=="}}}`),
+	})
 
 	nodeUpModelContext.Assets = fi.NewAssetStore("")
 	nodeUpModelContext.Assets.AddForTest("containerd", "bin/containerd", "testing containerd content")
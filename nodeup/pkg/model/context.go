@@ -337,15 +337,9 @@ func (c *NodeupModelContext) UseChallengeCallback(cloudProvider kops.CloudProvid
 	return kopsmodel.UseChallengeCallback(cloudProvider)
 }
 
+// UseExternalKubeletCredentialProvider is true if the kubelet uses image credential providers.
 func (c *NodeupModelContext) UseExternalKubeletCredentialProvider() bool {
-	switch c.CloudProvider() {
-	case kops.CloudProviderGCE:
-		return true
-	case kops.CloudProviderAWS:
-		return true
-	default:
-		return false
-	}
+	return len(kopsmodel.KubeletCredentialProviders(c.CloudProvider(), c.NodeupConfig.KubeletCredentialProviders)) > 0
 }
 
 // UsesSecondaryIP checks if the CNI in use attaches secondary interfaces to the host.
@@ -573,6 +567,11 @@ func (c *NodeupModelContext) InstallGVisorRuntime() bool {
 		fi.ValueOf(c.NodeupConfig.GVisor.Enabled)
 }
 
+// UsesCRIO returns true if CRI-O is the container runtime, instead of containerd.
+func (c *NodeupModelContext) UsesCRIO() bool {
+	return c.NodeupConfig.CRIOConfig != nil
+}
+
 // CloudProvider returns the cloud provider we are running on
 func (c *NodeupModelContext) CloudProvider() kops.CloudProviderID {
 	return c.BootConfig.CloudProvider
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/systemd"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+	"k8s.io/kops/util/pkg/distributions"
+)
+
+const (
+	crioConfigFilePath = "/etc/crio/crio.conf.d/10-kops.conf"
+	crioSocketPath     = "/var/run/crio/crio.sock"
+	crioBinaryPath     = "/usr/bin"
+)
+
+// CRIOBuilder installs and configures CRI-O, when it is used as the container runtime instead of containerd
+type CRIOBuilder struct {
+	*NodeupModelContext
+}
+
+var _ fi.NodeupModelBuilder = &CRIOBuilder{}
+
+// Build is responsible for configuring the CRI-O daemon
+func (b *CRIOBuilder) Build(c *fi.NodeupModelBuilderContext) error {
+	if !b.UsesCRIO() {
+		return nil
+	}
+
+	// Immutable OSes ship containerd and don't let us install another runtime
+	switch b.Distribution {
+	case distributions.DistributionFlatcar, distributions.DistributionContainerOS:
+		return fmt.Errorf("CRI-O is not supported on distribution %v", b.Distribution)
+	}
+
+	if err := b.installCRIO(c); err != nil {
+		return err
+	}
+
+	if err := b.buildConfigFile(c); err != nil {
+		return err
+	}
+
+	// CRI-O refuses to pull images without a signature policy
+	c.AddTask(&nodetasks.File{
+		Path:     "/etc/containers/policy.json",
+		Contents: fi.NewStringResource(`{"default":[{"type":"insecureAcceptAnything"}]}` + "\n"),
+		Type:     nodetasks.FileType_File,
+	})
+
+	// Resolve short image names (e.g. "nginx") the same way containerd does
+	c.AddTask(&nodetasks.File{
+		Path:     "/etc/containers/registries.conf.d/10-kops.conf",
+		Contents: fi.NewStringResource("unqualified-search-registries = [\"docker.io\"]\nshort-name-mode = \"permissive\"\n"),
+		Type:     nodetasks.FileType_File,
+	})
+
+	c.AddTask(&nodetasks.File{
+		Path:     "/etc/crictl.yaml",
+		Contents: fi.NewStringResource("runtime-endpoint: unix://" + crioSocketPath + "\n"),
+		Type:     nodetasks.FileType_File,
+	})
+
+	c.AddTask(b.buildSystemdService())
+
+	return nil
+}
+
+// installCRIO installs the binaries from the CRI-O static bundle.
+func (b *CRIOBuilder) installCRIO(c *fi.NodeupModelBuilderContext) error {
+	f := b.Assets.FindMatches(regexp.MustCompile(`^(\./)?cri-o/bin/(conmon|conmonrs|crictl|crio|crun|pinns|runc)$`))
+	if len(f) == 0 {
+		return fmt.Errorf("unable to find any crio binaries in assets")
+	}
+	for k, v := range f {
+		c.AddTask(&nodetasks.File{
+			Path:     filepath.Join(crioBinaryPath, path.Base(k)),
+			Contents: v,
+			Type:     nodetasks.FileType_File,
+			Mode:     s("0755"),
+		})
+	}
+	return nil
+}
+
+// buildConfigFile writes the CRI-O drop-in configuration, which overrides the built-in defaults.
+// See https://github.com/cri-o/cri-o/blob/main/docs/crio.conf.5.md
+func (b *CRIOBuilder) buildConfigFile(c *fi.NodeupModelBuilderContext) error {
+	crio := b.NodeupConfig.CRIOConfig
+
+	// toml.Load("") never fails for empty input; we use it to obtain an empty tree.
+	config, _ := toml.Load("")
+	config.SetPath([]string{"crio", "api", "listen"}, crioSocketPath)
+	config.SetPath([]string{"crio", "image", "pause_image"}, fi.ValueOf(crio.SandboxImage))
+	config.SetPath([]string{"crio", "network", "network_dir"}, b.CNIConfDir())
+	config.SetPath([]string{"crio", "network", "plugin_dirs"}, []string{b.CNIBinDir()})
+	config.SetPath([]string{"crio", "runtime", "cgroup_manager"}, "systemd")
+	config.SetPath([]string{"crio", "runtime", "default_runtime"}, "crun")
+	config.SetPath([]string{"crio", "runtime", "log_level"}, fi.ValueOf(crio.LogLevel))
+	config.SetPath([]string{"crio", "runtime", "pinns_path"}, filepath.Join(crioBinaryPath, "pinns"))
+	for _, runtime := range []string{"crun", "runc"} {
+		config.SetPath([]string{"crio", "runtime", "runtimes", runtime, "runtime_path"}, filepath.Join(crioBinaryPath, runtime))
+		config.SetPath([]string{"crio", "runtime", "runtimes", runtime, "runtime_root"}, "/run/"+runtime)
+		config.SetPath([]string{"crio", "runtime", "runtimes", runtime, "monitor_path"}, filepath.Join(crioBinaryPath, "conmon"))
+	}
+
+	c.AddTask(&nodetasks.File{
+		Path:     crioConfigFilePath,
+		Contents: fi.NewStringResource(config.String()),
+		Type:     nodetasks.FileType_File,
+	})
+	return nil
+}
+
+func (b *CRIOBuilder) buildSystemdService() *nodetasks.Service {
+	// Based on https://github.com/cri-o/cri-o/blob/main/contrib/crio.service
+
+	manifest := &systemd.Manifest{}
+	manifest.Set("Unit", "Description", "Container Runtime Interface for OCI (CRI-O)")
+	manifest.Set("Unit", "Documentation", "https://github.com/cri-o/cri-o")
+	manifest.Set("Unit", "Wants", "network-online.target")
+	manifest.Set("Unit", "Before", "kubelet.service")
+	manifest.Set("Unit", "After", "network-online.target")
+
+	manifest.Set("Service", "Type", "notify")
+	manifest.Set("Service", "EnvironmentFile", "/etc/environment")
+	manifest.Set("Service", "Environment", "GOTRACEBACK=crash")
+	manifest.Set("Service", "ExecStartPre", "-/sbin/modprobe overlay")
+	manifest.Set("Service", "ExecStart", filepath.Join(crioBinaryPath, "crio"))
+	manifest.Set("Service", "ExecReload", "/bin/kill -s HUP $MAINPID")
+
+	manifest.Set("Service", "TasksMax", "infinity")
+	manifest.Set("Service", "LimitNOFILE", "1048576")
+	manifest.Set("Service", "LimitNPROC", "1048576")
+	manifest.Set("Service", "LimitCORE", "infinity")
+
+	// make killing of processes of this unit under memory pressure very unlikely
+	manifest.Set("Service", "OOMScoreAdjust", "-999")
+	manifest.Set("Service", "TimeoutStartSec", "0")
+	manifest.Set("Service", "Restart", "on-failure")
+	manifest.Set("Service", "RestartSec", "10")
+
+	manifest.Set("Install", "WantedBy", "multi-user.target")
+
+	cgroup := b.NodeupConfig.KubeletConfig.RuntimeCgroups
+	if cgroup != "" {
+		manifest.Set("Service", "Slice", strings.Trim(cgroup, "/")+".slice")
+	}
+
+	manifestString := manifest.Render()
+	klog.V(8).Infof("Built service manifest %q\n%s", "crio", manifestString)
+
+	service := &nodetasks.Service{
+		Name:       "crio.service",
+		Definition: s(manifestString),
+	}
+
+	service.InitDefaults()
+
+	return service
+}
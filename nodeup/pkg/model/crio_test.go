@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"path"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/util/pkg/distributions"
+)
+
+func TestCRIOBuilder_Simple(t *testing.T) {
+	runCRIOBuilderTest(t, "simple", distributions.DistributionUbuntu2604)
+}
+
+func TestCRIOBuilder_Flatcar(t *testing.T) {
+	h := testutils.NewIntegrationTestHarness(t)
+	defer h.Close()
+
+	model, err := testutils.LoadModel("tests/criobuilder/simple")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nodeUpModelContext, err := BuildNodeupModelContext(model)
+	if err != nil {
+		t.Fatalf("error parsing cluster yaml: %v", err)
+	}
+	nodeUpModelContext.Distribution = distributions.DistributionFlatcar
+
+	builder := CRIOBuilder{NodeupModelContext: nodeUpModelContext}
+	if err := builder.Build(&fi.NodeupModelBuilderContext{Tasks: make(map[string]fi.NodeupTask)}); err == nil {
+		t.Fatalf("expected error building CRI-O on Flatcar")
+	}
+}
+
+func runCRIOBuilderTest(t *testing.T, key string, distro distributions.Distribution) {
+	h := testutils.NewIntegrationTestHarness(t)
+	defer h.Close()
+
+	h.MockKopsVersion("1.18.0")
+	h.SetupMockAWS()
+
+	basedir := path.Join("tests/criobuilder/", key)
+
+	model, err := testutils.LoadModel(basedir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nodeUpModelContext, err := BuildNodeupModelContext(model)
+	if err != nil {
+		t.Fatalf("error parsing cluster yaml %q: %v", basedir, err)
+		return
+	}
+
+	nodeUpModelContext.Distribution = distro
+
+	nodeUpModelContext.Assets = fi.NewAssetStore("")
+	for _, name := range []string{"conmon", "crictl", "crio", "crun", "pinns", "runc"} {
+		nodeUpModelContext.Assets.AddForTest(name, "cri-o/bin/"+name, "testing crio content")
+	}
+
+	if err := nodeUpModelContext.Init(); err != nil {
+		t.Fatalf("error from nodeupModelContext.Init(): %v", err)
+		return
+	}
+	context := &fi.NodeupModelBuilderContext{
+		Tasks: make(map[string]fi.NodeupTask),
+	}
+
+	builder := CRIOBuilder{NodeupModelContext: nodeUpModelContext}
+
+	err = builder.Build(context)
+	if err != nil {
+		t.Fatalf("error from CRIOBuilder Build: %v", err)
+		return
+	}
+
+	testutils.ValidateTasks(t, filepath.Join(basedir, "tasks.yaml"), context)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"slices"
+
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+)
+
+// FIPSBuilder verifies that the kubelet and container runtime binaries installed by kOps can run in FIPS mode.
+// It must run after the builders that install the binaries.
+type FIPSBuilder struct {
+	*NodeupModelContext
+}
+
+var _ fi.NodeupModelBuilder = &FIPSBuilder{}
+
+// Build is responsible for adding the FIPS check of the installed Go binaries
+func (b *FIPSBuilder) Build(c *fi.NodeupModelBuilderContext) error {
+	if !b.NodeupConfig.FIPSMode {
+		return nil
+	}
+
+	kubelet := &KubeletBuilder{NodeupModelContext: b.NodeupModelContext}
+	binaries := []string{
+		kubelet.kubeletPath(),
+		"/usr/bin/containerd",
+		"/usr/bin/containerd-shim-runc-v2",
+		"/usr/sbin/runc",
+	}
+
+	// Binaries provided by the image are outside of our control
+	var paths []string
+	for _, task := range c.Tasks {
+		if file, ok := task.(*nodetasks.File); ok && slices.Contains(binaries, file.Path) {
+			paths = append(paths, file.Path)
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	slices.Sort(paths)
+
+	c.AddTask(&nodetasks.GoFIPSCheck{Paths: paths})
+
+	return nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"slices"
+	"testing"
+
+	"k8s.io/kops/pkg/apis/nodeup"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+	"k8s.io/kops/util/pkg/distributions"
+)
+
+func TestFIPSBuilder(t *testing.T) {
+	nodeupModelContext := &NodeupModelContext{
+		Distribution: distributions.DistributionUbuntu2404,
+		NodeupConfig: &nodeup.Config{FIPSMode: true},
+	}
+
+	c := &fi.NodeupModelBuilderContext{Tasks: make(map[string]fi.NodeupTask)}
+	for _, path := range []string{"/usr/local/bin/kubelet", "/usr/bin/containerd", "/usr/bin/ctr", "/usr/sbin/runc"} {
+		c.AddTask(&nodetasks.File{Path: path, Contents: fi.NewStringResource(""), Type: nodetasks.FileType_File})
+	}
+
+	builder := FIPSBuilder{NodeupModelContext: nodeupModelContext}
+	if err := builder.Build(c); err != nil {
+		t.Fatalf("unexpected error from Build: %v", err)
+	}
+
+	task, found := c.Tasks["GoFIPSCheck/GoFIPSCheck"]
+	if !found {
+		t.Fatalf("expected the Go binaries to be checked")
+	}
+	expected := []string{"/usr/bin/containerd", "/usr/local/bin/kubelet", "/usr/sbin/runc"}
+	if paths := task.(*nodetasks.GoFIPSCheck).Paths; !slices.Equal(paths, expected) {
+		t.Errorf("expected paths %v, got %v", expected, paths)
+	}
+}
+
+func TestFIPSBuilderDisabled(t *testing.T) {
+	nodeupModelContext := &NodeupModelContext{
+		Distribution: distributions.DistributionUbuntu2404,
+		NodeupConfig: &nodeup.Config{},
+	}
+
+	c := &fi.NodeupModelBuilderContext{Tasks: make(map[string]fi.NodeupTask)}
+	c.AddTask(&nodetasks.File{Path: "/usr/local/bin/kubelet", Contents: fi.NewStringResource(""), Type: nodetasks.FileType_File})
+
+	builder := FIPSBuilder{NodeupModelContext: nodeupModelContext}
+	if err := builder.Build(c); err != nil {
+		t.Fatalf("unexpected error from Build: %v", err)
+	}
+	if _, found := c.Tasks["GoFIPSCheck/GoFIPSCheck"]; found {
+		t.Errorf("expected no Go binary check when FIPS mode is disabled")
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/model/components"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+)
+
+// hardeningReportPath is where nodeup records the controls of the hardening profile applied to the node.
+const hardeningReportPath = "/etc/kubernetes/hardening-report.yaml"
+
+const (
+	hardeningControlApplied    = "Applied"
+	hardeningControlOverridden = "Overridden"
+)
+
+// hardeningSysctls are the kernel parameters set by the cis hardening profile.
+// The first block matches the values the kubelet expects when protecting kernel defaults.
+var hardeningSysctls = []string{
+	"# CIS hardening profile settings",
+	"vm.panic_on_oom = 0",
+	"kernel.keys.root_maxkeys = 1000000",
+	"kernel.keys.root_maxbytes = 25000000",
+	"",
+	"kernel.randomize_va_space = 2",
+	"fs.suid_dumpable = 0",
+	"",
+	"net.ipv4.conf.all.send_redirects = 0",
+	"net.ipv4.conf.default.send_redirects = 0",
+	"net.ipv4.conf.all.accept_redirects = 0",
+	"net.ipv4.conf.default.accept_redirects = 0",
+	"net.ipv4.conf.all.secure_redirects = 0",
+	"net.ipv4.conf.default.secure_redirects = 0",
+	"net.ipv4.conf.all.accept_source_route = 0",
+	"net.ipv4.conf.default.accept_source_route = 0",
+	"net.ipv4.conf.all.log_martians = 1",
+	"net.ipv4.conf.default.log_martians = 1",
+	"net.ipv4.icmp_echo_ignore_broadcasts = 1",
+	"net.ipv4.icmp_ignore_bogus_error_responses = 1",
+	"net.ipv4.tcp_syncookies = 1",
+	"",
+}
+
+// HardeningBuilder applies the node side of the cluster's hardening profile and reports the controls applied.
+// It must run after the builders that create the files whose permissions it restricts.
+type HardeningBuilder struct {
+	*NodeupModelContext
+}
+
+var _ fi.NodeupModelBuilder = &HardeningBuilder{}
+
+type hardeningControl struct {
+	// Name identifies the control.
+	Name string `json:"name"`
+	// Description explains what the control enforces.
+	Description string `json:"description"`
+	// Status is Applied, or Overridden when the cluster spec configures a conflicting setting.
+	Status string `json:"status"`
+}
+
+type hardeningReport struct {
+	Profile  string             `json:"profile"`
+	Controls []hardeningControl `json:"controls"`
+}
+
+// Build is responsible for restricting file permissions and writing the hardening report.
+func (b *HardeningBuilder) Build(c *fi.NodeupModelBuilderContext) error {
+	if b.NodeupConfig.HardeningProfile != kops.HardeningProfileCIS {
+		return nil
+	}
+
+	report := &hardeningReport{Profile: b.NodeupConfig.HardeningProfile}
+	add := func(name, description string, applied bool) {
+		status := hardeningControlApplied
+		if !applied {
+			status = hardeningControlOverridden
+		}
+		report.Controls = append(report.Controls, hardeningControl{Name: name, Description: description, Status: status})
+	}
+
+	for _, task := range c.Tasks {
+		file, ok := task.(*nodetasks.File)
+		if !ok || file.Type != nodetasks.FileType_File {
+			continue
+		}
+		maxMode, found := b.hardeningMaxFileMode(file.Path)
+		if !found {
+			continue
+		}
+		mode, err := fi.ParseFileMode(fi.ValueOf(file.Mode), 0o644)
+		if err != nil {
+			return err
+		}
+		if mode&^maxMode != 0 {
+			file.Mode = s(fi.FileModeToString(mode & maxMode))
+		}
+	}
+	add("file-permissions", "Static pod manifests, kubeconfigs, the kubelet configuration and private keys are only accessible by their owner", true)
+
+	kubelet := &b.NodeupConfig.KubeletConfig
+	add("kernel-parameters", "Kernel parameters are hardened and the kubelet protects kernel defaults", fi.ValueOf(kubelet.ProtectKernelDefaults))
+	add("kubelet-anonymous-auth", "The kubelet rejects anonymous requests", !fi.ValueOf(kubelet.AnonymousAuth))
+	add("kubelet-authorization-mode", "The kubelet authorizes requests against the API server", kubelet.AuthorizationMode != "AlwaysAllow")
+	add("kubelet-read-only-port", "The kubelet read-only port is disabled", fi.ValueOf(kubelet.ReadOnlyPort) == 0)
+	add("kubelet-streaming-connection-idle-timeout", "Idle streaming connections to the kubelet time out",
+		kubelet.StreamingConnectionIdleTimeout == nil || kubelet.StreamingConnectionIdleTimeout.Duration != 0)
+	add("kubelet-tls-cipher-suites", "The kubelet only uses strong TLS cipher suites", strongTLSCipherSuites(kubelet.TLSCipherSuites))
+
+	if b.NodeupConfig.APIServerConfig != nil {
+		apiServer := b.NodeupConfig.APIServerConfig.KubeAPIServer
+		add("kube-apiserver-anonymous-auth", "kube-apiserver rejects anonymous requests",
+			apiServer.AnonymousAuth != nil && !*apiServer.AnonymousAuth)
+		add("kube-apiserver-profiling", "kube-apiserver profiling is disabled",
+			apiServer.EnableProfiling != nil && !*apiServer.EnableProfiling)
+		add("kube-apiserver-audit-log", "kube-apiserver writes an audit log following an audit policy",
+			fi.ValueOf(apiServer.AuditLogPath) != "" && (apiServer.AuditPolicy != nil || apiServer.AuditPolicyFile != ""))
+		add("kube-apiserver-audit-log-retention", "kube-apiserver retains audit logs for 30 days, in 10 files of 100MB",
+			fi.ValueOf(apiServer.AuditLogMaxAge) >= components.HardeningAuditLogMaxAge &&
+				fi.ValueOf(apiServer.AuditLogMaxBackups) >= components.HardeningAuditLogMaxBackups &&
+				fi.ValueOf(apiServer.AuditLogMaxSize) >= components.HardeningAuditLogMaxSize)
+		add("kube-apiserver-tls-cipher-suites", "kube-apiserver only uses strong TLS cipher suites", strongTLSCipherSuites(apiServer.TLSCipherSuites))
+	}
+
+	if b.NodeupConfig.ControlPlaneConfig != nil {
+		kcm := &b.NodeupConfig.ControlPlaneConfig.KubeControllerManager
+		add("kube-controller-manager-profiling", "kube-controller-manager profiling is disabled",
+			kcm.EnableProfiling != nil && !*kcm.EnableProfiling)
+		add("kube-controller-manager-terminated-pod-gc", "kube-controller-manager garbage collects terminated pods",
+			fi.ValueOf(kcm.TerminatedPodGCThreshold) > 0)
+		add("kube-controller-manager-service-account-credentials", "kube-controller-manager uses individual service account credentials",
+			fi.ValueOf(kcm.UseServiceAccountCredentials))
+
+		scheduler := &b.NodeupConfig.ControlPlaneConfig.KubeScheduler
+		add("kube-scheduler-profiling", "kube-scheduler profiling is disabled",
+			scheduler.EnableProfiling != nil && !*scheduler.EnableProfiling)
+	}
+
+	data, err := kops.ToRawYaml(report)
+	if err != nil {
+		return err
+	}
+	c.AddTask(&nodetasks.File{
+		Path:     hardeningReportPath,
+		Contents: fi.NewBytesResource(data),
+		Type:     nodetasks.FileType_File,
+		Mode:     s("0644"),
+	})
+
+	return nil
+}
+
+// hardeningMaxFileMode returns the most permissive mode the hardening profile allows for a file.
+func (b *HardeningBuilder) hardeningMaxFileMode(path string) (os.FileMode, bool) {
+	switch {
+	case strings.HasPrefix(path, "/etc/kubernetes/manifests/"):
+		return 0o600, true
+	case path == kubeletConfigFilePath:
+		return 0o600, true
+	case filepath.Base(path) == "kubeconfig":
+		return 0o600, true
+	case strings.HasPrefix(path, b.PathSrvKubernetes()+"/") && strings.HasSuffix(path, ".key"):
+		return 0o600, true
+	}
+	return 0, false
+}
+
+// strongTLSCipherSuites returns true if cipher suites are configured and all of them are strong.
+func strongTLSCipherSuites(cipherSuites []string) bool {
+	if len(cipherSuites) == 0 {
+		return false
+	}
+	for _, cipherSuite := range cipherSuites {
+		if !slices.Contains(components.HardeningTLSCipherSuites, cipherSuite) {
+			return false
+		}
+	}
+	return true
+}
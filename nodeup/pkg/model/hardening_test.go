@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/nodeup"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+)
+
+func TestHardeningBuilder(t *testing.T) {
+	nodeupModelContext := &NodeupModelContext{
+		NodeupConfig: &nodeup.Config{
+			HardeningProfile: kops.HardeningProfileCIS,
+			KubeletConfig: kops.KubeletConfigSpec{
+				AnonymousAuth:         new(true),
+				ProtectKernelDefaults: new(true),
+			},
+		},
+	}
+
+	c := &fi.NodeupModelBuilderContext{Tasks: make(map[string]fi.NodeupTask)}
+	files := map[string]*nodetasks.File{
+		"/etc/kubernetes/manifests/kube-proxy.manifest": {Type: nodetasks.FileType_File},
+		kubeletConfigFilePath:                           {Type: nodetasks.FileType_File, Mode: s("0644")},
+		"/var/lib/kube-proxy/kubeconfig":                {Type: nodetasks.FileType_File, Mode: s("0400")},
+		"/srv/kubernetes/kube-proxy.key":                {Type: nodetasks.FileType_File, Mode: s("0640")},
+		"/srv/kubernetes/ca.crt":                        {Type: nodetasks.FileType_File, Mode: s("0644")},
+	}
+	for path, file := range files {
+		file.Path = path
+		file.Contents = fi.NewStringResource("")
+		c.AddTask(file)
+	}
+
+	builder := HardeningBuilder{NodeupModelContext: nodeupModelContext}
+	if err := builder.Build(c); err != nil {
+		t.Fatalf("unexpected error from Build: %v", err)
+	}
+
+	expectedModes := map[string]string{
+		"/etc/kubernetes/manifests/kube-proxy.manifest": "0600",
+		kubeletConfigFilePath:                           "0600",
+		"/var/lib/kube-proxy/kubeconfig":                "0400",
+		"/srv/kubernetes/kube-proxy.key":                "0600",
+		"/srv/kubernetes/ca.crt":                        "0644",
+	}
+	for path, expected := range expectedModes {
+		if actual := fi.ValueOf(files[path].Mode); actual != expected {
+			t.Errorf("expected mode %s for %s, got %q", expected, path, actual)
+		}
+	}
+
+	reportTask, found := c.Tasks["File/"+hardeningReportPath]
+	if !found {
+		t.Fatalf("hardening report was not written")
+	}
+	report, err := fi.ResourceAsString(reportTask.(*nodetasks.File).Contents)
+	if err != nil {
+		t.Fatalf("error reading hardening report: %v", err)
+	}
+	for _, expected := range []string{
+		"profile: cis",
+		"name: kernel-parameters\n  status: Applied",
+		"name: kubelet-anonymous-auth\n  status: Overridden",
+		"name: kubelet-tls-cipher-suites\n  status: Overridden",
+	} {
+		if !strings.Contains(report, expected) {
+			t.Errorf("expected hardening report to contain %q, got:\n%s", expected, report)
+		}
+	}
+	if strings.Contains(report, "kube-apiserver") {
+		t.Errorf("hardening report of a node contains control plane controls:\n%s", report)
+	}
+}
+
+func TestHardeningBuilderDisabled(t *testing.T) {
+	nodeupModelContext := &NodeupModelContext{NodeupConfig: &nodeup.Config{}}
+	c := &fi.NodeupModelBuilderContext{Tasks: make(map[string]fi.NodeupTask)}
+	manifest := &nodetasks.File{Path: "/etc/kubernetes/manifests/kube-proxy.manifest", Contents: fi.NewStringResource(""), Type: nodetasks.FileType_File}
+	c.AddTask(manifest)
+
+	builder := HardeningBuilder{NodeupModelContext: nodeupModelContext}
+	if err := builder.Build(c); err != nil {
+		t.Fatalf("unexpected error from Build: %v", err)
+	}
+	if manifest.Mode != nil {
+		t.Errorf("file mode was changed without a hardening profile: %s", *manifest.Mode)
+	}
+	if len(c.Tasks) != 1 {
+		t.Errorf("expected no tasks to be added without a hardening profile, got %d", len(c.Tasks))
+	}
+}
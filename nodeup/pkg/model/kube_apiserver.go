@@ -21,8 +21,10 @@ import (
 	"fmt"
 	"net"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/apis/kops"
@@ -154,6 +156,22 @@ func (b *KubeAPIServerBuilder) Build(c *fi.NodeupModelBuilderContext) error {
 		}
 	}
 
+	if err := b.writeKMSEncryption(c, &kubeAPIServer); err != nil {
+		return err
+	}
+
+	if err := b.writeAuthorizationConfig(c, &kubeAPIServer); err != nil {
+		return err
+	}
+
+	if err := b.writeAdmissionConfig(c, &kubeAPIServer); err != nil {
+		return err
+	}
+
+	if err := b.writeAuditConfig(c, &kubeAPIServer); err != nil {
+		return err
+	}
+
 	kubeAPIServer.ServiceAccountKeyFile = append(kubeAPIServer.ServiceAccountKeyFile, filepath.Join(pathSrvKAPI, "service-account.pub"))
 	c.AddTask(&nodetasks.File{
 		Path:     filepath.Join(pathSrvKAPI, "service-account.pub"),
@@ -172,9 +190,14 @@ func (b *KubeAPIServerBuilder) Build(c *fi.NodeupModelBuilderContext) error {
 	}
 
 	{
+		// External etcd clusters can have serving certificates issued by another CA
+		etcdCAs := b.NodeupConfig.CAs["etcd-clients-ca"]
+		if b.NodeupConfig.APIServerConfig.EtcdCACertificates != "" {
+			etcdCAs = strings.TrimSpace(etcdCAs) + "\n" + b.NodeupConfig.APIServerConfig.EtcdCACertificates
+		}
 		c.AddTask(&nodetasks.File{
 			Path:     filepath.Join(pathSrvKAPI, "etcd-ca.crt"),
-			Contents: fi.NewStringResource(b.NodeupConfig.CAs["etcd-clients-ca"]),
+			Contents: fi.NewStringResource(etcdCAs),
 			Type:     nodetasks.FileType_File,
 			Mode:     new("0644"),
 		})
@@ -436,6 +459,389 @@ func (b *KubeAPIServerBuilder) writeAuthenticationConfig(c *fi.NodeupModelBuilde
 	return fmt.Errorf("unrecognized authentication config %v", b.NodeupConfig.APIServerConfig.Authentication)
 }
 
+// writeAuditConfig writes the audit policy and audit webhook configuration managed by kOps.
+func (b *KubeAPIServerBuilder) writeAuditConfig(c *fi.NodeupModelBuilderContext, kubeAPIServer *kops.KubeAPIServerConfig) error {
+	pathSrvKAPI := filepath.Join(b.PathSrvKubernetes(), "kube-apiserver")
+
+	if kubeAPIServer.AuditPolicy != nil {
+		policy, err := buildAuditPolicy(kubeAPIServer.AuditPolicy)
+		if err != nil {
+			return err
+		}
+
+		kubeAPIServer.AuditPolicyFile = filepath.Join(pathSrvKAPI, "audit-policy.yaml")
+		c.AddTask(&nodetasks.File{
+			Path:     kubeAPIServer.AuditPolicyFile,
+			Contents: fi.NewBytesResource(policy),
+			Type:     nodetasks.FileType_File,
+			Mode:     new("0600"),
+		})
+	}
+
+	if kubeAPIServer.AuditWebhook != nil {
+		token := ""
+		if kubeAPIServer.AuditWebhook.UseBearerToken {
+			secret, err := b.SecretStore.Secret(components.AuditWebhookTokenSecretName)
+			if err != nil {
+				return fmt.Errorf("auditWebhook uses a bearer token, but could not load the %s secret: %w", components.AuditWebhookTokenSecretName, err)
+			}
+			token, err = components.ParseAuditWebhookToken(secret.Data)
+			if err != nil {
+				return err
+			}
+		}
+
+		config, err := buildAuditWebhookConfig(kubeAPIServer.AuditWebhook, token)
+		if err != nil {
+			return err
+		}
+
+		kubeAPIServer.AuditWebhookConfigFile = filepath.Join(pathSrvKAPI, "audit-webhook-config.yaml")
+		c.AddTask(&nodetasks.File{
+			Path:     kubeAPIServer.AuditWebhookConfigFile,
+			Contents: fi.NewBytesResource(config),
+			Type:     nodetasks.FileType_File,
+			Mode:     new("0600"),
+		})
+	}
+
+	return nil
+}
+
+// buildAuditPolicy renders the audit policy as an audit.k8s.io/v1 Policy.
+func buildAuditPolicy(spec *kops.AuditPolicySpec) ([]byte, error) {
+	policy := struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+		kops.AuditPolicySpec
+	}{
+		APIVersion:      "audit.k8s.io/v1",
+		Kind:            "Policy",
+		AuditPolicySpec: *spec,
+	}
+
+	data, err := kops.ToRawYaml(policy)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling audit policy to yaml: %w", err)
+	}
+	return data, nil
+}
+
+// buildAuditWebhookConfig renders the kubeconfig kube-apiserver uses to send audit events to the webhook.
+func buildAuditWebhookConfig(webhook *kops.AuditWebhookSpec, token string) ([]byte, error) {
+	return buildWebhookKubeconfig("audit-webhook", webhook.Server, webhook.CACertificate, token)
+}
+
+// buildWebhookKubeconfig renders a kubeconfig for kube-apiserver to call a webhook.
+func buildWebhookKubeconfig(name string, server string, caCertificate string, token string) ([]byte, error) {
+	config := kubeconfig.KubectlConfig{
+		Kind:       "Config",
+		ApiVersion: "v1",
+	}
+	config.Clusters = append(config.Clusters, &kubeconfig.KubectlClusterWithName{
+		Name: name,
+		Cluster: kubeconfig.KubectlCluster{
+			Server:                   server,
+			CertificateAuthorityData: []byte(caCertificate),
+		},
+	})
+	config.Users = append(config.Users, &kubeconfig.KubectlUserWithName{
+		Name: "kube-apiserver",
+		User: kubeconfig.KubectlUser{
+			Token: token,
+		},
+	})
+	config.CurrentContext = name
+	config.Contexts = append(config.Contexts, &kubeconfig.KubectlContextWithName{
+		Name: name,
+		Context: kubeconfig.KubectlContext{
+			Cluster: name,
+			User:    "kube-apiserver",
+		},
+	})
+
+	data, err := kops.ToRawYaml(config)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling %s config to yaml: %w", name, err)
+	}
+	return data, nil
+}
+
+// writeAuthorizationConfig writes the authorization config, and a kubeconfig for each webhook authorizer that sets a server.
+func (b *KubeAPIServerBuilder) writeAuthorizationConfig(c *fi.NodeupModelBuilderContext, kubeAPIServer *kops.KubeAPIServerConfig) error {
+	if kubeAPIServer.AuthorizationConfig == nil {
+		return nil
+	}
+
+	pathSrvKAPI := filepath.Join(b.PathSrvKubernetes(), "kube-apiserver")
+
+	for _, authorizer := range kubeAPIServer.AuthorizationConfig.Authorizers {
+		if authorizer.Webhook == nil || authorizer.Webhook.Server == "" {
+			continue
+		}
+		config, err := buildWebhookKubeconfig("authz-webhook", authorizer.Webhook.Server, authorizer.Webhook.CACertificate, "")
+		if err != nil {
+			return err
+		}
+		c.AddTask(&nodetasks.File{
+			Path:     authorizationWebhookConfigPath(pathSrvKAPI, authorizer.Name),
+			Contents: fi.NewBytesResource(config),
+			Type:     nodetasks.FileType_File,
+			Mode:     new("0600"),
+		})
+	}
+
+	config, err := buildAuthorizationConfig(kubeAPIServer.AuthorizationConfig, pathSrvKAPI)
+	if err != nil {
+		return err
+	}
+
+	kubeAPIServer.AuthorizationConfigFile = filepath.Join(pathSrvKAPI, "authorization-config.yaml")
+	c.AddTask(&nodetasks.File{
+		Path:     kubeAPIServer.AuthorizationConfigFile,
+		Contents: fi.NewBytesResource(config),
+		Type:     nodetasks.FileType_File,
+		Mode:     new("0600"),
+	})
+
+	return nil
+}
+
+func authorizationWebhookConfigPath(pathSrvKAPI string, name string) string {
+	return filepath.Join(pathSrvKAPI, "authz-webhook-"+name+".yaml")
+}
+
+type authorizationConfiguration struct {
+	APIVersion  string                    `json:"apiVersion"`
+	Kind        string                    `json:"kind"`
+	Authorizers []authorizerConfiguration `json:"authorizers"`
+}
+
+type authorizerConfiguration struct {
+	Type    string                          `json:"type"`
+	Name    string                          `json:"name"`
+	Webhook *webhookAuthorizerConfiguration `json:"webhook,omitempty"`
+}
+
+type webhookAuthorizerConfiguration struct {
+	Timeout                                  *metav1.Duration                `json:"timeout,omitempty"`
+	AuthorizedTTL                            *metav1.Duration                `json:"authorizedTTL,omitempty"`
+	UnauthorizedTTL                          *metav1.Duration                `json:"unauthorizedTTL,omitempty"`
+	SubjectAccessReviewVersion               string                          `json:"subjectAccessReviewVersion"`
+	MatchConditionSubjectAccessReviewVersion string                          `json:"matchConditionSubjectAccessReviewVersion"`
+	FailurePolicy                            string                          `json:"failurePolicy"`
+	ConnectionInfo                           webhookConnectionInfo           `json:"connectionInfo"`
+	MatchConditions                          []kops.AuthorizerMatchCondition `json:"matchConditions,omitempty"`
+}
+
+type webhookConnectionInfo struct {
+	Type           string `json:"type"`
+	KubeConfigFile string `json:"kubeConfigFile,omitempty"`
+}
+
+// buildAuthorizationConfig renders the authorization config as an apiserver.config.k8s.io/v1 AuthorizationConfiguration.
+func buildAuthorizationConfig(spec *kops.AuthorizationConfigSpec, pathSrvKAPI string) ([]byte, error) {
+	config := authorizationConfiguration{
+		APIVersion: "apiserver.config.k8s.io/v1",
+		Kind:       "AuthorizationConfiguration",
+	}
+
+	for _, authorizer := range spec.Authorizers {
+		a := authorizerConfiguration{
+			Type: authorizer.Type,
+			Name: authorizer.Name,
+		}
+		if webhook := authorizer.Webhook; webhook != nil {
+			kubeConfigFile := webhook.KubeConfigFile
+			if webhook.Server != "" {
+				kubeConfigFile = authorizationWebhookConfigPath(pathSrvKAPI, authorizer.Name)
+			}
+			a.Webhook = &webhookAuthorizerConfiguration{
+				Timeout:                                  webhook.Timeout,
+				AuthorizedTTL:                            webhook.AuthorizedTTL,
+				UnauthorizedTTL:                          webhook.UnauthorizedTTL,
+				SubjectAccessReviewVersion:               "v1",
+				MatchConditionSubjectAccessReviewVersion: "v1",
+				FailurePolicy:                            webhook.FailurePolicy,
+				ConnectionInfo: webhookConnectionInfo{
+					Type:           "KubeConfigFile",
+					KubeConfigFile: kubeConfigFile,
+				},
+				MatchConditions: webhook.MatchConditions,
+			}
+		}
+		config.Authorizers = append(config.Authorizers, a)
+	}
+
+	data, err := kops.ToRawYaml(config)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling authorization config to yaml: %w", err)
+	}
+	return data, nil
+}
+
+// writeAdmissionConfig writes the admission configuration of the configured admission plugins.
+func (b *KubeAPIServerBuilder) writeAdmissionConfig(c *fi.NodeupModelBuilderContext, kubeAPIServer *kops.KubeAPIServerConfig) error {
+	admissionConfig := kubeAPIServer.AdmissionConfig
+	if podSecurity := b.NodeupConfig.APIServerConfig.PodSecurityAdmission; podSecurity != nil {
+		if admissionConfig == nil {
+			admissionConfig = &kops.AdmissionConfigSpec{}
+		} else {
+			admissionConfig = admissionConfig.DeepCopy()
+		}
+		admissionConfig.PodSecurity = podSecurity.DeepCopy()
+		// The system components in kube-system need privileges that the baseline and restricted levels forbid
+		if !slices.Contains(admissionConfig.PodSecurity.Exemptions.Namespaces, metav1.NamespaceSystem) {
+			admissionConfig.PodSecurity.Exemptions.Namespaces = append(admissionConfig.PodSecurity.Exemptions.Namespaces, metav1.NamespaceSystem)
+		}
+	}
+	if admissionConfig == nil {
+		return nil
+	}
+
+	pathSrvKAPI := filepath.Join(b.PathSrvKubernetes(), "kube-apiserver")
+
+	if webhook := admissionConfig.ImagePolicyWebhook; webhook != nil {
+		config, err := buildWebhookKubeconfig("image-policy-webhook", webhook.Server, webhook.CACertificate, "")
+		if err != nil {
+			return err
+		}
+		c.AddTask(&nodetasks.File{
+			Path:     filepath.Join(pathSrvKAPI, "image-policy-webhook.yaml"),
+			Contents: fi.NewBytesResource(config),
+			Type:     nodetasks.FileType_File,
+			Mode:     new("0600"),
+		})
+	}
+
+	config, err := buildAdmissionConfig(admissionConfig, pathSrvKAPI)
+	if err != nil {
+		return err
+	}
+
+	kubeAPIServer.AdmissionControlConfigFile = filepath.Join(pathSrvKAPI, "admission-config.yaml")
+	c.AddTask(&nodetasks.File{
+		Path:     kubeAPIServer.AdmissionControlConfigFile,
+		Contents: fi.NewBytesResource(config),
+		Type:     nodetasks.FileType_File,
+		Mode:     new("0600"),
+	})
+
+	return nil
+}
+
+type admissionConfiguration struct {
+	APIVersion string                         `json:"apiVersion"`
+	Kind       string                         `json:"kind"`
+	Plugins    []admissionPluginConfiguration `json:"plugins"`
+}
+
+type admissionPluginConfiguration struct {
+	Name          string `json:"name"`
+	Configuration any    `json:"configuration"`
+}
+
+type eventRateLimitConfiguration struct {
+	APIVersion string                `json:"apiVersion"`
+	Kind       string                `json:"kind"`
+	Limits     []kops.EventRateLimit `json:"limits"`
+}
+
+type podSecurityConfiguration struct {
+	APIVersion string                     `json:"apiVersion"`
+	Kind       string                     `json:"kind"`
+	Defaults   podSecurityDefaults        `json:"defaults"`
+	Exemptions kops.PodSecurityExemptions `json:"exemptions"`
+}
+
+type podSecurityDefaults struct {
+	Enforce        string `json:"enforce,omitempty"`
+	EnforceVersion string `json:"enforce-version,omitempty"`
+	Audit          string `json:"audit,omitempty"`
+	AuditVersion   string `json:"audit-version,omitempty"`
+	Warn           string `json:"warn,omitempty"`
+	WarnVersion    string `json:"warn-version,omitempty"`
+}
+
+type imagePolicyWebhookConfiguration struct {
+	ImagePolicy imagePolicyWebhookSettings `json:"imagePolicy"`
+}
+
+type imagePolicyWebhookSettings struct {
+	KubeConfigFile string `json:"kubeConfigFile"`
+	// AllowTTL and DenyTTL are in seconds, and RetryBackoff in milliseconds. Zero selects the default.
+	AllowTTL     int64 `json:"allowTTL,omitempty"`
+	DenyTTL      int64 `json:"denyTTL,omitempty"`
+	RetryBackoff int64 `json:"retryBackoff,omitempty"`
+	DefaultAllow bool  `json:"defaultAllow"`
+}
+
+// buildAdmissionConfig renders the admission plugin configuration as an apiserver.config.k8s.io/v1 AdmissionConfiguration.
+func buildAdmissionConfig(spec *kops.AdmissionConfigSpec, pathSrvKAPI string) ([]byte, error) {
+	config := admissionConfiguration{
+		APIVersion: "apiserver.config.k8s.io/v1",
+		Kind:       "AdmissionConfiguration",
+		Plugins:    []admissionPluginConfiguration{},
+	}
+
+	if spec.EventRateLimit != nil {
+		config.Plugins = append(config.Plugins, admissionPluginConfiguration{
+			Name: "EventRateLimit",
+			Configuration: eventRateLimitConfiguration{
+				APIVersion: "eventratelimit.admission.k8s.io/v1alpha1",
+				Kind:       "Configuration",
+				Limits:     spec.EventRateLimit.Limits,
+			},
+		})
+	}
+
+	if spec.PodSecurity != nil {
+		defaults := spec.PodSecurity.Defaults
+		config.Plugins = append(config.Plugins, admissionPluginConfiguration{
+			Name: "PodSecurity",
+			Configuration: podSecurityConfiguration{
+				APIVersion: "pod-security.admission.config.k8s.io/v1",
+				Kind:       "PodSecurityConfiguration",
+				Defaults: podSecurityDefaults{
+					Enforce:        defaults.Enforce,
+					EnforceVersion: defaults.EnforceVersion,
+					Audit:          defaults.Audit,
+					AuditVersion:   defaults.AuditVersion,
+					Warn:           defaults.Warn,
+					WarnVersion:    defaults.WarnVersion,
+				},
+				Exemptions: spec.PodSecurity.Exemptions,
+			},
+		})
+	}
+
+	if webhook := spec.ImagePolicyWebhook; webhook != nil {
+		settings := imagePolicyWebhookSettings{
+			KubeConfigFile: filepath.Join(pathSrvKAPI, "image-policy-webhook.yaml"),
+			DefaultAllow:   webhook.DefaultAllow,
+		}
+		if webhook.AllowTTL != nil {
+			settings.AllowTTL = int64(webhook.AllowTTL.Duration / time.Second)
+		}
+		if webhook.DenyTTL != nil {
+			settings.DenyTTL = int64(webhook.DenyTTL.Duration / time.Second)
+		}
+		if webhook.RetryBackoff != nil {
+			settings.RetryBackoff = int64(webhook.RetryBackoff.Duration / time.Millisecond)
+		}
+		config.Plugins = append(config.Plugins, admissionPluginConfiguration{
+			Name:          "ImagePolicyWebhook",
+			Configuration: imagePolicyWebhookConfiguration{ImagePolicy: settings},
+		})
+	}
+
+	data, err := kops.ToRawYaml(config)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling admission config to yaml: %w", err)
+	}
+	return data, nil
+}
+
 func (b *KubeAPIServerBuilder) writeServerCertificate(c *fi.NodeupModelBuilderContext, kubeAPIServer *kops.KubeAPIServerConfig) error {
 	pathSrvKAPI := filepath.Join(b.PathSrvKubernetes(), "kube-apiserver")
 
@@ -793,6 +1199,10 @@ func (b *KubeAPIServerBuilder) buildPod(ctx context.Context, kubeAPIServer *kops
 		kubemanifest.AddHostPathMapping(pod, container, "srvsshproxy", pathSrvSshproxy)
 	}
 
+	if b.NodeupConfig.APIServerConfig.KMSEncryption != nil {
+		kubemanifest.AddHostPathMapping(pod, container, "kmsplugin", kmsPluginSocketDir, kubemanifest.WithType(v1.HostPathDirectoryOrCreate))
+	}
+
 	auditLogPath := fi.ValueOf(kubeAPIServer.AuditLogPath)
 	// Don't mount a volume if the mount path is set to '-' for stdout logging
 	// See https://kubernetes.io/docs/tasks/debug-application-cluster/audit/#audit-backends
@@ -817,12 +1227,24 @@ func (b *KubeAPIServerBuilder) buildPod(ctx context.Context, kubeAPIServer *kops
 		}
 	}
 
+	if kubeAPIServer.AuthorizationConfigFile != "" && filepath.Dir(kubeAPIServer.AuthorizationConfigFile) != pathSrvKAPI {
+		kubemanifest.AddHostPathMapping(pod, container, "authz-config", kubeAPIServer.AuthorizationConfigFile)
+	}
+	if kubeAPIServer.AuthorizationConfig != nil {
+		for _, authorizer := range kubeAPIServer.AuthorizationConfig.Authorizers {
+			if authorizer.Webhook != nil && authorizer.Webhook.KubeConfigFile != "" && filepath.Dir(authorizer.Webhook.KubeConfigFile) != pathSrvKAPI {
+				kubemanifest.AddHostPathMapping(pod, container, "authz-webhook-"+authorizer.Name, authorizer.Webhook.KubeConfigFile)
+			}
+		}
+	}
+
 	pod.Spec.Containers = append(pod.Spec.Containers, *container)
 
 	kubemanifest.MarkPodAsCritical(pod)
 	kubemanifest.MarkPodAsClusterCritical(pod)
 
 	kubemanifest.AddHostPathSELinuxContext(pod, b.NodeupConfig)
+	kubemanifest.AddGoFIPSMode(pod, b.NodeupConfig)
 
 	if useHealthcheckProxy {
 		if err := b.addHealthcheckSidecar(ctx, pod); err != nil {
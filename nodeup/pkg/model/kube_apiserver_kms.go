@@ -0,0 +1,259 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/k8scodecs"
+	"k8s.io/kops/pkg/kubemanifest"
+	"k8s.io/kops/pkg/wellknownports"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+	"k8s.io/kops/util/pkg/env"
+)
+
+// kmsPluginSocketDir is the directory holding the sockets of the KMS plugins
+const kmsPluginSocketDir = "/var/run/kmsplugin"
+
+// kmsPluginKey is a KMS key with the plugin instance serving it
+type kmsPluginKey struct {
+	// KeyID is the KMS key
+	KeyID string
+	// Name is the name of the KMS provider in the encryption configuration.
+	// It is stored with the encrypted data, so it must not change for a key.
+	Name string
+	// HealthPort is the port the plugin serves health checks on
+	HealthPort int
+}
+
+// Endpoint is the socket the plugin listens on
+func (k *kmsPluginKey) Endpoint() string {
+	return filepath.Join(kmsPluginSocketDir, k.Name+".sock")
+}
+
+// kmsPluginKeys returns the current key followed by the previous keys
+func kmsPluginKeys(spec *kops.KMSEncryptionSpec) []kmsPluginKey {
+	var keys []kmsPluginKey
+	for i, keyID := range append([]string{spec.KeyID}, spec.PreviousKeyIDs...) {
+		hash := sha256.Sum256([]byte(keyID))
+		keys = append(keys, kmsPluginKey{
+			KeyID:      keyID,
+			Name:       "kops-" + hex.EncodeToString(hash[:])[:10],
+			HealthPort: wellknownports.KMSPluginHealthCheck - i,
+		})
+	}
+	return keys
+}
+
+// writeKMSEncryption configures kube-apiserver to encrypt data at rest with the KMS plugin,
+// and runs the KMS plugin as a static pod.
+func (b *KubeAPIServerBuilder) writeKMSEncryption(c *fi.NodeupModelBuilderContext, kubeAPIServer *kops.KubeAPIServerConfig) error {
+	spec := b.NodeupConfig.APIServerConfig.KMSEncryption
+	if spec == nil {
+		return nil
+	}
+
+	config, err := buildKMSEncryptionConfig(spec)
+	if err != nil {
+		return err
+	}
+
+	encryptionConfigPath := filepath.Join(b.PathSrvKubernetes(), "kube-apiserver", "encryptionconfig.yaml")
+	kubeAPIServer.EncryptionProviderConfig = new(encryptionConfigPath)
+	c.AddTask(&nodetasks.File{
+		Path:     encryptionConfigPath,
+		Contents: fi.NewBytesResource(config),
+		Mode:     new("600"),
+		Type:     nodetasks.FileType_File,
+	})
+
+	pod, err := b.buildKMSPluginPod(spec)
+	if err != nil {
+		return err
+	}
+	manifest, err := k8scodecs.ToVersionedYaml(pod)
+	if err != nil {
+		return fmt.Errorf("error marshaling kms-plugin manifest to yaml: %w", err)
+	}
+	c.AddTask(&nodetasks.File{
+		Path:     "/etc/kubernetes/manifests/kms-plugin.manifest",
+		Contents: fi.NewBytesResource(manifest),
+		Type:     nodetasks.FileType_File,
+	})
+
+	return nil
+}
+
+// buildKMSEncryptionConfig builds the EncryptionConfiguration for kube-apiserver.
+// New data is encrypted with the current key; the previous keys and the identity provider
+// allow reading data written before the key changed or before encryption was enabled.
+func buildKMSEncryptionConfig(spec *kops.KMSEncryptionSpec) ([]byte, error) {
+	type kmsProvider struct {
+		APIVersion string `json:"apiVersion"`
+		Name       string `json:"name"`
+		Endpoint   string `json:"endpoint"`
+		Timeout    string `json:"timeout"`
+	}
+	type provider struct {
+		KMS      *kmsProvider `json:"kms,omitempty"`
+		Identity *struct{}    `json:"identity,omitempty"`
+	}
+	type resourceConfig struct {
+		Resources []string   `json:"resources"`
+		Providers []provider `json:"providers"`
+	}
+	type encryptionConfiguration struct {
+		APIVersion string           `json:"apiVersion"`
+		Kind       string           `json:"kind"`
+		Resources  []resourceConfig `json:"resources"`
+	}
+
+	var providers []provider
+	for _, key := range kmsPluginKeys(spec) {
+		providers = append(providers, provider{
+			KMS: &kmsProvider{
+				APIVersion: "v2",
+				Name:       key.Name,
+				Endpoint:   "unix://" + key.Endpoint(),
+				Timeout:    "3s",
+			},
+		})
+	}
+	providers = append(providers, provider{Identity: &struct{}{}})
+
+	config := encryptionConfiguration{
+		APIVersion: "apiserver.config.k8s.io/v1",
+		Kind:       "EncryptionConfiguration",
+		Resources: []resourceConfig{
+			{
+				Resources: spec.Resources,
+				Providers: providers,
+			},
+		},
+	}
+
+	data, err := kops.ToRawYaml(config)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling encryption config to yaml: %w", err)
+	}
+	return data, nil
+}
+
+// buildKMSPluginPod builds the static pod running a KMS plugin container for each key.
+func (b *KubeAPIServerBuilder) buildKMSPluginPod(spec *kops.KMSEncryptionSpec) (*v1.Pod, error) {
+	pod := &v1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kms-plugin",
+			Namespace: "kube-system",
+			Labels: map[string]string{
+				"k8s-app": "kms-plugin",
+			},
+		},
+		Spec: v1.PodSpec{
+			HostNetwork: true,
+			Volumes: []v1.Volume{
+				{
+					Name: "kmsplugin",
+					VolumeSource: v1.VolumeSource{
+						HostPath: &v1.HostPathVolumeSource{
+							Path: kmsPluginSocketDir,
+							Type: new(v1.HostPathDirectoryOrCreate),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i, key := range kmsPluginKeys(spec) {
+		var args []string
+		switch spec.Provider {
+		case kops.KMSEncryptionProviderAWS:
+			parsed, err := arn.Parse(key.KeyID)
+			if err != nil {
+				return nil, fmt.Errorf("parsing KMS key %q: %w", key.KeyID, err)
+			}
+			args = []string{
+				"--key=" + key.KeyID,
+				"--region=" + parsed.Region,
+				"--listen=" + key.Endpoint(),
+				"--health-port=127.0.0.1:" + strconv.Itoa(key.HealthPort),
+			}
+		case kops.KMSEncryptionProviderGCP:
+			args = []string{
+				"--key-uri=" + key.KeyID,
+				"--path-to-unix-socket=" + key.Endpoint(),
+				"--healthz-port=" + strconv.Itoa(key.HealthPort),
+				"--logtostderr",
+			}
+		default:
+			return nil, fmt.Errorf("unknown KMS encryption provider %q", spec.Provider)
+		}
+
+		pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{
+			Name:  "kms-plugin-" + strconv.Itoa(i),
+			Image: b.RemapImage(spec.Image),
+			Args:  args,
+			Env:   env.GetProxyEnvVars(b.NodeupConfig.Networking.EgressProxy),
+			LivenessProbe: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					HTTPGet: &v1.HTTPGetAction{
+						Host: "127.0.0.1",
+						Path: "/healthz",
+						Port: intstr.FromInt(key.HealthPort),
+					},
+				},
+				InitialDelaySeconds: 15,
+				TimeoutSeconds:      15,
+			},
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("10m"),
+					v1.ResourceMemory: resource.MustParse("32Mi"),
+				},
+			},
+			VolumeMounts: []v1.VolumeMount{
+				{
+					Name:      "kmsplugin",
+					MountPath: kmsPluginSocketDir,
+				},
+			},
+		})
+	}
+
+	kubemanifest.MarkPodAsCritical(pod)
+	kubemanifest.MarkPodAsClusterCritical(pod)
+
+	kubemanifest.AddHostPathSELinuxContext(pod, b.NodeupConfig)
+
+	return pod, nil
+}
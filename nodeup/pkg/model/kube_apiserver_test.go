@@ -168,6 +168,41 @@ func TestAuditConfigAPIServerBuilder(t *testing.T) {
 	})
 }
 
+func TestManagedAuditConfigAPIServerBuilder(t *testing.T) {
+	RunGoldenTest(t, "tests/golden/audit-managed", "kube-apiserver", func(nodeupModelContext *NodeupModelContext, target *fi.NodeupModelBuilderContext) error {
+		builder := KubeAPIServerBuilder{NodeupModelContext: nodeupModelContext}
+		return builder.Build(target)
+	})
+}
+
+func TestAuthorizationConfigAPIServerBuilder(t *testing.T) {
+	RunGoldenTest(t, "tests/golden/authorization-config", "kube-apiserver", func(nodeupModelContext *NodeupModelContext, target *fi.NodeupModelBuilderContext) error {
+		builder := KubeAPIServerBuilder{NodeupModelContext: nodeupModelContext}
+		return builder.Build(target)
+	})
+}
+
+func TestAdmissionConfigAPIServerBuilder(t *testing.T) {
+	RunGoldenTest(t, "tests/golden/admission-config", "kube-apiserver", func(nodeupModelContext *NodeupModelContext, target *fi.NodeupModelBuilderContext) error {
+		builder := KubeAPIServerBuilder{NodeupModelContext: nodeupModelContext}
+		return builder.Build(target)
+	})
+}
+
+func TestPodSecurityAdmissionAPIServerBuilder(t *testing.T) {
+	RunGoldenTest(t, "tests/golden/pod-security-admission", "kube-apiserver", func(nodeupModelContext *NodeupModelContext, target *fi.NodeupModelBuilderContext) error {
+		builder := KubeAPIServerBuilder{NodeupModelContext: nodeupModelContext}
+		return builder.Build(target)
+	})
+}
+
+func TestKMSEncryptionAPIServerBuilder(t *testing.T) {
+	RunGoldenTest(t, "tests/golden/kms-encryption", "kube-apiserver", func(nodeupModelContext *NodeupModelContext, target *fi.NodeupModelBuilderContext) error {
+		builder := KubeAPIServerBuilder{NodeupModelContext: nodeupModelContext}
+		return builder.Build(target)
+	})
+}
+
 func TestOIDCConfigAPIServerBuilder(t *testing.T) {
 	RunGoldenTest(t, "tests/golden/oidc", "kube-apiserver", func(nodeupModelContext *NodeupModelContext, target *fi.NodeupModelBuilderContext) error {
 		builder := KubeAPIServerBuilder{NodeupModelContext: nodeupModelContext}
@@ -217,3 +252,38 @@ func TestKubeAPIServerEnvBuilder(t *testing.T) {
 		return builder.Build(target)
 	})
 }
+
+func Test_BuildAuditWebhookConfig(t *testing.T) {
+	webhook := &kops.AuditWebhookSpec{
+		Server:         "https://audit.example.com/events",
+		CACertificate:  "ca-data",
+		UseBearerToken: true,
+	}
+
+	data, err := buildAuditWebhookConfig(webhook, "secret-token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: Y2EtZGF0YQ==
+    server: https://audit.example.com/events
+  name: audit-webhook
+contexts:
+- context:
+    cluster: audit-webhook
+    user: kube-apiserver
+  name: audit-webhook
+current-context: audit-webhook
+kind: Config
+users:
+- name: kube-apiserver
+  user:
+    token: secret-token
+`
+	if string(data) != expected {
+		t.Errorf("unexpected audit webhook config:\n%s", string(data))
+	}
+}
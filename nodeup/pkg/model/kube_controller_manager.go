@@ -277,6 +277,7 @@ func (b *KubeControllerManagerBuilder) buildPod(kcm *kops.KubeControllerManagerC
 	kubemanifest.MarkPodAsClusterCritical(pod)
 
 	kubemanifest.AddHostPathSELinuxContext(pod, b.NodeupConfig)
+	kubemanifest.AddGoFIPSMode(pod, b.NodeupConfig)
 
 	return pod, nil
 }
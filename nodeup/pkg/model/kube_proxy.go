@@ -243,6 +243,7 @@ func (b *KubeProxyBuilder) buildPod() (*v1.Pod, error) {
 	kubemanifest.MarkPodAsNodeCritical(pod)
 
 	kubemanifest.AddHostPathSELinuxContext(pod, b.NodeupConfig)
+	kubemanifest.AddGoFIPSMode(pod, b.NodeupConfig)
 
 	return pod, nil
 }
@@ -288,6 +288,7 @@ func (b *KubeSchedulerBuilder) buildPod(kubeScheduler *kops.KubeSchedulerConfig)
 	kubemanifest.MarkPodAsClusterCritical(pod)
 
 	kubemanifest.AddHostPathSELinuxContext(pod, b.NodeupConfig)
+	kubemanifest.AddGoFIPSMode(pod, b.NodeupConfig)
 
 	return pod, nil
 }
@@ -22,8 +22,11 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -40,13 +43,16 @@ import (
 
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/apis/kops"
+	kopsmodel "k8s.io/kops/pkg/apis/kops/model"
 	kopsutil "k8s.io/kops/pkg/apis/kops/util"
 	"k8s.io/kops/pkg/flagbuilder"
+	"k8s.io/kops/pkg/kubemanifest"
 	"k8s.io/kops/pkg/rbac"
 	"k8s.io/kops/pkg/systemd"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/azure/azuremetadata"
 	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+	"k8s.io/kops/util/pkg/architectures"
 	"k8s.io/kops/util/pkg/distributions"
 	kubeletv1 "k8s.io/kubelet/config/v1"
 	kubelet "k8s.io/kubelet/config/v1beta1"
@@ -58,6 +64,8 @@ const (
 
 	kubeletConfigFilePath            = "/var/lib/kubelet/kubelet.conf"
 	credentialProviderConfigFilePath = "/var/lib/kubelet/credential-provider.conf" //nolint:gosec // This is a config file path, not a credential.
+	// acrCredentialProviderConfigFilePath is the Azure cloud configuration read by the ACR credential provider
+	acrCredentialProviderConfigFilePath = "/etc/kubernetes/acr-credential-provider.json" //nolint:gosec // This is a config file path, not a credential.
 )
 
 // Scheme registration is shared across all calls in this file because the
@@ -100,17 +108,18 @@ var _ fi.NodeupModelBuilder = &KubeletBuilder{}
 
 // Build is responsible for building the kubelet configuration
 func (b *KubeletBuilder) Build(c *fi.NodeupModelBuilderContext) error {
-	err := b.buildKubeletServingCertificate(c)
-	if err != nil {
-		return fmt.Errorf("error building kubelet server cert: %v", err)
-	}
-
 	ctx := c.Context()
 	kubeletConfig, err := b.buildKubeletConfigSpec(ctx)
 	if err != nil {
 		return fmt.Errorf("error building kubelet config: %v", err)
 	}
 
+	if !b.usesServerTLSBootstrap(kubeletConfig) {
+		if err := b.buildKubeletServingCertificate(c); err != nil {
+			return fmt.Errorf("error building kubelet server cert: %v", err)
+		}
+	}
+
 	{
 		// Set the provider ID to help speed node registration on large clusters
 		var providerID string
@@ -215,15 +224,8 @@ func (b *KubeletBuilder) Build(c *fi.NodeupModelBuilderContext) error {
 	}
 
 	if b.UseExternalKubeletCredentialProvider() {
-		switch b.CloudProvider() {
-		case kops.CloudProviderGCE:
-			if err := b.addGCPCredentialProvider(c); err != nil {
-				return fmt.Errorf("failed to add the %s kubelet credential provider: %w", b.CloudProvider(), err)
-			}
-		case kops.CloudProviderAWS:
-			if err := b.addECRCredentialProvider(c); err != nil {
-				return fmt.Errorf("failed to add the %s kubelet credential provider: %w", b.CloudProvider(), err)
-			}
+		if err := b.addCredentialProviders(c); err != nil {
+			return fmt.Errorf("failed to add the kubelet credential providers: %w", err)
 		}
 	}
 
@@ -299,8 +301,6 @@ func (b *KubeletBuilder) kubeletConfiguration(kubeletConfig *kops.KubeletConfigS
 	cc := &kubelet.KubeletConfiguration{
 		CgroupDriver:                     kubeletConfig.CgroupDriver,
 		CgroupRoot:                       kubeletConfig.CgroupRoot,
-		TLSCertFile:                      filepath.Join(b.PathSrvKubernetes(), "kubelet-server.crt"),
-		TLSPrivateKeyFile:                filepath.Join(b.PathSrvKubernetes(), "kubelet-server.key"),
 		TLSCipherSuites:                  kubeletConfig.TLSCipherSuites,
 		TLSMinVersion:                    kubeletConfig.TLSMinVersion,
 		ClusterDNS:                       []string{kubeletConfig.ClusterDNS},
@@ -351,6 +351,13 @@ func (b *KubeletBuilder) kubeletConfiguration(kubeletConfig *kops.KubeletConfigS
 		ShutdownGracePeriodCriticalPods:  fi.ValueOf(kubeletConfig.ShutdownGracePeriodCriticalPods),
 	}
 
+	if b.usesServerTLSBootstrap(kubeletConfig) {
+		cc.ServerTLSBootstrap = true
+	} else {
+		cc.TLSCertFile = filepath.Join(b.PathSrvKubernetes(), "kubelet-server.crt")
+		cc.TLSPrivateKeyFile = filepath.Join(b.PathSrvKubernetes(), "kubelet-server.key")
+	}
+
 	cc.Authentication.Anonymous.Enabled = kubeletConfig.AnonymousAuth
 	cc.Authentication.Webhook.Enabled = kubeletConfig.AuthenticationTokenWebhook
 	if kubeletConfig.ClientCAFile != "" {
@@ -367,7 +374,9 @@ func (b *KubeletBuilder) kubeletConfiguration(kubeletConfig *kops.KubeletConfigS
 		cc.EventRecordQPS = kubeletConfig.EventQPS
 	}
 
-	if b.NodeupConfig.ContainerdConfig.Address == nil {
+	if b.UsesCRIO() {
+		cc.ContainerRuntimeEndpoint = "unix://" + crioSocketPath
+	} else if b.NodeupConfig.ContainerdConfig.Address == nil {
 		cc.ContainerRuntimeEndpoint = "unix:///run/containerd/containerd.sock"
 	} else {
 		cc.ContainerRuntimeEndpoint = "unix://" + fi.ValueOf(b.NodeupConfig.ContainerdConfig.Address)
@@ -433,14 +442,9 @@ func (b *KubeletBuilder) kubeletPath() string {
 	return b.binaryPath() + "/kubelet"
 }
 
-// getECRCredentialProviderPath returns the path of the ECR Credentials Provider based on distro and archiecture
-func (b *KubeletBuilder) getECRCredentialProviderPath() string {
-	return b.binaryPath() + "/ecr-credential-provider"
-}
-
-// getGCPCredentialProviderPath returns the path of the GCP Credentials Provider based on distro and archiecture
-func (b *KubeletBuilder) getGCPCredentialProviderPath() string {
-	return b.binaryPath() + "/gcp-credential-provider"
+// getCredentialProviderPath returns the path of a kubelet credential provider based on distro
+func (b *KubeletBuilder) getCredentialProviderPath(name string) string {
+	return b.binaryPath() + "/" + name
 }
 
 // buildManifestDirectory creates the directory where kubelet expects static manifests to reside
@@ -488,6 +492,9 @@ func (b *KubeletBuilder) buildSystemdEnvironmentFile(ctx context.Context, kubele
 	sysconfig := "DAEMON_ARGS=\"" + flags + "\"\n"
 	// Makes kubelet read /root/.docker/config.json properly
 	sysconfig = sysconfig + "HOME=\"/root" + "\"\n"
+	if b.NodeupConfig.FIPSMode {
+		sysconfig = sysconfig + "GODEBUG=\"" + kubemanifest.GoFIPSModeGODEBUG + "\"\n"
+	}
 
 	t := &nodetasks.File{
 		Path:     "/etc/sysconfig/kubelet",
@@ -565,7 +572,11 @@ func (b *KubeletBuilder) buildSystemdService() *nodetasks.Service {
 	manifest := &systemd.Manifest{}
 	manifest.Set("Unit", "Description", "Kubernetes Kubelet Server")
 	manifest.Set("Unit", "Documentation", "https://github.com/kubernetes/kubernetes")
-	manifest.Set("Unit", "After", "containerd.service")
+	if b.UsesCRIO() {
+		manifest.Set("Unit", "After", "crio.service")
+	} else {
+		manifest.Set("Unit", "After", "containerd.service")
+	}
 
 	manifest.Set("Service", "EnvironmentFile", "/etc/sysconfig/kubelet")
 
@@ -604,12 +615,18 @@ func (b *KubeletBuilder) buildSystemdService() *nodetasks.Service {
 	return service
 }
 
-// addECRCredentialProvider installs the ECR Kubelet Credential Provider
-func (b *KubeletBuilder) addECRCredentialProvider(c *fi.NodeupModelBuilderContext) error {
-	{
-		assetName := "ecr-credential-provider-linux-" + string(b.Architecture)
-		assetPath := ""
-		asset, err := b.Assets.Find(assetName, assetPath)
+// addCredentialProviders installs the kubelet credential providers and their configuration
+func (b *KubeletBuilder) addCredentialProviders(c *fi.NodeupModelBuilderContext) error {
+	providers := kopsmodel.KubeletCredentialProviders(b.CloudProvider(), b.NodeupConfig.KubeletCredentialProviders)
+
+	for i := range providers {
+		provider := &providers[i]
+
+		assetName, err := b.credentialProviderAssetName(provider)
+		if err != nil {
+			return err
+		}
+		asset, err := b.Assets.Find(assetName, "")
 		if err != nil {
 			return fmt.Errorf("trying to locate asset %q: %v", assetName, err)
 		}
@@ -617,118 +634,152 @@ func (b *KubeletBuilder) addECRCredentialProvider(c *fi.NodeupModelBuilderContex
 			return fmt.Errorf("unable to locate asset %q", assetName)
 		}
 
-		t := &nodetasks.File{
-			Path:     b.getECRCredentialProviderPath(),
+		c.AddTask(&nodetasks.File{
+			Path:     b.getCredentialProviderPath(provider.Name),
 			Contents: asset,
 			Type:     nodetasks.FileType_File,
 			Mode:     s("0755"),
+		})
+
+		if provider.Name == kops.KubeletCredentialProviderACR {
+			// The ACR provider authenticates with the managed identity of the instance
+			c.AddTask(&nodetasks.File{
+				Path:     acrCredentialProviderConfigFilePath,
+				Contents: fi.NewStringResource(`{"cloud":"AzurePublicCloud","useManagedIdentityExtension":true}` + "\n"),
+				Type:     nodetasks.FileType_File,
+				Mode:     s("0644"),
+			})
 		}
-		c.AddTask(t)
 	}
 
-	{
+	providerConfig, err := b.buildCredentialProviderConfig(providers)
+	if err != nil {
+		return err
+	}
 
-		providerConfig := &kubeletv1.CredentialProviderConfig{}
+	var buf bytes.Buffer
+	if err := kubeletV1Encoder.Encode(providerConfig, &buf); err != nil {
+		return fmt.Errorf("encoding credential provider config: %w", err)
+	}
 
-		// Build the list of container registry globs to match
-		registryList := []string{
-			"*.dkr.ecr.*.amazonaws.com",
-			"*.dkr.ecr.*.amazonaws.com.cn",
-			"*.dkr.ecr-fips.*.amazonaws.com",
-			"*.dkr.ecr.us-iso-east-1.c2s.ic.gov",
-		}
+	c.AddTask(&nodetasks.File{
+		Path:     credentialProviderConfigFilePath,
+		Contents: fi.NewBytesResource(buf.Bytes()),
+		Type:     nodetasks.FileType_File,
+		Mode:     s("0644"),
+	})
 
-		containerd := b.NodeupConfig.ContainerdConfig
-		if containerd.UseECRCredentialsForMirrors {
-			for name := range containerd.RegistryMirrors {
-				registryList = append(registryList, name)
-			}
-		}
+	return nil
+}
 
-		cacheDuration, err := time.ParseDuration("12h")
+// credentialProviderAssetName returns the name of the asset holding the binary of a credential provider
+func (b *KubeletBuilder) credentialProviderAssetName(provider *kops.KubeletCredentialProviderSpec) (string, error) {
+	var packageURL *string
+	if provider.Packages != nil {
+		switch b.Architecture {
+		case architectures.ArchitectureAmd64:
+			packageURL = provider.Packages.UrlAmd64
+		case architectures.ArchitectureArm64:
+			packageURL = provider.Packages.UrlArm64
+		}
+	}
+	if packageURL != nil {
+		u, err := url.Parse(*packageURL)
 		if err != nil {
-			return err
+			return "", fmt.Errorf("parsing %s binary URL %q: %w", provider.Name, *packageURL, err)
+		}
+		return path.Base(u.Path), nil
+	}
+
+	switch provider.Name {
+	case kops.KubeletCredentialProviderECR:
+		return "ecr-credential-provider-linux-" + string(b.Architecture), nil
+	case kops.KubeletCredentialProviderGCP:
+		return "auth-provider-gcp", nil
+	default:
+		return "", fmt.Errorf("no binary of the %s kubelet credential provider for %s", provider.Name, b.Architecture)
+	}
+}
+
+// buildCredentialProviderConfig builds the kubelet credential provider configuration, applying the defaults of the well-known providers
+func (b *KubeletBuilder) buildCredentialProviderConfig(providers []kops.KubeletCredentialProviderSpec) (*kubeletv1.CredentialProviderConfig, error) {
+	providerConfig := &kubeletv1.CredentialProviderConfig{}
+
+	for _, provider := range providers {
+		credentialProvider := kubeletv1.CredentialProvider{
+			APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+			Name:                 provider.Name,
+			DefaultCacheDuration: &metav1.Duration{Duration: 5 * time.Minute},
 		}
 
-		providerConfig.Providers = []kubeletv1.CredentialProvider{
-			{
-				APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
-				Name:                 "ecr-credential-provider",
-				MatchImages:          registryList,
-				DefaultCacheDuration: &metav1.Duration{Duration: cacheDuration},
-				Args:                 []string{"get-credentials"},
-				Env: []kubeletv1.ExecEnvVar{
+		switch provider.Name {
+		case kops.KubeletCredentialProviderECR:
+			// Build the list of container registry globs to match
+			credentialProvider.MatchImages = []string{
+				"*.dkr.ecr.*.amazonaws.com",
+				"*.dkr.ecr.*.amazonaws.com.cn",
+				"*.dkr.ecr-fips.*.amazonaws.com",
+				"*.dkr.ecr.us-iso-east-1.c2s.ic.gov",
+			}
+			containerd := b.NodeupConfig.ContainerdConfig
+			if containerd != nil && containerd.UseECRCredentialsForMirrors {
+				for name := range containerd.RegistryMirrors {
+					credentialProvider.MatchImages = append(credentialProvider.MatchImages, name)
+				}
+			}
+			credentialProvider.DefaultCacheDuration = &metav1.Duration{Duration: 12 * time.Hour}
+			credentialProvider.Args = []string{"get-credentials"}
+			if b.CloudProvider() == kops.CloudProviderAWS {
+				credentialProvider.Env = []kubeletv1.ExecEnvVar{
 					{
 						Name:  "AWS_REGION",
 						Value: b.Cloud.Region(),
 					},
-				},
-			},
+				}
+			}
+		case kops.KubeletCredentialProviderGCP:
+			credentialProvider.MatchImages = []string{
+				"gcr.io",
+				"*.gcr.io",
+				"container.cloud.google.com",
+				"*.pkg.dev",
+			}
+			credentialProvider.DefaultCacheDuration = &metav1.Duration{Duration: time.Minute}
+			credentialProvider.Args = []string{"get-credentials", "--v=3"}
+		case kops.KubeletCredentialProviderACR:
+			credentialProvider.MatchImages = []string{
+				"*.azurecr.io",
+				"*.azurecr.cn",
+				"*.azurecr.de",
+				"*.azurecr.us",
+			}
+			credentialProvider.DefaultCacheDuration = &metav1.Duration{Duration: 10 * time.Minute}
+			credentialProvider.Args = []string{acrCredentialProviderConfigFilePath}
 		}
 
-		var buf bytes.Buffer
-		if err := kubeletV1Encoder.Encode(providerConfig, &buf); err != nil {
-			return fmt.Errorf("encoding ECR credential provider config: %w", err)
+		if len(provider.MatchImages) > 0 {
+			credentialProvider.MatchImages = provider.MatchImages
 		}
-
-		c.AddTask(&nodetasks.File{
-			Path:     credentialProviderConfigFilePath,
-			Contents: fi.NewBytesResource(buf.Bytes()),
-			Type:     nodetasks.FileType_File,
-			Mode:     s("0644"),
-		})
-	}
-	return nil
-}
-
-// addGCPCredentialProvider installs the GCP Kubelet Credential Provider
-func (b *KubeletBuilder) addGCPCredentialProvider(c *fi.NodeupModelBuilderContext) error {
-	{
-		assetName := "auth-provider-gcp"
-		assetPath := ""
-		asset, err := b.Assets.Find(assetName, assetPath)
-		if err != nil {
-			return fmt.Errorf("trying to locate asset %q: %v", assetName, err)
+		if provider.DefaultCacheDuration != nil {
+			credentialProvider.DefaultCacheDuration = provider.DefaultCacheDuration
 		}
-		if asset == nil {
-			return fmt.Errorf("unable to locate asset %q", assetName)
+		if len(provider.Args) > 0 {
+			credentialProvider.Args = provider.Args
 		}
-
-		t := &nodetasks.File{
-			Path:     b.getGCPCredentialProviderPath(),
-			Contents: asset,
-			Type:     nodetasks.FileType_File,
-			Mode:     s("0755"),
+		for _, env := range provider.Env {
+			credentialProvider.Env = slices.DeleteFunc(credentialProvider.Env, func(e kubeletv1.ExecEnvVar) bool {
+				return e.Name == env.Name
+			})
+			credentialProvider.Env = append(credentialProvider.Env, kubeletv1.ExecEnvVar{
+				Name:  env.Name,
+				Value: env.Value,
+			})
 		}
-		c.AddTask(t)
-	}
 
-	{
-		configContent := `apiVersion: kubelet.config.k8s.io/v1
-kind: CredentialProviderConfig
-providers:
-  - apiVersion: credentialprovider.kubelet.k8s.io/v1
-    name: gcp-credential-provider
-    matchImages:
-      - "gcr.io"
-      - "*.gcr.io"
-      - "container.cloud.google.com"
-      - "*.pkg.dev"
-    defaultCacheDuration: "1m"
-    args:
-      - get-credentials
-      - --v=3
-`
-
-		t := &nodetasks.File{
-			Path:     credentialProviderConfigFilePath,
-			Contents: fi.NewStringResource(configContent),
-			Type:     nodetasks.FileType_File,
-			Mode:     s("0644"),
-		}
-		c.AddTask(t)
+		providerConfig.Providers = append(providerConfig.Providers, credentialProvider)
 	}
-	return nil
+
+	return providerConfig, nil
 }
 
 // NodeLabels are defined in the InstanceGroup, but set flags on the kubelet config.
@@ -859,6 +910,12 @@ func (b *KubeletBuilder) buildControlPlaneKubeletKubeconfig(c *fi.NodeupModelBui
 	return b.BuildIssuedKubeconfig("kubelet", certName, c), nil
 }
 
+// usesServerTLSBootstrap is true if the kubelet requests its serving certificate with a CertificateSigningRequest.
+// Control plane nodes don't bootstrap through kops-controller, so they keep issuing their own serving certificate.
+func (b *KubeletBuilder) usesServerTLSBootstrap(kubeletConfig *kops.KubeletConfigSpec) bool {
+	return !b.HasAPIServer && fi.ValueOf(kubeletConfig.ServerTLSBootstrap)
+}
+
 func (b *KubeletBuilder) buildKubeletServingCertificate(c *fi.NodeupModelBuilderContext) error {
 	name := "kubelet-server"
 	dir := b.PathSrvKubernetes()
@@ -33,6 +33,7 @@ import (
 	"k8s.io/kops/pkg/apis/nodeup"
 	"k8s.io/kops/pkg/assets"
 	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/diff"
 	"k8s.io/kops/pkg/flagbuilder"
 	"k8s.io/kops/pkg/pki"
 	"k8s.io/kops/pkg/testutils"
@@ -507,3 +508,101 @@ func Test_Kubelet_BuildFlags(t *testing.T) {
 		})
 	}
 }
+
+func Test_BuildCredentialProviderConfig(t *testing.T) {
+	b := &KubeletBuilder{
+		NodeupModelContext: &NodeupModelContext{
+			Architecture: "arm64",
+			BootConfig: &nodeup.BootConfig{
+				CloudProvider: kops.CloudProviderGCE,
+			},
+			NodeupConfig: &nodeup.Config{},
+		},
+	}
+
+	providers := []kops.KubeletCredentialProviderSpec{
+		{
+			Name:                 kops.KubeletCredentialProviderGCP,
+			DefaultCacheDuration: &metav1.Duration{Duration: 5 * time.Minute},
+		},
+		{
+			Name: kops.KubeletCredentialProviderACR,
+			Packages: &kops.PackagesConfig{
+				UrlArm64: new("https://example.com/arm64/azure-acr-credential-provider-linux-arm64"),
+			},
+		},
+		{
+			Name:        "custom-credential-provider",
+			MatchImages: []string{"registry.example.com"},
+			Args:        []string{"--verbose"},
+			Env:         []kops.EnvVar{{Name: "REGION", Value: "us-test-1"}},
+			Packages: &kops.PackagesConfig{
+				UrlAmd64: new("https://example.com/amd64/custom"),
+			},
+		},
+	}
+
+	config, err := b.buildCredentialProviderConfig(providers)
+	if err != nil {
+		t.Fatalf("buildCredentialProviderConfig() error = %v", err)
+	}
+	var buf strings.Builder
+	if err := kubeletV1Encoder.Encode(config, &buf); err != nil {
+		t.Fatalf("error encoding config: %v", err)
+	}
+	want := `apiVersion: kubelet.config.k8s.io/v1
+kind: CredentialProviderConfig
+providers:
+- apiVersion: credentialprovider.kubelet.k8s.io/v1
+  args:
+  - get-credentials
+  - --v=3
+  defaultCacheDuration: 5m0s
+  matchImages:
+  - gcr.io
+  - '*.gcr.io'
+  - container.cloud.google.com
+  - '*.pkg.dev'
+  name: gcp-credential-provider
+- apiVersion: credentialprovider.kubelet.k8s.io/v1
+  args:
+  - /etc/kubernetes/acr-credential-provider.json
+  defaultCacheDuration: 10m0s
+  matchImages:
+  - '*.azurecr.io'
+  - '*.azurecr.cn'
+  - '*.azurecr.de'
+  - '*.azurecr.us'
+  name: acr-credential-provider
+- apiVersion: credentialprovider.kubelet.k8s.io/v1
+  args:
+  - --verbose
+  defaultCacheDuration: 5m0s
+  env:
+  - name: REGION
+    value: us-test-1
+  matchImages:
+  - registry.example.com
+  name: custom-credential-provider
+`
+	if buf.String() != want {
+		t.Errorf("unexpected credential provider config, diff:\n%s", diff.FormatDiff(want, buf.String()))
+	}
+
+	wantAssetNames := []string{"auth-provider-gcp", "azure-acr-credential-provider-linux-arm64", ""}
+	for i := range providers {
+		assetName, err := b.credentialProviderAssetName(&providers[i])
+		if wantAssetNames[i] == "" {
+			if err == nil {
+				t.Errorf("expected an error for the asset of %q without an arm64 binary, got %q", providers[i].Name, assetName)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("credentialProviderAssetName(%q) error = %v", providers[i].Name, err)
+		}
+		if assetName != wantAssetNames[i] {
+			t.Errorf("credentialProviderAssetName(%q) = %q, want %q", providers[i].Name, assetName, wantAssetNames[i])
+		}
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"path/filepath"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+)
+
+// minContainerSELinuxVersion is the oldest container-selinux policy accepted when kOps manages SELinux.
+// Older releases lack the policy for the kubelet and the pod log directories.
+const minContainerSELinuxVersion = "2.167.0"
+
+// SELinuxBuilder labels the directories used by containerd and the kubelet, and sets the SELinux mode.
+type SELinuxBuilder struct {
+	*NodeupModelContext
+}
+
+var _ fi.NodeupModelBuilder = &SELinuxBuilder{}
+
+// Build is responsible for configuring SELinux
+func (b *SELinuxBuilder) Build(c *fi.NodeupModelBuilderContext) error {
+	if b.NodeupConfig.SELinuxMode == "" {
+		return nil
+	}
+	if !b.Distribution.IsRHELFamily() {
+		klog.Warningf("SELinux is only managed on RHEL-family distributions, skipping SELinux mode %s", b.NodeupConfig.SELinuxMode)
+		return nil
+	}
+
+	// semanage is needed to add the file contexts to the local policy
+	c.EnsureTask(&nodetasks.Package{Name: "policycoreutils-python-utils"})
+
+	containerdRoot := "/var/lib/containerd"
+	containerdState := "/run/containerd"
+	if b.NodeupConfig.ContainerdConfig != nil {
+		if root := fi.ValueOf(b.NodeupConfig.ContainerdConfig.Root); root != "" {
+			containerdRoot = root
+		}
+		if state := fi.ValueOf(b.NodeupConfig.ContainerdConfig.State); state != "" {
+			containerdState = state
+		}
+	}
+	kubeletRoot := "/var/lib/kubelet"
+	if b.NodeupConfig.KubeletConfig.RootDir != "" {
+		kubeletRoot = b.NodeupConfig.KubeletConfig.RootDir
+	}
+
+	fileContexts := []*nodetasks.SELinuxFileContext{
+		{Path: filepath.Clean(containerdRoot), Type: "container_var_lib_t"},
+		{Path: filepath.Clean(containerdState), Type: "container_var_run_t"},
+		{Path: filepath.Clean(kubeletRoot), Type: "container_var_lib_t"},
+		// Volumes of pods are shared with their containers
+		{Path: filepath.Join(kubeletRoot, "pods"), Type: "container_file_t"},
+		{Path: "/var/log/pods", Type: "container_log_t"},
+		{Path: "/var/log/containers", Type: "container_log_t"},
+	}
+	for _, fileContext := range fileContexts {
+		c.AddTask(fileContext)
+	}
+
+	c.AddTask(&nodetasks.SELinuxMode{
+		Mode:                       b.NodeupConfig.SELinuxMode,
+		MinContainerSELinuxVersion: minContainerSELinuxVersion,
+	})
+
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/nodeup"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+	"k8s.io/kops/util/pkg/distributions"
+)
+
+func TestSELinuxBuilder(t *testing.T) {
+	nodeupModelContext := &NodeupModelContext{
+		Distribution: distributions.DistributionRocky9,
+		NodeupConfig: &nodeup.Config{
+			SELinuxMode: kops.SELinuxModeEnforcing,
+			ContainerdConfig: &kops.ContainerdConfig{
+				Root: new("/mnt/containerd/"),
+			},
+		},
+	}
+
+	c := &fi.NodeupModelBuilderContext{Tasks: make(map[string]fi.NodeupTask)}
+	builder := SELinuxBuilder{NodeupModelContext: nodeupModelContext}
+	if err := builder.Build(c); err != nil {
+		t.Fatalf("unexpected error from Build: %v", err)
+	}
+
+	expectedTypes := map[string]string{
+		"/mnt/containerd":       "container_var_lib_t",
+		"/run/containerd":       "container_var_run_t",
+		"/var/lib/kubelet":      "container_var_lib_t",
+		"/var/lib/kubelet/pods": "container_file_t",
+		"/var/log/pods":         "container_log_t",
+		"/var/log/containers":   "container_log_t",
+	}
+	for path, expected := range expectedTypes {
+		task, found := c.Tasks["SELinuxFileContext/SELinuxFileContext-"+path]
+		if !found {
+			t.Errorf("expected SELinux file context for %s", path)
+			continue
+		}
+		if actual := task.(*nodetasks.SELinuxFileContext).Type; actual != expected {
+			t.Errorf("expected type %s for %s, got %s", expected, path, actual)
+		}
+	}
+
+	task, found := c.Tasks["SELinuxMode/SELinuxMode"]
+	if !found {
+		t.Fatalf("expected SELinux mode to be set")
+	}
+	if mode := task.(*nodetasks.SELinuxMode).Mode; mode != kops.SELinuxModeEnforcing {
+		t.Errorf("expected SELinux mode %s, got %s", kops.SELinuxModeEnforcing, mode)
+	}
+	if _, found := c.Tasks["Package/policycoreutils-python-utils"]; !found {
+		t.Errorf("expected the package providing semanage to be installed")
+	}
+}
+
+func TestSELinuxBuilderSkipsOtherDistributions(t *testing.T) {
+	nodeupModelContext := &NodeupModelContext{
+		Distribution: distributions.DistributionUbuntu2404,
+		NodeupConfig: &nodeup.Config{SELinuxMode: kops.SELinuxModeEnforcing},
+	}
+
+	c := &fi.NodeupModelBuilderContext{Tasks: make(map[string]fi.NodeupTask)}
+	builder := SELinuxBuilder{NodeupModelContext: nodeupModelContext}
+	if err := builder.Build(c); err != nil {
+		t.Fatalf("unexpected error from Build: %v", err)
+	}
+	if len(c.Tasks) != 0 {
+		t.Errorf("expected no tasks on a distribution without SELinux, got %v", c.Tasks)
+	}
+}
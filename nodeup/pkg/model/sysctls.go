@@ -172,6 +172,10 @@ func (b *SysctlBuilder) Build(c *fi.NodeupModelBuilderContext) error {
 			"")
 	}
 
+	if b.NodeupConfig.HardeningProfile == kops.HardeningProfileCIS {
+		sysctls = append(sysctls, hardeningSysctls...)
+	}
+
 	sysctls = append(sysctls, b.NodeupConfig.SysctlParameters...)
 
 	c.AddTask(&nodetasks.File{
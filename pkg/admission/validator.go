@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package admission exposes the kOps Cluster and InstanceGroup validation
+// rules as a reusable validator, which can be run as a Kubernetes validating
+// admission webhook or against manifests (for example in CI).
+package admission
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/validation"
+	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/util/pkg/text"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+// ClusterGetter looks up the Cluster an InstanceGroup belongs to,
+// so that the InstanceGroup can be validated against it.
+type ClusterGetter interface {
+	// GetCluster reads a cluster by name
+	GetCluster(ctx context.Context, name string) (*kops.Cluster, error)
+}
+
+// Validator validates kOps resources with the same rules the kops CLI applies
+// before writing them to the state store.
+type Validator struct {
+	// Clusters is used to cross-validate InstanceGroups against their Cluster.
+	// If nil, InstanceGroups are only validated on their own.
+	Clusters ClusterGetter
+	// VFSContext is used to validate state store paths; defaults to vfs.Context.
+	VFSContext *vfs.VFSContext
+}
+
+// ObjectResult holds the validation errors for a single object of a manifest.
+type ObjectResult struct {
+	Kind   string
+	Name   string
+	Errors field.ErrorList
+}
+
+// Validate validates a Cluster or InstanceGroup.
+// old is the current version of the object, or nil if the object is being created.
+func (v *Validator) Validate(ctx context.Context, obj runtime.Object, old runtime.Object) (field.ErrorList, error) {
+	switch obj := obj.(type) {
+	case *kops.Cluster:
+		return v.validateCluster(obj, old), nil
+	case *kops.InstanceGroup:
+		return v.validateInstanceGroup(ctx, obj, nil)
+	default:
+		return nil, fmt.Errorf("unsupported object type %T", obj)
+	}
+}
+
+// ValidateManifest validates the Clusters and InstanceGroups in a (multi-document) manifest.
+// InstanceGroups are cross-validated against a Cluster defined in the same manifest,
+// falling back to Clusters if the manifest does not define it. Other objects are ignored.
+func (v *Validator) ValidateManifest(ctx context.Context, data []byte) ([]ObjectResult, error) {
+	var objects []runtime.Object
+	clusters := make(map[string]*kops.Cluster)
+	for _, section := range text.SplitContentToSections(data) {
+		obj, _, err := kopscodecs.Decode(section, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing manifest: %w", err)
+		}
+		switch obj := obj.(type) {
+		case *kops.Cluster:
+			clusters[obj.ObjectMeta.Name] = obj
+			objects = append(objects, obj)
+		case *kops.InstanceGroup:
+			objects = append(objects, obj)
+		}
+	}
+
+	var results []ObjectResult
+	for _, obj := range objects {
+		var result ObjectResult
+		var err error
+		switch obj := obj.(type) {
+		case *kops.Cluster:
+			result = ObjectResult{Kind: "Cluster", Name: obj.ObjectMeta.Name}
+			result.Errors = v.validateCluster(obj, nil)
+		case *kops.InstanceGroup:
+			result = ObjectResult{Kind: "InstanceGroup", Name: obj.ObjectMeta.Name}
+			result.Errors, err = v.validateInstanceGroup(ctx, obj, clusters)
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (v *Validator) vfsContext() *vfs.VFSContext {
+	if v.VFSContext != nil {
+		return v.VFSContext
+	}
+	return vfs.Context
+}
+
+func (v *Validator) validateCluster(cluster *kops.Cluster, old runtime.Object) field.ErrorList {
+	if old, ok := old.(*kops.Cluster); ok && old != nil {
+		return validation.ValidateClusterUpdate(cluster, nil, old, v.vfsContext())
+	}
+	return validation.ValidateCluster(cluster, false, v.vfsContext())
+}
+
+func (v *Validator) validateInstanceGroup(ctx context.Context, ig *kops.InstanceGroup, clusters map[string]*kops.Cluster) (field.ErrorList, error) {
+	clusterName := ig.ObjectMeta.Labels[kops.LabelClusterName]
+	if clusterName == "" {
+		return validation.ValidateInstanceGroup(ig, nil, false), nil
+	}
+
+	cluster := clusters[clusterName]
+	if cluster == nil && v.Clusters != nil {
+		var err error
+		cluster, err = v.Clusters.GetCluster(ctx, clusterName)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error reading cluster %q: %w", clusterName, err)
+		}
+	}
+	if cluster == nil {
+		return validation.ValidateInstanceGroup(ig, nil, false), nil
+	}
+	return validation.CrossValidateInstanceGroup(ig, cluster, nil, false), nil
+}
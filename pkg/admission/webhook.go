@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/kopscodecs"
+)
+
+// maxRequestBytes limits the size of the AdmissionReview requests we accept
+const maxRequestBytes = 4 * 1024 * 1024
+
+// ServeHTTP implements http.Handler, answering admission.k8s.io/v1 AdmissionReview requests.
+func (v *Validator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error reading request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	review := &admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(body, review); err != nil {
+		http.Error(w, fmt.Sprintf("error parsing AdmissionReview: %v", err), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, "AdmissionReview does not contain a request", http.StatusBadRequest)
+		return
+	}
+
+	response := v.Review(r.Context(), review.Request)
+	response.UID = review.Request.UID
+
+	b, err := json.Marshal(&admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionv1.SchemeGroupVersion.String(),
+			Kind:       "AdmissionReview",
+		},
+		Response: response,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error building response: %v", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(b); err != nil {
+		klog.Warningf("error writing response: %v", err)
+	}
+}
+
+// Review validates the object of an admission request.
+// Objects other than Clusters and InstanceGroups, and deletions, are always allowed.
+func (v *Validator) Review(ctx context.Context, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	obj, err := decodeObject(req.Object)
+	if err != nil {
+		return deniedResponse(apierrors.NewBadRequest(fmt.Sprintf("error decoding object: %v", err)))
+	}
+
+	var kind schema.GroupKind
+	var name string
+	switch obj := obj.(type) {
+	case *kops.Cluster:
+		kind, name = kops.SchemeGroupVersion.WithKind("Cluster").GroupKind(), obj.ObjectMeta.Name
+	case *kops.InstanceGroup:
+		kind, name = kops.SchemeGroupVersion.WithKind("InstanceGroup").GroupKind(), obj.ObjectMeta.Name
+	default:
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	var old runtime.Object
+	if req.Operation == admissionv1.Update && len(req.OldObject.Raw) != 0 {
+		old, err = decodeObject(req.OldObject)
+		if err != nil {
+			return deniedResponse(apierrors.NewBadRequest(fmt.Sprintf("error decoding old object: %v", err)))
+		}
+	}
+
+	errs, err := v.Validate(ctx, obj, old)
+	if err != nil {
+		klog.Warningf("error validating %s %q: %v", kind.Kind, name, err)
+		return deniedResponse(apierrors.NewInternalError(err))
+	}
+	if len(errs) != 0 {
+		klog.V(2).Infof("rejecting %s %q: %v", kind.Kind, name, errs.ToAggregate())
+		return deniedResponse(apierrors.NewInvalid(kind, name, errs))
+	}
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+func decodeObject(raw runtime.RawExtension) (runtime.Object, error) {
+	if len(raw.Raw) == 0 {
+		return nil, fmt.Errorf("object is empty")
+	}
+	obj, _, err := kopscodecs.Decode(raw.Raw, nil)
+	return obj, err
+}
+
+func deniedResponse(err *apierrors.StatusError) *admissionv1.AdmissionResponse {
+	status := err.Status()
+	return &admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result:  &status,
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/pkg/testutils"
+)
+
+type fakeClusters map[string]*kops.Cluster
+
+func (f fakeClusters) GetCluster(ctx context.Context, name string) (*kops.Cluster, error) {
+	if cluster, ok := f[name]; ok {
+		return cluster, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Group: kops.GroupName, Resource: "Cluster"}, name)
+}
+
+func toRawExtension(t *testing.T, obj runtime.Object) runtime.RawExtension {
+	b, err := kopscodecs.ToVersionedJSON(obj)
+	if err != nil {
+		t.Fatalf("error converting to json: %v", err)
+	}
+	return runtime.RawExtension{Raw: b}
+}
+
+func TestReview(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := testutils.BuildMinimalClusterAWS("example.com")
+	noneDNS := testutils.BuildMinimalClusterAWS("none.example.com")
+	noneDNS.Spec.Networking.Topology.DNS = kops.DNSTypeNone
+	validator := &Validator{Clusters: fakeClusters{"none.example.com": noneDNS}}
+
+	invalidCluster := testutils.BuildMinimalClusterAWS("example.com")
+	invalidCluster.Spec.KubernetesVersion = ""
+
+	removedEtcd := testutils.BuildMinimalClusterAWS("example.com")
+	removedEtcd.Spec.EtcdClusters = removedEtcd.Spec.EtcdClusters[:1]
+
+	apiServer := testutils.BuildMinimalNodeInstanceGroup("apiserver", "subnet-us-test-1a")
+	apiServer.Spec.Role = kops.InstanceGroupRoleAPIServer
+	apiServer.ObjectMeta.Labels = map[string]string{kops.LabelClusterName: "none.example.com"}
+
+	unknownCluster := apiServer.DeepCopy()
+	unknownCluster.ObjectMeta.Labels[kops.LabelClusterName] = "unknown.example.com"
+
+	invalidIG := testutils.BuildMinimalNodeInstanceGroup("nodes", "subnet-us-test-1a")
+	invalidIG.Spec.MinSize = new(int32(3))
+	invalidIG.Spec.MaxSize = new(int32(1))
+
+	grid := []struct {
+		name      string
+		operation admissionv1.Operation
+		object    runtime.Object
+		oldObject runtime.Object
+		expected  string
+	}{
+		{name: "valid cluster", operation: admissionv1.Create, object: cluster},
+		{name: "invalid cluster", operation: admissionv1.Create, object: invalidCluster, expected: "spec.kubernetesVersion: Required value"},
+		{name: "removed etcd cluster", operation: admissionv1.Update, object: removedEtcd, oldObject: cluster, expected: "EtcdClusters cannot be removed"},
+		{name: "invalid instance group", operation: admissionv1.Create, object: &invalidIG, expected: "maxSize must be greater than or equal to minSize"},
+		{name: "cross-validated instance group", operation: admissionv1.Create, object: &apiServer, expected: "APIServer cannot be used with topology.dns.type=None"},
+		{name: "instance group of unknown cluster", operation: admissionv1.Create, object: unknownCluster},
+		{name: "deletion", operation: admissionv1.Delete, object: invalidCluster},
+		{name: "other kind", operation: admissionv1.Create, object: &kops.SSHCredential{}},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			req := &admissionv1.AdmissionRequest{
+				Operation: g.operation,
+				Object:    toRawExtension(t, g.object),
+			}
+			if g.oldObject != nil {
+				req.OldObject = toRawExtension(t, g.oldObject)
+			}
+			response := validator.Review(ctx, req)
+			if g.expected == "" {
+				if !response.Allowed {
+					t.Errorf("expected request to be allowed, got %+v", response.Result)
+				}
+				return
+			}
+			if response.Allowed {
+				t.Fatalf("expected request to be denied")
+			}
+			if response.Result.Code != http.StatusUnprocessableEntity {
+				t.Errorf("expected code %d, got %d", http.StatusUnprocessableEntity, response.Result.Code)
+			}
+			if !strings.Contains(response.Result.Message, g.expected) {
+				t.Errorf("expected message to contain %q, got %q", g.expected, response.Result.Message)
+			}
+		})
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	cluster := testutils.BuildMinimalClusterAWS("example.com")
+	cluster.Spec.KubernetesVersion = "latest"
+
+	body, err := json.Marshal(&admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			UID:       types.UID("1234"),
+			Operation: admissionv1.Create,
+			Object:    toRawExtension(t, cluster),
+		},
+	})
+	if err != nil {
+		t.Fatalf("error building request: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	(&Validator{}).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(body)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d: %s", w.Code, w.Body.String())
+	}
+
+	review := &admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(w.Body.Bytes(), review); err != nil {
+		t.Fatalf("error parsing response: %v", err)
+	}
+	if review.APIVersion != "admission.k8s.io/v1" || review.Kind != "AdmissionReview" {
+		t.Errorf("unexpected type %s/%s", review.APIVersion, review.Kind)
+	}
+	if review.Response == nil || review.Response.UID != "1234" || review.Response.Allowed {
+		t.Fatalf("unexpected response %+v", review.Response)
+	}
+	if !strings.Contains(review.Response.Result.Message, "unable to determine kubernetes version") {
+		t.Errorf("unexpected message %q", review.Response.Result.Message)
+	}
+
+	w = httptest.NewRecorder()
+	(&Validator{}).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/validate", strings.NewReader("{}")))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d for a review without request, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestValidateManifest(t *testing.T) {
+	cluster := testutils.BuildMinimalClusterAWS("example.com")
+	cluster.Spec.Networking.Topology.DNS = kops.DNSTypeNone
+
+	apiServer := testutils.BuildMinimalNodeInstanceGroup("apiserver", "subnet-us-test-1a")
+	apiServer.Spec.Role = kops.InstanceGroupRoleAPIServer
+	apiServer.ObjectMeta.Labels = map[string]string{kops.LabelClusterName: "example.com"}
+
+	var manifest []string
+	for _, obj := range []runtime.Object{cluster, &apiServer} {
+		b, err := kopscodecs.ToVersionedYaml(obj)
+		if err != nil {
+			t.Fatalf("error converting to yaml: %v", err)
+		}
+		manifest = append(manifest, string(b))
+	}
+
+	results, err := (&Validator{}).ValidateManifest(context.Background(), []byte(strings.Join(manifest, "\n---\n")))
+	if err != nil {
+		t.Fatalf("error validating manifest: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %+v", results)
+	}
+	if results[0].Kind != "Cluster" || len(results[0].Errors) != 0 {
+		t.Errorf("expected cluster to be valid, got %+v", results[0])
+	}
+	if results[1].Kind != "InstanceGroup" || results[1].Name != "apiserver" || len(results[1].Errors) != 1 {
+		t.Errorf("expected instance group to be cross-validated against the cluster in the manifest, got %+v", results[1])
+	}
+}
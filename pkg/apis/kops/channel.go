@@ -76,6 +76,21 @@ type KubernetesVersionSpec struct {
 
 	RecommendedVersion string `json:"recommendedVersion,omitempty"`
 	RequiredVersion    string `json:"requiredVersion,omitempty"`
+
+	// BreakingChanges lists the known breaking changes when a cluster is upgraded into this Range of kubernetes versions
+	BreakingChanges []BreakingChangeSpec `json:"breakingChanges,omitempty"`
+}
+
+// BreakingChangeSpec describes a change that may need action when upgrading a cluster
+type BreakingChangeSpec struct {
+	// Component is the component that changes, for example kubernetes, etcd, containerd or addons
+	Component string `json:"component,omitempty"`
+
+	// Description describes the change and any action required
+	Description string `json:"description,omitempty"`
+
+	// URL links to more information about the change
+	URL string `json:"url,omitempty"`
 }
 
 type ChannelImageSpec struct {
@@ -262,6 +277,26 @@ func FindKubernetesVersionSpec(versions []KubernetesVersionSpec, version semver.
 	return nil
 }
 
+// FindBreakingChanges returns the breaking changes of the version ranges that apply to the new version but not to the old version
+func FindBreakingChanges(versions []KubernetesVersionSpec, oldVersion, newVersion semver.Version) []BreakingChangeSpec {
+	var breakingChanges []BreakingChangeSpec
+	for i := range versions {
+		v := &versions[i]
+		if len(v.BreakingChanges) == 0 || v.Range == "" {
+			continue
+		}
+		versionRange, err := semver.ParseRange(v.Range)
+		if err != nil {
+			klog.Warningf("unable to parse range in channel version spec: %q", v.Range)
+			continue
+		}
+		if versionRange(newVersion) && !versionRange(oldVersion) {
+			breakingChanges = append(breakingChanges, v.BreakingChanges...)
+		}
+	}
+	return breakingChanges
+}
+
 // FindKopsVersionSpec returns a KopsVersionSpec for the current version
 func FindKopsVersionSpec(versions []KopsVersionSpec, version semver.Version) *KopsVersionSpec {
 	for i := range versions {
@@ -21,6 +21,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const (
@@ -152,6 +153,8 @@ type InstanceGroupSpec struct {
 	Autoscale *bool `json:"autoscale,omitempty"`
 	// AutoscalePriority determines the InstanceGroup priority for scaling when cluster autoscaler uses the priority expander.
 	AutoscalePriority int16 `json:"autoscalePriority,omitempty"`
+	// ClusterAutoscaler overrides the scale-down options of the cluster autoscaler for this instance group (AWS only).
+	ClusterAutoscaler *InstanceGroupClusterAutoscalerSpec `json:"clusterAutoscaler,omitempty"`
 	// MachineType is the instance class
 	MachineType string `json:"machineType,omitempty"`
 	// RootVolume specifies options for the instances' root volumes.
@@ -171,12 +174,17 @@ type InstanceGroupSpec struct {
 	MaxPrice *string `json:"maxPrice,omitempty"`
 	// SpotDurationInMinutes reserves a spot block for the period specified
 	SpotDurationInMinutes *int64 `json:"spotDurationInMinutes,omitempty"`
+	// OnDemandMaxPrice is the highest hourly on-demand price, in USD, that an instance type in this group may have.
+	// kOps refuses to apply changes that would launch instances priced above this value (AWS only).
+	OnDemandMaxPrice *string `json:"onDemandMaxPrice,omitempty"`
 	// CPUCredits is the credit option for CPU Usage on burstable instance types (AWS only)
 	CPUCredits *string `json:"cpuCredits,omitempty"`
 	// AssociatePublicIP is true if we want instances to have a public IP
 	AssociatePublicIP *bool `json:"associatePublicIP,omitempty"`
 	// AdditionalSecurityGroups attaches additional security groups (e.g. i-123456)
 	AdditionalSecurityGroups []string `json:"additionalSecurityGroups,omitempty"`
+	// FirewallRules are additional firewall rules applied to the instances in this instance group
+	FirewallRules []FirewallRuleSpec `json:"firewallRules,omitempty"`
 	// CloudLabels defines additional tags or labels on cloud provider resources
 	CloudLabels map[string]string `json:"cloudLabels,omitempty"`
 	// NodeLabels indicates the kubernetes labels for nodes in this instance group
@@ -242,6 +250,51 @@ type InstanceGroupSpec struct {
 	//   'STANDARD': (default) standard provisioning with user controlled run time, no discounts
 	//   'SPOT': heavily discounted, no guaranteed run time.
 	GCPProvisioningModel *string `json:"gcpProvisioningModel,omitempty"`
+	// AzureUserAssignedIdentities are the resource IDs of user-assigned managed identities to attach to the instances,
+	// in addition to their system-assigned identity (Azure only).
+	AzureUserAssignedIdentities []string `json:"azureUserAssignedIdentities,omitempty"`
+	// AzureOrchestrationMode is the orchestration mode of the VM Scale Set (Azure only).
+	// Valid values:
+	//   'Uniform': (default) identical VMs managed through the VM Scale Set
+	//   'Flexible': standalone VMs, which can be spread across fault domains and mix Spot and regular priority
+	AzureOrchestrationMode *string `json:"azureOrchestrationMode,omitempty"`
+	// AzureEphemeralOSDiskPlacement places the OS disk on the local storage of the VMs, which is free and faster
+	// than a managed disk, but lost when the VM is deallocated (Azure only).
+	// Valid values are 'CacheDisk' and 'ResourceDisk'.
+	AzureEphemeralOSDiskPlacement *string `json:"azureEphemeralOSDiskPlacement,omitempty"`
+	// Karpenter configures the NodePool generated for an InstanceGroup managed by Karpenter.
+	Karpenter *KarpenterInstanceGroupSpec `json:"karpenter,omitempty"`
+	// SpotPolicy configures Spot capacity for the instance group, as MixedInstancesPolicy does on AWS (GCE and Azure only).
+	SpotPolicy *SpotPolicySpec `json:"spotPolicy,omitempty"`
+	// ManagedInstanceGroupUpdatePolicy configures how the managed instance groups apply changes to their instance template (GCE only).
+	ManagedInstanceGroupUpdatePolicy *ManagedInstanceGroupUpdatePolicySpec `json:"managedInstanceGroupUpdatePolicy,omitempty"`
+	// ImagePatching configures kOps to periodically check for a newer image when the image references one that changes over time,
+	// such as an SSM parameter on AWS, an image family on GCE or the latest version of a gallery image on Azure,
+	// and optionally to roll it out to the instances.
+	ImagePatching *ImagePatchingSpec `json:"imagePatching,omitempty"`
+}
+
+// InstanceGroupClusterAutoscalerSpec overrides the scale-down options of the cluster autoscaler for an instance group.
+type InstanceGroupClusterAutoscalerSpec struct {
+	// ScaleDownUtilizationThreshold overrides the utilization threshold for node scale-down.
+	ScaleDownUtilizationThreshold *string `json:"scaleDownUtilizationThreshold,omitempty"`
+	// ScaleDownUnneededTime overrides the time a node should be unneeded before it is eligible for scale down.
+	ScaleDownUnneededTime *string `json:"scaleDownUnneededTime,omitempty"`
+	// ScaleDownUnreadyTime overrides the time an unready node should be unneeded before it is eligible for scale down.
+	ScaleDownUnreadyTime *string `json:"scaleDownUnreadyTime,omitempty"`
+	// IgnoreDaemonSetsUtilization overrides whether DaemonSet-managed pods are ignored when calculating utilization for scale down.
+	IgnoreDaemonSetsUtilization *bool `json:"ignoreDaemonSetsUtilization,omitempty"`
+}
+
+// KarpenterInstanceGroupSpec configures the requirements of the NodePool generated for an InstanceGroup managed by Karpenter.
+type KarpenterInstanceGroupSpec struct {
+	// CapacityTypes are the capacity types that Karpenter may launch: on-demand or spot.
+	// Defaults to the capacity types implied by maxPrice and mixedInstancesPolicy.
+	CapacityTypes []string `json:"capacityTypes,omitempty"`
+	// Architectures are the CPU architectures that Karpenter may launch: amd64 or arm64.
+	Architectures []string `json:"architectures,omitempty"`
+	// InstanceFamilies are the EC2 instance families that Karpenter may launch, for example m7i or c7g.
+	InstanceFamilies []string `json:"instanceFamilies,omitempty"`
 }
 
 const (
@@ -319,6 +372,50 @@ type MixedInstancesPolicySpec struct {
 	SpotInstancePools *int64 `json:"spotInstancePools,omitempty"`
 }
 
+// ManagedInstanceGroupUpdatePolicySpec configures the update policy of the managed instance groups of an instance group on GCE.
+type ManagedInstanceGroupUpdatePolicySpec struct {
+	// Type is how the managed instance groups apply a new instance template.
+	// Valid values:
+	//   'OPPORTUNISTIC' (default): only new instances use the new template; existing instances are replaced by kops rolling-update
+	//   'PROACTIVE': the managed instance groups update existing instances themselves, and kops rolling-update skips the instance group
+	Type *string `json:"type,omitempty"`
+	// MinimalAction is the minimal action taken on an instance to apply a new template.
+	// Valid values are 'REPLACE' (default), 'RESTART', 'REFRESH' and 'NONE'.
+	MinimalAction *string `json:"minimalAction,omitempty"`
+	// ReplacementMethod is how instances are replaced.
+	// Valid values are 'SUBSTITUTE' (default), which creates instances with new names, and 'RECREATE', which keeps the instance names
+	// and requires maxSurge to be 0.
+	ReplacementMethod *string `json:"replacementMethod,omitempty"`
+	// MaxSurge is the maximum number of instances that can be created above the target size during an update,
+	// as an absolute number (for example 5) or a percentage of the target size (for example 10%).
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+	// MaxUnavailable is the maximum number of instances that can be unavailable during an update,
+	// as an absolute number (for example 5) or a percentage of the target size (for example 10%).
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// ImagePatchingSpec configures the automatic patching of the image of an instance group.
+type ImagePatchingSpec struct {
+	// Interval is how often the image is checked for a newer version. Defaults to 24h.
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// RollingUpdate replaces the instances of the instance group with a rolling update after a newer image is applied.
+	RollingUpdate *bool `json:"rollingUpdate,omitempty"`
+}
+
+// SpotPolicySpec configures Spot capacity for an instance group on GCE or Azure.
+type SpotPolicySpec struct {
+	// FallbackMachineTypes are machine types, in order of preference, that the managed instance groups may use
+	// when there is no Spot capacity for the machine type of the instance group (GCE only).
+	FallbackMachineTypes []string `json:"fallbackMachineTypes,omitempty"`
+	// TerminationAction is the action GCE takes when it preempts an instance.
+	// Valid values are 'DELETE' (default), so that the managed instance group recreates the instance, and 'STOP' (GCE only).
+	TerminationAction *string `json:"terminationAction,omitempty"`
+	// EvictionPolicy is the action Azure takes when it evicts an instance.
+	// Valid values are 'Delete' (default) and 'Deallocate' (Azure only).
+	// The maximum hourly price of the instances is set by spec.maxPrice, and defaults to the on-demand price.
+	EvictionPolicy *string `json:"evictionPolicy,omitempty"`
+}
+
 // InstanceRequirementsSpec is a list of requirements for any instance type we are willing to run in the EC2 fleet.
 type InstanceRequirementsSpec struct {
 	CPU    *MinMaxSpec `json:"cpu,omitempty"`
@@ -327,6 +424,11 @@ type InstanceRequirementsSpec struct {
 	// You can use strings with one or more wild cards, represented by an asterisk (*), to exclude an
 	// instance type, size, or generation.
 	ExcludedInstanceTypes []string `json:"excludedInstanceTypes,omitempty"`
+	// ExcludedInstanceFamilies is a list of instance families, such as "t2" or "m5a", which will not be used by the instance group.
+	ExcludedInstanceFamilies []string `json:"excludedInstanceFamilies,omitempty"`
+	// Architectures restricts the instance types to the given CPU architectures ('amd64' or 'arm64').
+	// The architecture of the image must be one of them.
+	Architectures []string `json:"architectures,omitempty"`
 }
 
 type MinMaxSpec struct {
@@ -387,6 +489,43 @@ type IAMProfileSpec struct {
 	Profile *string `json:"profile,omitempty"`
 }
 
+// FirewallRuleDirection is the direction of the traffic a firewall rule allows
+type FirewallRuleDirection string
+
+const (
+	FirewallRuleDirectionIngress FirewallRuleDirection = "Ingress"
+	FirewallRuleDirectionEgress  FirewallRuleDirection = "Egress"
+)
+
+// FirewallRuleSpec is an additional firewall rule applied to the instances of an instance group
+type FirewallRuleSpec struct {
+	// Description describes the purpose of the rule.
+	Description string `json:"description,omitempty"`
+	// Direction is the direction of the traffic the rule allows: Ingress (default) or Egress.
+	Direction FirewallRuleDirection `json:"direction,omitempty"`
+	// Protocol is the IP protocol the rule allows: tcp or udp.
+	Protocol string `json:"protocol"`
+	// FromPort is the first port of the allowed port range.
+	FromPort int32 `json:"fromPort"`
+	// ToPort is the last port of the allowed port range. Defaults to FromPort.
+	ToPort int32 `json:"toPort,omitempty"`
+	// CIDRs are the address ranges traffic is allowed from (Ingress) or to (Egress).
+	CIDRs []string `json:"cidrs"`
+}
+
+// IsEgress returns true if the rule allows outbound traffic.
+func (r *FirewallRuleSpec) IsEgress() bool {
+	return r.Direction == FirewallRuleDirectionEgress
+}
+
+// PortRange returns the first and last port allowed by the rule.
+func (r *FirewallRuleSpec) PortRange() (int32, int32) {
+	if r.ToPort == 0 {
+		return r.FromPort, r.FromPort
+	}
+	return r.FromPort, r.ToPort
+}
+
 // IsControlPlane checks if instanceGroup is a control-plane node.
 func (g *InstanceGroup) IsControlPlane() bool {
 	switch {
@@ -18,6 +18,7 @@ package model
 
 import (
 	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/featureflag"
 )
 
 // UseChallengeCallback is true if we should use a callback challenge during node provisioning with kops-controller.
@@ -59,3 +60,14 @@ func UseCiliumEtcd(cluster *kops.Cluster) bool {
 
 	return false
 }
+
+// UseKubeletServerTLSBootstrap is true if nodes request their kubelet serving certificate with a CertificateSigningRequest,
+// which kops-controller approves and signs.
+func UseKubeletServerTLSBootstrap(cluster *kops.Cluster) bool {
+	return cluster.Spec.Kubelet != nil && cluster.Spec.Kubelet.ServerTLSBootstrap != nil && *cluster.Spec.Kubelet.ServerTLSBootstrap
+}
+
+// UseInstanceGroupScaleAPI is true if kops-controller serves the API for scaling instance groups.
+func UseInstanceGroupScaleAPI(cluster *kops.Cluster) bool {
+	return featureflag.InstanceGroupScaleAPI.Enabled() && cluster.GetCloudProvider() == kops.CloudProviderAWS
+}
@@ -48,3 +48,20 @@ func FindZonesForInstanceGroup(c *kops.Cluster, ig *kops.InstanceGroup) ([]strin
 	}
 	return zones.List(), nil
 }
+
+// KubeletCredentialProviders returns the image credential providers of the kubelet,
+// which default to the credential provider of the cloud provider on AWS and GCE.
+func KubeletCredentialProviders(cloudProvider kops.CloudProviderID, providers []kops.KubeletCredentialProviderSpec) []kops.KubeletCredentialProviderSpec {
+	if len(providers) > 0 {
+		return providers
+	}
+
+	switch cloudProvider {
+	case kops.CloudProviderAWS:
+		return []kops.KubeletCredentialProviderSpec{{Name: kops.KubeletCredentialProviderECR}}
+	case kops.CloudProviderGCE:
+		return []kops.KubeletCredentialProviderSpec{{Name: kops.KubeletCredentialProviderGCP}}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kops
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"text/template"
+)
+
+// tagPolicyTemplateData is the data available to the values of required tags.
+type tagPolicyTemplateData struct {
+	ClusterName   string
+	CloudProvider string
+	CloudLabels   map[string]string
+}
+
+// RenderPolicyTags returns the values of the required tags of the tag policy that have a value, keyed by tag key.
+func (c *Cluster) RenderPolicyTags() (map[string]string, error) {
+	if c.Spec.TagPolicy == nil {
+		return nil, nil
+	}
+
+	tags := make(map[string]string)
+	for i := range c.Spec.TagPolicy.RequiredTags {
+		tag := &c.Spec.TagPolicy.RequiredTags[i]
+		if tag.Value == "" {
+			continue
+		}
+		value, err := tag.RenderValue(c)
+		if err != nil {
+			return nil, err
+		}
+		tags[tag.Key] = value
+	}
+	return tags, nil
+}
+
+// RenderValue renders the value template of the tag for the cluster.
+func (t *RequiredTagSpec) RenderValue(c *Cluster) (string, error) {
+	tmpl, err := template.New(t.Key).Option("missingkey=error").Parse(t.Value)
+	if err != nil {
+		return "", fmt.Errorf("parsing value of required tag %q: %w", t.Key, err)
+	}
+
+	data := &tagPolicyTemplateData{
+		ClusterName:   c.ObjectMeta.Name,
+		CloudProvider: string(c.GetCloudProvider()),
+		CloudLabels:   c.Spec.CloudLabels,
+	}
+	var value bytes.Buffer
+	if err := tmpl.Execute(&value, data); err != nil {
+		return "", fmt.Errorf("rendering value of required tag %q: %w", t.Key, err)
+	}
+	return value.String(), nil
+}
+
+// CheckValue returns an error if value is not a valid value for the tag,
+// given the rendered values of the required tags as returned by RenderPolicyTags.
+func (t *RequiredTagSpec) CheckValue(value string, policyTags map[string]string) error {
+	if t.Value != "" && value != policyTags[t.Key] {
+		return fmt.Errorf("tag %q is %q, expected %q", t.Key, value, policyTags[t.Key])
+	}
+	if len(t.AllowedValues) != 0 && !slices.Contains(t.AllowedValues, value) {
+		return fmt.Errorf("tag %q is %q, which is not one of the allowed values %q", t.Key, value, t.AllowedValues)
+	}
+	return nil
+}
@@ -63,7 +63,7 @@ type ClusterSpec struct {
 	LegacyCloudProvider string `json:"cloudProvider,omitempty"`
 	// GossipConfig for the cluster assuming the use of gossip DNS
 	GossipConfig *GossipConfig `json:"gossipConfig,omitempty"`
-	// ContainerRuntime was removed.
+	// ContainerRuntime is the container runtime used on nodes: containerd (default) or crio.
 	ContainerRuntime string `json:"containerRuntime,omitempty"`
 	// The version of kubernetes to install (optional, and can be a "spec" like stable)
 	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
@@ -171,6 +171,7 @@ type ClusterSpec struct {
 	Docker *DockerConfig `json:"docker,omitempty"`
 	// Component configurations
 	Containerd                     *ContainerdConfig             `json:"containerd,omitempty"`
+	CRIO                           *CRIOConfig                   `json:"crio,omitempty"`
 	KubeDNS                        *KubeDNSConfig                `json:"kubeDNS,omitempty"`
 	KubeAPIServer                  *KubeAPIServerConfig          `json:"kubeAPIServer,omitempty"`
 	KubeControllerManager          *KubeControllerManagerConfig  `json:"kubeControllerManager,omitempty"`
@@ -186,6 +187,9 @@ type ClusterSpec struct {
 	CloudConfig         *CloudConfiguration `json:"cloudConfig,omitempty"`
 	ExternalDNS         *ExternalDNSConfig  `json:"externalDns,omitempty"`
 	NTP                 *NTPConfig          `json:"ntp,omitempty"`
+	// KubeletCredentialProviders are the image credential providers of the kubelet.
+	// If not set, the credential provider of the cloud provider is used on AWS and GCE.
+	KubeletCredentialProviders []KubeletCredentialProviderSpec `json:"kubeletCredentialProviders,omitempty"`
 	// Packages specifies additional packages to be installed.
 	Packages []string `json:"packages,omitempty"`
 
@@ -194,8 +198,12 @@ type ClusterSpec struct {
 	NodeTerminationHandler *NodeTerminationHandlerSpec `json:"nodeTerminationHandler,omitempty"`
 	// NodeProblemDetector determines the node problem detector configuration.
 	NodeProblemDetector *NodeProblemDetectorConfig `json:"nodeProblemDetector,omitempty"`
+	// InterruptionHandler determines the interruption handler configuration.
+	InterruptionHandler *InterruptionHandlerConfig `json:"interruptionHandler,omitempty"`
 	// MetricsServer determines the metrics server configuration.
 	MetricsServer *MetricsServerConfig `json:"metricsServer,omitempty"`
+	// VerticalPodAutoscaler determines the vertical pod autoscaler configuration.
+	VerticalPodAutoscaler *VerticalPodAutoscalerConfig `json:"verticalPodAutoscaler,omitempty"`
 	// CertManager determines the metrics server configuration.
 	CertManager *CertManagerConfig `json:"certManager,omitempty"`
 	// AWSLoadbalancerControllerConfig determines the AWS LB controller configuration.
@@ -216,8 +224,15 @@ type ClusterSpec struct {
 	Authorization *AuthorizationSpec `json:"authorization,omitempty"`
 	// NodeAuthorization defined the custom node authorization configuration
 	NodeAuthorization *NodeAuthorizationSpec `json:"nodeAuthorization,omitempty"`
+	// PodSecurityAdmission configures the default Pod Security Standards levels and the exemptions
+	// of the PodSecurity admission plugin. The kube-system namespace is always exempt.
+	PodSecurityAdmission *PodSecurityAdmissionSpec `json:"podSecurityAdmission,omitempty"`
 	// CloudLabels defines additional tags or labels on cloud provider resources
 	CloudLabels map[string]string `json:"cloudLabels,omitempty"`
+	// TagPolicy defines tags that must be set on every cloud provider resource created for the cluster.
+	TagPolicy *TagPolicySpec `json:"tagPolicy,omitempty"`
+	// NodeIdentityLabels configures labels that kops-controller sets on nodes from the cloud metadata of their instances.
+	NodeIdentityLabels *NodeIdentityLabelsSpec `json:"nodeIdentityLabels,omitempty"`
 	// Hooks for custom actions e.g. on first installation
 	Hooks []HookSpec `json:"hooks,omitempty"`
 	// Alternative locations for files and containers
@@ -226,6 +241,9 @@ type ClusterSpec struct {
 	IAM *IAMSpec `json:"iam,omitempty"`
 	// EncryptionConfig holds the encryption config
 	EncryptionConfig *bool `json:"encryptionConfig,omitempty"`
+	// KMSEncryption encrypts data at rest with a KMS plugin that kOps runs on the control plane.
+	// It cannot be combined with encryptionConfig.
+	KMSEncryption *KMSEncryptionSpec `json:"kmsEncryption,omitempty"`
 	// DisableSubnetTags controls if subnets are tagged in AWS
 	// +k8s:conversion-gen=false
 	TagSubnets *bool `json:"DisableSubnetTags,omitempty"`
@@ -238,8 +256,22 @@ type ClusterSpec struct {
 	// specified, each parameter must follow the form variable=value, the way
 	// it would appear in sysctl.conf.
 	SysctlParameters []string `json:"sysctlParameters,omitempty"`
+	// Hardening applies a security hardening profile to the cluster's nodes and control plane components.
+	Hardening *HardeningSpec `json:"hardening,omitempty"`
+	// SELinux configures the SELinux mode of nodes running a RHEL-family distribution.
+	SELinux *SELinuxSpec `json:"selinux,omitempty"`
+	// FIPSMode restricts nodes and control plane components to FIPS 140-3 approved cryptography.
+	FIPSMode *bool `json:"fipsMode,omitempty"`
+	// TLS configures the TLS settings of the servers of kube-apiserver, kube-controller-manager, the kubelet, etcd and kops-controller.
+	// Settings configured for a component take precedence.
+	TLS *TLSSpec `json:"tls,omitempty"`
+	// KopsController configures how nodes authenticate kops-controller, and how kops-controller authenticates nodes.
+	KopsController *KopsControllerSpec `json:"kopsController,omitempty"`
 	// RollingUpdate defines the default rolling-update settings for instance groups
 	RollingUpdate *RollingUpdate `json:"rollingUpdate,omitempty"`
+	// MaintenanceWindows restrict when kops rolling-update and kops update cluster --watch may disrupt instance groups.
+	// Instance groups that no window applies to can be updated at any time.
+	MaintenanceWindows []MaintenanceWindowSpec `json:"maintenanceWindows,omitempty"`
 	// ClusterAutoscaler defines the cluster autoscaler configuration.
 	ClusterAutoscaler *ClusterAutoscalerConfig `json:"clusterAutoscaler,omitempty"`
 	// WarmPool defines the default warm pool settings for instance groups (AWS only).
@@ -254,6 +286,9 @@ type ClusterSpec struct {
 	// PodIdentityWebhook determines the EKS Pod Identity Webhook configuration.
 	// +k8s:conversion-gen=false
 	PodIdentityWebhook *PodIdentityWebhookSpec `json:"podIdentityWebhook,omitempty"`
+	// GracefulNodeDrain configures draining nodes before the autoscaling groups terminate their instances (AWS only).
+	// +k8s:conversion-gen=false
+	GracefulNodeDrain *GracefulNodeDrainSpec `json:"gracefulNodeDrain,omitempty"`
 }
 
 // PodIdentityWebhookSpec configures an EKS Pod Identity Webhook.
@@ -273,6 +308,96 @@ type KarpenterConfig struct {
 	CPURequest    *resource.Quantity `json:"cpuRequest,omitempty"`
 }
 
+// TagPolicySpec defines tags that must be set on every cloud provider resource created for the cluster.
+type TagPolicySpec struct {
+	// RequiredTags are the tags that every resource must have.
+	RequiredTags []RequiredTagSpec `json:"requiredTags,omitempty"`
+}
+
+// RequiredTagSpec defines a tag that every cloud provider resource created for the cluster must have.
+type RequiredTagSpec struct {
+	// Key is the key of the tag.
+	Key string `json:"key"`
+	// Value is the value of the tag, as a Go template which can reference .ClusterName, .CloudProvider
+	// and the cluster's cloud labels as .CloudLabels. cloudLabels cannot set tags with a value to a different value.
+	// If not set, the tag must be set in the cluster's cloudLabels, and may be overridden in the cloudLabels of instance groups.
+	Value string `json:"value,omitempty"`
+	// AllowedValues restricts the values the tag can have.
+	AllowedValues []string `json:"allowedValues,omitempty"`
+}
+
+// HardeningSpec configures security hardening of nodes and control plane components.
+type HardeningSpec struct {
+	// Profile is the hardening profile to apply. The only supported value is "cis", which applies
+	// the controls of the CIS Kubernetes Benchmark that kOps can manage.
+	Profile string `json:"profile,omitempty"`
+}
+
+// SELinuxSpec configures SELinux on nodes.
+type SELinuxSpec struct {
+	// Mode is the SELinux mode of nodes: Enforcing or Permissive.
+	// Nodes are labeled for kubelet and containerd in both modes.
+	Mode string `json:"mode,omitempty"`
+}
+
+// TLSSpec configures the TLS settings of servers.
+type TLSSpec struct {
+	// MinVersion is the minimum TLS version: VersionTLS10, VersionTLS11, VersionTLS12 or VersionTLS13.
+	MinVersion string `json:"minVersion,omitempty"`
+	// CipherSuites are the allowed TLS 1.2 cipher suites, using their Go names.
+	// TLS 1.3 cipher suites are not configurable.
+	CipherSuites []string `json:"cipherSuites,omitempty"`
+}
+
+// KopsControllerSpec configures the channel between nodes and kops-controller.
+type KopsControllerSpec struct {
+	// PinServingCA restricts nodes to kops-controller serving certificates issued by a CA whose public key
+	// is pinned in their configuration, rather than any CA in the certificate bundle.
+	PinServingCA *bool `json:"pinServingCA,omitempty"`
+	// RequireClientCertificates requires nodes that have already registered to present the client certificate
+	// issued to them by kops-controller, in addition to their cloud identity.
+	RequireClientCertificates *bool `json:"requireClientCertificates,omitempty"`
+	// TracesEndpoint is the OTLP/HTTP endpoint, such as http://otel-collector.example.com:4318, that kops-controller
+	// and nodeup export traces to, so that node bootstrap requests can be traced from nodeup to kops-controller.
+	TracesEndpoint string `json:"tracesEndpoint,omitempty"`
+	// EnforceAddons has kops-controller periodically re-apply the manifests of the installed managed addons,
+	// reverting changes made to their objects outside of kOps and recording an event for each reverted object.
+	EnforceAddons *bool `json:"enforceAddons,omitempty"`
+	// ContinuousApply has kops-controller apply the cluster and instance group specs in the state store, as
+	// kops update cluster --yes does, whenever they change and periodically to revert drift in the cloud.
+	ContinuousApply *ContinuousApplySpec `json:"continuousApply,omitempty"`
+}
+
+// ContinuousApplySpec configures kops-controller to continuously apply the cluster.
+type ContinuousApplySpec struct {
+	// Interval is how often the specs are applied even if they did not change.
+	// Default: 1h
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// MaintenanceWindowSpec defines a recurring period during which instance groups may be disrupted.
+type MaintenanceWindowSpec struct {
+	// Name identifies the maintenance window.
+	Name string `json:"name,omitempty"`
+	// Schedule is when the window opens, as a cron expression with minute, hour, day of month, month and day of week fields.
+	Schedule string `json:"schedule"`
+	// Duration is how long the window stays open.
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// TimeZone is the IANA time zone of the schedule. Defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+	// InstanceGroups are the names of the instance groups the window applies to. Defaults to all instance groups.
+	InstanceGroups []string `json:"instanceGroups,omitempty"`
+}
+
+// NodeIdentityLabelsSpec configures labels that kops-controller sets on nodes from the cloud metadata of their instances.
+type NodeIdentityLabelsSpec struct {
+	// Metadata is the list of instance metadata to label nodes with: region, zone, lifecycle and placementGroup.
+	Metadata []string `json:"metadata,omitempty"`
+	// CloudTags maps tags of instances (labels on GCE and Hetzner) to the node labels to copy their values to.
+	// Tags that are not listed are not copied.
+	CloudTags map[string]string `json:"cloudTags,omitempty"`
+}
+
 // ServiceAccountIssuerDiscoveryConfig configures an OIDC Issuer.
 type ServiceAccountIssuerDiscoveryConfig struct {
 	// DiscoveryStore is the VFS path to where OIDC Issuer Discovery metadata is stored.
@@ -283,6 +408,8 @@ type ServiceAccountIssuerDiscoveryConfig struct {
 	EnableAWSOIDCProvider bool `json:"enableAWSOIDCProvider,omitempty"`
 	// AdditionalAudiences adds user defined audiences to the provisioned AWS OIDC provider
 	AdditionalAudiences []string `json:"additionalAudiences,omitempty"`
+	// EnableGCPWorkloadIdentityFederation will provision a GCP workload identity pool that trusts the ServiceAccount Issuer
+	EnableGCPWorkloadIdentityFederation bool `json:"enableGCPWorkloadIdentityFederation,omitempty"`
 }
 
 // DiscoveryServiceOptions configures a hosted discovery service.
@@ -337,8 +464,15 @@ type NodeAuthorizerSpec struct {
 
 // AddonSpec defines an addon that we want to install in the cluster
 type AddonSpec struct {
-	// Manifest is a path to the manifest that defines the addon
+	// Manifest is a path to the manifest that defines the addon.
+	// Without a name, it is the location of a channel or manifest that kops-channels reads directly.
+	// With a name, it is a VFS location (such as a path in the state store) or an OCI artifact reference (oci://registry/repository:tag).
 	Manifest string `json:"manifest,omitempty"`
+	// Name registers the manifest as a user-defined addon. kOps reads the manifest when updating the cluster
+	// and adds it to the bootstrap channel, so that it is applied and pruned like the built-in addons.
+	Name string `json:"name,omitempty"`
+	// Version is the version of a user-defined addon. Changing it reapplies the addon even if the manifest is unchanged.
+	Version string `json:"version,omitempty"`
 }
 
 // FileAssetSpec defines the structure for a file asset
@@ -365,6 +499,21 @@ type AssetsSpec struct {
 	FileRepository *string `json:"fileRepository,omitempty"`
 	// ContainerProxy is a url for a pull-through proxy of a docker registry
 	ContainerProxy *string `json:"containerProxy,omitempty"`
+	// NodeDownloads configures how nodes download file assets, such as the Kubernetes binaries.
+	NodeDownloads *AssetDownloadsSpec `json:"nodeDownloads,omitempty"`
+}
+
+// AssetDownloadsSpec configures how nodeup downloads file assets.
+type AssetDownloadsSpec struct {
+	// Concurrency is the number of assets downloaded at the same time. Defaults to 4.
+	Concurrency *int32 `json:"concurrency,omitempty"`
+	// BandwidthLimit is the maximum total download rate of a node, in bytes per second (e.g. "20Mi").
+	// Downloads are not limited by default.
+	BandwidthLimit *resource.Quantity `json:"bandwidthLimit,omitempty"`
+	// CacheDirectory is a directory on the node, such as on a dedicated volume or preseeded in the image,
+	// that is checked for assets before downloading them. Downloaded assets are added to it.
+	// Assets are stored as <algorithm>/<hex digest>, e.g. sha256/0123...
+	CacheDirectory string `json:"cacheDirectory,omitempty"`
 }
 
 // IAMSpec adds control over the IAM security policies applied to resources
@@ -681,6 +830,20 @@ type EtcdClusterSpec struct {
 	MemoryRequest *resource.Quantity `json:"memoryRequest,omitempty"`
 	// CPURequest specifies the cpu requests of each etcd container in the cluster.
 	CPURequest *resource.Quantity `json:"cpuRequest,omitempty"`
+	// External points the control plane at an etcd cluster that is not managed by kOps.
+	// kOps does not run etcd-manager for the cluster, so it must not have members.
+	External *EtcdExternalSpec `json:"external,omitempty"`
+}
+
+// EtcdExternalSpec describes an etcd cluster that is not managed by kOps.
+type EtcdExternalSpec struct {
+	// Endpoints are the client URLs of the members of the etcd cluster, such as https://etcd-a.example.com:2379.
+	Endpoints []string `json:"endpoints,omitempty"`
+	// CACertificate is the PEM-encoded certificate of the CA that issued the serving certificates of the members.
+	// When unset, the serving certificates must be issued by the etcd-clients-ca keypair.
+	// kube-apiserver always authenticates with a client certificate issued by the etcd-clients-ca keypair,
+	// which can be imported from an external PKI with kops create keypair.
+	CACertificate string `json:"caCertificate,omitempty"`
 }
 
 // EtcdBackupSpec describes how we want to do backups of etcd
@@ -689,6 +852,18 @@ type EtcdBackupSpec struct {
 	BackupStore string `json:"backupStore,omitempty"`
 	// Image is the etcd backup manager image to use.  Setting this will create a sidecar container in the etcd pod with the specified image.
 	Image string `json:"image,omitempty"`
+	// S3 configures access to an S3-compatible backupStore that is not accessible with the credentials
+	// of the control plane nodes, such as a bucket in a different account or provider.
+	// The credentials are read from the etcdbackupcredentials secret.
+	S3 *EtcdBackupS3Spec `json:"s3,omitempty"`
+}
+
+// EtcdBackupS3Spec configures the S3-compatible service holding the etcd backups
+type EtcdBackupS3Spec struct {
+	// Endpoint is the URL of the S3 service, for example https://s3.eu-west-1.amazonaws.com.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Region is the region of the bucket.
+	Region string `json:"region,omitempty"`
 }
 
 // EtcdManagerSpec describes how we configure the etcd manager
@@ -713,6 +888,15 @@ type EtcdManagerSpec struct {
 	// LogLevel allows the klog library verbose log level to be set for etcd-manager. The default is 6.
 	// https://github.com/google/glog#verbose-logging
 	LogLevel *int32 `json:"logLevel,omitempty"`
+	// QuotaBackendBytes is the size limit of the etcd database. The etcd default is 2Gi.
+	QuotaBackendBytes *resource.Quantity `json:"quotaBackendBytes,omitempty"`
+	// AutoCompactionMode is the mode of the automatic compaction of the etcd history, either "periodic" or "revision".
+	AutoCompactionMode string `json:"autoCompactionMode,omitempty"`
+	// AutoCompactionRetention is the history kept by automatic compaction: a duration such as "1h" in periodic mode,
+	// or a number of revisions in revision mode.
+	AutoCompactionRetention string `json:"autoCompactionRetention,omitempty"`
+	// DefragInterval is the interval at which etcd-manager defragments the etcd database. Defragmentation is disabled by default.
+	DefragInterval *metav1.Duration `json:"defragInterval,omitempty"`
 }
 
 // EtcdMemberSpec is a specification for a etcd member
@@ -770,6 +954,13 @@ type ClusterSubnetSpec struct {
 
 	// AdditionalRoutes to attach to the subnet's route table
 	AdditionalRoutes []RouteSpec `json:"additionalRoutes,omitempty"`
+
+	// PodRangeName is the name of an existing secondary range of the subnet to use for pods,
+	// instead of creating one. Only supported on GCE with IP alias networking and an existing subnet.
+	PodRangeName string `json:"podRangeName,omitempty"`
+	// ServiceRangeName is the name of an existing secondary range of the subnet to use for services,
+	// instead of creating one. Only supported on GCE with IP alias networking and an existing subnet.
+	ServiceRangeName string `json:"serviceRangeName,omitempty"`
 }
 
 type RouteSpec struct {
@@ -793,6 +984,22 @@ type HTTPProxy struct {
 	// Password string `json:"password,omitempty"`
 }
 
+// KMSEncryptionSpec configures encryption at rest of Kubernetes resources through a KMS plugin.
+type KMSEncryptionSpec struct {
+	// Provider is the KMS plugin to run: aws (aws-encryption-provider) or gcp (k8s-cloudkms-plugin).
+	Provider string `json:"provider,omitempty"`
+	// KeyID identifies the key that encrypts new data:
+	// a KMS key ARN on AWS, or projects/*/locations/*/keyRings/*/cryptoKeys/* on GCP.
+	KeyID string `json:"keyID,omitempty"`
+	// PreviousKeyIDs are keys that are still used to decrypt data written before keyID was changed.
+	// They can be removed once `kops rotate encryption-key` has rewritten the data.
+	PreviousKeyIDs []string `json:"previousKeyIDs,omitempty"`
+	// Image is the image of the KMS plugin, which must implement the KMS v2 API.
+	Image string `json:"image,omitempty"`
+	// Resources are the resources that are encrypted. Defaults to secrets.
+	Resources []string `json:"resources,omitempty"`
+}
+
 // TargetSpec allows for specifying target config in an extensible way
 type TargetSpec struct {
 	Terraform *TerraformSpec `json:"terraform,omitempty"`
@@ -901,6 +1108,36 @@ type PackagesConfig struct {
 	UrlArm64 *string `json:"urlArm64,omitempty"`
 }
 
+// KubeletCredentialProviderSpec configures an image credential provider of the kubelet.
+type KubeletCredentialProviderSpec struct {
+	// Name is the name of the provider, which is also the name of its binary on the nodes.
+	// kOps knows the defaults of ecr-credential-provider, gcp-credential-provider and acr-credential-provider.
+	Name string `json:"name"`
+	// MatchImages are the images the provider supplies credentials for, in the format of the kubelet CredentialProviderConfig.
+	// Defaults to the registries of the cloud for the well-known providers.
+	MatchImages []string `json:"matchImages,omitempty"`
+	// DefaultCacheDuration is how long the credentials are cached when the provider does not set a duration.
+	DefaultCacheDuration *metav1.Duration `json:"defaultCacheDuration,omitempty"`
+	// Args are the arguments passed to the provider.
+	Args []string `json:"args,omitempty"`
+	// Env are the environment variables set for the provider.
+	Env []EnvVar `json:"env,omitempty"`
+	// Packages sets the URLs and hashes of the provider binaries.
+	// They are required for the providers whose binaries are not known to kOps, including acr-credential-provider.
+	Packages *PackagesConfig `json:"packages,omitempty"`
+}
+
+// GracefulNodeDrainSpec configures draining nodes before the autoscaling groups terminate their instances.
+type GracefulNodeDrainSpec struct {
+	// Enabled adds a lifecycle hook to the autoscaling groups of nodes, which kops-controller completes
+	// after cordoning and draining the node of the terminating instance.
+	// Default: false
+	Enabled *bool `json:"enabled,omitempty"`
+	// DrainTimeout is the maximum time to wait for the node to drain before the instance is terminated anyway.
+	// Default: 5m
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
+}
+
 type WarmPoolSpec struct {
 	// MinSize is the minimum size of the pool
 	MinSize int64 `json:"minSize,omitempty"`
@@ -916,4 +1153,9 @@ type WarmPoolSpec struct {
 	LifecycleHookTimeout *int32 `json:"lifecycleHookTimeout,omitempty"`
 	// AdditionalImages is a list of additional container images to pull into the warm pool instances.
 	AdditionalImages []string `json:"additionalImages,omitempty"`
+	// PoolState is the state instances are kept in while in the warm pool: Stopped, Running or Hibernated.
+	// The default is Stopped. Hibernated requires an encrypted root volume and an instance type that supports hibernation.
+	PoolState string `json:"poolState,omitempty"`
+	// ReuseOnScaleIn returns instances to the warm pool on scale in, instead of terminating them.
+	ReuseOnScaleIn bool `json:"reuseOnScaleIn,omitempty"`
 }
@@ -218,6 +218,9 @@ type KubeletConfigSpec struct {
 	TopologyManagerPolicy string `json:"topologyManagerPolicy,omitempty" flag:"topology-manager-policy"`
 	// rotateCertificates enables client certificate rotation.
 	RotateCertificates *bool `json:"rotateCertificates,omitempty" flag:"rotate-certificates"`
+	// ServerTLSBootstrap enables requesting the kubelet serving certificate with a CertificateSigningRequest, which kops-controller approves and signs.
+	// Only applies to nodes that bootstrap through kops-controller.
+	ServerTLSBootstrap *bool `json:"serverTLSBootstrap,omitempty" flag:"-"`
 	// Default kubelet behaviour for kernel tuning. If set, kubelet errors if any of kernel tunables is different than kubelet defaults.
 	// DEPRECATED: This parameter should be set via the config file specified by the Kubelet's --config flag.
 	ProtectKernelDefaults *bool `json:"protectKernelDefaults,omitempty" flag:"protect-kernel-defaults"`
@@ -348,6 +351,9 @@ type KubeAPIServerConfig struct {
 	DisableAdmissionPlugins []string `json:"disableAdmissionPlugins,omitempty" flag:"disable-admission-plugins"`
 	// AdmissionControlConfigFile is the location of the admission-control-config-file
 	AdmissionControlConfigFile string `json:"admissionControlConfigFile,omitempty" flag:"admission-control-config-file"`
+	// AdmissionConfig configures admission plugins. kOps writes the admission configuration to the control plane,
+	// passes it to kube-apiserver and enables the configured plugins. It cannot be combined with admissionControlConfigFile.
+	AdmissionConfig *AdmissionConfigSpec `json:"admissionConfig,omitempty" flag:"-"`
 	// ServiceClusterIPRange is the service address range
 	ServiceClusterIPRange string `json:"serviceClusterIPRange,omitempty" flag:"service-cluster-ip-range"`
 	// Passed as --service-node-port-range to kube-apiserver. Expects 'startPort-endPort' format e.g. 30000-33000
@@ -466,6 +472,12 @@ type KubeAPIServerConfig struct {
 	AuditWebhookInitialBackoff *metav1.Duration `json:"auditWebhookInitialBackoff,omitempty" flag:"audit-webhook-initial-backoff"`
 	// AuditWebhookMode is Strategy for sending audit events. Blocking indicates sending events should block server responses. Batch causes the backend to buffer and write events asynchronously. Known modes are batch,blocking. (default "batch")
 	AuditWebhookMode string `json:"auditWebhookMode,omitempty" flag:"audit-webhook-mode"`
+	// AuditPolicy is an audit policy that kOps writes to the control plane and passes to kube-apiserver.
+	// It cannot be combined with auditPolicyFile.
+	AuditPolicy *AuditPolicySpec `json:"auditPolicy,omitempty" flag:"-"`
+	// AuditWebhook configures an audit webhook backend whose configuration is managed by kOps.
+	// It cannot be combined with auditWebhookConfigFile.
+	AuditWebhook *AuditWebhookSpec `json:"auditWebhook,omitempty" flag:"-"`
 	// File with webhook configuration for token authentication in kubeconfig format. The API server will query the remote service to determine authentication for bearer tokens.
 	AuthenticationTokenWebhookConfigFile *string `json:"authenticationTokenWebhookConfigFile,omitempty" flag:"authentication-token-webhook-config-file"`
 	// The duration to cache responses from the webhook token authenticator. Default is 2m. (default 2m0s)
@@ -480,6 +492,12 @@ type KubeAPIServerConfig struct {
 	AuthorizationWebhookCacheUnauthorizedTTL *metav1.Duration `json:"authorizationWebhookCacheUnauthorizedTtl,omitempty" flag:"authorization-webhook-cache-unauthorized-ttl"`
 	// AuthorizationRBACSuperUser is the name of the superuser for default rbac
 	AuthorizationRBACSuperUser *string `json:"authorizationRbacSuperUser,omitempty" flag:"authorization-rbac-super-user"`
+	// AuthorizationConfigFile is the location of the authorization-config file.
+	// It cannot be combined with authorizationMode or the authorizationWebhook options.
+	AuthorizationConfigFile string `json:"authorizationConfigFile,omitempty" flag:"authorization-config"`
+	// AuthorizationConfig is a structured authorization configuration that kOps writes to the control plane
+	// and passes to kube-apiserver in place of authorizationMode. It cannot be combined with authorizationConfigFile.
+	AuthorizationConfig *AuthorizationConfigSpec `json:"authorizationConfig,omitempty" flag:"-"`
 	// EncryptionProviderConfig enables encryption at rest for secrets.
 	EncryptionProviderConfig *string `json:"encryptionProviderConfig,omitempty" flag:"encryption-provider-config"`
 	// ExperimentalEncryptionProviderConfig enables encryption at rest for secrets.
@@ -596,6 +614,194 @@ type KubeAPIServerConfig struct {
 	Env []corev1.EnvVar `json:"env,omitempty"`
 }
 
+// AuditPolicySpec is an audit policy, mirroring the audit.k8s.io/v1 Policy.
+type AuditPolicySpec struct {
+	// Rules specify the audit level a request should be recorded at. A request may match
+	// multiple rules, in which case the first matching rule is used.
+	Rules []AuditPolicyRule `json:"rules,omitempty"`
+	// OmitStages is a list of stages for which no events are created.
+	OmitStages []string `json:"omitStages,omitempty"`
+	// OmitManagedFields indicates whether to omit the managed fields of the request
+	// and response bodies from being written to the API audit log.
+	OmitManagedFields *bool `json:"omitManagedFields,omitempty"`
+}
+
+// AuditPolicyRule maps requests based off metadata to an audit level.
+type AuditPolicyRule struct {
+	// Level that requests matching this rule are recorded at: None, Metadata, Request or RequestResponse.
+	Level string `json:"level"`
+	// Users that this rule applies to. An empty list implies every user.
+	Users []string `json:"users,omitempty"`
+	// UserGroups that this rule applies to. A user is considered matching if it is a member of any of the UserGroups.
+	UserGroups []string `json:"userGroups,omitempty"`
+	// Verbs that this rule applies to. An empty list implies every verb.
+	Verbs []string `json:"verbs,omitempty"`
+	// Resources that this rule matches. An empty list implies all kinds in all API groups.
+	Resources []AuditGroupResources `json:"resources,omitempty"`
+	// Namespaces that this rule matches. The empty string "" matches non-namespaced resources.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// NonResourceURLs is a set of URL paths that should be audited.
+	// A trailing * is allowed as a wildcard, e.g. /healthz*.
+	NonResourceURLs []string `json:"nonResourceURLs,omitempty"`
+	// OmitStages is a list of stages for which no events are created, in addition to the policy's OmitStages.
+	OmitStages []string `json:"omitStages,omitempty"`
+	// OmitManagedFields overrides the policy's OmitManagedFields for requests matching this rule.
+	OmitManagedFields *bool `json:"omitManagedFields,omitempty"`
+}
+
+// AuditGroupResources represents resource kinds in an API group.
+type AuditGroupResources struct {
+	// Group is the name of the API group that contains the resources. The empty string represents the core API group.
+	Group string `json:"group,omitempty"`
+	// Resources is a list of resources this rule applies to, e.g. pods or pods/log.
+	Resources []string `json:"resources,omitempty"`
+	// ResourceNames is a list of resource instance names that the policy matches.
+	ResourceNames []string `json:"resourceNames,omitempty"`
+}
+
+// AuditWebhookSpec configures the audit webhook backend of kube-apiserver.
+type AuditWebhookSpec struct {
+	// Server is the URL of the remote service receiving audit events.
+	Server string `json:"server,omitempty"`
+	// CACertificate is a PEM encoded CA bundle used to verify the server certificate.
+	// If not set, the system trust store is used.
+	CACertificate string `json:"caCertificate,omitempty"`
+	// UseBearerToken sends the token stored in the auditwebhooktoken secret with each request.
+	// See `kops create secret auditwebhooktoken`.
+	UseBearerToken bool `json:"useBearerToken,omitempty"`
+}
+
+// AuthorizationConfigSpec is a structured authorization configuration, mirroring the
+// apiserver.config.k8s.io/v1 AuthorizationConfiguration.
+type AuthorizationConfigSpec struct {
+	// Authorizers are consulted in order. The first authorizer that allows or denies
+	// a request decides it, and a request that no authorizer decides is denied.
+	Authorizers []AuthorizerSpec `json:"authorizers,omitempty"`
+}
+
+// AuthorizerSpec configures an authorizer of kube-apiserver.
+type AuthorizerSpec struct {
+	// Type is the type of the authorizer: Node, RBAC, Webhook, AlwaysAllow or AlwaysDeny.
+	Type string `json:"type"`
+	// Name identifies the authorizer in logs and metrics, and must be unique.
+	// Defaults to the type in lowercase.
+	Name string `json:"name,omitempty"`
+	// Webhook configures the authorizer when type is Webhook.
+	Webhook *WebhookAuthorizerSpec `json:"webhook,omitempty"`
+}
+
+// WebhookAuthorizerSpec configures an authorizer that sends SubjectAccessReviews to a remote service.
+type WebhookAuthorizerSpec struct {
+	// Server is the URL of the remote service. kOps writes a kubeconfig for it on the control plane.
+	Server string `json:"server,omitempty"`
+	// CACertificate is a PEM encoded CA bundle used to verify the server certificate.
+	// If not set, the system trust store is used.
+	CACertificate string `json:"caCertificate,omitempty"`
+	// KubeConfigFile is the path on the control plane nodes of a kubeconfig for the remote service,
+	// for example one written with fileAssets. It cannot be combined with server.
+	KubeConfigFile string `json:"kubeConfigFile,omitempty"`
+	// Timeout is the time to wait for a response from the remote service, between 1s and 30s. Defaults to 3s.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// AuthorizedTTL is the duration to cache allowed responses. Defaults to 5m.
+	AuthorizedTTL *metav1.Duration `json:"authorizedTTL,omitempty"`
+	// UnauthorizedTTL is the duration to cache denied responses. Defaults to 30s.
+	UnauthorizedTTL *metav1.Duration `json:"unauthorizedTTL,omitempty"`
+	// FailurePolicy is the decision when the remote service fails: NoOpinion or Deny. Defaults to NoOpinion.
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+	// MatchConditions are CEL expressions on the SubjectAccessReview that must all be true
+	// for the request to be sent to the remote service.
+	MatchConditions []AuthorizerMatchCondition `json:"matchConditions,omitempty"`
+}
+
+// AuthorizerMatchCondition is a condition for sending a request to a webhook authorizer.
+type AuthorizerMatchCondition struct {
+	// Expression is a CEL expression that evaluates to a bool.
+	Expression string `json:"expression"`
+}
+
+// AdmissionConfigSpec configures admission plugins, which kOps renders to an
+// apiserver.config.k8s.io/v1 AdmissionConfiguration.
+type AdmissionConfigSpec struct {
+	// EventRateLimit configures the EventRateLimit plugin, which limits the rate at which events are written.
+	EventRateLimit *EventRateLimitAdmissionSpec `json:"eventRateLimit,omitempty"`
+	// PodSecurity configures the defaults and exemptions of the PodSecurity plugin.
+	PodSecurity *PodSecurityAdmissionSpec `json:"podSecurity,omitempty"`
+	// ImagePolicyWebhook configures the ImagePolicyWebhook plugin, which asks a remote service whether the images of a pod are allowed.
+	ImagePolicyWebhook *ImagePolicyWebhookAdmissionSpec `json:"imagePolicyWebhook,omitempty"`
+}
+
+// EventRateLimitAdmissionSpec configures the EventRateLimit admission plugin.
+type EventRateLimitAdmissionSpec struct {
+	// Limits are the limits on the rate of events. An event must be allowed by every limit.
+	Limits []EventRateLimit `json:"limits,omitempty"`
+}
+
+// EventRateLimit is a limit on the rate of events, enforced with a token bucket.
+type EventRateLimit struct {
+	// Type is the scope of the limit: Server, Namespace, User or SourceAndObject.
+	Type string `json:"type"`
+	// QPS is the number of events per second allowed.
+	QPS int32 `json:"qps"`
+	// Burst is the number of events allowed in a burst.
+	Burst int32 `json:"burst"`
+	// CacheSize is the number of namespaces, users or sources and objects that are tracked.
+	// It is not used for the Server type. Defaults to 4096.
+	CacheSize int32 `json:"cacheSize,omitempty"`
+}
+
+// PodSecurityAdmissionSpec configures the PodSecurity admission plugin.
+type PodSecurityAdmissionSpec struct {
+	// Defaults are the levels applied to namespaces that don't set them with labels.
+	Defaults PodSecurityDefaults `json:"defaults,omitempty"`
+	// Exemptions are the requests that are not checked.
+	Exemptions PodSecurityExemptions `json:"exemptions,omitempty"`
+}
+
+// PodSecurityDefaults are the default Pod Security Standards levels and versions.
+// Levels are privileged, baseline or restricted and default to privileged.
+// Versions are latest or a Kubernetes minor version such as v1.33, and default to latest.
+type PodSecurityDefaults struct {
+	// Enforce is the level at which violations reject the pod.
+	Enforce string `json:"enforce,omitempty"`
+	// EnforceVersion is the version of the enforce level.
+	EnforceVersion string `json:"enforceVersion,omitempty"`
+	// Audit is the level at which violations are recorded in the audit log.
+	Audit string `json:"audit,omitempty"`
+	// AuditVersion is the version of the audit level.
+	AuditVersion string `json:"auditVersion,omitempty"`
+	// Warn is the level at which violations are returned as warnings.
+	Warn string `json:"warn,omitempty"`
+	// WarnVersion is the version of the warn level.
+	WarnVersion string `json:"warnVersion,omitempty"`
+}
+
+// PodSecurityExemptions are the requests that the PodSecurity plugin does not check.
+type PodSecurityExemptions struct {
+	// Usernames are the users whose requests are exempt.
+	Usernames []string `json:"usernames,omitempty"`
+	// RuntimeClasses are the runtime class names of exempt pods.
+	RuntimeClasses []string `json:"runtimeClasses,omitempty"`
+	// Namespaces are the exempt namespaces.
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// ImagePolicyWebhookAdmissionSpec configures the ImagePolicyWebhook admission plugin.
+type ImagePolicyWebhookAdmissionSpec struct {
+	// Server is the URL of the remote service, which receives ImageReview requests. kOps writes a kubeconfig for it on the control plane.
+	Server string `json:"server,omitempty"`
+	// CACertificate is a PEM encoded CA bundle used to verify the server certificate.
+	// If not set, the system trust store is used.
+	CACertificate string `json:"caCertificate,omitempty"`
+	// AllowTTL is the duration to cache allowed responses. Defaults to 5m.
+	AllowTTL *metav1.Duration `json:"allowTTL,omitempty"`
+	// DenyTTL is the duration to cache denied responses. Defaults to 30s.
+	DenyTTL *metav1.Duration `json:"denyTTL,omitempty"`
+	// RetryBackoff is the time to wait before retrying a failed request. Defaults to 500ms.
+	RetryBackoff *metav1.Duration `json:"retryBackoff,omitempty"`
+	// DefaultAllow admits pods when the remote service can't be reached.
+	DefaultAllow bool `json:"defaultAllow,omitempty"`
+}
+
 // KubeControllerManagerConfig is the configuration for the controller
 type KubeControllerManagerConfig struct {
 	// Master is the url for the kube api master
@@ -795,6 +1001,10 @@ type CloudControllerManagerConfig struct {
 	ConcurrentNodeSyncs *int32 `json:"concurrentNodeSyncs,omitempty" flag:"concurrent-node-syncs"`
 	// AzureNodeManagerImage is the OCI image of the Azure cloud node manager.
 	AzureNodeManagerImage string `json:"azureNodeManagerImage,omitempty"`
+	// UseServiceAccountExternalPermissions gives the cloud controller manager its own cloud credentials,
+	// bound to its ServiceAccount, rather than the credentials of the control plane instances.
+	// This is implied by spec.iam.useServiceAccountExternalPermissions.
+	UseServiceAccountExternalPermissions *bool `json:"useServiceAccountExternalPermissions,omitempty"`
 }
 
 // KubeSchedulerConfig is the configuration for the kube-scheduler
@@ -895,6 +1105,9 @@ type OpenstackLoadbalancerConfig struct {
 	EnableIngressHostname *bool   `json:"enableIngressHostname,omitempty"`
 	IngressHostnameSuffix *string `json:"ingressHostnameSuffix,omitempty"`
 	FlavorID              *string `json:"flavorID,omitempty"`
+	// APIAllowedCIDRs restricts the listener of the Kubernetes API load balancer to the CIDRs.
+	// Defaults to spec.api.access.
+	APIAllowedCIDRs []string `json:"apiAllowedCIDRs,omitempty"`
 }
 
 type OpenstackBlockStorageConfig struct {
@@ -952,6 +1165,23 @@ type OpenstackSpec struct {
 	Metadata           *OpenstackMetadata           `json:"metadata,omitempty"`
 }
 
+// DOSpec configures the Digital Ocean cloud provider.
+type DOSpec struct {
+	// VPCNativeRouting routes the pod CIDRs of the nodes natively in the VPC, instead of through an overlay network.
+	// Requires Cilium networking.
+	VPCNativeRouting bool `json:"vpcNativeRouting,omitempty"`
+	// APILoadBalancer configures the load balancer of the API.
+	APILoadBalancer *DOLoadBalancerSpec `json:"apiLoadBalancer,omitempty"`
+}
+
+// DOLoadBalancerSpec configures a DigitalOcean load balancer.
+type DOLoadBalancerSpec struct {
+	// SizeUnit is the number of nodes of the load balancer, from 1 to 100.
+	SizeUnit *int32 `json:"sizeUnit,omitempty"`
+	// Algorithm is the load balancing algorithm: round_robin (the default) or least_connections.
+	Algorithm string `json:"algorithm,omitempty"`
+}
+
 // AzureSpec defines Azure specific cluster configuration.
 type AzureSpec struct {
 	// SubscriptionID specifies the subscription used for the cluster installation.
@@ -972,6 +1202,30 @@ type AzureSpec struct {
 	RouteTableName string `json:"routeTableName,omitempty"`
 	// AdminUser specifies the admin user of VMs.
 	AdminUser string `json:"adminUser,omitempty"`
+	// PrivateAPI exposes an internal API load balancer through a private DNS zone
+	// and, optionally, an Azure Private Link service.
+	PrivateAPI *AzurePrivateAPISpec `json:"privateAPI,omitempty"`
+}
+
+// AzurePrivateAPISpec configures private access to the API server on Azure.
+type AzurePrivateAPISpec struct {
+	// PrivateDNSZoneID is the resource ID of an existing private DNS zone.
+	// kOps creates an A record for spec.api.publicName, which must be a name in the zone,
+	// pointing at the internal API load balancer, and links the zone to the cluster virtual network.
+	PrivateDNSZoneID string `json:"privateDNSZoneID,omitempty"`
+	// LinkedVirtualNetworkIDs are the resource IDs of additional virtual networks linked to the
+	// private DNS zone, such as a jump network peered with the cluster virtual network.
+	LinkedVirtualNetworkIDs []string `json:"linkedVirtualNetworkIDs,omitempty"`
+	// PrivateLinkService exposes the API load balancer as a Private Link service, so that
+	// private endpoints can reach the API server from networks that are not peered with the cluster.
+	PrivateLinkService *AzurePrivateLinkServiceSpec `json:"privateLinkService,omitempty"`
+}
+
+// AzurePrivateLinkServiceSpec configures the Private Link service in front of the API load balancer.
+type AzurePrivateLinkServiceSpec struct {
+	// AllowedSubscriptionIDs are the subscriptions that can find the Private Link service and whose
+	// private endpoint connections are approved automatically.
+	AllowedSubscriptionIDs []string `json:"allowedSubscriptionIDs,omitempty"`
 }
 
 // CloudConfiguration defines the cloud provider configuration
@@ -1043,6 +1297,9 @@ type CloudConfiguration struct {
 	// Azure cloud-config options
 	// +k8s:conversion-gen=false
 	Azure *AzureSpec `json:"azure,omitempty"`
+	// DO cloud-config options
+	// +k8s:conversion-gen=false
+	DO *DOSpec `json:"do,omitempty"`
 	// AWSEBSCSIDriver is the config for the AWS EBS CSI driver
 	// +k8s:conversion-gen=false
 	AWSEBSCSIDriver *EBSCSIDriverSpec `json:"awsEBSCSIDriver,omitempty"`
@@ -1199,6 +1456,32 @@ type NodeProblemDetectorConfig struct {
 	CPULimit *resource.Quantity `json:"cpuLimit,omitempty"`
 }
 
+// InterruptionHandlerConfig determines the interruption handler configuration.
+// The interruption handler watches the instance metadata of each node for interruption notices
+// and maintenance events, and cordons and drains the node before the instance goes away.
+type InterruptionHandlerConfig struct {
+	// Enabled enables the interruption handler.
+	// Default: false
+	Enabled *bool `json:"enabled,omitempty"`
+	// DrainMaintenanceEvents makes the interruption handler also drain nodes for scheduled maintenance events
+	// that reboot, stop or terminate the instance.
+	// Default: true
+	DrainMaintenanceEvents *bool `json:"drainMaintenanceEvents,omitempty"`
+	// DrainTimeout is the maximum time to wait for a node to drain.
+	// Default: 90s
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
+	// EnablePrometheusMetrics enables the "/metrics" endpoint.
+	// Default: false
+	EnablePrometheusMetrics *bool `json:"enablePrometheusMetrics,omitempty"`
+
+	// MemoryRequest of the interruption handler container.
+	// Default: 64Mi
+	MemoryRequest *resource.Quantity `json:"memoryRequest,omitempty"`
+	// CPURequest of the interruption handler container.
+	// Default: 10m
+	CPURequest *resource.Quantity `json:"cpuRequest,omitempty"`
+}
+
 // ClusterAutoscalerConfig determines the cluster autoscaler configuration.
 type ClusterAutoscalerConfig struct {
 	// Enabled enables the cluster autoscaler.
@@ -1269,6 +1552,10 @@ type ClusterAutoscalerConfig struct {
 	// CustomPriorityExpanderConfig overides the priority-expander ConfigMap with the provided configuration. Any InstanceGroup configuration will be ignored if this is set.
 	// This could be useful in order to use regex on priorities configuration
 	CustomPriorityExpanderConfig map[string][]string `json:"customPriorityExpanderConfig,omitempty"`
+	// NodeGroupAutoDiscovery makes the cluster autoscaler discover the instance groups from their cloud tags,
+	// instead of passing it the list of instance groups as arguments (AWS only).
+	// Default: false
+	NodeGroupAutoDiscovery *bool `json:"nodeGroupAutoDiscovery,omitempty"`
 }
 
 // MetricsServerConfig determines the metrics server configuration.
@@ -1279,11 +1566,24 @@ type MetricsServerConfig struct {
 	// Image is the container image used.
 	// Default: the latest supported image for the specified kubernetes version.
 	Image *string `json:"image,omitempty"`
+	// Version is the version of metrics server to deploy, when image is not set.
+	// Default: the version bundled with kOps.
+	Version *string `json:"version,omitempty"`
 	// Insecure determines if API server will validate metrics server TLS cert.
 	// Default: true
 	Insecure *bool `json:"insecure,omitempty"`
 }
 
+// VerticalPodAutoscalerConfig determines the vertical pod autoscaler configuration.
+type VerticalPodAutoscalerConfig struct {
+	// Enabled enables the vertical pod autoscaler.
+	// Default: false
+	Enabled *bool `json:"enabled,omitempty"`
+	// Version is the version of the vertical pod autoscaler components to deploy.
+	// Default: the version bundled with kOps.
+	Version *string `json:"version,omitempty"`
+}
+
 // CertManagerConfig determines the cert manager configuration.
 type CertManagerConfig struct {
 	// Enabled enables the cert manager.
@@ -38,6 +38,8 @@ type ContainerdConfig struct {
 	Packages *PackagesConfig `json:"packages,omitempty"`
 	// RegistryMirrors is list of image registries
 	RegistryMirrors map[string][]string `json:"registryMirrors,omitempty"`
+	// Registries configures TLS and authentication for image registries and registry mirrors, keyed by host (and optional port).
+	Registries map[string]ContainerdRegistryConfig `json:"registries,omitempty"`
 	// Root directory for persistent data (default "/var/lib/containerd").
 	Root *string `json:"root,omitempty" flag:"root"`
 	// SkipInstall prevents kOps from installing and modifying containerd in any way (default "false").
@@ -66,6 +68,17 @@ type ContainerdConfig struct {
 	SandboxImage *string `json:"sandboxImage,omitempty"`
 }
 
+// ContainerdRegistryConfig configures how containerd connects to an image registry.
+type ContainerdRegistryConfig struct {
+	// CA is the PEM-encoded certificate bundle used to verify the registry's serving certificate.
+	CA string `json:"ca,omitempty"`
+	// SkipVerify disables verification of the registry's serving certificate.
+	SkipVerify bool `json:"skipVerify,omitempty"`
+	// UseDockerConfigAuth sends the credentials stored for the registry in the dockerconfig secret
+	// with every request to the registry.
+	UseDockerConfigAuth bool `json:"useDockerConfigAuth,omitempty"`
+}
+
 type NRIConfig struct {
 	// Enable NRI support in containerd
 	Enabled *bool `json:"enabled,omitempty"`
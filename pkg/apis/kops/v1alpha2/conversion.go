@@ -156,6 +156,11 @@ func Convert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *kops
 		}
 	case kops.CloudProviderDO:
 		out.CloudProvider.DO = &kops.DOSpec{}
+		if in.CloudConfig != nil && in.CloudConfig.DO != nil {
+			if err := autoConvert_v1alpha2_DOSpec_To_kops_DOSpec(in.CloudConfig.DO, out.CloudProvider.DO, s); err != nil {
+				return err
+			}
+		}
 	case kops.CloudProviderGCE:
 		out.CloudProvider.GCE = &kops.GCESpec{
 			Project: in.Project,
@@ -313,6 +318,15 @@ func Convert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *kops
 			return err
 		}
 	}
+	if in.GracefulNodeDrain != nil {
+		if out.CloudProvider.AWS == nil {
+			return field.Forbidden(field.NewPath("spec", "gracefulNodeDrain"), "graceful node drain supports only AWS")
+		}
+		out.CloudProvider.AWS.GracefulNodeDrain = &kops.GracefulNodeDrainSpec{}
+		if err := autoConvert_v1alpha2_GracefulNodeDrainSpec_To_kops_GracefulNodeDrainSpec(in.GracefulNodeDrain, out.CloudProvider.AWS.GracefulNodeDrain, s); err != nil {
+			return err
+		}
+	}
 	for i, hook := range in.Hooks {
 		if hook.Enabled != nil {
 			out.Hooks[i].Enabled = values.Bool(!*hook.Enabled)
@@ -530,6 +544,12 @@ func Convert_kops_ClusterSpec_To_v1alpha2_ClusterSpec(in *kops.ClusterSpec, out
 				return err
 			}
 		}
+		if aws.GracefulNodeDrain != nil {
+			out.GracefulNodeDrain = &GracefulNodeDrainSpec{}
+			if err := autoConvert_kops_GracefulNodeDrainSpec_To_v1alpha2_GracefulNodeDrainSpec(aws.GracefulNodeDrain, out.GracefulNodeDrain, s); err != nil {
+				return err
+			}
+		}
 	case kops.CloudProviderAzure:
 		if out.CloudConfig == nil {
 			out.CloudConfig = &CloudConfiguration{}
@@ -540,6 +560,17 @@ func Convert_kops_ClusterSpec_To_v1alpha2_ClusterSpec(in *kops.ClusterSpec, out
 		if err := autoConvert_kops_AzureSpec_To_v1alpha2_AzureSpec(in.CloudProvider.Azure, out.CloudConfig.Azure, s); err != nil {
 			return err
 		}
+	case kops.CloudProviderDO:
+		do := in.CloudProvider.DO
+		if do.VPCNativeRouting || do.APILoadBalancer != nil {
+			if out.CloudConfig == nil {
+				out.CloudConfig = &CloudConfiguration{}
+			}
+			out.CloudConfig.DO = &DOSpec{}
+			if err := autoConvert_kops_DOSpec_To_v1alpha2_DOSpec(do, out.CloudConfig.DO, s); err != nil {
+				return err
+			}
+		}
 	case kops.CloudProviderGCE:
 		gce := in.CloudProvider.GCE
 		out.Project = gce.Project
@@ -104,6 +104,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AdmissionConfigSpec)(nil), (*kops.AdmissionConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AdmissionConfigSpec_To_kops_AdmissionConfigSpec(a.(*AdmissionConfigSpec), b.(*kops.AdmissionConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AdmissionConfigSpec)(nil), (*AdmissionConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AdmissionConfigSpec_To_v1alpha2_AdmissionConfigSpec(a.(*kops.AdmissionConfigSpec), b.(*AdmissionConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AlwaysAllowAuthorizationSpec)(nil), (*kops.AlwaysAllowAuthorizationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(a.(*AlwaysAllowAuthorizationSpec), b.(*kops.AlwaysAllowAuthorizationSpec), scope)
 	}); err != nil {
@@ -124,6 +134,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AssetDownloadsSpec)(nil), (*kops.AssetDownloadsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AssetDownloadsSpec_To_kops_AssetDownloadsSpec(a.(*AssetDownloadsSpec), b.(*kops.AssetDownloadsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AssetDownloadsSpec)(nil), (*AssetDownloadsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AssetDownloadsSpec_To_v1alpha2_AssetDownloadsSpec(a.(*kops.AssetDownloadsSpec), b.(*AssetDownloadsSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AssetsSpec)(nil), (*kops.AssetsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_AssetsSpec_To_kops_AssetsSpec(a.(*AssetsSpec), b.(*kops.AssetsSpec), scope)
 	}); err != nil {
@@ -134,6 +154,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditGroupResources)(nil), (*kops.AuditGroupResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AuditGroupResources_To_kops_AuditGroupResources(a.(*AuditGroupResources), b.(*kops.AuditGroupResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AuditGroupResources)(nil), (*AuditGroupResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AuditGroupResources_To_v1alpha2_AuditGroupResources(a.(*kops.AuditGroupResources), b.(*AuditGroupResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditPolicyRule)(nil), (*kops.AuditPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AuditPolicyRule_To_kops_AuditPolicyRule(a.(*AuditPolicyRule), b.(*kops.AuditPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AuditPolicyRule)(nil), (*AuditPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AuditPolicyRule_To_v1alpha2_AuditPolicyRule(a.(*kops.AuditPolicyRule), b.(*AuditPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditPolicySpec)(nil), (*kops.AuditPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AuditPolicySpec_To_kops_AuditPolicySpec(a.(*AuditPolicySpec), b.(*kops.AuditPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AuditPolicySpec)(nil), (*AuditPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AuditPolicySpec_To_v1alpha2_AuditPolicySpec(a.(*kops.AuditPolicySpec), b.(*AuditPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditWebhookSpec)(nil), (*kops.AuditWebhookSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AuditWebhookSpec_To_kops_AuditWebhookSpec(a.(*AuditWebhookSpec), b.(*kops.AuditWebhookSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AuditWebhookSpec)(nil), (*AuditWebhookSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AuditWebhookSpec_To_v1alpha2_AuditWebhookSpec(a.(*kops.AuditWebhookSpec), b.(*AuditWebhookSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuthenticationSpec)(nil), (*kops.AuthenticationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_AuthenticationSpec_To_kops_AuthenticationSpec(a.(*AuthenticationSpec), b.(*kops.AuthenticationSpec), scope)
 	}); err != nil {
@@ -144,6 +204,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuthorizationConfigSpec)(nil), (*kops.AuthorizationConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AuthorizationConfigSpec_To_kops_AuthorizationConfigSpec(a.(*AuthorizationConfigSpec), b.(*kops.AuthorizationConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AuthorizationConfigSpec)(nil), (*AuthorizationConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AuthorizationConfigSpec_To_v1alpha2_AuthorizationConfigSpec(a.(*kops.AuthorizationConfigSpec), b.(*AuthorizationConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuthorizationSpec)(nil), (*kops.AuthorizationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_AuthorizationSpec_To_kops_AuthorizationSpec(a.(*AuthorizationSpec), b.(*kops.AuthorizationSpec), scope)
 	}); err != nil {
@@ -154,6 +224,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuthorizerMatchCondition)(nil), (*kops.AuthorizerMatchCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AuthorizerMatchCondition_To_kops_AuthorizerMatchCondition(a.(*AuthorizerMatchCondition), b.(*kops.AuthorizerMatchCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AuthorizerMatchCondition)(nil), (*AuthorizerMatchCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AuthorizerMatchCondition_To_v1alpha2_AuthorizerMatchCondition(a.(*kops.AuthorizerMatchCondition), b.(*AuthorizerMatchCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuthorizerSpec)(nil), (*kops.AuthorizerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AuthorizerSpec_To_kops_AuthorizerSpec(a.(*AuthorizerSpec), b.(*kops.AuthorizerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AuthorizerSpec)(nil), (*AuthorizerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AuthorizerSpec_To_v1alpha2_AuthorizerSpec(a.(*kops.AuthorizerSpec), b.(*AuthorizerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AzurePrivateAPISpec)(nil), (*kops.AzurePrivateAPISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AzurePrivateAPISpec_To_kops_AzurePrivateAPISpec(a.(*AzurePrivateAPISpec), b.(*kops.AzurePrivateAPISpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AzurePrivateAPISpec)(nil), (*AzurePrivateAPISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AzurePrivateAPISpec_To_v1alpha2_AzurePrivateAPISpec(a.(*kops.AzurePrivateAPISpec), b.(*AzurePrivateAPISpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AzurePrivateLinkServiceSpec)(nil), (*kops.AzurePrivateLinkServiceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AzurePrivateLinkServiceSpec_To_kops_AzurePrivateLinkServiceSpec(a.(*AzurePrivateLinkServiceSpec), b.(*kops.AzurePrivateLinkServiceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AzurePrivateLinkServiceSpec)(nil), (*AzurePrivateLinkServiceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AzurePrivateLinkServiceSpec_To_v1alpha2_AzurePrivateLinkServiceSpec(a.(*kops.AzurePrivateLinkServiceSpec), b.(*AzurePrivateLinkServiceSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AzureSpec)(nil), (*kops.AzureSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_AzureSpec_To_kops_AzureSpec(a.(*AzureSpec), b.(*kops.AzureSpec), scope)
 	}); err != nil {
@@ -194,6 +304,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CRIOConfig)(nil), (*kops.CRIOConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CRIOConfig_To_kops_CRIOConfig(a.(*CRIOConfig), b.(*kops.CRIOConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.CRIOConfig)(nil), (*CRIOConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_CRIOConfig_To_v1alpha2_CRIOConfig(a.(*kops.CRIOConfig), b.(*CRIOConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CalicoNetworkingSpec)(nil), (*kops.CalicoNetworkingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CalicoNetworkingSpec_To_kops_CalicoNetworkingSpec(a.(*CalicoNetworkingSpec), b.(*kops.CalicoNetworkingSpec), scope)
 	}); err != nil {
@@ -214,6 +334,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CiliumClusterMeshPeerSpec)(nil), (*kops.CiliumClusterMeshPeerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CiliumClusterMeshPeerSpec_To_kops_CiliumClusterMeshPeerSpec(a.(*CiliumClusterMeshPeerSpec), b.(*kops.CiliumClusterMeshPeerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.CiliumClusterMeshPeerSpec)(nil), (*CiliumClusterMeshPeerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_CiliumClusterMeshPeerSpec_To_v1alpha2_CiliumClusterMeshPeerSpec(a.(*kops.CiliumClusterMeshPeerSpec), b.(*CiliumClusterMeshPeerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CiliumClusterMeshSpec)(nil), (*kops.CiliumClusterMeshSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CiliumClusterMeshSpec_To_kops_CiliumClusterMeshSpec(a.(*CiliumClusterMeshSpec), b.(*kops.CiliumClusterMeshSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.CiliumClusterMeshSpec)(nil), (*CiliumClusterMeshSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_CiliumClusterMeshSpec_To_v1alpha2_CiliumClusterMeshSpec(a.(*kops.CiliumClusterMeshSpec), b.(*CiliumClusterMeshSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CiliumGatewayAPISpec)(nil), (*kops.CiliumGatewayAPISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CiliumGatewayAPISpec_To_kops_CiliumGatewayAPISpec(a.(*CiliumGatewayAPISpec), b.(*kops.CiliumGatewayAPISpec), scope)
 	}); err != nil {
@@ -314,6 +454,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ContainerdRegistryConfig)(nil), (*kops.ContainerdRegistryConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ContainerdRegistryConfig_To_kops_ContainerdRegistryConfig(a.(*ContainerdRegistryConfig), b.(*kops.ContainerdRegistryConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.ContainerdRegistryConfig)(nil), (*ContainerdRegistryConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_ContainerdRegistryConfig_To_v1alpha2_ContainerdRegistryConfig(a.(*kops.ContainerdRegistryConfig), b.(*ContainerdRegistryConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ContinuousApplySpec)(nil), (*kops.ContinuousApplySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ContinuousApplySpec_To_kops_ContinuousApplySpec(a.(*ContinuousApplySpec), b.(*kops.ContinuousApplySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.ContinuousApplySpec)(nil), (*ContinuousApplySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_ContinuousApplySpec_To_v1alpha2_ContinuousApplySpec(a.(*kops.ContinuousApplySpec), b.(*ContinuousApplySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DCGMExporterConfig)(nil), (*kops.DCGMExporterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_DCGMExporterConfig_To_kops_DCGMExporterConfig(a.(*DCGMExporterConfig), b.(*kops.DCGMExporterConfig), scope)
 	}); err != nil {
@@ -354,6 +514,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DOLoadBalancerSpec)(nil), (*kops.DOLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_DOLoadBalancerSpec_To_kops_DOLoadBalancerSpec(a.(*DOLoadBalancerSpec), b.(*kops.DOLoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.DOLoadBalancerSpec)(nil), (*DOLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_DOLoadBalancerSpec_To_v1alpha2_DOLoadBalancerSpec(a.(*kops.DOLoadBalancerSpec), b.(*DOLoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DOSpec)(nil), (*kops.DOSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_DOSpec_To_kops_DOSpec(a.(*DOSpec), b.(*kops.DOSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.DOSpec)(nil), (*DOSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_DOSpec_To_v1alpha2_DOSpec(a.(*kops.DOSpec), b.(*DOSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DiscoveryServiceOptions)(nil), (*kops.DiscoveryServiceOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_DiscoveryServiceOptions_To_kops_DiscoveryServiceOptions(a.(*DiscoveryServiceOptions), b.(*kops.DiscoveryServiceOptions), scope)
 	}); err != nil {
@@ -404,6 +584,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EtcdBackupS3Spec)(nil), (*kops.EtcdBackupS3Spec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EtcdBackupS3Spec_To_kops_EtcdBackupS3Spec(a.(*EtcdBackupS3Spec), b.(*kops.EtcdBackupS3Spec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.EtcdBackupS3Spec)(nil), (*EtcdBackupS3Spec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_EtcdBackupS3Spec_To_v1alpha2_EtcdBackupS3Spec(a.(*kops.EtcdBackupS3Spec), b.(*EtcdBackupS3Spec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EtcdBackupSpec)(nil), (*kops.EtcdBackupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_EtcdBackupSpec_To_kops_EtcdBackupSpec(a.(*EtcdBackupSpec), b.(*kops.EtcdBackupSpec), scope)
 	}); err != nil {
@@ -424,6 +614,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EtcdExternalSpec)(nil), (*kops.EtcdExternalSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EtcdExternalSpec_To_kops_EtcdExternalSpec(a.(*EtcdExternalSpec), b.(*kops.EtcdExternalSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.EtcdExternalSpec)(nil), (*EtcdExternalSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_EtcdExternalSpec_To_v1alpha2_EtcdExternalSpec(a.(*kops.EtcdExternalSpec), b.(*EtcdExternalSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EtcdManagerSpec)(nil), (*kops.EtcdManagerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_EtcdManagerSpec_To_kops_EtcdManagerSpec(a.(*EtcdManagerSpec), b.(*kops.EtcdManagerSpec), scope)
 	}); err != nil {
@@ -444,6 +644,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EventRateLimit)(nil), (*kops.EventRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EventRateLimit_To_kops_EventRateLimit(a.(*EventRateLimit), b.(*kops.EventRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.EventRateLimit)(nil), (*EventRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_EventRateLimit_To_v1alpha2_EventRateLimit(a.(*kops.EventRateLimit), b.(*EventRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EventRateLimitAdmissionSpec)(nil), (*kops.EventRateLimitAdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EventRateLimitAdmissionSpec_To_kops_EventRateLimitAdmissionSpec(a.(*EventRateLimitAdmissionSpec), b.(*kops.EventRateLimitAdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.EventRateLimitAdmissionSpec)(nil), (*EventRateLimitAdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_EventRateLimitAdmissionSpec_To_v1alpha2_EventRateLimitAdmissionSpec(a.(*kops.EventRateLimitAdmissionSpec), b.(*EventRateLimitAdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExecContainerAction)(nil), (*kops.ExecContainerAction)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ExecContainerAction_To_kops_ExecContainerAction(a.(*ExecContainerAction), b.(*kops.ExecContainerAction), scope)
 	}); err != nil {
@@ -474,6 +694,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FirewallRuleSpec)(nil), (*kops.FirewallRuleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_FirewallRuleSpec_To_kops_FirewallRuleSpec(a.(*FirewallRuleSpec), b.(*kops.FirewallRuleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.FirewallRuleSpec)(nil), (*FirewallRuleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_FirewallRuleSpec_To_v1alpha2_FirewallRuleSpec(a.(*kops.FirewallRuleSpec), b.(*FirewallRuleSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*FlannelNetworkingSpec)(nil), (*kops.FlannelNetworkingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_FlannelNetworkingSpec_To_kops_FlannelNetworkingSpec(a.(*FlannelNetworkingSpec), b.(*kops.FlannelNetworkingSpec), scope)
 	}); err != nil {
@@ -524,6 +754,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GracefulNodeDrainSpec)(nil), (*kops.GracefulNodeDrainSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_GracefulNodeDrainSpec_To_kops_GracefulNodeDrainSpec(a.(*GracefulNodeDrainSpec), b.(*kops.GracefulNodeDrainSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.GracefulNodeDrainSpec)(nil), (*GracefulNodeDrainSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_GracefulNodeDrainSpec_To_v1alpha2_GracefulNodeDrainSpec(a.(*kops.GracefulNodeDrainSpec), b.(*GracefulNodeDrainSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HTTPProxy)(nil), (*kops.HTTPProxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_HTTPProxy_To_kops_HTTPProxy(a.(*HTTPProxy), b.(*kops.HTTPProxy), scope)
 	}); err != nil {
@@ -534,6 +774,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HardeningSpec)(nil), (*kops.HardeningSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_HardeningSpec_To_kops_HardeningSpec(a.(*HardeningSpec), b.(*kops.HardeningSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.HardeningSpec)(nil), (*HardeningSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_HardeningSpec_To_v1alpha2_HardeningSpec(a.(*kops.HardeningSpec), b.(*HardeningSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HelmChartSpec)(nil), (*kops.HelmChartSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_HelmChartSpec_To_kops_HelmChartSpec(a.(*HelmChartSpec), b.(*kops.HelmChartSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.HelmChartSpec)(nil), (*HelmChartSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_HelmChartSpec_To_v1alpha2_HelmChartSpec(a.(*kops.HelmChartSpec), b.(*HelmChartSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Host)(nil), (*kops.Host)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_Host_To_kops_Host(a.(*Host), b.(*kops.Host), scope)
 	}); err != nil {
@@ -564,6 +824,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HubbleRelaySpec)(nil), (*kops.HubbleRelaySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_HubbleRelaySpec_To_kops_HubbleRelaySpec(a.(*HubbleRelaySpec), b.(*kops.HubbleRelaySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.HubbleRelaySpec)(nil), (*HubbleRelaySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_HubbleRelaySpec_To_v1alpha2_HubbleRelaySpec(a.(*kops.HubbleRelaySpec), b.(*HubbleRelaySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HubbleSpec)(nil), (*kops.HubbleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_HubbleSpec_To_kops_HubbleSpec(a.(*HubbleSpec), b.(*kops.HubbleSpec), scope)
 	}); err != nil {
@@ -574,6 +844,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HubbleUISpec)(nil), (*kops.HubbleUISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_HubbleUISpec_To_kops_HubbleUISpec(a.(*HubbleUISpec), b.(*kops.HubbleUISpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.HubbleUISpec)(nil), (*HubbleUISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_HubbleUISpec_To_v1alpha2_HubbleUISpec(a.(*kops.HubbleUISpec), b.(*HubbleUISpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IAMProfileSpec)(nil), (*kops.IAMProfileSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_IAMProfileSpec_To_kops_IAMProfileSpec(a.(*IAMProfileSpec), b.(*kops.IAMProfileSpec), scope)
 	}); err != nil {
@@ -594,6 +874,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImagePatchingSpec)(nil), (*kops.ImagePatchingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ImagePatchingSpec_To_kops_ImagePatchingSpec(a.(*ImagePatchingSpec), b.(*kops.ImagePatchingSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.ImagePatchingSpec)(nil), (*ImagePatchingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_ImagePatchingSpec_To_v1alpha2_ImagePatchingSpec(a.(*kops.ImagePatchingSpec), b.(*ImagePatchingSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImagePolicyWebhookAdmissionSpec)(nil), (*kops.ImagePolicyWebhookAdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ImagePolicyWebhookAdmissionSpec_To_kops_ImagePolicyWebhookAdmissionSpec(a.(*ImagePolicyWebhookAdmissionSpec), b.(*kops.ImagePolicyWebhookAdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.ImagePolicyWebhookAdmissionSpec)(nil), (*ImagePolicyWebhookAdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_ImagePolicyWebhookAdmissionSpec_To_v1alpha2_ImagePolicyWebhookAdmissionSpec(a.(*kops.ImagePolicyWebhookAdmissionSpec), b.(*ImagePolicyWebhookAdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstanceGroup)(nil), (*kops.InstanceGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_InstanceGroup_To_kops_InstanceGroup(a.(*InstanceGroup), b.(*kops.InstanceGroup), scope)
 	}); err != nil {
@@ -604,6 +904,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstanceGroupClusterAutoscalerSpec)(nil), (*kops.InstanceGroupClusterAutoscalerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(a.(*InstanceGroupClusterAutoscalerSpec), b.(*kops.InstanceGroupClusterAutoscalerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.InstanceGroupClusterAutoscalerSpec)(nil), (*InstanceGroupClusterAutoscalerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha2_InstanceGroupClusterAutoscalerSpec(a.(*kops.InstanceGroupClusterAutoscalerSpec), b.(*InstanceGroupClusterAutoscalerSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstanceGroupList)(nil), (*kops.InstanceGroupList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_InstanceGroupList_To_kops_InstanceGroupList(a.(*InstanceGroupList), b.(*kops.InstanceGroupList), scope)
 	}); err != nil {
@@ -634,6 +944,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InterruptionHandlerConfig)(nil), (*kops.InterruptionHandlerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_InterruptionHandlerConfig_To_kops_InterruptionHandlerConfig(a.(*InterruptionHandlerConfig), b.(*kops.InterruptionHandlerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.InterruptionHandlerConfig)(nil), (*InterruptionHandlerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_InterruptionHandlerConfig_To_v1alpha2_InterruptionHandlerConfig(a.(*kops.InterruptionHandlerConfig), b.(*InterruptionHandlerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KMSEncryptionSpec)(nil), (*kops.KMSEncryptionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_KMSEncryptionSpec_To_kops_KMSEncryptionSpec(a.(*KMSEncryptionSpec), b.(*kops.KMSEncryptionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KMSEncryptionSpec)(nil), (*KMSEncryptionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KMSEncryptionSpec_To_v1alpha2_KMSEncryptionSpec(a.(*kops.KMSEncryptionSpec), b.(*KMSEncryptionSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KarpenterConfig)(nil), (*kops.KarpenterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_KarpenterConfig_To_kops_KarpenterConfig(a.(*KarpenterConfig), b.(*kops.KarpenterConfig), scope)
 	}); err != nil {
@@ -644,6 +974,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KarpenterInstanceGroupSpec)(nil), (*kops.KarpenterInstanceGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_KarpenterInstanceGroupSpec_To_kops_KarpenterInstanceGroupSpec(a.(*KarpenterInstanceGroupSpec), b.(*kops.KarpenterInstanceGroupSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KarpenterInstanceGroupSpec)(nil), (*KarpenterInstanceGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KarpenterInstanceGroupSpec_To_v1alpha2_KarpenterInstanceGroupSpec(a.(*kops.KarpenterInstanceGroupSpec), b.(*KarpenterInstanceGroupSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Keyset)(nil), (*kops.Keyset)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_Keyset_To_kops_Keyset(a.(*Keyset), b.(*kops.Keyset), scope)
 	}); err != nil {
@@ -724,6 +1064,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KopsControllerSpec)(nil), (*kops.KopsControllerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_KopsControllerSpec_To_kops_KopsControllerSpec(a.(*KopsControllerSpec), b.(*kops.KopsControllerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KopsControllerSpec)(nil), (*KopsControllerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KopsControllerSpec_To_v1alpha2_KopsControllerSpec(a.(*kops.KopsControllerSpec), b.(*KopsControllerSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeControllerManagerConfig)(nil), (*kops.KubeControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_KubeControllerManagerConfig_To_kops_KubeControllerManagerConfig(a.(*KubeControllerManagerConfig), b.(*kops.KubeControllerManagerConfig), scope)
 	}); err != nil {
@@ -774,6 +1124,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeletCredentialProviderSpec)(nil), (*kops.KubeletCredentialProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_KubeletCredentialProviderSpec_To_kops_KubeletCredentialProviderSpec(a.(*KubeletCredentialProviderSpec), b.(*kops.KubeletCredentialProviderSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KubeletCredentialProviderSpec)(nil), (*KubeletCredentialProviderSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KubeletCredentialProviderSpec_To_v1alpha2_KubeletCredentialProviderSpec(a.(*kops.KubeletCredentialProviderSpec), b.(*KubeletCredentialProviderSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubenetNetworkingSpec)(nil), (*kops.KubenetNetworkingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_KubenetNetworkingSpec_To_kops_KubenetNetworkingSpec(a.(*KubenetNetworkingSpec), b.(*kops.KubenetNetworkingSpec), scope)
 	}); err != nil {
@@ -854,6 +1214,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceWindowSpec)(nil), (*kops.MaintenanceWindowSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_MaintenanceWindowSpec_To_kops_MaintenanceWindowSpec(a.(*MaintenanceWindowSpec), b.(*kops.MaintenanceWindowSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.MaintenanceWindowSpec)(nil), (*MaintenanceWindowSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_MaintenanceWindowSpec_To_v1alpha2_MaintenanceWindowSpec(a.(*kops.MaintenanceWindowSpec), b.(*MaintenanceWindowSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ManagedInstanceGroupUpdatePolicySpec)(nil), (*kops.ManagedInstanceGroupUpdatePolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ManagedInstanceGroupUpdatePolicySpec_To_kops_ManagedInstanceGroupUpdatePolicySpec(a.(*ManagedInstanceGroupUpdatePolicySpec), b.(*kops.ManagedInstanceGroupUpdatePolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.ManagedInstanceGroupUpdatePolicySpec)(nil), (*ManagedInstanceGroupUpdatePolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_ManagedInstanceGroupUpdatePolicySpec_To_v1alpha2_ManagedInstanceGroupUpdatePolicySpec(a.(*kops.ManagedInstanceGroupUpdatePolicySpec), b.(*ManagedInstanceGroupUpdatePolicySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MetricsServerConfig)(nil), (*kops.MetricsServerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_MetricsServerConfig_To_kops_MetricsServerConfig(a.(*MetricsServerConfig), b.(*kops.MetricsServerConfig), scope)
 	}); err != nil {
@@ -934,6 +1314,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeIdentityLabelsSpec)(nil), (*kops.NodeIdentityLabelsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NodeIdentityLabelsSpec_To_kops_NodeIdentityLabelsSpec(a.(*NodeIdentityLabelsSpec), b.(*kops.NodeIdentityLabelsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.NodeIdentityLabelsSpec)(nil), (*NodeIdentityLabelsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_NodeIdentityLabelsSpec_To_v1alpha2_NodeIdentityLabelsSpec(a.(*kops.NodeIdentityLabelsSpec), b.(*NodeIdentityLabelsSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NodeLocalDNSConfig)(nil), (*kops.NodeLocalDNSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_NodeLocalDNSConfig_To_kops_NodeLocalDNSConfig(a.(*NodeLocalDNSConfig), b.(*kops.NodeLocalDNSConfig), scope)
 	}); err != nil {
@@ -1074,6 +1464,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodSecurityAdmissionSpec)(nil), (*kops.PodSecurityAdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(a.(*PodSecurityAdmissionSpec), b.(*kops.PodSecurityAdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.PodSecurityAdmissionSpec)(nil), (*PodSecurityAdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_PodSecurityAdmissionSpec_To_v1alpha2_PodSecurityAdmissionSpec(a.(*kops.PodSecurityAdmissionSpec), b.(*PodSecurityAdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodSecurityDefaults)(nil), (*kops.PodSecurityDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_PodSecurityDefaults_To_kops_PodSecurityDefaults(a.(*PodSecurityDefaults), b.(*kops.PodSecurityDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.PodSecurityDefaults)(nil), (*PodSecurityDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_PodSecurityDefaults_To_v1alpha2_PodSecurityDefaults(a.(*kops.PodSecurityDefaults), b.(*PodSecurityDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodSecurityExemptions)(nil), (*kops.PodSecurityExemptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_PodSecurityExemptions_To_kops_PodSecurityExemptions(a.(*PodSecurityExemptions), b.(*kops.PodSecurityExemptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.PodSecurityExemptions)(nil), (*PodSecurityExemptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_PodSecurityExemptions_To_v1alpha2_PodSecurityExemptions(a.(*kops.PodSecurityExemptions), b.(*PodSecurityExemptions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RBACAuthorizationSpec)(nil), (*kops.RBACAuthorizationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_RBACAuthorizationSpec_To_kops_RBACAuthorizationSpec(a.(*RBACAuthorizationSpec), b.(*kops.RBACAuthorizationSpec), scope)
 	}); err != nil {
@@ -1084,6 +1504,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RequiredTagSpec)(nil), (*kops.RequiredTagSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_RequiredTagSpec_To_kops_RequiredTagSpec(a.(*RequiredTagSpec), b.(*kops.RequiredTagSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.RequiredTagSpec)(nil), (*RequiredTagSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_RequiredTagSpec_To_v1alpha2_RequiredTagSpec(a.(*kops.RequiredTagSpec), b.(*RequiredTagSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RollingUpdate)(nil), (*kops.RollingUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_RollingUpdate_To_kops_RollingUpdate(a.(*RollingUpdate), b.(*kops.RollingUpdate), scope)
 	}); err != nil {
@@ -1124,6 +1554,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SELinuxSpec)(nil), (*kops.SELinuxSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_SELinuxSpec_To_kops_SELinuxSpec(a.(*SELinuxSpec), b.(*kops.SELinuxSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.SELinuxSpec)(nil), (*SELinuxSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_SELinuxSpec_To_v1alpha2_SELinuxSpec(a.(*kops.SELinuxSpec), b.(*SELinuxSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SSHCredential)(nil), (*kops.SSHCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_SSHCredential_To_kops_SSHCredential(a.(*SSHCredential), b.(*kops.SSHCredential), scope)
 	}); err != nil {
@@ -1184,6 +1624,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SpotPolicySpec)(nil), (*kops.SpotPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_SpotPolicySpec_To_kops_SpotPolicySpec(a.(*SpotPolicySpec), b.(*kops.SpotPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.SpotPolicySpec)(nil), (*SpotPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_SpotPolicySpec_To_v1alpha2_SpotPolicySpec(a.(*kops.SpotPolicySpec), b.(*SpotPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TLSSpec)(nil), (*kops.TLSSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TLSSpec_To_kops_TLSSpec(a.(*TLSSpec), b.(*kops.TLSSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.TLSSpec)(nil), (*TLSSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_TLSSpec_To_v1alpha2_TLSSpec(a.(*kops.TLSSpec), b.(*TLSSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TagPolicySpec)(nil), (*kops.TagPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_TagPolicySpec_To_kops_TagPolicySpec(a.(*TagPolicySpec), b.(*kops.TagPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.TagPolicySpec)(nil), (*TagPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_TagPolicySpec_To_v1alpha2_TagPolicySpec(a.(*kops.TagPolicySpec), b.(*TagPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TargetSpec)(nil), (*kops.TargetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_TargetSpec_To_kops_TargetSpec(a.(*TargetSpec), b.(*kops.TargetSpec), scope)
 	}); err != nil {
@@ -1214,6 +1684,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VerticalPodAutoscalerConfig)(nil), (*kops.VerticalPodAutoscalerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(a.(*VerticalPodAutoscalerConfig), b.(*kops.VerticalPodAutoscalerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.VerticalPodAutoscalerConfig)(nil), (*VerticalPodAutoscalerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_VerticalPodAutoscalerConfig_To_v1alpha2_VerticalPodAutoscalerConfig(a.(*kops.VerticalPodAutoscalerConfig), b.(*VerticalPodAutoscalerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VolumeMountSpec)(nil), (*kops.VolumeMountSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VolumeMountSpec_To_kops_VolumeMountSpec(a.(*VolumeMountSpec), b.(*kops.VolumeMountSpec), scope)
 	}); err != nil {
@@ -1254,6 +1734,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WebhookAuthorizerSpec)(nil), (*kops.WebhookAuthorizerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_WebhookAuthorizerSpec_To_kops_WebhookAuthorizerSpec(a.(*WebhookAuthorizerSpec), b.(*kops.WebhookAuthorizerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.WebhookAuthorizerSpec)(nil), (*WebhookAuthorizerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_WebhookAuthorizerSpec_To_v1alpha2_WebhookAuthorizerSpec(a.(*kops.WebhookAuthorizerSpec), b.(*WebhookAuthorizerSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*kops.CanalNetworkingSpec)(nil), (*CanalNetworkingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_kops_CanalNetworkingSpec_To_v1alpha2_CanalNetworkingSpec(a.(*kops.CanalNetworkingSpec), b.(*CanalNetworkingSpec), scope)
 	}); err != nil {
@@ -1544,6 +2034,8 @@ func Convert_kops_AccessLogSpec_To_v1alpha2_AccessLogSpec(in *kops.AccessLogSpec
 
 func autoConvert_v1alpha2_AddonSpec_To_kops_AddonSpec(in *AddonSpec, out *kops.AddonSpec, s conversion.Scope) error {
 	out.Manifest = in.Manifest
+	out.Name = in.Name
+	out.Version = in.Version
 	return nil
 }
 
@@ -1554,6 +2046,8 @@ func Convert_v1alpha2_AddonSpec_To_kops_AddonSpec(in *AddonSpec, out *kops.Addon
 
 func autoConvert_kops_AddonSpec_To_v1alpha2_AddonSpec(in *kops.AddonSpec, out *AddonSpec, s conversion.Scope) error {
 	out.Manifest = in.Manifest
+	out.Name = in.Name
+	out.Version = in.Version
 	return nil
 }
 
@@ -1562,6 +2056,78 @@ func Convert_kops_AddonSpec_To_v1alpha2_AddonSpec(in *kops.AddonSpec, out *Addon
 	return autoConvert_kops_AddonSpec_To_v1alpha2_AddonSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_AdmissionConfigSpec_To_kops_AdmissionConfigSpec(in *AdmissionConfigSpec, out *kops.AdmissionConfigSpec, s conversion.Scope) error {
+	if in.EventRateLimit != nil {
+		in, out := &in.EventRateLimit, &out.EventRateLimit
+		*out = new(kops.EventRateLimitAdmissionSpec)
+		if err := Convert_v1alpha2_EventRateLimitAdmissionSpec_To_kops_EventRateLimitAdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.EventRateLimit = nil
+	}
+	if in.PodSecurity != nil {
+		in, out := &in.PodSecurity, &out.PodSecurity
+		*out = new(kops.PodSecurityAdmissionSpec)
+		if err := Convert_v1alpha2_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodSecurity = nil
+	}
+	if in.ImagePolicyWebhook != nil {
+		in, out := &in.ImagePolicyWebhook, &out.ImagePolicyWebhook
+		*out = new(kops.ImagePolicyWebhookAdmissionSpec)
+		if err := Convert_v1alpha2_ImagePolicyWebhookAdmissionSpec_To_kops_ImagePolicyWebhookAdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ImagePolicyWebhook = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_AdmissionConfigSpec_To_kops_AdmissionConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AdmissionConfigSpec_To_kops_AdmissionConfigSpec(in *AdmissionConfigSpec, out *kops.AdmissionConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AdmissionConfigSpec_To_kops_AdmissionConfigSpec(in, out, s)
+}
+
+func autoConvert_kops_AdmissionConfigSpec_To_v1alpha2_AdmissionConfigSpec(in *kops.AdmissionConfigSpec, out *AdmissionConfigSpec, s conversion.Scope) error {
+	if in.EventRateLimit != nil {
+		in, out := &in.EventRateLimit, &out.EventRateLimit
+		*out = new(EventRateLimitAdmissionSpec)
+		if err := Convert_kops_EventRateLimitAdmissionSpec_To_v1alpha2_EventRateLimitAdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.EventRateLimit = nil
+	}
+	if in.PodSecurity != nil {
+		in, out := &in.PodSecurity, &out.PodSecurity
+		*out = new(PodSecurityAdmissionSpec)
+		if err := Convert_kops_PodSecurityAdmissionSpec_To_v1alpha2_PodSecurityAdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodSecurity = nil
+	}
+	if in.ImagePolicyWebhook != nil {
+		in, out := &in.ImagePolicyWebhook, &out.ImagePolicyWebhook
+		*out = new(ImagePolicyWebhookAdmissionSpec)
+		if err := Convert_kops_ImagePolicyWebhookAdmissionSpec_To_v1alpha2_ImagePolicyWebhookAdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ImagePolicyWebhook = nil
+	}
+	return nil
+}
+
+// Convert_kops_AdmissionConfigSpec_To_v1alpha2_AdmissionConfigSpec is an autogenerated conversion function.
+func Convert_kops_AdmissionConfigSpec_To_v1alpha2_AdmissionConfigSpec(in *kops.AdmissionConfigSpec, out *AdmissionConfigSpec, s conversion.Scope) error {
+	return autoConvert_kops_AdmissionConfigSpec_To_v1alpha2_AdmissionConfigSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in *AlwaysAllowAuthorizationSpec, out *kops.AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
 	return nil
 }
@@ -1580,74 +2146,264 @@ func Convert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha2_AlwaysAllowAuthorizat
 	return autoConvert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha2_AlwaysAllowAuthorizationSpec(in, out, s)
 }
 
-func autoConvert_v1alpha2_AmazonVPCNetworkingSpec_To_kops_AmazonVPCNetworkingSpec(in *AmazonVPCNetworkingSpec, out *kops.AmazonVPCNetworkingSpec, s conversion.Scope) error {
-	out.Image = in.Image
-	out.InitImage = in.InitImage
-	out.NetworkPolicyAgentImage = in.NetworkPolicyAgentImage
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]kops.EnvVar, len(*in))
+func autoConvert_v1alpha2_AmazonVPCNetworkingSpec_To_kops_AmazonVPCNetworkingSpec(in *AmazonVPCNetworkingSpec, out *kops.AmazonVPCNetworkingSpec, s conversion.Scope) error {
+	out.Image = in.Image
+	out.InitImage = in.InitImage
+	out.NetworkPolicyAgentImage = in.NetworkPolicyAgentImage
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]kops.EnvVar, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_EnvVar_To_kops_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_AmazonVPCNetworkingSpec_To_kops_AmazonVPCNetworkingSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AmazonVPCNetworkingSpec_To_kops_AmazonVPCNetworkingSpec(in *AmazonVPCNetworkingSpec, out *kops.AmazonVPCNetworkingSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AmazonVPCNetworkingSpec_To_kops_AmazonVPCNetworkingSpec(in, out, s)
+}
+
+func autoConvert_kops_AmazonVPCNetworkingSpec_To_v1alpha2_AmazonVPCNetworkingSpec(in *kops.AmazonVPCNetworkingSpec, out *AmazonVPCNetworkingSpec, s conversion.Scope) error {
+	out.Image = in.Image
+	out.InitImage = in.InitImage
+	out.NetworkPolicyAgentImage = in.NetworkPolicyAgentImage
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]EnvVar, len(*in))
+		for i := range *in {
+			if err := Convert_kops_EnvVar_To_v1alpha2_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	return nil
+}
+
+// Convert_kops_AmazonVPCNetworkingSpec_To_v1alpha2_AmazonVPCNetworkingSpec is an autogenerated conversion function.
+func Convert_kops_AmazonVPCNetworkingSpec_To_v1alpha2_AmazonVPCNetworkingSpec(in *kops.AmazonVPCNetworkingSpec, out *AmazonVPCNetworkingSpec, s conversion.Scope) error {
+	return autoConvert_kops_AmazonVPCNetworkingSpec_To_v1alpha2_AmazonVPCNetworkingSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_AssetDownloadsSpec_To_kops_AssetDownloadsSpec(in *AssetDownloadsSpec, out *kops.AssetDownloadsSpec, s conversion.Scope) error {
+	out.Concurrency = in.Concurrency
+	out.BandwidthLimit = in.BandwidthLimit
+	out.CacheDirectory = in.CacheDirectory
+	return nil
+}
+
+// Convert_v1alpha2_AssetDownloadsSpec_To_kops_AssetDownloadsSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AssetDownloadsSpec_To_kops_AssetDownloadsSpec(in *AssetDownloadsSpec, out *kops.AssetDownloadsSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AssetDownloadsSpec_To_kops_AssetDownloadsSpec(in, out, s)
+}
+
+func autoConvert_kops_AssetDownloadsSpec_To_v1alpha2_AssetDownloadsSpec(in *kops.AssetDownloadsSpec, out *AssetDownloadsSpec, s conversion.Scope) error {
+	out.Concurrency = in.Concurrency
+	out.BandwidthLimit = in.BandwidthLimit
+	out.CacheDirectory = in.CacheDirectory
+	return nil
+}
+
+// Convert_kops_AssetDownloadsSpec_To_v1alpha2_AssetDownloadsSpec is an autogenerated conversion function.
+func Convert_kops_AssetDownloadsSpec_To_v1alpha2_AssetDownloadsSpec(in *kops.AssetDownloadsSpec, out *AssetDownloadsSpec, s conversion.Scope) error {
+	return autoConvert_kops_AssetDownloadsSpec_To_v1alpha2_AssetDownloadsSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_AssetsSpec_To_kops_AssetsSpec(in *AssetsSpec, out *kops.AssetsSpec, s conversion.Scope) error {
+	out.ContainerRegistry = in.ContainerRegistry
+	out.FileRepository = in.FileRepository
+	out.ContainerProxy = in.ContainerProxy
+	if in.NodeDownloads != nil {
+		in, out := &in.NodeDownloads, &out.NodeDownloads
+		*out = new(kops.AssetDownloadsSpec)
+		if err := Convert_v1alpha2_AssetDownloadsSpec_To_kops_AssetDownloadsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NodeDownloads = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_AssetsSpec_To_kops_AssetsSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AssetsSpec_To_kops_AssetsSpec(in *AssetsSpec, out *kops.AssetsSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AssetsSpec_To_kops_AssetsSpec(in, out, s)
+}
+
+func autoConvert_kops_AssetsSpec_To_v1alpha2_AssetsSpec(in *kops.AssetsSpec, out *AssetsSpec, s conversion.Scope) error {
+	out.ContainerRegistry = in.ContainerRegistry
+	out.FileRepository = in.FileRepository
+	out.ContainerProxy = in.ContainerProxy
+	if in.NodeDownloads != nil {
+		in, out := &in.NodeDownloads, &out.NodeDownloads
+		*out = new(AssetDownloadsSpec)
+		if err := Convert_kops_AssetDownloadsSpec_To_v1alpha2_AssetDownloadsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NodeDownloads = nil
+	}
+	return nil
+}
+
+// Convert_kops_AssetsSpec_To_v1alpha2_AssetsSpec is an autogenerated conversion function.
+func Convert_kops_AssetsSpec_To_v1alpha2_AssetsSpec(in *kops.AssetsSpec, out *AssetsSpec, s conversion.Scope) error {
+	return autoConvert_kops_AssetsSpec_To_v1alpha2_AssetsSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_AuditGroupResources_To_kops_AuditGroupResources(in *AuditGroupResources, out *kops.AuditGroupResources, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Resources = in.Resources
+	out.ResourceNames = in.ResourceNames
+	return nil
+}
+
+// Convert_v1alpha2_AuditGroupResources_To_kops_AuditGroupResources is an autogenerated conversion function.
+func Convert_v1alpha2_AuditGroupResources_To_kops_AuditGroupResources(in *AuditGroupResources, out *kops.AuditGroupResources, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AuditGroupResources_To_kops_AuditGroupResources(in, out, s)
+}
+
+func autoConvert_kops_AuditGroupResources_To_v1alpha2_AuditGroupResources(in *kops.AuditGroupResources, out *AuditGroupResources, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Resources = in.Resources
+	out.ResourceNames = in.ResourceNames
+	return nil
+}
+
+// Convert_kops_AuditGroupResources_To_v1alpha2_AuditGroupResources is an autogenerated conversion function.
+func Convert_kops_AuditGroupResources_To_v1alpha2_AuditGroupResources(in *kops.AuditGroupResources, out *AuditGroupResources, s conversion.Scope) error {
+	return autoConvert_kops_AuditGroupResources_To_v1alpha2_AuditGroupResources(in, out, s)
+}
+
+func autoConvert_v1alpha2_AuditPolicyRule_To_kops_AuditPolicyRule(in *AuditPolicyRule, out *kops.AuditPolicyRule, s conversion.Scope) error {
+	out.Level = in.Level
+	out.Users = in.Users
+	out.UserGroups = in.UserGroups
+	out.Verbs = in.Verbs
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]kops.AuditGroupResources, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_AuditGroupResources_To_kops_AuditGroupResources(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Resources = nil
+	}
+	out.Namespaces = in.Namespaces
+	out.NonResourceURLs = in.NonResourceURLs
+	out.OmitStages = in.OmitStages
+	out.OmitManagedFields = in.OmitManagedFields
+	return nil
+}
+
+// Convert_v1alpha2_AuditPolicyRule_To_kops_AuditPolicyRule is an autogenerated conversion function.
+func Convert_v1alpha2_AuditPolicyRule_To_kops_AuditPolicyRule(in *AuditPolicyRule, out *kops.AuditPolicyRule, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AuditPolicyRule_To_kops_AuditPolicyRule(in, out, s)
+}
+
+func autoConvert_kops_AuditPolicyRule_To_v1alpha2_AuditPolicyRule(in *kops.AuditPolicyRule, out *AuditPolicyRule, s conversion.Scope) error {
+	out.Level = in.Level
+	out.Users = in.Users
+	out.UserGroups = in.UserGroups
+	out.Verbs = in.Verbs
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]AuditGroupResources, len(*in))
+		for i := range *in {
+			if err := Convert_kops_AuditGroupResources_To_v1alpha2_AuditGroupResources(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Resources = nil
+	}
+	out.Namespaces = in.Namespaces
+	out.NonResourceURLs = in.NonResourceURLs
+	out.OmitStages = in.OmitStages
+	out.OmitManagedFields = in.OmitManagedFields
+	return nil
+}
+
+// Convert_kops_AuditPolicyRule_To_v1alpha2_AuditPolicyRule is an autogenerated conversion function.
+func Convert_kops_AuditPolicyRule_To_v1alpha2_AuditPolicyRule(in *kops.AuditPolicyRule, out *AuditPolicyRule, s conversion.Scope) error {
+	return autoConvert_kops_AuditPolicyRule_To_v1alpha2_AuditPolicyRule(in, out, s)
+}
+
+func autoConvert_v1alpha2_AuditPolicySpec_To_kops_AuditPolicySpec(in *AuditPolicySpec, out *kops.AuditPolicySpec, s conversion.Scope) error {
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]kops.AuditPolicyRule, len(*in))
 		for i := range *in {
-			if err := Convert_v1alpha2_EnvVar_To_kops_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+			if err := Convert_v1alpha2_AuditPolicyRule_To_kops_AuditPolicyRule(&(*in)[i], &(*out)[i], s); err != nil {
 				return err
 			}
 		}
 	} else {
-		out.Env = nil
+		out.Rules = nil
 	}
+	out.OmitStages = in.OmitStages
+	out.OmitManagedFields = in.OmitManagedFields
 	return nil
 }
 
-// Convert_v1alpha2_AmazonVPCNetworkingSpec_To_kops_AmazonVPCNetworkingSpec is an autogenerated conversion function.
-func Convert_v1alpha2_AmazonVPCNetworkingSpec_To_kops_AmazonVPCNetworkingSpec(in *AmazonVPCNetworkingSpec, out *kops.AmazonVPCNetworkingSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha2_AmazonVPCNetworkingSpec_To_kops_AmazonVPCNetworkingSpec(in, out, s)
+// Convert_v1alpha2_AuditPolicySpec_To_kops_AuditPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha2_AuditPolicySpec_To_kops_AuditPolicySpec(in *AuditPolicySpec, out *kops.AuditPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AuditPolicySpec_To_kops_AuditPolicySpec(in, out, s)
 }
 
-func autoConvert_kops_AmazonVPCNetworkingSpec_To_v1alpha2_AmazonVPCNetworkingSpec(in *kops.AmazonVPCNetworkingSpec, out *AmazonVPCNetworkingSpec, s conversion.Scope) error {
-	out.Image = in.Image
-	out.InitImage = in.InitImage
-	out.NetworkPolicyAgentImage = in.NetworkPolicyAgentImage
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]EnvVar, len(*in))
+func autoConvert_kops_AuditPolicySpec_To_v1alpha2_AuditPolicySpec(in *kops.AuditPolicySpec, out *AuditPolicySpec, s conversion.Scope) error {
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AuditPolicyRule, len(*in))
 		for i := range *in {
-			if err := Convert_kops_EnvVar_To_v1alpha2_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+			if err := Convert_kops_AuditPolicyRule_To_v1alpha2_AuditPolicyRule(&(*in)[i], &(*out)[i], s); err != nil {
 				return err
 			}
 		}
 	} else {
-		out.Env = nil
+		out.Rules = nil
 	}
+	out.OmitStages = in.OmitStages
+	out.OmitManagedFields = in.OmitManagedFields
 	return nil
 }
 
-// Convert_kops_AmazonVPCNetworkingSpec_To_v1alpha2_AmazonVPCNetworkingSpec is an autogenerated conversion function.
-func Convert_kops_AmazonVPCNetworkingSpec_To_v1alpha2_AmazonVPCNetworkingSpec(in *kops.AmazonVPCNetworkingSpec, out *AmazonVPCNetworkingSpec, s conversion.Scope) error {
-	return autoConvert_kops_AmazonVPCNetworkingSpec_To_v1alpha2_AmazonVPCNetworkingSpec(in, out, s)
+// Convert_kops_AuditPolicySpec_To_v1alpha2_AuditPolicySpec is an autogenerated conversion function.
+func Convert_kops_AuditPolicySpec_To_v1alpha2_AuditPolicySpec(in *kops.AuditPolicySpec, out *AuditPolicySpec, s conversion.Scope) error {
+	return autoConvert_kops_AuditPolicySpec_To_v1alpha2_AuditPolicySpec(in, out, s)
 }
 
-func autoConvert_v1alpha2_AssetsSpec_To_kops_AssetsSpec(in *AssetsSpec, out *kops.AssetsSpec, s conversion.Scope) error {
-	out.ContainerRegistry = in.ContainerRegistry
-	out.FileRepository = in.FileRepository
-	out.ContainerProxy = in.ContainerProxy
+func autoConvert_v1alpha2_AuditWebhookSpec_To_kops_AuditWebhookSpec(in *AuditWebhookSpec, out *kops.AuditWebhookSpec, s conversion.Scope) error {
+	out.Server = in.Server
+	out.CACertificate = in.CACertificate
+	out.UseBearerToken = in.UseBearerToken
 	return nil
 }
 
-// Convert_v1alpha2_AssetsSpec_To_kops_AssetsSpec is an autogenerated conversion function.
-func Convert_v1alpha2_AssetsSpec_To_kops_AssetsSpec(in *AssetsSpec, out *kops.AssetsSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha2_AssetsSpec_To_kops_AssetsSpec(in, out, s)
+// Convert_v1alpha2_AuditWebhookSpec_To_kops_AuditWebhookSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AuditWebhookSpec_To_kops_AuditWebhookSpec(in *AuditWebhookSpec, out *kops.AuditWebhookSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AuditWebhookSpec_To_kops_AuditWebhookSpec(in, out, s)
 }
 
-func autoConvert_kops_AssetsSpec_To_v1alpha2_AssetsSpec(in *kops.AssetsSpec, out *AssetsSpec, s conversion.Scope) error {
-	out.ContainerRegistry = in.ContainerRegistry
-	out.FileRepository = in.FileRepository
-	out.ContainerProxy = in.ContainerProxy
+func autoConvert_kops_AuditWebhookSpec_To_v1alpha2_AuditWebhookSpec(in *kops.AuditWebhookSpec, out *AuditWebhookSpec, s conversion.Scope) error {
+	out.Server = in.Server
+	out.CACertificate = in.CACertificate
+	out.UseBearerToken = in.UseBearerToken
 	return nil
 }
 
-// Convert_kops_AssetsSpec_To_v1alpha2_AssetsSpec is an autogenerated conversion function.
-func Convert_kops_AssetsSpec_To_v1alpha2_AssetsSpec(in *kops.AssetsSpec, out *AssetsSpec, s conversion.Scope) error {
-	return autoConvert_kops_AssetsSpec_To_v1alpha2_AssetsSpec(in, out, s)
+// Convert_kops_AuditWebhookSpec_To_v1alpha2_AuditWebhookSpec is an autogenerated conversion function.
+func Convert_kops_AuditWebhookSpec_To_v1alpha2_AuditWebhookSpec(in *kops.AuditWebhookSpec, out *AuditWebhookSpec, s conversion.Scope) error {
+	return autoConvert_kops_AuditWebhookSpec_To_v1alpha2_AuditWebhookSpec(in, out, s)
 }
 
 func autoConvert_v1alpha2_AuthenticationSpec_To_kops_AuthenticationSpec(in *AuthenticationSpec, out *kops.AuthenticationSpec, s conversion.Scope) error {
@@ -1706,6 +2462,46 @@ func Convert_kops_AuthenticationSpec_To_v1alpha2_AuthenticationSpec(in *kops.Aut
 	return autoConvert_kops_AuthenticationSpec_To_v1alpha2_AuthenticationSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_AuthorizationConfigSpec_To_kops_AuthorizationConfigSpec(in *AuthorizationConfigSpec, out *kops.AuthorizationConfigSpec, s conversion.Scope) error {
+	if in.Authorizers != nil {
+		in, out := &in.Authorizers, &out.Authorizers
+		*out = make([]kops.AuthorizerSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_AuthorizerSpec_To_kops_AuthorizerSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Authorizers = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_AuthorizationConfigSpec_To_kops_AuthorizationConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AuthorizationConfigSpec_To_kops_AuthorizationConfigSpec(in *AuthorizationConfigSpec, out *kops.AuthorizationConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AuthorizationConfigSpec_To_kops_AuthorizationConfigSpec(in, out, s)
+}
+
+func autoConvert_kops_AuthorizationConfigSpec_To_v1alpha2_AuthorizationConfigSpec(in *kops.AuthorizationConfigSpec, out *AuthorizationConfigSpec, s conversion.Scope) error {
+	if in.Authorizers != nil {
+		in, out := &in.Authorizers, &out.Authorizers
+		*out = make([]AuthorizerSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_AuthorizerSpec_To_v1alpha2_AuthorizerSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Authorizers = nil
+	}
+	return nil
+}
+
+// Convert_kops_AuthorizationConfigSpec_To_v1alpha2_AuthorizationConfigSpec is an autogenerated conversion function.
+func Convert_kops_AuthorizationConfigSpec_To_v1alpha2_AuthorizationConfigSpec(in *kops.AuthorizationConfigSpec, out *AuthorizationConfigSpec, s conversion.Scope) error {
+	return autoConvert_kops_AuthorizationConfigSpec_To_v1alpha2_AuthorizationConfigSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_AuthorizationSpec_To_kops_AuthorizationSpec(in *AuthorizationSpec, out *kops.AuthorizationSpec, s conversion.Scope) error {
 	if in.AlwaysAllow != nil {
 		in, out := &in.AlwaysAllow, &out.AlwaysAllow
@@ -1760,6 +2556,126 @@ func Convert_kops_AuthorizationSpec_To_v1alpha2_AuthorizationSpec(in *kops.Autho
 	return autoConvert_kops_AuthorizationSpec_To_v1alpha2_AuthorizationSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_AuthorizerMatchCondition_To_kops_AuthorizerMatchCondition(in *AuthorizerMatchCondition, out *kops.AuthorizerMatchCondition, s conversion.Scope) error {
+	out.Expression = in.Expression
+	return nil
+}
+
+// Convert_v1alpha2_AuthorizerMatchCondition_To_kops_AuthorizerMatchCondition is an autogenerated conversion function.
+func Convert_v1alpha2_AuthorizerMatchCondition_To_kops_AuthorizerMatchCondition(in *AuthorizerMatchCondition, out *kops.AuthorizerMatchCondition, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AuthorizerMatchCondition_To_kops_AuthorizerMatchCondition(in, out, s)
+}
+
+func autoConvert_kops_AuthorizerMatchCondition_To_v1alpha2_AuthorizerMatchCondition(in *kops.AuthorizerMatchCondition, out *AuthorizerMatchCondition, s conversion.Scope) error {
+	out.Expression = in.Expression
+	return nil
+}
+
+// Convert_kops_AuthorizerMatchCondition_To_v1alpha2_AuthorizerMatchCondition is an autogenerated conversion function.
+func Convert_kops_AuthorizerMatchCondition_To_v1alpha2_AuthorizerMatchCondition(in *kops.AuthorizerMatchCondition, out *AuthorizerMatchCondition, s conversion.Scope) error {
+	return autoConvert_kops_AuthorizerMatchCondition_To_v1alpha2_AuthorizerMatchCondition(in, out, s)
+}
+
+func autoConvert_v1alpha2_AuthorizerSpec_To_kops_AuthorizerSpec(in *AuthorizerSpec, out *kops.AuthorizerSpec, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Name = in.Name
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(kops.WebhookAuthorizerSpec)
+		if err := Convert_v1alpha2_WebhookAuthorizerSpec_To_kops_WebhookAuthorizerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Webhook = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_AuthorizerSpec_To_kops_AuthorizerSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AuthorizerSpec_To_kops_AuthorizerSpec(in *AuthorizerSpec, out *kops.AuthorizerSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AuthorizerSpec_To_kops_AuthorizerSpec(in, out, s)
+}
+
+func autoConvert_kops_AuthorizerSpec_To_v1alpha2_AuthorizerSpec(in *kops.AuthorizerSpec, out *AuthorizerSpec, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Name = in.Name
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookAuthorizerSpec)
+		if err := Convert_kops_WebhookAuthorizerSpec_To_v1alpha2_WebhookAuthorizerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Webhook = nil
+	}
+	return nil
+}
+
+// Convert_kops_AuthorizerSpec_To_v1alpha2_AuthorizerSpec is an autogenerated conversion function.
+func Convert_kops_AuthorizerSpec_To_v1alpha2_AuthorizerSpec(in *kops.AuthorizerSpec, out *AuthorizerSpec, s conversion.Scope) error {
+	return autoConvert_kops_AuthorizerSpec_To_v1alpha2_AuthorizerSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_AzurePrivateAPISpec_To_kops_AzurePrivateAPISpec(in *AzurePrivateAPISpec, out *kops.AzurePrivateAPISpec, s conversion.Scope) error {
+	out.PrivateDNSZoneID = in.PrivateDNSZoneID
+	out.LinkedVirtualNetworkIDs = in.LinkedVirtualNetworkIDs
+	if in.PrivateLinkService != nil {
+		in, out := &in.PrivateLinkService, &out.PrivateLinkService
+		*out = new(kops.AzurePrivateLinkServiceSpec)
+		if err := Convert_v1alpha2_AzurePrivateLinkServiceSpec_To_kops_AzurePrivateLinkServiceSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateLinkService = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_AzurePrivateAPISpec_To_kops_AzurePrivateAPISpec is an autogenerated conversion function.
+func Convert_v1alpha2_AzurePrivateAPISpec_To_kops_AzurePrivateAPISpec(in *AzurePrivateAPISpec, out *kops.AzurePrivateAPISpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AzurePrivateAPISpec_To_kops_AzurePrivateAPISpec(in, out, s)
+}
+
+func autoConvert_kops_AzurePrivateAPISpec_To_v1alpha2_AzurePrivateAPISpec(in *kops.AzurePrivateAPISpec, out *AzurePrivateAPISpec, s conversion.Scope) error {
+	out.PrivateDNSZoneID = in.PrivateDNSZoneID
+	out.LinkedVirtualNetworkIDs = in.LinkedVirtualNetworkIDs
+	if in.PrivateLinkService != nil {
+		in, out := &in.PrivateLinkService, &out.PrivateLinkService
+		*out = new(AzurePrivateLinkServiceSpec)
+		if err := Convert_kops_AzurePrivateLinkServiceSpec_To_v1alpha2_AzurePrivateLinkServiceSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateLinkService = nil
+	}
+	return nil
+}
+
+// Convert_kops_AzurePrivateAPISpec_To_v1alpha2_AzurePrivateAPISpec is an autogenerated conversion function.
+func Convert_kops_AzurePrivateAPISpec_To_v1alpha2_AzurePrivateAPISpec(in *kops.AzurePrivateAPISpec, out *AzurePrivateAPISpec, s conversion.Scope) error {
+	return autoConvert_kops_AzurePrivateAPISpec_To_v1alpha2_AzurePrivateAPISpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_AzurePrivateLinkServiceSpec_To_kops_AzurePrivateLinkServiceSpec(in *AzurePrivateLinkServiceSpec, out *kops.AzurePrivateLinkServiceSpec, s conversion.Scope) error {
+	out.AllowedSubscriptionIDs = in.AllowedSubscriptionIDs
+	return nil
+}
+
+// Convert_v1alpha2_AzurePrivateLinkServiceSpec_To_kops_AzurePrivateLinkServiceSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AzurePrivateLinkServiceSpec_To_kops_AzurePrivateLinkServiceSpec(in *AzurePrivateLinkServiceSpec, out *kops.AzurePrivateLinkServiceSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AzurePrivateLinkServiceSpec_To_kops_AzurePrivateLinkServiceSpec(in, out, s)
+}
+
+func autoConvert_kops_AzurePrivateLinkServiceSpec_To_v1alpha2_AzurePrivateLinkServiceSpec(in *kops.AzurePrivateLinkServiceSpec, out *AzurePrivateLinkServiceSpec, s conversion.Scope) error {
+	out.AllowedSubscriptionIDs = in.AllowedSubscriptionIDs
+	return nil
+}
+
+// Convert_kops_AzurePrivateLinkServiceSpec_To_v1alpha2_AzurePrivateLinkServiceSpec is an autogenerated conversion function.
+func Convert_kops_AzurePrivateLinkServiceSpec_To_v1alpha2_AzurePrivateLinkServiceSpec(in *kops.AzurePrivateLinkServiceSpec, out *AzurePrivateLinkServiceSpec, s conversion.Scope) error {
+	return autoConvert_kops_AzurePrivateLinkServiceSpec_To_v1alpha2_AzurePrivateLinkServiceSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_AzureSpec_To_kops_AzureSpec(in *AzureSpec, out *kops.AzureSpec, s conversion.Scope) error {
 	out.SubscriptionID = in.SubscriptionID
 	out.StorageAccountID = in.StorageAccountID
@@ -1767,6 +2683,15 @@ func autoConvert_v1alpha2_AzureSpec_To_kops_AzureSpec(in *AzureSpec, out *kops.A
 	out.ResourceGroupName = in.ResourceGroupName
 	out.RouteTableName = in.RouteTableName
 	out.AdminUser = in.AdminUser
+	if in.PrivateAPI != nil {
+		in, out := &in.PrivateAPI, &out.PrivateAPI
+		*out = new(kops.AzurePrivateAPISpec)
+		if err := Convert_v1alpha2_AzurePrivateAPISpec_To_kops_AzurePrivateAPISpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateAPI = nil
+	}
 	return nil
 }
 
@@ -1782,6 +2707,15 @@ func autoConvert_kops_AzureSpec_To_v1alpha2_AzureSpec(in *kops.AzureSpec, out *A
 	out.ResourceGroupName = in.ResourceGroupName
 	out.RouteTableName = in.RouteTableName
 	out.AdminUser = in.AdminUser
+	if in.PrivateAPI != nil {
+		in, out := &in.PrivateAPI, &out.PrivateAPI
+		*out = new(AzurePrivateAPISpec)
+		if err := Convert_kops_AzurePrivateAPISpec_To_v1alpha2_AzurePrivateAPISpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateAPI = nil
+	}
 	return nil
 }
 
@@ -1853,6 +2787,15 @@ func Convert_kops_BastionSpec_To_v1alpha2_BastionSpec(in *kops.BastionSpec, out
 
 func autoConvert_v1alpha2_CNINetworkingSpec_To_kops_CNINetworkingSpec(in *CNINetworkingSpec, out *kops.CNINetworkingSpec, s conversion.Scope) error {
 	out.UsesSecondaryIP = in.UsesSecondaryIP
+	if in.HelmChart != nil {
+		in, out := &in.HelmChart, &out.HelmChart
+		*out = new(kops.HelmChartSpec)
+		if err := Convert_v1alpha2_HelmChartSpec_To_kops_HelmChartSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.HelmChart = nil
+	}
 	return nil
 }
 
@@ -1863,6 +2806,15 @@ func Convert_v1alpha2_CNINetworkingSpec_To_kops_CNINetworkingSpec(in *CNINetwork
 
 func autoConvert_kops_CNINetworkingSpec_To_v1alpha2_CNINetworkingSpec(in *kops.CNINetworkingSpec, out *CNINetworkingSpec, s conversion.Scope) error {
 	out.UsesSecondaryIP = in.UsesSecondaryIP
+	if in.HelmChart != nil {
+		in, out := &in.HelmChart, &out.HelmChart
+		*out = new(HelmChartSpec)
+		if err := Convert_kops_HelmChartSpec_To_v1alpha2_HelmChartSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.HelmChart = nil
+	}
 	return nil
 }
 
@@ -1871,6 +2823,48 @@ func Convert_kops_CNINetworkingSpec_To_v1alpha2_CNINetworkingSpec(in *kops.CNINe
 	return autoConvert_kops_CNINetworkingSpec_To_v1alpha2_CNINetworkingSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_CRIOConfig_To_kops_CRIOConfig(in *CRIOConfig, out *kops.CRIOConfig, s conversion.Scope) error {
+	out.LogLevel = in.LogLevel
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = new(kops.PackagesConfig)
+		if err := Convert_v1alpha2_PackagesConfig_To_kops_PackagesConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Packages = nil
+	}
+	out.SandboxImage = in.SandboxImage
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1alpha2_CRIOConfig_To_kops_CRIOConfig is an autogenerated conversion function.
+func Convert_v1alpha2_CRIOConfig_To_kops_CRIOConfig(in *CRIOConfig, out *kops.CRIOConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CRIOConfig_To_kops_CRIOConfig(in, out, s)
+}
+
+func autoConvert_kops_CRIOConfig_To_v1alpha2_CRIOConfig(in *kops.CRIOConfig, out *CRIOConfig, s conversion.Scope) error {
+	out.LogLevel = in.LogLevel
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = new(PackagesConfig)
+		if err := Convert_kops_PackagesConfig_To_v1alpha2_PackagesConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Packages = nil
+	}
+	out.SandboxImage = in.SandboxImage
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_kops_CRIOConfig_To_v1alpha2_CRIOConfig is an autogenerated conversion function.
+func Convert_kops_CRIOConfig_To_v1alpha2_CRIOConfig(in *kops.CRIOConfig, out *CRIOConfig, s conversion.Scope) error {
+	return autoConvert_kops_CRIOConfig_To_v1alpha2_CRIOConfig(in, out, s)
+}
+
 func autoConvert_v1alpha2_CalicoNetworkingSpec_To_kops_CalicoNetworkingSpec(in *CalicoNetworkingSpec, out *kops.CalicoNetworkingSpec, s conversion.Scope) error {
 	out.Registry = in.Registry
 	out.Version = in.Version
@@ -2015,6 +3009,74 @@ func Convert_kops_CertManagerConfig_To_v1alpha2_CertManagerConfig(in *kops.CertM
 	return autoConvert_kops_CertManagerConfig_To_v1alpha2_CertManagerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha2_CiliumClusterMeshPeerSpec_To_kops_CiliumClusterMeshPeerSpec(in *CiliumClusterMeshPeerSpec, out *kops.CiliumClusterMeshPeerSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Address = in.Address
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_v1alpha2_CiliumClusterMeshPeerSpec_To_kops_CiliumClusterMeshPeerSpec is an autogenerated conversion function.
+func Convert_v1alpha2_CiliumClusterMeshPeerSpec_To_kops_CiliumClusterMeshPeerSpec(in *CiliumClusterMeshPeerSpec, out *kops.CiliumClusterMeshPeerSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CiliumClusterMeshPeerSpec_To_kops_CiliumClusterMeshPeerSpec(in, out, s)
+}
+
+func autoConvert_kops_CiliumClusterMeshPeerSpec_To_v1alpha2_CiliumClusterMeshPeerSpec(in *kops.CiliumClusterMeshPeerSpec, out *CiliumClusterMeshPeerSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Address = in.Address
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_kops_CiliumClusterMeshPeerSpec_To_v1alpha2_CiliumClusterMeshPeerSpec is an autogenerated conversion function.
+func Convert_kops_CiliumClusterMeshPeerSpec_To_v1alpha2_CiliumClusterMeshPeerSpec(in *kops.CiliumClusterMeshPeerSpec, out *CiliumClusterMeshPeerSpec, s conversion.Scope) error {
+	return autoConvert_kops_CiliumClusterMeshPeerSpec_To_v1alpha2_CiliumClusterMeshPeerSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_CiliumClusterMeshSpec_To_kops_CiliumClusterMeshSpec(in *CiliumClusterMeshSpec, out *kops.CiliumClusterMeshSpec, s conversion.Scope) error {
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]kops.CiliumClusterMeshPeerSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_CiliumClusterMeshPeerSpec_To_kops_CiliumClusterMeshPeerSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Clusters = nil
+	}
+	out.EnableEndpointSync = in.EnableEndpointSync
+	out.EnableMCSAPI = in.EnableMCSAPI
+	return nil
+}
+
+// Convert_v1alpha2_CiliumClusterMeshSpec_To_kops_CiliumClusterMeshSpec is an autogenerated conversion function.
+func Convert_v1alpha2_CiliumClusterMeshSpec_To_kops_CiliumClusterMeshSpec(in *CiliumClusterMeshSpec, out *kops.CiliumClusterMeshSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CiliumClusterMeshSpec_To_kops_CiliumClusterMeshSpec(in, out, s)
+}
+
+func autoConvert_kops_CiliumClusterMeshSpec_To_v1alpha2_CiliumClusterMeshSpec(in *kops.CiliumClusterMeshSpec, out *CiliumClusterMeshSpec, s conversion.Scope) error {
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]CiliumClusterMeshPeerSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_CiliumClusterMeshPeerSpec_To_v1alpha2_CiliumClusterMeshPeerSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Clusters = nil
+	}
+	out.EnableEndpointSync = in.EnableEndpointSync
+	out.EnableMCSAPI = in.EnableMCSAPI
+	return nil
+}
+
+// Convert_kops_CiliumClusterMeshSpec_To_v1alpha2_CiliumClusterMeshSpec is an autogenerated conversion function.
+func Convert_kops_CiliumClusterMeshSpec_To_v1alpha2_CiliumClusterMeshSpec(in *kops.CiliumClusterMeshSpec, out *CiliumClusterMeshSpec, s conversion.Scope) error {
+	return autoConvert_kops_CiliumClusterMeshSpec_To_v1alpha2_CiliumClusterMeshSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_CiliumGatewayAPISpec_To_kops_CiliumGatewayAPISpec(in *CiliumGatewayAPISpec, out *kops.CiliumGatewayAPISpec, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EnableSecretsSync = in.EnableSecretsSync
@@ -2200,6 +3262,15 @@ func autoConvert_v1alpha2_CiliumNetworkingSpec_To_kops_CiliumNetworkingSpec(in *
 	} else {
 		out.GatewayAPI = nil
 	}
+	if in.ClusterMesh != nil {
+		in, out := &in.ClusterMesh, &out.ClusterMesh
+		*out = new(kops.CiliumClusterMeshSpec)
+		if err := Convert_v1alpha2_CiliumClusterMeshSpec_To_kops_CiliumClusterMeshSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterMesh = nil
+	}
 	out.ExtraConfig = in.ExtraConfig
 	return nil
 }
@@ -2285,6 +3356,15 @@ func autoConvert_kops_CiliumNetworkingSpec_To_v1alpha2_CiliumNetworkingSpec(in *
 	} else {
 		out.GatewayAPI = nil
 	}
+	if in.ClusterMesh != nil {
+		in, out := &in.ClusterMesh, &out.ClusterMesh
+		*out = new(CiliumClusterMeshSpec)
+		if err := Convert_kops_CiliumClusterMeshSpec_To_v1alpha2_CiliumClusterMeshSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterMesh = nil
+	}
 	out.ExtraConfig = in.ExtraConfig
 	return nil
 }
@@ -2329,6 +3409,7 @@ func autoConvert_v1alpha2_CloudConfiguration_To_kops_CloudConfiguration(in *Clou
 	// INFO: in.SpotinstOrientation opted out of conversion generation
 	// INFO: in.Openstack opted out of conversion generation
 	// INFO: in.Azure opted out of conversion generation
+	// INFO: in.DO opted out of conversion generation
 	// INFO: in.AWSEBSCSIDriver opted out of conversion generation
 	// INFO: in.GCPPDCSIDriver opted out of conversion generation
 	return nil
@@ -2376,6 +3457,7 @@ func autoConvert_v1alpha2_CloudControllerManagerConfig_To_kops_CloudControllerMa
 	out.NodeStatusUpdateFrequency = in.NodeStatusUpdateFrequency
 	out.ConcurrentNodeSyncs = in.ConcurrentNodeSyncs
 	out.AzureNodeManagerImage = in.AzureNodeManagerImage
+	out.UseServiceAccountExternalPermissions = in.UseServiceAccountExternalPermissions
 	return nil
 }
 
@@ -2411,6 +3493,7 @@ func autoConvert_kops_CloudControllerManagerConfig_To_v1alpha2_CloudControllerMa
 	out.NodeStatusUpdateFrequency = in.NodeStatusUpdateFrequency
 	out.ConcurrentNodeSyncs = in.ConcurrentNodeSyncs
 	out.AzureNodeManagerImage = in.AzureNodeManagerImage
+	out.UseServiceAccountExternalPermissions = in.UseServiceAccountExternalPermissions
 	return nil
 }
 
@@ -2468,6 +3551,7 @@ func autoConvert_v1alpha2_ClusterAutoscalerConfig_To_kops_ClusterAutoscalerConfi
 	out.PodAnnotations = in.PodAnnotations
 	out.CreatePriorityExpenderConfig = in.CreatePriorityExpenderConfig
 	out.CustomPriorityExpanderConfig = in.CustomPriorityExpanderConfig
+	out.NodeGroupAutoDiscovery = in.NodeGroupAutoDiscovery
 	return nil
 }
 
@@ -2499,6 +3583,7 @@ func autoConvert_kops_ClusterAutoscalerConfig_To_v1alpha2_ClusterAutoscalerConfi
 	out.PodAnnotations = in.PodAnnotations
 	out.CreatePriorityExpenderConfig = in.CreatePriorityExpenderConfig
 	out.CustomPriorityExpanderConfig = in.CustomPriorityExpanderConfig
+	out.NodeGroupAutoDiscovery = in.NodeGroupAutoDiscovery
 	return nil
 }
 
@@ -2652,6 +3737,15 @@ func autoConvert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 	} else {
 		out.Containerd = nil
 	}
+	if in.CRIO != nil {
+		in, out := &in.CRIO, &out.CRIO
+		*out = new(kops.CRIOConfig)
+		if err := Convert_v1alpha2_CRIOConfig_To_kops_CRIOConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CRIO = nil
+	}
 	if in.KubeDNS != nil {
 		in, out := &in.KubeDNS, &out.KubeDNS
 		*out = new(kops.KubeDNSConfig)
@@ -2751,6 +3845,17 @@ func autoConvert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 	} else {
 		out.NTP = nil
 	}
+	if in.KubeletCredentialProviders != nil {
+		in, out := &in.KubeletCredentialProviders, &out.KubeletCredentialProviders
+		*out = make([]kops.KubeletCredentialProviderSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_KubeletCredentialProviderSpec_To_kops_KubeletCredentialProviderSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.KubeletCredentialProviders = nil
+	}
 	out.Packages = in.Packages
 	// INFO: in.NodeTerminationHandler opted out of conversion generation
 	if in.NodeProblemDetector != nil {
@@ -2762,6 +3867,15 @@ func autoConvert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 	} else {
 		out.NodeProblemDetector = nil
 	}
+	if in.InterruptionHandler != nil {
+		in, out := &in.InterruptionHandler, &out.InterruptionHandler
+		*out = new(kops.InterruptionHandlerConfig)
+		if err := Convert_v1alpha2_InterruptionHandlerConfig_To_kops_InterruptionHandlerConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.InterruptionHandler = nil
+	}
 	if in.MetricsServer != nil {
 		in, out := &in.MetricsServer, &out.MetricsServer
 		*out = new(kops.MetricsServerConfig)
@@ -2771,6 +3885,15 @@ func autoConvert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 	} else {
 		out.MetricsServer = nil
 	}
+	if in.VerticalPodAutoscaler != nil {
+		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
+		*out = new(kops.VerticalPodAutoscalerConfig)
+		if err := Convert_v1alpha2_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VerticalPodAutoscaler = nil
+	}
 	if in.CertManager != nil {
 		in, out := &in.CertManager, &out.CertManager
 		*out = new(kops.CertManagerConfig)
@@ -2807,16 +3930,43 @@ func autoConvert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 	} else {
 		out.Authorization = nil
 	}
-	if in.NodeAuthorization != nil {
-		in, out := &in.NodeAuthorization, &out.NodeAuthorization
-		*out = new(kops.NodeAuthorizationSpec)
-		if err := Convert_v1alpha2_NodeAuthorizationSpec_To_kops_NodeAuthorizationSpec(*in, *out, s); err != nil {
+	if in.NodeAuthorization != nil {
+		in, out := &in.NodeAuthorization, &out.NodeAuthorization
+		*out = new(kops.NodeAuthorizationSpec)
+		if err := Convert_v1alpha2_NodeAuthorizationSpec_To_kops_NodeAuthorizationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NodeAuthorization = nil
+	}
+	if in.PodSecurityAdmission != nil {
+		in, out := &in.PodSecurityAdmission, &out.PodSecurityAdmission
+		*out = new(kops.PodSecurityAdmissionSpec)
+		if err := Convert_v1alpha2_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodSecurityAdmission = nil
+	}
+	out.CloudLabels = in.CloudLabels
+	if in.TagPolicy != nil {
+		in, out := &in.TagPolicy, &out.TagPolicy
+		*out = new(kops.TagPolicySpec)
+		if err := Convert_v1alpha2_TagPolicySpec_To_kops_TagPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TagPolicy = nil
+	}
+	if in.NodeIdentityLabels != nil {
+		in, out := &in.NodeIdentityLabels, &out.NodeIdentityLabels
+		*out = new(kops.NodeIdentityLabelsSpec)
+		if err := Convert_v1alpha2_NodeIdentityLabelsSpec_To_kops_NodeIdentityLabelsSpec(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
-		out.NodeAuthorization = nil
+		out.NodeIdentityLabels = nil
 	}
-	out.CloudLabels = in.CloudLabels
 	if in.Hooks != nil {
 		in, out := &in.Hooks, &out.Hooks
 		*out = make([]kops.HookSpec, len(*in))
@@ -2847,6 +3997,15 @@ func autoConvert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 		out.IAM = nil
 	}
 	out.EncryptionConfig = in.EncryptionConfig
+	if in.KMSEncryption != nil {
+		in, out := &in.KMSEncryption, &out.KMSEncryption
+		*out = new(kops.KMSEncryptionSpec)
+		if err := Convert_v1alpha2_KMSEncryptionSpec_To_kops_KMSEncryptionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KMSEncryption = nil
+	}
 	// INFO: in.TagSubnets opted out of conversion generation
 	if in.Target != nil {
 		in, out := &in.Target, &out.Target
@@ -2859,6 +4018,43 @@ func autoConvert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 	}
 	out.UseHostCertificates = in.UseHostCertificates
 	out.SysctlParameters = in.SysctlParameters
+	if in.Hardening != nil {
+		in, out := &in.Hardening, &out.Hardening
+		*out = new(kops.HardeningSpec)
+		if err := Convert_v1alpha2_HardeningSpec_To_kops_HardeningSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Hardening = nil
+	}
+	if in.SELinux != nil {
+		in, out := &in.SELinux, &out.SELinux
+		*out = new(kops.SELinuxSpec)
+		if err := Convert_v1alpha2_SELinuxSpec_To_kops_SELinuxSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SELinux = nil
+	}
+	out.FIPSMode = in.FIPSMode
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(kops.TLSSpec)
+		if err := Convert_v1alpha2_TLSSpec_To_kops_TLSSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TLS = nil
+	}
+	if in.KopsController != nil {
+		in, out := &in.KopsController, &out.KopsController
+		*out = new(kops.KopsControllerSpec)
+		if err := Convert_v1alpha2_KopsControllerSpec_To_kops_KopsControllerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KopsController = nil
+	}
 	if in.RollingUpdate != nil {
 		in, out := &in.RollingUpdate, &out.RollingUpdate
 		*out = new(kops.RollingUpdate)
@@ -2868,6 +4064,17 @@ func autoConvert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 	} else {
 		out.RollingUpdate = nil
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]kops.MaintenanceWindowSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_MaintenanceWindowSpec_To_kops_MaintenanceWindowSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MaintenanceWindows = nil
+	}
 	if in.ClusterAutoscaler != nil {
 		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
 		*out = new(kops.ClusterAutoscalerConfig)
@@ -2906,6 +4113,7 @@ func autoConvert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 		out.Karpenter = nil
 	}
 	// INFO: in.PodIdentityWebhook opted out of conversion generation
+	// INFO: in.GracefulNodeDrain opted out of conversion generation
 	return nil
 }
 
@@ -2992,6 +4200,15 @@ func autoConvert_kops_ClusterSpec_To_v1alpha2_ClusterSpec(in *kops.ClusterSpec,
 	} else {
 		out.Containerd = nil
 	}
+	if in.CRIO != nil {
+		in, out := &in.CRIO, &out.CRIO
+		*out = new(CRIOConfig)
+		if err := Convert_kops_CRIOConfig_To_v1alpha2_CRIOConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CRIO = nil
+	}
 	if in.KubeDNS != nil {
 		in, out := &in.KubeDNS, &out.KubeDNS
 		*out = new(KubeDNSConfig)
@@ -3091,6 +4308,17 @@ func autoConvert_kops_ClusterSpec_To_v1alpha2_ClusterSpec(in *kops.ClusterSpec,
 	} else {
 		out.NTP = nil
 	}
+	if in.KubeletCredentialProviders != nil {
+		in, out := &in.KubeletCredentialProviders, &out.KubeletCredentialProviders
+		*out = make([]KubeletCredentialProviderSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_KubeletCredentialProviderSpec_To_v1alpha2_KubeletCredentialProviderSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.KubeletCredentialProviders = nil
+	}
 	out.Packages = in.Packages
 	if in.NodeProblemDetector != nil {
 		in, out := &in.NodeProblemDetector, &out.NodeProblemDetector
@@ -3101,6 +4329,15 @@ func autoConvert_kops_ClusterSpec_To_v1alpha2_ClusterSpec(in *kops.ClusterSpec,
 	} else {
 		out.NodeProblemDetector = nil
 	}
+	if in.InterruptionHandler != nil {
+		in, out := &in.InterruptionHandler, &out.InterruptionHandler
+		*out = new(InterruptionHandlerConfig)
+		if err := Convert_kops_InterruptionHandlerConfig_To_v1alpha2_InterruptionHandlerConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.InterruptionHandler = nil
+	}
 	if in.MetricsServer != nil {
 		in, out := &in.MetricsServer, &out.MetricsServer
 		*out = new(MetricsServerConfig)
@@ -3110,6 +4347,15 @@ func autoConvert_kops_ClusterSpec_To_v1alpha2_ClusterSpec(in *kops.ClusterSpec,
 	} else {
 		out.MetricsServer = nil
 	}
+	if in.VerticalPodAutoscaler != nil {
+		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
+		*out = new(VerticalPodAutoscalerConfig)
+		if err := Convert_kops_VerticalPodAutoscalerConfig_To_v1alpha2_VerticalPodAutoscalerConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VerticalPodAutoscaler = nil
+	}
 	if in.CertManager != nil {
 		in, out := &in.CertManager, &out.CertManager
 		*out = new(CertManagerConfig)
@@ -3152,7 +4398,34 @@ func autoConvert_kops_ClusterSpec_To_v1alpha2_ClusterSpec(in *kops.ClusterSpec,
 	} else {
 		out.NodeAuthorization = nil
 	}
+	if in.PodSecurityAdmission != nil {
+		in, out := &in.PodSecurityAdmission, &out.PodSecurityAdmission
+		*out = new(PodSecurityAdmissionSpec)
+		if err := Convert_kops_PodSecurityAdmissionSpec_To_v1alpha2_PodSecurityAdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodSecurityAdmission = nil
+	}
 	out.CloudLabels = in.CloudLabels
+	if in.TagPolicy != nil {
+		in, out := &in.TagPolicy, &out.TagPolicy
+		*out = new(TagPolicySpec)
+		if err := Convert_kops_TagPolicySpec_To_v1alpha2_TagPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TagPolicy = nil
+	}
+	if in.NodeIdentityLabels != nil {
+		in, out := &in.NodeIdentityLabels, &out.NodeIdentityLabels
+		*out = new(NodeIdentityLabelsSpec)
+		if err := Convert_kops_NodeIdentityLabelsSpec_To_v1alpha2_NodeIdentityLabelsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NodeIdentityLabels = nil
+	}
 	if in.Hooks != nil {
 		in, out := &in.Hooks, &out.Hooks
 		*out = make([]HookSpec, len(*in))
@@ -3183,6 +4456,15 @@ func autoConvert_kops_ClusterSpec_To_v1alpha2_ClusterSpec(in *kops.ClusterSpec,
 		out.IAM = nil
 	}
 	out.EncryptionConfig = in.EncryptionConfig
+	if in.KMSEncryption != nil {
+		in, out := &in.KMSEncryption, &out.KMSEncryption
+		*out = new(KMSEncryptionSpec)
+		if err := Convert_kops_KMSEncryptionSpec_To_v1alpha2_KMSEncryptionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KMSEncryption = nil
+	}
 	if in.Target != nil {
 		in, out := &in.Target, &out.Target
 		*out = new(TargetSpec)
@@ -3194,6 +4476,43 @@ func autoConvert_kops_ClusterSpec_To_v1alpha2_ClusterSpec(in *kops.ClusterSpec,
 	}
 	out.UseHostCertificates = in.UseHostCertificates
 	out.SysctlParameters = in.SysctlParameters
+	if in.Hardening != nil {
+		in, out := &in.Hardening, &out.Hardening
+		*out = new(HardeningSpec)
+		if err := Convert_kops_HardeningSpec_To_v1alpha2_HardeningSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Hardening = nil
+	}
+	if in.SELinux != nil {
+		in, out := &in.SELinux, &out.SELinux
+		*out = new(SELinuxSpec)
+		if err := Convert_kops_SELinuxSpec_To_v1alpha2_SELinuxSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SELinux = nil
+	}
+	out.FIPSMode = in.FIPSMode
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSSpec)
+		if err := Convert_kops_TLSSpec_To_v1alpha2_TLSSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TLS = nil
+	}
+	if in.KopsController != nil {
+		in, out := &in.KopsController, &out.KopsController
+		*out = new(KopsControllerSpec)
+		if err := Convert_kops_KopsControllerSpec_To_v1alpha2_KopsControllerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KopsController = nil
+	}
 	if in.RollingUpdate != nil {
 		in, out := &in.RollingUpdate, &out.RollingUpdate
 		*out = new(RollingUpdate)
@@ -3203,6 +4522,17 @@ func autoConvert_kops_ClusterSpec_To_v1alpha2_ClusterSpec(in *kops.ClusterSpec,
 	} else {
 		out.RollingUpdate = nil
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindowSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_MaintenanceWindowSpec_To_v1alpha2_MaintenanceWindowSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MaintenanceWindows = nil
+	}
 	if in.ClusterAutoscaler != nil {
 		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
 		*out = new(ClusterAutoscalerConfig)
@@ -3263,6 +4593,8 @@ func autoConvert_v1alpha2_ClusterSubnetSpec_To_kops_ClusterSubnetSpec(in *Cluste
 	} else {
 		out.AdditionalRoutes = nil
 	}
+	out.PodRangeName = in.PodRangeName
+	out.ServiceRangeName = in.ServiceRangeName
 	return nil
 }
 
@@ -3292,6 +4624,8 @@ func autoConvert_kops_ClusterSubnetSpec_To_v1alpha2_ClusterSubnetSpec(in *kops.C
 	} else {
 		out.AdditionalRoutes = nil
 	}
+	out.PodRangeName = in.PodRangeName
+	out.ServiceRangeName = in.ServiceRangeName
 	return nil
 }
 
@@ -3315,6 +4649,19 @@ func autoConvert_v1alpha2_ContainerdConfig_To_kops_ContainerdConfig(in *Containe
 		out.Packages = nil
 	}
 	out.RegistryMirrors = in.RegistryMirrors
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make(map[string]kops.ContainerdRegistryConfig, len(*in))
+		for key, val := range *in {
+			newVal := new(kops.ContainerdRegistryConfig)
+			if err := Convert_v1alpha2_ContainerdRegistryConfig_To_kops_ContainerdRegistryConfig(&val, newVal, s); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.Registries = nil
+	}
 	out.Root = in.Root
 	out.SkipInstall = in.SkipInstall
 	out.State = in.State
@@ -3383,6 +4730,19 @@ func autoConvert_kops_ContainerdConfig_To_v1alpha2_ContainerdConfig(in *kops.Con
 		out.Packages = nil
 	}
 	out.RegistryMirrors = in.RegistryMirrors
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make(map[string]ContainerdRegistryConfig, len(*in))
+		for key, val := range *in {
+			newVal := new(ContainerdRegistryConfig)
+			if err := Convert_kops_ContainerdRegistryConfig_To_v1alpha2_ContainerdRegistryConfig(&val, newVal, s); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.Registries = nil
+	}
 	out.Root = in.Root
 	out.SkipInstall = in.SkipInstall
 	out.State = in.State
@@ -3436,6 +4796,50 @@ func Convert_kops_ContainerdConfig_To_v1alpha2_ContainerdConfig(in *kops.Contain
 	return autoConvert_kops_ContainerdConfig_To_v1alpha2_ContainerdConfig(in, out, s)
 }
 
+func autoConvert_v1alpha2_ContainerdRegistryConfig_To_kops_ContainerdRegistryConfig(in *ContainerdRegistryConfig, out *kops.ContainerdRegistryConfig, s conversion.Scope) error {
+	out.CA = in.CA
+	out.SkipVerify = in.SkipVerify
+	out.UseDockerConfigAuth = in.UseDockerConfigAuth
+	return nil
+}
+
+// Convert_v1alpha2_ContainerdRegistryConfig_To_kops_ContainerdRegistryConfig is an autogenerated conversion function.
+func Convert_v1alpha2_ContainerdRegistryConfig_To_kops_ContainerdRegistryConfig(in *ContainerdRegistryConfig, out *kops.ContainerdRegistryConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ContainerdRegistryConfig_To_kops_ContainerdRegistryConfig(in, out, s)
+}
+
+func autoConvert_kops_ContainerdRegistryConfig_To_v1alpha2_ContainerdRegistryConfig(in *kops.ContainerdRegistryConfig, out *ContainerdRegistryConfig, s conversion.Scope) error {
+	out.CA = in.CA
+	out.SkipVerify = in.SkipVerify
+	out.UseDockerConfigAuth = in.UseDockerConfigAuth
+	return nil
+}
+
+// Convert_kops_ContainerdRegistryConfig_To_v1alpha2_ContainerdRegistryConfig is an autogenerated conversion function.
+func Convert_kops_ContainerdRegistryConfig_To_v1alpha2_ContainerdRegistryConfig(in *kops.ContainerdRegistryConfig, out *ContainerdRegistryConfig, s conversion.Scope) error {
+	return autoConvert_kops_ContainerdRegistryConfig_To_v1alpha2_ContainerdRegistryConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_ContinuousApplySpec_To_kops_ContinuousApplySpec(in *ContinuousApplySpec, out *kops.ContinuousApplySpec, s conversion.Scope) error {
+	out.Interval = in.Interval
+	return nil
+}
+
+// Convert_v1alpha2_ContinuousApplySpec_To_kops_ContinuousApplySpec is an autogenerated conversion function.
+func Convert_v1alpha2_ContinuousApplySpec_To_kops_ContinuousApplySpec(in *ContinuousApplySpec, out *kops.ContinuousApplySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ContinuousApplySpec_To_kops_ContinuousApplySpec(in, out, s)
+}
+
+func autoConvert_kops_ContinuousApplySpec_To_v1alpha2_ContinuousApplySpec(in *kops.ContinuousApplySpec, out *ContinuousApplySpec, s conversion.Scope) error {
+	out.Interval = in.Interval
+	return nil
+}
+
+// Convert_kops_ContinuousApplySpec_To_v1alpha2_ContinuousApplySpec is an autogenerated conversion function.
+func Convert_kops_ContinuousApplySpec_To_v1alpha2_ContinuousApplySpec(in *kops.ContinuousApplySpec, out *ContinuousApplySpec, s conversion.Scope) error {
+	return autoConvert_kops_ContinuousApplySpec_To_v1alpha2_ContinuousApplySpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_DCGMExporterConfig_To_kops_DCGMExporterConfig(in *DCGMExporterConfig, out *kops.DCGMExporterConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -3544,6 +4948,66 @@ func Convert_kops_DNSControllerGossipConfigSecondary_To_v1alpha2_DNSControllerGo
 	return autoConvert_kops_DNSControllerGossipConfigSecondary_To_v1alpha2_DNSControllerGossipConfigSecondary(in, out, s)
 }
 
+func autoConvert_v1alpha2_DOLoadBalancerSpec_To_kops_DOLoadBalancerSpec(in *DOLoadBalancerSpec, out *kops.DOLoadBalancerSpec, s conversion.Scope) error {
+	out.SizeUnit = in.SizeUnit
+	out.Algorithm = in.Algorithm
+	return nil
+}
+
+// Convert_v1alpha2_DOLoadBalancerSpec_To_kops_DOLoadBalancerSpec is an autogenerated conversion function.
+func Convert_v1alpha2_DOLoadBalancerSpec_To_kops_DOLoadBalancerSpec(in *DOLoadBalancerSpec, out *kops.DOLoadBalancerSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_DOLoadBalancerSpec_To_kops_DOLoadBalancerSpec(in, out, s)
+}
+
+func autoConvert_kops_DOLoadBalancerSpec_To_v1alpha2_DOLoadBalancerSpec(in *kops.DOLoadBalancerSpec, out *DOLoadBalancerSpec, s conversion.Scope) error {
+	out.SizeUnit = in.SizeUnit
+	out.Algorithm = in.Algorithm
+	return nil
+}
+
+// Convert_kops_DOLoadBalancerSpec_To_v1alpha2_DOLoadBalancerSpec is an autogenerated conversion function.
+func Convert_kops_DOLoadBalancerSpec_To_v1alpha2_DOLoadBalancerSpec(in *kops.DOLoadBalancerSpec, out *DOLoadBalancerSpec, s conversion.Scope) error {
+	return autoConvert_kops_DOLoadBalancerSpec_To_v1alpha2_DOLoadBalancerSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_DOSpec_To_kops_DOSpec(in *DOSpec, out *kops.DOSpec, s conversion.Scope) error {
+	out.VPCNativeRouting = in.VPCNativeRouting
+	if in.APILoadBalancer != nil {
+		in, out := &in.APILoadBalancer, &out.APILoadBalancer
+		*out = new(kops.DOLoadBalancerSpec)
+		if err := Convert_v1alpha2_DOLoadBalancerSpec_To_kops_DOLoadBalancerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.APILoadBalancer = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_DOSpec_To_kops_DOSpec is an autogenerated conversion function.
+func Convert_v1alpha2_DOSpec_To_kops_DOSpec(in *DOSpec, out *kops.DOSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_DOSpec_To_kops_DOSpec(in, out, s)
+}
+
+func autoConvert_kops_DOSpec_To_v1alpha2_DOSpec(in *kops.DOSpec, out *DOSpec, s conversion.Scope) error {
+	out.VPCNativeRouting = in.VPCNativeRouting
+	if in.APILoadBalancer != nil {
+		in, out := &in.APILoadBalancer, &out.APILoadBalancer
+		*out = new(DOLoadBalancerSpec)
+		if err := Convert_kops_DOLoadBalancerSpec_To_v1alpha2_DOLoadBalancerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.APILoadBalancer = nil
+	}
+	return nil
+}
+
+// Convert_kops_DOSpec_To_v1alpha2_DOSpec is an autogenerated conversion function.
+func Convert_kops_DOSpec_To_v1alpha2_DOSpec(in *kops.DOSpec, out *DOSpec, s conversion.Scope) error {
+	return autoConvert_kops_DOSpec_To_v1alpha2_DOSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_DiscoveryServiceOptions_To_kops_DiscoveryServiceOptions(in *DiscoveryServiceOptions, out *kops.DiscoveryServiceOptions, s conversion.Scope) error {
 	out.URL = in.URL
 	return nil
@@ -3748,9 +5212,40 @@ func Convert_kops_EnvVar_To_v1alpha2_EnvVar(in *kops.EnvVar, out *EnvVar, s conv
 	return autoConvert_kops_EnvVar_To_v1alpha2_EnvVar(in, out, s)
 }
 
+func autoConvert_v1alpha2_EtcdBackupS3Spec_To_kops_EtcdBackupS3Spec(in *EtcdBackupS3Spec, out *kops.EtcdBackupS3Spec, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_v1alpha2_EtcdBackupS3Spec_To_kops_EtcdBackupS3Spec is an autogenerated conversion function.
+func Convert_v1alpha2_EtcdBackupS3Spec_To_kops_EtcdBackupS3Spec(in *EtcdBackupS3Spec, out *kops.EtcdBackupS3Spec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EtcdBackupS3Spec_To_kops_EtcdBackupS3Spec(in, out, s)
+}
+
+func autoConvert_kops_EtcdBackupS3Spec_To_v1alpha2_EtcdBackupS3Spec(in *kops.EtcdBackupS3Spec, out *EtcdBackupS3Spec, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_kops_EtcdBackupS3Spec_To_v1alpha2_EtcdBackupS3Spec is an autogenerated conversion function.
+func Convert_kops_EtcdBackupS3Spec_To_v1alpha2_EtcdBackupS3Spec(in *kops.EtcdBackupS3Spec, out *EtcdBackupS3Spec, s conversion.Scope) error {
+	return autoConvert_kops_EtcdBackupS3Spec_To_v1alpha2_EtcdBackupS3Spec(in, out, s)
+}
+
 func autoConvert_v1alpha2_EtcdBackupSpec_To_kops_EtcdBackupSpec(in *EtcdBackupSpec, out *kops.EtcdBackupSpec, s conversion.Scope) error {
 	out.BackupStore = in.BackupStore
 	out.Image = in.Image
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(kops.EtcdBackupS3Spec)
+		if err := Convert_v1alpha2_EtcdBackupS3Spec_To_kops_EtcdBackupS3Spec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.S3 = nil
+	}
 	return nil
 }
 
@@ -3762,6 +5257,15 @@ func Convert_v1alpha2_EtcdBackupSpec_To_kops_EtcdBackupSpec(in *EtcdBackupSpec,
 func autoConvert_kops_EtcdBackupSpec_To_v1alpha2_EtcdBackupSpec(in *kops.EtcdBackupSpec, out *EtcdBackupSpec, s conversion.Scope) error {
 	out.BackupStore = in.BackupStore
 	out.Image = in.Image
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(EtcdBackupS3Spec)
+		if err := Convert_kops_EtcdBackupS3Spec_To_v1alpha2_EtcdBackupS3Spec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.S3 = nil
+	}
 	return nil
 }
 
@@ -3810,6 +5314,15 @@ func autoConvert_v1alpha2_EtcdClusterSpec_To_kops_EtcdClusterSpec(in *EtcdCluste
 	}
 	out.MemoryRequest = in.MemoryRequest
 	out.CPURequest = in.CPURequest
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(kops.EtcdExternalSpec)
+		if err := Convert_v1alpha2_EtcdExternalSpec_To_kops_EtcdExternalSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.External = nil
+	}
 	return nil
 }
 
@@ -3856,6 +5369,15 @@ func autoConvert_kops_EtcdClusterSpec_To_v1alpha2_EtcdClusterSpec(in *kops.EtcdC
 	}
 	out.MemoryRequest = in.MemoryRequest
 	out.CPURequest = in.CPURequest
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(EtcdExternalSpec)
+		if err := Convert_kops_EtcdExternalSpec_To_v1alpha2_EtcdExternalSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.External = nil
+	}
 	return nil
 }
 
@@ -3864,6 +5386,28 @@ func Convert_kops_EtcdClusterSpec_To_v1alpha2_EtcdClusterSpec(in *kops.EtcdClust
 	return autoConvert_kops_EtcdClusterSpec_To_v1alpha2_EtcdClusterSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_EtcdExternalSpec_To_kops_EtcdExternalSpec(in *EtcdExternalSpec, out *kops.EtcdExternalSpec, s conversion.Scope) error {
+	out.Endpoints = in.Endpoints
+	out.CACertificate = in.CACertificate
+	return nil
+}
+
+// Convert_v1alpha2_EtcdExternalSpec_To_kops_EtcdExternalSpec is an autogenerated conversion function.
+func Convert_v1alpha2_EtcdExternalSpec_To_kops_EtcdExternalSpec(in *EtcdExternalSpec, out *kops.EtcdExternalSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EtcdExternalSpec_To_kops_EtcdExternalSpec(in, out, s)
+}
+
+func autoConvert_kops_EtcdExternalSpec_To_v1alpha2_EtcdExternalSpec(in *kops.EtcdExternalSpec, out *EtcdExternalSpec, s conversion.Scope) error {
+	out.Endpoints = in.Endpoints
+	out.CACertificate = in.CACertificate
+	return nil
+}
+
+// Convert_kops_EtcdExternalSpec_To_v1alpha2_EtcdExternalSpec is an autogenerated conversion function.
+func Convert_kops_EtcdExternalSpec_To_v1alpha2_EtcdExternalSpec(in *kops.EtcdExternalSpec, out *EtcdExternalSpec, s conversion.Scope) error {
+	return autoConvert_kops_EtcdExternalSpec_To_v1alpha2_EtcdExternalSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_EtcdManagerSpec_To_kops_EtcdManagerSpec(in *EtcdManagerSpec, out *kops.EtcdManagerSpec, s conversion.Scope) error {
 	out.Image = in.Image
 	if in.Env != nil {
@@ -3883,6 +5427,10 @@ func autoConvert_v1alpha2_EtcdManagerSpec_To_kops_EtcdManagerSpec(in *EtcdManage
 	out.ListenMetricsURLs = in.ListenMetricsURLs
 	out.ListenClientHTTPURLs = in.ListenClientHTTPURLs
 	out.LogLevel = in.LogLevel
+	out.QuotaBackendBytes = in.QuotaBackendBytes
+	out.AutoCompactionMode = in.AutoCompactionMode
+	out.AutoCompactionRetention = in.AutoCompactionRetention
+	out.DefragInterval = in.DefragInterval
 	return nil
 }
 
@@ -3910,6 +5458,10 @@ func autoConvert_kops_EtcdManagerSpec_To_v1alpha2_EtcdManagerSpec(in *kops.EtcdM
 	out.ListenMetricsURLs = in.ListenMetricsURLs
 	out.ListenClientHTTPURLs = in.ListenClientHTTPURLs
 	out.LogLevel = in.LogLevel
+	out.QuotaBackendBytes = in.QuotaBackendBytes
+	out.AutoCompactionMode = in.AutoCompactionMode
+	out.AutoCompactionRetention = in.AutoCompactionRetention
+	out.DefragInterval = in.DefragInterval
 	return nil
 }
 
@@ -3952,6 +5504,72 @@ func Convert_kops_EtcdMemberSpec_To_v1alpha2_EtcdMemberSpec(in *kops.EtcdMemberS
 	return autoConvert_kops_EtcdMemberSpec_To_v1alpha2_EtcdMemberSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_EventRateLimit_To_kops_EventRateLimit(in *EventRateLimit, out *kops.EventRateLimit, s conversion.Scope) error {
+	out.Type = in.Type
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	out.CacheSize = in.CacheSize
+	return nil
+}
+
+// Convert_v1alpha2_EventRateLimit_To_kops_EventRateLimit is an autogenerated conversion function.
+func Convert_v1alpha2_EventRateLimit_To_kops_EventRateLimit(in *EventRateLimit, out *kops.EventRateLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EventRateLimit_To_kops_EventRateLimit(in, out, s)
+}
+
+func autoConvert_kops_EventRateLimit_To_v1alpha2_EventRateLimit(in *kops.EventRateLimit, out *EventRateLimit, s conversion.Scope) error {
+	out.Type = in.Type
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	out.CacheSize = in.CacheSize
+	return nil
+}
+
+// Convert_kops_EventRateLimit_To_v1alpha2_EventRateLimit is an autogenerated conversion function.
+func Convert_kops_EventRateLimit_To_v1alpha2_EventRateLimit(in *kops.EventRateLimit, out *EventRateLimit, s conversion.Scope) error {
+	return autoConvert_kops_EventRateLimit_To_v1alpha2_EventRateLimit(in, out, s)
+}
+
+func autoConvert_v1alpha2_EventRateLimitAdmissionSpec_To_kops_EventRateLimitAdmissionSpec(in *EventRateLimitAdmissionSpec, out *kops.EventRateLimitAdmissionSpec, s conversion.Scope) error {
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]kops.EventRateLimit, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_EventRateLimit_To_kops_EventRateLimit(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Limits = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_EventRateLimitAdmissionSpec_To_kops_EventRateLimitAdmissionSpec is an autogenerated conversion function.
+func Convert_v1alpha2_EventRateLimitAdmissionSpec_To_kops_EventRateLimitAdmissionSpec(in *EventRateLimitAdmissionSpec, out *kops.EventRateLimitAdmissionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EventRateLimitAdmissionSpec_To_kops_EventRateLimitAdmissionSpec(in, out, s)
+}
+
+func autoConvert_kops_EventRateLimitAdmissionSpec_To_v1alpha2_EventRateLimitAdmissionSpec(in *kops.EventRateLimitAdmissionSpec, out *EventRateLimitAdmissionSpec, s conversion.Scope) error {
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]EventRateLimit, len(*in))
+		for i := range *in {
+			if err := Convert_kops_EventRateLimit_To_v1alpha2_EventRateLimit(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Limits = nil
+	}
+	return nil
+}
+
+// Convert_kops_EventRateLimitAdmissionSpec_To_v1alpha2_EventRateLimitAdmissionSpec is an autogenerated conversion function.
+func Convert_kops_EventRateLimitAdmissionSpec_To_v1alpha2_EventRateLimitAdmissionSpec(in *kops.EventRateLimitAdmissionSpec, out *EventRateLimitAdmissionSpec, s conversion.Scope) error {
+	return autoConvert_kops_EventRateLimitAdmissionSpec_To_v1alpha2_EventRateLimitAdmissionSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_ExecContainerAction_To_kops_ExecContainerAction(in *ExecContainerAction, out *kops.ExecContainerAction, s conversion.Scope) error {
 	out.Image = in.Image
 	out.Command = in.Command
@@ -4057,6 +5675,36 @@ func Convert_kops_FileAssetSpec_To_v1alpha2_FileAssetSpec(in *kops.FileAssetSpec
 	return autoConvert_kops_FileAssetSpec_To_v1alpha2_FileAssetSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_FirewallRuleSpec_To_kops_FirewallRuleSpec(in *FirewallRuleSpec, out *kops.FirewallRuleSpec, s conversion.Scope) error {
+	out.Description = in.Description
+	out.Direction = kops.FirewallRuleDirection(in.Direction)
+	out.Protocol = in.Protocol
+	out.FromPort = in.FromPort
+	out.ToPort = in.ToPort
+	out.CIDRs = in.CIDRs
+	return nil
+}
+
+// Convert_v1alpha2_FirewallRuleSpec_To_kops_FirewallRuleSpec is an autogenerated conversion function.
+func Convert_v1alpha2_FirewallRuleSpec_To_kops_FirewallRuleSpec(in *FirewallRuleSpec, out *kops.FirewallRuleSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_FirewallRuleSpec_To_kops_FirewallRuleSpec(in, out, s)
+}
+
+func autoConvert_kops_FirewallRuleSpec_To_v1alpha2_FirewallRuleSpec(in *kops.FirewallRuleSpec, out *FirewallRuleSpec, s conversion.Scope) error {
+	out.Description = in.Description
+	out.Direction = FirewallRuleDirection(in.Direction)
+	out.Protocol = in.Protocol
+	out.FromPort = in.FromPort
+	out.ToPort = in.ToPort
+	out.CIDRs = in.CIDRs
+	return nil
+}
+
+// Convert_kops_FirewallRuleSpec_To_v1alpha2_FirewallRuleSpec is an autogenerated conversion function.
+func Convert_kops_FirewallRuleSpec_To_v1alpha2_FirewallRuleSpec(in *kops.FirewallRuleSpec, out *FirewallRuleSpec, s conversion.Scope) error {
+	return autoConvert_kops_FirewallRuleSpec_To_v1alpha2_FirewallRuleSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_FlannelNetworkingSpec_To_kops_FlannelNetworkingSpec(in *FlannelNetworkingSpec, out *kops.FlannelNetworkingSpec, s conversion.Scope) error {
 	out.Backend = in.Backend
 	// INFO: in.DisableTxChecksumOffloading opted out of conversion generation
@@ -4174,16 +5822,38 @@ func Convert_v1alpha2_GossipConfigSecondary_To_kops_GossipConfigSecondary(in *Go
 	return autoConvert_v1alpha2_GossipConfigSecondary_To_kops_GossipConfigSecondary(in, out, s)
 }
 
-func autoConvert_kops_GossipConfigSecondary_To_v1alpha2_GossipConfigSecondary(in *kops.GossipConfigSecondary, out *GossipConfigSecondary, s conversion.Scope) error {
-	out.Protocol = in.Protocol
-	out.Listen = in.Listen
-	out.Secret = in.Secret
+func autoConvert_kops_GossipConfigSecondary_To_v1alpha2_GossipConfigSecondary(in *kops.GossipConfigSecondary, out *GossipConfigSecondary, s conversion.Scope) error {
+	out.Protocol = in.Protocol
+	out.Listen = in.Listen
+	out.Secret = in.Secret
+	return nil
+}
+
+// Convert_kops_GossipConfigSecondary_To_v1alpha2_GossipConfigSecondary is an autogenerated conversion function.
+func Convert_kops_GossipConfigSecondary_To_v1alpha2_GossipConfigSecondary(in *kops.GossipConfigSecondary, out *GossipConfigSecondary, s conversion.Scope) error {
+	return autoConvert_kops_GossipConfigSecondary_To_v1alpha2_GossipConfigSecondary(in, out, s)
+}
+
+func autoConvert_v1alpha2_GracefulNodeDrainSpec_To_kops_GracefulNodeDrainSpec(in *GracefulNodeDrainSpec, out *kops.GracefulNodeDrainSpec, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.DrainTimeout = in.DrainTimeout
+	return nil
+}
+
+// Convert_v1alpha2_GracefulNodeDrainSpec_To_kops_GracefulNodeDrainSpec is an autogenerated conversion function.
+func Convert_v1alpha2_GracefulNodeDrainSpec_To_kops_GracefulNodeDrainSpec(in *GracefulNodeDrainSpec, out *kops.GracefulNodeDrainSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_GracefulNodeDrainSpec_To_kops_GracefulNodeDrainSpec(in, out, s)
+}
+
+func autoConvert_kops_GracefulNodeDrainSpec_To_v1alpha2_GracefulNodeDrainSpec(in *kops.GracefulNodeDrainSpec, out *GracefulNodeDrainSpec, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.DrainTimeout = in.DrainTimeout
 	return nil
 }
 
-// Convert_kops_GossipConfigSecondary_To_v1alpha2_GossipConfigSecondary is an autogenerated conversion function.
-func Convert_kops_GossipConfigSecondary_To_v1alpha2_GossipConfigSecondary(in *kops.GossipConfigSecondary, out *GossipConfigSecondary, s conversion.Scope) error {
-	return autoConvert_kops_GossipConfigSecondary_To_v1alpha2_GossipConfigSecondary(in, out, s)
+// Convert_kops_GracefulNodeDrainSpec_To_v1alpha2_GracefulNodeDrainSpec is an autogenerated conversion function.
+func Convert_kops_GracefulNodeDrainSpec_To_v1alpha2_GracefulNodeDrainSpec(in *kops.GracefulNodeDrainSpec, out *GracefulNodeDrainSpec, s conversion.Scope) error {
+	return autoConvert_kops_GracefulNodeDrainSpec_To_v1alpha2_GracefulNodeDrainSpec(in, out, s)
 }
 
 func autoConvert_v1alpha2_HTTPProxy_To_kops_HTTPProxy(in *HTTPProxy, out *kops.HTTPProxy, s conversion.Scope) error {
@@ -4208,6 +5878,56 @@ func Convert_kops_HTTPProxy_To_v1alpha2_HTTPProxy(in *kops.HTTPProxy, out *HTTPP
 	return autoConvert_kops_HTTPProxy_To_v1alpha2_HTTPProxy(in, out, s)
 }
 
+func autoConvert_v1alpha2_HardeningSpec_To_kops_HardeningSpec(in *HardeningSpec, out *kops.HardeningSpec, s conversion.Scope) error {
+	out.Profile = in.Profile
+	return nil
+}
+
+// Convert_v1alpha2_HardeningSpec_To_kops_HardeningSpec is an autogenerated conversion function.
+func Convert_v1alpha2_HardeningSpec_To_kops_HardeningSpec(in *HardeningSpec, out *kops.HardeningSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_HardeningSpec_To_kops_HardeningSpec(in, out, s)
+}
+
+func autoConvert_kops_HardeningSpec_To_v1alpha2_HardeningSpec(in *kops.HardeningSpec, out *HardeningSpec, s conversion.Scope) error {
+	out.Profile = in.Profile
+	return nil
+}
+
+// Convert_kops_HardeningSpec_To_v1alpha2_HardeningSpec is an autogenerated conversion function.
+func Convert_kops_HardeningSpec_To_v1alpha2_HardeningSpec(in *kops.HardeningSpec, out *HardeningSpec, s conversion.Scope) error {
+	return autoConvert_kops_HardeningSpec_To_v1alpha2_HardeningSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_HelmChartSpec_To_kops_HelmChartSpec(in *HelmChartSpec, out *kops.HelmChartSpec, s conversion.Scope) error {
+	out.Repository = in.Repository
+	out.Name = in.Name
+	out.Version = in.Version
+	out.ReleaseName = in.ReleaseName
+	out.Namespace = in.Namespace
+	out.Values = in.Values
+	return nil
+}
+
+// Convert_v1alpha2_HelmChartSpec_To_kops_HelmChartSpec is an autogenerated conversion function.
+func Convert_v1alpha2_HelmChartSpec_To_kops_HelmChartSpec(in *HelmChartSpec, out *kops.HelmChartSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_HelmChartSpec_To_kops_HelmChartSpec(in, out, s)
+}
+
+func autoConvert_kops_HelmChartSpec_To_v1alpha2_HelmChartSpec(in *kops.HelmChartSpec, out *HelmChartSpec, s conversion.Scope) error {
+	out.Repository = in.Repository
+	out.Name = in.Name
+	out.Version = in.Version
+	out.ReleaseName = in.ReleaseName
+	out.Namespace = in.Namespace
+	out.Values = in.Values
+	return nil
+}
+
+// Convert_kops_HelmChartSpec_To_v1alpha2_HelmChartSpec is an autogenerated conversion function.
+func Convert_kops_HelmChartSpec_To_v1alpha2_HelmChartSpec(in *kops.HelmChartSpec, out *HelmChartSpec, s conversion.Scope) error {
+	return autoConvert_kops_HelmChartSpec_To_v1alpha2_HelmChartSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_HookSpec_To_kops_HookSpec(in *HookSpec, out *kops.HookSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Enabled = in.Enabled
@@ -4356,9 +6076,49 @@ func Convert_kops_HostSpec_To_v1alpha2_HostSpec(in *kops.HostSpec, out *HostSpec
 	return autoConvert_kops_HostSpec_To_v1alpha2_HostSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_HubbleRelaySpec_To_kops_HubbleRelaySpec(in *HubbleRelaySpec, out *kops.HubbleRelaySpec, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ServerTLS = in.ServerTLS
+	return nil
+}
+
+// Convert_v1alpha2_HubbleRelaySpec_To_kops_HubbleRelaySpec is an autogenerated conversion function.
+func Convert_v1alpha2_HubbleRelaySpec_To_kops_HubbleRelaySpec(in *HubbleRelaySpec, out *kops.HubbleRelaySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_HubbleRelaySpec_To_kops_HubbleRelaySpec(in, out, s)
+}
+
+func autoConvert_kops_HubbleRelaySpec_To_v1alpha2_HubbleRelaySpec(in *kops.HubbleRelaySpec, out *HubbleRelaySpec, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ServerTLS = in.ServerTLS
+	return nil
+}
+
+// Convert_kops_HubbleRelaySpec_To_v1alpha2_HubbleRelaySpec is an autogenerated conversion function.
+func Convert_kops_HubbleRelaySpec_To_v1alpha2_HubbleRelaySpec(in *kops.HubbleRelaySpec, out *HubbleRelaySpec, s conversion.Scope) error {
+	return autoConvert_kops_HubbleRelaySpec_To_v1alpha2_HubbleRelaySpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_HubbleSpec_To_kops_HubbleSpec(in *HubbleSpec, out *kops.HubbleSpec, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.Metrics = in.Metrics
+	if in.Relay != nil {
+		in, out := &in.Relay, &out.Relay
+		*out = new(kops.HubbleRelaySpec)
+		if err := Convert_v1alpha2_HubbleRelaySpec_To_kops_HubbleRelaySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Relay = nil
+	}
+	if in.UI != nil {
+		in, out := &in.UI, &out.UI
+		*out = new(kops.HubbleUISpec)
+		if err := Convert_v1alpha2_HubbleUISpec_To_kops_HubbleUISpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.UI = nil
+	}
 	return nil
 }
 
@@ -4370,6 +6130,24 @@ func Convert_v1alpha2_HubbleSpec_To_kops_HubbleSpec(in *HubbleSpec, out *kops.Hu
 func autoConvert_kops_HubbleSpec_To_v1alpha2_HubbleSpec(in *kops.HubbleSpec, out *HubbleSpec, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.Metrics = in.Metrics
+	if in.Relay != nil {
+		in, out := &in.Relay, &out.Relay
+		*out = new(HubbleRelaySpec)
+		if err := Convert_kops_HubbleRelaySpec_To_v1alpha2_HubbleRelaySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Relay = nil
+	}
+	if in.UI != nil {
+		in, out := &in.UI, &out.UI
+		*out = new(HubbleUISpec)
+		if err := Convert_kops_HubbleUISpec_To_v1alpha2_HubbleUISpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.UI = nil
+	}
 	return nil
 }
 
@@ -4378,6 +6156,28 @@ func Convert_kops_HubbleSpec_To_v1alpha2_HubbleSpec(in *kops.HubbleSpec, out *Hu
 	return autoConvert_kops_HubbleSpec_To_v1alpha2_HubbleSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_HubbleUISpec_To_kops_HubbleUISpec(in *HubbleUISpec, out *kops.HubbleUISpec, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1alpha2_HubbleUISpec_To_kops_HubbleUISpec is an autogenerated conversion function.
+func Convert_v1alpha2_HubbleUISpec_To_kops_HubbleUISpec(in *HubbleUISpec, out *kops.HubbleUISpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_HubbleUISpec_To_kops_HubbleUISpec(in, out, s)
+}
+
+func autoConvert_kops_HubbleUISpec_To_v1alpha2_HubbleUISpec(in *kops.HubbleUISpec, out *HubbleUISpec, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_kops_HubbleUISpec_To_v1alpha2_HubbleUISpec is an autogenerated conversion function.
+func Convert_kops_HubbleUISpec_To_v1alpha2_HubbleUISpec(in *kops.HubbleUISpec, out *HubbleUISpec, s conversion.Scope) error {
+	return autoConvert_kops_HubbleUISpec_To_v1alpha2_HubbleUISpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_IAMProfileSpec_To_kops_IAMProfileSpec(in *IAMProfileSpec, out *kops.IAMProfileSpec, s conversion.Scope) error {
 	out.Profile = in.Profile
 	return nil
@@ -4446,6 +6246,58 @@ func Convert_kops_IAMSpec_To_v1alpha2_IAMSpec(in *kops.IAMSpec, out *IAMSpec, s
 	return autoConvert_kops_IAMSpec_To_v1alpha2_IAMSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_ImagePatchingSpec_To_kops_ImagePatchingSpec(in *ImagePatchingSpec, out *kops.ImagePatchingSpec, s conversion.Scope) error {
+	out.Interval = in.Interval
+	out.RollingUpdate = in.RollingUpdate
+	return nil
+}
+
+// Convert_v1alpha2_ImagePatchingSpec_To_kops_ImagePatchingSpec is an autogenerated conversion function.
+func Convert_v1alpha2_ImagePatchingSpec_To_kops_ImagePatchingSpec(in *ImagePatchingSpec, out *kops.ImagePatchingSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ImagePatchingSpec_To_kops_ImagePatchingSpec(in, out, s)
+}
+
+func autoConvert_kops_ImagePatchingSpec_To_v1alpha2_ImagePatchingSpec(in *kops.ImagePatchingSpec, out *ImagePatchingSpec, s conversion.Scope) error {
+	out.Interval = in.Interval
+	out.RollingUpdate = in.RollingUpdate
+	return nil
+}
+
+// Convert_kops_ImagePatchingSpec_To_v1alpha2_ImagePatchingSpec is an autogenerated conversion function.
+func Convert_kops_ImagePatchingSpec_To_v1alpha2_ImagePatchingSpec(in *kops.ImagePatchingSpec, out *ImagePatchingSpec, s conversion.Scope) error {
+	return autoConvert_kops_ImagePatchingSpec_To_v1alpha2_ImagePatchingSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_ImagePolicyWebhookAdmissionSpec_To_kops_ImagePolicyWebhookAdmissionSpec(in *ImagePolicyWebhookAdmissionSpec, out *kops.ImagePolicyWebhookAdmissionSpec, s conversion.Scope) error {
+	out.Server = in.Server
+	out.CACertificate = in.CACertificate
+	out.AllowTTL = in.AllowTTL
+	out.DenyTTL = in.DenyTTL
+	out.RetryBackoff = in.RetryBackoff
+	out.DefaultAllow = in.DefaultAllow
+	return nil
+}
+
+// Convert_v1alpha2_ImagePolicyWebhookAdmissionSpec_To_kops_ImagePolicyWebhookAdmissionSpec is an autogenerated conversion function.
+func Convert_v1alpha2_ImagePolicyWebhookAdmissionSpec_To_kops_ImagePolicyWebhookAdmissionSpec(in *ImagePolicyWebhookAdmissionSpec, out *kops.ImagePolicyWebhookAdmissionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ImagePolicyWebhookAdmissionSpec_To_kops_ImagePolicyWebhookAdmissionSpec(in, out, s)
+}
+
+func autoConvert_kops_ImagePolicyWebhookAdmissionSpec_To_v1alpha2_ImagePolicyWebhookAdmissionSpec(in *kops.ImagePolicyWebhookAdmissionSpec, out *ImagePolicyWebhookAdmissionSpec, s conversion.Scope) error {
+	out.Server = in.Server
+	out.CACertificate = in.CACertificate
+	out.AllowTTL = in.AllowTTL
+	out.DenyTTL = in.DenyTTL
+	out.RetryBackoff = in.RetryBackoff
+	out.DefaultAllow = in.DefaultAllow
+	return nil
+}
+
+// Convert_kops_ImagePolicyWebhookAdmissionSpec_To_v1alpha2_ImagePolicyWebhookAdmissionSpec is an autogenerated conversion function.
+func Convert_kops_ImagePolicyWebhookAdmissionSpec_To_v1alpha2_ImagePolicyWebhookAdmissionSpec(in *kops.ImagePolicyWebhookAdmissionSpec, out *ImagePolicyWebhookAdmissionSpec, s conversion.Scope) error {
+	return autoConvert_kops_ImagePolicyWebhookAdmissionSpec_To_v1alpha2_ImagePolicyWebhookAdmissionSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_InstanceGroup_To_kops_InstanceGroup(in *InstanceGroup, out *kops.InstanceGroup, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha2_InstanceGroupSpec_To_kops_InstanceGroupSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -4472,6 +6324,32 @@ func Convert_kops_InstanceGroup_To_v1alpha2_InstanceGroup(in *kops.InstanceGroup
 	return autoConvert_kops_InstanceGroup_To_v1alpha2_InstanceGroup(in, out, s)
 }
 
+func autoConvert_v1alpha2_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(in *InstanceGroupClusterAutoscalerSpec, out *kops.InstanceGroupClusterAutoscalerSpec, s conversion.Scope) error {
+	out.ScaleDownUtilizationThreshold = in.ScaleDownUtilizationThreshold
+	out.ScaleDownUnneededTime = in.ScaleDownUnneededTime
+	out.ScaleDownUnreadyTime = in.ScaleDownUnreadyTime
+	out.IgnoreDaemonSetsUtilization = in.IgnoreDaemonSetsUtilization
+	return nil
+}
+
+// Convert_v1alpha2_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec is an autogenerated conversion function.
+func Convert_v1alpha2_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(in *InstanceGroupClusterAutoscalerSpec, out *kops.InstanceGroupClusterAutoscalerSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(in, out, s)
+}
+
+func autoConvert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha2_InstanceGroupClusterAutoscalerSpec(in *kops.InstanceGroupClusterAutoscalerSpec, out *InstanceGroupClusterAutoscalerSpec, s conversion.Scope) error {
+	out.ScaleDownUtilizationThreshold = in.ScaleDownUtilizationThreshold
+	out.ScaleDownUnneededTime = in.ScaleDownUnneededTime
+	out.ScaleDownUnreadyTime = in.ScaleDownUnreadyTime
+	out.IgnoreDaemonSetsUtilization = in.IgnoreDaemonSetsUtilization
+	return nil
+}
+
+// Convert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha2_InstanceGroupClusterAutoscalerSpec is an autogenerated conversion function.
+func Convert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha2_InstanceGroupClusterAutoscalerSpec(in *kops.InstanceGroupClusterAutoscalerSpec, out *InstanceGroupClusterAutoscalerSpec, s conversion.Scope) error {
+	return autoConvert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha2_InstanceGroupClusterAutoscalerSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_InstanceGroupList_To_kops_InstanceGroupList(in *InstanceGroupList, out *kops.InstanceGroupList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4522,6 +6400,15 @@ func autoConvert_v1alpha2_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *Instan
 	out.MaxSize = in.MaxSize
 	out.Autoscale = in.Autoscale
 	out.AutoscalePriority = in.AutoscalePriority
+	if in.ClusterAutoscaler != nil {
+		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
+		*out = new(kops.InstanceGroupClusterAutoscalerSpec)
+		if err := Convert_v1alpha2_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterAutoscaler = nil
+	}
 	out.MachineType = in.MachineType
 	out.RootVolume = in.RootVolume
 	// INFO: in.RootVolumeSize opted out of conversion generation
@@ -4569,9 +6456,21 @@ func autoConvert_v1alpha2_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *Instan
 	}
 	out.MaxPrice = in.MaxPrice
 	out.SpotDurationInMinutes = in.SpotDurationInMinutes
+	out.OnDemandMaxPrice = in.OnDemandMaxPrice
 	out.CPUCredits = in.CPUCredits
 	out.AssociatePublicIP = in.AssociatePublicIP
 	out.AdditionalSecurityGroups = in.AdditionalSecurityGroups
+	if in.FirewallRules != nil {
+		in, out := &in.FirewallRules, &out.FirewallRules
+		*out = make([]kops.FirewallRuleSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_FirewallRuleSpec_To_kops_FirewallRuleSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FirewallRules = nil
+	}
 	out.CloudLabels = in.CloudLabels
 	out.NodeLabels = in.NodeLabels
 	if in.FileAssets != nil {
@@ -4695,6 +6594,45 @@ func autoConvert_v1alpha2_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *Instan
 	}
 	out.MaxInstanceLifetime = in.MaxInstanceLifetime
 	out.GCPProvisioningModel = in.GCPProvisioningModel
+	out.AzureUserAssignedIdentities = in.AzureUserAssignedIdentities
+	out.AzureOrchestrationMode = in.AzureOrchestrationMode
+	out.AzureEphemeralOSDiskPlacement = in.AzureEphemeralOSDiskPlacement
+	if in.Karpenter != nil {
+		in, out := &in.Karpenter, &out.Karpenter
+		*out = new(kops.KarpenterInstanceGroupSpec)
+		if err := Convert_v1alpha2_KarpenterInstanceGroupSpec_To_kops_KarpenterInstanceGroupSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Karpenter = nil
+	}
+	if in.SpotPolicy != nil {
+		in, out := &in.SpotPolicy, &out.SpotPolicy
+		*out = new(kops.SpotPolicySpec)
+		if err := Convert_v1alpha2_SpotPolicySpec_To_kops_SpotPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SpotPolicy = nil
+	}
+	if in.ManagedInstanceGroupUpdatePolicy != nil {
+		in, out := &in.ManagedInstanceGroupUpdatePolicy, &out.ManagedInstanceGroupUpdatePolicy
+		*out = new(kops.ManagedInstanceGroupUpdatePolicySpec)
+		if err := Convert_v1alpha2_ManagedInstanceGroupUpdatePolicySpec_To_kops_ManagedInstanceGroupUpdatePolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ManagedInstanceGroupUpdatePolicy = nil
+	}
+	if in.ImagePatching != nil {
+		in, out := &in.ImagePatching, &out.ImagePatching
+		*out = new(kops.ImagePatchingSpec)
+		if err := Convert_v1alpha2_ImagePatchingSpec_To_kops_ImagePatchingSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ImagePatching = nil
+	}
 	return nil
 }
 
@@ -4706,6 +6644,15 @@ func autoConvert_kops_InstanceGroupSpec_To_v1alpha2_InstanceGroupSpec(in *kops.I
 	out.MaxSize = in.MaxSize
 	out.Autoscale = in.Autoscale
 	out.AutoscalePriority = in.AutoscalePriority
+	if in.ClusterAutoscaler != nil {
+		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
+		*out = new(InstanceGroupClusterAutoscalerSpec)
+		if err := Convert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha2_InstanceGroupClusterAutoscalerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterAutoscaler = nil
+	}
 	out.MachineType = in.MachineType
 	out.RootVolume = in.RootVolume
 	if in.Volumes != nil {
@@ -4745,9 +6692,21 @@ func autoConvert_kops_InstanceGroupSpec_To_v1alpha2_InstanceGroupSpec(in *kops.I
 	}
 	out.MaxPrice = in.MaxPrice
 	out.SpotDurationInMinutes = in.SpotDurationInMinutes
+	out.OnDemandMaxPrice = in.OnDemandMaxPrice
 	out.CPUCredits = in.CPUCredits
 	out.AssociatePublicIP = in.AssociatePublicIP
 	out.AdditionalSecurityGroups = in.AdditionalSecurityGroups
+	if in.FirewallRules != nil {
+		in, out := &in.FirewallRules, &out.FirewallRules
+		*out = make([]FirewallRuleSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_FirewallRuleSpec_To_v1alpha2_FirewallRuleSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FirewallRules = nil
+	}
 	out.CloudLabels = in.CloudLabels
 	out.NodeLabels = in.NodeLabels
 	if in.FileAssets != nil {
@@ -4871,6 +6830,45 @@ func autoConvert_kops_InstanceGroupSpec_To_v1alpha2_InstanceGroupSpec(in *kops.I
 	}
 	out.MaxInstanceLifetime = in.MaxInstanceLifetime
 	out.GCPProvisioningModel = in.GCPProvisioningModel
+	out.AzureUserAssignedIdentities = in.AzureUserAssignedIdentities
+	out.AzureOrchestrationMode = in.AzureOrchestrationMode
+	out.AzureEphemeralOSDiskPlacement = in.AzureEphemeralOSDiskPlacement
+	if in.Karpenter != nil {
+		in, out := &in.Karpenter, &out.Karpenter
+		*out = new(KarpenterInstanceGroupSpec)
+		if err := Convert_kops_KarpenterInstanceGroupSpec_To_v1alpha2_KarpenterInstanceGroupSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Karpenter = nil
+	}
+	if in.SpotPolicy != nil {
+		in, out := &in.SpotPolicy, &out.SpotPolicy
+		*out = new(SpotPolicySpec)
+		if err := Convert_kops_SpotPolicySpec_To_v1alpha2_SpotPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SpotPolicy = nil
+	}
+	if in.ManagedInstanceGroupUpdatePolicy != nil {
+		in, out := &in.ManagedInstanceGroupUpdatePolicy, &out.ManagedInstanceGroupUpdatePolicy
+		*out = new(ManagedInstanceGroupUpdatePolicySpec)
+		if err := Convert_kops_ManagedInstanceGroupUpdatePolicySpec_To_v1alpha2_ManagedInstanceGroupUpdatePolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ManagedInstanceGroupUpdatePolicy = nil
+	}
+	if in.ImagePatching != nil {
+		in, out := &in.ImagePatching, &out.ImagePatching
+		*out = new(ImagePatchingSpec)
+		if err := Convert_kops_ImagePatchingSpec_To_v1alpha2_ImagePatchingSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ImagePatching = nil
+	}
 	return nil
 }
 
@@ -4916,6 +6914,8 @@ func autoConvert_v1alpha2_InstanceRequirementsSpec_To_kops_InstanceRequirementsS
 		out.Memory = nil
 	}
 	out.ExcludedInstanceTypes = in.ExcludedInstanceTypes
+	out.ExcludedInstanceFamilies = in.ExcludedInstanceFamilies
+	out.Architectures = in.Architectures
 	return nil
 }
 
@@ -4944,6 +6944,8 @@ func autoConvert_kops_InstanceRequirementsSpec_To_v1alpha2_InstanceRequirementsS
 		out.Memory = nil
 	}
 	out.ExcludedInstanceTypes = in.ExcludedInstanceTypes
+	out.ExcludedInstanceFamilies = in.ExcludedInstanceFamilies
+	out.Architectures = in.Architectures
 	return nil
 }
 
@@ -4952,6 +6954,64 @@ func Convert_kops_InstanceRequirementsSpec_To_v1alpha2_InstanceRequirementsSpec(
 	return autoConvert_kops_InstanceRequirementsSpec_To_v1alpha2_InstanceRequirementsSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_InterruptionHandlerConfig_To_kops_InterruptionHandlerConfig(in *InterruptionHandlerConfig, out *kops.InterruptionHandlerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.DrainMaintenanceEvents = in.DrainMaintenanceEvents
+	out.DrainTimeout = in.DrainTimeout
+	out.EnablePrometheusMetrics = in.EnablePrometheusMetrics
+	out.MemoryRequest = in.MemoryRequest
+	out.CPURequest = in.CPURequest
+	return nil
+}
+
+// Convert_v1alpha2_InterruptionHandlerConfig_To_kops_InterruptionHandlerConfig is an autogenerated conversion function.
+func Convert_v1alpha2_InterruptionHandlerConfig_To_kops_InterruptionHandlerConfig(in *InterruptionHandlerConfig, out *kops.InterruptionHandlerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_InterruptionHandlerConfig_To_kops_InterruptionHandlerConfig(in, out, s)
+}
+
+func autoConvert_kops_InterruptionHandlerConfig_To_v1alpha2_InterruptionHandlerConfig(in *kops.InterruptionHandlerConfig, out *InterruptionHandlerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.DrainMaintenanceEvents = in.DrainMaintenanceEvents
+	out.DrainTimeout = in.DrainTimeout
+	out.EnablePrometheusMetrics = in.EnablePrometheusMetrics
+	out.MemoryRequest = in.MemoryRequest
+	out.CPURequest = in.CPURequest
+	return nil
+}
+
+// Convert_kops_InterruptionHandlerConfig_To_v1alpha2_InterruptionHandlerConfig is an autogenerated conversion function.
+func Convert_kops_InterruptionHandlerConfig_To_v1alpha2_InterruptionHandlerConfig(in *kops.InterruptionHandlerConfig, out *InterruptionHandlerConfig, s conversion.Scope) error {
+	return autoConvert_kops_InterruptionHandlerConfig_To_v1alpha2_InterruptionHandlerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_KMSEncryptionSpec_To_kops_KMSEncryptionSpec(in *KMSEncryptionSpec, out *kops.KMSEncryptionSpec, s conversion.Scope) error {
+	out.Provider = in.Provider
+	out.KeyID = in.KeyID
+	out.PreviousKeyIDs = in.PreviousKeyIDs
+	out.Image = in.Image
+	out.Resources = in.Resources
+	return nil
+}
+
+// Convert_v1alpha2_KMSEncryptionSpec_To_kops_KMSEncryptionSpec is an autogenerated conversion function.
+func Convert_v1alpha2_KMSEncryptionSpec_To_kops_KMSEncryptionSpec(in *KMSEncryptionSpec, out *kops.KMSEncryptionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_KMSEncryptionSpec_To_kops_KMSEncryptionSpec(in, out, s)
+}
+
+func autoConvert_kops_KMSEncryptionSpec_To_v1alpha2_KMSEncryptionSpec(in *kops.KMSEncryptionSpec, out *KMSEncryptionSpec, s conversion.Scope) error {
+	out.Provider = in.Provider
+	out.KeyID = in.KeyID
+	out.PreviousKeyIDs = in.PreviousKeyIDs
+	out.Image = in.Image
+	out.Resources = in.Resources
+	return nil
+}
+
+// Convert_kops_KMSEncryptionSpec_To_v1alpha2_KMSEncryptionSpec is an autogenerated conversion function.
+func Convert_kops_KMSEncryptionSpec_To_v1alpha2_KMSEncryptionSpec(in *kops.KMSEncryptionSpec, out *KMSEncryptionSpec, s conversion.Scope) error {
+	return autoConvert_kops_KMSEncryptionSpec_To_v1alpha2_KMSEncryptionSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_KarpenterConfig_To_kops_KarpenterConfig(in *KarpenterConfig, out *kops.KarpenterConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.LogEncoding = in.LogEncoding
@@ -4986,6 +7046,30 @@ func Convert_kops_KarpenterConfig_To_v1alpha2_KarpenterConfig(in *kops.Karpenter
 	return autoConvert_kops_KarpenterConfig_To_v1alpha2_KarpenterConfig(in, out, s)
 }
 
+func autoConvert_v1alpha2_KarpenterInstanceGroupSpec_To_kops_KarpenterInstanceGroupSpec(in *KarpenterInstanceGroupSpec, out *kops.KarpenterInstanceGroupSpec, s conversion.Scope) error {
+	out.CapacityTypes = in.CapacityTypes
+	out.Architectures = in.Architectures
+	out.InstanceFamilies = in.InstanceFamilies
+	return nil
+}
+
+// Convert_v1alpha2_KarpenterInstanceGroupSpec_To_kops_KarpenterInstanceGroupSpec is an autogenerated conversion function.
+func Convert_v1alpha2_KarpenterInstanceGroupSpec_To_kops_KarpenterInstanceGroupSpec(in *KarpenterInstanceGroupSpec, out *kops.KarpenterInstanceGroupSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_KarpenterInstanceGroupSpec_To_kops_KarpenterInstanceGroupSpec(in, out, s)
+}
+
+func autoConvert_kops_KarpenterInstanceGroupSpec_To_v1alpha2_KarpenterInstanceGroupSpec(in *kops.KarpenterInstanceGroupSpec, out *KarpenterInstanceGroupSpec, s conversion.Scope) error {
+	out.CapacityTypes = in.CapacityTypes
+	out.Architectures = in.Architectures
+	out.InstanceFamilies = in.InstanceFamilies
+	return nil
+}
+
+// Convert_kops_KarpenterInstanceGroupSpec_To_v1alpha2_KarpenterInstanceGroupSpec is an autogenerated conversion function.
+func Convert_kops_KarpenterInstanceGroupSpec_To_v1alpha2_KarpenterInstanceGroupSpec(in *kops.KarpenterInstanceGroupSpec, out *KarpenterInstanceGroupSpec, s conversion.Scope) error {
+	return autoConvert_kops_KarpenterInstanceGroupSpec_To_v1alpha2_KarpenterInstanceGroupSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_Keyset_To_kops_Keyset(in *Keyset, out *kops.Keyset, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha2_KeysetSpec_To_kops_KeysetSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -5242,6 +7326,50 @@ func Convert_kops_KopeioNetworkingSpec_To_v1alpha2_KopeioNetworkingSpec(in *kops
 	return autoConvert_kops_KopeioNetworkingSpec_To_v1alpha2_KopeioNetworkingSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_KopsControllerSpec_To_kops_KopsControllerSpec(in *KopsControllerSpec, out *kops.KopsControllerSpec, s conversion.Scope) error {
+	out.PinServingCA = in.PinServingCA
+	out.RequireClientCertificates = in.RequireClientCertificates
+	out.TracesEndpoint = in.TracesEndpoint
+	out.EnforceAddons = in.EnforceAddons
+	if in.ContinuousApply != nil {
+		in, out := &in.ContinuousApply, &out.ContinuousApply
+		*out = new(kops.ContinuousApplySpec)
+		if err := Convert_v1alpha2_ContinuousApplySpec_To_kops_ContinuousApplySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ContinuousApply = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_KopsControllerSpec_To_kops_KopsControllerSpec is an autogenerated conversion function.
+func Convert_v1alpha2_KopsControllerSpec_To_kops_KopsControllerSpec(in *KopsControllerSpec, out *kops.KopsControllerSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_KopsControllerSpec_To_kops_KopsControllerSpec(in, out, s)
+}
+
+func autoConvert_kops_KopsControllerSpec_To_v1alpha2_KopsControllerSpec(in *kops.KopsControllerSpec, out *KopsControllerSpec, s conversion.Scope) error {
+	out.PinServingCA = in.PinServingCA
+	out.RequireClientCertificates = in.RequireClientCertificates
+	out.TracesEndpoint = in.TracesEndpoint
+	out.EnforceAddons = in.EnforceAddons
+	if in.ContinuousApply != nil {
+		in, out := &in.ContinuousApply, &out.ContinuousApply
+		*out = new(ContinuousApplySpec)
+		if err := Convert_kops_ContinuousApplySpec_To_v1alpha2_ContinuousApplySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ContinuousApply = nil
+	}
+	return nil
+}
+
+// Convert_kops_KopsControllerSpec_To_v1alpha2_KopsControllerSpec is an autogenerated conversion function.
+func Convert_kops_KopsControllerSpec_To_v1alpha2_KopsControllerSpec(in *kops.KopsControllerSpec, out *KopsControllerSpec, s conversion.Scope) error {
+	return autoConvert_kops_KopsControllerSpec_To_v1alpha2_KopsControllerSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_KubeAPIServerConfig_To_kops_KubeAPIServerConfig(in *KubeAPIServerConfig, out *kops.KubeAPIServerConfig, s conversion.Scope) error {
 	out.Image = in.Image
 	out.DisableBasicAuth = in.DisableBasicAuth
@@ -5262,6 +7390,15 @@ func autoConvert_v1alpha2_KubeAPIServerConfig_To_kops_KubeAPIServerConfig(in *Ku
 	out.EnableAdmissionPlugins = in.EnableAdmissionPlugins
 	out.DisableAdmissionPlugins = in.DisableAdmissionPlugins
 	out.AdmissionControlConfigFile = in.AdmissionControlConfigFile
+	if in.AdmissionConfig != nil {
+		in, out := &in.AdmissionConfig, &out.AdmissionConfig
+		*out = new(kops.AdmissionConfigSpec)
+		if err := Convert_v1alpha2_AdmissionConfigSpec_To_kops_AdmissionConfigSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AdmissionConfig = nil
+	}
 	out.ServiceClusterIPRange = in.ServiceClusterIPRange
 	out.ServiceNodePortRange = in.ServiceNodePortRange
 	out.EtcdServers = in.EtcdServers
@@ -5311,6 +7448,24 @@ func autoConvert_v1alpha2_KubeAPIServerConfig_To_kops_KubeAPIServerConfig(in *Ku
 	out.AuditWebhookConfigFile = in.AuditWebhookConfigFile
 	out.AuditWebhookInitialBackoff = in.AuditWebhookInitialBackoff
 	out.AuditWebhookMode = in.AuditWebhookMode
+	if in.AuditPolicy != nil {
+		in, out := &in.AuditPolicy, &out.AuditPolicy
+		*out = new(kops.AuditPolicySpec)
+		if err := Convert_v1alpha2_AuditPolicySpec_To_kops_AuditPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AuditPolicy = nil
+	}
+	if in.AuditWebhook != nil {
+		in, out := &in.AuditWebhook, &out.AuditWebhook
+		*out = new(kops.AuditWebhookSpec)
+		if err := Convert_v1alpha2_AuditWebhookSpec_To_kops_AuditWebhookSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AuditWebhook = nil
+	}
 	out.AuthenticationTokenWebhookConfigFile = in.AuthenticationTokenWebhookConfigFile
 	out.AuthenticationTokenWebhookCacheTTL = in.AuthenticationTokenWebhookCacheTTL
 	out.AuthorizationMode = in.AuthorizationMode
@@ -5318,6 +7473,16 @@ func autoConvert_v1alpha2_KubeAPIServerConfig_To_kops_KubeAPIServerConfig(in *Ku
 	out.AuthorizationWebhookCacheAuthorizedTTL = in.AuthorizationWebhookCacheAuthorizedTTL
 	out.AuthorizationWebhookCacheUnauthorizedTTL = in.AuthorizationWebhookCacheUnauthorizedTTL
 	out.AuthorizationRBACSuperUser = in.AuthorizationRBACSuperUser
+	out.AuthorizationConfigFile = in.AuthorizationConfigFile
+	if in.AuthorizationConfig != nil {
+		in, out := &in.AuthorizationConfig, &out.AuthorizationConfig
+		*out = new(kops.AuthorizationConfigSpec)
+		if err := Convert_v1alpha2_AuthorizationConfigSpec_To_kops_AuthorizationConfigSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AuthorizationConfig = nil
+	}
 	out.EncryptionProviderConfig = in.EncryptionProviderConfig
 	out.ExperimentalEncryptionProviderConfig = in.ExperimentalEncryptionProviderConfig
 	out.RequestheaderUsernameHeaders = in.RequestheaderUsernameHeaders
@@ -5379,6 +7544,15 @@ func autoConvert_kops_KubeAPIServerConfig_To_v1alpha2_KubeAPIServerConfig(in *ko
 	out.EnableAdmissionPlugins = in.EnableAdmissionPlugins
 	out.DisableAdmissionPlugins = in.DisableAdmissionPlugins
 	out.AdmissionControlConfigFile = in.AdmissionControlConfigFile
+	if in.AdmissionConfig != nil {
+		in, out := &in.AdmissionConfig, &out.AdmissionConfig
+		*out = new(AdmissionConfigSpec)
+		if err := Convert_kops_AdmissionConfigSpec_To_v1alpha2_AdmissionConfigSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AdmissionConfig = nil
+	}
 	out.ServiceClusterIPRange = in.ServiceClusterIPRange
 	out.ServiceNodePortRange = in.ServiceNodePortRange
 	out.EtcdServers = in.EtcdServers
@@ -5428,6 +7602,24 @@ func autoConvert_kops_KubeAPIServerConfig_To_v1alpha2_KubeAPIServerConfig(in *ko
 	out.AuditWebhookConfigFile = in.AuditWebhookConfigFile
 	out.AuditWebhookInitialBackoff = in.AuditWebhookInitialBackoff
 	out.AuditWebhookMode = in.AuditWebhookMode
+	if in.AuditPolicy != nil {
+		in, out := &in.AuditPolicy, &out.AuditPolicy
+		*out = new(AuditPolicySpec)
+		if err := Convert_kops_AuditPolicySpec_To_v1alpha2_AuditPolicySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AuditPolicy = nil
+	}
+	if in.AuditWebhook != nil {
+		in, out := &in.AuditWebhook, &out.AuditWebhook
+		*out = new(AuditWebhookSpec)
+		if err := Convert_kops_AuditWebhookSpec_To_v1alpha2_AuditWebhookSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AuditWebhook = nil
+	}
 	out.AuthenticationTokenWebhookConfigFile = in.AuthenticationTokenWebhookConfigFile
 	out.AuthenticationTokenWebhookCacheTTL = in.AuthenticationTokenWebhookCacheTTL
 	out.AuthorizationMode = in.AuthorizationMode
@@ -5435,6 +7627,16 @@ func autoConvert_kops_KubeAPIServerConfig_To_v1alpha2_KubeAPIServerConfig(in *ko
 	out.AuthorizationWebhookCacheAuthorizedTTL = in.AuthorizationWebhookCacheAuthorizedTTL
 	out.AuthorizationWebhookCacheUnauthorizedTTL = in.AuthorizationWebhookCacheUnauthorizedTTL
 	out.AuthorizationRBACSuperUser = in.AuthorizationRBACSuperUser
+	out.AuthorizationConfigFile = in.AuthorizationConfigFile
+	if in.AuthorizationConfig != nil {
+		in, out := &in.AuthorizationConfig, &out.AuthorizationConfig
+		*out = new(AuthorizationConfigSpec)
+		if err := Convert_kops_AuthorizationConfigSpec_To_v1alpha2_AuthorizationConfigSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AuthorizationConfig = nil
+	}
 	out.EncryptionProviderConfig = in.EncryptionProviderConfig
 	out.ExperimentalEncryptionProviderConfig = in.ExperimentalEncryptionProviderConfig
 	out.RequestheaderUsernameHeaders = in.RequestheaderUsernameHeaders
@@ -5928,6 +8130,7 @@ func autoConvert_v1alpha2_KubeletConfigSpec_To_kops_KubeletConfigSpec(in *Kubele
 	out.RegistryBurst = in.RegistryBurst
 	out.TopologyManagerPolicy = in.TopologyManagerPolicy
 	out.RotateCertificates = in.RotateCertificates
+	out.ServerTLSBootstrap = in.ServerTLSBootstrap
 	out.ProtectKernelDefaults = in.ProtectKernelDefaults
 	out.CgroupDriver = in.CgroupDriver
 	out.HousekeepingInterval = in.HousekeepingInterval
@@ -6037,6 +8240,7 @@ func autoConvert_kops_KubeletConfigSpec_To_v1alpha2_KubeletConfigSpec(in *kops.K
 	out.RegistryBurst = in.RegistryBurst
 	out.TopologyManagerPolicy = in.TopologyManagerPolicy
 	out.RotateCertificates = in.RotateCertificates
+	out.ServerTLSBootstrap = in.ServerTLSBootstrap
 	out.ProtectKernelDefaults = in.ProtectKernelDefaults
 	out.CgroupDriver = in.CgroupDriver
 	out.HousekeepingInterval = in.HousekeepingInterval
@@ -6062,6 +8266,72 @@ func Convert_kops_KubeletConfigSpec_To_v1alpha2_KubeletConfigSpec(in *kops.Kubel
 	return autoConvert_kops_KubeletConfigSpec_To_v1alpha2_KubeletConfigSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_KubeletCredentialProviderSpec_To_kops_KubeletCredentialProviderSpec(in *KubeletCredentialProviderSpec, out *kops.KubeletCredentialProviderSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MatchImages = in.MatchImages
+	out.DefaultCacheDuration = in.DefaultCacheDuration
+	out.Args = in.Args
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]kops.EnvVar, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_EnvVar_To_kops_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = new(kops.PackagesConfig)
+		if err := Convert_v1alpha2_PackagesConfig_To_kops_PackagesConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Packages = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_KubeletCredentialProviderSpec_To_kops_KubeletCredentialProviderSpec is an autogenerated conversion function.
+func Convert_v1alpha2_KubeletCredentialProviderSpec_To_kops_KubeletCredentialProviderSpec(in *KubeletCredentialProviderSpec, out *kops.KubeletCredentialProviderSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_KubeletCredentialProviderSpec_To_kops_KubeletCredentialProviderSpec(in, out, s)
+}
+
+func autoConvert_kops_KubeletCredentialProviderSpec_To_v1alpha2_KubeletCredentialProviderSpec(in *kops.KubeletCredentialProviderSpec, out *KubeletCredentialProviderSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MatchImages = in.MatchImages
+	out.DefaultCacheDuration = in.DefaultCacheDuration
+	out.Args = in.Args
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]EnvVar, len(*in))
+		for i := range *in {
+			if err := Convert_kops_EnvVar_To_v1alpha2_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = new(PackagesConfig)
+		if err := Convert_kops_PackagesConfig_To_v1alpha2_PackagesConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Packages = nil
+	}
+	return nil
+}
+
+// Convert_kops_KubeletCredentialProviderSpec_To_v1alpha2_KubeletCredentialProviderSpec is an autogenerated conversion function.
+func Convert_kops_KubeletCredentialProviderSpec_To_v1alpha2_KubeletCredentialProviderSpec(in *kops.KubeletCredentialProviderSpec, out *KubeletCredentialProviderSpec, s conversion.Scope) error {
+	return autoConvert_kops_KubeletCredentialProviderSpec_To_v1alpha2_KubeletCredentialProviderSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_KubenetNetworkingSpec_To_kops_KubenetNetworkingSpec(in *KubenetNetworkingSpec, out *kops.KubenetNetworkingSpec, s conversion.Scope) error {
 	return nil
 }
@@ -6307,9 +8577,66 @@ func Convert_kops_LyftVPCNetworkingSpec_To_v1alpha2_LyftVPCNetworkingSpec(in *ko
 	return autoConvert_kops_LyftVPCNetworkingSpec_To_v1alpha2_LyftVPCNetworkingSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_MaintenanceWindowSpec_To_kops_MaintenanceWindowSpec(in *MaintenanceWindowSpec, out *kops.MaintenanceWindowSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	out.InstanceGroups = in.InstanceGroups
+	return nil
+}
+
+// Convert_v1alpha2_MaintenanceWindowSpec_To_kops_MaintenanceWindowSpec is an autogenerated conversion function.
+func Convert_v1alpha2_MaintenanceWindowSpec_To_kops_MaintenanceWindowSpec(in *MaintenanceWindowSpec, out *kops.MaintenanceWindowSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_MaintenanceWindowSpec_To_kops_MaintenanceWindowSpec(in, out, s)
+}
+
+func autoConvert_kops_MaintenanceWindowSpec_To_v1alpha2_MaintenanceWindowSpec(in *kops.MaintenanceWindowSpec, out *MaintenanceWindowSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Schedule = in.Schedule
+	out.Duration = in.Duration
+	out.TimeZone = in.TimeZone
+	out.InstanceGroups = in.InstanceGroups
+	return nil
+}
+
+// Convert_kops_MaintenanceWindowSpec_To_v1alpha2_MaintenanceWindowSpec is an autogenerated conversion function.
+func Convert_kops_MaintenanceWindowSpec_To_v1alpha2_MaintenanceWindowSpec(in *kops.MaintenanceWindowSpec, out *MaintenanceWindowSpec, s conversion.Scope) error {
+	return autoConvert_kops_MaintenanceWindowSpec_To_v1alpha2_MaintenanceWindowSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_ManagedInstanceGroupUpdatePolicySpec_To_kops_ManagedInstanceGroupUpdatePolicySpec(in *ManagedInstanceGroupUpdatePolicySpec, out *kops.ManagedInstanceGroupUpdatePolicySpec, s conversion.Scope) error {
+	out.Type = in.Type
+	out.MinimalAction = in.MinimalAction
+	out.ReplacementMethod = in.ReplacementMethod
+	out.MaxSurge = in.MaxSurge
+	out.MaxUnavailable = in.MaxUnavailable
+	return nil
+}
+
+// Convert_v1alpha2_ManagedInstanceGroupUpdatePolicySpec_To_kops_ManagedInstanceGroupUpdatePolicySpec is an autogenerated conversion function.
+func Convert_v1alpha2_ManagedInstanceGroupUpdatePolicySpec_To_kops_ManagedInstanceGroupUpdatePolicySpec(in *ManagedInstanceGroupUpdatePolicySpec, out *kops.ManagedInstanceGroupUpdatePolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ManagedInstanceGroupUpdatePolicySpec_To_kops_ManagedInstanceGroupUpdatePolicySpec(in, out, s)
+}
+
+func autoConvert_kops_ManagedInstanceGroupUpdatePolicySpec_To_v1alpha2_ManagedInstanceGroupUpdatePolicySpec(in *kops.ManagedInstanceGroupUpdatePolicySpec, out *ManagedInstanceGroupUpdatePolicySpec, s conversion.Scope) error {
+	out.Type = in.Type
+	out.MinimalAction = in.MinimalAction
+	out.ReplacementMethod = in.ReplacementMethod
+	out.MaxSurge = in.MaxSurge
+	out.MaxUnavailable = in.MaxUnavailable
+	return nil
+}
+
+// Convert_kops_ManagedInstanceGroupUpdatePolicySpec_To_v1alpha2_ManagedInstanceGroupUpdatePolicySpec is an autogenerated conversion function.
+func Convert_kops_ManagedInstanceGroupUpdatePolicySpec_To_v1alpha2_ManagedInstanceGroupUpdatePolicySpec(in *kops.ManagedInstanceGroupUpdatePolicySpec, out *ManagedInstanceGroupUpdatePolicySpec, s conversion.Scope) error {
+	return autoConvert_kops_ManagedInstanceGroupUpdatePolicySpec_To_v1alpha2_ManagedInstanceGroupUpdatePolicySpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_MetricsServerConfig_To_kops_MetricsServerConfig(in *MetricsServerConfig, out *kops.MetricsServerConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.Image = in.Image
+	out.Version = in.Version
 	out.Insecure = in.Insecure
 	return nil
 }
@@ -6322,6 +8649,7 @@ func Convert_v1alpha2_MetricsServerConfig_To_kops_MetricsServerConfig(in *Metric
 func autoConvert_kops_MetricsServerConfig_To_v1alpha2_MetricsServerConfig(in *kops.MetricsServerConfig, out *MetricsServerConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.Image = in.Image
+	out.Version = in.Version
 	out.Insecure = in.Insecure
 	return nil
 }
@@ -6895,6 +9223,28 @@ func Convert_kops_NodeAuthorizerSpec_To_v1alpha2_NodeAuthorizerSpec(in *kops.Nod
 	return autoConvert_kops_NodeAuthorizerSpec_To_v1alpha2_NodeAuthorizerSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_NodeIdentityLabelsSpec_To_kops_NodeIdentityLabelsSpec(in *NodeIdentityLabelsSpec, out *kops.NodeIdentityLabelsSpec, s conversion.Scope) error {
+	out.Metadata = in.Metadata
+	out.CloudTags = in.CloudTags
+	return nil
+}
+
+// Convert_v1alpha2_NodeIdentityLabelsSpec_To_kops_NodeIdentityLabelsSpec is an autogenerated conversion function.
+func Convert_v1alpha2_NodeIdentityLabelsSpec_To_kops_NodeIdentityLabelsSpec(in *NodeIdentityLabelsSpec, out *kops.NodeIdentityLabelsSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NodeIdentityLabelsSpec_To_kops_NodeIdentityLabelsSpec(in, out, s)
+}
+
+func autoConvert_kops_NodeIdentityLabelsSpec_To_v1alpha2_NodeIdentityLabelsSpec(in *kops.NodeIdentityLabelsSpec, out *NodeIdentityLabelsSpec, s conversion.Scope) error {
+	out.Metadata = in.Metadata
+	out.CloudTags = in.CloudTags
+	return nil
+}
+
+// Convert_kops_NodeIdentityLabelsSpec_To_v1alpha2_NodeIdentityLabelsSpec is an autogenerated conversion function.
+func Convert_kops_NodeIdentityLabelsSpec_To_v1alpha2_NodeIdentityLabelsSpec(in *kops.NodeIdentityLabelsSpec, out *NodeIdentityLabelsSpec, s conversion.Scope) error {
+	return autoConvert_kops_NodeIdentityLabelsSpec_To_v1alpha2_NodeIdentityLabelsSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_NodeLocalDNSConfig_To_kops_NodeLocalDNSConfig(in *NodeLocalDNSConfig, out *kops.NodeLocalDNSConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.ExternalCoreFile = in.ExternalCoreFile
@@ -7107,6 +9457,7 @@ func autoConvert_v1alpha2_OpenstackLoadbalancerConfig_To_kops_OpenstackLoadbalan
 	out.EnableIngressHostname = in.EnableIngressHostname
 	out.IngressHostnameSuffix = in.IngressHostnameSuffix
 	out.FlavorID = in.FlavorID
+	out.APIAllowedCIDRs = in.APIAllowedCIDRs
 	return nil
 }
 
@@ -7127,6 +9478,7 @@ func autoConvert_kops_OpenstackLoadbalancerConfig_To_v1alpha2_OpenstackLoadbalan
 	out.EnableIngressHostname = in.EnableIngressHostname
 	out.IngressHostnameSuffix = in.IngressHostnameSuffix
 	out.FlavorID = in.FlavorID
+	out.APIAllowedCIDRs = in.APIAllowedCIDRs
 	return nil
 }
 
@@ -7433,6 +9785,90 @@ func Convert_kops_PodIdentityWebhookSpec_To_v1alpha2_PodIdentityWebhookSpec(in *
 	return autoConvert_kops_PodIdentityWebhookSpec_To_v1alpha2_PodIdentityWebhookSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(in *PodSecurityAdmissionSpec, out *kops.PodSecurityAdmissionSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha2_PodSecurityDefaults_To_kops_PodSecurityDefaults(&in.Defaults, &out.Defaults, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_PodSecurityExemptions_To_kops_PodSecurityExemptions(&in.Exemptions, &out.Exemptions, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec is an autogenerated conversion function.
+func Convert_v1alpha2_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(in *PodSecurityAdmissionSpec, out *kops.PodSecurityAdmissionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(in, out, s)
+}
+
+func autoConvert_kops_PodSecurityAdmissionSpec_To_v1alpha2_PodSecurityAdmissionSpec(in *kops.PodSecurityAdmissionSpec, out *PodSecurityAdmissionSpec, s conversion.Scope) error {
+	if err := Convert_kops_PodSecurityDefaults_To_v1alpha2_PodSecurityDefaults(&in.Defaults, &out.Defaults, s); err != nil {
+		return err
+	}
+	if err := Convert_kops_PodSecurityExemptions_To_v1alpha2_PodSecurityExemptions(&in.Exemptions, &out.Exemptions, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_kops_PodSecurityAdmissionSpec_To_v1alpha2_PodSecurityAdmissionSpec is an autogenerated conversion function.
+func Convert_kops_PodSecurityAdmissionSpec_To_v1alpha2_PodSecurityAdmissionSpec(in *kops.PodSecurityAdmissionSpec, out *PodSecurityAdmissionSpec, s conversion.Scope) error {
+	return autoConvert_kops_PodSecurityAdmissionSpec_To_v1alpha2_PodSecurityAdmissionSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_PodSecurityDefaults_To_kops_PodSecurityDefaults(in *PodSecurityDefaults, out *kops.PodSecurityDefaults, s conversion.Scope) error {
+	out.Enforce = in.Enforce
+	out.EnforceVersion = in.EnforceVersion
+	out.Audit = in.Audit
+	out.AuditVersion = in.AuditVersion
+	out.Warn = in.Warn
+	out.WarnVersion = in.WarnVersion
+	return nil
+}
+
+// Convert_v1alpha2_PodSecurityDefaults_To_kops_PodSecurityDefaults is an autogenerated conversion function.
+func Convert_v1alpha2_PodSecurityDefaults_To_kops_PodSecurityDefaults(in *PodSecurityDefaults, out *kops.PodSecurityDefaults, s conversion.Scope) error {
+	return autoConvert_v1alpha2_PodSecurityDefaults_To_kops_PodSecurityDefaults(in, out, s)
+}
+
+func autoConvert_kops_PodSecurityDefaults_To_v1alpha2_PodSecurityDefaults(in *kops.PodSecurityDefaults, out *PodSecurityDefaults, s conversion.Scope) error {
+	out.Enforce = in.Enforce
+	out.EnforceVersion = in.EnforceVersion
+	out.Audit = in.Audit
+	out.AuditVersion = in.AuditVersion
+	out.Warn = in.Warn
+	out.WarnVersion = in.WarnVersion
+	return nil
+}
+
+// Convert_kops_PodSecurityDefaults_To_v1alpha2_PodSecurityDefaults is an autogenerated conversion function.
+func Convert_kops_PodSecurityDefaults_To_v1alpha2_PodSecurityDefaults(in *kops.PodSecurityDefaults, out *PodSecurityDefaults, s conversion.Scope) error {
+	return autoConvert_kops_PodSecurityDefaults_To_v1alpha2_PodSecurityDefaults(in, out, s)
+}
+
+func autoConvert_v1alpha2_PodSecurityExemptions_To_kops_PodSecurityExemptions(in *PodSecurityExemptions, out *kops.PodSecurityExemptions, s conversion.Scope) error {
+	out.Usernames = in.Usernames
+	out.RuntimeClasses = in.RuntimeClasses
+	out.Namespaces = in.Namespaces
+	return nil
+}
+
+// Convert_v1alpha2_PodSecurityExemptions_To_kops_PodSecurityExemptions is an autogenerated conversion function.
+func Convert_v1alpha2_PodSecurityExemptions_To_kops_PodSecurityExemptions(in *PodSecurityExemptions, out *kops.PodSecurityExemptions, s conversion.Scope) error {
+	return autoConvert_v1alpha2_PodSecurityExemptions_To_kops_PodSecurityExemptions(in, out, s)
+}
+
+func autoConvert_kops_PodSecurityExemptions_To_v1alpha2_PodSecurityExemptions(in *kops.PodSecurityExemptions, out *PodSecurityExemptions, s conversion.Scope) error {
+	out.Usernames = in.Usernames
+	out.RuntimeClasses = in.RuntimeClasses
+	out.Namespaces = in.Namespaces
+	return nil
+}
+
+// Convert_kops_PodSecurityExemptions_To_v1alpha2_PodSecurityExemptions is an autogenerated conversion function.
+func Convert_kops_PodSecurityExemptions_To_v1alpha2_PodSecurityExemptions(in *kops.PodSecurityExemptions, out *PodSecurityExemptions, s conversion.Scope) error {
+	return autoConvert_kops_PodSecurityExemptions_To_v1alpha2_PodSecurityExemptions(in, out, s)
+}
+
 func autoConvert_v1alpha2_RBACAuthorizationSpec_To_kops_RBACAuthorizationSpec(in *RBACAuthorizationSpec, out *kops.RBACAuthorizationSpec, s conversion.Scope) error {
 	return nil
 }
@@ -7451,6 +9887,30 @@ func Convert_kops_RBACAuthorizationSpec_To_v1alpha2_RBACAuthorizationSpec(in *ko
 	return autoConvert_kops_RBACAuthorizationSpec_To_v1alpha2_RBACAuthorizationSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_RequiredTagSpec_To_kops_RequiredTagSpec(in *RequiredTagSpec, out *kops.RequiredTagSpec, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Value = in.Value
+	out.AllowedValues = in.AllowedValues
+	return nil
+}
+
+// Convert_v1alpha2_RequiredTagSpec_To_kops_RequiredTagSpec is an autogenerated conversion function.
+func Convert_v1alpha2_RequiredTagSpec_To_kops_RequiredTagSpec(in *RequiredTagSpec, out *kops.RequiredTagSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_RequiredTagSpec_To_kops_RequiredTagSpec(in, out, s)
+}
+
+func autoConvert_kops_RequiredTagSpec_To_v1alpha2_RequiredTagSpec(in *kops.RequiredTagSpec, out *RequiredTagSpec, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Value = in.Value
+	out.AllowedValues = in.AllowedValues
+	return nil
+}
+
+// Convert_kops_RequiredTagSpec_To_v1alpha2_RequiredTagSpec is an autogenerated conversion function.
+func Convert_kops_RequiredTagSpec_To_v1alpha2_RequiredTagSpec(in *kops.RequiredTagSpec, out *RequiredTagSpec, s conversion.Scope) error {
+	return autoConvert_kops_RequiredTagSpec_To_v1alpha2_RequiredTagSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_RollingUpdate_To_kops_RollingUpdate(in *RollingUpdate, out *kops.RollingUpdate, s conversion.Scope) error {
 	out.DrainAndTerminate = in.DrainAndTerminate
 	out.MaxUnavailable = in.MaxUnavailable
@@ -7557,6 +10017,26 @@ func Convert_kops_Runc_To_v1alpha2_Runc(in *kops.Runc, out *Runc, s conversion.S
 	return autoConvert_kops_Runc_To_v1alpha2_Runc(in, out, s)
 }
 
+func autoConvert_v1alpha2_SELinuxSpec_To_kops_SELinuxSpec(in *SELinuxSpec, out *kops.SELinuxSpec, s conversion.Scope) error {
+	out.Mode = in.Mode
+	return nil
+}
+
+// Convert_v1alpha2_SELinuxSpec_To_kops_SELinuxSpec is an autogenerated conversion function.
+func Convert_v1alpha2_SELinuxSpec_To_kops_SELinuxSpec(in *SELinuxSpec, out *kops.SELinuxSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_SELinuxSpec_To_kops_SELinuxSpec(in, out, s)
+}
+
+func autoConvert_kops_SELinuxSpec_To_v1alpha2_SELinuxSpec(in *kops.SELinuxSpec, out *SELinuxSpec, s conversion.Scope) error {
+	out.Mode = in.Mode
+	return nil
+}
+
+// Convert_kops_SELinuxSpec_To_v1alpha2_SELinuxSpec is an autogenerated conversion function.
+func Convert_kops_SELinuxSpec_To_v1alpha2_SELinuxSpec(in *kops.SELinuxSpec, out *SELinuxSpec, s conversion.Scope) error {
+	return autoConvert_kops_SELinuxSpec_To_v1alpha2_SELinuxSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_SSHCredential_To_kops_SSHCredential(in *SSHCredential, out *kops.SSHCredential, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha2_SSHCredentialSpec_To_kops_SSHCredentialSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -7698,6 +10178,7 @@ func autoConvert_v1alpha2_ServiceAccountIssuerDiscoveryConfig_To_kops_ServiceAcc
 	}
 	out.EnableAWSOIDCProvider = in.EnableAWSOIDCProvider
 	out.AdditionalAudiences = in.AdditionalAudiences
+	out.EnableGCPWorkloadIdentityFederation = in.EnableGCPWorkloadIdentityFederation
 	return nil
 }
 
@@ -7719,6 +10200,7 @@ func autoConvert_kops_ServiceAccountIssuerDiscoveryConfig_To_v1alpha2_ServiceAcc
 	}
 	out.EnableAWSOIDCProvider = in.EnableAWSOIDCProvider
 	out.AdditionalAudiences = in.AdditionalAudiences
+	out.EnableGCPWorkloadIdentityFederation = in.EnableGCPWorkloadIdentityFederation
 	return nil
 }
 
@@ -7749,6 +10231,92 @@ func Convert_kops_SnapshotControllerConfig_To_v1alpha2_SnapshotControllerConfig(
 	return autoConvert_kops_SnapshotControllerConfig_To_v1alpha2_SnapshotControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha2_SpotPolicySpec_To_kops_SpotPolicySpec(in *SpotPolicySpec, out *kops.SpotPolicySpec, s conversion.Scope) error {
+	out.FallbackMachineTypes = in.FallbackMachineTypes
+	out.TerminationAction = in.TerminationAction
+	out.EvictionPolicy = in.EvictionPolicy
+	return nil
+}
+
+// Convert_v1alpha2_SpotPolicySpec_To_kops_SpotPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha2_SpotPolicySpec_To_kops_SpotPolicySpec(in *SpotPolicySpec, out *kops.SpotPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_SpotPolicySpec_To_kops_SpotPolicySpec(in, out, s)
+}
+
+func autoConvert_kops_SpotPolicySpec_To_v1alpha2_SpotPolicySpec(in *kops.SpotPolicySpec, out *SpotPolicySpec, s conversion.Scope) error {
+	out.FallbackMachineTypes = in.FallbackMachineTypes
+	out.TerminationAction = in.TerminationAction
+	out.EvictionPolicy = in.EvictionPolicy
+	return nil
+}
+
+// Convert_kops_SpotPolicySpec_To_v1alpha2_SpotPolicySpec is an autogenerated conversion function.
+func Convert_kops_SpotPolicySpec_To_v1alpha2_SpotPolicySpec(in *kops.SpotPolicySpec, out *SpotPolicySpec, s conversion.Scope) error {
+	return autoConvert_kops_SpotPolicySpec_To_v1alpha2_SpotPolicySpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_TLSSpec_To_kops_TLSSpec(in *TLSSpec, out *kops.TLSSpec, s conversion.Scope) error {
+	out.MinVersion = in.MinVersion
+	out.CipherSuites = in.CipherSuites
+	return nil
+}
+
+// Convert_v1alpha2_TLSSpec_To_kops_TLSSpec is an autogenerated conversion function.
+func Convert_v1alpha2_TLSSpec_To_kops_TLSSpec(in *TLSSpec, out *kops.TLSSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TLSSpec_To_kops_TLSSpec(in, out, s)
+}
+
+func autoConvert_kops_TLSSpec_To_v1alpha2_TLSSpec(in *kops.TLSSpec, out *TLSSpec, s conversion.Scope) error {
+	out.MinVersion = in.MinVersion
+	out.CipherSuites = in.CipherSuites
+	return nil
+}
+
+// Convert_kops_TLSSpec_To_v1alpha2_TLSSpec is an autogenerated conversion function.
+func Convert_kops_TLSSpec_To_v1alpha2_TLSSpec(in *kops.TLSSpec, out *TLSSpec, s conversion.Scope) error {
+	return autoConvert_kops_TLSSpec_To_v1alpha2_TLSSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_TagPolicySpec_To_kops_TagPolicySpec(in *TagPolicySpec, out *kops.TagPolicySpec, s conversion.Scope) error {
+	if in.RequiredTags != nil {
+		in, out := &in.RequiredTags, &out.RequiredTags
+		*out = make([]kops.RequiredTagSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_RequiredTagSpec_To_kops_RequiredTagSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredTags = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_TagPolicySpec_To_kops_TagPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha2_TagPolicySpec_To_kops_TagPolicySpec(in *TagPolicySpec, out *kops.TagPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_TagPolicySpec_To_kops_TagPolicySpec(in, out, s)
+}
+
+func autoConvert_kops_TagPolicySpec_To_v1alpha2_TagPolicySpec(in *kops.TagPolicySpec, out *TagPolicySpec, s conversion.Scope) error {
+	if in.RequiredTags != nil {
+		in, out := &in.RequiredTags, &out.RequiredTags
+		*out = make([]RequiredTagSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_RequiredTagSpec_To_v1alpha2_RequiredTagSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RequiredTags = nil
+	}
+	return nil
+}
+
+// Convert_kops_TagPolicySpec_To_v1alpha2_TagPolicySpec is an autogenerated conversion function.
+func Convert_kops_TagPolicySpec_To_v1alpha2_TagPolicySpec(in *kops.TagPolicySpec, out *TagPolicySpec, s conversion.Scope) error {
+	return autoConvert_kops_TagPolicySpec_To_v1alpha2_TagPolicySpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_TargetSpec_To_kops_TargetSpec(in *TargetSpec, out *kops.TargetSpec, s conversion.Scope) error {
 	if in.Terraform != nil {
 		in, out := &in.Terraform, &out.Terraform
@@ -7862,6 +10430,28 @@ func Convert_kops_UserData_To_v1alpha2_UserData(in *kops.UserData, out *UserData
 	return autoConvert_kops_UserData_To_v1alpha2_UserData(in, out, s)
 }
 
+func autoConvert_v1alpha2_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(in *VerticalPodAutoscalerConfig, out *kops.VerticalPodAutoscalerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1alpha2_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig is an autogenerated conversion function.
+func Convert_v1alpha2_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(in *VerticalPodAutoscalerConfig, out *kops.VerticalPodAutoscalerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(in, out, s)
+}
+
+func autoConvert_kops_VerticalPodAutoscalerConfig_To_v1alpha2_VerticalPodAutoscalerConfig(in *kops.VerticalPodAutoscalerConfig, out *VerticalPodAutoscalerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_kops_VerticalPodAutoscalerConfig_To_v1alpha2_VerticalPodAutoscalerConfig is an autogenerated conversion function.
+func Convert_kops_VerticalPodAutoscalerConfig_To_v1alpha2_VerticalPodAutoscalerConfig(in *kops.VerticalPodAutoscalerConfig, out *VerticalPodAutoscalerConfig, s conversion.Scope) error {
+	return autoConvert_kops_VerticalPodAutoscalerConfig_To_v1alpha2_VerticalPodAutoscalerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha2_VolumeMountSpec_To_kops_VolumeMountSpec(in *VolumeMountSpec, out *kops.VolumeMountSpec, s conversion.Scope) error {
 	out.Device = in.Device
 	out.Filesystem = in.Filesystem
@@ -7930,6 +10520,8 @@ func autoConvert_v1alpha2_WarmPoolSpec_To_kops_WarmPoolSpec(in *WarmPoolSpec, ou
 	out.EnableLifecycleHook = in.EnableLifecycleHook
 	out.LifecycleHookTimeout = in.LifecycleHookTimeout
 	out.AdditionalImages = in.AdditionalImages
+	out.PoolState = in.PoolState
+	out.ReuseOnScaleIn = in.ReuseOnScaleIn
 	return nil
 }
 
@@ -7944,6 +10536,8 @@ func autoConvert_kops_WarmPoolSpec_To_v1alpha2_WarmPoolSpec(in *kops.WarmPoolSpe
 	out.EnableLifecycleHook = in.EnableLifecycleHook
 	out.LifecycleHookTimeout = in.LifecycleHookTimeout
 	out.AdditionalImages = in.AdditionalImages
+	out.PoolState = in.PoolState
+	out.ReuseOnScaleIn = in.ReuseOnScaleIn
 	return nil
 }
 
@@ -7997,3 +10591,57 @@ func autoConvert_kops_WeaveNetworkingSpec_To_v1alpha2_WeaveNetworkingSpec(in *ko
 func Convert_kops_WeaveNetworkingSpec_To_v1alpha2_WeaveNetworkingSpec(in *kops.WeaveNetworkingSpec, out *WeaveNetworkingSpec, s conversion.Scope) error {
 	return autoConvert_kops_WeaveNetworkingSpec_To_v1alpha2_WeaveNetworkingSpec(in, out, s)
 }
+
+func autoConvert_v1alpha2_WebhookAuthorizerSpec_To_kops_WebhookAuthorizerSpec(in *WebhookAuthorizerSpec, out *kops.WebhookAuthorizerSpec, s conversion.Scope) error {
+	out.Server = in.Server
+	out.CACertificate = in.CACertificate
+	out.KubeConfigFile = in.KubeConfigFile
+	out.Timeout = in.Timeout
+	out.AuthorizedTTL = in.AuthorizedTTL
+	out.UnauthorizedTTL = in.UnauthorizedTTL
+	out.FailurePolicy = in.FailurePolicy
+	if in.MatchConditions != nil {
+		in, out := &in.MatchConditions, &out.MatchConditions
+		*out = make([]kops.AuthorizerMatchCondition, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_AuthorizerMatchCondition_To_kops_AuthorizerMatchCondition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchConditions = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_WebhookAuthorizerSpec_To_kops_WebhookAuthorizerSpec is an autogenerated conversion function.
+func Convert_v1alpha2_WebhookAuthorizerSpec_To_kops_WebhookAuthorizerSpec(in *WebhookAuthorizerSpec, out *kops.WebhookAuthorizerSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_WebhookAuthorizerSpec_To_kops_WebhookAuthorizerSpec(in, out, s)
+}
+
+func autoConvert_kops_WebhookAuthorizerSpec_To_v1alpha2_WebhookAuthorizerSpec(in *kops.WebhookAuthorizerSpec, out *WebhookAuthorizerSpec, s conversion.Scope) error {
+	out.Server = in.Server
+	out.CACertificate = in.CACertificate
+	out.KubeConfigFile = in.KubeConfigFile
+	out.Timeout = in.Timeout
+	out.AuthorizedTTL = in.AuthorizedTTL
+	out.UnauthorizedTTL = in.UnauthorizedTTL
+	out.FailurePolicy = in.FailurePolicy
+	if in.MatchConditions != nil {
+		in, out := &in.MatchConditions, &out.MatchConditions
+		*out = make([]AuthorizerMatchCondition, len(*in))
+		for i := range *in {
+			if err := Convert_kops_AuthorizerMatchCondition_To_v1alpha2_AuthorizerMatchCondition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.MatchConditions = nil
+	}
+	return nil
+}
+
+// Convert_kops_WebhookAuthorizerSpec_To_v1alpha2_WebhookAuthorizerSpec is an autogenerated conversion function.
+func Convert_kops_WebhookAuthorizerSpec_To_v1alpha2_WebhookAuthorizerSpec(in *kops.WebhookAuthorizerSpec, out *WebhookAuthorizerSpec, s conversion.Scope) error {
+	return autoConvert_kops_WebhookAuthorizerSpec_To_v1alpha2_WebhookAuthorizerSpec(in, out, s)
+}
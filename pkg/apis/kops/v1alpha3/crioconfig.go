@@ -0,0 +1,29 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+// CRIOConfig is the configuration for CRI-O
+type CRIOConfig struct {
+	// LogLevel controls the logging details [fatal, panic, error, warn, info, debug, trace] (default "info").
+	LogLevel *string `json:"logLevel,omitempty"`
+	// Packages overrides the URL and hash for the packages.
+	Packages *PackagesConfig `json:"packages,omitempty"`
+	// SandboxImage is the image used for the sandbox container.
+	SandboxImage *string `json:"sandboxImage,omitempty"`
+	// Version used to pick the CRI-O package. It defaults to the release matching the Kubernetes minor version.
+	Version *string `json:"version,omitempty"`
+}
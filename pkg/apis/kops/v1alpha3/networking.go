@@ -94,6 +94,29 @@ type ExternalNetworkingSpec struct{}
 // CNINetworkingSpec is the specification for networking that is implemented by a user-provided Daemonset, which uses the CNI kubelet networking plugin.
 type CNINetworkingSpec struct {
 	UsesSecondaryIP bool `json:"usesSecondaryIP,omitempty"`
+
+	// HelmChart is a Helm chart that kOps renders and installs as the networking addon.
+	// The chart is rendered with the helm binary when the cluster is updated, so
+	// that CNIs can be installed and upgraded independently of kOps releases.
+	HelmChart *HelmChartSpec `json:"helmChart,omitempty"`
+}
+
+// HelmChartSpec is a reference to a Helm chart and the values to render it with.
+type HelmChartSpec struct {
+	// Repository is the URL of the chart repository, e.g. https://helm.cilium.io or oci://registry.example.com/charts.
+	Repository string `json:"repository"`
+	// Name is the name of the chart in the repository.
+	Name string `json:"name"`
+	// Version is the exact version of the chart.
+	Version string `json:"version"`
+	// ReleaseName is the name of the Helm release used when rendering the chart.
+	// Default: the chart name
+	ReleaseName string `json:"releaseName,omitempty"`
+	// Namespace is the namespace the chart is rendered for.
+	// Default: kube-system
+	Namespace string `json:"namespace,omitempty"`
+	// Values is a YAML document with the values passed to the chart.
+	Values string `json:"values,omitempty"`
 }
 
 // KopeioNetworkingSpec declares that we want Kopeio networking
@@ -490,6 +513,9 @@ type CiliumNetworkingSpec struct {
 	// GatewayAPI specifies the configuration for Cilium Gateway API settings.
 	GatewayAPI *CiliumGatewayAPISpec `json:"gatewayAPI,omitempty"`
 
+	// ClusterMesh configures the connection of this cluster to other clusters in a Cilium cluster mesh.
+	ClusterMesh *CiliumClusterMeshSpec `json:"clusterMesh,omitempty"`
+
 	// ExtraConfig is appended to the cilium-config ConfigMap. Keys here override any value
 	// rendered by kops. All values must be strings (e.g. "true", not true).
 	ExtraConfig map[string]string `json:"extraConfig,omitempty"`
@@ -540,6 +566,64 @@ type HubbleSpec struct {
 	// Metrics is a list of metrics to collect. If empty or null, metrics are disabled.
 	// See https://docs.cilium.io/en/stable/observability/metrics/#hubble-exported-metrics
 	Metrics []string `json:"metrics,omitempty"`
+
+	// Relay configures Hubble Relay, which aggregates the flows observed by all Cilium agents.
+	Relay *HubbleRelaySpec `json:"relay,omitempty"`
+
+	// UI configures the Hubble UI. It requires Hubble Relay.
+	UI *HubbleUISpec `json:"ui,omitempty"`
+}
+
+// HubbleRelaySpec configures Hubble Relay.
+type HubbleRelaySpec struct {
+	// Enabled specifies whether Hubble Relay is deployed.
+	// Default: true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ServerTLS specifies whether Hubble Relay serves TLS, using a certificate issued by cert-manager.
+	// Default: false
+	ServerTLS *bool `json:"serverTLS,omitempty"`
+}
+
+// HubbleUISpec configures the Hubble UI.
+type HubbleUISpec struct {
+	// Enabled specifies whether the Hubble UI is deployed.
+	// Default: true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Version is the version of the Hubble UI.
+	// Default: v0.13.2
+	Version string `json:"version,omitempty"`
+}
+
+// CiliumClusterMeshSpec configures the connection of a cluster to other clusters in a Cilium cluster mesh.
+// The clusters are reached through their clustermesh-apiserver, using the client certificate stored in the
+// clustermesh-apiserver-remote-cert secret.
+type CiliumClusterMeshSpec struct {
+	// Clusters are the remote clusters to connect to.
+	Clusters []CiliumClusterMeshPeerSpec `json:"clusters,omitempty"`
+
+	// EnableEndpointSync enables the synchronization of the endpoints of global services across clusters.
+	// Default: false
+	EnableEndpointSync *bool `json:"enableEndpointSync,omitempty"`
+
+	// EnableMCSAPI enables support for the Kubernetes Multi-Cluster Services API.
+	// Default: false
+	EnableMCSAPI *bool `json:"enableMCSAPI,omitempty"`
+}
+
+// CiliumClusterMeshPeerSpec describes a remote cluster in a Cilium cluster mesh.
+type CiliumClusterMeshPeerSpec struct {
+	// Name is the name of the remote cluster, as set in its cilium clusterName.
+	Name string `json:"name"`
+
+	// Address is the DNS name of the remote cluster's clustermesh-apiserver.
+	// It must match the server certificate of the clustermesh-apiserver.
+	Address string `json:"address"`
+
+	// Port is the port of the remote cluster's clustermesh-apiserver.
+	// Default: 2379
+	Port int32 `json:"port,omitempty"`
 }
 
 // GCPNetworkingSpec is the specification of GCP's native networking mode, using IP aliases.
@@ -178,6 +178,11 @@ func (in *AWSSpec) DeepCopyInto(out *AWSSpec) {
 		*out = new(WarmPoolSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GracefulNodeDrain != nil {
+		in, out := &in.GracefulNodeDrain, &out.GracefulNodeDrain
+		*out = new(GracefulNodeDrainSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NodeIPFamilies != nil {
 		in, out := &in.NodeIPFamilies, &out.NodeIPFamilies
 		*out = make([]string, len(*in))
@@ -284,6 +289,37 @@ func (in *AddonSpec) DeepCopy() *AddonSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionConfigSpec) DeepCopyInto(out *AdmissionConfigSpec) {
+	*out = *in
+	if in.EventRateLimit != nil {
+		in, out := &in.EventRateLimit, &out.EventRateLimit
+		*out = new(EventRateLimitAdmissionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodSecurity != nil {
+		in, out := &in.PodSecurity, &out.PodSecurity
+		*out = new(PodSecurityAdmissionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePolicyWebhook != nil {
+		in, out := &in.ImagePolicyWebhook, &out.ImagePolicyWebhook
+		*out = new(ImagePolicyWebhookAdmissionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionConfigSpec.
+func (in *AdmissionConfigSpec) DeepCopy() *AdmissionConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlwaysAllowAuthorizationSpec) DeepCopyInto(out *AlwaysAllowAuthorizationSpec) {
 	*out = *in
@@ -321,6 +357,32 @@ func (in *AmazonVPCNetworkingSpec) DeepCopy() *AmazonVPCNetworkingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssetDownloadsSpec) DeepCopyInto(out *AssetDownloadsSpec) {
+	*out = *in
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BandwidthLimit != nil {
+		in, out := &in.BandwidthLimit, &out.BandwidthLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssetDownloadsSpec.
+func (in *AssetDownloadsSpec) DeepCopy() *AssetDownloadsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AssetDownloadsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AssetsSpec) DeepCopyInto(out *AssetsSpec) {
 	*out = *in
@@ -339,6 +401,11 @@ func (in *AssetsSpec) DeepCopyInto(out *AssetsSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NodeDownloads != nil {
+		in, out := &in.NodeDownloads, &out.NodeDownloads
+		*out = new(AssetDownloadsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -352,6 +419,139 @@ func (in *AssetsSpec) DeepCopy() *AssetsSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditGroupResources) DeepCopyInto(out *AuditGroupResources) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceNames != nil {
+		in, out := &in.ResourceNames, &out.ResourceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditGroupResources.
+func (in *AuditGroupResources) DeepCopy() *AuditGroupResources {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditGroupResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditPolicyRule) DeepCopyInto(out *AuditPolicyRule) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserGroups != nil {
+		in, out := &in.UserGroups, &out.UserGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Verbs != nil {
+		in, out := &in.Verbs, &out.Verbs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]AuditGroupResources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NonResourceURLs != nil {
+		in, out := &in.NonResourceURLs, &out.NonResourceURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OmitStages != nil {
+		in, out := &in.OmitStages, &out.OmitStages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OmitManagedFields != nil {
+		in, out := &in.OmitManagedFields, &out.OmitManagedFields
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditPolicyRule.
+func (in *AuditPolicyRule) DeepCopy() *AuditPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditPolicySpec) DeepCopyInto(out *AuditPolicySpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AuditPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OmitStages != nil {
+		in, out := &in.OmitStages, &out.OmitStages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OmitManagedFields != nil {
+		in, out := &in.OmitManagedFields, &out.OmitManagedFields
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditPolicySpec.
+func (in *AuditPolicySpec) DeepCopy() *AuditPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditWebhookSpec) DeepCopyInto(out *AuditWebhookSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditWebhookSpec.
+func (in *AuditWebhookSpec) DeepCopy() *AuditWebhookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditWebhookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
 	*out = *in
@@ -383,6 +583,29 @@ func (in *AuthenticationSpec) DeepCopy() *AuthenticationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationConfigSpec) DeepCopyInto(out *AuthorizationConfigSpec) {
+	*out = *in
+	if in.Authorizers != nil {
+		in, out := &in.Authorizers, &out.Authorizers
+		*out = make([]AuthorizerSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationConfigSpec.
+func (in *AuthorizationConfigSpec) DeepCopy() *AuthorizationConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthorizationSpec) DeepCopyInto(out *AuthorizationSpec) {
 	*out = *in
@@ -409,9 +632,98 @@ func (in *AuthorizationSpec) DeepCopy() *AuthorizationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizerMatchCondition) DeepCopyInto(out *AuthorizerMatchCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizerMatchCondition.
+func (in *AuthorizerMatchCondition) DeepCopy() *AuthorizerMatchCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizerMatchCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizerSpec) DeepCopyInto(out *AuthorizerSpec) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookAuthorizerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizerSpec.
+func (in *AuthorizerSpec) DeepCopy() *AuthorizerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzurePrivateAPISpec) DeepCopyInto(out *AzurePrivateAPISpec) {
+	*out = *in
+	if in.LinkedVirtualNetworkIDs != nil {
+		in, out := &in.LinkedVirtualNetworkIDs, &out.LinkedVirtualNetworkIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateLinkService != nil {
+		in, out := &in.PrivateLinkService, &out.PrivateLinkService
+		*out = new(AzurePrivateLinkServiceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePrivateAPISpec.
+func (in *AzurePrivateAPISpec) DeepCopy() *AzurePrivateAPISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzurePrivateAPISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzurePrivateLinkServiceSpec) DeepCopyInto(out *AzurePrivateLinkServiceSpec) {
+	*out = *in
+	if in.AllowedSubscriptionIDs != nil {
+		in, out := &in.AllowedSubscriptionIDs, &out.AllowedSubscriptionIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePrivateLinkServiceSpec.
+func (in *AzurePrivateLinkServiceSpec) DeepCopy() *AzurePrivateLinkServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzurePrivateLinkServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureSpec) DeepCopyInto(out *AzureSpec) {
 	*out = *in
+	if in.PrivateAPI != nil {
+		in, out := &in.PrivateAPI, &out.PrivateAPI
+		*out = new(AzurePrivateAPISpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -470,6 +782,11 @@ func (in *BastionSpec) DeepCopy() *BastionSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CNINetworkingSpec) DeepCopyInto(out *CNINetworkingSpec) {
 	*out = *in
+	if in.HelmChart != nil {
+		in, out := &in.HelmChart, &out.HelmChart
+		*out = new(HelmChartSpec)
+		**out = **in
+	}
 	return
 }
 
@@ -483,6 +800,42 @@ func (in *CNINetworkingSpec) DeepCopy() *CNINetworkingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CRIOConfig) DeepCopyInto(out *CRIOConfig) {
+	*out = *in
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = new(PackagesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SandboxImage != nil {
+		in, out := &in.SandboxImage, &out.SandboxImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CRIOConfig.
+func (in *CRIOConfig) DeepCopy() *CRIOConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CRIOConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CalicoNetworkingSpec) DeepCopyInto(out *CalicoNetworkingSpec) {
 	*out = *in
@@ -603,6 +956,53 @@ func (in *CertManagerConfig) DeepCopy() *CertManagerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumClusterMeshPeerSpec) DeepCopyInto(out *CiliumClusterMeshPeerSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumClusterMeshPeerSpec.
+func (in *CiliumClusterMeshPeerSpec) DeepCopy() *CiliumClusterMeshPeerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumClusterMeshPeerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumClusterMeshSpec) DeepCopyInto(out *CiliumClusterMeshSpec) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]CiliumClusterMeshPeerSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnableEndpointSync != nil {
+		in, out := &in.EnableEndpointSync, &out.EnableEndpointSync
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableMCSAPI != nil {
+		in, out := &in.EnableMCSAPI, &out.EnableMCSAPI
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumClusterMeshSpec.
+func (in *CiliumClusterMeshSpec) DeepCopy() *CiliumClusterMeshSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumClusterMeshSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CiliumGatewayAPISpec) DeepCopyInto(out *CiliumGatewayAPISpec) {
 	*out = *in
@@ -762,6 +1162,11 @@ func (in *CiliumNetworkingSpec) DeepCopyInto(out *CiliumNetworkingSpec) {
 		*out = new(CiliumGatewayAPISpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClusterMesh != nil {
+		in, out := &in.ClusterMesh, &out.ClusterMesh
+		*out = new(CiliumClusterMeshSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExtraConfig != nil {
 		in, out := &in.ExtraConfig, &out.ExtraConfig
 		*out = make(map[string]string, len(*in))
@@ -861,6 +1266,11 @@ func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManager
 		*out = new(int32)
 		**out = **in
 	}
+	if in.UseServiceAccountExternalPermissions != nil {
+		in, out := &in.UseServiceAccountExternalPermissions, &out.UseServiceAccountExternalPermissions
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -885,12 +1295,12 @@ func (in *CloudProviderSpec) DeepCopyInto(out *CloudProviderSpec) {
 	if in.Azure != nil {
 		in, out := &in.Azure, &out.Azure
 		*out = new(AzureSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.DO != nil {
 		in, out := &in.DO, &out.DO
 		*out = new(DOSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.GCE != nil {
 		in, out := &in.GCE, &out.GCE
@@ -1072,6 +1482,11 @@ func (in *ClusterAutoscalerConfig) DeepCopyInto(out *ClusterAutoscalerConfig) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.NodeGroupAutoDiscovery != nil {
+		in, out := &in.NodeGroupAutoDiscovery, &out.NodeGroupAutoDiscovery
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1204,6 +1619,11 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(ContainerdConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CRIO != nil {
+		in, out := &in.CRIO, &out.CRIO
+		*out = new(CRIOConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.KubeDNS != nil {
 		in, out := &in.KubeDNS, &out.KubeDNS
 		*out = new(KubeDNSConfig)
@@ -1259,6 +1679,13 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(NTPConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KubeletCredentialProviders != nil {
+		in, out := &in.KubeletCredentialProviders, &out.KubeletCredentialProviders
+		*out = make([]KubeletCredentialProviderSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Packages != nil {
 		in, out := &in.Packages, &out.Packages
 		*out = make([]string, len(*in))
@@ -1269,11 +1696,21 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(NodeProblemDetectorConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.InterruptionHandler != nil {
+		in, out := &in.InterruptionHandler, &out.InterruptionHandler
+		*out = new(InterruptionHandlerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MetricsServer != nil {
 		in, out := &in.MetricsServer, &out.MetricsServer
 		*out = new(MetricsServerConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VerticalPodAutoscaler != nil {
+		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
+		*out = new(VerticalPodAutoscalerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CertManager != nil {
 		in, out := &in.CertManager, &out.CertManager
 		*out = new(CertManagerConfig)
@@ -1296,6 +1733,11 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(kops.NodeAuthorizationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodSecurityAdmission != nil {
+		in, out := &in.PodSecurityAdmission, &out.PodSecurityAdmission
+		*out = new(PodSecurityAdmissionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CloudLabels != nil {
 		in, out := &in.CloudLabels, &out.CloudLabels
 		*out = make(map[string]string, len(*in))
@@ -1303,6 +1745,16 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.TagPolicy != nil {
+		in, out := &in.TagPolicy, &out.TagPolicy
+		*out = new(TagPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeIdentityLabels != nil {
+		in, out := &in.NodeIdentityLabels, &out.NodeIdentityLabels
+		*out = new(NodeIdentityLabelsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Hooks != nil {
 		in, out := &in.Hooks, &out.Hooks
 		*out = make([]HookSpec, len(*in))
@@ -1325,6 +1777,11 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.KMSEncryption != nil {
+		in, out := &in.KMSEncryption, &out.KMSEncryption
+		*out = new(KMSEncryptionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Target != nil {
 		in, out := &in.Target, &out.Target
 		*out = new(TargetSpec)
@@ -1340,11 +1797,43 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Hardening != nil {
+		in, out := &in.Hardening, &out.Hardening
+		*out = new(HardeningSpec)
+		**out = **in
+	}
+	if in.SELinux != nil {
+		in, out := &in.SELinux, &out.SELinux
+		*out = new(SELinuxSpec)
+		**out = **in
+	}
+	if in.FIPSMode != nil {
+		in, out := &in.FIPSMode, &out.FIPSMode
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KopsController != nil {
+		in, out := &in.KopsController, &out.KopsController
+		*out = new(KopsControllerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RollingUpdate != nil {
 		in, out := &in.RollingUpdate, &out.RollingUpdate
 		*out = new(RollingUpdate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindowSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ClusterAutoscaler != nil {
 		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
 		*out = new(ClusterAutoscalerConfig)
@@ -1460,6 +1949,13 @@ func (in *ContainerdConfig) DeepCopyInto(out *ContainerdConfig) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make(map[string]ContainerdRegistryConfig, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Root != nil {
 		in, out := &in.Root, &out.Root
 		*out = new(string)
@@ -1513,6 +2009,43 @@ func (in *ContainerdConfig) DeepCopy() *ContainerdConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdRegistryConfig) DeepCopyInto(out *ContainerdRegistryConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdRegistryConfig.
+func (in *ContainerdRegistryConfig) DeepCopy() *ContainerdRegistryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdRegistryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContinuousApplySpec) DeepCopyInto(out *ContinuousApplySpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContinuousApplySpec.
+func (in *ContinuousApplySpec) DeepCopy() *ContinuousApplySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContinuousApplySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DCGMExporterConfig) DeepCopyInto(out *DCGMExporterConfig) {
 	*out = *in
@@ -1622,9 +2155,35 @@ func (in *DNSControllerGossipConfigSecondary) DeepCopy() *DNSControllerGossipCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DOLoadBalancerSpec) DeepCopyInto(out *DOLoadBalancerSpec) {
+	*out = *in
+	if in.SizeUnit != nil {
+		in, out := &in.SizeUnit, &out.SizeUnit
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DOLoadBalancerSpec.
+func (in *DOLoadBalancerSpec) DeepCopy() *DOLoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DOLoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DOSpec) DeepCopyInto(out *DOSpec) {
 	*out = *in
+	if in.APILoadBalancer != nil {
+		in, out := &in.APILoadBalancer, &out.APILoadBalancer
+		*out = new(DOLoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1911,9 +2470,30 @@ func (in *EnvVar) DeepCopy() *EnvVar {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupS3Spec) DeepCopyInto(out *EtcdBackupS3Spec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupS3Spec.
+func (in *EtcdBackupS3Spec) DeepCopy() *EtcdBackupS3Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupS3Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdBackupSpec) DeepCopyInto(out *EtcdBackupSpec) {
 	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(EtcdBackupS3Spec)
+		**out = **in
+	}
 	return
 }
 
@@ -1950,7 +2530,7 @@ func (in *EtcdClusterSpec) DeepCopyInto(out *EtcdClusterSpec) {
 	if in.Backups != nil {
 		in, out := &in.Backups, &out.Backups
 		*out = new(EtcdBackupSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Manager != nil {
 		in, out := &in.Manager, &out.Manager
@@ -1967,6 +2547,11 @@ func (in *EtcdClusterSpec) DeepCopyInto(out *EtcdClusterSpec) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(EtcdExternalSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1980,6 +2565,27 @@ func (in *EtcdClusterSpec) DeepCopy() *EtcdClusterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdExternalSpec) DeepCopyInto(out *EtcdExternalSpec) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdExternalSpec.
+func (in *EtcdExternalSpec) DeepCopy() *EtcdExternalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdExternalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdManagerSpec) DeepCopyInto(out *EtcdManagerSpec) {
 	*out = *in
@@ -2018,6 +2624,16 @@ func (in *EtcdManagerSpec) DeepCopyInto(out *EtcdManagerSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.QuotaBackendBytes != nil {
+		in, out := &in.QuotaBackendBytes, &out.QuotaBackendBytes
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DefragInterval != nil {
+		in, out := &in.DefragInterval, &out.DefragInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -2082,6 +2698,43 @@ func (in *EtcdMemberSpec) DeepCopy() *EtcdMemberSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventRateLimit) DeepCopyInto(out *EventRateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventRateLimit.
+func (in *EventRateLimit) DeepCopy() *EventRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(EventRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventRateLimitAdmissionSpec) DeepCopyInto(out *EventRateLimitAdmissionSpec) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]EventRateLimit, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventRateLimitAdmissionSpec.
+func (in *EventRateLimitAdmissionSpec) DeepCopy() *EventRateLimitAdmissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EventRateLimitAdmissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecContainerAction) DeepCopyInto(out *ExecContainerAction) {
 	*out = *in
@@ -2173,6 +2826,27 @@ func (in *FileAssetSpec) DeepCopy() *FileAssetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleSpec) DeepCopyInto(out *FirewallRuleSpec) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleSpec.
+func (in *FirewallRuleSpec) DeepCopy() *FirewallRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FlannelNetworkingSpec) DeepCopyInto(out *FlannelNetworkingSpec) {
 	*out = *in
@@ -2344,6 +3018,32 @@ func (in *GossipConfigSecondary) DeepCopy() *GossipConfigSecondary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GracefulNodeDrainSpec) DeepCopyInto(out *GracefulNodeDrainSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GracefulNodeDrainSpec.
+func (in *GracefulNodeDrainSpec) DeepCopy() *GracefulNodeDrainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GracefulNodeDrainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPProxy) DeepCopyInto(out *HTTPProxy) {
 	*out = *in
@@ -2360,6 +3060,38 @@ func (in *HTTPProxy) DeepCopy() *HTTPProxy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardeningSpec) DeepCopyInto(out *HardeningSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardeningSpec.
+func (in *HardeningSpec) DeepCopy() *HardeningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HardeningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartSpec) DeepCopyInto(out *HelmChartSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartSpec.
+func (in *HelmChartSpec) DeepCopy() *HelmChartSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HetznerSpec) DeepCopyInto(out *HetznerSpec) {
 	*out = *in
@@ -2498,6 +3230,32 @@ func (in *HostSpec) DeepCopy() *HostSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HubbleRelaySpec) DeepCopyInto(out *HubbleRelaySpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServerTLS != nil {
+		in, out := &in.ServerTLS, &out.ServerTLS
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HubbleRelaySpec.
+func (in *HubbleRelaySpec) DeepCopy() *HubbleRelaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HubbleRelaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HubbleSpec) DeepCopyInto(out *HubbleSpec) {
 	*out = *in
@@ -2511,15 +3269,46 @@ func (in *HubbleSpec) DeepCopyInto(out *HubbleSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Relay != nil {
+		in, out := &in.Relay, &out.Relay
+		*out = new(HubbleRelaySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UI != nil {
+		in, out := &in.UI, &out.UI
+		*out = new(HubbleUISpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HubbleSpec.
+func (in *HubbleSpec) DeepCopy() *HubbleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HubbleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HubbleUISpec) DeepCopyInto(out *HubbleUISpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HubbleSpec.
-func (in *HubbleSpec) DeepCopy() *HubbleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HubbleUISpec.
+func (in *HubbleUISpec) DeepCopy() *HubbleUISpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HubbleSpec)
+	out := new(HubbleUISpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2578,6 +3367,63 @@ func (in *IAMSpec) DeepCopy() *IAMSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePatchingSpec) DeepCopyInto(out *ImagePatchingSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePatchingSpec.
+func (in *ImagePatchingSpec) DeepCopy() *ImagePatchingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePatchingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePolicyWebhookAdmissionSpec) DeepCopyInto(out *ImagePolicyWebhookAdmissionSpec) {
+	*out = *in
+	if in.AllowTTL != nil {
+		in, out := &in.AllowTTL, &out.AllowTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DenyTTL != nil {
+		in, out := &in.DenyTTL, &out.DenyTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RetryBackoff != nil {
+		in, out := &in.RetryBackoff, &out.RetryBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePolicyWebhookAdmissionSpec.
+func (in *ImagePolicyWebhookAdmissionSpec) DeepCopy() *ImagePolicyWebhookAdmissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePolicyWebhookAdmissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceGroup) DeepCopyInto(out *InstanceGroup) {
 	*out = *in
@@ -2605,6 +3451,42 @@ func (in *InstanceGroup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceGroupClusterAutoscalerSpec) DeepCopyInto(out *InstanceGroupClusterAutoscalerSpec) {
+	*out = *in
+	if in.ScaleDownUtilizationThreshold != nil {
+		in, out := &in.ScaleDownUtilizationThreshold, &out.ScaleDownUtilizationThreshold
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScaleDownUnneededTime != nil {
+		in, out := &in.ScaleDownUnneededTime, &out.ScaleDownUnneededTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScaleDownUnreadyTime != nil {
+		in, out := &in.ScaleDownUnreadyTime, &out.ScaleDownUnreadyTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.IgnoreDaemonSetsUtilization != nil {
+		in, out := &in.IgnoreDaemonSetsUtilization, &out.IgnoreDaemonSetsUtilization
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceGroupClusterAutoscalerSpec.
+func (in *InstanceGroupClusterAutoscalerSpec) DeepCopy() *InstanceGroupClusterAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceGroupClusterAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceGroupList) DeepCopyInto(out *InstanceGroupList) {
 	*out = *in
@@ -2656,6 +3538,11 @@ func (in *InstanceGroupSpec) DeepCopyInto(out *InstanceGroupSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ClusterAutoscaler != nil {
+		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
+		*out = new(InstanceGroupClusterAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RootVolume != nil {
 		in, out := &in.RootVolume, &out.RootVolume
 		*out = new(InstanceRootVolumeSpec)
@@ -2702,6 +3589,11 @@ func (in *InstanceGroupSpec) DeepCopyInto(out *InstanceGroupSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.OnDemandMaxPrice != nil {
+		in, out := &in.OnDemandMaxPrice, &out.OnDemandMaxPrice
+		*out = new(string)
+		**out = **in
+	}
 	if in.CPUCredits != nil {
 		in, out := &in.CPUCredits, &out.CPUCredits
 		*out = new(string)
@@ -2717,6 +3609,13 @@ func (in *InstanceGroupSpec) DeepCopyInto(out *InstanceGroupSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.FirewallRules != nil {
+		in, out := &in.FirewallRules, &out.FirewallRules
+		*out = make([]FirewallRuleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.CloudLabels != nil {
 		in, out := &in.CloudLabels, &out.CloudLabels
 		*out = make(map[string]string, len(*in))
@@ -2855,6 +3754,41 @@ func (in *InstanceGroupSpec) DeepCopyInto(out *InstanceGroupSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AzureUserAssignedIdentities != nil {
+		in, out := &in.AzureUserAssignedIdentities, &out.AzureUserAssignedIdentities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AzureOrchestrationMode != nil {
+		in, out := &in.AzureOrchestrationMode, &out.AzureOrchestrationMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureEphemeralOSDiskPlacement != nil {
+		in, out := &in.AzureEphemeralOSDiskPlacement, &out.AzureEphemeralOSDiskPlacement
+		*out = new(string)
+		**out = **in
+	}
+	if in.Karpenter != nil {
+		in, out := &in.Karpenter, &out.Karpenter
+		*out = new(KarpenterInstanceGroupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SpotPolicy != nil {
+		in, out := &in.SpotPolicy, &out.SpotPolicy
+		*out = new(SpotPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedInstanceGroupUpdatePolicy != nil {
+		in, out := &in.ManagedInstanceGroupUpdatePolicy, &out.ManagedInstanceGroupUpdatePolicy
+		*out = new(ManagedInstanceGroupUpdatePolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePatching != nil {
+		in, out := &in.ImagePatching, &out.ImagePatching
+		*out = new(ImagePatchingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2912,6 +3846,16 @@ func (in *InstanceRequirementsSpec) DeepCopyInto(out *InstanceRequirementsSpec)
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExcludedInstanceFamilies != nil {
+		in, out := &in.ExcludedInstanceFamilies, &out.ExcludedInstanceFamilies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2976,6 +3920,78 @@ func (in *InstanceRootVolumeSpec) DeepCopy() *InstanceRootVolumeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterruptionHandlerConfig) DeepCopyInto(out *InterruptionHandlerConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DrainMaintenanceEvents != nil {
+		in, out := &in.DrainMaintenanceEvents, &out.DrainMaintenanceEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.EnablePrometheusMetrics != nil {
+		in, out := &in.EnablePrometheusMetrics, &out.EnablePrometheusMetrics
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MemoryRequest != nil {
+		in, out := &in.MemoryRequest, &out.MemoryRequest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CPURequest != nil {
+		in, out := &in.CPURequest, &out.CPURequest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterruptionHandlerConfig.
+func (in *InterruptionHandlerConfig) DeepCopy() *InterruptionHandlerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InterruptionHandlerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSEncryptionSpec) DeepCopyInto(out *KMSEncryptionSpec) {
+	*out = *in
+	if in.PreviousKeyIDs != nil {
+		in, out := &in.PreviousKeyIDs, &out.PreviousKeyIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSEncryptionSpec.
+func (in *KMSEncryptionSpec) DeepCopy() *KMSEncryptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSEncryptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KarpenterConfig) DeepCopyInto(out *KarpenterConfig) {
 	*out = *in
@@ -3007,6 +4023,37 @@ func (in *KarpenterConfig) DeepCopy() *KarpenterConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KarpenterInstanceGroupSpec) DeepCopyInto(out *KarpenterInstanceGroupSpec) {
+	*out = *in
+	if in.CapacityTypes != nil {
+		in, out := &in.CapacityTypes, &out.CapacityTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceFamilies != nil {
+		in, out := &in.InstanceFamilies, &out.InstanceFamilies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KarpenterInstanceGroupSpec.
+func (in *KarpenterInstanceGroupSpec) DeepCopy() *KarpenterInstanceGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KarpenterInstanceGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Keyset) DeepCopyInto(out *Keyset) {
 	*out = *in
@@ -3234,6 +4281,42 @@ func (in *KopeioNetworkingSpec) DeepCopy() *KopeioNetworkingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KopsControllerSpec) DeepCopyInto(out *KopsControllerSpec) {
+	*out = *in
+	if in.PinServingCA != nil {
+		in, out := &in.PinServingCA, &out.PinServingCA
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireClientCertificates != nil {
+		in, out := &in.RequireClientCertificates, &out.RequireClientCertificates
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnforceAddons != nil {
+		in, out := &in.EnforceAddons, &out.EnforceAddons
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ContinuousApply != nil {
+		in, out := &in.ContinuousApply, &out.ContinuousApply
+		*out = new(ContinuousApplySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KopsControllerSpec.
+func (in *KopsControllerSpec) DeepCopy() *KopsControllerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KopsControllerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 	*out = *in
@@ -3282,6 +4365,11 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdmissionConfig != nil {
+		in, out := &in.AdmissionConfig, &out.AdmissionConfig
+		*out = new(AdmissionConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EtcdServers != nil {
 		in, out := &in.EtcdServers, &out.EtcdServers
 		*out = make([]string, len(*in))
@@ -3439,6 +4527,16 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.AuditPolicy != nil {
+		in, out := &in.AuditPolicy, &out.AuditPolicy
+		*out = new(AuditPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuditWebhook != nil {
+		in, out := &in.AuditWebhook, &out.AuditWebhook
+		*out = new(AuditWebhookSpec)
+		**out = **in
+	}
 	if in.AuthenticationTokenWebhookConfigFile != nil {
 		in, out := &in.AuthenticationTokenWebhookConfigFile, &out.AuthenticationTokenWebhookConfigFile
 		*out = new(string)
@@ -3474,6 +4572,11 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AuthorizationConfig != nil {
+		in, out := &in.AuthorizationConfig, &out.AuthorizationConfig
+		*out = new(AuthorizationConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EncryptionProviderConfig != nil {
 		in, out := &in.EncryptionProviderConfig, &out.EncryptionProviderConfig
 		*out = new(string)
@@ -4431,6 +5534,11 @@ func (in *KubeletConfigSpec) DeepCopyInto(out *KubeletConfigSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ServerTLSBootstrap != nil {
+		in, out := &in.ServerTLSBootstrap, &out.ServerTLSBootstrap
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ProtectKernelDefaults != nil {
 		in, out := &in.ProtectKernelDefaults, &out.ProtectKernelDefaults
 		*out = new(bool)
@@ -4509,7 +5617,48 @@ func (in *KubeletConfigSpec) DeepCopy() *KubeletConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeletConfigSpec)
+	out := new(KubeletConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletCredentialProviderSpec) DeepCopyInto(out *KubeletCredentialProviderSpec) {
+	*out = *in
+	if in.MatchImages != nil {
+		in, out := &in.MatchImages, &out.MatchImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultCacheDuration != nil {
+		in, out := &in.DefaultCacheDuration, &out.DefaultCacheDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]EnvVar, len(*in))
+		copy(*out, *in)
+	}
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = new(PackagesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletCredentialProviderSpec.
+func (in *KubeletCredentialProviderSpec) DeepCopy() *KubeletCredentialProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletCredentialProviderSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4759,6 +5908,73 @@ func (in *LoadBalancerSubnetSpec) DeepCopy() *LoadBalancerSubnetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowSpec) DeepCopyInto(out *MaintenanceWindowSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.InstanceGroups != nil {
+		in, out := &in.InstanceGroups, &out.InstanceGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowSpec.
+func (in *MaintenanceWindowSpec) DeepCopy() *MaintenanceWindowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedInstanceGroupUpdatePolicySpec) DeepCopyInto(out *ManagedInstanceGroupUpdatePolicySpec) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinimalAction != nil {
+		in, out := &in.MinimalAction, &out.MinimalAction
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplacementMethod != nil {
+		in, out := &in.ReplacementMethod, &out.ReplacementMethod
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedInstanceGroupUpdatePolicySpec.
+func (in *ManagedInstanceGroupUpdatePolicySpec) DeepCopy() *ManagedInstanceGroupUpdatePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedInstanceGroupUpdatePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsServerConfig) DeepCopyInto(out *MetricsServerConfig) {
 	*out = *in
@@ -4772,6 +5988,11 @@ func (in *MetricsServerConfig) DeepCopyInto(out *MetricsServerConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
 	if in.Insecure != nil {
 		in, out := &in.Insecure, &out.Insecure
 		*out = new(bool)
@@ -4972,7 +6193,7 @@ func (in *NetworkingSpec) DeepCopyInto(out *NetworkingSpec) {
 	if in.CNI != nil {
 		in, out := &in.CNI, &out.CNI
 		*out = new(CNINetworkingSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Kopeio != nil {
 		in, out := &in.Kopeio, &out.Kopeio
@@ -5047,6 +6268,34 @@ func (in *NetworkingSpec) DeepCopy() *NetworkingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeIdentityLabelsSpec) DeepCopyInto(out *NodeIdentityLabelsSpec) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CloudTags != nil {
+		in, out := &in.CloudTags, &out.CloudTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeIdentityLabelsSpec.
+func (in *NodeIdentityLabelsSpec) DeepCopy() *NodeIdentityLabelsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeIdentityLabelsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeLocalDNSConfig) DeepCopyInto(out *NodeLocalDNSConfig) {
 	*out = *in
@@ -5440,6 +6689,11 @@ func (in *OpenstackLoadbalancerConfig) DeepCopyInto(out *OpenstackLoadbalancerCo
 		*out = new(string)
 		**out = **in
 	}
+	if in.APIAllowedCIDRs != nil {
+		in, out := &in.APIAllowedCIDRs, &out.APIAllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -5740,6 +6994,71 @@ func (in *PodIdentityWebhookSpec) DeepCopy() *PodIdentityWebhookSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityAdmissionSpec) DeepCopyInto(out *PodSecurityAdmissionSpec) {
+	*out = *in
+	out.Defaults = in.Defaults
+	in.Exemptions.DeepCopyInto(&out.Exemptions)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityAdmissionSpec.
+func (in *PodSecurityAdmissionSpec) DeepCopy() *PodSecurityAdmissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityAdmissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityDefaults) DeepCopyInto(out *PodSecurityDefaults) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityDefaults.
+func (in *PodSecurityDefaults) DeepCopy() *PodSecurityDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityExemptions) DeepCopyInto(out *PodSecurityExemptions) {
+	*out = *in
+	if in.Usernames != nil {
+		in, out := &in.Usernames, &out.Usernames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RuntimeClasses != nil {
+		in, out := &in.RuntimeClasses, &out.RuntimeClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityExemptions.
+func (in *PodSecurityExemptions) DeepCopy() *PodSecurityExemptions {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityExemptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RBACAuthorizationSpec) DeepCopyInto(out *RBACAuthorizationSpec) {
 	*out = *in
@@ -5756,6 +7075,27 @@ func (in *RBACAuthorizationSpec) DeepCopy() *RBACAuthorizationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequiredTagSpec) DeepCopyInto(out *RequiredTagSpec) {
+	*out = *in
+	if in.AllowedValues != nil {
+		in, out := &in.AllowedValues, &out.AllowedValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequiredTagSpec.
+func (in *RequiredTagSpec) DeepCopy() *RequiredTagSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RequiredTagSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RollingUpdate) DeepCopyInto(out *RollingUpdate) {
 	*out = *in
@@ -5829,6 +7169,22 @@ func (in *Runc) DeepCopy() *Runc {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SELinuxSpec) DeepCopyInto(out *SELinuxSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SELinuxSpec.
+func (in *SELinuxSpec) DeepCopy() *SELinuxSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SELinuxSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSHCredential) DeepCopyInto(out *SSHCredential) {
 	*out = *in
@@ -5989,6 +7345,81 @@ func (in *SnapshotControllerConfig) DeepCopy() *SnapshotControllerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotPolicySpec) DeepCopyInto(out *SpotPolicySpec) {
+	*out = *in
+	if in.FallbackMachineTypes != nil {
+		in, out := &in.FallbackMachineTypes, &out.FallbackMachineTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TerminationAction != nil {
+		in, out := &in.TerminationAction, &out.TerminationAction
+		*out = new(string)
+		**out = **in
+	}
+	if in.EvictionPolicy != nil {
+		in, out := &in.EvictionPolicy, &out.EvictionPolicy
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotPolicySpec.
+func (in *SpotPolicySpec) DeepCopy() *SpotPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
+	*out = *in
+	if in.CipherSuites != nil {
+		in, out := &in.CipherSuites, &out.CipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSpec.
+func (in *TLSSpec) DeepCopy() *TLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagPolicySpec) DeepCopyInto(out *TagPolicySpec) {
+	*out = *in
+	if in.RequiredTags != nil {
+		in, out := &in.RequiredTags, &out.RequiredTags
+		*out = make([]RequiredTagSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagPolicySpec.
+func (in *TagPolicySpec) DeepCopy() *TagPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TagPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetSpec) DeepCopyInto(out *TargetSpec) {
 	*out = *in
@@ -6077,6 +7508,32 @@ func (in *UserData) DeepCopy() *UserData {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerticalPodAutoscalerConfig) DeepCopyInto(out *VerticalPodAutoscalerConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerticalPodAutoscalerConfig.
+func (in *VerticalPodAutoscalerConfig) DeepCopy() *VerticalPodAutoscalerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalPodAutoscalerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMountSpec) DeepCopyInto(out *VolumeMountSpec) {
 	*out = *in
@@ -6245,3 +7702,39 @@ func (in *WeaveNetworkingSpec) DeepCopy() *WeaveNetworkingSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookAuthorizerSpec) DeepCopyInto(out *WebhookAuthorizerSpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AuthorizedTTL != nil {
+		in, out := &in.AuthorizedTTL, &out.AuthorizedTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.UnauthorizedTTL != nil {
+		in, out := &in.UnauthorizedTTL, &out.UnauthorizedTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MatchConditions != nil {
+		in, out := &in.MatchConditions, &out.MatchConditions
+		*out = make([]AuthorizerMatchCondition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookAuthorizerSpec.
+func (in *WebhookAuthorizerSpec) DeepCopy() *WebhookAuthorizerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookAuthorizerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
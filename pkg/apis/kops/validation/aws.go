@@ -31,6 +31,7 @@ import (
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/architectures"
 )
 
 func awsValidateCluster(c *kops.Cluster, strict bool) field.ErrorList {
@@ -241,6 +242,17 @@ func awsValidateSpotDurationInMinute(fieldPath *field.Path, ig *kops.InstanceGro
 	return allErrs
 }
 
+func awsValidateOnDemandMaxPrice(fieldPath *field.Path, maxPrice string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	price, err := strconv.ParseFloat(maxPrice, 64)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fieldPath, maxPrice, "must be a decimal hourly price in USD, e.g. \"0.25\""))
+	} else if price <= 0 {
+		allErrs = append(allErrs, field.Invalid(fieldPath, maxPrice, "must be greater than zero"))
+	}
+	return allErrs
+}
+
 func awsValidateInstanceInterruptionBehavior(fieldPath *field.Path, ig *kops.InstanceGroup) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if ig.Spec.InstanceInterruptionBehavior != nil {
@@ -280,6 +292,10 @@ func awsValidateMixedInstancesPolicy(path *field.Path, spec *kops.MixedInstances
 
 	}
 
+	if spec.InstanceRequirements != nil {
+		errs = append(errs, awsValidateInstanceRequirements(path.Child("instanceRequirements"), spec.InstanceRequirements, ig, cloud)...)
+	}
+
 	if spec.OnDemandBase != nil {
 		if fi.ValueOf(spec.OnDemandBase) < 0 {
 			errs = append(errs, field.Invalid(path.Child("onDemandBase"), spec.OnDemandBase, "cannot be less than zero"))
@@ -303,6 +319,43 @@ func awsValidateMixedInstancesPolicy(path *field.Path, spec *kops.MixedInstances
 	return errs
 }
 
+// awsValidateInstanceRequirements is responsible for validating the attributes used to select instance types
+func awsValidateInstanceRequirements(path *field.Path, spec *kops.InstanceRequirementsSpec, ig *kops.InstanceGroup, cloud awsup.AWSCloud) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, family := range spec.ExcludedInstanceFamilies {
+		if family == "" || strings.ContainsAny(family, ".*") {
+			errs = append(errs, field.Invalid(path.Child("excludedInstanceFamilies").Index(i), family, "must be an instance family, such as \"t2\""))
+		}
+	}
+
+	archs := sets.New[string]()
+	for i, arch := range spec.Architectures {
+		fld := path.Child("architectures").Index(i)
+		if archs.Has(arch) {
+			errs = append(errs, field.Duplicate(fld, arch))
+			continue
+		}
+		archs.Insert(arch)
+		errs = append(errs, IsValidValue(fld, &arch, []string{string(architectures.ArchitectureAmd64), string(architectures.ArchitectureArm64)})...)
+	}
+
+	if cloud != nil && archs.Len() > 0 {
+		// An unresolvable image is reported when validating the machine type
+		if imageInfo, err := cloud.ResolveImage(ig.Spec.Image); err == nil {
+			imageArch := string(architectures.ArchitectureAmd64)
+			if imageInfo.Architecture == ec2types.ArchitectureValuesArm64 {
+				imageArch = string(architectures.ArchitectureArm64)
+			}
+			if !archs.Has(imageArch) {
+				errs = append(errs, field.Invalid(path.Child("architectures"), spec.Architectures, fmt.Sprintf("must include the image architecture %q", imageArch)))
+			}
+		}
+	}
+
+	return errs
+}
+
 func awsValidateTopologyDNS(fieldPath *field.Path, c *kops.Cluster) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -333,6 +333,57 @@ func TestMixedInstancePolicies(t *testing.T) {
 			},
 			ExpectedErrors: []string{"Invalid value::spec.mixedInstancesPolicy.onDemandAboveBase"},
 		},
+		{
+			Input: kops.InstanceGroupSpec{
+				MachineType: "m4.large",
+				Image:       "ami-073c8c0760395aab8",
+				MixedInstancesPolicy: &kops.MixedInstancesPolicySpec{
+					InstanceRequirements: &kops.InstanceRequirementsSpec{
+						ExcludedInstanceFamilies: []string{"t2", "m5a"},
+						Architectures:            []string{"amd64", "arm64"},
+					},
+				},
+			},
+		},
+		{
+			Input: kops.InstanceGroupSpec{
+				MachineType: "m4.large",
+				Image:       "ami-073c8c0760395aab8",
+				MixedInstancesPolicy: &kops.MixedInstancesPolicySpec{
+					InstanceRequirements: &kops.InstanceRequirementsSpec{
+						ExcludedInstanceFamilies: []string{"t2.*"},
+					},
+				},
+			},
+			ExpectedErrors: []string{"Invalid value::spec.mixedInstancesPolicy.instanceRequirements.excludedInstanceFamilies[0]"},
+		},
+		{
+			Input: kops.InstanceGroupSpec{
+				MachineType: "m4.large",
+				Image:       "ami-073c8c0760395aab8",
+				MixedInstancesPolicy: &kops.MixedInstancesPolicySpec{
+					InstanceRequirements: &kops.InstanceRequirementsSpec{
+						Architectures: []string{"amd64", "x86_64", "amd64"},
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Unsupported value::spec.mixedInstancesPolicy.instanceRequirements.architectures[1]",
+				"Duplicate value::spec.mixedInstancesPolicy.instanceRequirements.architectures[2]",
+			},
+		},
+		{
+			Input: kops.InstanceGroupSpec{
+				MachineType: "m4.large",
+				Image:       "ami-073c8c0760395aab8",
+				MixedInstancesPolicy: &kops.MixedInstancesPolicySpec{
+					InstanceRequirements: &kops.InstanceRequirementsSpec{
+						Architectures: []string{"arm64"},
+					},
+				},
+			},
+			ExpectedErrors: []string{"Invalid value::spec.mixedInstancesPolicy.instanceRequirements.architectures"},
+		},
 	}
 	cloud := awsup.BuildMockAWSCloud("us-east-1", "abc")
 	mockEC2 := &mockec2.MockEC2{}
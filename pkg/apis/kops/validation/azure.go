@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
+)
+
+// azureUserAssignedIdentityResourceType is the resource type of user-assigned managed identities.
+const azureUserAssignedIdentityResourceType = "Microsoft.ManagedIdentity/userAssignedIdentities"
+
+const (
+	azurePrivateDNSZoneResourceType = "Microsoft.Network/privateDnsZones"
+	azureVirtualNetworkResourceType = "Microsoft.Network/virtualNetworks"
+)
+
+func validateAzure(c *kops.Cluster, spec *kops.AzureSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.PrivateAPI != nil {
+		allErrs = append(allErrs, azureValidatePrivateAPI(c, spec.PrivateAPI, fldPath.Child("privateAPI"))...)
+	}
+
+	return allErrs
+}
+
+func azureValidatePrivateAPI(c *kops.Cluster, spec *kops.AzurePrivateAPISpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if c.Spec.API.LoadBalancer == nil || c.Spec.API.LoadBalancer.Type != kops.LoadBalancerTypeInternal {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "private API access requires an internal API load balancer"))
+	}
+
+	if spec.PrivateDNSZoneID == "" {
+		if spec.PrivateLinkService == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("privateDNSZoneID"), "a private DNS zone or a Private Link service must be configured"))
+		}
+		if len(spec.LinkedVirtualNetworkIDs) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("linkedVirtualNetworkIDs"), "virtual networks can only be linked to a private DNS zone"))
+		}
+	} else {
+		res, err := arm.ParseResourceID(spec.PrivateDNSZoneID)
+		if err != nil || !strings.EqualFold(res.ResourceType.String(), azurePrivateDNSZoneResourceType) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("privateDNSZoneID"), spec.PrivateDNSZoneID, fmt.Sprintf("must be the resource ID of a private DNS zone (%s)", azurePrivateDNSZoneResourceType)))
+		} else {
+			apiName := c.Spec.API.PublicName
+			if apiName == "" {
+				apiName = "api." + c.Name
+			}
+			zoneName := strings.ToLower(res.Name)
+			if !strings.HasSuffix(strings.ToLower(apiName), "."+zoneName) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("privateDNSZoneID"), spec.PrivateDNSZoneID, fmt.Sprintf("the API name %q is not in the private DNS zone %q", apiName, res.Name)))
+			}
+		}
+
+		seen := sets.New[string]()
+		for i, id := range spec.LinkedVirtualNetworkIDs {
+			fld := fldPath.Child("linkedVirtualNetworkIDs").Index(i)
+			res, err := arm.ParseResourceID(id)
+			if err != nil || !strings.EqualFold(res.ResourceType.String(), azureVirtualNetworkResourceType) {
+				allErrs = append(allErrs, field.Invalid(fld, id, fmt.Sprintf("must be the resource ID of a virtual network (%s)", azureVirtualNetworkResourceType)))
+				continue
+			}
+			key := strings.ToLower(id)
+			if seen.Has(key) {
+				allErrs = append(allErrs, field.Duplicate(fld, id))
+			}
+			seen.Insert(key)
+		}
+	}
+
+	if spec.PrivateLinkService != nil {
+		seen := sets.New[string]()
+		for i, id := range spec.PrivateLinkService.AllowedSubscriptionIDs {
+			fld := fldPath.Child("privateLinkService", "allowedSubscriptionIDs").Index(i)
+			if _, err := uuid.Parse(id); err != nil {
+				allErrs = append(allErrs, field.Invalid(fld, id, "must be a subscription ID"))
+				continue
+			}
+			key := strings.ToLower(id)
+			if seen.Has(key) {
+				allErrs = append(allErrs, field.Duplicate(fld, id))
+			}
+			seen.Insert(key)
+		}
+	}
+
+	return allErrs
+}
+
+func azureValidateUserAssignedIdentities(identities []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.New[string]()
+	for i, identity := range identities {
+		res, err := arm.ParseResourceID(identity)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), identity, fmt.Sprintf("must be the resource ID of a user-assigned managed identity: %v", err)))
+			continue
+		}
+		if !strings.EqualFold(res.ResourceType.String(), azureUserAssignedIdentityResourceType) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), identity, fmt.Sprintf("must be the resource ID of a user-assigned managed identity (%s)", azureUserAssignedIdentityResourceType)))
+			continue
+		}
+		// Azure compares resource IDs case-insensitively
+		key := strings.ToLower(identity)
+		if seen.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), identity))
+		}
+		seen.Insert(key)
+	}
+
+	return allErrs
+}
+
+func azureValidateInstanceGroupZones(ig *kops.InstanceGroup, cluster *kops.Cluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	regions := sets.New[string]()
+	for _, subnet := range cluster.Spec.Networking.Subnets {
+		if subnet.Region != "" {
+			regions.Insert(subnet.Region)
+		}
+	}
+
+	for i, zone := range ig.Spec.Zones {
+		region, number, _ := strings.Cut(zone, "-")
+		if region == "" || !slices.Contains(azure.AvailabilityZoneNumbers, number) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), zone, fmt.Sprintf("must be an availability zone of the form <region>-<%s>", strings.Join(azure.AvailabilityZoneNumbers, "|"))))
+			continue
+		}
+		if regions.Len() != 0 && !regions.Has(region) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), zone, fmt.Sprintf("must be an availability zone of region %s", strings.Join(sets.List(regions), ", "))))
+		}
+	}
+
+	return allErrs
+}
+
+func azureValidateEphemeralOSDisk(ig *kops.InstanceGroup, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, IsValidValue(fldPath, ig.Spec.AzureEphemeralOSDiskPlacement, []string{"CacheDisk", "ResourceDisk"})...)
+
+	// Ephemeral OS disks are lost when VMs are deallocated, so Azure only allows evicted Spot VMs to be deleted
+	if ig.Spec.SpotPolicy != nil && fi.ValueOf(ig.Spec.SpotPolicy.EvictionPolicy) == "Deallocate" {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "ephemeral OS disks cannot be used with the Deallocate eviction policy"))
+	}
+	if ig.Spec.RootVolume != nil && ig.Spec.RootVolume.Type != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "rootVolume", "type"), "the root volume type cannot be set with ephemeral OS disks"))
+	}
+
+	return allErrs
+}
+
+func azureValidateSpotPolicy(ig *kops.InstanceGroup, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := ig.Spec.SpotPolicy
+
+	if len(spec.FallbackMachineTypes) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("fallbackMachineTypes"), "fallbackMachineTypes is only supported on GCE"))
+	}
+	if spec.TerminationAction != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("terminationAction"), "terminationAction is only supported on GCE"))
+	}
+	if spec.EvictionPolicy != nil {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("evictionPolicy"), spec.EvictionPolicy, []string{"Delete", "Deallocate"})...)
+	}
+
+	if ig.Spec.MaxPrice != nil {
+		// Azure accepts a price in USD with up to 5 decimal places, or -1 to pay up to the on-demand price
+		price, err := strconv.ParseFloat(*ig.Spec.MaxPrice, 64)
+		if err != nil || (price <= 0 && price != -1) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "maxPrice"), *ig.Spec.MaxPrice, "must be a positive price in USD, or -1 to pay up to the on-demand price"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+const (
+	testAzurePrivateDNSZoneID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dns/providers/Microsoft.Network/privateDnsZones/example.com"
+	testAzureVirtualNetworkID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/jump/providers/Microsoft.Network/virtualNetworks/jump"
+)
+
+func TestAzureValidatePrivateAPI(t *testing.T) {
+	grid := []struct {
+		description    string
+		lbType         kops.LoadBalancerType
+		publicName     string
+		spec           kops.AzurePrivateAPISpec
+		expectedErrors []string
+	}{
+		{
+			description: "private DNS zone",
+			lbType:      kops.LoadBalancerTypeInternal,
+			spec: kops.AzurePrivateAPISpec{
+				PrivateDNSZoneID:        testAzurePrivateDNSZoneID,
+				LinkedVirtualNetworkIDs: []string{testAzureVirtualNetworkID},
+			},
+		},
+		{
+			description: "private link service",
+			lbType:      kops.LoadBalancerTypeInternal,
+			spec: kops.AzurePrivateAPISpec{
+				PrivateLinkService: &kops.AzurePrivateLinkServiceSpec{
+					AllowedSubscriptionIDs: []string{"11111111-1111-1111-1111-111111111111"},
+				},
+			},
+		},
+		{
+			description:    "public load balancer",
+			lbType:         kops.LoadBalancerTypePublic,
+			spec:           kops.AzurePrivateAPISpec{PrivateDNSZoneID: testAzurePrivateDNSZoneID},
+			expectedErrors: []string{"Forbidden::spec.cloudProvider.azure.privateAPI"},
+		},
+		{
+			description:    "nothing configured",
+			lbType:         kops.LoadBalancerTypeInternal,
+			expectedErrors: []string{"Required value::spec.cloudProvider.azure.privateAPI.privateDNSZoneID"},
+		},
+		{
+			description:    "invalid zone ID",
+			lbType:         kops.LoadBalancerTypeInternal,
+			spec:           kops.AzurePrivateAPISpec{PrivateDNSZoneID: testAzureVirtualNetworkID},
+			expectedErrors: []string{"Invalid value::spec.cloudProvider.azure.privateAPI.privateDNSZoneID"},
+		},
+		{
+			description:    "API name outside zone",
+			lbType:         kops.LoadBalancerTypeInternal,
+			publicName:     "api.example.org",
+			spec:           kops.AzurePrivateAPISpec{PrivateDNSZoneID: testAzurePrivateDNSZoneID},
+			expectedErrors: []string{"Invalid value::spec.cloudProvider.azure.privateAPI.privateDNSZoneID"},
+		},
+		{
+			description: "invalid and duplicate linked virtual networks",
+			lbType:      kops.LoadBalancerTypeInternal,
+			spec: kops.AzurePrivateAPISpec{
+				PrivateDNSZoneID:        testAzurePrivateDNSZoneID,
+				LinkedVirtualNetworkIDs: []string{testAzurePrivateDNSZoneID, testAzureVirtualNetworkID, testAzureVirtualNetworkID},
+			},
+			expectedErrors: []string{
+				"Invalid value::spec.cloudProvider.azure.privateAPI.linkedVirtualNetworkIDs[0]",
+				"Duplicate value::spec.cloudProvider.azure.privateAPI.linkedVirtualNetworkIDs[2]",
+			},
+		},
+		{
+			description: "linked virtual networks without zone",
+			lbType:      kops.LoadBalancerTypeInternal,
+			spec: kops.AzurePrivateAPISpec{
+				LinkedVirtualNetworkIDs: []string{testAzureVirtualNetworkID},
+				PrivateLinkService:      &kops.AzurePrivateLinkServiceSpec{},
+			},
+			expectedErrors: []string{"Forbidden::spec.cloudProvider.azure.privateAPI.linkedVirtualNetworkIDs"},
+		},
+		{
+			description: "invalid subscription ID",
+			lbType:      kops.LoadBalancerTypeInternal,
+			spec: kops.AzurePrivateAPISpec{
+				PrivateLinkService: &kops.AzurePrivateLinkServiceSpec{
+					AllowedSubscriptionIDs: []string{"not-a-subscription"},
+				},
+			},
+			expectedErrors: []string{"Invalid value::spec.cloudProvider.azure.privateAPI.privateLinkService.allowedSubscriptionIDs[0]"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.description, func(t *testing.T) {
+			c := &kops.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test.example.com"},
+				Spec: kops.ClusterSpec{
+					API: kops.APISpec{
+						PublicName:   g.publicName,
+						LoadBalancer: &kops.LoadBalancerAccessSpec{Type: g.lbType},
+					},
+				},
+			}
+			errs := azureValidatePrivateAPI(c, &g.spec, field.NewPath("spec", "cloudProvider", "azure", "privateAPI"))
+			testErrors(t, g.description, errs, g.expectedErrors)
+		})
+	}
+}
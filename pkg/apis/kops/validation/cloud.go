@@ -0,0 +1,314 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	kopsmodel "k8s.io/kops/pkg/apis/kops/model"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+// ValidateCloudResources validates that the cloud resources the cluster and its instance groups reference exist,
+// with lookups in the cloud that are too expensive for every validation:
+// the existing network and its subnets, and the availability of the machine types in the zones of each instance group.
+func ValidateCloudResources(ctx context.Context, c *kops.Cluster, groups []*kops.InstanceGroup, cloud fi.Cloud) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch c.GetCloudProvider() {
+	case kops.CloudProviderAWS:
+		allErrs = append(allErrs, awsValidateExistingNetwork(c, cloud.(awsup.AWSCloud))...)
+	}
+
+	allErrs = append(allErrs, ValidateMachineTypeAvailability(ctx, c, groups, cloud)...)
+
+	return allErrs
+}
+
+// ValidateMachineTypeAvailability validates that the machine types of the instance groups are available in each of their zones.
+// Otherwise, it suggests similar machine types that are available in all of them.
+func ValidateMachineTypeAvailability(ctx context.Context, c *kops.Cluster, groups []*kops.InstanceGroup, cloud fi.Cloud) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, g := range groups {
+		if g.IsKarpenterManaged() {
+			continue
+		}
+		switch c.GetCloudProvider() {
+		case kops.CloudProviderAWS:
+			allErrs = append(allErrs, awsValidateInstanceTypeOfferings(ctx, c, g, cloud.(awsup.AWSCloud))...)
+		case kops.CloudProviderGCE:
+			allErrs = append(allErrs, gceValidateMachineTypeAvailability(ctx, c, g, cloud.(gce.GCECloud))...)
+		}
+	}
+
+	return allErrs
+}
+
+// awsValidateExistingNetwork validates that the existing VPC and subnets of the cluster exist, in the expected zones.
+func awsValidateExistingNetwork(c *kops.Cluster, cloud awsup.AWSCloud) field.ErrorList {
+	networkID := c.Spec.Networking.NetworkID
+	if networkID == "" {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	fieldPath := field.NewPath("spec", "networking")
+
+	vpcInfo, err := cloud.FindVPCInfo(networkID)
+	if err != nil {
+		return append(allErrs, field.InternalError(fieldPath.Child("networkID"), fmt.Errorf("error describing VPC %q: %w", networkID, err)))
+	}
+	if vpcInfo == nil {
+		return append(allErrs, field.NotFound(fieldPath.Child("networkID"), networkID))
+	}
+
+	vpcSubnets := make(map[string]*fi.SubnetInfo)
+	for _, subnet := range vpcInfo.Subnets {
+		vpcSubnets[subnet.ID] = subnet
+	}
+	for i, subnet := range c.Spec.Networking.Subnets {
+		if subnet.ID == "" {
+			continue
+		}
+		subnetPath := fieldPath.Child("subnets").Index(i)
+		vpcSubnet := vpcSubnets[subnet.ID]
+		if vpcSubnet == nil {
+			allErrs = append(allErrs, field.NotFound(subnetPath.Child("id"), subnet.ID))
+			continue
+		}
+		if subnet.Zone != "" && vpcSubnet.Zone != subnet.Zone {
+			allErrs = append(allErrs, field.Invalid(subnetPath.Child("zone"), subnet.Zone, fmt.Sprintf("subnet %q is in zone %q", subnet.ID, vpcSubnet.Zone)))
+		}
+	}
+
+	return allErrs
+}
+
+// awsValidateInstanceTypeOfferings validates that at least one of the machine types of the instance group is offered in each of its zones.
+func awsValidateInstanceTypeOfferings(ctx context.Context, c *kops.Cluster, g *kops.InstanceGroup, cloud awsup.AWSCloud) field.ErrorList {
+	var instanceTypes []string
+	if g.Spec.MachineType != "" {
+		instanceTypes = append(instanceTypes, strings.Split(g.Spec.MachineType, ",")...)
+	}
+	if g.Spec.MixedInstancesPolicy != nil {
+		instanceTypes = append(instanceTypes, g.Spec.MixedInstancesPolicy.Instances...)
+	}
+	if len(instanceTypes) == 0 {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	fieldPath := field.NewPath(g.GetName(), "spec", "machineType")
+
+	zones, err := kopsmodel.FindZonesForInstanceGroup(c, g)
+	if err != nil {
+		return append(allErrs, field.Invalid(field.NewPath(g.GetName(), "spec", "subnets"), g.Spec.Subnets, err.Error()))
+	}
+	if len(zones) == 0 {
+		return nil
+	}
+
+	offered, err := awsDescribeInstanceTypeOfferings(ctx, cloud, instanceTypes, zones)
+	if err != nil {
+		return append(allErrs, field.InternalError(fieldPath, err))
+	}
+
+	var missingZones []string
+	for _, zone := range zones {
+		if offered[zone].Len() == 0 {
+			missingZones = append(missingZones, zone)
+		}
+	}
+	if len(missingZones) == 0 {
+		return nil
+	}
+
+	var suggestions []string
+	if allOffered, err := awsDescribeInstanceTypeOfferings(ctx, cloud, nil, zones); err != nil {
+		klog.Warningf("error describing instance types offered in zones %q: %v", zones, err)
+	} else {
+		suggestions = suggestMachineTypes(instanceTypes[0], ".", availableInAllZones(allOffered, zones))
+	}
+
+	for _, zone := range missingZones {
+		allErrs = append(allErrs, machineTypeNotAvailable(fieldPath, g, instanceTypes, zone, suggestions))
+	}
+	return allErrs
+}
+
+// awsDescribeInstanceTypeOfferings returns the instance types offered in each of the zones, restricted to the given instance types if any.
+func awsDescribeInstanceTypeOfferings(ctx context.Context, cloud awsup.AWSCloud, instanceTypes []string, zones []string) (map[string]sets.Set[string], error) {
+	filters := []ec2types.Filter{
+		awsup.NewEC2Filter("location", zones...),
+	}
+	if len(instanceTypes) != 0 {
+		filters = append(filters, awsup.NewEC2Filter("instance-type", instanceTypes...))
+	}
+
+	offered := make(map[string]sets.Set[string])
+	paginator := ec2.NewDescribeInstanceTypeOfferingsPaginator(cloud.EC2(), &ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: ec2types.LocationTypeAvailabilityZone,
+		Filters:      filters,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error describing offerings of machine types %q: %w", instanceTypes, err)
+		}
+		for _, offering := range page.InstanceTypeOfferings {
+			zone := aws.ToString(offering.Location)
+			if offered[zone] == nil {
+				offered[zone] = sets.New[string]()
+			}
+			offered[zone].Insert(string(offering.InstanceType))
+		}
+	}
+	return offered, nil
+}
+
+// gceValidateMachineTypeAvailability validates that the machine type of the instance group is available in each of its zones.
+func gceValidateMachineTypeAvailability(ctx context.Context, c *kops.Cluster, g *kops.InstanceGroup, cloud gce.GCECloud) field.ErrorList {
+	machineType := g.Spec.MachineType
+	// Custom machine types are not listed
+	if machineType == "" || strings.Contains(machineType, "custom-") {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	fieldPath := field.NewPath(g.GetName(), "spec", "machineType")
+
+	zones, err := kopsmodel.FindZonesForInstanceGroup(c, g)
+	if err != nil {
+		return append(allErrs, field.Invalid(field.NewPath(g.GetName(), "spec", "subnets"), g.Spec.Subnets, err.Error()))
+	}
+
+	var missingZones []string
+	for _, zone := range zones {
+		if _, err := cloud.Compute().MachineTypes().Get(ctx, cloud.Project(), zone, machineType); err != nil {
+			if !gce.IsNotFound(err) {
+				return append(allErrs, field.InternalError(fieldPath, fmt.Errorf("error getting machine type %q in zone %q: %w", machineType, zone, err)))
+			}
+			missingZones = append(missingZones, zone)
+		}
+	}
+	if len(missingZones) == 0 {
+		return nil
+	}
+
+	available := make(map[string]sets.Set[string])
+	for _, zone := range zones {
+		machineTypes, err := cloud.Compute().MachineTypes().List(ctx, cloud.Project(), zone)
+		if err != nil {
+			klog.Warningf("error listing machine types in zone %q: %v", zone, err)
+			available = nil
+			break
+		}
+		available[zone] = sets.New[string]()
+		for _, mt := range machineTypes {
+			available[zone].Insert(mt.Name)
+		}
+	}
+	suggestions := suggestMachineTypes(machineType, "-", availableInAllZones(available, zones))
+
+	for _, zone := range missingZones {
+		allErrs = append(allErrs, machineTypeNotAvailable(fieldPath, g, []string{machineType}, zone, suggestions))
+	}
+	return allErrs
+}
+
+// machineTypeNotAvailable returns the error for an instance group whose machine types are not available in one of its zones.
+func machineTypeNotAvailable(fieldPath *field.Path, g *kops.InstanceGroup, machineTypes []string, zone string, suggestions []string) *field.Error {
+	var detail string
+	if len(machineTypes) == 1 {
+		detail = fmt.Sprintf("machine type %q is not offered in zone %q", machineTypes[0], zone)
+	} else {
+		detail = fmt.Sprintf("none of the machine types %q is offered in zone %q", machineTypes, zone)
+	}
+	if len(suggestions) != 0 {
+		detail += fmt.Sprintf("; similar machine types offered in all zones of the instance group: %s", strings.Join(suggestions, ", "))
+	}
+	return field.Invalid(fieldPath, g.Spec.MachineType, detail)
+}
+
+// availableInAllZones returns the sorted machine types that are available in every one of the zones.
+func availableInAllZones(available map[string]sets.Set[string], zones []string) []string {
+	if len(available) == 0 || len(zones) == 0 {
+		return nil
+	}
+	common := available[zones[0]].Clone()
+	for _, zone := range zones[1:] {
+		common = common.Intersection(available[zone])
+	}
+	return sets.List(common)
+}
+
+// maxMachineTypeSuggestions is the maximum number of alternative machine types suggested.
+const maxMachineTypeSuggestions = 5
+
+// suggestMachineTypes returns the candidates that have the same size as the machine type,
+// those of a family that starts with its family first, then those of the same class (the letters the family starts with).
+// The family and the size of machine types are separated by sep.
+func suggestMachineTypes(machineType string, sep string, candidates []string) []string {
+	family, size, found := strings.Cut(machineType, sep)
+	if !found {
+		return nil
+	}
+	class := family
+	if i := strings.IndexFunc(family, unicode.IsDigit); i > 0 {
+		class = family[:i]
+	}
+
+	tiers := make([][]string, 3)
+	for _, candidate := range candidates {
+		candidateFamily, candidateSize, found := strings.Cut(candidate, sep)
+		if !found || candidateSize != size || candidate == machineType {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(candidateFamily, family):
+			tiers[0] = append(tiers[0], candidate)
+		case strings.HasPrefix(candidateFamily, class):
+			tiers[1] = append(tiers[1], candidate)
+		default:
+			tiers[2] = append(tiers[2], candidate)
+		}
+	}
+
+	var suggestions []string
+	for _, tier := range tiers {
+		sort.Strings(tier)
+		suggestions = append(suggestions, tier...)
+	}
+	if len(suggestions) > maxMachineTypeSuggestions {
+		suggestions = suggestions[:maxMachineTypeSuggestions]
+	}
+	return suggestions
+}
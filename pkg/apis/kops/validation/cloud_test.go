@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cloudmock/aws/mockec2"
+	gcemock "k8s.io/kops/cloudmock/gce"
+	"k8s.io/kops/cloudmock/gce/mockcompute"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+func TestValidateCloudResources(t *testing.T) {
+	cloud := awsup.BuildMockAWSCloud("us-east-1", "ab")
+	mockEC2 := &mockec2.MockEC2{}
+	cloud.MockEC2 = mockEC2
+
+	if _, err := mockEC2.CreateVpcWithId(&ec2.CreateVpcInput{CidrBlock: aws.String("172.20.0.0/16")}, "vpc-1"); err != nil {
+		t.Fatalf("error creating VPC: %v", err)
+	}
+	for id, zone := range map[string]string{"subnet-a": "us-east-1a", "subnet-b": "us-east-1b"} {
+		request := &ec2.CreateSubnetInput{
+			VpcId:            aws.String("vpc-1"),
+			AvailabilityZone: aws.String(zone),
+			CidrBlock:        aws.String("172.20.0.0/24"),
+		}
+		if _, err := mockEC2.CreateSubnetWithId(request, id); err != nil {
+			t.Fatalf("error creating subnet: %v", err)
+		}
+	}
+	mockEC2.InstanceTypeOfferings = []ec2types.InstanceTypeOffering{
+		{InstanceType: "m5.large", Location: aws.String("us-east-1a"), LocationType: ec2types.LocationTypeAvailabilityZone},
+		{InstanceType: "m5.large", Location: aws.String("us-east-1b"), LocationType: ec2types.LocationTypeAvailabilityZone},
+		{InstanceType: "t3.medium", Location: aws.String("us-east-1a"), LocationType: ec2types.LocationTypeAvailabilityZone},
+	}
+
+	grid := []struct {
+		Description    string
+		NetworkID      string
+		Subnets        []kops.ClusterSubnetSpec
+		InstanceGroup  kops.InstanceGroupSpec
+		ExpectedErrors []string
+	}{
+		{
+			Description: "existing network",
+			NetworkID:   "vpc-1",
+			Subnets: []kops.ClusterSubnetSpec{
+				{Name: "a", Zone: "us-east-1a", ID: "subnet-a"},
+				{Name: "b", Zone: "us-east-1b", ID: "subnet-b"},
+			},
+			InstanceGroup: kops.InstanceGroupSpec{MachineType: "m5.large", Subnets: []string{"a", "b"}},
+		},
+		{
+			Description:    "missing network",
+			NetworkID:      "vpc-2",
+			Subnets:        []kops.ClusterSubnetSpec{{Name: "a", Zone: "us-east-1a", ID: "subnet-a"}},
+			ExpectedErrors: []string{"Not found::spec.networking.networkID"},
+		},
+		{
+			Description: "missing subnet and wrong zone",
+			NetworkID:   "vpc-1",
+			Subnets: []kops.ClusterSubnetSpec{
+				{Name: "a", Zone: "us-east-1a", ID: "subnet-c"},
+				{Name: "b", Zone: "us-east-1a", ID: "subnet-b"},
+			},
+			ExpectedErrors: []string{
+				"Not found::spec.networking.subnets[0].id",
+				"Invalid value::spec.networking.subnets[1].zone",
+			},
+		},
+		{
+			Description:    "machine type not offered in a zone",
+			Subnets:        []kops.ClusterSubnetSpec{{Name: "a", Zone: "us-east-1a"}, {Name: "b", Zone: "us-east-1b"}},
+			InstanceGroup:  kops.InstanceGroupSpec{MachineType: "t3.medium", Subnets: []string{"a", "b"}},
+			ExpectedErrors: []string{"Invalid value::nodes.spec.machineType"},
+		},
+		{
+			Description: "one of the mixed instance types offered in each zone",
+			Subnets:     []kops.ClusterSubnetSpec{{Name: "a", Zone: "us-east-1a"}, {Name: "b", Zone: "us-east-1b"}},
+			InstanceGroup: kops.InstanceGroupSpec{
+				MachineType:          "t3.medium",
+				Subnets:              []string{"a", "b"},
+				MixedInstancesPolicy: &kops.MixedInstancesPolicySpec{Instances: []string{"t3.medium", "m5.large"}},
+			},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Description, func(t *testing.T) {
+			cluster := &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+					Networking: kops.NetworkingSpec{
+						NetworkID: g.NetworkID,
+						Subnets:   g.Subnets,
+					},
+				},
+			}
+			ig := &kops.InstanceGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "nodes"},
+				Spec:       g.InstanceGroup,
+			}
+			errs := ValidateCloudResources(context.TODO(), cluster, []*kops.InstanceGroup{ig}, cloud)
+			testErrors(t, g.Description, errs, g.ExpectedErrors)
+		})
+	}
+}
+
+func TestValidateMachineTypeAvailabilitySuggestions(t *testing.T) {
+	cluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			Networking: kops.NetworkingSpec{
+				Subnets: []kops.ClusterSubnetSpec{{Name: "a", Zone: "us-east-1a"}, {Name: "b", Zone: "us-east-1b"}},
+			},
+		},
+	}
+	ig := &kops.InstanceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "nodes"},
+		Spec:       kops.InstanceGroupSpec{MachineType: "m6i.large", Subnets: []string{"a", "b"}},
+	}
+
+	cloud := awsup.BuildMockAWSCloud("us-east-1", "ab")
+	mockEC2 := &mockec2.MockEC2{}
+	cloud.MockEC2 = mockEC2
+	for _, offering := range []struct{ instanceType, zone string }{
+		{"m6i.large", "us-east-1a"},
+		{"m6in.large", "us-east-1a"},
+		{"m6in.large", "us-east-1b"},
+		{"m5.large", "us-east-1a"},
+		{"m5.large", "us-east-1b"},
+		{"c5.large", "us-east-1a"},
+		{"c5.large", "us-east-1b"},
+		{"m5.xlarge", "us-east-1a"},
+		{"m5.xlarge", "us-east-1b"},
+	} {
+		mockEC2.InstanceTypeOfferings = append(mockEC2.InstanceTypeOfferings, ec2types.InstanceTypeOffering{
+			InstanceType: ec2types.InstanceType(offering.instanceType),
+			Location:     aws.String(offering.zone),
+			LocationType: ec2types.LocationTypeAvailabilityZone,
+		})
+	}
+
+	errs := ValidateMachineTypeAvailability(context.TODO(), cluster, []*kops.InstanceGroup{ig}, cloud)
+	testErrors(t, "aws", errs, []string{"Invalid value::nodes.spec.machineType"})
+	if len(errs) == 1 {
+		expected := `machine type "m6i.large" is not offered in zone "us-east-1b"; similar machine types offered in all zones of the instance group: m6in.large, m5.large, c5.large`
+		if errs[0].Detail != expected {
+			t.Errorf("unexpected detail %q, expected %q", errs[0].Detail, expected)
+		}
+	}
+}
+
+func TestValidateMachineTypeAvailabilityGCE(t *testing.T) {
+	cluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+			Networking: kops.NetworkingSpec{
+				Subnets: []kops.ClusterSubnetSpec{{Name: "us-test1", Region: "us-test1"}},
+			},
+		},
+	}
+
+	cloud := gcemock.InstallMockGCECloud("us-test1", "testproject")
+	cloud.Compute().(*mockcompute.MockClient).SetMachineTypes("us-test1-a", "e2-medium", "n2-standard-2", "e2-standard-2")
+	cloud.Compute().(*mockcompute.MockClient).SetMachineTypes("us-test1-b", "e2-medium", "n2-standard-2", "e2-standard-2", "n1-standard-2")
+
+	grid := []struct {
+		MachineType    string
+		ExpectedErrors []string
+		ExpectedDetail string
+	}{
+		{
+			MachineType: "e2-medium",
+		},
+		{
+			MachineType: "custom-2-4096",
+		},
+		{
+			MachineType:    "n1-standard-2",
+			ExpectedErrors: []string{"Invalid value::nodes.spec.machineType"},
+			ExpectedDetail: `machine type "n1-standard-2" is not offered in zone "us-test1-a"; similar machine types offered in all zones of the instance group: n2-standard-2, e2-standard-2`,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.MachineType, func(t *testing.T) {
+			ig := &kops.InstanceGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "nodes"},
+				Spec: kops.InstanceGroupSpec{
+					MachineType: g.MachineType,
+					Subnets:     []string{"us-test1"},
+					Zones:       []string{"us-test1-a", "us-test1-b"},
+				},
+			}
+			errs := ValidateMachineTypeAvailability(context.TODO(), cluster, []*kops.InstanceGroup{ig}, cloud)
+			testErrors(t, g.MachineType, errs, g.ExpectedErrors)
+			if g.ExpectedDetail != "" && len(errs) == 1 && errs[0].Detail != g.ExpectedDetail {
+				t.Errorf("unexpected detail %q, expected %q", errs[0].Detail, g.ExpectedDetail)
+			}
+		})
+	}
+}
+
+func TestSuggestMachineTypes(t *testing.T) {
+	candidates := []string{"c5.large", "m5.large", "m5.xlarge", "m5a.large", "m5d.large", "m6i.large", "r5.large", "t3.large"}
+	actual := suggestMachineTypes("m5.large", ".", candidates)
+	expected := []string{"m5a.large", "m5d.large", "m6i.large", "c5.large", "r5.large"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("unexpected suggestions %v, expected %v", actual, expected)
+	}
+
+	if actual := suggestMachineTypes("unknown", ".", candidates); len(actual) != 0 {
+		t.Errorf("unexpected suggestions %v for a machine type without a size", actual)
+	}
+}
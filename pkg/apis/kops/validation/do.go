@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func validateDO(c *kops.Cluster, spec *kops.DOSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.VPCNativeRouting {
+		cilium := c.Spec.Networking.Cilium
+		if cilium == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("vpcNativeRouting"), "VPC-native routing requires Cilium networking"))
+		} else if cilium.Tunnel != "" && cilium.Tunnel != "disabled" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("vpcNativeRouting"), "VPC-native routing requires the Cilium tunnel to be disabled"))
+		}
+	}
+
+	if spec.APILoadBalancer != nil {
+		lbPath := fldPath.Child("apiLoadBalancer")
+		if c.Spec.API.LoadBalancer == nil {
+			allErrs = append(allErrs, field.Forbidden(lbPath, "apiLoadBalancer requires an API load balancer"))
+		}
+		if sizeUnit := spec.APILoadBalancer.SizeUnit; sizeUnit != nil && (*sizeUnit < 1 || *sizeUnit > 100) {
+			allErrs = append(allErrs, field.Invalid(lbPath.Child("sizeUnit"), *sizeUnit, "sizeUnit must be between 1 and 100"))
+		}
+		if spec.APILoadBalancer.Algorithm != "" {
+			allErrs = append(allErrs, IsValidValue(lbPath.Child("algorithm"), &spec.APILoadBalancer.Algorithm, []string{"round_robin", "least_connections"})...)
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func TestValidateDO(t *testing.T) {
+	grid := []struct {
+		desc           string
+		spec           kops.DOSpec
+		networking     kops.NetworkingSpec
+		noLoadBalancer bool
+		expectedErrors []string
+	}{
+		{
+			desc: "empty spec",
+		},
+		{
+			desc: "valid load balancer",
+			spec: kops.DOSpec{APILoadBalancer: &kops.DOLoadBalancerSpec{SizeUnit: new(int32(100)), Algorithm: "least_connections"}},
+		},
+		{
+			desc:           "size unit too small",
+			spec:           kops.DOSpec{APILoadBalancer: &kops.DOLoadBalancerSpec{SizeUnit: new(int32(0))}},
+			expectedErrors: []string{"Invalid value::spec.cloudProvider.do.apiLoadBalancer.sizeUnit"},
+		},
+		{
+			desc:           "size unit too large",
+			spec:           kops.DOSpec{APILoadBalancer: &kops.DOLoadBalancerSpec{SizeUnit: new(int32(101))}},
+			expectedErrors: []string{"Invalid value::spec.cloudProvider.do.apiLoadBalancer.sizeUnit"},
+		},
+		{
+			desc:           "unknown algorithm",
+			spec:           kops.DOSpec{APILoadBalancer: &kops.DOLoadBalancerSpec{Algorithm: "random"}},
+			expectedErrors: []string{"Unsupported value::spec.cloudProvider.do.apiLoadBalancer.algorithm"},
+		},
+		{
+			desc:           "load balancer options without load balancer",
+			spec:           kops.DOSpec{APILoadBalancer: &kops.DOLoadBalancerSpec{SizeUnit: new(int32(2))}},
+			noLoadBalancer: true,
+			expectedErrors: []string{"Forbidden::spec.cloudProvider.do.apiLoadBalancer"},
+		},
+		{
+			desc:       "VPC-native routing with Cilium",
+			spec:       kops.DOSpec{VPCNativeRouting: true},
+			networking: kops.NetworkingSpec{Cilium: &kops.CiliumNetworkingSpec{}},
+		},
+		{
+			desc:       "VPC-native routing with Cilium tunnel disabled",
+			spec:       kops.DOSpec{VPCNativeRouting: true},
+			networking: kops.NetworkingSpec{Cilium: &kops.CiliumNetworkingSpec{Tunnel: "disabled"}},
+		},
+		{
+			desc:           "VPC-native routing with Cilium tunnel",
+			spec:           kops.DOSpec{VPCNativeRouting: true},
+			networking:     kops.NetworkingSpec{Cilium: &kops.CiliumNetworkingSpec{Tunnel: "vxlan"}},
+			expectedErrors: []string{"Forbidden::spec.cloudProvider.do.vpcNativeRouting"},
+		},
+		{
+			desc:           "VPC-native routing without Cilium",
+			spec:           kops.DOSpec{VPCNativeRouting: true},
+			networking:     kops.NetworkingSpec{Calico: &kops.CalicoNetworkingSpec{}},
+			expectedErrors: []string{"Forbidden::spec.cloudProvider.do.vpcNativeRouting"},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			cluster := &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider: kops.CloudProviderSpec{DO: &g.spec},
+					Networking:    g.networking,
+				},
+			}
+			if !g.noLoadBalancer {
+				cluster.Spec.API.LoadBalancer = &kops.LoadBalancerAccessSpec{Type: kops.LoadBalancerTypePublic}
+			}
+			errs := validateDO(cluster, &g.spec, field.NewPath("spec", "cloudProvider", "do"))
+			testErrors(t, g.desc, errs, g.expectedErrors)
+		})
+	}
+}
@@ -17,11 +17,19 @@ limitations under the License.
 package validation
 
 import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
 )
 
+// gceRangeNameRegex matches the names GCE accepts for subnet secondary ranges (RFC1035, up to 63 characters)
+var gceRangeNameRegex = regexp.MustCompile(`^[a-z]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
 func gceValidateCluster(c *kops.Cluster) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -32,11 +40,33 @@ func gceValidateCluster(c *kops.Cluster) field.ErrorList {
 		if subnet.Zone != "" {
 			allErrs = append(allErrs, field.Invalid(f.Child("zone"), subnet.Zone, "zones should not be specified for GCE subnets, as GCE subnets are regional"))
 		}
+		allErrs = append(allErrs, gceValidateSecondaryRangeName(c, &subnet, f.Child("podRangeName"), subnet.PodRangeName)...)
+		allErrs = append(allErrs, gceValidateSecondaryRangeName(c, &subnet, f.Child("serviceRangeName"), subnet.ServiceRangeName)...)
 	}
 
 	return allErrs
 }
 
+// gceValidateSecondaryRangeName validates a reference to an existing secondary range of a subnet.
+// kOps only creates secondary ranges for the subnets it manages, so they can only be referenced on existing subnets.
+func gceValidateSecondaryRangeName(c *kops.Cluster, subnet *kops.ClusterSubnetSpec, fldPath *field.Path, name string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if name == "" {
+		return allErrs
+	}
+	if !gce.UsesIPAliases(c) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "secondary ranges are only used with IP alias networking"))
+	}
+	if subnet.ID == "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "secondary ranges can only be referenced on an existing subnet (id must be set)"))
+	}
+	if !gceRangeNameRegex.MatchString(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, "must be a valid GCE resource name"))
+	}
+	return allErrs
+}
+
 func gceValidateInstanceGroup(ig *kops.InstanceGroup, cloud gce.GCECloud) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -50,3 +80,82 @@ func gceValidateInstanceGroup(ig *kops.InstanceGroup, cloud gce.GCECloud) field.
 	}
 	return allErrs
 }
+
+func gceValidateSpotPolicy(ig *kops.InstanceGroup, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := ig.Spec.SpotPolicy
+
+	if ig.Spec.GCPProvisioningModel != nil && *ig.Spec.GCPProvisioningModel != "SPOT" {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "gcpProvisioningModel"), "gcpProvisioningModel must be SPOT when spotPolicy is set"))
+	}
+
+	seen := sets.New(ig.Spec.MachineType)
+	for i, machineType := range spec.FallbackMachineTypes {
+		fld := fldPath.Child("fallbackMachineTypes").Index(i)
+		if machineType == "" {
+			allErrs = append(allErrs, field.Required(fld, "machine type must not be empty"))
+			continue
+		}
+		if seen.Has(machineType) {
+			allErrs = append(allErrs, field.Duplicate(fld, machineType))
+		}
+		seen.Insert(machineType)
+	}
+	if spec.TerminationAction != nil {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("terminationAction"), spec.TerminationAction, []string{"DELETE", "STOP"})...)
+	}
+	if spec.EvictionPolicy != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("evictionPolicy"), "evictionPolicy is only supported on Azure"))
+	}
+
+	return allErrs
+}
+
+func gceValidateManagedInstanceGroupUpdatePolicy(spec *kops.ManagedInstanceGroupUpdatePolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.Type != nil {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("type"), spec.Type, []string{"OPPORTUNISTIC", "PROACTIVE"})...)
+	}
+	if spec.MinimalAction != nil {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("minimalAction"), spec.MinimalAction, []string{"REPLACE", "RESTART", "REFRESH", "NONE"})...)
+	}
+	if spec.ReplacementMethod != nil {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("replacementMethod"), spec.ReplacementMethod, []string{"SUBSTITUTE", "RECREATE"})...)
+	}
+
+	surge, surgeErrs := gceValidateFixedOrPercent(spec.MaxSurge, fldPath.Child("maxSurge"))
+	allErrs = append(allErrs, surgeErrs...)
+	unavailable, unavailableErrs := gceValidateFixedOrPercent(spec.MaxUnavailable, fldPath.Child("maxUnavailable"))
+	allErrs = append(allErrs, unavailableErrs...)
+
+	if fi.ValueOf(spec.ReplacementMethod) == "RECREATE" && surge > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxSurge"), "must be 0 when replacementMethod is RECREATE"))
+	}
+	if spec.MaxSurge != nil && spec.MaxUnavailable != nil && surge == 0 && unavailable == 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxUnavailable"), "Cannot be zero if maxSurge is zero"))
+	}
+
+	return allErrs
+}
+
+// gceValidateFixedOrPercent validates an absolute number or a percentage of instances, returning its value.
+func gceValidateFixedOrPercent(v *intstr.IntOrString, fldPath *field.Path) (int, field.ErrorList) {
+	allErrs := field.ErrorList{}
+	if v == nil {
+		return 0, allErrs
+	}
+
+	value, err := intstr.GetScaledValueFromIntOrPercent(v, 100, true)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, v, "Unable to parse"))
+		return 0, allErrs
+	}
+	if value < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, v, "Cannot be negative"))
+	}
+	if v.Type == intstr.String && value > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath, v, "Cannot be more than 100%"))
+	}
+	return value, allErrs
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func TestGCEValidateSecondaryRangeNames(t *testing.T) {
+	grid := []struct {
+		subnet         kops.ClusterSubnetSpec
+		ipAlias        bool
+		ExpectedErrors []string
+	}{
+		{
+			subnet:  kops.ClusterSubnetSpec{Name: "us-test1", ID: "shared", PodRangeName: "pods", ServiceRangeName: "services"},
+			ipAlias: true,
+		},
+		{
+			subnet:         kops.ClusterSubnetSpec{Name: "us-test1", PodRangeName: "pods"},
+			ipAlias:        true,
+			ExpectedErrors: []string{"Forbidden::spec.networking.subnets[0].podRangeName"},
+		},
+		{
+			subnet:         kops.ClusterSubnetSpec{Name: "us-test1", ID: "shared", ServiceRangeName: "services"},
+			ExpectedErrors: []string{"Forbidden::spec.networking.subnets[0].serviceRangeName"},
+		},
+		{
+			subnet:         kops.ClusterSubnetSpec{Name: "us-test1", ID: "shared", PodRangeName: "Pods_Range"},
+			ipAlias:        true,
+			ExpectedErrors: []string{"Invalid value::spec.networking.subnets[0].podRangeName"},
+		},
+	}
+
+	for _, g := range grid {
+		cluster := &kops.Cluster{}
+		cluster.Spec.CloudProvider.GCE = &kops.GCESpec{}
+		cluster.Spec.Networking.Subnets = []kops.ClusterSubnetSpec{g.subnet}
+		if g.ipAlias {
+			cluster.Spec.Networking.GCP = &kops.GCPNetworkingSpec{}
+		} else {
+			cluster.Spec.Networking.Kubenet = &kops.KubenetNetworkingSpec{}
+		}
+
+		errs := gceValidateCluster(cluster)
+		testErrors(t, g.subnet, errs, g.ExpectedErrors)
+	}
+}
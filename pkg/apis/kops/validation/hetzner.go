@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func hetznerValidateCluster(c *kops.Cluster) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	subnetsPath := field.NewPath("spec", "networking", "subnets")
+	private := false
+	for i, subnet := range c.Spec.Networking.Subnets {
+		switch subnet.Type {
+		case kops.SubnetTypePrivate:
+			private = true
+		case kops.SubnetTypeUtility:
+			allErrs = append(allErrs, field.Forbidden(subnetsPath.Index(i).Child("type"), "utility subnets are not supported on Hetzner Cloud"))
+		}
+	}
+
+	if private {
+		// Servers in private subnets have no public IPs, so the API is only reachable through the load balancer
+		if c.Spec.API.LoadBalancer == nil {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "api", "loadBalancer"), "a load balancer is required for the API with private subnets"))
+		}
+		// The default route through the NAT gateway is added to the network managed by kOps
+		if c.Spec.Networking.NetworkID != "" {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "networking", "networkID"), "private subnets are not supported with an existing network"))
+		}
+	}
+
+	return allErrs
+}
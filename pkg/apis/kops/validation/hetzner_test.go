@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func newHetznerClusterForClusterValidation(subnetType kops.SubnetType) *kops.Cluster {
+	return &kops.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "hetzner.example.com"},
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				Hetzner: &kops.HetznerSpec{},
+			},
+			Networking: kops.NetworkingSpec{
+				Subnets: []kops.ClusterSubnetSpec{
+					{Name: "fsn1", Zone: "fsn1", Type: subnetType},
+				},
+			},
+		},
+	}
+}
+
+func TestHetznerValidateCluster(t *testing.T) {
+	tests := []struct {
+		name     string
+		cluster  func(c *kops.Cluster)
+		private  bool
+		expected []*field.Error
+	}{
+		{
+			name: "accepts public subnets",
+		},
+		{
+			name:    "accepts private subnets with load balancer",
+			private: true,
+			cluster: func(c *kops.Cluster) {
+				c.Spec.API.LoadBalancer = &kops.LoadBalancerAccessSpec{Type: kops.LoadBalancerTypePublic}
+			},
+		},
+		{
+			name:    "rejects private subnets without load balancer",
+			private: true,
+			expected: []*field.Error{
+				{
+					Type:   field.ErrorTypeRequired,
+					Field:  "spec.api.loadBalancer",
+					Detail: "a load balancer is required for the API with private subnets",
+				},
+			},
+		},
+		{
+			name:    "rejects private subnets with existing network",
+			private: true,
+			cluster: func(c *kops.Cluster) {
+				c.Spec.API.LoadBalancer = &kops.LoadBalancerAccessSpec{Type: kops.LoadBalancerTypePublic}
+				c.Spec.Networking.NetworkID = "1234"
+			},
+			expected: []*field.Error{
+				{
+					Type:   field.ErrorTypeForbidden,
+					Field:  "spec.networking.networkID",
+					Detail: "private subnets are not supported with an existing network",
+				},
+			},
+		},
+		{
+			name: "rejects utility subnets",
+			cluster: func(c *kops.Cluster) {
+				c.Spec.Networking.Subnets = append(c.Spec.Networking.Subnets, kops.ClusterSubnetSpec{Name: "utility-fsn1", Zone: "fsn1", Type: kops.SubnetTypeUtility})
+			},
+			expected: []*field.Error{
+				{
+					Type:   field.ErrorTypeForbidden,
+					Field:  "spec.networking.subnets[1].type",
+					Detail: "utility subnets are not supported on Hetzner Cloud",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subnetType := kops.SubnetTypePublic
+			if tt.private {
+				subnetType = kops.SubnetTypePrivate
+			}
+			cluster := newHetznerClusterForClusterValidation(subnetType)
+			if tt.cluster != nil {
+				tt.cluster(cluster)
+			}
+			errList := hetznerValidateCluster(cluster)
+			testFieldErrors(t, errList, tt.expected)
+		})
+	}
+}
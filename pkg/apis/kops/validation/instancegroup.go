@@ -18,7 +18,10 @@ package validation
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"k8s.io/kops/pkg/nodeidentity/aws"
 
@@ -30,6 +33,7 @@ import (
 
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/apis/kops/util"
+	"k8s.io/kops/pkg/imagepatching"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
 	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
@@ -105,6 +109,10 @@ func ValidateInstanceGroup(g *kops.InstanceGroup, cloud fi.Cloud, strict bool) f
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "containerd", "gvisor"), "gVisor can only be enabled on instance groups with role Node"))
 	}
 
+	if g.Spec.Kubelet != nil && g.Spec.Kubelet.ServerTLSBootstrap != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "kubelet", "serverTLSBootstrap"), "serverTLSBootstrap can only be set in the cluster spec"))
+	}
+
 	if strict && g.Spec.Image == "" {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "image"), "image must be specified."))
 	}
@@ -164,6 +172,10 @@ func ValidateInstanceGroup(g *kops.InstanceGroup, cloud fi.Cloud, strict bool) f
 
 	allErrs = append(allErrs, validateInstanceProfile(g.Spec.IAM, field.NewPath("spec", "iam"))...)
 
+	for i := range g.Spec.FirewallRules {
+		allErrs = append(allErrs, validateFirewallRule(&g.Spec.FirewallRules[i], field.NewPath("spec", "firewallRules").Index(i))...)
+	}
+
 	for i, sysctlParameter := range g.Spec.SysctlParameters {
 		if !strings.ContainsRune(sysctlParameter, '=') {
 			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "sysctlParameters").Index(i), sysctlParameter, "must contain a \"=\" character"))
@@ -310,6 +322,96 @@ func CrossValidateInstanceGroup(g *kops.InstanceGroup, cluster *kops.Cluster, cl
 		if warmPool.MinSize < 0 {
 			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "warmPool", "minSize"), warmPool.MinSize, "warm pool minSize cannot be negative"))
 		}
+		if warmPool.PoolState != "" {
+			allErrs = append(allErrs, IsValidValue(field.NewPath("spec", "warmPool", "poolState"), &warmPool.PoolState, kops.SupportedWarmPoolStates)...)
+		}
+		if warmPool.IsEnabled() && warmPool.PoolState == kops.WarmPoolStateHibernated {
+			if g.Spec.RootVolume != nil && g.Spec.RootVolume.Encryption != nil && !*g.Spec.RootVolume.Encryption {
+				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "rootVolume", "encryption"), "hibernated warm pools require an encrypted root volume"))
+			}
+		}
+		if g.Spec.OnDemandMaxPrice != nil {
+			allErrs = append(allErrs, awsValidateOnDemandMaxPrice(field.NewPath("spec", "onDemandMaxPrice"), *g.Spec.OnDemandMaxPrice)...)
+		}
+	} else if g.Spec.OnDemandMaxPrice != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "onDemandMaxPrice"), "onDemandMaxPrice is only supported on AWS"))
+	}
+
+	if cluster.GetCloudProvider() == kops.CloudProviderAzure {
+		allErrs = append(allErrs, azureValidateInstanceGroupZones(g, cluster, field.NewPath("spec", "zones"))...)
+	}
+
+	if len(g.Spec.AzureUserAssignedIdentities) != 0 {
+		fldPath := field.NewPath("spec", "azureUserAssignedIdentities")
+		if cluster.GetCloudProvider() == kops.CloudProviderAzure {
+			allErrs = append(allErrs, azureValidateUserAssignedIdentities(g.Spec.AzureUserAssignedIdentities, fldPath)...)
+		} else {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "azureUserAssignedIdentities is only supported on Azure"))
+		}
+	}
+
+	if g.Spec.AzureOrchestrationMode != nil {
+		fldPath := field.NewPath("spec", "azureOrchestrationMode")
+		if cluster.GetCloudProvider() == kops.CloudProviderAzure {
+			allErrs = append(allErrs, IsValidValue(fldPath, g.Spec.AzureOrchestrationMode, []string{"Uniform", "Flexible"})...)
+		} else {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "azureOrchestrationMode is only supported on Azure"))
+		}
+	}
+
+	if g.Spec.AzureEphemeralOSDiskPlacement != nil {
+		fldPath := field.NewPath("spec", "azureEphemeralOSDiskPlacement")
+		if cluster.GetCloudProvider() == kops.CloudProviderAzure {
+			allErrs = append(allErrs, azureValidateEphemeralOSDisk(g, fldPath)...)
+		} else {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "azureEphemeralOSDiskPlacement is only supported on Azure"))
+		}
+	}
+
+	if g.Spec.SpotPolicy != nil {
+		fldPath := field.NewPath("spec", "spotPolicy")
+		switch cluster.GetCloudProvider() {
+		case kops.CloudProviderGCE:
+			allErrs = append(allErrs, gceValidateSpotPolicy(g, fldPath)...)
+		case kops.CloudProviderAzure:
+			allErrs = append(allErrs, azureValidateSpotPolicy(g, fldPath)...)
+		default:
+			allErrs = append(allErrs, field.Forbidden(fldPath, "spotPolicy is only supported on GCE and Azure"))
+		}
+	}
+
+	if g.Spec.ManagedInstanceGroupUpdatePolicy != nil {
+		fldPath := field.NewPath("spec", "managedInstanceGroupUpdatePolicy")
+		if cluster.GetCloudProvider() == kops.CloudProviderGCE {
+			allErrs = append(allErrs, gceValidateManagedInstanceGroupUpdatePolicy(g.Spec.ManagedInstanceGroupUpdatePolicy, fldPath)...)
+		} else {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "managedInstanceGroupUpdatePolicy is only supported on GCE"))
+		}
+	}
+
+	if g.Spec.ImagePatching != nil {
+		allErrs = append(allErrs, validateImagePatching(g, cluster.GetCloudProvider(), field.NewPath("spec", "imagePatching"))...)
+	}
+
+	if g.Spec.ClusterAutoscaler != nil {
+		fldPath := field.NewPath("spec", "clusterAutoscaler")
+		if cluster.GetCloudProvider() == kops.CloudProviderAWS {
+			allErrs = append(allErrs, validateInstanceGroupClusterAutoscaler(g.Spec.ClusterAutoscaler, fldPath)...)
+		} else {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "clusterAutoscaler is only supported on AWS"))
+		}
+	}
+
+	if len(g.Spec.FirewallRules) != 0 {
+		switch cluster.GetCloudProvider() {
+		case kops.CloudProviderAWS, kops.CloudProviderGCE, kops.CloudProviderAzure, kops.CloudProviderOpenstack:
+		default:
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "firewallRules"), "firewallRules are only supported on AWS, GCE, Azure and OpenStack"))
+		}
+	}
+
+	if cluster.Spec.TagPolicy != nil {
+		allErrs = append(allErrs, validateIGPolicyTags(g, cluster, field.NewPath("spec", "cloudLabels"))...)
 	}
 
 	allErrs = append(allErrs, validateKarpenterInstanceGroup(g, cluster)...)
@@ -318,12 +420,50 @@ func CrossValidateInstanceGroup(g *kops.InstanceGroup, cluster *kops.Cluster, cl
 		allErrs = append(allErrs, validateContainerdConfig(cluster, g.Spec.Containerd, field.NewPath("spec", "containerd"), false)...)
 	}
 
+	if cluster.UsesCRIO() {
+		if g.HasGVisor() {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "containerd", "gvisor"), "gVisor is not supported with CRI-O"))
+		}
+		if g.Spec.Containerd != nil && g.Spec.Containerd.NvidiaGPU != nil && fi.ValueOf(g.Spec.Containerd.NvidiaGPU.Enabled) {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "containerd", "nvidiaGPU"), "Nvidia GPU support is not available with CRI-O"))
+		}
+		for i, hook := range g.Spec.Hooks {
+			if hook.ExecContainer != nil {
+				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "hooks").Index(i).Child("execContainer"), "execContainer hooks are not supported with CRI-O"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateInstanceGroupClusterAutoscaler(spec *kops.InstanceGroupClusterAutoscalerSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.ScaleDownUtilizationThreshold != nil {
+		threshold, err := strconv.ParseFloat(*spec.ScaleDownUtilizationThreshold, 64)
+		if err != nil || threshold < 0 || threshold > 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownUtilizationThreshold"), *spec.ScaleDownUtilizationThreshold, "must be a number between 0 and 1"))
+		}
+	}
+	if spec.ScaleDownUnneededTime != nil {
+		if _, err := time.ParseDuration(*spec.ScaleDownUnneededTime); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownUnneededTime"), *spec.ScaleDownUnneededTime, "must be a duration"))
+		}
+	}
+	if spec.ScaleDownUnreadyTime != nil {
+		if _, err := time.ParseDuration(*spec.ScaleDownUnreadyTime); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownUnreadyTime"), *spec.ScaleDownUnreadyTime, "must be a duration"))
+		}
+	}
 	return allErrs
 }
 
 func validateKarpenterInstanceGroup(g *kops.InstanceGroup, cluster *kops.Cluster) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if g.Spec.Manager != kops.InstanceManagerKarpenter {
+		if g.Spec.Karpenter != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "karpenter"), "karpenter can only be set for InstanceGroups managed by Karpenter"))
+		}
 		return allErrs
 	}
 
@@ -338,6 +478,64 @@ func validateKarpenterInstanceGroup(g *kops.InstanceGroup, cluster *kops.Cluster
 	}
 	allErrs = append(allErrs, validateKarpenterAMISelectorImage(g.Spec.Image, field.NewPath("spec", "image"))...)
 	allErrs = append(allErrs, validateKarpenterStaticCapacity(g, cluster)...)
+	if g.Spec.Karpenter != nil {
+		allErrs = append(allErrs, validateKarpenterInstanceGroupSpec(g, field.NewPath("spec", "karpenter"))...)
+	}
+	return allErrs
+}
+
+func validateKarpenterInstanceGroupSpec(g *kops.InstanceGroup, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := g.Spec.Karpenter
+
+	allErrs = append(allErrs, validateKarpenterRequirementValues(spec.CapacityTypes, []string{"on-demand", "spot"}, fldPath.Child("capacityTypes"))...)
+	allErrs = append(allErrs, validateKarpenterRequirementValues(spec.Architectures, []string{"amd64", "arm64"}, fldPath.Child("architectures"))...)
+	allErrs = append(allErrs, validateKarpenterRequirementValues(spec.InstanceFamilies, nil, fldPath.Child("instanceFamilies"))...)
+
+	if len(spec.CapacityTypes) != 0 && !slices.Contains(spec.CapacityTypes, "spot") {
+		if g.Spec.MaxPrice != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "maxPrice"), "maxPrice requires spot in karpenter.capacityTypes"))
+		}
+		if g.Spec.SpotDurationInMinutes != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "spotDurationInMinutes"), "spotDurationInMinutes requires spot in karpenter.capacityTypes"))
+		}
+	}
+
+	// Instance types that are not in the instance families would make the requirements of the NodePool unsatisfiable
+	if len(spec.InstanceFamilies) != 0 {
+		instanceTypes := strings.Split(g.Spec.MachineType, ",")
+		if g.Spec.MixedInstancesPolicy != nil {
+			instanceTypes = append(instanceTypes, g.Spec.MixedInstancesPolicy.Instances...)
+		}
+		for _, instanceType := range instanceTypes {
+			instanceType = strings.TrimSpace(instanceType)
+			if instanceType == "" {
+				continue
+			}
+			family, _, _ := strings.Cut(instanceType, ".")
+			if !slices.Contains(spec.InstanceFamilies, family) {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "machineType"), instanceType, "instance type is not in karpenter.instanceFamilies"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateKarpenterRequirementValues(values []string, supported []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := sets.New[string]()
+	for i, value := range values {
+		if supported != nil && !slices.Contains(supported, value) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i), value, supported))
+		} else if value == "" || strings.ContainsAny(value, ". ") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), value, "must be a non-empty value without dots or spaces"))
+		}
+		if seen.Has(value) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), value))
+		}
+		seen.Insert(value)
+	}
 	return allErrs
 }
 
@@ -401,6 +599,9 @@ func validateKarpenterAMISelectorImage(image string, fldPath *field.Path) field.
 func ValidateControlPlaneInstanceGroup(g *kops.InstanceGroup, cluster *kops.Cluster) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for _, etcd := range cluster.Spec.EtcdClusters {
+		if etcd.IsExternal() {
+			continue
+		}
 		hasEtcd := false
 		last := ""
 		for _, m := range etcd.Members {
@@ -461,6 +662,41 @@ func validateInstanceProfile(v *kops.IAMProfileSpec, fldPath *field.Path) field.
 	return allErrs
 }
 
+// validateFirewallRule checks an additional firewall rule of an instance group
+func validateFirewallRule(rule *kops.FirewallRuleSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rule.Direction != "" {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("direction"), &rule.Direction, []kops.FirewallRuleDirection{kops.FirewallRuleDirectionIngress, kops.FirewallRuleDirectionEgress})...)
+	}
+
+	if rule.Protocol == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("protocol"), ""))
+	} else {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("protocol"), &rule.Protocol, []string{"tcp", "udp"})...)
+	}
+
+	if rule.FromPort < 1 || rule.FromPort > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("fromPort"), rule.FromPort, "must be between 1 and 65535"))
+	}
+	if rule.ToPort != 0 {
+		if rule.ToPort > 65535 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("toPort"), rule.ToPort, "must be between 1 and 65535"))
+		} else if rule.ToPort < rule.FromPort {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("toPort"), rule.ToPort, "must not be lower than fromPort"))
+		}
+	}
+
+	if len(rule.CIDRs) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("cidrs"), "at least one CIDR must be specified"))
+	}
+	for i, cidr := range rule.CIDRs {
+		allErrs = append(allErrs, validateCIDR(fldPath.Child("cidrs").Index(i), cidr)...)
+	}
+
+	return allErrs
+}
+
 func validateNodeLabels(labels map[string]string, fldPath *field.Path) (allErrs field.ErrorList) {
 	for key := range labels {
 		if strings.Count(key, "/") > 1 {
@@ -493,6 +729,24 @@ func validateIGCloudLabels(ig *kops.InstanceGroup, fldPath *field.Path) (allErrs
 	return allErrs
 }
 
+// validateIGPolicyTags checks that the cloud labels of the instance group that override the cluster's comply with its tag policy
+func validateIGPolicyTags(ig *kops.InstanceGroup, cluster *kops.Cluster, fldPath *field.Path) (allErrs field.ErrorList) {
+	policyTags, err := cluster.RenderPolicyTags()
+	if err != nil {
+		// Reported by the validation of the cluster
+		return allErrs
+	}
+	for i := range cluster.Spec.TagPolicy.RequiredTags {
+		tag := &cluster.Spec.TagPolicy.RequiredTags[i]
+		if value, found := ig.Spec.CloudLabels[tag.Key]; found {
+			if err := tag.CheckValue(value, policyTags); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Key(tag.Key), value, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
+
 func validateExternalLoadBalancer(lb *kops.LoadBalancerSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -540,3 +794,26 @@ func validateExternalLoadBalancer(lb *kops.LoadBalancerSpec, fldPath *field.Path
 
 	return allErrs
 }
+
+func validateImagePatching(g *kops.InstanceGroup, cloudProvider kops.CloudProviderID, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch cloudProvider {
+	case kops.CloudProviderAWS, kops.CloudProviderGCE, kops.CloudProviderAzure:
+	default:
+		return append(allErrs, field.Forbidden(fldPath, "imagePatching is only supported on AWS, GCE and Azure"))
+	}
+	if g.IsKarpenterManaged() {
+		return append(allErrs, field.Forbidden(fldPath, "imagePatching is not supported for instance groups managed by Karpenter"))
+	}
+
+	if interval := g.Spec.ImagePatching.Interval; interval != nil && interval.Duration < time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), interval.Duration.String(), "must be at least 1m"))
+	}
+	if g.Spec.Image != "" && !imagepatching.IsDynamicImage(cloudProvider, g.Spec.Image) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "image"), g.Spec.Image,
+			"imagePatching requires an image that references an SSM parameter (AWS), an image family (GCE) or the latest version of a gallery image (Azure)"))
+	}
+
+	return allErrs
+}
@@ -17,11 +17,14 @@ limitations under the License.
 package validation
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"k8s.io/kops/pkg/nodeidentity/aws"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/kops/pkg/apis/kops"
 )
@@ -362,6 +365,782 @@ func TestCrossValidateKarpenterInstanceGroup(t *testing.T) {
 	}
 }
 
+func TestCrossValidateOnDemandMaxPrice(t *testing.T) {
+	awsCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				AWS: &kops.AWSSpec{},
+			},
+		},
+	}
+	gceCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				GCE: &kops.GCESpec{},
+			},
+		},
+	}
+
+	grid := []struct {
+		desc     string
+		cluster  *kops.Cluster
+		price    string
+		expected []string
+	}{
+		{
+			desc:    "valid price",
+			cluster: awsCluster,
+			price:   "0.25",
+		},
+		{
+			desc:     "not a number",
+			cluster:  awsCluster,
+			price:    "cheap",
+			expected: []string{"Invalid value::spec.onDemandMaxPrice"},
+		},
+		{
+			desc:     "zero",
+			cluster:  awsCluster,
+			price:    "0",
+			expected: []string{"Invalid value::spec.onDemandMaxPrice"},
+		},
+		{
+			desc:     "not aws",
+			cluster:  gceCluster,
+			price:    "0.25",
+			expected: []string{"Forbidden::spec.onDemandMaxPrice"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			ig := createMinimalInstanceGroup()
+			ig.Spec.OnDemandMaxPrice = &g.price
+
+			errs := CrossValidateInstanceGroup(ig, g.cluster, nil, true)
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestCrossValidateWarmPoolState(t *testing.T) {
+	grid := []struct {
+		desc       string
+		clusterWP  *kops.WarmPoolSpec
+		warmPool   *kops.WarmPoolSpec
+		encryption *bool
+		expected   []string
+	}{
+		{
+			desc:     "stopped",
+			warmPool: &kops.WarmPoolSpec{PoolState: kops.WarmPoolStateStopped},
+		},
+		{
+			desc:     "running with reuse on scale in",
+			warmPool: &kops.WarmPoolSpec{PoolState: kops.WarmPoolStateRunning, ReuseOnScaleIn: true},
+		},
+		{
+			desc:     "hibernated",
+			warmPool: &kops.WarmPoolSpec{PoolState: kops.WarmPoolStateHibernated},
+		},
+		{
+			desc:      "hibernated from cluster defaults",
+			clusterWP: &kops.WarmPoolSpec{PoolState: kops.WarmPoolStateHibernated},
+			warmPool:  &kops.WarmPoolSpec{MinSize: 1},
+		},
+		{
+			desc:     "unknown state",
+			warmPool: &kops.WarmPoolSpec{PoolState: "Frozen"},
+			expected: []string{"Unsupported value::spec.warmPool.poolState"},
+		},
+		{
+			desc:       "hibernated without encryption",
+			warmPool:   &kops.WarmPoolSpec{PoolState: kops.WarmPoolStateHibernated},
+			encryption: new(false),
+			expected:   []string{"Forbidden::spec.rootVolume.encryption"},
+		},
+		{
+			desc:       "hibernated from cluster defaults without encryption",
+			clusterWP:  &kops.WarmPoolSpec{PoolState: kops.WarmPoolStateHibernated},
+			warmPool:   &kops.WarmPoolSpec{MinSize: 1},
+			encryption: new(false),
+			expected:   []string{"Forbidden::spec.rootVolume.encryption"},
+		},
+		{
+			desc:       "disabled hibernated pool without encryption",
+			warmPool:   &kops.WarmPoolSpec{MaxSize: new(int64(0)), PoolState: kops.WarmPoolStateHibernated},
+			encryption: new(false),
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			cluster := &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider: kops.CloudProviderSpec{
+						AWS: &kops.AWSSpec{
+							WarmPool: g.clusterWP,
+						},
+					},
+				},
+			}
+			ig := createMinimalInstanceGroup()
+			ig.Spec.WarmPool = g.warmPool
+			if g.encryption != nil {
+				ig.Spec.RootVolume = &kops.InstanceRootVolumeSpec{Encryption: g.encryption}
+			}
+
+			errs := CrossValidateInstanceGroup(ig, cluster, nil, true)
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestCrossValidateAzureUserAssignedIdentities(t *testing.T) {
+	azureCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				Azure: &kops.AzureSpec{},
+			},
+		},
+	}
+	awsCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				AWS: &kops.AWSSpec{},
+			},
+		},
+	}
+	identity := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/identities/providers/Microsoft.ManagedIdentity/userAssignedIdentities/workloads"
+
+	grid := []struct {
+		desc       string
+		cluster    *kops.Cluster
+		identities []string
+		expected   []string
+	}{
+		{
+			desc:       "valid identity",
+			cluster:    azureCluster,
+			identities: []string{identity},
+		},
+		{
+			desc:       "not a resource ID",
+			cluster:    azureCluster,
+			identities: []string{"workloads"},
+			expected:   []string{"Invalid value::spec.azureUserAssignedIdentities[0]"},
+		},
+		{
+			desc:       "not an identity",
+			cluster:    azureCluster,
+			identities: []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/identities/providers/Microsoft.Compute/virtualMachines/vm"},
+			expected:   []string{"Invalid value::spec.azureUserAssignedIdentities[0]"},
+		},
+		{
+			desc:       "duplicate",
+			cluster:    azureCluster,
+			identities: []string{identity, strings.ToUpper(identity)},
+			expected:   []string{"Duplicate value::spec.azureUserAssignedIdentities[1]"},
+		},
+		{
+			desc:       "not azure",
+			cluster:    awsCluster,
+			identities: []string{identity},
+			expected:   []string{"Forbidden::spec.azureUserAssignedIdentities"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			ig := createMinimalInstanceGroup()
+			ig.Spec.AzureUserAssignedIdentities = g.identities
+
+			errs := CrossValidateInstanceGroup(ig, g.cluster, nil, true)
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestCrossValidateAzureOrchestrationMode(t *testing.T) {
+	azureCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				Azure: &kops.AzureSpec{},
+			},
+		},
+	}
+	awsCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				AWS: &kops.AWSSpec{},
+			},
+		},
+	}
+
+	grid := []struct {
+		desc     string
+		cluster  *kops.Cluster
+		mode     string
+		expected []string
+	}{
+		{
+			desc:    "flexible",
+			cluster: azureCluster,
+			mode:    "Flexible",
+		},
+		{
+			desc:    "uniform",
+			cluster: azureCluster,
+			mode:    "Uniform",
+		},
+		{
+			desc:     "invalid",
+			cluster:  azureCluster,
+			mode:     "flexible",
+			expected: []string{"Unsupported value::spec.azureOrchestrationMode"},
+		},
+		{
+			desc:     "not azure",
+			cluster:  awsCluster,
+			mode:     "Flexible",
+			expected: []string{"Forbidden::spec.azureOrchestrationMode"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			ig := createMinimalInstanceGroup()
+			ig.Spec.AzureOrchestrationMode = &g.mode
+
+			errs := CrossValidateInstanceGroup(ig, g.cluster, nil, true)
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestCrossValidateAzureEphemeralOSDiskPlacement(t *testing.T) {
+	azureCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				Azure: &kops.AzureSpec{},
+			},
+		},
+	}
+	awsCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				AWS: &kops.AWSSpec{},
+			},
+		},
+	}
+
+	grid := []struct {
+		desc           string
+		cluster        *kops.Cluster
+		placement      string
+		evictionPolicy *string
+		volumeType     *string
+		expected       []string
+	}{
+		{
+			desc:      "resource disk",
+			cluster:   azureCluster,
+			placement: "ResourceDisk",
+		},
+		{
+			desc:           "cache disk with spot delete",
+			cluster:        azureCluster,
+			placement:      "CacheDisk",
+			evictionPolicy: new("Delete"),
+		},
+		{
+			desc:      "invalid",
+			cluster:   azureCluster,
+			placement: "NvmeDisk",
+			expected:  []string{"Unsupported value::spec.azureEphemeralOSDiskPlacement"},
+		},
+		{
+			desc:           "spot deallocate",
+			cluster:        azureCluster,
+			placement:      "ResourceDisk",
+			evictionPolicy: new("Deallocate"),
+			expected:       []string{"Forbidden::spec.azureEphemeralOSDiskPlacement"},
+		},
+		{
+			desc:       "root volume type",
+			cluster:    azureCluster,
+			placement:  "ResourceDisk",
+			volumeType: new("Premium_LRS"),
+			expected:   []string{"Forbidden::spec.rootVolume.type"},
+		},
+		{
+			desc:      "not azure",
+			cluster:   awsCluster,
+			placement: "ResourceDisk",
+			expected:  []string{"Forbidden::spec.azureEphemeralOSDiskPlacement"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			ig := createMinimalInstanceGroup()
+			ig.Spec.AzureEphemeralOSDiskPlacement = &g.placement
+			if g.evictionPolicy != nil {
+				ig.Spec.SpotPolicy = &kops.SpotPolicySpec{EvictionPolicy: g.evictionPolicy}
+			}
+			if g.volumeType != nil {
+				ig.Spec.RootVolume = &kops.InstanceRootVolumeSpec{Type: g.volumeType}
+			}
+
+			errs := CrossValidateInstanceGroup(ig, g.cluster, nil, true)
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestCrossValidateFirewallRules(t *testing.T) {
+	awsCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				AWS: &kops.AWSSpec{},
+			},
+		},
+	}
+	hetznerCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				Hetzner: &kops.HetznerSpec{},
+			},
+		},
+	}
+
+	grid := []struct {
+		desc     string
+		cluster  *kops.Cluster
+		rule     kops.FirewallRuleSpec
+		expected []string
+	}{
+		{
+			desc:    "ingress port",
+			cluster: awsCluster,
+			rule:    kops.FirewallRuleSpec{Protocol: "tcp", FromPort: 443, CIDRs: []string{"10.0.0.0/8", "2001:db8::/32"}},
+		},
+		{
+			desc:    "egress port range",
+			cluster: awsCluster,
+			rule:    kops.FirewallRuleSpec{Direction: kops.FirewallRuleDirectionEgress, Protocol: "udp", FromPort: 1000, ToPort: 2000, CIDRs: []string{"0.0.0.0/0"}},
+		},
+		{
+			desc:     "invalid direction",
+			cluster:  awsCluster,
+			rule:     kops.FirewallRuleSpec{Direction: "Inbound", Protocol: "tcp", FromPort: 443, CIDRs: []string{"10.0.0.0/8"}},
+			expected: []string{"Unsupported value::spec.firewallRules[0].direction"},
+		},
+		{
+			desc:     "missing protocol",
+			cluster:  awsCluster,
+			rule:     kops.FirewallRuleSpec{FromPort: 443, CIDRs: []string{"10.0.0.0/8"}},
+			expected: []string{"Required value::spec.firewallRules[0].protocol"},
+		},
+		{
+			desc:     "invalid protocol",
+			cluster:  awsCluster,
+			rule:     kops.FirewallRuleSpec{Protocol: "icmp", FromPort: 443, CIDRs: []string{"10.0.0.0/8"}},
+			expected: []string{"Unsupported value::spec.firewallRules[0].protocol"},
+		},
+		{
+			desc:     "missing port",
+			cluster:  awsCluster,
+			rule:     kops.FirewallRuleSpec{Protocol: "tcp", CIDRs: []string{"10.0.0.0/8"}},
+			expected: []string{"Invalid value::spec.firewallRules[0].fromPort"},
+		},
+		{
+			desc:     "port out of range",
+			cluster:  awsCluster,
+			rule:     kops.FirewallRuleSpec{Protocol: "tcp", FromPort: 443, ToPort: 70000, CIDRs: []string{"10.0.0.0/8"}},
+			expected: []string{"Invalid value::spec.firewallRules[0].toPort"},
+		},
+		{
+			desc:     "reversed port range",
+			cluster:  awsCluster,
+			rule:     kops.FirewallRuleSpec{Protocol: "tcp", FromPort: 443, ToPort: 80, CIDRs: []string{"10.0.0.0/8"}},
+			expected: []string{"Invalid value::spec.firewallRules[0].toPort"},
+		},
+		{
+			desc:     "missing CIDRs",
+			cluster:  awsCluster,
+			rule:     kops.FirewallRuleSpec{Protocol: "tcp", FromPort: 443},
+			expected: []string{"Required value::spec.firewallRules[0].cidrs"},
+		},
+		{
+			desc:     "invalid CIDR",
+			cluster:  awsCluster,
+			rule:     kops.FirewallRuleSpec{Protocol: "tcp", FromPort: 443, CIDRs: []string{"10.0.0.1"}},
+			expected: []string{"Invalid value::spec.firewallRules[0].cidrs[0]"},
+		},
+		{
+			desc:     "unsupported cloud",
+			cluster:  hetznerCluster,
+			rule:     kops.FirewallRuleSpec{Protocol: "tcp", FromPort: 443, CIDRs: []string{"10.0.0.0/8"}},
+			expected: []string{"Forbidden::spec.firewallRules"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			ig := createMinimalInstanceGroup()
+			ig.Spec.FirewallRules = []kops.FirewallRuleSpec{g.rule}
+
+			errs := CrossValidateInstanceGroup(ig, g.cluster, nil, true)
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestCrossValidateAzureInstanceGroupZones(t *testing.T) {
+	cluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				Azure: &kops.AzureSpec{},
+			},
+			Networking: kops.NetworkingSpec{
+				Subnets: []kops.ClusterSubnetSpec{
+					{Name: "eastus", Region: "eastus"},
+				},
+			},
+		},
+	}
+
+	grid := []struct {
+		desc     string
+		zones    []string
+		expected []string
+	}{
+		{
+			desc:  "zones",
+			zones: []string{"eastus-1", "eastus-2", "eastus-3"},
+		},
+		{
+			desc:     "zone number out of range",
+			zones:    []string{"eastus-4"},
+			expected: []string{"Invalid value::spec.zones[0]"},
+		},
+		{
+			desc:     "not an availability zone",
+			zones:    []string{"eastus-1", "eastus"},
+			expected: []string{"Invalid value::spec.zones[1]"},
+		},
+		{
+			desc:     "other region",
+			zones:    []string{"westus2-1"},
+			expected: []string{"Invalid value::spec.zones[0]"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			ig := createMinimalInstanceGroup()
+			ig.Spec.Zones = g.zones
+
+			errs := CrossValidateInstanceGroup(ig, cluster, nil, true)
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestCrossValidateSpotPolicy(t *testing.T) {
+	gceCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				GCE: &kops.GCESpec{},
+			},
+		},
+	}
+	azureCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				Azure: &kops.AzureSpec{},
+			},
+		},
+	}
+	awsCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				AWS: &kops.AWSSpec{},
+			},
+		},
+	}
+
+	grid := []struct {
+		desc              string
+		cluster           *kops.Cluster
+		spotPolicy        *kops.SpotPolicySpec
+		provisioningModel *string
+		maxPrice          *string
+		expected          []string
+	}{
+		{
+			desc:    "gce",
+			cluster: gceCluster,
+			spotPolicy: &kops.SpotPolicySpec{
+				FallbackMachineTypes: []string{"n2-standard-2", "e2-standard-2"},
+				TerminationAction:    new("DELETE"),
+			},
+		},
+		{
+			desc:    "gce duplicate machine type",
+			cluster: gceCluster,
+			spotPolicy: &kops.SpotPolicySpec{
+				FallbackMachineTypes: []string{"n2-standard-2", "n1-standard-2"},
+			},
+			expected: []string{"Duplicate value::spec.spotPolicy.fallbackMachineTypes[1]"},
+		},
+		{
+			desc:    "gce invalid termination action",
+			cluster: gceCluster,
+			spotPolicy: &kops.SpotPolicySpec{
+				TerminationAction: new("SUSPEND"),
+			},
+			expected: []string{"Unsupported value::spec.spotPolicy.terminationAction"},
+		},
+		{
+			desc:              "gce standard provisioning model",
+			cluster:           gceCluster,
+			spotPolicy:        &kops.SpotPolicySpec{},
+			provisioningModel: new("STANDARD"),
+			expected:          []string{"Forbidden::spec.gcpProvisioningModel"},
+		},
+		{
+			desc:    "gce eviction policy",
+			cluster: gceCluster,
+			spotPolicy: &kops.SpotPolicySpec{
+				EvictionPolicy: new("Delete"),
+			},
+			expected: []string{"Forbidden::spec.spotPolicy.evictionPolicy"},
+		},
+		{
+			desc:    "azure",
+			cluster: azureCluster,
+			spotPolicy: &kops.SpotPolicySpec{
+				EvictionPolicy: new("Deallocate"),
+			},
+			maxPrice: new("0.05"),
+		},
+		{
+			desc:       "azure on-demand price",
+			cluster:    azureCluster,
+			spotPolicy: &kops.SpotPolicySpec{},
+			maxPrice:   new("-1"),
+		},
+		{
+			desc:       "azure invalid max price",
+			cluster:    azureCluster,
+			spotPolicy: &kops.SpotPolicySpec{},
+			maxPrice:   new("0"),
+			expected:   []string{"Invalid value::spec.maxPrice"},
+		},
+		{
+			desc:    "azure invalid eviction policy",
+			cluster: azureCluster,
+			spotPolicy: &kops.SpotPolicySpec{
+				EvictionPolicy: new("Stop"),
+			},
+			expected: []string{"Unsupported value::spec.spotPolicy.evictionPolicy"},
+		},
+		{
+			desc:    "azure fallback machine types",
+			cluster: azureCluster,
+			spotPolicy: &kops.SpotPolicySpec{
+				FallbackMachineTypes: []string{"Standard_D2s_v5"},
+			},
+			expected: []string{"Forbidden::spec.spotPolicy.fallbackMachineTypes"},
+		},
+		{
+			desc:       "not gce or azure",
+			cluster:    awsCluster,
+			spotPolicy: &kops.SpotPolicySpec{},
+			expected:   []string{"Forbidden::spec.spotPolicy"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			ig := createMinimalInstanceGroup()
+			ig.Spec.MachineType = "n1-standard-2"
+			ig.Spec.SpotPolicy = g.spotPolicy
+			ig.Spec.GCPProvisioningModel = g.provisioningModel
+			ig.Spec.MaxPrice = g.maxPrice
+
+			errs := CrossValidateInstanceGroup(ig, g.cluster, nil, true)
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestCrossValidateManagedInstanceGroupUpdatePolicy(t *testing.T) {
+	gceCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				GCE: &kops.GCESpec{},
+			},
+		},
+	}
+	awsCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				AWS: &kops.AWSSpec{},
+			},
+		},
+	}
+
+	grid := []struct {
+		desc     string
+		cluster  *kops.Cluster
+		policy   *kops.ManagedInstanceGroupUpdatePolicySpec
+		expected []string
+	}{
+		{
+			desc:    "gce",
+			cluster: gceCluster,
+			policy: &kops.ManagedInstanceGroupUpdatePolicySpec{
+				Type:           new("PROACTIVE"),
+				MinimalAction:  new("RESTART"),
+				MaxSurge:       new(intstr.FromInt32(2)),
+				MaxUnavailable: new(intstr.FromString("10%")),
+			},
+		},
+		{
+			desc:    "gce invalid values",
+			cluster: gceCluster,
+			policy: &kops.ManagedInstanceGroupUpdatePolicySpec{
+				Type:              new("ROLLING"),
+				MinimalAction:     new("REBOOT"),
+				ReplacementMethod: new("REPLACE"),
+			},
+			expected: []string{
+				"Unsupported value::spec.managedInstanceGroupUpdatePolicy.type",
+				"Unsupported value::spec.managedInstanceGroupUpdatePolicy.minimalAction",
+				"Unsupported value::spec.managedInstanceGroupUpdatePolicy.replacementMethod",
+			},
+		},
+		{
+			desc:    "gce invalid surge",
+			cluster: gceCluster,
+			policy: &kops.ManagedInstanceGroupUpdatePolicySpec{
+				MaxSurge:       new(intstr.FromInt32(-1)),
+				MaxUnavailable: new(intstr.FromString("110%")),
+			},
+			expected: []string{
+				"Invalid value::spec.managedInstanceGroupUpdatePolicy.maxSurge",
+				"Invalid value::spec.managedInstanceGroupUpdatePolicy.maxUnavailable",
+			},
+		},
+		{
+			desc:    "gce recreate with surge",
+			cluster: gceCluster,
+			policy: &kops.ManagedInstanceGroupUpdatePolicySpec{
+				ReplacementMethod: new("RECREATE"),
+				MaxSurge:          new(intstr.FromInt32(1)),
+			},
+			expected: []string{"Forbidden::spec.managedInstanceGroupUpdatePolicy.maxSurge"},
+		},
+		{
+			desc:    "gce zero surge and unavailable",
+			cluster: gceCluster,
+			policy: &kops.ManagedInstanceGroupUpdatePolicySpec{
+				MaxSurge:       new(intstr.FromInt32(0)),
+				MaxUnavailable: new(intstr.FromString("0%")),
+			},
+			expected: []string{"Forbidden::spec.managedInstanceGroupUpdatePolicy.maxUnavailable"},
+		},
+		{
+			desc:     "not gce",
+			cluster:  awsCluster,
+			policy:   &kops.ManagedInstanceGroupUpdatePolicySpec{},
+			expected: []string{"Forbidden::spec.managedInstanceGroupUpdatePolicy"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			ig := createMinimalInstanceGroup()
+			ig.Spec.ManagedInstanceGroupUpdatePolicy = g.policy
+
+			errs := CrossValidateInstanceGroup(ig, g.cluster, nil, true)
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestCrossValidateImagePatching(t *testing.T) {
+	awsCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				AWS: &kops.AWSSpec{},
+			},
+		},
+	}
+	hetznerCluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				Hetzner: &kops.HetznerSpec{},
+			},
+		},
+	}
+
+	grid := []struct {
+		desc     string
+		cluster  *kops.Cluster
+		image    string
+		patching *kops.ImagePatchingSpec
+		expected []string
+	}{
+		{
+			desc:    "ssm parameter",
+			cluster: awsCluster,
+			image:   "ssm:/aws/service/canonical/ubuntu/server/24.04/stable/current/amd64/hvm/ebs-gp3/ami-id",
+			patching: &kops.ImagePatchingSpec{
+				Interval:      &v1.Duration{Duration: 12 * time.Hour},
+				RollingUpdate: new(true),
+			},
+		},
+		{
+			desc:     "static image",
+			cluster:  awsCluster,
+			image:    "ami-1234",
+			patching: &kops.ImagePatchingSpec{},
+			expected: []string{"Invalid value::spec.image"},
+		},
+		{
+			desc:    "short interval",
+			cluster: awsCluster,
+			image:   "ssm:/images/nodes",
+			patching: &kops.ImagePatchingSpec{
+				Interval: &v1.Duration{Duration: time.Second},
+			},
+			expected: []string{"Invalid value::spec.imagePatching.interval"},
+		},
+		{
+			desc:     "unsupported cloud",
+			cluster:  hetznerCluster,
+			image:    "ubuntu-24.04",
+			patching: &kops.ImagePatchingSpec{},
+			expected: []string{"Forbidden::spec.imagePatching"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			ig := createMinimalInstanceGroup()
+			ig.Spec.Image = g.image
+			ig.Spec.ImagePatching = g.patching
+
+			errs := CrossValidateInstanceGroup(ig, g.cluster, nil, true)
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
 func TestValidateKarpenterStaticCapacity(t *testing.T) {
 	grid := []struct {
 		desc         string
@@ -475,6 +1254,187 @@ func TestValidateKarpenterStaticCapacity(t *testing.T) {
 	}
 }
 
+func TestValidateInstanceGroupClusterAutoscaler(t *testing.T) {
+	grid := []struct {
+		desc     string
+		gce      bool
+		spec     *kops.InstanceGroupClusterAutoscalerSpec
+		expected []string
+	}{
+		{
+			desc: "valid options",
+			spec: &kops.InstanceGroupClusterAutoscalerSpec{
+				ScaleDownUtilizationThreshold: new("0.6"),
+				ScaleDownUnneededTime:         new("5m"),
+				ScaleDownUnreadyTime:          new("30m"),
+				IgnoreDaemonSetsUtilization:   new(true),
+			},
+		},
+		{
+			desc: "invalid options",
+			spec: &kops.InstanceGroupClusterAutoscalerSpec{
+				ScaleDownUtilizationThreshold: new("1.5"),
+				ScaleDownUnneededTime:         new("five minutes"),
+				ScaleDownUnreadyTime:          new("30"),
+			},
+			expected: []string{
+				"Invalid value::spec.clusterAutoscaler.scaleDownUtilizationThreshold",
+				"Invalid value::spec.clusterAutoscaler.scaleDownUnneededTime",
+				"Invalid value::spec.clusterAutoscaler.scaleDownUnreadyTime",
+			},
+		},
+		{
+			desc: "not AWS",
+			gce:  true,
+			spec: &kops.InstanceGroupClusterAutoscalerSpec{
+				ScaleDownUnneededTime: new("5m"),
+			},
+			expected: []string{"Forbidden::spec.clusterAutoscaler"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			cluster := &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider: kops.CloudProviderSpec{
+						AWS: &kops.AWSSpec{},
+					},
+				},
+			}
+			if g.gce {
+				cluster.Spec.CloudProvider = kops.CloudProviderSpec{
+					GCE: &kops.GCESpec{},
+				}
+			}
+			ig := &kops.InstanceGroup{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "some-ig",
+				},
+				Spec: kops.InstanceGroupSpec{
+					Role:              kops.InstanceGroupRoleNode,
+					Image:             "my-image",
+					ClusterAutoscaler: g.spec,
+				},
+			}
+
+			errs := CrossValidateInstanceGroup(ig, cluster, nil, true)
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestValidateKarpenterInstanceGroupSpec(t *testing.T) {
+	grid := []struct {
+		desc        string
+		manager     kops.InstanceManager
+		machineType string
+		maxPrice    *string
+		karpenter   *kops.KarpenterInstanceGroupSpec
+		expected    []string
+	}{
+		{
+			desc: "all requirements",
+			karpenter: &kops.KarpenterInstanceGroupSpec{
+				CapacityTypes:    []string{"on-demand", "spot"},
+				Architectures:    []string{"amd64", "arm64"},
+				InstanceFamilies: []string{"m7i", "m7g"},
+			},
+		},
+		{
+			desc:    "not managed by Karpenter",
+			manager: kops.InstanceManagerCloudGroup,
+			karpenter: &kops.KarpenterInstanceGroupSpec{
+				CapacityTypes: []string{"spot"},
+			},
+			expected: []string{"Forbidden::spec.karpenter"},
+		},
+		{
+			desc: "unsupported values",
+			karpenter: &kops.KarpenterInstanceGroupSpec{
+				CapacityTypes: []string{"reserved"},
+				Architectures: []string{"x86_64"},
+			},
+			expected: []string{
+				"Unsupported value::spec.karpenter.capacityTypes[0]",
+				"Unsupported value::spec.karpenter.architectures[0]",
+			},
+		},
+		{
+			desc: "duplicate values",
+			karpenter: &kops.KarpenterInstanceGroupSpec{
+				CapacityTypes: []string{"spot", "spot"},
+			},
+			expected: []string{"Duplicate value::spec.karpenter.capacityTypes[1]"},
+		},
+		{
+			desc: "invalid instance family",
+			karpenter: &kops.KarpenterInstanceGroupSpec{
+				InstanceFamilies: []string{"m7i.large"},
+			},
+			expected: []string{"Invalid value::spec.karpenter.instanceFamilies[0]"},
+		},
+		{
+			desc:     "maxPrice without spot",
+			maxPrice: new("0.10"),
+			karpenter: &kops.KarpenterInstanceGroupSpec{
+				CapacityTypes: []string{"on-demand"},
+			},
+			expected: []string{"Forbidden::spec.maxPrice"},
+		},
+		{
+			desc:        "instance types in the instance families",
+			machineType: "m7i.large,m7g.large",
+			karpenter: &kops.KarpenterInstanceGroupSpec{
+				InstanceFamilies: []string{"m7i", "m7g"},
+			},
+		},
+		{
+			desc:        "instance type outside the instance families",
+			machineType: "m7i.large,c7i.large",
+			karpenter: &kops.KarpenterInstanceGroupSpec{
+				InstanceFamilies: []string{"m7i"},
+			},
+			expected: []string{"Invalid value::spec.machineType"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			cluster := &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider: kops.CloudProviderSpec{
+						AWS: &kops.AWSSpec{},
+					},
+					Karpenter: &kops.KarpenterConfig{
+						Enabled: true,
+					},
+				},
+			}
+			manager := g.manager
+			if manager == "" {
+				manager = kops.InstanceManagerKarpenter
+			}
+			ig := &kops.InstanceGroup{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "some-ig",
+				},
+				Spec: kops.InstanceGroupSpec{
+					Manager:     manager,
+					Role:        kops.InstanceGroupRoleNode,
+					Image:       "my-image",
+					MachineType: g.machineType,
+					MaxPrice:    g.maxPrice,
+					Karpenter:   g.karpenter,
+				},
+			}
+
+			errs := validateKarpenterInstanceGroup(ig, cluster)
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
 func TestValidateIGCloudLabels(t *testing.T) {
 	grid := []struct {
 		label    string
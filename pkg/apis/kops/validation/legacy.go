@@ -264,6 +264,16 @@ func validateServiceAccountIssuerDiscovery(c *kops.Cluster, said *kops.ServiceAc
 		}
 	}
 
+	if said.EnableGCPWorkloadIdentityFederation {
+		enableWIFField := fieldSpec.Child("enableGCPWorkloadIdentityFederation")
+		if c.GetCloudProvider() != kops.CloudProviderGCE {
+			allErrs = append(allErrs, field.Forbidden(enableWIFField, "GCP workload identity federation is only supported on GCE"))
+		}
+		if discoveryStore == "" && discoveryService == nil {
+			allErrs = append(allErrs, field.Forbidden(enableWIFField, "GCP workload identity federation requires a discoveryStore or discoveryService to be set"))
+		}
+	}
+
 	return allErrs
 }
 
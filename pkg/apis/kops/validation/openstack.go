@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func openstackValidateCluster(c *kops.Cluster) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	lbSpec := c.Spec.CloudProvider.Openstack.Loadbalancer
+	if lbSpec != nil {
+		fieldPath := field.NewPath("spec", "cloudProvider", "openstack", "loadbalancer", "apiAllowedCIDRs")
+		for i, cidr := range lbSpec.APIAllowedCIDRs {
+			ipNet, errs := parseCIDR(fieldPath.Index(i), cidr)
+			allErrs = append(allErrs, errs...)
+			// The openstack model only supports IPv4 addresses in the listener allowed CIDRs
+			if ipNet != nil && ipNet.IP.To4() == nil {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Index(i), cidr, "only IPv4 CIDRs are supported"))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func TestOpenstackValidateCluster(t *testing.T) {
+	tests := []struct {
+		name            string
+		apiAllowedCIDRs []string
+		expected        []*field.Error
+	}{
+		{
+			name: "accepts no allowed CIDRs",
+		},
+		{
+			name:            "accepts IPv4 allowed CIDRs",
+			apiAllowedCIDRs: []string{"10.0.0.0/8", "192.168.1.0/24"},
+		},
+		{
+			name:            "rejects invalid allowed CIDRs",
+			apiAllowedCIDRs: []string{"10.0.0.0/8", "10.0.0.1"},
+			expected: []*field.Error{
+				{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.cloudProvider.openstack.loadbalancer.apiAllowedCIDRs[1]",
+				},
+			},
+		},
+		{
+			name:            "rejects IPv6 allowed CIDRs",
+			apiAllowedCIDRs: []string{"2001:db8::/32"},
+			expected: []*field.Error{
+				{
+					Type:   field.ErrorTypeInvalid,
+					Field:  "spec.cloudProvider.openstack.loadbalancer.apiAllowedCIDRs[0]",
+					Detail: "only IPv4 CIDRs are supported",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &kops.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "openstack.k8s.local"},
+				Spec: kops.ClusterSpec{
+					CloudProvider: kops.CloudProviderSpec{
+						Openstack: &kops.OpenstackSpec{
+							Loadbalancer: &kops.OpenstackLoadbalancerConfig{
+								APIAllowedCIDRs: tt.apiAllowedCIDRs,
+							},
+						},
+					},
+				},
+			}
+			errList := openstackValidateCluster(cluster)
+			testFieldErrors(t, errList, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func scalewayValidateCluster(c *kops.Cluster) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Instance groups can span several zones, but the cluster must stay in a single region
+	subnetsPath := field.NewPath("spec", "networking", "subnets")
+	region := ""
+	for i, subnet := range c.Spec.Networking.Subnets {
+		subnetRegion := subnet.Zone
+		if index := strings.LastIndex(subnet.Zone, "-"); index > 0 {
+			subnetRegion = subnet.Zone[:index]
+		}
+		if region == "" {
+			region = subnetRegion
+		} else if subnetRegion != region {
+			allErrs = append(allErrs, field.Invalid(subnetsPath.Index(i).Child("zone"), subnet.Zone, fmt.Sprintf("cluster cannot span multiple regions (region is %q)", region)))
+		}
+	}
+
+	for i, etcdCluster := range c.Spec.EtcdClusters {
+		for j, member := range etcdCluster.Members {
+			// Block Storage volumes come in two performance classes
+			if member.VolumeIOPS != nil && *member.VolumeIOPS != 5000 && *member.VolumeIOPS != 15000 {
+				fieldPath := field.NewPath("spec", "etcdClusters").Index(i).Child("etcdMembers").Index(j).Child("volumeIOPS")
+				allErrs = append(allErrs, field.NotSupported(fieldPath, *member.VolumeIOPS, []string{"5000", "15000"}))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func newScalewayClusterForClusterValidation() *kops.Cluster {
+	return &kops.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "scaleway.k8s.local"},
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				Scaleway: &kops.ScalewaySpec{},
+			},
+			Networking: kops.NetworkingSpec{
+				Subnets: []kops.ClusterSubnetSpec{
+					{Name: "fr-par-1", Zone: "fr-par-1", Type: kops.SubnetTypePublic},
+				},
+			},
+			EtcdClusters: []kops.EtcdClusterSpec{
+				{
+					Name: "main",
+					Members: []kops.EtcdMemberSpec{
+						{Name: "fr-par-1", InstanceGroup: new("control-plane-fr-par-1")},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestScalewayValidateCluster(t *testing.T) {
+	tests := []struct {
+		name     string
+		cluster  func(c *kops.Cluster)
+		expected []*field.Error
+	}{
+		{
+			name: "accepts single zone",
+		},
+		{
+			name: "accepts multiple zones in the same region",
+			cluster: func(c *kops.Cluster) {
+				c.Spec.Networking.Subnets = append(c.Spec.Networking.Subnets,
+					kops.ClusterSubnetSpec{Name: "fr-par-2", Zone: "fr-par-2", Type: kops.SubnetTypePublic},
+					kops.ClusterSubnetSpec{Name: "fr-par-3", Zone: "fr-par-3", Type: kops.SubnetTypePublic},
+				)
+			},
+		},
+		{
+			name: "rejects zones in multiple regions",
+			cluster: func(c *kops.Cluster) {
+				c.Spec.Networking.Subnets = append(c.Spec.Networking.Subnets, kops.ClusterSubnetSpec{Name: "nl-ams-1", Zone: "nl-ams-1", Type: kops.SubnetTypePublic})
+			},
+			expected: []*field.Error{
+				{
+					Type:   field.ErrorTypeInvalid,
+					Field:  "spec.networking.subnets[1].zone",
+					Detail: "cluster cannot span multiple regions (region is \"fr-par\")",
+				},
+			},
+		},
+		{
+			name: "accepts supported etcd volume IOPS",
+			cluster: func(c *kops.Cluster) {
+				c.Spec.EtcdClusters[0].Members[0].VolumeIOPS = new(int32(15000))
+			},
+		},
+		{
+			name: "rejects unsupported etcd volume IOPS",
+			cluster: func(c *kops.Cluster) {
+				c.Spec.EtcdClusters[0].Members[0].VolumeIOPS = new(int32(3000))
+			},
+			expected: []*field.Error{
+				{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "spec.etcdClusters[0].etcdMembers[0].volumeIOPS",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := newScalewayClusterForClusterValidation()
+			if tt.cluster != nil {
+				tt.cluster(cluster)
+			}
+			errList := scalewayValidateCluster(cluster)
+			testFieldErrors(t, errList, tt.expected)
+		})
+	}
+}
@@ -17,18 +17,23 @@ limitations under the License.
 package validation
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
 	"net/netip"
 	"net/url"
+	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/blang/semver/v4"
+	"github.com/google/go-containerregistry/pkg/name"
 	"golang.org/x/net/ipv4"
 	"golang.org/x/net/ipv6"
 	"k8s.io/apimachinery/pkg/api/validation"
@@ -37,14 +42,21 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	certutil "k8s.io/client-go/util/cert"
+	cliflag "k8s.io/component-base/cli/flag"
 	"k8s.io/kops/pkg/util/subnet"
 	netutils "k8s.io/utils/net"
 
 	"k8s.io/kops/pkg/apis/kops"
+	kopsmodel "k8s.io/kops/pkg/apis/kops/model"
+	"k8s.io/kops/pkg/apis/kops/util"
+	"k8s.io/kops/pkg/featureflag"
+	"k8s.io/kops/pkg/maintenancewindow"
 	"k8s.io/kops/pkg/model/components"
 	"k8s.io/kops/pkg/model/iam"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/utils"
+	"k8s.io/kops/util/pkg/hashing"
 	"k8s.io/kops/util/pkg/vfs"
 )
 
@@ -75,8 +87,14 @@ func newValidateCluster(cluster *kops.Cluster, strict bool) field.ErrorList {
 		allErrs = append(allErrs, awsValidateCluster(cluster, strict)...)
 	case kops.CloudProviderGCE:
 		allErrs = append(allErrs, gceValidateCluster(cluster)...)
+	case kops.CloudProviderHetzner:
+		allErrs = append(allErrs, hetznerValidateCluster(cluster)...)
 	case kops.CloudProviderLinode:
 		allErrs = append(allErrs, linodeValidateCluster(cluster)...)
+	case kops.CloudProviderOpenstack:
+		allErrs = append(allErrs, openstackValidateCluster(cluster)...)
+	case kops.CloudProviderScaleway:
+		allErrs = append(allErrs, scalewayValidateCluster(cluster)...)
 	}
 
 	return allErrs
@@ -84,6 +102,7 @@ func newValidateCluster(cluster *kops.Cluster, strict bool) field.ErrorList {
 
 func validateClusterSpec(spec *kops.ClusterSpec, c *kops.Cluster, fieldPath *field.Path, strict bool) field.ErrorList {
 	allErrs, providerConstraints := validateCloudProvider(c, &spec.CloudProvider, fieldPath.Child("cloudProvider"))
+	allErrs = append(allErrs, validateInstanceGroupScaleAPI(c, fieldPath.Child("cloudProvider"))...)
 
 	// SSHAccess
 	for i, cidr := range spec.SSHAccess {
@@ -156,6 +175,18 @@ func validateClusterSpec(spec *kops.ClusterSpec, c *kops.Cluster, fieldPath *fie
 		allErrs = append(allErrs, validateKubelet(spec.ControlPlaneKubelet, c, fieldPath.Child("controlPlaneKubelet"))...)
 	}
 
+	if len(spec.KubeletCredentialProviders) > 0 {
+		allErrs = append(allErrs, validateKubeletCredentialProviders(spec.KubeletCredentialProviders, fieldPath.Child("kubeletCredentialProviders"))...)
+	}
+
+	if spec.KMSEncryption != nil {
+		allErrs = append(allErrs, validateKMSEncryption(spec, c, fieldPath)...)
+	}
+
+	if spec.PodSecurityAdmission != nil {
+		allErrs = append(allErrs, validateClusterPodSecurityAdmission(spec, c, fieldPath)...)
+	}
+
 	allErrs = append(allErrs, validateNetworking(c, &spec.Networking, fieldPath.Child("networking"), strict, providerConstraints)...)
 
 	if spec.NodeAuthorization != nil {
@@ -174,19 +205,52 @@ func validateClusterSpec(spec *kops.ClusterSpec, c *kops.Cluster, fieldPath *fie
 		allErrs = append(allErrs, validateMetricsServer(c, spec.MetricsServer, fieldPath.Child("metricsServer"))...)
 	}
 
+	if spec.VerticalPodAutoscaler != nil {
+		allErrs = append(allErrs, validateVerticalPodAutoscaler(c, spec.VerticalPodAutoscaler, fieldPath.Child("verticalPodAutoscaler"))...)
+	}
+
+	if spec.InterruptionHandler != nil {
+		allErrs = append(allErrs, validateInterruptionHandler(c, spec.InterruptionHandler, fieldPath.Child("interruptionHandler"))...)
+	}
+
 	if spec.SnapshotController != nil {
 		allErrs = append(allErrs, validateSnapshotController(c, spec.SnapshotController, fieldPath.Child("snapshotController"))...)
 	}
 
-	// Custom addons: the kops-channels static pod fetches manifests via VFS at boot, so file:// schemes
-	// (which would resolve inside the container's mount namespace) aren't supported. Push the manifest
-	// to the state store or another VFS-supported backend.
-	for i, addon := range spec.Addons {
-		if strings.HasPrefix(addon.Manifest, "file://") {
-			allErrs = append(allErrs, field.Invalid(fieldPath.Child("addons").Index(i).Child("manifest"), addon.Manifest, "file:// addon manifests are not supported"))
-		}
+	if spec.NodeIdentityLabels != nil {
+		allErrs = append(allErrs, validateNodeIdentityLabels(spec.NodeIdentityLabels, fieldPath.Child("nodeIdentityLabels"))...)
+	}
+
+	if spec.TagPolicy != nil {
+		allErrs = append(allErrs, validateTagPolicy(c, spec.TagPolicy, fieldPath.Child("tagPolicy"))...)
+	}
+
+	if spec.Hardening != nil {
+		allErrs = append(allErrs, validateHardening(spec.Hardening, fieldPath.Child("hardening"))...)
 	}
 
+	if spec.SELinux != nil {
+		allErrs = append(allErrs, validateSELinux(c, spec.SELinux, fieldPath.Child("selinux"))...)
+	}
+
+	if spec.TLS != nil {
+		allErrs = append(allErrs, validateTLS(spec.TLS, fieldPath.Child("tls"))...)
+	}
+
+	if spec.KopsController != nil {
+		allErrs = append(allErrs, validateKopsController(spec.KopsController, fieldPath.Child("kopsController"))...)
+	}
+
+	if fi.ValueOf(spec.FIPSMode) {
+		allErrs = append(allErrs, validateFIPSMode(spec, fieldPath)...)
+	}
+
+	for i := range spec.MaintenanceWindows {
+		allErrs = append(allErrs, validateMaintenanceWindow(&spec.MaintenanceWindows[i], fieldPath.Child("maintenanceWindows").Index(i))...)
+	}
+
+	allErrs = append(allErrs, validateAddons(spec.Addons, fieldPath.Child("addons"))...)
+
 	// IAM additional policies
 	for k, v := range spec.AdditionalPolicies {
 		allErrs = append(allErrs, validateAdditionalPolicy(k, v, fieldPath.Child("additionalPolicies"))...)
@@ -221,6 +285,12 @@ func validateClusterSpec(spec *kops.ClusterSpec, c *kops.Cluster, fieldPath *fie
 		allErrs = append(allErrs, validateContainerdConfig(c, spec.Containerd, fieldPath.Child("containerd"), true)...)
 	}
 
+	if c.UsesCRIO() {
+		allErrs = append(allErrs, validateCRIO(c, fieldPath)...)
+	} else if spec.CRIO != nil {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("crio"), "crio can only be configured when containerRuntime is crio"))
+	}
+
 	if spec.Docker != nil {
 		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("docker"), "Docker CRI support was removed in Kubernetes 1.24: https://kubernetes.io/blog/2020/12/02/dockershim-faq"))
 	}
@@ -232,6 +302,9 @@ func validateClusterSpec(spec *kops.ClusterSpec, c *kops.Cluster, fieldPath *fie
 		if spec.Assets.FileRepository != nil {
 			allErrs = append(allErrs, validateFileRepository(*spec.Assets.FileRepository, fieldPath.Child("assets", "fileRepository"))...)
 		}
+		if spec.Assets.NodeDownloads != nil {
+			allErrs = append(allErrs, validateAssetDownloads(spec.Assets.NodeDownloads, fieldPath.Child("assets", "nodeDownloads"))...)
+		}
 	}
 
 	for i, sysctlParameter := range spec.SysctlParameters {
@@ -297,6 +370,16 @@ func validateClusterSpec(spec *kops.ClusterSpec, c *kops.Cluster, fieldPath *fie
 		if len(spec.IAM.ServiceAccountExternalPermissions) > 0 {
 			allErrs = append(allErrs, validateSAExternalPermissions(spec.IAM.ServiceAccountExternalPermissions, fieldPath.Child("iam", "serviceAccountExternalPermissions"))...)
 		}
+
+		if fi.ValueOf(spec.IAM.UseServiceAccountExternalPermissions) && c.GetCloudProvider() == kops.CloudProviderGCE {
+			if spec.ServiceAccountIssuerDiscovery == nil || !spec.ServiceAccountIssuerDiscovery.EnableGCPWorkloadIdentityFederation {
+				allErrs = append(allErrs, field.Forbidden(fieldPath.Child("iam", "useServiceAccountExternalPermissions"), "service accounts require GCP workload identity federation to use external permissions on GCE"))
+			}
+		}
+	}
+
+	if ccm := spec.ExternalCloudControllerManager; ccm != nil && fi.ValueOf(ccm.UseServiceAccountExternalPermissions) {
+		allErrs = append(allErrs, validateCloudControllerManagerExternalPermissions(c, fieldPath.Child("cloudControllerManager", "useServiceAccountExternalPermissions"))...)
 	}
 
 	if spec.Karpenter != nil && spec.Karpenter.Enabled {
@@ -344,6 +427,7 @@ func validateCloudProvider(c *kops.Cluster, provider *kops.CloudProviderSpec, fi
 			allErrs = append(allErrs, field.Forbidden(fieldSpec.Child("azure"), "only one cloudProvider option permitted"))
 		}
 		optionTaken = true
+		allErrs = append(allErrs, validateAzure(c, provider.Azure, fieldSpec.Child("azure"))...)
 		constraints.requiresSubnetRegion = true
 	}
 	if c.Spec.CloudProvider.DO != nil {
@@ -351,6 +435,7 @@ func validateCloudProvider(c *kops.Cluster, provider *kops.CloudProviderSpec, fi
 			allErrs = append(allErrs, field.Forbidden(fieldSpec.Child("do"), "only one cloudProvider option permitted"))
 		}
 		optionTaken = true
+		allErrs = append(allErrs, validateDO(c, provider.DO, fieldSpec.Child("do"))...)
 		constraints.requiresSubnets = false
 		constraints.requiresSubnetCIDR = false
 		constraints.requiresSubnetRegion = true
@@ -422,6 +507,14 @@ func validateCloudProvider(c *kops.Cluster, provider *kops.CloudProviderSpec, fi
 	return allErrs, constraints
 }
 
+// validateInstanceGroupScaleAPI rejects the API for scaling instance groups on clouds kops-controller can't scale instance groups on.
+func validateInstanceGroupScaleAPI(c *kops.Cluster, fldPath *field.Path) (allErrs field.ErrorList) {
+	if featureflag.InstanceGroupScaleAPI.Enabled() && c.GetCloudProvider() != kops.CloudProviderAWS {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("the InstanceGroupScaleAPI feature flag is not supported on cloud provider %q", c.GetCloudProvider())))
+	}
+	return allErrs
+}
+
 func validateAWS(c *kops.Cluster, aws *kops.AWSSpec, path *field.Path) (allErrs field.ErrorList) {
 	if aws.NodeTerminationHandler != nil {
 		allErrs = append(allErrs, validateNodeTerminationHandler(c, aws.NodeTerminationHandler, path.Child("nodeTerminationHandler"))...)
@@ -435,6 +528,10 @@ func validateAWS(c *kops.Cluster, aws *kops.AWSSpec, path *field.Path) (allErrs
 		allErrs = append(allErrs, validateWarmPool(aws.WarmPool, path.Child("warmPool"))...)
 	}
 
+	if aws.GracefulNodeDrain != nil {
+		allErrs = append(allErrs, validateGracefulNodeDrain(c, aws.GracefulNodeDrain, path.Child("gracefulNodeDrain"))...)
+	}
+
 	if aws.PodIdentityWebhook != nil && aws.PodIdentityWebhook.Enabled {
 		allErrs = append(allErrs, validatePodIdentityWebhook(c, aws.PodIdentityWebhook, path.Child("podIdentityWebhook"))...)
 	}
@@ -731,6 +828,9 @@ func validateSubnet(subnetSpec *kops.ClusterSubnetSpec, c *kops.ClusterSpec, fie
 		if subnetSpec.Egress != kops.EgressExternal && subnetSpec.Type != "DualStack" && subnetSpec.Type != "Private" && (subnetSpec.IPv6CIDR == "" || subnetSpec.Type != "Public") {
 			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("egress"), "egress can only be specified for private or IPv6-capable public subnets"))
 		}
+		if egressType == kops.EgressNatInstance && c.IsIPv6Only() {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("egress"), "NAT EC2 Instances cannot provide NAT64 for IPv6 clusters"))
+		}
 	}
 
 	allErrs = append(allErrs, IsValidValue(fieldPath.Child("type"), &subnetSpec.Type, []kops.SubnetType{
@@ -744,6 +844,15 @@ func validateSubnet(subnetSpec *kops.ClusterSubnetSpec, c *kops.ClusterSpec, fie
 		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("type"), "subnet type DualStack may only be used in IPv6 clusters"))
 	}
 
+	if c.CloudProvider.GCE == nil {
+		if subnetSpec.PodRangeName != "" {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("podRangeName"), "podRangeName is only supported on GCE"))
+		}
+		if subnetSpec.ServiceRangeName != "" {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("serviceRangeName"), "serviceRangeName is only supported on GCE"))
+		}
+	}
+
 	if c.CloudProvider.Openstack != nil {
 		if c.CloudProvider.Openstack.Router == nil || c.CloudProvider.Openstack.Router.ExternalNetwork == nil {
 			if subnetSpec.Type == kops.SubnetTypePublic {
@@ -796,6 +905,22 @@ func validateFileRepository(s string, fieldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+func validateAssetDownloads(spec *kops.AssetDownloadsSpec, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.Concurrency != nil && *spec.Concurrency < 1 {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("concurrency"), *spec.Concurrency, "concurrency must be at least 1"))
+	}
+	if spec.BandwidthLimit != nil && spec.BandwidthLimit.Value() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("bandwidthLimit"), spec.BandwidthLimit.String(), "bandwidthLimit must be positive"))
+	}
+	if spec.CacheDirectory != "" && !filepath.IsAbs(spec.CacheDirectory) {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("cacheDirectory"), spec.CacheDirectory, "cacheDirectory must be an absolute path"))
+	}
+
+	return allErrs
+}
+
 func validateHookSpec(v *kops.HookSpec, fieldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -921,6 +1046,39 @@ func validateKubeAPIServer(v *kops.KubeAPIServerConfig, c *kops.Cluster, fldPath
 		}
 	}
 
+	if v.AuthorizationConfig != nil || v.AuthorizationConfigFile != "" {
+		if v.AuthorizationConfig != nil && v.AuthorizationConfigFile != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("authorizationConfigFile"), "authorizationConfigFile is mutually exclusive with authorizationConfig"))
+		}
+		if v.AuthorizationMode != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("authorizationMode"), "authorizationMode cannot be combined with an authorization config, configure the authorizers in the authorization config instead"))
+		}
+		if v.AuthorizationWebhookConfigFile != nil || v.AuthorizationWebhookCacheAuthorizedTTL != nil || v.AuthorizationWebhookCacheUnauthorizedTTL != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("authorizationWebhookConfigFile"), "the authorizationWebhook options cannot be combined with an authorization config, configure a Webhook authorizer instead"))
+		}
+		if c.Spec.Authorization != nil && c.Spec.Authorization.AlwaysAllow != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "authorization", "alwaysAllow"), "alwaysAllow cannot be combined with an authorization config, use an AlwaysAllow authorizer instead"))
+		}
+		if v.AuthorizationConfigFile != "" && !filepath.IsAbs(v.AuthorizationConfigFile) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("authorizationConfigFile"), v.AuthorizationConfigFile, "must be an absolute path"))
+		}
+	}
+	if v.AuthorizationConfig != nil {
+		allErrs = append(allErrs, validateAuthorizationConfig(v.AuthorizationConfig, c, fldPath.Child("authorizationConfig"))...)
+	}
+
+	if v.AdmissionConfig != nil {
+		if v.AdmissionControlConfigFile != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("admissionControlConfigFile"), "admissionControlConfigFile is mutually exclusive with admissionConfig"))
+		}
+		for _, name := range v.AdmissionConfig.PluginNames() {
+			if slices.Contains(v.DisableAdmissionPlugins, name) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("disableAdmissionPlugins"), fmt.Sprintf("%s is configured in admissionConfig and cannot be disabled", name)))
+			}
+		}
+		allErrs = append(allErrs, validateAdmissionConfig(v.AdmissionConfig, c, fldPath.Child("admissionConfig"))...)
+	}
+
 	if v.LogFormat != "" {
 		allErrs = append(allErrs, IsValidValue(fldPath.Child("logFormat"), &v.LogFormat, []string{"text", "json"})...)
 	}
@@ -929,7 +1087,26 @@ func validateKubeAPIServer(v *kops.KubeAPIServerConfig, c *kops.Cluster, fldPath
 		field.Forbidden(fldPath.Child("insecurePort"), "insecurePort must not be set as of Kubernetes 1.24")
 	}
 
-	if v.AuditPolicyFile == "" && v.AuditWebhookConfigFile != "" {
+	if v.AuditPolicy != nil {
+		if v.AuditPolicyFile != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("auditPolicyFile"), "auditPolicyFile is mutually exclusive with auditPolicy"))
+		}
+		if v.AuditWebhookConfigFile != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("auditWebhookConfigFile"), "auditWebhookConfigFile cannot be combined with auditPolicy, use auditWebhook instead"))
+		}
+		allErrs = append(allErrs, validateAuditPolicy(v.AuditPolicy, fldPath.Child("auditPolicy"))...)
+	}
+	if v.AuditWebhook != nil {
+		if v.AuditWebhookConfigFile != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("auditWebhookConfigFile"), "auditWebhookConfigFile is mutually exclusive with auditWebhook"))
+		}
+		if v.AuditPolicy == nil && v.AuditPolicyFile == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("auditPolicy"), "an audit policy is required for the audit webhook"))
+		}
+		allErrs = append(allErrs, validateAuditWebhook(v.AuditWebhook, fldPath.Child("auditWebhook"))...)
+	}
+
+	if v.AuditPolicyFile == "" && v.AuditPolicy == nil && v.AuditWebhookConfigFile != "" {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("auditWebhookConfigFile"), v.AuditWebhookConfigFile, "an audit policy is required for the audit webhook config"))
 	}
 	if v.AuditPolicyFile != "" && v.AuditWebhookConfigFile != "" {
@@ -949,6 +1126,296 @@ func validateKubeAPIServer(v *kops.KubeAPIServerConfig, c *kops.Cluster, fldPath
 	return allErrs
 }
 
+func validateAuditPolicy(policy *kops.AuditPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(policy.Rules) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("rules"), "an audit policy must have at least one rule"))
+	}
+	allErrs = append(allErrs, validateAuditStages(policy.OmitStages, fldPath.Child("omitStages"))...)
+
+	for i, rule := range policy.Rules {
+		rulePath := fldPath.Child("rules").Index(i)
+		if rule.Level == "" {
+			allErrs = append(allErrs, field.Required(rulePath.Child("level"), ""))
+		} else {
+			allErrs = append(allErrs, IsValidValue(rulePath.Child("level"), &rule.Level, []string{"None", "Metadata", "Request", "RequestResponse"})...)
+		}
+		allErrs = append(allErrs, validateAuditStages(rule.OmitStages, rulePath.Child("omitStages"))...)
+
+		if len(rule.NonResourceURLs) > 0 {
+			if len(rule.Resources) > 0 || len(rule.Namespaces) > 0 {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("nonResourceURLs"), rule.NonResourceURLs, "rules cannot apply to both regular resources and non-resource URLs"))
+			}
+			for j, u := range rule.NonResourceURLs {
+				if u == "*" {
+					continue
+				}
+				if !strings.HasPrefix(u, "/") || strings.Contains(strings.TrimSuffix(u, "*"), "*") {
+					allErrs = append(allErrs, field.Invalid(rulePath.Child("nonResourceURLs").Index(j), u, "non-resource URLs must start with \"/\" and may only use \"*\" as a trailing wildcard"))
+				}
+			}
+		}
+
+		for j, gr := range rule.Resources {
+			if len(gr.ResourceNames) > 0 && len(gr.Resources) == 0 {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("resources").Index(j).Child("resourceNames"), gr.ResourceNames, "using resourceNames requires at least one resource"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateAuditStages(stages []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i := range stages {
+		allErrs = append(allErrs, IsValidValue(fldPath.Index(i), &stages[i], []string{"RequestReceived", "ResponseStarted", "ResponseComplete", "Panic"})...)
+	}
+	return allErrs
+}
+
+func validateAuditWebhook(webhook *kops.AuditWebhookSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if webhook.Server == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("server"), ""))
+	} else if u, err := url.Parse(webhook.Server); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("server"), webhook.Server, "server must be an http or https URL"))
+	}
+
+	if webhook.CACertificate != "" {
+		if _, err := certutil.ParseCertsPEM([]byte(webhook.CACertificate)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("caCertificate"), "...", fmt.Sprintf("unable to parse certificates: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateAuthorizationConfig(config *kops.AuthorizationConfigSpec, c *kops.Cluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(config.Authorizers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("authorizers"), "an authorization config must have at least one authorizer"))
+	}
+
+	names := sets.NewString()
+	types := sets.NewString()
+	for i, authorizer := range config.Authorizers {
+		authorizerPath := fldPath.Child("authorizers").Index(i)
+
+		if authorizer.Type == "" {
+			allErrs = append(allErrs, field.Required(authorizerPath.Child("type"), ""))
+		} else {
+			allErrs = append(allErrs, IsValidValue(authorizerPath.Child("type"), &authorizer.Type, []string{"Node", "RBAC", "Webhook", "AlwaysAllow", "AlwaysDeny"})...)
+			if authorizer.Type != "Webhook" && types.Has(authorizer.Type) {
+				allErrs = append(allErrs, field.Duplicate(authorizerPath.Child("type"), authorizer.Type))
+			}
+			types.Insert(authorizer.Type)
+		}
+
+		name := authorizer.Name
+		if name == "" {
+			name = strings.ToLower(authorizer.Type)
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Label(name) {
+				allErrs = append(allErrs, field.Invalid(authorizerPath.Child("name"), name, msg))
+			}
+		}
+		if names.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(authorizerPath.Child("name"), name))
+		}
+		names.Insert(name)
+
+		if authorizer.Type == "Webhook" {
+			if authorizer.Webhook == nil {
+				allErrs = append(allErrs, field.Required(authorizerPath.Child("webhook"), "a Webhook authorizer must set webhook"))
+			} else {
+				allErrs = append(allErrs, validateWebhookAuthorizer(authorizer.Webhook, authorizerPath.Child("webhook"))...)
+			}
+		} else if authorizer.Webhook != nil {
+			allErrs = append(allErrs, field.Forbidden(authorizerPath.Child("webhook"), "webhook can only be set for a Webhook authorizer"))
+		}
+	}
+
+	if c.Spec.Authorization != nil && c.Spec.Authorization.RBAC != nil && c.GetCloudProvider() == kops.CloudProviderAWS {
+		if !types.Has("Node") || !types.Has("RBAC") {
+			allErrs = append(allErrs, field.Required(fldPath.Child("authorizers"), "on AWS, the authorizers must include RBAC and Node"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWebhookAuthorizer(webhook *kops.WebhookAuthorizerSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if webhook.KubeConfigFile != "" {
+		if webhook.Server != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("kubeConfigFile"), "kubeConfigFile is mutually exclusive with server"))
+		}
+		if webhook.CACertificate != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("caCertificate"), "caCertificate cannot be combined with kubeConfigFile"))
+		}
+		if !filepath.IsAbs(webhook.KubeConfigFile) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("kubeConfigFile"), webhook.KubeConfigFile, "must be an absolute path"))
+		}
+	} else if webhook.Server == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("server"), "either server or kubeConfigFile must be set"))
+	} else if u, err := url.Parse(webhook.Server); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("server"), webhook.Server, "server must be an http or https URL"))
+	}
+
+	if webhook.CACertificate != "" {
+		if _, err := certutil.ParseCertsPEM([]byte(webhook.CACertificate)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("caCertificate"), "...", fmt.Sprintf("unable to parse certificates: %v", err)))
+		}
+	}
+
+	if webhook.Timeout != nil && (webhook.Timeout.Duration < time.Second || webhook.Timeout.Duration > 30*time.Second) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), webhook.Timeout.Duration.String(), "must be between 1s and 30s"))
+	}
+	if webhook.AuthorizedTTL != nil && webhook.AuthorizedTTL.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("authorizedTTL"), webhook.AuthorizedTTL.Duration.String(), "must not be negative"))
+	}
+	if webhook.UnauthorizedTTL != nil && webhook.UnauthorizedTTL.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("unauthorizedTTL"), webhook.UnauthorizedTTL.Duration.String(), "must not be negative"))
+	}
+	if webhook.FailurePolicy != "" {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("failurePolicy"), &webhook.FailurePolicy, []string{"NoOpinion", "Deny"})...)
+	}
+
+	expressions := sets.NewString()
+	for i, condition := range webhook.MatchConditions {
+		conditionPath := fldPath.Child("matchConditions").Index(i).Child("expression")
+		if strings.TrimSpace(condition.Expression) == "" {
+			allErrs = append(allErrs, field.Required(conditionPath, ""))
+		} else if expressions.Has(condition.Expression) {
+			allErrs = append(allErrs, field.Duplicate(conditionPath, condition.Expression))
+		}
+		expressions.Insert(condition.Expression)
+	}
+
+	return allErrs
+}
+
+var podSecurityVersionRegex = regexp.MustCompile(`^v1\.(0|[1-9][0-9]*)$`)
+
+func validateAdmissionConfig(config *kops.AdmissionConfigSpec, c *kops.Cluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if eventRateLimit := config.EventRateLimit; eventRateLimit != nil {
+		limitsPath := fldPath.Child("eventRateLimit", "limits")
+		if len(eventRateLimit.Limits) == 0 {
+			allErrs = append(allErrs, field.Required(limitsPath, "eventRateLimit must have at least one limit"))
+		}
+		types := sets.NewString()
+		for i, limit := range eventRateLimit.Limits {
+			limitPath := limitsPath.Index(i)
+			if limit.Type == "" {
+				allErrs = append(allErrs, field.Required(limitPath.Child("type"), ""))
+			} else {
+				allErrs = append(allErrs, IsValidValue(limitPath.Child("type"), &limit.Type, []string{"Server", "Namespace", "User", "SourceAndObject"})...)
+				if types.Has(limit.Type) {
+					allErrs = append(allErrs, field.Duplicate(limitPath.Child("type"), limit.Type))
+				}
+				types.Insert(limit.Type)
+			}
+			if limit.QPS <= 0 {
+				allErrs = append(allErrs, field.Invalid(limitPath.Child("qps"), limit.QPS, "must be greater than 0"))
+			}
+			if limit.Burst <= 0 {
+				allErrs = append(allErrs, field.Invalid(limitPath.Child("burst"), limit.Burst, "must be greater than 0"))
+			}
+			if limit.CacheSize < 0 {
+				allErrs = append(allErrs, field.Invalid(limitPath.Child("cacheSize"), limit.CacheSize, "must not be negative"))
+			}
+		}
+	}
+
+	if config.PodSecurity != nil {
+		allErrs = append(allErrs, validatePodSecurityAdmission(config.PodSecurity, c, fldPath.Child("podSecurity"))...)
+	}
+
+	if webhook := config.ImagePolicyWebhook; webhook != nil {
+		webhookPath := fldPath.Child("imagePolicyWebhook")
+		if webhook.Server == "" {
+			allErrs = append(allErrs, field.Required(webhookPath.Child("server"), ""))
+		} else if u, err := url.Parse(webhook.Server); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("server"), webhook.Server, "server must be an http or https URL"))
+		}
+		if webhook.CACertificate != "" {
+			if _, err := certutil.ParseCertsPEM([]byte(webhook.CACertificate)); err != nil {
+				allErrs = append(allErrs, field.Invalid(webhookPath.Child("caCertificate"), "...", fmt.Sprintf("unable to parse certificates: %v", err)))
+			}
+		}
+		if webhook.AllowTTL != nil && webhook.AllowTTL.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("allowTTL"), webhook.AllowTTL.Duration.String(), "must not be negative"))
+		}
+		if webhook.DenyTTL != nil && webhook.DenyTTL.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("denyTTL"), webhook.DenyTTL.Duration.String(), "must not be negative"))
+		}
+		if webhook.RetryBackoff != nil && webhook.RetryBackoff.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("retryBackoff"), webhook.RetryBackoff.Duration.String(), "must not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateClusterPodSecurityAdmission(spec *kops.ClusterSpec, c *kops.Cluster, fieldPath *field.Path) field.ErrorList {
+	allErrs := validatePodSecurityAdmission(spec.PodSecurityAdmission, c, fieldPath.Child("podSecurityAdmission"))
+
+	if kubeAPIServer := spec.KubeAPIServer; kubeAPIServer != nil {
+		kubeAPIServerPath := fieldPath.Child("kubeAPIServer")
+		if kubeAPIServer.AdmissionConfig != nil && kubeAPIServer.AdmissionConfig.PodSecurity != nil {
+			allErrs = append(allErrs, field.Forbidden(kubeAPIServerPath.Child("admissionConfig", "podSecurity"), "podSecurity is mutually exclusive with podSecurityAdmission"))
+		}
+		if kubeAPIServer.AdmissionControlConfigFile != "" {
+			allErrs = append(allErrs, field.Forbidden(kubeAPIServerPath.Child("admissionControlConfigFile"), "admissionControlConfigFile cannot be combined with podSecurityAdmission"))
+		}
+		if slices.Contains(kubeAPIServer.DisableAdmissionPlugins, "PodSecurity") {
+			allErrs = append(allErrs, field.Forbidden(kubeAPIServerPath.Child("disableAdmissionPlugins"), "PodSecurity cannot be disabled when podSecurityAdmission is set"))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePodSecurityAdmission(spec *kops.PodSecurityAdmissionSpec, c *kops.Cluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	k8sVersion, _ := util.ParseKubernetesVersion(c.Spec.KubernetesVersion)
+
+	defaultsPath := fldPath.Child("defaults")
+	defaults := spec.Defaults
+	for _, mode := range []struct {
+		name    string
+		level   string
+		version string
+	}{
+		{"enforce", defaults.Enforce, defaults.EnforceVersion},
+		{"audit", defaults.Audit, defaults.AuditVersion},
+		{"warn", defaults.Warn, defaults.WarnVersion},
+	} {
+		if mode.level != "" {
+			allErrs = append(allErrs, IsValidValue(defaultsPath.Child(mode.name), &mode.level, []string{"privileged", "baseline", "restricted"})...)
+		}
+		if mode.version == "" || mode.version == "latest" {
+			continue
+		}
+		versionPath := defaultsPath.Child(mode.name + "Version")
+		if !podSecurityVersionRegex.MatchString(mode.version) {
+			allErrs = append(allErrs, field.Invalid(versionPath, mode.version, "must be latest or a Kubernetes minor version such as v1.33"))
+		} else if version, err := util.ParseKubernetesVersion(mode.version); err == nil && k8sVersion != nil && version.Minor > k8sVersion.Minor {
+			allErrs = append(allErrs, field.Invalid(versionPath, mode.version, fmt.Sprintf("must not be newer than the Kubernetes version of the cluster, v%d.%d", k8sVersion.Major, k8sVersion.Minor)))
+		}
+	}
+
+	return allErrs
+}
+
 func validateKubeControllerManager(v *kops.KubeControllerManagerConfig, c *kops.Cluster, fldPath *field.Path, strict bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1238,9 +1705,9 @@ func validateNetworking(cluster *kops.Cluster, v *kops.NetworkingSpec, fldPath *
 		}
 	}
 
-	// Nothing to validate for CNI
-	// if v.CNI != nil {
-	// }
+	if v.CNI != nil && v.CNI.HelmChart != nil {
+		allErrs = append(allErrs, validateHelmChart(v.CNI.HelmChart, fldPath.Child("cni", "helmChart"))...)
+	}
 
 	if v.Weave != nil {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("weave"), "Weave is no longer supported"))
@@ -1354,6 +1821,14 @@ func validateNetworkingCilium(cluster *kops.Cluster, v *kops.CiliumNetworkingSpe
 		}
 	}
 
+	if v.Hubble != nil && v.Hubble.UI != nil && fi.ValueOf(v.Hubble.UI.Enabled) && !v.Hubble.RelayEnabled() {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("hubble", "ui", "enabled"), "Hubble UI requires that Hubble and Hubble Relay are enabled"))
+	}
+
+	if v.ClusterMesh != nil {
+		allErrs = append(allErrs, validateCiliumClusterMesh(v, fldPath)...)
+	}
+
 	if v.EnableNodePort && c.KubeProxy != nil && (c.KubeProxy.Enabled == nil || *c.KubeProxy.Enabled) {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Root().Child("spec", "kubeProxy", "enabled"), "When Cilium NodePort is enabled, kubeProxy must be disabled"))
 	}
@@ -1438,56 +1913,199 @@ func validateNetworkingCilium(cluster *kops.Cluster, v *kops.CiliumNetworkingSpe
 	return allErrs
 }
 
-func validateNetworkingGCP(cluster *kops.Cluster, v *kops.GCPNetworkingSpec, fldPath *field.Path) field.ErrorList {
+func validateCiliumClusterMesh(v *kops.CiliumNetworkingSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	c := cluster.Spec
+	if len(v.ClusterMesh.Clusters) == 0 {
+		return allErrs
+	}
 
-	if cluster.GetCloudProvider() != kops.CloudProviderGCE {
-		allErrs = append(allErrs, field.Forbidden(fldPath, "GCP networking is supported only when on GCP"))
+	// Cilium identifies the clusters of a mesh by name and ID; both must be set explicitly
+	if v.ClusterName == "" || v.ClusterName == "default" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterName"), "a unique cluster name is required to join a cluster mesh"))
+	}
+	if v.ClusterID == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterID"), "a unique non-zero cluster ID is required to join a cluster mesh"))
 	}
 
-	if c.IsIPv6Only() {
-		allErrs = append(allErrs, field.Forbidden(fldPath, "GCP networking does not support IPv6"))
+	names := sets.NewString()
+	for i, peer := range v.ClusterMesh.Clusters {
+		peerPath := fldPath.Child("clusterMesh", "clusters").Index(i)
+		if peer.Name == "" {
+			allErrs = append(allErrs, field.Required(peerPath.Child("name"), ""))
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Label(peer.Name) {
+				allErrs = append(allErrs, field.Invalid(peerPath.Child("name"), peer.Name, msg))
+			}
+			if names.Has(peer.Name) {
+				allErrs = append(allErrs, field.Duplicate(peerPath.Child("name"), peer.Name))
+			}
+			if peer.Name == v.ClusterName {
+				allErrs = append(allErrs, field.Invalid(peerPath.Child("name"), peer.Name, "must not be the name of this cluster"))
+			}
+			names.Insert(peer.Name)
+		}
+		if peer.Address == "" {
+			allErrs = append(allErrs, field.Required(peerPath.Child("address"), ""))
+		}
+		if peer.Port < 0 || peer.Port > 65535 {
+			allErrs = append(allErrs, field.Invalid(peerPath.Child("port"), peer.Port, "must be between 1 and 65535"))
+		}
 	}
 
 	return allErrs
 }
 
-func validateNetworkingKindnet(cluster *kops.Cluster, v *kops.KindnetNetworkingSpec, fldPath *field.Path) field.ErrorList {
+// addonVersionRegex matches the versions of user-defined addons, which are used in the name of the manifest file.
+var addonVersionRegex = regexp.MustCompile(`^[a-zA-Z0-9]([-a-zA-Z0-9_.]*[a-zA-Z0-9])?$`)
+
+func validateAddons(addons []kops.AddonSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if v.Masquerade != nil && v.Masquerade.Enabled != nil && *v.Masquerade.Enabled {
-		for _, cidr := range v.Masquerade.NonMasqueradeCIDRs {
-			if cidr == "" {
-				continue
+	names := sets.NewString()
+	for i, addon := range addons {
+		f := fldPath.Index(i)
+
+		// The kops-channels static pod fetches manifests via VFS at boot, so file:// schemes
+		// (which would resolve inside the container's mount namespace) aren't supported. Push the manifest
+		// to the state store or another VFS-supported backend.
+		if strings.HasPrefix(addon.Manifest, "file://") {
+			allErrs = append(allErrs, field.Invalid(f.Child("manifest"), addon.Manifest, "file:// addon manifests are not supported"))
+		}
+
+		if addon.Name == "" {
+			if addon.Version != "" {
+				allErrs = append(allErrs, field.Forbidden(f.Child("version"), "version can only be set on user-defined addons (name must be set)"))
 			}
-			_, err := netip.ParsePrefix(cidr)
-			if err != nil {
-				allErrs = append(allErrs, field.Invalid(fldPath, cidr, err.Error()))
+			if strings.HasPrefix(addon.Manifest, "oci://") {
+				allErrs = append(allErrs, field.Forbidden(f.Child("manifest"), "OCI references can only be used by user-defined addons (name must be set)"))
+			}
+			continue
+		}
+
+		// The name is used in the addons.k8s.io/<name> annotation that tracks the applied version
+		if errs := utilvalidation.IsDNS1123Subdomain(addon.Name); len(errs) != 0 {
+			allErrs = append(allErrs, field.Invalid(f.Child("name"), addon.Name, strings.Join(errs, ", ")))
+		} else if len(addon.Name) > utilvalidation.DNS1123LabelMaxLength {
+			allErrs = append(allErrs, field.TooLong(f.Child("name"), addon.Name, utilvalidation.DNS1123LabelMaxLength))
+		} else if strings.HasSuffix(addon.Name, ".addons.k8s.io") || strings.HasSuffix(addon.Name, ".kops.k8s.io") {
+			allErrs = append(allErrs, field.Invalid(f.Child("name"), addon.Name, "names ending in .addons.k8s.io or .kops.k8s.io are reserved for built-in addons"))
+		}
+		if names.Has(addon.Name) {
+			allErrs = append(allErrs, field.Duplicate(f.Child("name"), addon.Name))
+		}
+		names.Insert(addon.Name)
+
+		if addon.Version != "" && !addonVersionRegex.MatchString(addon.Version) {
+			allErrs = append(allErrs, field.Invalid(f.Child("version"), addon.Version, "must consist of alphanumeric characters, '-', '_' or '.'"))
+		}
+
+		if addon.Manifest == "" {
+			allErrs = append(allErrs, field.Required(f.Child("manifest"), "manifest is required"))
+		} else if ref, ok := strings.CutPrefix(addon.Manifest, "oci://"); ok {
+			if _, err := name.ParseReference(ref); err != nil {
+				allErrs = append(allErrs, field.Invalid(f.Child("manifest"), addon.Manifest, fmt.Sprintf("invalid OCI reference: %v", err)))
 			}
 		}
 	}
+
 	return allErrs
 }
 
-func validateAdditionalPolicy(role string, policy string, fldPath *field.Path) field.ErrorList {
+func validateHelmChart(chart *kops.HelmChartSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	var valid []string
-	for _, r := range kops.AllInstanceGroupRoles {
-		valid = append(valid, r.ToLowerString())
+	if chart.Repository == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("repository"), ""))
+	} else if u, err := url.Parse(chart.Repository); err != nil || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("repository"), chart.Repository, "must be a valid URL"))
+	} else if u.Scheme != "https" && u.Scheme != "http" && u.Scheme != "oci" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("repository"), chart.Repository, "scheme must be one of https, http or oci"))
 	}
-	allErrs = append(allErrs, IsValidValue(fldPath, &role, valid)...)
 
-	statements, err := iam.ParseStatements(policy)
-	if err != nil {
-		allErrs = append(allErrs, field.Invalid(fldPath.Key(role), policy, "policy was not valid JSON: "+err.Error()))
+	if chart.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
 	}
 
-	// Trivial validation of policy, mostly to make sure it isn't some other random object
-	for i, statement := range statements {
-		fldEffect := fldPath.Key(role).Index(i).Child("Effect")
+	// The version is pinned so that the rendered manifest only changes when the spec does
+	if chart.Version == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("version"), "chart version must be pinned"))
+	} else if _, err := semver.ParseTolerant(chart.Version); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("version"), chart.Version, "must be a semantic version"))
+	}
+
+	if chart.ReleaseName != "" {
+		for _, msg := range utilvalidation.IsDNS1123Label(chart.ReleaseName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("releaseName"), chart.ReleaseName, msg))
+		}
+	}
+
+	if chart.Namespace != "" {
+		for _, msg := range utilvalidation.IsDNS1123Label(chart.Namespace) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), chart.Namespace, msg))
+		}
+	}
+
+	if chart.Values != "" {
+		values := make(map[string]interface{})
+		if err := utils.YamlUnmarshal([]byte(chart.Values), &values); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("values"), chart.Values, fmt.Sprintf("must be a YAML map: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateNetworkingGCP(cluster *kops.Cluster, v *kops.GCPNetworkingSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	c := cluster.Spec
+
+	if cluster.GetCloudProvider() != kops.CloudProviderGCE {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "GCP networking is supported only when on GCP"))
+	}
+
+	if c.IsIPv6Only() {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "GCP networking does not support IPv6"))
+	}
+
+	return allErrs
+}
+
+func validateNetworkingKindnet(cluster *kops.Cluster, v *kops.KindnetNetworkingSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if v.Masquerade != nil && v.Masquerade.Enabled != nil && *v.Masquerade.Enabled {
+		for _, cidr := range v.Masquerade.NonMasqueradeCIDRs {
+			if cidr == "" {
+				continue
+			}
+			_, err := netip.ParsePrefix(cidr)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath, cidr, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateAdditionalPolicy(role string, policy string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var valid []string
+	for _, r := range kops.AllInstanceGroupRoles {
+		valid = append(valid, r.ToLowerString())
+	}
+	allErrs = append(allErrs, IsValidValue(fldPath, &role, valid)...)
+
+	statements, err := iam.ParseStatements(policy)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Key(role), policy, "policy was not valid JSON: "+err.Error()))
+	}
+
+	// Trivial validation of policy, mostly to make sure it isn't some other random object
+	for i, statement := range statements {
+		fldEffect := fldPath.Key(role).Index(i).Child("Effect")
 		if statement.Effect == "" {
 			allErrs = append(allErrs, field.Required(fldEffect, "Effect must be specified for IAM policy"))
 		} else {
@@ -1526,6 +2144,10 @@ func validateEtcdClusterSpec(spec kops.EtcdClusterSpec, c *kops.Cluster, fieldPa
 	if spec.Provider != "" {
 		allErrs = append(allErrs, IsValidValue(fieldPath.Child("provider"), &spec.Provider, []kops.EtcdProviderType{kops.EtcdProviderTypeManager})...)
 	}
+	if spec.IsExternal() {
+		allErrs = append(allErrs, validateEtcdExternal(spec, fieldPath)...)
+		return allErrs
+	}
 	if len(spec.Members) == 0 {
 		allErrs = append(allErrs, field.Required(fieldPath.Child("etcdMembers"), "No members defined in etcd cluster"))
 	} else if (len(spec.Members) % 2) == 0 {
@@ -1539,6 +2161,115 @@ func validateEtcdClusterSpec(spec kops.EtcdClusterSpec, c *kops.Cluster, fieldPa
 	for i, m := range spec.Members {
 		allErrs = append(allErrs, validateEtcdMemberSpec(m, fieldPath.Child("etcdMembers").Index(i))...)
 	}
+	if spec.Backups != nil && spec.Backups.S3 != nil {
+		allErrs = append(allErrs, validateEtcdBackupS3(spec.Backups, fieldPath.Child("backups"))...)
+	}
+	if spec.Manager != nil {
+		allErrs = append(allErrs, validateEtcdManagerSpec(spec, fieldPath.Child("manager"))...)
+	}
+
+	return allErrs
+}
+
+// validateEtcdManagerSpec checks the etcd tuning settings passed to etcd-manager.
+func validateEtcdManagerSpec(spec kops.EtcdClusterSpec, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	manager := spec.Manager
+
+	if manager.QuotaBackendBytes != nil {
+		quota := manager.QuotaBackendBytes.Value()
+		if quota <= 0 {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("quotaBackendBytes"), manager.QuotaBackendBytes.String(), "must be greater than 0"))
+		}
+		for _, member := range spec.Members {
+			if member.VolumeSize == nil {
+				continue
+			}
+			if quota >= int64(*member.VolumeSize)*1024*1024*1024 {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Child("quotaBackendBytes"), manager.QuotaBackendBytes.String(), fmt.Sprintf("must be smaller than the volume of etcd member %q (%dGi)", member.Name, *member.VolumeSize)))
+			}
+		}
+	}
+
+	if manager.AutoCompactionMode != "" {
+		allErrs = append(allErrs, IsValidValue(fieldPath.Child("autoCompactionMode"), &manager.AutoCompactionMode, []string{"periodic", "revision"})...)
+	}
+	if manager.AutoCompactionRetention != "" {
+		retentionPath := fieldPath.Child("autoCompactionRetention")
+		if manager.AutoCompactionMode == "revision" {
+			if n, err := strconv.ParseUint(manager.AutoCompactionRetention, 10, 64); err != nil || n == 0 {
+				allErrs = append(allErrs, field.Invalid(retentionPath, manager.AutoCompactionRetention, "must be a positive number of revisions"))
+			}
+		} else if _, err := strconv.ParseUint(manager.AutoCompactionRetention, 10, 64); err != nil {
+			// etcd accepts a number of hours, or a duration, in periodic mode
+			if d, err := time.ParseDuration(manager.AutoCompactionRetention); err != nil || d <= 0 {
+				allErrs = append(allErrs, field.Invalid(retentionPath, manager.AutoCompactionRetention, "must be a positive duration, such as \"1h\""))
+			}
+		}
+	}
+
+	if manager.DefragInterval != nil && manager.DefragInterval.Duration < time.Hour {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("defragInterval"), manager.DefragInterval.Duration.String(), "must be at least 1h"))
+	}
+
+	return allErrs
+}
+
+// validateEtcdExternal checks that an etcd cluster not managed by kOps has valid endpoints and no etcd-manager configuration.
+func validateEtcdExternal(spec kops.EtcdClusterSpec, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	externalPath := fieldPath.Child("external")
+
+	if spec.Name == "cilium" {
+		allErrs = append(allErrs, field.Forbidden(externalPath, "the cilium etcd cluster must be managed by kOps"))
+	}
+	if len(spec.Members) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("etcdMembers"), "members cannot be set for an external etcd cluster"))
+	}
+	if spec.Image != "" {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("image"), "image cannot be set for an external etcd cluster"))
+	}
+	if spec.Backups != nil {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("backups"), "backups of an external etcd cluster are not managed by kOps"))
+	}
+	if spec.Manager != nil {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("manager"), "etcd-manager does not run for an external etcd cluster"))
+	}
+	allErrs = append(allErrs, validateEtcdVersion(spec, fieldPath, nil)...)
+
+	if len(spec.External.Endpoints) == 0 {
+		allErrs = append(allErrs, field.Required(externalPath.Child("endpoints"), ""))
+	}
+	for i, endpoint := range spec.External.Endpoints {
+		if u, err := url.Parse(endpoint); err != nil || u.Scheme != "https" || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			allErrs = append(allErrs, field.Invalid(externalPath.Child("endpoints").Index(i), endpoint, "endpoint must be an https URL without a path"))
+		}
+	}
+	if spec.External.CACertificate != "" {
+		if _, err := certutil.ParseCertsPEM([]byte(spec.External.CACertificate)); err != nil {
+			allErrs = append(allErrs, field.Invalid(externalPath.Child("caCertificate"), "...", fmt.Sprintf("unable to parse certificates: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateEtcdBackupS3 checks that a backup store with its own S3 configuration is an S3 path with a valid endpoint.
+func validateEtcdBackupS3(spec *kops.EtcdBackupSpec, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.BackupStore == "" {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("backupStore"), "backupStore must be set when s3 is set"))
+	} else if !strings.HasPrefix(spec.BackupStore, "s3://") {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("backupStore"), spec.BackupStore, "backupStore must be an s3:// path when s3 is set"))
+	}
+
+	endpointPath := fieldPath.Child("s3", "endpoint")
+	if spec.S3.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(endpointPath, ""))
+	} else if u, err := url.Parse(spec.S3.Endpoint); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(endpointPath, spec.S3.Endpoint, "endpoint must be an http or https URL"))
+	}
 
 	return allErrs
 }
@@ -1867,7 +2598,7 @@ func validateCalicoEncapsulationMode(mode string, fldPath *field.Path) field.Err
 }
 
 func validateContainerRuntime(c *kops.Cluster, runtime string, fldPath *field.Path) field.ErrorList {
-	valid := []string{"containerd", "docker"}
+	valid := []string{kops.ContainerRuntimeContainerd, kops.ContainerRuntimeCRIO, "docker"}
 
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, IsValidValue(fldPath, &runtime, valid)...)
@@ -1879,6 +2610,44 @@ func validateContainerRuntime(c *kops.Cluster, runtime string, fldPath *field.Pa
 	return allErrs
 }
 
+// validateCRIO checks that the cluster only uses features that are implemented for CRI-O.
+func validateCRIO(c *kops.Cluster, fieldPath *field.Path) (allErrs field.ErrorList) {
+	spec := &c.Spec
+
+	if spec.Networking.UsesKubenet() {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("networking", "kubenet"), "kubenet is not supported with CRI-O"))
+	}
+
+	if spec.Containerd != nil && spec.Containerd.NvidiaGPU != nil && fi.ValueOf(spec.Containerd.NvidiaGPU.Enabled) {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("containerd", "nvidiaGPU"), "Nvidia GPU support is not available with CRI-O"))
+	}
+
+	if kopsmodel.IsBaseURL(spec.KubernetesVersion) {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("kubernetesVersion"), "side-loading Kubernetes images is not supported with CRI-O"))
+	}
+
+	for i, hook := range spec.Hooks {
+		if hook.ExecContainer != nil {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("hooks").Index(i).Child("execContainer"), "execContainer hooks are not supported with CRI-O"))
+		}
+	}
+
+	// CRI-O minor versions track the Kubernetes minor version they support
+	if spec.CRIO != nil && spec.CRIO.Version != nil {
+		crioVersion, err := semver.ParseTolerant(*spec.CRIO.Version)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("crio", "version"), *spec.CRIO.Version, fmt.Sprintf("unable to parse version string: %v", err)))
+		} else if k8sVersion, err := util.ParseKubernetesVersion(spec.KubernetesVersion); err == nil {
+			if crioVersion.Major != k8sVersion.Major || crioVersion.Minor != k8sVersion.Minor {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Child("crio", "version"), *spec.CRIO.Version,
+					fmt.Sprintf("must match the Kubernetes minor version %d.%d", k8sVersion.Major, k8sVersion.Minor)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func validateContainerdConfig(cluster *kops.Cluster, config *kops.ContainerdConfig, fldPath *field.Path, inClusterConfig bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1940,6 +2709,10 @@ func validateContainerdConfig(cluster *kops.Cluster, config *kops.ContainerdConf
 		}
 	}
 
+	for name, registry := range config.Registries {
+		allErrs = append(allErrs, validateContainerdRegistryConfig(name, registry, fldPath.Child("registries").Key(name))...)
+	}
+
 	if config.NvidiaGPU != nil {
 		allErrs = append(allErrs, validateNvidiaConfig(cluster, config.NvidiaGPU, fldPath.Child("nvidia"), inClusterConfig)...)
 	}
@@ -1951,6 +2724,18 @@ func validateContainerdConfig(cluster *kops.Cluster, config *kops.ContainerdConf
 	return allErrs
 }
 
+func validateContainerdRegistryConfig(name string, registry kops.ContainerdRegistryConfig, fldPath *field.Path) (allErrs field.ErrorList) {
+	if name == "" || name == "*" || strings.Contains(name, "/") {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, "must be a registry host, with an optional port"))
+	}
+	if registry.CA != "" {
+		if _, err := certutil.ParseCertsPEM([]byte(registry.CA)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ca"), "...", fmt.Sprintf("unable to parse certificates: %v", err)))
+		}
+	}
+	return allErrs
+}
+
 func validateNriConfig(containerd *kops.ContainerdConfig, fldPath *field.Path) (allErrs field.ErrorList) {
 	if containerd.NRI.Enabled == nil || !fi.ValueOf(containerd.NRI.Enabled) {
 		return allErrs
@@ -2060,6 +2845,10 @@ func validateClusterAutoscaler(cluster *kops.Cluster, spec *kops.ClusterAutoscal
 		allErrs = append(allErrs, field.Forbidden(fldPath, "Cluster autoscaler is not supported on OpenStack"))
 	}
 
+	if fi.ValueOf(spec.NodeGroupAutoDiscovery) && cluster.GetCloudProvider() != kops.CloudProviderAWS {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodeGroupAutoDiscovery"), "Cluster autoscaler node group auto-discovery is only supported on AWS"))
+	}
+
 	return allErrs
 }
 
@@ -2086,11 +2875,37 @@ func validateMetricsServer(cluster *kops.Cluster, spec *kops.MetricsServerConfig
 		if !fi.ValueOf(spec.Insecure) && !components.IsCertManagerEnabled(cluster) {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("insecure"), "Secure metrics server requires that cert manager is enabled"))
 		}
+		allErrs = append(allErrs, validateAddonVersion(spec.Version, fldPath.Child("version"))...)
+	}
+
+	return allErrs
+}
+
+func validateVerticalPodAutoscaler(cluster *kops.Cluster, spec *kops.VerticalPodAutoscalerConfig, fldPath *field.Path) (allErrs field.ErrorList) {
+	if fi.ValueOf(spec.Enabled) {
+		if !components.IsCertManagerEnabled(cluster) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "Vertical Pod Autoscaler requires that cert manager is enabled"))
+		}
+		if cluster.Spec.MetricsServer == nil || !fi.ValueOf(cluster.Spec.MetricsServer.Enabled) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "Vertical Pod Autoscaler requires that metrics server is enabled"))
+		}
+		allErrs = append(allErrs, validateAddonVersion(spec.Version, fldPath.Child("version"))...)
 	}
 
 	return allErrs
 }
 
+// validateAddonVersion checks that a pinned addon version is a semantic version, as used in the image tags of the addon.
+func validateAddonVersion(version *string, fldPath *field.Path) (allErrs field.ErrorList) {
+	if version == nil {
+		return nil
+	}
+	if _, err := semver.ParseTolerant(*version); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, *version, "must be a semantic version"))
+	}
+	return allErrs
+}
+
 func validateNodeTerminationHandler(cluster *kops.Cluster, spec *kops.NodeTerminationHandlerSpec, fldPath *field.Path) (allErrs field.ErrorList) {
 	if (spec.Enabled == nil || *spec.Enabled) && cluster.Spec.Karpenter != nil && cluster.Spec.Karpenter.Enabled {
 		allErrs = append(allErrs, field.Forbidden(fldPath, "nodeTerminationHandler cannot be used in conjunction with Karpenter"))
@@ -2139,6 +2954,112 @@ func validateWarmPool(warmPool *kops.WarmPoolSpec, fldPath *field.Path) (allErrs
 	if warmPool.MinSize < 0 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("minSize"), warmPool.MinSize, "warm pool minSize cannot be negative"))
 	}
+	if warmPool.PoolState != "" {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("poolState"), &warmPool.PoolState, kops.SupportedWarmPoolStates)...)
+	}
+	return allErrs
+}
+
+func validateInterruptionHandler(cluster *kops.Cluster, spec *kops.InterruptionHandlerConfig, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.DrainTimeout != nil && spec.DrainTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("drainTimeout"), spec.DrainTimeout.Duration.String(), "must be positive"))
+	}
+	if !spec.IsEnabled() {
+		return allErrs
+	}
+	switch cluster.GetCloudProvider() {
+	case kops.CloudProviderAWS:
+		if nth := cluster.Spec.CloudProvider.AWS.NodeTerminationHandler; nth != nil && fi.ValueOf(nth.Enabled) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "interruption handler cannot be used in conjunction with node termination handler"))
+		}
+	case kops.CloudProviderGCE, kops.CloudProviderAzure:
+	default:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "interruption handler supports only AWS, GCE and Azure"))
+	}
+	return allErrs
+}
+
+func validateKubeletCredentialProviders(providers []kops.KubeletCredentialProviderSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	names := sets.New[string]()
+	for i, provider := range providers {
+		fieldPath := fldPath.Index(i)
+
+		if provider.Name == "" {
+			allErrs = append(allErrs, field.Required(fieldPath.Child("name"), ""))
+		} else if strings.ContainsAny(provider.Name, "/\\") || provider.Name == "." || provider.Name == ".." {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("name"), provider.Name, "must be a file name"))
+		} else if names.Has(provider.Name) {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Child("name"), provider.Name))
+		}
+		names.Insert(provider.Name)
+
+		wellKnown := false
+		switch provider.Name {
+		case kops.KubeletCredentialProviderECR, kops.KubeletCredentialProviderGCP:
+			wellKnown = true
+		case kops.KubeletCredentialProviderACR:
+			// kOps knows the configuration of the ACR provider, but does not pin its binaries
+		default:
+			if len(provider.MatchImages) == 0 {
+				allErrs = append(allErrs, field.Required(fieldPath.Child("matchImages"), "matchImages must be set for providers not known to kOps"))
+			}
+		}
+
+		if provider.DefaultCacheDuration != nil && provider.DefaultCacheDuration.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("defaultCacheDuration"), provider.DefaultCacheDuration.Duration.String(), "must not be negative"))
+		}
+
+		for j, env := range provider.Env {
+			if env.Name == "" {
+				allErrs = append(allErrs, field.Required(fieldPath.Child("env").Index(j).Child("name"), ""))
+			}
+		}
+
+		packages := provider.Packages
+		if packages == nil {
+			packages = &kops.PackagesConfig{}
+		}
+		if !wellKnown && packages.UrlAmd64 == nil && packages.UrlArm64 == nil {
+			allErrs = append(allErrs, field.Required(fieldPath.Child("packages"), "the URL of the binary must be set for providers whose binaries are not known to kOps"))
+		}
+		allErrs = append(allErrs, validatePackageURL(packages.UrlAmd64, packages.HashAmd64, fieldPath.Child("packages", "urlAmd64"), fieldPath.Child("packages", "hashAmd64"))...)
+		allErrs = append(allErrs, validatePackageURL(packages.UrlArm64, packages.HashArm64, fieldPath.Child("packages", "urlArm64"), fieldPath.Child("packages", "hashArm64"))...)
+	}
+
+	return allErrs
+}
+
+// validatePackageURL checks that a package URL and its hash are set together.
+func validatePackageURL(u, h *string, urlPath, hashPath *field.Path) (allErrs field.ErrorList) {
+	if u == nil && h == nil {
+		return nil
+	}
+	if u == nil {
+		return append(allErrs, field.Required(urlPath, "the URL must be set with the hash"))
+	}
+	if h == nil {
+		return append(allErrs, field.Required(hashPath, "the hash must be set with the URL"))
+	}
+
+	if parsed, err := url.Parse(*u); err != nil || parsed.Scheme == "" || strings.HasSuffix(parsed.Path, "/") || path.Base(parsed.Path) == "." {
+		allErrs = append(allErrs, field.Invalid(urlPath, *u, "must be the URL of a file"))
+	}
+	if _, err := hashing.FromString(*h); err != nil {
+		allErrs = append(allErrs, field.Invalid(hashPath, *h, fmt.Sprintf("cannot parse hash: %v", err)))
+	}
+	return allErrs
+}
+
+func validateGracefulNodeDrain(cluster *kops.Cluster, spec *kops.GracefulNodeDrainSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.DrainTimeout != nil {
+		// The drain timeout, plus some slack, is used as the heartbeat timeout of the lifecycle hook, which is at most 2h.
+		if spec.DrainTimeout.Duration <= 0 || spec.DrainTimeout.Duration > time.Hour {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("drainTimeout"), spec.DrainTimeout.Duration.String(), "must be positive and at most 1h"))
+		}
+	}
+	if spec.IsEnabled() && cluster.Spec.CloudProvider.AWS.NodeTerminationHandler.IsQueueMode() {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enabled"), "graceful node drain cannot be used in conjunction with node termination handler in Queue Processor mode"))
+	}
 	return allErrs
 }
 
@@ -2151,6 +3072,169 @@ func validateSnapshotController(cluster *kops.Cluster, spec *kops.SnapshotContro
 	return allErrs
 }
 
+func validateNodeIdentityLabels(spec *kops.NodeIdentityLabelsSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	for i, metadata := range spec.Metadata {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("metadata").Index(i), &metadata, kops.SupportedNodeIdentityMetadata)...)
+	}
+	for tag, label := range spec.CloudTags {
+		for _, msg := range utilvalidation.IsQualifiedName(label) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cloudTags").Key(tag), label, msg))
+		}
+	}
+	return allErrs
+}
+
+func validateTagPolicy(cluster *kops.Cluster, spec *kops.TagPolicySpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	cloudLabelsPath := field.NewPath("spec", "cloudLabels")
+	keys := sets.New[string]()
+	for i := range spec.RequiredTags {
+		tag := &spec.RequiredTags[i]
+		tagPath := fldPath.Child("requiredTags").Index(i)
+
+		if tag.Key == "" {
+			allErrs = append(allErrs, field.Required(tagPath.Child("key"), ""))
+			continue
+		}
+		if keys.Has(tag.Key) {
+			allErrs = append(allErrs, field.Duplicate(tagPath.Child("key"), tag.Key))
+			continue
+		}
+		keys.Insert(tag.Key)
+
+		policyTags := make(map[string]string)
+		if tag.Value != "" {
+			value, err := tag.RenderValue(cluster)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(tagPath.Child("value"), tag.Value, err.Error()))
+				continue
+			}
+			policyTags[tag.Key] = value
+			if err := tag.CheckValue(value, policyTags); err != nil {
+				allErrs = append(allErrs, field.Invalid(tagPath.Child("value"), tag.Value, err.Error()))
+			}
+		}
+
+		if value, found := cluster.Spec.CloudLabels[tag.Key]; found {
+			if err := tag.CheckValue(value, policyTags); err != nil {
+				allErrs = append(allErrs, field.Invalid(cloudLabelsPath.Key(tag.Key), value, err.Error()))
+			}
+		} else if tag.Value == "" {
+			allErrs = append(allErrs, field.Required(cloudLabelsPath.Key(tag.Key), "tag is required by the tag policy"))
+		}
+	}
+	return allErrs
+}
+
+func validateMaintenanceWindow(spec *kops.MaintenanceWindowSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.Schedule == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("schedule"), ""))
+	} else if _, err := maintenancewindow.ParseSchedule(spec.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), spec.Schedule, err.Error()))
+	}
+	if spec.Duration == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("duration"), ""))
+	} else if spec.Duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), spec.Duration.Duration.String(), "must be positive"))
+	}
+	if spec.TimeZone != "" {
+		if _, err := time.LoadLocation(spec.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), spec.TimeZone, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+func validateHardening(spec *kops.HardeningSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.Profile != kops.HardeningProfileCIS {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("profile"), spec.Profile, []string{kops.HardeningProfileCIS}))
+	}
+	return allErrs
+}
+
+func validateSELinux(c *kops.Cluster, spec *kops.SELinuxSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	allErrs = append(allErrs, IsValidValue(fldPath.Child("mode"), &spec.Mode, []string{kops.SELinuxModeEnforcing, kops.SELinuxModePermissive})...)
+	if c.UsesCRIO() {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "SELinux can only be configured when containerRuntime is containerd"))
+	}
+	return allErrs
+}
+
+func validateKopsController(spec *kops.KopsControllerSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.TracesEndpoint != "" {
+		if u, err := url.Parse(spec.TracesEndpoint); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("tracesEndpoint"), spec.TracesEndpoint, "tracesEndpoint must be an http or https URL"))
+		}
+	}
+	if spec.ContinuousApply != nil && spec.ContinuousApply.Interval != nil && spec.ContinuousApply.Interval.Duration < time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("continuousApply", "interval"), spec.ContinuousApply.Interval.Duration.String(), "interval must be at least 1m"))
+	}
+	return allErrs
+}
+
+func validateTLS(spec *kops.TLSSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.MinVersion != "" {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("minVersion"), &spec.MinVersion, cliflag.TLSPossibleVersions())...)
+	}
+
+	// Kubernetes components accept the cipher suites of the Go release they are built with, including the TLS 1.3 ones,
+	// which etcd and kops-controller reject and Go does not allow configuring
+	tls13CipherSuites := sets.New[string]()
+	for _, cipherSuite := range tls.CipherSuites() {
+		if slices.Equal(cipherSuite.SupportedVersions, []uint16{tls.VersionTLS13}) {
+			tls13CipherSuites.Insert(cipherSuite.Name)
+		}
+	}
+	validCipherSuites := cliflag.TLSCipherPossibleValues()
+	for i, cipherSuite := range spec.CipherSuites {
+		if tls13CipherSuites.Has(cipherSuite) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("cipherSuites").Index(i), fmt.Sprintf("TLS 1.3 cipher suite %q is not configurable", cipherSuite)))
+		} else {
+			allErrs = append(allErrs, IsValidValue(fldPath.Child("cipherSuites").Index(i), &cipherSuite, validCipherSuites)...)
+		}
+	}
+
+	if spec.MinVersion == "VersionTLS13" && len(spec.CipherSuites) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("cipherSuites"), "cipher suites cannot be configured when the minimum TLS version is VersionTLS13"))
+	}
+
+	return allErrs
+}
+
+func validateFIPSMode(spec *kops.ClusterSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	// Kubernetes 1.33 is the first release built with a Go toolchain that includes the FIPS 140-3 module
+	if k8sVersion, err := util.ParseKubernetesVersion(spec.KubernetesVersion); err == nil && k8sVersion.LT(semver.MustParse("1.33.0")) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("fipsMode"), "FIPS mode requires kubernetesVersion 1.33 or later"))
+	}
+
+	validateTLS := func(cipherSuites []string, cipherSuitesPath *field.Path, minVersion string, minVersionPath *field.Path) {
+		for i, cipherSuite := range cipherSuites {
+			if !slices.Contains(kops.FIPSTLSCipherSuites, cipherSuite) {
+				allErrs = append(allErrs, field.Forbidden(cipherSuitesPath.Index(i), fmt.Sprintf("TLS cipher suite %q is not approved in FIPS mode", cipherSuite)))
+			}
+		}
+		if minVersion != "" && minVersion != "VersionTLS12" && minVersion != "VersionTLS13" {
+			allErrs = append(allErrs, field.Forbidden(minVersionPath, fmt.Sprintf("TLS version %q is not approved in FIPS mode", minVersion)))
+		}
+	}
+	if spec.TLS != nil {
+		validateTLS(spec.TLS.CipherSuites, fldPath.Child("tls", "cipherSuites"), spec.TLS.MinVersion, fldPath.Child("tls", "minVersion"))
+	}
+	if spec.Kubelet != nil {
+		validateTLS(spec.Kubelet.TLSCipherSuites, fldPath.Child("kubelet", "tlsCipherSuites"), spec.Kubelet.TLSMinVersion, fldPath.Child("kubelet", "tlsMinVersion"))
+	}
+	if spec.ControlPlaneKubelet != nil {
+		validateTLS(spec.ControlPlaneKubelet.TLSCipherSuites, fldPath.Child("controlPlaneKubelet", "tlsCipherSuites"), spec.ControlPlaneKubelet.TLSMinVersion, fldPath.Child("controlPlaneKubelet", "tlsMinVersion"))
+	}
+	if spec.KubeAPIServer != nil {
+		validateTLS(spec.KubeAPIServer.TLSCipherSuites, fldPath.Child("kubeAPIServer", "tlsCipherSuites"), spec.KubeAPIServer.TLSMinVersion, fldPath.Child("kubeAPIServer", "tlsMinVersion"))
+	}
+	if spec.KubeControllerManager != nil {
+		validateTLS(spec.KubeControllerManager.TLSCipherSuites, fldPath.Child("kubeControllerManager", "tlsCipherSuites"), spec.KubeControllerManager.TLSMinVersion, fldPath.Child("kubeControllerManager", "tlsMinVersion"))
+	}
+
+	return allErrs
+}
+
 func validatePodIdentityWebhook(cluster *kops.Cluster, spec *kops.PodIdentityWebhookSpec, fldPath *field.Path) (allErrs field.ErrorList) {
 	if spec != nil && spec.Enabled {
 		if !components.IsCertManagerEnabled(cluster) {
@@ -2161,6 +3245,23 @@ func validatePodIdentityWebhook(cluster *kops.Cluster, spec *kops.PodIdentityWeb
 	return allErrs
 }
 
+func validateCloudControllerManagerExternalPermissions(c *kops.Cluster, fldPath *field.Path) (allErrs field.ErrorList) {
+	said := c.Spec.ServiceAccountIssuerDiscovery
+	switch c.GetCloudProvider() {
+	case kops.CloudProviderAWS:
+		if said == nil || !said.EnableAWSOIDCProvider {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "the cloud controller manager requires an AWS OIDC provider to use external permissions on AWS"))
+		}
+	case kops.CloudProviderGCE:
+		if said == nil || !said.EnableGCPWorkloadIdentityFederation {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "the cloud controller manager requires GCP workload identity federation to use external permissions on GCE"))
+		}
+	default:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "the cloud controller manager only supports external permissions on AWS and GCE"))
+	}
+	return allErrs
+}
+
 func validateCertManager(cluster *kops.Cluster, spec *kops.CertManagerConfig, fldPath *field.Path) (allErrs field.ErrorList) {
 	if len(spec.HostedZoneIDs) > 0 {
 		if !fi.ValueOf(cluster.Spec.IAM.UseServiceAccountExternalPermissions) {
@@ -2169,3 +3270,85 @@ func validateCertManager(cluster *kops.Cluster, spec *kops.CertManagerConfig, fl
 	}
 	return allErrs
 }
+
+// maxKMSPreviousKeyIDs matches the health check ports reserved for the KMS plugin
+const maxKMSPreviousKeyIDs = 3
+
+var gcpKMSKeyRegex = regexp.MustCompile(`^projects/[^/]+/locations/[^/]+/keyRings/[^/]+/cryptoKeys/[^/]+$`)
+
+func validateKMSEncryption(spec *kops.ClusterSpec, c *kops.Cluster, fieldPath *field.Path) (allErrs field.ErrorList) {
+	kms := spec.KMSEncryption
+	fldPath := fieldPath.Child("kmsEncryption")
+
+	if fi.ValueOf(spec.EncryptionConfig) {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("encryptionConfig"), "encryptionConfig cannot be combined with kmsEncryption"))
+	}
+	if spec.KubeAPIServer != nil && (spec.KubeAPIServer.EncryptionProviderConfig != nil || spec.KubeAPIServer.ExperimentalEncryptionProviderConfig != nil) {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("kubeAPIServer", "encryptionProviderConfig"), "encryptionProviderConfig cannot be combined with kmsEncryption"))
+	}
+
+	switch kms.Provider {
+	case "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("provider"), ""))
+	case kops.KMSEncryptionProviderAWS:
+		if c.GetCloudProvider() != kops.CloudProviderAWS {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("provider"), "the aws provider is only supported on AWS"))
+		}
+	case kops.KMSEncryptionProviderGCP:
+		if c.GetCloudProvider() != kops.CloudProviderGCE {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("provider"), "the gcp provider is only supported on GCE"))
+		}
+		if kms.Image == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("image"), "an image is required for the gcp provider"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("provider"), kms.Provider, []string{kops.KMSEncryptionProviderAWS, kops.KMSEncryptionProviderGCP}))
+	}
+
+	if kms.KeyID == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("keyID"), ""))
+	} else {
+		allErrs = append(allErrs, validateKMSKeyID(kms.Provider, kms.KeyID, fldPath.Child("keyID"))...)
+	}
+
+	if len(kms.PreviousKeyIDs) > maxKMSPreviousKeyIDs {
+		allErrs = append(allErrs, field.TooMany(fldPath.Child("previousKeyIDs"), len(kms.PreviousKeyIDs), maxKMSPreviousKeyIDs))
+	}
+
+	keyIDs := sets.New(kms.KeyID)
+	for i, keyID := range kms.PreviousKeyIDs {
+		if keyIDs.Has(keyID) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("previousKeyIDs").Index(i), keyID))
+			continue
+		}
+		keyIDs.Insert(keyID)
+		allErrs = append(allErrs, validateKMSKeyID(kms.Provider, keyID, fldPath.Child("previousKeyIDs").Index(i))...)
+	}
+
+	resources := sets.New[string]()
+	for i, resource := range kms.Resources {
+		if resource == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("resources").Index(i), ""))
+		} else if resources.Has(resource) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("resources").Index(i), resource))
+		}
+		resources.Insert(resource)
+	}
+
+	return allErrs
+}
+
+func validateKMSKeyID(provider string, keyID string, fldPath *field.Path) (allErrs field.ErrorList) {
+	switch provider {
+	case kops.KMSEncryptionProviderAWS:
+		parsed, err := arn.Parse(keyID)
+		if err != nil || parsed.Service != "kms" || parsed.Region == "" || !(strings.HasPrefix(parsed.Resource, "key/") || strings.HasPrefix(parsed.Resource, "alias/")) {
+			allErrs = append(allErrs, field.Invalid(fldPath, keyID, "must be the ARN of a KMS key or alias"))
+		}
+	case kops.KMSEncryptionProviderGCP:
+		if !gcpKMSKeyRegex.MatchString(keyID) {
+			allErrs = append(allErrs, field.Invalid(fldPath, keyID, "must have the form projects/*/locations/*/keyRings/*/cryptoKeys/*"))
+		}
+	}
+	return allErrs
+}
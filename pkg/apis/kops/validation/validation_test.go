@@ -21,12 +21,14 @@ import (
 	"testing"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/featureflag"
 	"k8s.io/utils/ptr"
 )
 
@@ -187,6 +189,12 @@ func TestValidateSubnets(t *testing.T) {
 			},
 			ExpectedErrors: []string{"Invalid value::subnets[0].cidr"},
 		},
+		{
+			Input: []kops.ClusterSubnetSpec{
+				{Name: "a", ID: "a", PodRangeName: "pods", ServiceRangeName: "services", Type: kops.SubnetTypePublic},
+			},
+			ExpectedErrors: []string{"Forbidden::subnets[0].podRangeName", "Forbidden::subnets[0].serviceRangeName"},
+		},
 	}
 	for _, g := range grid {
 		cluster := &kops.Cluster{}
@@ -206,6 +214,42 @@ func TestValidateSubnets(t *testing.T) {
 	}
 }
 
+func TestValidateSubnetsEgressIPv6(t *testing.T) {
+	grid := []struct {
+		Input          []kops.ClusterSubnetSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: []kops.ClusterSubnetSpec{
+				{Name: "a", IPv6CIDR: "/64#1", Egress: "nat-123", Type: kops.SubnetTypePrivate},
+			},
+		},
+		{
+			Input: []kops.ClusterSubnetSpec{
+				{Name: "a", IPv6CIDR: "/64#1", Egress: "i-123", Type: kops.SubnetTypePrivate},
+			},
+			ExpectedErrors: []string{"Forbidden::subnets[0].egress"},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{}
+		cluster.Spec = kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				AWS: &kops.AWSSpec{},
+			},
+			Networking: kops.NetworkingSpec{
+				NetworkCIDR:       "10.0.0.0/8",
+				NonMasqueradeCIDR: "::/0",
+				Subnets:           g.Input,
+			},
+		}
+		_, ipNet, _ := net.ParseCIDR(cluster.Spec.Networking.NetworkCIDR)
+		errs := validateSubnets(cluster, cluster.Spec.Networking.Subnets, field.NewPath("subnets"), true, &cloudProviderConstraints{}, []*net.IPNet{ipNet}, nil, nil)
+
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
 func TestValidateKubeAPIServer(t *testing.T) {
 	str := "foobar"
 	authzMode := "RBAC,Webhook"
@@ -326,6 +370,261 @@ func TestValidateKubeAPIServer(t *testing.T) {
 			},
 			ExpectedErrors: []string{"Forbidden::KubeAPIServer.authenticationConfigFile"},
 		},
+		{
+			Input: kops.KubeAPIServerConfig{
+				AuditPolicy: &kops.AuditPolicySpec{
+					OmitStages: []string{"RequestReceived"},
+					Rules: []kops.AuditPolicyRule{
+						{Level: "None", NonResourceURLs: []string{"/healthz*", "/version"}},
+						{Level: "Metadata", Resources: []kops.AuditGroupResources{{Group: "", Resources: []string{"secrets"}}}},
+						{Level: "RequestResponse"},
+					},
+				},
+				AuditWebhook: &kops.AuditWebhookSpec{
+					Server:         "https://audit.example.com/events",
+					UseBearerToken: true,
+				},
+			},
+		},
+		{
+			Input: kops.KubeAPIServerConfig{
+				AuditPolicy: &kops.AuditPolicySpec{},
+			},
+			ExpectedErrors: []string{"Required value::KubeAPIServer.auditPolicy.rules"},
+		},
+		{
+			Input: kops.KubeAPIServerConfig{
+				AuditPolicy: &kops.AuditPolicySpec{
+					OmitStages: []string{"Bogus"},
+					Rules: []kops.AuditPolicyRule{
+						{},
+						{Level: "Everything"},
+						{Level: "None", Namespaces: []string{"kube-system"}, NonResourceURLs: []string{"/healthz"}},
+						{Level: "None", NonResourceURLs: []string{"healthz", "/a*/b"}},
+						{Level: "None", Resources: []kops.AuditGroupResources{{ResourceNames: []string{"foo"}}}},
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Unsupported value::KubeAPIServer.auditPolicy.omitStages[0]",
+				"Required value::KubeAPIServer.auditPolicy.rules[0].level",
+				"Unsupported value::KubeAPIServer.auditPolicy.rules[1].level",
+				"Invalid value::KubeAPIServer.auditPolicy.rules[2].nonResourceURLs",
+				"Invalid value::KubeAPIServer.auditPolicy.rules[3].nonResourceURLs[0]",
+				"Invalid value::KubeAPIServer.auditPolicy.rules[3].nonResourceURLs[1]",
+				"Invalid value::KubeAPIServer.auditPolicy.rules[4].resources[0].resourceNames",
+			},
+		},
+		{
+			Input: kops.KubeAPIServerConfig{
+				AuditPolicy:            &kops.AuditPolicySpec{Rules: []kops.AuditPolicyRule{{Level: "Metadata"}}},
+				AuditPolicyFile:        "/srv/kubernetes/audit/policy.yaml",
+				AuditWebhookConfigFile: "/srv/kubernetes/audit/webhook.yaml",
+			},
+			ExpectedErrors: []string{
+				"Forbidden::KubeAPIServer.auditPolicyFile",
+				"Forbidden::KubeAPIServer.auditWebhookConfigFile",
+			},
+		},
+		{
+			Input: kops.KubeAPIServerConfig{
+				AuditWebhook: &kops.AuditWebhookSpec{
+					Server:        "audit.example.com",
+					CACertificate: "not a certificate",
+				},
+			},
+			ExpectedErrors: []string{
+				"Required value::KubeAPIServer.auditPolicy",
+				"Invalid value::KubeAPIServer.auditWebhook.server",
+				"Invalid value::KubeAPIServer.auditWebhook.caCertificate",
+			},
+		},
+		{
+			Input: kops.KubeAPIServerConfig{
+				AuthorizationConfig: &kops.AuthorizationConfigSpec{
+					Authorizers: []kops.AuthorizerSpec{
+						{Type: "Node"},
+						{Type: "RBAC"},
+						{
+							Type: "Webhook",
+							Name: "policy",
+							Webhook: &kops.WebhookAuthorizerSpec{
+								Server:          "https://authz.example.com/authorize",
+								Timeout:         &metav1.Duration{Duration: 5 * time.Second},
+								FailurePolicy:   "Deny",
+								MatchConditions: []kops.AuthorizerMatchCondition{{Expression: "!('system:masters' in request.groups)"}},
+							},
+						},
+					},
+				},
+			},
+			Cluster: &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					Authorization: &kops.AuthorizationSpec{
+						RBAC: &kops.RBACAuthorizationSpec{},
+					},
+					KubernetesVersion: "1.35.0",
+					CloudProvider: kops.CloudProviderSpec{
+						AWS: &kops.AWSSpec{},
+					},
+				},
+			},
+		},
+		{
+			Input: kops.KubeAPIServerConfig{
+				AuthorizationMode:       new("Node,RBAC"),
+				AuthorizationConfigFile: "authz.yaml",
+				AuthorizationConfig: &kops.AuthorizationConfigSpec{
+					Authorizers: []kops.AuthorizerSpec{
+						{Type: "RBAC"},
+					},
+				},
+			},
+			Cluster: &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					Authorization: &kops.AuthorizationSpec{
+						RBAC: &kops.RBACAuthorizationSpec{},
+					},
+					KubernetesVersion: "1.35.0",
+					CloudProvider: kops.CloudProviderSpec{
+						AWS: &kops.AWSSpec{},
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Forbidden::KubeAPIServer.authorizationConfigFile",
+				"Forbidden::KubeAPIServer.authorizationMode",
+				"Invalid value::KubeAPIServer.authorizationConfigFile",
+				"Required value::KubeAPIServer.authorizationConfig.authorizers",
+			},
+			ExpectedDetail: "on AWS, the authorizers must include RBAC and Node",
+		},
+		{
+			Input: kops.KubeAPIServerConfig{
+				AuthorizationConfig: &kops.AuthorizationConfigSpec{
+					Authorizers: []kops.AuthorizerSpec{
+						{Type: "RBAC"},
+						{Type: "RBAC", Name: "other"},
+						{Type: "ABAC"},
+						{Type: "Node", Name: "rbac"},
+						{Type: "Webhook"},
+						{Type: "AlwaysAllow", Webhook: &kops.WebhookAuthorizerSpec{}},
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Duplicate value::KubeAPIServer.authorizationConfig.authorizers[1].type",
+				"Unsupported value::KubeAPIServer.authorizationConfig.authorizers[2].type",
+				"Duplicate value::KubeAPIServer.authorizationConfig.authorizers[3].name",
+				"Required value::KubeAPIServer.authorizationConfig.authorizers[4].webhook",
+				"Forbidden::KubeAPIServer.authorizationConfig.authorizers[5].webhook",
+			},
+		},
+		{
+			Input: kops.KubeAPIServerConfig{
+				AuthorizationConfig: &kops.AuthorizationConfigSpec{
+					Authorizers: []kops.AuthorizerSpec{
+						{
+							Type: "Webhook",
+							Webhook: &kops.WebhookAuthorizerSpec{
+								Server:          "https://authz.example.com",
+								KubeConfigFile:  "authz-kubeconfig.yaml",
+								CACertificate:   "not a certificate",
+								Timeout:         &metav1.Duration{Duration: time.Minute},
+								AuthorizedTTL:   &metav1.Duration{Duration: -time.Second},
+								FailurePolicy:   "Allow",
+								MatchConditions: []kops.AuthorizerMatchCondition{{Expression: "true"}, {Expression: "true"}, {}},
+							},
+						},
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Forbidden::KubeAPIServer.authorizationConfig.authorizers[0].webhook.kubeConfigFile",
+				"Forbidden::KubeAPIServer.authorizationConfig.authorizers[0].webhook.caCertificate",
+				"Invalid value::KubeAPIServer.authorizationConfig.authorizers[0].webhook.kubeConfigFile",
+				"Invalid value::KubeAPIServer.authorizationConfig.authorizers[0].webhook.caCertificate",
+				"Invalid value::KubeAPIServer.authorizationConfig.authorizers[0].webhook.timeout",
+				"Invalid value::KubeAPIServer.authorizationConfig.authorizers[0].webhook.authorizedTTL",
+				"Unsupported value::KubeAPIServer.authorizationConfig.authorizers[0].webhook.failurePolicy",
+				"Duplicate value::KubeAPIServer.authorizationConfig.authorizers[0].webhook.matchConditions[1].expression",
+				"Required value::KubeAPIServer.authorizationConfig.authorizers[0].webhook.matchConditions[2].expression",
+			},
+		},
+		{
+			Input: kops.KubeAPIServerConfig{
+				AdmissionConfig: &kops.AdmissionConfigSpec{
+					EventRateLimit: &kops.EventRateLimitAdmissionSpec{
+						Limits: []kops.EventRateLimit{
+							{Type: "Server", QPS: 50, Burst: 100},
+							{Type: "Namespace", QPS: 10, Burst: 50, CacheSize: 2000},
+						},
+					},
+					PodSecurity: &kops.PodSecurityAdmissionSpec{
+						Defaults: kops.PodSecurityDefaults{
+							Enforce:        "baseline",
+							EnforceVersion: "v1.33",
+							Warn:           "restricted",
+							WarnVersion:    "latest",
+						},
+						Exemptions: kops.PodSecurityExemptions{
+							Namespaces: []string{"kube-system"},
+						},
+					},
+					ImagePolicyWebhook: &kops.ImagePolicyWebhookAdmissionSpec{
+						Server:  "https://images.example.com/review",
+						DenyTTL: &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+		},
+		{
+			Input: kops.KubeAPIServerConfig{
+				AdmissionControlConfigFile: "/etc/kubernetes/admission.yaml",
+				DisableAdmissionPlugins:    []string{"PodSecurity"},
+				AdmissionConfig: &kops.AdmissionConfigSpec{
+					EventRateLimit: &kops.EventRateLimitAdmissionSpec{},
+					PodSecurity: &kops.PodSecurityAdmissionSpec{
+						Defaults: kops.PodSecurityDefaults{
+							Enforce:      "strict",
+							AuditVersion: "1.33",
+						},
+					},
+					ImagePolicyWebhook: &kops.ImagePolicyWebhookAdmissionSpec{
+						AllowTTL: &metav1.Duration{Duration: -time.Second},
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Forbidden::KubeAPIServer.admissionControlConfigFile",
+				"Forbidden::KubeAPIServer.disableAdmissionPlugins",
+				"Required value::KubeAPIServer.admissionConfig.eventRateLimit.limits",
+				"Unsupported value::KubeAPIServer.admissionConfig.podSecurity.defaults.enforce",
+				"Invalid value::KubeAPIServer.admissionConfig.podSecurity.defaults.auditVersion",
+				"Required value::KubeAPIServer.admissionConfig.imagePolicyWebhook.server",
+				"Invalid value::KubeAPIServer.admissionConfig.imagePolicyWebhook.allowTTL",
+			},
+		},
+		{
+			Input: kops.KubeAPIServerConfig{
+				AdmissionConfig: &kops.AdmissionConfigSpec{
+					EventRateLimit: &kops.EventRateLimitAdmissionSpec{
+						Limits: []kops.EventRateLimit{
+							{Type: "User", QPS: 0, Burst: 10, CacheSize: -1},
+							{Type: "User", QPS: 5, Burst: 0},
+							{Type: "Pod", QPS: 5, Burst: 10},
+						},
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Invalid value::KubeAPIServer.admissionConfig.eventRateLimit.limits[0].qps",
+				"Invalid value::KubeAPIServer.admissionConfig.eventRateLimit.limits[0].cacheSize",
+				"Duplicate value::KubeAPIServer.admissionConfig.eventRateLimit.limits[1].type",
+				"Invalid value::KubeAPIServer.admissionConfig.eventRateLimit.limits[1].burst",
+				"Unsupported value::KubeAPIServer.admissionConfig.eventRateLimit.limits[2].type",
+			},
+		},
 	}
 	for _, g := range grid {
 		if g.Cluster == nil {
@@ -524,6 +823,59 @@ func Test_Validate_Networking_Kindnet(t *testing.T) {
 	}
 }
 
+func Test_Validate_HelmChart(t *testing.T) {
+	grid := []struct {
+		Input          kops.HelmChartSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.HelmChartSpec{
+				Repository: "https://helm.cilium.io",
+				Name:       "cilium",
+				Version:    "1.18.2",
+				Values:     "ipam:\n  mode: kubernetes\n",
+			},
+		},
+		{
+			Input: kops.HelmChartSpec{
+				Repository:  "oci://registry.example.com/charts",
+				Name:        "cni",
+				Version:     "v0.1.0",
+				ReleaseName: "my-cni",
+				Namespace:   "cni-system",
+			},
+		},
+		{
+			Input: kops.HelmChartSpec{},
+			ExpectedErrors: []string{
+				"Required value::helmChart.repository",
+				"Required value::helmChart.name",
+				"Required value::helmChart.version",
+			},
+		},
+		{
+			Input: kops.HelmChartSpec{
+				Repository:  "s3://bucket/charts",
+				Name:        "cni",
+				Version:     "latest",
+				ReleaseName: "My_CNI",
+				Values:      "- not a map",
+			},
+			ExpectedErrors: []string{
+				"Invalid value::helmChart.repository",
+				"Invalid value::helmChart.version",
+				"Invalid value::helmChart.releaseName",
+				"Invalid value::helmChart.values",
+			},
+		},
+	}
+
+	for _, g := range grid {
+		errs := validateHelmChart(&g.Input, field.NewPath("helmChart"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
 func Test_Validate_Networking_OverlappingCIDR(t *testing.T) {
 	grid := []struct {
 		Name           string
@@ -721,6 +1073,47 @@ func Test_Validate_Addons(t *testing.T) {
 			Input:          []kops.AddonSpec{{Manifest: "file:///etc/kubernetes/kops/config/addons/extra.yaml"}},
 			ExpectedErrors: []string{"Invalid value::spec.addons[0].manifest"},
 		},
+		{
+			Input: []kops.AddonSpec{
+				{Name: "my-tool", Version: "1.2.0", Manifest: "s3://somebucket/my-tool.yaml"},
+				{Name: "other-tool.example.com", Manifest: "oci://registry.example.com/addons/other-tool:v1"},
+			},
+		},
+		{
+			Input:          []kops.AddonSpec{{Manifest: "s3://somebucket/example.yaml", Version: "1.0.0"}},
+			ExpectedErrors: []string{"Forbidden::spec.addons[0].version"},
+		},
+		{
+			Input:          []kops.AddonSpec{{Manifest: "oci://registry.example.com/addons/example:v1"}},
+			ExpectedErrors: []string{"Forbidden::spec.addons[0].manifest"},
+		},
+		{
+			Input:          []kops.AddonSpec{{Name: "my-tool"}},
+			ExpectedErrors: []string{"Required value::spec.addons[0].manifest"},
+		},
+		{
+			Input: []kops.AddonSpec{
+				{Name: "my-tool", Manifest: "s3://somebucket/my-tool.yaml"},
+				{Name: "my-tool", Manifest: "s3://somebucket/my-other-tool.yaml"},
+			},
+			ExpectedErrors: []string{"Duplicate value::spec.addons[1].name"},
+		},
+		{
+			Input:          []kops.AddonSpec{{Name: "My_Tool", Manifest: "s3://somebucket/my-tool.yaml"}},
+			ExpectedErrors: []string{"Invalid value::spec.addons[0].name"},
+		},
+		{
+			Input:          []kops.AddonSpec{{Name: "coredns.addons.k8s.io", Manifest: "s3://somebucket/coredns.yaml"}},
+			ExpectedErrors: []string{"Invalid value::spec.addons[0].name"},
+		},
+		{
+			Input:          []kops.AddonSpec{{Name: "my-tool", Version: "1.0/2", Manifest: "s3://somebucket/my-tool.yaml"}},
+			ExpectedErrors: []string{"Invalid value::spec.addons[0].version"},
+		},
+		{
+			Input:          []kops.AddonSpec{{Name: "my-tool", Manifest: "oci://Registry.example.com/UPPER:v1"}},
+			ExpectedErrors: []string{"Invalid value::spec.addons[0].manifest"},
+		},
 	}
 	for _, g := range grid {
 		clusterSpec := &kops.ClusterSpec{
@@ -1327,6 +1720,70 @@ func Test_Validate_Cilium(t *testing.T) {
 				BPFLBSockHostNSOnly: true,
 			},
 		},
+		{
+			Cilium: kops.CiliumNetworkingSpec{
+				Hubble: &kops.HubbleSpec{
+					Enabled: new(true),
+					Relay: &kops.HubbleRelaySpec{
+						Enabled: new(false),
+					},
+					UI: &kops.HubbleUISpec{
+						Enabled: new(true),
+					},
+				},
+			},
+			ExpectedErrors: []string{"Forbidden::cilium.hubble.ui.enabled"},
+		},
+		{
+			Cilium: kops.CiliumNetworkingSpec{
+				Hubble: &kops.HubbleSpec{
+					Enabled: new(true),
+					UI: &kops.HubbleUISpec{
+						Enabled: new(true),
+					},
+				},
+			},
+		},
+		{
+			Cilium: kops.CiliumNetworkingSpec{
+				ClusterName: "east",
+				ClusterID:   1,
+				ClusterMesh: &kops.CiliumClusterMeshSpec{
+					Clusters: []kops.CiliumClusterMeshPeerSpec{
+						{Name: "west", Address: "west.mesh.example.com", Port: 2379},
+					},
+				},
+			},
+		},
+		{
+			Cilium: kops.CiliumNetworkingSpec{
+				ClusterMesh: &kops.CiliumClusterMeshSpec{
+					Clusters: []kops.CiliumClusterMeshPeerSpec{
+						{Name: "west", Address: "west.mesh.example.com", Port: 2379},
+					},
+				},
+			},
+			ExpectedErrors: []string{"Required value::cilium.clusterName", "Required value::cilium.clusterID"},
+		},
+		{
+			Cilium: kops.CiliumNetworkingSpec{
+				ClusterName: "east",
+				ClusterID:   1,
+				ClusterMesh: &kops.CiliumClusterMeshSpec{
+					Clusters: []kops.CiliumClusterMeshPeerSpec{
+						{Name: "west", Address: "west.mesh.example.com", Port: 2379},
+						{Name: "west", Address: "", Port: 65536},
+						{Name: "east", Address: "east.mesh.example.com", Port: 2379},
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Duplicate value::cilium.clusterMesh.clusters[1].name",
+				"Required value::cilium.clusterMesh.clusters[1].address",
+				"Invalid value::cilium.clusterMesh.clusters[1].port",
+				"Invalid value::cilium.clusterMesh.clusters[2].name",
+			},
+		},
 	}
 	for _, g := range grid {
 		g.Spec.Networking.Cilium = &g.Cilium
@@ -1973,16 +2430,114 @@ func Test_Validate_NriConfig(t *testing.T) {
 	}
 }
 
-func newLinodeClusterForNetworkingValidation(networking kops.NetworkingSpec) *kops.Cluster {
-	return &kops.Cluster{
-		Spec: kops.ClusterSpec{
-			CloudProvider: kops.CloudProviderSpec{
-				Linode: &kops.LinodeSpec{},
+func Test_Validate_ContainerdRegistries(t *testing.T) {
+	caCertificate := "-----BEGIN CERTIFICATE-----\nMIIBZzCCARGgAwIBAgIBAjANBgkqhkiG9w0BAQsFADAaMRgwFgYDVQQDEw9zZXJ2\naWNlLWFjY291bnQwHhcNMjEwNTAyMjAzMDA2WhcNMzEwNTAyMjAzMDA2WjAaMRgw\nFgYDVQQDEw9zZXJ2aWNlLWFjY291bnQwXDANBgkqhkiG9w0BAQEFAANLADBIAkEA\n2JbeF8dNwqfEKKD65aGlVs58fWkA0qZdVLKw8qATzRBJTi1nqbj2kAR4gyy/C8Mx\nouxva/om9d7Sq8Ka55T7+wIDAQABo0IwQDAOBgNVHQ8BAf8EBAMCAQYwDwYDVR0T\nAQH/BAUwAwEB/zAdBgNVHQ4EFgQUI5beFHueAGyT1pQ6UTOdbMfj3gQwDQYJKoZI\nhvcNAQELBQADQQBwPLO+Np8o6k3aNBGKE4JTCOs06X72OXNivkWWWP/9XGz6x4DI\nHPU65kbUn/pWXBUVVlpsKsdmWA2Bu8pd/vD+\n-----END CERTIFICATE-----\n"
+	grid := []struct {
+		name           string
+		registries     map[string]kops.ContainerdRegistryConfig
+		expectedErrors []string
+	}{
+		{
+			name: "valid",
+			registries: map[string]kops.ContainerdRegistryConfig{
+				"registry.example.com:5000": {CA: caCertificate, UseDockerConfigAuth: true},
+				"mirror.example.com":        {SkipVerify: true},
 			},
-			Networking: networking,
+		},
+		{
+			name: "wildcard",
+			registries: map[string]kops.ContainerdRegistryConfig{
+				"*": {SkipVerify: true},
+			},
+			expectedErrors: []string{"Invalid value::containerd.registries[*]"},
+		},
+		{
+			name: "url",
+			registries: map[string]kops.ContainerdRegistryConfig{
+				"https://registry.example.com": {SkipVerify: true},
+			},
+			expectedErrors: []string{"Invalid value::containerd.registries[https://registry.example.com]"},
+		},
+		{
+			name: "invalid ca",
+			registries: map[string]kops.ContainerdRegistryConfig{
+				"registry.example.com": {CA: "not a certificate"},
+			},
+			expectedErrors: []string{"Invalid value::containerd.registries[registry.example.com].ca"},
 		},
 	}
-}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			containerd := &kops.ContainerdConfig{
+				Registries: g.registries,
+			}
+			errs := validateContainerdConfig(&kops.Cluster{}, containerd, field.NewPath("containerd"), true)
+			testErrors(t, g.name, errs, g.expectedErrors)
+		})
+	}
+}
+
+func Test_Validate_CRIO(t *testing.T) {
+	grid := []struct {
+		name           string
+		spec           kops.ClusterSpec
+		expectedErrors []string
+	}{
+		{
+			name: "valid",
+			spec: kops.ClusterSpec{
+				KubernetesVersion: "1.32.0",
+				CRIO:              &kops.CRIOConfig{Version: new("1.32.3")},
+				Networking:        kops.NetworkingSpec{Cilium: &kops.CiliumNetworkingSpec{}},
+			},
+		},
+		{
+			name: "kubenet",
+			spec: kops.ClusterSpec{
+				KubernetesVersion: "1.32.0",
+				Networking:        kops.NetworkingSpec{Kubenet: &kops.KubenetNetworkingSpec{}},
+			},
+			expectedErrors: []string{"Forbidden::spec.networking.kubenet"},
+		},
+		{
+			name: "version skew",
+			spec: kops.ClusterSpec{
+				KubernetesVersion: "1.32.0",
+				CRIO:              &kops.CRIOConfig{Version: new("1.31.0")},
+				Networking:        kops.NetworkingSpec{Cilium: &kops.CiliumNetworkingSpec{}},
+			},
+			expectedErrors: []string{"Invalid value::spec.crio.version"},
+		},
+		{
+			name: "exec container hook",
+			spec: kops.ClusterSpec{
+				KubernetesVersion: "1.32.0",
+				Networking:        kops.NetworkingSpec{Cilium: &kops.CiliumNetworkingSpec{}},
+				Hooks:             []kops.HookSpec{{ExecContainer: &kops.ExecContainerAction{Image: "busybox"}}},
+			},
+			expectedErrors: []string{"Forbidden::spec.hooks[0].execContainer"},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			g.spec.ContainerRuntime = kops.ContainerRuntimeCRIO
+			cluster := &kops.Cluster{Spec: g.spec}
+			errs := validateCRIO(cluster, field.NewPath("spec"))
+			testErrors(t, g.name, errs, g.expectedErrors)
+		})
+	}
+}
+
+func newLinodeClusterForNetworkingValidation(networking kops.NetworkingSpec) *kops.Cluster {
+	return &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				Linode: &kops.LinodeSpec{},
+			},
+			Networking: networking,
+		},
+	}
+}
 
 func validLinodeNetworkingSpec() kops.NetworkingSpec {
 	return kops.NetworkingSpec{
@@ -2053,6 +2608,222 @@ func TestValidateNetworkingLinode(t *testing.T) {
 	}
 }
 
+func TestValidateClusterAutoscalerNodeGroupAutoDiscovery(t *testing.T) {
+	grid := []struct {
+		desc          string
+		cloudProvider kops.CloudProviderSpec
+		expected      []string
+	}{
+		{
+			desc:          "AWS",
+			cloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+		},
+		{
+			desc:          "GCE",
+			cloudProvider: kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+			expected:      []string{"Forbidden::clusterAutoscaler.nodeGroupAutoDiscovery"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			cluster := &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider: g.cloudProvider,
+				},
+			}
+			spec := &kops.ClusterAutoscalerConfig{
+				Enabled:                new(true),
+				NodeGroupAutoDiscovery: new(true),
+			}
+			errs := validateClusterAutoscaler(cluster, spec, field.NewPath("clusterAutoscaler"))
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestValidateVerticalPodAutoscaler(t *testing.T) {
+	grid := []struct {
+		desc          string
+		certManager   *kops.CertManagerConfig
+		metricsServer *kops.MetricsServerConfig
+		version       *string
+		expected      []string
+	}{
+		{
+			desc:          "valid",
+			certManager:   &kops.CertManagerConfig{Enabled: new(true)},
+			metricsServer: &kops.MetricsServerConfig{Enabled: new(true)},
+			version:       new("1.4.1"),
+		},
+		{
+			desc:          "without cert manager",
+			metricsServer: &kops.MetricsServerConfig{Enabled: new(true)},
+			expected:      []string{"Forbidden::verticalPodAutoscaler"},
+		},
+		{
+			desc:        "without metrics server",
+			certManager: &kops.CertManagerConfig{Enabled: new(true)},
+			expected:    []string{"Forbidden::verticalPodAutoscaler"},
+		},
+		{
+			desc:          "invalid version",
+			certManager:   &kops.CertManagerConfig{Enabled: new(true)},
+			metricsServer: &kops.MetricsServerConfig{Enabled: new(true)},
+			version:       new("latest"),
+			expected:      []string{"Invalid value::verticalPodAutoscaler.version"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			cluster := &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CertManager:   g.certManager,
+					MetricsServer: g.metricsServer,
+				},
+			}
+			spec := &kops.VerticalPodAutoscalerConfig{
+				Enabled: new(true),
+				Version: g.version,
+			}
+			errs := validateVerticalPodAutoscaler(cluster, spec, field.NewPath("verticalPodAutoscaler"))
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestValidateInterruptionHandler(t *testing.T) {
+	grid := []struct {
+		desc          string
+		cloudProvider kops.CloudProviderSpec
+		spec          *kops.InterruptionHandlerConfig
+		expected      []string
+	}{
+		{
+			desc:          "aws",
+			cloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			spec:          &kops.InterruptionHandlerConfig{Enabled: new(true)},
+		},
+		{
+			desc:          "gce",
+			cloudProvider: kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+			spec:          &kops.InterruptionHandlerConfig{Enabled: new(true)},
+		},
+		{
+			desc:          "azure",
+			cloudProvider: kops.CloudProviderSpec{Azure: &kops.AzureSpec{}},
+			spec:          &kops.InterruptionHandlerConfig{Enabled: new(true)},
+		},
+		{
+			desc:          "hetzner",
+			cloudProvider: kops.CloudProviderSpec{Hetzner: &kops.HetznerSpec{}},
+			spec:          &kops.InterruptionHandlerConfig{Enabled: new(true)},
+			expected:      []string{"Forbidden::interruptionHandler"},
+		},
+		{
+			desc:          "disabled on hetzner",
+			cloudProvider: kops.CloudProviderSpec{Hetzner: &kops.HetznerSpec{}},
+			spec:          &kops.InterruptionHandlerConfig{Enabled: new(false)},
+		},
+		{
+			desc: "with node termination handler",
+			cloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{
+				NodeTerminationHandler: &kops.NodeTerminationHandlerSpec{Enabled: new(true)},
+			}},
+			spec:     &kops.InterruptionHandlerConfig{Enabled: new(true)},
+			expected: []string{"Forbidden::interruptionHandler"},
+		},
+		{
+			desc: "with disabled node termination handler",
+			cloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{
+				NodeTerminationHandler: &kops.NodeTerminationHandlerSpec{Enabled: new(false)},
+			}},
+			spec: &kops.InterruptionHandlerConfig{Enabled: new(true)},
+		},
+		{
+			desc:          "zero drain timeout",
+			cloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			spec:          &kops.InterruptionHandlerConfig{Enabled: new(true), DrainTimeout: &metav1.Duration{}},
+			expected:      []string{"Invalid value::interruptionHandler.drainTimeout"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			cluster := &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider:       g.cloudProvider,
+					InterruptionHandler: g.spec,
+				},
+			}
+			errs := validateInterruptionHandler(cluster, g.spec, field.NewPath("interruptionHandler"))
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestValidateGracefulNodeDrain(t *testing.T) {
+	grid := []struct {
+		desc     string
+		spec     *kops.GracefulNodeDrainSpec
+		nth      *kops.NodeTerminationHandlerSpec
+		expected []string
+	}{
+		{
+			desc: "enabled",
+			spec: &kops.GracefulNodeDrainSpec{Enabled: new(true)},
+		},
+		{
+			desc: "with drain timeout",
+			spec: &kops.GracefulNodeDrainSpec{Enabled: new(true), DrainTimeout: &metav1.Duration{Duration: 15 * time.Minute}},
+		},
+		{
+			desc:     "zero drain timeout",
+			spec:     &kops.GracefulNodeDrainSpec{Enabled: new(true), DrainTimeout: &metav1.Duration{}},
+			expected: []string{"Invalid value::gracefulNodeDrain.drainTimeout"},
+		},
+		{
+			desc:     "drain timeout too long",
+			spec:     &kops.GracefulNodeDrainSpec{Enabled: new(true), DrainTimeout: &metav1.Duration{Duration: 2 * time.Hour}},
+			expected: []string{"Invalid value::gracefulNodeDrain.drainTimeout"},
+		},
+		{
+			desc: "with node termination handler in IMDS mode",
+			spec: &kops.GracefulNodeDrainSpec{Enabled: new(true)},
+			nth:  &kops.NodeTerminationHandlerSpec{Enabled: new(true), EnableSQSTerminationDraining: new(false)},
+		},
+		{
+			desc:     "with node termination handler in queue processor mode",
+			spec:     &kops.GracefulNodeDrainSpec{Enabled: new(true)},
+			nth:      &kops.NodeTerminationHandlerSpec{Enabled: new(true)},
+			expected: []string{"Forbidden::gracefulNodeDrain.enabled"},
+		},
+		{
+			desc: "disabled with node termination handler in queue processor mode",
+			spec: &kops.GracefulNodeDrainSpec{Enabled: new(false)},
+			nth:  &kops.NodeTerminationHandlerSpec{Enabled: new(true)},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			cluster := &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider: kops.CloudProviderSpec{
+						AWS: &kops.AWSSpec{
+							NodeTerminationHandler: g.nth,
+							GracefulNodeDrain:      g.spec,
+						},
+					},
+				},
+			}
+			errs := validateGracefulNodeDrain(cluster, g.spec, field.NewPath("gracefulNodeDrain"))
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
 func TestValidateAzureBlobAccountUniformity(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -2245,3 +3016,909 @@ func TestValidateFileRepository(t *testing.T) {
 		testErrors(t, g.Input, errs, g.ExpectedErrors)
 	}
 }
+
+func TestValidateAssetDownloads(t *testing.T) {
+	grid := []struct {
+		Input          kops.AssetDownloadsSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.AssetDownloadsSpec{
+				Concurrency:    new(int32(8)),
+				BandwidthLimit: new(resource.MustParse("20Mi")),
+			},
+		},
+		{
+			Input: kops.AssetDownloadsSpec{
+				Concurrency: new(int32(0)),
+			},
+			ExpectedErrors: []string{"Invalid value::spec.assets.nodeDownloads.concurrency"},
+		},
+		{
+			Input: kops.AssetDownloadsSpec{
+				BandwidthLimit: new(resource.MustParse("0")),
+			},
+			ExpectedErrors: []string{"Invalid value::spec.assets.nodeDownloads.bandwidthLimit"},
+		},
+		{
+			Input: kops.AssetDownloadsSpec{
+				CacheDirectory: "/mnt/assets",
+			},
+		},
+		{
+			Input: kops.AssetDownloadsSpec{
+				CacheDirectory: "mnt/assets",
+			},
+			ExpectedErrors: []string{"Invalid value::spec.assets.nodeDownloads.cacheDirectory"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateAssetDownloads(&g.Input, field.NewPath("spec", "assets", "nodeDownloads"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidateEtcdBackupS3(t *testing.T) {
+	grid := []struct {
+		Input          kops.EtcdBackupSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.EtcdBackupSpec{
+				BackupStore: "s3://backups/etcd/main",
+				S3:          &kops.EtcdBackupS3Spec{Endpoint: "https://s3.eu-west-1.amazonaws.com", Region: "eu-west-1"},
+			},
+		},
+		{
+			Input: kops.EtcdBackupSpec{
+				S3: &kops.EtcdBackupS3Spec{Endpoint: "https://s3.eu-west-1.amazonaws.com"},
+			},
+			ExpectedErrors: []string{"Required value::backups.backupStore"},
+		},
+		{
+			Input: kops.EtcdBackupSpec{
+				BackupStore: "gs://backups/etcd/main",
+				S3:          &kops.EtcdBackupS3Spec{Endpoint: "https://s3.eu-west-1.amazonaws.com"},
+			},
+			ExpectedErrors: []string{"Invalid value::backups.backupStore"},
+		},
+		{
+			Input: kops.EtcdBackupSpec{
+				BackupStore: "s3://backups/etcd/main",
+				S3:          &kops.EtcdBackupS3Spec{},
+			},
+			ExpectedErrors: []string{"Required value::backups.s3.endpoint"},
+		},
+		{
+			Input: kops.EtcdBackupSpec{
+				BackupStore: "s3://backups/etcd/main",
+				S3:          &kops.EtcdBackupS3Spec{Endpoint: "s3.eu-west-1.amazonaws.com"},
+			},
+			ExpectedErrors: []string{"Invalid value::backups.s3.endpoint"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateEtcdBackupS3(&g.Input, field.NewPath("backups"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidateEtcdExternal(t *testing.T) {
+	grid := []struct {
+		Input          kops.EtcdClusterSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.EtcdClusterSpec{
+				Name:     "main",
+				External: &kops.EtcdExternalSpec{Endpoints: []string{"https://etcd-a.example.com:2379", "https://etcd-b.example.com:2379"}},
+			},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				Name:     "main",
+				External: &kops.EtcdExternalSpec{},
+			},
+			ExpectedErrors: []string{"Required value::etcdClusters[0].external.endpoints"},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				Name:     "events",
+				External: &kops.EtcdExternalSpec{Endpoints: []string{"http://etcd-a.example.com:2379", "https://etcd-b.example.com:2379/prefix"}},
+			},
+			ExpectedErrors: []string{
+				"Invalid value::etcdClusters[0].external.endpoints[0]",
+				"Invalid value::etcdClusters[0].external.endpoints[1]",
+			},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				Name: "main",
+				Members: []kops.EtcdMemberSpec{
+					{Name: "a", InstanceGroup: new("control-plane-a")},
+				},
+				Backups:  &kops.EtcdBackupSpec{BackupStore: "s3://backups/etcd/main"},
+				External: &kops.EtcdExternalSpec{Endpoints: []string{"https://etcd-a.example.com:2379"}},
+			},
+			ExpectedErrors: []string{
+				"Forbidden::etcdClusters[0].etcdMembers",
+				"Forbidden::etcdClusters[0].backups",
+			},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				Name:     "cilium",
+				External: &kops.EtcdExternalSpec{Endpoints: []string{"https://etcd-a.example.com:2379"}},
+			},
+			ExpectedErrors: []string{"Forbidden::etcdClusters[0].external"},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				Name: "main",
+				External: &kops.EtcdExternalSpec{
+					Endpoints:     []string{"https://etcd-a.example.com:2379"},
+					CACertificate: "not a certificate",
+				},
+			},
+			ExpectedErrors: []string{"Invalid value::etcdClusters[0].external.caCertificate"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateEtcdExternal(g.Input, field.NewPath("etcdClusters").Index(0))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidateEtcdManagerSpec(t *testing.T) {
+	grid := []struct {
+		Input          kops.EtcdClusterSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.EtcdClusterSpec{
+				Manager: &kops.EtcdManagerSpec{
+					QuotaBackendBytes:       new(resource.MustParse("8Gi")),
+					AutoCompactionMode:      "periodic",
+					AutoCompactionRetention: "8h",
+					DefragInterval:          &metav1.Duration{Duration: 24 * time.Hour},
+				},
+			},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				Manager: &kops.EtcdManagerSpec{
+					AutoCompactionMode:      "revision",
+					AutoCompactionRetention: "10000",
+				},
+			},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				Manager: &kops.EtcdManagerSpec{
+					AutoCompactionRetention: "1",
+				},
+			},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				Members: []kops.EtcdMemberSpec{
+					{Name: "a", VolumeSize: new(int32(20))},
+					{Name: "b", VolumeSize: new(int32(4))},
+				},
+				Manager: &kops.EtcdManagerSpec{
+					QuotaBackendBytes: new(resource.MustParse("8Gi")),
+				},
+			},
+			ExpectedErrors: []string{"Invalid value::etcdClusters[0].manager.quotaBackendBytes"},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				Manager: &kops.EtcdManagerSpec{
+					QuotaBackendBytes: new(resource.MustParse("0")),
+				},
+			},
+			ExpectedErrors: []string{"Invalid value::etcdClusters[0].manager.quotaBackendBytes"},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				Manager: &kops.EtcdManagerSpec{
+					AutoCompactionMode:      "hourly",
+					AutoCompactionRetention: "1h",
+				},
+			},
+			ExpectedErrors: []string{"Unsupported value::etcdClusters[0].manager.autoCompactionMode"},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				Manager: &kops.EtcdManagerSpec{
+					AutoCompactionMode:      "revision",
+					AutoCompactionRetention: "1h",
+				},
+			},
+			ExpectedErrors: []string{"Invalid value::etcdClusters[0].manager.autoCompactionRetention"},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				Manager: &kops.EtcdManagerSpec{
+					DefragInterval: &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+			ExpectedErrors: []string{"Invalid value::etcdClusters[0].manager.defragInterval"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateEtcdManagerSpec(g.Input, field.NewPath("etcdClusters").Index(0).Child("manager"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidateNodeIdentityLabels(t *testing.T) {
+	grid := []struct {
+		Input          kops.NodeIdentityLabelsSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.NodeIdentityLabelsSpec{
+				Metadata:  []string{"region", "zone", "lifecycle", "placementGroup"},
+				CloudTags: map[string]string{"CostCenter": "example.com/cost-center"},
+			},
+		},
+		{
+			Input: kops.NodeIdentityLabelsSpec{
+				Metadata: []string{"instanceType"},
+			},
+			ExpectedErrors: []string{"Unsupported value::nodeIdentityLabels.metadata[0]"},
+		},
+		{
+			Input: kops.NodeIdentityLabelsSpec{
+				CloudTags: map[string]string{"CostCenter": "cost center"},
+			},
+			ExpectedErrors: []string{"Invalid value::nodeIdentityLabels.cloudTags[CostCenter]"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateNodeIdentityLabels(&g.Input, field.NewPath("nodeIdentityLabels"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidateTagPolicy(t *testing.T) {
+	grid := []struct {
+		CloudLabels    map[string]string
+		RequiredTags   []kops.RequiredTagSpec
+		ExpectedErrors []string
+	}{
+		{
+			CloudLabels: map[string]string{"team": "platform", "env": "prod"},
+			RequiredTags: []kops.RequiredTagSpec{
+				{Key: "owner", Value: "{{ .CloudLabels.team }}"},
+				{Key: "env", AllowedValues: []string{"dev", "prod"}},
+			},
+		},
+		{
+			CloudLabels: map[string]string{"owner": "platform"},
+			RequiredTags: []kops.RequiredTagSpec{
+				{Key: "owner", Value: "platform"},
+			},
+		},
+		{
+			RequiredTags: []kops.RequiredTagSpec{
+				{Key: "env"},
+			},
+			ExpectedErrors: []string{"Required value::spec.cloudLabels[env]"},
+		},
+		{
+			CloudLabels: map[string]string{"env": "staging", "owner": "someone"},
+			RequiredTags: []kops.RequiredTagSpec{
+				{Key: "env", AllowedValues: []string{"dev", "prod"}},
+				{Key: "owner", Value: "platform"},
+			},
+			ExpectedErrors: []string{
+				"Invalid value::spec.cloudLabels[env]",
+				"Invalid value::spec.cloudLabels[owner]",
+			},
+		},
+		{
+			RequiredTags: []kops.RequiredTagSpec{
+				{Key: "owner", Value: "{{ .CloudLabels.team }}"},
+				{Key: "team", Value: "{{ .Team"},
+			},
+			ExpectedErrors: []string{
+				"Invalid value::tagPolicy.requiredTags[0].value",
+				"Invalid value::tagPolicy.requiredTags[1].value",
+			},
+		},
+		{
+			RequiredTags: []kops.RequiredTagSpec{
+				{Value: "platform"},
+				{Key: "owner", Value: "platform"},
+				{Key: "owner", Value: "platform"},
+			},
+			ExpectedErrors: []string{
+				"Required value::tagPolicy.requiredTags[0].key",
+				"Duplicate value::tagPolicy.requiredTags[2].key",
+			},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{
+			Spec: kops.ClusterSpec{
+				CloudLabels: g.CloudLabels,
+				TagPolicy:   &kops.TagPolicySpec{RequiredTags: g.RequiredTags},
+			},
+		}
+		errs := validateTagPolicy(cluster, cluster.Spec.TagPolicy, field.NewPath("tagPolicy"))
+		testErrors(t, g.RequiredTags, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidateKubeletCredentialProviders(t *testing.T) {
+	hash := "0000000000000000000000000000000000000000000000000000000000000000"
+	grid := []struct {
+		desc      string
+		providers []kops.KubeletCredentialProviderSpec
+		expected  []string
+	}{
+		{
+			desc: "well-known providers",
+			providers: []kops.KubeletCredentialProviderSpec{
+				{Name: kops.KubeletCredentialProviderECR},
+				{Name: kops.KubeletCredentialProviderGCP},
+			},
+		},
+		{
+			desc: "acr without packages",
+			providers: []kops.KubeletCredentialProviderSpec{
+				{Name: kops.KubeletCredentialProviderACR},
+			},
+			expected: []string{"Required value::kubeletCredentialProviders[0].packages"},
+		},
+		{
+			desc: "acr with packages",
+			providers: []kops.KubeletCredentialProviderSpec{
+				{
+					Name: kops.KubeletCredentialProviderACR,
+					Packages: &kops.PackagesConfig{
+						UrlAmd64:  new("https://example.com/azure-acr-credential-provider-linux-amd64"),
+						HashAmd64: new(hash),
+					},
+				},
+			},
+		},
+		{
+			desc: "custom provider",
+			providers: []kops.KubeletCredentialProviderSpec{
+				{
+					Name:        "custom-credential-provider",
+					MatchImages: []string{"registry.example.com"},
+					Env:         []kops.EnvVar{{Name: "REGION", Value: "us-test-1"}},
+					Packages: &kops.PackagesConfig{
+						UrlAmd64:  new("https://example.com/amd64/custom-credential-provider"),
+						HashAmd64: new(hash),
+						UrlArm64:  new("https://example.com/arm64/custom-credential-provider"),
+						HashArm64: new(hash),
+					},
+				},
+			},
+		},
+		{
+			desc: "custom provider without match images",
+			providers: []kops.KubeletCredentialProviderSpec{
+				{
+					Name: "custom-credential-provider",
+					Packages: &kops.PackagesConfig{
+						UrlAmd64:  new("https://example.com/custom-credential-provider"),
+						HashAmd64: new(hash),
+					},
+				},
+			},
+			expected: []string{"Required value::kubeletCredentialProviders[0].matchImages"},
+		},
+		{
+			desc: "url without hash",
+			providers: []kops.KubeletCredentialProviderSpec{
+				{
+					Name: kops.KubeletCredentialProviderECR,
+					Packages: &kops.PackagesConfig{
+						UrlArm64: new("https://example.com/ecr-credential-provider-linux-arm64"),
+					},
+				},
+			},
+			expected: []string{"Required value::kubeletCredentialProviders[0].packages.hashArm64"},
+		},
+		{
+			desc: "invalid url and hash",
+			providers: []kops.KubeletCredentialProviderSpec{
+				{
+					Name: kops.KubeletCredentialProviderECR,
+					Packages: &kops.PackagesConfig{
+						UrlAmd64:  new("https://example.com/binaries/"),
+						HashAmd64: new("abc"),
+					},
+				},
+			},
+			expected: []string{
+				"Invalid value::kubeletCredentialProviders[0].packages.urlAmd64",
+				"Invalid value::kubeletCredentialProviders[0].packages.hashAmd64",
+			},
+		},
+		{
+			desc: "invalid and duplicate names",
+			providers: []kops.KubeletCredentialProviderSpec{
+				{Name: kops.KubeletCredentialProviderECR},
+				{Name: kops.KubeletCredentialProviderECR},
+				{Name: "../ecr-credential-provider"},
+				{Name: kops.KubeletCredentialProviderGCP, Env: []kops.EnvVar{{Value: "value"}}},
+			},
+			expected: []string{
+				"Duplicate value::kubeletCredentialProviders[1].name",
+				"Invalid value::kubeletCredentialProviders[2].name",
+				"Required value::kubeletCredentialProviders[2].matchImages",
+				"Required value::kubeletCredentialProviders[2].packages",
+				"Required value::kubeletCredentialProviders[3].env[0].name",
+			},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			errs := validateKubeletCredentialProviders(g.providers, field.NewPath("kubeletCredentialProviders"))
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestValidateKMSEncryption(t *testing.T) {
+	awsKey := "arn:aws:kms:us-test-1:123456789012:key/11111111-2222-3333-4444-555555555555"
+	gcpKey := "projects/my-project/locations/global/keyRings/kops/cryptoKeys/etcd"
+	grid := []struct {
+		desc     string
+		spec     kops.ClusterSpec
+		expected []string
+	}{
+		{
+			desc: "aws",
+			spec: kops.ClusterSpec{
+				CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+				KMSEncryption: &kops.KMSEncryptionSpec{
+					Provider:       kops.KMSEncryptionProviderAWS,
+					KeyID:          awsKey,
+					PreviousKeyIDs: []string{"arn:aws:kms:us-test-1:123456789012:alias/previous"},
+					Resources:      []string{"secrets", "configmaps"},
+				},
+			},
+		},
+		{
+			desc: "gcp",
+			spec: kops.ClusterSpec{
+				CloudProvider: kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+				KMSEncryption: &kops.KMSEncryptionSpec{
+					Provider: kops.KMSEncryptionProviderGCP,
+					KeyID:    gcpKey,
+					Image:    "registry.example.com/k8s-cloudkms-plugin:v1",
+				},
+			},
+		},
+		{
+			desc: "missing fields",
+			spec: kops.ClusterSpec{
+				CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+				KMSEncryption: &kops.KMSEncryptionSpec{},
+			},
+			expected: []string{
+				"Required value::spec.kmsEncryption.provider",
+				"Required value::spec.kmsEncryption.keyID",
+			},
+		},
+		{
+			desc: "provider for another cloud",
+			spec: kops.ClusterSpec{
+				CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+				KMSEncryption: &kops.KMSEncryptionSpec{
+					Provider: kops.KMSEncryptionProviderGCP,
+					KeyID:    gcpKey,
+				},
+			},
+			expected: []string{
+				"Forbidden::spec.kmsEncryption.provider",
+				"Required value::spec.kmsEncryption.image",
+			},
+		},
+		{
+			desc: "invalid keys",
+			spec: kops.ClusterSpec{
+				CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+				KMSEncryption: &kops.KMSEncryptionSpec{
+					Provider:       kops.KMSEncryptionProviderAWS,
+					KeyID:          "11111111-2222-3333-4444-555555555555",
+					PreviousKeyIDs: []string{"11111111-2222-3333-4444-555555555555", "arn:aws:s3:::bucket"},
+					Resources:      []string{"secrets", "secrets"},
+				},
+			},
+			expected: []string{
+				"Invalid value::spec.kmsEncryption.keyID",
+				"Duplicate value::spec.kmsEncryption.previousKeyIDs[0]",
+				"Invalid value::spec.kmsEncryption.previousKeyIDs[1]",
+				"Duplicate value::spec.kmsEncryption.resources[1]",
+			},
+		},
+		{
+			desc: "combined with encryptionConfig",
+			spec: kops.ClusterSpec{
+				CloudProvider:    kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+				EncryptionConfig: new(true),
+				KubeAPIServer: &kops.KubeAPIServerConfig{
+					EncryptionProviderConfig: new("/srv/kubernetes/encryptionconfig.yaml"),
+				},
+				KMSEncryption: &kops.KMSEncryptionSpec{
+					Provider: kops.KMSEncryptionProviderAWS,
+					KeyID:    awsKey,
+				},
+			},
+			expected: []string{
+				"Forbidden::spec.encryptionConfig",
+				"Forbidden::spec.kubeAPIServer.encryptionProviderConfig",
+			},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			cluster := &kops.Cluster{Spec: g.spec}
+			errs := validateKMSEncryption(&cluster.Spec, cluster, field.NewPath("spec"))
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestValidatePodSecurityAdmission(t *testing.T) {
+	grid := []struct {
+		desc     string
+		spec     kops.ClusterSpec
+		expected []string
+	}{
+		{
+			desc: "valid",
+			spec: kops.ClusterSpec{
+				KubernetesVersion: "1.34.2",
+				PodSecurityAdmission: &kops.PodSecurityAdmissionSpec{
+					Defaults: kops.PodSecurityDefaults{
+						Enforce:        "baseline",
+						EnforceVersion: "v1.34",
+						Audit:          "restricted",
+						AuditVersion:   "latest",
+						Warn:           "restricted",
+					},
+					Exemptions: kops.PodSecurityExemptions{
+						Namespaces: []string{"monitoring"},
+					},
+				},
+			},
+		},
+		{
+			desc: "invalid levels and versions",
+			spec: kops.ClusterSpec{
+				KubernetesVersion: "1.34.2",
+				PodSecurityAdmission: &kops.PodSecurityAdmissionSpec{
+					Defaults: kops.PodSecurityDefaults{
+						Enforce:        "strict",
+						EnforceVersion: "v1.35",
+						WarnVersion:    "1.33",
+					},
+				},
+			},
+			expected: []string{
+				"Unsupported value::spec.podSecurityAdmission.defaults.enforce",
+				"Invalid value::spec.podSecurityAdmission.defaults.enforceVersion",
+				"Invalid value::spec.podSecurityAdmission.defaults.warnVersion",
+			},
+		},
+		{
+			desc: "conflicting apiserver settings",
+			spec: kops.ClusterSpec{
+				KubernetesVersion: "1.34.2",
+				PodSecurityAdmission: &kops.PodSecurityAdmissionSpec{
+					Defaults: kops.PodSecurityDefaults{
+						Enforce: "baseline",
+					},
+				},
+				KubeAPIServer: &kops.KubeAPIServerConfig{
+					AdmissionControlConfigFile: "/etc/kubernetes/admission.yaml",
+					DisableAdmissionPlugins:    []string{"PodSecurity"},
+					AdmissionConfig: &kops.AdmissionConfigSpec{
+						PodSecurity: &kops.PodSecurityAdmissionSpec{},
+					},
+				},
+			},
+			expected: []string{
+				"Forbidden::spec.kubeAPIServer.admissionConfig.podSecurity",
+				"Forbidden::spec.kubeAPIServer.admissionControlConfigFile",
+				"Forbidden::spec.kubeAPIServer.disableAdmissionPlugins",
+			},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			cluster := &kops.Cluster{Spec: g.spec}
+			errs := validateClusterPodSecurityAdmission(&cluster.Spec, cluster, field.NewPath("spec"))
+			testErrors(t, g.desc, errs, g.expected)
+		})
+	}
+}
+
+func TestValidateMaintenanceWindow(t *testing.T) {
+	grid := []struct {
+		Input          kops.MaintenanceWindowSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.MaintenanceWindowSpec{
+				Schedule: "0 22 * * 1-5",
+				Duration: &metav1.Duration{Duration: 4 * time.Hour},
+				TimeZone: "Europe/Berlin",
+			},
+		},
+		{
+			Input: kops.MaintenanceWindowSpec{},
+			ExpectedErrors: []string{
+				"Required value::maintenanceWindows[0].schedule",
+				"Required value::maintenanceWindows[0].duration",
+			},
+		},
+		{
+			Input: kops.MaintenanceWindowSpec{
+				Schedule: "0 25 * * *",
+				Duration: &metav1.Duration{Duration: -time.Hour},
+				TimeZone: "Mars/Olympus_Mons",
+			},
+			ExpectedErrors: []string{
+				"Invalid value::maintenanceWindows[0].schedule",
+				"Invalid value::maintenanceWindows[0].duration",
+				"Invalid value::maintenanceWindows[0].timeZone",
+			},
+		},
+	}
+	for _, g := range grid {
+		errs := validateMaintenanceWindow(&g.Input, field.NewPath("maintenanceWindows").Index(0))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidateHardening(t *testing.T) {
+	grid := []struct {
+		Input          kops.HardeningSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.HardeningSpec{Profile: kops.HardeningProfileCIS},
+		},
+		{
+			Input:          kops.HardeningSpec{},
+			ExpectedErrors: []string{"Unsupported value::hardening.profile"},
+		},
+		{
+			Input:          kops.HardeningSpec{Profile: "stig"},
+			ExpectedErrors: []string{"Unsupported value::hardening.profile"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateHardening(&g.Input, field.NewPath("hardening"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidateSELinux(t *testing.T) {
+	grid := []struct {
+		Input            kops.SELinuxSpec
+		ContainerRuntime string
+		ExpectedErrors   []string
+	}{
+		{
+			Input: kops.SELinuxSpec{Mode: kops.SELinuxModeEnforcing},
+		},
+		{
+			Input: kops.SELinuxSpec{Mode: kops.SELinuxModePermissive},
+		},
+		{
+			Input:          kops.SELinuxSpec{Mode: "enforcing"},
+			ExpectedErrors: []string{"Unsupported value::selinux.mode"},
+		},
+		{
+			Input:            kops.SELinuxSpec{Mode: kops.SELinuxModeEnforcing},
+			ContainerRuntime: "crio",
+			ExpectedErrors:   []string{"Forbidden::selinux"},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{Spec: kops.ClusterSpec{ContainerRuntime: g.ContainerRuntime}}
+		errs := validateSELinux(cluster, &g.Input, field.NewPath("selinux"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidateFIPSMode(t *testing.T) {
+	grid := []struct {
+		Input          kops.ClusterSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.ClusterSpec{
+				KubernetesVersion: "1.34.0",
+				KubeAPIServer: &kops.KubeAPIServerConfig{
+					TLSCipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+					TLSMinVersion:   "VersionTLS13",
+				},
+			},
+		},
+		{
+			Input:          kops.ClusterSpec{KubernetesVersion: "1.32.5"},
+			ExpectedErrors: []string{"Forbidden::spec.fipsMode"},
+		},
+		{
+			Input: kops.ClusterSpec{
+				KubernetesVersion: "1.34.0",
+				Kubelet: &kops.KubeletConfigSpec{
+					TLSCipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305"},
+				},
+			},
+			ExpectedErrors: []string{"Forbidden::spec.kubelet.tlsCipherSuites[1]"},
+		},
+		{
+			Input: kops.ClusterSpec{
+				KubernetesVersion:     "1.34.0",
+				KubeControllerManager: &kops.KubeControllerManagerConfig{TLSMinVersion: "VersionTLS11"},
+			},
+			ExpectedErrors: []string{"Forbidden::spec.kubeControllerManager.tlsMinVersion"},
+		},
+		{
+			Input: kops.ClusterSpec{
+				KubernetesVersion: "1.34.0",
+				TLS:               &kops.TLSSpec{CipherSuites: []string{"TLS_RSA_WITH_AES_128_CBC_SHA"}},
+			},
+			ExpectedErrors: []string{"Forbidden::spec.tls.cipherSuites[0]"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateFIPSMode(&g.Input, field.NewPath("spec"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidateTLS(t *testing.T) {
+	grid := []struct {
+		Input          kops.TLSSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.TLSSpec{
+				MinVersion:   "VersionTLS12",
+				CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305"},
+			},
+		},
+		{
+			Input: kops.TLSSpec{MinVersion: "VersionTLS13"},
+		},
+		{
+			Input:          kops.TLSSpec{MinVersion: "TLS1.2"},
+			ExpectedErrors: []string{"Unsupported value::tls.minVersion"},
+		},
+		{
+			Input:          kops.TLSSpec{CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "ECDHE-RSA-AES128-GCM-SHA256"}},
+			ExpectedErrors: []string{"Unsupported value::tls.cipherSuites[1]"},
+		},
+		{
+			Input:          kops.TLSSpec{CipherSuites: []string{"TLS_AES_128_GCM_SHA256"}},
+			ExpectedErrors: []string{"Forbidden::tls.cipherSuites[0]"},
+		},
+		{
+			Input: kops.TLSSpec{
+				MinVersion:   "VersionTLS13",
+				CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+			},
+			ExpectedErrors: []string{"Forbidden::tls.cipherSuites"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateTLS(&g.Input, field.NewPath("tls"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidateKopsController(t *testing.T) {
+	grid := []struct {
+		Input          kops.KopsControllerSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.KopsControllerSpec{TracesEndpoint: "http://otel-collector.example.com:4318"},
+		},
+		{
+			Input:          kops.KopsControllerSpec{TracesEndpoint: "otel-collector.example.com:4318"},
+			ExpectedErrors: []string{"Invalid value::kopsController.tracesEndpoint"},
+		},
+		{
+			Input: kops.KopsControllerSpec{ContinuousApply: &kops.ContinuousApplySpec{}},
+		},
+		{
+			Input: kops.KopsControllerSpec{ContinuousApply: &kops.ContinuousApplySpec{Interval: &metav1.Duration{Duration: 30 * time.Minute}}},
+		},
+		{
+			Input:          kops.KopsControllerSpec{ContinuousApply: &kops.ContinuousApplySpec{Interval: &metav1.Duration{Duration: 10 * time.Second}}},
+			ExpectedErrors: []string{"Invalid value::kopsController.continuousApply.interval"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateKopsController(&g.Input, field.NewPath("kopsController"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidateInstanceGroupScaleAPI(t *testing.T) {
+	featureflag.ParseFlags("+InstanceGroupScaleAPI")
+	defer featureflag.ParseFlags("-InstanceGroupScaleAPI")
+
+	grid := []struct {
+		Input          kops.CloudProviderSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+		},
+		{
+			Input:          kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+			ExpectedErrors: []string{"Forbidden::spec.cloudProvider"},
+		},
+		{
+			Input:          kops.CloudProviderSpec{Azure: &kops.AzureSpec{}},
+			ExpectedErrors: []string{"Forbidden::spec.cloudProvider"},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{Spec: kops.ClusterSpec{CloudProvider: g.Input}}
+		errs := validateInstanceGroupScaleAPI(cluster, field.NewPath("spec", "cloudProvider"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidateCloudControllerManagerExternalPermissions(t *testing.T) {
+	grid := []struct {
+		Input          kops.ClusterSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.ClusterSpec{
+				CloudProvider:                 kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+				ServiceAccountIssuerDiscovery: &kops.ServiceAccountIssuerDiscoveryConfig{EnableAWSOIDCProvider: true},
+			},
+		},
+		{
+			Input: kops.ClusterSpec{
+				CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			},
+			ExpectedErrors: []string{"Forbidden::spec.cloudControllerManager.useServiceAccountExternalPermissions"},
+		},
+		{
+			Input: kops.ClusterSpec{
+				CloudProvider:                 kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+				ServiceAccountIssuerDiscovery: &kops.ServiceAccountIssuerDiscoveryConfig{EnableGCPWorkloadIdentityFederation: true},
+			},
+		},
+		{
+			Input: kops.ClusterSpec{
+				CloudProvider:                 kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+				ServiceAccountIssuerDiscovery: &kops.ServiceAccountIssuerDiscoveryConfig{EnableAWSOIDCProvider: true},
+			},
+			ExpectedErrors: []string{"Forbidden::spec.cloudControllerManager.useServiceAccountExternalPermissions"},
+		},
+		{
+			Input: kops.ClusterSpec{
+				CloudProvider: kops.CloudProviderSpec{Openstack: &kops.OpenstackSpec{}},
+			},
+			ExpectedErrors: []string{"Forbidden::spec.cloudControllerManager.useServiceAccountExternalPermissions"},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{Spec: g.Input}
+		errs := validateCloudControllerManagerExternalPermissions(cluster, field.NewPath("spec", "cloudControllerManager", "useServiceAccountExternalPermissions"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
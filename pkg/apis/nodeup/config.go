@@ -32,6 +32,8 @@ type Config struct {
 	// Assets are locations where we can find files to be installed
 	// TODO: Remove once everything is in containers?
 	Assets map[architectures.Architecture][]string `json:",omitempty"`
+	// AssetDownloads configures how the assets are downloaded.
+	AssetDownloads *kops.AssetDownloadsSpec `json:"assetDownloads,omitempty"`
 	// Images are a list of images we should preload
 	Images map[architectures.Architecture][]*Image `json:"images,omitempty"`
 	// ClusterName is the name of the cluster
@@ -46,7 +48,7 @@ type Config struct {
 	// ConfigStore configures the stores that nodes use to get their configuration when they don't use kops-controller.
 	ConfigStore *kops.ConfigStoreSpec `json:"configStore,omitempty"`
 
-	// EtcdClusterNames are the names of the etcd clusters.
+	// EtcdClusterNames are the names of the etcd clusters run by etcd-manager.
 	EtcdClusterNames []string `json:",omitempty"`
 	// EtcdManifests are the manifests for running etcd.
 	EtcdManifests []string `json:"etcdManifests,omitempty"`
@@ -67,6 +69,9 @@ type Config struct {
 	StaticManifests []*StaticManifest `json:"staticManifests,omitempty"`
 	// KubeletConfig defines the kubelet configuration.
 	KubeletConfig kops.KubeletConfigSpec
+	// KubeletCredentialProviders are the image credential providers of the kubelet.
+	// If empty, the credential provider of the cloud provider is used.
+	KubeletCredentialProviders []kops.KubeletCredentialProviderSpec `json:"kubeletCredentialProviders,omitempty"`
 	// KubeProxy defines the kube-proxy configuration.
 	KubeProxy *kops.KubeProxyConfig
 	// Networking configures networking.
@@ -83,6 +88,18 @@ type Config struct {
 	ServiceNodePortRange string `json:",omitempty"`
 	// SysctlParameters will configure kernel parameters using sysctl(8).
 	SysctlParameters []string `json:",omitempty"`
+	// HardeningProfile is the security hardening profile applied to the node.
+	HardeningProfile string `json:",omitempty"`
+	// SELinuxMode is the SELinux mode of the node, if SELinux is managed by kOps.
+	SELinuxMode string `json:",omitempty"`
+	// FIPSMode restricts the node's Go binaries to FIPS 140-3 approved cryptography.
+	FIPSMode bool `json:",omitempty"`
+	// KopsControllerCAPins are the pins of the CAs that may issue kops-controller's serving certificate.
+	// When empty, any CA in CAs is accepted.
+	KopsControllerCAPins []string `json:",omitempty"`
+	// KopsControllerClientCertificate is true if the node requests a client certificate from kops-controller,
+	// which it presents when calling kops-controller again.
+	KopsControllerClientCertificate bool `json:",omitempty"`
 	// UpdatePolicy determines the policy for applying upgrades automatically.
 	UpdatePolicy string
 	// VolumeMounts are a collection of volume mounts.
@@ -94,6 +111,8 @@ type Config struct {
 	Hooks [][]kops.HookSpec
 	// ContainerdConfig holds the configuration for containerd.
 	ContainerdConfig *kops.ContainerdConfig `json:"containerdConfig,omitempty"`
+	// CRIOConfig holds the configuration for CRI-O, when it is used instead of containerd.
+	CRIOConfig *kops.CRIOConfig `json:"crioConfig,omitempty"`
 
 	// APIServerConfig is additional configuration for nodes running an APIServer.
 	APIServerConfig *APIServerConfig `json:",omitempty"`
@@ -123,6 +142,9 @@ type Config struct {
 	NodeIPFamilies []string `json:"nodeIPFamilies,omitempty"`
 	// WarmPoolImages are the container images to pre-pull during instance pre-initialization
 	WarmPoolImages []string `json:"warmPoolImages,omitempty"`
+	// WarmPoolState is the state of the warm pool, if instances keep running or hibernate in the warm pool
+	// and so do not reboot before entering service.
+	WarmPoolState string `json:"warmPoolState,omitempty"`
 
 	// Azure-specific
 	// AzureAdminUser is the admin user of VMs.
@@ -172,6 +194,8 @@ type BootConfig struct {
 	// EtcdIPs holds the address of the load balancer to use if Etcd is not local.
 	// This field is used for adding an alias for the *.etcd.internal. in /etc/hosts when etcd is not local
 	EtcdIPs []string `json:",omitempty"`
+	// TracesEndpoint is the OTLP/HTTP endpoint that nodeup exports traces to.
+	TracesEndpoint string `json:",omitempty"`
 }
 
 type ConfigServerOptions struct {
@@ -181,6 +205,9 @@ type ConfigServerOptions struct {
 	TLSServerName string `json:"tlsServerName,omitempty"`
 	// CACertificates are the certificates to trust for fi.CertificateIDCA.
 	CACertificates string
+	// CAPins are the pins of the CAs that may issue the configuration server's serving certificate.
+	// When empty, any CA in CACertificates is accepted.
+	CAPins []string `json:"caPins,omitempty"`
 }
 
 // Image is a container image we should pre-load
@@ -215,8 +242,17 @@ type APIServerConfig struct {
 	// It is empty if EncryptionConfig is not enabled.
 	// TODO: give secrets IDs and look them up like we do keypairs.
 	EncryptionConfigSecretHash string `json:",omitempty"`
+	// KMSEncryption is a copy of the KMSEncryptionSpec from the cluster spec.
+	KMSEncryption *kops.KMSEncryptionSpec `json:",omitempty"`
+	// PodSecurityAdmission is a copy of the PodSecurityAdmissionSpec from the cluster spec.
+	PodSecurityAdmission *kops.PodSecurityAdmissionSpec `json:",omitempty"`
+	// AuditWebhookTokenSecretHash is a hash of the auditwebhooktoken secret.
+	// It is empty unless the audit webhook uses a bearer token.
+	AuditWebhookTokenSecretHash string `json:",omitempty"`
 	// ServiceAccountPublicKeys are the service-account public keys to trust.
 	ServiceAccountPublicKeys string
+	// EtcdCACertificates are the CA certificates of external etcd clusters, trusted in addition to etcd-clients-ca.
+	EtcdCACertificates string `json:",omitempty"`
 }
 
 // ControlPlaneConfig is additional configuration for control-plane nodes.
@@ -251,6 +287,10 @@ func NewConfig(cluster *kops.Cluster, instanceGroup *kops.InstanceGroup) (*Confi
 		UsesNoneDNS:          cluster.UsesNoneDNS(),
 	}
 
+	if cluster.Spec.Assets != nil && cluster.Spec.Assets.NodeDownloads != nil {
+		config.AssetDownloads = cluster.Spec.Assets.NodeDownloads.DeepCopy()
+	}
+
 	if cluster.Spec.ServiceAccountIssuerDiscovery != nil && cluster.Spec.ServiceAccountIssuerDiscovery.DiscoveryService != nil {
 		discoveryService := cluster.Spec.ServiceAccountIssuerDiscovery.DiscoveryService
 		config.DiscoveryService = &DiscoveryServiceOptions{
@@ -265,10 +305,18 @@ func NewConfig(cluster *kops.Cluster, instanceGroup *kops.InstanceGroup) (*Confi
 		InstanceGroupRole: role,
 	}
 
+	if cluster.Spec.KopsController != nil {
+		bootConfig.TracesEndpoint = cluster.Spec.KopsController.TracesEndpoint
+	}
+
 	if cluster.Spec.Containerd != nil || instanceGroup.Spec.Containerd != nil {
 		config.ContainerdConfig = buildContainerdConfig(cluster, instanceGroup)
 	}
 
+	if cluster.UsesCRIO() && cluster.Spec.CRIO != nil {
+		config.CRIOConfig = cluster.Spec.CRIO.DeepCopy()
+	}
+
 	if (cluster.Spec.Containerd != nil && cluster.Spec.Containerd.NvidiaGPU != nil) || (instanceGroup.Spec.Containerd != nil && instanceGroup.Spec.Containerd.NvidiaGPU != nil) {
 		config.NvidiaGPU = buildNvidiaConfig(cluster, instanceGroup)
 	}
@@ -287,6 +335,9 @@ func NewConfig(cluster *kops.Cluster, instanceGroup *kops.InstanceGroup) (*Confi
 		if warmPool.IsEnabled() && warmPool.EnableLifecycleHook {
 			config.EnableLifecycleHook = true
 		}
+		if warmPool.KeepsInstancesWarm() {
+			config.WarmPoolState = warmPool.PoolState
+		}
 
 		if instanceGroup.HasAPIServer() {
 			config.DisableSecurityGroupIngress = aws.DisableSecurityGroupIngress
@@ -365,10 +416,16 @@ func NewConfig(cluster *kops.Cluster, instanceGroup *kops.InstanceGroup) (*Confi
 		config.KubeletConfig = *instanceGroup.Spec.Kubelet
 	}
 
+	for _, provider := range cluster.Spec.KubeletCredentialProviders {
+		config.KubeletCredentialProviders = append(config.KubeletCredentialProviders, *provider.DeepCopy())
+	}
+
 	if instanceGroup.HasAPIServer() {
 		config.APIServerConfig = &APIServerConfig{
-			ClusterDNSDomain: cluster.Spec.ClusterDNSDomain,
-			KubeAPIServer:    cluster.Spec.KubeAPIServer,
+			ClusterDNSDomain:     cluster.Spec.ClusterDNSDomain,
+			KubeAPIServer:        cluster.Spec.KubeAPIServer,
+			KMSEncryption:        cluster.Spec.KMSEncryption,
+			PodSecurityAdmission: cluster.Spec.PodSecurityAdmission,
 			API: kops.APISpec{
 				PublicName:     cluster.Spec.API.PublicName,
 				AdditionalSANs: cluster.Spec.API.AdditionalSANs,
@@ -389,6 +446,11 @@ func NewConfig(cluster *kops.Cluster, instanceGroup *kops.InstanceGroup) (*Confi
 		if cluster.Spec.API.LoadBalancer != nil && cluster.Spec.API.LoadBalancer.UseForInternalAPI {
 			config.APIServerConfig.API.LoadBalancer = &kops.LoadBalancerAccessSpec{UseForInternalAPI: true}
 		}
+		for _, etcdCluster := range cluster.Spec.EtcdClusters {
+			if etcdCluster.IsExternal() && etcdCluster.External.CACertificate != "" {
+				config.APIServerConfig.EtcdCACertificates += strings.TrimSpace(etcdCluster.External.CACertificate) + "\n"
+			}
+		}
 	}
 
 	if instanceGroup.HasAPIServer() || !model.UseKopsControllerForNodeConfig(cluster) {
@@ -431,6 +493,17 @@ func NewConfig(cluster *kops.Cluster, instanceGroup *kops.InstanceGroup) (*Confi
 		config.SysctlParameters = append(config.SysctlParameters, cluster.Spec.SysctlParameters...)
 	}
 
+	if cluster.Spec.Hardening != nil {
+		config.HardeningProfile = cluster.Spec.Hardening.Profile
+	}
+	if cluster.Spec.SELinux != nil {
+		config.SELinuxMode = cluster.Spec.SELinux.Mode
+	}
+	config.FIPSMode = aws.ToBool(cluster.Spec.FIPSMode)
+	if cluster.Spec.KopsController != nil {
+		config.KopsControllerClientCertificate = aws.ToBool(cluster.Spec.KopsController.RequireClientCertificates)
+	}
+
 	return &config, &bootConfig
 }
 
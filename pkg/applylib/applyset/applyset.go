@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
 )
 
 // ApplySet is a set of objects that we want to apply to the cluster.
@@ -46,6 +47,8 @@ type ApplySet struct {
 	restMapper meta.RESTMapper
 	// patchOptions holds the options used when applying, in particular the fieldManager
 	patchOptions metav1.PatchOptions
+	// forceOnConflict takes ownership of fields managed by other field managers, after logging the conflict.
+	forceOnConflict bool
 
 	// mutex guards trackers
 	mutex sync.Mutex
@@ -61,14 +64,18 @@ type Options struct {
 	RESTMapper meta.RESTMapper
 	// PatchOptions holds the options used when applying, in particular the fieldManager
 	PatchOptions metav1.PatchOptions
+	// ForceOnConflict retries an apply that conflicts with other field managers with force,
+	// so that the conflicting fields are reported before we take ownership of them.
+	ForceOnConflict bool
 }
 
 // New constructs a new ApplySet
 func New(options Options) (*ApplySet, error) {
 	a := &ApplySet{
-		client:       options.Client,
-		restMapper:   options.RESTMapper,
-		patchOptions: options.PatchOptions,
+		client:          options.Client,
+		restMapper:      options.RESTMapper,
+		patchOptions:    options.PatchOptions,
+		forceOnConflict: options.ForceOnConflict,
 	}
 	a.trackers = &objectTrackerList{}
 	return a, nil
@@ -146,6 +153,14 @@ func (a *ApplySet) ApplyOnce(ctx context.Context) (*ApplyResults, error) {
 		}
 
 		lastApplied, err := client.Patch(ctx, gvk, nn, types.ApplyPatchType, j, a.patchOptions)
+		if err != nil && apierrors.IsConflict(err) && a.forceOnConflict {
+			// The error lists the conflicting fields and their managers
+			klog.Warningf("taking ownership of fields of %s %s: %v", gvk.Kind, nn, err)
+			force := true
+			forceOptions := a.patchOptions
+			forceOptions.Force = &force
+			lastApplied, err = client.Patch(ctx, gvk, nn, types.ApplyPatchType, j, forceOptions)
+		}
 		if err != nil {
 			results.applyError(gvk, nn, fmt.Errorf("error from apply: %w", err))
 			continue
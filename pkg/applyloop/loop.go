@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applyloop
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/clusterlock"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+// Loop continuously applies the specs of a cluster: whenever they change in the state store,
+// and periodically even if they did not change, so that drift in the cloud is reverted.
+// Only the holder of the lease applies the specs, and the outcome is recorded as status conditions in the state store.
+type Loop struct {
+	// Lease coordinates the processes applying the cluster.
+	Lease *clusterlock.Lease
+	// StatusPath is where the status is written.
+	StatusPath vfs.Path
+
+	// Interval is how often unchanged specs are applied.
+	Interval time.Duration
+	// PollInterval is how often the state store is checked for spec changes.
+	PollInterval time.Duration
+
+	// SpecHash returns a hash of the current cluster and instance group specs.
+	SpecHash func(ctx context.Context) (string, error)
+	// Apply applies the current specs.
+	Apply func(ctx context.Context) error
+
+	status    *Status
+	lastApply time.Time
+
+	// now is the clock, replaced in tests
+	now func() time.Time
+}
+
+// NewLease builds the lease coordinating the processes continuously applying the cluster with the given config base.
+func NewLease(configBase vfs.Path, holderIdentity string, duration time.Duration) *clusterlock.Lease {
+	return clusterlock.NewLease(configBase.Join("reconcile", "lease.yaml"), holderIdentity, duration)
+}
+
+// Run runs the loop until the context is cancelled, then releases the lease.
+func (l *Loop) Run(ctx context.Context) error {
+	if l.now == nil {
+		l.now = time.Now
+	}
+
+	status, err := ReadStatus(ctx, l.StatusPath)
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		status = &Status{}
+	}
+	l.status = status
+
+	defer func() {
+		if err := l.Lease.Release(context.WithoutCancel(ctx)); err != nil {
+			klog.Warningf("error releasing lease: %v", err)
+		}
+	}()
+
+	ticker := time.NewTicker(l.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := l.reconcile(ctx); err != nil {
+			klog.Warningf("error reconciling cluster: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// reconcile applies the specs if we hold the lease, and they changed or the interval elapsed.
+func (l *Loop) reconcile(ctx context.Context) error {
+	held, err := l.Lease.TryAcquireOrRenew(ctx)
+	if err != nil {
+		return err
+	}
+	if !held {
+		klog.V(2).Infof("lease is held by another process, not applying")
+		// Whoever holds the lease may have applied in the meantime
+		l.lastApply = time.Time{}
+		return nil
+	}
+
+	hash, err := l.SpecHash(ctx)
+	if err != nil {
+		return err
+	}
+
+	reason := ReasonSpecChanged
+	if hash == l.status.ObservedSpecHash {
+		if !l.lastApply.IsZero() && l.now().Sub(l.lastApply) < l.Interval {
+			return nil
+		}
+		reason = ReasonResync
+	}
+
+	klog.Infof("applying cluster (%s)", reason)
+	l.setCondition(ConditionProgressing, metav1.ConditionTrue, reason, "Applying the cluster and instance group specs")
+	if err := l.writeStatus(ctx); err != nil {
+		return err
+	}
+
+	applyErr := l.applyWhileHoldingLease(ctx)
+
+	l.lastApply = l.now()
+	l.status.HolderIdentity = l.Lease.HolderIdentity()
+	l.status.ObservedSpecHash = hash
+	l.status.LastApplyTime = &metav1.Time{Time: l.lastApply}
+	l.setCondition(ConditionProgressing, metav1.ConditionFalse, ReasonIdle, "")
+	if applyErr != nil {
+		klog.Warningf("error applying cluster: %v", applyErr)
+		l.setCondition(ConditionReconciled, metav1.ConditionFalse, ReasonApplyFailed, applyErr.Error())
+	} else {
+		klog.Infof("applied cluster")
+		l.setCondition(ConditionReconciled, metav1.ConditionTrue, ReasonApplied, "")
+	}
+	return l.writeStatus(ctx)
+}
+
+// applyWhileHoldingLease applies the specs, renewing the lease meanwhile.
+// The apply is cancelled if the lease is lost.
+func (l *Loop) applyWhileHoldingLease(ctx context.Context) error {
+	applyCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	go func() {
+		ticker := time.NewTicker(l.Lease.Duration() / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-applyCtx.Done():
+				return
+			case <-ticker.C:
+			}
+			held, err := l.Lease.TryAcquireOrRenew(applyCtx)
+			if err != nil {
+				klog.Warningf("error renewing lease: %v", err)
+				continue
+			}
+			if !held {
+				cancel(fmt.Errorf("lost the lease"))
+				return
+			}
+		}
+	}()
+
+	if err := l.Apply(applyCtx); err != nil {
+		if cause := context.Cause(applyCtx); cause != nil && ctx.Err() == nil {
+			return fmt.Errorf("%w: %w", cause, err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (l *Loop) setCondition(conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&l.status.Conditions, metav1.Condition{
+		Type:    conditionType,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+func (l *Loop) writeStatus(ctx context.Context) error {
+	return WriteStatus(ctx, l.StatusPath, l.status)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applyloop
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func TestLoopReconcile(t *testing.T) {
+	ctx := context.Background()
+	base := vfs.NewMemFSPath(vfs.NewMemFSContext(), "/state/cluster")
+	clock := &fakeClock{t: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	hash := "v1"
+	var applyErr error
+	applies := 0
+
+	l := &Loop{
+		Lease:        NewLease(base, "a", time.Minute),
+		StatusPath:   StatusPath(base),
+		Interval:     10 * time.Minute,
+		PollInterval: 30 * time.Second,
+		SpecHash: func(ctx context.Context) (string, error) {
+			return hash, nil
+		},
+		Apply: func(ctx context.Context) error {
+			applies++
+			return applyErr
+		},
+		status: &Status{},
+		now:    clock.now,
+	}
+
+	expect := func(wantApplies int, wantReconciled metav1.ConditionStatus, wantReason string) {
+		t.Helper()
+		if err := l.reconcile(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if applies != wantApplies {
+			t.Fatalf("expected %d applies, got %d", wantApplies, applies)
+		}
+
+		status, err := ReadStatus(ctx, StatusPath(base))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if status.ObservedSpecHash != hash {
+			t.Errorf("expected observed hash %q, got %q", hash, status.ObservedSpecHash)
+		}
+		if !meta.IsStatusConditionFalse(status.Conditions, ConditionProgressing) {
+			t.Errorf("expected Progressing to be False, got %v", status.Conditions)
+		}
+		reconciled := meta.FindStatusCondition(status.Conditions, ConditionReconciled)
+		if reconciled == nil || reconciled.Status != wantReconciled || reconciled.Reason != wantReason {
+			t.Errorf("expected Reconciled %s/%s, got %v", wantReconciled, wantReason, reconciled)
+		}
+	}
+
+	// the first reconcile always applies
+	expect(1, metav1.ConditionTrue, ReasonApplied)
+
+	// unchanged specs are not applied again before the interval elapsed
+	clock.t = clock.t.Add(time.Minute)
+	expect(1, metav1.ConditionTrue, ReasonApplied)
+
+	// changed specs are applied immediately
+	hash = "v2"
+	applyErr = errors.New("apply failed")
+	expect(2, metav1.ConditionFalse, ReasonApplyFailed)
+
+	// unchanged specs are applied again once the interval elapsed
+	clock.t = clock.t.Add(10 * time.Minute)
+	applyErr = nil
+	expect(3, metav1.ConditionTrue, ReasonApplied)
+
+	// nothing is applied while another process holds the lease
+	if err := l.Lease.Release(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if held, err := NewLease(base, "b", time.Minute).TryAcquireOrRenew(ctx); err != nil || !held {
+		t.Fatalf("expected b to acquire the lease, got %v, %v", held, err)
+	}
+	hash = "v3"
+	if err := l.reconcile(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if applies != 3 {
+		t.Fatalf("expected no apply without the lease, got %d applies", applies)
+	}
+}
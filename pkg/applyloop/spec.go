@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applyloop
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/maintenancewindow"
+	"k8s.io/kops/pkg/predicates"
+)
+
+// SpecHash returns a hash of the specs of the cluster and its instance groups in the state store.
+func SpecHash(ctx context.Context, clientset simple.Clientset, clusterName string) (string, error) {
+	cluster, err := clientset.GetCluster(ctx, clusterName)
+	if err != nil {
+		return "", fmt.Errorf("error reading cluster %q: %w", clusterName, err)
+	}
+	igs, err := clientset.InstanceGroupsFor(cluster).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("error reading instance groups of cluster %q: %w", clusterName, err)
+	}
+
+	hasher := sha256.New()
+	encoder := json.NewEncoder(hasher)
+	if err := encoder.Encode(cluster.Spec); err != nil {
+		return "", fmt.Errorf("error serializing cluster spec: %w", err)
+	}
+	sort.Slice(igs.Items, func(i, j int) bool {
+		return igs.Items[i].ObjectMeta.Name < igs.Items[j].ObjectMeta.Name
+	})
+	for i := range igs.Items {
+		ig := &igs.Items[i]
+		if err := encoder.Encode(ig.ObjectMeta.Name); err != nil {
+			return "", fmt.Errorf("error serializing instance group name: %w", err)
+		}
+		if err := encoder.Encode(ig.Spec); err != nil {
+			return "", fmt.Errorf("error serializing instance group spec: %w", err)
+		}
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// MaintenanceWindowFilter returns a predicate that matches the instance groups that are inside their maintenance windows at now.
+// Changes to the other instance groups are deferred to a later apply.
+func MaintenanceWindowFilter(cluster *kops.Cluster, now time.Time) (predicates.Predicate[*kops.InstanceGroup], error) {
+	windows, err := maintenancewindow.ForCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return func(ig *kops.InstanceGroup) bool {
+		open, _ := maintenancewindow.Check(windows, ig.ObjectMeta.Name, now)
+		if !open {
+			klog.Infof("not applying changes to instance group %q, which is outside its maintenance windows", ig.ObjectMeta.Name)
+		}
+		return open
+	}, nil
+}
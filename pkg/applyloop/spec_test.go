@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applyloop
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func TestSpecHash(t *testing.T) {
+	ctx := context.Background()
+
+	vfsContext := vfs.NewVFSContext()
+	vfsContext.ResetMemfsContext(true)
+	basePath, err := vfsContext.BuildVfsPath("memfs://tests")
+	if err != nil {
+		t.Fatalf("error building vfspath: %v", err)
+	}
+	clientset := vfsclientset.NewVFSClientset(vfsContext, basePath)
+
+	cluster, err := clientset.CreateCluster(ctx, testutils.BuildMinimalClusterAWS("watch.example.com"))
+	if err != nil {
+		t.Fatalf("error creating cluster: %v", err)
+	}
+	ig := testutils.BuildMinimalNodeInstanceGroup("nodes", "subnet-us-test-1a")
+	if _, err := clientset.InstanceGroupsFor(cluster).Create(ctx, &ig, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error creating instance group: %v", err)
+	}
+
+	hash := func() string {
+		t.Helper()
+		h, err := SpecHash(ctx, clientset, "watch.example.com")
+		if err != nil {
+			t.Fatalf("error hashing cluster spec: %v", err)
+		}
+		return h
+	}
+
+	initial := hash()
+	if again := hash(); again != initial {
+		t.Fatalf("expected stable hash %q, got %q", initial, again)
+	}
+
+	created, err := clientset.InstanceGroupsFor(cluster).Get(ctx, "nodes", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("error getting instance group: %v", err)
+	}
+	created.Spec.MaxSize = new(int32(5))
+	if _, err := clientset.InstanceGroupsFor(cluster).Update(ctx, created, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("error updating instance group: %v", err)
+	}
+	if changed := hash(); changed == initial {
+		t.Fatalf("expected hash to change when an instance group spec changes")
+	}
+}
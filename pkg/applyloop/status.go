@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applyloop
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/util/pkg/vfs"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConditionProgressing is true while the specs are being applied.
+	ConditionProgressing = "Progressing"
+	// ConditionReconciled is true if the specs were last applied successfully.
+	ConditionReconciled = "Reconciled"
+
+	// ReasonSpecChanged is the reason for applying specs that changed in the state store.
+	ReasonSpecChanged = "SpecChanged"
+	// ReasonResync is the reason for periodically applying unchanged specs, to revert drift.
+	ReasonResync = "Resync"
+	// ReasonApplied is the reason of the Reconciled condition after a successful apply.
+	ReasonApplied = "Applied"
+	// ReasonApplyFailed is the reason of the Reconciled condition after a failed apply.
+	ReasonApplyFailed = "ApplyFailed"
+	// ReasonIdle is the reason of the Progressing condition when nothing is being applied.
+	ReasonIdle = "Idle"
+)
+
+// Status records the progress of the continuous apply of a cluster in the state store.
+type Status struct {
+	// HolderIdentity identifies the process that last applied the specs.
+	HolderIdentity string `json:"holderIdentity,omitempty"`
+	// ObservedSpecHash is the hash of the cluster and instance group specs that were last applied.
+	ObservedSpecHash string `json:"observedSpecHash,omitempty"`
+	// LastApplyTime is when the specs were last applied.
+	LastApplyTime *metav1.Time `json:"lastApplyTime,omitempty"`
+	// Conditions are the Progressing and Reconciled conditions.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// StatusPath returns the path of the status file for the cluster with the given config base.
+func StatusPath(configBase vfs.Path) vfs.Path {
+	return configBase.Join("reconcile", "status.yaml")
+}
+
+// ReadStatus reads the status from the state store, returning nil if there is none.
+func ReadStatus(ctx context.Context, p vfs.Path) (*Status, error) {
+	b, err := p.ReadFile(ctx)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading status %s: %w", p, err)
+	}
+
+	status := &Status{}
+	if err := yaml.Unmarshal(b, status); err != nil {
+		return nil, fmt.Errorf("error parsing status %s: %w", p, err)
+	}
+	return status, nil
+}
+
+// WriteStatus writes the status to the state store.
+func WriteStatus(ctx context.Context, p vfs.Path, status *Status) error {
+	b, err := yaml.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("error serializing status: %w", err)
+	}
+	if err := p.WriteFile(ctx, bytes.NewReader(b), nil); err != nil {
+		return fmt.Errorf("error writing status %s: %w", p, err)
+	}
+	return nil
+}
@@ -50,6 +50,11 @@ type VerifyResult struct {
 	// This should be sourced from e.g. the cloud, and acts as a cross-check
 	// that this is the correct instance.
 	ChallengeEndpoint string
+
+	// PinKey, if set, records the key that signed the request as the key of the instance,
+	// so that later requests for the instance must be signed with the same key.
+	// It must only be called once the challenge has confirmed that the request came from the instance.
+	PinKey func(ctx context.Context) error
 }
 
 // Verifier verifies authentication credentials for requests.
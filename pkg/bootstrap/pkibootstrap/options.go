@@ -24,3 +24,6 @@ type Options struct {
 
 // AuthenticationTokenPrefix is the prefix used for authentication using PKI
 const AuthenticationTokenPrefix = "x-pki-tpm " //nolint:gosec // This is an authentication scheme prefix, not a credential.
+
+// MachineKeyPath is the path of the key that identifies the machine to kops-controller.
+const MachineKeyPath = "/etc/kubernetes/kops/pki/machine/private.pem"
@@ -19,6 +19,8 @@ package pkibootstrap
 import (
 	"bytes"
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	cryptorand "crypto/rand"
 	"crypto/sha256"
 	"crypto/x509"
@@ -27,6 +29,7 @@ import (
 	"encoding/pem"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"k8s.io/klog/v2"
@@ -101,7 +104,50 @@ func NewAuthenticatorFromFile(p string) (bootstrap.Authenticator, error) {
 	return NewAuthenticator(hostname, key.Key)
 }
 
+// LoadOrCreateMachineKey loads the machine key from p (normally MachineKeyPath), generating it on first use.
+func LoadOrCreateMachineKey(p string) (crypto.Signer, error) {
+	keyBytes, err := os.ReadFile(p)
+	if err == nil {
+		key, err := pki.ParsePEMPrivateKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing key from %q: %w", p, err)
+		}
+		return key.Key, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("error reading %q: %w", p, err)
+	}
+
+	klog.Infof("generating machine key %q", p)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("error generating machine key: %w", err)
+	}
+	key := &pki.PrivateKey{Key: ecKey}
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return nil, fmt.Errorf("error creating directory for %q: %w", p, err)
+	}
+	if err := key.WriteToFile(p, 0o600); err != nil {
+		return nil, fmt.Errorf("error writing %q: %w", p, err)
+	}
+	return ecKey, nil
+}
+
 func (a *pkiAuthenticator) CreateToken(body []byte) (string, error) {
+	return createToken(AuthenticationTokenPrefix, a.hostname, a.keyID, a.signer, body)
+}
+
+// CreateSignedToken creates a token for body claiming to be the given instance, signed with signer and carrying its public key.
+// Cloud providers without signed instance identity documents use this so that kops-controller can pin the key of an instance.
+func CreateSignedToken(prefix string, instance string, signer crypto.Signer, body []byte) (string, error) {
+	keyID, err := computeKeyID(signer)
+	if err != nil {
+		return "", err
+	}
+	return createToken(prefix, instance, keyID, signer, body)
+}
+
+func createToken(prefix string, instance string, keyID string, signer crypto.Signer, body []byte) (string, error) {
 	requestHash := sha256.Sum256(body)
 
 	data := AuthTokenData{
@@ -109,8 +155,8 @@ func (a *pkiAuthenticator) CreateToken(body []byte) (string, error) {
 		Audience:    AudienceNodeAuthentication,
 		RequestHash: requestHash[:],
 
-		KeyID:    a.keyID,
-		Instance: a.hostname,
+		KeyID:    keyID,
+		Instance: instance,
 	}
 
 	payload, err := json.Marshal(&data)
@@ -118,7 +164,7 @@ func (a *pkiAuthenticator) CreateToken(body []byte) (string, error) {
 		return "", fmt.Errorf("failed to marshal token data: %w", err)
 	}
 
-	signature, err := a.sign(payload)
+	signature, err := sign(signer, payload)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token data: %w", err)
 	}
@@ -131,16 +177,16 @@ func (a *pkiAuthenticator) CreateToken(body []byte) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal token: %w", err)
 	}
-	return AuthenticationTokenPrefix + base64.StdEncoding.EncodeToString(b), nil
+	return prefix + base64.StdEncoding.EncodeToString(b), nil
 }
 
 // sign performs a TPM signature with the tpmKey, and sanity checks the result.
-func (a *pkiAuthenticator) sign(payload []byte) ([]byte, error) {
+func sign(signer crypto.Signer, payload []byte) ([]byte, error) {
 	beforeSign := time.Now()
 
 	digest := sha256.Sum256(payload)
 
-	signature, err := a.signer.Sign(cryptorand.Reader, digest[:], crypto.SHA256)
+	signature, err := signer.Sign(cryptorand.Reader, digest[:], crypto.SHA256)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign data: %w", err)
 	}
@@ -20,22 +20,13 @@ limitations under the License.
 package pkiverifier
 
 import (
-	"bytes"
 	"context"
 	"crypto"
-	"crypto/ecdsa"
-	"crypto/sha256"
-	"encoding/base64"
-	"encoding/json"
 	"fmt"
-	"math"
 	"net/http"
-	"strings"
-	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/klog/v2"
 	kops "k8s.io/kops/pkg/apis/kops/v1alpha2"
 	"k8s.io/kops/pkg/bootstrap"
 	"k8s.io/kops/pkg/bootstrap/pkibootstrap"
@@ -52,7 +43,7 @@ type verifier struct {
 func NewVerifier(options *pkibootstrap.Options, client client.Client) (bootstrap.Verifier, error) {
 	opt := *options
 	if opt.MaxTimeSkew == 0 {
-		opt.MaxTimeSkew = 300
+		opt.MaxTimeSkew = pkibootstrap.DefaultMaxTimeSkew
 	}
 	return &verifier{
 		opt:    opt,
@@ -62,46 +53,6 @@ func NewVerifier(options *pkibootstrap.Options, client client.Client) (bootstrap
 
 var _ bootstrap.Verifier = &verifier{}
 
-// TODO: Dedup with gce
-func (v *verifier) parseTokenData(tokenPrefix string, authToken string, body []byte) (*pkibootstrap.AuthToken, *pkibootstrap.AuthTokenData, error) {
-	if !strings.HasPrefix(authToken, tokenPrefix) {
-		return nil, nil, bootstrap.ErrNotThisVerifier
-	}
-	authToken = strings.TrimPrefix(authToken, tokenPrefix)
-
-	tokenBytes, err := base64.StdEncoding.DecodeString(authToken)
-	if err != nil {
-		return nil, nil, fmt.Errorf("decoding authorization token: %w", err)
-	}
-
-	token := &pkibootstrap.AuthToken{}
-	if err = json.Unmarshal(tokenBytes, token); err != nil {
-		return nil, nil, fmt.Errorf("unmarshalling authorization token: %w", err)
-	}
-
-	tokenData := &pkibootstrap.AuthTokenData{}
-	if err := json.Unmarshal(token.Data, tokenData); err != nil {
-		return nil, nil, fmt.Errorf("unmarshalling authorization token data: %w", err)
-	}
-
-	// Guard against replay attacks
-	if tokenData.Audience != pkibootstrap.AudienceNodeAuthentication {
-		return nil, nil, fmt.Errorf("incorrect Audience")
-	}
-	timeSkew := math.Abs(time.Since(time.Unix(tokenData.Timestamp, 0)).Seconds())
-	if timeSkew > float64(v.opt.MaxTimeSkew) {
-		return nil, nil, fmt.Errorf("incorrect Timestamp %v", tokenData.Timestamp)
-	}
-
-	// Verify the token has signed the body content.
-	requestHash := sha256.Sum256(body)
-	if !bytes.Equal(requestHash[:], tokenData.RequestHash) {
-		return nil, nil, fmt.Errorf("incorrect RequestHash")
-	}
-
-	return token, tokenData, nil
-}
-
 // Can generate keys with
 // openssl ecparam -name prime256v1 -genkey -noout -out ec-priv-key.pem
 // openssl ec -in ec-priv-key.pem -pubout > ec-pub-key.pem
@@ -111,7 +62,7 @@ func (v *verifier) VerifyToken(ctx context.Context, rawRequest *http.Request, au
 	// Reminder: we shouldn't trust any data we get from the client until we've checked the signature (and even then...)
 	// Thankfully the GCE SDK does seem to escape the parameters correctly, for example.
 
-	token, tokenData, err := v.parseTokenData(pkibootstrap.AuthenticationTokenPrefix, authToken, body)
+	token, tokenData, err := pkibootstrap.ParseToken(pkibootstrap.AuthenticationTokenPrefix, authToken, body, v.opt.MaxTimeSkew)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +73,7 @@ func (v *verifier) VerifyToken(ctx context.Context, rawRequest *http.Request, au
 		return nil, err
 	}
 
-	if !verifySignature(signingKey, token.Data, token.Signature) {
+	if !pkibootstrap.VerifySignature(signingKey, token.Data, token.Signature) {
 		return nil, fmt.Errorf("failed to verify claim signature for node")
 	}
 
@@ -167,17 +118,3 @@ func (v *verifier) getSigningKey(ctx context.Context, tokenData *pkibootstrap.Au
 
 	return result, pubKey.Key, nil
 }
-
-func verifySignature(signingKey crypto.PublicKey, payload []byte, signature []byte) bool {
-	attestHash := sha256.Sum256(payload)
-	switch signingKey := signingKey.(type) {
-	case *ecdsa.PublicKey:
-		klog.Infof("attestHash %x", attestHash)
-		klog.Infof("sig %x", signature)
-		return ecdsa.VerifyASN1(signingKey, attestHash[:], signature)
-
-	default:
-		klog.Warningf("key type %T not supported", signingKey)
-		return false
-	}
-}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pkibootstrap
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/bootstrap"
+	"k8s.io/kops/pkg/pki"
+)
+
+// ErrUnsignedToken is returned for the unsigned tokens of nodes from before signed tokens, when they are not allowed.
+var ErrUnsignedToken = errors.New("unsigned bootstrap tokens are not accepted, unless the AllowUnsignedBootstrapTokens feature flag is enabled")
+
+// DefaultMaxTimeSkew is the maximum time skew (in seconds) allowed when MaxTimeSkew is not set.
+const DefaultMaxTimeSkew = 300
+
+// ParseToken decodes a token with the given prefix, checking that it is fresh and was created for body.
+// The signature is not checked; the caller must verify it against the key it trusts for the instance.
+func ParseToken(tokenPrefix string, authToken string, body []byte, maxTimeSkew int64) (*AuthToken, *AuthTokenData, error) {
+	if !strings.HasPrefix(authToken, tokenPrefix) {
+		return nil, nil, bootstrap.ErrNotThisVerifier
+	}
+	authToken = strings.TrimPrefix(authToken, tokenPrefix)
+
+	tokenBytes, err := base64.StdEncoding.DecodeString(authToken)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decoding authorization token: %w", err)
+	}
+
+	token := &AuthToken{}
+	if err = json.Unmarshal(tokenBytes, token); err != nil {
+		return nil, nil, fmt.Errorf("unmarshalling authorization token: %w", err)
+	}
+
+	tokenData := &AuthTokenData{}
+	if err := json.Unmarshal(token.Data, tokenData); err != nil {
+		return nil, nil, fmt.Errorf("unmarshalling authorization token data: %w", err)
+	}
+
+	// Guard against replay attacks
+	if tokenData.Audience != AudienceNodeAuthentication {
+		return nil, nil, fmt.Errorf("incorrect Audience")
+	}
+	if maxTimeSkew == 0 {
+		maxTimeSkew = DefaultMaxTimeSkew
+	}
+	timeSkew := math.Abs(time.Since(time.Unix(tokenData.Timestamp, 0)).Seconds())
+	if timeSkew > float64(maxTimeSkew) {
+		return nil, nil, fmt.Errorf("incorrect Timestamp %v", tokenData.Timestamp)
+	}
+
+	// Verify the token has signed the body content.
+	requestHash := sha256.Sum256(body)
+	if !bytes.Equal(requestHash[:], tokenData.RequestHash) {
+		return nil, nil, fmt.Errorf("incorrect RequestHash")
+	}
+
+	return token, tokenData, nil
+}
+
+// VerifySignature checks that signature is a signature of payload by signingKey.
+func VerifySignature(signingKey crypto.PublicKey, payload []byte, signature []byte) bool {
+	attestHash := sha256.Sum256(payload)
+	switch signingKey := signingKey.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(signingKey, attestHash[:], signature)
+
+	default:
+		klog.Warningf("key type %T not supported", signingKey)
+		return false
+	}
+}
+
+// VerifySignedToken verifies a token created by CreateSignedToken, which is signed by the key it carries.
+// It returns the token data and the fingerprint of the key, which the caller must compare with the key pinned for the instance.
+func VerifySignedToken(tokenPrefix string, authToken string, body []byte, maxTimeSkew int64) (*AuthTokenData, string, error) {
+	token, tokenData, err := ParseToken(tokenPrefix, authToken, body, maxTimeSkew)
+	if err != nil {
+		return nil, "", err
+	}
+	if tokenData.Instance == "" {
+		return nil, "", fmt.Errorf("token did not specify instance")
+	}
+
+	publicKey, err := pki.ParsePEMPublicKey([]byte(tokenData.KeyID))
+	if err != nil || publicKey == nil {
+		return nil, "", fmt.Errorf("failed to parse public key of token: %w", err)
+	}
+	if !VerifySignature(publicKey.Key, token.Data, token.Signature) {
+		return nil, "", fmt.Errorf("failed to verify claim signature for instance %q", tokenData.Instance)
+	}
+
+	fingerprint, err := KeyFingerprint(publicKey.Key)
+	if err != nil {
+		return nil, "", err
+	}
+	return tokenData, fingerprint, nil
+}
+
+// KeyFingerprint returns the fingerprint of a public key.
+// It is the lowercase unpadded base32 encoding of the SHA-256 hash of the key, so it can be stored in the labels or tags of any cloud.
+func KeyFingerprint(publicKey crypto.PublicKey) (string, error) {
+	pkData, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("error converting public key to x509: %w", err)
+	}
+	hash := sha256.Sum256(pkData)
+	return strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(hash[:])), nil
+}
+
+// VerifyPinnedKey checks the fingerprint of the key a request was signed with against the key pinned for the instance.
+// Requests that are not signed (fingerprint is empty) are only accepted from instances that have no pinned key,
+// so that nodes from before signed tokens can still join, but only until the instance pins a key.
+func VerifyPinnedKey(instance string, pinned string, fingerprint string) error {
+	if pinned == "" {
+		return nil
+	}
+	if fingerprint == "" {
+		return fmt.Errorf("instance %q has a pinned key, but the request was not signed", instance)
+	}
+	if fingerprint != pinned {
+		return fmt.Errorf("request for instance %q was not signed by its pinned key", instance)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pkibootstrap
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/kops/pkg/bootstrap"
+)
+
+const testPrefix = "x-test-signed "
+
+func TestVerifySignedToken(t *testing.T) {
+	signer, err := LoadOrCreateMachineKey(filepath.Join(t.TempDir(), "machine", "private.pem"))
+	if err != nil {
+		t.Fatalf("creating machine key: %v", err)
+	}
+	expectedFingerprint, err := KeyFingerprint(signer.Public())
+	if err != nil {
+		t.Fatalf("computing fingerprint: %v", err)
+	}
+
+	body := []byte(`{"apiVersion":"bootstrap.kops.k8s.io/v1alpha1"}`)
+	token, err := CreateSignedToken(testPrefix, "12345", signer, body)
+	if err != nil {
+		t.Fatalf("creating token: %v", err)
+	}
+
+	tokenData, fingerprint, err := VerifySignedToken(testPrefix, token, body, 0)
+	if err != nil {
+		t.Fatalf("verifying token: %v", err)
+	}
+	if tokenData.Instance != "12345" {
+		t.Errorf("unexpected instance %q", tokenData.Instance)
+	}
+	if fingerprint != expectedFingerprint {
+		t.Errorf("unexpected fingerprint %q, expected %q", fingerprint, expectedFingerprint)
+	}
+	if len(fingerprint) != 52 || strings.ToLower(fingerprint) != fingerprint {
+		t.Errorf("fingerprint %q is not usable as a label value", fingerprint)
+	}
+
+	if _, _, err := VerifySignedToken("x-other ", token, body, 0); !errors.Is(err, bootstrap.ErrNotThisVerifier) {
+		t.Errorf("expected ErrNotThisVerifier for other prefix, got %v", err)
+	}
+	if _, _, err := VerifySignedToken(testPrefix, token, []byte("{}"), 0); err == nil {
+		t.Errorf("expected error for token of another body")
+	}
+
+	// Claiming another instance invalidates the signature
+	var authToken AuthToken
+	b, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(token, testPrefix))
+	if err := json.Unmarshal(b, &authToken); err != nil {
+		t.Fatalf("decoding token: %v", err)
+	}
+	authToken.Data = []byte(strings.Replace(string(authToken.Data), `"12345"`, `"54321"`, 1))
+	b, _ = json.Marshal(&authToken)
+	if _, _, err := VerifySignedToken(testPrefix, testPrefix+base64.StdEncoding.EncodeToString(b), body, 0); err == nil {
+		t.Errorf("expected error for tampered token")
+	}
+}
+
+func TestLoadOrCreateMachineKey(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "private.pem")
+	created, err := LoadOrCreateMachineKey(p)
+	if err != nil {
+		t.Fatalf("creating machine key: %v", err)
+	}
+	loaded, err := LoadOrCreateMachineKey(p)
+	if err != nil {
+		t.Fatalf("loading machine key: %v", err)
+	}
+	createdFingerprint, _ := KeyFingerprint(created.Public())
+	loadedFingerprint, _ := KeyFingerprint(loaded.Public())
+	if createdFingerprint != loadedFingerprint {
+		t.Errorf("loaded key %q does not match created key %q", loadedFingerprint, createdFingerprint)
+	}
+}
+
+func TestVerifyPinnedKey(t *testing.T) {
+	grid := []struct {
+		desc        string
+		pinned      string
+		fingerprint string
+		expectError bool
+	}{
+		{desc: "unsigned request, no pinned key"},
+		{desc: "signed request, no pinned key", fingerprint: "abc"},
+		{desc: "signed with pinned key", pinned: "abc", fingerprint: "abc"},
+		{desc: "signed with other key", pinned: "abc", fingerprint: "def", expectError: true},
+		{desc: "unsigned request, pinned key", pinned: "abc", expectError: true},
+	}
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			err := VerifyPinnedKey("12345", g.pinned, g.fingerprint)
+			if (err != nil) != g.expectError {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
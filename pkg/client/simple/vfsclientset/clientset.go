@@ -40,6 +40,9 @@ type VFSClientset struct {
 
 var _ simple.Clientset = &VFSClientset{}
 
+// stateStoreRemediation is the hint printed when the state store can't be read or written
+const stateStoreRemediation = "Check that the state store set with --state or KOPS_STATE_STORE exists, and that your credentials can read and write it."
+
 func (c *VFSClientset) VFSContext() *vfs.VFSContext {
 	return c.vfsContext
 }
@@ -140,7 +143,7 @@ func (c *VFSClientset) pkiPath(cluster *kops.Cluster) (vfs.Path, error) {
 func DeleteAllClusterState(ctx context.Context, basePath vfs.Path) error {
 	paths, err := basePath.ReadTree(ctx)
 	if err != nil {
-		return fmt.Errorf("error listing files in state store: %v", err)
+		return fi.NewStateStoreError(fmt.Errorf("error listing files in state store: %v", err), stateStoreRemediation)
 	}
 
 	for _, path := range paths {
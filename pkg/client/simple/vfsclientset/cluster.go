@@ -34,6 +34,7 @@ import (
 	api "k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/apis/kops/registry"
 	"k8s.io/kops/pkg/apis/kops/validation"
+	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/util/pkg/vfs"
 )
 
@@ -162,7 +163,7 @@ func (r *ClusterVFS) Update(c *api.Cluster, status *api.ClusterStatus) (*api.Clu
 func (r *ClusterVFS) listNames(ctx context.Context) ([]string, error) {
 	paths, err := r.basePath.ReadTree(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error reading state store: %v", err)
+		return nil, fi.NewStateStoreError(fmt.Errorf("error reading state store: %v", err), stateStoreRemediation)
 	}
 
 	var keys []string
@@ -191,7 +192,7 @@ func (r *ClusterVFS) find(ctx context.Context, clusterName string) (*api.Cluster
 		if os.IsNotExist(err) {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("error reading cluster configuration %q: %v", clusterName, err)
+		return nil, fmt.Errorf("error reading cluster configuration %q: %w", clusterName, err)
 	}
 
 	c := o.(*api.Cluster)
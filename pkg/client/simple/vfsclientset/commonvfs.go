@@ -32,6 +32,7 @@ import (
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/apis/kops/v1alpha2"
 	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/util/pkg/vfs"
 	"k8s.io/kops/util/pkg/vfs/acls"
 )
@@ -67,7 +68,7 @@ func (c *VFSClientBase) Find(ctx context.Context, name string) (runtime.Object,
 		if os.IsNotExist(err) {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("error reading %s %q: %v", c.kind, name, err)
+		return nil, fmt.Errorf("error reading %s %q: %w", c.kind, name, err)
 	}
 	return o, nil
 }
@@ -121,7 +122,7 @@ func (c *VFSClientBase) readConfig(ctx context.Context, configPath vfs.Path) (ru
 		if os.IsNotExist(err) {
 			return nil, err
 		}
-		return nil, fmt.Errorf("error reading %s: %v", configPath, err)
+		return nil, fi.NewStateStoreError(fmt.Errorf("error reading %s: %v", configPath, err), stateStoreRemediation)
 	}
 
 	object, _, err := kopscodecs.Decode(data, nil)
@@ -171,7 +172,7 @@ func (c *VFSClientBase) writeConfig(ctx context.Context, cluster *kops.Cluster,
 			klog.Warningf("failed to create file as already exists: %v", configPath)
 			return err
 		}
-		return fmt.Errorf("error writing configuration file %s: %v", configPath, err)
+		return fi.NewStateStoreError(fmt.Errorf("error writing configuration file %s: %v", configPath, err), stateStoreRemediation)
 	}
 	return nil
 }
@@ -217,7 +218,7 @@ func (c *VFSClientBase) delete(ctx context.Context, name string, options metav1.
 func (c *VFSClientBase) listNames(ctx context.Context) ([]string, error) {
 	keys, err := listChildNames(ctx, c.basePath)
 	if err != nil {
-		return nil, fmt.Errorf("error listing %s in state store: %v", c.kind, err)
+		return nil, fi.NewStateStoreError(fmt.Errorf("error listing %s in state store: %v", c.kind, err), stateStoreRemediation)
 	}
 
 	// Seems to be an assumption in k8s APIs that items are always returned sorted
@@ -32,6 +32,9 @@ type State string
 // WarmPool means the instance is in the warm pool
 const WarmPool State = "WarmPool"
 
+// Evicted means the cloud provider reclaimed the Spot capacity of the instance, which is no longer running.
+const Evicted State = "Evicted"
+
 // CloudInstance describes an instance in a CloudInstanceGroup group.
 type CloudInstance struct {
 	// ID is a unique identifier for the instance, meaningful to the cloud
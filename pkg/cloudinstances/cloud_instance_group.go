@@ -126,6 +126,10 @@ func GetNodeMap(nodes []v1.Node, cluster *kopsapi.Cluster) map[string]*v1.Node {
 // toAzureVMName returns a VM name from the resource path stored in the provider ID.
 func toAzureVMName(providerID string) (string, error) {
 	l := strings.Split(providerID, "/")
+	// VMs of VM Scale Sets in Flexible orchestration mode are standalone VMs, named by the VM Scale Set
+	if len(l) == 11 && l[9] == "virtualMachines" {
+		return l[10], nil
+	}
 	if len(l) != 13 {
 		return "", fmt.Errorf("unexpected form of resource path: %q", providerID)
 	}
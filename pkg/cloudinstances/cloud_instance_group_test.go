@@ -32,6 +32,11 @@ func TestToAzureVMName(t *testing.T) {
 			vmName:     "nodes.my-cluster.k8s.local_0",
 			success:    true,
 		},
+		{
+			providerID: "azure:///subscriptions/<subscription ID>/resourceGroups/my-group/providers/Microsoft.Compute/virtualMachines/nodes.my-cluster.k8s.local_0a1b2c3d",
+			vmName:     "nodes.my-cluster.k8s.local_0a1b2c3d",
+			success:    true,
+		},
 		{
 			providerID: "foo/bar",
 			success:    false,
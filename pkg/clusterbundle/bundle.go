@@ -0,0 +1,478 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusterbundle exports the state of a cluster to a single archive, and imports such an archive into a state store.
+// The archive is a gzipped tarball holding the cluster spec, instance groups, additional objects, keysets, secrets and
+// SSH public keys of the cluster. Keysets and secrets can be encrypted with age (https://age-encryption.org).
+package clusterbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"filippo.io/age"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	kopsbase "k8s.io/kops"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/pkg/kubemanifest"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+const (
+	manifestFilename        = "bundle.yaml"
+	clusterFilename         = "cluster.yaml"
+	addonsFilename          = "addons.yaml"
+	instanceGroupsDirectory = "instancegroups"
+	keysetsDirectory        = "keysets"
+	secretsDirectory        = "secrets"
+	sshPublicKeysDirectory  = "ssh"
+
+	// encryptedSuffix is appended to the name of files encrypted with age
+	encryptedSuffix = ".age"
+)
+
+// Manifest describes a bundle; it is the first file in the archive
+type Manifest struct {
+	// KopsVersion is the version of kOps that wrote the bundle
+	KopsVersion string `json:"kopsVersion"`
+	// ClusterName is the name of the cluster in the bundle
+	ClusterName string `json:"clusterName"`
+	// Created is when the bundle was written
+	Created time.Time `json:"created"`
+	// SourceConfigBase is the configStore.base of the cluster when it was exported
+	SourceConfigBase string `json:"sourceConfigBase,omitempty"`
+	// Encrypted is true if keysets and secrets are encrypted
+	Encrypted bool `json:"encrypted,omitempty"`
+	// EtcdBackups references the etcd backups of the cluster; the backups themselves are not part of the bundle
+	EtcdBackups []EtcdBackupReference `json:"etcdBackups,omitempty"`
+}
+
+// EtcdBackupReference is the location of the backups of an etcd cluster
+type EtcdBackupReference struct {
+	EtcdCluster string `json:"etcdCluster"`
+	BackupStore string `json:"backupStore"`
+	// LatestBackup is the name of the newest backup when the bundle was written, if there was one
+	LatestBackup string `json:"latestBackup,omitempty"`
+}
+
+// ExportOptions holds the options for Export
+type ExportOptions struct {
+	// Recipients are the age recipients to encrypt keysets and secrets to; they are not encrypted if empty
+	Recipients []age.Recipient
+	// EtcdBackups are recorded in the manifest
+	EtcdBackups []EtcdBackupReference
+	// Now is the creation time of the bundle
+	Now time.Time
+}
+
+// ImportOptions holds the options for Import
+type ImportOptions struct {
+	// Identities are the age identities to decrypt keysets and secrets with
+	Identities []age.Identity
+}
+
+// Export writes the state of the cluster to w as a bundle
+func Export(ctx context.Context, clientset simple.Clientset, cluster *kops.Cluster, w io.Writer, options *ExportOptions) (*Manifest, error) {
+	manifest := &Manifest{
+		KopsVersion:      kopsbase.Version,
+		ClusterName:      cluster.ObjectMeta.Name,
+		Created:          options.Now.UTC(),
+		SourceConfigBase: cluster.Spec.ConfigStore.Base,
+		Encrypted:        len(options.Recipients) != 0,
+		EtcdBackups:      options.EtcdBackups,
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	bw := &bundleWriter{
+		tarWriter:  tar.NewWriter(gzipWriter),
+		modTime:    manifest.Created,
+		recipients: options.Recipients,
+	}
+
+	if err := bw.writeBundle(ctx, clientset, cluster, manifest); err != nil {
+		return nil, err
+	}
+
+	if err := bw.tarWriter.Close(); err != nil {
+		return nil, fmt.Errorf("error writing bundle: %w", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, fmt.Errorf("error writing bundle: %w", err)
+	}
+	return manifest, nil
+}
+
+type bundleWriter struct {
+	tarWriter  *tar.Writer
+	modTime    time.Time
+	recipients []age.Recipient
+}
+
+func (w *bundleWriter) writeBundle(ctx context.Context, clientset simple.Clientset, cluster *kops.Cluster, manifest *Manifest) error {
+	manifestData, err := yaml.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("error serializing bundle manifest: %w", err)
+	}
+	if err := w.addFile(manifestFilename, manifestData, false); err != nil {
+		return err
+	}
+
+	clusterData, err := kopscodecs.ToVersionedYaml(cluster)
+	if err != nil {
+		return fmt.Errorf("error serializing cluster: %w", err)
+	}
+	if err := w.addFile(clusterFilename, clusterData, false); err != nil {
+		return err
+	}
+
+	instanceGroups, err := clientset.InstanceGroupsFor(cluster).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error reading instance groups: %w", err)
+	}
+	for i := range instanceGroups.Items {
+		ig := &instanceGroups.Items[i]
+		data, err := kopscodecs.ToVersionedYaml(ig)
+		if err != nil {
+			return fmt.Errorf("error serializing instance group %q: %w", ig.ObjectMeta.Name, err)
+		}
+		if err := w.addFile(path.Join(instanceGroupsDirectory, ig.ObjectMeta.Name+".yaml"), data, false); err != nil {
+			return err
+		}
+	}
+
+	addons, err := clientset.AddonsFor(cluster).List(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading additional objects: %w", err)
+	}
+	if len(addons) != 0 {
+		data, err := addons.ToYAML()
+		if err != nil {
+			return fmt.Errorf("error serializing additional objects: %w", err)
+		}
+		if err := w.addFile(addonsFilename, data, false); err != nil {
+			return err
+		}
+	}
+
+	keyStore, err := clientset.KeyStore(cluster)
+	if err != nil {
+		return err
+	}
+	keysets, err := keyStore.ListKeysets()
+	if err != nil {
+		return fmt.Errorf("error listing keysets: %w", err)
+	}
+	for _, name := range slices.Sorted(maps.Keys(keysets)) {
+		o, err := keysets[name].ToAPIObject(name)
+		if err != nil {
+			return fmt.Errorf("error converting keyset %q: %w", name, err)
+		}
+		data, err := kopscodecs.ToVersionedYaml(o)
+		if err != nil {
+			return fmt.Errorf("error serializing keyset %q: %w", name, err)
+		}
+		if err := w.addFile(path.Join(keysetsDirectory, name+".yaml"), data, true); err != nil {
+			return err
+		}
+	}
+
+	secretStore, err := clientset.SecretStore(cluster)
+	if err != nil {
+		return err
+	}
+	secretNames, err := secretStore.ListSecrets()
+	if err != nil {
+		return fmt.Errorf("error listing secrets: %w", err)
+	}
+	sort.Strings(secretNames)
+	for _, name := range secretNames {
+		secret, err := secretStore.FindSecret(name)
+		if err != nil {
+			return fmt.Errorf("error reading secret %q: %w", name, err)
+		}
+		if secret == nil {
+			continue
+		}
+		if err := w.addFile(path.Join(secretsDirectory, name), secret.Data, true); err != nil {
+			return err
+		}
+	}
+
+	sshCredentialStore, err := clientset.SSHCredentialStore(cluster)
+	if err != nil {
+		return err
+	}
+	sshCredentials, err := sshCredentialStore.FindSSHPublicKeys()
+	if err != nil {
+		return fmt.Errorf("error listing SSH public keys: %w", err)
+	}
+	for i, sshCredential := range sshCredentials {
+		if err := w.addFile(path.Join(sshPublicKeysDirectory, strconv.Itoa(i)+".pub"), []byte(sshCredential.Spec.PublicKey), false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addFile adds a file to the archive, encrypting it if it is sensitive and there are recipients
+func (w *bundleWriter) addFile(name string, data []byte, sensitive bool) error {
+	if sensitive && len(w.recipients) != 0 {
+		var encrypted bytes.Buffer
+		encryptWriter, err := age.Encrypt(&encrypted, w.recipients...)
+		if err != nil {
+			return fmt.Errorf("error encrypting %s: %w", name, err)
+		}
+		if _, err := encryptWriter.Write(data); err != nil {
+			return fmt.Errorf("error encrypting %s: %w", name, err)
+		}
+		if err := encryptWriter.Close(); err != nil {
+			return fmt.Errorf("error encrypting %s: %w", name, err)
+		}
+		name += encryptedSuffix
+		data = encrypted.Bytes()
+	}
+
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0o600,
+		Size:    int64(len(data)),
+		ModTime: w.modTime,
+	}
+	if err := w.tarWriter.WriteHeader(header); err != nil {
+		return fmt.Errorf("error writing %s to bundle: %w", name, err)
+	}
+	if _, err := w.tarWriter.Write(data); err != nil {
+		return fmt.Errorf("error writing %s to bundle: %w", name, err)
+	}
+	return nil
+}
+
+// Import creates the cluster in the bundle read from r, along with its instance groups, additional objects, keysets,
+// secrets and SSH public keys, in the state store of clientset. The cluster must not already exist.
+func Import(ctx context.Context, clientset simple.Clientset, r io.Reader, options *ImportOptions) (*Manifest, *kops.Cluster, error) {
+	files, err := readBundle(r, options.Identities)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	manifest := &Manifest{}
+	if data, found := files[manifestFilename]; !found {
+		return nil, nil, fmt.Errorf("bundle does not contain %s", manifestFilename)
+	} else if err := yaml.Unmarshal(data, manifest); err != nil {
+		return nil, nil, fmt.Errorf("error parsing %s: %w", manifestFilename, err)
+	}
+
+	cluster, err := decodeFile[*kops.Cluster](files, clusterFilename)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cluster.ObjectMeta.Name != manifest.ClusterName {
+		return nil, nil, fmt.Errorf("bundle is for cluster %q, but contains cluster %q", manifest.ClusterName, cluster.ObjectMeta.Name)
+	}
+
+	if existing, err := clientset.GetCluster(ctx, cluster.ObjectMeta.Name); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, nil, err
+		}
+	} else if existing != nil {
+		return nil, nil, fmt.Errorf("cluster %q already exists in the state store", cluster.ObjectMeta.Name)
+	}
+
+	// Locations inside the source state store move to the new state store
+	if base := manifest.SourceConfigBase; base != "" {
+		if cluster.Spec.ConfigStore.Base == base {
+			cluster.Spec.ConfigStore.Base = ""
+		}
+		if strings.HasPrefix(cluster.Spec.ConfigStore.Keypairs, base+"/") {
+			cluster.Spec.ConfigStore.Keypairs = ""
+		}
+		if strings.HasPrefix(cluster.Spec.ConfigStore.Secrets, base+"/") {
+			cluster.Spec.ConfigStore.Secrets = ""
+		}
+	}
+
+	if _, err := clientset.CreateCluster(ctx, cluster); err != nil {
+		return nil, nil, fmt.Errorf("error creating cluster: %w", err)
+	}
+	// Read the cluster back, to pick up its location in the new state store
+	cluster, err = clientset.GetCluster(ctx, cluster.ObjectMeta.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := importClusterState(ctx, clientset, cluster, files); err != nil {
+		return nil, nil, fmt.Errorf("cluster %q was created, but importing its state failed: %w", cluster.ObjectMeta.Name, err)
+	}
+
+	return manifest, cluster, nil
+}
+
+func importClusterState(ctx context.Context, clientset simple.Clientset, cluster *kops.Cluster, files map[string][]byte) error {
+	for _, name := range filesIn(files, instanceGroupsDirectory) {
+		ig, err := decodeFile[*kops.InstanceGroup](files, name)
+		if err != nil {
+			return err
+		}
+		if _, err := clientset.InstanceGroupsFor(cluster).Create(ctx, ig, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("error creating instance group %q: %w", ig.ObjectMeta.Name, err)
+		}
+	}
+
+	if data, found := files[addonsFilename]; found {
+		addons, err := kubemanifest.LoadObjectsFrom(data)
+		if err != nil {
+			return fmt.Errorf("error parsing %s: %w", addonsFilename, err)
+		}
+		if err := clientset.AddonsFor(cluster).Replace(addons); err != nil {
+			return fmt.Errorf("error writing additional objects: %w", err)
+		}
+	}
+
+	keyStore, err := clientset.KeyStore(cluster)
+	if err != nil {
+		return err
+	}
+	for _, name := range filesIn(files, keysetsDirectory) {
+		o, err := decodeFile[*kops.Keyset](files, name)
+		if err != nil {
+			return err
+		}
+		keyset, err := fi.KeysetFromAPIObject(o)
+		if err != nil {
+			return fmt.Errorf("error parsing %s: %w", name, err)
+		}
+		if err := keyStore.StoreKeyset(ctx, o.ObjectMeta.Name, keyset); err != nil {
+			return fmt.Errorf("error storing keyset %q: %w", o.ObjectMeta.Name, err)
+		}
+	}
+
+	secretStore, err := clientset.SecretStore(cluster)
+	if err != nil {
+		return err
+	}
+	for _, name := range filesIn(files, secretsDirectory) {
+		id := path.Base(name)
+		if _, _, err := secretStore.GetOrCreateSecret(ctx, id, &fi.Secret{Data: files[name]}); err != nil {
+			return fmt.Errorf("error storing secret %q: %w", id, err)
+		}
+	}
+
+	sshCredentialStore, err := clientset.SSHCredentialStore(cluster)
+	if err != nil {
+		return err
+	}
+	for _, name := range filesIn(files, sshPublicKeysDirectory) {
+		if err := sshCredentialStore.AddSSHPublicKey(ctx, files[name]); err != nil {
+			return fmt.Errorf("error adding SSH public key: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// readBundle returns the files in the bundle by name, decrypting encrypted files
+func readBundle(r io.Reader, identities []age.Identity) (map[string][]byte, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading bundle: %w", err)
+	}
+	defer gzipReader.Close()
+
+	files := make(map[string][]byte)
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading bundle: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s from bundle: %w", header.Name, err)
+		}
+
+		name := path.Clean(header.Name)
+		if strings.HasSuffix(name, encryptedSuffix) {
+			if len(identities) == 0 {
+				return nil, fmt.Errorf("bundle is encrypted; an age identity is required to import it")
+			}
+			plaintext, err := age.Decrypt(bytes.NewReader(data), identities...)
+			if err != nil {
+				return nil, fmt.Errorf("error decrypting %s: %w", name, err)
+			}
+			data, err = io.ReadAll(plaintext)
+			if err != nil {
+				return nil, fmt.Errorf("error decrypting %s: %w", name, err)
+			}
+			name = strings.TrimSuffix(name, encryptedSuffix)
+		}
+		files[name] = data
+	}
+	return files, nil
+}
+
+// decodeFile parses the named file in the bundle as a kOps API object of type T
+func decodeFile[T any](files map[string][]byte, name string) (T, error) {
+	var zero T
+	data, found := files[name]
+	if !found {
+		return zero, fmt.Errorf("bundle does not contain %s", name)
+	}
+	o, _, err := kopscodecs.Decode(data, nil)
+	if err != nil {
+		return zero, fmt.Errorf("error parsing %s: %w", name, err)
+	}
+	typed, ok := o.(T)
+	if !ok {
+		return zero, fmt.Errorf("unexpected object of type %T in %s", o, name)
+	}
+	return typed, nil
+}
+
+// filesIn returns the sorted names of the files in a directory of the bundle
+func filesIn(files map[string][]byte, dir string) []string {
+	var names []string
+	for name := range files {
+		if path.Dir(name) == dir {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterbundle
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509/pkix"
+	"strings"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/kubemanifest"
+	"k8s.io/kops/pkg/pki"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+const testSSHPublicKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDF2sghZsClUBXJB4mBMIw8rb0hJWjg1Vz4eUeXwYmTdi92Gf1zNc5xISSip9Y+PWX/jJokPB7tgPnMD/2JOAKhG1bi4ZqB15pYRmbbBekVpM4o4E0dx+czbqjiAm6wlccTrINK5LYenbucAAQt19eH+D0gJwzYUK9SYz1hWnlGS+qurt2bz7rrsG73lN8E2eiNvGtIXqv3GabW/Hea3acOBgCUJQWUDTRu0OmmwxzKbFN/UpNKeRaHlCqwZWjVAsmqA8TX8LIocq7Np7MmIBwt7EpEeZJxThcmC8DEJs9ClAjD+jlLIvMPXKC3JWCPgwCLGxHjy7ckSGFCSzbyPduh"
+
+func TestExportImportRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	vfs.Context.ResetMemfsContext(true)
+
+	source := newTestClientset(t, "memfs://source")
+	cluster := testutils.BuildMinimalClusterAWS("bundle.example.com")
+	cluster.Spec.ConfigStore.Base = "memfs://source/bundle.example.com"
+	if _, err := source.CreateCluster(ctx, cluster); err != nil {
+		t.Fatalf("error creating cluster: %v", err)
+	}
+	ig := testutils.BuildMinimalNodeInstanceGroup("nodes", "subnet-us-test-1a")
+	if _, err := source.InstanceGroupsFor(cluster).Create(ctx, &ig, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error creating instance group: %v", err)
+	}
+	addons, err := kubemanifest.LoadObjectsFrom([]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: extra\n  namespace: kube-system\ndata:\n  key: value\n"))
+	if err != nil {
+		t.Fatalf("error parsing addons: %v", err)
+	}
+	if err := source.AddonsFor(cluster).Replace(addons); err != nil {
+		t.Fatalf("error writing addons: %v", err)
+	}
+	keyStore, err := source.KeyStore(cluster)
+	if err != nil {
+		t.Fatalf("error building keystore: %v", err)
+	}
+	cert, privateKey, _, err := pki.IssueCert(ctx, &pki.IssueCertRequest{Type: "ca", Subject: pkix.Name{CommonName: "kubernetes-ca"}, Serial: pki.BuildPKISerial(1)}, nil)
+	if err != nil {
+		t.Fatalf("error issuing certificate: %v", err)
+	}
+	keyset, err := fi.NewKeyset(cert, privateKey)
+	if err != nil {
+		t.Fatalf("error building keyset: %v", err)
+	}
+	if err := keyStore.StoreKeyset(ctx, fi.CertificateIDCA, keyset); err != nil {
+		t.Fatalf("error storing keyset: %v", err)
+	}
+	secretStore, err := source.SecretStore(cluster)
+	if err != nil {
+		t.Fatalf("error building secret store: %v", err)
+	}
+	if _, _, err := secretStore.GetOrCreateSecret(ctx, "dockerconfig", &fi.Secret{Data: []byte(`{"auths":{}}`)}); err != nil {
+		t.Fatalf("error storing secret: %v", err)
+	}
+	sshCredentialStore, err := source.SSHCredentialStore(cluster)
+	if err != nil {
+		t.Fatalf("error building SSH credential store: %v", err)
+	}
+	if err := sshCredentialStore.AddSSHPublicKey(ctx, []byte(testSSHPublicKey)); err != nil {
+		t.Fatalf("error adding SSH public key: %v", err)
+	}
+
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatalf("error generating identity: %v", err)
+	}
+
+	cluster, err = source.GetCluster(ctx, cluster.ObjectMeta.Name)
+	if err != nil {
+		t.Fatalf("error reading cluster: %v", err)
+	}
+	var bundle bytes.Buffer
+	exportOptions := &ExportOptions{
+		Recipients:  []age.Recipient{identity.Recipient()},
+		EtcdBackups: []EtcdBackupReference{{EtcdCluster: "main", BackupStore: "memfs://source/bundle.example.com/backups/etcd/main", LatestBackup: "2026-01-02T03:04:05Z-000001"}},
+		Now:         time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	if _, err := Export(ctx, source, cluster, &bundle, exportOptions); err != nil {
+		t.Fatalf("error exporting bundle: %v", err)
+	}
+	if strings.Contains(bundle.String(), "auths") {
+		t.Errorf("bundle contains plaintext secret")
+	}
+
+	target := newTestClientset(t, "memfs://target")
+	if _, _, err := Import(ctx, target, bytes.NewReader(bundle.Bytes()), &ImportOptions{}); err == nil || !strings.Contains(err.Error(), "identity is required") {
+		t.Fatalf("expected error importing an encrypted bundle without identities, got %v", err)
+	}
+
+	manifest, imported, err := Import(ctx, target, bytes.NewReader(bundle.Bytes()), &ImportOptions{Identities: []age.Identity{identity}})
+	if err != nil {
+		t.Fatalf("error importing bundle: %v", err)
+	}
+	if !manifest.Encrypted || len(manifest.EtcdBackups) != 1 || manifest.EtcdBackups[0].LatestBackup != "2026-01-02T03:04:05Z-000001" {
+		t.Errorf("unexpected manifest %+v", manifest)
+	}
+	if imported.Spec.ConfigStore.Base != "memfs://target/bundle.example.com" {
+		t.Errorf("expected config base to move to the target state store, got %q", imported.Spec.ConfigStore.Base)
+	}
+
+	igs, err := target.InstanceGroupsFor(imported).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("error listing instance groups: %v", err)
+	}
+	if len(igs.Items) != 1 || igs.Items[0].ObjectMeta.Name != "nodes" {
+		t.Errorf("unexpected instance groups %v", igs.Items)
+	}
+
+	importedAddons, err := target.AddonsFor(imported).List(ctx)
+	if err != nil {
+		t.Fatalf("error listing addons: %v", err)
+	}
+	if len(importedAddons) != 1 || importedAddons[0].GetName() != "extra" {
+		t.Errorf("unexpected addons %v", importedAddons)
+	}
+
+	importedKeyStore, err := target.KeyStore(imported)
+	if err != nil {
+		t.Fatalf("error building keystore: %v", err)
+	}
+	importedKeyset, err := importedKeyStore.FindKeyset(ctx, fi.CertificateIDCA)
+	if err != nil || importedKeyset == nil {
+		t.Fatalf("error reading imported keyset: %v", err)
+	}
+	if !importedKeyset.Primary.Certificate.Certificate.Equal(cert.Certificate) {
+		t.Errorf("imported keyset has a different certificate")
+	}
+
+	importedSecretStore, err := target.SecretStore(imported)
+	if err != nil {
+		t.Fatalf("error building secret store: %v", err)
+	}
+	secret, err := importedSecretStore.FindSecret("dockerconfig")
+	if err != nil || secret == nil || string(secret.Data) != `{"auths":{}}` {
+		t.Errorf("unexpected imported secret %v: %v", secret, err)
+	}
+
+	importedSSHCredentialStore, err := target.SSHCredentialStore(imported)
+	if err != nil {
+		t.Fatalf("error building SSH credential store: %v", err)
+	}
+	sshCredentials, err := importedSSHCredentialStore.FindSSHPublicKeys()
+	if err != nil || len(sshCredentials) != 1 || sshCredentials[0].Spec.PublicKey != testSSHPublicKey {
+		t.Errorf("unexpected imported SSH public keys %v: %v", sshCredentials, err)
+	}
+
+	if _, _, err := Import(ctx, target, bytes.NewReader(bundle.Bytes()), &ImportOptions{Identities: []age.Identity{identity}}); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("expected error importing an existing cluster, got %v", err)
+	}
+}
+
+func newTestClientset(t *testing.T, base string) simple.Clientset {
+	basePath, err := vfs.Context.BuildVfsPath(base)
+	if err != nil {
+		t.Fatalf("error building vfs path: %v", err)
+	}
+	return vfsclientset.NewVFSClientset(vfs.Context, basePath)
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterlock
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/util/pkg/vfs"
+	"sigs.k8s.io/yaml"
+)
+
+// LeaseRecord is the content of the lease file in the state store.
+type LeaseRecord struct {
+	// HolderIdentity identifies the process holding the lease.
+	HolderIdentity string `json:"holderIdentity"`
+	// AcquireTime is when the holder acquired the lease.
+	AcquireTime metav1.Time `json:"acquireTime"`
+	// RenewTime is when the holder last renewed the lease.
+	RenewTime metav1.Time `json:"renewTime"`
+	// LeaseDurationSeconds is how long the lease is valid after it was renewed.
+	LeaseDurationSeconds int32 `json:"leaseDurationSeconds"`
+}
+
+// Expired returns true if the lease was not renewed within its duration.
+func (r *LeaseRecord) Expired(now time.Time) bool {
+	return now.After(r.RenewTime.Add(time.Duration(r.LeaseDurationSeconds) * time.Second))
+}
+
+// Lease coordinates the processes operating on a cluster, using a file in the state store.
+// Writes are compare-and-swap on state stores that support versioned writes,
+// so that only one process holds the lease at a time.
+type Lease struct {
+	path           vfs.Path
+	holderIdentity string
+	duration       time.Duration
+
+	// now is the clock, replaced in tests
+	now func() time.Time
+}
+
+// NewLease builds a Lease stored in the file at p.
+func NewLease(p vfs.Path, holderIdentity string, duration time.Duration) *Lease {
+	return &Lease{
+		path:           p,
+		holderIdentity: holderIdentity,
+		duration:       duration,
+		now:            time.Now,
+	}
+}
+
+// HolderIdentity returns the identity of this holder of the lease.
+func (l *Lease) HolderIdentity() string {
+	return l.holderIdentity
+}
+
+// Duration returns how long the lease is valid after it was renewed.
+func (l *Lease) Duration() time.Duration {
+	return l.duration
+}
+
+// Exclusive returns true if the state store supports versioned writes, so that only one process can hold the lease.
+// Otherwise the lease file is written unconditionally and concurrent processes can all believe they hold the lease.
+func (l *Lease) Exclusive() bool {
+	_, ok := l.path.(vfs.HasVersionedWrite)
+	return ok
+}
+
+// Get returns the current lease record, or nil if the lease was never acquired.
+func (l *Lease) Get(ctx context.Context) (*LeaseRecord, error) {
+	record, _, err := l.read(ctx)
+	return record, err
+}
+
+// TryAcquireOrRenew acquires the lease if it is free or expired, or renews it if we already hold it.
+// It returns false if the lease is held by another process.
+func (l *Lease) TryAcquireOrRenew(ctx context.Context) (bool, error) {
+	now := l.now()
+
+	existing, version, err := l.read(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	record := &LeaseRecord{
+		HolderIdentity:       l.holderIdentity,
+		AcquireTime:          metav1.NewTime(now),
+		RenewTime:            metav1.NewTime(now),
+		LeaseDurationSeconds: int32(l.duration / time.Second),
+	}
+	if existing != nil {
+		if existing.HolderIdentity != l.holderIdentity && !existing.Expired(now) {
+			return false, nil
+		}
+		if existing.HolderIdentity == l.holderIdentity {
+			record.AcquireTime = existing.AcquireTime
+		}
+	}
+
+	return l.write(ctx, record, version)
+}
+
+// Release gives up the lease if we hold it, so that another process can acquire it without waiting for it to expire.
+func (l *Lease) Release(ctx context.Context) error {
+	existing, version, err := l.read(ctx)
+	if err != nil {
+		return err
+	}
+	if existing == nil || existing.HolderIdentity != l.holderIdentity {
+		return nil
+	}
+
+	existing.LeaseDurationSeconds = 0
+	existing.RenewTime = metav1.NewTime(l.now().Add(-time.Second))
+	if _, err := l.write(ctx, existing, version); err != nil {
+		return err
+	}
+	return nil
+}
+
+// read returns the current lease record, or nil if there is none, along with the version of the lease file.
+func (l *Lease) read(ctx context.Context) (*LeaseRecord, string, error) {
+	b, version, err := vfs.ReadFileVersion(ctx, l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, "", nil
+		}
+		return nil, "", fmt.Errorf("error reading lease %s: %w", l.path, err)
+	}
+
+	record := &LeaseRecord{}
+	if err := yaml.Unmarshal(b, record); err != nil {
+		return nil, "", fmt.Errorf("error parsing lease %s: %w", l.path, err)
+	}
+	return record, version, nil
+}
+
+// write stores the lease record if the lease file is still at version.
+// It returns false if the lease file was changed concurrently.
+func (l *Lease) write(ctx context.Context, record *LeaseRecord, version string) (bool, error) {
+	b, err := yaml.Marshal(record)
+	if err != nil {
+		return false, fmt.Errorf("error serializing lease: %w", err)
+	}
+
+	if _, err := vfs.WriteFileIfVersion(ctx, l.path, bytes.NewReader(b), nil, version); err != nil {
+		if errors.Is(err, vfs.ErrVersionConflict) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error writing lease %s: %w", l.path, err)
+	}
+	return true, nil
+}
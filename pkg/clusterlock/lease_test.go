@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterlock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func newTestLease(base vfs.Path, holder string, clock *fakeClock) *Lease {
+	l := NewLease(base.Join("lease.yaml"), holder, time.Minute)
+	l.now = clock.now
+	return l
+}
+
+func TestLease(t *testing.T) {
+	ctx := context.Background()
+	base := vfs.NewMemFSPath(vfs.NewMemFSContext(), "/state/cluster")
+	clock := &fakeClock{t: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	a := newTestLease(base, "a", clock)
+	b := newTestLease(base, "b", clock)
+	if !a.Exclusive() {
+		t.Fatalf("expected lease on memfs to be exclusive")
+	}
+
+	expect := func(l *Lease, want bool) {
+		t.Helper()
+		got, err := l.TryAcquireOrRenew(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != want {
+			t.Fatalf("%s: expected held=%v, got %v", l.HolderIdentity(), want, got)
+		}
+	}
+
+	// a acquires the free lease, b cannot take it
+	expect(a, true)
+	expect(b, false)
+
+	// a renews, so the lease has not expired when b tries again
+	clock.t = clock.t.Add(50 * time.Second)
+	expect(a, true)
+	clock.t = clock.t.Add(50 * time.Second)
+	expect(b, false)
+
+	// b takes over once the lease expired
+	clock.t = clock.t.Add(time.Minute)
+	expect(b, true)
+	expect(a, false)
+
+	// a can acquire the lease immediately once b released it
+	if err := b.Release(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expect(a, true)
+
+	// releasing a lease held by another process is a no-op
+	if err := b.Release(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expect(b, false)
+}
+
+func TestLeaseConcurrentAcquire(t *testing.T) {
+	ctx := context.Background()
+	base := vfs.NewMemFSPath(vfs.NewMemFSContext(), "/state/cluster")
+	clock := &fakeClock{t: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	a := newTestLease(base, "a", clock)
+	b := newTestLease(base, "b", clock)
+
+	// b reads the free lease, then a acquires it before b writes
+	existing, version, err := b.read(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if existing != nil {
+		t.Fatalf("expected no lease, got %v", existing)
+	}
+	if held, err := a.TryAcquireOrRenew(ctx); err != nil || !held {
+		t.Fatalf("expected a to acquire the lease, got %v, %v", held, err)
+	}
+
+	held, err := b.write(ctx, &LeaseRecord{HolderIdentity: "b", LeaseDurationSeconds: 60}, version)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if held {
+		t.Fatalf("expected b to lose the race for the lease")
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterlock
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+// DefaultLockDuration is how long a lock is valid without being renewed,
+// after which a lock left behind by a crashed process can be acquired by another one.
+const DefaultLockDuration = 5 * time.Minute
+
+// LockedError is returned when the cluster is locked by another process.
+type LockedError struct {
+	// Path is the path of the lock file.
+	Path vfs.Path
+	// Holder is the lease record of the process holding the lock.
+	Holder *LeaseRecord
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("cluster is locked by %q since %s (the lock expires %s unless renewed); if no other kops process is operating on the cluster, use --force-unlock to remove the lock",
+		e.Holder.HolderIdentity, e.Holder.AcquireTime.Format(time.RFC3339), e.Holder.RenewTime.Add(time.Duration(e.Holder.LeaseDurationSeconds)*time.Second).Format(time.RFC3339))
+}
+
+// Lock is an advisory lock on a cluster in the state store, held while mutating the cluster
+// so that concurrent kops processes don't make conflicting changes.
+// The lock is renewed in the background until it is released.
+type Lock struct {
+	key   string
+	state *lockState
+}
+
+// lockState is a lock held by this process.
+type lockState struct {
+	lease  *Lease
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// count is the number of times the lock was acquired and not yet released
+	count int
+}
+
+var (
+	locksMutex sync.Mutex
+	// locks are the locks held by this process, by path, so that locking is reentrant
+	locks = make(map[string]*lockState)
+)
+
+// LockPath returns the path of the lock file for the cluster with the given config base.
+func LockPath(configBase vfs.Path) vfs.Path {
+	return configBase.Join("lock.yaml")
+}
+
+// HolderIdentity returns the identity of this process as a holder of locks and leases.
+func HolderIdentity() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		klog.Warningf("error getting hostname: %v", err)
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
+
+// Acquire locks the cluster with the given config base, returning a LockedError if it is locked by another process.
+// A process may acquire the same lock several times; it is released when every Lock was released.
+func Acquire(ctx context.Context, configBase vfs.Path) (*Lock, error) {
+	p := LockPath(configBase)
+	key := p.Path()
+
+	locksMutex.Lock()
+	defer locksMutex.Unlock()
+
+	if state := locks[key]; state != nil {
+		state.count++
+		return &Lock{key: key, state: state}, nil
+	}
+
+	lease := NewLease(p, HolderIdentity(), DefaultLockDuration)
+	if !lease.Exclusive() {
+		klog.Warningf("WARNING: the state store %s does not support versioned writes, so the cluster lock cannot prevent concurrent kops processes from operating on the cluster; make sure no other kops process is running", configBase)
+	}
+	held, err := lease.TryAcquireOrRenew(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error locking cluster: %w", err)
+	}
+	if !held {
+		holder, err := lease.Get(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error locking cluster: %w", err)
+		}
+		if holder == nil {
+			return nil, fmt.Errorf("error locking cluster: lock %s was changed concurrently", p)
+		}
+		return nil, &LockedError{Path: p, Holder: holder}
+	}
+
+	renewCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	state := &lockState{
+		lease:  lease,
+		cancel: cancel,
+		done:   make(chan struct{}),
+		count:  1,
+	}
+	locks[key] = state
+	go state.renew(renewCtx)
+	return &Lock{key: key, state: state}, nil
+}
+
+// renew renews the lock until the context is cancelled.
+func (s *lockState) renew(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.lease.Duration() / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		held, err := s.lease.TryAcquireOrRenew(ctx)
+		if err != nil {
+			klog.Warningf("error renewing lock %s: %v", s.lease.path, err)
+		} else if !held {
+			klog.Warningf("lost lock %s, another kops process may be operating on the cluster", s.lease.path)
+		}
+	}
+}
+
+// Release releases the lock, so that other processes can lock the cluster.
+// If the lock was acquired several times by this process, it is held until the last Lock is released.
+func (l *Lock) Release(ctx context.Context) error {
+	locksMutex.Lock()
+	defer locksMutex.Unlock()
+
+	l.state.count--
+	if l.state.count > 0 {
+		return nil
+	}
+	delete(locks, l.key)
+
+	l.state.cancel()
+	<-l.state.done
+	if err := l.state.lease.Release(ctx); err != nil {
+		return fmt.Errorf("error unlocking cluster: %w", err)
+	}
+	return nil
+}
+
+// ForceUnlock removes the lock of the cluster with the given config base, whichever process holds it.
+func ForceUnlock(ctx context.Context, configBase vfs.Path) error {
+	p := LockPath(configBase)
+	if err := p.Remove(ctx); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing lock %s: %w", p, err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterlock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func TestLock(t *testing.T) {
+	ctx := context.Background()
+	base := vfs.NewMemFSPath(vfs.NewMemFSContext(), "/state/cluster")
+	other := NewLease(LockPath(base), "other", time.Minute)
+
+	// another process holds the lock
+	if held, err := other.TryAcquireOrRenew(ctx); err != nil || !held {
+		t.Fatalf("expected other to acquire the lock, got %v, %v", held, err)
+	}
+	_, err := Acquire(ctx, base)
+	var lockedErr *LockedError
+	if !errors.As(err, &lockedErr) {
+		t.Fatalf("expected LockedError, got %v", err)
+	}
+	if lockedErr.Holder.HolderIdentity != "other" {
+		t.Errorf("expected lock to be held by %q, got %q", "other", lockedErr.Holder.HolderIdentity)
+	}
+
+	// the lock can be forcibly removed
+	if err := ForceUnlock(ctx, base); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lock, err := Acquire(ctx, base)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if held, err := other.TryAcquireOrRenew(ctx); err != nil || held {
+		t.Fatalf("expected other not to acquire the lock, got %v, %v", held, err)
+	}
+
+	// the lock is reentrant, and held until every acquisition was released
+	nested, err := Acquire(ctx, base)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := nested.Release(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if held, err := other.TryAcquireOrRenew(ctx); err != nil || held {
+		t.Fatalf("expected other not to acquire the lock, got %v, %v", held, err)
+	}
+
+	// other processes can acquire the lock once it was released
+	if err := lock.Release(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if held, err := other.TryAcquireOrRenew(ctx); err != nil || !held {
+		t.Fatalf("expected other to acquire the lock, got %v, %v", held, err)
+	}
+
+	// removing a lock that does not exist is not an error
+	if err := ForceUnlock(ctx, vfs.NewMemFSPath(vfs.NewMemFSContext(), "/state/other")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"regexp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/kopscodecs"
+)
+
+// CloneClusterOptions configures how CloneCluster changes the copy of a cluster
+type CloneClusterOptions struct {
+	// NewName is the name of the new cluster
+	NewName string
+
+	// NetworkCIDR is the IPv4 network CIDR of the new cluster.
+	// Subnets keep their position within the network. If empty, the CIDRs are copied unchanged.
+	NetworkCIDR string
+
+	// DNSZone is the DNS zone of the new cluster. If empty, the DNS zone is copied unchanged.
+	DNSZone string
+
+	// CloudLabels are cloud labels to set on the new cluster, in addition to the copied ones
+	CloudLabels map[string]string
+}
+
+// CloneCluster returns a copy of a cluster and its instance groups for a new cluster.
+// Occurrences of the cluster name in the spec, such as in DNS names and state store paths,
+// are replaced with the new name. The source objects are not modified.
+func CloneCluster(cluster *api.Cluster, instanceGroups []*api.InstanceGroup, options *CloneClusterOptions) (*api.Cluster, []*api.InstanceGroup, error) {
+	oldName := cluster.ObjectMeta.Name
+	newName := options.NewName
+	if newName == "" {
+		return nil, nil, fmt.Errorf("name of the new cluster is required")
+	}
+	if newName == oldName {
+		return nil, nil, fmt.Errorf("new cluster must have a different name from cluster %q", oldName)
+	}
+
+	// Match the name only as whole DNS labels, so that e.g. "prod.example.com" does not match "preprod.example.com"
+	nameRegexp := regexp.MustCompile(`(^|[^A-Za-z0-9-])` + regexp.QuoteMeta(oldName) + `([^A-Za-z0-9-]|$)`)
+
+	clone, err := renameObject(cluster, nameRegexp, newName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error copying cluster: %w", err)
+	}
+	clone.ObjectMeta = cloneObjectMeta(clone.ObjectMeta, newName)
+
+	if options.NetworkCIDR != "" {
+		if err := moveClusterNetwork(clone, options.NetworkCIDR); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if options.DNSZone != "" {
+		clone.Spec.DNSZone = options.DNSZone
+	}
+
+	if len(options.CloudLabels) != 0 {
+		if clone.Spec.CloudLabels == nil {
+			clone.Spec.CloudLabels = make(map[string]string)
+		}
+		for k, v := range options.CloudLabels {
+			clone.Spec.CloudLabels[k] = v
+		}
+	}
+
+	var cloneInstanceGroups []*api.InstanceGroup
+	for _, ig := range instanceGroups {
+		cloneIG, err := renameObject(ig, nameRegexp, newName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error copying instance group %q: %w", ig.ObjectMeta.Name, err)
+		}
+		cloneIG.ObjectMeta = cloneObjectMeta(cloneIG.ObjectMeta, ig.ObjectMeta.Name)
+		cloneIG.ObjectMeta.Labels[api.LabelClusterName] = newName
+		cloneInstanceGroups = append(cloneInstanceGroups, cloneIG)
+	}
+
+	return clone, cloneInstanceGroups, nil
+}
+
+// renameObject copies obj through its versioned representation, replacing the matches of nameRegexp with newName
+func renameObject[T runtime.Object](obj T, nameRegexp *regexp.Regexp, newName string) (T, error) {
+	var zero T
+
+	data, err := kopscodecs.ToVersionedJSON(obj)
+	if err != nil {
+		return zero, err
+	}
+	data = nameRegexp.ReplaceAll(data, []byte("${1}"+newName+"${2}"))
+
+	decoded, _, err := kopscodecs.Decode(data, nil)
+	if err != nil {
+		return zero, err
+	}
+	renamed, ok := decoded.(T)
+	if !ok {
+		return zero, fmt.Errorf("unexpected object type %T", decoded)
+	}
+	return renamed, nil
+}
+
+// cloneObjectMeta returns the metadata for a new object, keeping only its labels and annotations
+func cloneObjectMeta(meta metav1.ObjectMeta, name string) metav1.ObjectMeta {
+	clone := metav1.ObjectMeta{
+		Name:        name,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+	if clone.Labels == nil {
+		clone.Labels = make(map[string]string)
+	}
+	return clone
+}
+
+// moveClusterNetwork changes the network CIDR of the cluster, moving its subnets to the same offsets in the new network
+func moveClusterNetwork(cluster *api.Cluster, networkCIDR string) error {
+	to, err := netip.ParsePrefix(networkCIDR)
+	if err != nil || !to.Addr().Is4() || to.Masked() != to {
+		return fmt.Errorf("network CIDR %q is not a valid IPv4 network", networkCIDR)
+	}
+
+	if cluster.Spec.Networking.NetworkID != "" {
+		return fmt.Errorf("cannot change the network CIDR of a cluster that uses the existing network %q", cluster.Spec.Networking.NetworkID)
+	}
+	if cluster.Spec.Networking.NetworkCIDR == "" {
+		return fmt.Errorf("cannot change the network CIDR of a cluster that does not have a network CIDR")
+	}
+	from, err := netip.ParsePrefix(cluster.Spec.Networking.NetworkCIDR)
+	from = from.Masked()
+	if err != nil || !from.Addr().Is4() {
+		return fmt.Errorf("cannot change the network CIDR of a cluster with network CIDR %q", cluster.Spec.Networking.NetworkCIDR)
+	}
+
+	for i := range cluster.Spec.Networking.Subnets {
+		subnet := &cluster.Spec.Networking.Subnets[i]
+		if subnet.ID != "" {
+			return fmt.Errorf("cannot change the network CIDR of a cluster that uses the existing subnet %q", subnet.ID)
+		}
+		if subnet.CIDR == "" {
+			continue
+		}
+		cidr, err := moveCIDR(subnet.CIDR, from, to)
+		if err != nil {
+			return fmt.Errorf("subnet %q: %w", subnet.Name, err)
+		}
+		subnet.CIDR = cidr
+	}
+	cluster.Spec.Networking.NetworkCIDR = to.String()
+
+	return nil
+}
+
+// moveCIDR returns the CIDR at the same offset in the network to as cidr is in the network from
+func moveCIDR(cidr string, from, to netip.Prefix) (string, error) {
+	p, err := netip.ParsePrefix(cidr)
+	if err != nil || !p.Addr().Is4() {
+		return "", fmt.Errorf("CIDR %q is not a valid IPv4 CIDR", cidr)
+	}
+	if p.Bits() < from.Bits() || !from.Contains(p.Addr()) {
+		return "", fmt.Errorf("CIDR %q is not within the network CIDR %q", cidr, from)
+	}
+
+	fromAddr := from.Addr().As4()
+	toAddr := to.Addr().As4()
+	addr := p.Addr().As4()
+	offset := binary.BigEndian.Uint32(addr[:]) - binary.BigEndian.Uint32(fromAddr[:])
+	var moved [4]byte
+	binary.BigEndian.PutUint32(moved[:], binary.BigEndian.Uint32(toAddr[:])+offset)
+
+	result := netip.PrefixFrom(netip.AddrFrom4(moved), p.Bits())
+	if p.Bits() < to.Bits() || !to.Contains(result.Addr()) {
+		return "", fmt.Errorf("CIDR %q does not fit in the network CIDR %q", result, to)
+	}
+	return result.String(), nil
+}
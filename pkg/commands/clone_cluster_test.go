@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/testutils"
+)
+
+func TestCloneCluster(t *testing.T) {
+	cluster := testutils.BuildMinimalClusterAWS("prod.example.com")
+	cluster.ObjectMeta.UID = "1234"
+	cluster.ObjectMeta.ResourceVersion = "5"
+	cluster.Spec.ConfigStore.Base = "memfs://state/prod.example.com"
+	cluster.Spec.API.PublicName = "api.prod.example.com"
+	cluster.Spec.API.AdditionalSANs = []string{"api.preprod.example.com"}
+	cluster.Spec.DNSZone = "example.com"
+	cluster.Spec.CloudLabels = map[string]string{"Environment": "production", "Cluster": "prod.example.com"}
+	cluster.Spec.Networking.NetworkCIDR = "10.0.0.0/16"
+	cluster.Spec.Networking.Subnets = []kops.ClusterSubnetSpec{
+		{Name: "us-test-1a", Zone: "us-test-1a", CIDR: "10.0.32.0/19", Type: kops.SubnetTypePrivate},
+		{Name: "utility-us-test-1a", Zone: "us-test-1a", CIDR: "10.0.0.0/22", Type: kops.SubnetTypeUtility},
+	}
+	ig := testutils.BuildMinimalNodeInstanceGroup("nodes", "us-test-1a")
+	ig.ObjectMeta.Labels = map[string]string{kops.LabelClusterName: "prod.example.com"}
+	ig.Spec.NodeLabels = map[string]string{"example.com/cluster": "prod.example.com"}
+
+	clone, cloneIGs, err := CloneCluster(cluster, []*kops.InstanceGroup{&ig}, &CloneClusterOptions{
+		NewName:     "staging.example.com",
+		NetworkCIDR: "10.1.0.0/16",
+		CloudLabels: map[string]string{"Environment": "staging"},
+	})
+	if err != nil {
+		t.Fatalf("error cloning cluster: %v", err)
+	}
+
+	if clone.ObjectMeta.Name != "staging.example.com" || clone.ObjectMeta.UID != "" || clone.ObjectMeta.ResourceVersion != "" {
+		t.Errorf("unexpected metadata %+v", clone.ObjectMeta)
+	}
+	if clone.Spec.ConfigStore.Base != "memfs://state/staging.example.com" {
+		t.Errorf("unexpected config base %q", clone.Spec.ConfigStore.Base)
+	}
+	if clone.Spec.API.PublicName != "api.staging.example.com" {
+		t.Errorf("unexpected public name %q", clone.Spec.API.PublicName)
+	}
+	if clone.Spec.API.AdditionalSANs[0] != "api.preprod.example.com" {
+		t.Errorf("unexpected rename of a different name: %q", clone.Spec.API.AdditionalSANs[0])
+	}
+	if clone.Spec.DNSZone != "example.com" {
+		t.Errorf("unexpected DNS zone %q", clone.Spec.DNSZone)
+	}
+	if clone.Spec.CloudLabels["Environment"] != "staging" || clone.Spec.CloudLabels["Cluster"] != "staging.example.com" {
+		t.Errorf("unexpected cloud labels %v", clone.Spec.CloudLabels)
+	}
+	if clone.Spec.Networking.NetworkCIDR != "10.1.0.0/16" {
+		t.Errorf("unexpected network CIDR %q", clone.Spec.Networking.NetworkCIDR)
+	}
+	if clone.Spec.Networking.Subnets[0].CIDR != "10.1.32.0/19" || clone.Spec.Networking.Subnets[1].CIDR != "10.1.0.0/22" {
+		t.Errorf("unexpected subnets %+v", clone.Spec.Networking.Subnets)
+	}
+
+	if len(cloneIGs) != 1 {
+		t.Fatalf("expected 1 instance group, got %d", len(cloneIGs))
+	}
+	if cloneIGs[0].ObjectMeta.Name != "nodes" || cloneIGs[0].ObjectMeta.Labels[kops.LabelClusterName] != "staging.example.com" {
+		t.Errorf("unexpected instance group metadata %+v", cloneIGs[0].ObjectMeta)
+	}
+	if cloneIGs[0].Spec.NodeLabels["example.com/cluster"] != "staging.example.com" {
+		t.Errorf("unexpected node labels %v", cloneIGs[0].Spec.NodeLabels)
+	}
+
+	// The source is not modified
+	if cluster.Spec.API.PublicName != "api.prod.example.com" || cluster.Spec.Networking.Subnets[0].CIDR != "10.0.32.0/19" || ig.Spec.NodeLabels["example.com/cluster"] != "prod.example.com" {
+		t.Errorf("source cluster was modified")
+	}
+}
+
+func TestCloneClusterErrors(t *testing.T) {
+	grid := []struct {
+		name      string
+		options   *CloneClusterOptions
+		networkID string
+		expected  string
+	}{
+		{
+			name:     "same name",
+			options:  &CloneClusterOptions{NewName: "prod.example.com"},
+			expected: "different name",
+		},
+		{
+			name:     "invalid network CIDR",
+			options:  &CloneClusterOptions{NewName: "staging.example.com", NetworkCIDR: "10.1.0.1/16"},
+			expected: "not a valid IPv4 network",
+		},
+		{
+			name:     "network too small",
+			options:  &CloneClusterOptions{NewName: "staging.example.com", NetworkCIDR: "10.1.0.0/20"},
+			expected: "does not fit",
+		},
+		{
+			name:      "existing network",
+			options:   &CloneClusterOptions{NewName: "staging.example.com", NetworkCIDR: "10.1.0.0/16"},
+			networkID: "vpc-12345678",
+			expected:  "existing network",
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			cluster := testutils.BuildMinimalClusterAWS("prod.example.com")
+			cluster.Spec.Networking.NetworkID = g.networkID
+			cluster.Spec.Networking.NetworkCIDR = "10.0.0.0/16"
+			cluster.Spec.Networking.Subnets = []kops.ClusterSubnetSpec{
+				{Name: "us-test-1a", Zone: "us-test-1a", CIDR: "10.0.32.0/19", Type: kops.SubnetTypePrivate},
+			}
+			_, _, err := CloneCluster(cluster, nil, g.options)
+			if err == nil || !strings.Contains(err.Error(), g.expected) {
+				t.Errorf("expected error containing %q, got %v", g.expected, err)
+			}
+		})
+	}
+}
+
+func TestMoveCIDR(t *testing.T) {
+	from := netip.MustParsePrefix("172.20.0.0/16")
+	to := netip.MustParsePrefix("10.10.0.0/16")
+	for cidr, expected := range map[string]string{
+		"172.20.0.0/19":   "10.10.0.0/19",
+		"172.20.128.0/20": "10.10.128.0/20",
+		"172.20.255.0/24": "10.10.255.0/24",
+	} {
+		actual, err := moveCIDR(cidr, from, to)
+		if err != nil {
+			t.Errorf("unexpected error moving %q: %v", cidr, err)
+		} else if actual != expected {
+			t.Errorf("moving %q: expected %q, got %q", cidr, expected, actual)
+		}
+	}
+	if _, err := moveCIDR("172.21.0.0/24", from, to); err == nil {
+		t.Errorf("expected error moving a CIDR outside of the network")
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"filippo.io/age"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/kops/pkg/kubeconfig"
+	"k8s.io/kubectl/pkg/util/i18n"
+)
+
+var decryptKubeconfigShort = i18n.T(`Decrypt a kubeconfig exported with kops export kubeconfig --encrypt-to`)
+
+// HelperDecryptKubeconfigOptions holds the options for decrypting an exported kubeconfig
+type HelperDecryptKubeconfigOptions struct {
+	// Filename is the encrypted kubeconfig, or "-" to read from stdin
+	Filename string
+
+	// IdentityFiles are age identity files holding the keys to decrypt with
+	IdentityFiles []string
+
+	// Output is the file to write the decrypted kubeconfig to; it is written to stdout if empty
+	Output string
+}
+
+// NewCmdHelperDecryptKubeconfig builds a cobra command for the decrypt-kubeconfig command
+func NewCmdHelperDecryptKubeconfig(out io.Writer) *cobra.Command {
+	options := &HelperDecryptKubeconfigOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "decrypt-kubeconfig FILE",
+		Short: decryptKubeconfigShort,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Filename = args[0]
+
+			return RunDecryptKubeconfigHelper(cmd.InOrStdin(), out, options)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&options.IdentityFiles, "identity", "i", options.IdentityFiles, "age identity file to decrypt with (may be repeated)")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "file to write the decrypted kubeconfig to, instead of stdout")
+
+	return cmd
+}
+
+// RunDecryptKubeconfigHelper decrypts an encrypted kubeconfig with the given identities
+func RunDecryptKubeconfigHelper(in io.Reader, out io.Writer, options *HelperDecryptKubeconfigOptions) error {
+	if len(options.IdentityFiles) == 0 {
+		return fmt.Errorf("at least one --identity must be specified")
+	}
+
+	var identities []age.Identity
+	for _, identityFile := range options.IdentityFiles {
+		f, err := os.Open(identityFile)
+		if err != nil {
+			return fmt.Errorf("error opening identity file: %w", err)
+		}
+		ids, err := kubeconfig.ParseIdentities(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("error reading identity file %q: %w", identityFile, err)
+		}
+		identities = append(identities, ids...)
+	}
+
+	src := in
+	if options.Filename != "-" {
+		f, err := os.Open(options.Filename)
+		if err != nil {
+			return fmt.Errorf("error opening encrypted kubeconfig: %w", err)
+		}
+		defer f.Close()
+		src = f
+	}
+
+	config, err := kubeconfig.DecryptKubecfg(src, identities)
+	if err != nil {
+		return err
+	}
+
+	if options.Output != "" {
+		// clientcmd.WriteToFile creates the file with 0600 permissions
+		if err := clientcmd.WriteToFile(*config, options.Output); err != nil {
+			return fmt.Errorf("error writing kubeconfig %q: %w", options.Output, err)
+		}
+		return nil
+	}
+
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		return fmt.Errorf("error serializing kubeconfig: %w", err)
+	}
+	_, err = out.Write(data)
+	return err
+}
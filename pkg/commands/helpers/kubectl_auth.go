@@ -35,7 +35,6 @@ import (
 	"k8s.io/client-go/util/homedir"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/cmd/kops/util"
-	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/pki"
 	"k8s.io/kops/pkg/rbac"
 	"k8s.io/kops/upup/pkg/fi"
@@ -70,13 +69,8 @@ func NewCmdHelperKubectlAuth(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "kubectl-auth",
 		Short: kubectlAuthShort,
-		Run: func(cmd *cobra.Command, args []string) {
-			ctx := cmd.Context()
-
-			err := RunKubectlAuthHelper(ctx, f, out, options)
-			if err != nil {
-				commandutils.ExitWithError(err)
-			}
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunKubectlAuthHelper(cmd.Context(), f, out, options)
 		},
 	}
 
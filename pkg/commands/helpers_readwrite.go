@@ -52,7 +52,7 @@ func UpdateCluster(ctx context.Context, clientset simple.Clientset, cluster *kop
 	}
 
 	// Retrieve the current status of the cluster.  This will eventually be part of the cluster object.
-	status, err := cloud.FindClusterStatus(cluster)
+	status, err := cloud.FindClusterStatus(ctx, cluster)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	kopsmodel "k8s.io/kops/pkg/apis/kops/model"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/imagebuilder"
+	"k8s.io/kops/pkg/nodemodel"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/architectures"
+)
+
+// ToolboxBuildImageOptions are the options for building a node image with the assets of a cluster preloaded.
+type ToolboxBuildImageOptions struct {
+	ClusterName   string
+	InstanceGroup string
+
+	// SSHUsername is the user Packer connects to the build instance as; guessed from the source image if empty.
+	SSHUsername string
+	// SubnetID is the subnet to launch the build instance in.
+	SubnetID string
+	// Out is the directory the Packer template is written to; a temporary directory if empty.
+	Out string
+	// Packer is the Packer binary.
+	Packer string
+
+	// Yes builds the image and updates the instance group, instead of only writing the Packer template.
+	Yes bool
+}
+
+func (o *ToolboxBuildImageOptions) InitDefaults() {
+	o.Packer = "packer"
+}
+
+// RunToolboxBuildImage generates a Packer template that builds an image for the instance group, with
+// its file assets and container images preloaded. With Yes, it runs Packer and sets the image of the
+// instance group to the new image.
+func RunToolboxBuildImage(ctx context.Context, f commandutils.Factory, out io.Writer, options *ToolboxBuildImageOptions) error {
+	if options.ClusterName == "" {
+		return fmt.Errorf("cluster is required")
+	}
+	if options.InstanceGroup == "" {
+		return fmt.Errorf("instance-group is required")
+	}
+
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	configBuilder := &ConfigBuilder{
+		Clientset:         clientset,
+		ClusterName:       options.ClusterName,
+		InstanceGroupName: options.InstanceGroup,
+	}
+
+	cluster, err := configBuilder.GetFullCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if cluster.GetCloudProvider() != kops.CloudProviderAWS {
+		return fmt.Errorf("building images is only supported on AWS")
+	}
+	if cluster.Spec.Assets == nil || cluster.Spec.Assets.NodeDownloads == nil || cluster.Spec.Assets.NodeDownloads.CacheDirectory == "" {
+		return fmt.Errorf("spec.assets.nodeDownloads.cacheDirectory must be set, so that nodes use the assets preloaded in the image")
+	}
+
+	ig, err := configBuilder.GetFullInstanceGroup(ctx)
+	if err != nil {
+		return err
+	}
+
+	cloud, err := configBuilder.GetCloud(ctx)
+	if err != nil {
+		return err
+	}
+	sourceImage, err := cloud.(awsup.AWSCloud).ResolveImage(ig.Spec.Image)
+	if err != nil {
+		return fmt.Errorf("resolving image %q: %w", ig.Spec.Image, err)
+	}
+
+	arch := architectures.ArchitectureAmd64
+	if sourceImage.Architecture == ec2types.ArchitectureValuesArm64 {
+		arch = architectures.ArchitectureArm64
+	}
+
+	assetBuilder, err := configBuilder.GetAssetBuilder(ctx)
+	if err != nil {
+		return err
+	}
+	igModel, err := kopsmodel.ForInstanceGroup(cluster, ig)
+	if err != nil {
+		return fmt.Errorf("building instance group model: %w", err)
+	}
+	if !ig.HasAPIServer() && assetBuilder.KubeletSupportedVersion != "" {
+		// Match the kubelet version that nodeup will install
+		if err := igModel.ForceKubernetesVersion(assetBuilder.KubeletSupportedVersion); err != nil {
+			return err
+		}
+	}
+	kubernetesAssets, err := nodemodel.BuildKubernetesFileAssets(igModel, assetBuilder)
+	if err != nil {
+		return err
+	}
+
+	imageOptions := &imagebuilder.Options{
+		ClusterName:       cluster.Name,
+		InstanceGroupName: ig.Name,
+		CacheDirectory:    cluster.Spec.Assets.NodeDownloads.CacheDirectory,
+		Assets:            kubernetesAssets.KubernetesFileAssets[arch],
+		Region:            cloud.Region(),
+		SourceImage:       *sourceImage.ImageId,
+		InstanceType:      strings.Split(ig.Spec.MachineType, ",")[0],
+		SSHUsername:       options.SSHUsername,
+		SubnetID:          options.SubnetID,
+		ImageName:         fmt.Sprintf("kops-%s-%s-%s", ig.Name, cluster.Name, time.Now().UTC().Format("20060102150405")),
+	}
+	if !cluster.UsesCRIO() {
+		imageOptions.Images = nodemodel.PreloadImages(assetBuilder, ig)
+	}
+	if imageOptions.SSHUsername == "" {
+		imageOptions.SSHUsername = guessSSHUsername(aws.ToString(sourceImage.Name))
+	}
+
+	script, err := imagebuilder.BuildPreloadScript(imageOptions)
+	if err != nil {
+		return err
+	}
+	template, err := imagebuilder.BuildPackerTemplate(imageOptions)
+	if err != nil {
+		return err
+	}
+
+	dir := options.Out
+	if dir == "" {
+		dir, err = os.MkdirTemp("", "kops-build-image-")
+		if err != nil {
+			return err
+		}
+	} else if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("creating %q: %w", dir, err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, imagebuilder.PreloadScriptName), script, 0o644); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, imagebuilder.PackerTemplateName), template, 0o644); err != nil {
+		return err
+	}
+
+	if !options.Yes {
+		fmt.Fprintf(out, "Wrote Packer template for instance group %q, preloading %d files and %d images, to %s\n", ig.Name, len(imageOptions.Assets), len(imageOptions.Images), dir)
+		fmt.Fprintf(out, "\nMust specify --yes to build the image and use it for the instance group\n")
+		return nil
+	}
+
+	imageID, err := runPacker(ctx, out, options.Packer, dir)
+	if err != nil {
+		return err
+	}
+
+	// Update the unexpanded instance group, so that we don't persist defaults
+	original, err := configBuilder.GetInstanceGroup(ctx)
+	if err != nil {
+		return err
+	}
+	original.Spec.Image = imageID
+	if _, err := clientset.InstanceGroupsFor(configBuilder.Cluster).Update(ctx, original, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("updating instance group %q: %w", ig.Name, err)
+	}
+
+	fmt.Fprintf(out, "\nBuilt image %s and set it as the image of instance group %q.\n", imageID, ig.Name)
+	fmt.Fprintf(out, "Run kops update cluster --yes and kops rolling-update cluster --yes to roll it out.\n")
+	return nil
+}
+
+// runPacker builds the template in dir, returning the ID of the new image.
+func runPacker(ctx context.Context, out io.Writer, packer string, dir string) (string, error) {
+	klog.Infof("running %s init in %s", packer, dir)
+	initCmd := exec.CommandContext(ctx, packer, "init", ".")
+	initCmd.Dir = dir
+	initCmd.Stdout = out
+	initCmd.Stderr = os.Stderr
+	if err := initCmd.Run(); err != nil {
+		return "", fmt.Errorf("running packer init: %w", err)
+	}
+
+	klog.Infof("running %s build in %s", packer, dir)
+	var output bytes.Buffer
+	buildCmd := exec.CommandContext(ctx, packer, "build", "-machine-readable", ".")
+	buildCmd.Dir = dir
+	buildCmd.Stdout = io.MultiWriter(out, &output)
+	buildCmd.Stderr = os.Stderr
+	if err := buildCmd.Run(); err != nil {
+		return "", fmt.Errorf("running packer build: %w", err)
+	}
+
+	return imagebuilder.ParseArtifactID(&output)
+}
+
+// guessSSHUsername returns the default user of well-known images.
+func guessSSHUsername(imageName string) string {
+	name := strings.ToLower(imageName)
+	switch {
+	case strings.Contains(name, "ubuntu"):
+		return "ubuntu"
+	case strings.Contains(name, "debian"):
+		return "admin"
+	case strings.Contains(name, "flatcar"):
+		return "core"
+	case strings.Contains(name, "rocky"):
+		return "rocky"
+	default:
+		return "ec2-user"
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunPacker(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not found")
+	}
+
+	// A fake packer that reports an artifact when building.
+	packer := filepath.Join(t.TempDir(), "packer")
+	script := `#!/bin/sh
+if [ "$1" = "build" ]; then
+  echo "1760659300,amazon-ebs.kops,artifact,0,id,us-test-1:ami-0fedcba9876543210"
+fi
+`
+	if err := os.WriteFile(packer, []byte(script), 0o755); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	var out bytes.Buffer
+	id, err := runPacker(context.Background(), &out, packer, t.TempDir())
+	if err != nil {
+		t.Fatalf("runPacker() error = %v", err)
+	}
+	if id != "ami-0fedcba9876543210" {
+		t.Errorf("runPacker() = %q, expected %q", id, "ami-0fedcba9876543210")
+	}
+}
+
+func TestGuessSSHUsername(t *testing.T) {
+	grid := map[string]string{
+		"ubuntu/images/hvm-ssd-gp3/ubuntu-noble-24.04-amd64-server-20250610": "ubuntu",
+		"debian-12-amd64-20250610-2137":                                      "admin",
+		"Flatcar-stable-4152.2.3-hvm":                                        "core",
+		"Rocky-9-EC2-Base-9.6-20250531.0.x86_64":                             "rocky",
+		"al2023-ami-2023.7.20250609.0-kernel-6.1-x86_64":                     "ec2-user",
+	}
+	for name, expected := range grid {
+		if actual := guessSSHUsername(name); actual != expected {
+			t.Errorf("guessSSHUsername(%q) = %q, expected %q", name, actual, expected)
+		}
+	}
+}
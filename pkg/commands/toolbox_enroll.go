@@ -31,12 +31,16 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"golang.org/x/crypto/ssh"
 	"golang.org/x/crypto/ssh/agent"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -62,27 +66,49 @@ import (
 	"k8s.io/kops/util/pkg/vfs"
 )
 
+const (
+	// NodeTransportSSH runs commands on machines over SSH.
+	NodeTransportSSH = "ssh"
+	// NodeTransportSSM runs commands on AWS instances through AWS Systems Manager, without SSH access.
+	NodeTransportSSM = "ssm"
+)
+
 type ToolboxEnrollOptions struct {
 	ClusterName   string
 	InstanceGroup string
 
+	// Host is the IP/hostname of the machine, or its instance ID with the ssm transport
 	Host string
 
+	// Transport is how commands are run on the machine: ssh or ssm
+	Transport string
+
 	SSHUser string
 	SSHPort int
 
+	// AWSRegion is the region of the instance, with the ssm transport
+	AWSRegion string
+
 	// BuildHost is a flag to only build the host resource, don't apply it or enroll the node
 	BuildHost bool
 
 	// PodCIDRs is the list of IP Address ranges to use for pods that run on this node
 	PodCIDRs []string
 
+	// VerifyTimeout is how long to wait for the node to become Ready after enrolling it; zero skips the verification
+	VerifyTimeout time.Duration
+
+	// SkipPreflightChecks skips checking the OS prerequisites of the host before enrolling it
+	SkipPreflightChecks bool
+
 	kubeconfig.CreateKubecfgOptions
 }
 
 func (o *ToolboxEnrollOptions) InitDefaults() {
+	o.Transport = NodeTransportSSH
 	o.SSHUser = "root"
 	o.SSHPort = 22
+	o.VerifyTimeout = 15 * time.Minute
 }
 
 func RunToolboxEnroll(ctx context.Context, f commandutils.Factory, out io.Writer, options *ToolboxEnrollOptions) error {
@@ -99,6 +125,9 @@ func RunToolboxEnroll(ctx context.Context, f commandutils.Factory, out io.Writer
 		// Technically we could build the host resource without the PKI, but this isn't the case we are targeting right now.
 		return fmt.Errorf("host is required")
 	}
+	if options.Transport != NodeTransportSSH && options.Transport != NodeTransportSSM {
+		return fmt.Errorf("unsupported transport %q; must be %s or %s", options.Transport, NodeTransportSSH, NodeTransportSSM)
+	}
 
 	// Resolve KOPS_BASE_URL early so that kops.Version is overridden
 	// before the version downgrade check in ApplyClusterCmd.Run.
@@ -122,21 +151,18 @@ func RunToolboxEnroll(ctx context.Context, f commandutils.Factory, out io.Writer
 		return err
 	}
 
-	// Enroll the node over SSH.
 	restConfig, err := f.RESTConfig(ctx, fullCluster, options.CreateKubecfgOptions)
 	if err != nil {
 		return err
 	}
 
-	sudo := options.SSHUser != "root"
-
-	sshTarget, err := NewSSHHost(ctx, options.Host, options.SSHPort, options.SSHUser, sudo)
+	target, err := newEnrollTarget(ctx, options)
 	if err != nil {
 		return err
 	}
-	defer sshTarget.Close()
+	defer target.Close()
 
-	hostData, err := buildHostData(ctx, sshTarget, options)
+	hostData, err := buildHostData(ctx, target, options)
 	if err != nil {
 		return err
 	}
@@ -155,23 +181,130 @@ func RunToolboxEnroll(ctx context.Context, f commandutils.Factory, out io.Writer
 	if err != nil {
 		return err
 	}
+
+	if !options.SkipPreflightChecks {
+		if err := runPreflightChecks(ctx, target, buildPreflightConfig(fullCluster, fullInstanceGroup)); err != nil {
+			return err
+		}
+	}
+
 	bootstrapData, err := configBuilder.GetBootstrapData(ctx)
 	if err != nil {
 		return err
 	}
 
-	if err := enrollHost(ctx, fullInstanceGroup, bootstrapData, restConfig, hostData, sshTarget); err != nil {
+	if err := enrollHost(ctx, fullInstanceGroup, bootstrapData, restConfig, hostData, target); err != nil {
 		return err
 	}
 
+	if options.VerifyTimeout == 0 {
+		return nil
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("building kubernetes client: %w", err)
+	}
+
+	// The hostname is the node name, see buildHostData
+	nodeName := hostData.Name
+	klog.Infof("waiting up to %v for node %q to become Ready", options.VerifyTimeout, nodeName)
+	if err := waitForNodeReady(ctx, k8sClient, nodeName, options.VerifyTimeout, 10*time.Second); err != nil {
+		fmt.Fprintf(out, "Node %q did not become Ready after enrollment: %v\n\n", nodeName, err)
+		fmt.Fprintf(out, "Diagnostics from %s:\n%s\n", options.Host, diagnoseEnrolledHost(ctx, target))
+		return fmt.Errorf("node %q did not become Ready within %v", nodeName, options.VerifyTimeout)
+	}
+	fmt.Fprintf(out, "Node %q is Ready\n", nodeName)
+
+	return nil
+}
+
+// enrollTarget is a connection to the machine being enrolled.
+type enrollTarget interface {
+	io.Closer
+
+	// address returns the address of the machine, for messages.
+	address() string
+
+	readFile(ctx context.Context, path string) ([]byte, error)
+	writeFile(ctx context.Context, path string, data io.ReadSeeker) error
+	runScript(ctx context.Context, script string, options ExecOptions) (*CommandOutput, error)
+	runCommand(ctx context.Context, command string, options ExecOptions) (*CommandOutput, error)
+}
+
+var (
+	_ enrollTarget = &SSHHost{}
+	_ enrollTarget = &SSMHost{}
+)
+
+// newEnrollTarget connects to the machine with the transport in the options.
+func newEnrollTarget(ctx context.Context, options *ToolboxEnrollOptions) (enrollTarget, error) {
+	switch options.Transport {
+	case NodeTransportSSM:
+		return NewSSMHost(ctx, options.Host, options.AWSRegion)
+	default:
+		sudo := options.SSHUser != "root"
+		return NewSSHHost(ctx, options.Host, options.SSHPort, options.SSHUser, sudo)
+	}
+}
+
+// waitForNodeReady polls the API server until the node is registered and Ready.
+// On timeout, the returned error describes the last observed state of the node.
+func waitForNodeReady(ctx context.Context, k8sClient kubernetes.Interface, nodeName string, timeout time.Duration, interval time.Duration) error {
+	lastState := fmt.Errorf("node has not registered")
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, true, func(ctx context.Context) (bool, error) {
+		node, err := k8sClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				lastState = fmt.Errorf("node has not registered")
+			} else {
+				// The API server may not be reachable yet, in particular when enrolling a control plane node
+				klog.V(2).Infof("error getting node %q: %v", nodeName, err)
+				lastState = fmt.Errorf("error getting node: %w", err)
+			}
+			return false, nil
+		}
+
+		for _, condition := range node.Status.Conditions {
+			if condition.Type != corev1.NodeReady {
+				continue
+			}
+			if condition.Status == corev1.ConditionTrue {
+				return true, nil
+			}
+			lastState = fmt.Errorf("node is not Ready: %s: %s", condition.Reason, condition.Message)
+			return false, nil
+		}
+		lastState = fmt.Errorf("node has no Ready condition")
+		return false, nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
+		return lastState
+	}
 	return nil
 }
 
-// buildHostData builds an instance of the Host CRD, based on information in the options and by SSHing to the target host.
-func buildHostData(ctx context.Context, sshTarget *SSHHost, options *ToolboxEnrollOptions) (*v1alpha2.Host, error) {
+// diagnoseEnrolledHost collects the state of the services needed by the node, for reporting enrollment failures.
+func diagnoseEnrolledHost(ctx context.Context, target enrollTarget) string {
+	output, err := target.runScript(ctx, scriptDiagnoseHost, ExecOptions{Echo: false})
+	if output == nil {
+		return fmt.Sprintf("unable to collect diagnostics: %v", err)
+	}
+	s := output.Stdout.String() + output.Stderr.String()
+	if err != nil {
+		s += fmt.Sprintf("\nerror collecting diagnostics: %v", err)
+	}
+	return s
+}
+
+// buildHostData builds an instance of the Host CRD, based on information in the options and by connecting to the target host.
+func buildHostData(ctx context.Context, target enrollTarget, options *ToolboxEnrollOptions) (*v1alpha2.Host, error) {
 	publicKeyPath := "/etc/kubernetes/kops/pki/machine/public.pem"
 
-	publicKeyBytes, err := sshTarget.readFile(ctx, publicKeyPath)
+	publicKeyBytes, err := target.readFile(ctx, publicKeyPath)
 	if err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
 			publicKeyBytes = nil
@@ -183,11 +316,11 @@ func buildHostData(ctx context.Context, sshTarget *SSHHost, options *ToolboxEnro
 	// Create the key if it doesn't exist
 	publicKeyBytes = bytes.TrimSpace(publicKeyBytes)
 	if len(publicKeyBytes) == 0 {
-		if _, err := sshTarget.runScript(ctx, scriptCreateKey, ExecOptions{Echo: true}); err != nil {
+		if _, err := target.runScript(ctx, scriptCreateKey, ExecOptions{Echo: true}); err != nil {
 			return nil, err
 		}
 
-		b, err := sshTarget.readFile(ctx, publicKeyPath)
+		b, err := target.readFile(ctx, publicKeyPath)
 		if err != nil {
 			return nil, fmt.Errorf("error reading public key %q (after creation): %w", publicKeyPath, err)
 		}
@@ -195,7 +328,7 @@ func buildHostData(ctx context.Context, sshTarget *SSHHost, options *ToolboxEnro
 	}
 	klog.Infof("public key is %s", string(publicKeyBytes))
 
-	hostname, err := sshTarget.getHostname(ctx)
+	hostname, err := getHostname(ctx, target)
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +344,7 @@ func buildHostData(ctx context.Context, sshTarget *SSHHost, options *ToolboxEnro
 	return host, nil
 }
 
-func enrollHost(ctx context.Context, ig *kops.InstanceGroup, bootstrapData *BootstrapData, restConfig *rest.Config, hostData *v1alpha2.Host, sshTarget *SSHHost) error {
+func enrollHost(ctx context.Context, ig *kops.InstanceGroup, bootstrapData *BootstrapData, restConfig *rest.Config, hostData *v1alpha2.Host, target enrollTarget) error {
 	scheme := runtime.NewScheme()
 	if err := v1alpha2.AddToScheme(scheme); err != nil {
 		return fmt.Errorf("building kubernetes scheme: %w", err)
@@ -237,13 +370,13 @@ func enrollHost(ctx context.Context, ig *kops.InstanceGroup, bootstrapData *Boot
 	}
 
 	for k, v := range bootstrapData.NodeupScriptAdditionalFiles {
-		if err := sshTarget.writeFile(ctx, k, bytes.NewReader(v)); err != nil {
-			return fmt.Errorf("writing file %q over SSH: %w", k, err)
+		if err := target.writeFile(ctx, k, bytes.NewReader(v)); err != nil {
+			return fmt.Errorf("writing file %q to %s: %w", k, target.address(), err)
 		}
 	}
 
 	if len(bootstrapData.NodeupScript) != 0 {
-		if _, err := sshTarget.runScript(ctx, string(bootstrapData.NodeupScript), ExecOptions{Echo: true}); err != nil {
+		if _, err := target.runScript(ctx, string(bootstrapData.NodeupScript), ExecOptions{Echo: true}); err != nil {
 			return err
 		}
 	}
@@ -270,6 +403,19 @@ if [[ ! -f "${DIR}/public.pem" ]]; then
 fi
 `
 
+const scriptDiagnoseHost = `
+#!/bin/bash
+set -o nounset
+
+for unit in containerd kubelet; do
+  echo "${unit}: $(systemctl is-active ${unit})"
+done
+
+echo
+echo "Last kubelet logs:"
+journalctl -u kubelet -n 30 --no-pager
+`
+
 // SSHHost is a wrapper around an SSH connection to a host machine.
 type SSHHost struct {
 	hostname  string
@@ -335,6 +481,11 @@ func NewSSHHost(ctx context.Context, host string, sshPort int, sshUser string, s
 	}, nil
 }
 
+// address returns the hostname used to connect.
+func (s *SSHHost) address() string {
+	return s.hostname
+}
+
 func (s *SSHHost) readFile(ctx context.Context, path string) ([]byte, error) {
 	p := vfs.NewSSHPath(s.sshClient, s.hostname, path, s.sudo)
 
@@ -411,10 +562,10 @@ func (s *SSHHost) runCommand(ctx context.Context, command string, options ExecOp
 	return output, nil
 }
 
-// getHostname gets the hostname of the SSH target.
+// getHostname gets the hostname of the target.
 // This is used as the node name when registering the node.
-func (s *SSHHost) getHostname(ctx context.Context) (string, error) {
-	output, err := s.runCommand(ctx, "hostname", ExecOptions{Echo: true})
+func getHostname(ctx context.Context, target enrollTarget) (string, error) {
+	output, err := target.runCommand(ctx, "hostname", ExecOptions{Echo: true})
 	if err != nil {
 		return "", fmt.Errorf("failed to get hostname: %w", err)
 	}
@@ -722,7 +873,7 @@ func (b *ConfigBuilder) GetWellKnownAddresses(ctx context.Context) (model.WellKn
 	// Determine the well-known addresses for the cluster.
 	wellKnownAddresses := make(model.WellKnownAddresses)
 	{
-		ingresses, err := cloud.GetApiIngressStatus(fullCluster)
+		ingresses, err := cloud.GetApiIngressStatus(ctx, fullCluster)
 		if err != nil {
 			return nil, fmt.Errorf("error getting ingress status: %v", err)
 		}
@@ -802,7 +953,7 @@ func (b *ConfigBuilder) GetBootstrapData(ctx context.Context) (*BootstrapData, e
 		return nil, err
 	}
 
-	configBuilder, err := nodemodel.NewNodeUpConfigBuilder(cluster, assetBuilder, encryptionConfigSecretHash)
+	configBuilder, err := nodemodel.NewNodeUpConfigBuilder(cluster, assetBuilder, encryptionConfigSecretHash, "")
 	if err != nil {
 		return nil, err
 	}
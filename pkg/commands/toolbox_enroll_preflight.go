@@ -0,0 +1,301 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/wellknownports"
+)
+
+const (
+	// preflightMinKernelVersion is the minimum kernel version, as required by the kubelet for cgroup v2
+	preflightMinKernelVersion = "5.8"
+	// preflightMinDiskMiB is the minimum free disk space on /var, which holds container images and nodeup downloads
+	preflightMinDiskMiB = 10 * 1024
+)
+
+// preflightKernelModules are the kernel modules needed by containerd and the pod network
+var preflightKernelModules = []string{"overlay", "br_netfilter"}
+
+// PreflightCheckStatus is the outcome of a preflight check.
+type PreflightCheckStatus string
+
+const (
+	PreflightCheckPassed  PreflightCheckStatus = "pass"
+	PreflightCheckWarning PreflightCheckStatus = "warn"
+	PreflightCheckFailed  PreflightCheckStatus = "fail"
+)
+
+// PreflightCheckResult is the result of a single preflight check run on a host.
+type PreflightCheckResult struct {
+	// Name is the name of the check, for example "swap"
+	Name string
+	// Status is the outcome of the check
+	Status PreflightCheckStatus
+	// Message describes what was found on the host
+	Message string
+}
+
+// PreflightError is returned when a host fails preflight checks.
+type PreflightError struct {
+	// Host is the host that was checked
+	Host string
+	// Failures are the failed checks
+	Failures []PreflightCheckResult
+}
+
+func (e *PreflightError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "host %q failed %d preflight check(s):", e.Host, len(e.Failures))
+	for _, failure := range e.Failures {
+		fmt.Fprintf(&b, "\n  %s: %s", failure.Name, failure.Message)
+	}
+	b.WriteString("\nfix the host and retry, or use --skip-preflight-checks to enroll it anyway")
+	return b.String()
+}
+
+// preflightConfig holds the host requirements that depend on the cluster and instance group.
+type preflightConfig struct {
+	// Ports are the TCP ports that must not be in use
+	Ports []int
+	// AllowSwap is true if the kubelet is configured to start on hosts with swap enabled
+	AllowSwap bool
+}
+
+// buildPreflightConfig builds the requirements for enrolling a host into the instance group.
+func buildPreflightConfig(cluster *kops.Cluster, ig *kops.InstanceGroup) *preflightConfig {
+	config := &preflightConfig{}
+
+	ports := []int{wellknownports.KubeletAPI}
+	kubelet := cluster.Spec.Kubelet
+	if ig.IsControlPlane() {
+		apiServerPort := wellknownports.KubeAPIServer
+		if cluster.Spec.KubeAPIServer != nil && cluster.Spec.KubeAPIServer.SecurePort != 0 {
+			apiServerPort = int(cluster.Spec.KubeAPIServer.SecurePort)
+		}
+		ports = append(ports,
+			apiServerPort,
+			wellknownports.KubeAPIServerHealthCheck,
+			wellknownports.KopsControllerPort,
+			wellknownports.EtcdMainClientPort,
+			wellknownports.EtcdMainPeerPort,
+			wellknownports.EtcdMainGRPC,
+			wellknownports.EtcdEventsClientPort,
+			wellknownports.EtcdEventsPeerPort,
+			wellknownports.EtcdEventsGRPC,
+			wellknownports.KubeControllerManagerMetricsPort,
+			wellknownports.KubeSchedulerMetricsPort,
+		)
+		kubelet = cluster.Spec.ControlPlaneKubelet
+	}
+	if ig.Spec.Kubelet != nil && ig.Spec.Kubelet.FailSwapOn != nil {
+		kubelet = ig.Spec.Kubelet
+	}
+	slices.Sort(ports)
+	config.Ports = slices.Compact(ports)
+
+	config.AllowSwap = kubelet != nil && kubelet.FailSwapOn != nil && !*kubelet.FailSwapOn
+
+	return config
+}
+
+// buildPreflightScript returns the script that checks the host against the requirements.
+func buildPreflightScript(config *preflightConfig) string {
+	var ports []string
+	for _, port := range config.Ports {
+		ports = append(ports, strconv.Itoa(port))
+	}
+
+	var b strings.Builder
+	b.WriteString("#!/bin/bash\n")
+	fmt.Fprintf(&b, "MIN_KERNEL_VERSION=%q\n", preflightMinKernelVersion)
+	fmt.Fprintf(&b, "MIN_DISK_MIB=%d\n", preflightMinDiskMiB)
+	fmt.Fprintf(&b, "KERNEL_MODULES=%q\n", strings.Join(preflightKernelModules, " "))
+	fmt.Fprintf(&b, "PORTS=%q\n", strings.Join(ports, " "))
+	fmt.Fprintf(&b, "ALLOW_SWAP=%t\n", config.AllowSwap)
+	b.WriteString(scriptPreflight)
+	return b.String()
+}
+
+// runPreflightChecks checks that the host meets the OS prerequisites of nodeup and the kubelet.
+// Warnings are logged; failures are returned as a *PreflightError.
+func runPreflightChecks(ctx context.Context, target enrollTarget, config *preflightConfig) error {
+	output, err := target.runScript(ctx, buildPreflightScript(config), ExecOptions{Echo: false})
+	if err != nil {
+		if output != nil {
+			klog.Warningf("preflight script output: %s", output.Stderr.String())
+		}
+		return fmt.Errorf("running preflight checks: %w", err)
+	}
+
+	results := parsePreflightOutput(output.Stdout.String())
+	if len(results) == 0 {
+		return fmt.Errorf("preflight checks on host %q returned no results", target.address())
+	}
+
+	preflightErr := &PreflightError{Host: target.address()}
+	for _, result := range results {
+		switch result.Status {
+		case PreflightCheckPassed:
+			klog.V(2).Infof("preflight check %s passed: %s", result.Name, result.Message)
+		case PreflightCheckWarning:
+			klog.Warningf("preflight check %s: %s", result.Name, result.Message)
+		default:
+			preflightErr.Failures = append(preflightErr.Failures, result)
+		}
+	}
+	if len(preflightErr.Failures) != 0 {
+		return preflightErr
+	}
+	return nil
+}
+
+// parsePreflightOutput parses the results printed by the preflight script.
+// Each result is a line of the form "preflight<TAB>name<TAB>status<TAB>message"; other lines are ignored.
+func parsePreflightOutput(output string) []PreflightCheckResult {
+	var results []PreflightCheckResult
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.SplitN(strings.TrimRight(line, "\r"), "\t", 4)
+		if len(fields) != 4 || fields[0] != "preflight" {
+			continue
+		}
+		status := PreflightCheckStatus(fields[2])
+		switch status {
+		case PreflightCheckPassed, PreflightCheckWarning, PreflightCheckFailed:
+		default:
+			klog.Warningf("ignoring preflight check %q with unknown status %q", fields[1], fields[2])
+			continue
+		}
+		results = append(results, PreflightCheckResult{
+			Name:    fields[1],
+			Status:  status,
+			Message: fields[3],
+		})
+	}
+	return results
+}
+
+// scriptPreflight is appended to the variables set by buildPreflightScript.
+// It always exits successfully; the outcome of each check is printed to stdout.
+const scriptPreflight = `
+set -o nounset
+
+result() {
+  printf 'preflight\t%s\t%s\t%s\n' "$1" "$2" "$3"
+}
+
+# kernel: the kubelet requires a recent kernel for cgroup v2
+kernel=$(uname -r)
+if printf '%s\n%s\n' "${MIN_KERNEL_VERSION}" "${kernel%%-*}" | sort -V -C; then
+  result kernel pass "kernel ${kernel}"
+else
+  result kernel fail "kernel ${kernel} is older than the minimum version ${MIN_KERNEL_VERSION}"
+fi
+
+# cgroup2: cgroup v1 is not supported by the kubelet
+cgroupfs=$(stat -fc %T /sys/fs/cgroup 2>/dev/null || true)
+if [[ "${cgroupfs}" == "cgroup2fs" ]]; then
+  result cgroup2 pass "/sys/fs/cgroup is cgroup v2"
+else
+  result cgroup2 fail "/sys/fs/cgroup is not cgroup v2 (found ${cgroupfs:-nothing}); boot with systemd.unified_cgroup_hierarchy=1"
+fi
+
+# swap: the kubelet fails to start with swap enabled, unless failSwapOn is false
+swaps=$(tail -n +2 /proc/swaps | awk '{print $1}' | paste -sd, -)
+if [[ -z "${swaps}" ]]; then
+  result swap pass "swap is disabled"
+elif [[ "${ALLOW_SWAP}" == "true" ]]; then
+  result swap pass "swap is enabled (${swaps}) and allowed by the kubelet configuration"
+else
+  result swap fail "swap is enabled (${swaps}); disable it with swapoff -a and remove it from /etc/fstab"
+fi
+
+# modules: loaded, or available to be loaded by nodeup
+missing=()
+for module in ${KERNEL_MODULES}; do
+  if [[ ! -d "/sys/module/${module}" ]] && ! modprobe -n -q "${module}" 2>/dev/null; then
+    missing+=("${module}")
+  fi
+done
+if [[ ${#missing[@]} -eq 0 ]]; then
+  result modules pass "kernel modules ${KERNEL_MODULES// /, } are available"
+else
+  result modules fail "kernel modules are not available: ${missing[*]}"
+fi
+
+# timesync: certificates are rejected by hosts with a wrong clock
+if ! command -v timedatectl >/dev/null 2>&1; then
+  result timesync warn "timedatectl not found; cannot check time synchronization"
+elif [[ "$(timedatectl show -p NTP --value 2>/dev/null)" != "yes" ]]; then
+  result timesync fail "no time synchronization service is enabled; enable chrony or systemd-timesyncd"
+elif [[ "$(timedatectl show -p NTPSynchronized --value 2>/dev/null)" != "yes" ]]; then
+  result timesync warn "the system clock is not synchronized yet"
+else
+  result timesync pass "the system clock is synchronized"
+fi
+
+# disk: nodeup downloads and container images are stored under /var
+available=$(df -Pm /var 2>/dev/null | awk 'NR==2 {print $4}')
+if [[ -z "${available}" ]]; then
+  result disk warn "cannot determine free disk space on /var"
+elif (( available < MIN_DISK_MIB )); then
+  result disk fail "${available} MiB free on /var, at least ${MIN_DISK_MIB} MiB is required"
+else
+  result disk pass "${available} MiB free on /var"
+fi
+
+# ports: a port used by another process prevents the kubernetes components from starting;
+# ports used by kubernetes components are expected when the host is enrolled again.
+if ! command -v ss >/dev/null 2>&1; then
+  result ports warn "ss not found; cannot check port availability"
+else
+  used=()
+  reused=()
+  for port in ${PORTS}; do
+    listener=$(ss -Hltnp "sport = :${port}" 2>/dev/null | head -n 1)
+    if [[ -z "${listener}" ]]; then
+      continue
+    fi
+    process=$(echo "${listener}" | grep -o 'users:(("[^"]*"' | cut -d'"' -f2)
+    case "${process}" in
+      kubelet|kube-apiserver|kube-controller|kube-controller-manager|kube-scheduler|kops-controller|etcd|etcd-manager|kube-apiserver-healthcheck)
+        reused+=("${port} (${process})")
+        ;;
+      *)
+        used+=("${port} (${process:-unknown process})")
+        ;;
+    esac
+  done
+  if [[ ${#used[@]} -ne 0 ]]; then
+    result ports fail "ports are in use: ${used[*]}"
+  elif [[ ${#reused[@]} -ne 0 ]]; then
+    result ports warn "ports are in use by kubernetes components, the host may already be enrolled: ${reused[*]}"
+  else
+    result ports pass "ports ${PORTS// /, } are available"
+  fi
+fi
+
+exit 0
+`
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/pkg/wellknownports"
+)
+
+func TestParsePreflightOutput(t *testing.T) {
+	output := "+ uname -r\n" +
+		"preflight\tkernel\tpass\tkernel 6.1.0-18-amd64\n" +
+		"preflight\tswap\tfail\tswap is enabled (/swapfile); disable it\r\n" +
+		"preflight\ttimesync\twarn\tthe system clock is not synchronized yet\n" +
+		"preflight\tdisk\tunknown\tignored\n" +
+		"preflight\tincomplete\n"
+
+	expected := []PreflightCheckResult{
+		{Name: "kernel", Status: PreflightCheckPassed, Message: "kernel 6.1.0-18-amd64"},
+		{Name: "swap", Status: PreflightCheckFailed, Message: "swap is enabled (/swapfile); disable it"},
+		{Name: "timesync", Status: PreflightCheckWarning, Message: "the system clock is not synchronized yet"},
+	}
+
+	actual := parsePreflightOutput(output)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("unexpected results: got %+v, want %+v", actual, expected)
+	}
+}
+
+func TestPreflightError(t *testing.T) {
+	err := &PreflightError{
+		Host: "10.0.0.1",
+		Failures: []PreflightCheckResult{
+			{Name: "cgroup2", Status: PreflightCheckFailed, Message: "/sys/fs/cgroup is not cgroup v2"},
+			{Name: "ports", Status: PreflightCheckFailed, Message: "ports are in use: 10250 (nginx)"},
+		},
+	}
+	msg := err.Error()
+	for _, s := range []string{`host "10.0.0.1" failed 2 preflight check(s)`, "cgroup2: /sys/fs/cgroup is not cgroup v2", "ports: ports are in use: 10250 (nginx)", "--skip-preflight-checks"} {
+		if !strings.Contains(msg, s) {
+			t.Errorf("expected error to contain %q, got %q", s, msg)
+		}
+	}
+}
+
+func TestBuildPreflightConfig(t *testing.T) {
+	cluster := testutils.BuildMinimalClusterAWS("example.com")
+	cluster.Spec.KubeAPIServer = &kops.KubeAPIServerConfig{SecurePort: 6443}
+	cluster.Spec.Kubelet = &kops.KubeletConfigSpec{FailSwapOn: new(false)}
+
+	nodes := testutils.BuildMinimalNodeInstanceGroup("nodes", "us-test-1a")
+	config := buildPreflightConfig(cluster, &nodes)
+	if !reflect.DeepEqual(config.Ports, []int{wellknownports.KubeletAPI}) {
+		t.Errorf("unexpected node ports %v", config.Ports)
+	}
+	if !config.AllowSwap {
+		t.Errorf("expected swap to be allowed by the cluster kubelet configuration")
+	}
+
+	controlPlane := testutils.BuildMinimalMasterInstanceGroup("us-test-1a")
+	config = buildPreflightConfig(cluster, &controlPlane)
+	for _, port := range []int{6443, wellknownports.KubeletAPI, wellknownports.EtcdMainClientPort, wellknownports.KopsControllerPort} {
+		if !slices.Contains(config.Ports, port) {
+			t.Errorf("expected control plane ports %v to contain %d", config.Ports, port)
+		}
+	}
+	if slices.Contains(config.Ports, wellknownports.KubeAPIServer) {
+		t.Errorf("expected control plane ports %v not to contain the default API server port", config.Ports)
+	}
+	if config.AllowSwap {
+		t.Errorf("expected swap not to be allowed without a control plane kubelet configuration")
+	}
+
+	nodes.Spec.Kubelet = &kops.KubeletConfigSpec{FailSwapOn: new(true)}
+	if config := buildPreflightConfig(cluster, &nodes); config.AllowSwap {
+		t.Errorf("expected the instance group kubelet configuration to take precedence")
+	}
+
+	script := buildPreflightScript(config)
+	for _, s := range []string{"#!/bin/bash\n", `PORTS="2380 2381 3988 3990 3996 3997 4001 4002 6443 10250 10257 10259"`, "ALLOW_SWAP=false\n"} {
+		if !strings.Contains(script, s) {
+			t.Errorf("expected script to contain %q", s)
+		}
+	}
+}
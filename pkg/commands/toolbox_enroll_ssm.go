@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+// exitCodeNotExist is the exit code of the readFile command when the file does not exist.
+const exitCodeNotExist = 3
+
+// SSMHost runs commands on an AWS instance through AWS Systems Manager.
+// It reaches instances in private subnets without SSH daemons or bastions, as long as the SSM agent is running.
+// Commands run as root.
+type SSMHost struct {
+	runner *awsup.SSMCommandRunner
+}
+
+// NewSSMHost creates a new SSMHost for the instance.
+func NewSSMHost(ctx context.Context, instanceID string, region string) (*SSMHost, error) {
+	if !strings.HasPrefix(instanceID, "i-") {
+		return nil, fmt.Errorf("host %q is not an instance ID, which is required with transport %q", instanceID, NodeTransportSSM)
+	}
+	client, err := awsup.NewSSMClient(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+	return &SSMHost{
+		runner: awsup.NewSSMCommandRunner(client, instanceID),
+	}, nil
+}
+
+// Close releases the connection; there is nothing to release with SSM.
+func (s *SSMHost) Close() error {
+	return nil
+}
+
+// address returns the instance ID.
+func (s *SSMHost) address() string {
+	return s.runner.InstanceID()
+}
+
+func (s *SSMHost) readFile(ctx context.Context, path string) ([]byte, error) {
+	quoted := "'" + strings.ReplaceAll(path, "'", `'\''`) + "'"
+	command := fmt.Sprintf("[ -e %s ] || exit %d\ncat %s", quoted, exitCodeNotExist, quoted)
+
+	var stdout, stderr bytes.Buffer
+	if err := s.runner.Run(ctx, command, &stdout, &stderr); err != nil {
+		var exitErr *awsup.SSMCommandExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode == exitCodeNotExist {
+			return nil, fmt.Errorf("reading %q on %s: %w", path, s.address(), fs.ErrNotExist)
+		}
+		return nil, fmt.Errorf("reading %q on %s: %w: %s", path, s.address(), err, stderr.String())
+	}
+	return stdout.Bytes(), nil
+}
+
+func (s *SSMHost) writeFile(ctx context.Context, path string, data io.ReadSeeker) error {
+	b, err := io.ReadAll(data)
+	if err != nil {
+		return fmt.Errorf("reading data for %q: %w", path, err)
+	}
+	return s.runner.WriteFile(ctx, path, b)
+}
+
+// runScript runs the script; the runner already copies it to the instance before running it with /bin/bash.
+func (s *SSMHost) runScript(ctx context.Context, script string, options ExecOptions) (*CommandOutput, error) {
+	return s.runCommand(ctx, script, options)
+}
+
+func (s *SSMHost) runCommand(ctx context.Context, command string, options ExecOptions) (*CommandOutput, error) {
+	output := &CommandOutput{}
+
+	var stdout io.Writer = &output.Stdout
+	var stderr io.Writer = &output.Stderr
+	if options.Echo {
+		// We send both to stderr, so we don't "corrupt" stdout
+		stdout = io.MultiWriter(os.Stderr, stdout)
+		stderr = io.MultiWriter(os.Stderr, stderr)
+	}
+
+	if err := s.runner.Run(ctx, command, stdout, stderr); err != nil {
+		return output, fmt.Errorf("error running command %q on %s: %w", command, s.address(), err)
+	}
+	return output, nil
+}
@@ -17,10 +17,14 @@ limitations under the License.
 package commands
 
 import (
+	"context"
 	"strings"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 	"sigs.k8s.io/yaml"
 )
 
@@ -97,3 +101,32 @@ spec:
 		t.Errorf("expected mountPath /etc/kubernetes/kops/config/addons, got %q", mount.MountPath)
 	}
 }
+
+func TestWaitForNodeReady(t *testing.T) {
+	ctx := context.Background()
+	node := func(name string, status corev1.ConditionStatus) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeReady, Status: status, Reason: "KubeletNotReady", Message: "container runtime network not ready"},
+				},
+			},
+		}
+	}
+	k8sClient := fake.NewClientset(node("ready", corev1.ConditionTrue), node("notready", corev1.ConditionFalse))
+
+	if err := waitForNodeReady(ctx, k8sClient, "ready", time.Second, 10*time.Millisecond); err != nil {
+		t.Errorf("unexpected error for ready node: %v", err)
+	}
+
+	err := waitForNodeReady(ctx, k8sClient, "notready", 50*time.Millisecond, 10*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "container runtime network not ready") {
+		t.Errorf("expected error with the Ready condition message, got %v", err)
+	}
+
+	err = waitForNodeReady(ctx, k8sClient, "missing", 50*time.Millisecond, 10*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "not registered") {
+		t.Errorf("expected error for unregistered node, got %v", err)
+	}
+}
@@ -180,7 +180,7 @@ func (s *clusterScope) findSystemEndpoints(ctx context.Context) ([]capikops.Syst
 
 	// TODO: Sync with BuildKubecfg
 
-	ingresses, err := cloud.GetApiIngressStatus(clusterInternal)
+	ingresses, err := cloud.GetApiIngressStatus(ctx, clusterInternal)
 	if err != nil {
 		return nil, fmt.Errorf("error getting ingress status: %v", err)
 	}
@@ -38,6 +38,10 @@ import (
 // instanceID matches resources.Instance.Name. AWS implements this with EC2 Instance Connect.
 type SSHAccessGranter func(ctx context.Context, instanceID string) error
 
+// InstanceCommandRunner runs a shell command on a cloud instance without SSH, piping stdout & stderr.
+// instanceID matches resources.Instance.Name. AWS implements this with Systems Manager Run Command.
+type InstanceCommandRunner func(ctx context.Context, instanceID string, command string, stdout io.Writer, stderr io.Writer) error
+
 // logDumper gets all the nodes from a kubernetes cluster and dumps a well-known set of logs
 type logDumper struct {
 	sshClientFactory sshClientFactory
@@ -54,6 +58,9 @@ type logDumper struct {
 	// sshAccessGranter grants short-lived SSH access to a node before connecting. On AWS it uses
 	// EC2 Instance Connect so Karpenter nodes, lacking the cluster SSH key pair, are reachable.
 	sshAccessGranter SSHAccessGranter
+
+	// byInstanceID is set when nodes are reached through an InstanceCommandRunner, which addresses them by instance ID.
+	byInstanceID bool
 }
 
 // NewLogDumper is the constructor for a logDumper
@@ -122,6 +129,13 @@ func (d *logDumper) SetSSHAccessGranter(granter SSHAccessGranter) {
 	d.sshAccessGranter = granter
 }
 
+// SetInstanceCommandRunner runs commands on nodes with the runner, instead of connecting over SSH.
+// This reaches nodes in private subnets without SSH daemons or bastions.
+func (d *logDumper) SetInstanceCommandRunner(runner InstanceCommandRunner) {
+	d.sshClientFactory = &instanceClientFactory{runner: runner}
+	d.byInstanceID = true
+}
+
 // grantSSHAccess best-effort grants SSH access before connecting. Failures are non-fatal: some
 // nodes already trust our key (e.g. control plane via its launch template key pair).
 func (d *logDumper) grantSSHAccess(ctx context.Context, instanceID string) {
@@ -236,6 +250,10 @@ func (d *logDumper) dumpRegistered(ctx context.Context, node *corev1.Node) (stri
 		return "", ctx.Err()
 	}
 
+	if d.byInstanceID {
+		return node.Name, d.dumpNode(ctx, node.Name, node.Name, false)
+	}
+
 	d.grantSSHAccess(ctx, node.Name)
 
 	var publicIP, privateIP string
@@ -269,6 +287,10 @@ func (d *logDumper) dumpNotRegistered(ctx context.Context, node *resources.Insta
 	}
 
 	klog.Infof("dumping node not registered in kubernetes: %s", node.Name)
+	if d.byInstanceID {
+		return node.Name, d.dumpNode(ctx, node.Name, node.Name, false)
+	}
+
 	d.grantSSHAccess(ctx, node.Name)
 	if len(node.PublicAddresses) > 0 {
 		return node.PublicAddresses[0], d.dumpNode(ctx, node.PublicAddresses[0], node.PublicAddresses[0], false)
@@ -706,3 +728,69 @@ func (f *sshClientFactoryImplementation) Dial(ctx context.Context, host string,
 		}, nil
 	}
 }
+
+// instanceClient is an sshClient which runs commands on a cloud instance with an InstanceCommandRunner
+type instanceClient struct {
+	runner     InstanceCommandRunner
+	instanceID string
+}
+
+var _ sshClient = &instanceClient{}
+
+// ExecPiped implements sshClient::ExecPiped
+func (c *instanceClient) ExecPiped(ctx context.Context, command string, stdout io.Writer, stderr io.Writer) error {
+	klog.V(2).Infof("running command on instance %s: %v", c.instanceID, command)
+	return c.runner(ctx, c.instanceID, command, stdout, stderr)
+}
+
+// Close implements sshClient::Close
+func (c *instanceClient) Close() error {
+	return nil
+}
+
+// instanceClientFactory is an sshClientFactory which treats the host as an instance ID, and never uses a bastion
+type instanceClientFactory struct {
+	runner InstanceCommandRunner
+}
+
+var _ sshClientFactory = &instanceClientFactory{}
+
+// HasBastion implements sshClientFactory::HasBastion
+func (f *instanceClientFactory) HasBastion() bool {
+	return false
+}
+
+// Dial implements sshClientFactory::Dial
+func (f *instanceClientFactory) Dial(ctx context.Context, host string, useBastion bool) (sshClient, error) {
+	if host == "" {
+		return nil, fmt.Errorf("instance ID is empty")
+	}
+	return &instanceClient{runner: f.runner, instanceID: host}, nil
+}
+
+// NodeCommandRunner runs commands on nodes over SSH, through the bastion if there is one
+type NodeCommandRunner struct {
+	sshClientFactory sshClientFactory
+}
+
+// NewNodeCommandRunner is the constructor for a NodeCommandRunner
+func NewNodeCommandRunner(bastionAddress string, sshConfig *ssh.ClientConfig, keyRing agent.Agent) *NodeCommandRunner {
+	return &NodeCommandRunner{
+		sshClientFactory: &sshClientFactoryImplementation{
+			bastion:   bastionAddress,
+			keyRing:   keyRing,
+			sshConfig: sshConfig,
+		},
+	}
+}
+
+// Run runs the shell command on the node with the given address, piping stdout & stderr
+func (r *NodeCommandRunner) Run(ctx context.Context, host string, command string, stdout io.Writer, stderr io.Writer) error {
+	client, err := r.sshClientFactory.Dial(ctx, host, r.sshClientFactory.HasBastion())
+	if err != nil {
+		return fmt.Errorf("unable to SSH to %q: %w", host, err)
+	}
+	defer client.Close()
+
+	return client.ExecPiped(ctx, command, stdout, stderr)
+}
@@ -138,3 +138,50 @@ func TestDumpAllNodesNilGranter(t *testing.T) {
 		t.Fatalf("DumpAllNodes with nil granter: %v", err)
 	}
 }
+
+// TestDumpAllNodesInstanceCommandRunner verifies that nodes are dumped by instance ID through the
+// runner, even without any addresses, and that no SSH access is granted.
+func TestDumpAllNodesInstanceCommandRunner(t *testing.T) {
+	d := &logDumper{
+		sshClientFactory: &fakeSSHClientFactory{},
+		artifactsDir:     t.TempDir(),
+		nodeDumpTimeout:  time.Minute,
+	}
+
+	var granted []string
+	d.SetSSHAccessGranter(func(ctx context.Context, instanceID string) error {
+		granted = append(granted, instanceID)
+		return nil
+	})
+
+	ran := map[string]bool{}
+	d.SetInstanceCommandRunner(func(ctx context.Context, instanceID string, command string, stdout io.Writer, stderr io.Writer) error {
+		ran[instanceID] = true
+		return nil
+	})
+
+	cloudDump := &resources.Dump{
+		Instances: []*resources.Instance{
+			{Name: "i-worker"},
+			{Name: "i-unregistered"},
+		},
+	}
+	nodes := corev1.NodeList{
+		Items: []corev1.Node{
+			{ObjectMeta: metav1.ObjectMeta{Name: "i-worker"}},
+		},
+	}
+
+	if err := d.DumpAllNodes(context.Background(), nodes, 10, cloudDump); err != nil {
+		t.Fatalf("DumpAllNodes: %v", err)
+	}
+
+	for _, id := range []string{"i-worker", "i-unregistered"} {
+		if !ran[id] {
+			t.Errorf("expected commands to run on %q; ran=%v", id, ran)
+		}
+	}
+	if len(granted) != 0 {
+		t.Errorf("expected no SSH access grants, got %v", granted)
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package etcdbackup reads and writes the backup store of etcd-manager, using the same layout as etcd-manager-ctl:
+// each backup is a directory holding the compressed snapshot and its metadata, and commands for etcd-manager
+// are written under the control directory.
+package etcdbackup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+const (
+	// MetaFilename is the name of the file holding the BackupInfo of a backup
+	MetaFilename = "_etcd_backup.meta"
+	// DataFilename is the name of the file holding the gzipped etcd snapshot of a backup
+	DataFilename = "etcd.backup.gz"
+	// CommandFilename is the name of the file holding a Command in the control directory
+	CommandFilename = "_command.json"
+
+	controlDirectory = "control"
+)
+
+// ClusterSpec is the size and version of an etcd cluster
+type ClusterSpec struct {
+	MemberCount int32  `json:"memberCount,omitempty"`
+	EtcdVersion string `json:"etcdVersion,omitempty"`
+}
+
+// BackupInfo is the metadata stored with a backup
+type BackupInfo struct {
+	EtcdVersion string `json:"etcdVersion,omitempty"`
+	// Timestamp is the time of the backup, in seconds since the epoch
+	Timestamp   json.Number  `json:"timestamp,omitempty"`
+	ClusterSpec *ClusterSpec `json:"clusterSpec,omitempty"`
+}
+
+// Time returns the time of the backup, or the zero time if it is not known
+func (i *BackupInfo) Time() time.Time {
+	seconds, err := i.Timestamp.Int64()
+	if err != nil || seconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
+
+// Backup is a backup in the backup store
+type Backup struct {
+	Name string
+	Info *BackupInfo
+}
+
+// Command is a command for etcd-manager, which it reads from the control directory
+type Command struct {
+	Timestamp     json.Number           `json:"timestamp,omitempty"`
+	RestoreBackup *RestoreBackupCommand `json:"restoreBackup,omitempty"`
+}
+
+// RestoreBackupCommand asks etcd-manager to replace the etcd cluster with the contents of a backup
+type RestoreBackupCommand struct {
+	ClusterSpec *ClusterSpec `json:"clusterSpec,omitempty"`
+	Backup      string       `json:"backup,omitempty"`
+}
+
+// Store is the backup store of a single etcd cluster
+type Store struct {
+	base vfs.Path
+}
+
+// NewStore returns the Store for the backupStore of an etcd cluster
+func NewStore(base vfs.Path) *Store {
+	return &Store{base: base}
+}
+
+// ListBackups returns the backups in the store, oldest first
+func (s *Store) ListBackups(ctx context.Context) ([]*Backup, error) {
+	files, err := s.base.ReadTree(ctx)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error listing backups in %q: %w", s.base, err)
+	}
+
+	basePath := strings.TrimSuffix(s.base.Path(), "/") + "/"
+	var names []string
+	for _, f := range files {
+		tokens := strings.Split(strings.TrimPrefix(f.Path(), basePath), "/")
+		if len(tokens) != 2 || tokens[1] != MetaFilename {
+			continue
+		}
+		names = append(names, tokens[0])
+	}
+	sort.Strings(names)
+
+	var backups []*Backup
+	for _, name := range names {
+		info, err := s.LoadInfo(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, &Backup{Name: name, Info: info})
+	}
+	return backups, nil
+}
+
+// LoadInfo reads the metadata of the named backup
+func (s *Store) LoadInfo(ctx context.Context, name string) (*BackupInfo, error) {
+	p := s.base.Join(name, MetaFilename)
+	data, err := p.ReadFile(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error reading backup %q: %w", name, err)
+	}
+	info := &BackupInfo{}
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, fmt.Errorf("error parsing backup metadata %q: %w", p, err)
+	}
+	return info, nil
+}
+
+// AddBackup stores a gzipped etcd snapshot as a new backup, returning the name of the backup
+func (s *Store) AddBackup(ctx context.Context, info *BackupInfo, data io.ReadSeeker, now time.Time) (string, error) {
+	// Follow the etcd-manager naming, so that backups are listed in order and cleaned up by the retention policy
+	name := now.UTC().Format(time.RFC3339) + "-000001"
+	info.Timestamp = json.Number(strconv.FormatInt(now.Unix(), 10))
+
+	meta, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("error serializing backup metadata: %w", err)
+	}
+
+	// Write the data first, as the backup is only listed once the metadata exists
+	if err := s.base.Join(name, DataFilename).WriteFile(ctx, data, nil); err != nil {
+		return "", fmt.Errorf("error writing backup %q: %w", name, err)
+	}
+	if err := s.base.Join(name, MetaFilename).WriteFile(ctx, bytes.NewReader(meta), nil); err != nil {
+		return "", fmt.Errorf("error writing backup %q: %w", name, err)
+	}
+	return name, nil
+}
+
+// AddRestoreCommand asks etcd-manager to restore the named backup; etcd-manager picks up the command when it restarts
+func (s *Store) AddRestoreCommand(ctx context.Context, name string, clusterSpec *ClusterSpec, now time.Time) error {
+	timestamp := strconv.FormatInt(now.UnixNano(), 10)
+	cmd := &Command{
+		Timestamp: json.Number(timestamp),
+		RestoreBackup: &RestoreBackupCommand{
+			ClusterSpec: clusterSpec,
+			Backup:      name,
+		},
+	}
+
+	data, err := json.MarshalIndent(cmd, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing restore command: %w", err)
+	}
+
+	p := s.base.Join(controlDirectory, timestamp, CommandFilename)
+	if err := p.WriteFile(ctx, bytes.NewReader(data), nil); err != nil {
+		return fmt.Errorf("error writing restore command %q: %w", p, err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdbackup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	base := vfs.NewMemFSPath(vfs.NewMemFSContext(), "backups/etcd/main")
+	store := NewStore(base)
+
+	// A backup written by etcd-manager, which serializes the timestamp as a string
+	if err := base.Join("2026-01-02T03:04:05Z-000001", MetaFilename).WriteFile(ctx, bytes.NewReader([]byte(`{"etcdVersion":"3.5.21","timestamp":"1767323045","clusterSpec":{"memberCount":3,"etcdVersion":"3.5.21"}}`)), nil); err != nil {
+		t.Fatalf("error writing backup: %v", err)
+	}
+	// The cluster spec written by kOps is not a backup
+	if err := base.Join("control", "etcd-cluster-spec").WriteFile(ctx, bytes.NewReader([]byte(`{}`)), nil); err != nil {
+		t.Fatalf("error writing cluster spec: %v", err)
+	}
+
+	now := time.Date(2026, 2, 3, 4, 5, 6, 0, time.UTC)
+	name, err := store.AddBackup(ctx, &BackupInfo{EtcdVersion: "3.5.21", ClusterSpec: &ClusterSpec{MemberCount: 3, EtcdVersion: "3.5.21"}}, bytes.NewReader([]byte("snapshot")), now)
+	if err != nil {
+		t.Fatalf("error adding backup: %v", err)
+	}
+	if name != "2026-02-03T04:05:06Z-000001" {
+		t.Errorf("unexpected backup name %q", name)
+	}
+
+	backups, err := store.ListBackups(ctx)
+	if err != nil {
+		t.Fatalf("error listing backups: %v", err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("expected 2 backups, got %d", len(backups))
+	}
+	if backups[0].Name != "2026-01-02T03:04:05Z-000001" || backups[1].Name != name {
+		t.Errorf("unexpected backups %q, %q", backups[0].Name, backups[1].Name)
+	}
+	if got := backups[0].Info.Time(); !got.Equal(time.Unix(1767323045, 0)) {
+		t.Errorf("unexpected time %v", got)
+	}
+	if got := backups[1].Info.Time(); !got.Equal(now) {
+		t.Errorf("unexpected time %v", got)
+	}
+	data, err := base.Join(name, DataFilename).ReadFile(ctx)
+	if err != nil || string(data) != "snapshot" {
+		t.Errorf("unexpected backup data %q: %v", data, err)
+	}
+
+	if err := store.AddRestoreCommand(ctx, name, backups[1].Info.ClusterSpec, now); err != nil {
+		t.Fatalf("error adding restore command: %v", err)
+	}
+	data, err = base.Join("control", "1770091506000000000", CommandFilename).ReadFile(ctx)
+	if err != nil {
+		t.Fatalf("error reading restore command: %v", err)
+	}
+	cmd := &Command{}
+	if err := json.Unmarshal(data, cmd); err != nil {
+		t.Fatalf("error parsing restore command: %v", err)
+	}
+	if cmd.RestoreBackup == nil || cmd.RestoreBackup.Backup != name || cmd.RestoreBackup.ClusterSpec.MemberCount != 3 {
+		t.Errorf("unexpected restore command %s", data)
+	}
+}
@@ -104,6 +104,8 @@ var (
 	DOTerraform = new("DOTerraform", Bool(false))
 	// Metal enables the experimental bare-metal support.
 	Metal = new("Metal", Bool(false))
+	// MetalDeleteStaleHosts enables kops-controller to delete the Host and Node objects of bare-metal machines that are gone.
+	MetalDeleteStaleHosts = new("MetalDeleteStaleHosts", Bool(false))
 	// AWSSingleNodesInstanceGroup enables the creation of a single node instance group instead of one per availability zone.
 	AWSSingleNodesInstanceGroup = new("AWSSingleNodesInstanceGroup", Bool(false))
 	// ClusterAPI enables support for Cluster API (CAPI) resources.
@@ -112,6 +114,12 @@ var (
 	DiscoveryService = new("DiscoveryService", Bool(false))
 	// Linode toggles the Linode (Akamai) Cloud support.
 	Linode = new("Linode", Bool(false))
+	// AllowUnsignedBootstrapTokens makes kops-controller accept the unsigned bootstrap requests of
+	// DigitalOcean, Hetzner and Scaleway nodes from before kOps 1.37, for servers without a pinned key.
+	// Deprecated: it will be removed once such nodes are no longer supported.
+	AllowUnsignedBootstrapTokens = new("AllowUnsignedBootstrapTokens", Bool(false))
+	// InstanceGroupScaleAPI enables the kops-controller API for scaling instance groups.
+	InstanceGroupScaleAPI = new("InstanceGroupScaleAPI", Bool(false))
 )
 
 // FeatureFlag defines a feature flag
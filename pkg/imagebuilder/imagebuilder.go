@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package imagebuilder generates Packer templates that build node images with the assets
+// of a cluster preloaded, so that nodes don't need to download them when they boot.
+package imagebuilder
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+
+	"k8s.io/kops/pkg/assets"
+)
+
+// Options describes the image to build.
+type Options struct {
+	// ClusterName is the name of the cluster the image is built for.
+	ClusterName string
+	// InstanceGroupName is the name of the instance group the image is built for.
+	InstanceGroupName string
+
+	// CacheDirectory is the directory in the image where assets are stored, by hash.
+	// It must match spec.assets.nodeDownloads.cacheDirectory, so that nodeup finds them.
+	CacheDirectory string
+	// Assets are the file assets to preload.
+	Assets []*assets.MirroredAsset
+	// Images are the container images to pull into containerd.
+	Images []string
+
+	// Region is the AWS region to build the image in.
+	Region string
+	// SourceImage is the ID of the image to start from.
+	SourceImage string
+	// InstanceType is the type of the instance used to build the image.
+	InstanceType string
+	// SSHUsername is the user Packer connects as.
+	SSHUsername string
+	// SubnetID is the subnet to launch the build instance in; Packer uses the default VPC if empty.
+	SubnetID string
+	// ImageName is the name of the new image.
+	ImageName string
+}
+
+// PreloadScriptName is the name of the script that preloads the assets, next to the Packer template.
+const PreloadScriptName = "preload.sh"
+
+// PackerTemplateName is the name of the Packer template.
+const PackerTemplateName = "image.pkr.hcl"
+
+var preloadScriptTemplate = template.Must(template.New("preload").Funcs(template.FuncMap{"quote": shellQuote}).Parse(`#!/bin/bash
+# Generated by kops toolbox build-image for instance group {{ .InstanceGroupName }} of cluster {{ .ClusterName }}.
+# Downloads the node assets into the cache directory used by nodeup, and pulls the container images.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+CACHE_DIR={{ quote .CacheDirectory }}
+
+download() {
+  local algorithm=$1 hash=$2
+  shift 2
+  local dest="${CACHE_DIR}/${algorithm}/${hash}"
+  mkdir -p "$(dirname "${dest}")"
+  for url in "$@"; do
+    if curl -fsSL --retry 5 -o "${dest}.tmp" "${url}" && echo "${hash}  ${dest}.tmp" | "${algorithm}sum" --check --quiet -; then
+      mv "${dest}.tmp" "${dest}"
+      return 0
+    fi
+    echo "failed to download ${url}" >&2
+  done
+  rm -f "${dest}.tmp"
+  return 1
+}
+
+{{ range .Assets -}}
+download {{ .Algorithm }} {{ .Hash }}{{ range .Locations }} {{ quote . }}{{ end }}
+{{ end -}}
+{{ if .Containerd }}
+# Pull the container images with a temporary containerd, from the containerd asset,
+# into the directories that nodeup configures containerd with.
+CONTAINERD_DIR=$(mktemp -d)
+tar -xzf "${CACHE_DIR}/{{ .Containerd.Algorithm }}/{{ .Containerd.Hash }}" -C "${CONTAINERD_DIR}"
+CONTAINERD=$(find "${CONTAINERD_DIR}" -type f -name containerd | head -n 1)
+CTR=$(find "${CONTAINERD_DIR}" -type f -name ctr | head -n 1)
+SOCKET="${CONTAINERD_DIR}/containerd.sock"
+
+"${CONTAINERD}" --root /var/lib/containerd --state "${CONTAINERD_DIR}/state" --address "${SOCKET}" &
+CONTAINERD_PID=$!
+trap 'kill "${CONTAINERD_PID}"; wait "${CONTAINERD_PID}" || true; rm -rf "${CONTAINERD_DIR}"' EXIT
+for i in $(seq 30); do
+  [[ -S "${SOCKET}" ]] && break
+  sleep 1
+done
+
+{{ range .Images -}}
+"${CTR}" --address "${SOCKET}" --namespace k8s.io images pull {{ quote . }}
+{{ end -}}
+{{ end -}}
+`))
+
+type preloadAsset struct {
+	Algorithm string
+	Hash      string
+	Locations []string
+}
+
+// BuildPreloadScript returns the script run in the image to preload the assets and images.
+func BuildPreloadScript(o *Options) ([]byte, error) {
+	if o.CacheDirectory == "" {
+		return nil, fmt.Errorf("cache directory is required")
+	}
+
+	data := struct {
+		*Options
+		Assets     []preloadAsset
+		Containerd *preloadAsset
+	}{Options: o}
+
+	for _, asset := range o.Assets {
+		if asset.Hash == nil || len(asset.Locations) == 0 {
+			return nil, fmt.Errorf("asset %v has no hash or location", asset.Locations)
+		}
+		a := preloadAsset{
+			Algorithm: string(asset.Hash.Algorithm),
+			Hash:      asset.Hash.Hex(),
+			Locations: asset.Locations,
+		}
+		data.Assets = append(data.Assets, a)
+		if isContainerdAsset(asset) {
+			data.Containerd = &a
+		}
+	}
+	if len(o.Images) != 0 && data.Containerd == nil {
+		return nil, fmt.Errorf("preloading images requires the containerd asset")
+	}
+
+	var b bytes.Buffer
+	if err := preloadScriptTemplate.Execute(&b, data); err != nil {
+		return nil, fmt.Errorf("building preload script: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+// isContainerdAsset returns true for the containerd release archive.
+func isContainerdAsset(asset *assets.MirroredAsset) bool {
+	name := path.Base(asset.Locations[0])
+	return strings.HasPrefix(name, "containerd-") && strings.HasSuffix(name, ".tar.gz")
+}
+
+// shellQuote quotes s for use as a single shell word.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
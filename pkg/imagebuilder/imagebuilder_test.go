@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagebuilder
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/kops/pkg/assets"
+	"k8s.io/kops/util/pkg/hashing"
+)
+
+func testOptions() *Options {
+	return &Options{
+		ClusterName:       "minimal.example.com",
+		InstanceGroupName: "nodes",
+		CacheDirectory:    "/opt/kops/assets",
+		Assets: []*assets.MirroredAsset{
+			{
+				Locations: []string{"https://dl.k8s.io/release/v1.34.0/bin/linux/amd64/kubelet"},
+				Hash:      hashing.MustFromString("1111111111111111111111111111111111111111111111111111111111111111"),
+			},
+			{
+				Locations: []string{
+					"https://github.com/containerd/containerd/releases/download/v2.1.4/containerd-2.1.4-linux-amd64.tar.gz",
+					"https://mirror.example.com/containerd-2.1.4-linux-amd64.tar.gz?token=a'b",
+				},
+				Hash: hashing.MustFromString("2222222222222222222222222222222222222222222222222222222222222222"),
+			},
+		},
+		Images: []string{"registry.k8s.io/kube-proxy:v1.34.0"},
+
+		Region:       "us-test-1",
+		SourceImage:  "ami-0123456789abcdef0",
+		InstanceType: "t3.medium",
+		SSHUsername:  "ubuntu",
+		ImageName:    "kops-nodes-minimal.example.com-20261017000000",
+	}
+}
+
+func TestBuildPreloadScript(t *testing.T) {
+	script, err := BuildPreloadScript(testOptions())
+	if err != nil {
+		t.Fatalf("BuildPreloadScript() error = %v", err)
+	}
+
+	for _, expected := range []string{
+		"CACHE_DIR='/opt/kops/assets'",
+		"download sha256 1111111111111111111111111111111111111111111111111111111111111111 'https://dl.k8s.io/release/v1.34.0/bin/linux/amd64/kubelet'\n",
+		`'https://mirror.example.com/containerd-2.1.4-linux-amd64.tar.gz?token=a'\''b'`,
+		`tar -xzf "${CACHE_DIR}/sha256/2222222222222222222222222222222222222222222222222222222222222222"`,
+		`images pull 'registry.k8s.io/kube-proxy:v1.34.0'`,
+	} {
+		if !strings.Contains(string(script), expected) {
+			t.Errorf("expected script to contain %q, got:\n%s", expected, script)
+		}
+	}
+
+	bash, err := exec.LookPath("bash")
+	if err != nil {
+		t.Skip("bash not found")
+	}
+	p := filepath.Join(t.TempDir(), PreloadScriptName)
+	if err := os.WriteFile(p, script, 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if output, err := exec.Command(bash, "-n", p).CombinedOutput(); err != nil {
+		t.Errorf("script is not valid: %v: %s", err, output)
+	}
+}
+
+func TestBuildPreloadScriptRequiresContainerdForImages(t *testing.T) {
+	o := testOptions()
+	o.Assets = o.Assets[:1]
+	if _, err := BuildPreloadScript(o); err == nil {
+		t.Errorf("expected error without the containerd asset")
+	}
+
+	o.Images = nil
+	script, err := BuildPreloadScript(o)
+	if err != nil {
+		t.Fatalf("BuildPreloadScript() error = %v", err)
+	}
+	if strings.Contains(string(script), "containerd") {
+		t.Errorf("expected no image preloading, got:\n%s", script)
+	}
+}
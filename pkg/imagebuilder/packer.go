@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagebuilder
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+var packerTemplate = template.Must(template.New("packer").Funcs(template.FuncMap{"hcl": hclString}).Parse(`# Generated by kops toolbox build-image for instance group {{ .InstanceGroupName }} of cluster {{ .ClusterName }}.
+
+packer {
+  required_plugins {
+    amazon = {
+      source  = "github.com/hashicorp/amazon"
+      version = ">= 1.2.0"
+    }
+  }
+}
+
+source "amazon-ebs" "kops" {
+  region        = {{ hcl .Region }}
+  source_ami    = {{ hcl .SourceImage }}
+  instance_type = {{ hcl .InstanceType }}
+  ssh_username  = {{ hcl .SSHUsername }}
+{{- if .SubnetID }}
+  subnet_id     = {{ hcl .SubnetID }}
+{{- end }}
+  ami_name      = {{ hcl .ImageName }}
+
+  tags = {
+{{- range .Tags }}
+    {{ hcl .Key }} = {{ hcl .Value }}
+{{- end }}
+  }
+}
+
+build {
+  sources = ["source.amazon-ebs.kops"]
+
+  provisioner "file" {
+    source      = {{ hcl .PreloadScriptName }}
+    destination = "/tmp/kops-preload.sh"
+  }
+
+  provisioner "shell" {
+    inline = ["sudo bash /tmp/kops-preload.sh", "rm /tmp/kops-preload.sh"]
+  }
+}
+`))
+
+type tag struct {
+	Key   string
+	Value string
+}
+
+// BuildPackerTemplate returns a Packer template that builds the image on AWS, running the preload script.
+func BuildPackerTemplate(o *Options) ([]byte, error) {
+	for name, value := range map[string]string{
+		"region":        o.Region,
+		"source image":  o.SourceImage,
+		"instance type": o.InstanceType,
+		"ssh username":  o.SSHUsername,
+		"image name":    o.ImageName,
+	} {
+		if value == "" {
+			return nil, fmt.Errorf("%s is required", name)
+		}
+	}
+
+	tags := map[string]string{
+		"Name":                      o.ImageName,
+		"kops.k8s.io/cluster":       o.ClusterName,
+		"kops.k8s.io/instancegroup": o.InstanceGroupName,
+	}
+	data := struct {
+		*Options
+		PreloadScriptName string
+		Tags              []tag
+	}{
+		Options:           o,
+		PreloadScriptName: PreloadScriptName,
+	}
+	for k, v := range tags {
+		data.Tags = append(data.Tags, tag{Key: k, Value: v})
+	}
+	sort.Slice(data.Tags, func(i, j int) bool { return data.Tags[i].Key < data.Tags[j].Key })
+
+	var b bytes.Buffer
+	if err := packerTemplate.Execute(&b, data); err != nil {
+		return nil, fmt.Errorf("building packer template: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+// hclString quotes s as an HCL string literal, without template interpolation.
+func hclString(s string) string {
+	q := strconv.Quote(s)
+	q = strings.ReplaceAll(q, "${", "$${")
+	q = strings.ReplaceAll(q, "%{", "%%{")
+	return q
+}
+
+// ParseArtifactID returns the ID of the image built by Packer, from the output of
+// "packer build -machine-readable". Regional IDs ("us-east-1:ami-0123") are returned without the region.
+func ParseArtifactID(r io.Reader) (string, error) {
+	var id string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		// timestamp,target,type,data...
+		fields := strings.Split(scanner.Text(), ",")
+		if len(fields) < 6 || fields[2] != "artifact" || fields[4] != "id" {
+			continue
+		}
+		id = fields[5]
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("reading packer output: %w", err)
+	}
+	if id == "" {
+		return "", fmt.Errorf("packer did not report an image ID")
+	}
+	if i := strings.LastIndex(id, ":"); i != -1 {
+		id = id[i+1:]
+	}
+	return id, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagebuilder
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildPackerTemplate(t *testing.T) {
+	o := testOptions()
+	o.SubnetID = "subnet-${abc}"
+
+	template, err := BuildPackerTemplate(o)
+	if err != nil {
+		t.Fatalf("BuildPackerTemplate() error = %v", err)
+	}
+
+	for _, expected := range []string{
+		`source_ami    = "ami-0123456789abcdef0"`,
+		`instance_type = "t3.medium"`,
+		`subnet_id     = "subnet-$${abc}"`,
+		`ami_name      = "kops-nodes-minimal.example.com-20261017000000"`,
+		`"kops.k8s.io/instancegroup" = "nodes"`,
+		`source      = "preload.sh"`,
+	} {
+		if !strings.Contains(string(template), expected) {
+			t.Errorf("expected template to contain %q, got:\n%s", expected, template)
+		}
+	}
+
+	o.Region = ""
+	if _, err := BuildPackerTemplate(o); err == nil {
+		t.Errorf("expected error without region")
+	}
+}
+
+func TestParseArtifactID(t *testing.T) {
+	output := strings.Join([]string{
+		"1760659200,,ui,say,==> amazon-ebs.kops: Creating AMI",
+		"1760659300,amazon-ebs.kops,artifact-count,1",
+		"1760659300,amazon-ebs.kops,artifact,0,builder-id,mitchellh.amazonebs",
+		"1760659300,amazon-ebs.kops,artifact,0,id,us-test-1:ami-0fedcba9876543210",
+		"1760659300,amazon-ebs.kops,artifact,0,end",
+	}, "\n")
+
+	id, err := ParseArtifactID(strings.NewReader(output))
+	if err != nil {
+		t.Fatalf("ParseArtifactID() error = %v", err)
+	}
+	if id != "ami-0fedcba9876543210" {
+		t.Errorf("ParseArtifactID() = %q, expected %q", id, "ami-0fedcba9876543210")
+	}
+
+	if _, err := ParseArtifactID(strings.NewReader("1760659200,,ui,error,Build failed")); err == nil {
+		t.Errorf("expected error when no artifact is reported")
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepatching
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gcetasks"
+)
+
+// Resolver resolves an image reference to the image it currently references.
+type Resolver func(ctx context.Context, image string) (string, error)
+
+// IsDynamicImage returns true if the image references an image that changes over time:
+// an SSM parameter on AWS, an image family on GCE or the latest version of a gallery image on Azure.
+func IsDynamicImage(cloudProvider kops.CloudProviderID, image string) bool {
+	switch cloudProvider {
+	case kops.CloudProviderAWS:
+		return strings.HasPrefix(image, "ssm:")
+	case kops.CloudProviderGCE:
+		tokens := strings.Split(image, "/")
+		return len(tokens) == 3 && tokens[1] == "family"
+	case kops.CloudProviderAzure:
+		id, err := azure.ParseGalleryImageVersionID(image)
+		return err == nil && strings.EqualFold(id.Version, azure.GalleryImageVersionLatest)
+	default:
+		return false
+	}
+}
+
+// NewResolver returns a Resolver for the images of the cloud.
+// Lookups are not cached, so that newer images are found as soon as they are published.
+func NewResolver(cloud fi.Cloud) (Resolver, error) {
+	switch cloud := cloud.(type) {
+	case awsup.AWSCloud:
+		return func(ctx context.Context, image string) (string, error) {
+			parameter, found := strings.CutPrefix(image, "ssm:")
+			if !found {
+				return image, nil
+			}
+			response, err := cloud.SSM().GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String(parameter)})
+			if err != nil {
+				return "", fmt.Errorf("error getting SSM parameter %q: %w", parameter, err)
+			}
+			return aws.ToString(response.Parameter.Value), nil
+		}, nil
+	case gce.GCECloud:
+		return func(ctx context.Context, image string) (string, error) {
+			return gcetasks.ResolveImage(ctx, cloud, image)
+		}, nil
+	case azure.AzureCloud:
+		return func(ctx context.Context, image string) (string, error) {
+			return azure.ResolveImageID(ctx, cloud, image)
+		}, nil
+	default:
+		return nil, fmt.Errorf("image patching is not supported on %s", cloud.ProviderID())
+	}
+}
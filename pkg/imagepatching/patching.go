@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepatching
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+// DefaultInterval is how often images are checked for a newer version if the instance group does not set an interval.
+const DefaultInterval = 24 * time.Hour
+
+// Update is a newer image found for an instance group.
+type Update struct {
+	// InstanceGroup is the name of the instance group.
+	InstanceGroup string
+	// PreviousImage is the image that the image reference was previously resolved to.
+	PreviousImage string
+	// Image is the newer image.
+	Image string
+}
+
+// Check resolves the image references of the instance groups with image patching whose interval elapsed since they were last checked,
+// and returns the instance groups whose image changed since. The status records the resolved images, and marks the instance groups
+// that enable rolling updates as pending one.
+func Check(ctx context.Context, cloudProvider kops.CloudProviderID, groups []*kops.InstanceGroup, resolve Resolver, status *Status, now time.Time) ([]Update, error) {
+	if status.InstanceGroups == nil {
+		status.InstanceGroups = make(map[string]*InstanceGroupStatus)
+	}
+
+	var updates []Update
+	var errs []error
+	patched := make(map[string]bool)
+	for _, ig := range groups {
+		name := ig.ObjectMeta.Name
+		spec := ig.Spec.ImagePatching
+		if spec == nil || !IsDynamicImage(cloudProvider, ig.Spec.Image) {
+			continue
+		}
+		patched[name] = true
+
+		interval := DefaultInterval
+		if spec.Interval != nil {
+			interval = spec.Interval.Duration
+		}
+
+		igStatus := status.InstanceGroups[name]
+		if igStatus != nil && igStatus.Image == ig.Spec.Image && igStatus.LastCheckTime != nil && now.Sub(igStatus.LastCheckTime.Time) < interval {
+			continue
+		}
+
+		resolved, err := resolve(ctx, ig.Spec.Image)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error resolving image of instance group %q: %w", name, err))
+			continue
+		}
+
+		if igStatus == nil || igStatus.Image != ig.Spec.Image {
+			// The image reference changed, so the instance groups are updated like for any other change to their spec
+			igStatus = &InstanceGroupStatus{
+				Image:         ig.Spec.Image,
+				ResolvedImage: resolved,
+			}
+			status.InstanceGroups[name] = igStatus
+		} else if igStatus.ResolvedImage != resolved {
+			updates = append(updates, Update{
+				InstanceGroup: name,
+				PreviousImage: igStatus.ResolvedImage,
+				Image:         resolved,
+			})
+			igStatus.ResolvedImage = resolved
+			igStatus.LastUpdateTime = &metav1.Time{Time: now}
+			if spec.RollingUpdate != nil && *spec.RollingUpdate {
+				igStatus.PendingRollingUpdate = true
+			}
+		}
+		igStatus.LastCheckTime = &metav1.Time{Time: now}
+	}
+
+	for name := range status.InstanceGroups {
+		if !patched[name] {
+			delete(status.InstanceGroups, name)
+		}
+	}
+
+	return updates, errors.Join(errs...)
+}
+
+// PendingRollingUpdates returns the sorted names of the instance groups that are pending a rolling update.
+func PendingRollingUpdates(status *Status) []string {
+	var names []string
+	for name, igStatus := range status.InstanceGroups {
+		if igStatus.PendingRollingUpdate {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
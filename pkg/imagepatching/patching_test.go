@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepatching
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	groups := []*kops.InstanceGroup{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "nodes"},
+			Spec: kops.InstanceGroupSpec{
+				Image: "ssm:/images/nodes",
+				ImagePatching: &kops.ImagePatchingSpec{
+					RollingUpdate: new(true),
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "hourly"},
+			Spec: kops.InstanceGroupSpec{
+				Image: "ssm:/images/hourly",
+				ImagePatching: &kops.ImagePatchingSpec{
+					Interval: &metav1.Duration{Duration: time.Hour},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "static"},
+			Spec: kops.InstanceGroupSpec{
+				Image:         "ami-1",
+				ImagePatching: &kops.ImagePatchingSpec{},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "unpatched"},
+			Spec: kops.InstanceGroupSpec{
+				Image: "ssm:/images/nodes",
+			},
+		},
+	}
+	images := map[string]string{
+		"ssm:/images/nodes":  "ami-a",
+		"ssm:/images/hourly": "ami-b",
+	}
+	var resolved []string
+	resolve := func(ctx context.Context, image string) (string, error) {
+		resolved = append(resolved, image)
+		return images[image], nil
+	}
+
+	status := &Status{
+		InstanceGroups: map[string]*InstanceGroupStatus{
+			"deleted": {Image: "ssm:/images/deleted", ResolvedImage: "ami-z"},
+		},
+	}
+
+	// The first check records the images
+	updates, err := Check(ctx, kops.CloudProviderAWS, groups, resolve, status, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updates) != 0 {
+		t.Errorf("unexpected updates on first check: %v", updates)
+	}
+	if len(status.InstanceGroups) != 2 || status.InstanceGroups["nodes"].ResolvedImage != "ami-a" || status.InstanceGroups["hourly"].ResolvedImage != "ami-b" {
+		t.Errorf("unexpected status after first check: %+v", status.InstanceGroups)
+	}
+
+	// Newer images are only found once the interval elapsed
+	images["ssm:/images/nodes"] = "ami-c"
+	images["ssm:/images/hourly"] = "ami-d"
+	resolved = nil
+	updates, err = Check(ctx, kops.CloudProviderAWS, groups, resolve, status, now.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []Update{{InstanceGroup: "hourly", PreviousImage: "ami-b", Image: "ami-d"}}; !reflect.DeepEqual(updates, expected) {
+		t.Errorf("unexpected updates %v, expected %v", updates, expected)
+	}
+	if expected := []string{"ssm:/images/hourly"}; !reflect.DeepEqual(resolved, expected) {
+		t.Errorf("unexpected images resolved %v, expected %v", resolved, expected)
+	}
+	if pending := PendingRollingUpdates(status); len(pending) != 0 {
+		t.Errorf("unexpected pending rolling updates: %v", pending)
+	}
+
+	updates, err = Check(ctx, kops.CloudProviderAWS, groups, resolve, status, now.Add(25*time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []Update{{InstanceGroup: "nodes", PreviousImage: "ami-a", Image: "ami-c"}}; !reflect.DeepEqual(updates, expected) {
+		t.Errorf("unexpected updates %v, expected %v", updates, expected)
+	}
+	if expected := []string{"nodes"}; !reflect.DeepEqual(PendingRollingUpdates(status), expected) {
+		t.Errorf("unexpected pending rolling updates %v, expected %v", PendingRollingUpdates(status), expected)
+	}
+
+	// A changed image reference is a new baseline
+	groups[1].Spec.Image = "ssm:/images/other"
+	images["ssm:/images/other"] = "ami-e"
+	updates, err = Check(ctx, kops.CloudProviderAWS, groups, resolve, status, now.Add(26*time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updates) != 0 {
+		t.Errorf("unexpected updates after changing the image: %v", updates)
+	}
+	if status.InstanceGroups["hourly"].ResolvedImage != "ami-e" {
+		t.Errorf("unexpected status after changing the image: %+v", status.InstanceGroups["hourly"])
+	}
+
+	// Errors are reported after checking the other instance groups
+	resolve = func(ctx context.Context, image string) (string, error) {
+		return "", errors.New("unavailable")
+	}
+	if _, err := Check(ctx, kops.CloudProviderAWS, groups, resolve, status, now.Add(50*time.Hour)); err == nil {
+		t.Errorf("expected error resolving images")
+	}
+}
+
+func TestIsDynamicImage(t *testing.T) {
+	grid := []struct {
+		CloudProvider kops.CloudProviderID
+		Image         string
+		Expected      bool
+	}{
+		{kops.CloudProviderAWS, "ssm:/aws/service/canonical/ubuntu/server/24.04/stable/current/amd64/hvm/ebs-gp3/ami-id", true},
+		{kops.CloudProviderAWS, "ami-1234", false},
+		{kops.CloudProviderAWS, "099720109477/ubuntu/images/hvm-ssd-gp3/ubuntu-noble-24.04-amd64-server-20260901", false},
+		{kops.CloudProviderGCE, "ubuntu-os-cloud/family/ubuntu-2404-lts-amd64", true},
+		{kops.CloudProviderGCE, "ubuntu-os-cloud/ubuntu-2404-noble-amd64-v20260901", false},
+		{kops.CloudProviderAzure, "/subscriptions/s/resourceGroups/rg/providers/Microsoft.Compute/galleries/g/images/i/versions/latest", true},
+		{kops.CloudProviderAzure, "/subscriptions/s/resourceGroups/rg/providers/Microsoft.Compute/galleries/g/images/i/versions/1.0.0", false},
+		{kops.CloudProviderAzure, "Canonical:ubuntu-24_04-lts:server:latest", false},
+		{kops.CloudProviderHetzner, "ubuntu-24.04", false},
+	}
+	for _, g := range grid {
+		if actual := IsDynamicImage(g.CloudProvider, g.Image); actual != g.Expected {
+			t.Errorf("IsDynamicImage(%q, %q) = %v, expected %v", g.CloudProvider, g.Image, actual, g.Expected)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepatching
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/util/pkg/vfs"
+	"sigs.k8s.io/yaml"
+)
+
+// Status records the images that the instance groups with image patching were last resolved to, in the state store.
+type Status struct {
+	// InstanceGroups are the statuses of the instance groups, keyed by name.
+	InstanceGroups map[string]*InstanceGroupStatus `json:"instanceGroups,omitempty"`
+}
+
+// InstanceGroupStatus records the image an instance group was last resolved to.
+type InstanceGroupStatus struct {
+	// Image is the image reference of the instance group.
+	Image string `json:"image,omitempty"`
+	// ResolvedImage is the image that the reference was last resolved to.
+	ResolvedImage string `json:"resolvedImage,omitempty"`
+	// LastCheckTime is when the image reference was last resolved.
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+	// LastUpdateTime is when a newer image was last found.
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// PendingRollingUpdate is true if the instances need to be replaced with a rolling update to use the newer image.
+	PendingRollingUpdate bool `json:"pendingRollingUpdate,omitempty"`
+}
+
+// StatusPath returns the path of the status file for the cluster with the given config base.
+func StatusPath(configBase vfs.Path) vfs.Path {
+	return configBase.Join("imagepatching", "status.yaml")
+}
+
+// ReadStatus reads the status from the state store, returning an empty status if there is none.
+func ReadStatus(ctx context.Context, p vfs.Path) (*Status, error) {
+	b, err := p.ReadFile(ctx)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &Status{}, nil
+		}
+		return nil, fmt.Errorf("error reading image patching status %s: %w", p, err)
+	}
+
+	status := &Status{}
+	if err := yaml.Unmarshal(b, status); err != nil {
+		return nil, fmt.Errorf("error parsing image patching status %s: %w", p, err)
+	}
+	return status, nil
+}
+
+// WriteStatus writes the status to the state store.
+func WriteStatus(ctx context.Context, p vfs.Path, status *Status) error {
+	b, err := yaml.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("error serializing image patching status: %w", err)
+	}
+	if err := p.WriteFile(ctx, bytes.NewReader(b), nil); err != nil {
+		return fmt.Errorf("error writing image patching status %s: %w", p, err)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroups
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/klog/v2"
+	"k8s.io/kubectl/pkg/drain"
+
+	"k8s.io/kops/pkg/cloudinstances"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+// inPlaceResizer changes the machine type of instances without replacing them.
+type inPlaceResizer interface {
+	// PlanResize returns the machine type the instance can be resized to in place,
+	// or an empty string if the instance has other changes and needs to be replaced.
+	PlanResize(ctx context.Context, u *cloudinstances.CloudInstance) (string, error)
+	// Resize stops the instance, changes its machine type and starts it again.
+	Resize(ctx context.Context, u *cloudinstances.CloudInstance, machineType string) error
+}
+
+// newInPlaceResizer returns the inPlaceResizer for the instances of a group, or nil if the cloud can't resize them in place.
+var newInPlaceResizer = func(cloud fi.Cloud, group *cloudinstances.CloudInstanceGroup) inPlaceResizer {
+	switch cloud := cloud.(type) {
+	case awsup.AWSCloud:
+		if _, ok := group.Raw.(*autoscalingtypes.AutoScalingGroup); ok {
+			return awsup.NewInPlaceResizer(cloud)
+		}
+	case gce.GCECloud:
+		return gce.NewInPlaceResizer(cloud)
+	}
+	return nil
+}
+
+// resizeInstancesInPlace changes the machine type of the control plane instances that only differ from their
+// instance group by their machine type, one instance at a time so that etcd keeps its quorum.
+// It returns the instances that need to be replaced.
+func (c *RollingUpdateCluster) resizeInstancesInPlace(ctx context.Context, group *cloudinstances.CloudInstanceGroup, update []*cloudinstances.CloudInstance) ([]*cloudinstances.CloudInstance, error) {
+	if !c.Options.InPlaceResize || !group.InstanceGroup.IsControlPlane() {
+		return update, nil
+	}
+	resizer := newInPlaceResizer(c.Cloud, group)
+	if resizer == nil {
+		return update, nil
+	}
+
+	var replace []*cloudinstances.CloudInstance
+	for _, u := range update {
+		machineType, err := resizer.PlanResize(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		if machineType == "" {
+			klog.Infof("Instance %q has changes other than its machine type, it will be replaced", u.ID)
+			replace = append(replace, u)
+			continue
+		}
+
+		if err := c.maybeValidate(" before resizing instance", c.ValidateCount, group); err != nil {
+			return nil, err
+		}
+
+		if err := c.resizeInstanceInPlace(ctx, resizer, u, machineType); err != nil {
+			return nil, err
+		}
+
+		if err := c.maybeValidate(" after resizing instance", c.ValidateCount, group); err != nil {
+			return nil, err
+		}
+
+		if c.Interactive {
+			nodeName := ""
+			if u.Node != nil {
+				nodeName = u.Node.Name
+			}
+
+			stopPrompting, err := promptInteractive(u.ID, nodeName)
+			if err != nil {
+				return nil, err
+			}
+			if stopPrompting {
+				c.Interactive = false
+			}
+		}
+	}
+
+	return replace, nil
+}
+
+// resizeInstanceInPlace drains the node of an instance, changes the machine type of the instance and
+// makes the node schedulable again once it is ready.
+func (c *RollingUpdateCluster) resizeInstanceInPlace(ctx context.Context, resizer inPlaceResizer, u *cloudinstances.CloudInstance, machineType string) error {
+	// Control-plane nodes normally carry the exclude-from-load-balancers label already; only
+	// the label added by the drain should be removed again.
+	excludedFromLB := false
+	if u.Node != nil {
+		_, excludedFromLB = u.Node.Labels[corev1.LabelNodeExcludeBalancers]
+	}
+
+	if err := c.drainInstance(ctx, u); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	klog.Infof("Resizing instance %q from %q to %q", u.ID, u.MachineType, machineType)
+	if err := resizer.Resize(ctx, u, machineType); err != nil {
+		return fmt.Errorf("error resizing instance %q: %w", u.ID, err)
+	}
+
+	if c.CloudOnly || u.Node == nil {
+		return nil
+	}
+	if err := c.waitForNodeReady(ctx, u.Node.Name, start); err != nil {
+		return err
+	}
+	return c.restoreNode(ctx, u.Node.Name, excludedFromLB)
+}
+
+// waitForNodeReady waits until the kubelet of a node reports it as ready after the given time.
+func (c *RollingUpdateCluster) waitForNodeReady(ctx context.Context, nodeName string, since time.Time) error {
+	klog.Infof("Waiting for node %q to be ready", nodeName)
+	deadline := time.Now().Add(c.ValidationTimeout)
+	for {
+		node, err := c.K8sClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			klog.Warningf("Error getting node %q: %v", nodeName, err)
+		}
+		if err == nil {
+			for _, condition := range node.Status.Conditions {
+				if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue && condition.LastHeartbeatTime.After(since) {
+					return nil
+				}
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("node %q did not become ready within %s after resizing", nodeName, c.ValidationTimeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.ValidateTickDuration):
+		}
+	}
+}
+
+// restoreNode undoes the changes made to a node for draining it, as the node is kept after an in-place resize.
+// The exclude-from-load-balancers label is kept if the node already had it before it was drained.
+func (c *RollingUpdateCluster) restoreNode(ctx context.Context, nodeName string, keepExcludeFromLB bool) error {
+	node, err := c.K8sClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting node %q: %w", nodeName, err)
+	}
+
+	helper := &drain.Helper{
+		Ctx:    ctx,
+		Client: c.K8sClient,
+		Out:    os.Stdout,
+		ErrOut: os.Stderr,
+	}
+	if err := drain.RunCordonOrUncordon(helper, node, false); err != nil {
+		return fmt.Errorf("error uncordoning node %q: %w", nodeName, err)
+	}
+
+	node, err = c.K8sClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting node %q: %w", nodeName, err)
+	}
+	oldData, err := json.Marshal(node)
+	if err != nil {
+		return err
+	}
+
+	if !keepExcludeFromLB {
+		delete(node.Labels, corev1.LabelNodeExcludeBalancers)
+	}
+	var taints []corev1.Taint
+	for _, taint := range node.Spec.Taints {
+		if taint.Key != rollingUpdateTaintKey {
+			taints = append(taints, taint)
+		}
+	}
+	node.Spec.Taints = taints
+
+	newData, err := json.Marshal(node)
+	if err != nil {
+		return err
+	}
+	patchBytes, err := strategicpatch.CreateTwoWayMergePatch(oldData, newData, node)
+	if err != nil {
+		return err
+	}
+	if _, err := c.K8sClient.CoreV1().Nodes().Patch(ctx, nodeName, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("error patching node %q: %w", nodeName, err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroups
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/cloudinstances"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+// fakeInPlaceResizer resizes the instances it has a machine type for, and reports their node as ready again.
+type fakeInPlaceResizer struct {
+	k8sClient    kubernetes.Interface
+	machineTypes map[string]string
+	resized      []string
+}
+
+func (r *fakeInPlaceResizer) PlanResize(ctx context.Context, u *cloudinstances.CloudInstance) (string, error) {
+	return r.machineTypes[u.ID], nil
+}
+
+func (r *fakeInPlaceResizer) Resize(ctx context.Context, u *cloudinstances.CloudInstance, machineType string) error {
+	r.resized = append(r.resized, u.ID)
+
+	node, err := r.k8sClient.CoreV1().Nodes().Get(ctx, u.Node.Name, v1meta.GetOptions{})
+	if err != nil {
+		return err
+	}
+	node.Status.Conditions = []corev1.NodeCondition{
+		{Type: corev1.NodeReady, Status: corev1.ConditionTrue, LastHeartbeatTime: v1meta.Now()},
+	}
+	_, err = r.k8sClient.CoreV1().Nodes().UpdateStatus(ctx, node, v1meta.UpdateOptions{})
+	return err
+}
+
+func TestRollingUpdateInPlaceResize(t *testing.T) {
+	ctx := context.TODO()
+	c, cloud := getTestSetup()
+	c.Options.InPlaceResize = true
+
+	resizer := &fakeInPlaceResizer{
+		k8sClient: c.K8sClient,
+		machineTypes: map[string]string{
+			"master-1a": "m6g.xlarge",
+			"master-1b": "m6g.xlarge",
+		},
+	}
+	previous := newInPlaceResizer
+	newInPlaceResizer = func(cloud fi.Cloud, group *cloudinstances.CloudInstanceGroup) inPlaceResizer {
+		return resizer
+	}
+	t.Cleanup(func() { newInPlaceResizer = previous })
+
+	groups := make(map[string]*cloudinstances.CloudInstanceGroup)
+	makeGroup(groups, c.K8sClient, cloud, "master-1", kopsapi.InstanceGroupRoleControlPlane, 3, 3)
+	makeGroup(groups, c.K8sClient, cloud, "node-1", kopsapi.InstanceGroupRoleNode, 2, 2)
+
+	// Control-plane nodes are normally excluded from load balancers already; that label must survive the resize.
+	for _, u := range groups["master-1"].NeedUpdate {
+		if u.ID != "master-1a" {
+			continue
+		}
+		u.Node.Labels = map[string]string{corev1.LabelNodeExcludeBalancers: ""}
+		_, err := c.K8sClient.CoreV1().Nodes().Update(ctx, u.Node, v1meta.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	err := c.RollingUpdate(ctx, groups, &kopsapi.InstanceGroupList{})
+	assert.NoError(t, err, "rolling update")
+
+	assert.Equal(t, []string{"master-1a", "master-1b"}, resizer.resized, "resized instances")
+
+	asgGroups, err := cloud.Autoscaling().DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{})
+	require.NoError(t, err)
+	remaining := map[string][]string{}
+	for _, group := range asgGroups.AutoScalingGroups {
+		for _, instance := range group.Instances {
+			name := aws.ToString(group.AutoScalingGroupName)
+			remaining[name] = append(remaining[name], aws.ToString(instance.InstanceId))
+		}
+	}
+	assert.Equal(t, map[string][]string{"master-1": {"master-1a", "master-1b"}}, remaining, "remaining instances")
+
+	for name, excluded := range map[string]bool{"master-1a.local": true, "master-1b.local": false} {
+		node, err := c.K8sClient.CoreV1().Nodes().Get(ctx, name, v1meta.GetOptions{})
+		require.NoError(t, err)
+		assert.False(t, node.Spec.Unschedulable, "node %s unschedulable", name)
+		assert.Empty(t, node.Spec.Taints, "node %s taints", name)
+		if excluded {
+			assert.Contains(t, node.Labels, corev1.LabelNodeExcludeBalancers, "node %s labels", name)
+		} else {
+			assert.NotContains(t, node.Labels, corev1.LabelNodeExcludeBalancers, "node %s labels", name)
+		}
+	}
+}
+
+func TestValidateInPlaceResize(t *testing.T) {
+	grid := []struct {
+		desc        string
+		cloud       kopsapi.CloudProviderSpec
+		options     RollingUpdateOptions
+		expectedErr string
+	}{
+		{
+			desc:    "disabled",
+			cloud:   kopsapi.CloudProviderSpec{Openstack: &kopsapi.OpenstackSpec{}},
+			options: RollingUpdateOptions{},
+		},
+		{
+			desc:    "aws",
+			cloud:   kopsapi.CloudProviderSpec{AWS: &kopsapi.AWSSpec{}},
+			options: RollingUpdateOptions{InPlaceResize: true},
+		},
+		{
+			desc:    "gce",
+			cloud:   kopsapi.CloudProviderSpec{GCE: &kopsapi.GCESpec{}},
+			options: RollingUpdateOptions{InPlaceResize: true},
+		},
+		{
+			desc:        "not supported",
+			cloud:       kopsapi.CloudProviderSpec{Openstack: &kopsapi.OpenstackSpec{}},
+			options:     RollingUpdateOptions{InPlaceResize: true},
+			expectedErr: "in-place resize is only supported on AWS and GCE",
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			cluster := &kopsapi.Cluster{Spec: kopsapi.ClusterSpec{CloudProvider: g.cloud}}
+			err := g.options.ValidateInPlaceResize(cluster)
+			if g.expectedErr != "" {
+				assert.EqualError(t, err, g.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
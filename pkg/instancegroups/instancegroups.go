@@ -138,6 +138,11 @@ func (c *RollingUpdateCluster) rollingUpdateInstanceGroup(ctx context.Context, g
 		return nil
 	}
 
+	if !c.Force && updatedByCloud(group.InstanceGroup) {
+		klog.Infof("Not replacing %d instances of InstanceGroup %q, as its managed instance groups update them proactively", len(group.NeedUpdate), group.InstanceGroup.Name)
+		return nil
+	}
+
 	if isBastion {
 		klog.V(3).Info("Not validating the cluster as instance is a bastion.")
 	} else if err = c.maybeValidate("", 1, group); err != nil {
@@ -160,6 +165,12 @@ func (c *RollingUpdateCluster) rollingUpdateInstanceGroup(ctx context.Context, g
 			if err != nil {
 				return fmt.Errorf("failed to delete warm pool instance %q: %w", instance.ID, err)
 			}
+			// Instances reused on scale in return to the warm pool with a node that is no longer running.
+			if instance.Node != nil && !c.CloudOnly {
+				if err := c.deleteNode(ctx, instance.Node); err != nil {
+					return fmt.Errorf("failed to delete node for warm pool instance %q: %w", instance.ID, err)
+				}
+			}
 		} else {
 			nonWarmPool = append(nonWarmPool, instance)
 		}
@@ -168,6 +179,22 @@ func (c *RollingUpdateCluster) rollingUpdateInstanceGroup(ctx context.Context, g
 
 	settings := resolveSettings(c.Cluster, group.InstanceGroup, numInstances)
 
+	update, err = c.resizeInstancesInPlace(ctx, group, update)
+	if err != nil {
+		return err
+	}
+	if len(update) == 0 {
+		return nil
+	}
+
+	if c.useInstanceRefresh(group) {
+		if !*settings.DrainAndTerminate {
+			klog.Infof("Rolling updates for InstanceGroup %s are disabled", group.InstanceGroup.Name)
+			return nil
+		}
+		return c.instanceRefreshInstanceGroup(ctx, group, update, settings, numInstances)
+	}
+
 	runningDrains := 0
 	maxSurge := settings.MaxSurge.IntValue()
 
@@ -316,19 +343,24 @@ func (c *RollingUpdateCluster) rollingUpdateInstanceGroup(ctx context.Context, g
 
 func prioritizeUpdate(update []*cloudinstances.CloudInstance) []*cloudinstances.CloudInstance {
 	// The priorities are, in order:
+	//   evicted before running, as evicted instances no longer serve any workloads
 	//   attached before detached
 	//   TODO unhealthy before healthy
 	//   NeedUpdate before Ready (preserve original order)
 	result := make([]*cloudinstances.CloudInstance, 0, len(update))
-	var detached []*cloudinstances.CloudInstance
+	var attached, detached []*cloudinstances.CloudInstance
 	for _, u := range update {
-		if u.Status == cloudinstances.CloudInstanceStatusDetached {
-			detached = append(detached, u)
-		} else {
+		switch {
+		case u.State == cloudinstances.Evicted:
 			result = append(result, u)
+		case u.Status == cloudinstances.CloudInstanceStatusDetached:
+			detached = append(detached, u)
+		default:
+			attached = append(attached, u)
 		}
 	}
 
+	result = append(result, attached...)
 	result = append(result, detached...)
 	return result
 }
@@ -444,23 +476,8 @@ func (c *RollingUpdateCluster) drainTerminateAndWait(ctx context.Context, u *clo
 
 	isBastion := u.CloudInstanceGroup.InstanceGroup.IsBastion()
 
-	if isBastion {
-		// We don't want to validate for bastions - they aren't part of the cluster
-	} else if c.CloudOnly {
-		klog.Warning("Not draining cluster nodes as 'cloudonly' flag is set.")
-	} else {
-		if u.Node != nil {
-			klog.Infof("Draining the node: %q.", nodeName)
-
-			if err := c.drainNode(ctx, u); err != nil {
-				if c.FailOnDrainError {
-					return fmt.Errorf("failed to drain node %q: %w", nodeName, err)
-				}
-				klog.Infof("Ignoring error draining node %q: %v", nodeName, err)
-			}
-		} else {
-			klog.Warningf("Skipping drain of instance %q, because it is not registered in kubernetes", instanceID)
-		}
+	if err := c.drainInstance(ctx, u); err != nil {
+		return err
 	}
 
 	// GCE often re-uses names, so we delete the node object to prevent the new instance from using the cordoned Node object
@@ -494,6 +511,40 @@ func (c *RollingUpdateCluster) drainTerminateAndWait(ctx context.Context, u *clo
 	return nil
 }
 
+// drainInstance drains the node of an instance that is about to be terminated, unless there is nothing to drain.
+func (c *RollingUpdateCluster) drainInstance(ctx context.Context, u *cloudinstances.CloudInstance) error {
+	instanceID := u.ID
+
+	if u.CloudInstanceGroup.InstanceGroup.IsBastion() {
+		// We don't want to validate for bastions - they aren't part of the cluster
+		return nil
+	}
+	if c.CloudOnly {
+		klog.Warning("Not draining cluster nodes as 'cloudonly' flag is set.")
+		return nil
+	}
+	if u.State == cloudinstances.Evicted {
+		// The pods of an evicted instance are no longer running, so draining would only wait for them to time out
+		klog.Infof("Skipping drain of instance %q, because it was evicted by the cloud provider", instanceID)
+		return nil
+	}
+	if u.Node == nil {
+		klog.Warningf("Skipping drain of instance %q, because it is not registered in kubernetes", instanceID)
+		return nil
+	}
+
+	nodeName := u.Node.Name
+	klog.Infof("Draining the node: %q.", nodeName)
+
+	if err := c.drainNode(ctx, u); err != nil {
+		if c.FailOnDrainError {
+			return fmt.Errorf("failed to drain node %q: %w", nodeName, err)
+		}
+		klog.Infof("Ignoring error draining node %q: %v", nodeName, err)
+	}
+	return nil
+}
+
 func (c *RollingUpdateCluster) reconcileInstanceGroup(ctx context.Context) error {
 	if c.Cluster.GetCloudProvider() != api.CloudProviderOpenstack &&
 		c.Cluster.GetCloudProvider() != api.CloudProviderHetzner &&
@@ -776,3 +827,10 @@ func (c *RollingUpdateCluster) UpdateSingleInstance(ctx context.Context, cloudMe
 
 	return c.drainTerminateAndWait(ctx, cloudMember, 0)
 }
+
+// updatedByCloud returns true if the cloud applies changes to the existing instances of the group itself,
+// in which case a rolling update should not replace them.
+func updatedByCloud(ig *api.InstanceGroup) bool {
+	policy := ig.Spec.ManagedInstanceGroupUpdatePolicy
+	return policy != nil && fi.ValueOf(policy.Type) == "PROACTIVE"
+}
@@ -18,6 +18,7 @@ package instancegroups
 
 import (
 	"context"
+	"reflect"
 	"testing"
 	"time"
 
@@ -66,3 +67,21 @@ func TestWarmPoolOnlyRoll(t *testing.T) {
 		}
 	}
 }
+
+func TestPrioritizeUpdate(t *testing.T) {
+	update := []*cloudinstances.CloudInstance{
+		{ID: "detached", Status: cloudinstances.CloudInstanceStatusDetached},
+		{ID: "attached-1", Status: cloudinstances.CloudInstanceStatusNeedsUpdate},
+		{ID: "evicted", Status: cloudinstances.CloudInstanceStatusNeedsUpdate, State: cloudinstances.Evicted},
+		{ID: "attached-2", Status: cloudinstances.CloudInstanceStatusNeedsUpdate},
+	}
+
+	var ids []string
+	for _, u := range prioritizeUpdate(update) {
+		ids = append(ids, u.ID)
+	}
+	expected := []string{"evicted", "attached-1", "attached-2", "detached"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("unexpected order: expected %v, but got %v", expected, ids)
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroups
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"k8s.io/klog/v2"
+
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/cloudinstances"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+// instanceRefreshPollInterval is the time between checks on the progress of an instance refresh.
+var instanceRefreshPollInterval = 15 * time.Second
+
+// useInstanceRefresh returns true if the instances of the group should be replaced by an ASG instance refresh.
+func (c *RollingUpdateCluster) useInstanceRefresh(group *cloudinstances.CloudInstanceGroup) bool {
+	if !c.Options.InstanceRefresh {
+		return false
+	}
+	ig := group.InstanceGroup
+	if !ig.Spec.Role.HasNode() || ig.Spec.Manager == api.InstanceManagerKarpenter {
+		return false
+	}
+	if _, ok := c.Cloud.(awsup.AWSCloud); !ok {
+		return false
+	}
+	_, ok := group.Raw.(*autoscalingtypes.AutoScalingGroup)
+	return ok
+}
+
+// instanceRefreshHealthyPercentages translates the rolling update settings of an instance group
+// into the minimum and maximum healthy percentages of an instance refresh.
+func instanceRefreshHealthyPercentages(settings api.RollingUpdate, numInstances int) (minHealthy int32, maxHealthy int32) {
+	if numInstances <= 0 {
+		return 100, 100
+	}
+
+	// Round unavailable instances down and surge instances up, so that we never have fewer
+	// healthy instances than a regular rolling update would keep.
+	unavailable := min(settings.MaxUnavailable.IntValue()*100/numInstances, 100)
+	surge := min((settings.MaxSurge.IntValue()*100+numInstances-1)/numInstances, 100)
+
+	minHealthy = int32(100 - unavailable)
+	maxHealthy = int32(100 + surge)
+	// AWS does not allow the range to be wider than 100 percentage points.
+	if maxHealthy-minHealthy > 100 {
+		minHealthy = maxHealthy - 100
+	}
+	return minHealthy, maxHealthy
+}
+
+// instanceRefreshInstanceGroup replaces the instances of an AWS instance group using an ASG instance refresh.
+// AWS picks and replaces the instances, while a termination lifecycle hook lets us validate the cluster
+// and drain each instance before it is terminated.
+func (c *RollingUpdateCluster) instanceRefreshInstanceGroup(ctx context.Context, group *cloudinstances.CloudInstanceGroup, update []*cloudinstances.CloudInstance, settings api.RollingUpdate, numInstances int) error {
+	cloud := c.Cloud.(awsup.AWSCloud)
+
+	minHealthy, maxHealthy := instanceRefreshHealthyPercentages(settings, numInstances)
+	options := awsup.InstanceRefreshOptions{
+		MinHealthyPercentage:  minHealthy,
+		MaxHealthyPercentage:  maxHealthy,
+		SkipMatching:          !c.Force,
+		CheckpointPercentages: c.Options.InstanceRefreshCheckpoints,
+		CheckpointDelay:       c.Options.InstanceRefreshCheckpointDelay,
+		DrainTimeout:          c.DrainTimeout,
+	}
+
+	klog.Infof("Starting instance refresh of InstanceGroup %q, replacing %d instances (min healthy %d%%, max healthy %d%%)", group.InstanceGroup.Name, len(update), minHealthy, maxHealthy)
+	refreshID, err := awsup.StartInstanceRefresh(ctx, cloud, group, options)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := awsup.DeleteInstanceRefreshLifecycleHook(ctx, cloud, group); err != nil {
+			klog.Warningf("%v", err)
+		}
+	}()
+
+	instances := make(map[string]*cloudinstances.CloudInstance)
+	for _, u := range group.NeedUpdate {
+		instances[u.ID] = u
+	}
+	for _, u := range group.Ready {
+		instances[u.ID] = u
+	}
+
+	lastProgress := int32(-1)
+	for {
+		waiting, err := awsup.FindInstancesAwaitingTermination(ctx, cloud, group)
+		if err != nil {
+			return err
+		}
+		for _, id := range waiting {
+			if err := c.drainInstanceForRefresh(ctx, cloud, group, instances[id], id); err != nil {
+				if cancelErr := awsup.CancelInstanceRefresh(ctx, cloud, group); cancelErr != nil {
+					klog.Warningf("%v", cancelErr)
+				}
+				return err
+			}
+		}
+
+		refresh, err := awsup.DescribeInstanceRefresh(ctx, cloud, group, refreshID)
+		if err != nil {
+			return err
+		}
+		switch refresh.Status {
+		case autoscalingtypes.InstanceRefreshStatusSuccessful:
+			klog.Infof("Instance refresh %q of InstanceGroup %q completed", refreshID, group.InstanceGroup.Name)
+			return c.maybeValidate(" after instance refresh", c.ValidateCount, group)
+		case autoscalingtypes.InstanceRefreshStatusPending,
+			autoscalingtypes.InstanceRefreshStatusInProgress,
+			autoscalingtypes.InstanceRefreshStatusBaking:
+			if progress := aws.ToInt32(refresh.PercentageComplete); progress != lastProgress {
+				klog.Infof("Instance refresh %q of InstanceGroup %q is %d%% complete", refreshID, group.InstanceGroup.Name, progress)
+				lastProgress = progress
+			}
+		default:
+			return fmt.Errorf("instance refresh %q of InstanceGroup %q ended with status %q: %s", refreshID, group.InstanceGroup.Name, refresh.Status, aws.ToString(refresh.StatusReason))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(instanceRefreshPollInterval):
+		}
+	}
+}
+
+// drainInstanceForRefresh validates the cluster and drains an instance the instance refresh wants to terminate,
+// then lets the termination proceed.
+func (c *RollingUpdateCluster) drainInstanceForRefresh(ctx context.Context, cloud awsup.AWSCloud, group *cloudinstances.CloudInstanceGroup, u *cloudinstances.CloudInstance, id string) error {
+	if u == nil {
+		klog.Warningf("Instance %q being terminated by the instance refresh was not known at the start of the rolling update, not draining it", id)
+	} else {
+		if err := c.maybeValidate(" before draining instance", c.ValidateCount, group); err != nil {
+			return err
+		}
+		if err := c.drainInstance(ctx, u); err != nil {
+			return err
+		}
+	}
+	return awsup.CompleteInstanceTermination(ctx, cloud, group, id)
+}
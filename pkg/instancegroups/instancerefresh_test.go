@@ -0,0 +1,284 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroups
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	testingclient "k8s.io/client-go/testing"
+
+	"k8s.io/kops/cloudmock/aws/mockautoscaling"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/cloudinstances"
+	"k8s.io/kops/pkg/validation"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/awsinterfaces"
+)
+
+func TestInstanceRefreshHealthyPercentages(t *testing.T) {
+	grid := []struct {
+		surge        int
+		unavailable  int
+		numInstances int
+		minHealthy   int32
+		maxHealthy   int32
+	}{
+		{surge: 1, unavailable: 0, numInstances: 10, minHealthy: 100, maxHealthy: 110},
+		{surge: 0, unavailable: 1, numInstances: 3, minHealthy: 67, maxHealthy: 100},
+		{surge: 1, unavailable: 0, numInstances: 300, minHealthy: 100, maxHealthy: 101},
+		{surge: 0, unavailable: 1, numInstances: 300, minHealthy: 100, maxHealthy: 100},
+		{surge: 5, unavailable: 5, numInstances: 2, minHealthy: 100, maxHealthy: 200},
+		{surge: 2, unavailable: 1, numInstances: 2, minHealthy: 100, maxHealthy: 200},
+		{surge: 0, unavailable: 1, numInstances: 0, minHealthy: 100, maxHealthy: 100},
+	}
+
+	for _, g := range grid {
+		surge := intstr.FromInt(g.surge)
+		unavailable := intstr.FromInt(g.unavailable)
+		settings := kopsapi.RollingUpdate{MaxSurge: &surge, MaxUnavailable: &unavailable}
+
+		minHealthy, maxHealthy := instanceRefreshHealthyPercentages(settings, g.numInstances)
+		assert.Equal(t, g.minHealthy, minHealthy, "min healthy for %+v", g)
+		assert.Equal(t, g.maxHealthy, maxHealthy, "max healthy for %+v", g)
+	}
+}
+
+func TestValidateInstanceRefresh(t *testing.T) {
+	grid := []struct {
+		desc        string
+		cloud       kopsapi.CloudProviderSpec
+		options     RollingUpdateOptions
+		expected    []int32
+		expectedErr string
+	}{
+		{
+			desc:    "disabled",
+			cloud:   kopsapi.CloudProviderSpec{GCE: &kopsapi.GCESpec{}},
+			options: RollingUpdateOptions{},
+		},
+		{
+			desc:        "checkpoints without instance refresh",
+			cloud:       kopsapi.CloudProviderSpec{AWS: &kopsapi.AWSSpec{}},
+			options:     RollingUpdateOptions{InstanceRefreshCheckpoints: []int32{50}},
+			expectedErr: "instance refresh checkpoints can only be set together with instance refresh",
+		},
+		{
+			desc:        "not aws",
+			cloud:       kopsapi.CloudProviderSpec{GCE: &kopsapi.GCESpec{}},
+			options:     RollingUpdateOptions{InstanceRefresh: true},
+			expectedErr: "instance refresh is only supported on AWS",
+		},
+		{
+			desc:    "no checkpoints",
+			cloud:   kopsapi.CloudProviderSpec{AWS: &kopsapi.AWSSpec{}},
+			options: RollingUpdateOptions{InstanceRefresh: true},
+		},
+		{
+			desc:     "last checkpoint added",
+			cloud:    kopsapi.CloudProviderSpec{AWS: &kopsapi.AWSSpec{}},
+			options:  RollingUpdateOptions{InstanceRefresh: true, InstanceRefreshCheckpoints: []int32{20, 50}},
+			expected: []int32{20, 50, 100},
+		},
+		{
+			desc:     "last checkpoint kept",
+			cloud:    kopsapi.CloudProviderSpec{AWS: &kopsapi.AWSSpec{}},
+			options:  RollingUpdateOptions{InstanceRefresh: true, InstanceRefreshCheckpoints: []int32{50, 100}},
+			expected: []int32{50, 100},
+		},
+		{
+			desc:        "checkpoints not increasing",
+			cloud:       kopsapi.CloudProviderSpec{AWS: &kopsapi.AWSSpec{}},
+			options:     RollingUpdateOptions{InstanceRefresh: true, InstanceRefreshCheckpoints: []int32{50, 50}},
+			expectedErr: "instance refresh checkpoints must be increasing percentages between 1 and 100, got [50 50]",
+		},
+		{
+			desc:        "checkpoint out of range",
+			cloud:       kopsapi.CloudProviderSpec{AWS: &kopsapi.AWSSpec{}},
+			options:     RollingUpdateOptions{InstanceRefresh: true, InstanceRefreshCheckpoints: []int32{150}},
+			expectedErr: "instance refresh checkpoints must be increasing percentages between 1 and 100, got [150]",
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			cluster := &kopsapi.Cluster{Spec: kopsapi.ClusterSpec{CloudProvider: g.cloud}}
+			err := g.options.ValidateInstanceRefresh(cluster)
+			if g.expectedErr != "" {
+				assert.EqualError(t, err, g.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, g.expected, g.options.InstanceRefreshCheckpoints)
+		})
+	}
+}
+
+// instanceRefreshTest plays the part of AWS during an instance refresh: each time the refresh is
+// described, it holds the next old instance in the termination lifecycle hook, and removes the
+// instances whose lifecycle action was completed.
+type instanceRefreshTest struct {
+	awsinterfaces.AutoScalingAPI
+	mock     *mockautoscaling.MockAutoscaling
+	replaced []string
+}
+
+func (m *instanceRefreshTest) DescribeInstanceRefreshes(ctx context.Context, input *autoscaling.DescribeInstanceRefreshesInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeInstanceRefreshesOutput, error) {
+	name := aws.ToString(input.AutoScalingGroupName)
+	refresh := m.mock.InstanceRefreshes[name][0]
+	group := m.mock.Groups[name]
+
+	var remaining []autoscalingtypes.Instance
+	waiting := false
+	for _, instance := range group.Instances {
+		switch instance.LifecycleState {
+		case autoscalingtypes.LifecycleStateTerminatingProceed:
+			m.replaced = append(m.replaced, aws.ToString(instance.InstanceId))
+			continue
+		case autoscalingtypes.LifecycleStateTerminatingWait:
+			waiting = true
+		}
+		remaining = append(remaining, instance)
+	}
+	group.Instances = remaining
+
+	switch {
+	case waiting:
+	case len(group.Instances) == 0:
+		refresh.Status = autoscalingtypes.InstanceRefreshStatusSuccessful
+	default:
+		refresh.Status = autoscalingtypes.InstanceRefreshStatusInProgress
+		group.Instances[0].LifecycleState = autoscalingtypes.LifecycleStateTerminatingWait
+	}
+
+	return m.AutoScalingAPI.DescribeInstanceRefreshes(ctx, input, optFns...)
+}
+
+func setupInstanceRefreshTest(t *testing.T) (*RollingUpdateCluster, *instanceRefreshTest, map[string]*cloudinstances.CloudInstanceGroup) {
+	c, cloud := getTestSetup()
+	c.Options.InstanceRefresh = true
+
+	pollInterval := instanceRefreshPollInterval
+	instanceRefreshPollInterval = time.Millisecond
+	t.Cleanup(func() { instanceRefreshPollInterval = pollInterval })
+
+	mock := cloud.MockAutoscaling.(*mockautoscaling.MockAutoscaling)
+	refreshTest := &instanceRefreshTest{
+		AutoScalingAPI: mock,
+		mock:           mock,
+	}
+	cloud.MockAutoscaling = refreshTest
+
+	groups := make(map[string]*cloudinstances.CloudInstanceGroup)
+	makeGroup(groups, c.K8sClient, cloud, "node-1", kopsapi.InstanceGroupRoleNode, 3, 3)
+	groups["node-1"].Raw = &autoscalingtypes.AutoScalingGroup{AutoScalingGroupName: aws.String("node-1")}
+
+	return c, refreshTest, groups
+}
+
+func TestRollingUpdateInstanceRefresh(t *testing.T) {
+	ctx := context.TODO()
+	c, refreshTest, groups := setupInstanceRefreshTest(t)
+
+	err := c.RollingUpdate(ctx, groups, &kopsapi.InstanceGroupList{})
+	assert.NoError(t, err, "rolling update")
+
+	assert.ElementsMatch(t, []string{"node-1a", "node-1b", "node-1c"}, refreshTest.replaced, "replaced instances")
+
+	refreshes := refreshTest.mock.InstanceRefreshes["node-1"]
+	if assert.Len(t, refreshes, 1, "instance refreshes") {
+		preferences := refreshes[0].Preferences
+		assert.True(t, aws.ToBool(preferences.SkipMatching), "skip matching")
+		assert.Equal(t, int32(67), aws.ToInt32(preferences.MinHealthyPercentage), "min healthy percentage")
+		assert.Equal(t, int32(100), aws.ToInt32(preferences.MaxHealthyPercentage), "max healthy percentage")
+	}
+	assert.Empty(t, refreshTest.mock.LifecycleHooks, "lifecycle hooks after instance refresh")
+
+	cordoned := map[string]bool{}
+	for _, action := range c.K8sClient.(*fake.Clientset).Actions() {
+		if a, ok := action.(testingclient.PatchAction); ok && string(a.GetPatch()) == cordonPatch {
+			cordoned[a.GetName()] = true
+		}
+	}
+	assert.Equal(t, map[string]bool{"node-1a.local": true, "node-1b.local": true, "node-1c.local": true}, cordoned, "cordoned nodes")
+}
+
+// failAfterInstanceRefreshStartsValidator validates the cluster until an instance refresh has been started.
+type failAfterInstanceRefreshStartsValidator struct {
+	mock *mockautoscaling.MockAutoscaling
+}
+
+func (v *failAfterInstanceRefreshStartsValidator) Validate(ctx context.Context) (*validation.ValidationCluster, error) {
+	if len(v.mock.InstanceRefreshes) == 0 {
+		return &validation.ValidationCluster{}, nil
+	}
+	return (&failingClusterValidator{}).Validate(ctx)
+}
+
+func TestRollingUpdateInstanceRefreshCancelledOnValidationFailure(t *testing.T) {
+	ctx := context.TODO()
+	c, refreshTest, groups := setupInstanceRefreshTest(t)
+	c.ClusterValidator = &failAfterInstanceRefreshStartsValidator{mock: refreshTest.mock}
+
+	err := c.RollingUpdate(ctx, groups, &kopsapi.InstanceGroupList{})
+	assert.Error(t, err, "rolling update")
+
+	assert.Empty(t, refreshTest.replaced, "replaced instances")
+	refreshes := refreshTest.mock.InstanceRefreshes["node-1"]
+	if assert.Len(t, refreshes, 1, "instance refreshes") {
+		assert.Equal(t, autoscalingtypes.InstanceRefreshStatusCancelled, refreshes[0].Status, "instance refresh status")
+	}
+	assert.Empty(t, refreshTest.mock.LifecycleHooks, "lifecycle hooks after instance refresh")
+}
+
+func TestRollingUpdateInstanceRefreshResumesActiveRefresh(t *testing.T) {
+	ctx := context.TODO()
+	c, refreshTest, groups := setupInstanceRefreshTest(t)
+
+	cloud := c.Cloud.(awsup.AWSCloud)
+	_, err := cloud.Autoscaling().StartInstanceRefresh(ctx, &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String("node-1"),
+	})
+	assert.NoError(t, err, "starting instance refresh")
+
+	err = c.RollingUpdate(ctx, groups, &kopsapi.InstanceGroupList{})
+	assert.NoError(t, err, "rolling update")
+
+	assert.Len(t, refreshTest.mock.InstanceRefreshes["node-1"], 1, "instance refreshes")
+	assert.Len(t, refreshTest.replaced, 3, "replaced instances")
+}
+
+func TestRollingUpdateInstanceRefreshOnlyWorkers(t *testing.T) {
+	ctx := context.TODO()
+	c, refreshTest, _ := setupInstanceRefreshTest(t)
+
+	groups := make(map[string]*cloudinstances.CloudInstanceGroup)
+	makeGroup(groups, c.K8sClient, c.Cloud.(awsup.AWSCloud), "master-1", kopsapi.InstanceGroupRoleControlPlane, 2, 2)
+
+	err := c.RollingUpdate(ctx, groups, &kopsapi.InstanceGroupList{})
+	assert.NoError(t, err, "rolling update")
+
+	assert.Empty(t, refreshTest.mock.InstanceRefreshes, "instance refreshes")
+	assertGroupInstanceCount(t, c.Cloud.(*awsup.MockAWSCloud), "master-1", 0)
+}
@@ -92,10 +92,60 @@ type RollingUpdateOptions struct {
 	// DeregisterControlPlaneNodes controls if we deregister control plane instances from load balacners etc before draining/terminating.
 	// When a cluster only has a single apiserver, we don't want to do this, as we can't drain after deregistering it.
 	DeregisterControlPlaneNodes bool
+
+	// InstanceRefresh delegates replacing the instances of worker instance groups on AWS to an ASG instance refresh.
+	InstanceRefresh bool
+	// InstanceRefreshCheckpoints are the percentages of replaced instances at which an instance refresh pauses.
+	InstanceRefreshCheckpoints []int32
+	// InstanceRefreshCheckpointDelay is how long an instance refresh pauses at each checkpoint.
+	InstanceRefreshCheckpointDelay time.Duration
+
+	// InPlaceResize changes the machine type of control plane instances by restarting them, when it is their only change.
+	InPlaceResize bool
 }
 
 func (o *RollingUpdateOptions) InitDefaults() {
 	o.DeregisterControlPlaneNodes = true
+	o.InstanceRefreshCheckpointDelay = time.Hour
+}
+
+// ValidateInstanceRefresh checks the instance refresh options, and makes the last checkpoint
+// replace all remaining instances.
+func (o *RollingUpdateOptions) ValidateInstanceRefresh(cluster *api.Cluster) error {
+	if !o.InstanceRefresh {
+		if len(o.InstanceRefreshCheckpoints) != 0 {
+			return fmt.Errorf("instance refresh checkpoints can only be set together with instance refresh")
+		}
+		return nil
+	}
+	if cluster.GetCloudProvider() != api.CloudProviderAWS {
+		return fmt.Errorf("instance refresh is only supported on AWS")
+	}
+
+	previous := int32(0)
+	for _, checkpoint := range o.InstanceRefreshCheckpoints {
+		if checkpoint <= previous || checkpoint > 100 {
+			return fmt.Errorf("instance refresh checkpoints must be increasing percentages between 1 and 100, got %v", o.InstanceRefreshCheckpoints)
+		}
+		previous = checkpoint
+	}
+	if len(o.InstanceRefreshCheckpoints) != 0 && previous != 100 {
+		o.InstanceRefreshCheckpoints = append(o.InstanceRefreshCheckpoints, 100)
+	}
+	return nil
+}
+
+// ValidateInPlaceResize checks that the cloud of the cluster can resize instances in place.
+func (o *RollingUpdateOptions) ValidateInPlaceResize(cluster *api.Cluster) error {
+	if !o.InPlaceResize {
+		return nil
+	}
+	switch cluster.GetCloudProvider() {
+	case api.CloudProviderAWS, api.CloudProviderGCE:
+		return nil
+	default:
+		return fmt.Errorf("in-place resize is only supported on AWS and GCE")
+	}
 }
 
 // AdjustNeedUpdate adjusts the set of instances that need updating, using factors outside those known by the cloud implementation
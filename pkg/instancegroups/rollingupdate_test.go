@@ -346,6 +346,23 @@ func TestRollingUpdateNoneNeedUpdateWithForce(t *testing.T) {
 	}
 }
 
+func TestRollingUpdateSkipsProactivelyUpdatedGroups(t *testing.T) {
+	ctx := context.TODO()
+	c, cloud := getTestSetup()
+	groups := getGroupsAllNeedUpdate(c.K8sClient, cloud)
+	groups["node-1"].InstanceGroup.Spec.ManagedInstanceGroupUpdatePolicy = &kopsapi.ManagedInstanceGroupUpdatePolicySpec{
+		Type: new("PROACTIVE"),
+	}
+
+	err := c.RollingUpdate(ctx, groups, &kopsapi.InstanceGroupList{})
+	assert.NoError(t, err, "rolling update")
+
+	assertGroupInstanceCount(t, cloud, "node-1", 3)
+	assertGroupInstanceCount(t, cloud, "node-2", 0)
+	assertGroupInstanceCount(t, cloud, "master-1", 0)
+	assertGroupInstanceCount(t, cloud, "bastion-1", 0)
+}
+
 func TestRollingUpdateEmptyGroup(t *testing.T) {
 	ctx := context.TODO()
 	c, cloud := getTestSetup()
@@ -23,7 +23,11 @@ import (
 	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/kops/cloudmock/aws/mockautoscaling"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/cloudinstances"
@@ -71,6 +75,35 @@ func TestRollingWarmPoolBeforeJoinedNodes(t *testing.T) {
 	assert.Equal(t, 6, warmPoolBeforeJoinedNodesTest.numTerminations, "Number of terminations")
 }
 
+// Instances reused on scale in keep the node they registered while in service.
+func TestRollingUpdateWarmPoolDeletesReusedNodes(t *testing.T) {
+	ctx := context.TODO()
+	c, cloud := getTestSetup()
+	k8sClient := c.K8sClient
+	groups := make(map[string]*cloudinstances.CloudInstanceGroup)
+	makeGroupWithWarmPool(groups, k8sClient, cloud, "node-1", kops.InstanceGroupRoleNode, 3, 0, 2, 2)
+
+	reused := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1-wp-a.local"},
+	}
+	_ = k8sClient.(*fake.Clientset).Tracker().Add(reused)
+	for _, instance := range groups["node-1"].NeedUpdate {
+		if instance.ID == "node-1-wp-a" {
+			instance.Node = reused
+		}
+	}
+
+	err := c.RollingUpdate(ctx, groups, &kops.InstanceGroupList{})
+	assert.NoError(t, err, "rolling update")
+
+	_, err = k8sClient.CoreV1().Nodes().Get(ctx, reused.Name, metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err), "reused warm pool node should be deleted, got %v", err)
+
+	nodes, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	assert.NoError(t, err, "listing nodes")
+	assert.Equal(t, 3, len(nodes.Items), "number of remaining nodes")
+}
+
 type countingValidator struct {
 	numValidations int
 }
@@ -23,6 +23,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -31,14 +32,23 @@ import (
 	"path"
 	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/bootstrap"
 	"k8s.io/kops/pkg/dns"
+	"k8s.io/kops/pkg/pki"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/util/pkg/vfs"
 )
 
+const (
+	// ClientCertificatePath is where a node stores the client certificate kops-controller issued to it.
+	ClientCertificatePath = "/etc/kubernetes/kops/pki/kops-controller-client.crt"
+	// ClientKeyPath is where a node stores the private key of its kops-controller client certificate.
+	ClientKeyPath = "/etc/kubernetes/kops/pki/kops-controller-client.key"
+)
+
 type Client struct {
 	// Authenticator generates authentication credentials for requests.
 	Authenticator bootstrap.Authenticator
@@ -49,6 +59,7 @@ type Client struct {
 	BaseURL url.URL
 
 	httpClient *http.Client
+	tlsConfig  *tls.Config
 }
 
 func New(authenticator bootstrap.Authenticator, cas []byte, baseURL url.URL) *Client {
@@ -71,17 +82,49 @@ func NewWithTLSServerName(authenticator bootstrap.Authenticator, cas []byte, bas
 	if tlsServerName != "" {
 		tlsConfig.ServerName = tlsServerName
 	}
+	client.tlsConfig = tlsConfig
 	transport := &http.Transport{
 		TLSClientConfig: tlsConfig,
 	}
 	client.httpClient = &http.Client{
 		Timeout:   time.Duration(15) * time.Second,
-		Transport: transport,
+		Transport: otelhttp.NewTransport(transport),
 	}
 
 	return client
 }
 
+// PinCAs restricts the client to servers whose certificate was issued by a CA matching one of the pins.
+// It must be called before the first query.
+func (b *Client) PinCAs(pins []string) {
+	if len(pins) == 0 {
+		return
+	}
+	b.tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+		if !pki.ChainsMatchPins(state.VerifiedChains, pins) {
+			return fmt.Errorf("kops-controller certificate was not issued by a pinned CA")
+		}
+		return nil
+	}
+}
+
+// UseClientCertificate presents the client certificate at certPath to the server, if the node has one.
+// The certificate is read on every connection, so it can be replaced while the client is in use.
+// It must be called before the first query.
+func (b *Client) UseClientCertificate(certPath, keyPath string) {
+	b.tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				// The node has not bootstrapped yet
+				return &tls.Certificate{}, nil
+			}
+			return nil, fmt.Errorf("loading kops-controller client certificate: %w", err)
+		}
+		return &cert, nil
+	}
+}
+
 func (b *Client) Query(ctx context.Context, req any, resp any) error {
 	// Sanity-check DNS to provide clearer diagnostic messages.
 	if ips, err := net.LookupIP(b.BaseURL.Hostname()); err != nil {
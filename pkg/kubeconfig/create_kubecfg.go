@@ -99,8 +99,9 @@ func BuildKubecfg(ctx context.Context, cluster *kops.Cluster, keyStore fi.Keysto
 
 		// If a load balancer exists we use it, except for when an SSL certificate is set.
 		// This should avoid a lot of pain with DNS pre-creation.
-		if cluster.Spec.API.LoadBalancer != nil && (cluster.Spec.API.LoadBalancer.SSLCertificate == "" || options.Admin != 0) {
-			ingresses, err := cloud.GetApiIngressStatus(cluster)
+		// On Azure, the API name is resolvable from the networks linked to the private DNS zone, so we keep it.
+		if cluster.Spec.API.LoadBalancer != nil && (cluster.Spec.API.LoadBalancer.SSLCertificate == "" || options.Admin != 0) && !cluster.UsesAzurePrivateDNSForAPI() {
+			ingresses, err := cloud.GetApiIngressStatus(ctx, cluster)
 			if err != nil {
 				return nil, fmt.Errorf("error getting ingress status: %v", err)
 			}
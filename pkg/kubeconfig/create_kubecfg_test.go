@@ -45,7 +45,7 @@ type fakeStatusCloud struct {
 
 var _ fi.Cloud = &fakeStatusCloud{}
 
-func (f fakeStatusCloud) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+func (f fakeStatusCloud) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
 	return f.GetApiIngressStatusFn(cluster)
 }
 
@@ -85,7 +85,7 @@ func (f fakeStatusCloud) Region() string {
 	panic("not implemented")
 }
 
-func (f fakeStatusCloud) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+func (f fakeStatusCloud) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
 	panic("not implemented")
 }
 
@@ -160,6 +160,14 @@ func TestBuildKubecfg(t *testing.T) {
 	certCluster := buildMinimalCluster("testcluster", "testcluster.test.com", true, false)
 	certNLBCluster := buildMinimalCluster("testcluster", "testcluster.test.com", true, true)
 	certGossipNLBCluster := buildMinimalCluster("testgossipcluster.k8s.local", "", true, true)
+	azurePrivateDNSCluster := buildMinimalCluster("testcluster", "api.testcluster.example.com", false, true)
+	azurePrivateDNSCluster.Spec.CloudProvider = kops.CloudProviderSpec{
+		Azure: &kops.AzureSpec{
+			PrivateAPI: &kops.AzurePrivateAPISpec{
+				PrivateDNSZoneID: "/subscriptions/sub/resourceGroups/dns/providers/Microsoft.Network/privateDnsZones/example.com",
+			},
+		},
+	}
 
 	fakeStatus := fakeStatusCloud{
 		GetApiIngressStatusFn: func(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
@@ -304,6 +312,24 @@ func TestBuildKubecfg(t *testing.T) {
 			},
 			wantClientCert: false,
 		},
+		{
+			name: "Test Kube Config Data For Azure private DNS",
+			args: args{
+				cluster: azurePrivateDNSCluster,
+				status:  fakeStatus,
+				CreateKubecfgOptions: CreateKubecfgOptions{
+					Admin: DefaultKubecfgAdminLifetime,
+				},
+			},
+			want: &KubeconfigBuilder{
+				Context:       "testcluster",
+				Server:        "https://api.testcluster.example.com",
+				TLSServerName: "api.internal.testcluster",
+				CACerts:       []byte(nextCertificate + certData),
+				User:          "testcluster",
+			},
+			wantClientCert: true,
+		},
 		{
 			name: "Public DNS with kops auth plugin",
 			args: args{
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfig
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"filippo.io/age/plugin"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// Exported kubeconfigs are encrypted with age (https://age-encryption.org).
+// Native X25519 recipients ("age1...") are supported directly; any other
+// recipient or identity is delegated to the matching age plugin binary
+// ("age-plugin-<name>") on the PATH, which is how KMS and hardware-backed
+// keys are supported.
+
+// ParseRecipients parses age recipient strings, one per entry.
+func ParseRecipients(values []string) ([]age.Recipient, error) {
+	var recipients []age.Recipient
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if r, err := age.ParseX25519Recipient(value); err == nil {
+			recipients = append(recipients, r)
+			continue
+		}
+		r, err := plugin.NewRecipient(value, pluginUI)
+		if err != nil {
+			return nil, fmt.Errorf("unrecognized recipient %q: must be an age recipient (age1...) or an age plugin recipient", value)
+		}
+		recipients = append(recipients, r)
+	}
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("no recipients specified")
+	}
+	return recipients, nil
+}
+
+// ParseIdentities parses an age identity file. Empty lines and lines starting with "#" are ignored.
+func ParseIdentities(r io.Reader) ([]age.Identity, error) {
+	var identities []age.Identity
+	scanner := bufio.NewScanner(r)
+	n := 0
+	for scanner.Scan() {
+		n++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "AGE-PLUGIN-") {
+			identity, err := plugin.NewIdentity(line, pluginUI)
+			if err != nil {
+				return nil, fmt.Errorf("malformed plugin identity at line %d: %w", n, err)
+			}
+			identities = append(identities, identity)
+			continue
+		}
+		identity, err := age.ParseX25519Identity(line)
+		if err != nil {
+			// Don't include the line, which may contain a secret key
+			return nil, fmt.Errorf("malformed identity at line %d", n)
+		}
+		identities = append(identities, identity)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading identities: %w", err)
+	}
+	if len(identities) == 0 {
+		return nil, fmt.Errorf("no identities found")
+	}
+	return identities, nil
+}
+
+// EncryptKubecfg serializes config and encrypts it to the recipients, writing ASCII-armored output to w.
+func EncryptKubecfg(w io.Writer, config *clientcmdapi.Config, recipients []age.Recipient) error {
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		return fmt.Errorf("error serializing kubeconfig: %w", err)
+	}
+
+	armorWriter := armor.NewWriter(w)
+	encryptWriter, err := age.Encrypt(armorWriter, recipients...)
+	if err != nil {
+		return fmt.Errorf("error encrypting kubeconfig: %w", err)
+	}
+	if _, err := encryptWriter.Write(data); err != nil {
+		return fmt.Errorf("error encrypting kubeconfig: %w", err)
+	}
+	if err := encryptWriter.Close(); err != nil {
+		return fmt.Errorf("error encrypting kubeconfig: %w", err)
+	}
+	if err := armorWriter.Close(); err != nil {
+		return fmt.Errorf("error encrypting kubeconfig: %w", err)
+	}
+	return nil
+}
+
+// DecryptKubecfg decrypts a kubeconfig produced by EncryptKubecfg. Both armored and binary age files are accepted.
+func DecryptKubecfg(r io.Reader, identities []age.Identity) (*clientcmdapi.Config, error) {
+	br := bufio.NewReader(r)
+	var src io.Reader = br
+	if start, _ := br.Peek(len(armor.Header)); string(start) == armor.Header {
+		src = armor.NewReader(br)
+	}
+
+	plaintext, err := age.Decrypt(src, identities...)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting kubeconfig: %w", err)
+	}
+	var data bytes.Buffer
+	if _, err := io.Copy(&data, plaintext); err != nil {
+		return nil, fmt.Errorf("error decrypting kubeconfig: %w", err)
+	}
+
+	config, err := clientcmd.Load(data.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing decrypted kubeconfig: %w", err)
+	}
+	return config, nil
+}
+
+// pluginUI reports messages from age plugins on stderr, so they don't mix with kubeconfig output.
+var pluginUI = &plugin.ClientUI{
+	DisplayMessage: func(name, message string) error {
+		fmt.Fprintf(os.Stderr, "age-plugin-%s: %s\n", name, message)
+		return nil
+	},
+	WaitTimer: func(name string) {
+		fmt.Fprintf(os.Stderr, "waiting on age-plugin-%s...\n", name)
+	},
+}
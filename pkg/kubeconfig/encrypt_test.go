@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfig
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/age/plugin"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestEncryptKubecfgRoundTrip(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatalf("error generating identity: %v", err)
+	}
+	other, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatalf("error generating identity: %v", err)
+	}
+
+	b := &KubeconfigBuilder{
+		Server:     "https://api.testcluster",
+		Context:    "testcluster",
+		User:       "testcluster",
+		CACerts:    []byte("ca"),
+		ClientCert: []byte("cert"),
+		ClientKey:  []byte("secret-key"),
+	}
+	config := clientcmdapi.NewConfig()
+	if err := b.AddTo(config); err != nil {
+		t.Fatalf("error building kubeconfig: %v", err)
+	}
+
+	recipients, err := ParseRecipients([]string{identity.Recipient().String(), other.Recipient().String()})
+	if err != nil {
+		t.Fatalf("error parsing recipients: %v", err)
+	}
+
+	var encrypted bytes.Buffer
+	if err := EncryptKubecfg(&encrypted, config, recipients); err != nil {
+		t.Fatalf("error encrypting: %v", err)
+	}
+	if strings.Contains(encrypted.String(), "secret-key") || strings.Contains(encrypted.String(), "api.testcluster") {
+		t.Fatalf("encrypted kubeconfig contains plaintext")
+	}
+
+	identities, err := ParseIdentities(strings.NewReader("# created by test\n\n" + other.String() + "\n"))
+	if err != nil {
+		t.Fatalf("error parsing identities: %v", err)
+	}
+	decrypted, err := DecryptKubecfg(bytes.NewReader(encrypted.Bytes()), identities)
+	if err != nil {
+		t.Fatalf("error decrypting: %v", err)
+	}
+	if decrypted.CurrentContext != "testcluster" {
+		t.Errorf("unexpected current context %q", decrypted.CurrentContext)
+	}
+	if got := decrypted.Clusters["testcluster"].Server; got != "https://api.testcluster" {
+		t.Errorf("unexpected server %q", got)
+	}
+	if got := string(decrypted.AuthInfos["testcluster"].ClientKeyData); got != "secret-key" {
+		t.Errorf("unexpected client key %q", got)
+	}
+
+	unrelated, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatalf("error generating identity: %v", err)
+	}
+	if _, err := DecryptKubecfg(bytes.NewReader(encrypted.Bytes()), []age.Identity{unrelated}); err == nil {
+		t.Errorf("expected decryption with an unrelated identity to fail")
+	}
+}
+
+func TestParseRecipients(t *testing.T) {
+	if _, err := ParseRecipients(nil); err == nil {
+		t.Errorf("expected error for no recipients")
+	}
+	if _, err := ParseRecipients([]string{"not-a-recipient"}); err == nil {
+		t.Errorf("expected error for malformed recipient")
+	}
+	// Plugin recipients are parsed without running the plugin
+	recipients, err := ParseRecipients([]string{plugin.EncodeRecipient("kms", []byte("key-id"))})
+	if err != nil {
+		t.Fatalf("error parsing plugin recipient: %v", err)
+	}
+	if r, ok := recipients[0].(*plugin.Recipient); !ok || r.Name() != "kms" {
+		t.Errorf("expected recipient for age-plugin-kms, got %#v", recipients[0])
+	}
+}
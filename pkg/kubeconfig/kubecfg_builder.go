@@ -89,6 +89,20 @@ func (b *KubeconfigBuilder) WriteKubecfg(configAccess clientcmd.ConfigAccess) er
 		config = &clientcmdapi.Config{}
 	}
 
+	if err := b.AddTo(config); err != nil {
+		return err
+	}
+
+	if err := clientcmd.ModifyConfig(configAccess, *config, true); err != nil {
+		return err
+	}
+
+	fmt.Printf("kOps has set your kubectl context to %s\n", b.Context)
+	return nil
+}
+
+// AddTo adds the cluster, user and context to config, and makes the context the current context
+func (b *KubeconfigBuilder) AddTo(config *clientcmdapi.Config) error {
 	{
 		cluster := config.Clusters[b.Context]
 		if cluster == nil {
@@ -202,11 +216,6 @@ func (b *KubeconfigBuilder) WriteKubecfg(configAccess clientcmd.ConfigAccess) er
 
 	config.CurrentContext = b.Context
 
-	if err := clientcmd.ModifyConfig(configAccess, *config, true); err != nil {
-		return err
-	}
-
-	fmt.Printf("kOps has set your kubectl context to %s\n", b.Context)
 	return nil
 }
 
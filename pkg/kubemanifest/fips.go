@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubemanifest
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/kops/pkg/apis/nodeup"
+)
+
+// GoFIPSModeGODEBUG is the GODEBUG setting that restricts Go binaries to the FIPS 140-3 module.
+const GoFIPSModeGODEBUG = "fips140=on"
+
+// AddGoFIPSMode restricts the Go binaries of a pod to FIPS 140-3 approved cryptography when FIPS mode is enabled.
+func AddGoFIPSMode(pod *v1.Pod, cfg *nodeup.Config) {
+	if !cfg.FIPSMode {
+		return
+	}
+
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		found := false
+		for j := range container.Env {
+			env := &container.Env[j]
+			if env.Name == "GODEBUG" {
+				if env.Value == "" {
+					env.Value = GoFIPSModeGODEBUG
+				} else {
+					env.Value += "," + GoFIPSModeGODEBUG
+				}
+				found = true
+			}
+		}
+		if !found {
+			container.Env = append(container.Env, v1.EnvVar{Name: "GODEBUG", Value: GoFIPSModeGODEBUG})
+		}
+	}
+}
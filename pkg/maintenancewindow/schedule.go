@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression with minute, hour, day of month, month and day of week fields.
+type Schedule struct {
+	minutes     uint64
+	hours       uint64
+	daysOfMonth uint64
+	months      uint64
+	daysOfWeek  uint64
+
+	// anyDayOfMonth and anyDayOfWeek record the fields that were "*",
+	// because as with cron a day matches either day field when both are restricted.
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+type scheduleField struct {
+	name     string
+	min, max int
+}
+
+var scheduleFields = []scheduleField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	// 7 is also Sunday, as with cron
+	{name: "day of week", min: 0, max: 7},
+}
+
+// ParseSchedule parses a cron expression such as "0 22 * * 1-5".
+// Each field is "*" or a comma separated list of values and ranges, optionally with a "/step".
+func ParseSchedule(spec string) (*Schedule, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != len(scheduleFields) {
+		return nil, fmt.Errorf("schedule %q must have %d fields (minute, hour, day of month, month, day of week), found %d", spec, len(scheduleFields), len(parts))
+	}
+
+	var bits [5]uint64
+	for i, part := range parts {
+		b, err := parseScheduleField(part, scheduleFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("schedule %q: %w", spec, err)
+		}
+		bits[i] = b
+	}
+
+	// Fold Sunday as 7 into Sunday as 0
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = (bits[4] &^ (1 << 7)) | 1
+	}
+
+	return &Schedule{
+		minutes:       bits[0],
+		hours:         bits[1],
+		daysOfMonth:   bits[2],
+		months:        bits[3],
+		daysOfWeek:    bits[4],
+		anyDayOfMonth: parts[2] == "*",
+		anyDayOfWeek:  parts[4] == "*",
+	}, nil
+}
+
+func parseScheduleField(s string, field scheduleField) (uint64, error) {
+	var bits uint64
+	for _, term := range strings.Split(s, ",") {
+		rangeSpec, stepSpec, hasStep := strings.Cut(term, "/")
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepSpec)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepSpec, field.name)
+			}
+			step = n
+		}
+
+		start, end := field.min, field.max
+		if rangeSpec != "*" {
+			lo, hi, isRange := strings.Cut(rangeSpec, "-")
+			n, err := parseScheduleValue(lo, field)
+			if err != nil {
+				return 0, err
+			}
+			start, end = n, n
+			if isRange {
+				if end, err = parseScheduleValue(hi, field); err != nil {
+					return 0, err
+				}
+				if end < start {
+					return 0, fmt.Errorf("invalid range %q in %s field", rangeSpec, field.name)
+				}
+			} else if hasStep {
+				// As with cron, "n/step" means from n to the maximum
+				end = field.max
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseScheduleValue(s string, field scheduleField) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", s, field.name)
+	}
+	if n < field.min || n > field.max {
+		return 0, fmt.Errorf("value %d in %s field is not between %d and %d", n, field.name, field.min, field.max)
+	}
+	return n, nil
+}
+
+// Matches returns true if the schedule fires in the minute of t.
+func (s *Schedule) Matches(t time.Time) bool {
+	return s.minutes&(1<<uint(t.Minute())) != 0 &&
+		s.hours&(1<<uint(t.Hour())) != 0 &&
+		s.months&(1<<uint(t.Month())) != 0 &&
+		s.matchesDay(t)
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := s.daysOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.daysOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.anyDayOfMonth || s.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// maxScheduleSearch bounds how far ahead Next looks; every valid schedule fires at least once in this period,
+// except for schedules on days that don't exist such as February 30th.
+const maxScheduleSearch = 5 * 366 * 24 * time.Hour
+
+// Next returns the first time after t at which the schedule fires, in the location of t,
+// or the zero time if the schedule never fires.
+func (s *Schedule) Next(t time.Time) time.Time {
+	limit := t.Add(maxScheduleSearch)
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for t.Before(limit) {
+		if s.months&(1<<uint(t.Month())) == 0 || !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hours&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
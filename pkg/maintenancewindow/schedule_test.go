@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSchedule(t *testing.T) {
+	grid := []struct {
+		spec  string
+		error string
+	}{
+		{spec: "0 22 * * 1-5"},
+		{spec: "*/15 0-6 1,15 * 7"},
+		{spec: "30 2 * 1-12/3 0"},
+		{spec: "0 22 * *", error: `schedule "0 22 * *" must have 5 fields (minute, hour, day of month, month, day of week), found 4`},
+		{spec: "60 22 * * *", error: `schedule "60 22 * * *": value 60 in minute field is not between 0 and 59`},
+		{spec: "0 22 0 * *", error: `schedule "0 22 0 * *": value 0 in day of month field is not between 1 and 31`},
+		{spec: "0 5-1 * * *", error: `schedule "0 5-1 * * *": invalid range "5-1" in hour field`},
+		{spec: "*/0 * * * *", error: `schedule "*/0 * * * *": invalid step "0" in minute field`},
+		{spec: "0 22 * * mon", error: `schedule "0 22 * * mon": invalid value "mon" in day of week field`},
+	}
+	for _, g := range grid {
+		t.Run(g.spec, func(t *testing.T) {
+			_, err := ParseSchedule(g.spec)
+			if g.error == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != g.error {
+				t.Errorf("expected error %q, got %v", g.error, err)
+			}
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	// 2026-03-04 is a Wednesday
+	from := time.Date(2026, 3, 4, 10, 17, 42, 0, time.UTC)
+	grid := []struct {
+		spec     string
+		expected time.Time
+	}{
+		{spec: "* * * * *", expected: time.Date(2026, 3, 4, 10, 18, 0, 0, time.UTC)},
+		{spec: "0 22 * * 1-5", expected: time.Date(2026, 3, 4, 22, 0, 0, 0, time.UTC)},
+		{spec: "0 2 * * 6", expected: time.Date(2026, 3, 7, 2, 0, 0, 0, time.UTC)},
+		{spec: "0 2 * * 7", expected: time.Date(2026, 3, 8, 2, 0, 0, 0, time.UTC)},
+		{spec: "*/20 10 * * *", expected: time.Date(2026, 3, 4, 10, 20, 0, 0, time.UTC)},
+		{spec: "0 0 1 * *", expected: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		// Either day field matches when both are restricted
+		{spec: "0 0 1 * 5", expected: time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 30 2 *", expected: time.Time{}},
+	}
+	for _, g := range grid {
+		t.Run(g.spec, func(t *testing.T) {
+			s, err := ParseSchedule(g.spec)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual := s.Next(from); !actual.Equal(g.expected) {
+				t.Errorf("expected %v, got %v", g.expected, actual)
+			}
+		})
+	}
+}
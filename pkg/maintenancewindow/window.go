@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+// Window is a recurring period during which instance groups may be disrupted.
+type Window struct {
+	// Name identifies the window.
+	Name string
+	// Schedule is when the window opens.
+	Schedule *Schedule
+	// Duration is how long the window stays open.
+	Duration time.Duration
+	// Location is the time zone of the schedule.
+	Location *time.Location
+	// InstanceGroups are the names of the instance groups the window applies to; empty means all instance groups.
+	InstanceGroups []string
+}
+
+// NewWindow parses a maintenance window of the cluster spec.
+func NewWindow(spec *kops.MaintenanceWindowSpec) (*Window, error) {
+	schedule, err := ParseSchedule(spec.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Duration == nil || spec.Duration.Duration <= 0 {
+		return nil, fmt.Errorf("duration must be positive")
+	}
+	location := time.UTC
+	if spec.TimeZone != "" {
+		location, err = time.LoadLocation(spec.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", spec.TimeZone, err)
+		}
+	}
+	return &Window{
+		Name:           spec.Name,
+		Schedule:       schedule,
+		Duration:       spec.Duration.Duration,
+		Location:       location,
+		InstanceGroups: spec.InstanceGroups,
+	}, nil
+}
+
+// ForCluster parses the maintenance windows of the cluster.
+func ForCluster(cluster *kops.Cluster) ([]*Window, error) {
+	var windows []*Window
+	for i := range cluster.Spec.MaintenanceWindows {
+		spec := &cluster.Spec.MaintenanceWindows[i]
+		window, err := NewWindow(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance window %q: %w", spec.Name, err)
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+// AppliesTo returns true if the window restricts the instance group with the given name.
+func (w *Window) AppliesTo(instanceGroupName string) bool {
+	return len(w.InstanceGroups) == 0 || slices.Contains(w.InstanceGroups, instanceGroupName)
+}
+
+// IsOpen returns true if the window is open at t, that is if the schedule fired less than Duration before t.
+func (w *Window) IsOpen(t time.Time) bool {
+	t = t.In(w.Location)
+	for start := t.Truncate(time.Minute); t.Sub(start) < w.Duration; start = start.Add(-time.Minute) {
+		if w.Schedule.Matches(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// Check returns whether the instance group with the given name may be disrupted at t:
+// either no window applies to it, or one of the windows that apply to it is open.
+// If it may not, it also returns when the next of those windows opens, or the zero time if none ever does.
+func Check(windows []*Window, instanceGroupName string, t time.Time) (bool, time.Time) {
+	restricted := false
+	var next time.Time
+	for _, w := range windows {
+		if !w.AppliesTo(instanceGroupName) {
+			continue
+		}
+		restricted = true
+		if w.IsOpen(t) {
+			return true, time.Time{}
+		}
+		if n := w.Schedule.Next(t.In(w.Location)); !n.IsZero() && (next.IsZero() || n.Before(next)) {
+			next = n
+		}
+	}
+	return !restricted, next
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func TestCheck(t *testing.T) {
+	cluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			MaintenanceWindows: []kops.MaintenanceWindowSpec{
+				{
+					Name:           "weeknights",
+					Schedule:       "0 22 * * 1-5",
+					Duration:       &metav1.Duration{Duration: 4 * time.Hour},
+					TimeZone:       "Europe/Berlin",
+					InstanceGroups: []string{"nodes"},
+				},
+				{
+					Name:           "saturday",
+					Schedule:       "0 6 * * 6",
+					Duration:       &metav1.Duration{Duration: 2 * time.Hour},
+					InstanceGroups: []string{"nodes", "control-plane"},
+				},
+			},
+		},
+	}
+	windows, err := ForCluster(cluster)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	grid := []struct {
+		name          string
+		instanceGroup string
+		now           time.Time
+		open          bool
+		next          time.Time
+	}{
+		{
+			name:          "unrestricted instance group",
+			instanceGroup: "bastions",
+			now:           time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC),
+			open:          true,
+		},
+		{
+			name:          "weekday afternoon",
+			instanceGroup: "nodes",
+			now:           time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC),
+			next:          time.Date(2026, 3, 4, 21, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "weeknight window in Berlin",
+			instanceGroup: "nodes",
+			now:           time.Date(2026, 3, 4, 23, 30, 0, 0, time.UTC),
+			open:          true,
+		},
+		{
+			name:          "weeknight window closed",
+			instanceGroup: "nodes",
+			now:           time.Date(2026, 3, 5, 1, 0, 0, 0, time.UTC),
+			next:          time.Date(2026, 3, 5, 21, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "weeknight window does not apply",
+			instanceGroup: "control-plane",
+			now:           time.Date(2026, 3, 4, 23, 30, 0, 0, time.UTC),
+			next:          time.Date(2026, 3, 7, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "saturday window",
+			instanceGroup: "control-plane",
+			now:           time.Date(2026, 3, 7, 7, 59, 0, 0, time.UTC),
+			open:          true,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			open, next := Check(windows, g.instanceGroup, g.now)
+			if open != g.open {
+				t.Errorf("expected open=%v, got %v", g.open, open)
+			}
+			if !next.Equal(g.next) {
+				t.Errorf("expected next window at %v, got %v", g.next, next)
+			}
+		})
+	}
+}
@@ -371,6 +371,9 @@ func (b *APILoadBalancerBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 		if lbSpec.SecurityGroupOverride != nil {
 			lbSG.ID = new(*lbSpec.SecurityGroupOverride)
 			lbSG.Shared = new(true)
+			// Because the SecurityGroup is shared, we don't tag it and only remove the extra rules we own
+			lbSG.Tags = nil
+			lbSG.RemoveExtraRules = []string{b.RemoveOwnedRules()}
 		}
 
 		c.AddTask(lbSG)
@@ -386,7 +389,7 @@ func (b *APILoadBalancerBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				Egress:        new(true),
 				SecurityGroup: lbSG,
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 		{
 			t := &awstasks.SecurityGroupRule{
@@ -396,7 +399,7 @@ func (b *APILoadBalancerBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				Egress:        new(true),
 				SecurityGroup: lbSG,
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 	}
 
@@ -413,7 +416,7 @@ func (b *APILoadBalancerBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 					ToPort:        new(int32(443)),
 				}
 				t.SetCidrOrPrefix(cidr)
-				AddDirectionalGroupRule(c, t)
+				b.AddDirectionalGroupRule(c, t)
 			}
 
 			// If we have opened a secondary listener on 8443, allow it also
@@ -426,10 +429,12 @@ func (b *APILoadBalancerBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 					Protocol:      new("tcp"),
 					SecurityGroup: lbSG,
 				}
-				lbSG.RemoveExtraRules = append(lbSG.RemoveExtraRules, "port=8443")
+				if !fi.ValueOf(lbSG.Shared) {
+					lbSG.RemoveExtraRules = append(lbSG.RemoveExtraRules, "port=8443")
+				}
 
 				t.SetCidrOrPrefix(cidr)
-				AddDirectionalGroupRule(c, t)
+				b.AddDirectionalGroupRule(c, t)
 			}
 
 			// Allow ICMP traffic required for PMTU discovery
@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/klog/v2"
@@ -33,6 +35,7 @@ import (
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awstasks"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/architectures"
 )
 
 const (
@@ -107,6 +110,8 @@ func (b *AutoscalingGroupModelBuilder) Build(c *fi.CloudupModelBuilderContext) e
 			}
 			if warmPool.IsEnabled() {
 				warmPoolTask.MinSize = int32(warmPool.MinSize)
+				warmPoolTask.PoolState = autoscalingtypes.WarmPoolState(warmPool.PoolState)
+				warmPoolTask.ReuseOnScaleIn = new(warmPool.ReuseOnScaleIn)
 				if warmPool.MaxSize != nil {
 					warmPoolTask.MaxSize = new(int32(aws.ToInt64(warmPool.MaxSize)))
 				}
@@ -153,12 +158,38 @@ func (b *AutoscalingGroupModelBuilder) Build(c *fi.CloudupModelBuilderContext) e
 
 			c.AddTask(lifecyleTask)
 
+			b.buildGracefulNodeDrainLifecycleHook(c, ig)
+
 		}
 	}
 
 	return nil
 }
 
+// buildGracefulNodeDrainLifecycleHook adds the termination lifecycle hook that holds the instances of nodes
+// until kops-controller has drained them.
+func (b *AutoscalingGroupModelBuilder) buildGracefulNodeDrainLifecycleHook(c *fi.CloudupModelBuilderContext, ig *kops.InstanceGroup) {
+	gracefulNodeDrain := b.Cluster.Spec.CloudProvider.AWS.GracefulNodeDrain
+	hookName := awsup.GracefulNodeDrainLifecycleHookName
+	name := fmt.Sprintf("%s-%s", hookName, ig.GetName())
+	enableHook := gracefulNodeDrain.IsEnabled() && ig.Spec.Role == kops.InstanceGroupRoleNode
+
+	// Give kops-controller some time to notice the instance and complete the hook after draining the node.
+	heartbeatTimeout := gracefulNodeDrain.GetDrainTimeout() + 5*time.Minute
+
+	c.AddTask(&awstasks.AutoscalingLifecycleHook{
+		ID:                  aws.String(name),
+		Name:                aws.String(name),
+		HookName:            aws.String(hookName),
+		AutoscalingGroup:    b.LinkToAutoscalingGroup(ig),
+		Lifecycle:           b.Lifecycle,
+		DefaultResult:       aws.String("CONTINUE"),
+		HeartbeatTimeout:    aws.Int32(int32(heartbeatTimeout.Seconds())),
+		LifecycleTransition: aws.String("autoscaling:EC2_INSTANCE_TERMINATING"),
+		Enabled:             &enableHook,
+	})
+}
+
 // buildLaunchTemplateTask is responsible for creating the template task into the aws model
 func (b *AutoscalingGroupModelBuilder) buildLaunchTemplateTask(c *fi.CloudupModelBuilderContext, name string, ig *kops.InstanceGroup, userData fi.Resource) (*awstasks.LaunchTemplate, error) {
 	// @step: add the iam instance profile
@@ -224,6 +255,9 @@ func (b *AutoscalingGroupModelBuilder) buildLaunchTemplateTask(c *fi.CloudupMode
 		Tags:                    tags,
 		UserData:                userData,
 	}
+	// Instances in a hibernated warm pool must be launched with hibernation enabled.
+	warmPool := b.Cluster.Spec.CloudProvider.AWS.WarmPool.ResolveDefaults(ig)
+	lt.HibernationConfigured = new(warmPool.IsEnabled() && warmPool.PoolState == kops.WarmPoolStateHibernated)
 	if ig.Spec.InstanceInterruptionBehavior != nil {
 		lt.InstanceInterruptionBehavior = new(ec2types.InstanceInterruptionBehavior(fi.ValueOf(ig.Spec.InstanceInterruptionBehavior)))
 	}
@@ -401,6 +435,11 @@ func (b *AutoscalingGroupModelBuilder) buildSecurityGroups(c *fi.CloudupModelBui
 		}
 	}
 
+	// @step: add the security group holding the firewall rules of the instancegroup
+	if len(ig.Spec.FirewallRules) != 0 {
+		securityGroups = append(securityGroups, b.LinkToInstanceGroupSecurityGroup(ig))
+	}
+
 	// @step: add any additional security groups to the instancegroup
 	for _, id := range ig.Spec.AdditionalSecurityGroups {
 		sgTask := &awstasks.SecurityGroup{
@@ -576,6 +615,10 @@ func (b *AutoscalingGroupModelBuilder) buildAutoScalingGroupTask(c *fi.CloudupMo
 			if len(spec.InstanceRequirements.ExcludedInstanceTypes) > 0 {
 				ir.ExcludedInstanceTypes = spec.InstanceRequirements.ExcludedInstanceTypes
 			}
+			for _, family := range spec.InstanceRequirements.ExcludedInstanceFamilies {
+				ir.ExcludedInstanceTypes = append(ir.ExcludedInstanceTypes, family+".*")
+			}
+			ir.CPUManufacturers = cpuManufacturersForArchitectures(spec.InstanceRequirements.Architectures)
 			t.InstanceRequirements = ir
 		}
 
@@ -607,3 +650,18 @@ func (b *AutoscalingGroupModelBuilder) buildAutoScalingGroupTask(c *fi.CloudupMo
 	}
 	return t, nil
 }
+
+// cpuManufacturersForArchitectures maps CPU architectures to the EC2 CPU manufacturers that build them,
+// as ASG instance requirements cannot select instance types by architecture directly.
+func cpuManufacturersForArchitectures(archs []string) []string {
+	var manufacturers []string
+	for _, arch := range archs {
+		switch architectures.Architecture(arch) {
+		case architectures.ArchitectureAmd64:
+			manufacturers = append(manufacturers, string(autoscalingtypes.CpuManufacturerIntel), string(autoscalingtypes.CpuManufacturerAmd))
+		case architectures.ArchitectureArm64:
+			manufacturers = append(manufacturers, string(autoscalingtypes.CpuManufacturerAmazonWebServices))
+		}
+	}
+	return manufacturers
+}
@@ -18,6 +18,7 @@ package awsmodel
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -288,3 +289,21 @@ func TestAPIServerAdditionalSecurityGroupsWithNLB(t *testing.T) {
 		})
 	}
 }
+
+func TestCPUManufacturersForArchitectures(t *testing.T) {
+	tests := []struct {
+		archs    []string
+		expected []string
+	}{
+		{nil, nil},
+		{[]string{"amd64"}, []string{"intel", "amd"}},
+		{[]string{"arm64"}, []string{"amazon-web-services"}},
+		{[]string{"amd64", "arm64"}, []string{"intel", "amd", "amazon-web-services"}},
+	}
+	for _, test := range tests {
+		actual := cpuManufacturersForArchitectures(test.archs)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("architectures %v: expected %v, got %v", test.archs, test.expected, actual)
+		}
+	}
+}
@@ -84,7 +84,7 @@ func (b *BastionModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				Egress:        new(true),
 				CIDR:          new("0.0.0.0/0"),
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 		{
 			t := &awstasks.SecurityGroupRule{
@@ -94,7 +94,7 @@ func (b *BastionModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				Egress:        new(true),
 				IPv6CIDR:      new("::/0"),
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 	}
 
@@ -134,7 +134,7 @@ func (b *BastionModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				FromPort:      new(int32(22)),
 				ToPort:        new(int32(22)),
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 	}
 
@@ -150,7 +150,7 @@ func (b *BastionModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				FromPort:      new(int32(22)),
 				ToPort:        new(int32(22)),
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 	}
 
@@ -178,7 +178,7 @@ func (b *BastionModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				Egress:        new(true),
 				SecurityGroup: lbSG,
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 		{
 			t := &awstasks.SecurityGroupRule{
@@ -188,7 +188,7 @@ func (b *BastionModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				Egress:        new(true),
 				SecurityGroup: lbSG,
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 	}
 
@@ -241,7 +241,7 @@ func (b *BastionModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				ToPort:        new(int32(22)),
 			}
 			t.SetCidrOrPrefix(cidr)
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 
 		// Allow ICMP traffic required for PMTU discovery
@@ -288,7 +288,7 @@ func (b *BastionModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				FromPort:      new(int32(22)),
 				ToPort:        new(int32(22)),
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 		{
 			suffix := bastionGroup.Suffix
@@ -301,7 +301,7 @@ func (b *BastionModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				FromPort:      new(int32(3)),
 				ToPort:        new(int32(4)),
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 		{
 			suffix := bastionGroup.Suffix
@@ -314,7 +314,7 @@ func (b *BastionModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				FromPort:      new(int32(3)),
 				ToPort:        new(int32(4)),
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 	}
 
@@ -71,7 +71,7 @@ func (b *ExternalAccessModelBuilder) Build(c *fi.CloudupModelBuilderContext) err
 					ToPort:        new(int32(22)),
 				}
 				t.SetCidrOrPrefix(sshAccess)
-				AddDirectionalGroupRule(c, t)
+				b.AddDirectionalGroupRule(c, t)
 			}
 
 			for _, nodeGroup := range nodeGroups {
@@ -85,7 +85,7 @@ func (b *ExternalAccessModelBuilder) Build(c *fi.CloudupModelBuilderContext) err
 					ToPort:        new(int32(22)),
 				}
 				t.SetCidrOrPrefix(sshAccess)
-				AddDirectionalGroupRule(c, t)
+				b.AddDirectionalGroupRule(c, t)
 			}
 		}
 	}
@@ -143,7 +143,7 @@ func (b *ExternalAccessModelBuilder) Build(c *fi.CloudupModelBuilderContext) err
 					ToPort:        new(int32(443)),
 				}
 				t.SetCidrOrPrefix(apiAccess)
-				AddDirectionalGroupRule(c, t)
+				b.AddDirectionalGroupRule(c, t)
 			}
 		}
 	}
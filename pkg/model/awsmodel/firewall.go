@@ -23,6 +23,7 @@ import (
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awstasks"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
 
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/wellknownports"
@@ -60,9 +61,48 @@ func (b *FirewallModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 	// b.applyNodeToMasterAllowSpecificPorts(c)
 	b.applyNodeToMasterBlockSpecificPorts(c, nodeGroups, masterGroups)
 
+	b.buildInstanceGroupRules(c)
+
 	return nil
 }
 
+// buildInstanceGroupRules creates a dedicated security group for each instance group declaring firewall rules
+func (b *FirewallModelBuilder) buildInstanceGroupRules(c *fi.CloudupModelBuilderContext) {
+	for _, ig := range b.InstanceGroups {
+		if len(ig.Spec.FirewallRules) == 0 {
+			continue
+		}
+
+		name := b.InstanceGroupSecurityGroupName(ig)
+		group := &awstasks.SecurityGroup{
+			Name:        new(name),
+			Lifecycle:   b.Lifecycle,
+			VPC:         b.LinkToVPC(),
+			Description: new("Security group for instance group " + ig.ObjectMeta.Name),
+		}
+		group.Tags = b.CloudTags(name, false)
+		c.AddTask(group)
+
+		for _, rule := range ig.Spec.FirewallRules {
+			fromPort, toPort := rule.PortRange()
+			for _, cidr := range rule.CIDRs {
+				t := &awstasks.SecurityGroupRule{
+					Lifecycle:     b.Lifecycle,
+					SecurityGroup: group,
+					Protocol:      new(rule.Protocol),
+					FromPort:      new(fromPort),
+					ToPort:        new(toPort),
+				}
+				if rule.IsEgress() {
+					t.Egress = new(true)
+				}
+				t.SetCidrOrPrefix(cidr)
+				b.AddDirectionalGroupRule(c, t)
+			}
+		}
+	}
+}
+
 func (b *FirewallModelBuilder) buildNodeRules(c *fi.CloudupModelBuilderContext) ([]SecurityGroupInfo, error) {
 	nodeGroups, err := b.GetSecurityGroups(kops.InstanceGroupRoleNode)
 	if err != nil {
@@ -84,7 +124,7 @@ func (b *FirewallModelBuilder) buildNodeRules(c *fi.CloudupModelBuilderContext)
 				Egress:        new(true),
 				CIDR:          new("0.0.0.0/0"),
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 		{
 			t := &awstasks.SecurityGroupRule{
@@ -94,7 +134,7 @@ func (b *FirewallModelBuilder) buildNodeRules(c *fi.CloudupModelBuilderContext)
 				Egress:        new(true),
 				IPv6CIDR:      new("::/0"),
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 
 		// Nodes can talk to nodes
@@ -107,7 +147,7 @@ func (b *FirewallModelBuilder) buildNodeRules(c *fi.CloudupModelBuilderContext)
 				SecurityGroup: dest.Task,
 				SourceGroup:   src.Task,
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 
 	}
@@ -185,7 +225,7 @@ func (b *FirewallModelBuilder) applyNodeToMasterBlockSpecificPorts(c *fi.Cloudup
 					ToPort:        new(int32(r.To)),
 					Protocol:      new("udp"),
 				}
-				AddDirectionalGroupRule(c, t)
+				b.AddDirectionalGroupRule(c, t)
 			}
 			for _, r := range tcpRanges {
 				t := &awstasks.SecurityGroupRule{
@@ -197,7 +237,7 @@ func (b *FirewallModelBuilder) applyNodeToMasterBlockSpecificPorts(c *fi.Cloudup
 					ToPort:        new(int32(r.To)),
 					Protocol:      new("tcp"),
 				}
-				AddDirectionalGroupRule(c, t)
+				b.AddDirectionalGroupRule(c, t)
 			}
 			for _, protocol := range protocols {
 				awsName := strconv.Itoa(int(protocol))
@@ -216,7 +256,7 @@ func (b *FirewallModelBuilder) applyNodeToMasterBlockSpecificPorts(c *fi.Cloudup
 					SourceGroup:   nodeGroup.Task,
 					Protocol:      new(awsName),
 				}
-				AddDirectionalGroupRule(c, t)
+				b.AddDirectionalGroupRule(c, t)
 			}
 		}
 	}
@@ -234,7 +274,7 @@ func (b *FirewallModelBuilder) applyNodeToMasterBlockSpecificPorts(c *fi.Cloudup
 					SecurityGroup: dest.Task,
 					SourceGroup:   src.Task,
 				}
-				AddDirectionalGroupRule(c, t)
+				b.AddDirectionalGroupRule(c, t)
 			}
 		}
 	}
@@ -261,7 +301,7 @@ func (b *FirewallModelBuilder) buildMasterRules(c *fi.CloudupModelBuilderContext
 				Egress:        new(true),
 				CIDR:          new("0.0.0.0/0"),
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 		{
 			t := &awstasks.SecurityGroupRule{
@@ -271,7 +311,7 @@ func (b *FirewallModelBuilder) buildMasterRules(c *fi.CloudupModelBuilderContext
 				Egress:        new(true),
 				IPv6CIDR:      new("::/0"),
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 
 		// Masters can talk to masters
@@ -284,7 +324,7 @@ func (b *FirewallModelBuilder) buildMasterRules(c *fi.CloudupModelBuilderContext
 				SecurityGroup: dest.Task,
 				SourceGroup:   src.Task,
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 
 		// Masters can talk to nodes
@@ -297,7 +337,7 @@ func (b *FirewallModelBuilder) buildMasterRules(c *fi.CloudupModelBuilderContext
 				SecurityGroup: dest.Task,
 				SourceGroup:   src.Task,
 			}
-			AddDirectionalGroupRule(c, t)
+			b.AddDirectionalGroupRule(c, t)
 		}
 	}
 
@@ -406,9 +446,9 @@ func (b *AWSModelContext) GetSecurityGroups(role kops.InstanceGroupRole) ([]Secu
 			VPC:         b.LinkToVPC(),
 			Shared:      new(true),
 			Description: baseGroup.Description,
+			// Because the SecurityGroup is shared, we only remove the extra rules we own
+			RemoveExtraRules: []string{b.RemoveOwnedRules()},
 		}
-		// Because the SecurityGroup is shared, we don't set RemoveExtraRules
-		// This does mean we don't check them.  We might want to revisit this in future.
 
 		suffix := "-" + name
 
@@ -430,6 +470,11 @@ func (b *AWSModelContext) GetSecurityGroups(role kops.InstanceGroupRole) ([]Secu
 	return groups, nil
 }
 
+// RemoveOwnedRules returns the removal rule matching the security group rules owned by the cluster
+func (b *AWSModelContext) RemoveOwnedRules() string {
+	return "tag=" + awsup.TagNameClusterOwnershipPrefix + b.ClusterName()
+}
+
 // JoinSuffixes constructs a suffix for traffic from the src to the dest group
 // We have to avoid ambiguity in the case where one has a suffix and the other does not,
 // where normally l.Suffix + r.Suffix would equal r.Suffix + l.Suffix
@@ -451,12 +496,19 @@ func JoinSuffixes(src SecurityGroupInfo, dest SecurityGroupInfo) string {
 	return s + d
 }
 
-func AddDirectionalGroupRule(c *fi.CloudupModelBuilderContext, t *awstasks.SecurityGroupRule) {
+// AddDirectionalGroupRule names and tags the rule, then adds it to the model.
+// Rules added to shared security groups are tagged as owned by the cluster,
+// so they can be told apart from the rules managed outside of kOps.
+func (b *AWSModelContext) AddDirectionalGroupRule(c *fi.CloudupModelBuilderContext, t *awstasks.SecurityGroupRule) {
 	name := generateName(t)
 	t.Name = new(name)
 	tags := make(map[string]string)
-	for key, value := range t.SecurityGroup.Tags {
-		tags[key] = value
+	if fi.ValueOf(t.SecurityGroup.Shared) {
+		tags = b.CloudTags(name, false)
+	} else {
+		for key, value := range t.SecurityGroup.Tags {
+			tags[key] = value
+		}
 	}
 	tags["Name"] = *t.Name
 	t.Tags = tags
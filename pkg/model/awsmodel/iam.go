@@ -147,7 +147,7 @@ func (b *IAMModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				},
 				Policy: p,
 			}
-			iamRole, err := b.BuildServiceAccountRoleTasks(serviceAccount, c)
+			iamRole, err := b.buildServiceAccountExternalPermissionsTasks(serviceAccount, c)
 			if err != nil {
 				return fmt.Errorf("error building service account role tasks: %w", err)
 			}
@@ -168,6 +168,40 @@ func (b *IAMModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 	return nil
 }
 
+// buildServiceAccountExternalPermissionsTasks builds the role of a user-managed ServiceAccount.
+// If the ServiceAccount is used by a managed addon, the addon role has already been built with its minimal policy,
+// so the inline policy is added to that role instead, e.g. to let external-dns manage additional hosted zones.
+func (b *IAMModelBuilder) buildServiceAccountExternalPermissionsTasks(serviceAccount *iam.GenericServiceAccount, c *fi.CloudupModelBuilderContext) (*awstasks.IAMRole, error) {
+	iamName, err := b.IAMNameForServiceAccountRole(serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, found := c.Tasks["IAMRole/"+iamName]
+	if !found {
+		return b.BuildServiceAccountRoleTasks(serviceAccount, c)
+	}
+	iamRole, ok := existing.(*awstasks.IAMRole)
+	if !ok {
+		return nil, fmt.Errorf("unexpected task type %T for IAM role %q", existing, iamName)
+	}
+
+	if serviceAccount.Policy != nil {
+		policy, err := serviceAccount.Policy.AsJSON()
+		if err != nil {
+			return nil, fmt.Errorf("error building inline policy for %q: %w", iamName, err)
+		}
+		c.AddTask(&awstasks.IAMRolePolicy{
+			Name:           new("additional-" + iamName),
+			Lifecycle:      b.Lifecycle,
+			Role:           iamRole,
+			PolicyDocument: fi.NewStringResource(policy),
+		})
+	}
+
+	return iamRole, nil
+}
+
 // BuildServiceAccountRoleTasks build tasks specifically for the ServiceAccount role.
 func (b *IAMModelBuilder) BuildServiceAccountRoleTasks(role iam.Subject, c *fi.CloudupModelBuilderContext) (*awstasks.IAMRole, error) {
 	iamName, err := b.IAMNameForServiceAccountRole(role)
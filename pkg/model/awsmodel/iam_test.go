@@ -20,8 +20,12 @@ import (
 	"reflect"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kops/pkg/model"
 	"k8s.io/kops/pkg/model/iam"
 	"k8s.io/kops/pkg/util/stringorset"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awstasks"
 )
 
 func Test_formatAWSIAMStatement(t *testing.T) {
@@ -109,3 +113,63 @@ func Test_formatAWSIAMStatement(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceAccountExternalPermissionsExtendManagedRole(t *testing.T) {
+	cluster := buildMinimalCluster()
+	b := &IAMModelBuilder{
+		AWSModelContext: &AWSModelContext{
+			KopsModelContext: &model.KopsModelContext{
+				IAMModelContext: iam.IAMModelContext{Cluster: cluster},
+			},
+		},
+		Cluster: cluster,
+	}
+
+	// The role of the external-dns addon, as built with the bootstrap channel
+	managedRole := &awstasks.IAMRole{
+		Name: new("external-dns.kube-system.sa.testcluster.test.com"),
+	}
+	c := &fi.CloudupModelBuilderContext{
+		Tasks: map[string]fi.CloudupTask{
+			"IAMRole/" + *managedRole.Name: managedRole,
+		},
+	}
+
+	policy, err := b.buildPolicy(`[{"Effect": "Allow", "Action": "route53:ChangeResourceRecordSets", "Resource": "arn:aws:route53:::hostedzone/Z123"}]`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	serviceAccount := &iam.GenericServiceAccount{
+		NamespacedName: types.NamespacedName{Namespace: "kube-system", Name: "external-dns"},
+		Policy:         policy,
+	}
+
+	iamRole, err := b.buildServiceAccountExternalPermissionsTasks(serviceAccount, c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if iamRole != managedRole {
+		t.Errorf("expected the managed role to be reused, got %v", iamRole)
+	}
+	if len(c.Tasks) != 2 {
+		t.Fatalf("expected 2 tasks, got %d: %v", len(c.Tasks), c.Tasks)
+	}
+	rolePolicy, ok := c.Tasks["IAMRolePolicy/additional-external-dns.kube-system.sa.testcluster.test.com"].(*awstasks.IAMRolePolicy)
+	if !ok {
+		t.Fatalf("expected additional role policy, got %v", c.Tasks)
+	}
+	if rolePolicy.Role != managedRole {
+		t.Errorf("expected additional role policy to be attached to the managed role, got %v", rolePolicy.Role)
+	}
+	document, err := fi.ResourceAsString(rolePolicy.PolicyDocument)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected, err := policy.AsJSON()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if document != expected {
+		t.Errorf("expected policy document %s, got %s", expected, document)
+	}
+}
@@ -316,6 +316,10 @@ func (b *NetworkModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 				subnet.AmazonIPv6CIDR = b.LinkToAmazonVPCIPv6CIDR()
 			}
 			subnet.IPv6CIDR = new(subnetSpec.IPv6CIDR)
+			if subnetSpec.CIDR == "" && !sharedSubnet {
+				// IPv6-only instances reach IPv4-only destinations through NAT64
+				subnet.EnableDNS64 = new(true)
+			}
 		}
 		if subnetSpec.ID != "" {
 			subnet.ID = new(subnetSpec.ID)
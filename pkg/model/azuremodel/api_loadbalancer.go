@@ -25,6 +25,7 @@ import (
 	"k8s.io/kops/pkg/wellknownports"
 	"k8s.io/kops/pkg/wellknownservices"
 	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
 	"k8s.io/kops/upup/pkg/fi/cloudup/azuretasks"
 )
 
@@ -85,6 +86,7 @@ func (b *APILoadBalancerModelBuilder) Build(c *fi.CloudupModelBuilderContext) er
 			return err
 		}
 		lb.Subnet = b.LinkToAzureSubnet(subnet)
+		lb.Zones = b.ZonesForRegionalResources()
 	case kops.LoadBalancerTypePublic:
 		lb.External = to.Ptr(true)
 
@@ -97,6 +99,7 @@ func (b *APILoadBalancerModelBuilder) Build(c *fi.CloudupModelBuilderContext) er
 			AllocationMethod: network.IPAllocationMethodStatic,
 			SKU:              network.PublicIPAddressSKUNameStandard,
 			Tags:             map[string]*string{},
+			Zones:            b.ZonesForRegionalResources(),
 		}
 		c.AddTask(p)
 		lb.PublicIPAddress = p
@@ -128,6 +131,67 @@ func (b *APILoadBalancerModelBuilder) Build(c *fi.CloudupModelBuilderContext) er
 
 	c.AddTask(lb)
 
+	if privateAPI := b.PrivateAPI(); privateAPI != nil {
+		if err := b.buildPrivateAPI(c, lb, privateAPI); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildPrivateAPI builds the tasks that expose the internal API load balancer through
+// a private DNS zone and a Private Link service.
+func (b *APILoadBalancerModelBuilder) buildPrivateAPI(c *fi.CloudupModelBuilderContext, lb *azuretasks.LoadBalancer, privateAPI *kops.AzurePrivateAPISpec) error {
+	if zoneID := privateAPI.PrivateDNSZoneID; zoneID != "" {
+		recordName, err := azure.PrivateAPIRecordName(b.Cluster, zoneID)
+		if err != nil {
+			return err
+		}
+		c.AddTask(&azuretasks.PrivateDNSRecord{
+			Name:         new(recordName),
+			Lifecycle:    b.Lifecycle,
+			ZoneID:       new(zoneID),
+			TTL:          new(int64(60)),
+			LoadBalancer: lb,
+			Tags:         map[string]*string{},
+		})
+
+		networkID := azure.VirtualNetworkID(b.Cluster.Spec.CloudProvider.Azure.SubscriptionID, b.NameForResourceGroup(), b.NameForVirtualNetwork())
+		c.AddTask(&azuretasks.PrivateDNSZoneLink{
+			Name:             new(azure.PrivateDNSZoneLinkName(b.ClusterName(), networkID)),
+			Lifecycle:        b.Lifecycle,
+			ZoneID:           new(zoneID),
+			VirtualNetworkID: new(networkID),
+			VirtualNetwork:   b.LinkToVirtualNetwork(),
+			Tags:             map[string]*string{},
+		})
+		for _, networkID := range privateAPI.LinkedVirtualNetworkIDs {
+			c.AddTask(&azuretasks.PrivateDNSZoneLink{
+				Name:             new(azure.PrivateDNSZoneLinkName(b.ClusterName(), networkID)),
+				Lifecycle:        b.Lifecycle,
+				ZoneID:           new(zoneID),
+				VirtualNetworkID: new(networkID),
+				Tags:             map[string]*string{},
+			})
+		}
+	}
+
+	if privateAPI.PrivateLinkService != nil {
+		pls := &azuretasks.PrivateLinkService{
+			Name:          new(b.NameForLoadBalancer()),
+			Lifecycle:     b.Lifecycle,
+			ResourceGroup: b.LinkToResourceGroup(),
+			LoadBalancer:  lb,
+			Subnet:        lb.Subnet,
+			Tags:          map[string]*string{},
+		}
+		for _, subscriptionID := range privateAPI.PrivateLinkService.AllowedSubscriptionIDs {
+			pls.AllowedSubscriptionIDs = append(pls.AllowedSubscriptionIDs, new(subscriptionID))
+		}
+		c.AddTask(pls)
+	}
+
 	return nil
 }
 
@@ -22,6 +22,7 @@ import (
 
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azuretasks"
 )
 
 func TestAPILoadBalancerModelBuilder_Build(t *testing.T) {
@@ -73,3 +74,74 @@ func TestSubnetForLoadbalancer(t *testing.T) {
 		t.Errorf("expected subnet %+v, but got %+v", expected, actual)
 	}
 }
+
+func TestAPILoadBalancerModelBuilder_PrivateAPI(t *testing.T) {
+	const zoneID = "/subscriptions/sub/resourceGroups/dns/providers/Microsoft.Network/privateDnsZones/test.com"
+	const jumpNetworkID = "/subscriptions/sub/resourceGroups/hub/providers/Microsoft.Network/virtualNetworks/jump"
+
+	b := APILoadBalancerModelBuilder{
+		AzureModelContext: newTestAzureModelContext(),
+	}
+	b.InstanceGroups[0].Spec.Role = kops.InstanceGroupRoleControlPlane
+	b.Cluster.Spec.CloudProvider.Azure.SubscriptionID = "sub"
+	b.Cluster.Spec.CloudProvider.Azure.PrivateAPI = &kops.AzurePrivateAPISpec{
+		PrivateDNSZoneID:        zoneID,
+		LinkedVirtualNetworkIDs: []string{jumpNetworkID},
+		PrivateLinkService: &kops.AzurePrivateLinkServiceSpec{
+			AllowedSubscriptionIDs: []string{"consumer"},
+		},
+	}
+	c := &fi.CloudupModelBuilderContext{
+		Tasks: make(map[string]fi.CloudupTask),
+	}
+	if err := b.Build(c); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	record, ok := c.Tasks["PrivateDNSRecord/api.testcluster"].(*azuretasks.PrivateDNSRecord)
+	if !ok {
+		t.Fatalf("expected private DNS record task, got tasks %v", c.Tasks)
+	}
+	if fi.ValueOf(record.ZoneID) != zoneID || fi.ValueOf(record.LoadBalancer.Name) != b.NameForLoadBalancer() {
+		t.Errorf("unexpected private DNS record %v", record)
+	}
+
+	clusterLink, ok := c.Tasks["PrivateDNSZoneLink/testcluster.test.com-test-virtual-network"].(*azuretasks.PrivateDNSZoneLink)
+	if !ok {
+		t.Fatalf("expected private DNS zone link to the cluster network, got tasks %v", c.Tasks)
+	}
+	if expected := "/subscriptions/sub/resourceGroups/test-resource-group/providers/Microsoft.Network/virtualNetworks/test-virtual-network"; fi.ValueOf(clusterLink.VirtualNetworkID) != expected {
+		t.Errorf("expected cluster network link to %q, got %q", expected, fi.ValueOf(clusterLink.VirtualNetworkID))
+	}
+	jumpLink, ok := c.Tasks["PrivateDNSZoneLink/testcluster.test.com-jump"].(*azuretasks.PrivateDNSZoneLink)
+	if !ok {
+		t.Fatalf("expected private DNS zone link to the jump network, got tasks %v", c.Tasks)
+	}
+	if fi.ValueOf(jumpLink.VirtualNetworkID) != jumpNetworkID || jumpLink.VirtualNetwork != nil {
+		t.Errorf("unexpected jump network link %v", jumpLink)
+	}
+
+	pls, ok := c.Tasks["PrivateLinkService/"+b.NameForLoadBalancer()].(*azuretasks.PrivateLinkService)
+	if !ok {
+		t.Fatalf("expected private link service task, got tasks %v", c.Tasks)
+	}
+	if fi.ValueOf(pls.Subnet.Name) != "test-subnet" || len(pls.AllowedSubscriptionIDs) != 1 || *pls.AllowedSubscriptionIDs[0] != "consumer" {
+		t.Errorf("unexpected private link service %v", pls)
+	}
+}
+
+func TestAPILoadBalancerModelBuilder_PrivateAPIOutsideZone(t *testing.T) {
+	b := APILoadBalancerModelBuilder{
+		AzureModelContext: newTestAzureModelContext(),
+	}
+	b.InstanceGroups[0].Spec.Role = kops.InstanceGroupRoleControlPlane
+	b.Cluster.Spec.CloudProvider.Azure.PrivateAPI = &kops.AzurePrivateAPISpec{
+		PrivateDNSZoneID: "/subscriptions/sub/resourceGroups/dns/providers/Microsoft.Network/privateDnsZones/example.org",
+	}
+	c := &fi.CloudupModelBuilderContext{
+		Tasks: make(map[string]fi.CloudupTask),
+	}
+	if err := b.Build(c); err == nil {
+		t.Errorf("expected error for an API name outside of the private DNS zone")
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/model"
 	nodeidentityazure "k8s.io/kops/pkg/nodeidentity/azure"
@@ -76,6 +77,41 @@ func (c *AzureModelContext) NameForLoadBalancer() string {
 	return "api-" + c.ClusterName()
 }
 
+// PrivateAPI returns the configuration for private access to an internal API load balancer, or nil.
+func (c *AzureModelContext) PrivateAPI() *kops.AzurePrivateAPISpec {
+	lbSpec := c.Cluster.Spec.API.LoadBalancer
+	if lbSpec == nil || lbSpec.Type != kops.LoadBalancerTypeInternal {
+		return nil
+	}
+	return c.Cluster.Spec.CloudProvider.Azure.PrivateAPI
+}
+
+// ZonesForRegionalResources returns the availability zones of the public IP addresses and load balancer frontends
+// shared by all instance groups. They are zone-redundant when the instance groups span more than one zone,
+// so that the cluster stays reachable when a zone fails, and have no zone otherwise.
+func (c *AzureModelContext) ZonesForRegionalResources() []*string {
+	zones := sets.New[string]()
+	for _, ig := range c.InstanceGroups {
+		for _, zone := range ig.Spec.Zones {
+			az, err := azure.ZoneToAvailabilityZoneNumber(zone)
+			if err != nil {
+				continue
+			}
+			zones.Insert(az)
+		}
+	}
+	if zones.Len() < 2 {
+		return nil
+	}
+
+	// Zone-redundant resources are in all the zones of the region, not only those of the instance groups
+	var zoneRedundant []*string
+	for _, az := range azure.AvailabilityZoneNumbers {
+		zoneRedundant = append(zoneRedundant, new(az))
+	}
+	return zoneRedundant
+}
+
 // NameForApplicationSecurityGroupControlPlane returns the name of the Application Security Group object for the ControlPlane role.
 func (c *AzureModelContext) NameForApplicationSecurityGroupControlPlane() string {
 	return kops.InstanceGroupRoleControlPlane.ToLowerString() + "." + c.ClusterName()
@@ -86,6 +122,11 @@ func (c *AzureModelContext) NameForApplicationSecurityGroupNodes() string {
 	return kops.InstanceGroupRoleNode.ToLowerString() + "s." + c.ClusterName()
 }
 
+// LinkToApplicationSecurityGroupInstanceGroup returns the Application Security Group object holding the firewall rules of an instance group.
+func (c *AzureModelContext) LinkToApplicationSecurityGroupInstanceGroup(ig *kops.InstanceGroup) *azuretasks.ApplicationSecurityGroup {
+	return &azuretasks.ApplicationSecurityGroup{Name: new(c.InstanceGroupSecurityGroupName(ig))}
+}
+
 // LinkToApplicationSecurityGroupControlPlane returns the Application Security Group object for the ControlPlane role.
 func (c *AzureModelContext) LinkToApplicationSecurityGroupControlPlane() *azuretasks.ApplicationSecurityGroup {
 	return &azuretasks.ApplicationSecurityGroup{Name: new(c.NameForApplicationSecurityGroupControlPlane())}
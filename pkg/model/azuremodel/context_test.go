@@ -54,3 +54,49 @@ func TestCloudTagsForInstanceGroup(t *testing.T) {
 		t.Errorf("expected tags %+v, but got %+v", expected, actual)
 	}
 }
+
+func TestZonesForRegionalResources(t *testing.T) {
+	grid := []struct {
+		desc     string
+		zones    [][]string
+		expected []string
+	}{
+		{
+			desc: "no zones",
+		},
+		{
+			desc:  "single zone",
+			zones: [][]string{{"eastus-1"}, {"eastus-1"}},
+		},
+		{
+			desc:     "zones of one instance group",
+			zones:    [][]string{{"eastus-1", "eastus-2"}},
+			expected: []string{"1", "2", "3"},
+		},
+		{
+			desc:     "zones of several instance groups",
+			zones:    [][]string{{"eastus-1"}, {"eastus-3"}},
+			expected: []string{"1", "2", "3"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			c := newTestAzureModelContext()
+			c.InstanceGroups = nil
+			for _, zones := range g.zones {
+				ig := newTestInstanceGroup()
+				ig.Spec.Zones = zones
+				c.InstanceGroups = append(c.InstanceGroups, ig)
+			}
+
+			var actual []string
+			for _, zone := range c.ZonesForRegionalResources() {
+				actual = append(actual, *zone)
+			}
+			if !reflect.DeepEqual(actual, g.expected) {
+				t.Errorf("expected zones %v, but got %v", g.expected, actual)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package azuremodel
 
 import (
+	"fmt"
 	"strconv"
 
 	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
@@ -55,6 +56,8 @@ func (b *NetworkModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 		AllocationMethod: network.IPAllocationMethodStatic,
 		SKU:              network.PublicIPAddressSKUNameStandard,
 		Tags:             map[string]*string{},
+		// The NAT gateway has no zone, so its address is zone-redundant to keep egress working when a zone fails
+		Zones: b.ZonesForRegionalResources(),
 	}
 	c.AddTask(ngwPipTask)
 
@@ -285,6 +288,7 @@ func (b *NetworkModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 			DestinationPortRange:                     new(strconv.Itoa(wellknownports.KopsControllerPort)),
 		})
 	}
+	b.addInstanceGroupFirewallRules(nsgTask)
 	nsgTask.SecurityRules = append(nsgTask.SecurityRules, &azuretasks.NetworkSecurityRule{
 		Name:                     new("AllowAzureLoadBalancer"),
 		Priority:                 new(int32(4000)),
@@ -339,6 +343,16 @@ func (b *NetworkModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 	}
 	c.AddTask(rtTask)
 
+	// The NAT addresses of a Private Link service are allocated in the subnet of the API load balancer.
+	var privateLinkSubnetName string
+	if privateAPI := b.PrivateAPI(); privateAPI != nil && privateAPI.PrivateLinkService != nil {
+		subnet, err := b.subnetForLoadBalancer()
+		if err != nil {
+			return err
+		}
+		privateLinkSubnetName = subnet.Name
+	}
+
 	for _, subnetSpec := range b.Cluster.Spec.Networking.Subnets {
 		subnetTask := &azuretasks.Subnet{
 			Name:                 new(subnetSpec.Name),
@@ -351,12 +365,74 @@ func (b *NetworkModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 			CIDR:                 new(subnetSpec.CIDR),
 			Shared:               new(b.Cluster.SharedVPC()),
 		}
+		if subnetSpec.Name == privateLinkSubnetName {
+			subnetTask.DisablePrivateLinkServiceNetworkPolicies = new(true)
+		}
 		c.AddTask(subnetTask)
 	}
 
 	return nil
 }
 
+// addInstanceGroupFirewallRules adds the firewall rules of the instance groups to the network security group,
+// matching the instances through the Application Security Group of their instance group.
+func (b *NetworkModelBuilder) addInstanceGroupFirewallRules(nsgTask *azuretasks.NetworkSecurityGroup) {
+	// The rules must be evaluated before DenyAllToControlPlane and DenyAllToNodes
+	priority := int32(3000)
+	for _, ig := range b.InstanceGroups {
+		if len(ig.Spec.FirewallRules) == 0 {
+			continue
+		}
+
+		asgName := b.InstanceGroupSecurityGroupName(ig)
+		nsgTask.ApplicationSecurityGroups = append(nsgTask.ApplicationSecurityGroups, b.LinkToApplicationSecurityGroupInstanceGroup(ig))
+		for i, rule := range ig.Spec.FirewallRules {
+			fromPort, toPort := rule.PortRange()
+			portRange := strconv.Itoa(int(fromPort))
+			if toPort != fromPort {
+				portRange += "-" + strconv.Itoa(int(toPort))
+			}
+			protocol := network.SecurityRuleProtocolTCP
+			if rule.Protocol == "udp" {
+				protocol = network.SecurityRuleProtocolUDP
+			}
+
+			// A rule can't mix IPv4 and IPv6 prefixes
+			families := []struct {
+				suffix string
+				cidrs  []*string
+			}{
+				{suffix: "", cidrs: ipv4CIDRs(rule.CIDRs)},
+				{suffix: "_v6", cidrs: ipv6CIDRs(rule.CIDRs)},
+			}
+			for _, family := range families {
+				if len(family.cidrs) == 0 {
+					continue
+				}
+				t := &azuretasks.NetworkSecurityRule{
+					Name:                 new(fmt.Sprintf("%s-%d%s", ig.ObjectMeta.Name, i, family.suffix)),
+					Priority:             new(priority),
+					Access:               network.SecurityRuleAccessAllow,
+					Protocol:             protocol,
+					SourcePortRange:      new("*"),
+					DestinationPortRange: new(portRange),
+				}
+				if rule.IsEgress() {
+					t.Direction = network.SecurityRuleDirectionOutbound
+					t.SourceApplicationSecurityGroupNames = []*string{new(asgName)}
+					t.DestinationAddressPrefixes = family.cidrs
+				} else {
+					t.Direction = network.SecurityRuleDirectionInbound
+					t.SourceAddressPrefixes = family.cidrs
+					t.DestinationApplicationSecurityGroupNames = []*string{new(asgName)}
+				}
+				nsgTask.SecurityRules = append(nsgTask.SecurityRules, t)
+				priority++
+			}
+		}
+	}
+}
+
 func ipv4CIDRs(mixedCIDRs []string) []*string {
 	var cidrs []*string
 	for i := range mixedCIDRs {
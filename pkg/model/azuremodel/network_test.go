@@ -83,6 +83,100 @@ func TestNetworkModelBuilder_KindnetSecurityRules(t *testing.T) {
 	}
 }
 
+func TestNetworkModelBuilder_InstanceGroupFirewallRules(t *testing.T) {
+	ctx := newTestAzureModelContext()
+	ig := ctx.InstanceGroups[0]
+	ig.Spec.FirewallRules = []kops.FirewallRuleSpec{
+		{
+			Protocol: "tcp",
+			FromPort: 8080,
+			ToPort:   8090,
+			CIDRs:    []string{"10.0.0.0/8", "2001:db8::/32"},
+		},
+		{
+			Direction: kops.FirewallRuleDirectionEgress,
+			Protocol:  "udp",
+			FromPort:  53,
+			CIDRs:     []string{"192.168.0.0/16"},
+		},
+	}
+	nsg := buildNetworkSecurityGroup(t, ctx)
+	asgName := ctx.InstanceGroupSecurityGroupName(ig)
+
+	grid := []struct {
+		name      string
+		priority  int32
+		direction network.SecurityRuleDirection
+		protocol  network.SecurityRuleProtocol
+		ports     string
+		prefixes  []string
+	}{
+		{
+			name:      "nodes-0",
+			priority:  3000,
+			direction: network.SecurityRuleDirectionInbound,
+			protocol:  network.SecurityRuleProtocolTCP,
+			ports:     "8080-8090",
+			prefixes:  []string{"10.0.0.0/8"},
+		},
+		{
+			name:      "nodes-0_v6",
+			priority:  3001,
+			direction: network.SecurityRuleDirectionInbound,
+			protocol:  network.SecurityRuleProtocolTCP,
+			ports:     "8080-8090",
+			prefixes:  []string{"2001:db8::/32"},
+		},
+		{
+			name:      "nodes-1",
+			priority:  3002,
+			direction: network.SecurityRuleDirectionOutbound,
+			protocol:  network.SecurityRuleProtocolUDP,
+			ports:     "53",
+			prefixes:  []string{"192.168.0.0/16"},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			rule := findSecurityRule(nsg, g.name)
+			if rule == nil {
+				t.Fatalf("expected %s security rule", g.name)
+			}
+			if got := fi.ValueOf(rule.Priority); got != g.priority {
+				t.Errorf("priority: got %d, want %d", got, g.priority)
+			}
+			if rule.Direction != g.direction {
+				t.Errorf("direction: got %q, want %q", rule.Direction, g.direction)
+			}
+			if rule.Protocol != g.protocol {
+				t.Errorf("protocol: got %q, want %q", rule.Protocol, g.protocol)
+			}
+			if got := fi.ValueOf(rule.DestinationPortRange); got != g.ports {
+				t.Errorf("destination port range: got %q, want %q", got, g.ports)
+			}
+
+			prefixes, asgs := rule.SourceAddressPrefixes, rule.DestinationApplicationSecurityGroupNames
+			if g.direction == network.SecurityRuleDirectionOutbound {
+				prefixes, asgs = rule.DestinationAddressPrefixes, rule.SourceApplicationSecurityGroupNames
+			}
+			var gotPrefixes []string
+			for _, prefix := range prefixes {
+				gotPrefixes = append(gotPrefixes, fi.ValueOf(prefix))
+			}
+			if !slices.Equal(gotPrefixes, g.prefixes) {
+				t.Errorf("address prefixes: got %v, want %v", gotPrefixes, g.prefixes)
+			}
+			var gotASGs []string
+			for _, name := range asgs {
+				gotASGs = append(gotASGs, fi.ValueOf(name))
+			}
+			if want := []string{asgName}; !slices.Equal(gotASGs, want) {
+				t.Errorf("application security groups: got %v, want %v", gotASGs, want)
+			}
+		})
+	}
+}
+
 func buildNetworkSecurityGroup(t *testing.T, ctx *AzureModelContext) *azuretasks.NetworkSecurityGroup {
 	t.Helper()
 	b := NetworkModelBuilder{AzureModelContext: ctx}
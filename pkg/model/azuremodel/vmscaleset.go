@@ -18,6 +18,7 @@ package azuremodel
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
@@ -54,6 +55,17 @@ func (b *VMScaleSetModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 		Tags:          map[string]*string{},
 	})
 
+	for _, ig := range b.InstanceGroups {
+		if len(ig.Spec.FirewallRules) != 0 {
+			c.AddTask(&azuretasks.ApplicationSecurityGroup{
+				Name:          new(b.InstanceGroupSecurityGroupName(ig)),
+				Lifecycle:     b.Lifecycle,
+				ResourceGroup: b.LinkToResourceGroup(),
+				Tags:          map[string]*string{},
+			})
+		}
+	}
+
 	for _, ig := range b.InstanceGroups {
 		name := b.AutoscalingGroupName(ig)
 		vmss, err := b.buildVMScaleSetTask(c, name, ig)
@@ -123,6 +135,9 @@ func (b *VMScaleSetModelBuilder) buildVMScaleSetTask(
 	default:
 		return nil, fmt.Errorf("unexpected instance group role for instance group: %q, %q", ig.Name, ig.Spec.Role)
 	}
+	if len(ig.Spec.FirewallRules) != 0 {
+		t.ApplicationSecurityGroups = append(t.ApplicationSecurityGroups, b.LinkToApplicationSecurityGroupInstanceGroup(ig))
+	}
 
 	var err error
 	if t.Capacity, err = getCapacity(&ig.Spec); err != nil {
@@ -176,6 +191,19 @@ func (b *VMScaleSetModelBuilder) buildVMScaleSetTask(
 		}
 	}
 
+	t.OrchestrationMode = to.Ptr(string(compute.OrchestrationModeUniform))
+	if ig.Spec.AzureOrchestrationMode != nil {
+		t.OrchestrationMode = ig.Spec.AzureOrchestrationMode
+	}
+
+	if err := setSpotPolicy(t, &ig.Spec); err != nil {
+		return nil, err
+	}
+
+	for _, identity := range ig.Spec.AzureUserAssignedIdentities {
+		t.UserAssignedIdentities = append(t.UserAssignedIdentities, new(identity))
+	}
+
 	t.Tags = b.CloudTagsForInstanceGroup(ig)
 
 	return t, nil
@@ -201,6 +229,30 @@ func getCapacity(spec *kops.InstanceGroupSpec) (*int64, error) {
 	return new(int64(minSize)), nil
 }
 
+// setSpotPolicy makes the VMs of the VM Scale Set Azure Spot VMs when the instance group has a spot policy.
+func setSpotPolicy(t *azuretasks.VMScaleSet, spec *kops.InstanceGroupSpec) error {
+	t.Priority = to.Ptr(string(compute.VirtualMachinePriorityTypesRegular))
+	if spec.SpotPolicy == nil {
+		return nil
+	}
+
+	t.Priority = to.Ptr(string(compute.VirtualMachinePriorityTypesSpot))
+	t.EvictionPolicy = to.Ptr(string(compute.VirtualMachineEvictionPolicyTypesDelete))
+	if spec.SpotPolicy.EvictionPolicy != nil {
+		t.EvictionPolicy = spec.SpotPolicy.EvictionPolicy
+	}
+	// A max price of -1 means the VMs are not evicted for price reasons, only for capacity
+	t.MaxPrice = to.Ptr(float64(-1))
+	if spec.MaxPrice != nil {
+		maxPrice, err := strconv.ParseFloat(*spec.MaxPrice, 64)
+		if err != nil {
+			return fmt.Errorf("parsing maxPrice %q: %w", *spec.MaxPrice, err)
+		}
+		t.MaxPrice = &maxPrice
+	}
+	return nil
+}
+
 func getStorageProfile(spec *kops.InstanceGroupSpec) (*compute.VirtualMachineScaleSetStorageProfile, error) {
 	var volumeSize int32
 	if spec.RootVolume != nil && spec.RootVolume.Size != nil {
@@ -225,17 +277,28 @@ func getStorageProfile(spec *kops.InstanceGroupSpec) (*compute.VirtualMachineSca
 		return nil, err
 	}
 
+	osDisk := &compute.VirtualMachineScaleSetOSDisk{
+		OSType:       to.Ptr(compute.OperatingSystemTypesLinux),
+		CreateOption: to.Ptr(compute.DiskCreateOptionTypesFromImage),
+		DiskSizeGB:   to.Ptr(volumeSize),
+		ManagedDisk: &compute.VirtualMachineScaleSetManagedDiskParameters{
+			StorageAccountType: &storageAccountType,
+		},
+		Caching: to.Ptr(compute.CachingTypesReadWrite),
+	}
+	if spec.AzureEphemeralOSDiskPlacement != nil {
+		// Ephemeral OS disks are stored on the local storage of the VMs, and Azure requires read-only caching for them
+		osDisk.DiffDiskSettings = &compute.DiffDiskSettings{
+			Option:    to.Ptr(compute.DiffDiskOptionsLocal),
+			Placement: to.Ptr(compute.DiffDiskPlacement(*spec.AzureEphemeralOSDiskPlacement)),
+		}
+		osDisk.Caching = to.Ptr(compute.CachingTypesReadOnly)
+		osDisk.ManagedDisk.StorageAccountType = to.Ptr(compute.StorageAccountTypesStandardLRS)
+	}
+
 	return &compute.VirtualMachineScaleSetStorageProfile{
 		ImageReference: imageReference,
-		OSDisk: &compute.VirtualMachineScaleSetOSDisk{
-			OSType:       to.Ptr(compute.OperatingSystemTypesLinux),
-			CreateOption: to.Ptr(compute.DiskCreateOptionTypesFromImage),
-			DiskSizeGB:   to.Ptr(volumeSize),
-			ManagedDisk: &compute.VirtualMachineScaleSetManagedDiskParameters{
-				StorageAccountType: &storageAccountType,
-			},
-			Caching: to.Ptr(compute.CachingTypesReadWrite),
-		},
+		OSDisk:         osDisk,
 	}, nil
 }
 
@@ -28,6 +28,7 @@ import (
 	"k8s.io/kops/pkg/model/defaults"
 	"k8s.io/kops/pkg/model/iam"
 	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azuretasks"
 	"k8s.io/kops/upup/pkg/fi/fitasks"
 )
 
@@ -75,10 +76,76 @@ func TestVMScaleSetModelBuilder_Build(t *testing.T) {
 		})
 	}
 
+	identity := "/subscriptions/subID/resourceGroups/identities/providers/Microsoft.ManagedIdentity/userAssignedIdentities/workloads"
+	b.InstanceGroups[0].Spec.AzureUserAssignedIdentities = []string{identity}
+	b.InstanceGroups[0].Spec.AzureOrchestrationMode = new("Flexible")
+
 	err := b.Build(c)
 	if err != nil {
 		t.Errorf("unexpected error %s", err)
 	}
+
+	vmss := c.Tasks["VMScaleSet/"+b.AutoscalingGroupName(b.InstanceGroups[0])].(*azuretasks.VMScaleSet)
+	if len(vmss.UserAssignedIdentities) != 1 || *vmss.UserAssignedIdentities[0] != identity {
+		t.Errorf("unexpected user-assigned identities %v", vmss.UserAssignedIdentities)
+	}
+	if a, e := fi.ValueOf(vmss.OrchestrationMode), "Flexible"; a != e {
+		t.Errorf("unexpected orchestration mode: expected %s, but got %s", e, a)
+	}
+}
+
+func TestSetSpotPolicy(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		spec                   kops.InstanceGroupSpec
+		expectedPriority       string
+		expectedEvictionPolicy *string
+		expectedMaxPrice       *float64
+	}{
+		{
+			name:             "regular",
+			spec:             kops.InstanceGroupSpec{},
+			expectedPriority: "Regular",
+		},
+		{
+			name: "spot with defaults",
+			spec: kops.InstanceGroupSpec{
+				SpotPolicy: &kops.SpotPolicySpec{},
+			},
+			expectedPriority:       "Spot",
+			expectedEvictionPolicy: to.Ptr("Delete"),
+			expectedMaxPrice:       to.Ptr(float64(-1)),
+		},
+		{
+			name: "spot with max price",
+			spec: kops.InstanceGroupSpec{
+				MaxPrice: to.Ptr("0.05"),
+				SpotPolicy: &kops.SpotPolicySpec{
+					EvictionPolicy: to.Ptr("Deallocate"),
+				},
+			},
+			expectedPriority:       "Spot",
+			expectedEvictionPolicy: to.Ptr("Deallocate"),
+			expectedMaxPrice:       to.Ptr(0.05),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vmss := &azuretasks.VMScaleSet{}
+			if err := setSpotPolicy(vmss, &tc.spec); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if a, e := fi.ValueOf(vmss.Priority), tc.expectedPriority; a != e {
+				t.Errorf("unexpected priority: expected %s, but got %s", e, a)
+			}
+			if a, e := vmss.EvictionPolicy, tc.expectedEvictionPolicy; !reflect.DeepEqual(a, e) {
+				t.Errorf("unexpected eviction policy: expected %v, but got %v", fi.ValueOf(e), fi.ValueOf(a))
+			}
+			if a, e := vmss.MaxPrice, tc.expectedMaxPrice; !reflect.DeepEqual(a, e) {
+				t.Errorf("unexpected max price: expected %v, but got %v", fi.ValueOf(e), fi.ValueOf(a))
+			}
+		})
+	}
 }
 
 func TestGetCapacity(t *testing.T) {
@@ -194,6 +261,36 @@ func TestGetStorageProfile(t *testing.T) {
 				},
 			},
 		},
+		{
+			spec: kops.InstanceGroupSpec{
+				Image: "Canonical:UbuntuServer:18.04-LTS:latest",
+				RootVolume: &kops.InstanceRootVolumeSpec{
+					Size: new(int32(64)),
+				},
+				AzureEphemeralOSDiskPlacement: new("ResourceDisk"),
+			},
+			profile: &compute.VirtualMachineScaleSetStorageProfile{
+				ImageReference: &compute.ImageReference{
+					Publisher: to.Ptr("Canonical"),
+					Offer:     to.Ptr("UbuntuServer"),
+					SKU:       to.Ptr("18.04-LTS"),
+					Version:   to.Ptr("latest"),
+				},
+				OSDisk: &compute.VirtualMachineScaleSetOSDisk{
+					OSType:       to.Ptr(compute.OperatingSystemTypesLinux),
+					CreateOption: to.Ptr(compute.DiskCreateOptionTypesFromImage),
+					DiskSizeGB:   to.Ptr[int32](64),
+					ManagedDisk: &compute.VirtualMachineScaleSetManagedDiskParameters{
+						StorageAccountType: to.Ptr(compute.StorageAccountTypesStandardLRS),
+					},
+					Caching: to.Ptr(compute.CachingTypesReadOnly),
+					DiffDiskSettings: &compute.DiffDiskSettings{
+						Option:    to.Ptr(compute.DiffDiskOptionsLocal),
+						Placement: to.Ptr(compute.DiffDiskPlacementResourceDisk),
+					},
+				},
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -30,6 +30,7 @@ import (
 	"k8s.io/kops/pkg/nodemodel/wellknownassets"
 	"k8s.io/kops/pkg/wellknownservices"
 	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/hetzner"
 	"k8s.io/kops/upup/pkg/fi/fitasks"
 	"k8s.io/kops/upup/pkg/fi/utils"
 	"k8s.io/kops/util/pkg/architectures"
@@ -173,7 +174,9 @@ func KeypairNamesForInstanceGroup(cluster *kops.Cluster, ig *kops.InstanceGroup)
 				// Likely cilium
 				continue
 			}
-			keypairs = append(keypairs, "etcd-manager-ca-"+k, "etcd-peers-ca-"+k)
+			if !etcdCluster.IsExternal() {
+				keypairs = append(keypairs, "etcd-manager-ca-"+k, "etcd-peers-ca-"+k)
+			}
 			// The client ca certificate is shared between events, main, and leases etcd clusters
 			keypairs = append(keypairs, "etcd-clients-ca")
 		}
@@ -300,6 +303,14 @@ func (b *BootstrapScript) Run(c *fi.CloudupContext) error {
 	nodeupScript.WithProxyEnv(b.cluster)
 	nodeupScript.WithSysctls()
 
+	if b.cluster.GetCloudProvider() == kops.CloudProviderHetzner && hetzner.UsesPrivateNetworking(b.cluster) {
+		gateway, err := hetzner.NetworkGatewayIP(b.cluster.Spec.Networking.NetworkCIDR)
+		if err != nil {
+			return err
+		}
+		nodeupScript.WithHetznerPrivateNetworking(gateway.String())
+	}
+
 	nodeupScript.CompressUserData = fi.ValueOf(b.ig.Spec.CompressUserData)
 
 	nodeupScript.CloudProvider = string(c.T.Cluster.GetCloudProvider())
@@ -22,10 +22,10 @@ import (
 )
 
 // ServiceAccount represents the service-account used by the dns-controller.
-// It implements iam.Subject to get AWS IAM permissions.
+// It implements iam.Subject to get AWS IAM permissions, and iam.GCPSubject to get GCP permissions.
 type ServiceAccount struct{}
 
-var _ iam.Subject = &ServiceAccount{}
+var _ iam.GCPSubject = &ServiceAccount{}
 
 // BuildAWSPolicy generates a custom policy for a ServiceAccount IAM role.
 func (r *ServiceAccount) BuildAWSPolicy(b *iam.PolicyBuilder) (*iam.Policy, error) {
@@ -45,3 +45,15 @@ func (r *ServiceAccount) ServiceAccount() (types.NamespacedName, bool) {
 		Name:      "external-dns",
 	}, true
 }
+
+// GCPServiceAccountName returns the short name of the GCP service account.
+func (r *ServiceAccount) GCPServiceAccountName() string {
+	return "external-dns"
+}
+
+// GCPRoles returns the project roles granted to the GCP service account, to manage the records of the cluster zone.
+func (r *ServiceAccount) GCPRoles(context *iam.IAMModelContext) []string {
+	return []string{
+		"roles/dns.admin",
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpcloudcontrollermanager
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kops/pkg/model/iam"
+)
+
+// ServiceAccount represents the service-account used by the GCP cloud-controller-manager.
+// It implements iam.GCPSubject to get GCP permissions.
+type ServiceAccount struct{}
+
+var _ iam.GCPSubject = &ServiceAccount{}
+
+// BuildAWSPolicy is not supported, as the GCP cloud-controller-manager only runs on GCE.
+func (r *ServiceAccount) BuildAWSPolicy(b *iam.PolicyBuilder) (*iam.Policy, error) {
+	return nil, fmt.Errorf("the GCP cloud-controller-manager does not support AWS IAM permissions")
+}
+
+// ServiceAccount returns the kubernetes service account used.
+func (r *ServiceAccount) ServiceAccount() (types.NamespacedName, bool) {
+	return types.NamespacedName{
+		Namespace: "kube-system",
+		Name:      "cloud-controller-manager",
+	}, true
+}
+
+// GCPServiceAccountName returns the short name of the GCP service account.
+func (r *ServiceAccount) GCPServiceAccountName() string {
+	return "cloud-controller"
+}
+
+// GCPRoles returns the project roles granted to the GCP service account, to manage the routes, load balancers and firewall rules of the cluster.
+func (r *ServiceAccount) GCPRoles(context *iam.IAMModelContext) []string {
+	return []string{
+		"roles/compute.instanceAdmin.v1",
+		"roles/compute.loadBalancerAdmin",
+		"roles/compute.networkAdmin",
+		"roles/compute.securityAdmin",
+	}
+}
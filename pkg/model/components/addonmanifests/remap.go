@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	addonsapi "k8s.io/kops/channels/pkg/api"
+	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/assets"
 	"k8s.io/kops/pkg/kubemanifest"
 	"k8s.io/kops/pkg/model"
@@ -51,14 +52,14 @@ func RemapAddonManifest(addon *addonsapi.AddonSpec, context *model.KopsModelCont
 			}
 		}
 
-		err = addLabels(addon, objects)
+		objects, err = addServiceAccountRole(context, objects, serviceAccounts)
 		if err != nil {
-			return nil, fmt.Errorf("failed to annotate %q: %w", name, err)
+			return nil, fmt.Errorf("failed to add service account for %q: %w", name, err)
 		}
 
-		err = addServiceAccountRole(context, objects, serviceAccounts)
+		err = addLabels(addon, objects)
 		if err != nil {
-			return nil, fmt.Errorf("failed to add service account for %q: %w", name, err)
+			return nil, fmt.Errorf("failed to annotate %q: %w", name, err)
 		}
 
 		b, err := objects.ToYAML()
@@ -79,11 +80,15 @@ func RemapAddonManifest(addon *addonsapi.AddonSpec, context *model.KopsModelCont
 	return manifest, nil
 }
 
-func addServiceAccountRole(context *model.KopsModelContext, objects kubemanifest.ObjectList, serviceAccounts map[types.NamespacedName]iam.Subject) error {
-	if !context.UseServiceAccountExternalPermissions() {
-		return nil
+// addServiceAccountRole configures the pods to use the permissions of their ServiceAccount.
+// On GCE, it also adds the ConfigMaps holding the credential configuration of the ServiceAccounts.
+func addServiceAccountRole(context *model.KopsModelContext, objects kubemanifest.ObjectList, serviceAccounts map[types.NamespacedName]iam.Subject) (kubemanifest.ObjectList, error) {
+	if len(serviceAccounts) == 0 {
+		return objects, nil
 	}
 
+	credentialConfigMaps := make(map[types.NamespacedName]bool)
+	var added kubemanifest.ObjectList
 	for _, object := range objects {
 		if !hasPodSpecTemplate(object) {
 			continue
@@ -91,7 +96,7 @@ func addServiceAccountRole(context *model.KopsModelContext, objects kubemanifest
 		podSpec := &corev1.PodSpec{}
 
 		if err := object.Reparse(podSpec, "spec", "template", "spec"); err != nil {
-			return fmt.Errorf("failed to parse spec.template.spec from Deployment: %v", err)
+			return nil, fmt.Errorf("failed to parse spec.template.spec from Deployment: %v", err)
 		}
 		sa := types.NamespacedName{
 			Name:      podSpec.ServiceAccountName,
@@ -103,15 +108,27 @@ func addServiceAccountRole(context *model.KopsModelContext, objects kubemanifest
 		}
 
 		if err := iam.AddServiceAccountRole(&context.IAMModelContext, podSpec, subject); err != nil {
-			return err
+			return nil, err
 		}
 
 		if err := object.Set(podSpec, "spec", "template", "spec"); err != nil {
-			return fmt.Errorf("failed to set object: %w", err)
+			return nil, fmt.Errorf("failed to set object: %w", err)
 		}
 
+		if context.Cluster.GetCloudProvider() == kops.CloudProviderGCE && !credentialConfigMaps[sa] {
+			configMap, err := iam.BuildGCPCredentialConfigMap(&context.IAMModelContext, subject)
+			if err != nil {
+				return nil, err
+			}
+			configMapObject, err := kubemanifest.FromRuntimeObject(configMap)
+			if err != nil {
+				return nil, err
+			}
+			added = append(added, configMapObject)
+			credentialConfigMaps[sa] = true
+		}
 	}
-	return nil
+	return append(objects, added...), nil
 }
 
 func addLabels(addon *addonsapi.AddonSpec, objects kubemanifest.ObjectList) error {
@@ -18,9 +18,12 @@ package components
 
 import (
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/featureflag"
@@ -31,6 +34,49 @@ import (
 	"github.com/blang/semver/v4"
 )
 
+// AuditWebhookTokenSecretName is the name of the secret holding the bearer token sent to the auditWebhook server
+const AuditWebhookTokenSecretName = "auditwebhooktoken"
+
+// ParseAuditWebhookToken returns the bearer token stored in the auditwebhooktoken secret
+func ParseAuditWebhookToken(data []byte) (string, error) {
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("the audit webhook token is empty")
+	}
+	if strings.ContainsAny(token, "\r\n") {
+		return "", fmt.Errorf("the audit webhook token must be a single line")
+	}
+	return token, nil
+}
+
+// defaultAuthorizationConfig fills in the authorizer names and the webhook settings
+// that the apiserver.config.k8s.io/v1 AuthorizationConfiguration requires.
+func defaultAuthorizationConfig(config *kops.AuthorizationConfigSpec) {
+	for i := range config.Authorizers {
+		authorizer := &config.Authorizers[i]
+		if authorizer.Name == "" {
+			authorizer.Name = strings.ToLower(authorizer.Type)
+		}
+
+		webhook := authorizer.Webhook
+		if webhook == nil {
+			continue
+		}
+		if webhook.Timeout == nil {
+			webhook.Timeout = &metav1.Duration{Duration: 3 * time.Second}
+		}
+		if webhook.AuthorizedTTL == nil {
+			webhook.AuthorizedTTL = &metav1.Duration{Duration: 5 * time.Minute}
+		}
+		if webhook.UnauthorizedTTL == nil {
+			webhook.UnauthorizedTTL = &metav1.Duration{Duration: 30 * time.Second}
+		}
+		if webhook.FailurePolicy == "" {
+			webhook.FailurePolicy = "NoOpinion"
+		}
+	}
+}
+
 // KubeAPIServerOptionsBuilder adds options for the apiserver to the model
 type KubeAPIServerOptionsBuilder struct {
 	*OptionsContext
@@ -46,7 +92,8 @@ func (b *KubeAPIServerOptionsBuilder) BuildOptions(cluster *kops.Cluster) error
 	}
 	c := clusterSpec.KubeAPIServer
 
-	if c.APIServerCount == nil {
+	// The count is derived from the members of the main etcd cluster, which an external etcd cluster doesn't have
+	if c.APIServerCount == nil && !isExternalEtcdCluster(clusterSpec, "main") {
 		count := b.buildAPIServerCount(clusterSpec)
 		if count == 0 {
 			return fmt.Errorf("no instance groups found")
@@ -80,7 +127,12 @@ func (b *KubeAPIServerOptionsBuilder) BuildOptions(cluster *kops.Cluster) error
 		}
 	}
 
-	if clusterSpec.Authorization == nil || clusterSpec.Authorization.IsEmpty() {
+	if c.AuthorizationConfig != nil || c.AuthorizationConfigFile != "" {
+		// The authorizers are configured in the authorization config, which replaces authorization-mode
+		if c.AuthorizationConfig != nil {
+			defaultAuthorizationConfig(c.AuthorizationConfig)
+		}
+	} else if clusterSpec.Authorization == nil || clusterSpec.Authorization.IsEmpty() {
 		// Do nothing - use the default as defined by the apiserver.
 		// In practice unreachable: defaulting sets RBAC when authorization is omitted.
 	} else if clusterSpec.Authorization.AlwaysAllow != nil {
@@ -118,6 +170,19 @@ func (b *KubeAPIServerOptionsBuilder) BuildOptions(cluster *kops.Cluster) error
 	c.EtcdServersOverrides = nil
 
 	for _, etcdCluster := range clusterSpec.EtcdClusters {
+		if etcdCluster.IsExternal() {
+			endpoints := etcdCluster.External.Endpoints
+			switch etcdCluster.Name {
+			case "main":
+				c.EtcdServers = append(c.EtcdServers, endpoints...)
+			case "events":
+				c.EtcdServersOverrides = append(c.EtcdServersOverrides, "/events#"+strings.Join(endpoints, ";"))
+			case "leases":
+				c.EtcdServersOverrides = append(c.EtcdServersOverrides, "coordination.k8s.io/leases#"+strings.Join(endpoints, ";"))
+			}
+			continue
+		}
+
 		switch etcdCluster.Name {
 		case "main":
 			c.EtcdServers = append(c.EtcdServers, fmt.Sprintf("https://127.0.0.1:%d", wellknownports.EtcdMainClientPort))
@@ -154,6 +219,16 @@ func (b *KubeAPIServerOptionsBuilder) BuildOptions(cluster *kops.Cluster) error
 			"ValidatingAdmissionWebhook",
 		}
 		c.EnableAdmissionPlugins = append(c.EnableAdmissionPlugins, c.AppendAdmissionPlugins...)
+		if c.AdmissionConfig != nil {
+			for _, name := range c.AdmissionConfig.PluginNames() {
+				if !slices.Contains(c.EnableAdmissionPlugins, name) {
+					c.EnableAdmissionPlugins = append(c.EnableAdmissionPlugins, name)
+				}
+			}
+		}
+		if clusterSpec.PodSecurityAdmission != nil && !slices.Contains(c.EnableAdmissionPlugins, "PodSecurity") {
+			c.EnableAdmissionPlugins = append(c.EnableAdmissionPlugins, "PodSecurity")
+		}
 	}
 
 	// We make sure to disable AnonymousAuth
@@ -209,6 +284,16 @@ func (b *KubeAPIServerOptionsBuilder) buildAPIServerCount(clusterSpec *kops.Clus
 	return count
 }
 
+// isExternalEtcdCluster returns true if the named etcd cluster is not managed by kOps.
+func isExternalEtcdCluster(clusterSpec *kops.ClusterSpec, name string) bool {
+	for _, etcdCluster := range clusterSpec.EtcdClusters {
+		if etcdCluster.Name == name {
+			return etcdCluster.IsExternal()
+		}
+	}
+	return false
+}
+
 // configureAggregation sets up the aggregation options
 func (b *KubeAPIServerOptionsBuilder) configureAggregation(clusterSpec *kops.ClusterSpec) error {
 	clusterSpec.KubeAPIServer.RequestheaderAllowedNames = []string{"aggregator"}
@@ -19,8 +19,10 @@ package channels
 import (
 	"fmt"
 	"path/filepath"
+	"reflect"
 	"testing"
 
+	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/assets"
 	"k8s.io/kops/pkg/featureflag"
 	"k8s.io/kops/pkg/model"
@@ -63,6 +65,33 @@ func Test_RunChannelsBuilder(t *testing.T) {
 	}
 }
 
+func Test_ChannelList(t *testing.T) {
+	vfs.Context.ResetMemfsContext(true)
+	cluster := &kops.Cluster{}
+	cluster.Spec.ConfigStore.Base = "memfs://clusters.example.com/minimal.example.com"
+	cluster.Spec.Addons = []kops.AddonSpec{
+		{Manifest: "s3://bucket/channel.yaml"},
+		{Name: "my-tool", Manifest: "s3://bucket/my-tool.yaml"},
+	}
+	builder := ChannelsBuilder{
+		KopsModelContext: &model.KopsModelContext{
+			IAMModelContext: iam.IAMModelContext{Cluster: cluster},
+		},
+	}
+
+	channels, err := builder.channelList()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{
+		"memfs://clusters.example.com/minimal.example.com/addons/bootstrap-channel.yaml",
+		"s3://bucket/channel.yaml",
+	}
+	if !reflect.DeepEqual(channels, expected) {
+		t.Errorf("unexpected channels %v, expected %v", channels, expected)
+	}
+}
+
 func loadKopsModelContext(basedir string) (*model.KopsModelContext, error) {
 	spec, err := testutils.LoadModel(basedir)
 	if err != nil {
@@ -118,8 +118,14 @@ func (b *CiliumOptionsBuilder) BuildOptions(o *kops.Cluster) error {
 		c.Masquerade = new(!clusterSpec.IsIPv6Only())
 	}
 
+	vpcNativeRouting := clusterSpec.CloudProvider.DO != nil && clusterSpec.CloudProvider.DO.VPCNativeRouting
+	if vpcNativeRouting {
+		// Pod traffic is routed between the nodes in the VPC instead of being encapsulated
+		c.AutoDirectNodeRoutes = true
+	}
+
 	if c.Tunnel == "" {
-		if c.IPAM == "eni" || clusterSpec.IsIPv6Only() {
+		if c.IPAM == "eni" || clusterSpec.IsIPv6Only() || vpcNativeRouting {
 			c.Tunnel = "disabled"
 		} else {
 			c.Tunnel = "vxlan"
@@ -173,6 +179,17 @@ func (b *CiliumOptionsBuilder) BuildOptions(o *kops.Cluster) error {
 		if hubble.Enabled == nil {
 			hubble.Enabled = new(true)
 		}
+		if hubble.Relay != nil && hubble.Relay.Enabled == nil {
+			hubble.Relay.Enabled = new(true)
+		}
+		if hubble.UI != nil {
+			if hubble.UI.Enabled == nil {
+				hubble.UI.Enabled = new(true)
+			}
+			if hubble.UI.Version == "" {
+				hubble.UI.Version = "v0.13.2"
+			}
+		}
 	} else {
 		c.Hubble = &kops.HubbleSpec{
 			Enabled: new(false),
@@ -201,5 +218,13 @@ func (b *CiliumOptionsBuilder) BuildOptions(o *kops.Cluster) error {
 		}
 	}
 
+	if c.ClusterMesh != nil {
+		for i := range c.ClusterMesh.Clusters {
+			if c.ClusterMesh.Clusters[i].Port == 0 {
+				c.ClusterMesh.Clusters[i].Port = 2379
+			}
+		}
+	}
+
 	return nil
 }
@@ -24,6 +24,11 @@ import (
 
 const (
 	DefaultSandboxImage = "registry.k8s.io/pause:3.10.1"
+
+	// Stay on containerd 2.2.x rather than 2.3.x to avoid a sandbox-image
+	// regression in 2.3 (https://github.com/containerd/containerd/issues/13529).
+	DefaultContainerdVersion = "2.2.4"
+	DefaultRuncVersion       = "1.3.5"
 )
 
 // ContainerdOptionsBuilder adds options for containerd to the model
@@ -45,11 +50,9 @@ func (b *ContainerdOptionsBuilder) BuildOptions(o *kops.Cluster) error {
 
 	// Set the default version
 	if fi.ValueOf(containerd.Version) == "" {
-		// Stay on containerd 2.2.x rather than 2.3.x to avoid a sandbox-image
-		// regression in 2.3 (https://github.com/containerd/containerd/issues/13529).
-		containerd.Version = new("2.2.4")
+		containerd.Version = new(DefaultContainerdVersion)
 		containerd.Runc = &kops.Runc{
-			Version: new("1.3.5"),
+			Version: new(DefaultRuncVersion),
 		}
 	}
 	// Set the default log level to INFO
@@ -60,6 +63,11 @@ func (b *ContainerdOptionsBuilder) BuildOptions(o *kops.Cluster) error {
 		containerd.SandboxImage = new(b.AssetBuilder.RemapImage(DefaultSandboxImage))
 	}
 
+	// Containers need SELinux labels once nodes are labeled for SELinux
+	if clusterSpec.SELinux != nil {
+		containerd.SeLinuxEnabled = true
+	}
+
 	if containerd.NvidiaGPU != nil && fi.ValueOf(containerd.NvidiaGPU.Enabled) {
 		if containerd.NvidiaGPU.DriverPackage == "" {
 			containerd.NvidiaGPU.DriverPackage = kops.NvidiaDefaultDriverPackage
@@ -63,3 +63,24 @@ func Test_Build_Containerd_Supported_Version(t *testing.T) {
 		}
 	}
 }
+
+func Test_Build_Containerd_SELinux(t *testing.T) {
+	c := buildContainerdCluster("1.34.0")
+	c.Spec.SELinux = &kopsapi.SELinuxSpec{Mode: kopsapi.SELinuxModeEnforcing}
+	b := assets.NewAssetBuilder(vfs.Context, c.Spec.Assets, false)
+
+	optionsContext, err := NewOptionsContext(c, b, b.KubeletSupportedVersion)
+	if err != nil {
+		t.Fatalf("unexpected error from NewOptionsContext: %v", err)
+	}
+	ob := &ContainerdOptionsBuilder{
+		OptionsContext: optionsContext,
+	}
+	if err := ob.BuildOptions(c); err != nil {
+		t.Fatalf("unexpected error from BuildOptions: %v", err)
+	}
+
+	if !c.Spec.Containerd.SeLinuxEnabled {
+		t.Errorf("expected containerd SELinux support to be enabled")
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"fmt"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/loader"
+)
+
+// CRIOOptionsBuilder adds options for CRI-O to the model
+type CRIOOptionsBuilder struct {
+	*OptionsContext
+}
+
+var _ loader.ClusterOptionsBuilder = &CRIOOptionsBuilder{}
+
+// BuildOptions is responsible for filling in the default settings for the CRI-O daemon
+func (b *CRIOOptionsBuilder) BuildOptions(o *kops.Cluster) error {
+	if !o.UsesCRIO() {
+		return nil
+	}
+
+	clusterSpec := &o.Spec
+	if clusterSpec.CRIO == nil {
+		clusterSpec.CRIO = &kops.CRIOConfig{}
+	}
+	crio := clusterSpec.CRIO
+
+	// CRI-O minor versions track the Kubernetes minor versions they support,
+	// so default to the release matching the kubelet.
+	if fi.ValueOf(crio.Version) == "" {
+		crio.Version = new(fmt.Sprintf("1.%d.0", b.NodeKubernetesVersion().Minor()))
+	}
+
+	if fi.ValueOf(crio.LogLevel) == "" {
+		crio.LogLevel = new("info")
+	}
+
+	// Set the sandbox image used to scope pod shared resources used by the pod's containers.
+	if fi.ValueOf(crio.SandboxImage) == "" {
+		crio.SandboxImage = new(b.AssetBuilder.RemapImage(DefaultSandboxImage))
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"testing"
+
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/assets"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func Test_Build_CRIO_Default_Version(t *testing.T) {
+	grid := []struct {
+		containerRuntime  string
+		kubernetesVersion string
+		crio              *kopsapi.CRIOConfig
+		expected          string
+	}{
+		{
+			containerRuntime:  "containerd",
+			kubernetesVersion: "1.34.2",
+			expected:          "",
+		},
+		{
+			containerRuntime:  "crio",
+			kubernetesVersion: "1.34.2",
+			expected:          "1.34.0",
+		},
+		{
+			containerRuntime:  "crio",
+			kubernetesVersion: "1.35.0",
+			crio:              &kopsapi.CRIOConfig{Version: new("1.35.1")},
+			expected:          "1.35.1",
+		},
+	}
+
+	for _, g := range grid {
+		c := buildContainerdCluster(g.kubernetesVersion)
+		c.Spec.ContainerRuntime = g.containerRuntime
+		c.Spec.CRIO = g.crio
+		b := assets.NewAssetBuilder(vfs.Context, c.Spec.Assets, false)
+
+		optionsContext, err := NewOptionsContext(c, b, b.KubeletSupportedVersion)
+		if err != nil {
+			t.Fatalf("unexpected error from NewOptionsContext: %v", err)
+		}
+		ob := &CRIOOptionsBuilder{
+			OptionsContext: optionsContext,
+		}
+
+		if err := ob.BuildOptions(c); err != nil {
+			t.Fatalf("unexpected error from BuildOptions: %v", err)
+		}
+
+		var actual string
+		if c.Spec.CRIO != nil {
+			actual = fi.ValueOf(c.Spec.CRIO.Version)
+		}
+		if actual != g.expected {
+			t.Errorf("expected CRI-O version %q for %s with Kubernetes %s, got %q", g.expected, g.containerRuntime, g.kubernetesVersion, actual)
+		}
+	}
+}
@@ -18,6 +18,7 @@ package components
 
 import (
 	"k8s.io/kops/pkg/apis/kops"
+	kopsmodel "k8s.io/kops/pkg/apis/kops/model"
 	"k8s.io/kops/upup/pkg/fi/loader"
 )
 
@@ -42,17 +43,22 @@ func (b *EtcdOptionsBuilder) BuildOptions(o *kops.Cluster) error {
 		c := &spec.EtcdClusters[i]
 		// Ensure the version is set
 		if c.Version == "" {
-			// We run the k8s-recommended versions of etcd
-			switch {
-			case b.ControlPlaneKubernetesVersion().IsLT("1.34.0"):
-				c.Version = LatestEtcd35Version
-			case b.ControlPlaneKubernetesVersion().IsLT("1.37.0"):
-				c.Version = LatestEtcd36Version
-			default:
-				c.Version = LatestEtcd37Version
-			}
+			c.Version = RecommendedEtcdVersion(b.ControlPlaneKubernetesVersion())
 		}
 	}
 
 	return nil
 }
+
+// RecommendedEtcdVersion returns the version of etcd that is run by default for the control plane kubernetes version
+func RecommendedEtcdVersion(kubernetesVersion kopsmodel.KubernetesVersion) string {
+	// We run the k8s-recommended versions of etcd
+	switch {
+	case kubernetesVersion.IsLT("1.34.0"):
+		return LatestEtcd35Version
+	case kubernetesVersion.IsLT("1.37.0"):
+		return LatestEtcd36Version
+	default:
+		return LatestEtcd37Version
+	}
+}
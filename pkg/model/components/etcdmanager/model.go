@@ -46,8 +46,33 @@ import (
 	"k8s.io/kops/upup/pkg/fi/fitasks"
 	"k8s.io/kops/util/pkg/env"
 	"k8s.io/kops/util/pkg/vfs"
+	"sigs.k8s.io/yaml"
 )
 
+// BackupCredentialsSecretName is the name of the secret holding the credentials for backup stores configured with backups.s3
+const BackupCredentialsSecretName = "etcdbackupcredentials"
+
+// BackupCredentials are the credentials etcd-manager uses for backup stores configured with backups.s3
+type BackupCredentials struct {
+	AccessKeyID     string `json:"accessKeyID"`
+	SecretAccessKey string `json:"secretAccessKey"`
+}
+
+// ParseBackupCredentials parses the contents of the etcdbackupcredentials secret
+func ParseBackupCredentials(data []byte) (*BackupCredentials, error) {
+	credentials := &BackupCredentials{}
+	if err := yaml.UnmarshalStrict(data, credentials); err != nil {
+		return nil, fmt.Errorf("parsing etcd backup credentials: %w", err)
+	}
+	if credentials.AccessKeyID == "" {
+		return nil, fmt.Errorf("etcd backup credentials must set accessKeyID")
+	}
+	if credentials.SecretAccessKey == "" {
+		return nil, fmt.Errorf("etcd backup credentials must set secretAccessKey")
+	}
+	return credentials, nil
+}
+
 // resolveAzureBackupStore rewrites azureblob://<account>/<container>/<key> into
 // the legacy azureblob://<container>/<key> shape understood by the pinned
 // etcd-manager image, returning the storage account derived from
@@ -90,6 +115,9 @@ type EtcdManagerBuilder struct {
 	*model.KopsModelContext
 	Lifecycle    fi.Lifecycle
 	AssetBuilder *assets.AssetBuilder
+
+	// BackupCredentials are the credentials for backup stores configured with backups.s3
+	BackupCredentials *BackupCredentials
 }
 
 var _ fi.CloudupModelBuilder = &EtcdManagerBuilder{}
@@ -97,6 +125,9 @@ var _ fi.CloudupModelBuilder = &EtcdManagerBuilder{}
 // Build creates the tasks
 func (b *EtcdManagerBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 	for _, etcdCluster := range b.Cluster.Spec.EtcdClusters {
+		if etcdCluster.IsExternal() {
+			continue
+		}
 		backupStore := ""
 		if etcdCluster.Backups != nil {
 			backupStore = etcdCluster.Backups.BackupStore
@@ -104,6 +135,9 @@ func (b *EtcdManagerBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 		if backupStore == "" {
 			return fmt.Errorf("backupStore must be set for use with etcd-manager")
 		}
+		if etcdCluster.Backups.S3 != nil && b.BackupCredentials == nil {
+			return fmt.Errorf("the %s secret must be set for the backups.s3 configuration of etcd cluster %q", BackupCredentialsSecretName, etcdCluster.Name)
+		}
 
 		for _, member := range etcdCluster.Members {
 			instanceGroupName := fi.ValueOf(member.InstanceGroup)
@@ -680,6 +714,29 @@ func (b *EtcdManagerBuilder) buildPod(etcdCluster kops.EtcdClusterSpec, instance
 
 	envMap := env.BuildSystemComponentEnvVars(&b.Cluster.Spec)
 
+	// The backup store has its own S3 configuration, which etcd-manager only uses for the backup store
+	if etcdCluster.Backups != nil && etcdCluster.Backups.S3 != nil && b.BackupCredentials != nil {
+		envMap["S3_ENDPOINT"] = etcdCluster.Backups.S3.Endpoint
+		if etcdCluster.Backups.S3.Region != "" {
+			envMap["S3_REGION"] = etcdCluster.Backups.S3.Region
+		} else {
+			delete(envMap, "S3_REGION")
+		}
+		envMap["S3_ACCESS_KEY_ID"] = b.BackupCredentials.AccessKeyID
+		envMap["S3_SECRET_ACCESS_KEY"] = b.BackupCredentials.SecretAccessKey
+	}
+
+	// etcd-manager passes the ETCD_ variables on to etcd
+	if tlsSpec := b.Cluster.Spec.TLS; tlsSpec != nil {
+		if len(tlsSpec.CipherSuites) > 0 {
+			envMap["ETCD_CIPHER_SUITES"] = strings.Join(tlsSpec.CipherSuites, ",")
+		}
+		// etcd only supports TLS 1.2 and later
+		if tlsSpec.MinVersion == "VersionTLS13" {
+			envMap["ETCD_TLS_MIN_VERSION"] = "TLS1.3"
+		}
+	}
+
 	container.Env = envMap.ToEnvVars()
 
 	// Required by the pinned etcd-manager's legacy VFS; see resolveAzureBackupStore.
@@ -718,6 +775,34 @@ func (b *EtcdManagerBuilder) buildPod(etcdCluster kops.EtcdClusterSpec, instance
 			container.Env = append(container.Env, envVar)
 		}
 
+		if etcdCluster.Manager.QuotaBackendBytes != nil {
+			container.Env = append(container.Env, v1.EnvVar{
+				Name:  "ETCD_QUOTA_BACKEND_BYTES",
+				Value: strconv.FormatInt(etcdCluster.Manager.QuotaBackendBytes.Value(), 10),
+			})
+		}
+
+		if etcdCluster.Manager.AutoCompactionMode != "" {
+			container.Env = append(container.Env, v1.EnvVar{
+				Name:  "ETCD_AUTO_COMPACTION_MODE",
+				Value: etcdCluster.Manager.AutoCompactionMode,
+			})
+		}
+
+		if etcdCluster.Manager.AutoCompactionRetention != "" {
+			container.Env = append(container.Env, v1.EnvVar{
+				Name:  "ETCD_AUTO_COMPACTION_RETENTION",
+				Value: etcdCluster.Manager.AutoCompactionRetention,
+			})
+		}
+
+		if etcdCluster.Manager.DefragInterval != nil {
+			container.Env = append(container.Env, v1.EnvVar{
+				Name:  "ETCD_MANAGER_DEFRAG_INTERVAL",
+				Value: etcdCluster.Manager.DefragInterval.Duration.String(),
+			})
+		}
+
 		for _, envVar := range etcdCluster.Manager.Env {
 			klog.V(2).Infof("overloading ENV var in manifest %s with %s=%s", bundle, envVar.Name, envVar.Value)
 			configOverwrite := v1.EnvVar{
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"slices"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/loader"
+)
+
+// FIPSTLSMinVersion is the minimum TLS version of Kubernetes components in FIPS mode.
+const FIPSTLSMinVersion = "VersionTLS12"
+
+// FIPSOptionsBuilder restricts the cluster's TLS settings to FIPS 140-3 approved cryptography.
+// Settings that are explicitly configured in the cluster spec are left untouched, and checked by validation.
+type FIPSOptionsBuilder struct {
+	*OptionsContext
+}
+
+var _ loader.ClusterOptionsBuilder = &FIPSOptionsBuilder{}
+
+func (b *FIPSOptionsBuilder) BuildOptions(o *kops.Cluster) error {
+	clusterSpec := &o.Spec
+	if !fi.ValueOf(clusterSpec.FIPSMode) {
+		return nil
+	}
+
+	// The cluster's TLS settings are propagated to the components by the TLSOptionsBuilder
+	if clusterSpec.TLS == nil {
+		clusterSpec.TLS = &kops.TLSSpec{}
+	}
+	if clusterSpec.TLS.MinVersion == "" {
+		clusterSpec.TLS.MinVersion = FIPSTLSMinVersion
+	}
+	// TLS 1.3 cipher suites are not configurable, Go restricts them to approved ones in FIPS mode
+	if len(clusterSpec.TLS.CipherSuites) == 0 && clusterSpec.TLS.MinVersion != "VersionTLS13" {
+		clusterSpec.TLS.CipherSuites = slices.Clone(kops.FIPSTLSCipherSuites)
+	}
+
+	return nil
+}
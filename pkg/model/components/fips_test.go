@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"slices"
+	"testing"
+
+	api "k8s.io/kops/pkg/apis/kops"
+)
+
+func TestFIPSOptionsBuilder(t *testing.T) {
+	c := buildCluster()
+	c.Spec.FIPSMode = new(true)
+
+	b := &FIPSOptionsBuilder{OptionsContext: &OptionsContext{}}
+	if err := b.BuildOptions(c); err != nil {
+		t.Fatalf("unexpected error from BuildOptions: %v", err)
+	}
+
+	if !slices.Equal(c.Spec.TLS.CipherSuites, api.FIPSTLSCipherSuites) {
+		t.Errorf("expected tls cipherSuites %v, got %v", api.FIPSTLSCipherSuites, c.Spec.TLS.CipherSuites)
+	}
+	if c.Spec.TLS.MinVersion != FIPSTLSMinVersion {
+		t.Errorf("expected tls minVersion %q, got %q", FIPSTLSMinVersion, c.Spec.TLS.MinVersion)
+	}
+}
+
+func TestFIPSOptionsBuilderTLS13(t *testing.T) {
+	c := buildCluster()
+	c.Spec.FIPSMode = new(true)
+	c.Spec.TLS = &api.TLSSpec{MinVersion: "VersionTLS13"}
+
+	b := &FIPSOptionsBuilder{OptionsContext: &OptionsContext{}}
+	if err := b.BuildOptions(c); err != nil {
+		t.Fatalf("unexpected error from BuildOptions: %v", err)
+	}
+
+	if c.Spec.TLS.MinVersion != "VersionTLS13" {
+		t.Errorf("explicit tls minVersion was overridden")
+	}
+	if c.Spec.TLS.CipherSuites != nil {
+		t.Errorf("expected tls cipherSuites to be unset with TLS 1.3, got %v", c.Spec.TLS.CipherSuites)
+	}
+}
+
+func TestFIPSOptionsBuilderDisabled(t *testing.T) {
+	c := buildCluster()
+
+	b := &FIPSOptionsBuilder{OptionsContext: &OptionsContext{}}
+	if err := b.BuildOptions(c); err != nil {
+		t.Fatalf("unexpected error from BuildOptions: %v", err)
+	}
+
+	if c.Spec.TLS != nil {
+		t.Errorf("expected tls to be unset, got %v", c.Spec.TLS)
+	}
+}
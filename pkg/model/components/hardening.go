@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"slices"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi/loader"
+)
+
+// HardeningTLSCipherSuites are the strong TLS cipher suites that the cis hardening profile restricts
+// kube-apiserver and the kubelet to.
+var HardeningTLSCipherSuites = []string{
+	"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305",
+	"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305",
+	"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+}
+
+const (
+	// HardeningAuditLogPath is where kube-apiserver writes its audit log under the cis hardening profile.
+	HardeningAuditLogPath = "/var/log/kube-apiserver-audit.log"
+	// HardeningAuditLogMaxAge is the minimum number of days audit logs are retained under the cis hardening profile.
+	HardeningAuditLogMaxAge = 30
+	// HardeningAuditLogMaxBackups is the minimum number of audit log files retained under the cis hardening profile.
+	HardeningAuditLogMaxBackups = 10
+	// HardeningAuditLogMaxSize is the minimum size in megabytes of an audit log file under the cis hardening profile.
+	HardeningAuditLogMaxSize = 100
+)
+
+// HardeningOptionsBuilder applies the component settings of the cluster's hardening profile.
+// Settings that are explicitly configured in the cluster spec are left untouched.
+type HardeningOptionsBuilder struct {
+	*OptionsContext
+}
+
+var _ loader.ClusterOptionsBuilder = &HardeningOptionsBuilder{}
+
+func (b *HardeningOptionsBuilder) BuildOptions(o *kops.Cluster) error {
+	clusterSpec := &o.Spec
+	if clusterSpec.Hardening == nil || clusterSpec.Hardening.Profile != kops.HardeningProfileCIS {
+		return nil
+	}
+
+	if clusterSpec.Kubelet == nil {
+		clusterSpec.Kubelet = &kops.KubeletConfigSpec{}
+	}
+	hardenKubelet(clusterSpec.Kubelet)
+	if clusterSpec.ControlPlaneKubelet == nil {
+		clusterSpec.ControlPlaneKubelet = &kops.KubeletConfigSpec{}
+	}
+	hardenKubelet(clusterSpec.ControlPlaneKubelet)
+
+	if clusterSpec.KubeAPIServer == nil {
+		clusterSpec.KubeAPIServer = &kops.KubeAPIServerConfig{}
+	}
+	apiServer := clusterSpec.KubeAPIServer
+	if apiServer.EnableProfiling == nil {
+		apiServer.EnableProfiling = new(false)
+	}
+	if len(apiServer.TLSCipherSuites) == 0 {
+		apiServer.TLSCipherSuites = slices.Clone(HardeningTLSCipherSuites)
+	}
+	if apiServer.AuditLogPath == nil {
+		apiServer.AuditLogPath = new(HardeningAuditLogPath)
+	}
+	if apiServer.AuditLogMaxAge == nil {
+		apiServer.AuditLogMaxAge = new(int32(HardeningAuditLogMaxAge))
+	}
+	if apiServer.AuditLogMaxBackups == nil {
+		apiServer.AuditLogMaxBackups = new(int32(HardeningAuditLogMaxBackups))
+	}
+	if apiServer.AuditLogMaxSize == nil {
+		apiServer.AuditLogMaxSize = new(int32(HardeningAuditLogMaxSize))
+	}
+	if apiServer.AuditPolicy == nil && apiServer.AuditPolicyFile == "" && apiServer.AuditWebhookConfigFile == "" {
+		// Record the metadata of every request, without the noise of a separate event when it is received.
+		apiServer.AuditPolicy = &kops.AuditPolicySpec{
+			OmitStages: []string{"RequestReceived"},
+			Rules: []kops.AuditPolicyRule{
+				{Level: "Metadata"},
+			},
+		}
+	}
+
+	if clusterSpec.KubeControllerManager == nil {
+		clusterSpec.KubeControllerManager = &kops.KubeControllerManagerConfig{}
+	}
+	kcm := clusterSpec.KubeControllerManager
+	if kcm.EnableProfiling == nil {
+		kcm.EnableProfiling = new(false)
+	}
+	if kcm.TerminatedPodGCThreshold == nil {
+		kcm.TerminatedPodGCThreshold = new(int32(12500))
+	}
+	if kcm.UseServiceAccountCredentials == nil {
+		kcm.UseServiceAccountCredentials = new(true)
+	}
+
+	if clusterSpec.KubeScheduler == nil {
+		clusterSpec.KubeScheduler = &kops.KubeSchedulerConfig{}
+	}
+	if clusterSpec.KubeScheduler.EnableProfiling == nil {
+		clusterSpec.KubeScheduler.EnableProfiling = new(false)
+	}
+
+	return nil
+}
+
+func hardenKubelet(kubelet *kops.KubeletConfigSpec) {
+	if kubelet.AnonymousAuth == nil {
+		kubelet.AnonymousAuth = new(false)
+	}
+	if kubelet.ReadOnlyPort == nil {
+		kubelet.ReadOnlyPort = new(int32(0))
+	}
+	if kubelet.ProtectKernelDefaults == nil {
+		kubelet.ProtectKernelDefaults = new(true)
+	}
+	if len(kubelet.TLSCipherSuites) == 0 {
+		kubelet.TLSCipherSuites = slices.Clone(HardeningTLSCipherSuites)
+	}
+}
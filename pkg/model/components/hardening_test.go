@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"testing"
+
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func TestHardeningOptionsBuilder(t *testing.T) {
+	c := buildCluster()
+	c.Spec.Hardening = &api.HardeningSpec{Profile: api.HardeningProfileCIS}
+	c.Spec.Kubelet = &api.KubeletConfigSpec{AnonymousAuth: new(true)}
+	c.Spec.KubeAPIServer.AuditPolicyFile = "/srv/kubernetes/audit.yaml"
+
+	b := &HardeningOptionsBuilder{OptionsContext: &OptionsContext{}}
+	if err := b.BuildOptions(c); err != nil {
+		t.Fatalf("unexpected error from BuildOptions: %v", err)
+	}
+
+	if !fi.ValueOf(c.Spec.Kubelet.AnonymousAuth) {
+		t.Errorf("explicit kubelet anonymousAuth was overridden")
+	}
+	if fi.ValueOf(c.Spec.ControlPlaneKubelet.AnonymousAuth) {
+		t.Errorf("expected control plane kubelet anonymousAuth to be disabled")
+	}
+	if c.Spec.Kubelet.ReadOnlyPort == nil || *c.Spec.Kubelet.ReadOnlyPort != 0 {
+		t.Errorf("expected kubelet readOnlyPort to be 0, got %v", c.Spec.Kubelet.ReadOnlyPort)
+	}
+	if len(c.Spec.Kubelet.TLSCipherSuites) != len(HardeningTLSCipherSuites) {
+		t.Errorf("expected kubelet tlsCipherSuites to be set, got %v", c.Spec.Kubelet.TLSCipherSuites)
+	}
+
+	apiServer := c.Spec.KubeAPIServer
+	if apiServer.EnableProfiling == nil || *apiServer.EnableProfiling {
+		t.Errorf("expected kube-apiserver profiling to be disabled")
+	}
+	if fi.ValueOf(apiServer.AuditLogPath) != HardeningAuditLogPath {
+		t.Errorf("expected auditLogPath %q, got %q", HardeningAuditLogPath, fi.ValueOf(apiServer.AuditLogPath))
+	}
+	if fi.ValueOf(apiServer.AuditLogMaxAge) != HardeningAuditLogMaxAge {
+		t.Errorf("expected auditLogMaxAge %d, got %d", HardeningAuditLogMaxAge, fi.ValueOf(apiServer.AuditLogMaxAge))
+	}
+	if apiServer.AuditPolicy != nil {
+		t.Errorf("audit policy must not be set alongside auditPolicyFile")
+	}
+
+	if c.Spec.KubeControllerManager.EnableProfiling == nil || *c.Spec.KubeControllerManager.EnableProfiling {
+		t.Errorf("expected kube-controller-manager profiling to be disabled")
+	}
+	if c.Spec.KubeControllerManager.TerminatedPodGCThreshold == nil {
+		t.Errorf("expected kube-controller-manager terminatedPodGCThreshold to be set")
+	}
+	if c.Spec.KubeScheduler.EnableProfiling == nil || *c.Spec.KubeScheduler.EnableProfiling {
+		t.Errorf("expected kube-scheduler profiling to be disabled")
+	}
+}
+
+func TestHardeningOptionsBuilderDisabled(t *testing.T) {
+	c := buildCluster()
+
+	b := &HardeningOptionsBuilder{OptionsContext: &OptionsContext{}}
+	if err := b.BuildOptions(c); err != nil {
+		t.Fatalf("unexpected error from BuildOptions: %v", err)
+	}
+
+	if c.Spec.KubeAPIServer.EnableProfiling != nil || c.Spec.KubeAPIServer.AuditLogPath != nil {
+		t.Errorf("kube-apiserver was changed without a hardening profile: %+v", c.Spec.KubeAPIServer)
+	}
+	if c.Spec.Kubelet != nil {
+		t.Errorf("kubelet was changed without a hardening profile: %+v", c.Spec.Kubelet)
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi/loader"
+)
+
+// InterruptionHandlerOptionsBuilder adds options for the interruption handler to the model.
+type InterruptionHandlerOptionsBuilder struct {
+	*OptionsContext
+}
+
+var _ loader.ClusterOptionsBuilder = &InterruptionHandlerOptionsBuilder{}
+
+func (b *InterruptionHandlerOptionsBuilder) BuildOptions(o *kops.Cluster) error {
+	clusterSpec := &o.Spec
+	if clusterSpec.InterruptionHandler == nil {
+		return nil
+	}
+	ih := clusterSpec.InterruptionHandler
+
+	if ih.Enabled == nil {
+		ih.Enabled = new(false)
+	}
+
+	if ih.DrainMaintenanceEvents == nil {
+		ih.DrainMaintenanceEvents = new(true)
+	}
+
+	if ih.DrainTimeout == nil {
+		// Spot instances are interrupted two minutes after the notice.
+		ih.DrainTimeout = &metav1.Duration{Duration: 90 * time.Second}
+	}
+
+	if ih.EnablePrometheusMetrics == nil {
+		ih.EnablePrometheusMetrics = new(false)
+	}
+
+	if ih.CPURequest == nil {
+		defaultCPURequest := resource.MustParse("10m")
+		ih.CPURequest = &defaultCPURequest
+	}
+
+	if ih.MemoryRequest == nil {
+		defaultMemoryRequest := resource.MustParse("64Mi")
+		ih.MemoryRequest = &defaultMemoryRequest
+	}
+
+	return nil
+}
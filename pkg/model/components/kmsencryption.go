@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi/loader"
+)
+
+// DefaultAWSEncryptionProviderImage is the aws-encryption-provider image used for kmsEncryption on AWS
+const DefaultAWSEncryptionProviderImage = "registry.k8s.io/provider-aws/aws-encryption-provider:v1.0.0"
+
+// KMSEncryptionOptionsBuilder adds options for the KMS plugin to the model
+type KMSEncryptionOptionsBuilder struct {
+	*OptionsContext
+}
+
+var _ loader.ClusterOptionsBuilder = &KMSEncryptionOptionsBuilder{}
+
+func (b *KMSEncryptionOptionsBuilder) BuildOptions(o *kops.Cluster) error {
+	kms := o.Spec.KMSEncryption
+	if kms == nil {
+		return nil
+	}
+
+	if kms.Image == "" && kms.Provider == kops.KMSEncryptionProviderAWS {
+		kms.Image = b.AssetBuilder.RemapImage(DefaultAWSEncryptionProviderImage)
+	}
+	if len(kms.Resources) == 0 {
+		kms.Resources = []string{"secrets"}
+	}
+
+	return nil
+}
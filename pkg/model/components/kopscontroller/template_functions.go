@@ -22,12 +22,19 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/kops/channels/pkg/channels"
 	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/model"
 	"k8s.io/kops/pkg/featureflag"
 	"k8s.io/kops/pkg/model/components/etcdmanager"
 	"k8s.io/kops/pkg/wellknownports"
+	"k8s.io/kops/upup/pkg/fi"
 )
 
+// ApplyCredentialsSecret is the name of the secret in kube-system holding the environment variables with the credentials
+// kops-controller uses to continuously apply the cluster, such as AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.
+const ApplyCredentialsSecret = "kops-controller-apply-credentials"
+
 // AddTemplateFunctions registers template functions for KopsController
 func AddTemplateFunctions(cluster *kops.Cluster, dest template.FuncMap) {
 	t := &templateFunctions{
@@ -43,6 +50,50 @@ type templateFunctions struct {
 	Cluster *kops.Cluster
 }
 
+// SignsKubeletServingCertificates is true if kops-controller approves and signs the kubelet serving certificates.
+func (t *templateFunctions) SignsKubeletServingCertificates() bool {
+	return model.UseKubeletServerTLSBootstrap(t.Cluster)
+}
+
+// GarbageCollectsHosts is true if kops-controller marks and deletes the Hosts of bare-metal machines that are gone.
+func (t *templateFunctions) GarbageCollectsHosts() bool {
+	return featureflag.Metal.Enabled()
+}
+
+// ScalesInstanceGroups is true if kops-controller serves the API for scaling instance groups.
+func (t *templateFunctions) ScalesInstanceGroups() bool {
+	return model.UseInstanceGroupScaleAPI(t.Cluster)
+}
+
+// DrainsNodes is true if kops-controller drains the nodes of instances held by the graceful node drain lifecycle hook.
+func (t *templateFunctions) DrainsNodes() bool {
+	return t.Cluster.GetCloudProvider() == kops.CloudProviderAWS && t.Cluster.Spec.CloudProvider.AWS.GracefulNodeDrain.IsEnabled()
+}
+
+// EnforcesAddons is true if kops-controller re-applies the manifests of the installed managed addons.
+func (t *templateFunctions) EnforcesAddons() bool {
+	return t.Cluster.Spec.KopsController != nil && fi.ValueOf(t.Cluster.Spec.KopsController.EnforceAddons)
+}
+
+// AppliesCluster is true if kops-controller continuously applies the cluster.
+func (t *templateFunctions) AppliesCluster() bool {
+	return t.Cluster.Spec.KopsController != nil && t.Cluster.Spec.KopsController.ContinuousApply != nil
+}
+
+// ApplyCredentialsSecret returns the name of the secret with the credentials for continuously applying the cluster.
+func (t *templateFunctions) ApplyCredentialsSecret() string {
+	return ApplyCredentialsSecret
+}
+
+// EnforcedAddonResources returns the resources of the kinds of addon objects that kops-controller re-applies, by API group.
+func (t *templateFunctions) EnforcedAddonResources() map[string][]string {
+	resources := make(map[string][]string)
+	for _, r := range channels.EnforcedResources {
+		resources[r.Group] = append(resources[r.Group], r.Resource)
+	}
+	return resources
+}
+
 // KopsControllerConfig returns the yaml configuration for kops-controller
 func (t *templateFunctions) GossipServices() ([]*corev1.Service, error) {
 	if !t.Cluster.UsesLegacyGossip() {
@@ -84,6 +135,9 @@ func (t *templateFunctions) GossipServices() ([]*corev1.Service, error) {
 	// etcd services
 	if featureflag.APIServerNodes.Enabled() {
 		for _, etcdCluster := range t.Cluster.Spec.EtcdClusters {
+			if etcdCluster.IsExternal() {
+				continue
+			}
 			name := "etcd-" + etcdCluster.Name + "-internal"
 			service := buildHeadlessService(types.NamespacedName{Name: name, Namespace: "kube-system"})
 			ports, err := etcdmanager.PortsForCluster(etcdCluster)
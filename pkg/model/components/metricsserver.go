@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/loader"
+)
+
+// MetricsServerVersion is the version of metrics server that kOps deploys by default.
+const MetricsServerVersion = "v0.8.0"
+
+// MetricsServerOptionsBuilder adds options for metrics server to the model.
+type MetricsServerOptionsBuilder struct {
+	*OptionsContext
+}
+
+var _ loader.ClusterOptionsBuilder = &MetricsServerOptionsBuilder{}
+
+func (b *MetricsServerOptionsBuilder) BuildOptions(o *kops.Cluster) error {
+	ms := o.Spec.MetricsServer
+	if ms == nil || !fi.ValueOf(ms.Enabled) {
+		return nil
+	}
+
+	if ms.Version == nil {
+		ms.Version = new(MetricsServerVersion)
+	}
+
+	return nil
+}
@@ -45,7 +45,8 @@ func (b *NodeTerminationHandlerOptionsBuilder) BuildOptions(o *kops.Cluster) err
 	}
 	nth := clusterSpec.CloudProvider.AWS.NodeTerminationHandler
 	if nth.Enabled == nil {
-		nth.Enabled = new(true)
+		// The kOps interruption handler replaces the node termination handler.
+		nth.Enabled = new(!clusterSpec.InterruptionHandler.IsEnabled())
 	}
 	if !fi.ValueOf(nth.Enabled) {
 		return nil
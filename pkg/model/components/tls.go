@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"slices"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi/loader"
+)
+
+// TLSOptionsBuilder propagates the cluster's TLS settings to the Kubernetes components.
+// Settings that are explicitly configured for a component are left untouched.
+type TLSOptionsBuilder struct {
+	*OptionsContext
+}
+
+var _ loader.ClusterOptionsBuilder = &TLSOptionsBuilder{}
+
+func (b *TLSOptionsBuilder) BuildOptions(o *kops.Cluster) error {
+	clusterSpec := &o.Spec
+	if clusterSpec.TLS == nil {
+		return nil
+	}
+
+	if clusterSpec.Kubelet == nil {
+		clusterSpec.Kubelet = &kops.KubeletConfigSpec{}
+	}
+	defaultTLSSettings(clusterSpec.TLS, &clusterSpec.Kubelet.TLSCipherSuites, &clusterSpec.Kubelet.TLSMinVersion)
+	if clusterSpec.ControlPlaneKubelet == nil {
+		clusterSpec.ControlPlaneKubelet = &kops.KubeletConfigSpec{}
+	}
+	defaultTLSSettings(clusterSpec.TLS, &clusterSpec.ControlPlaneKubelet.TLSCipherSuites, &clusterSpec.ControlPlaneKubelet.TLSMinVersion)
+
+	if clusterSpec.KubeAPIServer == nil {
+		clusterSpec.KubeAPIServer = &kops.KubeAPIServerConfig{}
+	}
+	defaultTLSSettings(clusterSpec.TLS, &clusterSpec.KubeAPIServer.TLSCipherSuites, &clusterSpec.KubeAPIServer.TLSMinVersion)
+
+	if clusterSpec.KubeControllerManager == nil {
+		clusterSpec.KubeControllerManager = &kops.KubeControllerManagerConfig{}
+	}
+	defaultTLSSettings(clusterSpec.TLS, &clusterSpec.KubeControllerManager.TLSCipherSuites, &clusterSpec.KubeControllerManager.TLSMinVersion)
+
+	return nil
+}
+
+// defaultTLSSettings sets the TLS cipher suites and minimum TLS version of a component if they are not set.
+func defaultTLSSettings(tls *kops.TLSSpec, cipherSuites *[]string, minVersion *string) {
+	if len(*cipherSuites) == 0 && len(tls.CipherSuites) > 0 {
+		*cipherSuites = slices.Clone(tls.CipherSuites)
+	}
+	if *minVersion == "" {
+		*minVersion = tls.MinVersion
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"slices"
+	"testing"
+
+	api "k8s.io/kops/pkg/apis/kops"
+)
+
+func TestTLSOptionsBuilder(t *testing.T) {
+	cipherSuites := []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"}
+
+	c := buildCluster()
+	c.Spec.TLS = &api.TLSSpec{
+		MinVersion:   "VersionTLS12",
+		CipherSuites: cipherSuites,
+	}
+	c.Spec.Kubelet = &api.KubeletConfigSpec{TLSMinVersion: "VersionTLS13"}
+	c.Spec.KubeAPIServer.TLSCipherSuites = []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"}
+
+	b := &TLSOptionsBuilder{OptionsContext: &OptionsContext{}}
+	if err := b.BuildOptions(c); err != nil {
+		t.Fatalf("unexpected error from BuildOptions: %v", err)
+	}
+
+	if c.Spec.Kubelet.TLSMinVersion != "VersionTLS13" {
+		t.Errorf("explicit kubelet tlsMinVersion was overridden")
+	}
+	if !slices.Equal(c.Spec.Kubelet.TLSCipherSuites, cipherSuites) {
+		t.Errorf("expected kubelet tlsCipherSuites %v, got %v", cipherSuites, c.Spec.Kubelet.TLSCipherSuites)
+	}
+	if c.Spec.ControlPlaneKubelet.TLSMinVersion != "VersionTLS12" {
+		t.Errorf("expected control plane kubelet tlsMinVersion VersionTLS12, got %q", c.Spec.ControlPlaneKubelet.TLSMinVersion)
+	}
+	if !slices.Equal(c.Spec.KubeAPIServer.TLSCipherSuites, []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"}) {
+		t.Errorf("explicit kube-apiserver tlsCipherSuites were overridden")
+	}
+	if c.Spec.KubeAPIServer.TLSMinVersion != "VersionTLS12" {
+		t.Errorf("expected kube-apiserver tlsMinVersion VersionTLS12, got %q", c.Spec.KubeAPIServer.TLSMinVersion)
+	}
+	if !slices.Equal(c.Spec.KubeControllerManager.TLSCipherSuites, cipherSuites) {
+		t.Errorf("expected kube-controller-manager tlsCipherSuites %v, got %v", cipherSuites, c.Spec.KubeControllerManager.TLSCipherSuites)
+	}
+
+	// Components must not share the cluster's slice
+	c.Spec.Kubelet.TLSCipherSuites[0] = "changed"
+	if c.Spec.TLS.CipherSuites[0] == "changed" {
+		t.Errorf("kubelet tlsCipherSuites share the cluster's slice")
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/loader"
+)
+
+// VerticalPodAutoscalerVersion is the version of the vertical pod autoscaler that kOps deploys by default.
+const VerticalPodAutoscalerVersion = "1.4.1"
+
+// VerticalPodAutoscalerOptionsBuilder adds options for the vertical pod autoscaler to the model.
+type VerticalPodAutoscalerOptionsBuilder struct {
+	*OptionsContext
+}
+
+var _ loader.ClusterOptionsBuilder = &VerticalPodAutoscalerOptionsBuilder{}
+
+func (b *VerticalPodAutoscalerOptionsBuilder) BuildOptions(o *kops.Cluster) error {
+	vpa := o.Spec.VerticalPodAutoscaler
+	if vpa == nil || !fi.ValueOf(vpa.Enabled) {
+		return nil
+	}
+
+	if vpa.Version == nil {
+		vpa.Version = new(VerticalPodAutoscalerVersion)
+	}
+
+	return nil
+}
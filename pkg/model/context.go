@@ -19,6 +19,7 @@ package model
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -44,6 +45,10 @@ import (
 
 const (
 	clusterAutoscalerNodeTemplateTaint = "k8s.io/cluster-autoscaler/node-template/taint/"
+	// clusterAutoscalerAutoDiscoveryTagPrefix is the prefix of the tags that the cluster autoscaler uses to discover autoscaling groups
+	clusterAutoscalerAutoDiscoveryTagPrefix = "k8s.io/cluster-autoscaler/"
+	// clusterAutoscalerAutoscalingOptionsTagPrefix is the prefix of the tags that override the cluster autoscaler options of an autoscaling group
+	clusterAutoscalerAutoscalingOptionsTagPrefix = "k8s.io/cluster-autoscaler/node-template/autoscaling-options/"
 )
 
 // KopsModelContext is the kops model
@@ -235,10 +240,52 @@ func (b *KopsModelContext) CloudTagsForInstanceGroup(ig *kops.InstanceGroup) (ma
 		}
 
 		labels[nodeidentityaws.CloudTagInstanceGroupName] = ig.Name
+
+		for k, v := range b.clusterAutoscalerTags(ig) {
+			labels[k] = v
+		}
 	}
 	return labels, nil
 }
 
+// clusterAutoscalerTags returns the tags that the cluster autoscaler reads from the autoscaling group of an instance group on AWS.
+func (b *KopsModelContext) clusterAutoscalerTags(ig *kops.InstanceGroup) map[string]string {
+	tags := make(map[string]string)
+
+	cas := b.Cluster.Spec.ClusterAutoscaler
+	if cas == nil || !fi.ValueOf(cas.Enabled) {
+		return tags
+	}
+	if !ig.Spec.Role.HasNode() || ig.Spec.Manager == kops.InstanceManagerKarpenter {
+		return tags
+	}
+	if ig.Spec.Autoscale != nil && !*ig.Spec.Autoscale {
+		return tags
+	}
+
+	if fi.ValueOf(cas.NodeGroupAutoDiscovery) {
+		tags[clusterAutoscalerAutoDiscoveryTagPrefix+"enabled"] = "true"
+		tags[clusterAutoscalerAutoDiscoveryTagPrefix+b.ClusterName()] = "owned"
+	}
+
+	if options := ig.Spec.ClusterAutoscaler; options != nil {
+		if options.ScaleDownUtilizationThreshold != nil {
+			tags[clusterAutoscalerAutoscalingOptionsTagPrefix+"scaledownutilizationthreshold"] = *options.ScaleDownUtilizationThreshold
+		}
+		if options.ScaleDownUnneededTime != nil {
+			tags[clusterAutoscalerAutoscalingOptionsTagPrefix+"scaledownunneededtime"] = *options.ScaleDownUnneededTime
+		}
+		if options.ScaleDownUnreadyTime != nil {
+			tags[clusterAutoscalerAutoscalingOptionsTagPrefix+"scaledownunreadytime"] = *options.ScaleDownUnreadyTime
+		}
+		if options.IgnoreDaemonSetsUtilization != nil {
+			tags[clusterAutoscalerAutoscalingOptionsTagPrefix+"ignoredaemonsetsutilization"] = strconv.FormatBool(*options.IgnoreDaemonSetsUtilization)
+		}
+	}
+
+	return tags
+}
+
 func (b *KopsModelContext) CloudTagsForServiceAccount(name string, sa types.NamespacedName) map[string]string {
 	tags := b.CloudTags(name, false)
 	tags[awstasks.CloudTagServiceAccountName] = sa.Name
@@ -400,6 +447,16 @@ func (b *KopsModelContext) UseServiceAccountExternalPermissions() bool {
 		fi.ValueOf(b.Cluster.Spec.IAM.UseServiceAccountExternalPermissions)
 }
 
+// UseCloudControllerManagerExternalPermissions returns true if the cloud controller manager uses a service-account bound role,
+// either because all managed ServiceAccounts do or because it is configured for the cloud controller manager alone.
+func (b *KopsModelContext) UseCloudControllerManagerExternalPermissions() bool {
+	if b.UseServiceAccountExternalPermissions() {
+		return true
+	}
+	ccm := b.Cluster.Spec.ExternalCloudControllerManager
+	return ccm != nil && fi.ValueOf(ccm.UseServiceAccountExternalPermissions)
+}
+
 // NetworkingIsCalico returns true if we are using calico networking
 func (b *KopsModelContext) NetworkingIsCalico() bool {
 	return b.Cluster.Spec.Networking.Calico != nil
@@ -89,3 +89,79 @@ func TestCloudTagsForInstanceGroup_Taints(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudTagsForInstanceGroup_ClusterAutoscaler(t *testing.T) {
+	discoveryTags := []string{
+		"k8s.io/cluster-autoscaler/enabled",
+		"k8s.io/cluster-autoscaler/testcluster.test.com",
+	}
+	optionsTag := "k8s.io/cluster-autoscaler/node-template/autoscaling-options/scaledownunneededtime"
+
+	grid := []struct {
+		name          string
+		autoDiscovery bool
+		autoscale     *bool
+		manager       kops.InstanceManager
+		wantDiscovery bool
+		wantOptions   bool
+	}{
+		{
+			name:        "static node groups",
+			wantOptions: true,
+		},
+		{
+			name:          "auto-discovery",
+			autoDiscovery: true,
+			wantDiscovery: true,
+			wantOptions:   true,
+		},
+		{
+			name:          "instance group not autoscaled",
+			autoDiscovery: true,
+			autoscale:     new(false),
+		},
+		{
+			name:          "instance group managed by Karpenter",
+			autoDiscovery: true,
+			manager:       kops.InstanceManagerKarpenter,
+		},
+	}
+
+	for _, tc := range grid {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := testutils.BuildMinimalClusterAWS("testcluster.test.com")
+			cluster.Spec.ClusterAutoscaler = &kops.ClusterAutoscalerConfig{
+				Enabled:                new(true),
+				NodeGroupAutoDiscovery: new(tc.autoDiscovery),
+			}
+			ig := &kops.InstanceGroup{}
+			ig.ObjectMeta.Name = "nodes"
+			ig.Spec.Role = kops.InstanceGroupRoleNode
+			ig.Spec.Manager = tc.manager
+			ig.Spec.Autoscale = tc.autoscale
+			ig.Spec.ClusterAutoscaler = &kops.InstanceGroupClusterAutoscalerSpec{
+				ScaleDownUnneededTime: new("5m"),
+			}
+
+			b := &KopsModelContext{
+				IAMModelContext:   iam.IAMModelContext{Cluster: cluster},
+				AllInstanceGroups: []*kops.InstanceGroup{ig},
+				InstanceGroups:    []*kops.InstanceGroup{ig},
+			}
+
+			tags, err := b.CloudTagsForInstanceGroup(ig)
+			if err != nil {
+				t.Fatalf("CloudTagsForInstanceGroup() error = %v", err)
+			}
+
+			for _, k := range discoveryTags {
+				if _, found := tags[k]; found != tc.wantDiscovery {
+					t.Errorf("tag %q present = %t, want %t", k, found, tc.wantDiscovery)
+				}
+			}
+			if got, found := tags[optionsTag]; found != tc.wantOptions || (found && got != "5m") {
+				t.Errorf("tag %q = %q (present = %t), want present = %t", optionsTag, got, found, tc.wantOptions)
+			}
+		})
+	}
+}
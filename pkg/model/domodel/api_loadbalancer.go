@@ -68,6 +68,13 @@ func (b *APILoadBalancerModelBuilder) Build(c *fi.CloudupModelBuilderContext) er
 		WellKnownServices: []wellknownservices.WellKnownService{wellknownservices.KopsController, wellknownservices.KubeAPIServer},
 	}
 
+	if doSpec := b.Cluster.Spec.CloudProvider.DO; doSpec != nil && doSpec.APILoadBalancer != nil {
+		loadbalancer.SizeUnit = doSpec.APILoadBalancer.SizeUnit
+		if doSpec.APILoadBalancer.Algorithm != "" {
+			loadbalancer.Algorithm = new(doSpec.APILoadBalancer.Algorithm)
+		}
+	}
+
 	if b.Cluster.Spec.Networking.NetworkID != "" {
 		loadbalancer.VPCUUID = new(b.Cluster.Spec.Networking.NetworkID)
 	} else if b.Cluster.Spec.Networking.NetworkCIDR != "" {
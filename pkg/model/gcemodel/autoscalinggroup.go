@@ -19,8 +19,10 @@ package gcemodel
 import (
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/model"
@@ -121,6 +123,17 @@ func (b *AutoscalingGroupModelBuilder) buildInstanceTemplate(c *fi.CloudupModelB
 			},
 		}
 
+		if ig.Spec.SpotPolicy != nil {
+			t.Preemptible = new(true)
+			t.GCPProvisioningModel = s("SPOT")
+			// Deleting preempted instances lets the managed instance group recreate them when capacity is available,
+			// instead of leaving stopped instances in the group.
+			t.InstanceTerminationAction = s("DELETE")
+			if ig.Spec.SpotPolicy.TerminationAction != nil {
+				t.InstanceTerminationAction = ig.Spec.SpotPolicy.TerminationAction
+			}
+		}
+
 		if volumeIops > 0 {
 			t.BootDiskIOPS = i64(int64(volumeIops))
 		}
@@ -225,6 +238,9 @@ func (b *AutoscalingGroupModelBuilder) buildInstanceTemplate(c *fi.CloudupModelB
 		case kops.InstanceGroupRoleBastion:
 			t.Tags = append(t.Tags, b.GCETagForRole(kops.InstanceGroupRoleBastion))
 		}
+		if len(ig.Spec.FirewallRules) != 0 {
+			t.Tags = append(t.Tags, b.GCETagForInstanceGroup(ig))
+		}
 
 		if gce.UsesIPAliases(b.Cluster) {
 			t.CanIPForward = new(false)
@@ -234,7 +250,7 @@ func (b *AutoscalingGroupModelBuilder) buildInstanceTemplate(c *fi.CloudupModelB
 				nodeCIDRMaskSize = *b.Cluster.Spec.KubeControllerManager.NodeCIDRMaskSize
 			}
 			t.AliasIPRanges = map[string]string{
-				b.NameForIPAliasRange("pods"): fmt.Sprintf("/%d", nodeCIDRMaskSize),
+				b.NameForPodRange(subnet): fmt.Sprintf("/%d", nodeCIDRMaskSize),
 			}
 		} else {
 			t.CanIPForward = new(true)
@@ -360,6 +376,11 @@ func (b *AutoscalingGroupModelBuilder) Build(c *fi.CloudupModelBuilderContext) e
 			return err
 		}
 
+		updatePolicy, err := buildUpdatePolicy(ig)
+		if err != nil {
+			return err
+		}
+
 		for zone, targetSize := range instanceCountByZone {
 			name := gce.NameForInstanceGroupManager(b.Cluster.ObjectMeta.Name, ig.ObjectMeta.Name, zone)
 
@@ -368,12 +389,16 @@ func (b *AutoscalingGroupModelBuilder) Build(c *fi.CloudupModelBuilderContext) e
 				Lifecycle:                   b.Lifecycle,
 				Zone:                        s(zone),
 				TargetSize:                  new(int64(targetSize)),
-				UpdatePolicy:                &gcetasks.UpdatePolicy{MinimalAction: "REPLACE", Type: "OPPORTUNISTIC"},
+				UpdatePolicy:                updatePolicy,
 				BaseInstanceName:            s(ig.ObjectMeta.Name),
 				InstanceTemplate:            instanceTemplate,
 				ListManagedInstancesResults: "PAGINATED",
 			}
 
+			if ig.Spec.SpotPolicy != nil && len(ig.Spec.SpotPolicy.FallbackMachineTypes) != 0 {
+				t.MachineTypes = append([]string{ig.Spec.MachineType}, ig.Spec.SpotPolicy.FallbackMachineTypes...)
+			}
+
 			// Attach API server instances to load balancer if we're using one
 			// Do not attach API server instances from the control plane if we
 			// have an APIServer only IG declared. We are assuming that APIServer
@@ -399,3 +424,53 @@ func (b *AutoscalingGroupModelBuilder) Build(c *fi.CloudupModelBuilderContext) e
 
 	return nil
 }
+
+// buildUpdatePolicy builds the update policy of the managed instance groups of the instance group.
+// By default, only new instances use a new instance template, and kops rolling-update replaces the existing ones.
+func buildUpdatePolicy(ig *kops.InstanceGroup) (*gcetasks.UpdatePolicy, error) {
+	policy := &gcetasks.UpdatePolicy{MinimalAction: "REPLACE", Type: "OPPORTUNISTIC"}
+
+	spec := ig.Spec.ManagedInstanceGroupUpdatePolicy
+	if spec == nil {
+		return policy, nil
+	}
+	if spec.Type != nil {
+		policy.Type = *spec.Type
+	}
+	if spec.MinimalAction != nil {
+		policy.MinimalAction = *spec.MinimalAction
+	}
+	policy.ReplacementMethod = fi.ValueOf(spec.ReplacementMethod)
+
+	var err error
+	policy.MaxSurgeFixed, policy.MaxSurgePercent, err = fixedOrPercent(spec.MaxSurge)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maxSurge for instance group %q: %w", ig.Name, err)
+	}
+	policy.MaxUnavailableFixed, policy.MaxUnavailablePercent, err = fixedOrPercent(spec.MaxUnavailable)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maxUnavailable for instance group %q: %w", ig.Name, err)
+	}
+
+	// GCE requires instances to be recreated without surge, and would otherwise default to a surge of 1
+	if policy.ReplacementMethod == "RECREATE" && policy.MaxSurgeFixed == nil && policy.MaxSurgePercent == nil {
+		policy.MaxSurgeFixed = new(int64(0))
+	}
+
+	return policy, nil
+}
+
+// fixedOrPercent splits an absolute number or percentage into the fixed and percent values of a GCE update policy.
+func fixedOrPercent(v *intstr.IntOrString) (*int64, *int64, error) {
+	if v == nil {
+		return nil, nil, nil
+	}
+	if v.Type == intstr.Int {
+		return new(int64(v.IntValue())), nil, nil
+	}
+	percent, err := strconv.ParseInt(strings.TrimSuffix(v.StrVal, "%"), 10, 64)
+	if err != nil || !strings.HasSuffix(v.StrVal, "%") {
+		return nil, nil, fmt.Errorf("%q is not a number or a percentage", v.StrVal)
+	}
+	return nil, new(percent), nil
+}
@@ -21,8 +21,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/model"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gcetasks"
 )
 
 func TestSplitToZones(t *testing.T) {
@@ -123,3 +125,67 @@ func TestSplitToZones(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildUpdatePolicy(t *testing.T) {
+	testcases := []struct {
+		name     string
+		spec     *kops.ManagedInstanceGroupUpdatePolicySpec
+		expected *gcetasks.UpdatePolicy
+		mustErr  bool
+	}{
+		{
+			name:     "default",
+			expected: &gcetasks.UpdatePolicy{MinimalAction: "REPLACE", Type: "OPPORTUNISTIC"},
+		},
+		{
+			name: "proactive with surge",
+			spec: &kops.ManagedInstanceGroupUpdatePolicySpec{
+				Type:           new("PROACTIVE"),
+				MinimalAction:  new("RESTART"),
+				MaxSurge:       new(intstr.FromInt32(3)),
+				MaxUnavailable: new(intstr.FromString("20%")),
+			},
+			expected: &gcetasks.UpdatePolicy{
+				MinimalAction:         "RESTART",
+				Type:                  "PROACTIVE",
+				MaxSurgeFixed:         new(int64(3)),
+				MaxUnavailablePercent: new(int64(20)),
+			},
+		},
+		{
+			name: "recreate without surge",
+			spec: &kops.ManagedInstanceGroupUpdatePolicySpec{
+				ReplacementMethod: new("RECREATE"),
+			},
+			expected: &gcetasks.UpdatePolicy{
+				MinimalAction:     "REPLACE",
+				Type:              "OPPORTUNISTIC",
+				ReplacementMethod: "RECREATE",
+				MaxSurgeFixed:     new(int64(0)),
+			},
+		},
+		{
+			name: "invalid percentage",
+			spec: &kops.ManagedInstanceGroupUpdatePolicySpec{
+				MaxSurge: new(intstr.FromString("20")),
+			},
+			mustErr: true,
+		},
+	}
+
+	for _, g := range testcases {
+		t.Run(g.name, func(t *testing.T) {
+			ig := &kops.InstanceGroup{}
+			ig.Spec.ManagedInstanceGroupUpdatePolicy = g.spec
+
+			actual, err := buildUpdatePolicy(ig)
+
+			if g.mustErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, g.expected, actual)
+		})
+	}
+}
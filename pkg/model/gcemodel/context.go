@@ -17,6 +17,8 @@ limitations under the License.
 package gcemodel
 
 import (
+	"strings"
+
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/model"
@@ -56,6 +58,22 @@ func (c *GCEModelContext) NameForIPAliasRange(key string) string {
 	return c.SafeSuffixedObjectName(key)
 }
 
+// NameForPodRange returns the name of the secondary IP range of the subnet used for pods
+func (c *GCEModelContext) NameForPodRange(subnet *kops.ClusterSubnetSpec) string {
+	if subnet.PodRangeName != "" {
+		return subnet.PodRangeName
+	}
+	return c.NameForIPAliasRange("pods")
+}
+
+// NameForServiceRange returns the name of the secondary IP range of the subnet used for services
+func (c *GCEModelContext) NameForServiceRange(subnet *kops.ClusterSubnetSpec) string {
+	if subnet.ServiceRangeName != "" {
+		return subnet.ServiceRangeName
+	}
+	return c.NameForIPAliasRange("services")
+}
+
 // LinkToSubnet returns a link to the GCE subnet object
 func (c *GCEModelContext) LinkToSubnet(subnet *kops.ClusterSubnetSpec) *gcetasks.Subnet {
 	name := subnet.ID
@@ -91,6 +109,11 @@ func (c *GCEModelContext) GCETagForRole(role kops.InstanceGroupRole) string {
 	return gce.TagForRole(c.Cluster.ObjectMeta.Name, role)
 }
 
+// GCETagForInstanceGroup returns the (network) tag targeted by the firewall rules of the given instance group.
+func (c *GCEModelContext) GCETagForInstanceGroup(ig *kops.InstanceGroup) string {
+	return gce.ClusterPrefixedName("ig-"+strings.ReplaceAll(ig.ObjectMeta.Name, ".", "-"), c.Cluster.ObjectMeta.Name, 63)
+}
+
 // HasAPIServerOnlyInstanceGroups returns true if the cluster has any APIServer-only instance groups.
 func (c *GCEModelContext) HasAPIServerOnlyInstanceGroups() bool {
 	for _, ig := range c.InstanceGroups {
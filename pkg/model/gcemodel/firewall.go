@@ -188,6 +188,37 @@ func (b *FirewallModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 		}
 	}
 
+	for _, ig := range b.InstanceGroups {
+		if len(ig.Spec.FirewallRules) == 0 {
+			continue
+		}
+
+		network, err := b.LinkToNetwork()
+		if err != nil {
+			return err
+		}
+		for i, rule := range ig.Spec.FirewallRules {
+			fromPort, toPort := rule.PortRange()
+			allowed := fmt.Sprintf("%s:%d", rule.Protocol, fromPort)
+			if toPort != fromPort {
+				allowed += fmt.Sprintf("-%d", toPort)
+			}
+			t := &gcetasks.FirewallRule{
+				Lifecycle:  b.Lifecycle,
+				Network:    network,
+				TargetTags: []string{b.GCETagForInstanceGroup(ig)},
+				Allowed:    []string{allowed},
+			}
+			if rule.IsEgress() {
+				t.Direction = gcetasks.FirewallDirectionEgress
+				t.DestinationRanges = rule.CIDRs
+			} else {
+				t.SourceRanges = rule.CIDRs
+			}
+			b.AddFirewallRulesTasks(c, fmt.Sprintf("ig-%s-%d", strings.ReplaceAll(ig.ObjectMeta.Name, ".", "-"), i), t)
+		}
+	}
+
 	return nil
 }
 
@@ -195,10 +226,17 @@ func (b *FirewallModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 // GCE does not allow us to mix ipv4 and ipv6 in the same firewall rule, so we must create separate rules.
 // Furthermore, an empty SourceRange with empty SourceTags is interpreted as allow-everything,
 // but we intend for it to block everything; so we can Disabled to achieve the desired blocking.
+// EGRESS rules are split on their DestinationRanges in the same way.
 func (b *GCEModelContext) AddFirewallRulesTasks(c *fi.CloudupModelBuilderContext, name string, rule *gcetasks.FirewallRule) {
+	egress := rule.Direction == gcetasks.FirewallDirectionEgress
+	ranges := rule.SourceRanges
+	if egress {
+		ranges = rule.DestinationRanges
+	}
+
 	var ipv4SourceRanges []string
 	var ipv6SourceRanges []string
-	for _, sourceRange := range rule.SourceRanges {
+	for _, sourceRange := range ranges {
 		_, cidr, err := net.ParseCIDR(sourceRange)
 		if err != nil {
 			klog.Fatalf("failed to parse invalid sourceRange %q", sourceRange)
@@ -215,7 +253,13 @@ func (b *GCEModelContext) AddFirewallRulesTasks(c *fi.CloudupModelBuilderContext
 	ipv4 := *rule
 	ipv4.Name = s(b.NameForFirewallRule(name))
 	ipv4.Family = gcetasks.AddressFamilyIPv4
-	if len(ipv4.SourceTags) == 0 {
+	if egress {
+		ipv4.DestinationRanges = ipv4SourceRanges
+		if len(ipv4.DestinationRanges) == 0 {
+			ipv4.Disabled = true
+			ipv4.DestinationRanges = []string{"0.0.0.0/0"}
+		}
+	} else if len(ipv4.SourceTags) == 0 {
 		ipv4.SourceRanges = ipv4SourceRanges
 		if len(ipv4.SourceRanges) == 0 {
 			// This is helpful because empty SourceRanges and SourceTags are interpreted as allow everything,
@@ -229,7 +273,13 @@ func (b *GCEModelContext) AddFirewallRulesTasks(c *fi.CloudupModelBuilderContext
 	ipv6 := *rule
 	ipv6.Name = s(b.NameForFirewallRule(name + "-ipv6"))
 	ipv6.Family = gcetasks.AddressFamilyIPv6
-	if len(ipv6.SourceTags) == 0 {
+	if egress {
+		ipv6.DestinationRanges = ipv6SourceRanges
+		if len(ipv6.DestinationRanges) == 0 {
+			ipv6.Disabled = true
+			ipv6.DestinationRanges = []string{"::/0"}
+		}
+	} else if len(ipv6.SourceTags) == 0 {
 		ipv6.SourceRanges = ipv6SourceRanges
 		if len(ipv6.SourceRanges) == 0 {
 			// We specify explicitly so the rule is in IPv6 mode
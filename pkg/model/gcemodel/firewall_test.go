@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcemodel
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/model"
+	"k8s.io/kops/pkg/model/iam"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gcetasks"
+)
+
+func TestAddFirewallRulesTasksEgress(t *testing.T) {
+	b := &GCEModelContext{
+		KopsModelContext: &model.KopsModelContext{
+			IAMModelContext: iam.IAMModelContext{
+				Cluster: &kops.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "minimal.example.com"}},
+			},
+		},
+	}
+
+	grid := []struct {
+		desc     string
+		ranges   []string
+		expected map[string]gcetasks.FirewallRule
+	}{
+		{
+			desc:   "ipv4 and ipv6",
+			ranges: []string{"10.0.0.0/8", "2001:db8::/32"},
+			expected: map[string]gcetasks.FirewallRule{
+				"ig-nodes-0-minimal-example-com":      {Family: gcetasks.AddressFamilyIPv4, DestinationRanges: []string{"10.0.0.0/8"}},
+				"ig-nodes-0-ipv6-minimal-example-com": {Family: gcetasks.AddressFamilyIPv6, DestinationRanges: []string{"2001:db8::/32"}},
+			},
+		},
+		{
+			desc:   "ipv4 only",
+			ranges: []string{"10.0.0.0/8"},
+			expected: map[string]gcetasks.FirewallRule{
+				"ig-nodes-0-minimal-example-com":      {Family: gcetasks.AddressFamilyIPv4, DestinationRanges: []string{"10.0.0.0/8"}},
+				"ig-nodes-0-ipv6-minimal-example-com": {Family: gcetasks.AddressFamilyIPv6, DestinationRanges: []string{"::/0"}, Disabled: true},
+			},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			c := &fi.CloudupModelBuilderContext{
+				Tasks: make(map[string]fi.CloudupTask),
+			}
+			b.AddFirewallRulesTasks(c, "ig-nodes-0", &gcetasks.FirewallRule{
+				Direction:         gcetasks.FirewallDirectionEgress,
+				DestinationRanges: g.ranges,
+				Allowed:           []string{"tcp:443"},
+			})
+
+			actual := make(map[string]gcetasks.FirewallRule)
+			for _, task := range c.Tasks {
+				rule := task.(*gcetasks.FirewallRule)
+				if rule.Direction != gcetasks.FirewallDirectionEgress {
+					t.Errorf("rule %s: expected direction %s, got %q", fi.ValueOf(rule.Name), gcetasks.FirewallDirectionEgress, rule.Direction)
+				}
+				if len(rule.SourceRanges) != 0 {
+					t.Errorf("rule %s: expected no source ranges, got %v", fi.ValueOf(rule.Name), rule.SourceRanges)
+				}
+				actual[fi.ValueOf(rule.Name)] = gcetasks.FirewallRule{
+					Family:            rule.Family,
+					DestinationRanges: rule.DestinationRanges,
+					Disabled:          rule.Disabled,
+				}
+			}
+			if !reflect.DeepEqual(actual, g.expected) {
+				t.Errorf("unexpected rules: got %+v, want %+v", actual, g.expected)
+			}
+		})
+	}
+}
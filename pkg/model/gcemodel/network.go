@@ -89,13 +89,20 @@ func (b *NetworkModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 
 		t.SecondaryIpRanges = make(map[string]string)
 		if gce.UsesIPAliases(b.Cluster) {
+			// kOps sizes the ranges it creates, but existing ranges might be too small for the cluster
+			if subnet.PodRangeName != "" || subnet.ServiceRangeName != "" {
+				if err := gce.ValidateIPAliasRangeSizes(b.Cluster, b.AllInstanceGroups); err != nil {
+					return fmt.Errorf("secondary ranges of subnet %q: %w", subnet.ID, err)
+				}
+			}
+
 			// The primary CIDR is used by the nodes,
 			// services and pods draw from the secondary IP ranges.
 			// All the CIDRs must be valid RFC1918 IP addresses, which makes conversion from the "pure kubenet" 100.64.0.0 GCE range difficult
 
 			t.CIDR = s(subnet.CIDR)
-			t.SecondaryIpRanges[b.NameForIPAliasRange("pods")] = b.Cluster.Spec.Networking.PodCIDR
-			t.SecondaryIpRanges[b.NameForIPAliasRange("services")] = b.Cluster.Spec.Networking.ServiceClusterIPRange
+			t.SecondaryIpRanges[b.NameForPodRange(subnet)] = b.Cluster.Spec.Networking.PodCIDR
+			t.SecondaryIpRanges[b.NameForServiceRange(subnet)] = b.Cluster.Spec.Networking.ServiceClusterIPRange
 		}
 
 		c.AddTask(t)
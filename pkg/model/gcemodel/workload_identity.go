@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcemodel
+
+import (
+	"fmt"
+
+	"k8s.io/kops/pkg/model/iam"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gcetasks"
+)
+
+// WorkloadIdentityBuilder configures the workload identity pool trusting the ServiceAccount issuer,
+// and the GCP service accounts impersonated by the kubernetes ServiceAccounts of the addons.
+type WorkloadIdentityBuilder struct {
+	*GCEModelContext
+
+	Lifecycle fi.Lifecycle
+}
+
+var _ fi.CloudupModelBuilder = &WorkloadIdentityBuilder{}
+
+func (b *WorkloadIdentityBuilder) Build(c *fi.CloudupModelBuilderContext) error {
+	if !b.UseGCPWorkloadIdentityFederation() {
+		return nil
+	}
+
+	pool := b.LinkToWorkloadIdentityPool()
+	pool.Lifecycle = b.Lifecycle
+	pool.Description = s("kubernetes cluster " + b.ClusterName())
+	c.AddTask(pool)
+
+	c.AddTask(&gcetasks.WorkloadIdentityPoolProvider{
+		Name:      s(iam.GCPWorkloadIdentityProviderID),
+		Lifecycle: b.Lifecycle,
+
+		Pool:      pool,
+		IssuerURI: b.Cluster.Spec.KubeAPIServer.ServiceAccountIssuer,
+		AttributeMapping: map[string]string{
+			"google.subject": "assertion.sub",
+		},
+	})
+
+	return nil
+}
+
+// UseGCPWorkloadIdentityFederation returns true if the ServiceAccounts of the cluster can impersonate GCP service accounts
+func (b *GCEModelContext) UseGCPWorkloadIdentityFederation() bool {
+	discovery := b.Cluster.Spec.ServiceAccountIssuerDiscovery
+	return discovery != nil && discovery.EnableGCPWorkloadIdentityFederation
+}
+
+// LinkToWorkloadIdentityPool returns the workload identity pool trusting the ServiceAccount issuer
+func (b *GCEModelContext) LinkToWorkloadIdentityPool() *gcetasks.WorkloadIdentityPool {
+	return &gcetasks.WorkloadIdentityPool{
+		Name:          s(gce.WorkloadIdentityPoolID(b.ClusterName())),
+		ProjectNumber: s(b.GCPProjectNumber),
+	}
+}
+
+// BuildServiceAccountTasks creates the GCP service account impersonated by the kubernetes ServiceAccount of the subject,
+// and grants it the roles of the subject.
+func (b *WorkloadIdentityBuilder) BuildServiceAccountTasks(subject iam.Subject, c *fi.CloudupModelBuilderContext) error {
+	gcpSubject, ok := subject.(iam.GCPSubject)
+	if !ok {
+		return fmt.Errorf("ServiceAccount-level IAM is not yet supported on GCE for %T", subject)
+	}
+	sa, ok := subject.ServiceAccount()
+	if !ok {
+		return fmt.Errorf("role %v does not have ServiceAccount", subject)
+	}
+
+	name := gcpSubject.GCPServiceAccountName()
+	serviceAccount := &gcetasks.ServiceAccount{
+		Name:        s(name),
+		Lifecycle:   b.Lifecycle,
+		Email:       s(b.GCPServiceAccountEmail(gcpSubject)),
+		DisplayName: s(name),
+		Description: s("kubernetes ServiceAccount " + sa.Namespace + "/" + sa.Name),
+	}
+	c.AddTask(serviceAccount)
+
+	for _, role := range gcpSubject.GCPRoles(&b.IAMModelContext) {
+		c.AddTask(&gcetasks.ProjectIAMBinding{
+			Name:      s("serviceaccount-" + name + "-" + gce.LastComponent(role)),
+			Lifecycle: b.Lifecycle,
+
+			Project:              s(b.ProjectID),
+			MemberServiceAccount: serviceAccount,
+			Role:                 s(role),
+		})
+	}
+
+	c.AddTask(&gcetasks.WorkloadIdentityBinding{
+		Name:      s(name),
+		Lifecycle: b.Lifecycle,
+
+		ServiceAccount: serviceAccount,
+		Pool:           b.LinkToWorkloadIdentityPool(),
+		Subject:        s("system:serviceaccount:" + sa.Namespace + ":" + sa.Name),
+	})
+
+	return nil
+}
@@ -29,3 +29,8 @@ func (b *HetznerModelContext) LinkToNetwork() *hetznertasks.Network {
 	name := b.ClusterName()
 	return &hetznertasks.Network{Name: &name}
 }
+
+// NATGatewayName returns the name of the NAT gateway server of clusters with private networking
+func (b *HetznerModelContext) NATGatewayName() string {
+	return "nat-gateway." + b.ClusterName()
+}
@@ -135,5 +135,30 @@ func (b *ExternalAccessModelBuilder) Build(c *fi.CloudupModelBuilderContext) err
 	c.AddTask(controlPlaneFirewall)
 	c.AddTask(nodesFirewall)
 
+	if hetzner.UsesPrivateNetworking(b.Cluster) {
+		// The NAT gateway is the only server with a public IP, so it is also the way in for SSH
+		natGatewayLabelSelector := []string{
+			fmt.Sprintf("%s=%s", hetzner.TagKubernetesClusterName, b.ClusterName()),
+			fmt.Sprintf("%s=%s", hetzner.TagKubernetesInstanceRole, hetzner.InstanceRoleNATGateway),
+		}
+		c.AddTask(&hetznertasks.Firewall{
+			Name:      new(b.NATGatewayName()),
+			Lifecycle: b.Lifecycle,
+			Selector:  strings.Join(natGatewayLabelSelector, ","),
+			Rules: []*hetznertasks.FirewallRule{
+				{
+					Direction: string(hcloud.FirewallRuleDirectionIn),
+					SourceIPs: sshAccess,
+					Protocol:  string(hcloud.FirewallRuleProtocolTCP),
+					Port:      new("22"),
+				},
+			},
+			Labels: map[string]string{
+				hetzner.TagKubernetesClusterName:  b.ClusterName(),
+				hetzner.TagKubernetesFirewallRole: hetzner.InstanceRoleNATGateway,
+			},
+		})
+	}
+
 	return nil
 }
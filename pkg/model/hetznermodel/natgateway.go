@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetznermodel
+
+import (
+	"fmt"
+
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/hetzner"
+	"k8s.io/kops/upup/pkg/fi/cloudup/hetznertasks"
+)
+
+const (
+	natGatewaySize  = "cx23"
+	natGatewayImage = "ubuntu-24.04"
+)
+
+// natGatewayUserDataTemplate enables forwarding and masquerades the traffic from the network through the public interface
+var natGatewayUserDataTemplate = `#!/bin/bash
+set -o errexit
+set -o nounset
+set -o pipefail
+
+cat > /etc/sysctl.d/99-kops-nat-gateway.conf <<EOF
+net.ipv4.ip_forward = 1
+EOF
+sysctl --system
+
+cat > /etc/systemd/system/kops-nat-gateway.service <<EOF
+[Unit]
+Description=Masquerade the traffic of the cluster network
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=/usr/sbin/iptables -t nat -A POSTROUTING -s %[1]s -o eth0 -j MASQUERADE
+ExecStop=/usr/sbin/iptables -t nat -D POSTROUTING -s %[1]s -o eth0 -j MASQUERADE
+
+[Install]
+WantedBy=multi-user.target
+EOF
+systemctl daemon-reload
+systemctl enable --now kops-nat-gateway.service
+`
+
+// buildNATGateway returns the server routing the traffic of the servers without public IPs to the internet
+func (b *ServerGroupModelBuilder) buildNATGateway(sshkeyTasks []*hetznertasks.SSHKey) (*hetznertasks.NATGateway, error) {
+	networkCIDR := b.Cluster.Spec.Networking.NetworkCIDR
+	ip, err := hetzner.NATGatewayIP(networkCIDR)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hetznertasks.NATGateway{
+		Name:      new(b.NATGatewayName()),
+		Lifecycle: b.Lifecycle,
+		SSHKeys:   sshkeyTasks,
+		Network:   b.LinkToNetwork(),
+		Location:  b.InstanceGroups[0].Spec.Subnets[0],
+		Size:      natGatewaySize,
+		Image:     natGatewayImage,
+		IP:        ip.String(),
+		UserData:  fi.NewStringResource(fmt.Sprintf(natGatewayUserDataTemplate, networkCIDR)),
+		Labels: map[string]string{
+			hetzner.TagKubernetesClusterName:  b.ClusterName(),
+			hetzner.TagKubernetesInstanceRole: hetzner.InstanceRoleNATGateway,
+		},
+	}, nil
+}
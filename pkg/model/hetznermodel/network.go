@@ -45,6 +45,17 @@ func (b *NetworkModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 		network.Labels = map[string]string{
 			hetzner.TagKubernetesClusterName: b.ClusterName(),
 		}
+
+		if hetzner.UsesPrivateNetworking(b.Cluster) {
+			// Servers without public IPs reach the internet through the NAT gateway
+			natGatewayIP, err := hetzner.NATGatewayIP(b.Cluster.Spec.Networking.NetworkCIDR)
+			if err != nil {
+				return err
+			}
+			network.Routes = map[string]string{
+				"0.0.0.0/0": natGatewayIP.String(),
+			}
+		}
 	} else {
 		network.ID = new(b.Cluster.Spec.Networking.NetworkID)
 	}
@@ -52,6 +52,8 @@ func (b *ServerGroupModelBuilder) Build(c *fi.CloudupModelBuilderContext) error
 		sshkeyTasks = append(sshkeyTasks, t)
 	}
 
+	privateNetworking := hetzner.UsesPrivateNetworking(b.Cluster)
+
 	for _, ig := range b.InstanceGroups {
 		igSize := fi.ValueOf(ig.Spec.MinSize)
 		labels, err := b.CloudTagsForInstanceGroup(ig)
@@ -73,7 +75,7 @@ func (b *ServerGroupModelBuilder) Build(c *fi.CloudupModelBuilderContext) error
 			Location:   ig.Spec.Subnets[0],
 			Size:       ig.Spec.MachineType,
 			Image:      ig.Spec.Image,
-			EnableIPv4: true,
+			EnableIPv4: !privateNetworking,
 			EnableIPv6: false,
 			UserData:   userData,
 			Labels:     labels,
@@ -82,5 +84,13 @@ func (b *ServerGroupModelBuilder) Build(c *fi.CloudupModelBuilderContext) error
 		c.AddTask(&serverGroup)
 	}
 
+	if privateNetworking {
+		natGateway, err := b.buildNATGateway(sshkeyTasks)
+		if err != nil {
+			return err
+		}
+		c.AddTask(natGateway)
+	}
+
 	return nil
 }
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/wellknownusers"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+// GCPWorkloadIdentityProviderID is the ID of the provider of the workload identity pool, which trusts the ServiceAccount issuer
+const GCPWorkloadIdentityProviderID = "kubernetes"
+
+// GCPSubject is a Subject which can be granted GCP permissions through workload identity federation.
+type GCPSubject interface {
+	Subject
+
+	// GCPServiceAccountName returns the short name of the GCP service account impersonated by the subject.
+	// It must be at most 19 characters, so that it can be suffixed by the cluster name.
+	GCPServiceAccountName() string
+
+	// GCPRoles returns the project roles granted to the GCP service account.
+	GCPRoles(context *IAMModelContext) []string
+}
+
+// GCPServiceAccountEmail returns the email of the GCP service account impersonated by the subject
+func (b *IAMModelContext) GCPServiceAccountEmail(subject GCPSubject) string {
+	projectID := b.Cluster.Spec.CloudProvider.GCE.Project
+	return gce.ServiceAccountName(subject.GCPServiceAccountName(), b.ClusterName()) + "@" + projectID + ".iam.gserviceaccount.com"
+}
+
+// GCPWorkloadIdentityProvider returns the full resource name of the provider of the workload identity pool
+func (b *IAMModelContext) GCPWorkloadIdentityProvider() string {
+	return "//iam.googleapis.com/projects/" + b.GCPProjectNumber + "/locations/global/workloadIdentityPools/" + gce.WorkloadIdentityPoolID(b.ClusterName()) + "/providers/" + GCPWorkloadIdentityProviderID
+}
+
+// GCPCredentialConfigMapName returns the name of the ConfigMap holding the credential configuration of the ServiceAccount
+func GCPCredentialConfigMapName(serviceAccountName string) string {
+	return serviceAccountName + "-gcp-credentials"
+}
+
+const (
+	gcpTokenDir           = "/var/run/secrets/iam.googleapis.com/" //nolint:gosec // This is the projected token directory path, not a credential.
+	gcpTokenName          = "token"
+	gcpCredentialFileName = "credential-configuration.json"
+)
+
+// BuildGCPCredentialConfigMap builds the ConfigMap holding the credential configuration, which the Google client libraries
+// use to exchange the projected ServiceAccount token for the credentials of the GCP service account.
+func BuildGCPCredentialConfigMap(context *IAMModelContext, subject Subject) (*corev1.ConfigMap, error) {
+	gcpSubject, ok := subject.(GCPSubject)
+	if !ok {
+		return nil, fmt.Errorf("ServiceAccount-level IAM is not yet supported on GCE for %T", subject)
+	}
+	serviceAccount, ok := subject.ServiceAccount()
+	if !ok {
+		return nil, fmt.Errorf("role %v does not have ServiceAccount", subject)
+	}
+
+	credentialConfig := map[string]any{
+		"type":                              "external_account",
+		"audience":                          context.GCPWorkloadIdentityProvider(),
+		"subject_token_type":                "urn:ietf:params:oauth:token-type:jwt",
+		"token_url":                         "https://sts.googleapis.com/v1/token",
+		"service_account_impersonation_url": "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/" + context.GCPServiceAccountEmail(gcpSubject) + ":generateAccessToken",
+		"credential_source": map[string]any{
+			"file": gcpTokenDir + gcpTokenName,
+		},
+	}
+	b, err := json.MarshalIndent(credentialConfig, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("building credential configuration: %w", err)
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GCPCredentialConfigMapName(serviceAccount.Name),
+			Namespace: serviceAccount.Namespace,
+		},
+		Data: map[string]string{
+			gcpCredentialFileName: string(b),
+		},
+	}, nil
+}
+
+func addServiceAccountRoleForGCE(context *IAMModelContext, podSpec *corev1.PodSpec, serviceAccountRole Subject) error {
+	serviceAccount, ok := serviceAccountRole.ServiceAccount()
+	if !ok {
+		return fmt.Errorf("role %v does not have ServiceAccount", serviceAccountRole)
+	}
+	if _, ok := serviceAccountRole.(GCPSubject); !ok {
+		return fmt.Errorf("ServiceAccount-level IAM is not yet supported on GCE for %T", serviceAccountRole)
+	}
+
+	volume := corev1.Volume{
+		Name: "token-iam-googleapis-com",
+	}
+
+	mode := int32(0o644)
+	expiration := int64(3600)
+	volume.Projected = &corev1.ProjectedVolumeSource{
+		DefaultMode: &mode,
+		Sources: []corev1.VolumeProjection{
+			{
+				ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+					Audience:          context.GCPWorkloadIdentityProvider(),
+					ExpirationSeconds: &expiration,
+					Path:              gcpTokenName,
+				},
+			},
+			{
+				ConfigMap: &corev1.ConfigMapProjection{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: GCPCredentialConfigMapName(serviceAccount.Name),
+					},
+					Items: []corev1.KeyToPath{
+						{
+							Key:  gcpCredentialFileName,
+							Path: gcpCredentialFileName,
+						},
+					},
+				},
+			},
+		},
+	}
+	podSpec.Volumes = append(podSpec.Volumes, volume)
+
+	containers := podSpec.Containers
+	for k, container := range containers {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			MountPath: gcpTokenDir,
+			Name:      volume.Name,
+			ReadOnly:  true,
+		})
+
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  "GOOGLE_APPLICATION_CREDENTIALS",
+			Value: gcpTokenDir + gcpCredentialFileName,
+		})
+		containers[k] = container
+	}
+
+	// Set securityContext.fsGroup to enable file to be read
+	if podSpec.SecurityContext == nil {
+		podSpec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	if podSpec.SecurityContext.FSGroup == nil {
+		fsGroup := int64(wellknownusers.Generic)
+		podSpec.SecurityContext.FSGroup = &fsGroup
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"encoding/json"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+type testGCPSubject struct{}
+
+func (s *testGCPSubject) BuildAWSPolicy(*PolicyBuilder) (*Policy, error) {
+	return nil, nil
+}
+
+func (s *testGCPSubject) ServiceAccount() (types.NamespacedName, bool) {
+	return types.NamespacedName{Namespace: "kube-system", Name: "dns-controller"}, true
+}
+
+func (s *testGCPSubject) GCPServiceAccountName() string {
+	return "dns-controller"
+}
+
+func (s *testGCPSubject) GCPRoles(context *IAMModelContext) []string {
+	return []string{"roles/dns.admin"}
+}
+
+func TestAddServiceAccountRoleForGCE(t *testing.T) {
+	context := &IAMModelContext{
+		GCPProjectNumber: "123456789012",
+		Cluster: &kops.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "minimal.example.com"},
+			Spec: kops.ClusterSpec{
+				CloudProvider: kops.CloudProviderSpec{
+					GCE: &kops.GCESpec{Project: "testproject"},
+				},
+			},
+		},
+	}
+	subject := &testGCPSubject{}
+
+	podSpec := &corev1.PodSpec{
+		Containers: []corev1.Container{{Name: "dns-controller"}},
+	}
+	if err := AddServiceAccountRole(context, podSpec, subject); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedAudience := "//iam.googleapis.com/projects/123456789012/locations/global/workloadIdentityPools/minimal-example-com/providers/kubernetes"
+	if len(podSpec.Volumes) != 1 || podSpec.Volumes[0].Projected == nil {
+		t.Fatalf("expected a projected volume, got %v", podSpec.Volumes)
+	}
+	sources := podSpec.Volumes[0].Projected.Sources
+	if len(sources) != 2 || sources[0].ServiceAccountToken == nil || sources[1].ConfigMap == nil {
+		t.Fatalf("expected token and ConfigMap sources, got %v", sources)
+	}
+	if sources[0].ServiceAccountToken.Audience != expectedAudience {
+		t.Errorf("expected audience %q, got %q", expectedAudience, sources[0].ServiceAccountToken.Audience)
+	}
+	if sources[1].ConfigMap.Name != "dns-controller-gcp-credentials" {
+		t.Errorf("unexpected ConfigMap %q", sources[1].ConfigMap.Name)
+	}
+	env := podSpec.Containers[0].Env
+	if len(env) != 1 || env[0].Name != "GOOGLE_APPLICATION_CREDENTIALS" || env[0].Value != "/var/run/secrets/iam.googleapis.com/credential-configuration.json" {
+		t.Errorf("unexpected env %v", env)
+	}
+
+	configMap, err := BuildGCPCredentialConfigMap(context, subject)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if configMap.Namespace != "kube-system" || configMap.Name != "dns-controller-gcp-credentials" {
+		t.Errorf("unexpected ConfigMap %s/%s", configMap.Namespace, configMap.Name)
+	}
+	credentialConfig := map[string]any{}
+	if err := json.Unmarshal([]byte(configMap.Data["credential-configuration.json"]), &credentialConfig); err != nil {
+		t.Fatalf("failed to parse credential configuration: %v", err)
+	}
+	if credentialConfig["audience"] != expectedAudience {
+		t.Errorf("expected audience %q, got %q", expectedAudience, credentialConfig["audience"])
+	}
+	expectedImpersonationURL := "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/dns-controller-minimal--rabo9p@testproject.iam.gserviceaccount.com:generateAccessToken"
+	if credentialConfig["service_account_impersonation_url"] != expectedImpersonationURL {
+		t.Errorf("expected impersonation URL %q, got %q", expectedImpersonationURL, credentialConfig["service_account_impersonation_url"])
+	}
+}
@@ -375,9 +375,10 @@ func (r *NodeRoleAPIServer) BuildAWSPolicy(b *PolicyBuilder) (*Policy, error) {
 	}
 
 	// The API server role may host a kms-plugin sidecar wired to the instance role
-	// when EncryptionConfig is enabled; bypass kms:ViaService so that direct KMS
-	// calls from kube-apiserver are not denied.
-	addKMSIAMPolicies(p, fi.ValueOf(b.Cluster.Spec.EncryptionConfig))
+	// when EncryptionConfig is enabled, or the kOps-managed KMS plugin when
+	// KMSEncryption is set; bypass kms:ViaService so that direct KMS calls from
+	// the plugin are not denied.
+	addKMSIAMPolicies(p, b.usesDirectKMSAccess())
 
 	if b.Cluster.Spec.IAM != nil && b.Cluster.Spec.IAM.AllowContainerRegistry {
 		addECRPermissions(p)
@@ -419,14 +420,22 @@ func (r *NodeRoleMaster) BuildAWSPolicy(b *PolicyBuilder) (*Policy, error) {
 		addKopsControllerIPAMPermissions(p)
 	}
 
+	if model.UseInstanceGroupScaleAPI(b.Cluster) {
+		addKopsControllerScalingPermissions(p)
+	}
+
+	if b.Cluster.Spec.CloudProvider.AWS.GracefulNodeDrain.IsEnabled() {
+		addKopsControllerNodeDrainPermissions(p)
+	}
+
 	if err := b.AddS3Permissions(p); err != nil {
 		return nil, fmt.Errorf("failed to generate AWS IAM S3 access statements: %v", err)
 	}
 
 	// The combined master role hosts kube-apiserver, so a user-supplied kms-plugin
-	// for EncryptionConfig will call KMS directly from this role. Bypass ViaService
-	// in that case.
-	addKMSIAMPolicies(p, fi.ValueOf(b.Cluster.Spec.EncryptionConfig))
+	// for EncryptionConfig, or the kOps-managed KMS plugin, will call KMS directly
+	// from this role. Bypass ViaService in that case.
+	addKMSIAMPolicies(p, b.usesDirectKMSAccess())
 
 	// Protokube needs dns-controller permissions in instance role even if UseServiceAccountExternalPermissions.
 	AddDNSControllerPermissions(b, p)
@@ -440,7 +449,10 @@ func (r *NodeRoleMaster) BuildAWSPolicy(b *PolicyBuilder) (*Policy, error) {
 		nlbSecurityGroupMode := b.Cluster.Spec.CloudProvider.AWS.NLBSecurityGroupMode
 		nlbSecurityGroupModeManaged := nlbSecurityGroupMode != nil && *nlbSecurityGroupMode == "Managed"
 
-		AddCCMPermissions(p, b.Cluster.Spec.Networking.Kubenet != nil, nlbSecurityGroupModeManaged)
+		// The cloud controller manager can use its own role even if other addons use the instance role.
+		if ccm := b.Cluster.Spec.ExternalCloudControllerManager; ccm == nil || !fi.ValueOf(ccm.UseServiceAccountExternalPermissions) {
+			AddCCMPermissions(p, b.Cluster.Spec.Networking.Kubenet != nil, nlbSecurityGroupModeManaged)
+		}
 
 		if c := b.Cluster.Spec.CloudProvider.AWS.LoadBalancerController; c != nil && fi.ValueOf(b.Cluster.Spec.CloudProvider.AWS.LoadBalancerController.Enabled) {
 			AddAWSLoadbalancerControllerPermissions(p, c.EnableWAF, c.EnableWAFv2, c.EnableShield)
@@ -715,6 +727,10 @@ func WriteableVFSPaths(cluster *kops.Cluster, role Subject) ([]vfs.Path, error)
 			if c.Backups == nil || c.Backups.BackupStore == "" || backupStores.Has(c.Backups.BackupStore) {
 				continue
 			}
+			// Backup stores with their own S3 configuration are accessed with the etcdbackupcredentials secret
+			if c.Backups.S3 != nil {
+				continue
+			}
 			backupStore := c.Backups.BackupStore
 
 			vfsPath, err := vfs.Context.BuildVfsPath(backupStore)
@@ -883,6 +899,26 @@ func addKopsControllerIPAMPermissions(p *Policy) {
 	)
 }
 
+// addKopsControllerScalingPermissions adds the permissions kops-controller needs to scale instance groups.
+func addKopsControllerScalingPermissions(p *Policy) {
+	p.clusterTaggedAction.Insert(
+		"autoscaling:SetDesiredCapacity",
+	)
+	p.unconditionalAction.Insert(
+		"autoscaling:DescribeAutoScalingGroups",
+	)
+}
+
+func addKopsControllerNodeDrainPermissions(p *Policy) {
+	p.clusterTaggedAction.Insert(
+		"autoscaling:CompleteLifecycleAction",
+	)
+	p.unconditionalAction.Insert(
+		"autoscaling:DescribeAutoScalingGroups",
+		"autoscaling:DescribeLifecycleHooks",
+	)
+}
+
 func addEtcdManagerPermissions(p *Policy) {
 	p.unconditionalAction.Insert(
 		"ec2:DescribeInstances",
@@ -1420,6 +1456,12 @@ func AddKubeRouterPermissions(b *PolicyBuilder, p *Policy) {
 	)
 }
 
+// usesDirectKMSAccess reports whether a KMS plugin on the control plane calls KMS with the instance role.
+func (b *PolicyBuilder) usesDirectKMSAccess() bool {
+	kms := b.Cluster.Spec.KMSEncryption
+	return fi.ValueOf(b.Cluster.Spec.EncryptionConfig) || (kms != nil && kms.Provider == kops.KMSEncryptionProviderAWS)
+}
+
 func addKMSIAMPolicies(p *Policy, bypassViaService bool) {
 	// kms:CreateGrant is called directly by the EBS CSI driver pod (no AWS
 	// service makes the call on its behalf), so kms:ViaService is not set and
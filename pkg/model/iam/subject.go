@@ -112,6 +112,8 @@ func AddServiceAccountRole(context *IAMModelContext, podSpec *corev1.PodSpec, se
 	switch cloudProvider {
 	case kops.CloudProviderAWS:
 		return addServiceAccountRoleForAWS(context, podSpec, serviceAccountRole)
+	case kops.CloudProviderGCE:
+		return addServiceAccountRoleForGCE(context, podSpec, serviceAccountRole)
 	default:
 		return fmt.Errorf("ServiceAccount-level IAM is not yet supported on cloud %T", cloudProvider)
 	}
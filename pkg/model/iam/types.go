@@ -43,6 +43,9 @@ type IAMModelContext struct {
 	// AWSPartition defines the partition of the AWS account, typically "aws", "aws-cn", or "aws-us-gov"
 	AWSPartition string
 
+	// GCPProjectNumber holds the number of the GCP project, when running on GCE with workload identity federation
+	GCPProjectNumber string
+
 	// Cluster holds the cluster we are working with.
 	Cluster *kops.Cluster
 }
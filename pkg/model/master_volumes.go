@@ -51,6 +51,7 @@ const (
 	DefaultAWSEtcdVolumeGp3Throughput = 125
 	DefaultAZUREEtcdVolumeType        = "StandardSSD_LRS"
 	DefaultGCEEtcdVolumeType          = "pd-ssd"
+	DefaultScalewayEtcdVolumeIops     = 5000
 )
 
 // MasterVolumeBuilder builds master EBS volumes
@@ -274,6 +275,10 @@ func (b *MasterVolumeBuilder) addGCEVolume(c *fi.CloudupModelBuilderContext, pre
 
 	// The tags are how protokube knows to mount the volume and use it for etcd
 	tags := make(map[string]string)
+	// Apply all user defined labels on the volumes
+	for k, v := range b.Cluster.Spec.CloudLabels {
+		tags[k] = v
+	}
 	tags[clusterLabel.Key] = clusterLabel.Value
 	tags[gce.GceLabelNameRolePrefix+"master"] = "master" // Can't start with a number
 	tags[gce.GceLabelNameEtcdClusterPrefix+etcd.Name] = gce.EncodeGCELabel(clusterSpec)
@@ -427,7 +432,11 @@ func (b *MasterVolumeBuilder) addScalewayVolume(c *fi.CloudupModelBuilderContext
 		Size:      new(int64(volumeSize) * 1e9),
 		Zone:      &zone,
 		Tags:      volumeTags,
-		Type:      new(string(instance.VolumeVolumeTypeBSSD)),
+		Type:      new(string(instance.VolumeVolumeTypeSbsVolume)),
+		IOPS:      new(int32(DefaultScalewayEtcdVolumeIops)),
+	}
+	if m.VolumeIOPS != nil {
+		t.IOPS = m.VolumeIOPS
 	}
 	c.AddTask(t)
 }
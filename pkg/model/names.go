@@ -53,6 +53,17 @@ func (b *KopsModelContext) LinkToSecurityGroup(role kops.InstanceGroupRole) *aws
 	return &awstasks.SecurityGroup{Name: &name}
 }
 
+// InstanceGroupSecurityGroupName returns the name of the security group holding the firewall rules of an instance group
+func (b *KopsModelContext) InstanceGroupSecurityGroupName(ig *kops.InstanceGroup) string {
+	return ig.ObjectMeta.Name + ".firewall." + b.ClusterName()
+}
+
+// LinkToInstanceGroupSecurityGroup creates a task link to the security group holding the firewall rules of an instance group
+func (b *KopsModelContext) LinkToInstanceGroupSecurityGroup(ig *kops.InstanceGroup) *awstasks.SecurityGroup {
+	name := b.InstanceGroupSecurityGroupName(ig)
+	return &awstasks.SecurityGroup{Name: &name}
+}
+
 // AutoscalingGroupName derives the autoscaling group name for us
 func (b *KopsModelContext) AutoscalingGroupName(ig *kops.InstanceGroup) string {
 	switch ig.Spec.Role {
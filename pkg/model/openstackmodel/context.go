@@ -78,6 +78,15 @@ func (c *OpenstackModelContext) APIResourceName() string {
 	return "api." + c.ClusterName()
 }
 
+// APILoadBalancerAllowedCIDRs returns the CIDRs allowed to reach the Kubernetes API through the load balancer
+func (c *OpenstackModelContext) APILoadBalancerAllowedCIDRs() []string {
+	lbSpec := c.Cluster.Spec.CloudProvider.Openstack.Loadbalancer
+	if lbSpec != nil && len(lbSpec.APIAllowedCIDRs) > 0 {
+		return lbSpec.APIAllowedCIDRs
+	}
+	return c.Cluster.Spec.API.Access
+}
+
 func (c *OpenstackModelContext) findSubnetClusterSpec(subnet string) (string, kops.SubnetType, error) {
 	for _, sp := range c.Cluster.Spec.Networking.Subnets {
 		if sp.Name == subnet {
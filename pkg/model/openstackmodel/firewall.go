@@ -289,7 +289,7 @@ func (b *FirewallModelBuilder) addHTTPSRules(c *fi.CloudupModelBuilderContext, s
 	if b.UseLoadBalancerForAPI() {
 		if !useVIPACL {
 			// Allow API Access to the lb sg
-			for _, apiAccess := range b.Cluster.Spec.API.Access {
+			for _, apiAccess := range b.APILoadBalancerAllowedCIDRs() {
 				etherType := IPV4
 				if !net.IsIPv4CIDRString(apiAccess) {
 					etherType = IPV6
@@ -311,7 +311,7 @@ func (b *FirewallModelBuilder) addHTTPSRules(c *fi.CloudupModelBuilderContext, s
 		// FIXME: Octavia port traffic appears to be denied though its port is in lbSG
 		if b.usesOctavia() {
 			if b.getOctaviaProvider() == "ovn" {
-				for _, apiAccess := range b.Cluster.Spec.API.Access {
+				for _, apiAccess := range b.APILoadBalancerAllowedCIDRs() {
 					etherType := IPV4
 					if !net.IsIPv4CIDRString(apiAccess) {
 						etherType = IPV6
@@ -627,6 +627,39 @@ func (b *FirewallModelBuilder) addProtokubeRules(c *fi.CloudupModelBuilderContex
 	return nil
 }
 
+// addInstanceGroupRules - Add the firewall rules declared by the instance groups
+func (b *FirewallModelBuilder) addInstanceGroupRules(c *fi.CloudupModelBuilderContext, sgMap map[string]*openstacktasks.SecurityGroup) {
+	for _, ig := range b.InstanceGroups {
+		igSG := sgMap[b.InstanceGroupSecurityGroupName(ig)]
+		if igSG == nil {
+			continue
+		}
+		for _, rule := range ig.Spec.FirewallRules {
+			direction := rules.DirIngress
+			if rule.IsEgress() {
+				direction = rules.DirEgress
+			}
+			fromPort, toPort := rule.PortRange()
+			for _, cidr := range rule.CIDRs {
+				etherType := IPV4
+				if !net.IsIPv4CIDRString(cidr) {
+					etherType = IPV6
+				}
+				t := &openstacktasks.SecurityGroupRule{
+					Lifecycle:      b.Lifecycle,
+					Direction:      s(string(direction)),
+					Protocol:       s(rule.Protocol),
+					EtherType:      s(etherType),
+					PortRangeMin:   i(int(fromPort)),
+					PortRangeMax:   i(int(toPort)),
+					RemoteIPPrefix: s(cidr),
+				}
+				b.addDirectionalGroupRule(c, igSG, nil, t)
+			}
+		}
+	}
+}
+
 func (b *FirewallModelBuilder) getExistingRules(sgMap map[string]*openstacktasks.SecurityGroup) error {
 	osCloud, err := b.createCloud()
 	if err != nil {
@@ -748,6 +781,21 @@ func (b *FirewallModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 		sgMap[groupName] = sg
 	}
 
+	// Create Security Groups for the firewall rules of the instance groups
+	for _, ig := range b.InstanceGroups {
+		if len(ig.Spec.FirewallRules) == 0 {
+			continue
+		}
+		groupName := b.InstanceGroupSecurityGroupName(ig)
+		sg := &openstacktasks.SecurityGroup{
+			Name:        s(groupName),
+			Lifecycle:   b.Lifecycle,
+			RemoveGroup: false,
+		}
+		c.AddTask(sg)
+		sgMap[groupName] = sg
+	}
+
 	b.Rules = make(map[string]*openstacktasks.SecurityGroupRule)
 
 	err := b.getExistingRules(sgMap)
@@ -786,6 +834,8 @@ func (b *FirewallModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 	if err != nil {
 		return fmt.Errorf("failed to add node port rules: %v", err)
 	}
+	// Add Instance Group Rules
+	b.addInstanceGroupRules(c, sgMap)
 
 	for _, r := range b.Rules {
 		c.AddTask(r)
@@ -133,6 +133,9 @@ func (b *ServerGroupModelBuilder) buildInstances(c *fi.CloudupModelBuilderContex
 	var securityGroups []*openstacktasks.SecurityGroup
 	securityGroupName := b.SecurityGroupName(ig.Spec.Role)
 	securityGroups = append(securityGroups, b.LinkToSecurityGroup(securityGroupName))
+	if len(ig.Spec.FirewallRules) != 0 {
+		securityGroups = append(securityGroups, b.LinkToSecurityGroup(b.InstanceGroupSecurityGroupName(ig)))
+	}
 
 	if b.Cluster.Spec.CloudProvider.Openstack.Loadbalancer == nil && ig.Spec.Role.HasControlPlane() {
 		securityGroups = append(securityGroups, b.LinkToSecurityGroup(b.APIResourceName()))
@@ -322,6 +325,9 @@ func (b *ServerGroupModelBuilder) Build(c *fi.CloudupModelBuilderContext) error
 		if b.Cluster.Spec.CloudProvider.Openstack.Loadbalancer.FlavorID != nil {
 			lbTask.FlavorID = b.Cluster.Spec.CloudProvider.Openstack.Loadbalancer.FlavorID
 		}
+		if b.Cluster.Spec.CloudProvider.Openstack.Loadbalancer.Provider != nil {
+			lbTask.Provider = b.Cluster.Spec.CloudProvider.Openstack.Loadbalancer.Provider
+		}
 
 		useVIPACL := b.UseVIPACL()
 		if !useVIPACL {
@@ -356,7 +362,7 @@ func (b *ServerGroupModelBuilder) Build(c *fi.CloudupModelBuilderContext) error
 		if useVIPACL {
 			var AllowedCIDRs []string
 			// currently kOps openstack supports only ipv4 addresses
-			for _, CIDR := range b.Cluster.Spec.API.Access {
+			for _, CIDR := range b.APILoadBalancerAllowedCIDRs() {
 				if net.IsIPv4CIDRString(CIDR) {
 					AllowedCIDRs = append(AllowedCIDRs, CIDR)
 				}
@@ -57,6 +57,10 @@ NODEUP_HASH_ARM64={{ NodeUpSourceHashArm64 }}
 {{ ProxyEnv }}
 
 {{ SetSysctls }}
+{{- if PrivateNetworkSetup }}
+
+{{ PrivateNetworkSetup }}
+{{- end }}
 
 function ensure-install-dir() {
   INSTALL_DIR="/opt/kops"
@@ -164,6 +168,7 @@ type NodeUpScript struct {
 	BootConfig           *nodeup.BootConfig
 	CompressUserData     bool
 	SetSysctls           string
+	PrivateNetworkSetup  string
 	CloudProvider        string
 	ProxyEnv             func() (string, error)
 	EnvironmentVariables func() (string, error)
@@ -230,6 +235,10 @@ func (b *NodeUpScript) Build() (fi.Resource, error) {
 			return b.SetSysctls
 		},
 
+		"PrivateNetworkSetup": func() string {
+			return b.PrivateNetworkSetup
+		},
+
 		"ProxyEnv":             b.ProxyEnv,
 		"EnvironmentVariables": b.EnvironmentVariables,
 		"CopyCheckUrlBlock":    b.copyCheckUrlBlock,
@@ -567,3 +576,35 @@ func (s *NodeUpScript) WithSysctls() {
 
 	s.SetSysctls = b.String()
 }
+
+// WithHetznerPrivateNetworking routes the traffic of servers without public IPs through the gateway of the network,
+// and configures the Hetzner Cloud resolvers, so that nodeup can be downloaded.
+func (s *NodeUpScript) WithHetznerPrivateNetworking(gateway string) {
+	var b bytes.Buffer
+
+	b.WriteString("cat > /etc/systemd/system/kops-default-route.service <<EOF\n")
+	b.WriteString("[Unit]\n")
+	b.WriteString("Description=Route the traffic through the gateway of the private network\n")
+	b.WriteString("After=network-online.target\n")
+	b.WriteString("Wants=network-online.target\n")
+	b.WriteString("\n")
+	b.WriteString("[Service]\n")
+	b.WriteString("Type=oneshot\n")
+	b.WriteString("RemainAfterExit=yes\n")
+	b.WriteString("ExecStart=/bin/sh -c 'until ip route replace default via " + gateway + "; do sleep 1; done'\n")
+	b.WriteString("\n")
+	b.WriteString("[Install]\n")
+	b.WriteString("WantedBy=multi-user.target\n")
+	b.WriteString("EOF\n")
+	b.WriteString("systemctl daemon-reload\n")
+	b.WriteString("systemctl enable --now kops-default-route.service\n")
+
+	b.WriteString("mkdir -p /etc/systemd/resolved.conf.d\n")
+	b.WriteString("cat > /etc/systemd/resolved.conf.d/kops-hetzner.conf <<EOF\n")
+	b.WriteString("[Resolve]\n")
+	b.WriteString("DNS=185.12.64.1 185.12.64.2\n")
+	b.WriteString("EOF\n")
+	b.WriteString("systemctl restart systemd-resolved || true\n")
+
+	s.PrivateNetworkSetup = b.String()
+}
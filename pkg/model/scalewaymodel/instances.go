@@ -45,9 +45,13 @@ var _ fi.CloudupModelBuilder = &InstanceModelBuilder{}
 func (b *InstanceModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 	for _, ig := range b.InstanceGroups {
 		name := ig.Name
-		zone, err := scw.ParseZone(ig.Spec.Subnets[0])
-		if err != nil {
-			return fmt.Errorf("error building instance task for %q: %w", name, err)
+		var zones []string
+		for _, subnet := range ig.Spec.Subnets {
+			zone, err := scw.ParseZone(subnet)
+			if err != nil {
+				return fmt.Errorf("error building instance task for %q: %w", name, err)
+			}
+			zones = append(zones, zone.String())
 		}
 
 		userData, err := b.BootstrapScriptBuilder.ResourceNodeUp(c, ig)
@@ -67,7 +71,7 @@ func (b *InstanceModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 			Count:          int(fi.ValueOf(ig.Spec.MinSize)),
 			Name:           new(name),
 			Lifecycle:      b.Lifecycle,
-			Zone:           new(string(zone)),
+			Zones:          zones,
 			CommercialType: new(ig.Spec.MachineType),
 			Image:          new(ig.Spec.Image),
 			UserData:       &userData,
@@ -19,6 +19,7 @@ package aws
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -46,11 +47,16 @@ const (
 	KarpenterNodeLabel = "karpenter.sh/"
 )
 
+var _ nodeidentity.BatchIdentifier = &nodeIdentifier{}
+
 // nodeIdentifier identifies a node from EC2
 type nodeIdentifier struct {
 	// client is the ec2 interface
 	ec2Client ec2.DescribeInstancesAPIClient
 
+	// region is the region of the ec2 client, and so of the instances we identify
+	region string
+
 	// cache is a cache of nodeidentity.Info
 	cache expirationcache.Store
 	// cacheEnabled indicates if caching should be used
@@ -76,6 +82,7 @@ func New(ctx context.Context, cacheNodeidentityInfo bool) (nodeidentity.Identifi
 
 	return &nodeIdentifier{
 		ec2Client:    ec2Client,
+		region:       regionResp.Region,
 		cache:        expirationcache.NewTTLStore(stringKeyFunc, cacheTTL),
 		cacheEnabled: cacheNodeidentityInfo,
 	}, nil
@@ -89,25 +96,14 @@ func stringKeyFunc(obj interface{}) (string, error) {
 
 // IdentifyNode queries AWS for the node identity information
 func (i *nodeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*nodeidentity.Info, error) {
-	providerID := node.Spec.ProviderID
-	if providerID == "" {
-		return nil, fmt.Errorf("providerID was not set for node %s", node.Name)
-	}
-	if !strings.HasPrefix(providerID, "aws://") {
-		return nil, fmt.Errorf("providerID %q not recognized for node %s", providerID, node.Name)
-	}
-
-	tokens := strings.Split(strings.TrimPrefix(providerID, "aws://"), "/")
-	if len(tokens) != 3 {
-		return nil, fmt.Errorf("providerID %q not recognized for node %s", providerID, node.Name)
+	instanceID, err := instanceIDFromProviderID(node)
+	if err != nil {
+		return nil, err
 	}
 
-	// zone := tokens[1]
-	instanceID := tokens[2]
-
 	// If caching is enabled try pulling nodeidentity.Info from cache before
 	// doing a EC2 API call.
-	if i.cacheEnabled {
+	if i.cacheEnabled && !nodeidentity.CacheBypassed(ctx) {
 		obj, exists, err := i.cache.GetByKey(instanceID)
 		if err != nil {
 			klog.Warningf("Nodeidentity info cache lookup failure: %v", err)
@@ -131,14 +127,92 @@ func (i *nodeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*
 		return nil, fmt.Errorf("found instance %q, but state is %q", instanceID, instanceState)
 	}
 
+	return i.buildInfo(instance), nil
+}
+
+// describeInstancesBatchSize is the number of instance IDs we filter on in each DescribeInstances request.
+const describeInstancesBatchSize = 200
+
+// IdentifyNodes queries AWS for the node identity information of many nodes, batching the requests.
+func (i *nodeIdentifier) IdentifyNodes(ctx context.Context, nodes []*corev1.Node) (map[string]*nodeidentity.Info, error) {
+	nodeNames := make(map[string]string)
+	var instanceIDs []string
+	for _, node := range nodes {
+		instanceID, err := instanceIDFromProviderID(node)
+		if err != nil {
+			klog.Warningf("skipping node: %v", err)
+			continue
+		}
+		nodeNames[instanceID] = node.Name
+		instanceIDs = append(instanceIDs, instanceID)
+	}
+
+	infos := make(map[string]*nodeidentity.Info)
+	for batch := range slices.Chunk(instanceIDs, describeInstancesBatchSize) {
+		// We filter by instance ID rather than asking for the instances, so that instances that have gone away don't fail the whole batch
+		paginator := ec2.NewDescribeInstancesPaginator(i.ec2Client, &ec2.DescribeInstancesInput{
+			Filters: []ec2types.Filter{
+				{Name: aws.String("instance-id"), Values: batch},
+				{Name: aws.String("instance-state-name"), Values: []string{string(ec2types.InstanceStateNameRunning), string(ec2types.InstanceStateNamePending)}},
+			},
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error from ec2 DescribeInstances request: %v", err)
+			}
+			for _, reservation := range page.Reservations {
+				for j := range reservation.Instances {
+					info := i.buildInfo(&reservation.Instances[j])
+					if nodeName, found := nodeNames[info.InstanceID]; found {
+						infos[nodeName] = info
+					}
+				}
+			}
+		}
+	}
+	return infos, nil
+}
+
+// instanceIDFromProviderID returns the ID of the instance of the node.
+func instanceIDFromProviderID(node *corev1.Node) (string, error) {
+	providerID := node.Spec.ProviderID
+	if providerID == "" {
+		return "", fmt.Errorf("providerID was not set for node %s", node.Name)
+	}
+	if !strings.HasPrefix(providerID, "aws://") {
+		return "", fmt.Errorf("providerID %q not recognized for node %s", providerID, node.Name)
+	}
+
+	tokens := strings.Split(strings.TrimPrefix(providerID, "aws://"), "/")
+	if len(tokens) != 3 {
+		return "", fmt.Errorf("providerID %q not recognized for node %s", providerID, node.Name)
+	}
+
+	// zone := tokens[1]
+	return tokens[2], nil
+}
+
+// buildInfo builds the nodeidentity.Info of an instance, adding it to the cache if caching is enabled.
+func (i *nodeIdentifier) buildInfo(instance *ec2types.Instance) *nodeidentity.Info {
 	labels := map[string]string{}
 	if len(instance.InstanceLifecycle) > 0 {
 		labels[fmt.Sprintf("node-role.kubernetes.io/%s-worker", instance.InstanceLifecycle)] = "true"
 	}
 
 	info := &nodeidentity.Info{
-		InstanceID: instanceID,
+		InstanceID: aws.ToString(instance.InstanceId),
 		Labels:     labels,
+		Region:     i.region,
+		Lifecycle:  nodeidentity.LifecycleOnDemand,
+		CloudTags:  make(map[string]string),
+	}
+	if instance.InstanceLifecycle == ec2types.InstanceLifecycleTypeSpot {
+		info.Lifecycle = nodeidentity.LifecycleSpot
+	}
+	if instance.Placement != nil {
+		info.Zone = aws.ToString(instance.Placement.AvailabilityZone)
+		info.PlacementGroup = aws.ToString(instance.Placement.GroupName)
 	}
 
 	for _, tag := range instance.Tags {
@@ -146,17 +220,17 @@ func (i *nodeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*
 		if strings.HasPrefix(key, ClusterAutoscalerNodeTemplateLabel) {
 			info.Labels[strings.TrimPrefix(aws.ToString(tag.Key), ClusterAutoscalerNodeTemplateLabel)] = aws.ToString(tag.Value)
 		}
+		info.CloudTags[key] = aws.ToString(tag.Value)
 	}
 
 	// If caching is enabled add the nodeidentity.Info to cache.
 	if i.cacheEnabled {
-		err = i.cache.Add(info)
-		if err != nil {
+		if err := i.cache.Add(info); err != nil {
 			klog.Warningf("Failed to add node identity info to cache: %v", err)
 		}
 	}
 
-	return info, nil
+	return info
 }
 
 // getInstance queries EC2 for the instance with the specified ID, returning an error if not found
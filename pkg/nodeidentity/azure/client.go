@@ -24,6 +24,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/azure/azuremetadata"
 )
 
@@ -67,7 +68,17 @@ func newClient() (*client, error) {
 	}, nil
 }
 
-func (c *client) getVMTags(ctx context.Context, providerID string) (map[string]*string, error) {
+// vm holds the properties of a VM or VMSS VM that we use to identify nodes.
+type vm struct {
+	tags     map[string]*string
+	location string
+	zones    []*string
+	// priority is only known for standalone VMs; it is set on the VM ScaleSet for VMSS VMs.
+	priority                *compute.VirtualMachinePriorityTypes
+	proximityPlacementGroup *compute.SubResource
+}
+
+func (c *client) getVM(ctx context.Context, providerID string) (*vm, error) {
 	if !strings.HasPrefix(providerID, "azure://") {
 		return nil, fmt.Errorf("unknown providerID : %s", providerID)
 	}
@@ -83,13 +94,26 @@ func (c *client) getVMTags(ctx context.Context, providerID string) (map[string]*
 		if err != nil {
 			return nil, fmt.Errorf("getting VM: %w", err)
 		}
-		return resp.VirtualMachine.Tags, nil
+		v := &vm{
+			tags:     resp.VirtualMachine.Tags,
+			location: fi.ValueOf(resp.VirtualMachine.Location),
+			zones:    resp.VirtualMachine.Zones,
+		}
+		if props := resp.VirtualMachine.Properties; props != nil {
+			v.priority = props.Priority
+			v.proximityPlacementGroup = props.ProximityPlacementGroup
+		}
+		return v, nil
 	case "Microsoft.Compute/virtualMachineScaleSets/virtualMachines":
 		resp, err := c.vmssClient.Get(ctx, res.ResourceGroupName, res.Parent.Name, res.Name, nil)
 		if err != nil {
 			return nil, fmt.Errorf("getting VMSS VM: %w", err)
 		}
-		return resp.VirtualMachineScaleSetVM.Tags, nil
+		return &vm{
+			tags:     resp.VirtualMachineScaleSetVM.Tags,
+			location: fi.ValueOf(resp.VirtualMachineScaleSetVM.Location),
+			zones:    resp.VirtualMachineScaleSetVM.Zones,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unsupported resource type %q for %q", res.ResourceType, providerID)
 	}
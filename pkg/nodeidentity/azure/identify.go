@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
 	corev1 "k8s.io/api/core/v1"
 	expirationcache "k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
@@ -83,7 +84,7 @@ func (i *nodeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*
 	}
 
 	// If caching is enabled, try pulling nodeidentity.Info from the cache before doing an API call.
-	if i.cacheEnabled {
+	if i.cacheEnabled && !nodeidentity.CacheBypassed(ctx) {
 		obj, exists, err := i.cache.GetByKey(vmName)
 		if err != nil {
 			klog.Warningf("Nodeidentity info cache lookup failure: %v", err)
@@ -93,10 +94,11 @@ func (i *nodeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*
 		}
 	}
 
-	tags, err := i.azureClient.getVMTags(ctx, providerID)
+	vm, err := i.azureClient.getVM(ctx, providerID)
 	if err != nil {
 		return nil, fmt.Errorf("error on getting VM ScaleSet: %s", err)
 	}
+	tags := vm.tags
 
 	labels := map[string]string{}
 	for k, v := range tags {
@@ -132,6 +134,29 @@ func (i *nodeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*
 	info := &nodeidentity.Info{
 		InstanceID: vmName,
 		Labels:     labels,
+		Region:     vm.location,
+		CloudTags:  make(map[string]string),
+	}
+	if len(vm.zones) != 0 && vm.zones[0] != nil {
+		// Use the same zone format as the Azure cloud provider, e.g. eastus-1
+		info.Zone = vm.location + "-" + *vm.zones[0]
+	}
+	if vm.priority != nil {
+		if *vm.priority == compute.VirtualMachinePriorityTypesSpot || *vm.priority == compute.VirtualMachinePriorityTypesLow {
+			info.Lifecycle = nodeidentity.LifecycleSpot
+		} else {
+			info.Lifecycle = nodeidentity.LifecycleOnDemand
+		}
+	}
+	if vm.proximityPlacementGroup != nil && vm.proximityPlacementGroup.ID != nil {
+		if res, err := arm.ParseResourceID(*vm.proximityPlacementGroup.ID); err == nil {
+			info.PlacementGroup = res.Name
+		}
+	}
+	for k, v := range tags {
+		if v != nil {
+			info.CloudTags[k] = *v
+		}
 	}
 
 	// If caching is enabled, add the nodeidentity.Info to the cache.
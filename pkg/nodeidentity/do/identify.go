@@ -132,7 +132,7 @@ func (i *nodeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*
 		return nil, errors.New("provider ID number cannot be empty")
 	}
 
-	if i.cacheEnabled {
+	if i.cacheEnabled && !nodeidentity.CacheBypassed(ctx) {
 		if obj, exists, err := i.cache.GetByKey(instanceID); err != nil {
 			klog.Warningf("Nodeidentity info cache lookup failure: %v", err)
 		} else if exists {
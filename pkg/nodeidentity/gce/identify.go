@@ -178,8 +178,18 @@ func (i *nodeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*
 		}
 	}
 
-	info := &nodeidentity.Info{}
+	info := &nodeidentity.Info{
+		Zone:      zone,
+		Lifecycle: nodeidentity.LifecycleOnDemand,
+		CloudTags: instance.Labels,
+	}
 	// info.InstanceID TODO: InstanceID is only used by the provider?
+	if region, err := gce.ZoneToRegion(zone); err == nil {
+		info.Region = region
+	}
+	if scheduling := instance.Scheduling; scheduling != nil && (scheduling.Preemptible || scheduling.ProvisioningModel == "SPOT") {
+		info.Lifecycle = nodeidentity.LifecycleSpot
+	}
 
 	tagToRole := make(map[string]kops.InstanceGroupRole)
 	for _, role := range kops.AllInstanceGroupRoles {
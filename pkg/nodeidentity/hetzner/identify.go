@@ -78,7 +78,7 @@ func (i *nodeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*
 	serverID := strings.TrimPrefix(providerID, "hcloud://")
 
 	// If caching is enabled try pulling nodeidentity.Info from cache before doing a Hetzner Cloud API call.
-	if i.cacheEnabled {
+	if i.cacheEnabled && !nodeidentity.CacheBypassed(ctx) {
 		obj, exists, err := i.cache.GetByKey(serverID)
 		if err != nil {
 			klog.Warningf("Nodeidentity info cache lookup failure: %v", err)
@@ -119,6 +119,11 @@ func (i *nodeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*
 	info := &nodeidentity.Info{
 		InstanceID: serverID,
 		Labels:     labels,
+		CloudTags:  server.Labels,
+	}
+	if server.Datacenter != nil && server.Datacenter.Location != nil {
+		info.Region = string(server.Datacenter.Location.NetworkZone)
+		info.Zone = server.Datacenter.Location.Name
 	}
 
 	// If caching is enabled add the nodeidentity.Info to cache.
@@ -26,7 +26,46 @@ type Identifier interface {
 	IdentifyNode(ctx context.Context, node *corev1.Node) (*Info, error)
 }
 
+// BatchIdentifier is implemented by identifiers that can identify many nodes with a few cloud API calls.
+type BatchIdentifier interface {
+	// IdentifyNodes identifies the nodes from the cloud, bypassing and refreshing any cache.
+	// The result is keyed by node name; nodes that could not be identified are omitted.
+	IdentifyNodes(ctx context.Context, nodes []*corev1.Node) (map[string]*Info, error)
+}
+
+type bypassCacheKey struct{}
+
+// WithoutCache returns a context in which identifiers look up nodes from the cloud instead of their cache.
+// The cache is still refreshed with the result.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+// CacheBypassed returns true if the context was built with WithoutCache.
+func CacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return bypass
+}
+
 type Info struct {
 	InstanceID string
 	Labels     map[string]string
+
+	// Region is the region of the instance, if known
+	Region string
+	// Zone is the zone of the instance, if known
+	Zone string
+	// Lifecycle is LifecycleSpot or LifecycleOnDemand, or empty if unknown
+	Lifecycle string
+	// PlacementGroup is the placement group of the instance, if any
+	PlacementGroup string
+	// CloudTags are the tags (or labels) of the instance in the cloud
+	CloudTags map[string]string
 }
+
+const (
+	// LifecycleSpot is the lifecycle of spot and preemptible instances
+	LifecycleSpot = "spot"
+	// LifecycleOnDemand is the lifecycle of regular instances
+	LifecycleOnDemand = "on-demand"
+)
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeidentity
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+const (
+	// LabelRegion is the node label holding the region of the instance
+	LabelRegion = "node.kops.k8s.io/region"
+	// LabelZone is the node label holding the zone of the instance
+	LabelZone = "node.kops.k8s.io/zone"
+	// LabelLifecycle is the node label holding the lifecycle of the instance, spot or on-demand
+	LabelLifecycle = "node.kops.k8s.io/lifecycle"
+	// LabelPlacementGroup is the node label holding the placement group of the instance
+	LabelPlacementGroup = "node.kops.k8s.io/placement-group"
+)
+
+// metadataLabels maps the supported metadata to their node labels
+var metadataLabels = map[string]string{
+	kops.NodeIdentityMetadataRegion:         LabelRegion,
+	kops.NodeIdentityMetadataZone:           LabelZone,
+	kops.NodeIdentityMetadataLifecycle:      LabelLifecycle,
+	kops.NodeIdentityMetadataPlacementGroup: LabelPlacementGroup,
+}
+
+// LabelOptions configures the node labels built from the cloud metadata of instances
+type LabelOptions struct {
+	// Metadata is the list of instance metadata to label nodes with
+	Metadata []string `json:"metadata,omitempty"`
+	// CloudTags maps cloud tags of instances to node labels
+	CloudTags map[string]string `json:"cloudTags,omitempty"`
+}
+
+// BuildLabels returns the node labels for the instance described by info.
+// Values that are empty or are not valid label values are skipped.
+func (o *LabelOptions) BuildLabels(info *Info) map[string]string {
+	labels := make(map[string]string)
+	if o == nil {
+		return labels
+	}
+
+	for _, metadata := range o.Metadata {
+		var value string
+		switch metadata {
+		case kops.NodeIdentityMetadataRegion:
+			value = info.Region
+		case kops.NodeIdentityMetadataZone:
+			value = info.Zone
+		case kops.NodeIdentityMetadataLifecycle:
+			value = info.Lifecycle
+		case kops.NodeIdentityMetadataPlacementGroup:
+			value = info.PlacementGroup
+		default:
+			klog.Warningf("ignoring unknown node identity metadata %q", metadata)
+			continue
+		}
+		addLabel(labels, info, metadataLabels[metadata], value)
+	}
+
+	for tag, label := range o.CloudTags {
+		value, found := info.CloudTags[tag]
+		if !found {
+			continue
+		}
+		addLabel(labels, info, label, value)
+	}
+
+	return labels
+}
+
+// ManagedLabels returns the node labels that BuildLabels can set.
+// They are removed from nodes when they no longer apply.
+func (o *LabelOptions) ManagedLabels() map[string]struct{} {
+	managed := make(map[string]struct{})
+	if o == nil {
+		return managed
+	}
+	for _, metadata := range o.Metadata {
+		if label, found := metadataLabels[metadata]; found {
+			managed[label] = struct{}{}
+		}
+	}
+	for _, label := range o.CloudTags {
+		managed[label] = struct{}{}
+	}
+	return managed
+}
+
+func addLabel(labels map[string]string, info *Info, key string, value string) {
+	if value == "" {
+		return
+	}
+	if errs := validation.IsValidLabelValue(value); len(errs) != 0 {
+		klog.Warningf("not setting label %q on instance %q: value %q is not a valid label value: %v", key, info.InstanceID, value, errs)
+		return
+	}
+	labels[key] = value
+}
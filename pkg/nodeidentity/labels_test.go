@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeidentity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildLabels(t *testing.T) {
+	info := &Info{
+		InstanceID:     "i-0123456789",
+		Region:         "us-east-1",
+		Zone:           "us-east-1a",
+		Lifecycle:      LifecycleSpot,
+		PlacementGroup: "",
+		CloudTags: map[string]string{
+			"CostCenter": "platform",
+			"Team":       "core",
+			"Owner":      "not a valid label value",
+		},
+	}
+
+	grid := []struct {
+		name    string
+		options *LabelOptions
+		labels  map[string]string
+		managed map[string]struct{}
+	}{
+		{
+			name:    "nil options",
+			labels:  map[string]string{},
+			managed: map[string]struct{}{},
+		},
+		{
+			name: "metadata",
+			options: &LabelOptions{
+				Metadata: []string{"zone", "lifecycle", "placementGroup"},
+			},
+			labels: map[string]string{
+				LabelZone:      "us-east-1a",
+				LabelLifecycle: "spot",
+			},
+			managed: map[string]struct{}{
+				LabelZone:           {},
+				LabelLifecycle:      {},
+				LabelPlacementGroup: {},
+			},
+		},
+		{
+			name: "cloud tags",
+			options: &LabelOptions{
+				CloudTags: map[string]string{
+					"CostCenter": "example.com/cost-center",
+					"Owner":      "example.com/owner",
+					"Missing":    "example.com/missing",
+				},
+			},
+			labels: map[string]string{
+				"example.com/cost-center": "platform",
+			},
+			managed: map[string]struct{}{
+				"example.com/cost-center": {},
+				"example.com/owner":       {},
+				"example.com/missing":     {},
+			},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			labels := g.options.BuildLabels(info)
+			if !reflect.DeepEqual(labels, g.labels) {
+				t.Errorf("unexpected labels: got %v, want %v", labels, g.labels)
+			}
+			managed := g.options.ManagedLabels()
+			if !reflect.DeepEqual(managed, g.managed) {
+				t.Errorf("unexpected managed labels: got %v, want %v", managed, g.managed)
+			}
+		})
+	}
+}
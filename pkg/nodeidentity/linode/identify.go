@@ -83,7 +83,7 @@ func (i *nodeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*
 		return nil, fmt.Errorf("providerID %q not recognized for node %s: %w", providerID, node.Name, err)
 	}
 
-	if i.cacheEnabled {
+	if i.cacheEnabled && !nodeidentity.CacheBypassed(ctx) {
 		obj, exists, err := i.cache.GetByKey(instanceID)
 		if err != nil {
 			klog.Warningf("Nodeidentity info cache lookup failure: %v", err)
@@ -108,7 +108,7 @@ func (i *nodeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*
 	instanceID = strings.TrimPrefix(instanceID, "/")
 
 	// If caching is enabled try pulling nodeidentity.Info from cache before doing a Hetzner Cloud API call.
-	if i.cacheEnabled {
+	if i.cacheEnabled && !nodeidentity.CacheBypassed(ctx) {
 		obj, exists, err := i.cache.GetByKey(instanceID)
 		if err != nil {
 			klog.Warningf("Nodeidentity info cache lookup failure: %v", err)
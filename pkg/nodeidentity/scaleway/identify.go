@@ -81,7 +81,7 @@ func (i *nodeIdentifier) IdentifyNode(ctx context.Context, node *corev1.Node) (*
 	serverID := strings.TrimPrefix(providerID, "scaleway://")
 
 	// If caching is enabled try pulling nodeidentity.Info from cache before doing a Scaleway API call.
-	if i.cacheEnabled {
+	if i.cacheEnabled && !nodeidentity.CacheBypassed(ctx) {
 		obj, exists, err := i.cache.GetByKey(serverID)
 		if err != nil {
 			klog.Warningf("Nodeidentity info cache lookup failure: %v", err)
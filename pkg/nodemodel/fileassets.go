@@ -69,39 +69,14 @@ func BuildKubernetesFileAssets(ig model.InstanceGroup, assetBuilder *assets.Asse
 			kubernetesAssets[arch] = append(kubernetesAssets[arch], assets.BuildMirroredAsset(asset))
 		}
 
-		cloudProvider := ig.GetCloudProvider()
-		switch cloudProvider {
-		case kops.CloudProviderGCE:
-			binaryLocation := ig.RawClusterSpec().CloudProvider.GCE.BinariesLocation
-			if binaryLocation == nil {
-				binaryLocation = new("https://artifacts.k8s.io/binaries/cloud-provider-gcp/v35.0.0")
-			}
-
-			u, err := url.Parse(fmt.Sprintf("%s/auth-provider-gcp/linux/%s/auth-provider-gcp", *binaryLocation, arch))
-			if err != nil {
-				return nil, err
-			}
-			asset, err := assetBuilder.RemapFile(u, nil)
-			if err != nil {
-				return nil, err
-			}
-
-			kubernetesAssets[arch] = append(kubernetesAssets[arch], assets.BuildMirroredAsset(asset))
-		case kops.CloudProviderAWS:
-			binaryLocation := ig.RawClusterSpec().CloudProvider.AWS.BinariesLocation
-			if binaryLocation == nil {
-				binaryLocation = new("https://artifacts.k8s.io/binaries/cloud-provider-aws/v1.31.7")
-			}
-
-			u, err := url.Parse(fmt.Sprintf("%s/linux/%s/ecr-credential-provider-linux-%s", *binaryLocation, arch, arch))
+		for _, provider := range model.KubeletCredentialProviders(ig.GetCloudProvider(), ig.RawClusterSpec().KubeletCredentialProviders) {
+			asset, err := wellknownassets.FindKubeletCredentialProviderAsset(ig, assetBuilder, arch, &provider)
 			if err != nil {
 				return nil, err
 			}
-			asset, err := assetBuilder.RemapFile(u, nil)
-			if err != nil {
-				return nil, err
+			if asset != nil {
+				kubernetesAssets[arch] = append(kubernetesAssets[arch], assets.BuildMirroredAsset(asset))
 			}
-			kubernetesAssets[arch] = append(kubernetesAssets[arch], assets.BuildMirroredAsset(asset))
 		}
 
 		if ig.InstallCNIAssets() {
@@ -112,7 +87,13 @@ func BuildKubernetesFileAssets(ig model.InstanceGroup, assetBuilder *assets.Asse
 			kubernetesAssets[arch] = append(kubernetesAssets[arch], assets.BuildMirroredAsset(cniAsset))
 		}
 
-		if ig.RawClusterSpec().Containerd == nil || !ig.RawClusterSpec().Containerd.SkipInstall {
+		if ig.RawClusterSpec().ContainerRuntime == kops.ContainerRuntimeCRIO {
+			crioAsset, err := wellknownassets.FindCRIOAsset(ig, assetBuilder, arch)
+			if err != nil {
+				return nil, err
+			}
+			kubernetesAssets[arch] = append(kubernetesAssets[arch], assets.BuildMirroredAsset(crioAsset))
+		} else if ig.RawClusterSpec().Containerd == nil || !ig.RawClusterSpec().Containerd.SkipInstall {
 			containerdAsset, err := wellknownassets.FindContainerdAsset(ig, assetBuilder, arch)
 			if err != nil {
 				return nil, err
@@ -41,17 +41,18 @@ import (
 )
 
 type nodeUpConfigBuilder struct {
-	assetBuilder               *assets.AssetBuilder
-	channelsManifest           string
-	configBase                 vfs.Path
-	cluster                    *kops.Cluster
-	etcdManifests              map[string][]string
-	images                     map[kops.InstanceGroupRole]map[architectures.Architecture][]*nodeup.Image
-	protokubeAsset             map[architectures.Architecture][]*assets.MirroredAsset
-	encryptionConfigSecretHash string
+	assetBuilder                *assets.AssetBuilder
+	channelsManifest            string
+	configBase                  vfs.Path
+	cluster                     *kops.Cluster
+	etcdManifests               map[string][]string
+	images                      map[kops.InstanceGroupRole]map[architectures.Architecture][]*nodeup.Image
+	protokubeAsset              map[architectures.Architecture][]*assets.MirroredAsset
+	encryptionConfigSecretHash  string
+	auditWebhookTokenSecretHash string
 }
 
-func NewNodeUpConfigBuilder(cluster *kops.Cluster, assetBuilder *assets.AssetBuilder, encryptionConfigSecretHash string) (model.NodeUpConfigBuilder, error) {
+func NewNodeUpConfigBuilder(cluster *kops.Cluster, assetBuilder *assets.AssetBuilder, encryptionConfigSecretHash string, auditWebhookTokenSecretHash string) (model.NodeUpConfigBuilder, error) {
 	configBase, err := vfs.Context.BuildVfsPath(cluster.Spec.ConfigStore.Base)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing configStore.base %q: %v", cluster.Spec.ConfigStore.Base, err)
@@ -171,14 +172,15 @@ func NewNodeUpConfigBuilder(cluster *kops.Cluster, assetBuilder *assets.AssetBui
 	}
 
 	configBuilder := nodeUpConfigBuilder{
-		assetBuilder:               assetBuilder,
-		channelsManifest:           channelsManifest,
-		configBase:                 configBase,
-		cluster:                    cluster,
-		etcdManifests:              etcdManifests,
-		images:                     images,
-		protokubeAsset:             protokubeAsset,
-		encryptionConfigSecretHash: encryptionConfigSecretHash,
+		assetBuilder:                assetBuilder,
+		channelsManifest:            channelsManifest,
+		configBase:                  configBase,
+		cluster:                     cluster,
+		etcdManifests:               etcdManifests,
+		images:                      images,
+		protokubeAsset:              protokubeAsset,
+		encryptionConfigSecretHash:  encryptionConfigSecretHash,
+		auditWebhookTokenSecretHash: auditWebhookTokenSecretHash,
 	}
 
 	return &configBuilder, nil
@@ -235,6 +237,9 @@ func (n *nodeUpConfigBuilder) BuildConfig(ig *kops.InstanceGroup, wellKnownAddre
 		if err := loadCertificates(keysets, fi.CertificateIDCA, config, true); err != nil {
 			return nil, nil, err
 		}
+		if cluster.Spec.KopsController != nil && fi.ValueOf(cluster.Spec.KopsController.PinServingCA) {
+			config.KopsControllerCAPins = keysets[fi.CertificateIDCA].ToPublicKeyPins()
+		}
 		if keysets["etcd-clients-ca-cilium"] != nil {
 			if err := loadCertificates(keysets, "etcd-clients-ca-cilium", config, true); err != nil {
 				return nil, nil, err
@@ -246,6 +251,9 @@ func (n *nodeUpConfigBuilder) BuildConfig(ig *kops.InstanceGroup, wellKnownAddre
 				return nil, nil, err
 			}
 			for _, etcdCluster := range cluster.Spec.EtcdClusters {
+				if etcdCluster.IsExternal() {
+					continue
+				}
 				k := etcdCluster.Name
 				if err := loadCertificates(keysets, "etcd-manager-ca-"+k, config, true); err != nil {
 					return nil, nil, err
@@ -285,6 +293,7 @@ func (n *nodeUpConfigBuilder) BuildConfig(ig *kops.InstanceGroup, wellKnownAddre
 			config.KeypairIDs["service-account"] = keysets["service-account"].Primary.Id
 
 			config.APIServerConfig.EncryptionConfigSecretHash = n.encryptionConfigSecretHash
+			config.APIServerConfig.AuditWebhookTokenSecretHash = n.auditWebhookTokenSecretHash
 			serviceAccountPublicKeys, err := keysets["service-account"].ToPublicKeys()
 			if err != nil {
 				return nil, nil, fmt.Errorf("encoding service-account keys: %w", err)
@@ -386,6 +395,7 @@ func (n *nodeUpConfigBuilder) BuildConfig(ig *kops.InstanceGroup, wellKnownAddre
 	useConfigServer := kopsmodel.UseKopsControllerForNodeConfig(cluster) && !ig.HasAPIServer()
 	if useConfigServer {
 		bootConfig.ConfigServer = buildConfigServerOptions(cluster.ObjectMeta.Name, config.CAs[fi.CertificateIDCA], bootConfig.APIServerIPs)
+		bootConfig.ConfigServer.CAPins = config.KopsControllerCAPins
 		delete(config.CAs, fi.CertificateIDCA)
 	} else {
 		bootConfig.ConfigBase = new(n.configBase.Path())
@@ -424,6 +434,9 @@ func (n *nodeUpConfigBuilder) BuildConfig(ig *kops.InstanceGroup, wellKnownAddre
 
 	if isMaster {
 		for _, etcdCluster := range cluster.Spec.EtcdClusters {
+			if etcdCluster.IsExternal() {
+				continue
+			}
 			config.EtcdClusterNames = append(config.EtcdClusterNames, etcdCluster.Name)
 		}
 		config.EtcdManifests = n.etcdManifests[ig.Name]
@@ -491,6 +504,12 @@ func (n *nodeUpConfigBuilder) buildWarmPoolImages(ig *kops.InstanceGroup) []stri
 		return nil
 	}
 
+	return PreloadImages(n.assetBuilder, ig)
+}
+
+// PreloadImages returns the container images that impact the startup time of nodes in the instance group,
+// and are worth pre-pulling.
+func PreloadImages(assetBuilder *assets.AssetBuilder, ig *kops.InstanceGroup) []string {
 	images := map[string]bool{}
 
 	// Add component and addon images that impact startup time
@@ -511,7 +530,6 @@ func (n *nodeUpConfigBuilder) buildWarmPoolImages(ig *kops.InstanceGroup) []stri
 		"quay.io/coreos/flannel:",
 		"quay.io/weaveworks/",
 	}
-	assetBuilder := n.assetBuilder
 	if assetBuilder != nil {
 		// Add kops-managed images
 		for _, image := range assetBuilder.ImageAssets() {
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wellknownassets
+
+import (
+	"fmt"
+	"net/url"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/model"
+	"k8s.io/kops/pkg/assets"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/util/pkg/architectures"
+	"k8s.io/kops/util/pkg/hashing"
+)
+
+const (
+	ecrCredentialProviderBinariesLocation = "https://artifacts.k8s.io/binaries/cloud-provider-aws/v1.31.7"
+	gcpCredentialProviderBinariesLocation = "https://artifacts.k8s.io/binaries/cloud-provider-gcp/v35.0.0"
+)
+
+// FindKubeletCredentialProviderAsset returns the binary of a kubelet credential provider for the architecture,
+// or nil if the provider has no binary for the architecture.
+func FindKubeletCredentialProviderAsset(ig model.InstanceGroup, assetBuilder *assets.AssetBuilder, arch architectures.Architecture, provider *kops.KubeletCredentialProviderSpec) (*assets.FileAsset, error) {
+	var canonicalURL, knownHash string
+
+	if provider.Packages != nil {
+		switch arch {
+		case architectures.ArchitectureAmd64:
+			canonicalURL = fi.ValueOf(provider.Packages.UrlAmd64)
+			knownHash = fi.ValueOf(provider.Packages.HashAmd64)
+		case architectures.ArchitectureArm64:
+			canonicalURL = fi.ValueOf(provider.Packages.UrlArm64)
+			knownHash = fi.ValueOf(provider.Packages.HashArm64)
+		}
+	}
+
+	if canonicalURL == "" {
+		cloudProvider := ig.RawClusterSpec().CloudProvider
+		switch provider.Name {
+		case kops.KubeletCredentialProviderECR:
+			binaryLocation := ecrCredentialProviderBinariesLocation
+			if cloudProvider.AWS != nil && cloudProvider.AWS.BinariesLocation != nil {
+				binaryLocation = *cloudProvider.AWS.BinariesLocation
+			}
+			canonicalURL = fmt.Sprintf("%s/linux/%s/ecr-credential-provider-linux-%s", binaryLocation, arch, arch)
+		case kops.KubeletCredentialProviderGCP:
+			binaryLocation := gcpCredentialProviderBinariesLocation
+			if cloudProvider.GCE != nil && cloudProvider.GCE.BinariesLocation != nil {
+				binaryLocation = *cloudProvider.GCE.BinariesLocation
+			}
+			canonicalURL = fmt.Sprintf("%s/auth-provider-gcp/linux/%s/auth-provider-gcp", binaryLocation, arch)
+		default:
+			// The binaries of other providers are only set with packages
+			return nil, nil
+		}
+	}
+
+	u, err := url.Parse(canonicalURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s binary URL %q: %w", provider.Name, canonicalURL, err)
+	}
+
+	var hash *hashing.Hash
+	if knownHash != "" {
+		hash, err = hashing.FromString(knownHash)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %s binary hash %q: %w", provider.Name, knownHash, err)
+		}
+	}
+
+	asset, err := assetBuilder.RemapFile(u, hash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to remap %s binary: %w", provider.Name, err)
+	}
+
+	return asset, nil
+}
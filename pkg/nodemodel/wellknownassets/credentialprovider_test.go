@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wellknownassets
+
+import (
+	"testing"
+
+	"k8s.io/kops/pkg/apis/kops"
+	kopsmodel "k8s.io/kops/pkg/apis/kops/model"
+	"k8s.io/kops/pkg/assets"
+	"k8s.io/kops/util/pkg/architectures"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func Test_FindKubeletCredentialProviderAsset(t *testing.T) {
+	hash := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	grid := []struct {
+		desc         string
+		provider     kops.KubeletCredentialProviderSpec
+		arch         architectures.Architecture
+		expectedURL  string
+		expectedHash string
+	}{
+		{
+			desc:         "ecr",
+			provider:     kops.KubeletCredentialProviderSpec{Name: kops.KubeletCredentialProviderECR},
+			arch:         architectures.ArchitectureArm64,
+			expectedURL:  "https://artifacts.k8s.io/binaries/cloud-provider-aws/v1.31.7/linux/arm64/ecr-credential-provider-linux-arm64",
+			expectedHash: "sha256:1980e3a038cb16da48a137743b31fb81de6c0b59fa06c206c2bc20ce0a52f849",
+		},
+		{
+			desc:         "gcp",
+			provider:     kops.KubeletCredentialProviderSpec{Name: kops.KubeletCredentialProviderGCP},
+			arch:         architectures.ArchitectureAmd64,
+			expectedURL:  "https://artifacts.k8s.io/binaries/cloud-provider-gcp/v35.0.0/auth-provider-gcp/linux/amd64/auth-provider-gcp",
+			expectedHash: "sha256:b5852509ab5c950485794afd49a3fd1c3d6166db988c15b683d2373b7055300f",
+		},
+		{
+			desc: "acr",
+			provider: kops.KubeletCredentialProviderSpec{
+				Name: kops.KubeletCredentialProviderACR,
+				Packages: &kops.PackagesConfig{
+					UrlAmd64:  new("https://example.com/azure-acr-credential-provider-linux-amd64"),
+					HashAmd64: new(hash),
+				},
+			},
+			arch:         architectures.ArchitectureAmd64,
+			expectedURL:  "https://example.com/azure-acr-credential-provider-linux-amd64",
+			expectedHash: hash,
+		},
+		{
+			desc: "acr without binary for the architecture",
+			provider: kops.KubeletCredentialProviderSpec{
+				Name: kops.KubeletCredentialProviderACR,
+				Packages: &kops.PackagesConfig{
+					UrlAmd64:  new("https://example.com/azure-acr-credential-provider-linux-amd64"),
+					HashAmd64: new(hash),
+				},
+			},
+			arch: architectures.ArchitectureArm64,
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			cluster := &kops.Cluster{}
+			cluster.Spec.KubernetesVersion = "v1.32.0"
+
+			igModel, err := kopsmodel.ForInstanceGroup(cluster, &kops.InstanceGroup{})
+			if err != nil {
+				t.Fatalf("building instance group model: %v", err)
+			}
+
+			assetBuilder := assets.NewAssetBuilder(vfs.Context, cluster.Spec.Assets, false)
+
+			asset, err := FindKubeletCredentialProviderAsset(igModel, assetBuilder, g.arch, &g.provider)
+			if err != nil {
+				t.Fatalf("error finding asset: %v", err)
+			}
+			if g.expectedURL == "" {
+				if asset != nil {
+					t.Fatalf("expected no asset, got %q", asset.DownloadURL)
+				}
+				return
+			}
+			if asset.DownloadURL.String() != g.expectedURL {
+				t.Errorf("expected URL %q, got %q", g.expectedURL, asset.DownloadURL)
+			}
+			if asset.SHAValue.String() != g.expectedHash {
+				t.Errorf("expected hash %q, got %q", g.expectedHash, asset.SHAValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wellknownassets
+
+import (
+	"fmt"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/kops/pkg/apis/kops/model"
+	"k8s.io/kops/pkg/assets"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/util/pkg/architectures"
+)
+
+const (
+	// CRI-O static bundles, which include crio, conmon, crun, runc, pinns and crictl
+	crioBundleUrl = "https://storage.googleapis.com/cri-o/artifacts/cri-o.%s.v%s.tar.gz"
+)
+
+func FindCRIOAsset(ig model.InstanceGroup, assetBuilder *assets.AssetBuilder, arch architectures.Architecture) (*assets.FileAsset, error) {
+	crio := ig.RawClusterSpec().CRIO
+	if crio == nil {
+		return nil, fmt.Errorf("unable to find crio config")
+	}
+
+	canonicalURL := ""
+	knownHash := ""
+
+	if crio.Packages != nil {
+		if arch == architectures.ArchitectureAmd64 && crio.Packages.UrlAmd64 != nil && crio.Packages.HashAmd64 != nil {
+			canonicalURL = fi.ValueOf(crio.Packages.UrlAmd64)
+			knownHash = fi.ValueOf(crio.Packages.HashAmd64)
+		}
+		if arch == architectures.ArchitectureArm64 && crio.Packages.UrlArm64 != nil && crio.Packages.HashArm64 != nil {
+			canonicalURL = fi.ValueOf(crio.Packages.UrlArm64)
+			knownHash = fi.ValueOf(crio.Packages.HashArm64)
+		}
+	}
+
+	if canonicalURL == "" {
+		version := fi.ValueOf(crio.Version)
+		if version == "" {
+			return nil, fmt.Errorf("unable to find crio version")
+		}
+		u, err := findCRIOVersionUrl(arch, version)
+		if err != nil {
+			return nil, err
+		}
+		canonicalURL = u
+	}
+
+	return buildFileAsset(assetBuilder, canonicalURL, knownHash)
+}
+
+func findCRIOVersionUrl(arch architectures.Architecture, version string) (string, error) {
+	sv, err := semver.ParseTolerant(version)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse version string: %q", version)
+	}
+	if sv.LT(semver.MustParse("1.30.0")) {
+		return "", fmt.Errorf("unsupported crio version: %q", version)
+	}
+
+	switch arch {
+	case architectures.ArchitectureAmd64, architectures.ArchitectureArm64:
+		return fmt.Sprintf(crioBundleUrl, arch, sv.String()), nil
+	default:
+		return "", fmt.Errorf("unknown arch: %q", arch)
+	}
+}
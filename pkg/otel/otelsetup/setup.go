@@ -14,7 +14,8 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package main
+// Package otelsetup configures the OpenTelemetry SDK of the kOps binaries.
+package otelsetup
 
 import (
 	"context"
@@ -28,6 +29,8 @@ import (
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
@@ -35,9 +38,13 @@ import (
 	"k8s.io/kops/pkg/otel/otlptracefile"
 )
 
-// setupOTelSDK bootstraps the OpenTelemetry pipeline.
+// SetupOTelSDK bootstraps the OpenTelemetry pipeline.
+// Traces are exported with OTLP/HTTP to tracesEndpoint if it is set, or to the endpoint set in the standard
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT or OTEL_EXPORTER_OTLP_ENDPOINT environment variables,
+// or otherwise written to the file set in OTEL_EXPORTER_OTLP_TRACES_FILE and related environment variables.
+// If none of them is set, tracing is disabled.
 // If it does not return an error, make sure to call shutdown for proper cleanup.
-func setupOTelSDK(ctx context.Context, serviceName, serviceVersion string) (shutdown func(context.Context) error, err error) {
+func SetupOTelSDK(ctx context.Context, serviceName, serviceVersion, tracesEndpoint string) (shutdown func(context.Context) error, err error) {
 	var shutdownFuncs []func(context.Context) error
 
 	// shutdown calls cleanup functions registered via shutdownFuncs.
@@ -65,7 +72,7 @@ func setupOTelSDK(ctx context.Context, serviceName, serviceVersion string) (shut
 	}
 
 	// Setup trace provider.
-	tracerProvider, err := newTraceProvider(ctx, res)
+	tracerProvider, err := newTraceProvider(ctx, res, tracesEndpoint)
 	if err != nil {
 		handleErr(err)
 		return
@@ -73,6 +80,8 @@ func setupOTelSDK(ctx context.Context, serviceName, serviceVersion string) (shut
 	if tracerProvider != nil {
 		shutdownFuncs = append(shutdownFuncs, tracerProvider.Shutdown)
 		otel.SetTracerProvider(tracerProvider)
+		// Propagate the trace context in requests, so that the spans of kops-controller are part of the traces of nodeup.
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
 
 		http.DefaultClient = &http.Client{
 			Transport: otelhttp.NewTransport(http.DefaultTransport),
@@ -90,7 +99,30 @@ func newResource(serviceName, serviceVersion string) (*resource.Resource, error)
 		))
 }
 
-func newTraceProvider(ctx context.Context, res *resource.Resource) (*trace.TracerProvider, error) {
+func newTraceProvider(ctx context.Context, res *resource.Resource, tracesEndpoint string) (*trace.TracerProvider, error) {
+	traceExporter, err := newTraceExporter(ctx, tracesEndpoint)
+	if err != nil || traceExporter == nil {
+		return nil, err
+	}
+
+	traceProvider := trace.NewTracerProvider(
+		trace.WithBatcher(traceExporter,
+			// Default is 5s. Set to 1s for demonstrative purposes.
+			trace.WithBatchTimeout(time.Second)),
+		trace.WithResource(res),
+	)
+	return traceProvider, nil
+}
+
+func newTraceExporter(ctx context.Context, tracesEndpoint string) (trace.SpanExporter, error) {
+	if tracesEndpoint != "" {
+		return otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(tracesEndpoint))
+	}
+	if os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" {
+		// The exporter reads the endpoint, headers and TLS settings from the environment.
+		return otlptracehttp.New(ctx)
+	}
+
 	destIsDirectory := false
 
 	dest := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_FILE")
@@ -130,16 +162,5 @@ func newTraceProvider(ctx context.Context, res *resource.Resource) (*trace.Trace
 		dest = filepath.Join(dest, filename)
 	}
 
-	traceExporter, err := otlptracefile.New(ctx, otlptracefile.WithPath(dest))
-	if err != nil {
-		return nil, err
-	}
-
-	traceProvider := trace.NewTracerProvider(
-		trace.WithBatcher(traceExporter,
-			// Default is 5s. Set to 1s for demonstrative purposes.
-			trace.WithBatchTimeout(time.Second)),
-		trace.WithResource(res),
-	)
-	return traceProvider, nil
+	return otlptracefile.New(ctx, otlptracefile.WithPath(dest))
 }
@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"slices"
+)
+
+// PublicKeyPin returns the pin of a DER-encoded SubjectPublicKeyInfo, as "sha256:" followed by the hex encoded hash.
+// This is the format kubeadm uses for CA certificate hashes.
+func PublicKeyPin(subjectPublicKeyInfo []byte) string {
+	hash := sha256.Sum256(subjectPublicKeyInfo)
+	return "sha256:" + hex.EncodeToString(hash[:])
+}
+
+// ChainsMatchPins returns true if a verified certificate chain ends in a CA whose public key matches one of the pins.
+func ChainsMatchPins(verifiedChains [][]*x509.Certificate, pins []string) bool {
+	for _, chain := range verifiedChains {
+		if len(chain) == 0 {
+			continue
+		}
+		if slices.Contains(pins, PublicKeyPin(chain[len(chain)-1].RawSubjectPublicKeyInfo)) {
+			return true
+		}
+	}
+	return false
+}
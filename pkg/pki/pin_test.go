@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicKeyPin(t *testing.T) {
+	assert.Equal(t, "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", PublicKeyPin(nil))
+}
+
+func TestChainsMatchPins(t *testing.T) {
+	leaf := &x509.Certificate{RawSubjectPublicKeyInfo: []byte("leaf")}
+	ca := &x509.Certificate{RawSubjectPublicKeyInfo: []byte("ca")}
+	otherCA := &x509.Certificate{RawSubjectPublicKeyInfo: []byte("other-ca")}
+
+	for _, tc := range []struct {
+		name     string
+		chains   [][]*x509.Certificate
+		pins     []string
+		expected bool
+	}{
+		{
+			name:     "pinned root",
+			chains:   [][]*x509.Certificate{{leaf, ca}},
+			pins:     []string{PublicKeyPin([]byte("ca"))},
+			expected: true,
+		},
+		{
+			name:     "one of several chains",
+			chains:   [][]*x509.Certificate{{leaf, otherCA}, {leaf, ca}},
+			pins:     []string{PublicKeyPin([]byte("ca"))},
+			expected: true,
+		},
+		{
+			name:   "pinned leaf",
+			chains: [][]*x509.Certificate{{leaf, ca}},
+			pins:   []string{PublicKeyPin([]byte("leaf"))},
+		},
+		{
+			name:   "unpinned root",
+			chains: [][]*x509.Certificate{{leaf, otherCA}},
+			pins:   []string{PublicKeyPin([]byte("ca"))},
+		},
+		{
+			name: "no chains",
+			pins: []string{PublicKeyPin([]byte("ca"))},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ChainsMatchPins(tc.chains, tc.pins))
+		})
+	}
+}
@@ -71,6 +71,7 @@ func ListResourcesAWS(cloud awsup.AWSCloud, clusterInfo resources.ClusterInfo) (
 		ListInstances,
 		ListKeypairs,
 		ListSecurityGroups,
+		ListSecurityGroupRules,
 		ListVolumes,
 		// EC2 VPC
 		ListDhcpOptions,
@@ -149,7 +150,9 @@ func ListResourcesAWS(cloud awsup.AWSCloud, clusterInfo resources.ClusterInfo) (
 						Name:    FindName(igw.Tags),
 						ID:      igwID,
 						Obj:     igw,
+						Tags:    mapEC2TagsToMap(igw.Tags),
 						Type:    "internet-gateway",
+						Reason:  resources.ReasonAttached,
 						Dumper:  DumpInternetGateway,
 						Deleter: DeleteInternetGateway,
 						Shared:  vpc.Shared, // Shared iff the VPC is shared
@@ -222,7 +225,7 @@ func BuildEC2Filters(cloud fi.Cloud) []ec2types.Filter {
 	return filters
 }
 
-func addUntaggedRouteTables(cloud awsup.AWSCloud, clusterName string, resources map[string]*resources.Resource) error {
+func addUntaggedRouteTables(cloud awsup.AWSCloud, clusterName string, resourceTrackers map[string]*resources.Resource) error {
 	// We sometimes have trouble tagging the route table (eventual consistency, e.g. #597)
 	// If we are deleting the VPC, we should delete the route table
 	// (no real reason not to; easy to recreate; no real state etc)
@@ -238,7 +241,7 @@ func addUntaggedRouteTables(cloud awsup.AWSCloud, clusterName string, resources
 			continue
 		}
 
-		if resources["vpc:"+vpcID] == nil || resources["vpc:"+vpcID].Shared {
+		if resourceTrackers["vpc:"+vpcID] == nil || resourceTrackers["vpc:"+vpcID].Shared {
 			// Not deleting this VPC; ignore
 			continue
 		}
@@ -261,8 +264,9 @@ func addUntaggedRouteTables(cloud awsup.AWSCloud, clusterName string, resources
 		}
 
 		t := buildTrackerForRouteTable(rt, clusterName)
-		if resources[t.Type+":"+t.ID] == nil {
-			resources[t.Type+":"+t.ID] = t
+		if resourceTrackers[t.Type+":"+t.ID] == nil {
+			t.Reason = resources.ReasonAttached
+			resourceTrackers[t.Type+":"+t.ID] = t
 		}
 	}
 
@@ -389,10 +393,12 @@ func ListInstances(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.Reso
 					Name:         FindName(instance.Tags),
 					ID:           id,
 					Type:         string(ec2types.ResourceTypeInstance),
+					Reason:       resources.ReasonTagMatch,
 					GroupDeleter: DeleteInstances,
 					GroupKey:     fi.ValueOf(instance.SubnetId),
 					Dumper:       DumpInstance,
 					Obj:          instance,
+					Tags:         mapEC2TagsToMap(instance.Tags),
 				}
 
 				var blocks []string
@@ -601,7 +607,10 @@ func ListVolumes(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.Resour
 			Name:    FindName(volume.Tags),
 			ID:      id,
 			Type:    "volume",
+			Reason:  resources.ReasonTagMatch,
 			Deleter: DeleteVolume,
+			Obj:     volume,
+			Tags:    mapEC2TagsToMap(volume.Tags),
 			Shared:  HasSharedTag(string(ec2types.ResourceTypeVolume)+":"+id, volume.Tags, clusterName),
 		}
 
@@ -720,6 +729,7 @@ func ListKeypairs(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.Resou
 			Name:    name,
 			ID:      id,
 			Type:    "keypair",
+			Reason:  resources.ReasonNameMatch,
 			Deleter: DeleteKeypair,
 		}
 
@@ -773,10 +783,12 @@ func ListSubnets(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.Resour
 			Name:    FindName(subnet.Tags),
 			ID:      subnetID,
 			Type:    string(ec2types.ResourceTypeSubnet),
+			Reason:  resources.ReasonTagMatch,
 			Deleter: DeleteSubnet,
 			Dumper:  DumpSubnet,
 			Shared:  shared,
 			Obj:     subnet,
+			Tags:    mapEC2TagsToMap(subnet.Tags),
 		}
 		resourceTracker.Blocks = append(resourceTracker.Blocks, "vpc:"+aws.ToString(subnet.VpcId))
 		resourceTrackers = append(resourceTrackers, resourceTracker)
@@ -964,7 +976,9 @@ func ListDhcpOptions(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.Re
 			Name:    FindName(o.Tags),
 			ID:      aws.ToString(o.DhcpOptionsId),
 			Type:    "dhcp-options",
+			Reason:  resources.ReasonTagMatch,
 			Deleter: DeleteDhcpOptions,
+			Tags:    mapEC2TagsToMap(o.Tags),
 			Shared:  HasSharedTag(string(ec2types.ResourceTypeDhcpOptions)+":"+aws.ToString(o.DhcpOptionsId), o.Tags, clusterName),
 		}
 
@@ -1081,7 +1095,9 @@ func ListInternetGateways(cloud fi.Cloud, vpcID, clusterName string) ([]*resourc
 			Name:    FindName(o.Tags),
 			ID:      aws.ToString(o.InternetGatewayId),
 			Type:    "internet-gateway",
+			Reason:  resources.ReasonTagMatch,
 			Deleter: DeleteInternetGateway,
+			Tags:    mapEC2TagsToMap(o.Tags),
 			Shared:  HasSharedTag(string(ec2types.ResourceTypeInternetGateway)+":"+aws.ToString(o.InternetGatewayId), o.Tags, clusterName),
 		}
 
@@ -1188,7 +1204,9 @@ func ListEgressOnlyInternetGateways(cloud fi.Cloud, vpcID, clusterName string) (
 			Name:    FindName(o.Tags),
 			ID:      aws.ToString(o.EgressOnlyInternetGatewayId),
 			Type:    "egress-only-internet-gateway",
+			Reason:  resources.ReasonTagMatch,
 			Obj:     o,
+			Tags:    mapEC2TagsToMap(o.Tags),
 			Dumper:  DumpEgressOnlyInternetGateway,
 			Deleter: DeleteEgressOnlyInternetGateway,
 			Shared:  HasSharedTag(string(ec2types.ResourceTypeEgressOnlyInternetGateway)+":"+aws.ToString(o.EgressOnlyInternetGatewayId), o.Tags, clusterName),
@@ -1266,7 +1284,9 @@ func ListAutoScalingGroups(cloud fi.Cloud, vpcID, clusterName string) ([]*resour
 			Name:    FindASGName(asg.Tags),
 			ID:      aws.ToString(asg.AutoScalingGroupName),
 			Type:    "autoscaling-group",
+			Reason:  resources.ReasonTagMatch,
 			Deleter: DeleteAutoScalingGroup,
+			Tags:    mapAutoscalingTagsToMap(asg.Tags),
 		}
 
 		var blocks []string
@@ -1320,6 +1340,7 @@ func FindAutoScalingLaunchTemplates(cloud fi.Cloud, clusterName string) ([]*reso
 				Name:    aws.ToString(lt.LaunchTemplateName),
 				ID:      aws.ToString(lt.LaunchTemplateId),
 				Type:    TypeAutoscalingLaunchConfig,
+				Reason:  resources.ReasonTagMatch,
 				Deleter: DeleteAutoScalingGroupLaunchTemplate,
 			})
 		}
@@ -1536,9 +1557,11 @@ func ListELBs(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.Resource,
 			Name:    FindELBName(elbTags[id]),
 			ID:      id,
 			Type:    TypeLoadBalancer,
+			Reason:  resources.ReasonTagMatch,
 			Deleter: DeleteELB,
 			Dumper:  DumpELB,
 			Obj:     elb,
+			Tags:    mapELBTagsToMap(elbTags[id]),
 		}
 
 		var blocks []string
@@ -1647,9 +1670,11 @@ func ListELBV2s(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.Resourc
 			Name:    id,
 			ID:      string(*elb.LoadBalancerArn),
 			Type:    TypeLoadBalancer,
+			Reason:  resources.ReasonTagMatch,
 			Deleter: DeleteELBV2,
 			Dumper:  DumpELB,
 			Obj:     elb,
+			Tags:    mapELBV2TagsToMap(loadBalancer.Tags),
 		}
 
 		var blocks []string
@@ -1690,9 +1715,11 @@ func ListTargetGroups(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.R
 			Name:    id,
 			ID:      targetGroup.ARN,
 			Type:    TypeTargetGroup,
+			Reason:  resources.ReasonTagMatch,
 			Deleter: DeleteTargetGroup,
 			Dumper:  DumpTargetGroup,
 			Obj:     tg,
+			Tags:    mapELBV2TagsToMap(targetGroup.Tags),
 		}
 
 		resourceTrackers = append(resourceTrackers, resourceTracker)
@@ -1881,6 +1908,7 @@ func ListRoute53Records(cloud fi.Cloud, vpcID, clusterName string) ([]*resources
 					Name:     aws.ToString(rrs.Name),
 					ID:       hostedZoneID + "/" + string(rrs.Type) + "/" + aws.ToString(rrs.Name),
 					Type:     "route53-record",
+					Reason:   resources.ReasonNameMatch,
 					GroupKey: hostedZoneID,
 					GroupDeleter: func(cloud fi.Cloud, resourceTrackers []*resources.Resource) error {
 						return deleteRoute53Records(ctx, cloud, zone, resourceTrackers)
@@ -2018,7 +2046,9 @@ func ListIAMRoles(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.Resou
 							Name:    name,
 							ID:      name,
 							Type:    "iam-role",
+							Reason:  resources.ReasonTagMatch,
 							Deleter: DeleteIAMRole,
+							Tags:    mapIAMTagsToMap(roleOutput.Role.Tags),
 						}
 						resourceTrackers = append(resourceTrackers, resourceTracker)
 					}
@@ -2112,6 +2142,7 @@ func ListIAMInstanceProfiles(cloud fi.Cloud, vpcID, clusterName string) ([]*reso
 			Name:    name,
 			ID:      name,
 			Type:    "iam-instance-profile",
+			Reason:  resources.ReasonTagMatch,
 			Deleter: DeleteIAMInstanceProfile,
 			Obj:     profile,
 		}
@@ -2165,6 +2196,7 @@ func ListIAMOIDCProviders(cloud fi.Cloud, vpcID, clusterName string) ([]*resourc
 			Name:    aws.ToString(arn),
 			ID:      aws.ToString(arn),
 			Type:    "oidc-provider",
+			Reason:  resources.ReasonTagMatch,
 			Deleter: DeleteIAMOIDCProvider,
 		}
 		resourceTrackers = append(resourceTrackers, resourceTracker)
@@ -344,6 +344,95 @@ func TestSharedVolume(t *testing.T) {
 	}
 }
 
+func TestListSecurityGroupRules(t *testing.T) {
+	ctx := context.Background()
+	cloud := awsup.BuildMockAWSCloud("us-east-1", "abc")
+	clusterName := "me.example.com"
+	ownershipTagKey := "kubernetes.io/cluster/" + clusterName
+
+	c := &mockec2.MockEC2{}
+	cloud.MockEC2 = c
+
+	createGroup := func(name string, ownership string) string {
+		sg, err := c.CreateSecurityGroup(ctx, &ec2.CreateSecurityGroupInput{
+			GroupName: aws.String(name),
+			VpcId:     aws.String("vpc-1234"),
+			TagSpecifications: []ec2types.TagSpecification{
+				{
+					ResourceType: ec2types.ResourceTypeSecurityGroup,
+					Tags: []ec2types.Tag{
+						{Key: aws.String(ownershipTagKey), Value: aws.String(ownership)},
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("error creating security group: %v", err)
+		}
+		return aws.ToString(sg.GroupId)
+	}
+	authorize := func(groupID string, cidr string, tags []ec2types.Tag) {
+		request := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: aws.String(groupID),
+			IpPermissions: []ec2types.IpPermission{
+				{
+					IpProtocol: aws.String("tcp"),
+					FromPort:   aws.Int32(443),
+					ToPort:     aws.Int32(443),
+					IpRanges:   []ec2types.IpRange{{CidrIp: aws.String(cidr)}},
+				},
+			},
+		}
+		if tags != nil {
+			request.TagSpecifications = []ec2types.TagSpecification{
+				{ResourceType: ec2types.ResourceTypeSecurityGroupRule, Tags: tags},
+			}
+		}
+		if _, err := c.AuthorizeSecurityGroupIngress(ctx, request); err != nil {
+			t.Fatalf("error authorizing ingress: %v", err)
+		}
+	}
+
+	ownedTags := []ec2types.Tag{{Key: aws.String(ownershipTagKey), Value: aws.String("owned")}}
+	sharedGroup := createGroup("shared", "shared")
+	ownedGroup := createGroup("owned", "owned")
+
+	// Rule added by kOps to a shared group
+	authorize(sharedGroup, "10.0.0.0/8", ownedTags)
+	// Rule added by someone else to the shared group
+	authorize(sharedGroup, "192.168.0.0/16", nil)
+	// Rule added by another cluster to the shared group
+	authorize(sharedGroup, "172.16.0.0/12", []ec2types.Tag{{Key: aws.String("kubernetes.io/cluster/other.example.com"), Value: aws.String("owned")}})
+	// Rule in an owned group, which is deleted along with the group
+	authorize(ownedGroup, "10.0.0.0/8", ownedTags)
+
+	resourceTrackers, err := ListSecurityGroupRules(cloud, "", clusterName)
+	if err != nil {
+		t.Fatalf("error listing security group rules: %v", err)
+	}
+	if len(resourceTrackers) != 1 {
+		t.Fatalf("expected 1 security group rule, got %d", len(resourceTrackers))
+	}
+	rule := resourceTrackers[0].Obj.(ec2types.SecurityGroupRule)
+	if aws.ToString(rule.GroupId) != sharedGroup || aws.ToString(rule.CidrIpv4) != "10.0.0.0/8" {
+		t.Fatalf("unexpected security group rule: %v", resourceTrackers[0].ID)
+	}
+
+	if err := DeleteSecurityGroupRule(cloud, resourceTrackers[0]); err != nil {
+		t.Fatalf("error deleting security group rule: %v", err)
+	}
+
+	rules, err := c.DescribeSecurityGroupRules(ctx, &ec2.DescribeSecurityGroupRulesInput{
+		Filters: []ec2types.Filter{{Name: aws.String("group-id"), Values: []string{sharedGroup}}},
+	})
+	if err != nil {
+		t.Fatalf("error describing security group rules: %v", err)
+	}
+	if len(rules.SecurityGroupRules) != 2 {
+		t.Fatalf("expected 2 remaining security group rules, got %d", len(rules.SecurityGroupRules))
+	}
+}
+
 func TestMatchesElbTags(t *testing.T) {
 	tc := []struct {
 		tags     map[string]string
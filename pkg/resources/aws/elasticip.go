@@ -36,7 +36,10 @@ func buildElasticIPResource(address ec2types.Address, forceShared bool, clusterN
 		Name:    name,
 		ID:      aws.ToString(address.AllocationId),
 		Type:    TypeElasticIp,
+		Reason:  resources.ReasonAttached,
 		Deleter: DeleteElasticIP,
+		Obj:     address,
+		Tags:    mapEC2TagsToMap(address.Tags),
 		Shared:  forceShared,
 	}
 
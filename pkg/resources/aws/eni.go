@@ -71,16 +71,21 @@ func DescribeENIs(cloud fi.Cloud, vpcID, clusterName string) (map[string]ec2type
 		return nil, nil
 	}
 
+	return describeAvailableENIs(cloud, clusterName, awsup.NewEC2Filter("vpc-id", vpcID))
+}
+
+// describeAvailableENIs lists the detached ENIs tagged for the cluster that match the additional filters
+func describeAvailableENIs(cloud fi.Cloud, clusterName string, additionalFilters ...ec2types.Filter) (map[string]ec2types.NetworkInterface, error) {
 	ctx := context.TODO()
 	c := cloud.(awsup.AWSCloud)
 
-	vpcFilter := awsup.NewEC2Filter("vpc-id", vpcID)
 	statusFilter := awsup.NewEC2Filter("status", string(ec2types.NetworkInterfaceStatusAvailable))
 	enis := make(map[string]ec2types.NetworkInterface)
 	klog.V(2).Info("Listing ENIs")
 	for _, filters := range buildEC2FiltersForCluster(clusterName) {
+		filters = append(filters, additionalFilters...)
 		request := &ec2.DescribeNetworkInterfacesInput{
-			Filters: append(filters, vpcFilter, statusFilter),
+			Filters: append(filters, statusFilter),
 		}
 		paginator := ec2.NewDescribeNetworkInterfacesPaginator(c.EC2(), request)
 		for paginator.HasMorePages() {
@@ -110,9 +115,11 @@ func ListENIs(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.Resource,
 		resourceTracker := &resources.Resource{
 			ID:      eniID,
 			Type:    string(ec2types.ResourceTypeNetworkInterface),
+			Reason:  resources.ReasonTagMatch,
 			Deleter: DeleteENI,
 			Dumper:  DumpENI,
 			Obj:     v,
+			Tags:    mapEC2TagsToMap(v.TagSet),
 			Shared:  !HasOwnedTag(string(ec2types.ResourceTypeNetworkInterface)+":"+eniID, v.TagSet, clusterName),
 		}
 
@@ -108,6 +108,7 @@ func ListEventBridgeRules(cloud fi.Cloud, vpcID, clusterName string) ([]*resourc
 			Name:    *rule.Name,
 			ID:      *rule.Name,
 			Type:    TypeEventBridgeRule,
+			Reason:  resources.ReasonNameMatch,
 			Deleter: EventBridgeRuleDeleter,
 			Dumper:  DumpEventBridgeRule,
 			Obj:     rule,
@@ -39,7 +39,9 @@ func buildNatGatewayResource(ngw ec2types.NatGateway, forceShared bool, clusterN
 		Name:    id,
 		ID:      id,
 		Obj:     ngw,
+		Tags:    mapEC2TagsToMap(ngw.Tags),
 		Type:    TypeNatGateway,
+		Reason:  resources.ReasonAttached,
 		Dumper:  DumpNatGateway,
 		Deleter: DeleteNatGateway,
 		Shared:  forceShared,
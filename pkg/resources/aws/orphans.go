@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	"k8s.io/kops/pkg/resources"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+// ListOrphanedResources lists the resources Kubernetes creates on behalf of the cluster,
+// such as load balancers for Services, volumes for PersistentVolumes and network interfaces,
+// that are still tagged for the cluster. It is used to find what was left behind after a cluster is deleted.
+func ListOrphanedResources(cloud awsup.AWSCloud, clusterName string) ([]*resources.Resource, error) {
+	listFunctions := []listFn{
+		ListELBs,
+		ListELBV2s,
+		ListVolumes,
+		listOrphanedENIs,
+	}
+
+	var orphans []*resources.Resource
+	for _, fn := range listFunctions {
+		rt, err := fn(cloud, "", clusterName)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rt {
+			if r.Shared {
+				continue
+			}
+			orphans = append(orphans, r)
+		}
+	}
+
+	return orphans, nil
+}
+
+// listOrphanedENIs is like ListENIs, but does not require the cluster VPC to still exist
+func listOrphanedENIs(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.Resource, error) {
+	enis, err := describeAvailableENIs(cloud, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	var resourceTrackers []*resources.Resource
+	for _, v := range enis {
+		eniID := aws.ToString(v.NetworkInterfaceId)
+
+		resourceTrackers = append(resourceTrackers, &resources.Resource{
+			Name:    FindName(v.TagSet),
+			ID:      eniID,
+			Type:    string(ec2types.ResourceTypeNetworkInterface),
+			Reason:  resources.ReasonTagMatch,
+			Deleter: DeleteENI,
+			Dumper:  DumpENI,
+			Obj:     v,
+			Tags:    mapEC2TagsToMap(v.TagSet),
+			Shared:  !HasOwnedTag(string(ec2types.ResourceTypeNetworkInterface)+":"+eniID, v.TagSet, clusterName),
+		})
+	}
+
+	return resourceTrackers, nil
+}
+
+// EstimateMonthlyCost returns the approximate monthly cost in USD of keeping the resource,
+// based on us-east-1 on-demand prices. It returns false if the cost of the resource is not known.
+func EstimateMonthlyCost(r *resources.Resource) (float64, bool) {
+	switch obj := r.Obj.(type) {
+	case ec2types.Volume:
+		return awsup.VolumeMonthlyCost(obj.VolumeType, aws.ToInt32(obj.Size))
+	case elbtypes.LoadBalancerDescription:
+		return awsup.ClassicLoadBalancerPricePerHour * awsup.HoursPerMonth, true
+	case elbv2types.LoadBalancer:
+		return awsup.LoadBalancerV2PricePerHour * awsup.HoursPerMonth, true
+	case ec2types.NetworkInterface:
+		if obj.Association != nil && obj.Association.PublicIp != nil {
+			return awsup.PublicIPv4PricePerHour * awsup.HoursPerMonth, true
+		}
+		return 0, true
+	case ec2types.Address:
+		return awsup.PublicIPv4PricePerHour * awsup.HoursPerMonth, true
+	default:
+		return 0, false
+	}
+}
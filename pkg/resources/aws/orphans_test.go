@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	"k8s.io/kops/cloudmock/aws/mockec2"
+	"k8s.io/kops/cloudmock/aws/mockelb"
+	"k8s.io/kops/cloudmock/aws/mockelbv2"
+	"k8s.io/kops/pkg/resources"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+func TestListOrphanedResources(t *testing.T) {
+	ctx := context.Background()
+	cloud := awsup.BuildMockAWSCloud("us-east-1", "abc")
+	clusterName := "me.example.com"
+	ownershipTagKey := "kubernetes.io/cluster/" + clusterName
+
+	c := &mockec2.MockEC2{}
+	cloud.MockEC2 = c
+	cloud.MockELB = &mockelb.MockELB{}
+	cloud.MockELBV2 = &mockelbv2.MockELBV2{EC2: c}
+
+	createVolume := func(ownership string) string {
+		volume, err := c.CreateVolume(ctx, &ec2.CreateVolumeInput{
+			Size:       aws.Int32(20),
+			VolumeType: ec2types.VolumeTypeGp3,
+			TagSpecifications: []ec2types.TagSpecification{
+				{
+					ResourceType: ec2types.ResourceTypeVolume,
+					Tags: []ec2types.Tag{
+						{Key: aws.String(ownershipTagKey), Value: aws.String(ownership)},
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("error creating volume: %v", err)
+		}
+		return aws.ToString(volume.VolumeId)
+	}
+
+	ownedVolume := createVolume("owned")
+	createVolume("shared")
+
+	orphans, err := ListOrphanedResources(cloud, clusterName)
+	if err != nil {
+		t.Fatalf("error listing orphaned resources: %v", err)
+	}
+	if len(orphans) != 1 {
+		t.Fatalf("expected 1 orphaned resource, got %d", len(orphans))
+	}
+	if orphans[0].ID != ownedVolume {
+		t.Fatalf("expected orphaned volume %q, got %q", ownedVolume, orphans[0].ID)
+	}
+
+	cost, ok := EstimateMonthlyCost(orphans[0])
+	if !ok {
+		t.Fatalf("expected the cost of the volume to be known")
+	}
+	if cost != 1.6 {
+		t.Fatalf("expected volume to cost 1.6, got %v", cost)
+	}
+}
+
+func TestEstimateMonthlyCost(t *testing.T) {
+	grid := []struct {
+		obj      interface{}
+		expected float64
+		known    bool
+	}{
+		{
+			obj:      ec2types.Volume{Size: aws.Int32(100), VolumeType: ec2types.VolumeTypeGp2},
+			expected: 10,
+			known:    true,
+		},
+		{
+			obj:   ec2types.Volume{Size: aws.Int32(100), VolumeType: "unknown"},
+			known: false,
+		},
+		{
+			obj:      elbtypes.LoadBalancerDescription{},
+			expected: 18.25,
+			known:    true,
+		},
+		{
+			obj:      ec2types.NetworkInterface{},
+			expected: 0,
+			known:    true,
+		},
+		{
+			obj:      ec2types.NetworkInterface{Association: &ec2types.NetworkInterfaceAssociation{PublicIp: aws.String("1.2.3.4")}},
+			expected: 3.65,
+			known:    true,
+		},
+		{
+			obj:   ec2types.SecurityGroup{},
+			known: false,
+		},
+	}
+	for _, g := range grid {
+		cost, known := EstimateMonthlyCost(&resources.Resource{Obj: g.obj})
+		if known != g.known {
+			t.Errorf("unexpected known=%v for %T", known, g.obj)
+			continue
+		}
+		if known && cost != g.expected {
+			t.Errorf("expected cost %v for %T, got %v", g.expected, g.obj, cost)
+		}
+	}
+}
@@ -84,7 +84,9 @@ func buildTrackerForRouteTable(rt ec2types.RouteTable, clusterName string) *reso
 		Name:    FindName(rt.Tags),
 		ID:      aws.ToString(rt.RouteTableId),
 		Type:    string(ec2types.ResourceTypeRouteTable),
+		Reason:  resources.ReasonTagMatch,
 		Obj:     rt,
+		Tags:    mapEC2TagsToMap(rt.Tags),
 		Dumper:  dumpRouteTable,
 		Deleter: DeleteRouteTable,
 		Shared:  !HasOwnedTag(string(ec2types.ResourceTypeRouteTable)+":"+*rt.RouteTableId, rt.Tags, clusterName),
@@ -128,9 +128,11 @@ func ListSecurityGroups(cloud fi.Cloud, vpcID, clusterName string) ([]*resources
 			Name:    FindName(sg.Tags),
 			ID:      id,
 			Type:    string(ec2types.ResourceTypeSecurityGroup),
+			Reason:  resources.ReasonTagMatch,
 			Deleter: DeleteSecurityGroup,
 			Dumper:  DumpSecurityGroup,
 			Obj:     sg,
+			Tags:    mapEC2TagsToMap(sg.Tags),
 			Shared:  !HasOwnedTag(string(ec2types.ResourceTypeSecurityGroup)+":"+id, sg.Tags, clusterName),
 		}
 
@@ -167,3 +169,106 @@ func DescribeSecurityGroups(cloud fi.Cloud, clusterName string) (map[string]ec2t
 
 	return groups, nil
 }
+
+func DeleteSecurityGroupRule(cloud fi.Cloud, r *resources.Resource) error {
+	ctx := context.TODO()
+	c := cloud.(awsup.AWSCloud)
+
+	rule := r.Obj.(ec2types.SecurityGroupRule)
+	id := r.ID
+	groupID := aws.ToString(rule.GroupId)
+
+	klog.V(2).Infof("Deleting EC2 SecurityGroupRule %q from SecurityGroup %q", id, groupID)
+	var err error
+	if aws.ToBool(rule.IsEgress) {
+		_, err = c.EC2().RevokeSecurityGroupEgress(ctx, &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: []string{id},
+		})
+	} else {
+		_, err = c.EC2().RevokeSecurityGroupIngress(ctx, &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: []string{id},
+		})
+	}
+	if err != nil {
+		switch awsup.AWSErrorCode(err) {
+		case "InvalidGroup.NotFound", "InvalidSecurityGroupRuleId.NotFound":
+			klog.V(2).Infof("Got %s error deleting SecurityGroupRule %q; will treat as already-deleted", awsup.AWSErrorCode(err), id)
+			return nil
+		}
+		return fmt.Errorf("error deleting SecurityGroupRule %q: %v", id, err)
+	}
+	return nil
+}
+
+func DumpSecurityGroupRule(op *resources.DumpOperation, r *resources.Resource) error {
+	data := make(map[string]interface{})
+	data["id"] = r.ID
+	data["type"] = ec2types.ResourceTypeSecurityGroupRule
+	data["raw"] = r.Obj
+	op.Dump.Resources = append(op.Dump.Resources, data)
+	return nil
+}
+
+// ListSecurityGroupRules lists the rules kOps added to security groups that the cluster does not own,
+// such as those referenced by securityGroupOverride. Rules in owned security groups are removed along with the group.
+func ListSecurityGroupRules(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.Resource, error) {
+	ctx := context.TODO()
+	c := cloud.(awsup.AWSCloud)
+
+	groups, err := DescribeSecurityGroups(cloud, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make(map[string]ec2types.SecurityGroupRule)
+	klog.V(2).Infof("Listing EC2 SecurityGroupRules")
+	for _, filters := range buildEC2FiltersForCluster(clusterName) {
+		request := &ec2.DescribeSecurityGroupRulesInput{
+			Filters: filters,
+		}
+		paginator := ec2.NewDescribeSecurityGroupRulesPaginator(c.EC2(), request)
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error listing SecurityGroupRules: %v", err)
+			}
+			for _, rule := range page.SecurityGroupRules {
+				rules[aws.ToString(rule.SecurityGroupRuleId)] = rule
+			}
+		}
+	}
+
+	var resourceTrackers []*resources.Resource
+
+	for id, rule := range rules {
+		groupID := aws.ToString(rule.GroupId)
+		if sg, found := groups[groupID]; found && HasOwnedTag(string(ec2types.ResourceTypeSecurityGroup)+":"+groupID, sg.Tags, clusterName) {
+			continue
+		}
+		if !HasOwnedTag(string(ec2types.ResourceTypeSecurityGroupRule)+":"+id, rule.Tags, clusterName) {
+			continue
+		}
+
+		resourceTracker := &resources.Resource{
+			Name:    FindName(rule.Tags),
+			ID:      id,
+			Type:    string(ec2types.ResourceTypeSecurityGroupRule),
+			Reason:  resources.ReasonTagMatch,
+			Deleter: DeleteSecurityGroupRule,
+			Dumper:  DumpSecurityGroupRule,
+			Obj:     rule,
+			Tags:    mapEC2TagsToMap(rule.Tags),
+		}
+
+		// A rule referencing one of our security groups must be removed before that group can be deleted
+		if rule.ReferencedGroupInfo != nil && rule.ReferencedGroupInfo.GroupId != nil {
+			resourceTracker.Blocks = append(resourceTracker.Blocks, string(ec2types.ResourceTypeSecurityGroup)+":"+aws.ToString(rule.ReferencedGroupInfo.GroupId))
+		}
+
+		resourceTrackers = append(resourceTrackers, resourceTracker)
+	}
+
+	return resourceTrackers, nil
+}
@@ -83,6 +83,7 @@ func ListSQSQueues(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.Reso
 			Name:    queueUrl,
 			ID:      queueUrl,
 			Type:    "sqs",
+			Reason:  resources.ReasonNameMatch,
 			Deleter: DeleteSQSQueue,
 			Dumper:  DumpSQSQueue,
 			Obj:     queueUrl,
@@ -18,7 +18,11 @@ package aws
 
 import (
 	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
 )
@@ -67,3 +71,34 @@ func HasOwnedTag(description string, tags []ec2types.Tag, clusterName string) bo
 	klog.Warningf("cluster tag not found on %s", description)
 	return false
 }
+
+// tagsToMap converts the tags of a resource into a map that is never nil,
+// so that untagged resources can be told apart from resources with unknown tags
+func tagsToMap[T any](tags []T, keyValue func(T) (*string, *string)) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		k, v := keyValue(tag)
+		m[aws.ToString(k)] = aws.ToString(v)
+	}
+	return m
+}
+
+func mapEC2TagsToMap(tags []ec2types.Tag) map[string]string {
+	return tagsToMap(tags, func(t ec2types.Tag) (*string, *string) { return t.Key, t.Value })
+}
+
+func mapAutoscalingTagsToMap(tags []autoscalingtypes.TagDescription) map[string]string {
+	return tagsToMap(tags, func(t autoscalingtypes.TagDescription) (*string, *string) { return t.Key, t.Value })
+}
+
+func mapELBTagsToMap(tags []elbtypes.Tag) map[string]string {
+	return tagsToMap(tags, func(t elbtypes.Tag) (*string, *string) { return t.Key, t.Value })
+}
+
+func mapELBV2TagsToMap(tags []elbv2types.Tag) map[string]string {
+	return tagsToMap(tags, func(t elbv2types.Tag) (*string, *string) { return t.Key, t.Value })
+}
+
+func mapIAMTagsToMap(tags []iamtypes.Tag) map[string]string {
+	return tagsToMap(tags, func(t iamtypes.Tag) (*string, *string) { return t.Key, t.Value })
+}
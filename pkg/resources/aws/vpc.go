@@ -115,9 +115,11 @@ func ListVPCs(cloud fi.Cloud, clusterName string) ([]*resources.Resource, error)
 			Name:    FindName(vpc.Tags),
 			ID:      vpcID,
 			Type:    string(ec2types.ResourceTypeVpc),
+			Reason:  resources.ReasonTagMatch,
 			Deleter: DeleteVPC,
 			Dumper:  DumpVPC,
 			Obj:     vpc,
+			Tags:    mapEC2TagsToMap(vpc.Tags),
 			Shared:  !HasOwnedTag(string(ec2types.ResourceTypeVpc)+":"+vpcID, vpc.Tags, clusterName),
 		}
 
@@ -41,11 +41,15 @@ const (
 	typeRouteTable               = "RouteTable"
 	typeVMScaleSet               = "VMScaleSet"
 	typeVMScaleSetVM             = "VMScaleSetVM"
+	typeVirtualMachine           = "VirtualMachine"
 	typeDisk                     = "Disk"
 	typeRoleAssignment           = "RoleAssignment"
 	typeLoadBalancer             = "LoadBalancer"
 	typePublicIPAddress          = "PublicIPAddress"
 	typeNatGateway               = "NatGateway"
+	typePrivateLinkService       = "PrivateLinkService"
+	typePrivateDNSRecord         = "PrivateDNSRecord"
+	typePrivateDNSZoneLink       = "PrivateDNSZoneLink"
 )
 
 // ListResourcesAzure lists all resources for the cluster by quering Azure.
@@ -110,6 +114,8 @@ func (g *resourceGetter) listAll() ([]*resources.Resource, error) {
 		g.listLoadBalancers,
 		g.listPublicIPAddresses,
 		g.listNatGateways,
+		g.listPrivateLinkServices,
+		g.listPrivateDNSResources,
 	}
 
 	var resources []*resources.Resource
@@ -402,20 +408,19 @@ func (g *resourceGetter) listVMScaleSetsAndRoleAssignments(ctx context.Context)
 			continue
 		}
 
-		vms, err := g.cloud.VMScaleSetVM().List(ctx, g.resourceGroupName(), *vmss.Name)
+		vmrs, err := g.listVMScaleSetVMs(ctx, vmss)
 		if err != nil {
 			return nil, err
 		}
+		rs = append(rs, vmrs...)
 
-		for _, vm := range vms {
-			vmr, err := g.toVMScaleSetVMResource(vmss, vm)
-			if err != nil {
-				return nil, err
-			}
-			rs = append(rs, vmr)
+		// The VM Scale Set deletion is blocked by its instances.
+		var blocked []string
+		for _, vmr := range vmrs {
+			blocked = append(blocked, toKey(vmr.Type, vmr.ID))
 		}
 
-		r, err := g.toVMScaleSetResource(vmss, vms)
+		r, err := g.toVMScaleSetResource(vmss, blocked)
 		if err != nil {
 			return nil, err
 		}
@@ -441,19 +446,41 @@ func (g *resourceGetter) listVMScaleSetsAndRoleAssignments(ctx context.Context)
 	return rs, nil
 }
 
-func (g *resourceGetter) toVMScaleSetResource(vmss *compute.VirtualMachineScaleSet, vms []*compute.VirtualMachineScaleSetVM) (*resources.Resource, error) {
-	// Add resources whose deletion is blocked by this VMSS.
-	var blocks []string
-	blocks = append(blocks, toKey(typeResourceGroup, g.resourceGroupID()))
+// listVMScaleSetVMs lists the instances of the VM Scale Set, which are standalone VMs in Flexible orchestration mode.
+func (g *resourceGetter) listVMScaleSetVMs(ctx context.Context, vmss *compute.VirtualMachineScaleSet) ([]*resources.Resource, error) {
+	var rs []*resources.Resource
+	if vmss.Properties != nil && fi.ValueOf(vmss.Properties.OrchestrationMode) == compute.OrchestrationModeFlexible {
+		vms, err := g.cloud.VirtualMachine().ListByVMScaleSet(ctx, g.resourceGroupName(), *vmss.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, vm := range vms {
+			rs = append(rs, g.toVirtualMachineResource(vmss, vm))
+		}
+		return rs, nil
+	}
 
-	// The VM Scale Set deletion is blocked by its instances.
-	var blocked []string
+	vms, err := g.cloud.VMScaleSetVM().List(ctx, g.resourceGroupName(), *vmss.Name)
+	if err != nil {
+		return nil, err
+	}
 	for _, vm := range vms {
 		if vm == nil || vm.ID == nil {
 			continue
 		}
-		blocked = append(blocked, toKey(typeVMScaleSetVM, *vm.ID))
+		vmr, err := g.toVMScaleSetVMResource(vmss, vm)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, vmr)
 	}
+	return rs, nil
+}
+
+func (g *resourceGetter) toVMScaleSetResource(vmss *compute.VirtualMachineScaleSet, blocked []string) (*resources.Resource, error) {
+	// Add resources whose deletion is blocked by this VMSS.
+	var blocks []string
+	blocks = append(blocks, toKey(typeResourceGroup, g.resourceGroupID()))
 
 	vnets := set.New[string]()
 	subnets := set.New[string]()
@@ -556,6 +583,23 @@ func (g *resourceGetter) toVMScaleSetVMResource(vmss *compute.VirtualMachineScal
 	}, nil
 }
 
+func (g *resourceGetter) toVirtualMachineResource(vmss *compute.VirtualMachineScaleSet, vm *compute.VirtualMachine) *resources.Resource {
+	return &resources.Resource{
+		Obj:    vm,
+		Type:   typeVirtualMachine,
+		ID:     *vm.ID,
+		Name:   *vm.Name,
+		Dumper: DumpVirtualMachine,
+		Deleter: func(_ fi.Cloud, r *resources.Resource) error {
+			return g.cloud.VirtualMachine().Delete(context.TODO(), g.resourceGroupName(), r.Name)
+		},
+		Blocks: []string{
+			toKey(typeResourceGroup, g.resourceGroupID()),
+			toKey(typeVMScaleSet, *vmss.ID),
+		},
+	}
+}
+
 func (g *resourceGetter) deleteVMScaleSet(_ fi.Cloud, r *resources.Resource) error {
 	return g.cloud.VMScaleSet().Delete(context.TODO(), g.resourceGroupName(), r.Name)
 }
@@ -583,7 +627,10 @@ func (g *resourceGetter) toDiskResource(disk *compute.Disk) *resources.Resource
 		// The raw ManagedBy path may not match the listed VM's resource ID,
 		// but parsing it to extract the parent VMSS gives a reliable match.
 		vmID, err := arm.ParseResourceID(*disk.ManagedBy)
-		if err == nil && vmID.Parent != nil {
+		if err == nil && strings.EqualFold(vmID.ResourceType.String(), "Microsoft.Compute/virtualMachines") {
+			// Standalone VMs of VM Scale Sets in Flexible orchestration mode
+			blocked = append(blocked, toKey(typeVirtualMachine, vmID.String()))
+		} else if err == nil && vmID.Parent != nil {
 			blocked = append(blocked, toKey(typeVMScaleSet, vmID.Parent.String()))
 		}
 	}
@@ -779,6 +826,124 @@ func (g *resourceGetter) deleteNatGateway(_ fi.Cloud, r *resources.Resource) err
 	return g.cloud.NatGateway().Delete(context.TODO(), g.resourceGroupName(), r.Name)
 }
 
+func (g *resourceGetter) listPrivateLinkServices(ctx context.Context) ([]*resources.Resource, error) {
+	privateLinkServices, err := g.cloud.PrivateLinkService().List(ctx, g.resourceGroupName())
+	if err != nil {
+		return nil, err
+	}
+
+	var rs []*resources.Resource
+	for _, pls := range privateLinkServices {
+		if !g.isOwnedByCluster(pls.Tags) {
+			continue
+		}
+		r, err := g.toPrivateLinkServiceResource(pls)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+func (g *resourceGetter) toPrivateLinkServiceResource(privateLinkService *network.PrivateLinkService) (*resources.Resource, error) {
+	var blocks []string
+	blocks = append(blocks, toKey(typeResourceGroup, g.resourceGroupID()))
+
+	// The Private Link service must be deleted before the load balancer and the subnet it uses.
+	if privateLinkService.Properties != nil {
+		for _, fip := range privateLinkService.Properties.LoadBalancerFrontendIPConfigurations {
+			if fip.ID == nil {
+				continue
+			}
+			frontend, err := arm.ParseResourceID(*fip.ID)
+			if err != nil {
+				return nil, err
+			}
+			blocks = append(blocks, toKey(typeLoadBalancer, frontend.Parent.String()))
+		}
+		for _, ip := range privateLinkService.Properties.IPConfigurations {
+			if ip.Properties == nil || ip.Properties.Subnet == nil || ip.Properties.Subnet.ID == nil {
+				continue
+			}
+			blocks = append(blocks, toKey(typeSubnet, *ip.Properties.Subnet.ID))
+		}
+	}
+
+	return &resources.Resource{
+		Obj:     privateLinkService,
+		Type:    typePrivateLinkService,
+		ID:      *privateLinkService.ID,
+		Name:    *privateLinkService.Name,
+		Deleter: g.deletePrivateLinkService,
+		Blocks:  blocks,
+	}, nil
+}
+
+func (g *resourceGetter) deletePrivateLinkService(_ fi.Cloud, r *resources.Resource) error {
+	return g.cloud.PrivateLinkService().Delete(context.TODO(), g.resourceGroupName(), r.Name)
+}
+
+// listPrivateDNSResources lists the record and the virtual network links of the API in the private DNS zone.
+// The zone is not owned by the cluster, so only the resources tagged for the cluster are returned.
+func (g *resourceGetter) listPrivateDNSResources(ctx context.Context) ([]*resources.Resource, error) {
+	var rs []*resources.Resource
+	for _, id := range g.clusterInfo.AzurePrivateDNSResourceIDs {
+		rid, err := arm.ParseResourceID(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.EqualFold(rid.ResourceType.String(), "Microsoft.Network/privateDnsZones/virtualNetworkLinks") {
+			link, err := g.cloud.PrivateDNS().GetZoneLink(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if link == nil || !g.isOwnedByCluster(link.Tags) {
+				continue
+			}
+			rs = append(rs, g.toPrivateDNSZoneLinkResource(link, rid.Name))
+		} else {
+			record, err := g.cloud.PrivateDNS().GetARecord(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if record == nil || !g.isOwnedByCluster(record.Metadata) {
+				continue
+			}
+			rs = append(rs, &resources.Resource{
+				Obj:     record,
+				Type:    typePrivateDNSRecord,
+				ID:      record.ID,
+				Name:    rid.Name,
+				Deleter: g.deletePrivateDNSResource,
+			})
+		}
+	}
+	return rs, nil
+}
+
+func (g *resourceGetter) toPrivateDNSZoneLinkResource(link *azure.PrivateDNSZoneLink, name string) *resources.Resource {
+	// Azure refuses to delete a virtual network that is linked to a private DNS zone.
+	var blocks []string
+	if link.VirtualNetworkID != "" {
+		blocks = append(blocks, toKey(typeVirtualNetwork, link.VirtualNetworkID))
+	}
+
+	return &resources.Resource{
+		Obj:     link,
+		Type:    typePrivateDNSZoneLink,
+		ID:      link.ID,
+		Name:    name,
+		Deleter: g.deletePrivateDNSResource,
+		Blocks:  blocks,
+	}
+}
+
+func (g *resourceGetter) deletePrivateDNSResource(_ fi.Cloud, r *resources.Resource) error {
+	return g.cloud.PrivateDNS().Delete(context.TODO(), r.ID)
+}
+
 // isOwnedByCluster returns true if the resource is owned by the cluster.
 func (g *resourceGetter) isOwnedByCluster(tags map[string]*string) bool {
 	for k, v := range tags {
@@ -48,6 +48,11 @@ func TestListResourcesAzure(t *testing.T) {
 		diskID         = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/disks/disk"
 		raID           = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Authorization/roleAssignments/ra"
 		lbID           = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/loadBalancers/lb"
+		plsID          = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/privateLinkServices/pls"
+		zoneID         = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dns/providers/Microsoft.Network/privateDnsZones/example.com"
+		recordID       = zoneID + "/A/api.cluster"
+		linkID         = zoneID + "/virtualNetworkLinks/cluster-vnet"
+		otherLinkID    = zoneID + "/virtualNetworkLinks/cluster-other"
 	)
 
 	rg, _ := arm.ParseResourceID(rgID)
@@ -202,14 +207,52 @@ func TestListResourcesAzure(t *testing.T) {
 		Name: to.Ptr(irrelevantName),
 	}
 
+	plss := cloud.PrivateLinkServicesClient.PLSs
+	plss["pls"] = &network.PrivateLinkService{
+		ID:   to.Ptr(plsID),
+		Name: to.Ptr("pls"),
+		Tags: clusterTags,
+		Properties: &network.PrivateLinkServiceProperties{
+			LoadBalancerFrontendIPConfigurations: []*network.FrontendIPConfiguration{
+				{ID: to.Ptr(lbID + "/frontendIPConfigurations/LoadBalancerFrontEnd")},
+			},
+			IPConfigurations: []*network.PrivateLinkServiceIPConfiguration{
+				{
+					Properties: &network.PrivateLinkServiceIPConfigurationProperties{
+						Subnet: &network.Subnet{ID: to.Ptr(subnetID)},
+					},
+				},
+			},
+		},
+	}
+	plss[irrelevantName] = &network.PrivateLinkService{
+		Name: to.Ptr(irrelevantName),
+	}
+
+	privateDNS := cloud.PrivateDNSClient
+	privateDNS.Records[recordID] = &azure.PrivateDNSARecord{
+		ID:       recordID,
+		Metadata: clusterTags,
+	}
+	privateDNS.Links[linkID] = &azure.PrivateDNSZoneLink{
+		ID:               linkID,
+		VirtualNetworkID: vnetID,
+		Tags:             clusterTags,
+	}
+	// A link with the same name that was not created by kOps must be kept.
+	privateDNS.Links[otherLinkID] = &azure.PrivateDNSZoneLink{
+		ID: otherLinkID,
+	}
+
 	// Call listResourcesAzure.
 	g := resourceGetter{
 		cloud: cloud,
 		clusterInfo: resources.ClusterInfo{
-			Name:                     clusterName,
-			AzureSubscriptionID:      subscriptionID,
-			AzureResourceGroupName:   rgName,
-			AzureResourceGroupShared: true,
+			Name:                       clusterName,
+			AzureSubscriptionID:        subscriptionID,
+			AzureResourceGroupName:     rgName,
+			AzureResourceGroupShared:   true,
+			AzurePrivateDNSResourceIDs: []string{recordID, linkID, otherLinkID},
 		},
 	}
 	actual, err := g.listResourcesAzure()
@@ -301,6 +344,24 @@ func TestListResourcesAzure(t *testing.T) {
 			name:   vmName,
 			blocks: []string{toKey(typeResourceGroup, rgID), toKey(typeVMScaleSet, vmssID)},
 		},
+		toKey(typePrivateLinkService, plsID): {
+			rtype: typePrivateLinkService,
+			name:  "pls",
+			blocks: []string{
+				toKey(typeLoadBalancer, lbID),
+				toKey(typeResourceGroup, rgID),
+				toKey(typeSubnet, subnetID),
+			},
+		},
+		toKey(typePrivateDNSRecord, recordID): {
+			rtype: typePrivateDNSRecord,
+			name:  "api.cluster",
+		},
+		toKey(typePrivateDNSZoneLink, linkID): {
+			rtype:  typePrivateDNSZoneLink,
+			name:   "cluster-vnet",
+			blocks: []string{toKey(typeVirtualNetwork, vnetID)},
+		},
 	}
 	if !reflect.DeepEqual(a, e) {
 		t.Errorf("expected %+v, but got %+v", e, a)
@@ -333,6 +394,11 @@ func TestToDiskResource_ManagedBy(t *testing.T) {
 			managedBy:   to.Ptr(vmssID + "/virtualMachines/0"),
 			wantBlocked: []string{toKey(typeVMScaleSet, vmssID)},
 		},
+		{
+			name:        "ManagedBy standalone VM blocks on VM",
+			managedBy:   to.Ptr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm"),
+			wantBlocked: []string{toKey(typeVirtualMachine, "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm")},
+		},
 	}
 
 	for _, tc := range tests {
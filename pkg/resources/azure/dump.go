@@ -35,6 +35,7 @@ type dumpState struct {
 
 	mutex    sync.Mutex
 	vmssNICs map[string][]*network.Interface // key: "rg/vmss"
+	rgNICs   map[string][]*network.Interface // key: "rg"
 }
 
 func getDumpState(op *resources.DumpOperation) *dumpState {
@@ -138,6 +139,81 @@ func DumpVMScaleSetVM(op *resources.DumpOperation, r *resources.Resource) error
 	return nil
 }
 
+// getResourceGroupNICs lists the network interfaces of the resource group, which include those of standalone VMs.
+func (s *dumpState) getResourceGroupNICs(ctx context.Context, resourceGroupName string) ([]*network.Interface, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.rgNICs == nil {
+		s.rgNICs = make(map[string][]*network.Interface)
+	}
+
+	if cached, ok := s.rgNICs[resourceGroupName]; ok {
+		return cached, nil
+	}
+
+	nis, err := s.cloud.NetworkInterface().List(ctx, resourceGroupName)
+	if err != nil {
+		return nil, err
+	}
+	s.rgNICs[resourceGroupName] = nis
+	return nis, nil
+}
+
+// DumpVirtualMachine dumps a standalone VM, such as a VM of a VM Scale Set in Flexible orchestration mode.
+func DumpVirtualMachine(op *resources.DumpOperation, r *resources.Resource) error {
+	data := make(map[string]interface{})
+	data["id"] = r.ID
+	data["type"] = r.Type
+	data["raw"] = r.Obj
+	op.Dump.Resources = append(op.Dump.Resources, data)
+
+	vm, ok := r.Obj.(*compute.VirtualMachine)
+	if !ok {
+		return fmt.Errorf("expected VirtualMachine, got %T", r.Obj)
+	}
+
+	rid, err := arm.ParseResourceID(r.ID)
+	if err != nil {
+		return err
+	}
+
+	instance := &resources.Instance{Name: r.Name}
+	if vm.Properties != nil && vm.Properties.OSProfile != nil {
+		if vm.Properties.OSProfile.ComputerName != nil {
+			instance.Name = *vm.Properties.OSProfile.ComputerName
+		}
+		if vm.Properties.OSProfile.AdminUsername != nil {
+			instance.SSHUser = *vm.Properties.OSProfile.AdminUsername
+		}
+	}
+
+	nis, err := getDumpState(op).getResourceGroupNICs(op.Context, rid.ResourceGroupName)
+	if err != nil {
+		return err
+	}
+	for _, ni := range nis {
+		if ni == nil || ni.Properties == nil || ni.Properties.VirtualMachine == nil || ni.Properties.VirtualMachine.ID == nil {
+			continue
+		}
+		if !strings.EqualFold(*ni.Properties.VirtualMachine.ID, r.ID) {
+			continue
+		}
+		for _, ip := range ni.Properties.IPConfigurations {
+			if ip == nil || ip.Properties == nil {
+				continue
+			}
+			if ip.Properties.PrivateIPAddress != nil {
+				instance.PrivateAddresses = append(instance.PrivateAddresses, *ip.Properties.PrivateIPAddress)
+			}
+		}
+	}
+
+	instance.Roles = append(instance.Roles, rolesFromTags(vm.Tags)...)
+	op.Dump.Instances = append(op.Dump.Instances, instance)
+	return nil
+}
+
 func rolesFromTags(tags map[string]*string) []string {
 	var roles []string
 	if tags == nil {
@@ -26,4 +26,7 @@ type ClusterInfo struct {
 	AzureResourceGroupShared bool
 	AzureNetworkShared       bool
 	AzureRouteTableShared    bool
+	// AzurePrivateDNSResourceIDs are the IDs of the records and virtual network links
+	// that kOps may have created in the private DNS zone of the API.
+	AzurePrivateDNSResourceIDs []string
 }
@@ -262,6 +262,12 @@ func deleteDroplet(cloud fi.Cloud, t *resources.Resource) error {
 		return fmt.Errorf("failed to delete droplet: %d, err: %s", dropletID, err)
 	}
 
+	if droplet, ok := t.Obj.(godo.Droplet); ok {
+		if err := do.DeleteNodeKeyTag(context.TODO(), c.TagsService(), droplet.Tags); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -19,6 +19,7 @@ package gce
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 
 	compute "google.golang.org/api/compute/v1"
@@ -52,6 +53,7 @@ const (
 	typeDNSRecord            = "DNSRecord"
 	typeServiceAccount       = "ServiceAccount"
 	typeBackendService       = "BackendService"
+	typeWorkloadIdentityPool = "WorkloadIdentityPool"
 )
 
 // Maximum number of `-` separated tokens in a name
@@ -109,6 +111,7 @@ func ListResourcesGCE(gceCloud gce.GCECloud, clusterInfo resources.ClusterInfo)
 		d.listRouters,
 		d.listNetworks,
 		d.listServiceAccounts,
+		d.listWorkloadIdentityPools,
 		d.listBackendServices,
 		d.listHealthchecks,
 		// Note: Order matters here..this is last because it depends on other resources to be listed.
@@ -171,13 +174,15 @@ func (d *clusterDiscoveryGCE) listGCEInstanceTemplates() ([]*resources.Resource,
 	for _, t := range templates {
 		selfLink := t.SelfLink // avoid closure-in-loop go-tcha
 		resourceTracker := &resources.Resource{
-			Name: t.Name,
-			ID:   t.Name,
-			Type: typeInstanceTemplate,
+			Name:   t.Name,
+			ID:     t.Name,
+			Type:   typeInstanceTemplate,
+			Reason: resources.ReasonTagMatch,
 			Deleter: func(cloud fi.Cloud, r *resources.Resource) error {
 				return gce.DeleteInstanceTemplate(d.gceCloud, selfLink)
 			},
-			Obj: t,
+			Obj:  t,
+			Tags: resourceLabels(t.Properties.Labels),
 		}
 
 		for _, ni := range t.Properties.NetworkInterfaces {
@@ -229,6 +234,7 @@ func (d *clusterDiscoveryGCE) listInstanceGroupManagersAndInstances() ([]*resour
 				Name:    mig.Name,
 				ID:      zoneName + "/" + mig.Name,
 				Type:    typeInstanceGroupManager,
+				Reason:  resources.ReasonAttached,
 				Deleter: func(cloud fi.Cloud, r *resources.Resource) error { return gce.DeleteInstanceGroupManager(c, mig) },
 				Obj:     mig,
 			}
@@ -266,9 +272,10 @@ func (d *clusterDiscoveryGCE) listManagedInstances(igm *compute.InstanceGroupMan
 		name := gce.LastComponent(url)
 
 		resourceTracker := &resources.Resource{
-			Name: name,
-			ID:   zoneName + "/" + name,
-			Type: typeInstance,
+			Name:   name,
+			ID:     zoneName + "/" + name,
+			Type:   typeInstance,
+			Reason: resources.ReasonAttached,
 			Deleter: func(cloud fi.Cloud, tracker *resources.Resource) error {
 				return gce.DeleteInstance(c, url)
 			},
@@ -339,8 +346,10 @@ func (d *clusterDiscoveryGCE) listGCEDisks() ([]*resources.Resource, error) {
 			Name:    t.Name,
 			ID:      t.Name,
 			Type:    typeDisk,
+			Reason:  resources.ReasonTagMatch,
 			Deleter: deleteGCEDisk,
 			Obj:     t,
+			Tags:    resourceLabels(t.Labels),
 		}
 
 		for _, u := range t.Users {
@@ -397,6 +406,7 @@ func (d *clusterDiscoveryGCE) listTargetPools() ([]*resources.Resource, error) {
 			Name:    tp.Name,
 			ID:      tp.Name,
 			Type:    typeTargetPool,
+			Reason:  resources.ReasonNameMatch,
 			Deleter: deleteTargetPool,
 			Obj:     tp,
 		}
@@ -415,6 +425,7 @@ func (d *clusterDiscoveryGCE) listTargetPools() ([]*resources.Resource, error) {
 				Name:    hc.Name,
 				ID:      hc.Name,
 				Type:    typeHTTPHealthcheck,
+				Reason:  resources.ReasonAttached,
 				Deleter: deleteHTTPHealthCheck,
 				Obj:     hc,
 			}
@@ -470,6 +481,7 @@ func (d *clusterDiscoveryGCE) listForwardingRules() ([]*resources.Resource, erro
 			Name:    fr.Name,
 			ID:      fr.Name,
 			Type:    typeForwardingRule,
+			Reason:  resources.ReasonNameMatch,
 			Deleter: deleteForwardingRule,
 			Obj:     fr,
 		}
@@ -557,6 +569,7 @@ nextFirewallRule:
 			Name:    firewallRule.Name,
 			ID:      firewallRule.Name,
 			Type:    typeFirewallRule,
+			Reason:  resources.ReasonTagMatch,
 			Deleter: deleteFirewallRule,
 			Obj:     firewallRule,
 		}
@@ -591,6 +604,7 @@ nextFirewallRule:
 				Name:    forwardingRule.Name,
 				ID:      forwardingRule.Name,
 				Type:    typeForwardingRule,
+				Reason:  resources.ReasonAttached,
 				Deleter: deleteForwardingRule,
 				Obj:     forwardingRule,
 			}
@@ -618,6 +632,7 @@ nextFirewallRule:
 				Name:    targetPool.Name,
 				ID:      targetPool.Name,
 				Type:    typeTargetPool,
+				Reason:  resources.ReasonAttached,
 				Deleter: deleteTargetPool,
 				Obj:     targetPool,
 			}
@@ -647,6 +662,7 @@ nextFirewallRule:
 					Name:    hc.Name,
 					ID:      hc.Name,
 					Type:    typeHTTPHealthcheck,
+					Reason:  resources.ReasonAttached,
 					Deleter: deleteHTTPHealthCheck,
 					Obj:     hc,
 				}
@@ -769,6 +785,7 @@ func (d *clusterDiscoveryGCE) listRoutes(ctx context.Context, resourceMap map[st
 				Name:    r.Name,
 				ID:      r.Name,
 				Type:    typeRoute,
+				Reason:  resources.ReasonNameMatch,
 				Deleter: deleteRoute,
 				Obj:     r,
 			}
@@ -831,6 +848,7 @@ func (d *clusterDiscoveryGCE) listAddresses() ([]*resources.Resource, error) {
 			Name:    a.Name,
 			ID:      a.Name,
 			Type:    typeAddress,
+			Reason:  resources.ReasonNameMatch,
 			Deleter: deleteAddress,
 			Obj:     a,
 		}
@@ -904,6 +922,7 @@ func (d *clusterDiscoveryGCE) listSubnets() ([]*resources.Resource, error) {
 			Name:    o.Name,
 			ID:      o.Name,
 			Type:    typeSubnet,
+			Reason:  resources.ReasonNameMatch,
 			Deleter: deleteSubnet,
 			Obj:     o,
 			Dumper:  DumpSubnetwork,
@@ -961,6 +980,7 @@ func (d *clusterDiscoveryGCE) listRouters() ([]*resources.Resource, error) {
 			Name:    o.Name,
 			ID:      o.Name,
 			Type:    typeRouter,
+			Reason:  resources.ReasonNameMatch,
 			Deleter: deleteRouter,
 			Obj:     o,
 		}
@@ -1010,6 +1030,8 @@ func (d *clusterDiscoveryGCE) listServiceAccounts() ([]*resources.Resource, erro
 		}
 		accountID := tokens[0]
 		names := []string{gce.ControlPlane, gce.Bastion, gce.Node}
+		// The ServiceAccounts impersonated by the addons through workload identity federation
+		names = append(names, "dns-controller", "external-dns", "cluster-autoscaler", "cloud-controller", "pd-csi-controller")
 		for _, name := range names {
 			generatedName := gce.ServiceAccountName(name, d.clusterName)
 			if generatedName == accountID {
@@ -1017,6 +1039,7 @@ func (d *clusterDiscoveryGCE) listServiceAccounts() ([]*resources.Resource, erro
 					Name:    gce.LastComponent(sa.Name),
 					ID:      sa.Name,
 					Type:    typeServiceAccount,
+					Reason:  resources.ReasonNameMatch,
 					Deleter: deleteServiceAccount,
 					Obj:     sa,
 				}
@@ -1046,6 +1069,56 @@ func deleteServiceAccount(cloud fi.Cloud, r *resources.Resource) error {
 	return nil
 }
 
+func (d *clusterDiscoveryGCE) listWorkloadIdentityPools() ([]*resources.Resource, error) {
+	c := d.gceCloud
+	ctx := context.Background()
+
+	project, err := c.CloudResourceManager().Projects.Get(c.Project()).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("error getting project %q: %w", c.Project(), err)
+	}
+
+	name := fmt.Sprintf("projects/%d/locations/global/workloadIdentityPools/%s", project.ProjectNumber, gce.WorkloadIdentityPoolID(d.clusterName))
+	pool, err := c.IAM().WorkloadIdentityPools().Get(ctx, name)
+	if err != nil {
+		if gce.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting WorkloadIdentityPool %q: %w", name, err)
+	}
+	if pool.State == "DELETED" {
+		return nil, nil
+	}
+
+	resourceTracker := &resources.Resource{
+		Name:    gce.LastComponent(pool.Name),
+		ID:      pool.Name,
+		Type:    typeWorkloadIdentityPool,
+		Reason:  resources.ReasonNameMatch,
+		Deleter: deleteWorkloadIdentityPool,
+		Obj:     pool,
+	}
+	klog.V(4).Infof("found resource: %s", pool.Name)
+	return []*resources.Resource{resourceTracker}, nil
+}
+
+// deleteWorkloadIdentityPool deletes the pool along with its providers.
+// The pool is soft-deleted, and restored if a cluster with the same name is created within 30 days.
+func deleteWorkloadIdentityPool(cloud fi.Cloud, r *resources.Resource) error {
+	c := cloud.(gce.GCECloud)
+	o := r.Obj.(*iam.WorkloadIdentityPool)
+
+	klog.V(2).Infof("deleting GCE WorkloadIdentityPool %s", o.Name)
+	if err := c.IAM().WorkloadIdentityPools().Delete(context.TODO(), o.Name); err != nil {
+		if gce.IsNotFound(err) {
+			klog.Infof("WorkloadIdentityPool not found, assuming deleted: %q", o.Name)
+			return nil
+		}
+		return fmt.Errorf("error deleting WorkloadIdentityPool %s: %w", o.Name, err)
+	}
+	return nil
+}
+
 // containsOnlyListedIGMs returns true if all the given backend service's backends
 // are contained in the provided list of IGM resources.
 func containsOnlyListedIGMs(svc *compute.BackendService, igms []*resources.Resource) bool {
@@ -1094,9 +1167,10 @@ func (d *clusterDiscoveryGCE) listBackendServices() ([]*resources.Resource, erro
 	for _, svc := range svcs {
 		if containsOnlyListedIGMs(svc, igms) {
 			bs = append(bs, &resources.Resource{
-				Name: svc.Name,
-				ID:   svc.Name,
-				Type: typeBackendService,
+				Name:   svc.Name,
+				ID:     svc.Name,
+				Type:   typeBackendService,
+				Reason: resources.ReasonAttached,
 				Deleter: func(cloud fi.Cloud, r *resources.Resource) error {
 					op, err := c.Compute().RegionBackendServices().Delete(c.Project(), c.Region(), svc.Name)
 					if err != nil {
@@ -1138,9 +1212,10 @@ func (d *clusterDiscoveryGCE) listHealthchecks() ([]*resources.Resource, error)
 	var hcResources []*resources.Resource
 	for hc := range hcs {
 		hcResources = append(hcResources, &resources.Resource{
-			Name: gce.LastComponent(hc),
-			ID:   gce.LastComponent(hc),
-			Type: typeHealthcheck,
+			Name:   gce.LastComponent(hc),
+			ID:     gce.LastComponent(hc),
+			Type:   typeHealthcheck,
+			Reason: resources.ReasonAttached,
 			Deleter: func(cloud fi.Cloud, r *resources.Resource) error {
 				op, err := c.Compute().RegionHealthChecks().Delete(c.Project(), c.Region(), gce.LastComponent(hc))
 				if err != nil {
@@ -1198,6 +1273,7 @@ func (d *clusterDiscoveryGCE) listNetworks() ([]*resources.Resource, error) {
 			Name:    o.Name,
 			ID:      o.Name,
 			Type:    typeNetwork,
+			Reason:  resources.ReasonNameMatch,
 			Deleter: deleteNetwork,
 			Obj:     o,
 			Dumper:  DumpNetwork,
@@ -1232,6 +1308,14 @@ func deleteNetwork(cloud fi.Cloud, r *resources.Resource) error {
 	return c.WaitForOp(op)
 }
 
+// resourceLabels copies the labels of a resource into a map that is never nil,
+// so that unlabeled resources can be told apart from resources with unknown labels
+func resourceLabels(labels map[string]string) map[string]string {
+	m := make(map[string]string, len(labels))
+	maps.Copy(m, labels)
+	return m
+}
+
 func (d *clusterDiscoveryGCE) matchesClusterName(name string) bool {
 	// Names could have hypens in them, so really there is no limit.
 	// 8 hyphens feels like enough for any "reasonable" name
@@ -65,6 +65,11 @@ func ListResources(cloud fi.Cloud, cluster *kops.Cluster) (map[string]*resources
 		clusterInfo.AzureResourceGroupShared = cluster.IsSharedAzureResourceGroup()
 		clusterInfo.AzureNetworkShared = cluster.SharedVPC()
 		clusterInfo.AzureRouteTableShared = cluster.IsSharedAzureRouteTable()
+		privateDNSResourceIDs, err := cloudazure.PrivateAPIDNSResourceIDs(cluster)
+		if err != nil {
+			return nil, err
+		}
+		clusterInfo.AzurePrivateDNSResourceIDs = privateDNSResourceIDs
 		return azure.ListResourcesAzure(cloud.(cloudazure.AzureCloud), clusterInfo)
 	case kops.CloudProviderScaleway:
 		return scaleway.ListResources(cloud.(cloudscaleway.ScwCloud), clusterInfo)
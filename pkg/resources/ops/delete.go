@@ -31,19 +31,13 @@ import (
 
 // DeleteResources deletes the resources, as previously collected by ListResources
 func DeleteResources(cloud fi.Cloud, resourceMap map[string]*resources.Resource, count int, interval, wait time.Duration) error {
-	depMap := make(map[string][]string)
+	depMap := buildDependencies(resourceMap)
 
 	done := make(map[string]*resources.Resource)
 
 	var mutex sync.Mutex
 
 	for k, t := range resourceMap {
-		for _, block := range t.Blocks {
-			depMap[block] = append(depMap[block], k)
-		}
-
-		depMap[k] = append(depMap[k], t.Blocked...)
-
 		if t.Done {
 			done[k] = t
 		}
@@ -179,3 +173,16 @@ func DeleteResources(cloud fi.Cloud, resourceMap map[string]*resources.Resource,
 		time.Sleep(interval)
 	}
 }
+
+// buildDependencies returns, for each resource key, the keys of the resources that must be deleted before it
+func buildDependencies(resourceMap map[string]*resources.Resource) map[string][]string {
+	depMap := make(map[string][]string)
+	for k, t := range resourceMap {
+		for _, block := range t.Blocks {
+			depMap[block] = append(depMap[block], k)
+		}
+
+		depMap[k] = append(depMap[k], t.Blocked...)
+	}
+	return depMap
+}
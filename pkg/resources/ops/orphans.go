@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ops
+
+import (
+	"context"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/resources"
+	awsresources "k8s.io/kops/pkg/resources/aws"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+// OrphanedResources are the resources left behind in one region once a cluster has been deleted
+type OrphanedResources struct {
+	Region string
+	// Cloud is the cloud for the region, to be used when deleting the resources
+	Cloud     fi.Cloud
+	Resources map[string]*resources.Resource
+}
+
+// ListOrphanedResources sweeps all the regions reachable with the cloud credentials for resources
+// that are still tagged for the cluster, typically created by controllers running in the cluster.
+// Clouds that are not supported return no resources.
+func ListOrphanedResources(ctx context.Context, cloud fi.Cloud, clusterName string) ([]*OrphanedResources, error) {
+	switch cloud.ProviderID() {
+	case kops.CloudProviderAWS:
+		return listOrphanedResourcesAWS(ctx, cloud.(awsup.AWSCloud), clusterName)
+	default:
+		klog.V(2).Infof("looking for orphaned resources on %q not (yet) supported", cloud.ProviderID())
+		return nil, nil
+	}
+}
+
+func listOrphanedResourcesAWS(ctx context.Context, cloud awsup.AWSCloud, clusterName string) ([]*OrphanedResources, error) {
+	regions, err := awsup.AllRegionNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []*OrphanedResources
+	for _, region := range regions {
+		regionCloud := cloud
+		if region != cloud.Region() {
+			regionCloud, err = awsup.NewAWSCloud(region, cloud.Tags())
+			if err != nil {
+				klog.Warningf("skipping region %q: error initializing AWS client: %v", region, err)
+				continue
+			}
+		}
+
+		// Regions can be unreachable, for example when disabled by an SCP, so we only warn
+		l, err := awsresources.ListOrphanedResources(regionCloud, clusterName)
+		if err != nil {
+			klog.Warningf("skipping region %q: %v", region, err)
+			continue
+		}
+		if len(l) == 0 {
+			continue
+		}
+
+		o := &OrphanedResources{
+			Region:    region,
+			Cloud:     regionCloud,
+			Resources: make(map[string]*resources.Resource),
+		}
+		for _, r := range l {
+			o.Resources[r.Type+":"+r.ID] = r
+		}
+		orphans = append(orphans, o)
+	}
+
+	return orphans, nil
+}
+
+// EstimateMonthlyCost returns the approximate monthly cost in USD of keeping the resource,
+// or false if it is not known
+func EstimateMonthlyCost(cloud fi.Cloud, r *resources.Resource) (float64, bool) {
+	switch cloud.ProviderID() {
+	case kops.CloudProviderAWS:
+		return awsresources.EstimateMonthlyCost(r)
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ops
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"k8s.io/kops/pkg/resources"
+)
+
+// PlanDeletion groups the resources in the order DeleteResources deletes them: the resources in a phase
+// only wait for resources in earlier phases. Resources with dependencies that cannot be resolved are
+// returned in a last phase, where deletion is attempted until it succeeds or times out.
+func PlanDeletion(resourceMap map[string]*resources.Resource) [][]*resources.Resource {
+	depMap := buildDependencies(resourceMap)
+
+	done := make(map[string]bool)
+	for k, t := range resourceMap {
+		if t.Done {
+			done[k] = true
+		}
+	}
+
+	var phases [][]*resources.Resource
+	for len(done) < len(resourceMap) {
+		var phase []*resources.Resource
+		for k, r := range resourceMap {
+			if done[k] {
+				continue
+			}
+
+			ready := true
+			for _, dep := range depMap[k] {
+				if !done[dep] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				phase = append(phase, r)
+			}
+		}
+
+		if len(phase) == 0 {
+			for k, r := range resourceMap {
+				if !done[k] {
+					phase = append(phase, r)
+				}
+			}
+		}
+
+		for _, r := range phase {
+			done[r.Type+":"+r.ID] = true
+		}
+		sortResources(phase)
+		phases = append(phases, phase)
+	}
+
+	return phases
+}
+
+// ExcludeResources removes the resources matching any of the filters from resourceMap, along with
+// the resources that can only be deleted after them. Filters have the form TYPE:VALUE, where VALUE
+// is matched against the ID and the name of the resource; both parts can use shell patterns.
+// It returns the removed resources, keyed like resourceMap, with the reason they are not deleted.
+func ExcludeResources(resourceMap map[string]*resources.Resource, filters []string) (map[string]string, error) {
+	type filter struct {
+		resourceType string
+		value        string
+	}
+	var parsed []filter
+	for _, f := range filters {
+		resourceType, value, found := strings.Cut(f, ":")
+		if !found || resourceType == "" || value == "" {
+			return nil, fmt.Errorf("invalid resource filter %q, expected TYPE:VALUE", f)
+		}
+		if _, err := path.Match(resourceType, ""); err != nil {
+			return nil, fmt.Errorf("invalid resource filter %q: %w", f, err)
+		}
+		if _, err := path.Match(value, ""); err != nil {
+			return nil, fmt.Errorf("invalid resource filter %q: %w", f, err)
+		}
+		parsed = append(parsed, filter{resourceType: resourceType, value: value})
+	}
+
+	matches := func(pattern, s string) bool {
+		match, _ := path.Match(pattern, s)
+		return match
+	}
+
+	excluded := make(map[string]string)
+	for k, r := range resourceMap {
+		for _, f := range parsed {
+			if !matches(f.resourceType, r.Type) {
+				continue
+			}
+			if matches(f.value, r.ID) || (r.Name != "" && matches(f.value, r.Name)) {
+				excluded[k] = "excluded by " + f.resourceType + ":" + f.value
+				break
+			}
+		}
+	}
+
+	// A resource that waits for an excluded resource can never be deleted
+	depMap := buildDependencies(resourceMap)
+	for {
+		changed := false
+		for k := range resourceMap {
+			if _, found := excluded[k]; found {
+				continue
+			}
+			for _, dep := range depMap[k] {
+				if _, found := excluded[dep]; found {
+					excluded[k] = "depends on excluded " + dep
+					changed = true
+					break
+				}
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	for k := range excluded {
+		delete(resourceMap, k)
+	}
+
+	return excluded, nil
+}
+
+func sortResources(l []*resources.Resource) {
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].Type != l[j].Type {
+			return l[i].Type < l[j].Type
+		}
+		if l[i].Name != l[j].Name {
+			return l[i].Name < l[j].Name
+		}
+		return l[i].ID < l[j].ID
+	})
+}
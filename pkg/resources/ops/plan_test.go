@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ops
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kops/pkg/resources"
+)
+
+func buildTestResources() map[string]*resources.Resource {
+	l := []*resources.Resource{
+		{Type: "vpc", ID: "vpc-1", Name: "cluster"},
+		{Type: "subnet", ID: "subnet-1", Name: "us-east-1a", Blocks: []string{"vpc:vpc-1"}},
+		{Type: "security-group", ID: "sg-1", Name: "nodes", Blocks: []string{"vpc:vpc-1"}},
+		{Type: "instance", ID: "i-1", Name: "node", Blocks: []string{"subnet:subnet-1", "security-group:sg-1"}},
+		{Type: "volume", ID: "vol-1", Name: "etcd", Blocked: []string{"instance:i-1"}},
+	}
+	m := make(map[string]*resources.Resource)
+	for _, r := range l {
+		m[r.Type+":"+r.ID] = r
+	}
+	return m
+}
+
+func TestPlanDeletion(t *testing.T) {
+	phases := PlanDeletion(buildTestResources())
+
+	var actual [][]string
+	for _, phase := range phases {
+		var keys []string
+		for _, r := range phase {
+			keys = append(keys, r.Type+":"+r.ID)
+		}
+		actual = append(actual, keys)
+	}
+
+	expected := [][]string{
+		{"instance:i-1"},
+		{"security-group:sg-1", "subnet:subnet-1", "volume:vol-1"},
+		{"vpc:vpc-1"},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("unexpected plan, expected=%v, actual=%v", expected, actual)
+	}
+}
+
+func TestExcludeResources(t *testing.T) {
+	grid := []struct {
+		filters  []string
+		expected map[string]string
+	}{
+		{
+			filters:  nil,
+			expected: map[string]string{},
+		},
+		{
+			filters: []string{"volume:etcd"},
+			expected: map[string]string{
+				"volume:vol-1": "excluded by volume:etcd",
+			},
+		},
+		{
+			filters: []string{"subnet:subnet-*"},
+			expected: map[string]string{
+				"subnet:subnet-1": "excluded by subnet:subnet-*",
+				"vpc:vpc-1":       "depends on excluded subnet:subnet-1",
+			},
+		},
+		{
+			filters: []string{"*:i-1"},
+			expected: map[string]string{
+				"instance:i-1":        "excluded by *:i-1",
+				"security-group:sg-1": "depends on excluded instance:i-1",
+				"subnet:subnet-1":     "depends on excluded instance:i-1",
+				"volume:vol-1":        "depends on excluded instance:i-1",
+				"vpc:vpc-1":           "",
+			},
+		},
+	}
+	for _, g := range grid {
+		resourceMap := buildTestResources()
+		excluded, err := ExcludeResources(resourceMap, g.filters)
+		if err != nil {
+			t.Fatalf("unexpected error for %v: %v", g.filters, err)
+		}
+		if len(excluded) != len(g.expected) {
+			t.Errorf("unexpected exclusions for %v: %v", g.filters, excluded)
+			continue
+		}
+		for k, reason := range g.expected {
+			actual, found := excluded[k]
+			if !found {
+				t.Errorf("expected %q to be excluded for %v", k, g.filters)
+			}
+			// The reason for transitive exclusions depends on the order they are found in
+			if reason != "" && actual != reason {
+				t.Errorf("unexpected reason for %q with %v: %q", k, g.filters, actual)
+			}
+			if _, found := resourceMap[k]; found {
+				t.Errorf("expected %q to be removed for %v", k, g.filters)
+			}
+		}
+	}
+}
+
+func TestExcludeResourcesInvalidFilter(t *testing.T) {
+	for _, filter := range []string{"volume", "volume:", ":vol-1", "volume:[vol"} {
+		if _, err := ExcludeResources(buildTestResources(), []string{filter}); err == nil {
+			t.Errorf("expected error for filter %q", filter)
+		}
+	}
+}
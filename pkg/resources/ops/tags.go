@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ops
+
+import (
+	"fmt"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/resources"
+)
+
+// TagViolation describes a resource whose tags do not comply with the tag policy of the cluster.
+type TagViolation struct {
+	Resource *resources.Resource
+	// Problems describes each required tag that is missing or has an invalid value.
+	Problems []string
+}
+
+// VerifyTags checks the tags of the resources of the cluster against its tag policy.
+// Shared resources are not checked. Resources whose tags are not known are returned as unverified.
+func VerifyTags(cluster *kops.Cluster, resourceMap map[string]*resources.Resource) (violations []*TagViolation, unverified []*resources.Resource, err error) {
+	if cluster.Spec.TagPolicy == nil {
+		return nil, nil, fmt.Errorf("cluster %q does not have a tag policy", cluster.ObjectMeta.Name)
+	}
+	policyTags, err := cluster.RenderPolicyTags()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var l []*resources.Resource
+	for _, r := range resourceMap {
+		l = append(l, r)
+	}
+	sortResources(l)
+
+	for _, r := range l {
+		if r.Shared {
+			continue
+		}
+		if r.Tags == nil {
+			unverified = append(unverified, r)
+			continue
+		}
+
+		var problems []string
+		for i := range cluster.Spec.TagPolicy.RequiredTags {
+			tag := &cluster.Spec.TagPolicy.RequiredTags[i]
+			value, found := r.Tags[tag.Key]
+			if !found {
+				problems = append(problems, fmt.Sprintf("tag %q is missing", tag.Key))
+				continue
+			}
+			if err := tag.CheckValue(value, policyTags); err != nil {
+				problems = append(problems, err.Error())
+			}
+		}
+		if len(problems) != 0 {
+			violations = append(violations, &TagViolation{Resource: r, Problems: problems})
+		}
+	}
+
+	return violations, unverified, nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ops
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/resources"
+)
+
+func TestVerifyTags(t *testing.T) {
+	cluster := &kops.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "example.com"},
+		Spec: kops.ClusterSpec{
+			CloudLabels: map[string]string{"team": "platform"},
+			TagPolicy: &kops.TagPolicySpec{
+				RequiredTags: []kops.RequiredTagSpec{
+					{Key: "owner", Value: "{{ .CloudLabels.team }}-{{ .ClusterName }}"},
+					{Key: "env", AllowedValues: []string{"dev", "prod"}},
+				},
+			},
+		},
+	}
+
+	resourceMap := map[string]*resources.Resource{
+		"instance:i-1": {Type: "instance", ID: "i-1", Tags: map[string]string{"owner": "platform-example.com", "env": "prod"}},
+		"instance:i-2": {Type: "instance", ID: "i-2", Tags: map[string]string{"owner": "someone", "env": "staging"}},
+		"volume:vol-1": {Type: "volume", ID: "vol-1", Tags: map[string]string{}},
+		"vpc:vpc-1":    {Type: "vpc", ID: "vpc-1", Shared: true, Tags: map[string]string{}},
+		"iam-role:r-1": {Type: "iam-role", ID: "r-1"},
+	}
+
+	violations, unverified, err := VerifyTags(cluster, resourceMap)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := make(map[string][]string)
+	for _, v := range violations {
+		actual[v.Resource.Type+":"+v.Resource.ID] = v.Problems
+	}
+	expected := map[string][]string{
+		"instance:i-2": {
+			`tag "owner" is "someone", expected "platform-example.com"`,
+			`tag "env" is "staging", which is not one of the allowed values ["dev" "prod"]`,
+		},
+		"volume:vol-1": {
+			`tag "owner" is missing`,
+			`tag "env" is missing`,
+		},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("unexpected violations, expected=%q, actual=%q", expected, actual)
+	}
+
+	if len(unverified) != 1 || unverified[0].ID != "r-1" {
+		t.Errorf("unexpected unverified resources: %v", unverified)
+	}
+}
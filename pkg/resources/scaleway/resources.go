@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	block "github.com/scaleway/scaleway-sdk-go/api/block/v1alpha1"
 	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"k8s.io/kops/pkg/resources"
@@ -38,6 +39,7 @@ const (
 	resourceTypeServerIP     = "server-IP"
 	resourceTypeSSHKey       = "ssh-key"
 	resourceTypeVolume       = "volume"
+	resourceTypeBlockVolume  = "block-volume"
 )
 
 type listFn func(fi.Cloud, string) ([]*resources.Resource, error)
@@ -52,6 +54,7 @@ func ListResources(cloud scaleway.ScwCloud, clusterInfo resources.ClusterInfo) (
 		listServerIPs,
 		listSSHKeys,
 		listVolumes,
+		listBlockVolumes,
 	}
 	if !strings.HasSuffix(clusterName, ".k8s.local") && !clusterInfo.UsesNoneDNS {
 		listFunctions = append(listFunctions, listDNSRecords)
@@ -141,24 +144,26 @@ func listServers(cloud fi.Cloud, clusterName string) ([]*resources.Resource, err
 func listServerIPs(cloud fi.Cloud, clusterName string) ([]*resources.Resource, error) {
 	c := cloud.(scaleway.ScwCloud)
 
-	ips, err := c.InstanceService().ListIPs(&instance.ListIPsRequest{
-		Zone: scw.Zone(c.Zone()),
-		Tags: []string{fmt.Sprintf("%s=%s", scaleway.TagClusterName, clusterName)},
-	}, scw.WithAllPages())
-	if err != nil {
-		return nil, fmt.Errorf("listing IPs for deletion: %w", err)
-	}
-
 	resourceTrackers := []*resources.Resource(nil)
-	for _, ip := range ips.IPs {
-		resourceTracker := &resources.Resource{
-			Name:    ip.Address.String(),
-			ID:      ip.ID,
-			Type:    resourceTypeServerIP,
-			Deleter: deleteServerIP,
-			Obj:     ip,
+	for _, zone := range c.Zones() {
+		ips, err := c.InstanceService().ListIPs(&instance.ListIPsRequest{
+			Zone: zone,
+			Tags: []string{fmt.Sprintf("%s=%s", scaleway.TagClusterName, clusterName)},
+		}, scw.WithAllPages())
+		if err != nil {
+			return nil, fmt.Errorf("listing IPs in zone %s for deletion: %w", zone, err)
+		}
+
+		for _, ip := range ips.IPs {
+			resourceTracker := &resources.Resource{
+				Name:    ip.Address.String(),
+				ID:      ip.ID,
+				Type:    resourceTypeServerIP,
+				Deleter: deleteServerIP,
+				Obj:     ip,
+			}
+			resourceTrackers = append(resourceTrackers, resourceTracker)
 		}
-		resourceTrackers = append(resourceTrackers, resourceTracker)
 	}
 
 	return resourceTrackers, nil
@@ -211,6 +216,33 @@ func listVolumes(cloud fi.Cloud, clusterName string) ([]*resources.Resource, err
 	return resourceTrackers, nil
 }
 
+func listBlockVolumes(cloud fi.Cloud, clusterName string) ([]*resources.Resource, error) {
+	c := cloud.(scaleway.ScwCloud)
+	volumes, err := c.GetClusterBlockVolumes(clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceTrackers := []*resources.Resource(nil)
+	for _, volume := range volumes {
+		resourceTracker := &resources.Resource{
+			Name:    volume.Name,
+			ID:      volume.ID,
+			Type:    resourceTypeBlockVolume,
+			Deleter: deleteBlockVolume,
+			Obj:     volume,
+		}
+		for _, reference := range volume.References {
+			if reference.ProductResourceType == "instance_server" {
+				resourceTracker.Blocked = append(resourceTracker.Blocked, resourceTypeServer+":"+reference.ProductResourceID)
+			}
+		}
+		resourceTrackers = append(resourceTrackers, resourceTracker)
+	}
+
+	return resourceTrackers, nil
+}
+
 func deleteDNSRecord(cloud fi.Cloud, tracker *resources.Resource, domainName string) error {
 	c := cloud.(scaleway.ScwCloud)
 	record := tracker.Obj.(*domain.Record)
@@ -260,3 +292,10 @@ func deleteVolume(cloud fi.Cloud, tracker *resources.Resource) error {
 
 	return c.DeleteVolume(volume)
 }
+
+func deleteBlockVolume(cloud fi.Cloud, tracker *resources.Resource) error {
+	c := cloud.(scaleway.ScwCloud)
+	volume := tracker.Obj.(*block.Volume)
+
+	return c.DeleteBlockVolume(volume)
+}
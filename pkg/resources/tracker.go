@@ -20,6 +20,16 @@ import (
 	"k8s.io/kops/upup/pkg/fi"
 )
 
+// Reasons why a resource is believed to belong to the cluster
+const (
+	// ReasonTagMatch is used for resources that are tagged or labeled for the cluster
+	ReasonTagMatch = "tag match"
+	// ReasonNameMatch is used for resources whose name is derived from the cluster name
+	ReasonNameMatch = "name match"
+	// ReasonAttached is used for resources that are attached to another resource of the cluster
+	ReasonAttached = "attached to cluster resource"
+)
+
 type Resource struct {
 	Name string
 	Type string
@@ -28,6 +38,12 @@ type Resource struct {
 	// If true, this resource is not owned by the cluster
 	Shared bool
 
+	// Reason explains why the resource is believed to belong to the cluster, if known
+	Reason string
+
+	// Tags are the tags or labels of the resource, if known
+	Tags map[string]string
+
 	Blocks  []string
 	Blocked []string
 	Done    bool
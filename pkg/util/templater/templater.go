@@ -19,6 +19,10 @@ package templater
 import (
 	"bytes"
 	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"text/template"
 
@@ -43,9 +47,50 @@ func NewTemplater(channel *kops.Channel) *Templater {
 
 // Render is responsible for actually rendering the template
 func (r *Templater) Render(content string, context map[string]interface{}, snippets map[string]string, failOnMissing bool) (rendered string, err error) {
+	return r.render(content, context, snippets, failOnMissing, nil)
+}
+
+// RenderFile renders the template in the file at filePath. The template can render other template files
+// with the includeTemplate function; their paths are relative to the directory of the including template.
+func (r *Templater) RenderFile(filePath string, context map[string]interface{}, snippets map[string]string, failOnMissing bool) (string, error) {
+	return r.renderFile(filePath, context, snippets, failOnMissing, nil)
+}
+
+// renderFile renders the template file, where includes holds the chain of templates including it
+func (r *Templater) renderFile(filePath string, context map[string]interface{}, snippets map[string]string, failOnMissing bool, includes []string) (string, error) {
+	filePath = filepath.Clean(filePath)
+	if slices.Contains(includes, filePath) {
+		return "", fmt.Errorf("template include cycle: %s", strings.Join(append(includes, filePath), " -> "))
+	}
+	includes = append(includes, filePath)
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("unable to read template: %s, error: %s", filePath, err)
+	}
+
+	funcs := template.FuncMap{
+		"includeTemplate": func(name string, context map[string]interface{}) (string, error) {
+			if !filepath.IsAbs(name) {
+				name = filepath.Join(filepath.Dir(filePath), name)
+			}
+			return r.renderFile(name, context, snippets, failOnMissing, slices.Clone(includes))
+		},
+	}
+	rendered, err := r.render(string(content), context, snippets, failOnMissing, funcs)
+	if err != nil && len(includes) > 1 {
+		return "", fmt.Errorf("template: %s, issue: %s", filePath, err)
+	}
+	return rendered, err
+}
+
+// render renders the template content, with extra template functions in addition to the default ones
+func (r *Templater) render(content string, context map[string]interface{}, snippets map[string]string, failOnMissing bool, extraFuncs template.FuncMap) (rendered string, err error) {
 	// @step: create the template
 	tm := template.New(templateName)
-	if _, err = tm.Funcs(r.templateFuncsMap(tm)).Parse(content); err != nil {
+	funcs := r.templateFuncsMap(tm)
+	maps.Copy(funcs, extraFuncs)
+	if _, err = tm.Funcs(funcs).Parse(content); err != nil {
 		return
 	}
 	if failOnMissing {
@@ -18,6 +18,8 @@ package templater
 
 import (
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"k8s.io/kops/pkg/diff"
@@ -186,6 +188,54 @@ func TestAllowForMissingVars(t *testing.T) {
 	makeRenderTests(t, cases)
 }
 
+func TestRenderFileIncludeTemplate(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"cluster.yaml":           "{{ range $name, $ig := .instanceGroups }}{{ includeTemplate \"igs/ig.yaml\" (dict \"name\" $name \"ig\" $ig) }}{{ end }}",
+		"igs/ig.yaml":            "name: {{ .name }}\n{{ includeTemplate \"size.yaml\" .ig }}",
+		"igs/size.yaml":          "maxSize: {{ .maxSize }}\n",
+		"cycle.yaml":             "{{ includeTemplate \"cycle-include.yaml\" . }}",
+		"cycle-include.yaml":     "{{ includeTemplate \"cycle.yaml\" . }}",
+		"missing.yaml":           "{{ includeTemplate \"igs/missing-value.yaml\" . }}",
+		"igs/missing-value.yaml": "{{ .missing }}",
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatalf("error creating directory: %v", err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatalf("error writing template: %v", err)
+		}
+	}
+
+	r := NewTemplater(nil)
+	context := map[string]interface{}{
+		"instanceGroups": map[string]interface{}{
+			"nodes-a": map[string]interface{}{"maxSize": 3},
+			"nodes-b": map[string]interface{}{"maxSize": 5},
+		},
+	}
+	rendered, err := r.RenderFile(filepath.Join(dir, "cluster.yaml"), context, nil, true)
+	if err != nil {
+		t.Fatalf("failed to render template, error: %s", err)
+	}
+	expected := "name: nodes-a\nmaxSize: 3\nname: nodes-b\nmaxSize: 5\n"
+	if rendered != expected {
+		t.Errorf("unexpected output:\n%s", diff.FormatDiff(expected, rendered))
+	}
+
+	_, err = r.RenderFile(filepath.Join(dir, "cycle.yaml"), context, nil, true)
+	if err == nil || !strings.Contains(err.Error(), "template include cycle") {
+		t.Errorf("expected include cycle error, got %v", err)
+	}
+
+	_, err = r.RenderFile(filepath.Join(dir, "missing.yaml"), context, nil, true)
+	if err == nil || !strings.Contains(err.Error(), filepath.Join(dir, "igs/missing-value.yaml")) {
+		t.Errorf("expected error naming the included template, got %v", err)
+	}
+}
+
 func TestRenderIntegration(t *testing.T) {
 	var cases []renderTest
 	content, err := os.ReadFile("integration_tests.yml")
@@ -0,0 +1,376 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templater
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+// supportedSchemaTypes are the JSON Schema types of values
+var supportedSchemaTypes = []string{"array", "boolean", "integer", "null", "number", "object", "string"}
+
+// ValuesSchema is a JSON Schema describing the values of templates.
+// Only the keywords needed to type and constrain values are supported; schemas using other keywords are rejected.
+type ValuesSchema struct {
+	Schema      string        `json:"$schema,omitempty"`
+	ID          string        `json:"$id,omitempty"`
+	Title       string        `json:"title,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Examples    []interface{} `json:"examples,omitempty"`
+
+	// Type is the type, or the list of allowed types, of the value
+	Type SchemaTypes `json:"type,omitempty"`
+	// Default is the value used when the property is not set
+	Default interface{} `json:"default,omitempty"`
+	// Enum is the list of allowed values
+	Enum []interface{} `json:"enum,omitempty"`
+
+	// Properties are the schemas of the properties of an object
+	Properties map[string]*ValuesSchema `json:"properties,omitempty"`
+	// Required are the properties of an object that must be set
+	Required []string `json:"required,omitempty"`
+	// AdditionalProperties is false if an object cannot have other properties, or the schema of the other properties
+	AdditionalProperties *AdditionalProperties `json:"additionalProperties,omitempty"`
+
+	// Items is the schema of the items of an array
+	Items    *ValuesSchema `json:"items,omitempty"`
+	MinItems *int          `json:"minItems,omitempty"`
+	MaxItems *int          `json:"maxItems,omitempty"`
+
+	Minimum *float64 `json:"minimum,omitempty"`
+	Maximum *float64 `json:"maximum,omitempty"`
+
+	MinLength *int   `json:"minLength,omitempty"`
+	MaxLength *int   `json:"maxLength,omitempty"`
+	Pattern   string `json:"pattern,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// SchemaTypes is the list of types of a schema, written as a string or a list of strings
+type SchemaTypes []string
+
+func (t *SchemaTypes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*t = SchemaTypes{s}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("type must be a string or a list of strings")
+	}
+	*t = list
+	return nil
+}
+
+// AdditionalProperties is the additionalProperties keyword, written as a boolean or a schema
+type AdditionalProperties struct {
+	Allowed bool
+	Schema  *ValuesSchema
+}
+
+func (a *AdditionalProperties) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &a.Allowed); err == nil {
+		return nil
+	}
+	a.Allowed = true
+	a.Schema = &ValuesSchema{}
+	return unmarshalSchema(data, a.Schema)
+}
+
+// ParseValuesSchema parses a values schema in YAML or JSON.
+func ParseValuesSchema(data []byte) (*ValuesSchema, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	schema := &ValuesSchema{}
+	if err := unmarshalSchema(jsonData, schema); err != nil {
+		return nil, err
+	}
+	if err := schema.compile(field.NewPath("schema")); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// unmarshalSchema decodes a schema, rejecting keywords that are not supported
+func unmarshalSchema(data []byte, schema *ValuesSchema) error {
+	return yaml.UnmarshalStrict(data, schema)
+}
+
+// compile checks the schema and compiles its patterns
+func (s *ValuesSchema) compile(fldPath *field.Path) error {
+	for _, t := range s.Type {
+		if !slices.Contains(supportedSchemaTypes, t) {
+			return fmt.Errorf("%s: unsupported type %q, supported types are %s", fldPath.Child("type"), t, strings.Join(supportedSchemaTypes, ", "))
+		}
+	}
+	if s.Pattern != "" {
+		pattern, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return fmt.Errorf("%s: %w", fldPath.Child("pattern"), err)
+		}
+		s.pattern = pattern
+	}
+	for name, property := range s.Properties {
+		if err := property.compile(fldPath.Child("properties").Key(name)); err != nil {
+			return err
+		}
+	}
+	if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+		if err := s.AdditionalProperties.Schema.compile(fldPath.Child("additionalProperties")); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		if err := s.Items.compile(fldPath.Child("items")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyDefaults sets the default values of the properties that are not set in values.
+func (s *ValuesSchema) ApplyDefaults(values map[string]interface{}) {
+	s.applyDefaults(values)
+}
+
+func (s *ValuesSchema) applyDefaults(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, property := range s.Properties {
+			if _, found := v[name]; !found && property.Default != nil {
+				v[name] = runtime.DeepCopyJSONValue(property.Default)
+			}
+			if child, found := v[name]; found {
+				property.applyDefaults(child)
+			}
+		}
+		if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+			for name, child := range v {
+				if _, found := s.Properties[name]; !found {
+					s.AdditionalProperties.Schema.applyDefaults(child)
+				}
+			}
+		}
+	case []interface{}:
+		if s.Items != nil {
+			for _, item := range v {
+				s.Items.applyDefaults(item)
+			}
+		}
+	}
+}
+
+// Validate validates values against the schema.
+// The paths of the errors are the paths of the offending values, for example "instanceGroups.nodes.maxSize".
+func (s *ValuesSchema) Validate(values map[string]interface{}) field.ErrorList {
+	return s.validate(nil, values)
+}
+
+func (s *ValuesSchema) validate(fldPath *field.Path, value interface{}) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(s.Type) != 0 && !slices.ContainsFunc(s.Type, func(t string) bool { return isSchemaType(value, t) }) {
+		return append(allErrs, field.TypeInvalid(fldPath, value, "must be of type "+strings.Join(s.Type, " or ")))
+	}
+
+	if len(s.Enum) != 0 && !slices.ContainsFunc(s.Enum, func(e interface{}) bool { return valuesEqual(e, value) }) {
+		var allowed []string
+		for _, e := range s.Enum {
+			if str, ok := e.(string); ok {
+				allowed = append(allowed, str)
+			} else {
+				b, _ := json.Marshal(e)
+				allowed = append(allowed, string(b))
+			}
+		}
+		allErrs = append(allErrs, field.NotSupported(fldPath, value, allowed))
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, name := range s.Required {
+			if _, found := v[name]; !found {
+				allErrs = append(allErrs, field.Required(childPath(fldPath, name), ""))
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(v)) {
+			if property, found := s.Properties[name]; found {
+				allErrs = append(allErrs, property.validate(childPath(fldPath, name), v[name])...)
+			} else if s.AdditionalProperties != nil {
+				if !s.AdditionalProperties.Allowed {
+					allErrs = append(allErrs, field.Forbidden(childPath(fldPath, name), "is not a property of the schema"))
+				} else if s.AdditionalProperties.Schema != nil {
+					allErrs = append(allErrs, s.AdditionalProperties.Schema.validate(childPath(fldPath, name), v[name])...)
+				}
+			}
+		}
+
+	case []interface{}:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, fmt.Sprintf("must have at least %d items", *s.MinItems)))
+		}
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			allErrs = append(allErrs, field.TooMany(fldPath, len(v), *s.MaxItems))
+		}
+		if s.Items != nil {
+			for i, item := range v {
+				allErrs = append(allErrs, s.Items.validate(fldPath.Index(i), item)...)
+			}
+		}
+
+	case string:
+		length := len([]rune(v))
+		if s.MinLength != nil && length < *s.MinLength {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, fmt.Sprintf("must be at least %d characters long", *s.MinLength)))
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			allErrs = append(allErrs, field.TooLongCharacters(fldPath, v, *s.MaxLength))
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, fmt.Sprintf("must match the regular expression %q", s.Pattern)))
+		}
+
+	default:
+		if n, ok := toFloat(value); ok {
+			if s.Minimum != nil && n < *s.Minimum {
+				allErrs = append(allErrs, field.Invalid(fldPath, value, fmt.Sprintf("must be greater than or equal to %v", *s.Minimum)))
+			}
+			if s.Maximum != nil && n > *s.Maximum {
+				allErrs = append(allErrs, field.Invalid(fldPath, value, fmt.Sprintf("must be less than or equal to %v", *s.Maximum)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// childPath returns the path of a property; the properties of the values are at the root
+func childPath(fldPath *field.Path, name string) *field.Path {
+	if fldPath == nil {
+		return field.NewPath(name)
+	}
+	return fldPath.Child(name)
+}
+
+// isSchemaType returns true if value is of the JSON Schema type t
+func isSchemaType(value interface{}, t string) bool {
+	switch t {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	case "number":
+		_, ok := toFloat(value)
+		return ok
+	case "integer":
+		n, ok := toFloat(value)
+		return ok && n == math.Trunc(n)
+	}
+	return false
+}
+
+// toFloat converts the numeric types produced by parsing YAML values and --set flags to float64
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// valuesEqual compares values, considering numbers of different types with the same value as equal
+func valuesEqual(a, b interface{}) bool {
+	if x, ok := toFloat(a); ok {
+		y, ok := toFloat(b)
+		return ok && x == y
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// ValueSources records where values were set, so that errors can point at the file or flag setting the offending value.
+type ValueSources map[string]string
+
+// Record records source as the origin of all the values in values, replacing previous origins.
+func (s ValueSources) Record(values map[string]interface{}, source string) {
+	s.record(nil, values, source)
+}
+
+func (s ValueSources) record(fldPath *field.Path, value interface{}, source string) {
+	if fldPath != nil {
+		s[fldPath.String()] = source
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, child := range v {
+			s.record(childPath(fldPath, name), child, source)
+		}
+	case []interface{}:
+		for i, item := range v {
+			s.record(fldPath.Index(i), item, source)
+		}
+	}
+}
+
+// Lookup returns the source of the value at path, or of its closest parent that has a source.
+func (s ValueSources) Lookup(path string) (string, bool) {
+	for path != "" {
+		if source, found := s[path]; found {
+			return source, true
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			break
+		}
+		path = path[:i]
+	}
+	return "", false
+}
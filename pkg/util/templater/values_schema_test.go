@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templater
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+)
+
+const testValuesSchema = `
+$schema: https://json-schema.org/draft/2020-12/schema
+type: object
+required: [clusterName, awsRegion]
+additionalProperties: false
+properties:
+  clusterName:
+    type: string
+    pattern: '^[a-z0-9.-]+$'
+  awsRegion:
+    type: string
+    enum: [eu-west-1, us-east-1]
+  kubernetesVersion:
+    type: string
+    default: 1.34.1
+  instanceGroups:
+    type: object
+    additionalProperties:
+      type: object
+      properties:
+        maxSize:
+          type: integer
+          minimum: 1
+          maximum: 100
+        minSize:
+          type: integer
+          default: 1
+        zones:
+          type: array
+          minItems: 1
+          items:
+            type: string
+`
+
+func TestValuesSchemaValidate(t *testing.T) {
+	schema, err := ParseValuesSchema([]byte(testValuesSchema))
+	if err != nil {
+		t.Fatalf("error parsing schema: %v", err)
+	}
+
+	grid := []struct {
+		name     string
+		values   string
+		expected []string
+	}{
+		{
+			name: "valid",
+			values: `
+clusterName: dev.example.com
+awsRegion: eu-west-1
+instanceGroups:
+  nodes:
+    maxSize: 10
+    zones: [eu-west-1a]
+`,
+		},
+		{
+			name: "invalid",
+			values: `
+clusterName: Dev_Example
+awsRegion: eu-central-1
+instanceGroup: {}
+instanceGroups:
+  nodes:
+    maxSize: 2.5
+    zones: []
+  workers:
+    maxSize: 200
+    zones: [1]
+`,
+			expected: []string{
+				`awsRegion: Unsupported value: "eu-central-1": supported values: "eu-west-1", "us-east-1"`,
+				`clusterName: Invalid value: "Dev_Example": must match the regular expression "^[a-z0-9.-]+$"`,
+				`instanceGroup: Forbidden: is not a property of the schema`,
+				`instanceGroups.nodes.maxSize: Invalid value: 2.5: must be of type integer`,
+				`instanceGroups.nodes.zones: Invalid value: []: must have at least 1 items`,
+				`instanceGroups.workers.maxSize: Invalid value: 200: must be less than or equal to 100`,
+				`instanceGroups.workers.zones[0]: Invalid value: 1: must be of type string`,
+			},
+		},
+		{
+			name:     "missing",
+			values:   `clusterName: dev.example.com`,
+			expected: []string{`awsRegion: Required value`},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			values := make(map[string]interface{})
+			if err := yaml.Unmarshal([]byte(g.values), &values); err != nil {
+				t.Fatalf("error parsing values: %v", err)
+			}
+			schema.ApplyDefaults(values)
+			var actual []string
+			for _, err := range schema.Validate(values) {
+				actual = append(actual, err.Error())
+			}
+			if !reflect.DeepEqual(actual, g.expected) {
+				t.Errorf("unexpected errors:\ngot  %q\nwant %q", actual, g.expected)
+			}
+		})
+	}
+}
+
+func TestValuesSchemaApplyDefaults(t *testing.T) {
+	schema, err := ParseValuesSchema([]byte(testValuesSchema))
+	if err != nil {
+		t.Fatalf("error parsing schema: %v", err)
+	}
+
+	values := map[string]interface{}{
+		"kubernetesVersion": "1.35.0",
+		"instanceGroups": map[string]interface{}{
+			"nodes":   map[string]interface{}{"minSize": int64(3)},
+			"workers": map[string]interface{}{},
+		},
+	}
+	schema.ApplyDefaults(values)
+
+	expected := map[string]interface{}{
+		"kubernetesVersion": "1.35.0",
+		"instanceGroups": map[string]interface{}{
+			"nodes":   map[string]interface{}{"minSize": int64(3)},
+			"workers": map[string]interface{}{"minSize": float64(1)},
+		},
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("unexpected values after applying defaults:\ngot  %v\nwant %v", values, expected)
+	}
+}
+
+func TestParseValuesSchemaErrors(t *testing.T) {
+	for schema, expected := range map[string]string{
+		`{"type": "object", "oneOf": []}`:                          `unknown field "oneOf"`,
+		`{"type": "map"}`:                                          `schema.type: unsupported type "map"`,
+		`{"properties": {"name": {"pattern": "("}}}`:               `schema.properties[name].pattern`,
+		`{"additionalProperties": {"items": {"type": "tuple"}}}`:   `schema.additionalProperties.items.type`,
+		`{"properties": {"name": {"type": ["string", "object"]}}}`: ``,
+	} {
+		_, err := ParseValuesSchema([]byte(schema))
+		if expected == "" {
+			if err != nil {
+				t.Errorf("unexpected error parsing %s: %v", schema, err)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error containing %q parsing %s, got %v", expected, schema, err)
+		}
+	}
+}
+
+func TestValueSources(t *testing.T) {
+	sources := make(ValueSources)
+	sources.Record(map[string]interface{}{
+		"clusterName": "dev",
+		"instanceGroups": map[string]interface{}{
+			"nodes": map[string]interface{}{"maxSize": 3, "zones": []interface{}{"a"}},
+		},
+	}, "values.yaml")
+	sources.Record(map[string]interface{}{
+		"instanceGroups": map[string]interface{}{
+			"nodes": map[string]interface{}{"maxSize": 5},
+		},
+	}, "--set instanceGroups.nodes.maxSize=5")
+
+	for path, expected := range map[string]string{
+		"clusterName":                      "values.yaml",
+		"instanceGroups.nodes.maxSize":     "--set instanceGroups.nodes.maxSize=5",
+		"instanceGroups.nodes.zones[0]":    "values.yaml",
+		"instanceGroups.nodes.minSize":     "--set instanceGroups.nodes.maxSize=5",
+		"instanceGroups.workers.zones[0]":  "--set instanceGroups.nodes.maxSize=5",
+		"instanceGroups.nodes.zones[3].id": "values.yaml",
+		"missing":                          "",
+	} {
+		actual, _ := sources.Lookup(path)
+		if actual != expected {
+			t.Errorf("source of %q: expected %q, got %q", path, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/cloudinstances"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+// etcdVolumeTagPrefix is the prefix of the tag that etcd-manager uses to identify its volumes
+const etcdVolumeTagPrefix = "k8s.io/etcd/"
+
+// ValidateCloudResources checks the health of the cloud resources backing the cluster,
+// beyond the nodes and pods checked by Validate. It is currently only implemented for AWS.
+func (v *ValidationCluster) ValidateCloudResources(ctx context.Context, cluster *kops.Cluster, cloud fi.Cloud, instanceGroups []*kops.InstanceGroup) error {
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		klog.V(2).Infof("cloud resource validation is not supported for %s", cluster.GetCloudProvider())
+		return nil
+	}
+
+	if cluster.Spec.API.LoadBalancer != nil {
+		if err := v.validateAPILoadBalancerTargets(ctx, cluster, awsCloud); err != nil {
+			return err
+		}
+	}
+
+	cloudGroups, err := cloud.GetCloudGroups(cluster, instanceGroups, false, nil)
+	if err != nil {
+		return err
+	}
+	v.validateSuspendedProcesses(cloudGroups)
+
+	volumes, err := listClusterVolumes(ctx, cluster, awsCloud)
+	if err != nil {
+		return err
+	}
+	v.validateVolumes(volumes)
+
+	return nil
+}
+
+// validateAPILoadBalancerTargets reports targets of the API load balancer that are not healthy.
+func (v *ValidationCluster) validateAPILoadBalancerTargets(ctx context.Context, cluster *kops.Cluster, cloud awsup.AWSCloud) error {
+	loadBalancers, err := awsup.ListELBV2LoadBalancers(ctx, cloud)
+	if err != nil {
+		return err
+	}
+	lb := awsup.FindLatestELBV2ByNameTag(loadBalancers, "api."+cluster.ObjectMeta.Name)
+	if lb == nil {
+		v.addError(&ValidationError{
+			Kind:        "LoadBalancer",
+			Name:        "api." + cluster.ObjectMeta.Name,
+			Message:     "API load balancer was not found",
+			Remediation: "Run `kops update cluster --yes` to create the load balancer.",
+		})
+		return nil
+	}
+
+	targetGroups, err := cloud.ELBV2().DescribeTargetGroups(ctx, &elbv2.DescribeTargetGroupsInput{
+		LoadBalancerArn: aws.String(lb.ARN()),
+	})
+	if err != nil {
+		return fmt.Errorf("describing target groups of load balancer %q: %w", lb.ARN(), err)
+	}
+
+	for _, tg := range targetGroups.TargetGroups {
+		response, err := cloud.ELBV2().DescribeTargetHealth(ctx, &elbv2.DescribeTargetHealthInput{
+			TargetGroupArn: tg.TargetGroupArn,
+		})
+		if err != nil {
+			return fmt.Errorf("describing target health of target group %q: %w", aws.ToString(tg.TargetGroupArn), err)
+		}
+		v.validateTargetHealth(aws.ToString(tg.TargetGroupName), response.TargetHealthDescriptions)
+	}
+
+	return nil
+}
+
+func (v *ValidationCluster) validateTargetHealth(targetGroupName string, targets []elbv2types.TargetHealthDescription) {
+	if len(targets) == 0 {
+		v.addError(&ValidationError{
+			Kind:        "LoadBalancerTarget",
+			Name:        targetGroupName,
+			Message:     fmt.Sprintf("target group %q of the API load balancer has no registered targets", targetGroupName),
+			Remediation: "Check that the control plane instance groups are running and attached to the target group.",
+		})
+		return
+	}
+
+	for _, target := range targets {
+		if target.TargetHealth == nil || target.TargetHealth.State == elbv2types.TargetHealthStateEnumHealthy {
+			continue
+		}
+		id := aws.ToString(target.Target.Id)
+		message := fmt.Sprintf("target %q of target group %q is %s", id, targetGroupName, target.TargetHealth.State)
+		if description := aws.ToString(target.TargetHealth.Description); description != "" {
+			message += ": " + description
+		}
+		v.addError(&ValidationError{
+			Kind:        "LoadBalancerTarget",
+			Name:        targetGroupName + "/" + id,
+			Message:     message,
+			Remediation: fmt.Sprintf("Check that kube-apiserver is running on instance %q and that its security group allows traffic from the load balancer.", id),
+		})
+	}
+}
+
+// validateSuspendedProcesses reports autoscaling processes that are suspended but not listed in the instance group's spec.suspendProcesses.
+func (v *ValidationCluster) validateSuspendedProcesses(cloudGroups map[string]*cloudinstances.CloudInstanceGroup) {
+	for _, cloudGroup := range cloudGroups {
+		asg, ok := cloudGroup.Raw.(*autoscalingtypes.AutoScalingGroup)
+		if !ok || cloudGroup.InstanceGroup == nil {
+			continue
+		}
+
+		expected := make(map[string]bool)
+		for _, process := range cloudGroup.InstanceGroup.Spec.SuspendProcesses {
+			expected[process] = true
+		}
+
+		var unexpected []string
+		for _, process := range asg.SuspendedProcesses {
+			name := aws.ToString(process.ProcessName)
+			if !expected[name] {
+				unexpected = append(unexpected, name)
+			}
+		}
+		if len(unexpected) == 0 {
+			continue
+		}
+		sort.Strings(unexpected)
+
+		v.addError(&ValidationError{
+			Kind:          "InstanceGroup",
+			Name:          cloudGroup.InstanceGroup.Name,
+			Message:       fmt.Sprintf("autoscaling group %q has suspended processes: %s", cloudGroup.HumanName, strings.Join(unexpected, ", ")),
+			Remediation:   fmt.Sprintf("Run `aws autoscaling resume-processes --auto-scaling-group-name %s --scaling-processes %s`, or add them to spec.suspendProcesses if they should stay suspended.", cloudGroup.HumanName, strings.Join(unexpected, " ")),
+			InstanceGroup: cloudGroup.InstanceGroup,
+		})
+	}
+}
+
+// listClusterVolumes returns the EBS volumes owned by the cluster.
+func listClusterVolumes(ctx context.Context, cluster *kops.Cluster, cloud awsup.AWSCloud) ([]ec2types.Volume, error) {
+	request := &ec2.DescribeVolumesInput{
+		Filters: []ec2types.Filter{
+			awsup.NewEC2Filter("tag-key", "kubernetes.io/cluster/"+cluster.ObjectMeta.Name),
+		},
+	}
+
+	var volumes []ec2types.Volume
+	paginator := ec2.NewDescribeVolumesPaginator(cloud.EC2(), request)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing volumes: %w", err)
+		}
+		volumes = append(volumes, page.Volumes...)
+	}
+	return volumes, nil
+}
+
+// validateVolumes reports volumes in the error state, and etcd volumes that are not attached to a control plane node.
+// Other detached volumes are expected, e.g. persistent volumes whose pods are not scheduled.
+func (v *ValidationCluster) validateVolumes(volumes []ec2types.Volume) {
+	for _, volume := range volumes {
+		id := aws.ToString(volume.VolumeId)
+
+		switch volume.State {
+		case ec2types.VolumeStateError:
+			v.addError(&ValidationError{
+				Kind:        "Volume",
+				Name:        id,
+				Message:     fmt.Sprintf("volume %q is in the error state", id),
+				Remediation: "The volume's data may be lost; restore it from a snapshot or, for etcd, from an etcd backup.",
+			})
+
+		case ec2types.VolumeStateAvailable:
+			etcdCluster := ""
+			for _, tag := range volume.Tags {
+				if key := aws.ToString(tag.Key); strings.HasPrefix(key, etcdVolumeTagPrefix) {
+					etcdCluster = strings.TrimPrefix(key, etcdVolumeTagPrefix)
+				}
+			}
+			if etcdCluster == "" {
+				continue
+			}
+			v.addError(&ValidationError{
+				Kind:        "Volume",
+				Name:        id,
+				Message:     fmt.Sprintf("etcd %q volume %q in zone %s is not attached", etcdCluster, id, aws.ToString(volume.AvailabilityZone)),
+				Remediation: fmt.Sprintf("Check that a control plane node is running in zone %s and inspect the etcd-manager logs on it.", aws.ToString(volume.AvailabilityZone)),
+			})
+		}
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/cloudinstances"
+)
+
+func Test_ValidateTargetHealth(t *testing.T) {
+	v := &ValidationCluster{}
+	v.validateTargetHealth("tcp-api", []elbv2types.TargetHealthDescription{
+		{
+			Target:       &elbv2types.TargetDescription{Id: aws.String("i-healthy")},
+			TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumHealthy},
+		},
+		{
+			Target: &elbv2types.TargetDescription{Id: aws.String("i-unhealthy")},
+			TargetHealth: &elbv2types.TargetHealth{
+				State:       elbv2types.TargetHealthStateEnumUnhealthy,
+				Description: aws.String("Health checks failed"),
+			},
+		},
+	})
+	v.validateTargetHealth("tls-api", nil)
+
+	if assert.Len(t, v.Failures, 2) {
+		assert.Equal(t, "tcp-api/i-unhealthy", v.Failures[0].Name)
+		assert.Equal(t, `target "i-unhealthy" of target group "tcp-api" is unhealthy: Health checks failed`, v.Failures[0].Message)
+		assert.NotEmpty(t, v.Failures[0].Remediation)
+		assert.Equal(t, "tls-api", v.Failures[1].Name)
+	}
+}
+
+func Test_ValidateSuspendedProcesses(t *testing.T) {
+	ig := &kopsapi.InstanceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "nodes"},
+		Spec: kopsapi.InstanceGroupSpec{
+			SuspendProcesses: []string{"AZRebalance"},
+		},
+	}
+	groups := map[string]*cloudinstances.CloudInstanceGroup{
+		"nodes": {
+			HumanName:     "nodes.example.com",
+			InstanceGroup: ig,
+			Raw: &autoscalingtypes.AutoScalingGroup{
+				SuspendedProcesses: []autoscalingtypes.SuspendedProcess{
+					{ProcessName: aws.String("AZRebalance")},
+					{ProcessName: aws.String("Terminate")},
+					{ProcessName: aws.String("Launch")},
+				},
+			},
+		},
+	}
+
+	v := &ValidationCluster{}
+	v.validateSuspendedProcesses(groups)
+
+	if assert.Len(t, v.Failures, 1) {
+		assert.Equal(t, "InstanceGroup", v.Failures[0].Kind)
+		assert.Equal(t, `autoscaling group "nodes.example.com" has suspended processes: Launch, Terminate`, v.Failures[0].Message)
+		assert.Equal(t, ig, v.Failures[0].InstanceGroup)
+	}
+}
+
+func Test_ValidateVolumes(t *testing.T) {
+	volumes := []ec2types.Volume{
+		{
+			VolumeId: aws.String("vol-attached"),
+			State:    ec2types.VolumeStateInUse,
+			Tags:     []ec2types.Tag{{Key: aws.String("k8s.io/etcd/main"), Value: aws.String("a/a")}},
+		},
+		{
+			VolumeId:         aws.String("vol-etcd"),
+			AvailabilityZone: aws.String("us-test-1a"),
+			State:            ec2types.VolumeStateAvailable,
+			Tags:             []ec2types.Tag{{Key: aws.String("k8s.io/etcd/events"), Value: aws.String("a/a")}},
+		},
+		{
+			VolumeId: aws.String("vol-pv"),
+			State:    ec2types.VolumeStateAvailable,
+		},
+		{
+			VolumeId: aws.String("vol-error"),
+			State:    ec2types.VolumeStateError,
+		},
+	}
+
+	v := &ValidationCluster{}
+	v.validateVolumes(volumes)
+
+	if assert.Len(t, v.Failures, 2) {
+		assert.Equal(t, `etcd "events" volume "vol-etcd" in zone us-test-1a is not attached`, v.Failures[0].Message)
+		assert.Equal(t, `volume "vol-error" is in the error state`, v.Failures[1].Message)
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+// etcdStorageSizeMetric is the kube-apiserver metric reporting the size of the database of each etcd cluster
+const etcdStorageSizeMetric = "apiserver_storage_size_bytes"
+
+// defaultEtcdQuotaBackendBytes is the etcd default for --quota-backend-bytes
+const defaultEtcdQuotaBackendBytes = 2 * 1024 * 1024 * 1024
+
+// etcdQuotaWarningPercent is the percentage of the quota above which an etcd database is reported as a failure
+const etcdQuotaWarningPercent = 80
+
+// ValidationEtcdDatabase reports the size of the database of an etcd cluster, as seen by kube-apiserver
+type ValidationEtcdDatabase struct {
+	// StorageClusterID is the etcd cluster ID reported by kube-apiserver
+	StorageClusterID string `json:"storageClusterID,omitempty"`
+	// SizeBytes is the size of the etcd database, including space that can be reclaimed by defragmentation
+	SizeBytes int64 `json:"sizeBytes"`
+}
+
+// collectEtcdDatabases reads the etcd database sizes from the kube-apiserver metrics, and adds a failure for
+// databases close to the smallest etcd backend quota of the cluster.
+func (v *ValidationCluster) collectEtcdDatabases(ctx context.Context, client rest.Interface, cluster *kops.Cluster) error {
+	metrics, err := client.Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return fmt.Errorf("reading kube-apiserver metrics: %w", err)
+	}
+
+	v.EtcdDatabases = parseEtcdDatabaseSizes(metrics)
+
+	quota, found := smallestEtcdQuota(cluster)
+	if !found {
+		return nil
+	}
+	for _, database := range v.EtcdDatabases {
+		if database.SizeBytes*100 < quota*etcdQuotaWarningPercent {
+			continue
+		}
+		v.addError(&ValidationError{
+			Kind: "etcd",
+			Name: database.StorageClusterID,
+			Message: fmt.Sprintf("etcd database size %s is over %d%% of the etcd backend quota %s",
+				FormatBytes(database.SizeBytes), etcdQuotaWarningPercent, FormatBytes(quota)),
+			Remediation: "defragment the etcd database, or increase etcdClusters[].manager.quotaBackendBytes",
+		})
+	}
+
+	return nil
+}
+
+// parseEtcdDatabaseSizes returns the etcd database sizes found in kube-apiserver metrics in the text exposition format.
+func parseEtcdDatabaseSizes(metrics []byte) []*ValidationEtcdDatabase {
+	var databases []*ValidationEtcdDatabase
+
+	scanner := bufio.NewScanner(bytes.NewReader(metrics))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, etcdStorageSizeMetric+"{") {
+			continue
+		}
+		labels, value, found := strings.Cut(strings.TrimPrefix(line, etcdStorageSizeMetric+"{"), "} ")
+		if !found {
+			continue
+		}
+		size, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			klog.V(2).Infof("ignoring metric %q: %v", line, err)
+			continue
+		}
+
+		database := &ValidationEtcdDatabase{SizeBytes: int64(size)}
+		for _, label := range strings.Split(labels, ",") {
+			k, v, _ := strings.Cut(label, "=")
+			if k == "storage_cluster_id" {
+				database.StorageClusterID = strings.Trim(v, `"`)
+			}
+		}
+		databases = append(databases, database)
+	}
+
+	sort.Slice(databases, func(i, j int) bool {
+		return databases[i].StorageClusterID < databases[j].StorageClusterID
+	})
+	return databases
+}
+
+// smallestEtcdQuota returns the smallest backend quota of the etcd clusters managed by kOps.
+// kube-apiserver doesn't report which etcd cluster a database belongs to, so the smallest quota is used for all of them.
+func smallestEtcdQuota(cluster *kops.Cluster) (int64, bool) {
+	var quota int64
+	found := false
+	for _, etcdCluster := range cluster.Spec.EtcdClusters {
+		if etcdCluster.IsExternal() {
+			continue
+		}
+		clusterQuota := int64(defaultEtcdQuotaBackendBytes)
+		if etcdCluster.Manager != nil && etcdCluster.Manager.QuotaBackendBytes != nil {
+			clusterQuota = etcdCluster.Manager.QuotaBackendBytes.Value()
+		}
+		if !found || clusterQuota < quota {
+			quota = clusterQuota
+			found = true
+		}
+	}
+	return quota, found
+}
+
+// FormatBytes formats a size in bytes as a binary quantity, rounded to MiB.
+func FormatBytes(size int64) string {
+	const mib = 1024 * 1024
+	rounded := (size + mib/2) / mib * mib
+	return resource.NewQuantity(rounded, resource.BinarySI).String()
+}
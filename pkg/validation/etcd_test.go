@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes/scheme"
+	restfake "k8s.io/client-go/rest/fake"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+const testAPIServerMetrics = `# HELP apiserver_storage_size_bytes [STABLE] Size of the storage database file physically allocated in bytes.
+# TYPE apiserver_storage_size_bytes gauge
+apiserver_storage_size_bytes{storage_cluster_id="b6ae1d2c3e4f5a6b"} 2.01326592e+08
+apiserver_storage_size_bytes{storage_cluster_id="7f8e9d0c1b2a3948"} 1.8253611008e+09
+# HELP apiserver_storage_objects [STABLE] Number of stored objects at the time of last check split by kind.
+apiserver_storage_objects{resource="pods"} 12
+`
+
+func TestCollectEtcdDatabases(t *testing.T) {
+	grid := []struct {
+		Name             string
+		EtcdClusters     []kops.EtcdClusterSpec
+		ExpectedFailures []string
+	}{
+		{
+			Name: "default quota",
+			EtcdClusters: []kops.EtcdClusterSpec{
+				{Name: "main"},
+				{Name: "events"},
+			},
+			ExpectedFailures: []string{"7f8e9d0c1b2a3948"},
+		},
+		{
+			Name: "raised quota",
+			EtcdClusters: []kops.EtcdClusterSpec{
+				{Name: "main", Manager: &kops.EtcdManagerSpec{QuotaBackendBytes: new(resource.MustParse("8Gi"))}},
+				{Name: "events", Manager: &kops.EtcdManagerSpec{QuotaBackendBytes: new(resource.MustParse("4Gi"))}},
+			},
+		},
+		{
+			Name: "external etcd",
+			EtcdClusters: []kops.EtcdClusterSpec{
+				{Name: "main", External: &kops.EtcdExternalSpec{Endpoints: []string{"https://etcd.example.com:2379"}}},
+			},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			client := &restfake.RESTClient{
+				NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+				Resp: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(testAPIServerMetrics)),
+				},
+			}
+			cluster := &kops.Cluster{Spec: kops.ClusterSpec{EtcdClusters: g.EtcdClusters}}
+
+			v := &ValidationCluster{}
+			require.NoError(t, v.collectEtcdDatabases(context.TODO(), client, cluster))
+
+			assert.Equal(t, []*ValidationEtcdDatabase{
+				{StorageClusterID: "7f8e9d0c1b2a3948", SizeBytes: 1825361100},
+				{StorageClusterID: "b6ae1d2c3e4f5a6b", SizeBytes: 201326592},
+			}, v.EtcdDatabases)
+
+			var failures []string
+			for _, failure := range v.Failures {
+				assert.Equal(t, "etcd", failure.Kind)
+				failures = append(failures, failure.Name)
+			}
+			assert.Equal(t, g.ExpectedFailures, failures)
+		})
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "192Mi", FormatBytes(201326592))
+	assert.Equal(t, "1741Mi", FormatBytes(1825361100))
+	assert.Equal(t, "2Gi", FormatBytes(2*1024*1024*1024))
+}
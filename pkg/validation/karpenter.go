@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+var (
+	karpenterNodePoolGVR     = schema.GroupVersionResource{Group: "karpenter.sh", Version: "v1", Resource: "nodepools"}
+	karpenterEC2NodeClassGVR = schema.GroupVersionResource{Group: "karpenter.k8s.aws", Version: "v1", Resource: "ec2nodeclasses"}
+)
+
+// karpenterAddonSelector selects the Karpenter objects that kOps generated for the InstanceGroups.
+const karpenterAddonSelector = "addon.kops.k8s.io/name=karpenter.sh"
+
+const karpenterUpdateRemediation = "Run `kops update cluster --yes` to apply the Karpenter resources generated for the instance groups."
+
+// collectKarpenterFailures checks that the NodePool and EC2NodeClass generated for each InstanceGroup managed by Karpenter
+// are in the cluster, still match the InstanceGroup and are ready, and that no NodePool remains for a deleted InstanceGroup.
+func (v *ValidationCluster) collectKarpenterFailures(ctx context.Context, client dynamic.Interface, groups []*kops.InstanceGroup, shouldValidateInstanceGroup func(ig *kops.InstanceGroup) bool) error {
+	nodePools, err := listKarpenterObjects(ctx, client, karpenterNodePoolGVR)
+	if err != nil {
+		return err
+	}
+	nodeClasses, err := listKarpenterObjects(ctx, client, karpenterEC2NodeClassGVR)
+	if err != nil {
+		return err
+	}
+
+	for _, failure := range karpenterFailures(groups, shouldValidateInstanceGroup, nodePools, nodeClasses) {
+		v.addError(failure)
+	}
+	return nil
+}
+
+// listKarpenterObjects lists the objects generated by kOps, by name; the resource is not served when Karpenter is not installed yet.
+func listKarpenterObjects(ctx context.Context, client dynamic.Interface, gvr schema.GroupVersionResource) (map[string]*unstructured.Unstructured, error) {
+	objects := make(map[string]*unstructured.Unstructured)
+	list, err := client.Resource(gvr).List(ctx, metav1.ListOptions{LabelSelector: karpenterAddonSelector})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return objects, nil
+		}
+		return nil, fmt.Errorf("listing %s: %w", gvr.GroupResource(), err)
+	}
+	for i := range list.Items {
+		objects[list.Items[i].GetName()] = &list.Items[i]
+	}
+	return objects, nil
+}
+
+func karpenterFailures(groups []*kops.InstanceGroup, shouldValidateInstanceGroup func(ig *kops.InstanceGroup) bool, nodePools, nodeClasses map[string]*unstructured.Unstructured) []*ValidationError {
+	var failures []*ValidationError
+
+	karpenterGroups := make(map[string]bool)
+	for _, ig := range groups {
+		if !ig.IsKarpenterManaged() {
+			continue
+		}
+		karpenterGroups[ig.Name] = true
+		if !shouldValidateInstanceGroup(ig) {
+			continue
+		}
+
+		nodeClass := nodeClasses[ig.Name]
+		if nodeClass == nil {
+			failures = append(failures, &ValidationError{
+				Kind:          "EC2NodeClass",
+				Name:          ig.Name,
+				Message:       fmt.Sprintf("EC2NodeClass %q for InstanceGroup %q was not found", ig.Name, ig.Name),
+				Remediation:   karpenterUpdateRemediation,
+				InstanceGroup: ig,
+			})
+		} else if message := karpenterNotReadyMessage(nodeClass); message != "" {
+			failures = append(failures, &ValidationError{
+				Kind:          "EC2NodeClass",
+				Name:          ig.Name,
+				Message:       fmt.Sprintf("EC2NodeClass %q is not ready: %s", ig.Name, message),
+				InstanceGroup: ig,
+			})
+		}
+
+		nodePool := nodePools[ig.Name]
+		if nodePool == nil {
+			failures = append(failures, &ValidationError{
+				Kind:          "NodePool",
+				Name:          ig.Name,
+				Message:       fmt.Sprintf("NodePool %q for InstanceGroup %q was not found", ig.Name, ig.Name),
+				Remediation:   karpenterUpdateRemediation,
+				InstanceGroup: ig,
+			})
+			continue
+		}
+		nodeClassName, _, _ := unstructured.NestedString(nodePool.Object, "spec", "template", "spec", "nodeClassRef", "name")
+		if nodeClassName != ig.Name {
+			failures = append(failures, &ValidationError{
+				Kind:          "NodePool",
+				Name:          ig.Name,
+				Message:       fmt.Sprintf("NodePool %q references EC2NodeClass %q instead of %q", ig.Name, nodeClassName, ig.Name),
+				Remediation:   karpenterUpdateRemediation,
+				InstanceGroup: ig,
+			})
+		} else if message := karpenterNotReadyMessage(nodePool); message != "" {
+			failures = append(failures, &ValidationError{
+				Kind:          "NodePool",
+				Name:          ig.Name,
+				Message:       fmt.Sprintf("NodePool %q is not ready: %s", ig.Name, message),
+				InstanceGroup: ig,
+			})
+		}
+	}
+
+	for _, name := range sets.List(sets.KeySet(nodePools)) {
+		if !karpenterGroups[name] {
+			failures = append(failures, &ValidationError{
+				Kind:        "NodePool",
+				Name:        name,
+				Message:     fmt.Sprintf("NodePool %q does not belong to any InstanceGroup managed by Karpenter", name),
+				Remediation: karpenterUpdateRemediation,
+			})
+		}
+	}
+
+	return failures
+}
+
+// karpenterNotReadyMessage returns why a Karpenter object is not ready, or "" if its Ready condition is true.
+func karpenterNotReadyMessage(u *unstructured.Unstructured) string {
+	conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+		if condition["status"] == "True" {
+			return ""
+		}
+		if message, _ := condition["message"].(string); message != "" {
+			return message
+		}
+		return fmt.Sprintf("Ready condition is %v", condition["status"])
+	}
+	return "Ready condition is not reported"
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func newKarpenterObject(name, nodeClassName, readyStatus string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	u.SetName(name)
+	if nodeClassName != "" {
+		_ = unstructured.SetNestedField(u.Object, nodeClassName, "spec", "template", "spec", "nodeClassRef", "name")
+	}
+	if readyStatus != "" {
+		_ = unstructured.SetNestedSlice(u.Object, []interface{}{
+			map[string]interface{}{"type": "Ready", "status": readyStatus},
+		}, "status", "conditions")
+	}
+	return u
+}
+
+func TestKarpenterFailures(t *testing.T) {
+	newIG := func(name string, manager kops.InstanceManager) *kops.InstanceGroup {
+		return &kops.InstanceGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: kops.InstanceGroupSpec{
+				Role:    kops.InstanceGroupRoleNode,
+				Manager: manager,
+			},
+		}
+	}
+	groups := []*kops.InstanceGroup{
+		newIG("nodes-asg", kops.InstanceManagerCloudGroup),
+		newIG("ready", kops.InstanceManagerKarpenter),
+		newIG("missing", kops.InstanceManagerKarpenter),
+		newIG("not-ready", kops.InstanceManagerKarpenter),
+		newIG("wrong-class", kops.InstanceManagerKarpenter),
+	}
+	nodePools := map[string]*unstructured.Unstructured{
+		"ready":       newKarpenterObject("ready", "ready", "True"),
+		"not-ready":   newKarpenterObject("not-ready", "not-ready", "False"),
+		"wrong-class": newKarpenterObject("wrong-class", "ready", "True"),
+		"deleted":     newKarpenterObject("deleted", "deleted", "True"),
+	}
+	nodeClasses := map[string]*unstructured.Unstructured{
+		"ready":       newKarpenterObject("ready", "", "True"),
+		"not-ready":   newKarpenterObject("not-ready", "", ""),
+		"wrong-class": newKarpenterObject("wrong-class", "", "True"),
+	}
+
+	var actual []string
+	for _, failure := range karpenterFailures(groups, func(ig *kops.InstanceGroup) bool { return true }, nodePools, nodeClasses) {
+		actual = append(actual, failure.Kind+"/"+failure.Name+": "+failure.Message)
+	}
+	expected := []string{
+		`EC2NodeClass/missing: EC2NodeClass "missing" for InstanceGroup "missing" was not found`,
+		`NodePool/missing: NodePool "missing" for InstanceGroup "missing" was not found`,
+		`EC2NodeClass/not-ready: EC2NodeClass "not-ready" is not ready: Ready condition is not reported`,
+		`NodePool/not-ready: NodePool "not-ready" is not ready: Ready condition is False`,
+		`NodePool/wrong-class: NodePool "wrong-class" references EC2NodeClass "ready" instead of "wrong-class"`,
+		`NodePool/deleted: NodePool "deleted" does not belong to any InstanceGroup managed by Karpenter`,
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("unexpected failures:\n  got:  %q\n  want: %q", actual, expected)
+	}
+}
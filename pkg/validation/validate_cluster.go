@@ -25,6 +25,7 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/pager"
 	"k8s.io/kops/pkg/apis/kops"
@@ -43,6 +44,8 @@ type ValidationCluster struct {
 	Failures []*ValidationError `json:"failures,omitempty"`
 
 	Nodes []*ValidationNode `json:"nodes,omitempty"`
+
+	EtcdDatabases []*ValidationEtcdDatabase `json:"etcdDatabases,omitempty"`
 }
 
 // ValidationError holds a validation failure
@@ -50,6 +53,8 @@ type ValidationError struct {
 	Kind    string `json:"type,omitempty"`
 	Name    string `json:"name,omitempty"`
 	Message string `json:"message,omitempty"`
+	// Remediation is an optional hint on how to resolve the failure
+	Remediation string `json:"remediation,omitempty"`
 	// The InstanceGroup field is used to indicate which instance group this validation error is coming from
 	InstanceGroup *kops.InstanceGroup `json:"instanceGroup,omitempty"`
 }
@@ -204,7 +209,7 @@ func (v *clusterValidatorImpl) Validate(ctx context.Context) (*ValidationCluster
 					if member.Status == cloudinstances.CloudInstanceStatusDetached {
 						continue
 					}
-					if member.State == cloudinstances.WarmPool {
+					if member.State == cloudinstances.WarmPool || member.State == cloudinstances.Evicted {
 						continue
 					}
 
@@ -235,6 +240,23 @@ func (v *clusterValidatorImpl) Validate(ctx context.Context) (*ValidationCluster
 		return nil, fmt.Errorf("cannot get pod health for %q: %v", v.cluster.Name, err)
 	}
 
+	// The kube-apiserver metrics are not available with fake clients
+	if restClient := v.k8sClient.Discovery().RESTClient(); restClient != nil {
+		if err := validation.collectEtcdDatabases(ctx, restClient, v.cluster); err != nil {
+			klog.Warningf("unable to determine the size of the etcd databases: %v", err)
+		}
+	}
+
+	if v.cluster.Spec.Karpenter != nil && v.cluster.Spec.Karpenter.Enabled {
+		dynamicClient, err := dynamic.NewForConfig(v.restConfig)
+		if err != nil {
+			return nil, fmt.Errorf("building dynamic client: %w", err)
+		}
+		if err := validation.collectKarpenterFailures(ctx, dynamicClient, v.allInstanceGroups, v.filterInstanceGroups); err != nil {
+			return nil, fmt.Errorf("cannot get Karpenter resources for %q: %v", v.cluster.Name, err)
+		}
+	}
+
 	return validation, nil
 }
 
@@ -387,7 +409,7 @@ func (v *ValidationCluster) validateNodes(cloudGroups map[string]*cloudinstances
 					// bastion nodes don't join the cluster
 					nodeExpectedToJoin = false
 				}
-				if member.State == cloudinstances.WarmPool {
+				if member.State == cloudinstances.WarmPool || member.State == cloudinstances.Evicted {
 					nodeExpectedToJoin = false
 				}
 
@@ -26,6 +26,13 @@ const (
 	// EtcdMetricsPort is used to serve etcd metrics
 	EtcdMetricsPort = 2382
 
+	// KMSPluginHealthCheck is the port where the KMS plugin for the current key serves health checks.
+	// The plugins for previous keys use the ports below it, down to 3981.
+	KMSPluginHealthCheck = 3984
+
+	// KopsInterruptionHandlerMetrics is the port where the interruption handler serves metrics.
+	KopsInterruptionHandlerMetrics = 3985
+
 	// KopsChannelsHealthCheck is the loopback port the kops-channels static pod serves /readyz on.
 	KopsChannelsHealthCheck = 3986
 
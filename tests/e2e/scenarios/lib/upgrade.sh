@@ -178,3 +178,148 @@ function kops-upgrade() {
         $test_package_args \
         --parallel 25
 }
+
+# k8s-resolve-version resolves a kubernetes version or marker (latest, stable, stable-1.N or ci) to a version
+function k8s-resolve-version() {
+    case "$1" in
+        latest|stable|stable-*)
+            curl -fsL "https://dl.k8s.io/release/$1.txt"
+            ;;
+        ci)
+            echo "https://storage.googleapis.com/k8s-release-dev/ci/$(curl -fs https://storage.googleapis.com/k8s-release-dev/ci/latest.txt)"
+            ;;
+        *)
+            echo "$1"
+            ;;
+    esac
+}
+
+# kops-acquire-version sets KOPS and KOPS_BASE_URL for a kops version:
+# "latest" builds the tree (or downloads the latest CI build in periodic jobs),
+# a released version (v1.N.M) is downloaded from the releases, and a minor version (1.N)
+# or a marker URL uses the latest CI build of that release branch.
+function kops-acquire-version() {
+    if [[ "$1" == "latest" ]]; then
+        kops-acquire-latest
+    elif [[ "${1:0:1}" == "v" ]]; then
+        KOPS_BASE_URL=""
+        KOPS=$(kops-download-release "$1")
+    else
+        KOPS_BASE_URL=$(kops-base-from-marker "$1")
+        KOPS=$(kops-download-from-base)
+    fi
+}
+
+# kops-upgrade-path-default prints the default upgrade path from N-2 to N,
+# where N is the minor version of kops in the tree.
+function kops-upgrade-path-default() {
+    local minor
+    minor=$(sed -n 's/^\s*KOPS_RELEASE_VERSION = "1\.\([0-9]*\)\..*"/\1/p' "${REPO_ROOT}/kops-version.go")
+    echo "1.$((minor - 2)):stable-1.$((minor - 2)) 1.$((minor - 1)):stable-1.$((minor - 1)) latest:stable"
+}
+
+# kops-upgrade-path-test runs validation and, unless KOPS_SKIP_E2E is set, the e2e tests
+# against the current cluster, using ${KOPS} and the kubernetes version $1.
+function kops-upgrade-path-test() {
+    local k8s_version="$1"
+
+    "${KOPS}" validate cluster --wait=15m
+    "${KOPS}" export kubecfg --name "${CLUSTER_NAME}" --admin
+
+    if [[ -n ${KOPS_SKIP_E2E:-} ]]; then
+        return 0
+    fi
+
+    local test_package_args
+    if [[ "${k8s_version}" =~ ^https: ]]; then
+        test_package_args="--test-package-marker=latest.txt --test-package-dir=ci --test-package-url=https://storage.googleapis.com/k8s-release-dev"
+    else
+        test_package_args="--test-package-version=${k8s_version}"
+    fi
+
+    boskos-heartbeat
+    # shellcheck disable=SC2086
+    ${KUBETEST2} \
+        --cloud-provider="${CLOUD_PROVIDER}" \
+        --kops-binary-path="${KOPS}" \
+        --test=kops \
+        -- \
+        $test_package_args \
+        --parallel 25
+}
+
+# kops-upgrade-path creates a cluster with the first step of an upgrade path and upgrades
+# it through the remaining steps, validating and testing the cluster after each step.
+# Source after lib/common.sh. Caller passes the create-args string.
+# KOPS_UPGRADE_PATH is a space-separated list of KOPS_VERSION:K8S_VERSION steps,
+# defaulting to kops-upgrade-path-default.
+function kops-upgrade-path() {
+    local create_args="$1"
+    local upgrade_path="${KOPS_UPGRADE_PATH:-$(kops-upgrade-path-default)}"
+    echo "Upgrade path: ${upgrade_path}"
+
+    local steps
+    read -r -a steps <<< "${upgrade_path}"
+    if [[ ${#steps[@]} -lt 2 ]]; then
+        >&2 echo "KOPS_UPGRADE_PATH must have at least two steps, got ${upgrade_path}"
+        exit 1
+    fi
+
+    export KOPS_BASE_URL
+
+    # TODO: Switch scripts to use KOPS_CONTROL_PLANE_COUNT
+    if [[ -n "${KOPS_CONTROL_PLANE_SIZE:-}" ]]; then
+        echo "Recognized (deprecated) KOPS_CONTROL_PLANE_SIZE=${KOPS_CONTROL_PLANE_SIZE}, please set KOPS_CONTROL_PLANE_COUNT instead"
+        KOPS_CONTROL_PLANE_COUNT=${KOPS_CONTROL_PLANE_SIZE}
+    fi
+
+    local i kops_version k8s_version
+    for i in "${!steps[@]}"; do
+        kops_version="${steps[$i]%%:*}"
+        k8s_version=$(k8s-resolve-version "${steps[$i]#*:}")
+        echo "Upgrade path step $((i + 1))/${#steps[@]}: kops ${kops_version}, kubernetes ${k8s_version}"
+
+        kops-acquire-version "${kops_version}"
+
+        if [[ $i -eq 0 ]]; then
+            echo "Cleaning up any leaked resources from previous cluster"
+            ${KUBETEST2} \
+                --down \
+                --kops-binary-path="${KOPS}" || echo "kubetest2 down failed"
+
+            # Note that we use --control-plane-size, even though it is deprecated, because we have to support old versions
+            # in the upgrade test.
+            ${KUBETEST2} \
+                --up \
+                --env-file="${WORKSPACE}/env" \
+                --kops-binary-path="${KOPS}" \
+                --kubernetes-version="${k8s_version}" \
+                --control-plane-size="${KOPS_CONTROL_PLANE_COUNT:-1}" \
+                --template-path="${KOPS_TEMPLATE:-}" \
+                --create-args="${create_args}"
+
+            # Source the env file to get exported variables, in particular CLUSTER_NAME and KOPS_STATE_STORE
+            # shellcheck disable=SC1091
+            . "${WORKSPACE}/env"
+            export CLUSTER_NAME KOPS_STATE_STORE
+        else
+            "${KOPS}" edit cluster "${CLUSTER_NAME}" "--set=cluster.spec.kubernetesVersion=${k8s_version}"
+
+            # Preview changes
+            "${KOPS}" reconcile cluster --allow-kops-downgrade
+
+            # Apply changes
+            boskos-heartbeat
+            "${KOPS}" reconcile cluster --allow-kops-downgrade --yes
+            boskos-heartbeat
+
+            # Verify no additional changes
+            "${KOPS}" update cluster
+        fi
+
+        kops-upgrade-path-test "${k8s_version}"
+    done
+
+    cp "${KOPS}" "${WORKSPACE}/kops"
+    export PATH="${WORKSPACE}:${PATH}"
+}
@@ -0,0 +1,24 @@
+#!/usr/bin/env bash
+
+# Copyright 2026 The Kubernetes Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Like upgrade-ab, but upgrades the cluster through every step of KOPS_UPGRADE_PATH
+# (by default from kops N-2 through N-1 to N), validating and testing it after each step.
+
+REPO_ROOT=$(git rev-parse --show-toplevel);
+source "${REPO_ROOT}"/tests/e2e/scenarios/lib/common.sh
+source "${REPO_ROOT}"/tests/e2e/scenarios/lib/upgrade.sh
+
+kops-upgrade-path "--networking calico ${KOPS_EXTRA_FLAGS:-}"
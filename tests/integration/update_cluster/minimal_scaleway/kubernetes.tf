@@ -178,6 +178,22 @@ resource "aws_s3_object" "scw-minimal-k8s-local-addons-scaleway-csi-driver-addon
   server_side_encryption = "AES256"
 }
 
+resource "scaleway_block_volume" "etcd-1-etcd-events-scw-minimal-k8s-local" {
+  iops       = 5000
+  name       = "etcd-1.etcd-events.scw-minimal.k8s.local"
+  size_in_gb = 20
+  tags       = ["noprefix=kops.k8s.io/cluster=scw-minimal.k8s.local", "noprefix=kops.k8s.io/etcd=events", "noprefix=kops.k8s.io/role=ControlPlane", "noprefix=kops.k8s.io/instance-group=control-plane-fr-par-1"]
+  zone       = "fr-par-1"
+}
+
+resource "scaleway_block_volume" "etcd-1-etcd-main-scw-minimal-k8s-local" {
+  iops       = 5000
+  name       = "etcd-1.etcd-main.scw-minimal.k8s.local"
+  size_in_gb = 20
+  tags       = ["noprefix=kops.k8s.io/cluster=scw-minimal.k8s.local", "noprefix=kops.k8s.io/etcd=main", "noprefix=kops.k8s.io/role=ControlPlane", "noprefix=kops.k8s.io/instance-group=control-plane-fr-par-1"]
+  zone       = "fr-par-1"
+}
+
 resource "scaleway_iam_ssh_key" "kubernetes-scw-minimal-k8s-local-be_9e_c3_eb_cb_0c_c0_50_ea_bd_b4_5a_15_e3_40_2a" {
   name       = "kubernetes.scw-minimal.k8s.local-be:9e:c3:eb:cb:0c:c0:50:ea:bd:b4:5a:15:e3:40:2a"
   public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDKqbVEozfAqng0gx8HTUu69EppcE5SWet6MpwrGShqMVUC4wkoiuVtJDPhMmWmdt7B7Ttc5pvnAZAZaQ6TKMguyBoAyS7qOTLU9/hM803XtSiwQUftOXiJfmsqAXEc8yDyb7UnrF8X7aA3gQJsnQBGJGdp+C88dPHNZenw4PnQc8BNYTCXG9d8F5vJ3xQ5qbiG4HVNoQ2CZh2ht+GedZJ3hl9lMJ24kE/cbMCLKxabMP4ROetECG6PU251jnm84NA8rm0Av/JMmn/c9CFAe0D0D1dGDlHWPsk4mbhGKJ0yU0YliatmPfmgSasismbYzIFf7VPq91ARzRUbavd1fYMBmkMsce0YR/5FdtrpzRhqDzuvwQgQRsoTcttdvp0puFcrtNefMfk8NCbBedIlkzOFxfGiBbe6jde4wqsqEnSrNHwZ2b+Er8z7vjcDPBqYk3gubmMBCrYxg6o1lOS6tTN0kJDUlyKO2AN1ZDr3mpkbhkvZV/N7gLglcClM0X5X7iM= leila@leila-ThinkPad-T14s-Gen-2i"
@@ -185,10 +201,12 @@ resource "scaleway_iam_ssh_key" "kubernetes-scw-minimal-k8s-local-be_9e_c3_eb_cb
 
 resource "scaleway_instance_ip" "control-plane-fr-par-1-0" {
   tags = ["noprefix=kops.k8s.io/cluster=scw-minimal.k8s.local"]
+  zone = "fr-par-1"
 }
 
 resource "scaleway_instance_ip" "nodes-fr-par-1-0" {
   tags = ["noprefix=kops.k8s.io/cluster=scw-minimal.k8s.local"]
+  zone = "fr-par-1"
 }
 
 resource "scaleway_instance_server" "control-plane-fr-par-1-0" {
@@ -205,6 +223,7 @@ resource "scaleway_instance_server" "control-plane-fr-par-1-0" {
   user_data = {
     "cloud-init" = file("${path.module}/data/scaleway_instance_server_control-plane-fr-par-1-0_user_data")
   }
+  zone = "fr-par-1"
 }
 
 resource "scaleway_instance_server" "nodes-fr-par-1-0" {
@@ -218,20 +237,7 @@ resource "scaleway_instance_server" "nodes-fr-par-1-0" {
   user_data = {
     "cloud-init" = file("${path.module}/data/scaleway_instance_server_nodes-fr-par-1-0_user_data")
   }
-}
-
-resource "scaleway_instance_volume" "etcd-1-etcd-events-scw-minimal-k8s-local" {
-  name       = "etcd-1.etcd-events.scw-minimal.k8s.local"
-  size_in_gb = 20
-  tags       = ["noprefix=kops.k8s.io/cluster=scw-minimal.k8s.local", "noprefix=kops.k8s.io/etcd=events", "noprefix=kops.k8s.io/role=ControlPlane", "noprefix=kops.k8s.io/instance-group=control-plane-fr-par-1"]
-  type       = "b_ssd"
-}
-
-resource "scaleway_instance_volume" "etcd-1-etcd-main-scw-minimal-k8s-local" {
-  name       = "etcd-1.etcd-main.scw-minimal.k8s.local"
-  size_in_gb = 20
-  tags       = ["noprefix=kops.k8s.io/cluster=scw-minimal.k8s.local", "noprefix=kops.k8s.io/etcd=main", "noprefix=kops.k8s.io/role=ControlPlane", "noprefix=kops.k8s.io/instance-group=control-plane-fr-par-1"]
-  type       = "b_ssd"
+  zone = "fr-par-1"
 }
 
 resource "scaleway_lb" "api-scw-minimal-k8s-local" {
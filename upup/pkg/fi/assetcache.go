@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/util/pkg/hashing"
+)
+
+// The shared asset cache is a directory that outlives the node's own cache, for example on a
+// dedicated volume or preseeded in the image. Assets are stored by content, as <algorithm>/<hex digest>,
+// so that it can be populated without knowing how nodeup names its files.
+
+// sharedCachePath returns the path of the asset with the given hash in the shared cache.
+func sharedCachePath(dir string, hash *hashing.Hash) string {
+	return filepath.Join(dir, string(hash.Algorithm), hash.Hex())
+}
+
+// copyFromSharedCache places the asset with the given hash at localFile, if the shared cache
+// holds a valid copy of it.
+func copyFromSharedCache(dir string, localFile string, hash *hashing.Hash) (bool, error) {
+	cached := sharedCachePath(dir, hash)
+	match, err := fileHasHash(cached, hash)
+	if err != nil {
+		return false, err
+	}
+	if !match {
+		return false, nil
+	}
+
+	if err := linkOrCopyFile(cached, localFile); err != nil {
+		return false, err
+	}
+	klog.Infof("Using cached asset %q for %s", cached, hash)
+	return true, nil
+}
+
+// addToSharedCache stores the asset downloaded to localFile in the shared cache.
+func addToSharedCache(dir string, localFile string, hash *hashing.Hash) error {
+	cached := sharedCachePath(dir, hash)
+	match, err := fileHasHash(cached, hash)
+	if err != nil || match {
+		return err
+	}
+	return linkOrCopyFile(localFile, cached)
+}
+
+// linkOrCopyFile atomically creates dest with the contents of src, using a hard link when possible.
+func linkOrCopyFile(src string, dest string) error {
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return fmt.Errorf("error creating directories for %q: %v", dest, err)
+	}
+
+	tempPath := dest + ".tmp-" + strconv.FormatInt(time.Now().UnixNano(), 10)
+	defer os.Remove(tempPath)
+
+	if err := os.Link(src, tempPath); err != nil {
+		// Probably on a different filesystem
+		if err := copyFile(src, tempPath); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Rename(tempPath, dest); err != nil {
+		return fmt.Errorf("error moving %q to %q: %v", tempPath, dest, err)
+	}
+	return nil
+}
+
+func copyFile(src string, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("error opening %q: %v", src, err)
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("error creating %q: %v", dest, err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return fmt.Errorf("error copying %q to %q: %v", src, dest, err)
+	}
+	if err := out.Close(); err != nil {
+		return fmt.Errorf("error writing %q: %v", dest, err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/kops/util/pkg/hashing"
+)
+
+func TestAssetStoreSharedCache(t *testing.T) {
+	body := []byte("payload")
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	hash, err := hashing.HashAlgorithmSHA256.Hash(bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	id := hash.Hex() + "@" + server.URL + "/asset"
+
+	sharedCacheDir := t.TempDir()
+	options := DownloadOptions{CacheDirectory: sharedCacheDir}
+
+	// The first node downloads the asset and adds it to the shared cache.
+	store := NewAssetStore(t.TempDir())
+	store.SetDownloadOptions(options)
+	if err := store.AddAll(context.TODO(), []string{id}); err != nil {
+		t.Fatalf("AddAll() error = %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("made %d requests, expected 1", requests)
+	}
+
+	cached, err := os.ReadFile(filepath.Join(sharedCacheDir, "sha256", hash.Hex()))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !bytes.Equal(cached, body) {
+		t.Fatalf("cached %q, expected %q", cached, body)
+	}
+
+	// After reprovisioning, the asset is taken from the shared cache.
+	store = NewAssetStore(t.TempDir())
+	store.SetDownloadOptions(options)
+	if err := store.AddAll(context.TODO(), []string{id}); err != nil {
+		t.Fatalf("AddAll() error = %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests, expected the asset to be taken from the cache", requests)
+	}
+	if len(store.assets) != 1 {
+		t.Fatalf("added %d assets, expected 1", len(store.assets))
+	}
+
+	// A corrupt cache entry is ignored.
+	if err := os.WriteFile(filepath.Join(sharedCacheDir, "sha256", hash.Hex()), []byte("corrupt"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	store = NewAssetStore(t.TempDir())
+	store.SetDownloadOptions(options)
+	if err := store.AddAll(context.TODO(), []string{id}); err != nil {
+		t.Fatalf("AddAll() error = %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("made %d requests, expected the corrupt cache entry to be downloaded again", requests)
+	}
+}
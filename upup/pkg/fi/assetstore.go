@@ -28,8 +28,11 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/upup/pkg/fi/utils"
 	"k8s.io/kops/util/pkg/hashing"
@@ -94,6 +97,12 @@ func (r *assetResource) GetSource() *Source {
 type AssetStore struct {
 	cacheDir string
 	assets   []*asset
+
+	downloadOptions DownloadOptions
+	// limiter bounds the total bandwidth of the downloads, if set.
+	limiter *rate.Limiter
+	// fileLocks serializes downloads to the same local file.
+	fileLocks sync.Map
 }
 
 func NewAssetStore(cacheDir string) *AssetStore {
@@ -103,6 +112,12 @@ func NewAssetStore(cacheDir string) *AssetStore {
 	return a
 }
 
+// SetDownloadOptions configures how assets are downloaded by AddAll.
+func (a *AssetStore) SetDownloadOptions(options DownloadOptions) {
+	a.downloadOptions = options
+	a.limiter = newBandwidthLimiter(options.BandwidthLimit)
+}
+
 func (a *AssetStore) FindMatches(expr *regexp.Regexp) map[string]Resource {
 	matches := make(map[string]Resource)
 
@@ -199,10 +214,61 @@ func hashFromHTTPHeader(url string) (*hashing.Hash, error) {
 	return nil, fmt.Errorf("unable to determine hash from HTTP HEAD: %q", url)
 }
 
+// assetDownload is an asset being added to the store.
+type assetDownload struct {
+	id        string
+	urls      []string
+	hash      *hashing.Hash
+	localFile string
+}
+
 // Add an asset into the store, in one of the recognized formats (see Assets in types package)
 func (a *AssetStore) Add(ctx context.Context, id string) error {
+	return a.AddAll(ctx, []string{id})
+}
+
+// AddAll adds assets into the store, downloading them in parallel.
+// The assets are added in the order given, once all the downloads are complete.
+func (a *AssetStore) AddAll(ctx context.Context, ids []string) error {
+	var downloads []*assetDownload
+	for _, id := range ids {
+		download, err := parseAssetID(id)
+		if err != nil {
+			return fmt.Errorf("error adding asset %q: %w", id, err)
+		}
+		downloads = append(downloads, download)
+	}
+
+	concurrency := a.downloadOptions.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultDownloadConcurrency
+	}
+
+	var eg errgroup.Group
+	eg.SetLimit(concurrency)
+	for _, download := range downloads {
+		eg.Go(func() error {
+			if err := a.download(ctx, download); err != nil {
+				return fmt.Errorf("error adding asset %q: %w", download.id, err)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	for _, download := range downloads {
+		if err := a.addDownloaded(download); err != nil {
+			return fmt.Errorf("error adding asset %q: %w", download.id, err)
+		}
+	}
+	return nil
+}
+
+func parseAssetID(id string) (*assetDownload, error) {
 	if strings.HasPrefix(id, "http://") || strings.HasPrefix(id, "https://") || strings.HasPrefix(id, "gs://") {
-		return a.addURLs(ctx, strings.Split(id, ","), nil)
+		return &assetDownload{id: id, urls: strings.Split(id, ",")}, nil
 	}
 	i := strings.Index(id, "@http://")
 	if i == -1 {
@@ -215,20 +281,23 @@ func (a *AssetStore) Add(ctx context.Context, id string) error {
 		urls := strings.Split(id[i+1:], ",")
 		hash, err := hashing.FromString(id[:i])
 		if err != nil {
-			return err
+			return nil, err
 		}
-		return a.addURLs(ctx, urls, hash)
+		return &assetDownload{id: id, urls: urls, hash: hash}, nil
 	}
 	// TODO: local files!
-	return fmt.Errorf("unknown asset format: %q", id)
+	return nil, fmt.Errorf("unknown asset format: %q", id)
 }
 
-func (a *AssetStore) addURLs(ctx context.Context, urls []string, hash *hashing.Hash) error {
+// download fetches the asset into the cache directory, trying each of its urls in turn.
+func (a *AssetStore) download(ctx context.Context, d *assetDownload) error {
+	urls := d.urls
 	if len(urls) == 0 {
 		return fmt.Errorf("no urls were specified")
 	}
 
 	var err error
+	hash := d.hash
 	if hash == nil {
 		for _, url := range urls {
 			hash, err = hashFromHTTPHeader(url)
@@ -245,12 +314,34 @@ func (a *AssetStore) addURLs(ctx context.Context, urls []string, hash *hashing.H
 	}
 
 	// We assume the first url is the "main" url, and download to the base of that _name_, wherever we get it from
-	primaryURL := urls[0]
-	key := path.Base(primaryURL)
+	key := path.Base(urls[0])
 	localFile := path.Join(a.cacheDir, hash.String()+"_"+utils.SanitizeString(key))
 
+	lock, _ := a.fileLocks.LoadOrStore(localFile, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	d.hash = hash
+	d.localFile = localFile
+
+	match, err := fileHasHash(localFile, hash)
+	if err != nil || match {
+		return err
+	}
+
+	sharedCacheDir := a.downloadOptions.CacheDirectory
+	if sharedCacheDir != "" {
+		found, err := copyFromSharedCache(sharedCacheDir, localFile, hash)
+		if err != nil {
+			klog.Warningf("error reading asset %s from cache %q: %v", hash, sharedCacheDir, err)
+		}
+		if found {
+			return nil
+		}
+	}
+
 	for _, url := range urls {
-		_, err = DownloadURL(ctx, url, localFile, hash)
+		_, err = downloadURLResumable(ctx, url, localFile, hash, a.limiter)
 		if err != nil {
 			klog.Warningf("error downloading url %q: %v", url, err)
 			continue
@@ -262,10 +353,23 @@ func (a *AssetStore) addURLs(ctx context.Context, urls []string, hash *hashing.H
 		return err
 	}
 
+	if sharedCacheDir != "" {
+		if err := addToSharedCache(sharedCacheDir, localFile, hash); err != nil {
+			klog.Warningf("error adding asset %s to cache %q: %v", hash, sharedCacheDir, err)
+		}
+	}
+	return nil
+}
+
+// addDownloaded adds a downloaded asset, and the contents of archives, into the store.
+func (a *AssetStore) addDownloaded(d *assetDownload) error {
+	primaryURL := d.urls[0]
+	key := path.Base(primaryURL)
+
 	assetPath := primaryURL
-	r := NewFileResource(localFile)
+	r := NewFileResource(d.localFile)
 
-	source := &Source{URL: primaryURL, Hash: hash}
+	source := &Source{URL: primaryURL, Hash: d.hash}
 
 	asset := &asset{
 		Key:       key,
@@ -280,8 +384,7 @@ func (a *AssetStore) addURLs(ctx context.Context, urls []string, hash *hashing.H
 	file := strings.ToLower(assetPath)
 	// pickup both tar.gz and tgz files
 	if strings.HasSuffix(file, ".tar.gz") || strings.HasSuffix(file, ".tgz") {
-		err = a.addArchive(source, localFile)
-		if err != nil {
+		if err := a.addArchive(source, d.localFile); err != nil {
 			return err
 		}
 	}
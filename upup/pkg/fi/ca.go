@@ -56,6 +56,15 @@ type Keyset struct {
 	// Primary is the KeysetItem that is considered the "active" key.
 	// It is guaranteed to be non-nil, if there are any keypairs.
 	Primary *KeysetItem
+
+	// stored records where the Keyset was loaded from, so that writes can detect concurrent modifications.
+	stored *storedKeysetVersion
+}
+
+// storedKeysetVersion is the version of a serialized Keyset, as returned by vfs.ReadFileVersion
+type storedKeysetVersion struct {
+	path    string
+	version string
 }
 
 // KeysetItem is a certificate/key pair in a Keyset.
@@ -182,6 +191,28 @@ func (k *Keyset) ToPublicKeys() (string, error) {
 	return buf.String(), nil
 }
 
+// ToPublicKeyPins returns the pins of the public keys of the trusted items, see pki.PublicKeyPin.
+func (k *Keyset) ToPublicKeyPins() []string {
+	keys := make([]string, 0, len(k.Items))
+	for k, item := range k.Items {
+		if item.DistrustTimestamp == nil {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return KeysetItemIdOlder(k.Items[keys[i]].Id, k.Items[keys[j]].Id)
+	})
+
+	var pins []string
+	for _, key := range keys {
+		item := k.Items[key]
+		if item.Certificate != nil && item.Certificate.Certificate != nil {
+			pins = append(pins, pki.PublicKeyPin(item.Certificate.Certificate.RawSubjectPublicKeyInfo))
+		}
+	}
+	return pins
+}
+
 // NewKeyset creates a Keyset.
 func NewKeyset(cert *pki.Certificate, privateKey *pki.PrivateKey) (*Keyset, error) {
 	keyset := &Keyset{
@@ -398,3 +398,27 @@ func assertSerialOlderThan(t *testing.T, id string, cert *pki.Certificate) {
 		t.Errorf("id %q not smaller than %q", id, cert.Certificate.SerialNumber.String())
 	}
 }
+
+func TestToPublicKeyPins(t *testing.T) {
+	cert, err := pki.ParsePEMCertificate([]byte(certData))
+	require.NoError(t, err)
+	privateKey, err := pki.ParsePEMPrivateKey([]byte(privatekeyData))
+	require.NoError(t, err)
+	afterCert, err := pki.ParsePEMCertificate([]byte(afterCertData))
+	require.NoError(t, err)
+	afterPrivateKey, err := pki.ParsePEMPrivateKey([]byte(afterPrivateKeyData))
+	require.NoError(t, err)
+
+	keyset, err := fi.NewKeyset(cert, privateKey)
+	require.NoError(t, err)
+	_, err = keyset.AddItem(afterCert, afterPrivateKey, false)
+	require.NoError(t, err)
+
+	certPin := pki.PublicKeyPin(cert.Certificate.RawSubjectPublicKeyInfo)
+	afterPin := pki.PublicKeyPin(afterCert.Certificate.RawSubjectPublicKeyInfo)
+	assert.Equal(t, []string{certPin, afterPin}, keyset.ToPublicKeyPins())
+
+	now := time.Now()
+	keyset.Primary.DistrustTimestamp = &now
+	assert.Equal(t, []string{afterPin}, keyset.ToPublicKeyPins())
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package fi
 
 import (
+	"context"
+
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/kops/dnsprovider/pkg/dnsprovider"
 	"k8s.io/kops/pkg/apis/kops"
@@ -51,8 +53,8 @@ type Cloud interface {
 	Region() string
 
 	// FindClusterStatus discovers the status of the cluster, by inspecting the cloud objects
-	FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error)
-	GetApiIngressStatus(cluster *kops.Cluster) ([]ApiIngressStatus, error)
+	FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error)
+	GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]ApiIngressStatus, error)
 }
 
 type VPCInfo struct {
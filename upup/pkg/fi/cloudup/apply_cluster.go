@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -36,11 +37,13 @@ import (
 	"k8s.io/kops/pkg/apis/kops/validation"
 	"k8s.io/kops/pkg/assets"
 	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/clusterlock"
 	"k8s.io/kops/pkg/featureflag"
 	"k8s.io/kops/pkg/kubemanifest"
 	"k8s.io/kops/pkg/model"
 	"k8s.io/kops/pkg/model/awsmodel"
 	"k8s.io/kops/pkg/model/azuremodel"
+	"k8s.io/kops/pkg/model/components"
 	"k8s.io/kops/pkg/model/components/channels"
 	"k8s.io/kops/pkg/model/components/etcdmanager"
 	"k8s.io/kops/pkg/model/components/kubeapiserver"
@@ -131,6 +134,9 @@ type ApplyClusterCmd struct {
 	// GetAssets is whether this is called just to obtain the list of assets.
 	GetAssets bool
 
+	// DryRunReport is where the report of the changes is written on a dry-run; defaults to stdout.
+	DryRunReport io.Writer
+
 	// TaskMap is the map of tasks that we built (output)
 	TaskMap map[string]fi.CloudupTask
 
@@ -145,6 +151,18 @@ type ApplyClusterCmd struct {
 
 	// The current oldest version of control plane nodes, defaults to version defined in cluster spec if IgnoreVersionSkew was set
 	ControlPlaneRunningVersion string
+
+	// CapacityReport prints how the planned on-demand capacity maps onto the account's reservations and Savings Plans (AWS only).
+	CapacityReport bool
+
+	// AllowUnknownOnDemandPrices skips the onDemandMaxPrice guardrail, with a warning, for instance types whose price cannot be looked up.
+	AllowUnknownOnDemandPrices bool
+
+	// ForceUnlock removes the lock of the cluster held by another kops process before applying.
+	ForceUnlock bool
+
+	// TaskGraph, if not nil, is where the dependency graph of the tasks is written, in Graphviz DOT format.
+	TaskGraph io.Writer
 }
 
 // ApplyResults holds information about an ApplyClusterCmd operation.
@@ -153,6 +171,33 @@ type ApplyResults struct {
 	AssetBuilder *assets.AssetBuilder
 }
 
+// maxConcurrentTasks is how many tasks run at the same time for each cloud provider,
+// keeping the API calls made by large clusters within the provider's rate limits.
+// Providers not listed run all the tasks that are ready at the same time.
+var maxConcurrentTasks = map[kops.CloudProviderID]int{
+	kops.CloudProviderAWS:       20,
+	kops.CloudProviderAzure:     10,
+	kops.CloudProviderDO:        5,
+	kops.CloudProviderGCE:       20,
+	kops.CloudProviderHetzner:   5,
+	kops.CloudProviderOpenstack: 10,
+	kops.CloudProviderScaleway:  5,
+}
+
+// lockCluster acquires the lock of the cluster in the state store, so that concurrent kops processes don't mutate it.
+func (c *ApplyClusterCmd) lockCluster(ctx context.Context) (*clusterlock.Lock, error) {
+	configBase, err := c.Clientset.ConfigBaseFor(c.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	if c.ForceUnlock {
+		if err := clusterlock.ForceUnlock(ctx, configBase); err != nil {
+			return nil, err
+		}
+	}
+	return clusterlock.Acquire(ctx, configBase)
+}
+
 func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 	if c.TargetName == TargetTerraform {
 		found := false
@@ -172,6 +217,19 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 			return nil, fmt.Errorf("cloud provider DigitalOcean requires the DOTerraform feature flag to enable the terraform target")
 		}
 	}
+
+	if !c.DryRun && !c.GetAssets {
+		lock, err := c.lockCluster(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := lock.Release(context.WithoutCancel(ctx)); err != nil {
+				klog.Warningf("%v", err)
+			}
+		}()
+	}
+
 	if c.InstanceGroups == nil {
 		list, err := c.Clientset.InstanceGroupsFor(c.Cluster).List(ctx, metav1.ListOptions{})
 		if err != nil {
@@ -302,7 +360,12 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 
 	err = validation.DeepValidate(c.Cluster, c.InstanceGroups, true, c.Clientset.VFSContext(), cloud)
 	if err != nil {
-		return nil, err
+		return nil, fi.NewUserConfigError(err, "Fix the cluster or instance group spec with `kops edit cluster` or `kops edit instancegroup`.")
+	}
+
+	err = validation.ValidateMachineTypeAvailability(ctx, c.Cluster, c.InstanceGroups, cloud).ToAggregate()
+	if err != nil {
+		return nil, fi.NewUserConfigError(err, "Change the machine type of the instance group with `kops edit instancegroup`.")
 	}
 
 	if cluster.Spec.KubernetesVersion == "" {
@@ -384,6 +447,19 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 		encryptionConfigSecretHash = base64.URLEncoding.EncodeToString(hashBytes[:])
 	}
 
+	auditWebhookTokenSecretHash := ""
+	if c.Cluster.Spec.KubeAPIServer != nil && c.Cluster.Spec.KubeAPIServer.AuditWebhook != nil && c.Cluster.Spec.KubeAPIServer.AuditWebhook.UseBearerToken {
+		secret, err := secretStore.FindSecret(components.AuditWebhookTokenSecretName)
+		if err != nil {
+			return nil, fmt.Errorf("could not load the %s secret: %w", components.AuditWebhookTokenSecretName, err)
+		}
+		if secret == nil {
+			return nil, fmt.Errorf("the audit webhook uses a bearer token, but no %s secret has been set; see `kops create secret auditwebhooktoken -h`", components.AuditWebhookTokenSecretName)
+		}
+		hashBytes := sha256.Sum256(secret.Data)
+		auditWebhookTokenSecretHash = base64.URLEncoding.EncodeToString(hashBytes[:])
+	}
+
 	ciliumSpec := c.Cluster.Spec.Networking.Cilium
 	if ciliumSpec != nil && ciliumSpec.EnableEncryption && ciliumSpec.EncryptionType == kops.CiliumEncryptionTypeIPSec {
 		secret, err := secretStore.FindSecret("ciliumpassword")
@@ -398,6 +474,28 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 		}
 	}
 
+	var etcdBackupCredentials *etcdmanager.BackupCredentials
+	for _, etcdCluster := range c.Cluster.Spec.EtcdClusters {
+		if etcdCluster.Backups == nil || etcdCluster.Backups.S3 == nil {
+			continue
+		}
+		secret, err := secretStore.FindSecret(etcdmanager.BackupCredentialsSecretName)
+		if err != nil {
+			return nil, fmt.Errorf("could not load the %s secret: %w", etcdmanager.BackupCredentialsSecretName, err)
+		}
+		if secret == nil {
+			fmt.Println("")
+			fmt.Println("You have configured etcd backups with backups.s3, but no etcdbackupcredentials secret has been set.")
+			fmt.Println("See `kops create secret etcdbackupcredentials -h`")
+			return nil, fmt.Errorf("could not find %s secret", etcdmanager.BackupCredentialsSecretName)
+		}
+		etcdBackupCredentials, err = etcdmanager.ParseBackupCredentials(secret.Data)
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+
 	project := ""
 	scwZone := ""
 
@@ -428,6 +526,15 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 		{
 			gceCloud := cloud.(gce.GCECloud)
 			project = gceCloud.Project()
+
+			if discovery := cluster.Spec.ServiceAccountIssuerDiscovery; discovery != nil && discovery.EnableGCPWorkloadIdentityFederation {
+				// The workload identity pool and its principals are named after the project number
+				p, err := gceCloud.CloudResourceManager().Projects.Get(project).Context(ctx).Do()
+				if err != nil {
+					return nil, fmt.Errorf("error getting project %q: %w", project, err)
+				}
+				modelContext.GCPProjectNumber = strconv.FormatInt(p.ProjectNumber, 10)
+			}
 		}
 
 	case kops.CloudProviderHetzner:
@@ -455,6 +562,12 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 			if len(sshPublicKeys) > 1 {
 				return nil, fmt.Errorf("exactly one 'admin' SSH public key can be specified when running with AWS; please delete a key using `kops delete secret`")
 			}
+
+			if !c.GetAssets {
+				if err := c.checkAWSCapacity(ctx, awsCloud, filteredInstanceGroups, os.Stdout); err != nil {
+					return nil, err
+				}
+			}
 		}
 
 	case kops.CloudProviderAzure:
@@ -533,7 +646,7 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 	if err != nil {
 		return nil, err
 	}
-	configBuilder, err := nodemodel.NewNodeUpConfigBuilder(cluster, assetBuilder, encryptionConfigSecretHash)
+	configBuilder, err := nodemodel.NewNodeUpConfigBuilder(cluster, assetBuilder, encryptionConfigSecretHash, auditWebhookTokenSecretHash)
 	if err != nil {
 		return nil, err
 	}
@@ -582,9 +695,10 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 				Lifecycle:        clusterLifecycle,
 			},
 			&etcdmanager.EtcdManagerBuilder{
-				AssetBuilder:     assetBuilder,
-				KopsModelContext: modelContext,
-				Lifecycle:        clusterLifecycle,
+				AssetBuilder:      assetBuilder,
+				KopsModelContext:  modelContext,
+				Lifecycle:         clusterLifecycle,
+				BackupCredentials: etcdBackupCredentials,
 			},
 			&channels.ChannelsBuilder{
 				AssetBuilder:     assetBuilder,
@@ -684,6 +798,7 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 				&gcemodel.StorageAclBuilder{GCEModelContext: gceModelContext, Cloud: cloud.(gce.GCECloud), Lifecycle: storageACLLifecycle},
 				&gcemodel.AutoscalingGroupModelBuilder{GCEModelContext: gceModelContext, BootstrapScriptBuilder: bootstrapScriptBuilder, Lifecycle: clusterLifecycle},
 				&gcemodel.ServiceAccountsBuilder{GCEModelContext: gceModelContext, Lifecycle: clusterLifecycle},
+				&gcemodel.WorkloadIdentityBuilder{GCEModelContext: gceModelContext, Lifecycle: clusterLifecycle},
 			)
 		case kops.CloudProviderAzure:
 			azureModelContext := &azuremodel.AzureModelContext{
@@ -819,6 +934,9 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 
 	case TargetDryRun:
 		var out io.Writer = os.Stdout
+		if c.DryRunReport != nil {
+			out = c.DryRunReport
+		}
 		checkExisting := true
 		if c.GetAssets {
 			out = io.Discard
@@ -843,6 +961,12 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 		}
 	}
 
+	if c.TaskGraph != nil {
+		if err := fi.WriteTaskGraph(c.TaskGraph, c.TaskMap); err != nil {
+			return nil, fmt.Errorf("error writing task graph: %w", err)
+		}
+	}
+
 	context, err := fi.NewCloudupContext(ctx, deletionProcessingMode, target, cluster, cloud, keyStore, secretStore, configBase, c.TaskMap)
 	if err != nil {
 		return nil, fmt.Errorf("error building context: %v", err)
@@ -854,10 +978,19 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 	} else {
 		options.InitDefaults()
 	}
+	if options.MaxConcurrentTasks == 0 {
+		options.MaxConcurrentTasks = maxConcurrentTasks[cloud.ProviderID()]
+	}
+
+	// Tasks looking up the same cloud objects share the results during this apply
+	if hasDescribeCache, ok := cloud.(fi.HasDescribeCache); ok && hasDescribeCache.DescribeCache() == nil {
+		hasDescribeCache.SetDescribeCache(fi.NewDescribeCache(nil))
+		defer hasDescribeCache.SetDescribeCache(nil)
+	}
 
 	err = context.RunTasks(options)
 	if err != nil {
-		return nil, fmt.Errorf("error running tasks: %v", err)
+		return nil, fmt.Errorf("error running tasks: %w", err)
 	}
 
 	if !cluster.PublishesDNSRecords() {
@@ -867,6 +867,26 @@ type terraformAutoscalingMixedInstancesPolicyLaunchTemplateSpecification struct
 type terraformAutoscalingMixedInstancesPolicyLaunchTemplateOverride struct {
 	// InstanceType is the instance to use
 	InstanceType *string `cty:"instance_type"`
+	// InstanceRequirements are the attributes of the instance types to use
+	InstanceRequirements []*terraformAutoscalingInstanceRequirements `cty:"instance_requirements"`
+}
+
+type terraformAutoscalingMinMax struct {
+	Min *int32 `cty:"min"`
+	Max *int32 `cty:"max"`
+}
+
+type terraformAutoscalingInstanceRequirements struct {
+	// VCPUCount is the range of vCPUs of the instance types
+	VCPUCount []*terraformAutoscalingMinMax `cty:"vcpu_count"`
+	// MemoryMiB is the range of memory, in MiB, of the instance types
+	MemoryMiB []*terraformAutoscalingMinMax `cty:"memory_mib"`
+	// CPUManufacturers are the CPU manufacturers of the instance types
+	CPUManufacturers []*string `cty:"cpu_manufacturers"`
+	// ExcludedInstanceTypes are the instance types to exclude
+	ExcludedInstanceTypes []*string `cty:"excluded_instance_types"`
+	// BurstablePerformance indicates whether burstable instance types are included
+	BurstablePerformance *string `cty:"burstable_performance"`
 }
 
 type terraformAutoscalingMixedInstancesPolicyLaunchTemplate struct {
@@ -899,8 +919,14 @@ type terraformMixedInstancesPolicy struct {
 }
 
 type terraformWarmPool struct {
-	MinSize *int32 `cty:"min_size"`
-	MaxSize *int32 `cty:"max_group_prepared_capacity"`
+	MinSize             *int32                        `cty:"min_size"`
+	MaxSize             *int32                        `cty:"max_group_prepared_capacity"`
+	PoolState           *string                       `cty:"pool_state"`
+	InstanceReusePolicy *terraformInstanceReusePolicy `cty:"instance_reuse_policy"`
+}
+
+type terraformInstanceReusePolicy struct {
+	ReuseOnScaleIn *bool `cty:"reuse_on_scale_in"`
 }
 
 type terraformAutoscalingGroup struct {
@@ -993,6 +1019,22 @@ func (_ *AutoscalingGroup) RenderTerraform(t *terraform.TerraformTarget, a, e, c
 		for _, x := range e.MixedInstanceOverrides {
 			tf.MixedInstancesPolicy[0].LaunchTemplate[0].Override = append(tf.MixedInstancesPolicy[0].LaunchTemplate[0].Override, &terraformAutoscalingMixedInstancesPolicyLaunchTemplateOverride{InstanceType: new(x)})
 		}
+		if ir := e.InstanceRequirements; ir != nil {
+			tfIR := &terraformAutoscalingInstanceRequirements{
+				VCPUCount:            []*terraformAutoscalingMinMax{{Min: ir.CPUMin, Max: ir.CPUMax}},
+				MemoryMiB:            []*terraformAutoscalingMinMax{{Min: ir.MemoryMin, Max: ir.MemoryMax}},
+				BurstablePerformance: new(string(autoscalingtypes.BurstablePerformanceIncluded)),
+			}
+			if len(ir.CPUManufacturers) > 0 {
+				tfIR.CPUManufacturers = aws.StringSlice(ir.CPUManufacturers)
+			}
+			if len(ir.ExcludedInstanceTypes) > 0 {
+				tfIR.ExcludedInstanceTypes = aws.StringSlice(ir.ExcludedInstanceTypes)
+			}
+			tf.MixedInstancesPolicy[0].LaunchTemplate[0].Override = append(tf.MixedInstancesPolicy[0].LaunchTemplate[0].Override, &terraformAutoscalingMixedInstancesPolicyLaunchTemplateOverride{
+				InstanceRequirements: []*terraformAutoscalingInstanceRequirements{tfIR},
+			})
+		}
 	} else if e.LaunchTemplate != nil {
 		tf.LaunchTemplate = &terraformAutoscalingLaunchTemplateSpecification{
 			LaunchTemplateID: e.LaunchTemplate.TerraformLink(),
@@ -1049,6 +1091,14 @@ func (_ *AutoscalingGroup) RenderTerraform(t *terraform.TerraformTarget, a, e, c
 			MinSize: &e.WarmPool.MinSize,
 			MaxSize: e.WarmPool.MaxSize,
 		}
+		if e.WarmPool.PoolState != "" {
+			tf.WarmPool.PoolState = new(string(e.WarmPool.PoolState))
+		}
+		if fi.ValueOf(e.WarmPool.ReuseOnScaleIn) {
+			tf.WarmPool.InstanceReusePolicy = &terraformInstanceReusePolicy{
+				ReuseOnScaleIn: e.WarmPool.ReuseOnScaleIn,
+			}
+		}
 	}
 
 	return t.RenderResource("aws_autoscaling_group", *e.Name, tf)
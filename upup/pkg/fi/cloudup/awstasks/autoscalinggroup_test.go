@@ -402,6 +402,58 @@ resource "aws_autoscaling_group" "test1" {
   }
 }
 
+terraform {
+  required_version = ">= 0.15.0"
+  required_providers {
+    aws = {
+      "source"  = "hashicorp/aws"
+      "version" = ">= 5.0.0"
+    }
+  }
+}
+`,
+		},
+		{
+			Resource: &AutoscalingGroup{
+				Name:           new("test1"),
+				LaunchTemplate: &LaunchTemplate{Name: new("test_lt")},
+				MaxSize:        new(int32(10)),
+				MinSize:        new(int32(1)),
+				WarmPool: &WarmPool{
+					Enabled:        new(true),
+					MinSize:        2,
+					PoolState:      autoscalingtypes.WarmPoolStateHibernated,
+					ReuseOnScaleIn: new(true),
+				},
+				Subnets: []*Subnet{
+					{
+						Name: new("test-sg"),
+						ID:   new("sg-1111"),
+					},
+				},
+			},
+			Expected: `provider "aws" {
+  region = "eu-west-2"
+}
+
+resource "aws_autoscaling_group" "test1" {
+  launch_template {
+    id      = aws_launch_template.test_lt.id
+    version = aws_launch_template.test_lt.latest_version
+  }
+  max_size            = 10
+  min_size            = 1
+  name                = "test1"
+  vpc_zone_identifier = [aws_subnet.test-sg.id]
+  warm_pool {
+    instance_reuse_policy {
+      reuse_on_scale_in = true
+    }
+    min_size   = 2
+    pool_state = "Hibernated"
+  }
+}
+
 terraform {
   required_version = ">= 0.15.0"
   required_providers {
@@ -29,6 +29,7 @@ type InstanceRequirements struct {
 	MemoryMin             *int32
 	MemoryMax             *int32
 	ExcludedInstanceTypes []string
+	CPUManufacturers      []string
 }
 
 var _ fi.CloudupHasDependencies = (*InstanceRequirements)(nil)
@@ -53,6 +54,9 @@ func findInstanceRequirements(asg *autoscalingtypes.AutoScalingGroup) (*Instance
 				if len(override.InstanceRequirements.ExcludedInstanceTypes) > 0 {
 					actual.ExcludedInstanceTypes = override.InstanceRequirements.ExcludedInstanceTypes
 				}
+				for _, manufacturer := range override.InstanceRequirements.CpuManufacturers {
+					actual.CPUManufacturers = append(actual.CPUManufacturers, string(manufacturer))
+				}
 				return actual, nil
 			}
 		}
@@ -75,6 +79,9 @@ func overridesFromInstanceRequirements(ir *InstanceRequirements) autoscalingtype
 	if len(ir.ExcludedInstanceTypes) > 0 {
 		req.ExcludedInstanceTypes = ir.ExcludedInstanceTypes
 	}
+	for _, manufacturer := range ir.CPUManufacturers {
+		req.CpuManufacturers = append(req.CpuManufacturers, autoscalingtypes.CpuManufacturer(manufacturer))
+	}
 	return autoscalingtypes.LaunchTemplateOverrides{
 		InstanceRequirements: req,
 	}
@@ -42,6 +42,8 @@ type LaunchTemplate struct {
 	BlockDeviceMappings []*BlockDeviceMapping
 	// CPUCredits is the credit option for CPU Usage on some instance types
 	CPUCredits *string
+	// HibernationConfigured enables hibernation for the instances
+	HibernationConfigured *bool
 	// HTTPPutResponseHopLimit is the desired HTTP PUT response hop limit for instance metadata requests.
 	HTTPPutResponseHopLimit *int32
 	// HTTPTokens is the state of token usage for your instance metadata requests.
@@ -148,6 +148,11 @@ func (t *LaunchTemplate) RenderAWS(c *awsup.AWSAPITarget, a, e, changes *LaunchT
 			SpotOptions: s,
 		}
 	}
+	if fi.ValueOf(t.HibernationConfigured) {
+		data.HibernationOptions = &ec2types.LaunchTemplateHibernationOptionsRequest{
+			Configured: new(true),
+		}
+	}
 	if fi.ValueOf(t.CPUCredits) != "" {
 		data.CreditSpecification = &ec2types.CreditSpecificationRequest{
 			CpuCredits: t.CPUCredits,
@@ -249,6 +254,10 @@ func (t *LaunchTemplate) Find(c *fi.CloudupContext) (*LaunchTemplate, error) {
 	} else {
 		actual.CPUCredits = aws.String("")
 	}
+	actual.HibernationConfigured = new(false)
+	if lt.LaunchTemplateData.HibernationOptions != nil {
+		actual.HibernationConfigured = new(aws.ToBool(lt.LaunchTemplateData.HibernationOptions.Configured))
+	}
 	// @step: check if monitoring it enabled
 	if lt.LaunchTemplateData.Monitoring != nil {
 		actual.InstanceMonitoring = lt.LaunchTemplateData.Monitoring.Enabled
@@ -111,6 +111,10 @@ type terraformLaunchTemplateCreditSpecification struct {
 	CPUCredits *string `cty:"cpu_credits"`
 }
 
+type terraformLaunchTemplateHibernationOptions struct {
+	Configured *bool `cty:"configured"`
+}
+
 type terraformLaunchTemplateTagSpecification struct {
 	// ResourceType is the type of resource to tag.
 	ResourceType *string `cty:"resource_type"`
@@ -141,6 +145,8 @@ type terraformLaunchTemplate struct {
 	CreditSpecification *terraformLaunchTemplateCreditSpecification `cty:"credit_specification"`
 	// EBSOptimized indicates if the root device is ebs optimized
 	EBSOptimized *bool `cty:"ebs_optimized"`
+	// HibernationOptions configures hibernation for the instances
+	HibernationOptions *terraformLaunchTemplateHibernationOptions `cty:"hibernation_options"`
 	// IAMInstanceProfile is the IAM profile to assign to the nodes
 	IAMInstanceProfile []*terraformLaunchTemplateIAMProfile `cty:"iam_instance_profile"`
 	// ImageID is the ami to use for the instances
@@ -232,6 +238,11 @@ func (t *LaunchTemplate) RenderTerraform(target *terraform.TerraformTarget, a, e
 			CPUCredits: e.CPUCredits,
 		}
 	}
+	if fi.ValueOf(e.HibernationConfigured) {
+		tf.HibernationOptions = &terraformLaunchTemplateHibernationOptions{
+			Configured: e.HibernationConfigured,
+		}
+	}
 	for _, x := range e.SecurityGroups {
 		tf.NetworkInterfaces[0].SecurityGroups = append(tf.NetworkInterfaces[0].SecurityGroups, x.TerraformLink())
 	}
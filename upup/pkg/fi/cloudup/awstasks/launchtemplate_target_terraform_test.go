@@ -123,6 +123,7 @@ terraform {
 					},
 				},
 				ID:                     new("test-11"),
+				HibernationConfigured:  new(true),
 				InstanceMonitoring:     new(true),
 				InstanceType:           new(ec2types.InstanceTypeT2Medium),
 				RootVolumeOptimization: new(true),
@@ -154,6 +155,9 @@ resource "aws_launch_template" "test" {
     }
   }
   ebs_optimized = true
+  hibernation_options {
+    configured = true
+  }
   iam_instance_profile {
     name = aws_iam_instance_profile.nodes.id
   }
@@ -240,6 +240,12 @@ func buildDeleteSecurityGroupRule(rule ec2types.SecurityGroupRule) *deleteSecuri
 
 var _ fi.CloudupDeletion = (*deleteSecurityGroupRule)(nil)
 
+// IsSecurityGroupRuleDeletion returns true if the deletion revokes a security group rule that is not in the cluster spec.
+func IsSecurityGroupRuleDeletion(d fi.CloudupDeletion) bool {
+	_, ok := d.(*deleteSecurityGroupRule)
+	return ok
+}
+
 func (d *deleteSecurityGroupRule) Delete(t fi.CloudupTarget) error {
 	ctx := context.TODO()
 	klog.V(2).Infof("deleting security group permission: %v", fi.DebugAsJsonString(d.rule))
@@ -258,6 +264,10 @@ func (d *deleteSecurityGroupRule) Delete(t fi.CloudupTarget) error {
 		klog.V(2).Infof("Calling EC2 RevokeSecurityGroupEgress")
 		_, err := awsTarget.Cloud.EC2().RevokeSecurityGroupEgress(ctx, request)
 		if err != nil {
+			if awsup.AWSErrorCode(err) == "InvalidSecurityGroupRuleId.NotFound" {
+				// A security group shared by several instance groups may list the same rule more than once
+				return nil
+			}
 			return fmt.Errorf("error revoking SecurityGroupEgress: %v", err)
 		}
 	} else {
@@ -269,6 +279,10 @@ func (d *deleteSecurityGroupRule) Delete(t fi.CloudupTarget) error {
 		klog.V(2).Infof("Calling EC2 RevokeSecurityGroupIngress")
 		_, err := awsTarget.Cloud.EC2().RevokeSecurityGroupIngress(ctx, request)
 		if err != nil {
+			if awsup.AWSErrorCode(err) == "InvalidSecurityGroupRuleId.NotFound" {
+				// A security group shared by several instance groups may list the same rule more than once
+				return nil
+			}
 			return fmt.Errorf("error revoking SecurityGroupIngress: %v", err)
 		}
 	}
@@ -401,10 +415,17 @@ func ParseRemovalRule(rule string) (RemovalRule, error) {
 
 	// Simple little language:
 	//   port=N matches rules that filter (only) by port=N
+	//   tag=K matches rules that carry a tag with key K
 	//
 	// Note this language is internal, so isn't required to be stable
 
 	if len(tokens) == 2 {
+		if tokens[0] == "tag" {
+			if tokens[1] == "" {
+				return nil, fmt.Errorf("cannot parse rule %q", rule)
+			}
+			return &TagRemovalRule{Key: tokens[1]}, nil
+		}
 		if tokens[0] == "port" {
 			ports := strings.SplitN(tokens[1], ":", 2)
 			fromPort, err := strconv.Atoi(ports[0])
@@ -430,6 +451,27 @@ func ParseRemovalRule(rule string) (RemovalRule, error) {
 	return nil, fmt.Errorf("cannot parse rule %q", rule)
 }
 
+// TagRemovalRule matches the rules carrying a tag, typically the ownership tag of the cluster,
+// so that kOps only removes its own rules from security groups it shares with others.
+type TagRemovalRule struct {
+	Key string
+}
+
+var _ RemovalRule = (*TagRemovalRule)(nil)
+
+func (r *TagRemovalRule) String() string {
+	return fi.DebugAsJsonString(r)
+}
+
+func (r *TagRemovalRule) Matches(permission *ec2types.SecurityGroupRule) bool {
+	for _, tag := range permission.Tags {
+		if aws.ToString(tag.Key) == r.Key {
+			return true
+		}
+	}
+	return false
+}
+
 type PortRemovalRule struct {
 	FromPort int
 	ToPort   int
@@ -33,11 +33,20 @@ func TestParseRemovalRule(t *testing.T) {
 	testNotParse(t, "port22")
 	testNotParse(t, "port=a")
 	testNotParse(t, "port=22-23")
+	testNotParse(t, "tag=")
 
 	testParsesAsPort(t, "port=22", 22, 22)
 	testParsesAsPort(t, "port=443", 443, 443)
 	testParsesAsPort(t, "port=22:23", 22, 23)
 	testParsesAsPort(t, "port=-1", -1, -1)
+
+	r, err := ParseRemovalRule("tag=kubernetes.io/cluster/minimal.example.com")
+	if err != nil {
+		t.Fatalf("unexpected failure to parse tag rule: %v", err)
+	}
+	if tagRemovalRule, ok := r.(*TagRemovalRule); !ok || tagRemovalRule.Key != "kubernetes.io/cluster/minimal.example.com" {
+		t.Fatalf("unexpected rule for tag rule: %+v", r)
+	}
 }
 
 func testNotParse(t *testing.T, rule string) {
@@ -91,13 +100,26 @@ func TestPortRemovalRule_Zero(t *testing.T) {
 	testNotMatches(t, r, &ec2types.SecurityGroupRule{})
 }
 
-func testMatches(t *testing.T, rule *PortRemovalRule, permission *ec2types.SecurityGroupRule) {
+func TestTagRemovalRule(t *testing.T) {
+	r := &TagRemovalRule{Key: "kubernetes.io/cluster/minimal.example.com"}
+	testMatches(t, r, &ec2types.SecurityGroupRule{Tags: []ec2types.Tag{
+		{Key: aws.String("Name"), Value: aws.String("rule")},
+		{Key: aws.String("kubernetes.io/cluster/minimal.example.com"), Value: aws.String("owned")},
+	}})
+
+	testNotMatches(t, r, &ec2types.SecurityGroupRule{Tags: []ec2types.Tag{
+		{Key: aws.String("kubernetes.io/cluster/other.example.com"), Value: aws.String("owned")},
+	}})
+	testNotMatches(t, r, &ec2types.SecurityGroupRule{FromPort: aws.Int32(443), ToPort: aws.Int32(443)})
+}
+
+func testMatches(t *testing.T, rule RemovalRule, permission *ec2types.SecurityGroupRule) {
 	if !rule.Matches(permission) {
 		t.Fatalf("rule %+v failed to match permission %+v", rule, permission)
 	}
 }
 
-func testNotMatches(t *testing.T, rule *PortRemovalRule, permission *ec2types.SecurityGroupRule) {
+func testNotMatches(t *testing.T, rule RemovalRule, permission *ec2types.SecurityGroupRule) {
 	if rule.Matches(permission) {
 		t.Fatalf("rule %+v unexpectedly matched permission %+v", rule, permission)
 	}
@@ -53,7 +53,9 @@ type Subnet struct {
 	IPv6CIDR                    *string
 	ResourceBasedNaming         *bool
 	AssignIPv6AddressOnCreation *bool
-	Shared                      *bool
+	// EnableDNS64 makes the VPC resolver return synthetic NAT64 AAAA records for IPv4-only destinations.
+	EnableDNS64 *bool
+	Shared      *bool
 
 	Tags map[string]string
 }
@@ -110,6 +112,7 @@ func (e *Subnet) Find(c *fi.CloudupContext) (*Subnet, error) {
 	}
 
 	actual.AssignIPv6AddressOnCreation = subnet.AssignIpv6AddressOnCreation
+	actual.EnableDNS64 = subnet.EnableDns64
 
 	actual.ResourceBasedNaming = new(subnet.PrivateDnsNameOptionsOnLaunch.HostnameType == ec2types.HostnameTypeResourceName)
 	if *actual.ResourceBasedNaming {
@@ -228,6 +231,7 @@ func (s *Subnet) CheckChanges(a, e, changes *Subnet) error {
 func (_ *Subnet) ShouldCreate(a, e, changes *Subnet) (bool, error) {
 	if fi.ValueOf(e.Shared) {
 		changes.ResourceBasedNaming = nil
+		changes.EnableDNS64 = nil
 		return changes.Tags != nil, nil
 	}
 	return true, nil
@@ -323,16 +327,7 @@ func (_ *Subnet) RenderAWS(t *awsup.AWSAPITarget, a, e, changes *Subnet) error {
 			return fmt.Errorf("error modifying hostname type: %w", err)
 		}
 
-		if fi.ValueOf(e.CIDR) == "" {
-			request = &ec2.ModifySubnetAttributeInput{
-				SubnetId:    e.ID,
-				EnableDns64: &ec2types.AttributeBooleanValue{Value: aws.Bool(true)},
-			}
-			_, err = t.Cloud.EC2().ModifySubnetAttribute(ctx, request)
-			if err != nil {
-				return fmt.Errorf("error enabling DNS64: %w", err)
-			}
-		} else {
+		if fi.ValueOf(e.CIDR) != "" {
 			request = &ec2.ModifySubnetAttributeInput{
 				SubnetId:                             e.ID,
 				EnableResourceNameDnsARecordOnLaunch: &ec2types.AttributeBooleanValue{Value: changes.ResourceBasedNaming},
@@ -355,6 +350,17 @@ func (_ *Subnet) RenderAWS(t *awsup.AWSAPITarget, a, e, changes *Subnet) error {
 		}
 	}
 
+	if changes.EnableDNS64 != nil {
+		request := &ec2.ModifySubnetAttributeInput{
+			SubnetId:    e.ID,
+			EnableDns64: &ec2types.AttributeBooleanValue{Value: e.EnableDNS64},
+		}
+		_, err := t.Cloud.EC2().ModifySubnetAttribute(ctx, request)
+		if err != nil {
+			return fmt.Errorf("error modifying DNS64: %w", err)
+		}
+	}
+
 	return t.AddAWSTags(*e.ID, e.Tags)
 }
 
@@ -436,9 +442,11 @@ func (_ *Subnet) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *Su
 		Tags:             e.Tags,
 	}
 	if fi.ValueOf(e.CIDR) == "" {
-		tf.EnableDNS64 = new(true)
 		tf.IPv6Native = new(true)
 	}
+	if fi.ValueOf(e.EnableDNS64) {
+		tf.EnableDNS64 = new(true)
+	}
 	if fi.ValueOf(e.IPv6CIDR) != "" {
 		tf.AssignIPv6AddressOnCreation = new(true)
 	}
@@ -228,6 +228,93 @@ func TestSubnetCreateIPv6(t *testing.T) {
 	}
 }
 
+func TestSubnetCreateIPv6Only(t *testing.T) {
+	ctx := context.TODO()
+
+	cloud := awsup.BuildMockAWSCloud("us-east-1", "abc")
+	c := &mockec2.MockEC2{}
+	cloud.MockEC2 = c
+
+	// We define a function so we can rebuild the tasks, because we modify in-place when running
+	buildTasks := func() map[string]fi.CloudupTask {
+		vpc1 := &VPC{
+			Name:      s("vpc1"),
+			Lifecycle: fi.LifecycleSync,
+			CIDR:      s("172.20.0.0/16"),
+			IPv6CIDR:  s("2001:db8::/56"),
+			Tags:      map[string]string{"Name": "vpc1"},
+		}
+		cidr1 := &VPCAmazonIPv6CIDRBlock{
+			Name:      s("vpcamazonipv6cidr"),
+			Lifecycle: fi.LifecycleSync,
+			VPC:       vpc1,
+		}
+		subnet1 := &Subnet{
+			Name:                s("subnet1"),
+			Lifecycle:           fi.LifecycleSync,
+			VPC:                 vpc1,
+			IPv6CIDR:            s("2001:db8:0:1::/64"),
+			ResourceBasedNaming: new(true),
+			EnableDNS64:         new(true),
+			Tags:                map[string]string{"Name": "subnet1"},
+		}
+
+		return map[string]fi.CloudupTask{
+			"vpc1":    vpc1,
+			"cidr1":   cidr1,
+			"subnet1": subnet1,
+		}
+	}
+
+	{
+		allTasks := buildTasks()
+		subnet1 := allTasks["subnet1"].(*Subnet)
+
+		runTasks(t, cloud, allTasks)
+
+		if fi.ValueOf(subnet1.ID) == "" {
+			t.Fatalf("ID not set after create")
+		}
+
+		expected := &ec2types.Subnet{
+			AssignIpv6AddressOnCreation: aws.Bool(true),
+			EnableDns64:                 aws.Bool(true),
+			Ipv6Native:                  aws.Bool(true),
+			Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
+				{
+					AssociationId: aws.String("subnet-cidr-assoc-ipv6-subnet-1"),
+					Ipv6CidrBlock: aws.String("2001:db8:0:1::/64"),
+					Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+						State: ec2types.SubnetCidrBlockStateCodeAssociated,
+					},
+				},
+			},
+			PrivateDnsNameOptionsOnLaunch: &ec2types.PrivateDnsNameOptionsOnLaunch{
+				EnableResourceNameDnsAAAARecord: aws.Bool(true),
+				EnableResourceNameDnsARecord:    aws.Bool(false),
+				HostnameType:                    ec2types.HostnameTypeResourceName,
+			},
+			SubnetId: aws.String("subnet-1"),
+			VpcId:    aws.String("vpc-1"),
+			Tags: buildTags(map[string]string{
+				"Name": "subnet1",
+			}),
+		}
+		actual := c.FindSubnet(*subnet1.ID)
+		if actual == nil {
+			t.Fatalf("Subnet created but then not found")
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("Unexpected Subnet: expected=%v actual=%v", expected, actual)
+		}
+	}
+
+	{
+		allTasks := buildTasks()
+		checkNoChanges(t, ctx, cloud, allTasks)
+	}
+}
+
 func TestSubnetCreateIPv6NetNum(t *testing.T) {
 	ctx := context.TODO()
 
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
 	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
@@ -40,6 +41,10 @@ type WarmPool struct {
 	MaxSize *int32
 	// MinSize is the smallest number of nodes in the warm pool.
 	MinSize int32
+	// PoolState is the state instances are kept in while in the warm pool.
+	PoolState autoscalingtypes.WarmPoolState
+	// ReuseOnScaleIn returns instances to the warm pool on scale in.
+	ReuseOnScaleIn *bool
 
 	// AutoscalingGroup is the AutoscalingGroup on which we configure this warmpool.
 	AutoscalingGroup *AutoscalingGroup
@@ -104,6 +109,15 @@ func (e *WarmPool) Find(c *fi.CloudupContext) (*WarmPool, error) {
 		AutoscalingGroup: &AutoscalingGroup{Name: e.AutoscalingGroup.Name},
 		MaxSize:          warmPool.WarmPoolConfiguration.MaxGroupPreparedCapacity,
 		MinSize:          fi.ValueOf(warmPool.WarmPoolConfiguration.MinSize),
+		PoolState:        warmPool.WarmPoolConfiguration.PoolState,
+		ReuseOnScaleIn:   new(false),
+	}
+	// Stopped is the default pool state, treat it as equivalent to not specifying one.
+	if e.PoolState == "" && actual.PoolState == autoscalingtypes.WarmPoolStateStopped {
+		actual.PoolState = ""
+	}
+	if warmPool.WarmPoolConfiguration.InstanceReusePolicy != nil {
+		actual.ReuseOnScaleIn = new(fi.ValueOf(warmPool.WarmPoolConfiguration.InstanceReusePolicy.ReuseOnScaleIn))
 	}
 	return actual, nil
 }
@@ -130,6 +144,10 @@ func (*WarmPool) RenderAWS(t *awsup.AWSAPITarget, a, e, changes *WarmPool) error
 				AutoScalingGroupName:     e.AutoscalingGroup.Name,
 				MaxGroupPreparedCapacity: maxSize,
 				MinSize:                  new(minSize),
+				PoolState:                e.PoolState,
+				InstanceReusePolicy: &autoscalingtypes.InstanceReusePolicy{
+					ReuseOnScaleIn: new(fi.ValueOf(e.ReuseOnScaleIn)),
+				},
 			}
 
 			_, err := svc.PutWarmPool(ctx, request)
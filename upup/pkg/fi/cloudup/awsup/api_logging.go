@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// APIRequestLogger, when set, logs every AWS API call made by the AWS clients created by kops.
+var APIRequestLogger *RequestLogger
+
+// RequestLogger writes a line for each AWS API operation, with its parameters.
+// Parameters that may hold credentials or secrets are redacted.
+type RequestLogger struct {
+	mutex sync.Mutex
+	out   io.Writer
+}
+
+// NewRequestLogger returns a RequestLogger writing to out.
+func NewRequestLogger(out io.Writer) *RequestLogger {
+	return &RequestLogger{out: out}
+}
+
+// redacted replaces the values of sensitive parameters.
+const redacted = "<redacted>"
+
+// sensitiveParameters are substrings of the (lowercased) names of parameters whose values are never logged.
+var sensitiveParameters = []string{
+	"accesskey",
+	"body",
+	"certificatechain",
+	"credential",
+	"password",
+	"privatekey",
+	"secret",
+	"token",
+	"userdata",
+}
+
+// paginationParameters are parameters that match sensitiveParameters but are safe, and useful, to log.
+var paginationParameters = map[string]bool{
+	"clienttoken":       true,
+	"continuationtoken": true,
+	"nexttoken":         true,
+}
+
+func isSensitiveParameter(name string) bool {
+	name = strings.ToLower(name)
+	if paginationParameters[name] {
+		return false
+	}
+	for _, s := range sensitiveParameters {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactParameters returns the parameters of an operation as JSON, without empty values and with sensitive values redacted.
+func redactParameters(params any) string {
+	if params == nil {
+		return "{}"
+	}
+	b, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Sprintf("<%T>", params)
+	}
+	var obj any
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return fmt.Sprintf("<%T>", params)
+	}
+	obj = redactValue(obj)
+	if obj == nil {
+		return "{}"
+	}
+	var out strings.Builder
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(obj); err != nil {
+		return fmt.Sprintf("<%T>", params)
+	}
+	return strings.TrimSuffix(out.String(), "\n")
+}
+
+// redactValue walks a decoded JSON value, dropping empty values and redacting sensitive fields.
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any)
+		for k, child := range v {
+			child = redactValue(child)
+			if child == nil {
+				continue
+			}
+			if isSensitiveParameter(k) {
+				child = redacted
+			}
+			out[k] = child
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		return out
+	case []any:
+		var out []any
+		for _, child := range v {
+			if child = redactValue(child); child != nil {
+				out = append(out, child)
+			}
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		return out
+	case string:
+		if v == "" {
+			return nil
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// statusCode returns the HTTP status code of the last attempt of an operation, if a response was received.
+func statusCode(metadata middleware.Metadata, err error) int {
+	var responseError interface{ HTTPStatusCode() int }
+	if errors.As(err, &responseError) {
+		return responseError.HTTPStatusCode()
+	}
+	if response, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok && response != nil {
+		return response.StatusCode
+	}
+	return 0
+}
+
+// AddMiddleware registers the logging middleware on an SDK middleware stack.
+// The operation is logged once, after all its retries.
+func (l *RequestLogger) AddMiddleware(stack *middleware.Stack) error {
+	logging := middleware.InitializeMiddlewareFunc("kopsAPIRequestLogging", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		var b strings.Builder
+		fmt.Fprintf(&b, "AWS API call: service=%s operation=%s region=%s duration=%s",
+			awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), awsmiddleware.GetRegion(ctx), time.Since(start).Round(time.Millisecond))
+		if attempts, ok := retry.GetAttemptResults(metadata); ok && len(attempts.Results) > 1 {
+			fmt.Fprintf(&b, " attempts=%d", len(attempts.Results))
+		}
+		if code := statusCode(metadata, err); code != 0 {
+			fmt.Fprintf(&b, " status=%d", code)
+		}
+		if err == nil {
+			b.WriteString(" result=OK")
+		} else if code := AWSErrorCode(err); code != "" {
+			b.WriteString(" result=" + code)
+		} else {
+			b.WriteString(" result=Error")
+		}
+		if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok && requestID != "" {
+			b.WriteString(" requestID=" + requestID)
+		}
+		b.WriteString(" params=" + redactParameters(in.Parameters))
+		if err != nil {
+			// The error message names the principal and the action when permission is denied
+			fmt.Fprintf(&b, " error=%q", err.Error())
+		}
+
+		l.mutex.Lock()
+		fmt.Fprintln(l.out, b.String())
+		l.mutex.Unlock()
+
+		return out, metadata, err
+	})
+	return stack.Initialize.Add(logging, middleware.After)
+}
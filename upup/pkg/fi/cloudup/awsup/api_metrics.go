@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// APICallStats holds the counters for a single AWS API operation.
+type APICallStats struct {
+	Service   string
+	Operation string
+	// Calls is the number of operations invoked, regardless of retries.
+	Calls int
+	// Attempts is the number of requests sent, including retries.
+	Attempts int
+	// Throttled is the number of attempts rejected because of throttling.
+	Throttled int
+	// Failed is the number of operations that returned an error after all retries.
+	Failed int
+	// Duration is the time spent in the operations, including retries.
+	Duration time.Duration
+}
+
+// APIMetrics counts the AWS API calls made by this process.
+type APIMetrics struct {
+	mutex sync.Mutex
+	stats map[string]*APICallStats
+}
+
+// NewAPIMetrics returns an empty set of counters.
+func NewAPIMetrics() *APIMetrics {
+	return &APIMetrics{stats: make(map[string]*APICallStats)}
+}
+
+var defaultAPIMetrics = NewAPIMetrics()
+
+// DefaultAPIMetrics returns the counters shared by all AWS clients created by kops.
+func DefaultAPIMetrics() *APIMetrics {
+	return defaultAPIMetrics
+}
+
+func (m *APIMetrics) update(service, operation string, fn func(s *APICallStats)) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key := service + "/" + operation
+	s := m.stats[key]
+	if s == nil {
+		s = &APICallStats{Service: service, Operation: operation}
+		m.stats[key] = s
+	}
+	fn(s)
+}
+
+// List returns a snapshot of the counters, ordered by service and operation.
+func (m *APIMetrics) List() []APICallStats {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var list []APICallStats
+	for _, s := range m.stats {
+		list = append(list, *s)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Service != list[j].Service {
+			return list[i].Service < list[j].Service
+		}
+		return list[i].Operation < list[j].Operation
+	})
+	return list
+}
+
+// Throttled returns the total number of throttled attempts.
+func (m *APIMetrics) Throttled() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	total := 0
+	for _, s := range m.stats {
+		total += s.Throttled
+	}
+	return total
+}
+
+// Write prints the counters as a table, with a line per service and operation.
+func (m *APIMetrics) Write(w io.Writer) error {
+	list := m.List()
+	if len(list) == 0 {
+		return nil
+	}
+
+	var total APICallStats
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "SERVICE\tOPERATION\tCALLS\tATTEMPTS\tTHROTTLED\tFAILED\tDURATION\n")
+	for _, s := range list {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%d\t%s\n", s.Service, s.Operation, s.Calls, s.Attempts, s.Throttled, s.Failed, s.Duration.Round(time.Millisecond))
+		total.Calls += s.Calls
+		total.Attempts += s.Attempts
+		total.Throttled += s.Throttled
+		total.Failed += s.Failed
+		total.Duration += s.Duration
+	}
+	fmt.Fprintf(tw, "TOTAL\t\t%d\t%d\t%d\t%d\t%s\n", total.Calls, total.Attempts, total.Throttled, total.Failed, total.Duration.Round(time.Millisecond))
+	return tw.Flush()
+}
+
+// AddMiddleware registers the counting middleware on an SDK middleware stack.
+// Calls and failures are counted once per operation, attempts and throttling
+// once per request sent by the retry loop.
+func (m *APIMetrics) AddMiddleware(stack *middleware.Stack) error {
+	isThrottle := retry.IsErrorThrottles(retry.DefaultThrottles)
+
+	calls := middleware.InitializeMiddlewareFunc("kopsAPIMetricsCalls", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		duration := time.Since(start)
+		m.update(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), func(s *APICallStats) {
+			s.Calls++
+			s.Duration += duration
+			if err != nil {
+				s.Failed++
+			}
+		})
+		return out, metadata, err
+	})
+	if err := stack.Initialize.Add(calls, middleware.After); err != nil {
+		return err
+	}
+
+	attempts := middleware.FinalizeMiddlewareFunc("kopsAPIMetricsAttempts", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleFinalize(ctx, in)
+		throttled := err != nil && isThrottle.IsErrorThrottle(err) == aws.TrueTernary
+		m.update(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), func(s *APICallStats) {
+			s.Attempts++
+			if throttled {
+				s.Throttled++
+			}
+		})
+		return out, metadata, err
+	})
+	// Run inside the retry loop, so that we see every attempt.
+	if _, ok := stack.Finalize.Get("Retry"); ok {
+		return stack.Finalize.Insert(attempts, "Retry", middleware.After)
+	}
+	return stack.Finalize.Add(attempts, middleware.After)
+}
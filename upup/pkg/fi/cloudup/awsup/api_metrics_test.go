@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// fakeEC2 replies to the first requests with a throttling error, then succeeds.
+type fakeEC2 struct {
+	throttle int
+}
+
+func (f *fakeEC2) Do(req *http.Request) (*http.Response, error) {
+	if f.throttle > 0 {
+		f.throttle--
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`<Response><Errors><Error><Code>RequestLimitExceeded</Code><Message>Request limit exceeded.</Message></Error></Errors><RequestID>1</RequestID></Response>`)),
+		}, nil
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`<DescribeRegionsResponse><regionInfo/></DescribeRegionsResponse>`)),
+	}, nil
+}
+
+func TestAPIMetrics(t *testing.T) {
+	ctx := context.Background()
+	metrics := NewAPIMetrics()
+
+	newClient := func(httpClient *fakeEC2) *ec2.Client {
+		return ec2.New(ec2.Options{
+			Region:      "us-test-1",
+			Credentials: credentials.NewStaticCredentialsProvider("id", "secret", ""),
+			HTTPClient:  httpClient,
+			Retryer: retry.NewStandard(func(so *retry.StandardOptions) {
+				so.MaxAttempts = 3
+				so.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) { return 0, nil })
+			}),
+			APIOptions: []func(*middleware.Stack) error{metrics.AddMiddleware},
+		})
+	}
+
+	if _, err := newClient(&fakeEC2{throttle: 2}).DescribeRegions(ctx, &ec2.DescribeRegionsInput{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := newClient(&fakeEC2{throttle: 5}).DescribeRegions(ctx, &ec2.DescribeRegionsInput{}); err == nil {
+		t.Fatalf("expected error after exhausting retries")
+	}
+
+	expected := []APICallStats{
+		{Service: "EC2", Operation: "DescribeRegions", Calls: 2, Attempts: 6, Throttled: 5, Failed: 1},
+	}
+	actual := metrics.List()
+	if len(actual) == 1 {
+		// Durations depend on the machine running the test
+		actual[0].Duration = 0
+	}
+	if len(actual) != 1 || actual[0] != expected[0] {
+		t.Fatalf("expected %+v, got %+v", expected, actual)
+	}
+	if metrics.Throttled() != 5 {
+		t.Errorf("expected 5 throttled attempts, got %d", metrics.Throttled())
+	}
+
+	var buf bytes.Buffer
+	if err := metrics.Write(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "DescribeRegions") || !strings.Contains(buf.String(), "TOTAL") {
+		t.Errorf("unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestAPIMetricsWriteEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewAPIMetrics().Write(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no output, got %q", buf.String())
+	}
+}
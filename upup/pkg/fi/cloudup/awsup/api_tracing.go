@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"context"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("k8s.io/kops/upup/pkg/fi/cloudup/awsup")
+
+// AddTracingMiddleware registers a middleware on an SDK middleware stack that records a span for each
+// AWS API operation, with the number of attempts made by the retry loop and the request ID of the last attempt.
+func AddTracingMiddleware(stack *middleware.Stack) error {
+	tracing := middleware.InitializeMiddlewareFunc("kopsTracing", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		service := awsmiddleware.GetServiceID(ctx)
+		operation := awsmiddleware.GetOperationName(ctx)
+		ctx, span := tracer.Start(ctx, service+"."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("rpc.system", "aws-api"),
+				attribute.String("rpc.service", service),
+				attribute.String("rpc.method", operation),
+			))
+		defer span.End()
+
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+			span.SetAttributes(attribute.String("aws.request_id", requestID))
+		}
+		if attempts, ok := retry.GetAttemptResults(metadata); ok {
+			span.SetAttributes(attribute.Int("aws.attempts", len(attempts.Results)))
+		}
+		if err != nil {
+			if code := AWSErrorCode(err); code != "" {
+				span.SetAttributes(attribute.String("aws.error_code", code))
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return out, metadata, err
+	})
+	return stack.Initialize.Add(tracing, middleware.After)
+}
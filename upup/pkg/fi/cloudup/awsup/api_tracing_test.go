@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// spanRecorder keeps the spans that ended.
+type spanRecorder struct {
+	mutex sync.Mutex
+	ended []sdktrace.ReadOnlySpan
+}
+
+func (r *spanRecorder) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+func (r *spanRecorder) Shutdown(context.Context) error                  { return nil }
+func (r *spanRecorder) ForceFlush(context.Context) error                { return nil }
+
+func (r *spanRecorder) OnEnd(s sdktrace.ReadOnlySpan) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.ended = append(r.ended, s)
+}
+
+func TestTracingMiddleware(t *testing.T) {
+	ctx := context.Background()
+
+	recorder := &spanRecorder{}
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	client := ec2.New(ec2.Options{
+		Region:      "us-test-1",
+		Credentials: credentials.NewStaticCredentialsProvider("id", "secret", ""),
+		HTTPClient:  &fakeEC2{throttle: 5},
+		Retryer: retry.NewStandard(func(so *retry.StandardOptions) {
+			so.MaxAttempts = 3
+			so.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) { return 0, nil })
+		}),
+		APIOptions: []func(*middleware.Stack) error{AddTracingMiddleware},
+	})
+	if _, err := client.DescribeRegions(ctx, &ec2.DescribeRegionsInput{}); err == nil {
+		t.Fatalf("expected error after exhausting retries")
+	}
+
+	if len(recorder.ended) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(recorder.ended))
+	}
+	span := recorder.ended[0]
+	if span.Name() != "EC2.DescribeRegions" {
+		t.Errorf("unexpected span name %q", span.Name())
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("expected error status, got %v", span.Status())
+	}
+	attributes := attribute.NewSet(span.Attributes()...)
+	for key, expected := range map[attribute.Key]attribute.Value{
+		"rpc.service":    attribute.StringValue("EC2"),
+		"rpc.method":     attribute.StringValue("DescribeRegions"),
+		"aws.attempts":   attribute.IntValue(3),
+		"aws.error_code": attribute.StringValue("RequestLimitExceeded"),
+	} {
+		if actual, _ := attributes.Value(key); actual != expected {
+			t.Errorf("unexpected %s attribute: expected %v, got %v", key, expected.Emit(), actual.Emit())
+		}
+	}
+}
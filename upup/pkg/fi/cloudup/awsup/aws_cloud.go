@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
@@ -33,7 +34,6 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
-	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	stscredsv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
@@ -46,6 +46,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go/middleware"
 	"k8s.io/klog/v2"
 
 	v1 "k8s.io/api/core/v1"
@@ -110,6 +111,10 @@ const TagNameSubnetPublicELB = "kubernetes.io/role/elb"
 
 const tagNameDetachedInstance = "kops.k8s.io/detached-from-asg"
 
+// GracefulNodeDrainLifecycleHookName is the termination lifecycle hook that holds the instances of nodes
+// until kops-controller has drained them.
+const GracefulNodeDrainLifecycleHookName = "kops-graceful-drain"
+
 const (
 	WellKnownAccountAmazonLinux2023 = "137112412989"
 	WellKnownAccountDebian          = "136693071363"
@@ -218,6 +223,9 @@ type awsCloudImplementation struct {
 
 	instanceTypes *instanceTypes
 
+	// describeCache memoizes lookups during an apply, if set; it is shared with the copies made by WithTags
+	describeCache *atomic.Pointer[fi.DescribeCache]
+
 	config aws.Config
 }
 
@@ -227,6 +235,36 @@ type instanceTypes struct {
 }
 
 var _ fi.Cloud = (*awsCloudImplementation)(nil)
+var _ fi.HasDescribeCache = (*awsCloudImplementation)(nil)
+
+// DescribeCache implements fi.HasDescribeCache
+func (c *awsCloudImplementation) DescribeCache() *fi.DescribeCache {
+	return c.describeCache.Load()
+}
+
+// SetDescribeCache implements fi.HasDescribeCache
+func (c *awsCloudImplementation) SetDescribeCache(cache *fi.DescribeCache) {
+	c.describeCache.Store(cache)
+}
+
+// persistentCachedDescribe memoizes a lookup that may be cached on disk, scoped to the account and region,
+// as the same kops user may work against several accounts.
+func persistentCachedDescribe[V any](c *awsCloudImplementation, key string, describe func() (V, error)) (V, error) {
+	cache := c.describeCache.Load()
+	if cache == nil {
+		return describe()
+	}
+
+	account, err := fi.CachedDescribe(cache, "AccountInfo", func() (string, error) {
+		account, _, err := c.AccountInfo(context.TODO())
+		return account, err
+	})
+	if err != nil {
+		klog.V(2).Infof("not caching %q: %v", key, err)
+		return fi.CachedDescribe(cache, key, describe)
+	}
+	return fi.PersistentCachedDescribe(cache, "aws/"+account+"/"+c.region+"/"+key, describe)
+}
 
 func (c *awsCloudImplementation) ProviderID() kops.CloudProviderID {
 	return kops.CloudProviderAWS
@@ -274,17 +312,25 @@ func getCloudInstancesFromRegion(region string) AWSCloud {
 }
 
 func loadAWSConfig(ctx context.Context, region string) (aws.Config, error) {
+	retryOptions, err := retryOptionsFromEnv()
+	if err != nil {
+		return aws.Config{}, err
+	}
+
+	apiOptions := []func(*middleware.Stack) error{
+		DefaultAPIMetrics().AddMiddleware,
+		AddTracingMiddleware,
+	}
+	if APIRequestLogger != nil {
+		apiOptions = append(apiOptions, APIRequestLogger.AddMiddleware)
+	}
+
 	loadOptions := []func(*awsconfig.LoadOptions) error{
 		awsconfig.WithRegion(region),
 		awsconfig.WithClientLogMode(aws.LogRetries),
 		awsconfig.WithLogger(awsLogger{}),
-		awsconfig.WithRetryer(func() aws.Retryer {
-			return retry.NewAdaptiveMode(func(ao *retry.AdaptiveModeOptions) {
-				ao.StandardOptions = append(ao.StandardOptions, func(so *retry.StandardOptions) {
-					so.MaxAttempts = ClientMaxRetries
-				})
-			})
-		}),
+		awsconfig.WithRetryer(retryOptions.NewRetryer),
+		awsconfig.WithAPIOptions(apiOptions),
 	}
 
 	// assumes the role before executing commands
@@ -309,7 +355,8 @@ func NewAWSCloud(region string, tags map[string]string) (AWSCloud, error) {
 
 	if raw == nil {
 		c := &awsCloudImplementation{
-			region: region,
+			region:        region,
+			describeCache: &atomic.Pointer[fi.DescribeCache]{},
 			instanceTypes: &instanceTypes{
 				typeMap: make(map[string]*ec2types.InstanceTypeInfo),
 			},
@@ -1059,7 +1106,12 @@ func buildCloudInstance(i autoscalingtypes.Instance, instances map[string]*ec2ty
 	}
 	instanceSeen[id] = true
 	// @step: check if the instance is terminating
-	if i.LifecycleState == autoscalingtypes.LifecycleStateTerminating {
+	switch i.LifecycleState {
+	case autoscalingtypes.LifecycleStateTerminating,
+		autoscalingtypes.LifecycleStateWarmedTerminating,
+		autoscalingtypes.LifecycleStateWarmedTerminatingWait,
+		autoscalingtypes.LifecycleStateWarmedTerminatingProceed,
+		autoscalingtypes.LifecycleStateWarmedTerminated:
 		klog.Warningf("ignoring instance as it is terminating: %s in autoscaling group: %s", id, cg.HumanName)
 		return nil
 	}
@@ -1067,6 +1119,12 @@ func buildCloudInstance(i autoscalingtypes.Instance, instances map[string]*ec2ty
 		return nil
 	}
 	currentConfigName := findInstanceLaunchConfiguration(i)
+	for _, tag := range instances[id].Tags {
+		// Instances resized in place still reference the launch template version they were launched from
+		if aws.ToString(tag.Key) == tagNameResizedToLaunchTemplate && aws.ToString(tag.Value) == newConfigName {
+			currentConfigName = newConfigName
+		}
+	}
 	status := cloudinstances.CloudInstanceStatusUpToDate
 	if newConfigName != currentConfigName {
 		status = cloudinstances.CloudInstanceStatusNeedsUpdate
@@ -1690,7 +1748,9 @@ func describeVPC(c AWSCloud, vpcID string) (*ec2types.Vpc, error) {
 // owner/name in which case we find the image with the specified name, owned by owner
 // name in which case we find the image with the specified name, with the current owner
 func (c *awsCloudImplementation) ResolveImage(name string) (*ec2types.Image, error) {
-	return resolveImage(context.TODO(), c.ssm, c.ec2, name)
+	return persistentCachedDescribe(c, "ResolveImage/"+name, func() (*ec2types.Image, error) {
+		return resolveImage(context.TODO(), c.ssm, c.ec2, name)
+	})
 }
 
 func resolveSSMParameter(ctx context.Context, ssmClient awsinterfaces.SSMAPI, name string) (string, error) {
@@ -1790,16 +1850,18 @@ func ResolveImageOwnerAlias(owner string) string {
 }
 
 func (c *awsCloudImplementation) DescribeAvailabilityZones() ([]ec2types.AvailabilityZone, error) {
-	klog.V(2).Infof("Querying EC2 for all valid zones in region %q", c.region)
-	ctx := context.TODO()
+	return persistentCachedDescribe(c, "DescribeAvailabilityZones", func() ([]ec2types.AvailabilityZone, error) {
+		klog.V(2).Infof("Querying EC2 for all valid zones in region %q", c.region)
+		ctx := context.TODO()
 
-	request := &ec2.DescribeAvailabilityZonesInput{}
-	response, err := c.EC2().DescribeAvailabilityZones(ctx, request)
-	if err != nil {
-		return nil, fmt.Errorf("error querying for valid AZs in %q - verify your AWS credentials.  Error: %v", c.region, err)
-	}
+		request := &ec2.DescribeAvailabilityZonesInput{}
+		response, err := c.EC2().DescribeAvailabilityZones(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("error querying for valid AZs in %q - verify your AWS credentials.  Error: %v", c.region, err)
+		}
 
-	return response.AvailabilityZones, nil
+		return response.AvailabilityZones, nil
+	})
 }
 
 // ValidateZones checks that every zone in the sliced passed is recognized
@@ -1888,7 +1950,9 @@ func (c *awsCloudImplementation) SSM() awsinterfaces.SSMAPI {
 }
 
 func (c *awsCloudImplementation) FindVPCInfo(vpcID string) (*fi.VPCInfo, error) {
-	return findVPCInfo(c, vpcID)
+	return persistentCachedDescribe(c, "FindVPCInfo/"+vpcID, func() (*fi.VPCInfo, error) {
+		return findVPCInfo(c, vpcID)
+	})
 }
 
 func findVPCInfo(c AWSCloud, vpcID string) (*fi.VPCInfo, error) {
@@ -1932,13 +1996,13 @@ func findVPCInfo(c AWSCloud, vpcID string) (*fi.VPCInfo, error) {
 	return vpcInfo, nil
 }
 
-func (c *awsCloudImplementation) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
-	return getApiIngressStatus(c, cluster)
+func (c *awsCloudImplementation) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+	return getApiIngressStatus(ctx, c, cluster)
 }
 
-func getApiIngressStatus(c AWSCloud, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+func getApiIngressStatus(ctx context.Context, c AWSCloud, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
 	var ingresses []fi.ApiIngressStatus
-	if lbDnsName, err := findDNSName(c, cluster); err != nil {
+	if lbDnsName, err := findDNSName(ctx, c, cluster); err != nil {
 		return nil, fmt.Errorf("error finding aws DNSName: %v", err)
 	} else if lbDnsName != "" {
 		ingresses = append(ingresses, fi.ApiIngressStatus{Hostname: lbDnsName})
@@ -1947,9 +2011,7 @@ func getApiIngressStatus(c AWSCloud, cluster *kops.Cluster) ([]fi.ApiIngressStat
 	return ingresses, nil
 }
 
-func findDNSName(cloud AWSCloud, cluster *kops.Cluster) (string, error) {
-	ctx := context.TODO()
-
+func findDNSName(ctx context.Context, cloud AWSCloud, cluster *kops.Cluster) (string, error) {
 	name := "api." + cluster.Name
 	if cluster.Spec.API.LoadBalancer == nil {
 		return "", nil
@@ -2079,7 +2141,9 @@ func (c *awsCloudImplementation) DescribeInstanceType(instanceType string) (*ec2
 	c.instanceTypes.mutex.Lock()
 	defer c.instanceTypes.mutex.Unlock()
 
-	info, err := describeInstanceType(c, instanceType)
+	info, err := persistentCachedDescribe(c, "DescribeInstanceType/"+instanceType, func() (*ec2types.InstanceTypeInfo, error) {
+		return describeInstanceType(c, instanceType)
+	})
 	if err != nil {
 		return nil, err
 	}
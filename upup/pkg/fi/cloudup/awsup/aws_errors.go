@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"strings"
+
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func init() {
+	fi.RegisterErrorClassifier(ClassifyAWSError)
+}
+
+var awsAuthErrorCodes = map[string]bool{
+	"AccessDenied":                true,
+	"AccessDeniedException":       true,
+	"AuthFailure":                 true,
+	"ExpiredToken":                true,
+	"ExpiredTokenException":       true,
+	"InvalidClientTokenId":        true,
+	"OptInRequired":               true,
+	"SignatureDoesNotMatch":       true,
+	"UnauthorizedOperation":       true,
+	"UnrecognizedClientException": true,
+}
+
+var awsThrottlingErrorCodes = map[string]bool{
+	"RequestLimitExceeded":     true,
+	"Throttling":               true,
+	"ThrottlingException":      true,
+	"TooManyRequestsException": true,
+}
+
+var awsTransientErrorCodes = map[string]bool{
+	"InternalError":               true,
+	"InternalFailure":             true,
+	"InternalServerError":         true,
+	"RequestTimeout":              true,
+	"ServiceUnavailable":          true,
+	"ServiceUnavailableException": true,
+	"Unavailable":                 true,
+}
+
+// ClassifyAWSError classifies errors returned by the AWS APIs, returning nil if the error is not recognized
+func ClassifyAWSError(err error) *fi.ClassifiedError {
+	code := AWSErrorCode(err)
+	if code == "" {
+		return nil
+	}
+
+	switch {
+	case awsAuthErrorCodes[code]:
+		return fi.NewClassifiedError(fi.ErrorReasonAuth, err,
+			"Check that your AWS credentials are valid and not expired, and that they grant the permissions kOps needs: https://kops.sigs.k8s.io/getting_started/aws/#setup-iam-user")
+	case awsThrottlingErrorCodes[code]:
+		return fi.NewClassifiedError(fi.ErrorReasonQuota, err,
+			"AWS is throttling API requests; wait a few minutes and run the command again.")
+	case strings.HasSuffix(code, "LimitExceeded") || strings.HasSuffix(code, "LimitExceededException") || code == "ServiceQuotaExceededException":
+		return fi.NewClassifiedError(fi.ErrorReasonQuota, err,
+			"An AWS service quota was exceeded; delete unused resources or request a quota increase in the Service Quotas console.")
+	case code == "InsufficientInstanceCapacity":
+		return fi.NewClassifiedError(fi.ErrorReasonQuota, err,
+			"AWS has no capacity for the instance type in this zone; try again later, or use another instance type or zone.")
+	case awsTransientErrorCodes[code]:
+		return fi.NewClassifiedError(fi.ErrorReasonTransient, err,
+			"AWS failed the request because of a temporary problem; run the command again.")
+	case tagsEventualConsistencyErrors[code]:
+		return fi.NewClassifiedError(fi.ErrorReasonEventualConsistency, err,
+			"A newly created AWS resource is not visible yet; wait a few minutes and run the command again.")
+	}
+	return nil
+}
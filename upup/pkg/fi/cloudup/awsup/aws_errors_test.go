@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func TestClassifyAWSError(t *testing.T) {
+	grid := []struct {
+		err      error
+		expected fi.ErrorReason
+	}{
+		{
+			err:      &smithy.GenericAPIError{Code: "UnauthorizedOperation"},
+			expected: fi.ErrorReasonAuth,
+		},
+		{
+			err:      fmt.Errorf("error creating instance: %w", &smithy.GenericAPIError{Code: "VcpuLimitExceeded"}),
+			expected: fi.ErrorReasonQuota,
+		},
+		{
+			err:      &smithy.GenericAPIError{Code: "RequestLimitExceeded"},
+			expected: fi.ErrorReasonQuota,
+		},
+		{
+			err:      &smithy.GenericAPIError{Code: "InvalidSubnetID.NotFound"},
+			expected: fi.ErrorReasonEventualConsistency,
+		},
+		{
+			err:      fmt.Errorf("error describing instances: %w", &smithy.GenericAPIError{Code: "ServiceUnavailable"}),
+			expected: fi.ErrorReasonTransient,
+		},
+		{
+			err: &smithy.GenericAPIError{Code: "InvalidParameterValue"},
+		},
+		{
+			err: errors.New("not an AWS error"),
+		},
+	}
+
+	for _, g := range grid {
+		var actual fi.ErrorReason
+		if classified := fi.ClassifyError(g.err); classified != nil {
+			actual = classified.Reason
+		}
+		if actual != g.expected {
+			t.Errorf("unexpected reason for %v: expected %q, got %q", g.err, g.expected, actual)
+		}
+	}
+}
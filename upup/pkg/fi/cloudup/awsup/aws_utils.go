@@ -47,30 +47,8 @@ func ValidateRegion(ctx context.Context, region string) error {
 	allRegionsMutex.Lock()
 	defer allRegionsMutex.Unlock()
 
-	if allRegions == nil {
-		klog.V(2).Infof("Querying EC2 for all valid regions")
-
-		request := &ec2.DescribeRegionsInput{}
-		awsRegion := os.Getenv("AWS_REGION")
-		if awsRegion == "" {
-			awsRegion = "us-east-1"
-		}
-		cfg, err := loadAWSConfig(ctx, awsRegion)
-		if err != nil {
-			return fmt.Errorf("error loading AWS config: %v", err)
-		}
-
-		if err != nil {
-			return fmt.Errorf("error starting a new AWS session: %v", err)
-		}
-
-		client := ec2.NewFromConfig(cfg)
-
-		response, err := client.DescribeRegions(ctx, request)
-		if err != nil {
-			return fmt.Errorf("got an error while querying for valid regions (verify your AWS credentials?): %v", err)
-		}
-		allRegions = response.Regions
+	if err := loadAllRegions(ctx); err != nil {
+		return err
 	}
 
 	for _, r := range allRegions {
@@ -88,6 +66,51 @@ func ValidateRegion(ctx context.Context, region string) error {
 	return fmt.Errorf("Region is not a recognized EC2 region: %q (check you have specified valid zones?)", region)
 }
 
+// AllRegionNames returns the names of all the EC2 regions enabled for the account
+func AllRegionNames(ctx context.Context) ([]string, error) {
+	allRegionsMutex.Lock()
+	defer allRegionsMutex.Unlock()
+
+	if err := loadAllRegions(ctx); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, r := range allRegions {
+		names = append(names, aws.ToString(r.RegionName))
+	}
+	return names, nil
+}
+
+// loadAllRegions queries EC2 for allRegions, if it has not been populated yet.
+// allRegionsMutex must be held by the caller.
+func loadAllRegions(ctx context.Context) error {
+	if allRegions != nil {
+		return nil
+	}
+
+	klog.V(2).Infof("Querying EC2 for all valid regions")
+
+	request := &ec2.DescribeRegionsInput{}
+	awsRegion := os.Getenv("AWS_REGION")
+	if awsRegion == "" {
+		awsRegion = "us-east-1"
+	}
+	cfg, err := loadAWSConfig(ctx, awsRegion)
+	if err != nil {
+		return fmt.Errorf("error loading AWS config: %v", err)
+	}
+
+	client := ec2.NewFromConfig(cfg)
+
+	response, err := client.DescribeRegions(ctx, request)
+	if err != nil {
+		return fmt.Errorf("got an error while querying for valid regions (verify your AWS credentials?): %v", err)
+	}
+	allRegions = response.Regions
+	return nil
+}
+
 // FindRegion determines the region from the zones specified in the cluster
 func FindRegion(cluster *kops.Cluster) (string, error) {
 	region := ""
@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"k8s.io/klog/v2"
+
+	"k8s.io/kops/pkg/cloudinstances"
+)
+
+// InstanceRefreshLifecycleHookName is the termination lifecycle hook that holds instances
+// replaced by an instance refresh until kops has drained them.
+const InstanceRefreshLifecycleHookName = "kops-instance-refresh"
+
+// maxLifecycleHookHeartbeat is the longest heartbeat timeout AWS accepts for a lifecycle hook.
+const maxLifecycleHookHeartbeat = 2 * time.Hour
+
+// InstanceRefreshOptions configures an instance refresh of an autoscaling group.
+type InstanceRefreshOptions struct {
+	// MinHealthyPercentage is the percentage of the desired capacity that must stay in service.
+	MinHealthyPercentage int32
+	// MaxHealthyPercentage is the percentage of the desired capacity that can be in service or pending.
+	MaxHealthyPercentage int32
+	// SkipMatching skips instances that already use the launch template of the group.
+	SkipMatching bool
+	// CheckpointPercentages are the percentages of replaced instances at which the refresh pauses.
+	CheckpointPercentages []int32
+	// CheckpointDelay is how long the refresh pauses at each checkpoint.
+	CheckpointDelay time.Duration
+	// DrainTimeout is how long an instance waits in the termination lifecycle hook for kops to drain it.
+	DrainTimeout time.Duration
+}
+
+func autoscalingGroupName(g *cloudinstances.CloudInstanceGroup) (string, error) {
+	asg, ok := g.Raw.(*autoscalingtypes.AutoScalingGroup)
+	if !ok {
+		return "", fmt.Errorf("instance group %q is not backed by an autoscaling group", g.HumanName)
+	}
+	return aws.ToString(asg.AutoScalingGroupName), nil
+}
+
+func isActiveInstanceRefresh(refresh *autoscalingtypes.InstanceRefresh) bool {
+	switch refresh.Status {
+	case autoscalingtypes.InstanceRefreshStatusPending,
+		autoscalingtypes.InstanceRefreshStatusInProgress,
+		autoscalingtypes.InstanceRefreshStatusBaking:
+		return true
+	}
+	return false
+}
+
+// StartInstanceRefresh adds the termination lifecycle hook to the autoscaling group of g and starts
+// an instance refresh. If a refresh is already in progress, its ID is returned so it can be resumed.
+func StartInstanceRefresh(ctx context.Context, c AWSCloud, g *cloudinstances.CloudInstanceGroup, options InstanceRefreshOptions) (string, error) {
+	asgName, err := autoscalingGroupName(g)
+	if err != nil {
+		return "", err
+	}
+
+	heartbeat := options.DrainTimeout + 5*time.Minute
+	if heartbeat > maxLifecycleHookHeartbeat {
+		heartbeat = maxLifecycleHookHeartbeat
+	}
+	_, err = c.Autoscaling().PutLifecycleHook(ctx, &autoscaling.PutLifecycleHookInput{
+		AutoScalingGroupName: aws.String(asgName),
+		LifecycleHookName:    aws.String(InstanceRefreshLifecycleHookName),
+		LifecycleTransition:  aws.String("autoscaling:EC2_INSTANCE_TERMINATING"),
+		// If kops goes away, instances are still terminated once the heartbeat times out.
+		DefaultResult:    aws.String("CONTINUE"),
+		HeartbeatTimeout: aws.Int32(int32(heartbeat.Seconds())),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error adding lifecycle hook %q to autoscaling group %q: %w", InstanceRefreshLifecycleHookName, asgName, err)
+	}
+
+	preferences := &autoscalingtypes.RefreshPreferences{
+		MinHealthyPercentage: aws.Int32(options.MinHealthyPercentage),
+		MaxHealthyPercentage: aws.Int32(options.MaxHealthyPercentage),
+		SkipMatching:         aws.Bool(options.SkipMatching),
+	}
+	if len(options.CheckpointPercentages) > 0 {
+		preferences.CheckpointPercentages = options.CheckpointPercentages
+		preferences.CheckpointDelay = aws.Int32(int32(options.CheckpointDelay.Seconds()))
+	}
+
+	response, err := c.Autoscaling().StartInstanceRefresh(ctx, &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(asgName),
+		Strategy:             autoscalingtypes.RefreshStrategyRolling,
+		Preferences:          preferences,
+	})
+	if err != nil {
+		var inProgress *autoscalingtypes.InstanceRefreshInProgressFault
+		if !errors.As(err, &inProgress) {
+			return "", fmt.Errorf("error starting instance refresh of autoscaling group %q: %w", asgName, err)
+		}
+
+		refreshes, err := c.Autoscaling().DescribeInstanceRefreshes(ctx, &autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: aws.String(asgName),
+		})
+		if err != nil {
+			return "", fmt.Errorf("error describing instance refreshes of autoscaling group %q: %w", asgName, err)
+		}
+		for i := range refreshes.InstanceRefreshes {
+			refresh := &refreshes.InstanceRefreshes[i]
+			if isActiveInstanceRefresh(refresh) {
+				klog.Infof("Resuming instance refresh %q of autoscaling group %q", aws.ToString(refresh.InstanceRefreshId), asgName)
+				return aws.ToString(refresh.InstanceRefreshId), nil
+			}
+		}
+		return "", fmt.Errorf("error starting instance refresh of autoscaling group %q: %w", asgName, err)
+	}
+
+	return aws.ToString(response.InstanceRefreshId), nil
+}
+
+// DescribeInstanceRefresh returns the instance refresh with the given ID.
+func DescribeInstanceRefresh(ctx context.Context, c AWSCloud, g *cloudinstances.CloudInstanceGroup, id string) (*autoscalingtypes.InstanceRefresh, error) {
+	asgName, err := autoscalingGroupName(g)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.Autoscaling().DescribeInstanceRefreshes(ctx, &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(asgName),
+		InstanceRefreshIds:   []string{id},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error describing instance refresh %q of autoscaling group %q: %w", id, asgName, err)
+	}
+	if len(response.InstanceRefreshes) == 0 {
+		return nil, fmt.Errorf("instance refresh %q of autoscaling group %q not found", id, asgName)
+	}
+	return &response.InstanceRefreshes[0], nil
+}
+
+// CancelInstanceRefresh cancels the active instance refresh of the autoscaling group of g, if there is one.
+func CancelInstanceRefresh(ctx context.Context, c AWSCloud, g *cloudinstances.CloudInstanceGroup) error {
+	asgName, err := autoscalingGroupName(g)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Autoscaling().CancelInstanceRefresh(ctx, &autoscaling.CancelInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(asgName),
+	})
+	if err != nil {
+		var notFound *autoscalingtypes.ActiveInstanceRefreshNotFoundFault
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return fmt.Errorf("error cancelling instance refresh of autoscaling group %q: %w", asgName, err)
+	}
+	return nil
+}
+
+// FindInstancesAwaitingTermination returns the IDs of the instances of the autoscaling group of g
+// that are held by a termination lifecycle hook.
+func FindInstancesAwaitingTermination(ctx context.Context, c AWSCloud, g *cloudinstances.CloudInstanceGroup) ([]string, error) {
+	asgName, err := autoscalingGroupName(g)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.Autoscaling().DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{asgName},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error describing autoscaling group %q: %w", asgName, err)
+	}
+
+	var ids []string
+	for _, asg := range response.AutoScalingGroups {
+		for _, instance := range asg.Instances {
+			if instance.LifecycleState == autoscalingtypes.LifecycleStateTerminatingWait {
+				ids = append(ids, aws.ToString(instance.InstanceId))
+			}
+		}
+	}
+	return ids, nil
+}
+
+// CompleteInstanceTermination lets an instance held by the instance refresh lifecycle hook terminate.
+func CompleteInstanceTermination(ctx context.Context, c AWSCloud, g *cloudinstances.CloudInstanceGroup, instanceID string) error {
+	asgName, err := autoscalingGroupName(g)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Autoscaling().CompleteLifecycleAction(ctx, &autoscaling.CompleteLifecycleActionInput{
+		AutoScalingGroupName:  aws.String(asgName),
+		InstanceId:            aws.String(instanceID),
+		LifecycleHookName:     aws.String(InstanceRefreshLifecycleHookName),
+		LifecycleActionResult: aws.String("CONTINUE"),
+	})
+	if err != nil {
+		return fmt.Errorf("error completing lifecycle action of instance %q: %w", instanceID, err)
+	}
+	return nil
+}
+
+// DeleteInstanceRefreshLifecycleHook removes the lifecycle hook added by StartInstanceRefresh.
+// AWS lets any instances still held by the hook terminate.
+func DeleteInstanceRefreshLifecycleHook(ctx context.Context, c AWSCloud, g *cloudinstances.CloudInstanceGroup) error {
+	asgName, err := autoscalingGroupName(g)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Autoscaling().DeleteLifecycleHook(ctx, &autoscaling.DeleteLifecycleHookInput{
+		AutoScalingGroupName: aws.String(asgName),
+		LifecycleHookName:    aws.String(InstanceRefreshLifecycleHookName),
+	})
+	if err != nil {
+		return fmt.Errorf("error removing lifecycle hook %q from autoscaling group %q: %w", InstanceRefreshLifecycleHookName, asgName, err)
+	}
+	return nil
+}
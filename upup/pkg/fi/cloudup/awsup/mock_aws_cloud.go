@@ -282,8 +282,8 @@ func (c *MockAWSCloud) FindVPCInfo(id string) (*fi.VPCInfo, error) {
 	return findVPCInfo(c, id)
 }
 
-func (c *MockAWSCloud) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
-	return getApiIngressStatus(c, cluster)
+func (c *MockAWSCloud) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+	return getApiIngressStatus(ctx, c, cluster)
 }
 
 // DefaultInstanceType determines an instance type for the specified cluster & instance group
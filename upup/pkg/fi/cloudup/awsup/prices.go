@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Approximate us-east-1 list prices, in USD, of the resources kOps creates besides instances.
+// They are used for cost estimates only; instance prices are looked up with the pricing API.
+
+// HoursPerMonth is the number of hours AWS uses to compute monthly prices
+const HoursPerMonth = 730
+
+const (
+	ClassicLoadBalancerPricePerHour = 0.025
+	LoadBalancerV2PricePerHour      = 0.0225
+	NatGatewayPricePerHour          = 0.045
+	PublicIPv4PricePerHour          = 0.005
+)
+
+// volumePricePerGiBMonth holds the price of EBS volumes, by volume type
+var volumePricePerGiBMonth = map[ec2types.VolumeType]float64{
+	ec2types.VolumeTypeGp2:      0.10,
+	ec2types.VolumeTypeGp3:      0.08,
+	ec2types.VolumeTypeIo1:      0.125,
+	ec2types.VolumeTypeIo2:      0.125,
+	ec2types.VolumeTypeSt1:      0.045,
+	ec2types.VolumeTypeSc1:      0.015,
+	ec2types.VolumeTypeStandard: 0.05,
+}
+
+// VolumeMonthlyCost returns the monthly cost of an EBS volume of the given type and size in GiB.
+// It returns false if the price of the volume type is not known.
+func VolumeMonthlyCost(volumeType ec2types.VolumeType, sizeGiB int32) (float64, bool) {
+	price, found := volumePricePerGiBMonth[volumeType]
+	if !found {
+		return 0, false
+	}
+	return price * float64(sizeGiB), true
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/klog/v2"
+
+	"k8s.io/kops/pkg/cloudinstances"
+)
+
+// tagNameResizedToLaunchTemplate records the launch template version an instance was resized in place to match,
+// so that the instance is considered up to date even though it was launched from an older version.
+const tagNameResizedToLaunchTemplate = "kops.k8s.io/resized-to-launch-template"
+
+// resizeInPlaceTimeout is how long we wait for an instance to stop, start or change standby state.
+var resizeInPlaceTimeout = 15 * time.Minute
+
+// resizeInPlacePollInterval is the time between checks on the standby state of an instance.
+var resizeInPlacePollInterval = 5 * time.Second
+
+// InPlaceResizer changes the instance type of the instances of an autoscaling group by stopping and starting them.
+type InPlaceResizer struct {
+	cloud AWSCloud
+}
+
+// NewInPlaceResizer builds an InPlaceResizer for the instances of autoscaling groups.
+func NewInPlaceResizer(cloud AWSCloud) *InPlaceResizer {
+	return &InPlaceResizer{cloud: cloud}
+}
+
+// PlanResize returns the instance type the instance can be resized to in place, or an empty string if the launch
+// template of its autoscaling group has other changes and the instance needs to be replaced.
+func (r *InPlaceResizer) PlanResize(ctx context.Context, i *cloudinstances.CloudInstance) (string, error) {
+	asg, ok := i.CloudInstanceGroup.Raw.(*autoscalingtypes.AutoScalingGroup)
+	if !ok || asg.LaunchTemplate == nil {
+		// Mixed instances policies pick the instance type themselves
+		return "", nil
+	}
+
+	newConfigName, err := findAutoscalingGroupLaunchConfiguration(ctx, r.cloud, asg)
+	if err != nil {
+		return "", err
+	}
+
+	currentConfigName := ""
+	for _, instance := range asg.Instances {
+		if aws.ToString(instance.InstanceId) == i.ID {
+			currentConfigName = findInstanceLaunchConfiguration(instance)
+		}
+	}
+	resized, err := r.resizedToLaunchTemplate(ctx, i.ID)
+	if err != nil {
+		return "", err
+	}
+	if resized != "" {
+		currentConfigName = resized
+	}
+
+	templateID, newVersion, found := strings.Cut(newConfigName, ":")
+	if !found {
+		return "", nil
+	}
+	currentTemplateID, currentVersion, found := strings.Cut(currentConfigName, ":")
+	if !found || currentTemplateID != templateID {
+		return "", nil
+	}
+
+	response, err := r.cloud.EC2().DescribeLaunchTemplateVersions(ctx, &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(templateID),
+		Versions:         []string{currentVersion, newVersion},
+	})
+	if err != nil {
+		return "", fmt.Errorf("error describing launch template %q: %w", templateID, err)
+	}
+	versions := make(map[string]*ec2types.ResponseLaunchTemplateData)
+	for _, version := range response.LaunchTemplateVersions {
+		versions[fmt.Sprintf("%d", aws.ToInt64(version.VersionNumber))] = version.LaunchTemplateData
+	}
+	if versions[currentVersion] == nil || versions[newVersion] == nil {
+		return "", nil
+	}
+
+	instanceType, ok := instanceTypeChange(versions[currentVersion], versions[newVersion])
+	if !ok {
+		return "", nil
+	}
+	return instanceType, nil
+}
+
+// instanceTypeChange returns the new instance type if it is the only difference between two launch template versions.
+func instanceTypeChange(current, updated *ec2types.ResponseLaunchTemplateData) (string, bool) {
+	if current.InstanceType == updated.InstanceType || updated.InstanceType == "" {
+		return "", false
+	}
+	if current.InstanceMarketOptions != nil {
+		// Spot instances can't be stopped reliably
+		return "", false
+	}
+
+	a := *current
+	b := *updated
+	a.InstanceType = ""
+	b.InstanceType = ""
+	if !reflect.DeepEqual(a, b) {
+		return "", false
+	}
+	return string(updated.InstanceType), true
+}
+
+func (r *InPlaceResizer) resizedToLaunchTemplate(ctx context.Context, id string) (string, error) {
+	response, err := r.cloud.EC2().DescribeInstances(ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{id}})
+	if err != nil {
+		return "", fmt.Errorf("error describing instance %q: %w", id, err)
+	}
+	for _, reservation := range response.Reservations {
+		for _, instance := range reservation.Instances {
+			for _, tag := range instance.Tags {
+				if aws.ToString(tag.Key) == tagNameResizedToLaunchTemplate {
+					return aws.ToString(tag.Value), nil
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+// Resize moves the instance to standby, so that the autoscaling group doesn't replace it while it is stopped,
+// changes its instance type, and returns it to service.
+func (r *InPlaceResizer) Resize(ctx context.Context, i *cloudinstances.CloudInstance, instanceType string) error {
+	asg, ok := i.CloudInstanceGroup.Raw.(*autoscalingtypes.AutoScalingGroup)
+	if !ok {
+		return fmt.Errorf("instance group %q is not backed by an autoscaling group", i.CloudInstanceGroup.HumanName)
+	}
+	asgName := aws.ToString(asg.AutoScalingGroupName)
+	newConfigName, err := findAutoscalingGroupLaunchConfiguration(ctx, r.cloud, asg)
+	if err != nil {
+		return err
+	}
+
+	klog.Infof("Moving instance %q of autoscaling group %q to standby", i.ID, asgName)
+	if _, err := r.cloud.Autoscaling().EnterStandby(ctx, &autoscaling.EnterStandbyInput{
+		AutoScalingGroupName:           aws.String(asgName),
+		InstanceIds:                    []string{i.ID},
+		ShouldDecrementDesiredCapacity: aws.Bool(true),
+	}); err != nil {
+		return fmt.Errorf("error moving instance %q to standby: %w", i.ID, err)
+	}
+	if err := r.waitForLifecycleState(ctx, asgName, i.ID, autoscalingtypes.LifecycleStateStandby); err != nil {
+		return err
+	}
+
+	if err := r.changeInstanceType(ctx, i.ID, instanceType); err != nil {
+		klog.Warningf("Instance %q is left in standby in autoscaling group %q", i.ID, asgName)
+		return err
+	}
+
+	if _, err := r.cloud.EC2().CreateTags(ctx, &ec2.CreateTagsInput{
+		Resources: []string{i.ID},
+		Tags: []ec2types.Tag{
+			{Key: aws.String(tagNameResizedToLaunchTemplate), Value: aws.String(newConfigName)},
+		},
+	}); err != nil {
+		return fmt.Errorf("error tagging instance %q: %w", i.ID, err)
+	}
+
+	klog.Infof("Returning instance %q of autoscaling group %q to service", i.ID, asgName)
+	if _, err := r.cloud.Autoscaling().ExitStandby(ctx, &autoscaling.ExitStandbyInput{
+		AutoScalingGroupName: aws.String(asgName),
+		InstanceIds:          []string{i.ID},
+	}); err != nil {
+		return fmt.Errorf("error returning instance %q to service: %w", i.ID, err)
+	}
+	return r.waitForLifecycleState(ctx, asgName, i.ID, autoscalingtypes.LifecycleStateInService)
+}
+
+func (r *InPlaceResizer) changeInstanceType(ctx context.Context, id string, instanceType string) error {
+	klog.Infof("Stopping instance %q", id)
+	if _, err := r.cloud.EC2().StopInstances(ctx, &ec2.StopInstancesInput{InstanceIds: []string{id}}); err != nil {
+		return fmt.Errorf("error stopping instance %q: %w", id, err)
+	}
+	if err := ec2.NewInstanceStoppedWaiter(r.cloud.EC2()).Wait(ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{id}}, resizeInPlaceTimeout); err != nil {
+		return fmt.Errorf("error waiting for instance %q to stop: %w", id, err)
+	}
+
+	klog.Infof("Changing the instance type of instance %q to %q", id, instanceType)
+	_, modifyErr := r.cloud.EC2().ModifyInstanceAttribute(ctx, &ec2.ModifyInstanceAttributeInput{
+		InstanceId:   aws.String(id),
+		InstanceType: &ec2types.AttributeValue{Value: aws.String(instanceType)},
+	})
+	if modifyErr != nil {
+		klog.Warningf("Failed to change the instance type of instance %q, starting it with its previous instance type", id)
+	}
+
+	klog.Infof("Starting instance %q", id)
+	if _, err := r.cloud.EC2().StartInstances(ctx, &ec2.StartInstancesInput{InstanceIds: []string{id}}); err != nil {
+		return fmt.Errorf("error starting instance %q: %w", id, err)
+	}
+	if err := ec2.NewInstanceRunningWaiter(r.cloud.EC2()).Wait(ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{id}}, resizeInPlaceTimeout); err != nil {
+		return fmt.Errorf("error waiting for instance %q to start: %w", id, err)
+	}
+
+	if modifyErr != nil {
+		return fmt.Errorf("error changing the instance type of instance %q: %w", id, modifyErr)
+	}
+	return nil
+}
+
+func (r *InPlaceResizer) waitForLifecycleState(ctx context.Context, asgName string, id string, state autoscalingtypes.LifecycleState) error {
+	deadline := time.Now().Add(resizeInPlaceTimeout)
+	for {
+		response, err := r.cloud.Autoscaling().DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{asgName},
+		})
+		if err != nil {
+			return fmt.Errorf("error describing autoscaling group %q: %w", asgName, err)
+		}
+		for _, group := range response.AutoScalingGroups {
+			for _, instance := range group.Instances {
+				if aws.ToString(instance.InstanceId) == id && instance.LifecycleState == state {
+					return nil
+				}
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for instance %q to be %s", id, state)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(resizeInPlacePollInterval):
+		}
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestInstanceTypeChange(t *testing.T) {
+	grid := []struct {
+		desc     string
+		current  ec2types.ResponseLaunchTemplateData
+		updated  ec2types.ResponseLaunchTemplateData
+		expected string
+	}{
+		{
+			desc:     "instance type only",
+			current:  ec2types.ResponseLaunchTemplateData{InstanceType: ec2types.InstanceTypeM5Large, ImageId: aws.String("ami-1")},
+			updated:  ec2types.ResponseLaunchTemplateData{InstanceType: ec2types.InstanceTypeM5Xlarge, ImageId: aws.String("ami-1")},
+			expected: "m5.xlarge",
+		},
+		{
+			desc:    "unchanged",
+			current: ec2types.ResponseLaunchTemplateData{InstanceType: ec2types.InstanceTypeM5Large},
+			updated: ec2types.ResponseLaunchTemplateData{InstanceType: ec2types.InstanceTypeM5Large},
+		},
+		{
+			desc:    "image changed too",
+			current: ec2types.ResponseLaunchTemplateData{InstanceType: ec2types.InstanceTypeM5Large, ImageId: aws.String("ami-1")},
+			updated: ec2types.ResponseLaunchTemplateData{InstanceType: ec2types.InstanceTypeM5Xlarge, ImageId: aws.String("ami-2")},
+		},
+		{
+			desc: "spot",
+			current: ec2types.ResponseLaunchTemplateData{
+				InstanceType:          ec2types.InstanceTypeM5Large,
+				InstanceMarketOptions: &ec2types.LaunchTemplateInstanceMarketOptions{MarketType: ec2types.MarketTypeSpot},
+			},
+			updated: ec2types.ResponseLaunchTemplateData{
+				InstanceType:          ec2types.InstanceTypeM5Xlarge,
+				InstanceMarketOptions: &ec2types.LaunchTemplateInstanceMarketOptions{MarketType: ec2types.MarketTypeSpot},
+			},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			instanceType, ok := instanceTypeChange(&g.current, &g.updated)
+			if instanceType != g.expected || ok != (g.expected != "") {
+				t.Errorf("instanceTypeChange() = %q, %v; expected %q", instanceType, ok, g.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+// Environment variables that tune how the AWS SDK retries failed requests.
+// They are useful for accounts that hit RequestLimitExceeded regularly.
+const (
+	// RetryModeEnv selects the SDK retry mode, either "adaptive" (the default) or "standard".
+	RetryModeEnv = "KOPS_AWS_RETRY_MODE"
+	// MaxAttemptsEnv overrides the number of attempts made for each request (default ClientMaxRetries).
+	MaxAttemptsEnv = "KOPS_AWS_MAX_ATTEMPTS"
+	// MaxBackoffEnv overrides the maximum delay between attempts, as a duration (default 20s).
+	MaxBackoffEnv = "KOPS_AWS_MAX_BACKOFF"
+)
+
+// retryOptions holds the retry configuration used for the AWS clients.
+type retryOptions struct {
+	Mode        aws.RetryMode
+	MaxAttempts int
+	MaxBackoff  time.Duration
+}
+
+// retryOptionsFromEnv builds the retry configuration, applying any overrides from the environment.
+func retryOptionsFromEnv() (retryOptions, error) {
+	o := retryOptions{
+		Mode:        aws.RetryModeAdaptive,
+		MaxAttempts: ClientMaxRetries,
+		MaxBackoff:  retry.DefaultMaxBackoff,
+	}
+
+	if s := os.Getenv(RetryModeEnv); s != "" {
+		mode, err := aws.ParseRetryMode(s)
+		if err != nil {
+			return o, fmt.Errorf("invalid %s: %w", RetryModeEnv, err)
+		}
+		o.Mode = mode
+	}
+
+	if s := os.Getenv(MaxAttemptsEnv); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 1 {
+			return o, fmt.Errorf("invalid %s %q: must be a positive integer", MaxAttemptsEnv, s)
+		}
+		o.MaxAttempts = n
+	}
+
+	if s := os.Getenv(MaxBackoffEnv); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil || d <= 0 {
+			return o, fmt.Errorf("invalid %s %q: must be a positive duration", MaxBackoffEnv, s)
+		}
+		o.MaxBackoff = d
+	}
+
+	return o, nil
+}
+
+// NewRetryer returns a new SDK retryer for the configuration.
+func (o retryOptions) NewRetryer() aws.Retryer {
+	standardOptions := func(so *retry.StandardOptions) {
+		so.MaxAttempts = o.MaxAttempts
+		so.MaxBackoff = o.MaxBackoff
+	}
+
+	if o.Mode == aws.RetryModeStandard {
+		return retry.NewStandard(standardOptions)
+	}
+	return retry.NewAdaptiveMode(func(ao *retry.AdaptiveModeOptions) {
+		ao.StandardOptions = append(ao.StandardOptions, standardOptions)
+	})
+}
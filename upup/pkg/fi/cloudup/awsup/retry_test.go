@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+func TestRetryOptionsFromEnv(t *testing.T) {
+	grid := []struct {
+		name        string
+		env         map[string]string
+		expected    retryOptions
+		expectError bool
+	}{
+		{
+			name: "defaults",
+			expected: retryOptions{
+				Mode:        aws.RetryModeAdaptive,
+				MaxAttempts: ClientMaxRetries,
+				MaxBackoff:  retry.DefaultMaxBackoff,
+			},
+		},
+		{
+			name: "overrides",
+			env: map[string]string{
+				RetryModeEnv:   "standard",
+				MaxAttemptsEnv: "25",
+				MaxBackoffEnv:  "1m",
+			},
+			expected: retryOptions{
+				Mode:        aws.RetryModeStandard,
+				MaxAttempts: 25,
+				MaxBackoff:  time.Minute,
+			},
+		},
+		{
+			name:        "invalid mode",
+			env:         map[string]string{RetryModeEnv: "legacy"},
+			expectError: true,
+		},
+		{
+			name:        "invalid attempts",
+			env:         map[string]string{MaxAttemptsEnv: "0"},
+			expectError: true,
+		},
+		{
+			name:        "invalid backoff",
+			env:         map[string]string{MaxBackoffEnv: "10"},
+			expectError: true,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			for _, k := range []string{RetryModeEnv, MaxAttemptsEnv, MaxBackoffEnv} {
+				t.Setenv(k, g.env[k])
+			}
+
+			actual, err := retryOptionsFromEnv()
+			if g.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != g.expected {
+				t.Errorf("expected %+v, got %+v", g.expected, actual)
+			}
+
+			retryer := actual.NewRetryer()
+			if retryer.MaxAttempts() != g.expected.MaxAttempts {
+				t.Errorf("expected retryer to make %d attempts, got %d", g.expected.MaxAttempts, retryer.MaxAttempts())
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"k8s.io/klog/v2"
+
+	"k8s.io/kops/util/pkg/awsinterfaces"
+)
+
+const (
+	// ssmOutputChunkSize is the number of bytes of command output fetched per command.
+	// Run Command truncates the output it returns to 24000 characters, which fits the base64 encoding of this.
+	ssmOutputChunkSize = 16 * 1024
+	// ssmInputChunkSize is the number of bytes of file content sent per command.
+	ssmInputChunkSize = 24 * 1024
+)
+
+// SSMCommandRunner runs shell commands as root on an EC2 instance through AWS Systems Manager Run Command.
+// It reaches instances which have the SSM agent running, without SSH daemons, bastions or inbound connectivity.
+type SSMCommandRunner struct {
+	client     awsinterfaces.SSMAPI
+	instanceID string
+
+	// PollInterval is how often the status of a command is checked.
+	PollInterval time.Duration
+}
+
+// SSMCommandExitError is returned when a command ran, but exited with a non-zero status.
+type SSMCommandExitError struct {
+	Command  string
+	ExitCode int
+}
+
+func (e *SSMCommandExitError) Error() string {
+	return fmt.Sprintf("command %q exited with status %d", e.Command, e.ExitCode)
+}
+
+// NewSSMCommandRunner builds an SSMCommandRunner for the instance.
+func NewSSMCommandRunner(client awsinterfaces.SSMAPI, instanceID string) *SSMCommandRunner {
+	return &SSMCommandRunner{
+		client:       client,
+		instanceID:   instanceID,
+		PollInterval: time.Second,
+	}
+}
+
+// NewSSMClient builds a client for AWS Systems Manager in the region, or in the default region of the environment if empty.
+func NewSSMClient(ctx context.Context, region string) (awsinterfaces.SSMAPI, error) {
+	cfg, err := loadAWSConfig(ctx, region)
+	if err != nil {
+		return nil, fmt.Errorf("loading AWS config: %w", err)
+	}
+	return ssm.NewFromConfig(cfg), nil
+}
+
+// InstanceID returns the ID of the instance the commands run on.
+func (r *SSMCommandRunner) InstanceID() string {
+	return r.instanceID
+}
+
+// Run runs the command with /bin/bash, copying its output to stdout and stderr.
+// As Run Command truncates the output it returns, the output is kept on the instance and fetched in chunks.
+func (r *SSMCommandRunner) Run(ctx context.Context, command string, stdout io.Writer, stderr io.Writer) error {
+	script := strings.Join([]string{
+		`d=$(mktemp -d) || exit 1`,
+		`echo '` + base64.StdEncoding.EncodeToString([]byte(command)) + `' | base64 -d > "$d/command"`,
+		`/bin/bash "$d/command" > "$d/stdout" 2> "$d/stderr"`,
+		`rc=$?`,
+		`gzip "$d/stdout" "$d/stderr"`,
+		`echo "$rc $d"`,
+	}, "\n")
+	result, err := r.send(ctx, script)
+	if err != nil {
+		return err
+	}
+
+	fields := strings.Fields(result)
+	if len(fields) != 2 {
+		return fmt.Errorf("unexpected result %q running command on instance %s", result, r.instanceID)
+	}
+	exitCode, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return fmt.Errorf("unexpected exit status %q running command on instance %s", fields[0], r.instanceID)
+	}
+	dir := fields[1]
+
+	defer func() {
+		if _, err := r.send(ctx, "rm -rf "+shellQuote(dir)); err != nil {
+			klog.Warningf("error cleaning up %q on instance %s: %v", dir, r.instanceID, err)
+		}
+	}()
+
+	if err := r.fetchOutput(ctx, path.Join(dir, "stdout.gz"), stdout); err != nil {
+		return err
+	}
+	if err := r.fetchOutput(ctx, path.Join(dir, "stderr.gz"), stderr); err != nil {
+		return err
+	}
+
+	if exitCode != 0 {
+		return &SSMCommandExitError{Command: command, ExitCode: exitCode}
+	}
+	return nil
+}
+
+// fetchOutput copies the gzipped file on the instance to w.
+func (r *SSMCommandRunner) fetchOutput(ctx context.Context, p string, w io.Writer) error {
+	var compressed bytes.Buffer
+	for {
+		chunk, err := r.send(ctx, fmt.Sprintf("tail -c +%d %s | head -c %d | base64 -w 0", compressed.Len()+1, shellQuote(p), ssmOutputChunkSize))
+		if err != nil {
+			return fmt.Errorf("reading %s from instance %s: %w", p, r.instanceID, err)
+		}
+		b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(chunk))
+		if err != nil {
+			return fmt.Errorf("decoding %s from instance %s: %w", p, r.instanceID, err)
+		}
+		compressed.Write(b)
+		if len(b) < ssmOutputChunkSize {
+			break
+		}
+	}
+
+	gz, err := gzip.NewReader(&compressed)
+	if err != nil {
+		return fmt.Errorf("decompressing %s from instance %s: %w", p, r.instanceID, err)
+	}
+	if _, err := io.Copy(w, gz); err != nil {
+		return fmt.Errorf("decompressing %s from instance %s: %w", p, r.instanceID, err)
+	}
+	return nil
+}
+
+// WriteFile writes the data to the file on the instance, creating its parent directories.
+func (r *SSMCommandRunner) WriteFile(ctx context.Context, p string, data []byte) error {
+	tmp := shellQuote(p + ".kops-ssm-tmp")
+	if _, err := r.send(ctx, fmt.Sprintf("mkdir -p %s && : > %s", shellQuote(path.Dir(p)), tmp)); err != nil {
+		return fmt.Errorf("writing %s on instance %s: %w", p, r.instanceID, err)
+	}
+	for len(data) > 0 {
+		n := min(len(data), ssmInputChunkSize)
+		if _, err := r.send(ctx, fmt.Sprintf("echo '%s' | base64 -d >> %s", base64.StdEncoding.EncodeToString(data[:n]), tmp)); err != nil {
+			return fmt.Errorf("writing %s on instance %s: %w", p, r.instanceID, err)
+		}
+		data = data[n:]
+	}
+	if _, err := r.send(ctx, fmt.Sprintf("mv %s %s", tmp, shellQuote(p))); err != nil {
+		return fmt.Errorf("writing %s on instance %s: %w", p, r.instanceID, err)
+	}
+	return nil
+}
+
+// send runs the shell script with Run Command, waits for it to complete and returns its (possibly truncated) output.
+func (r *SSMCommandRunner) send(ctx context.Context, script string) (string, error) {
+	response, err := r.client.SendCommand(ctx, &ssm.SendCommandInput{
+		DocumentName: aws.String("AWS-RunShellScript"),
+		InstanceIds:  []string{r.instanceID},
+		Parameters: map[string][]string{
+			"commands": {script},
+		},
+		Comment: aws.String("kops"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("sending command to instance %s: %w", r.instanceID, err)
+	}
+	commandID := aws.ToString(response.Command.CommandId)
+	klog.V(4).Infof("sent command %s to instance %s", commandID, r.instanceID)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(r.PollInterval):
+		}
+
+		invocation, err := r.client.GetCommandInvocation(ctx, &ssm.GetCommandInvocationInput{
+			CommandId:  aws.String(commandID),
+			InstanceId: aws.String(r.instanceID),
+		})
+		if err != nil {
+			// The invocation is not visible immediately after the command is sent
+			var notExist *ssmtypes.InvocationDoesNotExist
+			if errors.As(err, &notExist) {
+				continue
+			}
+			return "", fmt.Errorf("getting status of command %s on instance %s: %w", commandID, r.instanceID, err)
+		}
+
+		switch invocation.Status {
+		case ssmtypes.CommandInvocationStatusPending, ssmtypes.CommandInvocationStatusInProgress, ssmtypes.CommandInvocationStatusDelayed:
+			continue
+		case ssmtypes.CommandInvocationStatusSuccess:
+			return aws.ToString(invocation.StandardOutputContent), nil
+		default:
+			return "", fmt.Errorf("command %s on instance %s ended with status %s (%s): %s", commandID, r.instanceID, invocation.Status, aws.ToString(invocation.StatusDetails), aws.ToString(invocation.StandardErrorContent))
+		}
+	}
+}
+
+// shellQuote quotes s for use as a single word in a shell command.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
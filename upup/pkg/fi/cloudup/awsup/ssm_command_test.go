@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"k8s.io/kops/util/pkg/awsinterfaces"
+)
+
+// localSSM runs the scripts sent with SendCommand on the local machine, truncating their output like Run Command.
+type localSSM struct {
+	awsinterfaces.SSMAPI
+
+	invocations map[string]*ssm.GetCommandInvocationOutput
+	polled      map[string]bool
+}
+
+func (m *localSSM) SendCommand(ctx context.Context, input *ssm.SendCommandInput, optFns ...func(*ssm.Options)) (*ssm.SendCommandOutput, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "/bin/bash", "-c", input.Parameters["commands"][0])
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	status := ssmtypes.CommandInvocationStatusSuccess
+	if err := cmd.Run(); err != nil {
+		status = ssmtypes.CommandInvocationStatusFailed
+	}
+
+	output := stdout.String()
+	if len(output) > 24000 {
+		output = output[:24000]
+	}
+
+	id := fmt.Sprintf("command-%d", len(m.invocations))
+	m.invocations[id] = &ssm.GetCommandInvocationOutput{
+		Status:                status,
+		StandardOutputContent: aws.String(output),
+		StandardErrorContent:  aws.String(stderr.String()),
+	}
+	return &ssm.SendCommandOutput{Command: &ssmtypes.Command{CommandId: aws.String(id)}}, nil
+}
+
+func (m *localSSM) GetCommandInvocation(ctx context.Context, input *ssm.GetCommandInvocationInput, optFns ...func(*ssm.Options)) (*ssm.GetCommandInvocationOutput, error) {
+	id := aws.ToString(input.CommandId)
+	// The first poll doesn't find the invocation, as with the real API
+	if !m.polled[id] {
+		m.polled[id] = true
+		return nil, &ssmtypes.InvocationDoesNotExist{}
+	}
+	return m.invocations[id], nil
+}
+
+func newLocalSSMCommandRunner(t *testing.T) *SSMCommandRunner {
+	for _, tool := range []string{"bash", "base64", "gzip", "mktemp"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			t.Skipf("%s not available: %v", tool, err)
+		}
+	}
+	client := &localSSM{
+		invocations: make(map[string]*ssm.GetCommandInvocationOutput),
+		polled:      make(map[string]bool),
+	}
+	r := NewSSMCommandRunner(client, "i-0123456789abcdef0")
+	r.PollInterval = 0
+	return r
+}
+
+func TestSSMCommandRunnerRun(t *testing.T) {
+	ctx := context.Background()
+	r := newLocalSSMCommandRunner(t)
+
+	// The output is larger than a chunk, even compressed
+	var stdout, stderr bytes.Buffer
+	err := r.Run(ctx, "head -c 60000 /dev/urandom | base64 -w 0\necho 'it failed' >&2\nexit 2", &stdout, &stderr)
+
+	var exitErr *SSMCommandExitError
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("expected SSMCommandExitError, got %v", err)
+	}
+	if exitErr.ExitCode != 2 {
+		t.Errorf("expected exit code 2, got %d", exitErr.ExitCode)
+	}
+	if stdout.Len() != 80000 {
+		t.Errorf("expected 80000 bytes of stdout, got %d", stdout.Len())
+	}
+	if got := stderr.String(); got != "it failed\n" {
+		t.Errorf("unexpected stderr %q", got)
+	}
+}
+
+func TestSSMCommandRunnerWriteFile(t *testing.T) {
+	ctx := context.Background()
+	r := newLocalSSMCommandRunner(t)
+
+	p := filepath.Join(t.TempDir(), "some dir", "file")
+	data := []byte(strings.Repeat("0123456789", 5000))
+	if err := r.WriteFile(ctx, p, data); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err := r.Run(ctx, "cat '"+p+"'", &stdout, &stderr); err != nil {
+		t.Fatalf("Run: %v: %s", err, stderr.String())
+	}
+	if !bytes.Equal(stdout.Bytes(), data) {
+		t.Errorf("file content does not match: got %d bytes, expected %d", stdout.Len(), len(data))
+	}
+}
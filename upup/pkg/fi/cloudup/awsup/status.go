@@ -31,8 +31,8 @@ import (
 )
 
 // FindClusterStatus discovers the status of the cluster, by looking for the tagged etcd volumes
-func (c *awsCloudImplementation) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
-	etcdStatus, err := findEtcdStatus(c, cluster)
+func (c *awsCloudImplementation) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+	etcdStatus, err := findEtcdStatus(ctx, c, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -44,8 +44,8 @@ func (c *awsCloudImplementation) FindClusterStatus(cluster *kops.Cluster) (*kops
 }
 
 // FindClusterStatus discovers the status of the cluster, by looking for the tagged etcd volumes
-func (c *MockAWSCloud) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
-	etcdStatus, err := findEtcdStatus(c, cluster)
+func (c *MockAWSCloud) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+	etcdStatus, err := findEtcdStatus(ctx, c, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +55,7 @@ func (c *MockAWSCloud) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterSt
 }
 
 // findEtcdStatus discovers the status of etcd, by looking for the tagged etcd volumes
-func findEtcdStatus(c AWSCloud, cluster *kops.Cluster) ([]kops.EtcdClusterStatus, error) {
+func findEtcdStatus(ctx context.Context, c AWSCloud, cluster *kops.Cluster) ([]kops.EtcdClusterStatus, error) {
 	klog.V(2).Infof("Querying AWS for etcd volumes")
 	statusMap := make(map[string]*kops.EtcdClusterStatus)
 
@@ -70,7 +70,7 @@ func findEtcdStatus(c AWSCloud, cluster *kops.Cluster) ([]kops.EtcdClusterStatus
 	klog.V(2).Infof("Listing EC2 Volumes")
 	paginator := ec2.NewDescribeVolumesPaginator(c.EC2(), request)
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.TODO())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("error describing volumes: %v", err)
 		}
@@ -24,7 +24,10 @@ import (
 	"sync"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/dnsprovider/pkg/dnsprovider"
 	"k8s.io/kops/pkg/apis/kops"
@@ -57,12 +60,16 @@ type AzureCloud interface {
 	ApplicationSecurityGroup() ApplicationSecurityGroupsClient
 	VMScaleSet() VMScaleSetsClient
 	VMScaleSetVM() VMScaleSetVMsClient
+	VirtualMachine() VirtualMachinesClient
 	Disk() DisksClient
+	GalleryImageVersion() GalleryImageVersionsClient
 	RoleAssignment() RoleAssignmentsClient
 	NetworkInterface() NetworkInterfacesClient
 	LoadBalancer() LoadBalancersClient
 	PublicIPAddress() PublicIPAddressesClient
 	NatGateway() NatGatewaysClient
+	PrivateLinkService() PrivateLinkServicesClient
+	PrivateDNS() PrivateDNSClient
 }
 
 type azureCloudImplementation struct {
@@ -78,13 +85,17 @@ type azureCloudImplementation struct {
 	routeTablesClient               RouteTablesClient
 	vmscaleSetsClient               VMScaleSetsClient
 	vmscaleSetVMsClient             VMScaleSetVMsClient
+	virtualMachinesClient           VirtualMachinesClient
 	disksClient                     DisksClient
+	galleryImageVersionsClient      GalleryImageVersionsClient
 	roleAssignmentsClient           RoleAssignmentsClient
 	networkInterfacesClient         NetworkInterfacesClient
 	loadBalancersClient             LoadBalancersClient
 	publicIPAddressesClient         PublicIPAddressesClient
 	natGatewaysClient               NatGatewaysClient
 	storageAccountsClient           StorageAccountsClient
+	privateLinkServicesClient       PrivateLinkServicesClient
+	privateDNSClient                PrivateDNSClient
 }
 
 var _ fi.Cloud = (*azureCloudImplementation)(nil)
@@ -147,9 +158,13 @@ func newAzureCloud(subscriptionID, resourceGroupName, location string, tags map[
 	if azureCloudImpl.vmscaleSetVMsClient, err = newVMScaleSetVMsClientImpl(subscriptionID, cred); err != nil {
 		return nil, err
 	}
+	if azureCloudImpl.virtualMachinesClient, err = newVirtualMachinesClientImpl(subscriptionID, cred); err != nil {
+		return nil, err
+	}
 	if azureCloudImpl.disksClient, err = newDisksClientImpl(subscriptionID, cred); err != nil {
 		return nil, err
 	}
+	azureCloudImpl.galleryImageVersionsClient = newGalleryImageVersionsClientImpl(cred)
 	if azureCloudImpl.roleAssignmentsClient, err = newRoleAssignmentsClientImpl(subscriptionID, cred); err != nil {
 		return nil, err
 	}
@@ -168,6 +183,12 @@ func newAzureCloud(subscriptionID, resourceGroupName, location string, tags map[
 	if azureCloudImpl.storageAccountsClient, err = newStorageAccountsClientImpl(subscriptionID, cred); err != nil {
 		return nil, err
 	}
+	if azureCloudImpl.privateLinkServicesClient, err = newPrivateLinkServicesClientImpl(subscriptionID, cred); err != nil {
+		return nil, err
+	}
+	if azureCloudImpl.privateDNSClient, err = newPrivateDNSClientImpl(subscriptionID, cred); err != nil {
+		return nil, err
+	}
 
 	CacheAzureCloudInstance(subscriptionID, resourceGroupName, azureCloudImpl)
 
@@ -237,6 +258,10 @@ func (c *azureCloudImplementation) FindStorageAccountInfo(name string) (*armstor
 }
 
 func (c *azureCloudImplementation) DeleteInstance(i *cloudinstances.CloudInstance) error {
+	// The VMs of VM Scale Sets in Flexible orchestration mode are standalone VMs
+	if vmss, ok := i.CloudInstanceGroup.Raw.(*compute.VirtualMachineScaleSet); ok && isFlexible(vmss) {
+		return c.virtualMachinesClient.Delete(context.TODO(), c.resourceGroupName, i.ID)
+	}
 	vmssName := i.CloudInstanceGroup.HumanName
 	instanceID := strings.TrimPrefix(i.ID, vmssName+"_")
 	return c.vmscaleSetVMsClient.Delete(context.TODO(), c.resourceGroupName, vmssName, instanceID)
@@ -263,14 +288,14 @@ func (c *azureCloudImplementation) AddClusterTags(tags map[string]*string) {
 	}
 }
 
-func (c *azureCloudImplementation) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+func (c *azureCloudImplementation) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
 	var ingresses []fi.ApiIngressStatus
 	rg := cluster.AzureResourceGroupName()
 
 	lbSpec := cluster.Spec.API.LoadBalancer
 	if lbSpec != nil {
 		// Get load balancers in cluster resource group
-		lbs, err := c.loadBalancersClient.List(context.TODO(), rg)
+		lbs, err := c.loadBalancersClient.List(ctx, rg)
 		if err != nil {
 			return nil, fmt.Errorf("getting Loadbalancer for API Ingress Status: %w", err)
 		}
@@ -299,7 +324,7 @@ func (c *azureCloudImplementation) GetApiIngressStatus(cluster *kops.Cluster) ([
 					if i.Properties.PublicIPAddress == nil || i.Properties.PublicIPAddress.ID == nil {
 						continue
 					}
-					pips, err := c.publicIPAddressesClient.List(context.TODO(), rg)
+					pips, err := c.publicIPAddressesClient.List(ctx, rg)
 					if err != nil {
 						return nil, fmt.Errorf("error getting PublicIPAddress for API Ingress Status: %w", err)
 					}
@@ -318,26 +343,26 @@ func (c *azureCloudImplementation) GetApiIngressStatus(cluster *kops.Cluster) ([
 		}
 	} else {
 		// Get scale sets in cluster resource group and find masters scale set
-		scaleSets, err := c.vmscaleSetsClient.List(context.TODO(), rg)
+		scaleSets, err := c.vmscaleSetsClient.List(ctx, rg)
 		if err != nil {
 			return nil, fmt.Errorf("getting cluster control plane VMSS for API ingress status: %w", err)
 		}
-		var vmssName string
+		var vmss *compute.VirtualMachineScaleSet
 		for _, scaleSet := range scaleSets {
 			val, ok := scaleSet.Tags[TagClusterName]
 			val2, ok2 := scaleSet.Tags[TagNameRolePrefix+TagRoleControlPlane]
 			val3, ok3 := scaleSet.Tags[TagNameRolePrefix+TagRoleMaster]
 			if ok && *val == cluster.Name && (ok2 && *val2 == "1" || ok3 && *val3 == "1") {
-				vmssName = *scaleSet.Name
+				vmss = scaleSet
 				break
 			}
 		}
-		if vmssName == "" {
+		if vmss == nil {
 			return nil, fmt.Errorf("getting control plane VMSS name for API ingress status")
 		}
 
 		// Get masters scale set network interfaces and append to api ingress status
-		nis, err := c.NetworkInterface().ListScaleSetsNetworkInterfaces(context.TODO(), rg, vmssName)
+		nis, err := ListVMScaleSetNetworkInterfaces(ctx, c, rg, vmss)
 		if err != nil {
 			return nil, fmt.Errorf("getting control plane VMSS network interfaces for API ingress status: %w", err)
 		}
@@ -362,6 +387,37 @@ func (c *azureCloudImplementation) GetApiIngressStatus(cluster *kops.Cluster) ([
 	return ingresses, nil
 }
 
+// ListVMScaleSetNetworkInterfaces returns the network interfaces of the VMs of the VM Scale Set.
+// The network interfaces of VMs in Flexible orchestration mode are standalone resources, which are matched by VM.
+func ListVMScaleSetNetworkInterfaces(ctx context.Context, c AzureCloud, resourceGroupName string, vmss *compute.VirtualMachineScaleSet) ([]*network.Interface, error) {
+	if !isFlexible(vmss) {
+		return c.NetworkInterface().ListScaleSetsNetworkInterfaces(ctx, resourceGroupName, *vmss.Name)
+	}
+
+	vms, err := c.VirtualMachine().ListByVMScaleSet(ctx, resourceGroupName, *vmss.ID)
+	if err != nil {
+		return nil, err
+	}
+	vmIDs := sets.New[string]()
+	for _, vm := range vms {
+		vmIDs.Insert(strings.ToLower(*vm.ID))
+	}
+	nis, err := c.NetworkInterface().List(ctx, resourceGroupName)
+	if err != nil {
+		return nil, err
+	}
+	var l []*network.Interface
+	for _, ni := range nis {
+		if ni.Properties == nil || ni.Properties.VirtualMachine == nil || ni.Properties.VirtualMachine.ID == nil {
+			continue
+		}
+		if vmIDs.Has(strings.ToLower(*ni.Properties.VirtualMachine.ID)) {
+			l = append(l, ni)
+		}
+	}
+	return l, nil
+}
+
 func (c *azureCloudImplementation) SubscriptionID() string {
 	return c.subscriptionID
 }
@@ -398,10 +454,18 @@ func (c *azureCloudImplementation) VMScaleSetVM() VMScaleSetVMsClient {
 	return c.vmscaleSetVMsClient
 }
 
+func (c *azureCloudImplementation) VirtualMachine() VirtualMachinesClient {
+	return c.virtualMachinesClient
+}
+
 func (c *azureCloudImplementation) Disk() DisksClient {
 	return c.disksClient
 }
 
+func (c *azureCloudImplementation) GalleryImageVersion() GalleryImageVersionsClient {
+	return c.galleryImageVersionsClient
+}
+
 func (c *azureCloudImplementation) RoleAssignment() RoleAssignmentsClient {
 	return c.roleAssignmentsClient
 }
@@ -421,3 +485,11 @@ func (c *azureCloudImplementation) PublicIPAddress() PublicIPAddressesClient {
 func (c *azureCloudImplementation) NatGateway() NatGatewaysClient {
 	return c.natGatewaysClient
 }
+
+func (c *azureCloudImplementation) PrivateLinkService() PrivateLinkServicesClient {
+	return c.privateLinkServicesClient
+}
+
+func (c *azureCloudImplementation) PrivateDNS() PrivateDNSClient {
+	return c.privateDNSClient
+}
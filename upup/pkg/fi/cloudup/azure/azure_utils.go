@@ -21,6 +21,9 @@ import (
 	"strings"
 )
 
+// AvailabilityZoneNumbers are the numbers of the availability zones of the Azure regions that have them.
+var AvailabilityZoneNumbers = []string{"1", "2", "3"}
+
 // ZoneToLocation extracts the location from a zone of the
 // form <location>-<available-zone-number>..
 func ZoneToLocation(zone string) (string, error) {
@@ -171,3 +174,37 @@ func ParsePublicIPAddressID(s string) (*PublicIPAddressID, error) {
 		PublicIPAddressName: l[8],
 	}, nil
 }
+
+// GalleryImageVersionID contains the resource ID/names required to construct a GalleryImageVersion ID.
+type GalleryImageVersionID struct {
+	SubscriptionID    string
+	ResourceGroupName string
+	GalleryName       string
+	ImageName         string
+	Version           string
+}
+
+// String returns the GalleryImageVersion ID in the path format.
+func (s *GalleryImageVersionID) String() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s/versions/%s",
+		s.SubscriptionID,
+		s.ResourceGroupName,
+		s.GalleryName,
+		s.ImageName,
+		s.Version)
+}
+
+// ParseGalleryImageVersionID parses a given GalleryImageVersion ID string and returns a GalleryImageVersionID.
+func ParseGalleryImageVersionID(s string) (*GalleryImageVersionID, error) {
+	l := strings.Split(s, "/")
+	if len(l) != 13 || !strings.EqualFold(l[7], "galleries") || !strings.EqualFold(l[11], "versions") {
+		return nil, fmt.Errorf("malformed format of GalleryImageVersion ID: %s, %d", s, len(l))
+	}
+	return &GalleryImageVersionID{
+		SubscriptionID:    l[2],
+		ResourceGroupName: l[4],
+		GalleryName:       l[8],
+		ImageName:         l[10],
+		Version:           l[12],
+	}, nil
+}
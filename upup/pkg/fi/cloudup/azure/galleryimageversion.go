@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+	"k8s.io/klog/v2"
+)
+
+// GalleryImageVersionLatest is the version of a gallery image that refers to its latest version.
+const GalleryImageVersionLatest = "latest"
+
+// GalleryImageVersionsClient is a client for listing the versions of gallery images.
+type GalleryImageVersionsClient interface {
+	List(ctx context.Context, subscriptionID, resourceGroupName, galleryName, imageName string) ([]*compute.GalleryImageVersion, error)
+}
+
+type galleryImageVersionsClientImpl struct {
+	cred *azidentity.DefaultAzureCredential
+}
+
+var _ GalleryImageVersionsClient = (*galleryImageVersionsClientImpl)(nil)
+
+func (c *galleryImageVersionsClientImpl) List(ctx context.Context, subscriptionID, resourceGroupName, galleryName, imageName string) ([]*compute.GalleryImageVersion, error) {
+	// Galleries are often shared from other subscriptions than the one of the cluster
+	client, err := compute.NewGalleryImageVersionsClient(subscriptionID, c.cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating gallery image versions client: %w", err)
+	}
+
+	var l []*compute.GalleryImageVersion
+	pager := client.NewListByGalleryImagePager(resourceGroupName, galleryName, imageName, nil)
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing gallery image versions: %w", err)
+		}
+		l = append(l, resp.Value...)
+	}
+	return l, nil
+}
+
+func newGalleryImageVersionsClientImpl(cred *azidentity.DefaultAzureCredential) *galleryImageVersionsClientImpl {
+	return &galleryImageVersionsClientImpl{
+		cred: cred,
+	}
+}
+
+// ResolveImageID resolves the ID of the latest version of a gallery image,
+// ".../galleries/<gallery>/images/<image>/versions/latest", to the ID of that version.
+// Other image IDs are returned unchanged.
+func ResolveImageID(ctx context.Context, cloud AzureCloud, imageID string) (string, error) {
+	id, err := ParseGalleryImageVersionID(imageID)
+	if err != nil || !strings.EqualFold(id.Version, GalleryImageVersionLatest) {
+		return imageID, nil
+	}
+
+	versions, err := cloud.GalleryImageVersion().List(ctx, id.SubscriptionID, id.ResourceGroupName, id.GalleryName, id.ImageName)
+	if err != nil {
+		return "", fmt.Errorf("error resolving latest version of image %q: %w", imageID, err)
+	}
+
+	var latest string
+	for _, v := range versions {
+		if v.Name == nil || v.Properties == nil {
+			continue
+		}
+		if v.Properties.ProvisioningState != nil && *v.Properties.ProvisioningState != compute.GalleryImageVersionPropertiesProvisioningStateSucceeded {
+			continue
+		}
+		if p := v.Properties.PublishingProfile; p != nil && p.ExcludeFromLatest != nil && *p.ExcludeFromLatest {
+			continue
+		}
+		if latest == "" || compareGalleryImageVersions(*v.Name, latest) > 0 {
+			latest = *v.Name
+		}
+	}
+	if latest == "" {
+		return "", fmt.Errorf("could not find a version of image %q", imageID)
+	}
+
+	id.Version = latest
+	klog.V(2).Infof("Resolved image %q -> %q", imageID, id.String())
+	return id.String(), nil
+}
+
+// compareGalleryImageVersions compares two versions of the form <major>.<minor>.<patch>,
+// returning a negative number if a is older than b, zero if they are the same and a positive number if a is newer.
+func compareGalleryImageVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.ParseInt(as[i], 10, 64)
+		bn, bErr := strconv.ParseInt(bs[i], 10, 64)
+		if aErr != nil || bErr != nil {
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+			continue
+		}
+		if an != bn {
+			if an < bn {
+				return -1
+			}
+			return 1
+		}
+	}
+	return len(as) - len(bs)
+}
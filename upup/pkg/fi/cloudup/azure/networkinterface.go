@@ -27,6 +27,7 @@ import (
 // NetworkInterfacesClient is a client for managing Network Interfaces.
 type NetworkInterfacesClient interface {
 	ListScaleSetsNetworkInterfaces(ctx context.Context, resourceGroupName, vmssName string) ([]*network.Interface, error)
+	List(ctx context.Context, resourceGroupName string) ([]*network.Interface, error)
 }
 
 type networkInterfacesClientImpl struct {
@@ -48,6 +49,19 @@ func (c *networkInterfacesClientImpl) ListScaleSetsNetworkInterfaces(ctx context
 	return l, nil
 }
 
+func (c *networkInterfacesClientImpl) List(ctx context.Context, resourceGroupName string) ([]*network.Interface, error) {
+	var l []*network.Interface
+	pager := c.c.NewListPager(resourceGroupName, nil)
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing network interfaces: %w", err)
+		}
+		l = append(l, resp.Value...)
+	}
+	return l, nil
+}
+
 func newNetworkInterfacesClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*networkInterfacesClientImpl, error) {
 	c, err := network.NewInterfacesClient(subscriptionID, cred, nil)
 	if err != nil {
@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	resources "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+// privateDNSAPIVersion is the Microsoft.Network/privateDnsZones API version.
+// The private DNS SDK is not vendored, so records and links are managed as generic resources.
+const privateDNSAPIVersion = "2020-06-01"
+
+// PrivateAPIName returns the API server name that is recorded in the private DNS zone.
+func PrivateAPIName(cluster *kops.Cluster) string {
+	if cluster.Spec.API.PublicName != "" {
+		return cluster.Spec.API.PublicName
+	}
+	return "api." + cluster.Name
+}
+
+// PrivateDNSARecordID returns the resource ID of the A record set with the given name in a private DNS zone.
+func PrivateDNSARecordID(zoneID, name string) string {
+	return strings.TrimSuffix(zoneID, "/") + "/A/" + name
+}
+
+// PrivateDNSZoneLinkID returns the resource ID of the virtual network link with the given name in a private DNS zone.
+func PrivateDNSZoneLinkID(zoneID, name string) string {
+	return strings.TrimSuffix(zoneID, "/") + "/virtualNetworkLinks/" + name
+}
+
+// PrivateDNSZoneLinkName returns the name of the link of a private DNS zone to a virtual network, for the cluster.
+func PrivateDNSZoneLinkName(clusterName, virtualNetworkID string) string {
+	return clusterName + "-" + path.Base(virtualNetworkID)
+}
+
+// VirtualNetworkID returns the resource ID of a virtual network.
+func VirtualNetworkID(subscriptionID, resourceGroupName, virtualNetworkName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s",
+		subscriptionID,
+		resourceGroupName,
+		virtualNetworkName)
+}
+
+// PrivateAPIRecordName returns the name of the API server record, relative to the private DNS zone.
+func PrivateAPIRecordName(cluster *kops.Cluster, zoneID string) (string, error) {
+	zoneName := strings.ToLower(path.Base(zoneID))
+	apiName := strings.ToLower(strings.TrimSuffix(PrivateAPIName(cluster), "."))
+	if !strings.HasSuffix(apiName, "."+zoneName) {
+		return "", fmt.Errorf("API server name %q is not in the private DNS zone %q", apiName, zoneName)
+	}
+	return strings.TrimSuffix(apiName, "."+zoneName), nil
+}
+
+// PrivateAPIDNSResourceIDs returns the IDs of the record and the virtual network links that kOps
+// manages in the private DNS zone of the cluster.
+func PrivateAPIDNSResourceIDs(cluster *kops.Cluster) ([]string, error) {
+	azureSpec := cluster.Spec.CloudProvider.Azure
+	if azureSpec == nil || azureSpec.PrivateAPI == nil || azureSpec.PrivateAPI.PrivateDNSZoneID == "" {
+		return nil, nil
+	}
+	zoneID := azureSpec.PrivateAPI.PrivateDNSZoneID
+
+	recordName, err := PrivateAPIRecordName(cluster, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{PrivateDNSARecordID(zoneID, recordName)}
+
+	networkName := cluster.Spec.Networking.NetworkID
+	if networkName == "" {
+		networkName = cluster.Name
+	}
+	networkIDs := append([]string{VirtualNetworkID(azureSpec.SubscriptionID, cluster.AzureResourceGroupName(), networkName)}, azureSpec.PrivateAPI.LinkedVirtualNetworkIDs...)
+	for _, networkID := range networkIDs {
+		ids = append(ids, PrivateDNSZoneLinkID(zoneID, PrivateDNSZoneLinkName(cluster.Name, networkID)))
+	}
+	return ids, nil
+}
+
+// PrivateDNSARecord is an A record set in a private DNS zone.
+type PrivateDNSARecord struct {
+	ID            string
+	TTL           int64
+	IPv4Addresses []string
+	// Metadata holds the record set metadata; record sets do not support tags.
+	Metadata map[string]*string
+}
+
+// PrivateDNSZoneLink is a virtual network link of a private DNS zone.
+type PrivateDNSZoneLink struct {
+	ID               string
+	VirtualNetworkID string
+	Tags             map[string]*string
+}
+
+// PrivateDNSClient is a client for records and virtual network links in private DNS zones.
+type PrivateDNSClient interface {
+	// GetARecord returns the A record set with the given ID, or nil if it does not exist.
+	GetARecord(ctx context.Context, id string) (*PrivateDNSARecord, error)
+	CreateOrUpdateARecord(ctx context.Context, record *PrivateDNSARecord) error
+	// GetZoneLink returns the virtual network link with the given ID, or nil if it does not exist.
+	GetZoneLink(ctx context.Context, id string) (*PrivateDNSZoneLink, error)
+	CreateOrUpdateZoneLink(ctx context.Context, link *PrivateDNSZoneLink) error
+	// Delete deletes a record set or a virtual network link.
+	Delete(ctx context.Context, id string) error
+}
+
+type privateDNSARecordProperties struct {
+	TTL      int64              `json:"ttl"`
+	Metadata map[string]*string `json:"metadata,omitempty"`
+	ARecords []struct {
+		IPv4Address string `json:"ipv4Address"`
+	} `json:"aRecords"`
+}
+
+type privateDNSZoneLinkProperties struct {
+	VirtualNetwork struct {
+		ID string `json:"id"`
+	} `json:"virtualNetwork"`
+	RegistrationEnabled bool `json:"registrationEnabled"`
+}
+
+type privateDNSClientImpl struct {
+	c *resources.Client
+}
+
+var _ PrivateDNSClient = (*privateDNSClientImpl)(nil)
+
+func (c *privateDNSClientImpl) GetARecord(ctx context.Context, id string) (*PrivateDNSARecord, error) {
+	var props privateDNSARecordProperties
+	r, err := c.get(ctx, id, &props)
+	if err != nil || r == nil {
+		return nil, err
+	}
+	record := &PrivateDNSARecord{
+		ID:       fi.ValueOf(r.ID),
+		TTL:      props.TTL,
+		Metadata: props.Metadata,
+	}
+	for _, a := range props.ARecords {
+		record.IPv4Addresses = append(record.IPv4Addresses, a.IPv4Address)
+	}
+	return record, nil
+}
+
+func (c *privateDNSClientImpl) CreateOrUpdateARecord(ctx context.Context, record *PrivateDNSARecord) error {
+	props := privateDNSARecordProperties{
+		TTL:      record.TTL,
+		Metadata: record.Metadata,
+	}
+	for _, ip := range record.IPv4Addresses {
+		props.ARecords = append(props.ARecords, struct {
+			IPv4Address string `json:"ipv4Address"`
+		}{IPv4Address: ip})
+	}
+	return c.createOrUpdate(ctx, record.ID, resources.GenericResource{Properties: props})
+}
+
+func (c *privateDNSClientImpl) GetZoneLink(ctx context.Context, id string) (*PrivateDNSZoneLink, error) {
+	var props privateDNSZoneLinkProperties
+	r, err := c.get(ctx, id, &props)
+	if err != nil || r == nil {
+		return nil, err
+	}
+	return &PrivateDNSZoneLink{
+		ID:               fi.ValueOf(r.ID),
+		VirtualNetworkID: props.VirtualNetwork.ID,
+		Tags:             r.Tags,
+	}, nil
+}
+
+func (c *privateDNSClientImpl) CreateOrUpdateZoneLink(ctx context.Context, link *PrivateDNSZoneLink) error {
+	var props privateDNSZoneLinkProperties
+	props.VirtualNetwork.ID = link.VirtualNetworkID
+	return c.createOrUpdate(ctx, link.ID, resources.GenericResource{
+		// Private DNS zones and their links are global resources.
+		Location:   to.Ptr("global"),
+		Properties: props,
+		Tags:       link.Tags,
+	})
+}
+
+func (c *privateDNSClientImpl) Delete(ctx context.Context, id string) error {
+	future, err := c.c.BeginDeleteByID(ctx, id, privateDNSAPIVersion, nil)
+	if err != nil {
+		return fmt.Errorf("deleting private DNS resource %q: %w", id, err)
+	}
+	if _, err := future.PollUntilDone(ctx, nil); err != nil {
+		return fmt.Errorf("waiting for private DNS resource %q deletion completion: %w", id, err)
+	}
+	return nil
+}
+
+// get reads the resource with the given ID, decoding its properties into props.
+func (c *privateDNSClientImpl) get(ctx context.Context, id string, props any) (*resources.GenericResource, error) {
+	resp, err := c.c.GetByID(ctx, id, privateDNSAPIVersion, nil)
+	if err != nil {
+		var respErr *azcore.ResponseError
+		if errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("getting private DNS resource %q: %w", id, err)
+	}
+	// Generic resources carry their properties as untyped JSON.
+	b, err := json.Marshal(resp.Properties)
+	if err != nil {
+		return nil, fmt.Errorf("encoding properties of private DNS resource %q: %w", id, err)
+	}
+	if err := json.Unmarshal(b, props); err != nil {
+		return nil, fmt.Errorf("decoding properties of private DNS resource %q: %w", id, err)
+	}
+	return &resp.GenericResource, nil
+}
+
+func (c *privateDNSClientImpl) createOrUpdate(ctx context.Context, id string, parameters resources.GenericResource) error {
+	future, err := c.c.BeginCreateOrUpdateByID(ctx, id, privateDNSAPIVersion, parameters, nil)
+	if err != nil {
+		return fmt.Errorf("creating/updating private DNS resource %q: %w", id, err)
+	}
+	if _, err := future.PollUntilDone(ctx, nil); err != nil {
+		return fmt.Errorf("waiting for private DNS resource %q create/update completion: %w", id, err)
+	}
+	return nil
+}
+
+func newPrivateDNSClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*privateDNSClientImpl, error) {
+	c, err := resources.NewClient(subscriptionID, cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating resources client: %w", err)
+	}
+	return &privateDNSClientImpl{
+		c: c,
+	}, nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
+)
+
+// PrivateLinkServicesClient is a client for Private Link services.
+type PrivateLinkServicesClient interface {
+	CreateOrUpdate(ctx context.Context, resourceGroupName, serviceName string, parameters network.PrivateLinkService) (*network.PrivateLinkService, error)
+	List(ctx context.Context, resourceGroupName string) ([]*network.PrivateLinkService, error)
+	Delete(ctx context.Context, resourceGroupName, serviceName string) error
+}
+
+type privateLinkServicesClientImpl struct {
+	c *network.PrivateLinkServicesClient
+}
+
+var _ PrivateLinkServicesClient = (*privateLinkServicesClientImpl)(nil)
+
+func (c *privateLinkServicesClientImpl) CreateOrUpdate(ctx context.Context, resourceGroupName, serviceName string, parameters network.PrivateLinkService) (*network.PrivateLinkService, error) {
+	future, err := c.c.BeginCreateOrUpdate(ctx, resourceGroupName, serviceName, parameters, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating/updating private link service: %w", err)
+	}
+	resp, err := future.PollUntilDone(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for private link service create/update completion: %w", err)
+	}
+	return &resp.PrivateLinkService, nil
+}
+
+func (c *privateLinkServicesClientImpl) List(ctx context.Context, resourceGroupName string) ([]*network.PrivateLinkService, error) {
+	if resourceGroupName == "" {
+		return nil, nil
+	}
+
+	var l []*network.PrivateLinkService
+	pager := c.c.NewListPager(resourceGroupName, nil)
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) && respErr.ErrorCode == "ResourceGroupNotFound" {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("listing private link services: %w", err)
+		}
+		l = append(l, resp.Value...)
+	}
+	return l, nil
+}
+
+func (c *privateLinkServicesClientImpl) Delete(ctx context.Context, resourceGroupName, serviceName string) error {
+	future, err := c.c.BeginDelete(ctx, resourceGroupName, serviceName, nil)
+	if err != nil {
+		return fmt.Errorf("deleting private link service: %w", err)
+	}
+	if _, err := future.PollUntilDone(ctx, nil); err != nil {
+		return fmt.Errorf("waiting for private link service deletion completion: %w", err)
+	}
+	return nil
+}
+
+func newPrivateLinkServicesClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*privateLinkServicesClientImpl, error) {
+	c, err := network.NewPrivateLinkServicesClient(subscriptionID, cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating private link services client: %w", err)
+	}
+	return &privateLinkServicesClientImpl{
+		c: c,
+	}, nil
+}
@@ -31,9 +31,9 @@ import (
 )
 
 // FindClusterStatus discovers the status of the cluster by looking for the tagged etcd volume.
-func (c *azureCloudImplementation) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+func (c *azureCloudImplementation) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
 	klog.V(2).Infof("Listing Azure managed disks.")
-	disks, err := c.Disk().List(context.TODO(), cluster.AzureResourceGroupName())
+	disks, err := c.Disk().List(ctx, cluster.AzureResourceGroupName())
 	if err != nil {
 		return nil, fmt.Errorf("error listing disks: %s", err)
 	}
@@ -172,6 +172,13 @@ func (c *azureCloudImplementation) buildCloudInstanceGroup(
 		Raw:           vmss,
 	}
 
+	if isFlexible(vmss) {
+		if err := c.addFlexibleVMs(ctx, cluster, cg, vmss, nodeMap); err != nil {
+			return nil, err
+		}
+		return cg, nil
+	}
+
 	// Add members (VMs) to the Cloud Instance Group.
 	vms, err := c.vmscaleSetVMsClient.List(ctx, cluster.AzureResourceGroupName(), *vmss.Name)
 	if err != nil {
@@ -183,16 +190,88 @@ func (c *azureCloudImplementation) buildCloudInstanceGroup(
 		// TODO(kenji): Set the status properly so that kops can
 		// tell whether a VM is up-to-date or not.
 		status := cloudinstances.CloudInstanceStatusUpToDate
-		_, err := cg.NewCloudInstance(*vm.Name, status, nodeMap[*vm.Name])
+		cm, err := cg.NewCloudInstance(*vm.Name, status, nodeMap[*vm.Name])
 		if err != nil {
 			return nil, fmt.Errorf("error creating cloud instance group member: %s", err)
 		}
+		if isSpot(vmss) && isDeallocated(vm) {
+			cm.State = cloudinstances.Evicted
+		}
 		// TODO(kenji): Set addCloudInstanceData.
 	}
 
 	return cg, nil
 }
 
+// addFlexibleVMs adds the VMs of a VM Scale Set in Flexible orchestration mode to the Cloud Instance Group.
+// These are standalone VMs, so they are listed with the VMs client and identified by their names.
+func (c *azureCloudImplementation) addFlexibleVMs(
+	ctx context.Context,
+	cluster *kops.Cluster,
+	cg *cloudinstances.CloudInstanceGroup,
+	vmss *compute.VirtualMachineScaleSet,
+	nodeMap map[string]*v1.Node,
+) error {
+	vms, err := c.virtualMachinesClient.ListByVMScaleSet(ctx, cluster.AzureResourceGroupName(), *vmss.ID)
+	if err != nil {
+		return fmt.Errorf("error querying VM ScaleSet VMs: %s", err)
+	}
+	for _, vm := range vms {
+		status := cloudinstances.CloudInstanceStatusUpToDate
+		cm, err := cg.NewCloudInstance(*vm.Name, status, nodeMap[*vm.Name])
+		if err != nil {
+			return fmt.Errorf("error creating cloud instance group member: %s", err)
+		}
+		if isSpot(vmss) {
+			// Listing VMs doesn't return their instance view
+			vm, err = c.virtualMachinesClient.Get(ctx, cluster.AzureResourceGroupName(), *vm.Name)
+			if err != nil {
+				return fmt.Errorf("error querying VM %q: %s", cm.ID, err)
+			}
+			if vm.Properties != nil && vm.Properties.InstanceView != nil && hasDeallocatedStatus(vm.Properties.InstanceView.Statuses) {
+				cm.State = cloudinstances.Evicted
+			}
+		}
+	}
+	return nil
+}
+
+// isFlexible returns true if the VM Scale Set uses the Flexible orchestration mode.
+func isFlexible(vmss *compute.VirtualMachineScaleSet) bool {
+	if vmss.Properties == nil || vmss.Properties.OrchestrationMode == nil {
+		return false
+	}
+	return *vmss.Properties.OrchestrationMode == compute.OrchestrationModeFlexible
+}
+
+// isSpot returns true if the VMs of the VM Scale Set are Azure Spot VMs.
+func isSpot(vmss *compute.VirtualMachineScaleSet) bool {
+	if vmss.Properties == nil || vmss.Properties.VirtualMachineProfile == nil {
+		return false
+	}
+	priority := vmss.Properties.VirtualMachineProfile.Priority
+	return priority != nil && *priority == compute.VirtualMachinePriorityTypesSpot
+}
+
+// isDeallocated returns true if the VM is deallocated, which is what happens to Spot VMs
+// evicted with the Deallocate eviction policy.
+func isDeallocated(vm *compute.VirtualMachineScaleSetVM) bool {
+	if vm.Properties == nil || vm.Properties.InstanceView == nil {
+		return false
+	}
+	return hasDeallocatedStatus(vm.Properties.InstanceView.Statuses)
+}
+
+// hasDeallocatedStatus returns true if the instance view statuses contain the deallocated power state.
+func hasDeallocatedStatus(statuses []*compute.InstanceViewStatus) bool {
+	for _, status := range statuses {
+		if status.Code != nil && strings.EqualFold(*status.Code, "PowerState/deallocated") {
+			return true
+		}
+	}
+	return false
+}
+
 func isOwnedByCluster(vmss *compute.VirtualMachineScaleSet, clusterName string) bool {
 	for k, v := range vmss.Tags {
 		if k == TagClusterName && *v == clusterName {
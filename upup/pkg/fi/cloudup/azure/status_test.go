@@ -27,6 +27,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/cloudinstances"
 )
 
 type mockVMScaleSetsClient struct {
@@ -78,6 +79,36 @@ func (c *mockVMScaleSetVMsClient) Delete(ctx context.Context, resourceGroupName,
 	return nil
 }
 
+type mockVirtualMachinesClient struct {
+	vms []*compute.VirtualMachine
+}
+
+var _ VirtualMachinesClient = (*mockVirtualMachinesClient)(nil)
+
+func (c *mockVirtualMachinesClient) ListByVMScaleSet(ctx context.Context, resourceGroupName, vmssID string) ([]*compute.VirtualMachine, error) {
+	var l []*compute.VirtualMachine
+	for _, vm := range c.vms {
+		// The list response doesn't contain the instance view
+		l = append(l, &compute.VirtualMachine{
+			Name: vm.Name,
+		})
+	}
+	return l, nil
+}
+
+func (c *mockVirtualMachinesClient) Get(ctx context.Context, resourceGroupName, vmName string) (*compute.VirtualMachine, error) {
+	for _, vm := range c.vms {
+		if *vm.Name == vmName {
+			return vm, nil
+		}
+	}
+	return nil, fmt.Errorf("%s does not exist", vmName)
+}
+
+func (c *mockVirtualMachinesClient) Delete(ctx context.Context, resourceGroupName, vmName string) error {
+	return fmt.Errorf("unimplemented")
+}
+
 func TestFindEtcdStatus(t *testing.T) {
 	clusterName := "my-cluster"
 	c := &azureCloudImplementation{
@@ -317,3 +348,161 @@ func TestGetCloudGroups(t *testing.T) {
 		t.Fatalf("expected min size %d, but got %d", e, a)
 	}
 }
+
+func TestGetCloudGroupsFlexible(t *testing.T) {
+	const (
+		clusterName = "my-cluster"
+		nodeIG      = "nodes"
+		nodeVMSS    = "nodes.my-cluster"
+		nodeVM0     = "nodes.my-cluster_0a1b2c3d"
+		nodeVM1     = "nodes.my-cluster_4e5f6a7b"
+	)
+
+	vmssClient := &mockVMScaleSetsClient{}
+	vmssClient.vmsses = append(vmssClient.vmsses,
+		&compute.VirtualMachineScaleSet{
+			ID:   to.Ptr("/subscriptions/sub/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachineScaleSets/" + nodeVMSS),
+			Name: to.Ptr(nodeVMSS),
+			Tags: map[string]*string{
+				TagClusterName: to.Ptr(clusterName),
+			},
+			SKU: &compute.SKU{
+				Capacity: to.Ptr[int64](2),
+			},
+			Properties: &compute.VirtualMachineScaleSetProperties{
+				OrchestrationMode: to.Ptr(compute.OrchestrationModeFlexible),
+				VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
+					Priority: to.Ptr(compute.VirtualMachinePriorityTypesSpot),
+				},
+			},
+		},
+	)
+
+	vmClient := &mockVirtualMachinesClient{}
+	vmClient.vms = append(vmClient.vms,
+		&compute.VirtualMachine{
+			Name: to.Ptr(nodeVM0),
+			Properties: &compute.VirtualMachineProperties{
+				InstanceView: &compute.VirtualMachineInstanceView{
+					Statuses: []*compute.InstanceViewStatus{
+						{Code: to.Ptr("PowerState/running")},
+					},
+				},
+			},
+		},
+		&compute.VirtualMachine{
+			Name: to.Ptr(nodeVM1),
+			Properties: &compute.VirtualMachineProperties{
+				InstanceView: &compute.VirtualMachineInstanceView{
+					Statuses: []*compute.InstanceViewStatus{
+						{Code: to.Ptr("PowerState/deallocated")},
+					},
+				},
+			},
+		},
+	)
+
+	c := &azureCloudImplementation{
+		tags: map[string]string{
+			TagClusterName: clusterName,
+		},
+		vmscaleSetsClient:     vmssClient,
+		vmscaleSetVMsClient:   &mockVMScaleSetVMsClient{},
+		virtualMachinesClient: vmClient,
+	}
+
+	cluster := &kops.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				Azure: &kops.AzureSpec{
+					ResourceGroupName: "my-rg",
+				},
+			},
+		},
+	}
+	instancegroups := []*kops.InstanceGroup{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: nodeIG,
+			},
+			Spec: kops.InstanceGroupSpec{
+				Role: kops.InstanceGroupRoleNode,
+			},
+		},
+	}
+
+	groups, err := c.GetCloudGroups(cluster, instancegroups, false /* warnUnmatched */, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	group := groups[nodeIG]
+	if group == nil {
+		t.Fatalf("expected group %s", nodeIG)
+	}
+	if a, e := len(group.Ready), 2; a != e {
+		t.Fatalf("expected %d instance(s), but found %d", e, a)
+	}
+	states := map[string]cloudinstances.State{}
+	for _, i := range group.Ready {
+		states[i.ID] = i.State
+	}
+	expected := map[string]cloudinstances.State{
+		nodeVM0: "",
+		nodeVM1: cloudinstances.Evicted,
+	}
+	if !reflect.DeepEqual(states, expected) {
+		t.Errorf("expected %+v, but got %+v", expected, states)
+	}
+}
+
+func TestIsDeallocated(t *testing.T) {
+	testCases := []struct {
+		name     string
+		vm       *compute.VirtualMachineScaleSetVM
+		expected bool
+	}{
+		{
+			name:     "no instance view",
+			vm:       &compute.VirtualMachineScaleSetVM{},
+			expected: false,
+		},
+		{
+			name: "running",
+			vm: &compute.VirtualMachineScaleSetVM{
+				Properties: &compute.VirtualMachineScaleSetVMProperties{
+					InstanceView: &compute.VirtualMachineScaleSetVMInstanceView{
+						Statuses: []*compute.InstanceViewStatus{
+							{Code: to.Ptr("ProvisioningState/succeeded")},
+							{Code: to.Ptr("PowerState/running")},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "deallocated",
+			vm: &compute.VirtualMachineScaleSetVM{
+				Properties: &compute.VirtualMachineScaleSetVMProperties{
+					InstanceView: &compute.VirtualMachineScaleSetVMInstanceView{
+						Statuses: []*compute.InstanceViewStatus{
+							{Code: to.Ptr("ProvisioningState/succeeded")},
+							{Code: to.Ptr("PowerState/deallocated")},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if a, e := isDeallocated(tc.vm), tc.expected; a != e {
+				t.Errorf("expected %t, but got %t", e, a)
+			}
+		})
+	}
+}
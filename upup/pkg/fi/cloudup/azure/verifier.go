@@ -98,6 +98,27 @@ func vmLogIDFromResource(res *arm.ResourceID) string {
 	}
 }
 
+// primaryNetworkInterfaceName returns the name of the primary network interface of the VM.
+// The network interfaces of VMs created by VM Scale Sets in Flexible orchestration mode are not named after the VMs,
+// so the name is taken from the network profile, falling back to defaultName.
+func primaryNetworkInterfaceName(vm *compute.VirtualMachine, defaultName string) string {
+	if vm.Properties == nil || vm.Properties.NetworkProfile == nil {
+		return defaultName
+	}
+	nis := vm.Properties.NetworkProfile.NetworkInterfaces
+	for _, ni := range nis {
+		if ni.ID == nil {
+			continue
+		}
+		if len(nis) == 1 || (ni.Properties != nil && ni.Properties.Primary != nil && *ni.Properties.Primary) {
+			if res, err := arm.ParseResourceID(*ni.ID); err == nil {
+				return res.Name
+			}
+		}
+	}
+	return defaultName
+}
+
 // VerifyToken validates the Azure attestation token, confirms the claimed VM through the Azure API,
 // and returns the node bootstrap identity.
 func (a azureVerifier) VerifyToken(ctx context.Context, rawRequest *http.Request, token string, body []byte) (*bootstrap.VerifyResult, error) {
@@ -175,9 +196,10 @@ func (a azureVerifier) VerifyToken(ctx context.Context, rawRequest *http.Request
 		}
 
 		// Collect private IP addresses from the VM's network interface.
-		ni, err := a.client.nisClient.Get(ctx, a.client.resourceGroup, nodeName, nil)
+		niName := primaryNetworkInterfaceName(&vm.VirtualMachine, nodeName)
+		ni, err := a.client.nisClient.Get(ctx, a.client.resourceGroup, niName, nil)
 		if err != nil {
-			return nil, fmt.Errorf("getting info for %s network interface %q: %w", desc, nodeName, err)
+			return nil, fmt.Errorf("getting info for %s network interface %q: %w", desc, niName, err)
 		}
 		addrs, challengeEndpoints, err = privateIPEndpoints(ni.Interface, desc)
 		if err != nil {
@@ -303,6 +325,8 @@ func newVerifierClient(ctx context.Context) (*client, error) {
 	}
 	klog.V(4).Infof("Azure verifier client using subscription %q resource group %q", metadata.SubscriptionID, metadata.ResourceGroupName)
 
+	// Without a client ID, IMDS issues tokens for the system-assigned identity, which holds the roles granted by kOps,
+	// even when the instance group also attaches user-assigned identities.
 	cred, err := azidentity.NewDefaultAzureCredential(nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating an identity: %w", err)
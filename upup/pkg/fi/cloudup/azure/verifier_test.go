@@ -27,6 +27,8 @@ import (
 	"k8s.io/kops/upup/pkg/fi/cloudup/azure/azuremetadata"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
 	"k8s.io/kops/pkg/bootstrap"
 )
 
@@ -66,6 +68,59 @@ func TestVMLogIDFromResource(t *testing.T) {
 	}
 }
 
+func TestPrimaryNetworkInterfaceName(t *testing.T) {
+	const nicPrefix = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/networkInterfaces/"
+	testCases := []struct {
+		name string
+		vm   *compute.VirtualMachine
+		want string
+	}{
+		{
+			name: "no network profile",
+			vm:   &compute.VirtualMachine{},
+			want: "vm-1",
+		},
+		{
+			name: "single network interface",
+			vm: &compute.VirtualMachine{
+				Properties: &compute.VirtualMachineProperties{
+					NetworkProfile: &compute.NetworkProfile{
+						NetworkInterfaces: []*compute.NetworkInterfaceReference{
+							{ID: to.Ptr(nicPrefix + "nodes_1a2b3c4d-nic")},
+						},
+					},
+				},
+			},
+			want: "nodes_1a2b3c4d-nic",
+		},
+		{
+			name: "primary network interface",
+			vm: &compute.VirtualMachine{
+				Properties: &compute.VirtualMachineProperties{
+					NetworkProfile: &compute.NetworkProfile{
+						NetworkInterfaces: []*compute.NetworkInterfaceReference{
+							{ID: to.Ptr(nicPrefix + "secondary")},
+							{
+								ID:         to.Ptr(nicPrefix + "primary"),
+								Properties: &compute.NetworkInterfaceReferenceProperties{Primary: to.Ptr(true)},
+							},
+						},
+					},
+				},
+			},
+			want: "primary",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := primaryNetworkInterfaceName(tc.vm, "vm-1"); got != tc.want {
+				t.Fatalf("primaryNetworkInterfaceName() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
 // TestVerifyToken covers the early rejection paths: wrong prefix (different cloud verifier),
 // malformed two-part payload, mismatched subscription/RG, and unparseable PKCS7.
 func TestVerifyToken(t *testing.T) {
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
 )
@@ -38,7 +39,11 @@ var _ VMScaleSetVMsClient = (*vmScaleSetVMsClientImpl)(nil)
 
 func (c *vmScaleSetVMsClientImpl) List(ctx context.Context, resourceGroupName, vmssName string) ([]*compute.VirtualMachineScaleSetVM, error) {
 	var l []*compute.VirtualMachineScaleSetVM
-	pager := c.c.NewListPager(resourceGroupName, vmssName, nil)
+	// The instance view contains the power state of the VMs, which tells whether Spot VMs were evicted
+	opts := &compute.VirtualMachineScaleSetVMsClientListOptions{
+		Expand: to.Ptr("instanceView"),
+	}
+	pager := c.c.NewListPager(resourceGroupName, vmssName, opts)
 	for pager.More() {
 		resp, err := pager.NextPage(ctx)
 		if err != nil {
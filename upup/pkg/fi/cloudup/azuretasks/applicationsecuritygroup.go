@@ -51,7 +51,7 @@ func (asg *ApplicationSecurityGroup) CompareWithID() *string {
 // Find discovers the Application Security Group in the cloud provider
 func (asg *ApplicationSecurityGroup) Find(c *fi.CloudupContext) (*ApplicationSecurityGroup, error) {
 	cloud := c.T.Cloud.(azure.AzureCloud)
-	l, err := cloud.ApplicationSecurityGroup().List(context.TODO(), *asg.ResourceGroup.Name)
+	l, err := cloud.ApplicationSecurityGroup().List(c.Context(), *asg.ResourceGroup.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +53,7 @@ func (d *Disk) CompareWithID() *string {
 // Find discovers the Disk in the cloud provider.
 func (d *Disk) Find(c *fi.CloudupContext) (*Disk, error) {
 	cloud := c.T.Cloud.(azure.AzureCloud)
-	l, err := cloud.Disk().List(context.TODO(), *d.ResourceGroup.Name)
+	l, err := cloud.Disk().List(c.Context(), *d.ResourceGroup.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +88,13 @@ type LoadBalancer struct {
 
 	// PublicIPAddress is the public IP address for external load balancers.
 	PublicIPAddress *PublicIPAddress
+	// PrivateIPAddress is the frontend address of internal load balancers.
+	// It is output-only, populated by Find and RenderAzure.
+	PrivateIPAddress *string
+	// Zones are the availability zones of the frontend of internal load balancers.
+	// The frontend of external load balancers is in the zones of their public IP address.
+	// They can only be set when the load balancer is created.
+	Zones []*string
 
 	Tags map[string]*string
 
@@ -125,7 +132,7 @@ func (lb *LoadBalancer) GetWellKnownServices() []wellknownservices.WellKnownServ
 
 func (lb *LoadBalancer) FindAddresses(c *fi.CloudupContext) ([]string, error) {
 	cloud := c.T.Cloud.(azure.AzureCloud)
-	loadbalancer, err := cloud.LoadBalancer().Get(context.TODO(), *lb.ResourceGroup.Name, *lb.Name)
+	loadbalancer, err := cloud.LoadBalancer().Get(c.Context(), *lb.ResourceGroup.Name, *lb.Name)
 	if err != nil && !strings.Contains(err.Error(), "NotFound") {
 		return nil, err
 	}
@@ -152,7 +159,7 @@ func (lb *LoadBalancer) FindAddresses(c *fi.CloudupContext) ([]string, error) {
 // Find discovers the LoadBalancer in the cloud provider
 func (lb *LoadBalancer) Find(c *fi.CloudupContext) (*LoadBalancer, error) {
 	cloud := c.T.Cloud.(azure.AzureCloud)
-	l, err := cloud.LoadBalancer().List(context.TODO(), *lb.ResourceGroup.Name)
+	l, err := cloud.LoadBalancer().List(c.Context(), *lb.ResourceGroup.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -189,7 +196,9 @@ func (lb *LoadBalancer) Find(c *fi.CloudupContext) (*LoadBalancer, error) {
 		},
 		External: to.Ptr(feConfig.Properties.PublicIPAddress != nil),
 		Tags:     found.Tags,
+		Zones:    feConfig.Zones,
 	}
+	lb.Zones = keepZones("load balancer frontend", *lb.Name, feConfig.Zones, lb.Zones)
 	if found.SKU != nil {
 		actual.SKU = fi.ValueOf(found.SKU.Name)
 	}
@@ -203,6 +212,9 @@ func (lb *LoadBalancer) Find(c *fi.CloudupContext) (*LoadBalancer, error) {
 			ID: feConfig.Properties.PublicIPAddress.ID,
 		}
 	}
+	actual.PrivateIPAddress = feConfig.Properties.PrivateIPAddress
+	// Propagate to the expected task so that dependent tasks can use the address.
+	lb.PrivateIPAddress = feConfig.Properties.PrivateIPAddress
 
 	for _, probe := range lbProperties.Probes {
 		if probe.Properties == nil {
@@ -302,6 +314,7 @@ func (*LoadBalancer) RenderAzure(t *azure.AzureAPITarget, a, e, changes *LoadBal
 				{
 					Name:       to.Ptr("LoadBalancerFrontEnd"),
 					Properties: feConfigProperties,
+					Zones:      e.Zones,
 				},
 			},
 			BackendAddressPools: []*network.BackendAddressPool{
@@ -352,11 +365,22 @@ func (*LoadBalancer) RenderAzure(t *azure.AzureAPITarget, a, e, changes *LoadBal
 		})
 	}
 
-	_, err := t.Cloud.LoadBalancer().CreateOrUpdate(
+	result, err := t.Cloud.LoadBalancer().CreateOrUpdate(
 		context.TODO(),
 		*e.ResourceGroup.Name,
 		*e.Name,
 		lb)
+	if err != nil {
+		return err
+	}
 
-	return err
+	if result != nil && result.Properties != nil {
+		for _, fipc := range result.Properties.FrontendIPConfigurations {
+			if fipc.Properties != nil && fipc.Properties.PrivateIPAddress != nil {
+				e.PrivateIPAddress = fipc.Properties.PrivateIPAddress
+			}
+		}
+	}
+
+	return nil
 }
@@ -35,6 +35,7 @@ type terraformAzureLoadBalancerFrontendIPConfiguration struct {
 	PublicIPAddressID         *terraformWriter.Literal `cty:"public_ip_address_id"`
 	PrivateIPAllocationMethod *string                  `cty:"private_ip_address_allocation"`
 	SubnetID                  *terraformWriter.Literal `cty:"subnet_id"`
+	Zones                     []string                 `cty:"zones"`
 }
 
 type terraformAzureLoadBalancer struct {
@@ -98,6 +99,7 @@ func (*LoadBalancer) RenderTerraform(t *terraform.TerraformTarget, a, e, changes
 			return err
 		}
 		frontend.SubnetID = subnetID
+		frontend.Zones = stringSlice(e.Zones)
 	}
 	tf.FrontendIPConfiguration = []*terraformAzureLoadBalancerFrontendIPConfiguration{frontend}
 
@@ -160,3 +162,11 @@ func (lb *LoadBalancer) terraformID() *terraformWriter.Literal {
 func (lb *LoadBalancer) terraformBackendAddressPoolID() *terraformWriter.Literal {
 	return terraformWriter.LiteralProperty("azurerm_lb_backend_address_pool", fmt.Sprintf("%s-backend-pool", fi.ValueOf(lb.Name)), "id")
 }
+
+func (lb *LoadBalancer) terraformFrontendIPConfigurationID() *terraformWriter.Literal {
+	return terraformWriter.LiteralProperty("azurerm_lb", fi.ValueOf(lb.Name), "frontend_ip_configuration[0].id")
+}
+
+func (lb *LoadBalancer) terraformPrivateIPAddress() *terraformWriter.Literal {
+	return terraformWriter.LiteralProperty("azurerm_lb", fi.ValueOf(lb.Name), "private_ip_address")
+}
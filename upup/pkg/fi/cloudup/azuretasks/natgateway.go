@@ -56,7 +56,7 @@ func (ngw *NatGateway) CompareWithID() *string {
 // Find discovers the Nat Gateway in the cloud provider
 func (ngw *NatGateway) Find(c *fi.CloudupContext) (*NatGateway, error) {
 	cloud := c.T.Cloud.(azure.AzureCloud)
-	l, err := cloud.NatGateway().List(context.TODO(), *ngw.ResourceGroup.Name)
+	l, err := cloud.NatGateway().List(c.Context(), *ngw.ResourceGroup.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +60,7 @@ func (nsg *NetworkSecurityGroup) CompareWithID() *string {
 // Find discovers the Network Security Group in the cloud provider
 func (nsg *NetworkSecurityGroup) Find(c *fi.CloudupContext) (*NetworkSecurityGroup, error) {
 	cloud := c.T.Cloud.(azure.AzureCloud)
-	l, err := cloud.NetworkSecurityGroup().List(context.TODO(), *nsg.ResourceGroup.Name)
+	l, err := cloud.NetworkSecurityGroup().List(c.Context(), *nsg.ResourceGroup.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuretasks
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
+)
+
+const testPrivateDNSZoneID = "/subscriptions/sub/resourceGroups/dns/providers/Microsoft.Network/privateDnsZones/example.com"
+
+func newTestPrivateDNSRecord() *PrivateDNSRecord {
+	return &PrivateDNSRecord{
+		Name:      to.Ptr("api.cluster"),
+		Lifecycle: fi.LifecycleSync,
+		ZoneID:    to.Ptr(testPrivateDNSZoneID),
+		TTL:       to.Ptr(int64(60)),
+		LoadBalancer: &LoadBalancer{
+			Name:             to.Ptr("api"),
+			PrivateIPAddress: to.Ptr("10.0.0.4"),
+		},
+		Tags: map[string]*string{
+			testTagKey: to.Ptr(testTagValue),
+		},
+	}
+}
+
+func TestPrivateDNSRecordRenderAzure(t *testing.T) {
+	cloud := NewMockAzureCloud("eastus")
+	apiTarget := azure.NewAzureAPITarget(cloud)
+	c, err := fi.NewCloudupContext(context.Background(), fi.DeletionProcessingModeDeleteIncludingDeferred, apiTarget, nil, cloud, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := newTestPrivateDNSRecord()
+	if err := (&PrivateDNSRecord{}).RenderAzure(c, apiTarget, nil, expected, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	actual := cloud.PrivateDNSClient.Records[testPrivateDNSZoneID+"/A/api.cluster"]
+	if actual == nil {
+		t.Fatalf("record was not created: %v", cloud.PrivateDNSClient.Records)
+	}
+	if a, e := actual.IPv4Addresses, []string{"10.0.0.4"}; !reflect.DeepEqual(a, e) {
+		t.Errorf("unexpected addresses: expected %v, but got %v", e, a)
+	}
+
+	expected.LoadBalancer.PrivateIPAddress = nil
+	if err := (&PrivateDNSRecord{}).RenderAzure(c, apiTarget, nil, expected, nil); err == nil {
+		t.Errorf("expected error when the load balancer has no address")
+	}
+}
+
+func TestPrivateDNSRecordFind(t *testing.T) {
+	cloud := NewMockAzureCloud("eastus")
+	ctx := &fi.CloudupContext{
+		T: fi.CloudupSubContext{
+			Cloud: cloud,
+		},
+	}
+
+	record := newTestPrivateDNSRecord()
+	actual, err := record.Find(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if actual != nil {
+		t.Errorf("unexpected record found: %+v", actual)
+	}
+
+	cloud.PrivateDNSClient.Records[testPrivateDNSZoneID+"/A/api.cluster"] = &azure.PrivateDNSARecord{
+		ID:            testPrivateDNSZoneID + "/A/api.cluster",
+		TTL:           60,
+		IPv4Addresses: []string{"10.0.0.4"},
+	}
+	actual, err = record.Find(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if actual.LoadBalancer != record.LoadBalancer {
+		t.Errorf("expected record pointing at the load balancer to be up to date")
+	}
+
+	// A record pointing at another address must be updated.
+	cloud.PrivateDNSClient.Records[testPrivateDNSZoneID+"/A/api.cluster"].IPv4Addresses = []string{"10.0.0.5"}
+	actual, err = record.Find(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if actual.LoadBalancer != nil {
+		t.Errorf("expected stale record to be updated")
+	}
+}
+
+func TestPrivateDNSZoneLinkRun(t *testing.T) {
+	cloud := NewMockAzureCloud("eastus")
+	ctx := &fi.CloudupContext{
+		T: fi.CloudupSubContext{
+			Cloud: cloud,
+		},
+		Target: azure.NewAzureAPITarget(cloud),
+	}
+
+	networkID := "/subscriptions/sub/resourceGroups/hub/providers/Microsoft.Network/virtualNetworks/jump"
+	link := &PrivateDNSZoneLink{
+		Name:             to.Ptr("cluster-jump"),
+		Lifecycle:        fi.LifecycleSync,
+		ZoneID:           to.Ptr(testPrivateDNSZoneID),
+		VirtualNetworkID: to.Ptr(networkID),
+		Tags:             map[string]*string{},
+	}
+	if err := link.Normalize(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := link.Run(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	actual := cloud.PrivateDNSClient.Links[testPrivateDNSZoneID+"/virtualNetworkLinks/cluster-jump"]
+	if actual == nil {
+		t.Fatalf("link was not created: %v", cloud.PrivateDNSClient.Links)
+	}
+	if actual.VirtualNetworkID != networkID {
+		t.Errorf("unexpected virtual network: expected %s, but got %s", networkID, actual.VirtualNetworkID)
+	}
+	if a, e := actual.Tags[azure.TagClusterName], testClusterName; fi.ValueOf(a) != e {
+		t.Errorf("unexpected cluster tag: expected %s, but got %v", e, a)
+	}
+
+	// The virtual network ID is matched case-insensitively.
+	actual.VirtualNetworkID = "/subscriptions/sub/resourcegroups/hub/providers/Microsoft.Network/virtualNetworks/jump"
+	found, err := link.Find(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if fi.ValueOf(found.VirtualNetworkID) != networkID {
+		t.Errorf("expected virtual network ID %s, but got %s", networkID, fi.ValueOf(found.VirtualNetworkID))
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuretasks
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
+)
+
+// PrivateDNSRecord is an A record in an existing Azure private DNS zone, pointing at an internal load balancer.
+// +kops:fitask
+type PrivateDNSRecord struct {
+	// Name is the name of the record, relative to the zone.
+	Name      *string
+	Lifecycle fi.Lifecycle
+
+	// ZoneID is the resource ID of the private DNS zone.
+	ZoneID *string
+	TTL    *int64
+	// LoadBalancer is the internal load balancer whose frontend address is recorded.
+	LoadBalancer *LoadBalancer
+
+	// Tags are stored as the metadata of the record set, as record sets do not support tags.
+	Tags map[string]*string
+}
+
+var (
+	_ fi.CloudupTask          = &PrivateDNSRecord{}
+	_ fi.CompareWithID        = &PrivateDNSRecord{}
+	_ fi.CloudupTaskNormalize = &PrivateDNSRecord{}
+)
+
+// CompareWithID returns the Name of the record.
+func (r *PrivateDNSRecord) CompareWithID() *string {
+	return r.Name
+}
+
+// Find discovers the record in the private DNS zone.
+func (r *PrivateDNSRecord) Find(c *fi.CloudupContext) (*PrivateDNSRecord, error) {
+	cloud := c.T.Cloud.(azure.AzureCloud)
+	found, err := cloud.PrivateDNS().GetARecord(c.Context(), azure.PrivateDNSARecordID(*r.ZoneID, *r.Name))
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, nil
+	}
+
+	actual := &PrivateDNSRecord{
+		Name:      r.Name,
+		Lifecycle: r.Lifecycle,
+		ZoneID:    r.ZoneID,
+		TTL:       new(found.TTL),
+		Tags:      found.Metadata,
+	}
+	// The record is up to date when it holds exactly the address of the load balancer.
+	if r.LoadBalancer != nil && r.LoadBalancer.PrivateIPAddress != nil && reflect.DeepEqual(found.IPv4Addresses, []string{*r.LoadBalancer.PrivateIPAddress}) {
+		actual.LoadBalancer = r.LoadBalancer
+	}
+	return actual, nil
+}
+
+func (r *PrivateDNSRecord) Normalize(c *fi.CloudupContext) error {
+	c.T.Cloud.(azure.AzureCloud).AddClusterTags(r.Tags)
+	return nil
+}
+
+// Run implements fi.Task.Run.
+func (r *PrivateDNSRecord) Run(c *fi.CloudupContext) error {
+	return fi.CloudupDefaultDeltaRunMethod(r, c)
+}
+
+// CheckChanges returns an error if a change is not allowed.
+func (*PrivateDNSRecord) CheckChanges(a, e, changes *PrivateDNSRecord) error {
+	if a == nil {
+		// Check if required fields are set when a new resource is created.
+		if e.Name == nil {
+			return fi.RequiredField("Name")
+		}
+		if e.ZoneID == nil {
+			return fi.RequiredField("ZoneID")
+		}
+		return nil
+	}
+
+	// Check if unchangeable fields won't be changed.
+	if changes.Name != nil {
+		return fi.CannotChangeField("Name")
+	}
+	if changes.ZoneID != nil {
+		return fi.CannotChangeField("ZoneID")
+	}
+	return nil
+}
+
+// RenderAzure creates or updates the record.
+func (*PrivateDNSRecord) RenderAzure(c *fi.CloudupContext, t *azure.AzureAPITarget, a, e, changes *PrivateDNSRecord) error {
+	ctx := c.Context()
+	if a == nil {
+		klog.Infof("Creating a new private DNS record with name: %s", fi.ValueOf(e.Name))
+	} else {
+		klog.Infof("Updating a private DNS record with name: %s", fi.ValueOf(e.Name))
+	}
+
+	if e.LoadBalancer == nil || e.LoadBalancer.PrivateIPAddress == nil {
+		return fmt.Errorf("load balancer for private DNS record %q has no private address yet", fi.ValueOf(e.Name))
+	}
+
+	return t.Cloud.PrivateDNS().CreateOrUpdateARecord(ctx, &azure.PrivateDNSARecord{
+		ID:            azure.PrivateDNSARecordID(*e.ZoneID, *e.Name),
+		TTL:           fi.ValueOf(e.TTL),
+		IPv4Addresses: []string{*e.LoadBalancer.PrivateIPAddress},
+		Metadata:      e.Tags,
+	})
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuretasks
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+)
+
+type terraformAzurePrivateDNSARecord struct {
+	Name              *string                    `cty:"name"`
+	ZoneName          *string                    `cty:"zone_name"`
+	ResourceGroupName *string                    `cty:"resource_group_name"`
+	TTL               *int64                     `cty:"ttl"`
+	Records           []*terraformWriter.Literal `cty:"records"`
+	Tags              map[string]string          `cty:"tags"`
+}
+
+func (*PrivateDNSRecord) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *PrivateDNSRecord) error {
+	zone, err := arm.ParseResourceID(fi.ValueOf(e.ZoneID))
+	if err != nil {
+		return fmt.Errorf("parsing private DNS zone ID %q: %w", fi.ValueOf(e.ZoneID), err)
+	}
+
+	tf := &terraformAzurePrivateDNSARecord{
+		Name:              e.Name,
+		ZoneName:          new(zone.Name),
+		ResourceGroupName: new(zone.ResourceGroupName),
+		TTL:               e.TTL,
+		Records:           []*terraformWriter.Literal{e.LoadBalancer.terraformPrivateIPAddress()},
+		Tags:              stringMap(e.Tags),
+	}
+	return t.RenderResource("azurerm_private_dns_a_record", fi.ValueOf(e.Name), tf)
+}
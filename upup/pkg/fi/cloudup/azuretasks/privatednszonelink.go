@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuretasks
+
+import (
+	"strings"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
+)
+
+// PrivateDNSZoneLink links an existing Azure private DNS zone to a virtual network.
+// +kops:fitask
+type PrivateDNSZoneLink struct {
+	// Name is the name of the link in the zone.
+	Name      *string
+	Lifecycle fi.Lifecycle
+
+	// ZoneID is the resource ID of the private DNS zone.
+	ZoneID *string
+	// VirtualNetworkID is the resource ID of the linked virtual network.
+	VirtualNetworkID *string
+	// VirtualNetwork is set when the linked network is the cluster virtual network,
+	// so that the link is created after the network.
+	VirtualNetwork *VirtualNetwork
+
+	Tags map[string]*string
+}
+
+var (
+	_ fi.CloudupTask          = &PrivateDNSZoneLink{}
+	_ fi.CompareWithID        = &PrivateDNSZoneLink{}
+	_ fi.CloudupTaskNormalize = &PrivateDNSZoneLink{}
+)
+
+// CompareWithID returns the Name of the link.
+func (l *PrivateDNSZoneLink) CompareWithID() *string {
+	return l.Name
+}
+
+// Find discovers the link in the private DNS zone.
+func (l *PrivateDNSZoneLink) Find(c *fi.CloudupContext) (*PrivateDNSZoneLink, error) {
+	cloud := c.T.Cloud.(azure.AzureCloud)
+	found, err := cloud.PrivateDNS().GetZoneLink(c.Context(), azure.PrivateDNSZoneLinkID(*l.ZoneID, *l.Name))
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, nil
+	}
+
+	actual := &PrivateDNSZoneLink{
+		Name:             l.Name,
+		Lifecycle:        l.Lifecycle,
+		ZoneID:           l.ZoneID,
+		VirtualNetworkID: &found.VirtualNetworkID,
+		VirtualNetwork:   l.VirtualNetwork,
+		Tags:             found.Tags,
+	}
+	// Resource IDs are case-insensitive.
+	if strings.EqualFold(found.VirtualNetworkID, fi.ValueOf(l.VirtualNetworkID)) {
+		actual.VirtualNetworkID = l.VirtualNetworkID
+	}
+	return actual, nil
+}
+
+func (l *PrivateDNSZoneLink) Normalize(c *fi.CloudupContext) error {
+	c.T.Cloud.(azure.AzureCloud).AddClusterTags(l.Tags)
+	return nil
+}
+
+// Run implements fi.Task.Run.
+func (l *PrivateDNSZoneLink) Run(c *fi.CloudupContext) error {
+	return fi.CloudupDefaultDeltaRunMethod(l, c)
+}
+
+// CheckChanges returns an error if a change is not allowed.
+func (*PrivateDNSZoneLink) CheckChanges(a, e, changes *PrivateDNSZoneLink) error {
+	if a == nil {
+		// Check if required fields are set when a new resource is created.
+		if e.Name == nil {
+			return fi.RequiredField("Name")
+		}
+		if e.ZoneID == nil {
+			return fi.RequiredField("ZoneID")
+		}
+		if e.VirtualNetworkID == nil {
+			return fi.RequiredField("VirtualNetworkID")
+		}
+		return nil
+	}
+
+	// Check if unchangeable fields won't be changed.
+	if changes.Name != nil {
+		return fi.CannotChangeField("Name")
+	}
+	if changes.ZoneID != nil {
+		return fi.CannotChangeField("ZoneID")
+	}
+	if changes.VirtualNetworkID != nil {
+		return fi.CannotChangeField("VirtualNetworkID")
+	}
+	return nil
+}
+
+// RenderAzure creates or updates the link.
+func (*PrivateDNSZoneLink) RenderAzure(c *fi.CloudupContext, t *azure.AzureAPITarget, a, e, changes *PrivateDNSZoneLink) error {
+	ctx := c.Context()
+	if a == nil {
+		klog.Infof("Creating a new private DNS zone link with name: %s", fi.ValueOf(e.Name))
+	} else {
+		klog.Infof("Updating a private DNS zone link with name: %s", fi.ValueOf(e.Name))
+	}
+
+	return t.Cloud.PrivateDNS().CreateOrUpdateZoneLink(ctx, &azure.PrivateDNSZoneLink{
+		ID:               azure.PrivateDNSZoneLinkID(*e.ZoneID, *e.Name),
+		VirtualNetworkID: *e.VirtualNetworkID,
+		Tags:             e.Tags,
+	})
+}
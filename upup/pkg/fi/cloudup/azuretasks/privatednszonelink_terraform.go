@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuretasks
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+)
+
+type terraformAzurePrivateDNSZoneVirtualNetworkLink struct {
+	Name               *string                  `cty:"name"`
+	ResourceGroupName  *string                  `cty:"resource_group_name"`
+	PrivateDNSZoneName *string                  `cty:"private_dns_zone_name"`
+	VirtualNetworkID   *terraformWriter.Literal `cty:"virtual_network_id"`
+	Tags               map[string]string        `cty:"tags"`
+}
+
+func (*PrivateDNSZoneLink) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *PrivateDNSZoneLink) error {
+	zone, err := arm.ParseResourceID(fi.ValueOf(e.ZoneID))
+	if err != nil {
+		return fmt.Errorf("parsing private DNS zone ID %q: %w", fi.ValueOf(e.ZoneID), err)
+	}
+
+	tf := &terraformAzurePrivateDNSZoneVirtualNetworkLink{
+		Name:               e.Name,
+		ResourceGroupName:  new(zone.ResourceGroupName),
+		PrivateDNSZoneName: new(zone.Name),
+		VirtualNetworkID:   terraformWriter.LiteralFromStringValue(fi.ValueOf(e.VirtualNetworkID)),
+		Tags:               stringMap(e.Tags),
+	}
+	if e.VirtualNetwork != nil && !fi.ValueOf(e.VirtualNetwork.Shared) {
+		tf.VirtualNetworkID = terraformWriter.LiteralProperty("azurerm_virtual_network", fi.ValueOf(e.VirtualNetwork.Name), "id")
+	}
+	return t.RenderResource("azurerm_private_dns_zone_virtual_network_link", fi.ValueOf(e.Name), tf)
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuretasks
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
+)
+
+// PrivateLinkService is an Azure Private Link service in front of an internal load balancer.
+// +kops:fitask
+type PrivateLinkService struct {
+	Name      *string
+	Lifecycle fi.Lifecycle
+
+	ID            *string
+	ResourceGroup *ResourceGroup
+	// LoadBalancer is the internal load balancer exposed by the service.
+	LoadBalancer *LoadBalancer
+	// Subnet is the subnet of the NAT addresses of the service.
+	Subnet *Subnet
+
+	// AllowedSubscriptionIDs are the subscriptions that can find the service and whose
+	// private endpoint connections are approved automatically.
+	AllowedSubscriptionIDs []*string
+
+	// Alias is the globally unique name of the service, used to create private endpoints.
+	// It is output-only, populated by Find and RenderAzure.
+	Alias *string
+
+	Tags map[string]*string
+}
+
+var (
+	_ fi.CloudupTask          = &PrivateLinkService{}
+	_ fi.CompareWithID        = &PrivateLinkService{}
+	_ fi.CloudupTaskNormalize = &PrivateLinkService{}
+)
+
+// CompareWithID returns the ID of the Private Link service.
+func (p *PrivateLinkService) CompareWithID() *string {
+	return p.ID
+}
+
+// Find discovers the Private Link service in the cloud provider.
+func (p *PrivateLinkService) Find(c *fi.CloudupContext) (*PrivateLinkService, error) {
+	cloud := c.T.Cloud.(azure.AzureCloud)
+	l, err := cloud.PrivateLinkService().List(c.Context(), *p.ResourceGroup.Name)
+	if err != nil {
+		return nil, err
+	}
+	var found *network.PrivateLinkService
+	for _, v := range l {
+		if *v.Name == *p.Name {
+			found = v
+			break
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+	if found.Properties == nil {
+		return nil, fmt.Errorf("found private link service without properties")
+	}
+	if found.Properties.ProvisioningState != nil && *found.Properties.ProvisioningState == network.ProvisioningStateFailed {
+		klog.Warningf("found private link service %q in failed provisioning state", *p.Name)
+		return nil, nil
+	}
+
+	p.ID = found.ID
+	p.Alias = found.Properties.Alias
+
+	actual := &PrivateLinkService{
+		Name:      p.Name,
+		Lifecycle: p.Lifecycle,
+		ResourceGroup: &ResourceGroup{
+			Name: p.ResourceGroup.Name,
+		},
+		ID:    found.ID,
+		Alias: found.Properties.Alias,
+		Tags:  found.Tags,
+	}
+	for _, fe := range found.Properties.LoadBalancerFrontendIPConfigurations {
+		if fe.ID == nil {
+			continue
+		}
+		// The ID is .../loadBalancers/<name>/frontendIPConfigurations/<frontend>
+		actual.LoadBalancer = &LoadBalancer{
+			Name: new(path.Base(path.Dir(path.Dir(*fe.ID)))),
+		}
+	}
+	for _, ipConfig := range found.Properties.IPConfigurations {
+		if ipConfig.Properties == nil || ipConfig.Properties.Subnet == nil || ipConfig.Properties.Subnet.ID == nil {
+			continue
+		}
+		actual.Subnet = &Subnet{
+			ID: ipConfig.Properties.Subnet.ID,
+		}
+		// Resource IDs are case-insensitive.
+		if p.Subnet != nil && strings.EqualFold(fi.ValueOf(p.Subnet.ID), *ipConfig.Properties.Subnet.ID) {
+			actual.Subnet = p.Subnet
+		}
+	}
+	if found.Properties.Visibility != nil {
+		actual.AllowedSubscriptionIDs = found.Properties.Visibility.Subscriptions
+		if equalSubscriptionIDs(actual.AllowedSubscriptionIDs, p.AllowedSubscriptionIDs) {
+			actual.AllowedSubscriptionIDs = p.AllowedSubscriptionIDs
+		}
+	}
+
+	return actual, nil
+}
+
+// equalSubscriptionIDs returns true if both lists hold the same subscriptions, in any order.
+func equalSubscriptionIDs(a, b []*string) bool {
+	normalize := func(ids []*string) []string {
+		var out []string
+		for _, id := range ids {
+			out = append(out, strings.ToLower(fi.ValueOf(id)))
+		}
+		slices.Sort(out)
+		return out
+	}
+	return slices.Equal(normalize(a), normalize(b))
+}
+
+func (p *PrivateLinkService) Normalize(c *fi.CloudupContext) error {
+	c.T.Cloud.(azure.AzureCloud).AddClusterTags(p.Tags)
+	return nil
+}
+
+// Run implements fi.Task.Run.
+func (p *PrivateLinkService) Run(c *fi.CloudupContext) error {
+	return fi.CloudupDefaultDeltaRunMethod(p, c)
+}
+
+// CheckChanges returns an error if a change is not allowed.
+func (*PrivateLinkService) CheckChanges(a, e, changes *PrivateLinkService) error {
+	if a == nil {
+		// Check if required fields are set when a new resource is created.
+		if e.Name == nil {
+			return fi.RequiredField("Name")
+		}
+		return nil
+	}
+
+	// Check if unchangeable fields won't be changed.
+	if changes.Name != nil {
+		return fi.CannotChangeField("Name")
+	}
+	return nil
+}
+
+// RenderAzure creates or updates a Private Link service.
+func (*PrivateLinkService) RenderAzure(c *fi.CloudupContext, t *azure.AzureAPITarget, a, e, changes *PrivateLinkService) error {
+	ctx := c.Context()
+	if a == nil {
+		klog.Infof("Creating a new Private Link service with name: %s", fi.ValueOf(e.Name))
+	} else {
+		klog.Infof("Updating a Private Link service with name: %s", fi.ValueOf(e.Name))
+	}
+
+	frontendID := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/frontendIPConfigurations/%s",
+		t.Cloud.SubscriptionID(), *e.ResourceGroup.Name, *e.LoadBalancer.Name, terraformAzureLoadBalancerFrontendName)
+
+	pls := network.PrivateLinkService{
+		Location: to.Ptr(t.Cloud.Region()),
+		Name:     to.Ptr(*e.Name),
+		Properties: &network.PrivateLinkServiceProperties{
+			LoadBalancerFrontendIPConfigurations: []*network.FrontendIPConfiguration{
+				{
+					ID: to.Ptr(frontendID),
+				},
+			},
+			IPConfigurations: []*network.PrivateLinkServiceIPConfiguration{
+				{
+					Name: to.Ptr("nat"),
+					Properties: &network.PrivateLinkServiceIPConfigurationProperties{
+						Primary:                   to.Ptr(true),
+						PrivateIPAllocationMethod: to.Ptr(network.IPAllocationMethodDynamic),
+						Subnet: &network.Subnet{
+							ID: e.Subnet.ID,
+						},
+					},
+				},
+			},
+			Visibility: &network.PrivateLinkServicePropertiesVisibility{
+				Subscriptions: e.AllowedSubscriptionIDs,
+			},
+			AutoApproval: &network.PrivateLinkServicePropertiesAutoApproval{
+				Subscriptions: e.AllowedSubscriptionIDs,
+			},
+		},
+		Tags: e.Tags,
+	}
+
+	result, err := t.Cloud.PrivateLinkService().CreateOrUpdate(
+		ctx,
+		*e.ResourceGroup.Name,
+		*e.Name,
+		pls)
+	if err != nil {
+		return err
+	}
+
+	e.ID = result.ID
+	if result.Properties != nil {
+		e.Alias = result.Properties.Alias
+	}
+	if e.Alias != nil {
+		klog.Infof("Private endpoints can connect to the API server through the Private Link service alias %s", *e.Alias)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuretasks
+
+import (
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+)
+
+type terraformAzurePrivateLinkServiceNatIPConfiguration struct {
+	Name     *string                  `cty:"name"`
+	SubnetID *terraformWriter.Literal `cty:"subnet_id"`
+	Primary  *bool                    `cty:"primary"`
+}
+
+type terraformAzurePrivateLinkService struct {
+	Name                                   *string                                               `cty:"name"`
+	Location                               *string                                               `cty:"location"`
+	ResourceGroupName                      *terraformWriter.Literal                              `cty:"resource_group_name"`
+	LoadBalancerFrontendIPConfigurationIDs []*terraformWriter.Literal                            `cty:"load_balancer_frontend_ip_configuration_ids"`
+	NatIPConfiguration                     []*terraformAzurePrivateLinkServiceNatIPConfiguration `cty:"nat_ip_configuration"`
+	VisibilitySubscriptionIDs              []string                                              `cty:"visibility_subscription_ids"`
+	AutoApprovalSubscriptionIDs            []string                                              `cty:"auto_approval_subscription_ids"`
+	Tags                                   map[string]string                                     `cty:"tags"`
+}
+
+func (*PrivateLinkService) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *PrivateLinkService) error {
+	subnetID, err := e.Subnet.terraformID(t)
+	if err != nil {
+		return err
+	}
+
+	tf := &terraformAzurePrivateLinkService{
+		Name:                                   e.Name,
+		Location:                               new(t.Cloud.Region()),
+		ResourceGroupName:                      e.ResourceGroup.terraformName(),
+		LoadBalancerFrontendIPConfigurationIDs: []*terraformWriter.Literal{e.LoadBalancer.terraformFrontendIPConfigurationID()},
+		NatIPConfiguration: []*terraformAzurePrivateLinkServiceNatIPConfiguration{
+			{
+				Name:     new("nat"),
+				SubnetID: subnetID,
+				Primary:  new(true),
+			},
+		},
+		VisibilitySubscriptionIDs:   stringSlice(e.AllowedSubscriptionIDs),
+		AutoApprovalSubscriptionIDs: stringSlice(e.AllowedSubscriptionIDs),
+		Tags:                        stringMap(e.Tags),
+	}
+	return t.RenderResource("azurerm_private_link_service", fi.ValueOf(e.Name), tf)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuretasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
+)
+
+func newTestPrivateLinkService() *PrivateLinkService {
+	return &PrivateLinkService{
+		Name:      to.Ptr("api"),
+		Lifecycle: fi.LifecycleSync,
+		ResourceGroup: &ResourceGroup{
+			Name: to.Ptr("rg"),
+		},
+		LoadBalancer: &LoadBalancer{
+			Name: to.Ptr("api"),
+		},
+		Subnet: &Subnet{
+			Name: to.Ptr("subnet"),
+			ID:   to.Ptr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/subnet"),
+		},
+		AllowedSubscriptionIDs: []*string{to.Ptr("consumer")},
+		Tags:                   map[string]*string{},
+	}
+}
+
+func TestPrivateLinkServiceRenderAzure(t *testing.T) {
+	cloud := NewMockAzureCloud("eastus")
+	cloud.subscriptionID = "sub"
+	apiTarget := azure.NewAzureAPITarget(cloud)
+	c, err := fi.NewCloudupContext(context.Background(), fi.DeletionProcessingModeDeleteIncludingDeferred, apiTarget, nil, cloud, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := newTestPrivateLinkService()
+	if err := (&PrivateLinkService{}).RenderAzure(c, apiTarget, nil, expected, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	actual := cloud.PrivateLinkServicesClient.PLSs[*expected.Name]
+	frontends := actual.Properties.LoadBalancerFrontendIPConfigurations
+	if e := "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/loadBalancers/api/frontendIPConfigurations/LoadBalancerFrontEnd"; len(frontends) != 1 || *frontends[0].ID != e {
+		t.Errorf("unexpected frontend configurations: expected %s, but got %v", e, frontends)
+	}
+	if a := actual.Properties.AutoApproval.Subscriptions; len(a) != 1 || *a[0] != "consumer" {
+		t.Errorf("unexpected auto-approval subscriptions: %v", a)
+	}
+	if expected.Alias == nil {
+		t.Errorf("expected alias to be populated")
+	}
+}
+
+func TestPrivateLinkServiceFind(t *testing.T) {
+	cloud := NewMockAzureCloud("eastus")
+	ctx := &fi.CloudupContext{
+		T: fi.CloudupSubContext{
+			Cloud: cloud,
+		},
+	}
+
+	pls := newTestPrivateLinkService()
+	cloud.PrivateLinkServicesClient.PLSs["api"] = &network.PrivateLinkService{
+		Name: to.Ptr("api"),
+		ID:   to.Ptr("api"),
+		Tags: map[string]*string{},
+		Properties: &network.PrivateLinkServiceProperties{
+			Alias: to.Ptr("api.alias"),
+			LoadBalancerFrontendIPConfigurations: []*network.FrontendIPConfiguration{
+				{ID: to.Ptr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/loadBalancers/api/frontendIPConfigurations/LoadBalancerFrontEnd")},
+			},
+			IPConfigurations: []*network.PrivateLinkServiceIPConfiguration{
+				{Properties: &network.PrivateLinkServiceIPConfigurationProperties{
+					Subnet: &network.Subnet{ID: to.Ptr("/subscriptions/sub/resourcegroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/subnet")},
+				}},
+			},
+			Visibility: &network.PrivateLinkServicePropertiesVisibility{
+				Subscriptions: []*string{to.Ptr("CONSUMER")},
+			},
+		},
+	}
+
+	actual, err := pls.Find(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	changes := &PrivateLinkService{}
+	if fi.BuildChanges(actual, pls, changes) {
+		t.Errorf("unexpected changes: %+v", changes)
+	}
+	if fi.ValueOf(pls.Alias) != "api.alias" {
+		t.Errorf("expected alias to be propagated, got %v", pls.Alias)
+	}
+}
@@ -43,6 +43,9 @@ type PublicIPAddress struct {
 	SKU network.PublicIPAddressSKUName
 	// IPAddress is the allocated address. It is output-only, populated by Find and RenderAzure.
 	IPAddress *string
+	// Zones are the availability zones of the address, all the zones of the region for a zone-redundant address.
+	// They can only be set when the address is created.
+	Zones []*string
 
 	Tags map[string]*string
 }
@@ -61,7 +64,7 @@ func (p *PublicIPAddress) CompareWithID() *string {
 // Find discovers the Public IP Address in the cloud provider
 func (p *PublicIPAddress) Find(c *fi.CloudupContext) (*PublicIPAddress, error) {
 	cloud := c.T.Cloud.(azure.AzureCloud)
-	l, err := cloud.PublicIPAddress().List(context.TODO(), *p.ResourceGroup.Name)
+	l, err := cloud.PublicIPAddress().List(c.Context(), *p.ResourceGroup.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -88,9 +91,11 @@ func (p *PublicIPAddress) Find(c *fi.CloudupContext) (*PublicIPAddress, error) {
 		ResourceGroup: &ResourceGroup{
 			Name: p.ResourceGroup.Name,
 		},
-		ID:   found.ID,
-		Tags: found.Tags,
+		ID:    found.ID,
+		Tags:  found.Tags,
+		Zones: found.Zones,
 	}
+	p.Zones = keepZones("public IP address", *p.Name, found.Zones, p.Zones)
 	if found.Properties != nil {
 		actual.IPVersion = fi.ValueOf(found.Properties.PublicIPAddressVersion)
 		actual.AllocationMethod = fi.ValueOf(found.Properties.PublicIPAllocationMethod)
@@ -149,7 +154,8 @@ func (*PublicIPAddress) RenderAzure(t *azure.AzureAPITarget, a, e, changes *Publ
 		SKU: &network.PublicIPAddressSKU{
 			Name: to.Ptr(e.SKU),
 		},
-		Tags: e.Tags,
+		Tags:  e.Tags,
+		Zones: e.Zones,
 	}
 
 	pip, err := t.Cloud.PublicIPAddress().CreateOrUpdate(
@@ -30,6 +30,7 @@ type terraformAzurePublicIPAddress struct {
 	SKU               *string                  `cty:"sku"`
 	IPVersion         *string                  `cty:"ip_version"`
 	Tags              map[string]string        `cty:"tags"`
+	Zones             []string                 `cty:"zones"`
 }
 
 func (*PublicIPAddress) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *PublicIPAddress) error {
@@ -44,6 +45,7 @@ func (*PublicIPAddress) RenderTerraform(t *terraform.TerraformTarget, a, e, chan
 		SKU:               &sku,
 		IPVersion:         &ipVersion,
 		Tags:              stringMap(e.Tags),
+		Zones:             stringSlice(e.Zones),
 	}
 	return t.RenderResource("azurerm_public_ip", fi.ValueOf(e.Name), tf)
 }
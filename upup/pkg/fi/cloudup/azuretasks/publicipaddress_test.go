@@ -176,3 +176,39 @@ func TestPublicIPAddressCheckChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestPublicIPAddressFindKeepsZones(t *testing.T) {
+	cloud := NewMockAzureCloud("eastus")
+	ctx := &fi.CloudupContext{
+		T: fi.CloudupSubContext{
+			Cloud: cloud,
+		},
+	}
+
+	// Create a public ip address without zones.
+	publicIPAddressParameters := network.PublicIPAddress{
+		Location: to.Ptr("eastus"),
+		Name:     to.Ptr("publicIPAddress"),
+		Properties: &network.PublicIPAddressPropertiesFormat{
+			PublicIPAddressVersion:   to.Ptr(network.IPVersionIPv4),
+			PublicIPAllocationMethod: to.Ptr(network.IPAllocationMethodStatic),
+		},
+	}
+	if _, err := cloud.PublicIPAddress().CreateOrUpdate(context.Background(), "rg", "publicIPAddress", publicIPAddressParameters); err != nil {
+		t.Fatalf("failed to create: %s", err)
+	}
+
+	// Zones can only be set on creation, so the expected zones are replaced by those of the existing address.
+	publicIPAddress := newTestPublicIPAddress()
+	publicIPAddress.Zones = []*string{to.Ptr("1"), to.Ptr("2"), to.Ptr("3")}
+	actual, err := publicIPAddress.Find(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(actual.Zones) != 0 {
+		t.Errorf("unexpected zones found: %v", stringSlice(actual.Zones))
+	}
+	if len(publicIPAddress.Zones) != 0 {
+		t.Errorf("expected zones of the existing address, but got %v", stringSlice(publicIPAddress.Zones))
+	}
+}
@@ -52,7 +52,7 @@ func (r *ResourceGroup) CompareWithID() *string {
 // Find discovers the ResourceGroup in the cloud provider.
 func (r *ResourceGroup) Find(c *fi.CloudupContext) (*ResourceGroup, error) {
 	cloud := c.T.Cloud.(azure.AzureCloud)
-	l, err := cloud.ResourceGroup().List(context.TODO())
+	l, err := cloud.ResourceGroup().List(c.Context())
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +70,7 @@ func (r *RoleAssignment) Find(c *fi.CloudupContext) (*RoleAssignment, error) {
 	}
 
 	cloud := c.T.Cloud.(azure.AzureCloud)
-	rs, err := cloud.RoleAssignment().List(context.TODO(), *r.Scope)
+	rs, err := cloud.RoleAssignment().List(c.Context(), *r.Scope)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +96,7 @@ func (r *RoleAssignment) Find(c *fi.CloudupContext) (*RoleAssignment, error) {
 	}
 
 	// Query VM Scale Sets and find one that has matching Principal ID.
-	vs, err := cloud.VMScaleSet().List(context.TODO(), *r.VMScaleSet.ResourceGroup.Name)
+	vs, err := cloud.VMScaleSet().List(c.Context(), *r.VMScaleSet.ResourceGroup.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +52,7 @@ func (r *RouteTable) CompareWithID() *string {
 // Find discovers the RouteTable in the cloud provider.
 func (r *RouteTable) Find(c *fi.CloudupContext) (*RouteTable, error) {
 	cloud := c.T.Cloud.(azure.AzureCloud)
-	l, err := cloud.RouteTable().List(context.TODO(), *r.ResourceGroup.Name)
+	l, err := cloud.RouteTable().List(c.Context(), *r.ResourceGroup.Name)
 	if err != nil {
 		return nil, err
 	}
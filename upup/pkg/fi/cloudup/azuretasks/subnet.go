@@ -23,6 +23,7 @@ import (
 	"path"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/upup/pkg/fi"
@@ -44,6 +45,9 @@ type Subnet struct {
 
 	CIDR   *string
 	Shared *bool
+
+	// DisablePrivateLinkServiceNetworkPolicies is set on subnets that host the NAT addresses of a Private Link service.
+	DisablePrivateLinkServiceNetworkPolicies *bool
 }
 
 var (
@@ -60,7 +64,7 @@ func (s *Subnet) CompareWithID() *string {
 // Find discovers the Subnet in the cloud provider.
 func (s *Subnet) Find(c *fi.CloudupContext) (*Subnet, error) {
 	cloud := c.T.Cloud.(azure.AzureCloud)
-	l, err := cloud.Subnet().List(context.TODO(), *s.ResourceGroup.Name, *s.VirtualNetwork.Name)
+	l, err := cloud.Subnet().List(c.Context(), *s.ResourceGroup.Name, *s.VirtualNetwork.Name)
 	if err != nil {
 		var azErr *azcore.ResponseError
 		if errors.As(err, &azErr) {
@@ -110,6 +114,8 @@ func (s *Subnet) Find(c *fi.CloudupContext) (*Subnet, error) {
 		},
 		ID:   found.ID,
 		CIDR: found.Properties.AddressPrefix,
+
+		DisablePrivateLinkServiceNetworkPolicies: new(fi.ValueOf(found.Properties.PrivateLinkServiceNetworkPolicies) == network.VirtualNetworkPrivateLinkServiceNetworkPoliciesDisabled),
 	}
 	if found.Properties.NatGateway != nil {
 		fs.NatGateway = &NatGateway{
@@ -184,6 +190,9 @@ func (*Subnet) RenderAzure(t *azure.AzureAPITarget, a, e, changes *Subnet) error
 			ID: e.RouteTable.ID,
 		}
 	}
+	if fi.ValueOf(e.DisablePrivateLinkServiceNetworkPolicies) {
+		subnet.Properties.PrivateLinkServiceNetworkPolicies = to.Ptr(network.VirtualNetworkPrivateLinkServiceNetworkPoliciesDisabled)
+	}
 
 	sn, err := t.Cloud.Subnet().CreateOrUpdate(
 		context.TODO(),
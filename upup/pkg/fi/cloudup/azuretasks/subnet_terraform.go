@@ -34,6 +34,8 @@ type terraformAzureSubnet struct {
 	ResourceGroupName  *terraformWriter.Literal `cty:"resource_group_name"`
 	VirtualNetworkName *terraformWriter.Literal `cty:"virtual_network_name"`
 	AddressPrefixes    []string                 `cty:"address_prefixes"`
+
+	PrivateLinkServiceNetworkPoliciesEnabled *bool `cty:"private_link_service_network_policies_enabled"`
 }
 
 type terraformAzureSubnetNetworkSecurityGroupAssociation struct {
@@ -59,6 +61,9 @@ func (*Subnet) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *Subn
 			VirtualNetworkName: e.VirtualNetwork.terraformName(),
 			AddressPrefixes:    []string{fi.ValueOf(e.CIDR)},
 		}
+		if fi.ValueOf(e.DisablePrivateLinkServiceNetworkPolicies) {
+			tf.PrivateLinkServiceNetworkPoliciesEnabled = new(false)
+		}
 		if err := t.RenderResource("azurerm_subnet", fi.ValueOf(e.Name), tf); err != nil {
 			return err
 		}
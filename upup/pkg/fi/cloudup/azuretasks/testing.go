@@ -52,13 +52,17 @@ type MockAzureCloud struct {
 	ApplicationSecurityGroupsClient *MockApplicationSecurityGroupsClient
 	VMScaleSetsClient               *MockVMScaleSetsClient
 	VMScaleSetVMsClient             *MockVMScaleSetVMsClient
+	VirtualMachinesClient           *MockVirtualMachinesClient
 	DisksClient                     *MockDisksClient
+	GalleryImageVersionsClient      *MockGalleryImageVersionsClient
 	RoleAssignmentsClient           *MockRoleAssignmentsClient
 	NetworkInterfacesClient         *MockNetworkInterfacesClient
 	LoadBalancersClient             *MockLoadBalancersClient
 	PublicIPAddressesClient         *MockPublicIPAddressesClient
 	NatGatewaysClient               *MockNatGatewaysClient
 	StorageAccountsClient           *MockStorageAccountsClient
+	PrivateLinkServicesClient       *MockPrivateLinkServicesClient
+	PrivateDNSClient                *MockPrivateDNSClient
 }
 
 var _ azure.AzureCloud = (*MockAzureCloud)(nil)
@@ -99,9 +103,15 @@ func NewMockAzureCloud(location string) *MockAzureCloud {
 		VMScaleSetVMsClient: &MockVMScaleSetVMsClient{
 			VMs: map[string]*compute.VirtualMachineScaleSetVM{},
 		},
+		VirtualMachinesClient: &MockVirtualMachinesClient{
+			VMs: map[string]*compute.VirtualMachine{},
+		},
 		DisksClient: &MockDisksClient{
 			Disks: map[string]*compute.Disk{},
 		},
+		GalleryImageVersionsClient: &MockGalleryImageVersionsClient{
+			Versions: map[string][]*compute.GalleryImageVersion{},
+		},
 		RoleAssignmentsClient: &MockRoleAssignmentsClient{
 			RAs: map[string]*authz.RoleAssignment{},
 		},
@@ -120,6 +130,13 @@ func NewMockAzureCloud(location string) *MockAzureCloud {
 		StorageAccountsClient: &MockStorageAccountsClient{
 			SAs: map[string]*armstorage.Account{},
 		},
+		PrivateLinkServicesClient: &MockPrivateLinkServicesClient{
+			PLSs: map[string]*network.PrivateLinkService{},
+		},
+		PrivateDNSClient: &MockPrivateDNSClient{
+			Records: map[string]*azure.PrivateDNSARecord{},
+			Links:   map[string]*azure.PrivateDNSZoneLink{},
+		},
 	}
 }
 
@@ -188,12 +205,12 @@ func (c *MockAzureCloud) AddClusterTags(tags map[string]*string) {
 }
 
 // FindClusterStatus discovers the status of the cluster, by looking for the tagged etcd volumes
-func (c *MockAzureCloud) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+func (c *MockAzureCloud) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
 	return &kops.ClusterStatus{}, nil
 }
 
 // GetApiIngressStatus returns the status of API ingress.
-func (c *MockAzureCloud) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+func (c *MockAzureCloud) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
 	return nil, nil
 }
 
@@ -242,11 +259,21 @@ func (c *MockAzureCloud) VMScaleSetVM() azure.VMScaleSetVMsClient {
 	return c.VMScaleSetVMsClient
 }
 
+// VirtualMachine returns the VM client.
+func (c *MockAzureCloud) VirtualMachine() azure.VirtualMachinesClient {
+	return c.VirtualMachinesClient
+}
+
 // Disk returns the disk client.
 func (c *MockAzureCloud) Disk() azure.DisksClient {
 	return c.DisksClient
 }
 
+// GalleryImageVersion returns the gallery image versions client.
+func (c *MockAzureCloud) GalleryImageVersion() azure.GalleryImageVersionsClient {
+	return c.GalleryImageVersionsClient
+}
+
 // RoleAssignment returns the role assignment client.
 func (c *MockAzureCloud) RoleAssignment() azure.RoleAssignmentsClient {
 	return c.RoleAssignmentsClient
@@ -272,6 +299,16 @@ func (c *MockAzureCloud) NatGateway() azure.NatGatewaysClient {
 	return c.NatGatewaysClient
 }
 
+// PrivateLinkService returns the private link service client.
+func (c *MockAzureCloud) PrivateLinkService() azure.PrivateLinkServicesClient {
+	return c.PrivateLinkServicesClient
+}
+
+// PrivateDNS returns the private DNS client.
+func (c *MockAzureCloud) PrivateDNS() azure.PrivateDNSClient {
+	return c.PrivateDNSClient
+}
+
 // MockResourceGroupsClient is a mock implementation of resource group client.
 type MockResourceGroupsClient struct {
 	RGs map[string]*resources.ResourceGroup
@@ -490,6 +527,42 @@ func (c *MockVMScaleSetVMsClient) Delete(ctx context.Context, resourceGroupName,
 	return nil
 }
 
+// MockVirtualMachinesClient is a mock implementation of VM client.
+type MockVirtualMachinesClient struct {
+	VMs map[string]*compute.VirtualMachine
+}
+
+var _ azure.VirtualMachinesClient = (*MockVirtualMachinesClient)(nil)
+
+// ListByVMScaleSet returns a slice of VMs of a VM Scale Set.
+func (c *MockVirtualMachinesClient) ListByVMScaleSet(ctx context.Context, resourceGroupName, vmssID string) ([]*compute.VirtualMachine, error) {
+	// Ignore resourceGroupName for simplicity.
+	var l []*compute.VirtualMachine
+	for _, vm := range c.VMs {
+		if vm.Properties != nil && vm.Properties.VirtualMachineScaleSet != nil && *vm.Properties.VirtualMachineScaleSet.ID == vmssID {
+			l = append(l, vm)
+		}
+	}
+	return l, nil
+}
+
+// Get returns a VM.
+func (c *MockVirtualMachinesClient) Get(ctx context.Context, resourceGroupName, vmName string) (*compute.VirtualMachine, error) {
+	// Ignore resourceGroupName for simplicity.
+	vm, ok := c.VMs[vmName]
+	if !ok {
+		return nil, fmt.Errorf("%s does not exist", vmName)
+	}
+	return vm, nil
+}
+
+// Delete deletes a VM.
+func (c *MockVirtualMachinesClient) Delete(ctx context.Context, resourceGroupName, vmName string) error {
+	// Ignore resourceGroupName for simplicity.
+	delete(c.VMs, vmName)
+	return nil
+}
+
 // MockDisksClient is a mock implementation of disk client.
 type MockDisksClient struct {
 	Disks map[string]*compute.Disk
@@ -528,6 +601,20 @@ func (c *MockDisksClient) Delete(ctx context.Context, resourceGroupName, diskNam
 	return nil
 }
 
+// MockGalleryImageVersionsClient is a mock implementation of gallery image versions client.
+type MockGalleryImageVersionsClient struct {
+	// Versions are the versions of gallery images, keyed by gallery and image name, "<gallery>/<image>".
+	Versions map[string][]*compute.GalleryImageVersion
+}
+
+var _ azure.GalleryImageVersionsClient = (*MockGalleryImageVersionsClient)(nil)
+
+// List returns a slice of the versions of a gallery image.
+func (c *MockGalleryImageVersionsClient) List(ctx context.Context, subscriptionID, resourceGroupName, galleryName, imageName string) ([]*compute.GalleryImageVersion, error) {
+	// Ignore subscriptionID and resourceGroupName for simplicity.
+	return c.Versions[galleryName+"/"+imageName], nil
+}
+
 // MockRoleAssignmentsClient is a mock implementation of role assignment client.
 type MockRoleAssignmentsClient struct {
 	RAs map[string]*authz.RoleAssignment
@@ -594,6 +681,16 @@ func (c *MockNetworkInterfacesClient) ListScaleSetsNetworkInterfaces(ctx context
 	return l, nil
 }
 
+// List returns a slice of Network Interfaces.
+func (c *MockNetworkInterfacesClient) List(ctx context.Context, resourceGroupName string) ([]*network.Interface, error) {
+	// Ignore resourceGroupName for simplicity.
+	var l []*network.Interface
+	for _, ni := range c.NIs {
+		l = append(l, ni)
+	}
+	return l, nil
+}
+
 // MockLoadBalancersClient is a mock implementation of role assignment client.
 type MockLoadBalancersClient struct {
 	LBs map[string]*network.LoadBalancer
@@ -837,3 +934,82 @@ func (c *MockStorageAccountsClient) List(ctx context.Context) ([]*armstorage.Acc
 	}
 	return l, nil
 }
+
+// MockPrivateLinkServicesClient is a mock implementation of Private Link service client.
+type MockPrivateLinkServicesClient struct {
+	PLSs map[string]*network.PrivateLinkService
+}
+
+var _ azure.PrivateLinkServicesClient = (*MockPrivateLinkServicesClient)(nil)
+
+// CreateOrUpdate creates or updates a Private Link service.
+func (c *MockPrivateLinkServicesClient) CreateOrUpdate(ctx context.Context, resourceGroupName, serviceName string, parameters network.PrivateLinkService) (*network.PrivateLinkService, error) {
+	parameters.Name = &serviceName
+	parameters.ID = &serviceName
+	if parameters.Properties != nil {
+		parameters.Properties.Alias = to.Ptr(serviceName + ".00000000-0000-0000-0000-000000000000." + resourceGroupName + ".azure.privatelinkservice")
+	}
+	c.PLSs[serviceName] = &parameters
+	return &parameters, nil
+}
+
+// List returns a slice of Private Link services.
+func (c *MockPrivateLinkServicesClient) List(ctx context.Context, resourceGroupName string) ([]*network.PrivateLinkService, error) {
+	var l []*network.PrivateLinkService
+	for _, pls := range c.PLSs {
+		l = append(l, pls)
+	}
+	return l, nil
+}
+
+// Delete deletes a specified Private Link service.
+func (c *MockPrivateLinkServicesClient) Delete(ctx context.Context, resourceGroupName, serviceName string) error {
+	if _, ok := c.PLSs[serviceName]; !ok {
+		return fmt.Errorf("%s does not exist", serviceName)
+	}
+	delete(c.PLSs, serviceName)
+	return nil
+}
+
+// MockPrivateDNSClient is a mock implementation of private DNS client.
+type MockPrivateDNSClient struct {
+	Records map[string]*azure.PrivateDNSARecord
+	Links   map[string]*azure.PrivateDNSZoneLink
+}
+
+var _ azure.PrivateDNSClient = (*MockPrivateDNSClient)(nil)
+
+// GetARecord returns an A record set.
+func (c *MockPrivateDNSClient) GetARecord(ctx context.Context, id string) (*azure.PrivateDNSARecord, error) {
+	return c.Records[id], nil
+}
+
+// CreateOrUpdateARecord creates or updates an A record set.
+func (c *MockPrivateDNSClient) CreateOrUpdateARecord(ctx context.Context, record *azure.PrivateDNSARecord) error {
+	c.Records[record.ID] = record
+	return nil
+}
+
+// GetZoneLink returns a virtual network link.
+func (c *MockPrivateDNSClient) GetZoneLink(ctx context.Context, id string) (*azure.PrivateDNSZoneLink, error) {
+	return c.Links[id], nil
+}
+
+// CreateOrUpdateZoneLink creates or updates a virtual network link.
+func (c *MockPrivateDNSClient) CreateOrUpdateZoneLink(ctx context.Context, link *azure.PrivateDNSZoneLink) error {
+	c.Links[link.ID] = link
+	return nil
+}
+
+// Delete deletes a record set or a virtual network link.
+func (c *MockPrivateDNSClient) Delete(ctx context.Context, id string) error {
+	if _, ok := c.Records[id]; ok {
+		delete(c.Records, id)
+		return nil
+	}
+	if _, ok := c.Links[id]; ok {
+		delete(c.Links, id)
+		return nil
+	}
+	return fmt.Errorf("%s does not exist", id)
+}
@@ -53,7 +53,7 @@ func (n *VirtualNetwork) CompareWithID() *string {
 // Find discovers the VirtualNetwork in the cloud provider.
 func (n *VirtualNetwork) Find(c *fi.CloudupContext) (*VirtualNetwork, error) {
 	cloud := c.T.Cloud.(azure.AzureCloud)
-	l, err := cloud.VirtualNetwork().List(context.TODO(), *n.ResourceGroup.Name)
+	l, err := cloud.VirtualNetwork().List(c.Context(), *n.ResourceGroup.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
@@ -55,10 +56,21 @@ type VMScaleSet struct {
 	AdminUser    *string
 	SSHPublicKey *string
 	// UserData is the user data configuration
-	UserData    fi.Resource
-	Tags        map[string]*string
-	Zones       []*string
-	PrincipalID *string
+	UserData fi.Resource
+	Tags     map[string]*string
+	Zones    []*string
+	// UserAssignedIdentities are the resource IDs of the user-assigned managed identities attached to the VMs,
+	// in addition to the system-assigned identity.
+	UserAssignedIdentities []*string
+	PrincipalID            *string
+	// Priority is Spot for Azure Spot VMs, or Regular.
+	Priority *string
+	// EvictionPolicy specifies whether evicted Spot VMs are deleted or deallocated.
+	EvictionPolicy *string
+	// MaxPrice is the maximum hourly price in USD of Spot VMs, or -1 to pay up to the on-demand price.
+	MaxPrice *float64
+	// OrchestrationMode is Flexible for VM Scale Sets of standalone VMs, or Uniform.
+	OrchestrationMode *string
 }
 
 var _ fi.CloudupTaskNormalize = (*VMScaleSet)(nil)
@@ -94,7 +106,7 @@ func (s *VMScaleSet) CompareWithID() *string {
 // Find discovers the VMScaleSet in the cloud provider.
 func (s *VMScaleSet) Find(c *fi.CloudupContext) (*VMScaleSet, error) {
 	cloud := c.T.Cloud.(azure.AzureCloud)
-	found, err := cloud.VMScaleSet().Get(context.TODO(), *s.ResourceGroup.Name, *s.Name)
+	found, err := cloud.VMScaleSet().Get(c.Context(), *s.ResourceGroup.Name, *s.Name)
 	if err != nil && !strings.Contains(err.Error(), "NotFound") {
 		return nil, err
 	}
@@ -209,12 +221,29 @@ func (s *VMScaleSet) Find(c *fi.CloudupContext) (*VMScaleSet, error) {
 		UserData:           fi.NewBytesResource(userData),
 		Tags:               found.Tags,
 	}
+	// VM Scale Sets created without an orchestration mode are uniform
+	vmss.OrchestrationMode = to.Ptr(string(compute.OrchestrationModeUniform))
+	if found.Properties.OrchestrationMode != nil {
+		vmss.OrchestrationMode = to.Ptr(string(*found.Properties.OrchestrationMode))
+	}
+	// VM Scale Sets created without a priority have regular VMs
+	vmss.Priority = to.Ptr(string(compute.VirtualMachinePriorityTypesRegular))
+	if profile.Priority != nil {
+		vmss.Priority = to.Ptr(string(*profile.Priority))
+	}
+	if profile.EvictionPolicy != nil {
+		vmss.EvictionPolicy = to.Ptr(string(*profile.EvictionPolicy))
+	}
+	if profile.BillingProfile != nil {
+		vmss.MaxPrice = profile.BillingProfile.MaxPrice
+	}
 	if found.SKU != nil {
 		vmss.SKUName = found.SKU.Name
 		vmss.Capacity = found.SKU.Capacity
 	}
 	if found.Identity != nil {
 		vmss.PrincipalID = found.Identity.PrincipalID
+		vmss.UserAssignedIdentities = s.matchUserAssignedIdentities(found.Identity.UserAssignedIdentities)
 	}
 	if ipConfig.Properties != nil && ipConfig.Properties.ApplicationSecurityGroups != nil {
 		for _, asg := range ipConfig.Properties.ApplicationSecurityGroups {
@@ -237,8 +266,48 @@ func (s *VMScaleSet) Find(c *fi.CloudupContext) (*VMScaleSet, error) {
 	return vmss, nil
 }
 
+// matchUserAssignedIdentities returns the resource IDs of the found identities in the order and spelling of the
+// expected ones, as Azure compares resource IDs case-insensitively and may return them in another case.
+func (s *VMScaleSet) matchUserAssignedIdentities(found map[string]*compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue) []*string {
+	var identities []*string
+	matched := make(map[string]bool)
+	for _, expected := range s.UserAssignedIdentities {
+		for id := range found {
+			if !matched[id] && strings.EqualFold(id, *expected) {
+				identities = append(identities, expected)
+				matched[id] = true
+				break
+			}
+		}
+	}
+	var unexpected []string
+	for id := range found {
+		if !matched[id] {
+			unexpected = append(unexpected, id)
+		}
+	}
+	sort.Strings(unexpected)
+	for _, id := range unexpected {
+		identities = append(identities, to.Ptr(id))
+	}
+	return identities
+}
+
 func (s *VMScaleSet) Normalize(c *fi.CloudupContext) error {
-	c.T.Cloud.(azure.AzureCloud).AddClusterTags(s.Tags)
+	cloud := c.T.Cloud.(azure.AzureCloud)
+	cloud.AddClusterTags(s.Tags)
+
+	// Record the concrete version of gallery images referenced by their latest version,
+	// so that new versions are rolled out by updating the VM Scale Set
+	if s.StorageProfile != nil && s.StorageProfile.VirtualMachineScaleSetStorageProfile != nil {
+		if image := s.StorageProfile.ImageReference; image != nil && image.ID != nil {
+			id, err := azure.ResolveImageID(c.Context(), cloud, *image.ID)
+			if err != nil {
+				return err
+			}
+			image.ID = to.Ptr(id)
+		}
+	}
 	return nil
 }
 
@@ -261,6 +330,13 @@ func (s *VMScaleSet) CheckChanges(a, e, changes *VMScaleSet) error {
 	if changes.Name != nil {
 		return fi.CannotChangeField("Name")
 	}
+	// Azure does not allow changing the priority of an existing VM Scale Set
+	if changes.Priority != nil {
+		return fi.CannotChangeField("Priority")
+	}
+	if changes.OrchestrationMode != nil {
+		return fi.CannotChangeField("OrchestrationMode")
+	}
 	return nil
 }
 
@@ -384,6 +460,34 @@ func (s *VMScaleSet) RenderAzure(t *azure.AzureAPITarget, a, e, changes *VMScale
 		Tags:  e.Tags,
 		Zones: e.Zones,
 	}
+	if fi.ValueOf(e.OrchestrationMode) == string(compute.OrchestrationModeFlexible) {
+		vmss.Properties.OrchestrationMode = to.Ptr(compute.OrchestrationModeFlexible)
+		// Azure spreads the VMs of flexible VM Scale Sets across fault domains on a best effort basis
+		vmss.Properties.PlatformFaultDomainCount = to.Ptr(int32(1))
+		// Flexible VM Scale Sets do not support upgrade policies, and create the network interfaces of their VMs with the network API
+		vmss.Properties.UpgradePolicy = nil
+		vmss.Properties.VirtualMachineProfile.NetworkProfile.NetworkAPIVersion = to.Ptr(compute.NetworkAPIVersionTwoThousandTwenty1101)
+		// The network interfaces are standalone resources, which are deleted with their VMs
+		networkConfig.Properties.DeleteOption = to.Ptr(compute.DeleteOptionsDelete)
+	}
+	if e.Priority != nil {
+		vmss.Properties.VirtualMachineProfile.Priority = to.Ptr(compute.VirtualMachinePriorityTypes(*e.Priority))
+	}
+	if e.EvictionPolicy != nil {
+		vmss.Properties.VirtualMachineProfile.EvictionPolicy = to.Ptr(compute.VirtualMachineEvictionPolicyTypes(*e.EvictionPolicy))
+	}
+	if e.MaxPrice != nil {
+		vmss.Properties.VirtualMachineProfile.BillingProfile = &compute.BillingProfile{
+			MaxPrice: e.MaxPrice,
+		}
+	}
+	if len(e.UserAssignedIdentities) > 0 {
+		vmss.Identity.Type = to.Ptr(compute.ResourceIdentityTypeSystemAssignedUserAssigned)
+		vmss.Identity.UserAssignedIdentities = make(map[string]*compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue)
+		for _, id := range e.UserAssignedIdentities {
+			vmss.Identity.UserAssignedIdentities[*id] = &compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue{}
+		}
+	}
 
 	result, err := t.Cloud.VMScaleSet().CreateOrUpdate(
 		context.TODO(),
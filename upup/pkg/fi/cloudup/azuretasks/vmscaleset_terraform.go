@@ -38,10 +38,16 @@ type terraformAzureVMScaleSetImageReference struct {
 	Version   *string `cty:"version"`
 }
 
+type terraformAzureVMScaleSetDiffDiskSettings struct {
+	Option    *string `cty:"option"`
+	Placement *string `cty:"placement"`
+}
+
 type terraformAzureVMScaleSetOSDisk struct {
-	Caching            *string `cty:"caching"`
-	StorageAccountType *string `cty:"storage_account_type"`
-	DiskSizeGB         *int32  `cty:"disk_size_gb"`
+	Caching            *string                                   `cty:"caching"`
+	StorageAccountType *string                                   `cty:"storage_account_type"`
+	DiskSizeGB         *int32                                    `cty:"disk_size_gb"`
+	DiffDiskSettings   *terraformAzureVMScaleSetDiffDiskSettings `cty:"diff_disk_settings"`
 }
 
 type terraformAzureVMScaleSetPublicIPAddress struct {
@@ -66,7 +72,8 @@ type terraformAzureVMScaleSetNetworkInterface struct {
 }
 
 type terraformAzureVMScaleSetIdentity struct {
-	Type *string `cty:"type"`
+	Type        *string  `cty:"type"`
+	IdentityIDs []string `cty:"identity_ids"`
 }
 
 type terraformAzureVMScaleSet struct {
@@ -86,6 +93,9 @@ type terraformAzureVMScaleSet struct {
 	OSDisk                        *terraformAzureVMScaleSetOSDisk             `cty:"os_disk"`
 	NetworkInterface              []*terraformAzureVMScaleSetNetworkInterface `cty:"network_interface"`
 	Identity                      *terraformAzureVMScaleSetIdentity           `cty:"identity"`
+	Priority                      *string                                     `cty:"priority"`
+	EvictionPolicy                *string                                     `cty:"eviction_policy"`
+	MaxBidPrice                   *float64                                    `cty:"max_bid_price"`
 	UserData                      *terraformWriter.Literal                    `cty:"user_data"`
 	Tags                          map[string]string                           `cty:"tags"`
 }
@@ -100,6 +110,10 @@ func (*VMScaleSet) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *
 		return fmt.Errorf("os disk is required for VMScaleSet %q", fi.ValueOf(e.Name))
 	}
 
+	if fi.ValueOf(e.OrchestrationMode) == string(compute.OrchestrationModeFlexible) {
+		return fmt.Errorf("flexible orchestration mode is not supported with Terraform for VMScaleSet %q", fi.ValueOf(e.Name))
+	}
+
 	upgradeMode := string(compute.UpgradeModeManual)
 	disablePasswordAuthentication := true
 	tf := &terraformAzureVMScaleSet{
@@ -129,6 +143,21 @@ func (*VMScaleSet) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *
 		},
 		Tags: stringMap(e.Tags),
 	}
+	if diff := storageProfile.OSDisk.DiffDiskSettings; diff != nil {
+		tf.OSDisk.DiffDiskSettings = &terraformAzureVMScaleSetDiffDiskSettings{
+			Option:    stringPtr(diff.Option),
+			Placement: stringPtr(diff.Placement),
+		}
+	}
+	if fi.ValueOf(e.Priority) == string(compute.VirtualMachinePriorityTypesSpot) {
+		tf.Priority = e.Priority
+		tf.EvictionPolicy = e.EvictionPolicy
+		tf.MaxBidPrice = e.MaxPrice
+	}
+	if len(e.UserAssignedIdentities) > 0 {
+		tf.Identity.Type = new("SystemAssigned, UserAssigned")
+		tf.Identity.IdentityIDs = stringSlice(e.UserAssignedIdentities)
+	}
 
 	if e.UserData != nil {
 		userData, err := t.AddFileResource("azurerm_linux_virtual_machine_scale_set", fi.ValueOf(e.Name), "user_data", e.UserData, true)
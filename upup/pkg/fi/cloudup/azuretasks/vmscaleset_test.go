@@ -22,6 +22,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
@@ -301,6 +302,79 @@ func TestVMScaleSetFind(t *testing.T) {
 	}
 }
 
+func TestVMScaleSetUserAssignedIdentities(t *testing.T) {
+	cloud := NewMockAzureCloud("eastus")
+	ctx := &fi.CloudupContext{
+		T: fi.CloudupSubContext{
+			Cloud: cloud,
+		},
+	}
+	identity := "/subscriptions/subID/resourceGroups/identities/providers/Microsoft.ManagedIdentity/userAssignedIdentities/workloads"
+
+	expected := newTestVMScaleSet()
+	expected.UserAssignedIdentities = []*string{to.Ptr(identity)}
+	if err := expected.RenderAzure(azure.NewAzureAPITarget(cloud), nil, expected, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	actual := cloud.VMScaleSetsClient.VMSSes[*expected.Name]
+	if a, e := *actual.Identity.Type, compute.ResourceIdentityTypeSystemAssignedUserAssigned; a != e {
+		t.Errorf("unexpected identity type: expected %s, but got %s", e, a)
+	}
+	if _, ok := actual.Identity.UserAssignedIdentities[identity]; !ok || len(actual.Identity.UserAssignedIdentities) != 1 {
+		t.Errorf("unexpected user-assigned identities: %v", actual.Identity.UserAssignedIdentities)
+	}
+
+	// Azure may return the resource IDs in another case.
+	actual.Identity.UserAssignedIdentities = map[string]*compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue{
+		strings.ToLower(identity): {},
+	}
+	found, err := expected.Find(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if a, e := found.UserAssignedIdentities, expected.UserAssignedIdentities; !reflect.DeepEqual(a, e) {
+		t.Errorf("unexpected user-assigned identities: expected %v, but got %v", e, a)
+	}
+}
+
+func TestVMScaleSetFlexible(t *testing.T) {
+	cloud := NewMockAzureCloud("eastus")
+	ctx := &fi.CloudupContext{
+		T: fi.CloudupSubContext{
+			Cloud: cloud,
+		},
+	}
+
+	expected := newTestVMScaleSet()
+	expected.OrchestrationMode = to.Ptr("Flexible")
+	if err := expected.RenderAzure(azure.NewAzureAPITarget(cloud), nil, expected, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	actual := cloud.VMScaleSetsClient.VMSSes[*expected.Name]
+	if a, e := fi.ValueOf(actual.Properties.OrchestrationMode), compute.OrchestrationModeFlexible; a != e {
+		t.Errorf("unexpected orchestration mode: expected %s, but got %s", e, a)
+	}
+	if a, e := fi.ValueOf(actual.Properties.PlatformFaultDomainCount), int32(1); a != e {
+		t.Errorf("unexpected platform fault domain count: expected %d, but got %d", e, a)
+	}
+	if actual.Properties.UpgradePolicy != nil {
+		t.Errorf("unexpected upgrade policy %+v", actual.Properties.UpgradePolicy)
+	}
+	if actual.Properties.VirtualMachineProfile.NetworkProfile.NetworkAPIVersion == nil {
+		t.Errorf("expected network API version to be set")
+	}
+
+	found, err := expected.Find(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if a, e := fi.ValueOf(found.OrchestrationMode), "Flexible"; a != e {
+		t.Errorf("unexpected orchestration mode: expected %s, but got %s", e, a)
+	}
+}
+
 func TestVMScaleSetRun(t *testing.T) {
 	cloud := NewMockAzureCloud("eastus")
 	ctx := &fi.CloudupContext{
@@ -354,6 +428,11 @@ func TestVMScaleSetCheckChanges(t *testing.T) {
 			changes: &VMScaleSet{Name: to.Ptr("newName")},
 			success: false,
 		},
+		{
+			a:       &VMScaleSet{Name: to.Ptr("name"), OrchestrationMode: to.Ptr("Uniform")},
+			changes: &VMScaleSet{OrchestrationMode: to.Ptr("Flexible")},
+			success: false,
+		},
 	}
 	for i, tc := range testCases {
 		t.Run(fmt.Sprintf("test case %d", i), func(t *testing.T) {
@@ -365,3 +444,52 @@ func TestVMScaleSetCheckChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestVMScaleSetNormalizeGalleryImage(t *testing.T) {
+	cloud := NewMockAzureCloud("eastus")
+	ctx := &fi.CloudupContext{
+		T: fi.CloudupSubContext{
+			Cloud: cloud,
+		},
+	}
+	cloud.GalleryImageVersionsClient.Versions["gallery/image"] = []*compute.GalleryImageVersion{
+		{
+			Name:       to.Ptr("1.2.0"),
+			Properties: &compute.GalleryImageVersionProperties{},
+		},
+		{
+			Name:       to.Ptr("1.10.0"),
+			Properties: &compute.GalleryImageVersionProperties{},
+		},
+		{
+			Name: to.Ptr("2.0.0"),
+			Properties: &compute.GalleryImageVersionProperties{
+				PublishingProfile: &compute.GalleryImageVersionPublishingProfile{
+					ExcludeFromLatest: to.Ptr(true),
+				},
+			},
+		},
+	}
+	image := "/subscriptions/subID/resourceGroups/images/providers/Microsoft.Compute/galleries/gallery/images/image/versions/"
+
+	vmss := newTestVMScaleSet()
+	vmss.StorageProfile.VirtualMachineScaleSetStorageProfile = &compute.VirtualMachineScaleSetStorageProfile{
+		ImageReference: &compute.ImageReference{
+			ID: to.Ptr(image + "latest"),
+		},
+	}
+	if err := vmss.Normalize(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if a, e := *vmss.StorageProfile.ImageReference.ID, image+"1.10.0"; a != e {
+		t.Errorf("unexpected image: expected %s, but got %s", e, a)
+	}
+
+	vmss.StorageProfile.ImageReference.ID = to.Ptr(image + "1.2.0")
+	if err := vmss.Normalize(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if a, e := *vmss.StorageProfile.ImageReference.ID, image+"1.2.0"; a != e {
+		t.Errorf("unexpected image: expected %s, but got %s", e, a)
+	}
+}
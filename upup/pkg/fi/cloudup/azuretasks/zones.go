@@ -0,0 +1,36 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuretasks
+
+import (
+	"slices"
+
+	"k8s.io/klog/v2"
+)
+
+// keepZones returns the zones of an existing resource, whose zones can only be set when it is created.
+// Expected tasks adopt them so that existing resources in other zones are kept, with a warning, rather than failing the update.
+func keepZones(kind string, name string, found []*string, expected []*string) []*string {
+	a := stringSlice(found)
+	e := stringSlice(expected)
+	slices.Sort(a)
+	slices.Sort(e)
+	if !slices.Equal(a, e) {
+		klog.Warningf("%s %q is in zones %v rather than %v; zones can only be set when it is created", kind, name, a, e)
+	}
+	return found
+}
@@ -37,9 +37,12 @@ import (
 	"k8s.io/kops/pkg/model/components/addonmanifests/clusterautoscaler"
 	"k8s.io/kops/pkg/model/components/addonmanifests/dnscontroller"
 	"k8s.io/kops/pkg/model/components/addonmanifests/externaldns"
+	"k8s.io/kops/pkg/model/components/addonmanifests/gcpcloudcontrollermanager"
+	"k8s.io/kops/pkg/model/components/addonmanifests/gcppdcsidriver"
 	"k8s.io/kops/pkg/model/components/addonmanifests/karpenter"
 	"k8s.io/kops/pkg/model/components/addonmanifests/kuberouter"
 	"k8s.io/kops/pkg/model/components/addonmanifests/nodeterminationhandler"
+	"k8s.io/kops/pkg/model/gcemodel"
 	"k8s.io/kops/pkg/model/iam"
 	"k8s.io/kops/pkg/templates"
 	"k8s.io/kops/pkg/wellknownoperators"
@@ -510,6 +513,25 @@ func (b *BootstrapChannelBuilder) buildAddons(c *fi.CloudupModelBuilderContext)
 		}
 	}
 
+	if b.Cluster.Spec.VerticalPodAutoscaler != nil && fi.ValueOf(b.Cluster.Spec.VerticalPodAutoscaler.Enabled) {
+		{
+			key := "vertical-pod-autoscaler.addons.k8s.io"
+
+			{
+				location := key + "/k8s-1.31.yaml"
+				id := "k8s-1.31"
+
+				addon := addons.Add(&channelsapi.AddonSpec{
+					Name:     new(key),
+					Manifest: new(location),
+					Id:       id,
+					NeedsPKI: true,
+				})
+				addon.BuildPrune = true
+			}
+		}
+	}
+
 	if b.Cluster.Spec.CertManager != nil && fi.ValueOf(b.Cluster.Spec.CertManager.Enabled) && (b.Cluster.Spec.CertManager.Managed == nil || fi.ValueOf(b.Cluster.Spec.CertManager.Managed)) {
 		{
 			key := "certmanager.io"
@@ -578,6 +600,23 @@ func (b *BootstrapChannelBuilder) buildAddons(c *fi.CloudupModelBuilderContext)
 		}
 	}
 
+	if b.Cluster.Spec.InterruptionHandler.IsEnabled() {
+		key := "interruption-handler.addons.k8s.io"
+
+		{
+			location := key + "/k8s-1.31.yaml"
+			id := "k8s-1.31"
+
+			addon := addons.Add(&channelsapi.AddonSpec{
+				Name:     new(key),
+				Selector: map[string]string{"k8s-addon": key},
+				Manifest: new(location),
+				Id:       id,
+			})
+			addon.BuildPrune = true
+		}
+	}
+
 	nvidia := b.Cluster.Spec.Containerd.NvidiaGPU
 	igNvidia := false
 	for _, ig := range b.KopsModelContext.InstanceGroups {
@@ -852,6 +891,9 @@ func (b *BootstrapChannelBuilder) buildAddons(c *fi.CloudupModelBuilderContext)
 					Id:       id,
 				})
 			}
+			if b.UseServiceAccountExternalPermissions() {
+				serviceAccountRoles = append(serviceAccountRoles, &gcppdcsidriver.ServiceAccount{})
+			}
 		}
 	}
 
@@ -945,6 +987,9 @@ func (b *BootstrapChannelBuilder) buildAddons(c *fi.CloudupModelBuilderContext)
 				})
 				addon.BuildPrune = true
 			}
+			if b.UseCloudControllerManagerExternalPermissions() {
+				serviceAccountRoles = append(serviceAccountRoles, &gcpcloudcontrollermanager.ServiceAccount{})
+			}
 		}
 	}
 
@@ -1065,6 +1110,10 @@ func (b *BootstrapChannelBuilder) buildAddons(c *fi.CloudupModelBuilderContext)
 		return nil, nil, fmt.Errorf("failed to add cilium addon: %w", err)
 	}
 
+	if err := addHelmChartCNIAddon(c.Context(), b, addons); err != nil {
+		return nil, nil, fmt.Errorf("failed to add helm networking addon: %w", err)
+	}
+
 	authenticationSelector := map[string]string{"role.kubernetes.io/authentication": "1"}
 
 	if b.Cluster.Spec.Authentication != nil {
@@ -1147,7 +1196,7 @@ func (b *BootstrapChannelBuilder) buildAddons(c *fi.CloudupModelBuilderContext)
 					Id:       id,
 				})
 			}
-			if b.UseServiceAccountExternalPermissions() {
+			if b.UseCloudControllerManagerExternalPermissions() {
 				serviceAccountRoles = append(serviceAccountRoles, &awscloudcontrollermanager.ServiceAccount{})
 			}
 		}
@@ -1205,6 +1254,11 @@ func (b *BootstrapChannelBuilder) buildAddons(c *fi.CloudupModelBuilderContext)
 		}
 	}
 
+	// User-defined addons go last, so that we can reject names that collide with a built-in addon.
+	if err := addUserAddons(c.Context(), b, addons); err != nil {
+		return nil, nil, fmt.Errorf("failed to add user-defined addons: %w", err)
+	}
+
 	serviceAccounts := make(map[types.NamespacedName]iam.Subject)
 
 	if b.Cluster.GetCloudProvider() == kops.CloudProviderAWS && b.Cluster.Spec.KubeAPIServer.ServiceAccountIssuer != nil {
@@ -1223,6 +1277,27 @@ func (b *BootstrapChannelBuilder) buildAddons(c *fi.CloudupModelBuilderContext)
 			serviceAccounts[sa] = serviceAccountRole
 		}
 	}
+
+	if b.Cluster.GetCloudProvider() == kops.CloudProviderGCE && b.Cluster.Spec.KubeAPIServer.ServiceAccountIssuer != nil {
+		gceModelContext := &gcemodel.GCEModelContext{
+			ProjectID:        b.Cluster.Spec.CloudProvider.GCE.Project,
+			KopsModelContext: b.KopsModelContext,
+		}
+
+		for _, serviceAccountRole := range serviceAccountRoles {
+			if _, ok := serviceAccountRole.(iam.GCPSubject); !ok {
+				// The addon does not need GCP permissions
+				continue
+			}
+			workloadIdentityBuilder := &gcemodel.WorkloadIdentityBuilder{GCEModelContext: gceModelContext, Lifecycle: b.Lifecycle}
+
+			if err := workloadIdentityBuilder.BuildServiceAccountTasks(serviceAccountRole, c); err != nil {
+				return nil, nil, err
+			}
+			sa, _ := serviceAccountRole.ServiceAccount()
+			serviceAccounts[sa] = serviceAccountRole
+		}
+	}
 	return addons, serviceAccounts, nil
 }
 
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapchannelbuilder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/channels/pkg/api"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+// helmChartRenderer renders a Helm chart to a manifest; it is a variable so that tests can replace it.
+var helmChartRenderer = renderHelmChart
+
+func addHelmChartCNIAddon(ctx context.Context, b *BootstrapChannelBuilder, addons *AddonList) error {
+	cni := b.Cluster.Spec.Networking.CNI
+	if cni == nil || cni.HelmChart == nil {
+		return nil
+	}
+	chart := cni.HelmChart
+
+	key := "networking.helm"
+	if b.hasExternalAddon(key) {
+		klog.Infof("found helm networking (%q) in addons; won't use builtin", key)
+		return nil
+	}
+
+	manifest, err := helmChartRenderer(ctx, chart, b.Cluster.Spec.KubernetesVersion)
+	if err != nil {
+		return err
+	}
+
+	// The id changes with the chart, so that switching to another chart prunes the objects of the previous one
+	id := chart.Name + "-" + chart.Version
+	location := key + "/" + id + ".yaml"
+
+	addon := addons.AddWithSource(&api.AddonSpec{
+		Name:               new(key),
+		Selector:           networkingSelector(),
+		Manifest:           new(location),
+		Id:                 id,
+		NeedsRollingUpdate: api.NeedsRollingUpdateAll,
+	}, fi.NewBytesResource(manifest))
+	addon.BuildPrune = true
+
+	return nil
+}
+
+// helmTemplateArgs builds the arguments of the `helm template` command for the chart.
+func helmTemplateArgs(chart *kops.HelmChartSpec, kubernetesVersion string, valuesFile string) []string {
+	releaseName := chart.ReleaseName
+	if releaseName == "" {
+		releaseName = chart.Name
+	}
+	namespace := chart.Namespace
+	if namespace == "" {
+		namespace = "kube-system"
+	}
+
+	args := []string{"template", releaseName}
+	if strings.HasPrefix(chart.Repository, "oci://") {
+		args = append(args, strings.TrimSuffix(chart.Repository, "/")+"/"+chart.Name)
+	} else {
+		args = append(args, chart.Name, "--repo", chart.Repository)
+	}
+	args = append(args,
+		"--version", chart.Version,
+		"--namespace", namespace,
+		"--kube-version", kubernetesVersion,
+		"--include-crds",
+		"--skip-tests",
+	)
+	if valuesFile != "" {
+		args = append(args, "--values", valuesFile)
+	}
+	return args
+}
+
+// renderHelmChart renders the chart with the helm binary found in PATH.
+func renderHelmChart(ctx context.Context, chart *kops.HelmChartSpec, kubernetesVersion string) ([]byte, error) {
+	helmPath, err := exec.LookPath("helm")
+	if err != nil {
+		return nil, fmt.Errorf("the helm binary is required to render the networking chart %q: %w", chart.Name, err)
+	}
+
+	valuesFile := ""
+	if chart.Values != "" {
+		tmpDir, err := os.MkdirTemp("", "helm-values")
+		if err != nil {
+			return nil, fmt.Errorf("error creating temp dir: %w", err)
+		}
+		defer func() {
+			if err := os.RemoveAll(tmpDir); err != nil {
+				klog.Warningf("error deleting temp dir %q: %v", tmpDir, err)
+			}
+		}()
+
+		valuesFile = filepath.Join(tmpDir, "values.yaml")
+		if err := os.WriteFile(valuesFile, []byte(chart.Values), 0o600); err != nil {
+			return nil, fmt.Errorf("error writing values file: %w", err)
+		}
+	}
+
+	cmd := exec.CommandContext(ctx, helmPath, helmTemplateArgs(chart, kubernetesVersion, valuesFile)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	klog.V(2).Infof("Running command: %s", strings.Join(cmd.Args, " "))
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("error rendering helm chart %q version %q: %w: %s", chart.Name, chart.Version, err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
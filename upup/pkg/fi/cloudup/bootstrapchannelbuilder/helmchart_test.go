@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapchannelbuilder
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/model"
+	"k8s.io/kops/pkg/model/iam"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func TestHelmTemplateArgs(t *testing.T) {
+	grid := []struct {
+		chart      kops.HelmChartSpec
+		valuesFile string
+		expected   []string
+	}{
+		{
+			chart: kops.HelmChartSpec{
+				Repository: "https://helm.cilium.io",
+				Name:       "cilium",
+				Version:    "1.18.2",
+			},
+			expected: []string{"template", "cilium", "cilium", "--repo", "https://helm.cilium.io", "--version", "1.18.2", "--namespace", "kube-system", "--kube-version", "1.34.0", "--include-crds", "--skip-tests"},
+		},
+		{
+			chart: kops.HelmChartSpec{
+				Repository:  "oci://registry.example.com/charts/",
+				Name:        "cni",
+				Version:     "0.1.0",
+				ReleaseName: "my-cni",
+				Namespace:   "cni-system",
+			},
+			valuesFile: "/tmp/values.yaml",
+			expected:   []string{"template", "my-cni", "oci://registry.example.com/charts/cni", "--version", "0.1.0", "--namespace", "cni-system", "--kube-version", "1.34.0", "--include-crds", "--skip-tests", "--values", "/tmp/values.yaml"},
+		},
+	}
+
+	for _, g := range grid {
+		actual := helmTemplateArgs(&g.chart, "1.34.0", g.valuesFile)
+		if !reflect.DeepEqual(actual, g.expected) {
+			t.Errorf("unexpected args for %q:\n  got:  %v\n  want: %v", g.chart.Name, actual, g.expected)
+		}
+	}
+}
+
+func TestAddHelmChartCNIAddon(t *testing.T) {
+	rendered := []byte("apiVersion: apps/v1\nkind: DaemonSet\nmetadata:\n  name: cni\n")
+	defer func(r func(context.Context, *kops.HelmChartSpec, string) ([]byte, error)) { helmChartRenderer = r }(helmChartRenderer)
+	helmChartRenderer = func(ctx context.Context, chart *kops.HelmChartSpec, kubernetesVersion string) ([]byte, error) {
+		return rendered, nil
+	}
+
+	cluster := &kops.Cluster{}
+	cluster.Spec.KubernetesVersion = "1.34.0"
+	cluster.Spec.Networking.CNI = &kops.CNINetworkingSpec{
+		HelmChart: &kops.HelmChartSpec{
+			Repository: "https://charts.example.com",
+			Name:       "cni",
+			Version:    "1.2.3",
+		},
+	}
+	b := &BootstrapChannelBuilder{
+		KopsModelContext: &model.KopsModelContext{
+			IAMModelContext: iam.IAMModelContext{Cluster: cluster},
+		},
+	}
+
+	addons := &AddonList{}
+	if err := addHelmChartCNIAddon(context.Background(), b, addons); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(addons.Items) != 1 {
+		t.Fatalf("expected 1 addon, got %d", len(addons.Items))
+	}
+
+	addon := addons.Items[0]
+	if *addon.Spec.Manifest != "networking.helm/cni-1.2.3.yaml" {
+		t.Errorf("unexpected manifest location %q", *addon.Spec.Manifest)
+	}
+	if !reflect.DeepEqual(addon.Spec.Selector, networkingSelector()) {
+		t.Errorf("unexpected selector %v", addon.Spec.Selector)
+	}
+	if !addon.SkipRender || !addon.BuildPrune {
+		t.Errorf("expected rendered chart to skip templating and build prune specs")
+	}
+	actual, err := fi.ResourceAsBytes(addon.Source)
+	if err != nil {
+		t.Fatalf("reading addon source: %v", err)
+	}
+	if string(actual) != string(rendered) {
+		t.Errorf("unexpected addon source %q", actual)
+	}
+}
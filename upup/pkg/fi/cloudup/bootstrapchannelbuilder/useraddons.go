@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapchannelbuilder
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/channels/pkg/api"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+// userAddonReader reads the manifest of a user-defined addon; it is a variable so that tests can replace it.
+var userAddonReader = readUserAddon
+
+// addUserAddons adds the named entries of spec.addons to the bootstrap channel.
+// Their manifests are read now, so that kops-channels applies, tracks (by manifest hash) and prunes them
+// exactly like the built-in addons.
+func addUserAddons(ctx context.Context, b *BootstrapChannelBuilder, addons *AddonList) error {
+	for _, userAddon := range b.Cluster.Spec.Addons {
+		if userAddon.Name == "" {
+			continue
+		}
+		key := userAddon.Name
+
+		for _, existing := range addons.Items {
+			if *existing.Spec.Name == key {
+				return fmt.Errorf("addon %q conflicts with a built-in addon", key)
+			}
+		}
+
+		manifest, err := userAddonReader(ctx, userAddon.Manifest)
+		if err != nil {
+			return fmt.Errorf("reading manifest of addon %q: %w", key, err)
+		}
+
+		id := userAddon.Version
+		location := key + "/default.yaml"
+		if id != "" {
+			location = key + "/" + id + ".yaml"
+		}
+
+		addon := addons.AddWithSource(&api.AddonSpec{
+			Name:     new(key),
+			Selector: map[string]string{"k8s-addon": key},
+			Manifest: new(location),
+			Id:       id,
+		}, fi.NewBytesResource(manifest))
+		addon.BuildPrune = true
+	}
+
+	return nil
+}
+
+// readUserAddon reads a manifest from an OCI artifact reference (oci://registry/repository:tag) or a VFS location.
+func readUserAddon(ctx context.Context, location string) ([]byte, error) {
+	if ref, ok := strings.CutPrefix(location, "oci://"); ok {
+		return readOCIManifest(ctx, ref)
+	}
+
+	klog.V(2).Infof("Reading addon manifest from %q", location)
+	p, err := vfs.Context.BuildVfsPath(location)
+	if err != nil {
+		return nil, fmt.Errorf("parsing location %q: %w", location, err)
+	}
+	return p.ReadFile(ctx)
+}
+
+// readOCIManifest reads a manifest stored as the single layer of an OCI artifact, as pushed by e.g. `oras push`.
+func readOCIManifest(ctx context.Context, reference string) ([]byte, error) {
+	ref, err := name.ParseReference(reference)
+	if err != nil {
+		return nil, fmt.Errorf("parsing reference %q: %w", reference, err)
+	}
+
+	klog.V(2).Infof("Pulling addon manifest from %q", ref)
+	img, err := remote.Image(ref, remote.WithContext(ctx), remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return nil, fmt.Errorf("fetching %q: %w", ref, err)
+	}
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("reading layers of %q: %w", ref, err)
+	}
+	if len(layers) != 1 {
+		return nil, fmt.Errorf("expected %q to have a single layer containing the manifest, found %d", ref, len(layers))
+	}
+
+	r, err := layers[0].Compressed()
+	if err != nil {
+		return nil, fmt.Errorf("reading layer of %q: %w", ref, err)
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
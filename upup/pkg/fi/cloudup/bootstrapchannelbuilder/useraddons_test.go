@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapchannelbuilder
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/kops/channels/pkg/api"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/model"
+	"k8s.io/kops/pkg/model/iam"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func TestAddUserAddons(t *testing.T) {
+	manifests := map[string]string{
+		"s3://bucket/my-tool.yaml":                 "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: my-tool\n",
+		"oci://registry.example.com/other-tool:v1": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: other-tool\n",
+	}
+	defer func(r func(context.Context, string) ([]byte, error)) { userAddonReader = r }(userAddonReader)
+	userAddonReader = func(ctx context.Context, location string) ([]byte, error) {
+		return []byte(manifests[location]), nil
+	}
+
+	cluster := &kops.Cluster{}
+	cluster.Spec.Addons = []kops.AddonSpec{
+		{Manifest: "s3://bucket/channel.yaml"},
+		{Name: "my-tool", Manifest: "s3://bucket/my-tool.yaml"},
+		{Name: "other-tool", Version: "1.0.0", Manifest: "oci://registry.example.com/other-tool:v1"},
+	}
+	b := &BootstrapChannelBuilder{
+		KopsModelContext: &model.KopsModelContext{
+			IAMModelContext: iam.IAMModelContext{Cluster: cluster},
+		},
+	}
+
+	addons := &AddonList{}
+	if err := addUserAddons(context.Background(), b, addons); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(addons.Items) != 2 {
+		t.Fatalf("expected 2 addons, got %d", len(addons.Items))
+	}
+
+	expected := []struct {
+		name     string
+		manifest string
+		id       string
+		source   string
+	}{
+		{name: "my-tool", manifest: "my-tool/default.yaml", source: manifests["s3://bucket/my-tool.yaml"]},
+		{name: "other-tool", manifest: "other-tool/1.0.0.yaml", id: "1.0.0", source: manifests["oci://registry.example.com/other-tool:v1"]},
+	}
+	for i, e := range expected {
+		addon := addons.Items[i]
+		if *addon.Spec.Name != e.name || *addon.Spec.Manifest != e.manifest || addon.Spec.Id != e.id {
+			t.Errorf("unexpected addon spec %+v", addon.Spec)
+		}
+		if !addon.SkipRender || !addon.BuildPrune {
+			t.Errorf("expected addon %q to skip templating and build prune specs", e.name)
+		}
+		actual, err := fi.ResourceAsBytes(addon.Source)
+		if err != nil {
+			t.Fatalf("reading addon source: %v", err)
+		}
+		if string(actual) != e.source {
+			t.Errorf("unexpected source for addon %q: %q", e.name, actual)
+		}
+	}
+}
+
+func TestAddUserAddonsConflict(t *testing.T) {
+	defer func(r func(context.Context, string) ([]byte, error)) { userAddonReader = r }(userAddonReader)
+	userAddonReader = func(ctx context.Context, location string) ([]byte, error) {
+		return nil, nil
+	}
+
+	cluster := &kops.Cluster{}
+	cluster.Spec.Addons = []kops.AddonSpec{
+		{Name: "karpenter.sh", Manifest: "s3://bucket/karpenter.yaml"},
+	}
+	b := &BootstrapChannelBuilder{
+		KopsModelContext: &model.KopsModelContext{
+			IAMModelContext: iam.IAMModelContext{Cluster: cluster},
+		},
+	}
+
+	addons := &AddonList{}
+	addons.Add(&api.AddonSpec{Name: new("karpenter.sh")})
+	err := addUserAddons(context.Background(), b, addons)
+	if err == nil || !strings.Contains(err.Error(), "conflicts with a built-in addon") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestReadUserAddonFromVFS(t *testing.T) {
+	vfs.Context.ResetMemfsContext(true)
+	p, err := vfs.Context.BuildVfsPath("memfs://tests/addons/my-tool.yaml")
+	if err != nil {
+		t.Fatalf("building path: %v", err)
+	}
+	manifest := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: my-tool\n"
+	if err := p.WriteFile(context.Background(), strings.NewReader(manifest), nil); err != nil {
+		t.Fatalf("writing manifest: %v", err)
+	}
+
+	actual, err := readUserAddon(context.Background(), "memfs://tests/addons/my-tool.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(actual) != manifest {
+		t.Errorf("unexpected manifest %q", actual)
+	}
+}
@@ -50,13 +50,17 @@ func TestBootstrapChannelBuilder_BuildTasks(t *testing.T) {
 	runChannelBuilderTest(t, "dns-none", []string{"dns-controller.addons.k8s.io-k8s-1.12"})
 	// Use cilium networking, proxy
 	runChannelBuilderTest(t, "cilium", []string{"kops-controller.addons.k8s.io-k8s-1.16"})
+	// Use cilium with hubble relay TLS, hubble UI and cluster mesh
+	runChannelBuilderTest(t, "cilium-hubble", []string{"networking.cilium.io-k8s-1.16"})
 	runChannelBuilderTest(t, "amazonvpc", []string{"networking.amazon-vpc-routed-eni-k8s-1.16"})
 	runChannelBuilderTest(t, "amazonvpc-containerd", []string{"networking.amazon-vpc-routed-eni-k8s-1.16"})
 	runChannelBuilderTest(t, "awsiamauthenticator/crd", []string{"authentication.aws-k8s-1.12"})
 	runChannelBuilderTest(t, "awsiamauthenticator/mappings", []string{"authentication.aws-k8s-1.12"})
 	runChannelBuilderTest(t, "metrics-server/insecure-1.19", []string{"metrics-server.addons.k8s.io-k8s-1.11"})
 	runChannelBuilderTest(t, "metrics-server/secure-1.19", []string{"metrics-server.addons.k8s.io-k8s-1.11"})
+	runChannelBuilderTest(t, "vertical-pod-autoscaler", []string{"vertical-pod-autoscaler.addons.k8s.io-k8s-1.31"})
 	runChannelBuilderTest(t, "coredns", []string{"coredns.addons.k8s.io-k8s-1.12"})
+	runChannelBuilderTest(t, "interruption-handler", []string{"interruption-handler.addons.k8s.io-k8s-1.31"})
 }
 
 func TestBootstrapChannelBuilder_ServiceAccountIAM(t *testing.T) {
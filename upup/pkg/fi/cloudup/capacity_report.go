@@ -0,0 +1,397 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/amazon-ec2-instance-selector/v3/pkg/ec2pricing"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans"
+	savingsplanstypes "github.com/aws/aws-sdk-go-v2/service/savingsplans/types"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/awsinterfaces"
+)
+
+// onDemandPricer looks up the hourly on-demand price, in USD, of an EC2 instance type.
+type onDemandPricer interface {
+	GetOnDemandInstanceTypeCost(ctx context.Context, instanceType ec2types.InstanceType) (float64, error)
+}
+
+// capacityPlan describes the on-demand capacity that an instance group may launch at its max size.
+type capacityPlan struct {
+	InstanceGroup string
+	// InstanceTypes are the instance types the group may launch, in order of preference.
+	InstanceTypes []string
+	// OnDemandInstances is the number of on-demand instances the group may run at its max size.
+	OnDemandInstances int32
+	// Prices holds the hourly on-demand price of each of InstanceTypes; types whose price could not be looked up are missing.
+	Prices map[string]float64
+	// MaxPrice is the guardrail configured on the instance group, if any.
+	MaxPrice *float64
+}
+
+// checkAWSCapacity enforces the instance groups' onDemandMaxPrice guardrails and, if requested,
+// prints how the planned on-demand capacity maps onto the account's reserved instances and Savings Plans.
+// The guardrails fail when the prices cannot be looked up, unless AllowUnknownOnDemandPrices is set.
+func (c *ApplyClusterCmd) checkAWSCapacity(ctx context.Context, cloud awsup.AWSCloud, instanceGroups []*kops.InstanceGroup, out io.Writer) error {
+	guarded := false
+	for _, ig := range instanceGroups {
+		if ig.Spec.OnDemandMaxPrice != nil {
+			guarded = true
+		}
+	}
+	if !guarded && !c.CapacityReport {
+		return nil
+	}
+
+	pricer, err := ec2pricing.New(ctx, cloud.Config())
+	if err != nil {
+		if guarded && !c.AllowUnknownOnDemandPrices {
+			return fmt.Errorf("unable to build EC2 pricing client to enforce onDemandMaxPrice (use --allow-unknown-on-demand-prices to skip the check): %w", err)
+		}
+		klog.Warningf("not checking on-demand prices, unable to build EC2 pricing client: %v", err)
+		return nil
+	}
+
+	plans, err := planOnDemandCapacity(ctx, instanceGroups, pricer)
+	if err != nil {
+		return err
+	}
+
+	if err := checkOnDemandMaxPrice(plans, c.AllowUnknownOnDemandPrices); err != nil {
+		return err
+	}
+
+	if c.CapacityReport {
+		reserved, err := findReservations(ctx, cloud.EC2(), savingsplans.NewFromConfig(cloud.Config()), cloud.Region())
+		if err != nil {
+			return err
+		}
+		if err := writeCapacityReport(out, plans, reserved); err != nil {
+			return fmt.Errorf("writing capacity report: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// planOnDemandCapacity computes the on-demand capacity plan for every cloud-group managed instance group.
+func planOnDemandCapacity(ctx context.Context, instanceGroups []*kops.InstanceGroup, pricer onDemandPricer) ([]*capacityPlan, error) {
+	var plans []*capacityPlan
+	for _, ig := range instanceGroups {
+		if ig.IsKarpenterManaged() {
+			continue
+		}
+
+		plan := &capacityPlan{
+			InstanceGroup:     ig.Name,
+			InstanceTypes:     onDemandInstanceTypes(ig),
+			OnDemandInstances: maxOnDemandInstances(ig),
+			Prices:            make(map[string]float64),
+		}
+		if ig.Spec.OnDemandMaxPrice != nil {
+			maxPrice, err := strconv.ParseFloat(*ig.Spec.OnDemandMaxPrice, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing onDemandMaxPrice of instance group %q: %w", ig.Name, err)
+			}
+			plan.MaxPrice = &maxPrice
+		}
+		if plan.OnDemandInstances == 0 {
+			continue
+		}
+
+		for _, instanceType := range plan.InstanceTypes {
+			price, err := pricer.GetOnDemandInstanceTypeCost(ctx, ec2types.InstanceType(instanceType))
+			if err != nil {
+				klog.Warningf("unable to look up on-demand price of %q for instance group %q: %v", instanceType, ig.Name, err)
+				continue
+			}
+			plan.Prices[instanceType] = price
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// onDemandInstanceTypes returns the explicitly configured instance types for an instance group.
+// Groups selecting instance types by attributes only return their primary machine type.
+func onDemandInstanceTypes(ig *kops.InstanceGroup) []string {
+	var instanceTypes []string
+	seen := make(map[string]bool)
+	add := func(s string) {
+		for _, instanceType := range strings.Split(s, ",") {
+			instanceType = strings.TrimSpace(instanceType)
+			if instanceType != "" && !seen[instanceType] {
+				seen[instanceType] = true
+				instanceTypes = append(instanceTypes, instanceType)
+			}
+		}
+	}
+	add(ig.Spec.MachineType)
+	if ig.Spec.MixedInstancesPolicy != nil {
+		for _, instanceType := range ig.Spec.MixedInstancesPolicy.Instances {
+			add(instanceType)
+		}
+	}
+	return instanceTypes
+}
+
+// maxOnDemandInstances returns how many on-demand instances the group runs when scaled to its max size.
+func maxOnDemandInstances(ig *kops.InstanceGroup) int32 {
+	maxSize := fi.ValueOf(ig.Spec.MaxSize)
+
+	spec := ig.Spec.MixedInstancesPolicy
+	if spec == nil {
+		if ig.Spec.MaxPrice != nil {
+			// A spot-only group
+			return 0
+		}
+		return maxSize
+	}
+
+	base := min(int32(fi.ValueOf(spec.OnDemandBase)), maxSize)
+	aboveBase := int64(100)
+	if spec.OnDemandAboveBase != nil {
+		aboveBase = *spec.OnDemandAboveBase
+	}
+	return base + int32(math.Ceil(float64(maxSize-base)*float64(aboveBase)/100))
+}
+
+// checkOnDemandMaxPrice returns an error naming every instance type priced above its group's onDemandMaxPrice.
+// Instance types with an unknown price are also reported, unless allowUnknownPrices is set.
+func checkOnDemandMaxPrice(plans []*capacityPlan, allowUnknownPrices bool) error {
+	var errs []error
+	for _, plan := range plans {
+		if plan.MaxPrice == nil {
+			continue
+		}
+		for _, instanceType := range plan.InstanceTypes {
+			price, found := plan.Prices[instanceType]
+			if !found {
+				if allowUnknownPrices {
+					klog.Warningf("instance group %q: not checking onDemandMaxPrice of %s, its on-demand price is unknown", plan.InstanceGroup, instanceType)
+				} else {
+					errs = append(errs, fmt.Errorf("instance group %q: on-demand price of %s is unknown, unable to enforce onDemandMaxPrice (use --allow-unknown-on-demand-prices to skip the check)", plan.InstanceGroup, instanceType))
+				}
+				continue
+			}
+			if price > *plan.MaxPrice {
+				errs = append(errs, fmt.Errorf("instance group %q: on-demand price of %s is $%.4f/hour, above onDemandMaxPrice of $%.4f/hour", plan.InstanceGroup, instanceType, price, *plan.MaxPrice))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// savingsPlansLister lists the Savings Plans of the account.
+type savingsPlansLister interface {
+	DescribeSavingsPlans(ctx context.Context, params *savingsplans.DescribeSavingsPlansInput, optFns ...func(*savingsplans.Options)) (*savingsplans.DescribeSavingsPlansOutput, error)
+}
+
+// reservations are the commitments of the account that on-demand instances in the region consume.
+type reservations struct {
+	// units are the normalized units of regional Linux reserved instances, which apply to any size in their instance family, by family.
+	units map[string]float64
+	// instances are the reserved instances that only apply to their instance type, by instance type.
+	instances map[string]int32
+	// computeCommitment is the hourly commitment, in USD, of the Compute Savings Plans.
+	computeCommitment float64
+	// familyCommitments are the hourly commitments, in USD, of the EC2 Instance Savings Plans for the region, by instance family.
+	familyCommitments map[string]float64
+}
+
+// findReservations returns the active reserved instances in the region and the active Savings Plans of the account.
+// Savings Plans are skipped, with a warning, if they cannot be listed.
+func findReservations(ctx context.Context, ec2Client awsinterfaces.EC2API, savingsPlansClient savingsPlansLister, region string) (*reservations, error) {
+	r := &reservations{
+		units:             make(map[string]float64),
+		instances:         make(map[string]int32),
+		familyCommitments: make(map[string]float64),
+	}
+
+	response, err := ec2Client.DescribeReservedInstances(ctx, &ec2.DescribeReservedInstancesInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("state"), Values: []string{string(ec2types.ReservedInstanceStateActive)}},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing reserved instances: %w", err)
+	}
+	for _, ri := range response.ReservedInstances {
+		instanceType := string(ri.InstanceType)
+		count := aws.ToInt32(ri.InstanceCount)
+		// Regional reserved instances for Linux are size-flexible
+		family, factor, flexible := normalizationFactor(instanceType)
+		if flexible && ri.Scope == ec2types.ScopeRegional && strings.HasPrefix(string(ri.ProductDescription), "Linux/UNIX") {
+			r.units[family] += float64(count) * factor
+		} else {
+			r.instances[instanceType] += count
+		}
+	}
+
+	var nextToken *string
+	for {
+		response, err := savingsPlansClient.DescribeSavingsPlans(ctx, &savingsplans.DescribeSavingsPlansInput{
+			States:    []savingsplanstypes.SavingsPlanState{savingsplanstypes.SavingsPlanStateActive},
+			NextToken: nextToken,
+		})
+		if err != nil {
+			klog.Warningf("not including Savings Plans in the capacity report, unable to list them: %v", err)
+			break
+		}
+		for _, plan := range response.SavingsPlans {
+			commitment, err := strconv.ParseFloat(aws.ToString(plan.Commitment), 64)
+			if err != nil {
+				klog.Warningf("ignoring Savings Plan %q with invalid commitment %q", aws.ToString(plan.SavingsPlanId), aws.ToString(plan.Commitment))
+				continue
+			}
+			switch plan.SavingsPlanType {
+			case savingsplanstypes.SavingsPlanTypeCompute:
+				r.computeCommitment += commitment
+			case savingsplanstypes.SavingsPlanTypeEc2Instance:
+				if aws.ToString(plan.Region) == region {
+					r.familyCommitments[aws.ToString(plan.Ec2InstanceFamily)] += commitment
+				}
+			}
+		}
+		nextToken = response.NextToken
+		if aws.ToString(nextToken) == "" {
+			break
+		}
+	}
+
+	return r, nil
+}
+
+// normalizationFactor returns the instance family of an instance type, and its size as a number of normalized units.
+// Reserved instances that are size-flexible cover instances of any size in their family, by normalized units.
+// It returns false for sizes without a fixed factor, such as metal.
+func normalizationFactor(instanceType string) (string, float64, bool) {
+	family, size, found := strings.Cut(instanceType, ".")
+	if !found {
+		return "", 0, false
+	}
+	switch size {
+	case "nano":
+		return family, 0.25, true
+	case "micro":
+		return family, 0.5, true
+	case "small":
+		return family, 1, true
+	case "medium":
+		return family, 2, true
+	case "large":
+		return family, 4, true
+	case "xlarge":
+		return family, 8, true
+	}
+	if multiple, found := strings.CutSuffix(size, "xlarge"); found {
+		if n, err := strconv.Atoi(multiple); err == nil && n > 0 {
+			return family, float64(8 * n), true
+		}
+	}
+	return "", 0, false
+}
+
+// reserve consumes the reservations for up to count instances of the instance type, and returns how many were covered.
+func (r *reservations) reserve(instanceType string, count int32) int32 {
+	covered := min(count, r.instances[instanceType])
+	r.instances[instanceType] -= covered
+
+	if family, factor, ok := normalizationFactor(instanceType); ok && covered < count {
+		flexible := min(count-covered, int32(r.units[family]/factor))
+		r.units[family] -= float64(flexible) * factor
+		covered += flexible
+	}
+	return covered
+}
+
+// commit consumes the Savings Plans commitments for up to cost of hourly on-demand usage of the instance type, and returns how much was covered.
+// Savings Plans are charged at discounted rates, so the commitment covers at least as much on-demand usage.
+func (r *reservations) commit(instanceType string, cost float64) float64 {
+	family, _, _ := strings.Cut(instanceType, ".")
+	covered := min(cost, r.familyCommitments[family])
+	r.familyCommitments[family] -= covered
+
+	compute := min(cost-covered, r.computeCommitment)
+	r.computeCommitment -= compute
+	return covered + compute
+}
+
+// writeCapacityReport prints the planned on-demand capacity, the reserved instances it can consume,
+// the on-demand spend covered by Savings Plans, and the remaining hourly on-demand spend.
+// Reserved instances are matched against every instance type the group may launch, in order of preference.
+func writeCapacityReport(out io.Writer, plans []*capacityPlan, reserved *reservations) error {
+	sort.Slice(plans, func(i, j int) bool { return plans[i].InstanceGroup < plans[j].InstanceGroup })
+
+	fmt.Fprintf(out, "\nOn-demand capacity at max size:\n\n")
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "INSTANCE GROUP\tINSTANCE TYPES\tON-DEMAND\tRESERVED\tCOST/HOUR\tSAVINGS PLANS/HOUR\tUNCOVERED/HOUR\n")
+
+	var total, savingsPlans, uncovered float64
+	var unpriced []string
+	for _, plan := range plans {
+		if len(plan.InstanceTypes) == 0 {
+			continue
+		}
+		// The ASG launches the first listed instance type unless it lacks capacity, so we price the group with it.
+		instanceType := plan.InstanceTypes[0]
+		price, found := plan.Prices[instanceType]
+		if !found {
+			unpriced = append(unpriced, plan.InstanceGroup)
+		}
+
+		remaining := plan.OnDemandInstances
+		for _, t := range plan.InstanceTypes {
+			remaining -= reserved.reserve(t, remaining)
+		}
+		covered := plan.OnDemandInstances - remaining
+
+		cost := price * float64(plan.OnDemandInstances)
+		savingsPlansCost := reserved.commit(instanceType, price*float64(remaining))
+		uncoveredCost := price*float64(remaining) - savingsPlansCost
+		total += cost
+		savingsPlans += savingsPlansCost
+		uncovered += uncoveredCost
+
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t$%.4f\t$%.4f\t$%.4f\n", plan.InstanceGroup, strings.Join(plan.InstanceTypes, ","), plan.OnDemandInstances, covered, cost, savingsPlansCost, uncoveredCost)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "\nTotal on-demand cost: $%.4f/hour, of which $%.4f/hour is covered by Savings Plans and $%.4f/hour is not covered by reserved instances or Savings Plans.\n", total, savingsPlans, uncovered)
+	if len(unpriced) != 0 {
+		fmt.Fprintf(out, "The on-demand price of instance groups %s could not be looked up; they are not included in the costs.\n", strings.Join(unpriced, ", "))
+	}
+	fmt.Fprintf(out, "\n")
+	return nil
+}
@@ -0,0 +1,322 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans"
+	savingsplanstypes "github.com/aws/aws-sdk-go-v2/service/savingsplans/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/util/pkg/awsinterfaces"
+)
+
+type fakePricer map[string]float64
+
+func (p fakePricer) GetOnDemandInstanceTypeCost(ctx context.Context, instanceType ec2types.InstanceType) (float64, error) {
+	price, found := p[string(instanceType)]
+	if !found {
+		return 0, fmt.Errorf("no price for %q", instanceType)
+	}
+	return price, nil
+}
+
+func TestMaxOnDemandInstances(t *testing.T) {
+	grid := []struct {
+		desc     string
+		spec     kops.InstanceGroupSpec
+		expected int32
+	}{
+		{
+			desc:     "on-demand",
+			spec:     kops.InstanceGroupSpec{MaxSize: new(int32(5))},
+			expected: 5,
+		},
+		{
+			desc:     "spot",
+			spec:     kops.InstanceGroupSpec{MaxSize: new(int32(5)), MaxPrice: new("0.1")},
+			expected: 0,
+		},
+		{
+			desc: "mixed default",
+			spec: kops.InstanceGroupSpec{
+				MaxSize:              new(int32(5)),
+				MixedInstancesPolicy: &kops.MixedInstancesPolicySpec{},
+			},
+			expected: 5,
+		},
+		{
+			desc: "mixed base and percentage",
+			spec: kops.InstanceGroupSpec{
+				MaxSize: new(int32(10)),
+				MixedInstancesPolicy: &kops.MixedInstancesPolicySpec{
+					OnDemandBase:      new(int64(2)),
+					OnDemandAboveBase: new(int64(25)),
+				},
+			},
+			expected: 4,
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			ig := &kops.InstanceGroup{Spec: g.spec}
+			if actual := maxOnDemandInstances(ig); actual != g.expected {
+				t.Errorf("expected %d on-demand instances, got %d", g.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCheckOnDemandMaxPrice(t *testing.T) {
+	pricer := fakePricer{
+		"m5.large":  0.096,
+		"m5.xlarge": 0.192,
+	}
+
+	instanceGroups := []*kops.InstanceGroup{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "cheap"},
+			Spec: kops.InstanceGroupSpec{
+				MachineType:      "m5.large",
+				MaxSize:          new(int32(3)),
+				OnDemandMaxPrice: new("0.10"),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "mixed"},
+			Spec: kops.InstanceGroupSpec{
+				MachineType:      "m5.large",
+				MaxSize:          new(int32(3)),
+				OnDemandMaxPrice: new("0.10"),
+				MixedInstancesPolicy: &kops.MixedInstancesPolicySpec{
+					Instances: []string{"m5.large", "m5.xlarge"},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "spot"},
+			Spec: kops.InstanceGroupSpec{
+				MachineType:      "c5.large",
+				MaxSize:          new(int32(3)),
+				MaxPrice:         new("0.05"),
+				OnDemandMaxPrice: new("0.01"),
+			},
+		},
+	}
+
+	plans, err := planOnDemandCapacity(context.Background(), instanceGroups, pricer)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plans) != 2 {
+		t.Fatalf("expected 2 plans, got %d", len(plans))
+	}
+
+	err = checkOnDemandMaxPrice(plans, false)
+	if err == nil {
+		t.Fatalf("expected error for instance group priced above its guardrail")
+	}
+	if !strings.Contains(err.Error(), `"mixed"`) || !strings.Contains(err.Error(), "m5.xlarge") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if strings.Contains(err.Error(), `"cheap"`) {
+		t.Errorf("instance group within its guardrail was reported: %v", err)
+	}
+}
+
+func TestPlanOnDemandCapacityUnknownPrice(t *testing.T) {
+	instanceGroups := []*kops.InstanceGroup{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "nodes"},
+			Spec: kops.InstanceGroupSpec{
+				MachineType:      "m7i.large",
+				MaxSize:          new(int32(3)),
+				OnDemandMaxPrice: new("0.01"),
+			},
+		},
+	}
+
+	plans, err := planOnDemandCapacity(context.Background(), instanceGroups, fakePricer{})
+	if err != nil {
+		t.Fatalf("expected a missing price not to fail planning, got %v", err)
+	}
+	err = checkOnDemandMaxPrice(plans, false)
+	if err == nil || !strings.Contains(err.Error(), "m7i.large") || !strings.Contains(err.Error(), "unknown") {
+		t.Errorf("expected an error for the instance type with an unknown price, got %v", err)
+	}
+	if err := checkOnDemandMaxPrice(plans, true); err != nil {
+		t.Errorf("expected instance types with an unknown price not to be checked when allowed, got %v", err)
+	}
+}
+
+func TestNormalizationFactor(t *testing.T) {
+	grid := []struct {
+		instanceType string
+		family       string
+		factor       float64
+		ok           bool
+	}{
+		{instanceType: "t3.nano", family: "t3", factor: 0.25, ok: true},
+		{instanceType: "m5.large", family: "m5", factor: 4, ok: true},
+		{instanceType: "m5.xlarge", family: "m5", factor: 8, ok: true},
+		{instanceType: "m5.12xlarge", family: "m5", factor: 96, ok: true},
+		{instanceType: "m5.metal", ok: false},
+		{instanceType: "invalid", ok: false},
+	}
+	for _, g := range grid {
+		family, factor, ok := normalizationFactor(g.instanceType)
+		if family != g.family || factor != g.factor || ok != g.ok {
+			t.Errorf("normalizationFactor(%q): expected %q, %v, %v, got %q, %v, %v", g.instanceType, g.family, g.factor, g.ok, family, factor, ok)
+		}
+	}
+}
+
+type fakeReservationsEC2 struct {
+	awsinterfaces.EC2API
+	reservedInstances []ec2types.ReservedInstances
+}
+
+func (f *fakeReservationsEC2) DescribeReservedInstances(ctx context.Context, params *ec2.DescribeReservedInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeReservedInstancesOutput, error) {
+	return &ec2.DescribeReservedInstancesOutput{ReservedInstances: f.reservedInstances}, nil
+}
+
+type fakeSavingsPlans struct {
+	savingsPlans []savingsplanstypes.SavingsPlan
+	err          error
+}
+
+func (f *fakeSavingsPlans) DescribeSavingsPlans(ctx context.Context, params *savingsplans.DescribeSavingsPlansInput, optFns ...func(*savingsplans.Options)) (*savingsplans.DescribeSavingsPlansOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &savingsplans.DescribeSavingsPlansOutput{SavingsPlans: f.savingsPlans}, nil
+}
+
+func TestFindReservations(t *testing.T) {
+	ec2Client := &fakeReservationsEC2{
+		reservedInstances: []ec2types.ReservedInstances{
+			{
+				InstanceType:       ec2types.InstanceTypeM5Xlarge,
+				InstanceCount:      aws.Int32(2),
+				Scope:              ec2types.ScopeRegional,
+				ProductDescription: ec2types.RIProductDescription("Linux/UNIX"),
+			},
+			{
+				InstanceType:       ec2types.InstanceTypeC5Large,
+				InstanceCount:      aws.Int32(1),
+				Scope:              ec2types.ScopeAvailabilityZone,
+				ProductDescription: ec2types.RIProductDescription("Linux/UNIX"),
+			},
+			{
+				InstanceType:       ec2types.InstanceTypeR5Large,
+				InstanceCount:      aws.Int32(1),
+				Scope:              ec2types.ScopeRegional,
+				ProductDescription: ec2types.RIProductDescription("Windows"),
+			},
+		},
+	}
+	savingsPlansClient := &fakeSavingsPlans{
+		savingsPlans: []savingsplanstypes.SavingsPlan{
+			{SavingsPlanType: savingsplanstypes.SavingsPlanTypeCompute, Commitment: aws.String("0.5")},
+			{SavingsPlanType: savingsplanstypes.SavingsPlanTypeEc2Instance, Commitment: aws.String("0.25"), Ec2InstanceFamily: aws.String("m5"), Region: aws.String("us-test-1")},
+			{SavingsPlanType: savingsplanstypes.SavingsPlanTypeEc2Instance, Commitment: aws.String("1"), Ec2InstanceFamily: aws.String("m5"), Region: aws.String("eu-test-1")},
+		},
+	}
+
+	r, err := findReservations(context.Background(), ec2Client, savingsPlansClient, "us-test-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(r.units, map[string]float64{"m5": 16}) {
+		t.Errorf("unexpected size-flexible units %v", r.units)
+	}
+	if !reflect.DeepEqual(r.instances, map[string]int32{"c5.large": 1, "r5.large": 1}) {
+		t.Errorf("unexpected reserved instances %v", r.instances)
+	}
+	if r.computeCommitment != 0.5 {
+		t.Errorf("unexpected compute commitment %v", r.computeCommitment)
+	}
+	if !reflect.DeepEqual(r.familyCommitments, map[string]float64{"m5": 0.25}) {
+		t.Errorf("unexpected instance family commitments %v", r.familyCommitments)
+	}
+
+	savingsPlansClient.err = fmt.Errorf("AccessDeniedException")
+	r, err = findReservations(context.Background(), ec2Client, savingsPlansClient, "us-test-1")
+	if err != nil {
+		t.Fatalf("expected failing to list Savings Plans to only be a warning, got %v", err)
+	}
+	if r.computeCommitment != 0 || len(r.instances) != 2 {
+		t.Errorf("unexpected reservations %+v", r)
+	}
+}
+
+func TestWriteCapacityReport(t *testing.T) {
+	plans := []*capacityPlan{
+		{
+			InstanceGroup:     "nodes",
+			InstanceTypes:     []string{"m5.large", "m5a.large"},
+			OnDemandInstances: 6,
+			Prices:            map[string]float64{"m5.large": 0.1, "m5a.large": 0.09},
+		},
+		{
+			InstanceGroup:     "control-plane",
+			InstanceTypes:     []string{"m5.large"},
+			OnDemandInstances: 1,
+			Prices:            map[string]float64{"m5.large": 0.1},
+		},
+		{
+			InstanceGroup:     "unpriced",
+			InstanceTypes:     []string{"x9.large"},
+			OnDemandInstances: 1,
+			Prices:            map[string]float64{},
+		},
+	}
+	reserved := &reservations{
+		// One m5.xlarge covers two m5.large
+		units:             map[string]float64{"m5": 8},
+		instances:         map[string]int32{"m5.large": 1, "m5a.large": 1},
+		computeCommitment: 0.15,
+		familyCommitments: map[string]float64{"m5": 0.1},
+	}
+
+	var out bytes.Buffer
+	if err := writeCapacityReport(&out, plans, reserved); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	report := out.String()
+	for _, expected := range []string{
+		"control-plane   m5.large            1          1         $0.1000    $0.0000             $0.0000",
+		"nodes           m5.large,m5a.large  6          3         $0.6000    $0.2500             $0.0500",
+		"Total on-demand cost: $0.7000/hour, of which $0.2500/hour is covered by Savings Plans and $0.0500/hour is not covered by reserved instances or Savings Plans.",
+		"The on-demand price of instance groups unpriced could not be looked up",
+	} {
+		if !strings.Contains(report, expected) {
+			t.Errorf("expected report to contain %q, got:\n%s", expected, report)
+		}
+	}
+}
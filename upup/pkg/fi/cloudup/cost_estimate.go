@@ -0,0 +1,294 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/amazon-ec2-instance-selector/v3/pkg/ec2pricing"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/klog/v2"
+	nodeidentityaws "k8s.io/kops/pkg/nodeidentity/aws"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awstasks"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+// CostEstimate is the estimated monthly cost, in USD, of the cloud resources of a cluster.
+type CostEstimate struct {
+	// InstanceGroups is the cost of the instances of each instance group, including their volumes.
+	InstanceGroups []*InstanceGroupCost `json:"instanceGroups,omitempty"`
+	// Resources is the cost of the other resources, such as load balancers, NAT gateways and etcd volumes.
+	Resources []*ResourceCost `json:"resources,omitempty"`
+	// MinMonthlyCost is the total cost with every instance group at its min size.
+	MinMonthlyCost float64 `json:"minMonthlyCost"`
+	// MaxMonthlyCost is the total cost with every instance group at its max size.
+	MaxMonthlyCost float64 `json:"maxMonthlyCost"`
+}
+
+// InstanceGroupCost is the estimated monthly cost of the instances of an instance group.
+type InstanceGroupCost struct {
+	Name string `json:"name"`
+	// InstanceType is the instance type used for pricing; it is empty if the instance type could not be determined.
+	InstanceType string `json:"instanceType,omitempty"`
+	MinSize      int32  `json:"minSize"`
+	MaxSize      int32  `json:"maxSize"`
+	// InstanceMonthlyCost is the cost of a single instance, including its volumes.
+	InstanceMonthlyCost float64 `json:"instanceMonthlyCost"`
+	MinMonthlyCost      float64 `json:"minMonthlyCost"`
+	MaxMonthlyCost      float64 `json:"maxMonthlyCost"`
+}
+
+// ResourceCost is the estimated monthly cost of a resource that is not part of an instance group.
+type ResourceCost struct {
+	// Type is the type of the task that manages the resource.
+	Type        string  `json:"type"`
+	Name        string  `json:"name"`
+	MonthlyCost float64 `json:"monthlyCost"`
+}
+
+// CostEstimator estimates the monthly cost of the cloud resources described by the tasks of a cluster (AWS only).
+// Instances are priced with the EC2 pricing API at the on-demand rate, so the estimate is an upper bound
+// for instance groups using spot instances. Other resources are priced with approximate us-east-1 list prices.
+type CostEstimator struct {
+	pricer onDemandPricer
+	// prices caches the monthly on-demand price of each instance type
+	prices map[string]float64
+}
+
+// NewCostEstimator builds a CostEstimator for the region of the cloud.
+func NewCostEstimator(ctx context.Context, cloud awsup.AWSCloud) (*CostEstimator, error) {
+	pricer, err := ec2pricing.New(ctx, cloud.Config())
+	if err != nil {
+		return nil, fmt.Errorf("building EC2 pricing client: %w", err)
+	}
+	return newCostEstimator(pricer), nil
+}
+
+func newCostEstimator(pricer onDemandPricer) *CostEstimator {
+	return &CostEstimator{
+		pricer: pricer,
+		prices: make(map[string]float64),
+	}
+}
+
+// Estimate estimates the monthly cost of the resources described by the tasks, keyed like a task map.
+func (e *CostEstimator) Estimate(ctx context.Context, tasks map[string]fi.CloudupTask) (*CostEstimate, error) {
+	estimate := &CostEstimate{}
+
+	addResource := func(task fi.CloudupTask, name *string, monthlyCost float64) {
+		estimate.Resources = append(estimate.Resources, &ResourceCost{
+			Type:        fi.TypeNameForTask(task),
+			Name:        fi.ValueOf(name),
+			MonthlyCost: monthlyCost,
+		})
+	}
+
+	for _, task := range tasks {
+		switch t := task.(type) {
+		case *awstasks.AutoscalingGroup:
+			igCost, err := e.estimateInstanceGroup(ctx, tasks, t)
+			if err != nil {
+				return nil, err
+			}
+			estimate.InstanceGroups = append(estimate.InstanceGroups, igCost)
+
+		case *awstasks.EBSVolume:
+			cost, found := awsup.VolumeMonthlyCost(t.VolumeType, fi.ValueOf(t.SizeGB))
+			if !found {
+				klog.Warningf("unknown price for volume type %q of volume %q", t.VolumeType, fi.ValueOf(t.Name))
+			}
+			addResource(t, t.Name, cost)
+
+		case *awstasks.ClassicLoadBalancer:
+			if !fi.ValueOf(t.Shared) {
+				addResource(t, t.Name, awsup.ClassicLoadBalancerPricePerHour*awsup.HoursPerMonth)
+			}
+
+		case *awstasks.NetworkLoadBalancer:
+			addResource(t, t.Name, awsup.LoadBalancerV2PricePerHour*awsup.HoursPerMonth)
+
+		case *awstasks.NatGateway:
+			if !fi.ValueOf(t.Shared) {
+				addResource(t, t.Name, awsup.NatGatewayPricePerHour*awsup.HoursPerMonth)
+			}
+
+		case *awstasks.ElasticIP:
+			if !fi.ValueOf(t.Shared) {
+				addResource(t, t.Name, awsup.PublicIPv4PricePerHour*awsup.HoursPerMonth)
+			}
+		}
+	}
+
+	sort.Slice(estimate.InstanceGroups, func(i, j int) bool {
+		return estimate.InstanceGroups[i].Name < estimate.InstanceGroups[j].Name
+	})
+	sort.Slice(estimate.Resources, func(i, j int) bool {
+		if estimate.Resources[i].Type != estimate.Resources[j].Type {
+			return estimate.Resources[i].Type < estimate.Resources[j].Type
+		}
+		return estimate.Resources[i].Name < estimate.Resources[j].Name
+	})
+
+	for _, ig := range estimate.InstanceGroups {
+		estimate.MinMonthlyCost += ig.MinMonthlyCost
+		estimate.MaxMonthlyCost += ig.MaxMonthlyCost
+	}
+	for _, r := range estimate.Resources {
+		estimate.MinMonthlyCost += r.MonthlyCost
+		estimate.MaxMonthlyCost += r.MonthlyCost
+	}
+
+	return estimate, nil
+}
+
+// estimateInstanceGroup estimates the cost of the instances launched by an autoscaling group.
+func (e *CostEstimator) estimateInstanceGroup(ctx context.Context, tasks map[string]fi.CloudupTask, asg *awstasks.AutoscalingGroup) (*InstanceGroupCost, error) {
+	igCost := &InstanceGroupCost{
+		Name:    asg.Tags[nodeidentityaws.CloudTagInstanceGroupName],
+		MinSize: fi.ValueOf(asg.MinSize),
+		MaxSize: fi.ValueOf(asg.MaxSize),
+	}
+	if igCost.Name == "" {
+		igCost.Name = fi.ValueOf(asg.Name)
+	}
+
+	// The launch template referenced by an actual autoscaling group only has its name populated,
+	// so we look up the launch template task by name.
+	lt := asg.LaunchTemplate
+	if lt != nil {
+		if task, found := tasks["LaunchTemplate/"+fi.ValueOf(lt.Name)]; found {
+			lt = task.(*awstasks.LaunchTemplate)
+		}
+	}
+
+	// The autoscaling group launches the first listed instance type unless it lacks capacity.
+	if len(asg.MixedInstanceOverrides) != 0 {
+		igCost.InstanceType = asg.MixedInstanceOverrides[0]
+	} else if lt != nil && lt.InstanceType != nil {
+		igCost.InstanceType = string(*lt.InstanceType)
+	}
+
+	if igCost.InstanceType == "" {
+		klog.Warningf("unable to determine the instance type of instance group %q; its instances are not included in the cost estimate", igCost.Name)
+	} else {
+		price, err := e.instanceMonthlyCost(ctx, igCost.InstanceType)
+		if err != nil {
+			return nil, fmt.Errorf("looking up on-demand price of %q for instance group %q: %w", igCost.InstanceType, igCost.Name, err)
+		}
+		igCost.InstanceMonthlyCost += price
+	}
+
+	if lt != nil {
+		if lt.RootVolumeSize != nil {
+			cost, found := awsup.VolumeMonthlyCost(lt.RootVolumeType, *lt.RootVolumeSize)
+			if !found {
+				klog.Warningf("unknown price for root volume type %q of instance group %q", lt.RootVolumeType, igCost.Name)
+			}
+			igCost.InstanceMonthlyCost += cost
+		}
+		for _, bdm := range lt.BlockDeviceMappings {
+			if bdm.EbsVolumeSize == nil {
+				continue
+			}
+			cost, found := awsup.VolumeMonthlyCost(bdm.EbsVolumeType, *bdm.EbsVolumeSize)
+			if !found {
+				klog.Warningf("unknown price for volume type %q of instance group %q", bdm.EbsVolumeType, igCost.Name)
+			}
+			igCost.InstanceMonthlyCost += cost
+		}
+	}
+
+	igCost.MinMonthlyCost = igCost.InstanceMonthlyCost * float64(igCost.MinSize)
+	igCost.MaxMonthlyCost = igCost.InstanceMonthlyCost * float64(igCost.MaxSize)
+	return igCost, nil
+}
+
+// instanceMonthlyCost returns the monthly on-demand price of an instance type.
+func (e *CostEstimator) instanceMonthlyCost(ctx context.Context, instanceType string) (float64, error) {
+	if price, found := e.prices[instanceType]; found {
+		return price, nil
+	}
+	hourly, err := e.pricer.GetOnDemandInstanceTypeCost(ctx, ec2types.InstanceType(instanceType))
+	if err != nil {
+		return 0, err
+	}
+	price := hourly * awsup.HoursPerMonth
+	e.prices[instanceType] = price
+	return price, nil
+}
+
+// CostChange is the change in the estimated monthly cost of an instance group or resource.
+type CostChange struct {
+	// Type is "InstanceGroup" for instance groups, or the type of the task that manages the resource.
+	Type string `json:"type"`
+	Name string `json:"name"`
+
+	CurrentMinMonthlyCost  float64 `json:"currentMinMonthlyCost"`
+	CurrentMaxMonthlyCost  float64 `json:"currentMaxMonthlyCost"`
+	ProposedMinMonthlyCost float64 `json:"proposedMinMonthlyCost"`
+	ProposedMaxMonthlyCost float64 `json:"proposedMaxMonthlyCost"`
+}
+
+// DiffCostEstimates returns the instance groups and resources whose cost differs between the estimates, sorted by type and name.
+func DiffCostEstimates(current, proposed *CostEstimate) []*CostChange {
+	changes := make(map[string]*CostChange)
+	get := func(typeName, name string) *CostChange {
+		key := typeName + "/" + name
+		if changes[key] == nil {
+			changes[key] = &CostChange{Type: typeName, Name: name}
+		}
+		return changes[key]
+	}
+
+	for _, ig := range current.InstanceGroups {
+		c := get("InstanceGroup", ig.Name)
+		c.CurrentMinMonthlyCost += ig.MinMonthlyCost
+		c.CurrentMaxMonthlyCost += ig.MaxMonthlyCost
+	}
+	for _, r := range current.Resources {
+		c := get(r.Type, r.Name)
+		c.CurrentMinMonthlyCost += r.MonthlyCost
+		c.CurrentMaxMonthlyCost += r.MonthlyCost
+	}
+	for _, ig := range proposed.InstanceGroups {
+		c := get("InstanceGroup", ig.Name)
+		c.ProposedMinMonthlyCost += ig.MinMonthlyCost
+		c.ProposedMaxMonthlyCost += ig.MaxMonthlyCost
+	}
+	for _, r := range proposed.Resources {
+		c := get(r.Type, r.Name)
+		c.ProposedMinMonthlyCost += r.MonthlyCost
+		c.ProposedMaxMonthlyCost += r.MonthlyCost
+	}
+
+	var diff []*CostChange
+	for _, c := range changes {
+		if c.CurrentMinMonthlyCost != c.ProposedMinMonthlyCost || c.CurrentMaxMonthlyCost != c.ProposedMaxMonthlyCost {
+			diff = append(diff, c)
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool {
+		if diff[i].Type != diff[j].Type {
+			return diff[i].Type < diff[j].Type
+		}
+		return diff[i].Name < diff[j].Name
+	})
+	return diff
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudup
+
+import (
+	"context"
+	"testing"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	nodeidentityaws "k8s.io/kops/pkg/nodeidentity/aws"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awstasks"
+)
+
+func TestCostEstimatorEstimate(t *testing.T) {
+	ctx := context.TODO()
+
+	lt := &awstasks.LaunchTemplate{
+		Name:           new("nodes.example.com"),
+		InstanceType:   new(ec2types.InstanceTypeM5Large),
+		RootVolumeSize: new(int32(50)),
+		RootVolumeType: ec2types.VolumeTypeGp3,
+	}
+	tasks := map[string]fi.CloudupTask{
+		"LaunchTemplate/nodes.example.com": lt,
+		"AutoscalingGroup/nodes.example.com": &awstasks.AutoscalingGroup{
+			Name: new("nodes.example.com"),
+			// Like an actual autoscaling group, the launch template only has its name
+			LaunchTemplate: &awstasks.LaunchTemplate{Name: lt.Name},
+			MinSize:        new(int32(2)),
+			MaxSize:        new(int32(4)),
+			Tags:           map[string]string{nodeidentityaws.CloudTagInstanceGroupName: "nodes"},
+		},
+		"AutoscalingGroup/mixed.example.com": &awstasks.AutoscalingGroup{
+			Name:                   new("mixed.example.com"),
+			LaunchTemplate:         lt,
+			MixedInstanceOverrides: []string{"c5.large", "m5.large"},
+			MinSize:                new(int32(1)),
+			MaxSize:                new(int32(1)),
+		},
+		"EBSVolume/a.etcd-main.example.com": &awstasks.EBSVolume{
+			Name:       new("a.etcd-main.example.com"),
+			SizeGB:     new(int32(20)),
+			VolumeType: ec2types.VolumeTypeGp3,
+		},
+		"NatGateway/us-east-1a.example.com": &awstasks.NatGateway{
+			Name: new("us-east-1a.example.com"),
+		},
+		"NatGateway/shared": &awstasks.NatGateway{
+			Name:   new("shared"),
+			Shared: new(true),
+		},
+		"NetworkLoadBalancer/api.example.com": &awstasks.NetworkLoadBalancer{
+			Name: new("api.example.com"),
+		},
+	}
+
+	estimator := newCostEstimator(fakePricer{"m5.large": 0.1, "c5.large": 0.08})
+	estimate, err := estimator.Estimate(ctx, tasks)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if assert.Len(t, estimate.InstanceGroups, 2) {
+		mixed := estimate.InstanceGroups[0]
+		assert.Equal(t, "mixed.example.com", mixed.Name)
+		assert.Equal(t, "c5.large", mixed.InstanceType)
+		assert.InDelta(t, 0.08*730+0.08*50, mixed.InstanceMonthlyCost, 0.001)
+
+		nodes := estimate.InstanceGroups[1]
+		assert.Equal(t, "nodes", nodes.Name)
+		assert.Equal(t, "m5.large", nodes.InstanceType)
+		assert.InDelta(t, 0.1*730+0.08*50, nodes.InstanceMonthlyCost, 0.001)
+		assert.InDelta(t, 2*nodes.InstanceMonthlyCost, nodes.MinMonthlyCost, 0.001)
+		assert.InDelta(t, 4*nodes.InstanceMonthlyCost, nodes.MaxMonthlyCost, 0.001)
+	}
+
+	var resources []string
+	for _, r := range estimate.Resources {
+		resources = append(resources, r.Type+"/"+r.Name)
+	}
+	assert.Equal(t, []string{
+		"EBSVolume/a.etcd-main.example.com",
+		"NatGateway/us-east-1a.example.com",
+		"NetworkLoadBalancer/api.example.com",
+	}, resources)
+
+	other := 0.08*20 + 0.045*730 + 0.0225*730
+	assert.InDelta(t, 62.4+154+other, estimate.MinMonthlyCost, 0.001)
+	assert.InDelta(t, 62.4+4*77+other, estimate.MaxMonthlyCost, 0.001)
+}
+
+func TestCostEstimatorUnknownInstanceType(t *testing.T) {
+	tasks := map[string]fi.CloudupTask{
+		"AutoscalingGroup/nodes.example.com": &awstasks.AutoscalingGroup{
+			Name:           new("nodes.example.com"),
+			LaunchTemplate: &awstasks.LaunchTemplate{Name: new("nodes.example.com"), InstanceType: new(ec2types.InstanceTypeM5Large)},
+		},
+	}
+
+	_, err := newCostEstimator(fakePricer{}).Estimate(context.TODO(), tasks)
+	assert.ErrorContains(t, err, `looking up on-demand price of "m5.large" for instance group "nodes.example.com"`)
+}
+
+func TestDiffCostEstimates(t *testing.T) {
+	current := &CostEstimate{
+		InstanceGroups: []*InstanceGroupCost{
+			{Name: "nodes", MinMonthlyCost: 100, MaxMonthlyCost: 200},
+			{Name: "control-plane", MinMonthlyCost: 70, MaxMonthlyCost: 70},
+		},
+		Resources: []*ResourceCost{
+			{Type: "NatGateway", Name: "us-east-1a", MonthlyCost: 32.85},
+		},
+	}
+	proposed := &CostEstimate{
+		InstanceGroups: []*InstanceGroupCost{
+			{Name: "nodes", MinMonthlyCost: 100, MaxMonthlyCost: 400},
+			{Name: "control-plane", MinMonthlyCost: 70, MaxMonthlyCost: 70},
+		},
+		Resources: []*ResourceCost{
+			{Type: "NetworkLoadBalancer", Name: "api", MonthlyCost: 16.425},
+		},
+	}
+
+	assert.Equal(t, []*CostChange{
+		{Type: "InstanceGroup", Name: "nodes", CurrentMinMonthlyCost: 100, CurrentMaxMonthlyCost: 200, ProposedMinMonthlyCost: 100, ProposedMaxMonthlyCost: 400},
+		{Type: "NatGateway", Name: "us-east-1a", CurrentMinMonthlyCost: 32.85, CurrentMaxMonthlyCost: 32.85},
+		{Type: "NetworkLoadBalancer", Name: "api", ProposedMinMonthlyCost: 16.425, ProposedMaxMonthlyCost: 16.425},
+	}, DiffCostEstimates(current, proposed))
+}
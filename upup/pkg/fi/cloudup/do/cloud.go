@@ -46,6 +46,7 @@ const (
 	TagKubernetesClusterMasterPrefix = "KubernetesCluster-Master"
 	TagKubernetesInstanceGroup       = "kops-instancegroup"
 	TagKubernetesInstanceRole        = "kops-instance-role"
+	TagKubernetesNodeKey             = "kops-node-key"
 )
 
 type DOInstanceGroup struct {
@@ -67,7 +68,8 @@ type DOCloud interface {
 	DomainService() godo.DomainsService
 	ActionsService() godo.ActionsService
 	VPCsService() godo.VPCsService
-	FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error)
+	TagsService() godo.TagsService
+	FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error)
 	GetAllLoadBalancers() ([]godo.LoadBalancer, error)
 	GetAllDropletsByTag(tag string) ([]godo.Droplet, error)
 	GetAllVolumesByRegion() ([]godo.Volume, error)
@@ -135,6 +137,7 @@ func (c *doCloudImplementation) DeleteInstance(i *cloudinstances.CloudInstance)
 		return fmt.Errorf("failed to convert droplet ID to int: %s", err)
 	}
 
+	var dropletTags []string
 	_, _, err = c.Client.DropletActions.Shutdown(context.TODO(), dropletID)
 	if err != nil {
 		return fmt.Errorf("error stopping instance %d: %v", dropletID, err)
@@ -148,6 +151,7 @@ func (c *doCloudImplementation) DeleteInstance(i *cloudinstances.CloudInstance)
 		}
 
 		klog.V(8).Infof("stopping DO instance %q, current Status: %q", droplet, droplet.Status)
+		dropletTags = droplet.Tags
 
 		if droplet.Status == "off" {
 			break
@@ -167,6 +171,10 @@ func (c *doCloudImplementation) DeleteInstance(i *cloudinstances.CloudInstance)
 
 	klog.V(8).Infof("deleted droplet instance %d", dropletID)
 
+	if err := DeleteNodeKeyTag(context.TODO(), c.Client.Tags, dropletTags); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -234,6 +242,11 @@ func (c *doCloudImplementation) VPCsService() godo.VPCsService {
 	return c.Client.VPCs
 }
 
+// TagsService returns an implementation of godo.TagsService
+func (c *doCloudImplementation) TagsService() godo.TagsService {
+	return c.Client.Tags
+}
+
 // FindVPCInfo is not implemented, it's only here to satisfy the fi.Cloud interface
 func (c *doCloudImplementation) FindVPCInfo(id string) (*fi.VPCInfo, error) {
 	return nil, errors.New("not implemented")
@@ -268,7 +281,7 @@ func (c *doCloudImplementation) GetVPCUUID(networkCIDR string, vpcName string) (
 	}
 }
 
-func (c *doCloudImplementation) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+func (c *doCloudImplementation) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
 	var ingresses []fi.ApiIngressStatus
 	done, err := vfs.RetryWithBackoff(readBackoff, func() (bool, error) {
 		// Note that this must match Digital Ocean's lb name
@@ -306,7 +319,7 @@ func (c *doCloudImplementation) GetApiIngressStatus(cluster *kops.Cluster) ([]fi
 }
 
 // FindClusterStatus discovers the status of the cluster, by looking for the tagged etcd volumes
-func (c *doCloudImplementation) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+func (c *doCloudImplementation) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
 	etcdStatus, err := findEtcdStatus(c, cluster)
 	if err != nil {
 		return nil, err
@@ -20,22 +20,33 @@ limitations under the License.
 package dometadata
 
 import (
+	"crypto"
 	"fmt"
 	"io"
 	"net/http"
 
 	"k8s.io/kops/pkg/bootstrap"
+	"k8s.io/kops/pkg/bootstrap/pkibootstrap"
 )
 
 const DOAuthenticationTokenPrefix = "x-digitalocean-droplet-id "
 
+// DOSignedAuthenticationTokenPrefix is the prefix of tokens signed with the machine key of the droplet.
+const DOSignedAuthenticationTokenPrefix = "x-digitalocean-signed "
+
 type doAuthenticator struct {
+	signer crypto.Signer
 }
 
 var _ bootstrap.Authenticator = (*doAuthenticator)(nil)
 
 func NewAuthenticator() (bootstrap.Authenticator, error) {
-	return &doAuthenticator{}, nil
+	// Droplets have no signed identity documents; kops-controller pins this key to the droplet on its first bootstrap instead.
+	signer, err := pkibootstrap.LoadOrCreateMachineKey(pkibootstrap.MachineKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	return &doAuthenticator{signer: signer}, nil
 }
 
 func (o *doAuthenticator) CreateToken(body []byte) (string, error) {
@@ -43,7 +54,7 @@ func (o *doAuthenticator) CreateToken(body []byte) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("unable to fetch droplet id: %w", err)
 	}
-	return DOAuthenticationTokenPrefix + dropletID, nil
+	return pkibootstrap.CreateSignedToken(DOSignedAuthenticationTokenPrefix, dropletID, o.signer, body)
 }
 
 const (
@@ -16,10 +16,45 @@ limitations under the License.
 
 package do
 
-import "strings"
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/digitalocean/godo"
+)
 
 func SafeClusterName(clusterName string) string {
 	// DO does not support . in tags / names
 	safeClusterName := strings.ReplaceAll(clusterName, ".", "-")
 	return safeClusterName
 }
+
+// NodeKeyFromTags returns the fingerprint of the key pinned to a droplet, if any.
+func NodeKeyFromTags(tags []string) string {
+	for _, tag := range tags {
+		if strings.HasPrefix(tag, TagKubernetesNodeKey+":") {
+			return strings.TrimPrefix(tag, TagKubernetesNodeKey+":")
+		}
+	}
+	return ""
+}
+
+// DeleteNodeKeyTag deletes the tag pinning the key of a deleted droplet, given the tags of the droplet.
+// The tag is unique to the droplet, but DigitalOcean tags are account-wide and outlive the droplets they are attached to.
+func DeleteNodeKeyTag(ctx context.Context, tagsService godo.TagsService, dropletTags []string) error {
+	nodeKey := NodeKeyFromTags(dropletTags)
+	if nodeKey == "" {
+		return nil
+	}
+	tag := TagKubernetesNodeKey + ":" + nodeKey
+	resp, err := tagsService.Delete(ctx, tag)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("deleting tag %q: %w", tag, err)
+	}
+	return nil
+}
@@ -23,26 +23,38 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/digitalocean/godo"
 	"golang.org/x/oauth2"
 	"k8s.io/kops/pkg/bootstrap"
+	"k8s.io/kops/pkg/bootstrap/pkibootstrap"
 	"k8s.io/kops/pkg/wellknownports"
 	"k8s.io/kops/upup/pkg/fi/cloudup/do/dometadata"
 )
 
 type DigitalOceanVerifierOptions struct {
+	// ClusterName is the name of the cluster, used to check that droplets belong to the cluster.
+	ClusterName string `json:"clusterName,omitempty"`
+	// AllowUnsignedTokens accepts the unsigned tokens of nodes from before kOps 1.37, for servers without a pinned key.
+	// Deprecated: set from the AllowUnsignedBootstrapTokens feature flag, only while such nodes still bootstrap.
+	AllowUnsignedTokens bool `json:"allowUnsignedTokens,omitempty"`
 }
 
 type digitalOceanVerifier struct {
+	opt      DigitalOceanVerifierOptions
 	doClient *godo.Client
 }
 
 var _ bootstrap.Verifier = (*digitalOceanVerifier)(nil)
 
 func NewVerifier(ctx context.Context, opt *DigitalOceanVerifierOptions) (bootstrap.Verifier, error) {
+	if opt == nil || opt.ClusterName == "" {
+		return nil, errors.New("determining cluster name")
+	}
+
 	accessToken := os.Getenv("DIGITALOCEAN_ACCESS_TOKEN")
 	if accessToken == "" {
 		return nil, errors.New("DIGITALOCEAN_ACCESS_TOKEN is required")
@@ -52,15 +64,29 @@ func NewVerifier(ctx context.Context, opt *DigitalOceanVerifierOptions) (bootstr
 	doClient := godo.NewClient(oauth2.NewClient(ctx, tokenSource))
 
 	return &digitalOceanVerifier{
+		opt:      *opt,
 		doClient: doClient,
 	}, nil
 }
 
 func (o digitalOceanVerifier) VerifyToken(ctx context.Context, rawRequest *http.Request, token string, body []byte) (*bootstrap.VerifyResult, error) {
-	if !strings.HasPrefix(token, dometadata.DOAuthenticationTokenPrefix) {
+	var serverIDString, keyFingerprint string
+	switch {
+	case strings.HasPrefix(token, dometadata.DOSignedAuthenticationTokenPrefix):
+		tokenData, fingerprint, err := pkibootstrap.VerifySignedToken(dometadata.DOSignedAuthenticationTokenPrefix, token, body, 0)
+		if err != nil {
+			return nil, err
+		}
+		serverIDString = tokenData.Instance
+		keyFingerprint = fingerprint
+	case strings.HasPrefix(token, dometadata.DOAuthenticationTokenPrefix):
+		if !o.opt.AllowUnsignedTokens {
+			return nil, pkibootstrap.ErrUnsignedToken
+		}
+		serverIDString = strings.TrimPrefix(token, dometadata.DOAuthenticationTokenPrefix)
+	default:
 		return nil, bootstrap.ErrNotThisVerifier
 	}
-	serverIDString := strings.TrimPrefix(token, dometadata.DOAuthenticationTokenPrefix)
 
 	serverID, err := strconv.Atoi(serverIDString)
 	if err != nil {
@@ -69,7 +95,17 @@ func (o digitalOceanVerifier) VerifyToken(ctx context.Context, rawRequest *http.
 
 	droplet, _, err := o.doClient.Droplets.Get(ctx, serverID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get info for server %v: %w", token, err)
+		return nil, fmt.Errorf("failed to get info for server %v: %w", serverIDString, err)
+	}
+
+	// DigitalOcean does not provide signed instance identity documents, so we only trust droplets of this cluster,
+	// and the node challenge then verifies that the caller controls the droplet.
+	if err := verifyDroplet(droplet, o.opt.ClusterName); err != nil {
+		return nil, err
+	}
+	pinnedKey := NodeKeyFromTags(droplet.Tags)
+	if err := pkibootstrap.VerifyPinnedKey(serverIDString, pinnedKey, keyFingerprint); err != nil {
+		return nil, err
 	}
 
 	var addresses []string
@@ -118,5 +154,38 @@ func (o digitalOceanVerifier) VerifyToken(ctx context.Context, rawRequest *http.
 			result.InstanceGroupName = strings.TrimPrefix(tag, TagKubernetesInstanceGroup+":")
 		}
 	}
+
+	if keyFingerprint != "" && pinnedKey == "" {
+		result.PinKey = func(ctx context.Context) error {
+			return o.pinKey(ctx, serverID, keyFingerprint)
+		}
+	}
 	return result, nil
 }
+
+// pinKey tags the droplet with the fingerprint of its key.
+func (o digitalOceanVerifier) pinKey(ctx context.Context, dropletID int, keyFingerprint string) error {
+	tag := TagKubernetesNodeKey + ":" + keyFingerprint
+	if _, _, err := o.doClient.Tags.Create(ctx, &godo.TagCreateRequest{Name: tag}); err != nil {
+		return fmt.Errorf("creating tag %q: %w", tag, err)
+	}
+	_, err := o.doClient.Tags.TagResources(ctx, tag, &godo.TagResourcesRequest{
+		Resources: []godo.Resource{{ID: strconv.Itoa(dropletID), Type: godo.DropletResourceType}},
+	})
+	if err != nil {
+		return fmt.Errorf("tagging droplet %d: %w", dropletID, err)
+	}
+	return nil
+}
+
+// verifyDroplet checks that the droplet is an active droplet of the cluster.
+func verifyDroplet(droplet *godo.Droplet, clusterName string) error {
+	clusterTag := TagKubernetesClusterNamePrefix + ":" + SafeClusterName(clusterName)
+	if !slices.Contains(droplet.Tags, clusterTag) {
+		return fmt.Errorf("droplet %d is not part of cluster %q", droplet.ID, clusterName)
+	}
+	if droplet.Status != "active" {
+		return fmt.Errorf("droplet %d is not active (status %q)", droplet.ID, droplet.Status)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package do
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"k8s.io/kops/pkg/bootstrap/pkibootstrap"
+	"k8s.io/kops/upup/pkg/fi/cloudup/do/dometadata"
+)
+
+func TestVerifyDroplet(t *testing.T) {
+	grid := []struct {
+		desc        string
+		droplet     *godo.Droplet
+		expectError bool
+	}{
+		{
+			desc: "active droplet of the cluster",
+			droplet: &godo.Droplet{
+				ID:     1,
+				Status: "active",
+				Tags:   []string{"KubernetesCluster:example-k8s-local", "kops-instancegroup:nodes"},
+			},
+		},
+		{
+			desc: "droplet of another cluster",
+			droplet: &godo.Droplet{
+				ID:     2,
+				Status: "active",
+				Tags:   []string{"KubernetesCluster:other-k8s-local"},
+			},
+			expectError: true,
+		},
+		{
+			desc: "droplet of a cluster with a longer name",
+			droplet: &godo.Droplet{
+				ID:     3,
+				Status: "active",
+				Tags:   []string{"KubernetesCluster:example-k8s-local-2"},
+			},
+			expectError: true,
+		},
+		{
+			desc: "droplet that is not active",
+			droplet: &godo.Droplet{
+				ID:     4,
+				Status: "off",
+				Tags:   []string{"KubernetesCluster:example-k8s-local"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			err := verifyDroplet(g.droplet, "example.k8s.local")
+			if g.expectError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !g.expectError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestNodeKeyFromTags(t *testing.T) {
+	tags := []string{"KubernetesCluster:example-k8s-local", "kops-instancegroup:nodes", "kops-node-key:abc234"}
+	if key := NodeKeyFromTags(tags); key != "abc234" {
+		t.Errorf("unexpected key %q", key)
+	}
+	if key := NodeKeyFromTags(tags[:2]); key != "" {
+		t.Errorf("unexpected key %q for droplet without pinned key", key)
+	}
+}
+
+func TestVerifyTokenRejectsUnsignedTokens(t *testing.T) {
+	verifier := digitalOceanVerifier{opt: DigitalOceanVerifierOptions{ClusterName: "example.k8s.local"}}
+	_, err := verifier.VerifyToken(context.Background(), nil, dometadata.DOAuthenticationTokenPrefix+"12345", nil)
+	if !errors.Is(err, pkibootstrap.ErrUnsignedToken) {
+		t.Errorf("expected ErrUnsignedToken, got %v", err)
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/digitalocean/godo"
 
@@ -81,12 +83,13 @@ func (d *Droplet) Find(c *fi.CloudupContext) (*Droplet, error) {
 	}
 
 	return &Droplet{
-		Name:      new(foundDroplet.Name),
-		Count:     count,
-		Region:    new(foundDroplet.Region.Slug),
-		Size:      new(foundDroplet.Size.Slug),
-		Image:     d.Image, //Image should not change so we keep it as-is
-		Tags:      foundDroplet.Tags,
+		Name:   new(foundDroplet.Name),
+		Count:  count,
+		Region: new(foundDroplet.Region.Slug),
+		Size:   new(foundDroplet.Size.Slug),
+		Image:  d.Image, //Image should not change so we keep it as-is
+		// The key kops-controller pins to a droplet isn't part of the spec
+		Tags:      slices.DeleteFunc(slices.Clone(foundDroplet.Tags), func(tag string) bool { return strings.HasPrefix(tag, do.TagKubernetesNodeKey+":") }),
 		SSHKey:    d.SSHKey,   // TODO: get from droplet or ignore change
 		UserData:  d.UserData, // TODO: get from droplet or ignore change
 		VPCUUID:   new(foundDroplet.VPCUUID),
@@ -17,7 +17,6 @@ limitations under the License.
 package dotasks
 
 import (
-	"context"
 	"fmt"
 	"net"
 	"strings"
@@ -31,6 +30,7 @@ import (
 	"k8s.io/kops/pkg/wellknownservices"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/do"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
 	"k8s.io/kops/util/pkg/vfs"
 )
 
@@ -46,6 +46,10 @@ type LoadBalancer struct {
 	VPCUUID     *string
 	VPCName     *string
 	NetworkCIDR *string
+	// SizeUnit is the number of nodes of the load balancer
+	SizeUnit *int32
+	// Algorithm is the load balancing algorithm, round_robin or least_connections
+	Algorithm *string
 
 	// WellKnownServices indicates which services are supported by this resource.
 	// This field is internal and is not rendered to the cloud.
@@ -77,16 +81,18 @@ func (lb *LoadBalancer) Find(c *fi.CloudupContext) (*LoadBalancer, error) {
 
 	cloud := c.T.Cloud.(do.DOCloud)
 	lbService := cloud.LoadBalancersService()
-	loadbalancer, _, err := lbService.Get(context.TODO(), fi.ValueOf(lb.ID))
+	loadbalancer, _, err := lbService.Get(c.Context(), fi.ValueOf(lb.ID))
 	if err != nil {
 		return nil, fmt.Errorf("load balancer service get request returned error %v", err)
 	}
 
 	return &LoadBalancer{
-		Name:    new(loadbalancer.Name),
-		ID:      new(loadbalancer.ID),
-		Region:  new(loadbalancer.Region.Slug),
-		VPCUUID: new(loadbalancer.VPCUUID),
+		Name:      new(loadbalancer.Name),
+		ID:        new(loadbalancer.ID),
+		Region:    new(loadbalancer.Region.Slug),
+		VPCUUID:   new(loadbalancer.VPCUUID),
+		SizeUnit:  new(int32(loadbalancer.SizeUnit)),
+		Algorithm: new(loadbalancer.Algorithm),
 
 		// Ignore system fields
 		Lifecycle:         lb.Lifecycle,
@@ -120,40 +126,8 @@ func (_ *LoadBalancer) CheckChanges(a, e, changes *LoadBalancer) error {
 	return nil
 }
 
-func (_ *LoadBalancer) RenderDO(t *do.DOAPITarget, a, e, changes *LoadBalancer) error {
-	Rules := []godo.ForwardingRule{
-		{
-			EntryProtocol:  "https",
-			EntryPort:      443,
-			TargetProtocol: "https",
-			TargetPort:     443,
-			TlsPassthrough: true,
-		},
-		{
-			EntryProtocol:  "http",
-			EntryPort:      80,
-			TargetProtocol: "http",
-			TargetPort:     80,
-		},
-		{
-			EntryProtocol:  "https",
-			EntryPort:      wellknownports.KopsControllerPort,
-			TargetProtocol: "https",
-			TargetPort:     wellknownports.KopsControllerPort,
-			TlsPassthrough: true,
-		},
-	}
-
-	HealthCheck := &godo.HealthCheck{
-		Protocol:               "tcp",
-		Port:                   443,
-		Path:                   "",
-		CheckIntervalSeconds:   60,
-		ResponseTimeoutSeconds: 5,
-		UnhealthyThreshold:     3,
-		HealthyThreshold:       5,
-	}
-
+func (_ *LoadBalancer) RenderDO(c *fi.CloudupContext, t *do.DOAPITarget, a, e, changes *LoadBalancer) error {
+	ctx := c.Context()
 	// check if load balancer exist.
 	loadBalancers, err := t.Cloud.GetAllLoadBalancers()
 	if err != nil {
@@ -166,6 +140,25 @@ func (_ *LoadBalancer) RenderDO(t *do.DOAPITarget, a, e, changes *LoadBalancer)
 			// load balancer already exists.
 			e.ID = new(loadbalancer.ID)
 			e.IPAddress = new(loadbalancer.IP) // This will be empty on create, but will be filled later on FindAddresses invokation.
+
+			request := loadbalancer.AsRequest()
+			updated := false
+			if e.SizeUnit != nil && uint32(fi.ValueOf(e.SizeUnit)) != loadbalancer.SizeUnit {
+				klog.V(2).Infof("Resizing load balancer %q from %d to %d nodes", loadbalancer.Name, loadbalancer.SizeUnit, fi.ValueOf(e.SizeUnit))
+				request.SizeUnit = uint32(fi.ValueOf(e.SizeUnit))
+				updated = true
+			}
+			if e.Algorithm != nil && fi.ValueOf(e.Algorithm) != loadbalancer.Algorithm {
+				klog.V(2).Infof("Changing algorithm of load balancer %q from %q to %q", loadbalancer.Name, loadbalancer.Algorithm, fi.ValueOf(e.Algorithm))
+				request.Algorithm = fi.ValueOf(e.Algorithm)
+				updated = true
+			}
+			if updated {
+				_, _, err := t.Cloud.LoadBalancersService().Update(ctx, loadbalancer.ID, request)
+				if err != nil {
+					return fmt.Errorf("Error updating load balancer with Name=%s, Error=%v", fi.ValueOf(e.Name), err)
+				}
+			}
 			return nil
 		}
 	}
@@ -182,13 +175,15 @@ func (_ *LoadBalancer) RenderDO(t *do.DOAPITarget, a, e, changes *LoadBalancer)
 	}
 
 	loadBalancerService := t.Cloud.LoadBalancersService()
-	loadbalancer, _, err := loadBalancerService.Create(context.TODO(), &godo.LoadBalancerRequest{
+	loadbalancer, _, err := loadBalancerService.Create(ctx, &godo.LoadBalancerRequest{
 		Name:            fi.ValueOf(e.Name),
 		Region:          fi.ValueOf(e.Region),
 		Tag:             fi.ValueOf(e.DropletTag),
 		VPCUUID:         vpcUUID,
-		ForwardingRules: Rules,
-		HealthCheck:     HealthCheck,
+		SizeUnit:        uint32(fi.ValueOf(e.SizeUnit)),
+		Algorithm:       fi.ValueOf(e.Algorithm),
+		ForwardingRules: forwardingRules(),
+		HealthCheck:     healthCheck(),
 	})
 	if err != nil {
 		return fmt.Errorf("Error creating load balancer with Name=%s, Error=%v", fi.ValueOf(e.Name), err)
@@ -201,6 +196,108 @@ func (_ *LoadBalancer) RenderDO(t *do.DOAPITarget, a, e, changes *LoadBalancer)
 	return nil
 }
 
+// forwardingRules returns the rules of the load balancer, which passes the TLS traffic through to the control plane
+func forwardingRules() []godo.ForwardingRule {
+	return []godo.ForwardingRule{
+		{
+			EntryProtocol:  "https",
+			EntryPort:      443,
+			TargetProtocol: "https",
+			TargetPort:     443,
+			TlsPassthrough: true,
+		},
+		{
+			EntryProtocol:  "http",
+			EntryPort:      80,
+			TargetProtocol: "http",
+			TargetPort:     80,
+		},
+		{
+			EntryProtocol:  "https",
+			EntryPort:      wellknownports.KopsControllerPort,
+			TargetProtocol: "https",
+			TargetPort:     wellknownports.KopsControllerPort,
+			TlsPassthrough: true,
+		},
+	}
+}
+
+func healthCheck() *godo.HealthCheck {
+	return &godo.HealthCheck{
+		Protocol:               "tcp",
+		Port:                   443,
+		Path:                   "",
+		CheckIntervalSeconds:   60,
+		ResponseTimeoutSeconds: 5,
+		UnhealthyThreshold:     3,
+		HealthyThreshold:       5,
+	}
+}
+
+type terraformForwardingRule struct {
+	EntryProtocol  *string `cty:"entry_protocol"`
+	EntryPort      *int    `cty:"entry_port"`
+	TargetProtocol *string `cty:"target_protocol"`
+	TargetPort     *int    `cty:"target_port"`
+	TLSPassthrough *bool   `cty:"tls_passthrough"`
+}
+
+type terraformHealthCheck struct {
+	Protocol               *string `cty:"protocol"`
+	Port                   *int    `cty:"port"`
+	CheckIntervalSeconds   *int    `cty:"check_interval_seconds"`
+	ResponseTimeoutSeconds *int    `cty:"response_timeout_seconds"`
+	UnhealthyThreshold     *int    `cty:"unhealthy_threshold"`
+	HealthyThreshold       *int    `cty:"healthy_threshold"`
+}
+
+type terraformLoadBalancer struct {
+	Name           *string                   `cty:"name"`
+	Region         *string                   `cty:"region"`
+	SizeUnit       *int32                    `cty:"size_unit"`
+	Algorithm      *string                   `cty:"algorithm"`
+	DropletTag     *string                   `cty:"droplet_tag"`
+	VPCUUID        *string                   `cty:"vpc_uuid"`
+	ForwardingRule []terraformForwardingRule `cty:"forwarding_rule"`
+	Healthcheck    *terraformHealthCheck     `cty:"healthcheck"`
+}
+
+func (_ *LoadBalancer) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *LoadBalancer) error {
+	tf := &terraformLoadBalancer{
+		Name:       e.Name,
+		Region:     e.Region,
+		SizeUnit:   e.SizeUnit,
+		Algorithm:  e.Algorithm,
+		DropletTag: e.DropletTag,
+		VPCUUID:    e.VPCUUID,
+	}
+
+	for _, rule := range forwardingRules() {
+		tfRule := terraformForwardingRule{
+			EntryProtocol:  new(rule.EntryProtocol),
+			EntryPort:      new(rule.EntryPort),
+			TargetProtocol: new(rule.TargetProtocol),
+			TargetPort:     new(rule.TargetPort),
+		}
+		if rule.TlsPassthrough {
+			tfRule.TLSPassthrough = new(true)
+		}
+		tf.ForwardingRule = append(tf.ForwardingRule, tfRule)
+	}
+
+	hc := healthCheck()
+	tf.Healthcheck = &terraformHealthCheck{
+		Protocol:               new(hc.Protocol),
+		Port:                   new(hc.Port),
+		CheckIntervalSeconds:   new(hc.CheckIntervalSeconds),
+		ResponseTimeoutSeconds: new(hc.ResponseTimeoutSeconds),
+		UnhealthyThreshold:     new(hc.UnhealthyThreshold),
+		HealthyThreshold:       new(hc.HealthyThreshold),
+	}
+
+	return t.RenderResource("digitalocean_loadbalancer", fi.ValueOf(e.Name), tf)
+}
+
 // GetWellKnownServices implements fi.HasAddress::GetWellKnownServices.
 // It indicates which services we support with this load balancer.
 func (lb *LoadBalancer) GetWellKnownServices() []wellknownservices.WellKnownService {
@@ -216,7 +313,7 @@ func (lb *LoadBalancer) FindAddresses(c *fi.CloudupContext) ([]string, error) {
 		// able to retrieve ID.
 		done, err := vfs.RetryWithBackoff(readBackoff, func() (bool, error) {
 			klog.V(2).Infof("Finding IP address for load balancer ID=%s", fi.ValueOf(lb.ID))
-			loadBalancer, _, err := loadBalancerService.Get(context.TODO(), fi.ValueOf(lb.ID))
+			loadBalancer, _, err := loadBalancerService.Get(c.Context(), fi.ValueOf(lb.ID))
 			if err != nil {
 				klog.Errorf("Error fetching load balancer with Name=%s", fi.ValueOf(lb.Name))
 				return false, err
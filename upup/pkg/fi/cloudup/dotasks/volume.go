@@ -49,7 +49,7 @@ func (v *Volume) Find(c *fi.CloudupContext) (*Volume, error) {
 	cloud := c.T.Cloud.(do.DOCloud)
 	volService := cloud.VolumeService()
 
-	volumes, _, err := volService.ListVolumes(context.TODO(), &godo.ListVolumeParams{
+	volumes, _, err := volService.ListVolumes(c.Context(), &godo.ListVolumeParams{
 		Region: cloud.Region(),
 		Name:   fi.ValueOf(v.Name),
 	})
@@ -45,7 +45,7 @@ func (v *VPC) Find(c *fi.CloudupContext) (*VPC, error) {
 	vpcService := cloud.VPCsService()
 
 	opt := &godo.ListOptions{}
-	vpcs, _, err := vpcService.List(context.TODO(), opt)
+	vpcs, _, err := vpcService.List(c.Context(), opt)
 	if err != nil {
 		return nil, err
 	}
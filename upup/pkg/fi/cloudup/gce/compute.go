@@ -42,6 +42,8 @@ type ComputeClient interface {
 	TargetPools() TargetPoolClient
 	Disks() DiskClient
 	RegionBackendServices() RegionBackendServiceClient
+	MachineTypes() MachineTypeClient
+	Images() ImageClient
 }
 
 type computeClientImpl struct {
@@ -168,6 +170,18 @@ func (c *computeClientImpl) Disks() DiskClient {
 	}
 }
 
+func (c *computeClientImpl) MachineTypes() MachineTypeClient {
+	return &machineTypeClientImpl{
+		srv: c.srv.MachineTypes,
+	}
+}
+
+func (c *computeClientImpl) Images() ImageClient {
+	return &imageClientImpl{
+		srv: c.srv.Images,
+	}
+}
+
 type ProjectClient interface {
 	Get(project string) (*compute.Project, error)
 }
@@ -680,9 +694,11 @@ type InstanceGroupManagerClient interface {
 	List(ctx context.Context, project, zone string) ([]*compute.InstanceGroupManager, error)
 	ListManagedInstances(ctx context.Context, project, zone, name string) ([]*compute.ManagedInstance, error)
 	RecreateInstances(project, zone, name, id string) (*compute.Operation, error)
+	ApplyUpdatesToInstances(project, zone, name string, req *compute.InstanceGroupManagersApplyUpdatesRequest) (*compute.Operation, error)
 	SetTargetPools(project, zone, name string, targetPools []string) (*compute.Operation, error)
 	SetInstanceTemplate(project, zone, name, instanceTemplateURL string) (*compute.Operation, error)
 	Resize(project, zone, name string, newSize int64) (*compute.Operation, error)
+	Patch(project, zone, name string, i *compute.InstanceGroupManager) (*compute.Operation, error)
 }
 
 type instanceGroupManagerClientImpl struct {
@@ -734,6 +750,10 @@ func (c *instanceGroupManagerClientImpl) RecreateInstances(project, zone, name,
 	return c.srv.RecreateInstances(project, zone, name, req).Do()
 }
 
+func (c *instanceGroupManagerClientImpl) ApplyUpdatesToInstances(project, zone, name string, req *compute.InstanceGroupManagersApplyUpdatesRequest) (*compute.Operation, error) {
+	return c.srv.ApplyUpdatesToInstances(project, zone, name, req).Do()
+}
+
 func (c *instanceGroupManagerClientImpl) SetTargetPools(project, zone, name string, targetPools []string) (*compute.Operation, error) {
 	req := &compute.InstanceGroupManagersSetTargetPoolsRequest{
 		TargetPools: targetPools,
@@ -752,6 +772,10 @@ func (c *instanceGroupManagerClientImpl) Resize(project, zone, name string, newS
 	return c.srv.Resize(project, zone, name, newSize).Do()
 }
 
+func (c *instanceGroupManagerClientImpl) Patch(project, zone, name string, i *compute.InstanceGroupManager) (*compute.Operation, error) {
+	return c.srv.Patch(project, zone, name, i).Do()
+}
+
 type TargetPoolClient interface {
 	Insert(project, region string, tp *compute.TargetPool) (*compute.Operation, error)
 	Delete(project, region, name string) (*compute.Operation, error)
@@ -848,3 +872,43 @@ func (c *diskClientImpl) SetLabels(project, zone, name string, req *compute.Zone
 	_, err := c.srv.SetLabels(project, zone, name, req).Do()
 	return err
 }
+
+type MachineTypeClient interface {
+	Get(ctx context.Context, project, zone, name string) (*compute.MachineType, error)
+	List(ctx context.Context, project, zone string) ([]*compute.MachineType, error)
+}
+
+type machineTypeClientImpl struct {
+	srv *compute.MachineTypesService
+}
+
+var _ MachineTypeClient = (*machineTypeClientImpl)(nil)
+
+func (c *machineTypeClientImpl) Get(ctx context.Context, project, zone, name string) (*compute.MachineType, error) {
+	return c.srv.Get(project, zone, name).Context(ctx).Do()
+}
+
+func (c *machineTypeClientImpl) List(ctx context.Context, project, zone string) ([]*compute.MachineType, error) {
+	var machineTypes []*compute.MachineType
+	if err := c.srv.List(project, zone).Pages(ctx, func(page *compute.MachineTypeList) error {
+		machineTypes = append(machineTypes, page.Items...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return machineTypes, nil
+}
+
+type ImageClient interface {
+	GetFromFamily(ctx context.Context, project, family string) (*compute.Image, error)
+}
+
+type imageClientImpl struct {
+	srv *compute.ImagesService
+}
+
+var _ ImageClient = (*imageClientImpl)(nil)
+
+func (c *imageClientImpl) GetFromFamily(ctx context.Context, project, family string) (*compute.Image, error) {
+	return c.srv.GetFromFamily(project, family).Context(ctx).Do()
+}
@@ -317,7 +317,7 @@ func (c *gceCloudImplementation) WaitForOp(op *compute.Operation) error {
 	return WaitForOp(c.compute.srv, op)
 }
 
-func (c *gceCloudImplementation) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+func (c *gceCloudImplementation) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
 	// TODO: Add context to GetApiIngressStatus
 
 	var ingresses []fi.ApiIngressStatus
@@ -331,7 +331,7 @@ func (c *gceCloudImplementation) GetApiIngressStatus(cluster *kops.Cluster) ([]f
 		project = c.Project()
 	}
 
-	forwardingRules, err := c.compute.ForwardingRules().List(context.Background(), project, c.region)
+	forwardingRules, err := c.compute.ForwardingRules().List(ctx, project, c.region)
 	if err != nil {
 		if !IsNotFound(err) {
 			forwardingRules = nil
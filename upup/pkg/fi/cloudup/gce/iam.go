@@ -19,12 +19,14 @@ package gce
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"google.golang.org/api/iam/v1"
 )
 
 type IamClient interface {
 	ServiceAccounts() ServiceAccountClient
+	WorkloadIdentityPools() WorkloadIdentityPoolClient
 }
 
 type iamClientImpl struct {
@@ -49,12 +51,20 @@ func (i *iamClientImpl) ServiceAccounts() ServiceAccountClient {
 	}
 }
 
+func (i *iamClientImpl) WorkloadIdentityPools() WorkloadIdentityPoolClient {
+	return &workloadIdentityPoolClientImpl{
+		srv: i.srv.Projects.Locations.WorkloadIdentityPools,
+	}
+}
+
 type ServiceAccountClient interface {
 	Get(ctx context.Context, fqn string) (*iam.ServiceAccount, error)
 	Create(ctx context.Context, project string, req *iam.CreateServiceAccountRequest) (*iam.ServiceAccount, error)
 	Update(ctx context.Context, fqn string, sa *iam.ServiceAccount) (*iam.ServiceAccount, error)
 	Delete(saName string) (*iam.Empty, error)
 	List(ctx context.Context, project string) ([]*iam.ServiceAccount, error)
+	GetIamPolicy(ctx context.Context, fqn string) (*iam.Policy, error)
+	SetIamPolicy(ctx context.Context, fqn string, policy *iam.Policy) (*iam.Policy, error)
 }
 
 type serviceAccountClientImpl struct {
@@ -89,3 +99,108 @@ func (s *serviceAccountClientImpl) List(ctx context.Context, project string) ([]
 func (s *serviceAccountClientImpl) Delete(saName string) (*iam.Empty, error) {
 	return s.srv.Delete(saName).Do()
 }
+
+func (s *serviceAccountClientImpl) GetIamPolicy(ctx context.Context, fqn string) (*iam.Policy, error) {
+	return s.srv.GetIamPolicy(fqn).Context(ctx).Do()
+}
+
+func (s *serviceAccountClientImpl) SetIamPolicy(ctx context.Context, fqn string, policy *iam.Policy) (*iam.Policy, error) {
+	return s.srv.SetIamPolicy(fqn, &iam.SetIamPolicyRequest{Policy: policy}).Context(ctx).Do()
+}
+
+// WorkloadIdentityPoolClient manages workload identity pools and their providers.
+// The mutating calls wait for the long-running operations to complete.
+type WorkloadIdentityPoolClient interface {
+	Get(ctx context.Context, name string) (*iam.WorkloadIdentityPool, error)
+	Create(ctx context.Context, parent string, poolID string, pool *iam.WorkloadIdentityPool) error
+	Undelete(ctx context.Context, name string) error
+	Delete(ctx context.Context, name string) error
+	GetProvider(ctx context.Context, name string) (*iam.WorkloadIdentityPoolProvider, error)
+	CreateProvider(ctx context.Context, parent string, providerID string, provider *iam.WorkloadIdentityPoolProvider) error
+	UpdateProvider(ctx context.Context, name string, provider *iam.WorkloadIdentityPoolProvider, updateMask string) error
+	UndeleteProvider(ctx context.Context, name string) error
+}
+
+type workloadIdentityPoolClientImpl struct {
+	srv *iam.ProjectsLocationsWorkloadIdentityPoolsService
+}
+
+var _ WorkloadIdentityPoolClient = (*workloadIdentityPoolClientImpl)(nil)
+
+func (c *workloadIdentityPoolClientImpl) Get(ctx context.Context, name string) (*iam.WorkloadIdentityPool, error) {
+	return c.srv.Get(name).Context(ctx).Do()
+}
+
+func (c *workloadIdentityPoolClientImpl) Create(ctx context.Context, parent string, poolID string, pool *iam.WorkloadIdentityPool) error {
+	op, err := c.srv.Create(parent, pool).WorkloadIdentityPoolId(poolID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.waitForOperation(ctx, op)
+}
+
+func (c *workloadIdentityPoolClientImpl) Undelete(ctx context.Context, name string) error {
+	op, err := c.srv.Undelete(name, &iam.UndeleteWorkloadIdentityPoolRequest{}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.waitForOperation(ctx, op)
+}
+
+func (c *workloadIdentityPoolClientImpl) Delete(ctx context.Context, name string) error {
+	op, err := c.srv.Delete(name).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.waitForOperation(ctx, op)
+}
+
+func (c *workloadIdentityPoolClientImpl) GetProvider(ctx context.Context, name string) (*iam.WorkloadIdentityPoolProvider, error) {
+	return c.srv.Providers.Get(name).Context(ctx).Do()
+}
+
+func (c *workloadIdentityPoolClientImpl) CreateProvider(ctx context.Context, parent string, providerID string, provider *iam.WorkloadIdentityPoolProvider) error {
+	op, err := c.srv.Providers.Create(parent, provider).WorkloadIdentityPoolProviderId(providerID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.waitForOperation(ctx, op)
+}
+
+func (c *workloadIdentityPoolClientImpl) UpdateProvider(ctx context.Context, name string, provider *iam.WorkloadIdentityPoolProvider, updateMask string) error {
+	op, err := c.srv.Providers.Patch(name, provider).UpdateMask(updateMask).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.waitForOperation(ctx, op)
+}
+
+func (c *workloadIdentityPoolClientImpl) UndeleteProvider(ctx context.Context, name string) error {
+	op, err := c.srv.Providers.Undelete(name, &iam.UndeleteWorkloadIdentityPoolProviderRequest{}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.waitForOperation(ctx, op)
+}
+
+// waitForOperation polls the operation until it is done.
+// The operations of pools and providers share the same endpoint, which is addressed by the operation name.
+func (c *workloadIdentityPoolClientImpl) waitForOperation(ctx context.Context, op *iam.Operation) error {
+	for !op.Done {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+
+		latest, err := c.srv.Operations.Get(op.Name).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("error waiting for operation %q: %w", op.Name, err)
+		}
+		op = latest
+	}
+	if op.Error != nil {
+		return fmt.Errorf("operation %q failed: %s", op.Name, op.Error.Message)
+	}
+	return nil
+}
@@ -304,6 +304,10 @@ func addCloudInstanceData(cm *cloudinstances.CloudInstance, instance *compute.In
 	if instance.Status == "RUNNING" {
 		cm.State = cloudinstances.CloudInstanceStatusUpToDate
 	}
+	// Spot VMs preempted with the STOP termination action stay in the managed instance group, stopped
+	if instance.Scheduling != nil && instance.Scheduling.ProvisioningModel == "SPOT" && (instance.Status == "STOPPING" || instance.Status == "TERMINATED") {
+		cm.State = cloudinstances.Evicted
+	}
 	for k := range instance.Labels {
 		if !strings.HasPrefix(k, GceLabelNameRolePrefix) {
 			continue
@@ -157,48 +157,190 @@ func performNetworkAssignmentsIPAliases(ctx context.Context, c *kops.Cluster, cl
 	}
 	nodeSubnet := &c.Spec.Networking.Subnets[0]
 
-	if c.Spec.Networking.PodCIDR != "" && c.Spec.Networking.ServiceClusterIPRange != "" && nodeSubnet.CIDR != "" {
+	// CIDRs should be in the RFC1918 range, but otherwise we have no constraints
+	networkCIDR := "10.0.0.0/8"
+
+	if nodeSubnet.PodRangeName != "" || nodeSubnet.ServiceRangeName != "" {
+		if err := assignExistingSecondaryRanges(c, nodeSubnet, cloudObj.(GCECloud)); err != nil {
+			return err
+		}
+		// The existing ranges may be in any of the private IP ranges
+		privateCIDR, err := privateRangeContaining(c.Spec.Networking.PodCIDR)
+		if err != nil {
+			return err
+		}
+		networkCIDR = privateCIDR
+	} else if c.Spec.Networking.PodCIDR != "" && c.Spec.Networking.ServiceClusterIPRange != "" && nodeSubnet.CIDR != "" {
 		return nil
 	}
 
-	used, err := buildUsed(ctx, c, cloudObj)
-	if err != nil {
-		return err
+	if c.Spec.Networking.PodCIDR == "" || c.Spec.Networking.ServiceClusterIPRange == "" || nodeSubnet.CIDR == "" {
+		used, err := buildUsed(ctx, c, cloudObj)
+		if err != nil {
+			return err
+		}
+
+		if c.Spec.Networking.PodCIDR == "" {
+			podCIDR, err := used.Allocate(networkCIDR, net.CIDRMask(14, 32))
+			if err != nil {
+				return err
+			}
+			c.Spec.Networking.PodCIDR = podCIDR.String()
+		}
+
+		if c.Spec.Networking.ServiceClusterIPRange == "" {
+			serviceCIDR, err := used.Allocate(networkCIDR, net.CIDRMask(16, 32))
+			if err != nil {
+				return err
+			}
+			c.Spec.Networking.ServiceClusterIPRange = serviceCIDR.String()
+		}
+
+		if nodeSubnet.CIDR == "" {
+			nodeCIDR, err := used.Allocate(networkCIDR, net.CIDRMask(19, 32))
+			if err != nil {
+				return err
+			}
+			nodeSubnet.CIDR = nodeCIDR.String()
+		}
 	}
 
-	// CIDRs should be in the RFC1918 range, but otherwise we have no constraints
-	networkCIDR := "10.0.0.0/8"
+	klog.Infof("Will use %v for Nodes, %v for Pods and %v for Services", nodeSubnet.CIDR, c.Spec.Networking.PodCIDR, c.Spec.Networking.ServiceClusterIPRange)
+
+	// NonMasqueradeCIDR should include all the pods and any hosts which can route to the pod IP.
+	// Here, that is any IP on the network.
+	// Networks on GCE don't have a well-defined CIDR (instead, subnets do).
+	// We use networkCIDR instead; these IPs are routable on the GCE network.
+	// Technically this means that the service CIDR would be subject to masquerade,
+	// but that traffic is already remapped before it reaches the masquerade rule.
+	// Ideally we would support all the ip ranges in use (all the private IP ranges),
+	// but as long as we cover the pod CIDR we should be OK.
+	c.Spec.Networking.NonMasqueradeCIDR = networkCIDR
+
+	return nil
+}
 
-	podCIDR, err := used.Allocate(networkCIDR, net.CIDRMask(14, 32))
+// assignExistingSecondaryRanges sets the pod and service CIDRs from the secondary ranges of an existing subnet,
+// which are referenced by name.
+func assignExistingSecondaryRanges(c *kops.Cluster, nodeSubnet *kops.ClusterSubnetSpec, cloud GCECloud) error {
+	_, project, err := ParseNameAndProjectFromNetworkID(c.Spec.Networking.NetworkID)
 	if err != nil {
 		return err
 	}
+	if project == "" {
+		project = cloud.Project()
+	}
 
-	serviceCIDR, err := used.Allocate(networkCIDR, net.CIDRMask(16, 32))
+	subnet, err := cloud.Compute().Subnetworks().Get(project, cloud.Region(), nodeSubnet.ID)
 	if err != nil {
+		return fmt.Errorf("error fetching subnet %q: %w", nodeSubnet.ID, err)
+	}
+
+	ranges := make(map[string]string)
+	for _, r := range subnet.SecondaryIpRanges {
+		ranges[r.RangeName] = r.IpCidrRange
+	}
+
+	if nodeSubnet.CIDR == "" {
+		nodeSubnet.CIDR = subnet.IpCidrRange
+	} else if nodeSubnet.CIDR != subnet.IpCidrRange {
+		return fmt.Errorf("cidr %q of subnet %q does not match its primary range %q", nodeSubnet.CIDR, nodeSubnet.ID, subnet.IpCidrRange)
+	}
+
+	if err := assignSecondaryRange(nodeSubnet.ID, ranges, nodeSubnet.PodRangeName, "podCIDR", &c.Spec.Networking.PodCIDR); err != nil {
+		return err
+	}
+	if err := assignSecondaryRange(nodeSubnet.ID, ranges, nodeSubnet.ServiceRangeName, "serviceClusterIPRange", &c.Spec.Networking.ServiceClusterIPRange); err != nil {
 		return err
 	}
+	return nil
+}
+
+func assignSecondaryRange(subnetName string, ranges map[string]string, rangeName string, fieldName string, cidr *string) error {
+	if rangeName == "" {
+		return nil
+	}
+	rangeCIDR, found := ranges[rangeName]
+	if !found {
+		return fmt.Errorf("subnet %q has no secondary range named %q", subnetName, rangeName)
+	}
+	if *cidr != "" && *cidr != rangeCIDR {
+		return fmt.Errorf("%s %q does not match secondary range %q (%s) of subnet %q", fieldName, *cidr, rangeName, rangeCIDR, subnetName)
+	}
+	*cidr = rangeCIDR
+	return nil
+}
 
-	nodeCIDR, err := used.Allocate(networkCIDR, net.CIDRMask(19, 32))
+// privateRangeContaining returns the RFC1918 range that contains the CIDR.
+func privateRangeContaining(cidr string) (string, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
 	if err != nil {
-		return err
+		return "", fmt.Errorf("error parsing CIDR %q: %w", cidr, err)
+	}
+	for _, private := range []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"} {
+		_, privateNet, _ := net.ParseCIDR(private)
+		if privateNet.Contains(ipNet.IP) {
+			return private, nil
+		}
 	}
+	return "", fmt.Errorf("CIDR %q is not in a private IP range", cidr)
+}
 
-	klog.Infof("Will use %v for Nodes, %v for Pods and %v for Services", nodeCIDR, podCIDR, serviceCIDR)
+// ValidateIPAliasRangeSizes checks that the node and pod ranges of a cluster using IP aliases
+// are large enough for the maximum number of nodes of the instance groups and for maxPods.
+func ValidateIPAliasRangeSizes(c *kops.Cluster, instanceGroups []*kops.InstanceGroup) error {
+	nodeCIDRMaskSize := 24
+	if c.Spec.KubeControllerManager != nil && c.Spec.KubeControllerManager.NodeCIDRMaskSize != nil {
+		nodeCIDRMaskSize = int(*c.Spec.KubeControllerManager.NodeCIDRMaskSize)
+	}
 
-	nodeSubnet.CIDR = nodeCIDR.String()
-	c.Spec.Networking.PodCIDR = podCIDR.String()
-	c.Spec.Networking.ServiceClusterIPRange = serviceCIDR.String()
+	nodes := 0
+	maxPods := 110
+	if c.Spec.Kubelet != nil && c.Spec.Kubelet.MaxPods != nil {
+		maxPods = int(*c.Spec.Kubelet.MaxPods)
+	}
+	for _, ig := range instanceGroups {
+		size := 1
+		if ig.Spec.MinSize != nil {
+			size = int(*ig.Spec.MinSize)
+		}
+		if ig.Spec.MaxSize != nil && int(*ig.Spec.MaxSize) > size {
+			size = int(*ig.Spec.MaxSize)
+		}
+		nodes += size
 
-	// NonMasqueradeCIDR should include all the pods and any hosts which can route to the pod IP.
-	// Here, that is any IP on the network.
-	// Networks on GCE don't have a well-defined CIDR (instead, subnets do).
-	// We use networkCIDR instead; these IPs are routable on the GCE network.
-	// Technically this means that the service CIDR would be subject to masquerade,
-	// but that traffic is already remapped before it reaches the masquerade rule.
-	// Ideally we would support all the ip ranges in use (all the private IP ranges),
-	// but as long as we cover the pod CIDR we should be OK.
-	c.Spec.Networking.NonMasqueradeCIDR = networkCIDR
+		if ig.Spec.Kubelet != nil && ig.Spec.Kubelet.MaxPods != nil && int(*ig.Spec.Kubelet.MaxPods) > maxPods {
+			maxPods = int(*ig.Spec.Kubelet.MaxPods)
+		}
+	}
+
+	if podsPerNode := 1 << (32 - nodeCIDRMaskSize); podsPerNode < maxPods {
+		return fmt.Errorf("the /%d pod range of each node has %d addresses, which is fewer than maxPods (%d)", nodeCIDRMaskSize, podsPerNode, maxPods)
+	}
+
+	_, podNet, err := net.ParseCIDR(c.Spec.Networking.PodCIDR)
+	if err != nil {
+		return fmt.Errorf("error parsing podCIDR %q: %w", c.Spec.Networking.PodCIDR, err)
+	}
+	podPrefix, _ := podNet.Mask.Size()
+	if podPrefix > nodeCIDRMaskSize {
+		return fmt.Errorf("podCIDR %q is smaller than the /%d pod range of a single node", c.Spec.Networking.PodCIDR, nodeCIDRMaskSize)
+	}
+	if podNodes := 1 << (nodeCIDRMaskSize - podPrefix); podNodes < nodes {
+		return fmt.Errorf("podCIDR %q has room for %d nodes with a /%d pod range each, but the instance groups have up to %d nodes", c.Spec.Networking.PodCIDR, podNodes, nodeCIDRMaskSize, nodes)
+	}
+
+	for _, subnet := range c.Spec.Networking.Subnets {
+		_, subnetNet, err := net.ParseCIDR(subnet.CIDR)
+		if err != nil {
+			return fmt.Errorf("error parsing cidr %q of subnet %q: %w", subnet.CIDR, subnet.Name, err)
+		}
+		prefix, _ := subnetNet.Mask.Size()
+		// GCE reserves four addresses in the primary range of each subnet
+		if addresses := (1 << (32 - prefix)) - 4; addresses < nodes {
+			return fmt.Errorf("subnet %q (%s) has room for %d nodes, but the instance groups have up to %d nodes", subnet.Name, subnet.CIDR, addresses, nodes)
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gce_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	compute "google.golang.org/api/compute/v1"
+	gcemock "k8s.io/kops/cloudmock/gce"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+func newIPAliasCluster(subnet kops.ClusterSubnetSpec) *kops.Cluster {
+	c := &kops.Cluster{}
+	c.Name = "test.k8s.local"
+	c.Spec.Networking.NetworkID = "shared-network"
+	c.Spec.Networking.GCP = &kops.GCPNetworkingSpec{}
+	c.Spec.Networking.Subnets = []kops.ClusterSubnetSpec{subnet}
+	return c
+}
+
+func TestPerformNetworkAssignmentsExistingSecondaryRanges(t *testing.T) {
+	cloud := gcemock.InstallMockGCECloud("us-test1", "testproject")
+	_, err := cloud.Compute().Subnetworks().Insert("testproject", "us-test1", &compute.Subnetwork{
+		Name:        "shared-subnet",
+		IpCidrRange: "172.16.0.0/20",
+		SecondaryIpRanges: []*compute.SubnetworkSecondaryRange{
+			{RangeName: "pods", IpCidrRange: "172.20.0.0/14"},
+			{RangeName: "services", IpCidrRange: "172.24.0.0/20"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("creating subnet: %v", err)
+	}
+
+	t.Run("both ranges", func(t *testing.T) {
+		c := newIPAliasCluster(kops.ClusterSubnetSpec{Name: "us-test1", ID: "shared-subnet", PodRangeName: "pods", ServiceRangeName: "services"})
+		if err := gce.PerformNetworkAssignments(context.Background(), c, cloud); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assert.Equal(t, "172.16.0.0/20", c.Spec.Networking.Subnets[0].CIDR)
+		assert.Equal(t, "172.20.0.0/14", c.Spec.Networking.PodCIDR)
+		assert.Equal(t, "172.24.0.0/20", c.Spec.Networking.ServiceClusterIPRange)
+		assert.Equal(t, "172.16.0.0/12", c.Spec.Networking.NonMasqueradeCIDR)
+	})
+
+	t.Run("pod range only", func(t *testing.T) {
+		c := newIPAliasCluster(kops.ClusterSubnetSpec{Name: "us-test1", ID: "shared-subnet", PodRangeName: "pods"})
+		if err := gce.PerformNetworkAssignments(context.Background(), c, cloud); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assert.Equal(t, "172.20.0.0/14", c.Spec.Networking.PodCIDR)
+		assert.NotEmpty(t, c.Spec.Networking.ServiceClusterIPRange)
+		assert.NotEqual(t, "172.24.0.0/20", c.Spec.Networking.ServiceClusterIPRange)
+	})
+
+	t.Run("missing range", func(t *testing.T) {
+		c := newIPAliasCluster(kops.ClusterSubnetSpec{Name: "us-test1", ID: "shared-subnet", PodRangeName: "other"})
+		err := gce.PerformNetworkAssignments(context.Background(), c, cloud)
+		if err == nil || !strings.Contains(err.Error(), `no secondary range named "other"`) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("conflicting podCIDR", func(t *testing.T) {
+		c := newIPAliasCluster(kops.ClusterSubnetSpec{Name: "us-test1", ID: "shared-subnet", PodRangeName: "pods"})
+		c.Spec.Networking.PodCIDR = "10.4.0.0/14"
+		err := gce.PerformNetworkAssignments(context.Background(), c, cloud)
+		if err == nil || !strings.Contains(err.Error(), "does not match secondary range") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestValidateIPAliasRangeSizes(t *testing.T) {
+	grid := []struct {
+		desc          string
+		podCIDR       string
+		subnetCIDR    string
+		maxSize       int32
+		maxPods       int32
+		expectedError string
+	}{
+		{
+			desc:       "fits",
+			podCIDR:    "10.4.0.0/14",
+			subnetCIDR: "10.0.0.0/20",
+			maxSize:    100,
+		},
+		{
+			desc:          "pod range too small",
+			podCIDR:       "10.4.0.0/20",
+			subnetCIDR:    "10.0.0.0/20",
+			maxSize:       100,
+			expectedError: "has room for 16 nodes",
+		},
+		{
+			desc:          "subnet too small",
+			podCIDR:       "10.4.0.0/14",
+			subnetCIDR:    "10.0.0.0/28",
+			maxSize:       100,
+			expectedError: `subnet "us-test1" (10.0.0.0/28) has room for 12 nodes`,
+		},
+		{
+			desc:          "maxPods too large",
+			podCIDR:       "10.4.0.0/14",
+			subnetCIDR:    "10.0.0.0/20",
+			maxSize:       10,
+			maxPods:       300,
+			expectedError: "fewer than maxPods (300)",
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			c := newIPAliasCluster(kops.ClusterSubnetSpec{Name: "us-test1", CIDR: g.subnetCIDR})
+			c.Spec.Networking.PodCIDR = g.podCIDR
+			ig := &kops.InstanceGroup{}
+			ig.Spec.MaxSize = new(g.maxSize)
+			if g.maxPods != 0 {
+				ig.Spec.Kubelet = &kops.KubeletConfigSpec{MaxPods: new(g.maxPods)}
+			}
+
+			err := gce.ValidateIPAliasRangeSizes(c, []*kops.InstanceGroup{ig})
+			if g.expectedError == "" {
+				assert.NoError(t, err)
+			} else if err == nil || !strings.Contains(err.Error(), g.expectedError) {
+				t.Errorf("expected error containing %q, got %v", g.expectedError, err)
+			}
+		})
+	}
+}
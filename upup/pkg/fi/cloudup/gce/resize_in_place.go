@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gce
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/klog/v2"
+
+	"k8s.io/kops/pkg/cloudinstances"
+)
+
+// resizeInPlaceTimeout is how long we wait for a managed instance to be restarted with its new instance template.
+var resizeInPlaceTimeout = 15 * time.Minute
+
+// InPlaceResizer changes the machine type of the instances of a managed instance group by restarting them
+// with the current instance template of the group.
+type InPlaceResizer struct {
+	cloud GCECloud
+}
+
+// NewInPlaceResizer builds an InPlaceResizer for the instances of managed instance groups.
+func NewInPlaceResizer(cloud GCECloud) *InPlaceResizer {
+	return &InPlaceResizer{cloud: cloud}
+}
+
+// PlanResize returns the machine type the instance can be resized to in place, or an empty string if the instance
+// template of its managed instance group has other changes and the instance needs to be recreated.
+func (r *InPlaceResizer) PlanResize(ctx context.Context, i *cloudinstances.CloudInstance) (string, error) {
+	mig, ok := i.CloudInstanceGroup.Raw.(*compute.InstanceGroupManager)
+	if !ok {
+		return "", nil
+	}
+
+	managed, err := r.findManagedInstance(mig, i.ID)
+	if err != nil {
+		return "", err
+	}
+	if managed == nil || managed.Version == nil {
+		return "", nil
+	}
+
+	templates, err := r.cloud.Compute().InstanceTemplates().List(ctx, r.cloud.Project())
+	if err != nil {
+		return "", fmt.Errorf("error listing instance templates: %w", err)
+	}
+	var current, updated *compute.InstanceTemplate
+	for _, template := range templates {
+		switch template.SelfLink {
+		case managed.Version.InstanceTemplate:
+			current = template
+		case mig.InstanceTemplate:
+			updated = template
+		}
+	}
+	if current == nil || updated == nil {
+		return "", nil
+	}
+
+	machineType, ok := machineTypeChange(current.Properties, updated.Properties)
+	if !ok {
+		return "", nil
+	}
+	return machineType, nil
+}
+
+// machineTypeChange returns the new machine type if it is the only difference between two instance templates.
+func machineTypeChange(current, updated *compute.InstanceProperties) (string, bool) {
+	if current == nil || updated == nil || current.MachineType == updated.MachineType {
+		return "", false
+	}
+	if current.Scheduling != nil && current.Scheduling.ProvisioningModel == "SPOT" {
+		// Spot VMs can be preempted while they are stopped
+		return "", false
+	}
+
+	a := *current
+	b := *updated
+	a.MachineType = ""
+	b.MachineType = ""
+	if !reflect.DeepEqual(a, b) {
+		return "", false
+	}
+	return updated.MachineType, true
+}
+
+// Resize lets the managed instance group apply its current instance template to the instance,
+// which only requires restarting the instance when the machine type is the only change.
+func (r *InPlaceResizer) Resize(ctx context.Context, i *cloudinstances.CloudInstance, machineType string) error {
+	mig, ok := i.CloudInstanceGroup.Raw.(*compute.InstanceGroupManager)
+	if !ok {
+		return fmt.Errorf("instance group %q is not backed by a managed instance group", i.CloudInstanceGroup.HumanName)
+	}
+	migURL, err := ParseGoogleCloudURL(mig.SelfLink)
+	if err != nil {
+		return err
+	}
+
+	klog.Infof("Restarting instance %q of MIG %q with machine type %q", i.ID, mig.Name, machineType)
+	op, err := r.cloud.Compute().InstanceGroupManagers().ApplyUpdatesToInstances(migURL.Project, migURL.Zone, migURL.Name, &compute.InstanceGroupManagersApplyUpdatesRequest{
+		Instances:                   []string{i.ID},
+		MinimalAction:               "RESTART",
+		MostDisruptiveAllowedAction: "RESTART",
+	})
+	if err != nil {
+		return fmt.Errorf("error applying instance template to instance %q: %w", i.ID, err)
+	}
+	if err := r.cloud.WaitForOp(op); err != nil {
+		return fmt.Errorf("error applying instance template to instance %q: %w", i.ID, err)
+	}
+
+	deadline := time.Now().Add(resizeInPlaceTimeout)
+	for {
+		managed, err := r.findManagedInstance(mig, i.ID)
+		if err != nil {
+			return err
+		}
+		if managed != nil && managed.CurrentAction == "NONE" && managed.InstanceStatus == "RUNNING" &&
+			managed.Version != nil && managed.Version.InstanceTemplate == mig.InstanceTemplate {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for instance %q to restart", i.ID)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(PollingInterval):
+		}
+	}
+}
+
+func (r *InPlaceResizer) findManagedInstance(mig *compute.InstanceGroupManager, id string) (*compute.ManagedInstance, error) {
+	instances, err := ListManagedInstances(r.cloud, mig)
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		if instance.Instance == id {
+			return instance, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gce
+
+import (
+	"testing"
+
+	compute "google.golang.org/api/compute/v1"
+)
+
+func TestMachineTypeChange(t *testing.T) {
+	grid := []struct {
+		desc     string
+		current  compute.InstanceProperties
+		updated  compute.InstanceProperties
+		expected string
+	}{
+		{
+			desc:     "machine type only",
+			current:  compute.InstanceProperties{MachineType: "n2-standard-2", Labels: map[string]string{"k8s-io-role-control-plane": ""}},
+			updated:  compute.InstanceProperties{MachineType: "n2-standard-4", Labels: map[string]string{"k8s-io-role-control-plane": ""}},
+			expected: "n2-standard-4",
+		},
+		{
+			desc:    "unchanged",
+			current: compute.InstanceProperties{MachineType: "n2-standard-2"},
+			updated: compute.InstanceProperties{MachineType: "n2-standard-2"},
+		},
+		{
+			desc:    "metadata changed too",
+			current: compute.InstanceProperties{MachineType: "n2-standard-2", Metadata: &compute.Metadata{Fingerprint: "a"}},
+			updated: compute.InstanceProperties{MachineType: "n2-standard-4", Metadata: &compute.Metadata{Fingerprint: "b"}},
+		},
+		{
+			desc:    "spot",
+			current: compute.InstanceProperties{MachineType: "n2-standard-2", Scheduling: &compute.Scheduling{ProvisioningModel: "SPOT"}},
+			updated: compute.InstanceProperties{MachineType: "n2-standard-4", Scheduling: &compute.Scheduling{ProvisioningModel: "SPOT"}},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			machineType, ok := machineTypeChange(&g.current, &g.updated)
+			if machineType != g.expected || ok != (g.expected != "") {
+				t.Errorf("machineTypeChange() = %q, %v; expected %q", machineType, ok, g.expected)
+			}
+		})
+	}
+}
@@ -46,8 +46,8 @@ func (c *gceCloudImplementation) allZones() ([]string, error) {
 }
 
 // FindClusterStatus discovers the status of the cluster, by inspecting the cloud objects
-func (c *gceCloudImplementation) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
-	etcdClusters, err := c.findEtcdStatus(cluster)
+func (c *gceCloudImplementation) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+	etcdClusters, err := c.findEtcdStatus(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +60,7 @@ func (c *gceCloudImplementation) FindClusterStatus(cluster *kops.Cluster) (*kops
 }
 
 // FindEtcdStatus discovers the status of etcd, by looking for the tagged etcd volumes
-func (c *gceCloudImplementation) findEtcdStatus(cluster *kops.Cluster) ([]kops.EtcdClusterStatus, error) {
+func (c *gceCloudImplementation) findEtcdStatus(ctx context.Context, cluster *kops.Cluster) ([]kops.EtcdClusterStatus, error) {
 	statusMap := make(map[string]*kops.EtcdClusterStatus)
 
 	labels := c.Labels()
@@ -73,7 +73,6 @@ func (c *gceCloudImplementation) findEtcdStatus(cluster *kops.Cluster) ([]kops.E
 	var disks []*compute.Disk
 
 	// TODO: Filter disks query by Label?
-	ctx := context.Background()
 	for _, zone := range zones {
 		l, err := c.compute.Disks().List(ctx, c.project, zone)
 		if err != nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package gce
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -50,6 +51,45 @@ func IsNotReady(err error) bool {
 	return false
 }
 
+func init() {
+	fi.RegisterErrorClassifier(classifyGCEError)
+}
+
+// classifyGCEError classifies errors returned by the GCE APIs, returning nil if the error is not recognized
+func classifyGCEError(err error) *fi.ClassifiedError {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) {
+		return nil
+	}
+
+	for _, e := range apiErr.Errors {
+		switch e.Reason {
+		case "quotaExceeded", "rateLimitExceeded", "userRateLimitExceeded":
+			return fi.NewClassifiedError(fi.ErrorReasonQuota, err,
+				"A GCP quota was exceeded; delete unused resources or request a quota increase in the Cloud Console.")
+		case "backendError", "internalError":
+			return fi.NewClassifiedError(fi.ErrorReasonTransient, err,
+				"GCP failed the request because of a temporary problem; run the command again.")
+		case "resourceNotReady":
+			return fi.NewClassifiedError(fi.ErrorReasonEventualConsistency, err,
+				"A GCP resource is not ready yet; wait a few minutes and run the command again.")
+		}
+	}
+
+	switch apiErr.Code {
+	case 401, 403:
+		return fi.NewClassifiedError(fi.ErrorReasonAuth, err,
+			"Check that your application default credentials are valid (`gcloud auth application-default login`) and have the permissions kOps needs.")
+	case 429:
+		return fi.NewClassifiedError(fi.ErrorReasonQuota, err,
+			"GCP is rate limiting API requests; wait a few minutes and run the command again.")
+	case 500, 502, 503, 504:
+		return fi.NewClassifiedError(fi.ErrorReasonTransient, err,
+			"GCP failed the request because of a temporary problem; run the command again.")
+	}
+	return nil
+}
+
 // ClusterPrefixedName returns a cluster-prefixed name, with a maxLength
 func ClusterPrefixedName(objectName string, clusterName string, maxLength int) string {
 	suffix := "-" + objectName
@@ -139,6 +179,12 @@ func ServiceAccountName(name string, clusterName string) string {
 	return ClusterSuffixedName(name, clusterName, 30)
 }
 
+// WorkloadIdentityPoolID returns the ID of the workload identity pool trusting the ServiceAccount issuer of the cluster.
+// Pool IDs are limited to 32 characters.
+func WorkloadIdentityPoolID(clusterName string) string {
+	return SafeTruncatedClusterName(clusterName, 32)
+}
+
 // LastComponent returns the last component of a URL, i.e. anything after the last slash
 // If there is no slash, returns the whole string
 func LastComponent(s string) string {
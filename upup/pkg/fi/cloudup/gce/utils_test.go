@@ -17,9 +17,14 @@ limitations under the License.
 package gce
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/googleapi"
+
+	"k8s.io/kops/upup/pkg/fi"
 )
 
 func TestSSHUsernameForImage(t *testing.T) {
@@ -59,3 +64,41 @@ func TestSSHUsernameForImage(t *testing.T) {
 		})
 	}
 }
+
+func TestClassifyGCEError(t *testing.T) {
+	testcases := []struct {
+		err      error
+		expected fi.ErrorReason
+	}{
+		{
+			err:      &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "quotaExceeded"}}},
+			expected: fi.ErrorReasonQuota,
+		},
+		{
+			err:      &googleapi.Error{Code: 401},
+			expected: fi.ErrorReasonAuth,
+		},
+		{
+			err:      fmt.Errorf("error listing instances: %w", &googleapi.Error{Code: 503}),
+			expected: fi.ErrorReasonTransient,
+		},
+		{
+			err:      &googleapi.Error{Code: 500, Errors: []googleapi.ErrorItem{{Reason: "backendError"}}},
+			expected: fi.ErrorReasonTransient,
+		},
+		{
+			err: &googleapi.Error{Code: 400},
+		},
+		{
+			err: errors.New("not a GCE error"),
+		},
+	}
+
+	for _, testcase := range testcases {
+		var actual fi.ErrorReason
+		if classified := classifyGCEError(testcase.err); classified != nil {
+			actual = classified.Reason
+		}
+		assert.Equal(t, testcase.expected, actual, "reason for %v", testcase.err)
+	}
+}
@@ -33,6 +33,9 @@ type AddressFamily string
 const AddressFamilyIPv4 AddressFamily = "ipv4"
 const AddressFamilyIPv6 AddressFamily = "ipv6"
 
+// FirewallDirectionEgress is the direction of rules matching outbound traffic; rules match inbound traffic by default.
+const FirewallDirectionEgress = "EGRESS"
+
 // FirewallRule represents a GCE firewall rules
 // +kops:fitask
 type FirewallRule struct {
@@ -46,6 +49,11 @@ type FirewallRule struct {
 	TargetTags   []string
 	Allowed      []string
 
+	// Direction is the direction of the traffic the rule matches; empty for INGRESS, or EGRESS.
+	Direction string
+	// DestinationRanges are the destination CIDRs of EGRESS rules.
+	DestinationRanges []string
+
 	// Disabled: Denotes whether the firewall rule is disabled. When set to
 	// true, the firewall rule is not enforced and the network behaves as if
 	// it did not exist. If this is unspecified, the firewall rule will be
@@ -78,6 +86,10 @@ func (e *FirewallRule) Find(c *fi.CloudupContext) (*FirewallRule, error) {
 	actual.SourceRanges = r.SourceRanges
 	actual.SourceTags = r.SourceTags
 	actual.Disabled = r.Disabled
+	if r.Direction == FirewallDirectionEgress {
+		actual.Direction = r.Direction
+	}
+	actual.DestinationRanges = r.DestinationRanges
 	for _, a := range r.Allowed {
 		actual.Allowed = append(actual.Allowed, serializeFirewallAllowed(a))
 	}
@@ -96,45 +108,61 @@ func (e *FirewallRule) Run(c *fi.CloudupContext) error {
 // Normalize applies some validation that isn't technically required,
 // but avoids some problems with surprising behaviours.
 func (e *FirewallRule) Normalize(c *fi.CloudupContext) error {
-	if !e.Disabled {
-		// Treat it as an error if SourceRanges _and_ SourceTags empty with Disabled=false
-		// this is interpreted as SourceRanges="0.0.0.0/0", which is likely not what was intended.
-		if len(e.SourceRanges) == 0 && len(e.SourceTags) == 0 {
-			return fmt.Errorf("either SourceRanges or SourceTags should be specified when Disabled is false")
+	ranges := e.SourceRanges
+	if e.Direction == FirewallDirectionEgress {
+		// Egress rules match on the destination, the source is always the targeted instances.
+		if len(e.SourceRanges) != 0 || len(e.SourceTags) != 0 {
+			return fmt.Errorf("SourceRanges and SourceTags should not be specified for EGRESS rules")
+		}
+		if !e.Disabled && len(e.DestinationRanges) == 0 {
+			return fmt.Errorf("DestinationRanges should be specified for EGRESS rules when Disabled is false")
+		}
+		ranges = e.DestinationRanges
+	} else {
+		if !e.Disabled {
+			// Treat it as an error if SourceRanges _and_ SourceTags empty with Disabled=false
+			// this is interpreted as SourceRanges="0.0.0.0/0", which is likely not what was intended.
+			if len(e.SourceRanges) == 0 && len(e.SourceTags) == 0 {
+				return fmt.Errorf("either SourceRanges or SourceTags should be specified when Disabled is false")
+			}
 		}
-	}
 
-	// Treat it as an error if SourceRanges _and_ SourceTags both set;
-	// this is interpreted as OR, not AND, which is likely not what was intended.
-	if len(e.SourceRanges) != 0 && len(e.SourceTags) != 0 {
-		return fmt.Errorf("SourceRanges and SourceTags should not both be specified")
+		// Treat it as an error if SourceRanges _and_ SourceTags both set;
+		// this is interpreted as OR, not AND, which is likely not what was intended.
+		if len(e.SourceRanges) != 0 && len(e.SourceTags) != 0 {
+			return fmt.Errorf("SourceRanges and SourceTags should not both be specified")
+		}
+
+		if len(e.DestinationRanges) != 0 {
+			return fmt.Errorf("DestinationRanges should only be specified for EGRESS rules")
+		}
 	}
 
 	name := fi.ValueOf(e.Name)
 
 	// Make sure we've split the ipv4 / ipv6 addresses.
 	// A single firewall rule can't mix ipv4 and ipv6 addresses, so we split them into two rules.
-	for _, sourceRange := range e.SourceRanges {
-		_, cidr, err := net.ParseCIDR(sourceRange)
+	for _, r := range ranges {
+		_, cidr, err := net.ParseCIDR(r)
 		if err != nil {
-			return fmt.Errorf("sourceRange %q is not valid: %w", sourceRange, err)
+			return fmt.Errorf("range %q is not valid: %w", r, err)
 		}
 
 		if e.Family == "" {
 			// This is our own requirement, just for consistency checking.
 			// Previous we used the name, but that was confused when the cluster name was ipv6.example.com
-			return fmt.Errorf("must set Family when using SourceRanges")
+			return fmt.Errorf("must set Family when using SourceRanges or DestinationRanges")
 		}
 
 		if cidr.IP.To4() != nil {
 			// IPv4
 			if e.Family != AddressFamilyIPv4 {
-				return fmt.Errorf("ipv4 ranges should not be in a ipv6-named rule (found %s in %s)", sourceRange, name)
+				return fmt.Errorf("ipv4 ranges should not be in a ipv6-named rule (found %s in %s)", r, name)
 			}
 		} else {
 			// IPv6
 			if e.Family != AddressFamilyIPv6 {
-				return fmt.Errorf("ipv6 ranges should be in a ipv6-named rule (found %s in %s)", sourceRange, name)
+				return fmt.Errorf("ipv6 ranges should be in a ipv6-named rule (found %s in %s)", r, name)
 			}
 		}
 	}
@@ -198,6 +226,9 @@ func (e *FirewallRule) mapToGCE(project string) (*compute.Firewall, error) {
 		TargetTags:   e.TargetTags,
 		Allowed:      allowed,
 		Disabled:     e.Disabled,
+
+		Direction:         e.Direction,
+		DestinationRanges: e.DestinationRanges,
 	}
 	return firewall, nil
 }
@@ -241,6 +272,9 @@ type terraformFirewall struct {
 	TargetTags   []string `cty:"target_tags"`
 
 	Disabled bool `cty:"disabled"`
+
+	Direction         *string  `cty:"direction"`
+	DestinationRanges []string `cty:"destination_ranges"`
 }
 
 func (_ *FirewallRule) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *FirewallRule) error {
@@ -267,6 +301,11 @@ func (_ *FirewallRule) RenderTerraform(t *terraform.TerraformTarget, a, e, chang
 		SourceTags:   g.SourceTags,
 		Allowed:      allowed,
 		Disabled:     g.Disabled,
+
+		DestinationRanges: g.DestinationRanges,
+	}
+	if g.Direction != "" {
+		tf.Direction = new(g.Direction)
 	}
 
 	tf.Network = e.Network.TerraformLink()
@@ -17,6 +17,7 @@ limitations under the License.
 package gcetasks
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -379,6 +380,24 @@ func BuildImageURL(defaultProject, nameSpec string) string {
 	return u
 }
 
+// ResolveImage resolves an image spec that references an image family, "<project>/family/<family>",
+// to the latest image of the family, as "<project>/<image>".
+// Other image specs are returned unchanged.
+func ResolveImage(ctx context.Context, cloud gce.GCECloud, nameSpec string) (string, error) {
+	tokens := strings.Split(nameSpec, "/")
+	if len(tokens) != 3 || tokens[1] != "family" {
+		return nameSpec, nil
+	}
+	project, family := tokens[0], tokens[2]
+
+	image, err := cloud.Compute().Images().GetFromFamily(ctx, project, family)
+	if err != nil {
+		return "", fmt.Errorf("error getting latest image of family %q in project %q: %w", family, project, err)
+	}
+	klog.V(2).Infof("Resolved image family %q -> %q", nameSpec, project+"/"+image.Name)
+	return project + "/" + image.Name, nil
+}
+
 func ShortenImageURL(defaultProject string, imageURL string) (string, error) {
 	u, err := gce.ParseGoogleCloudURL(imageURL)
 	if err != nil {
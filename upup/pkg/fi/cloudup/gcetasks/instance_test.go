@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcetasks
+
+import (
+	"context"
+	"testing"
+
+	gcemock "k8s.io/kops/cloudmock/gce"
+	"k8s.io/kops/cloudmock/gce/mockcompute"
+)
+
+func TestResolveImage(t *testing.T) {
+	cloud := gcemock.InstallMockGCECloud("us-test1", "testproject")
+	cloud.Compute().(*mockcompute.MockClient).SetImageFamily("ubuntu-os-cloud", "ubuntu-2404-lts-amd64", "ubuntu-2404-noble-amd64-v20260901")
+
+	grid := []struct {
+		Image    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Image:    "ubuntu-os-cloud/family/ubuntu-2404-lts-amd64",
+			Expected: "ubuntu-os-cloud/ubuntu-2404-noble-amd64-v20260901",
+		},
+		{
+			Image:    "ubuntu-os-cloud/ubuntu-2404-noble-amd64-v20260801",
+			Expected: "ubuntu-os-cloud/ubuntu-2404-noble-amd64-v20260801",
+		},
+		{
+			Image:    "my-image",
+			Expected: "my-image",
+		},
+		{
+			Image: "ubuntu-os-cloud/family/unknown",
+			Error: true,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Image, func(t *testing.T) {
+			actual, err := ResolveImage(context.TODO(), cloud, g.Image)
+			if g.Error {
+				if err == nil {
+					t.Fatalf("expected error resolving %q", g.Image)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != g.Expected {
+				t.Errorf("unexpected image %q, expected %q", actual, g.Expected)
+			}
+		})
+	}
+}
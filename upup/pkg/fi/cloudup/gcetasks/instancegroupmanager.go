@@ -19,6 +19,8 @@ package gcetasks
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	compute "google.golang.org/api/compute/v1"
 	"k8s.io/kops/upup/pkg/fi"
@@ -38,6 +40,9 @@ type InstanceGroupManager struct {
 	ListManagedInstancesResults string
 	TargetSize                  *int64
 	UpdatePolicy                *UpdatePolicy
+	// MachineTypes are the machine types, in order of preference, that the MIG creates instances with.
+	// If empty, instances use the machine type of the instance template.
+	MachineTypes []string
 
 	TargetPools []*TargetPool
 }
@@ -68,7 +73,11 @@ func (e *InstanceGroupManager) Find(c *fi.CloudupContext) (*InstanceGroupManager
 	actual.ListManagedInstancesResults = r.ListManagedInstancesResults
 
 	if policy := r.UpdatePolicy; policy != nil {
-		actual.UpdatePolicy = &UpdatePolicy{MinimalAction: policy.MinimalAction, Type: policy.Type}
+		actual.UpdatePolicy = updatePolicyFromCompute(policy, e.UpdatePolicy)
+	}
+
+	if policy := r.InstanceFlexibilityPolicy; policy != nil {
+		actual.MachineTypes = machineTypesFromFlexibilityPolicy(policy)
 	}
 
 	for _, targetPool := range r.TargetPools {
@@ -110,10 +119,11 @@ func (_ *InstanceGroupManager) RenderGCE(t *gce.GCEAPITarget, a, e, changes *Ins
 	}
 
 	if policy := e.UpdatePolicy; policy != nil {
-		i.UpdatePolicy = &compute.InstanceGroupManagerUpdatePolicy{
-			MinimalAction: policy.MinimalAction,
-			Type:          policy.Type,
-		}
+		i.UpdatePolicy = buildComputeUpdatePolicy(policy)
+	}
+
+	if len(e.MachineTypes) != 0 {
+		i.InstanceFlexibilityPolicy = buildFlexibilityPolicy(e.MachineTypes)
 	}
 
 	for _, targetPool := range e.TargetPools {
@@ -160,6 +170,38 @@ func (_ *InstanceGroupManager) RenderGCE(t *gce.GCEAPITarget, a, e, changes *Ins
 			changes.InstanceTemplate = nil
 		}
 
+		if changes.MachineTypes != nil {
+			patch := &compute.InstanceGroupManager{
+				InstanceFlexibilityPolicy: i.InstanceFlexibilityPolicy,
+			}
+			op, err := t.Cloud.Compute().InstanceGroupManagers().Patch(t.Cloud.Project(), *e.Zone, i.Name, patch)
+			if err != nil {
+				return fmt.Errorf("error updating InstanceFlexibilityPolicy for InstanceGroupManager: %v", err)
+			}
+
+			if err := t.Cloud.WaitForOp(op); err != nil {
+				return fmt.Errorf("error updating InstanceFlexibilityPolicy for InstanceGroupManager: %v", err)
+			}
+
+			changes.MachineTypes = nil
+		}
+
+		if changes.UpdatePolicy != nil {
+			patch := &compute.InstanceGroupManager{
+				UpdatePolicy: i.UpdatePolicy,
+			}
+			op, err := t.Cloud.Compute().InstanceGroupManagers().Patch(t.Cloud.Project(), *e.Zone, i.Name, patch)
+			if err != nil {
+				return fmt.Errorf("error updating UpdatePolicy for InstanceGroupManager: %v", err)
+			}
+
+			if err := t.Cloud.WaitForOp(op); err != nil {
+				return fmt.Errorf("error updating UpdatePolicy for InstanceGroupManager: %v", err)
+			}
+
+			changes.UpdatePolicy = nil
+		}
+
 		if changes.TargetSize != nil {
 			newSize := int64(0)
 			if i.TargetSize != 0 {
@@ -186,6 +228,47 @@ func (_ *InstanceGroupManager) RenderGCE(t *gce.GCEAPITarget, a, e, changes *Ins
 	return nil
 }
 
+// buildFlexibilityPolicy builds an instance flexibility policy that ranks the machine types in the order given.
+func buildFlexibilityPolicy(machineTypes []string) *compute.InstanceGroupManagerInstanceFlexibilityPolicy {
+	policy := &compute.InstanceGroupManagerInstanceFlexibilityPolicy{
+		InstanceSelections: make(map[string]compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection),
+	}
+	for rank, machineType := range machineTypes {
+		selection := compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection{
+			MachineTypes: []string{machineType},
+			Rank:         int64(rank),
+		}
+		if rank == 0 {
+			// Rank 0 is omitted by the marshaling code, but it is the highest preference
+			selection.ForceSendFields = []string{"Rank"}
+		}
+		policy.InstanceSelections[fmt.Sprintf("rank-%d", rank)] = selection
+	}
+	return policy
+}
+
+// machineTypesFromFlexibilityPolicy returns the machine types of an instance flexibility policy, ordered by rank.
+func machineTypesFromFlexibilityPolicy(policy *compute.InstanceGroupManagerInstanceFlexibilityPolicy) []string {
+	var selections []compute.InstanceGroupManagerInstanceFlexibilityPolicyInstanceSelection
+	for _, selection := range policy.InstanceSelections {
+		selections = append(selections, selection)
+	}
+	sort.SliceStable(selections, func(i, j int) bool {
+		if selections[i].Rank != selections[j].Rank {
+			return selections[i].Rank < selections[j].Rank
+		}
+		return strings.Join(selections[i].MachineTypes, ",") < strings.Join(selections[j].MachineTypes, ",")
+	})
+
+	var machineTypes []string
+	for _, selection := range selections {
+		for _, machineType := range selection.MachineTypes {
+			machineTypes = append(machineTypes, lastComponent(machineType))
+		}
+	}
+	return machineTypes
+}
+
 type terraformInstanceGroupManager struct {
 	Lifecycle                   *terraform.Lifecycle       `cty:"lifecycle"`
 	Name                        *string                    `cty:"name"`
@@ -199,8 +282,13 @@ type terraformInstanceGroupManager struct {
 }
 
 type terraformUpdatePolicy struct {
-	MinimalAction string `cty:"minimal_action"`
-	Type          string `cty:"type"`
+	MinimalAction         string  `cty:"minimal_action"`
+	Type                  string  `cty:"type"`
+	ReplacementMethod     *string `cty:"replacement_method"`
+	MaxSurgeFixed         *int64  `cty:"max_surge_fixed"`
+	MaxSurgePercent       *int64  `cty:"max_surge_percent"`
+	MaxUnavailableFixed   *int64  `cty:"max_unavailable_fixed"`
+	MaxUnavailablePercent *int64  `cty:"max_unavailable_percent"`
 }
 
 type terraformVersion struct {
@@ -208,6 +296,10 @@ type terraformVersion struct {
 }
 
 func (_ *InstanceGroupManager) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *InstanceGroupManager) error {
+	if len(e.MachineTypes) != 0 {
+		return fmt.Errorf("fallback machine types for InstanceGroupManager %q are not supported with terraform", fi.ValueOf(e.Name))
+	}
+
 	tf := &terraformInstanceGroupManager{
 		Name:                        e.Name,
 		Zone:                        e.Zone,
@@ -220,8 +312,15 @@ func (_ *InstanceGroupManager) RenderTerraform(t *terraform.TerraformTarget, a,
 	}
 	if policy := e.UpdatePolicy; policy != nil {
 		tf.UpdatePolicy = &terraformUpdatePolicy{
-			MinimalAction: policy.MinimalAction,
-			Type:          policy.Type,
+			MinimalAction:         policy.MinimalAction,
+			Type:                  policy.Type,
+			MaxSurgeFixed:         policy.MaxSurgeFixed,
+			MaxSurgePercent:       policy.MaxSurgePercent,
+			MaxUnavailableFixed:   policy.MaxUnavailableFixed,
+			MaxUnavailablePercent: policy.MaxUnavailablePercent,
+		}
+		if policy.ReplacementMethod != "" {
+			tf.UpdatePolicy.ReplacementMethod = &policy.ReplacementMethod
 		}
 	}
 	tf.Version = &terraformVersion{
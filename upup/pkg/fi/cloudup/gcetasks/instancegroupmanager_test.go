@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcetasks
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlexibilityPolicyMachineTypes(t *testing.T) {
+	machineTypes := []string{"n2-standard-2", "n2d-standard-2", "e2-standard-2"}
+
+	policy := buildFlexibilityPolicy(machineTypes)
+	if len(policy.InstanceSelections) != len(machineTypes) {
+		t.Fatalf("expected %d instance selections, but got %d", len(machineTypes), len(policy.InstanceSelections))
+	}
+	if selection := policy.InstanceSelections["rank-0"]; selection.Rank != 0 || !reflect.DeepEqual(selection.ForceSendFields, []string{"Rank"}) {
+		t.Errorf("expected rank 0 to be sent, but got %+v", selection)
+	}
+
+	// GCE may return machine types as URLs
+	selection := policy.InstanceSelections["rank-1"]
+	selection.MachineTypes = []string{"https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a/machineTypes/n2d-standard-2"}
+	policy.InstanceSelections["rank-1"] = selection
+
+	if actual := machineTypesFromFlexibilityPolicy(policy); !reflect.DeepEqual(actual, machineTypes) {
+		t.Errorf("expected machine types %v, but got %v", machineTypes, actual)
+	}
+}
@@ -56,6 +56,8 @@ type InstanceTemplate struct {
 	Labels               map[string]string
 	Preemptible          *bool
 	GCPProvisioningModel *string
+	// InstanceTerminationAction is the action taken when a preemptible instance is preempted (STOP or DELETE).
+	InstanceTerminationAction *string
 
 	BootDiskImage      *string
 	BootDiskSizeGB     *int64
@@ -105,7 +107,12 @@ func (e *InstanceTemplate) Find(c *fi.CloudupContext) (*InstanceTemplate, error)
 		return nil, fmt.Errorf("error listing InstanceTemplates: %v", err)
 	}
 
-	expected, err := e.mapToGCE(cloud.Project(), cloud.Region())
+	resolvedBootDiskImage, err := ResolveImage(context.TODO(), cloud, fi.ValueOf(e.BootDiskImage))
+	if err != nil {
+		return nil, err
+	}
+
+	expected, err := e.mapToGCE(cloud.Project(), cloud.Region(), resolvedBootDiskImage)
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +140,10 @@ func (e *InstanceTemplate) Find(c *fi.CloudupContext) (*InstanceTemplate, error)
 			return nil, fmt.Errorf("error parsing source image URL: %v", err)
 		}
 		actual.BootDiskImage = new(bootDiskImage)
+		// Avoid spurious changes when the image is resolved from an image family
+		if e.BootDiskImage != nil && bootDiskImage == resolvedBootDiskImage {
+			actual.BootDiskImage = e.BootDiskImage
+		}
 		actual.BootDiskType = &p.Disks[0].InitializeParams.DiskType
 		actual.BootDiskSizeGB = &p.Disks[0].InitializeParams.DiskSizeGb
 		if p.Disks[0].InitializeParams.ProvisionedIops > 0 {
@@ -145,6 +156,9 @@ func (e *InstanceTemplate) Find(c *fi.CloudupContext) (*InstanceTemplate, error)
 		if p.Scheduling != nil {
 			actual.Preemptible = &p.Scheduling.Preemptible
 			actual.GCPProvisioningModel = &p.Scheduling.ProvisioningModel
+			if p.Scheduling.InstanceTerminationAction != "" {
+				actual.InstanceTerminationAction = &p.Scheduling.InstanceTerminationAction
+			}
 		}
 		if len(p.NetworkInterfaces) != 0 {
 			ni := p.NetworkInterfaces[0]
@@ -318,19 +332,22 @@ func guessMachineTypeInfo(machineType string) (*MachineTypeInfo, error) {
 	return machineTypeInfo, nil
 }
 
-func (e *InstanceTemplate) mapToGCE(project string, region string) (*compute.InstanceTemplate, error) {
+func (e *InstanceTemplate) mapToGCE(project string, region string, bootDiskImage string) (*compute.InstanceTemplate, error) {
 	machineTypeInfo, err := guessMachineTypeInfo(fi.ValueOf(e.MachineType))
 	if err != nil {
 		return nil, fmt.Errorf("getting machine type info: %w", err)
 	}
 
 	scheduling := buildScheduling(machineTypeInfo, e.Preemptible, e.GCPProvisioningModel, e.GuestAccelerators)
+	if scheduling.Preemptible && e.InstanceTerminationAction != nil {
+		scheduling.InstanceTerminationAction = *e.InstanceTerminationAction
+	}
 
 	var disks []*compute.AttachedDisk
 	disks = append(disks, &compute.AttachedDisk{
 		Kind: "compute#attachedDisk",
 		InitializeParams: &compute.AttachedDiskInitializeParams{
-			SourceImage: BuildImageURL(project, *e.BootDiskImage),
+			SourceImage: BuildImageURL(project, bootDiskImage),
 			DiskSizeGb:  *e.BootDiskSizeGB,
 			DiskType:    *e.BootDiskType,
 		},
@@ -520,7 +537,12 @@ func (_ *InstanceTemplate) RenderGCE(t *gce.GCEAPITarget, a, e, changes *Instanc
 	project := t.Cloud.Project()
 	region := t.Cloud.Region()
 
-	i, err := e.mapToGCE(project, region)
+	bootDiskImage, err := ResolveImage(context.TODO(), t.Cloud, fi.ValueOf(e.BootDiskImage))
+	if err != nil {
+		return err
+	}
+
+	i, err := e.mapToGCE(project, region, bootDiskImage)
 	if err != nil {
 		return err
 	}
@@ -573,6 +595,8 @@ type terraformScheduling struct {
 	OnHostMaintenance string `cty:"on_host_maintenance"`
 	Preemptible       bool   `cty:"preemptible"`
 	ProvisioningModel string `cty:"provisioning_model"`
+
+	InstanceTerminationAction *string `cty:"instance_termination_action"`
 }
 
 type terraformInstanceTemplateAttachedDisk struct {
@@ -677,7 +701,12 @@ func mapServiceAccountsToTerraform(serviceAccounts []*ServiceAccount, saScopes [
 func (_ *InstanceTemplate) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *InstanceTemplate) error {
 	project := t.Project
 
-	i, err := e.mapToGCE(project, t.Cloud.Region())
+	bootDiskImage, err := ResolveImage(context.TODO(), t.Cloud.(gce.GCECloud), fi.ValueOf(e.BootDiskImage))
+	if err != nil {
+		return err
+	}
+
+	i, err := e.mapToGCE(project, t.Cloud.Region(), bootDiskImage)
 	if err != nil {
 		return err
 	}
@@ -735,6 +764,9 @@ func (_ *InstanceTemplate) RenderTerraform(t *terraform.TerraformTarget, a, e, c
 			Preemptible:       i.Properties.Scheduling.Preemptible,
 			ProvisioningModel: i.Properties.Scheduling.ProvisioningModel,
 		}
+		if action := i.Properties.Scheduling.InstanceTerminationAction; action != "" {
+			tf.Scheduling.InstanceTerminationAction = &action
+		}
 	}
 
 	if len(i.Properties.GuestAccelerators) > 0 {
@@ -46,7 +46,7 @@ func (e *ProjectIAMBinding) CompareWithID() *string {
 }
 
 func (e *ProjectIAMBinding) Find(c *fi.CloudupContext) (*ProjectIAMBinding, error) {
-	ctx := context.TODO()
+	ctx := c.Context()
 
 	cloud := c.T.Cloud.(gce.GCECloud)
 
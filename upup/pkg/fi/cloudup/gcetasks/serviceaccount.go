@@ -50,7 +50,7 @@ func (e *ServiceAccount) CompareWithID() *string {
 func (e *ServiceAccount) Find(c *fi.CloudupContext) (*ServiceAccount, error) {
 	cloud := c.T.Cloud.(gce.GCECloud)
 
-	ctx := context.TODO()
+	ctx := c.Context()
 
 	email := fi.ValueOf(e.Email)
 
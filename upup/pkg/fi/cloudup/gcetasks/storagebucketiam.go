@@ -46,7 +46,7 @@ func (e *StorageBucketIAM) CompareWithID() *string {
 }
 
 func (e *StorageBucketIAM) Find(c *fi.CloudupContext) (*StorageBucketIAM, error) {
-	ctx := context.TODO()
+	ctx := c.Context()
 
 	cloud := c.T.Cloud.(gce.GCECloud)
 
@@ -16,12 +16,25 @@ limitations under the License.
 
 package gcetasks
 
-import "k8s.io/kops/upup/pkg/fi"
+import (
+	compute "google.golang.org/api/compute/v1"
+
+	"k8s.io/kops/upup/pkg/fi"
+)
 
 // UpdatePolicy represents a GCE instance group manager UpdatePolicy
 type UpdatePolicy struct {
 	MinimalAction string
 	Type          string
+
+	// ReplacementMethod is SUBSTITUTE or RECREATE; if empty, the method of the instance group manager is not managed.
+	ReplacementMethod string
+	// MaxSurgeFixed and MaxSurgePercent limit the instances created above the target size; if both are nil, the limit is not managed.
+	MaxSurgeFixed   *int64
+	MaxSurgePercent *int64
+	// MaxUnavailableFixed and MaxUnavailablePercent limit the unavailable instances; if both are nil, the limit is not managed.
+	MaxUnavailableFixed   *int64
+	MaxUnavailablePercent *int64
 }
 
 var _ fi.CloudupHasDependencies = (*UpdatePolicy)(nil)
@@ -29,3 +42,55 @@ var _ fi.CloudupHasDependencies = (*UpdatePolicy)(nil)
 func (_ *UpdatePolicy) GetDependencies(tasks map[string]fi.CloudupTask) []fi.CloudupTask {
 	return nil
 }
+
+// buildComputeUpdatePolicy builds the compute API representation of the update policy.
+func buildComputeUpdatePolicy(policy *UpdatePolicy) *compute.InstanceGroupManagerUpdatePolicy {
+	return &compute.InstanceGroupManagerUpdatePolicy{
+		MinimalAction:     policy.MinimalAction,
+		Type:              policy.Type,
+		ReplacementMethod: policy.ReplacementMethod,
+		MaxSurge:          buildFixedOrPercent(policy.MaxSurgeFixed, policy.MaxSurgePercent),
+		MaxUnavailable:    buildFixedOrPercent(policy.MaxUnavailableFixed, policy.MaxUnavailablePercent),
+	}
+}
+
+// updatePolicyFromCompute builds an UpdatePolicy from the compute API representation,
+// leaving out the settings that are not managed by the expected policy.
+func updatePolicyFromCompute(policy *compute.InstanceGroupManagerUpdatePolicy, expected *UpdatePolicy) *UpdatePolicy {
+	actual := &UpdatePolicy{MinimalAction: policy.MinimalAction, Type: policy.Type}
+	if expected == nil {
+		return actual
+	}
+	if expected.ReplacementMethod != "" {
+		actual.ReplacementMethod = policy.ReplacementMethod
+	}
+	if expected.MaxSurgeFixed != nil || expected.MaxSurgePercent != nil {
+		actual.MaxSurgeFixed, actual.MaxSurgePercent = fixedOrPercentFromCompute(policy.MaxSurge)
+	}
+	if expected.MaxUnavailableFixed != nil || expected.MaxUnavailablePercent != nil {
+		actual.MaxUnavailableFixed, actual.MaxUnavailablePercent = fixedOrPercentFromCompute(policy.MaxUnavailable)
+	}
+	return actual
+}
+
+func buildFixedOrPercent(fixed *int64, percent *int64) *compute.FixedOrPercent {
+	switch {
+	case percent != nil:
+		return &compute.FixedOrPercent{Percent: *percent, ForceSendFields: []string{"Percent"}}
+	case fixed != nil:
+		// A fixed value of 0 is omitted by the marshaling code, but it is meaningful
+		return &compute.FixedOrPercent{Fixed: *fixed, ForceSendFields: []string{"Fixed"}}
+	default:
+		return nil
+	}
+}
+
+func fixedOrPercentFromCompute(v *compute.FixedOrPercent) (*int64, *int64) {
+	if v == nil {
+		return nil, nil
+	}
+	if v.Percent != 0 {
+		return nil, new(v.Percent)
+	}
+	return new(v.Fixed), nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcetasks
+
+import (
+	"context"
+	"testing"
+
+	gcemock "k8s.io/kops/cloudmock/gce"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func TestWorkloadIdentity(t *testing.T) {
+	ctx := context.TODO()
+
+	project := "testproject"
+	region := "us-test1"
+
+	cloud := gcemock.InstallMockGCECloud(region, project)
+
+	// We define a function so we can rebuild the tasks, because we modify in-place when running
+	buildTasks := func() map[string]fi.CloudupTask {
+		pool := &WorkloadIdentityPool{
+			Name:      new("test-cluster"),
+			Lifecycle: fi.LifecycleSync,
+
+			ProjectNumber: new("123456789"),
+			Description:   new("test pool"),
+		}
+
+		provider := &WorkloadIdentityPoolProvider{
+			Name:      new("kubernetes"),
+			Lifecycle: fi.LifecycleSync,
+
+			Pool:      pool,
+			IssuerURI: new("https://discovery.example.com/test-cluster"),
+			AttributeMapping: map[string]string{
+				"google.subject": "assertion.sub",
+			},
+		}
+
+		serviceAccount := &ServiceAccount{
+			Name:      new("dns-controller"),
+			Lifecycle: fi.LifecycleSync,
+
+			Email: new("dns-controller@testproject.iam.gserviceaccount.com"),
+		}
+
+		binding := &WorkloadIdentityBinding{
+			Name:      new("dns-controller"),
+			Lifecycle: fi.LifecycleSync,
+
+			ServiceAccount: serviceAccount,
+			Pool:           pool,
+			Subject:        new("system:serviceaccount:kube-system:dns-controller"),
+		}
+
+		return map[string]fi.CloudupTask{
+			"pool":           pool,
+			"provider":       provider,
+			"serviceAccount": serviceAccount,
+			"binding":        binding,
+		}
+	}
+
+	{
+		allTasks := buildTasks()
+		checkHasChanges(t, ctx, cloud, allTasks)
+	}
+
+	{
+		allTasks := buildTasks()
+		runTasks(t, ctx, cloud, allTasks)
+	}
+
+	{
+		allTasks := buildTasks()
+		checkNoChanges(t, ctx, cloud, allTasks)
+	}
+
+	// A deleted pool is restored rather than recreated
+	poolName := "projects/123456789/locations/global/workloadIdentityPools/test-cluster"
+	if err := cloud.IAM().WorkloadIdentityPools().Delete(ctx, poolName); err != nil {
+		t.Fatalf("failed to delete pool: %v", err)
+	}
+
+	{
+		allTasks := buildTasks()
+		checkHasChanges(t, ctx, cloud, allTasks)
+	}
+
+	{
+		allTasks := buildTasks()
+		runTasks(t, ctx, cloud, allTasks)
+	}
+
+	{
+		allTasks := buildTasks()
+		checkNoChanges(t, ctx, cloud, allTasks)
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcetasks
+
+import (
+	"fmt"
+
+	"google.golang.org/api/iam/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+)
+
+// workloadIdentityUserRole is the role allowing the identities of a workload identity pool to impersonate a service account
+const workloadIdentityUserRole = "roles/iam.workloadIdentityUser"
+
+// WorkloadIdentityBinding allows an identity of a workload identity pool to impersonate a ServiceAccount
+// +kops:fitask
+type WorkloadIdentityBinding struct {
+	Name      *string
+	Lifecycle fi.Lifecycle
+
+	ServiceAccount *ServiceAccount
+	Pool           *WorkloadIdentityPool
+	// Subject is the subject of the identity, as mapped by the provider of the pool
+	Subject *string
+}
+
+var _ fi.CompareWithID = (*WorkloadIdentityBinding)(nil)
+
+func (e *WorkloadIdentityBinding) CompareWithID() *string {
+	return e.Name
+}
+
+// member returns the principal of the identity in IAM policies
+func (e *WorkloadIdentityBinding) member() string {
+	return "principal://iam.googleapis.com/" + e.Pool.ResourceName() + "/subject/" + fi.ValueOf(e.Subject)
+}
+
+func (e *WorkloadIdentityBinding) serviceAccountFQN() (string, error) {
+	email := fi.ValueOf(e.ServiceAccount.Email)
+	_, projectID, err := gce.SplitServiceAccountEmail(email)
+	if err != nil {
+		return "", err
+	}
+	return "projects/" + projectID + "/serviceAccounts/" + email, nil
+}
+
+func (e *WorkloadIdentityBinding) Find(c *fi.CloudupContext) (*WorkloadIdentityBinding, error) {
+	ctx := c.Context()
+	cloud := c.T.Cloud.(gce.GCECloud)
+
+	fqn, err := e.serviceAccountFQN()
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(2).Infof("Checking IAM for ServiceAccount %q", fqn)
+	policy, err := cloud.IAM().ServiceAccounts().GetIamPolicy(ctx, fqn)
+	if err != nil {
+		if gce.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error checking IAM for ServiceAccount %q: %w", fqn, err)
+	}
+
+	changed := patchIAMPolicy(policy, e.member(), workloadIdentityUserRole)
+	if changed {
+		return nil, nil
+	}
+
+	actual := &WorkloadIdentityBinding{}
+	actual.ServiceAccount = e.ServiceAccount
+	actual.Pool = e.Pool
+	actual.Subject = e.Subject
+
+	// Ignore "system" fields
+	actual.Name = e.Name
+	actual.Lifecycle = e.Lifecycle
+
+	return actual, nil
+}
+
+func (e *WorkloadIdentityBinding) Run(c *fi.CloudupContext) error {
+	return fi.CloudupDefaultDeltaRunMethod(e, c)
+}
+
+func (_ *WorkloadIdentityBinding) CheckChanges(a, e, changes *WorkloadIdentityBinding) error {
+	if e.ServiceAccount == nil {
+		return fi.RequiredField("ServiceAccount")
+	}
+	if fi.ValueOf(e.ServiceAccount.Email) == "" {
+		return fi.RequiredField("ServiceAccount.Email")
+	}
+	if e.Pool == nil {
+		return fi.RequiredField("Pool")
+	}
+	if fi.ValueOf(e.Subject) == "" {
+		return fi.RequiredField("Subject")
+	}
+	return nil
+}
+
+func (_ *WorkloadIdentityBinding) RenderGCE(c *fi.CloudupContext, t *gce.GCEAPITarget, a, e, changes *WorkloadIdentityBinding) error {
+	ctx := c.Context()
+
+	fqn, err := e.serviceAccountFQN()
+	if err != nil {
+		return err
+	}
+
+	// Avoid concurrent operations
+	localMutex := gce.InProcessMutex.Get("iam/" + fqn)
+	localMutex.Lock()
+	defer localMutex.Unlock()
+
+	policy, err := t.Cloud.IAM().ServiceAccounts().GetIamPolicy(ctx, fqn)
+	if err != nil {
+		return fmt.Errorf("error getting IAM policy for ServiceAccount %q: %w", fqn, err)
+	}
+
+	changed := patchIAMPolicy(policy, e.member(), workloadIdentityUserRole)
+
+	if !changed {
+		klog.Warningf("did not need to change policy (concurrent change?)")
+		return nil
+	}
+
+	klog.V(2).Infof("updating IAM for ServiceAccount %q", fqn)
+	if _, err := t.Cloud.IAM().ServiceAccounts().SetIamPolicy(ctx, fqn, policy); err != nil {
+		return fmt.Errorf("error updating IAM for ServiceAccount %q: %w", fqn, err)
+	}
+
+	return nil
+}
+
+// terraformServiceAccountIAMMember is the model for a terraform google_service_account_iam_member rule
+type terraformServiceAccountIAMMember struct {
+	ServiceAccountID *terraformWriter.Literal `cty:"service_account_id"`
+	Role             string                   `cty:"role"`
+	Member           *terraformWriter.Literal `cty:"member"`
+}
+
+func (_ *WorkloadIdentityBinding) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *WorkloadIdentityBinding) error {
+	tf := &terraformServiceAccountIAMMember{
+		ServiceAccountID: terraformWriter.LiteralFunctionExpression(
+			"format",
+			terraformWriter.LiteralFromStringValue("projects/-/serviceAccounts/%s"),
+			e.ServiceAccount.TerraformLink_Email(),
+		),
+		Role: workloadIdentityUserRole,
+		Member: terraformWriter.LiteralFunctionExpression(
+			"format",
+			terraformWriter.LiteralFromStringValue("principal://iam.googleapis.com/%s/subject/%s"),
+			e.Pool.TerraformLink(),
+			terraformWriter.LiteralFromStringValue(fi.ValueOf(e.Subject)),
+		),
+	}
+
+	return t.RenderResource("google_service_account_iam_member", *e.Name, tf)
+}
+
+func patchIAMPolicy(policy *iam.Policy, wantMember string, wantRole string) bool {
+	for _, binding := range policy.Bindings {
+		if binding.Condition != nil {
+			continue
+		}
+		if binding.Role != wantRole {
+			continue
+		}
+		for _, member := range binding.Members {
+			if member == wantMember {
+				return false
+			}
+		}
+
+		binding.Members = append(binding.Members, wantMember)
+		return true
+	}
+
+	policy.Bindings = append(policy.Bindings, &iam.Binding{
+		Members: []string{wantMember},
+		Role:    wantRole,
+	})
+	return true
+}
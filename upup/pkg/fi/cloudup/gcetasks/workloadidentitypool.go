@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcetasks
+
+import (
+	"fmt"
+
+	"google.golang.org/api/iam/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+)
+
+// workloadIdentityPoolStateDeleted is the state of soft-deleted pools and providers,
+// which can be restored for 30 days and cannot be recreated with the same ID until then.
+const workloadIdentityPoolStateDeleted = "DELETED"
+
+// WorkloadIdentityPool represents a GCP workload identity pool, whose identities can impersonate service accounts
+// +kops:fitask
+type WorkloadIdentityPool struct {
+	Name      *string
+	Lifecycle fi.Lifecycle
+
+	// ProjectNumber is the number of the project, which is part of the name of the pool and of the principals of its identities
+	ProjectNumber *string
+	Description   *string
+}
+
+var _ fi.CompareWithID = (*WorkloadIdentityPool)(nil)
+
+func (e *WorkloadIdentityPool) CompareWithID() *string {
+	return e.Name
+}
+
+// ResourceName returns the full resource name of the pool
+func (e *WorkloadIdentityPool) ResourceName() string {
+	return "projects/" + fi.ValueOf(e.ProjectNumber) + "/locations/global/workloadIdentityPools/" + fi.ValueOf(e.Name)
+}
+
+func (e *WorkloadIdentityPool) Find(c *fi.CloudupContext) (*WorkloadIdentityPool, error) {
+	ctx := c.Context()
+	cloud := c.T.Cloud.(gce.GCECloud)
+
+	pool, err := cloud.IAM().WorkloadIdentityPools().Get(ctx, e.ResourceName())
+	if err != nil {
+		if gce.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting WorkloadIdentityPool %q: %w", e.ResourceName(), err)
+	}
+	if pool.State == workloadIdentityPoolStateDeleted {
+		return nil, nil
+	}
+
+	actual := &WorkloadIdentityPool{}
+	actual.Description = &pool.Description
+
+	// Ignore "system" fields
+	actual.Name = e.Name
+	actual.Lifecycle = e.Lifecycle
+	actual.ProjectNumber = e.ProjectNumber
+
+	return actual, nil
+}
+
+func (e *WorkloadIdentityPool) Run(c *fi.CloudupContext) error {
+	return fi.CloudupDefaultDeltaRunMethod(e, c)
+}
+
+func (_ *WorkloadIdentityPool) CheckChanges(a, e, changes *WorkloadIdentityPool) error {
+	if fi.ValueOf(e.Name) == "" {
+		return fi.RequiredField("Name")
+	}
+	if fi.ValueOf(e.ProjectNumber) == "" {
+		return fi.RequiredField("ProjectNumber")
+	}
+	return nil
+}
+
+func (_ *WorkloadIdentityPool) RenderGCE(c *fi.CloudupContext, t *gce.GCEAPITarget, a, e, changes *WorkloadIdentityPool) error {
+	ctx := c.Context()
+	client := t.Cloud.IAM().WorkloadIdentityPools()
+	name := e.ResourceName()
+
+	if a != nil {
+		if changes.Description != nil {
+			return fmt.Errorf("cannot apply changes to WorkloadIdentityPool %q: %v", name, changes)
+		}
+		return nil
+	}
+
+	existing, err := client.Get(ctx, name)
+	if err != nil && !gce.IsNotFound(err) {
+		return fmt.Errorf("error getting WorkloadIdentityPool %q: %w", name, err)
+	}
+	if existing != nil && existing.State == workloadIdentityPoolStateDeleted {
+		// The pool was deleted along with a previous cluster of the same name
+		klog.V(2).Infof("Restoring deleted WorkloadIdentityPool %q", name)
+		if err := client.Undelete(ctx, name); err != nil {
+			return fmt.Errorf("error restoring WorkloadIdentityPool %q: %w", name, err)
+		}
+		return nil
+	}
+
+	klog.V(2).Infof("Creating WorkloadIdentityPool %q", name)
+	pool := &iam.WorkloadIdentityPool{
+		Description: fi.ValueOf(e.Description),
+	}
+	parent := "projects/" + fi.ValueOf(e.ProjectNumber) + "/locations/global"
+	if err := client.Create(ctx, parent, fi.ValueOf(e.Name), pool); err != nil {
+		return fmt.Errorf("error creating WorkloadIdentityPool %q: %w", name, err)
+	}
+	return nil
+}
+
+type terraformWorkloadIdentityPool struct {
+	Project                *string `cty:"project"`
+	WorkloadIdentityPoolID *string `cty:"workload_identity_pool_id"`
+	Description            *string `cty:"description"`
+}
+
+func (_ *WorkloadIdentityPool) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *WorkloadIdentityPool) error {
+	tf := &terraformWorkloadIdentityPool{
+		Project:                e.ProjectNumber,
+		WorkloadIdentityPoolID: e.Name,
+		Description:            e.Description,
+	}
+	return t.RenderResource("google_iam_workload_identity_pool", *e.Name, tf)
+}
+
+// TerraformLink returns the full resource name of the pool
+func (e *WorkloadIdentityPool) TerraformLink() *terraformWriter.Literal {
+	return terraformWriter.LiteralProperty("google_iam_workload_identity_pool", *e.Name, "name")
+}
+
+// TerraformLink_ID returns the ID of the pool
+func (e *WorkloadIdentityPool) TerraformLink_ID() *terraformWriter.Literal {
+	return terraformWriter.LiteralProperty("google_iam_workload_identity_pool", *e.Name, "workload_identity_pool_id")
+}
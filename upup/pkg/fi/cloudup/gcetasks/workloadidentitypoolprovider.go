@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcetasks
+
+import (
+	"fmt"
+
+	"google.golang.org/api/iam/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+)
+
+// WorkloadIdentityPoolProvider represents an OIDC provider of a workload identity pool
+// +kops:fitask
+type WorkloadIdentityPoolProvider struct {
+	Name      *string
+	Lifecycle fi.Lifecycle
+
+	Pool *WorkloadIdentityPool
+	// IssuerURI is the URL of the OIDC issuer whose tokens are exchanged
+	IssuerURI *string
+	// AttributeMapping maps the claims of the tokens to the attributes of the identities
+	AttributeMapping map[string]string
+}
+
+var _ fi.CompareWithID = (*WorkloadIdentityPoolProvider)(nil)
+
+func (e *WorkloadIdentityPoolProvider) CompareWithID() *string {
+	return e.Name
+}
+
+// ResourceName returns the full resource name of the provider
+func (e *WorkloadIdentityPoolProvider) ResourceName() string {
+	return e.Pool.ResourceName() + "/providers/" + fi.ValueOf(e.Name)
+}
+
+func (e *WorkloadIdentityPoolProvider) Find(c *fi.CloudupContext) (*WorkloadIdentityPoolProvider, error) {
+	ctx := c.Context()
+	cloud := c.T.Cloud.(gce.GCECloud)
+
+	provider, err := cloud.IAM().WorkloadIdentityPools().GetProvider(ctx, e.ResourceName())
+	if err != nil {
+		if gce.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting WorkloadIdentityPoolProvider %q: %w", e.ResourceName(), err)
+	}
+	if provider.State == workloadIdentityPoolStateDeleted {
+		return nil, nil
+	}
+
+	actual := &WorkloadIdentityPoolProvider{}
+	if provider.Oidc != nil {
+		actual.IssuerURI = &provider.Oidc.IssuerUri
+	}
+	actual.AttributeMapping = provider.AttributeMapping
+
+	// Ignore "system" fields
+	actual.Name = e.Name
+	actual.Lifecycle = e.Lifecycle
+	actual.Pool = e.Pool
+
+	return actual, nil
+}
+
+func (e *WorkloadIdentityPoolProvider) Run(c *fi.CloudupContext) error {
+	return fi.CloudupDefaultDeltaRunMethod(e, c)
+}
+
+func (_ *WorkloadIdentityPoolProvider) CheckChanges(a, e, changes *WorkloadIdentityPoolProvider) error {
+	if fi.ValueOf(e.Name) == "" {
+		return fi.RequiredField("Name")
+	}
+	if e.Pool == nil {
+		return fi.RequiredField("Pool")
+	}
+	if fi.ValueOf(e.IssuerURI) == "" {
+		return fi.RequiredField("IssuerURI")
+	}
+	return nil
+}
+
+func (_ *WorkloadIdentityPoolProvider) RenderGCE(c *fi.CloudupContext, t *gce.GCEAPITarget, a, e, changes *WorkloadIdentityPoolProvider) error {
+	ctx := c.Context()
+	client := t.Cloud.IAM().WorkloadIdentityPools()
+	name := e.ResourceName()
+
+	provider := &iam.WorkloadIdentityPoolProvider{
+		Oidc: &iam.Oidc{
+			IssuerUri: fi.ValueOf(e.IssuerURI),
+		},
+		AttributeMapping: e.AttributeMapping,
+	}
+
+	if a == nil {
+		existing, err := client.GetProvider(ctx, name)
+		if err != nil && !gce.IsNotFound(err) {
+			return fmt.Errorf("error getting WorkloadIdentityPoolProvider %q: %w", name, err)
+		}
+		if existing == nil {
+			klog.V(2).Infof("Creating WorkloadIdentityPoolProvider %q", name)
+			if err := client.CreateProvider(ctx, e.Pool.ResourceName(), fi.ValueOf(e.Name), provider); err != nil {
+				return fmt.Errorf("error creating WorkloadIdentityPoolProvider %q: %w", name, err)
+			}
+			return nil
+		}
+
+		// The provider was deleted along with a previous cluster of the same name, and may have a stale configuration
+		klog.V(2).Infof("Restoring deleted WorkloadIdentityPoolProvider %q", name)
+		if err := client.UndeleteProvider(ctx, name); err != nil {
+			return fmt.Errorf("error restoring WorkloadIdentityPoolProvider %q: %w", name, err)
+		}
+	}
+
+	klog.V(2).Infof("Updating WorkloadIdentityPoolProvider %q", name)
+	if err := client.UpdateProvider(ctx, name, provider, "oidc,attributeMapping"); err != nil {
+		return fmt.Errorf("error updating WorkloadIdentityPoolProvider %q: %w", name, err)
+	}
+	return nil
+}
+
+type terraformWorkloadIdentityPoolProviderOIDC struct {
+	IssuerURI *string `cty:"issuer_uri"`
+}
+
+type terraformWorkloadIdentityPoolProvider struct {
+	Project                        *string                                    `cty:"project"`
+	WorkloadIdentityPoolID         *terraformWriter.Literal                   `cty:"workload_identity_pool_id"`
+	WorkloadIdentityPoolProviderID *string                                    `cty:"workload_identity_pool_provider_id"`
+	AttributeMapping               map[string]string                          `cty:"attribute_mapping"`
+	OIDC                           *terraformWorkloadIdentityPoolProviderOIDC `cty:"oidc"`
+}
+
+func (_ *WorkloadIdentityPoolProvider) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *WorkloadIdentityPoolProvider) error {
+	tf := &terraformWorkloadIdentityPoolProvider{
+		Project:                        e.Pool.ProjectNumber,
+		WorkloadIdentityPoolID:         e.Pool.TerraformLink_ID(),
+		WorkloadIdentityPoolProviderID: e.Name,
+		AttributeMapping:               e.AttributeMapping,
+		OIDC: &terraformWorkloadIdentityPoolProviderOIDC{
+			IssuerURI: e.IssuerURI,
+		},
+	}
+	return t.RenderResource("google_iam_workload_identity_pool_provider", fi.ValueOf(e.Pool.Name)+"-"+fi.ValueOf(e.Name), tf)
+}
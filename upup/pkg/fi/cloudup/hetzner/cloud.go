@@ -43,6 +43,7 @@ const (
 	TagKubernetesInstanceNeedsUpdate = "kops.k8s.io/needs-update"
 	TagKubernetesVolumeRole          = "kops.k8s.io/volume-role"
 	TagKubernetesNodeLabelPrefix     = "node-label.kops.k8s.io."
+	TagKubernetesNodeKey             = "kops.k8s.io/node-key"
 	// TagClusterAutoscalerNodeGroup is the label Cluster Autoscaler uses to determine membership of a server.
 	TagClusterAutoscalerNodeGroup = "hcloud/node-group"
 )
@@ -349,6 +350,10 @@ func findServerGroups(c *hetznerCloudImplementation, clusterName string) (map[st
 
 	serverGroups := make(map[string][]*hcloud.Server)
 	for _, server := range servers {
+		// The NAT gateway server is not part of any instance group
+		if server.Labels[TagKubernetesInstanceRole] == InstanceRoleNATGateway {
+			continue
+		}
 		instanceGroupNameLabel, ok := server.Labels[TagKubernetesInstanceGroup]
 		if !ok {
 			klog.Warningf("failed to find instance group name for server %s(%d)", server.Name, server.ID)
@@ -428,15 +433,15 @@ func (c *hetznerCloudImplementation) FindVPCInfo(id string) (*fi.VPCInfo, error)
 }
 
 // FindClusterStatus was used before etcd-manager to check the etcd cluster status and prevent unsupported changes.
-func (c *hetznerCloudImplementation) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+func (c *hetznerCloudImplementation) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
 	return nil, nil
 }
 
-func (c *hetznerCloudImplementation) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+func (c *hetznerCloudImplementation) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
 	lbName := "api." + cluster.Name
 
 	client := c.LoadBalancerClient()
-	lb, _, err := client.GetByName(context.TODO(), lbName)
+	lb, _, err := client.GetByName(ctx, lbName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get info for load balancer %q: %w", lbName, err)
 	}
@@ -20,22 +20,34 @@ limitations under the License.
 package hetznermetadata
 
 import (
+	"crypto"
 	"fmt"
 	"strconv"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud/metadata"
 	"k8s.io/kops/pkg/bootstrap"
+	"k8s.io/kops/pkg/bootstrap/pkibootstrap"
 )
 
 const HetznerAuthenticationTokenPrefix = "x-hetzner-id " //nolint:gosec // This is an authentication scheme prefix, not a credential.
 
+// HetznerSignedAuthenticationTokenPrefix is the prefix of tokens signed with the machine key of the server.
+const HetznerSignedAuthenticationTokenPrefix = "x-hetzner-signed " //nolint:gosec // This is an authentication scheme prefix, not a credential.
+
 type hetznerAuthenticator struct {
+	signer crypto.Signer
 }
 
 var _ bootstrap.Authenticator = (*hetznerAuthenticator)(nil)
 
 func NewHetznerAuthenticator() (bootstrap.Authenticator, error) {
-	return &hetznerAuthenticator{}, nil
+	// Hetzner does not provide signed instance identity documents, so we sign with a key of our own,
+	// which kops-controller pins to the server the first time it bootstraps.
+	signer, err := pkibootstrap.LoadOrCreateMachineKey(pkibootstrap.MachineKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	return &hetznerAuthenticator{signer: signer}, nil
 }
 
 func (h *hetznerAuthenticator) CreateToken(body []byte) (string, error) {
@@ -43,5 +55,5 @@ func (h *hetznerAuthenticator) CreateToken(body []byte) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("failed to retrieve server ID: %w", err)
 	}
-	return HetznerAuthenticationTokenPrefix + strconv.FormatInt(serverID, 10), nil
+	return pkibootstrap.CreateSignedToken(HetznerSignedAuthenticationTokenPrefix, strconv.FormatInt(serverID, 10), h.signer, body)
 }
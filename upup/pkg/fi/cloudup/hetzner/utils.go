@@ -17,11 +17,18 @@ limitations under the License.
 package hetzner
 
 import (
+	"encoding/binary"
 	"fmt"
+	"net"
 
 	"k8s.io/kops/pkg/apis/kops"
 )
 
+const (
+	// InstanceRoleNATGateway is the instance role label of the NAT gateway server of clusters with private networking.
+	InstanceRoleNATGateway = "nat-gateway"
+)
+
 // FindRegion determines the region from the zones specified in the cluster
 func FindRegion(cluster *kops.Cluster) (string, error) {
 	var region string
@@ -48,3 +55,55 @@ func FindRegion(cluster *kops.Cluster) (string, error) {
 
 	return region, nil
 }
+
+// UsesPrivateNetworking returns true if the servers of the cluster have no public IPs,
+// and reach the internet through the NAT gateway server.
+func UsesPrivateNetworking(cluster *kops.Cluster) bool {
+	for _, subnet := range cluster.Spec.Networking.Subnets {
+		if subnet.Type == kops.SubnetTypePrivate {
+			return true
+		}
+	}
+	return false
+}
+
+// NetworkGatewayIP returns the gateway of the network with the IP range, which Hetzner Cloud reserves as its first address.
+func NetworkGatewayIP(ipRange string) (net.IP, error) {
+	ipNet, err := parseIPv4Range(ipRange)
+	if err != nil {
+		return nil, err
+	}
+	return offsetIP(ipNet.IP, 1), nil
+}
+
+// NATGatewayIP returns the address of the NAT gateway server in the network with the IP range.
+// It is the last usable address, so that it doesn't conflict with the addresses Hetzner Cloud assigns to other servers.
+func NATGatewayIP(ipRange string) (net.IP, error) {
+	ipNet, err := parseIPv4Range(ipRange)
+	if err != nil {
+		return nil, err
+	}
+	ones, bits := ipNet.Mask.Size()
+	return offsetIP(ipNet.IP, (1<<(bits-ones))-2), nil
+}
+
+func parseIPv4Range(ipRange string) (*net.IPNet, error) {
+	_, ipNet, err := net.ParseCIDR(ipRange)
+	if err != nil {
+		return nil, fmt.Errorf("parsing network IP range %q: %w", ipRange, err)
+	}
+	if ipNet.IP.To4() == nil {
+		return nil, fmt.Errorf("network IP range %q is not an IPv4 range", ipRange)
+	}
+	if ones, _ := ipNet.Mask.Size(); ones > 30 {
+		return nil, fmt.Errorf("network IP range %q is too small", ipRange)
+	}
+	return ipNet, nil
+}
+
+func offsetIP(ip net.IP, offset int) net.IP {
+	n := binary.BigEndian.Uint32(ip.To4()) + uint32(offset)
+	result := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(result, n)
+	return result
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetzner
+
+import (
+	"testing"
+)
+
+func TestNetworkIPs(t *testing.T) {
+	grid := []struct {
+		ipRange           string
+		expectedGatewayIP string
+		expectedNATIP     string
+		expectError       bool
+	}{
+		{
+			ipRange:           "10.0.0.0/16",
+			expectedGatewayIP: "10.0.0.1",
+			expectedNATIP:     "10.0.255.254",
+		},
+		{
+			ipRange:           "172.20.0.0/24",
+			expectedGatewayIP: "172.20.0.1",
+			expectedNATIP:     "172.20.0.254",
+		},
+		{
+			ipRange:           "10.1.2.3/8",
+			expectedGatewayIP: "10.0.0.1",
+			expectedNATIP:     "10.255.255.254",
+		},
+		{
+			ipRange:     "10.0.0.0/31",
+			expectError: true,
+		},
+		{
+			ipRange:     "fd00::/64",
+			expectError: true,
+		},
+		{
+			ipRange:     "invalid",
+			expectError: true,
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.ipRange, func(t *testing.T) {
+			gatewayIP, err := NetworkGatewayIP(g.ipRange)
+			if g.expectError {
+				if err == nil {
+					t.Errorf("expected error, got gateway IP %v", gatewayIP)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gatewayIP.String() != g.expectedGatewayIP {
+				t.Errorf("expected gateway IP %s, got %s", g.expectedGatewayIP, gatewayIP)
+			}
+
+			natIP, err := NATGatewayIP(g.ipRange)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if natIP.String() != g.expectedNATIP {
+				t.Errorf("expected NAT gateway IP %s, got %s", g.expectedNATIP, natIP)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package hetzner
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
@@ -28,11 +29,17 @@ import (
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	version "k8s.io/kops"
 	"k8s.io/kops/pkg/bootstrap"
+	"k8s.io/kops/pkg/bootstrap/pkibootstrap"
 	"k8s.io/kops/pkg/wellknownports"
 	"k8s.io/kops/upup/pkg/fi/cloudup/hetzner/hetznermetadata"
 )
 
 type HetznerVerifierOptions struct {
+	// ClusterName is the name of the cluster, used to check that servers belong to the cluster.
+	ClusterName string `json:"clusterName,omitempty"`
+	// AllowUnsignedTokens accepts the unsigned tokens of nodes from before kOps 1.37, for servers without a pinned key.
+	// Deprecated: set from the AllowUnsignedBootstrapTokens feature flag, only while such nodes still bootstrap.
+	AllowUnsignedTokens bool `json:"allowUnsignedTokens,omitempty"`
 }
 
 type hetznerVerifier struct {
@@ -43,6 +50,10 @@ type hetznerVerifier struct {
 var _ bootstrap.Verifier = (*hetznerVerifier)(nil)
 
 func NewHetznerVerifier(opt *HetznerVerifierOptions) (bootstrap.Verifier, error) {
+	if opt == nil || opt.ClusterName == "" {
+		return nil, fmt.Errorf("determining cluster name")
+	}
+
 	hcloudToken := os.Getenv("HCLOUD_TOKEN")
 	if hcloudToken == "" {
 		return nil, fmt.Errorf("%s is required", "HCLOUD_TOKEN")
@@ -61,33 +72,44 @@ func NewHetznerVerifier(opt *HetznerVerifierOptions) (bootstrap.Verifier, error)
 }
 
 func (h hetznerVerifier) VerifyToken(ctx context.Context, rawRequest *http.Request, token string, body []byte) (*bootstrap.VerifyResult, error) {
-	if !strings.HasPrefix(token, hetznermetadata.HetznerAuthenticationTokenPrefix) {
+	var serverIDString, keyFingerprint string
+	switch {
+	case strings.HasPrefix(token, hetznermetadata.HetznerSignedAuthenticationTokenPrefix):
+		tokenData, fingerprint, err := pkibootstrap.VerifySignedToken(hetznermetadata.HetznerSignedAuthenticationTokenPrefix, token, body, 0)
+		if err != nil {
+			return nil, err
+		}
+		serverIDString = tokenData.Instance
+		keyFingerprint = fingerprint
+	case strings.HasPrefix(token, hetznermetadata.HetznerAuthenticationTokenPrefix):
+		if !h.opt.AllowUnsignedTokens {
+			return nil, pkibootstrap.ErrUnsignedToken
+		}
+		serverIDString = strings.TrimPrefix(token, hetznermetadata.HetznerAuthenticationTokenPrefix)
+	default:
 		return nil, bootstrap.ErrNotThisVerifier
 	}
-	token = strings.TrimPrefix(token, hetznermetadata.HetznerAuthenticationTokenPrefix)
 
-	serverID, err := strconv.ParseInt(token, 10, 64)
+	serverID, err := strconv.ParseInt(serverIDString, 10, 64)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert server ID %q to int: %w", token, err)
+		return nil, fmt.Errorf("failed to convert server ID %q to int: %w", serverIDString, err)
 	}
 	server, _, err := h.client.Server.GetByID(ctx, serverID)
 	if err != nil || server == nil {
-		return nil, fmt.Errorf("failed to get info for server %q: %w", token, err)
+		return nil, fmt.Errorf("failed to get info for server %q: %w", serverIDString, err)
 	}
 
-	var addrs []string
-	var challengeEndpoints []string
-	if server.PublicNet.IPv4.IP != nil {
-		// Don't challenge over the public network
-		addrs = append(addrs, server.PublicNet.IPv4.IP.String())
+	// Hetzner does not provide signed instance identity documents, so we only trust servers of this cluster,
+	// and the node challenge then verifies that the caller controls the server.
+	if err := verifyServer(server, h.opt.ClusterName); err != nil {
+		return nil, err
 	}
-	for _, network := range server.PrivateNet {
-		if network.IP != nil {
-			addrs = append(addrs, network.IP.String())
-			challengeEndpoints = append(challengeEndpoints, net.JoinHostPort(network.IP.String(), strconv.Itoa(wellknownports.NodeupChallenge)))
-		}
+	// Once a server has bootstrapped with a key, only requests signed with that key are accepted for it.
+	if err := pkibootstrap.VerifyPinnedKey(serverIDString, server.Labels[TagKubernetesNodeKey], keyFingerprint); err != nil {
+		return nil, err
 	}
 
+	addrs, challengeEndpoints := serverAddresses(server)
 	if len(challengeEndpoints) == 0 {
 		return nil, fmt.Errorf("cannot determine challenge endpoint for server %d", serverID)
 	}
@@ -104,5 +126,44 @@ func (h hetznerVerifier) VerifyToken(ctx context.Context, rawRequest *http.Reque
 		}
 	}
 
+	if keyFingerprint != "" && server.Labels[TagKubernetesNodeKey] == "" {
+		result.PinKey = func(ctx context.Context) error {
+			labels := maps.Clone(server.Labels)
+			labels[TagKubernetesNodeKey] = keyFingerprint
+			_, _, err := h.client.Server.Update(ctx, server, hcloud.ServerUpdateOpts{Labels: labels})
+			return err
+		}
+	}
+
 	return result, nil
 }
+
+// verifyServer checks that the server is a running server of the cluster.
+func verifyServer(server *hcloud.Server, clusterName string) error {
+	if server.Labels[TagKubernetesClusterName] != clusterName {
+		return fmt.Errorf("server %d is not part of cluster %q", server.ID, clusterName)
+	}
+	if server.Labels[TagKubernetesInstanceRole] == InstanceRoleNATGateway {
+		return fmt.Errorf("server %d is the NAT gateway of cluster %q", server.ID, clusterName)
+	}
+	if server.Status != hcloud.ServerStatusRunning {
+		return fmt.Errorf("server %d is not running (status %q)", server.ID, server.Status)
+	}
+	return nil
+}
+
+// serverAddresses returns the addresses of the server and the challenge endpoints on its private IPs.
+// Servers of clusters with private networking have no public IPs.
+func serverAddresses(server *hcloud.Server) (addrs []string, challengeEndpoints []string) {
+	if server.PublicNet.IPv4.IP != nil {
+		// Don't challenge over the public network
+		addrs = append(addrs, server.PublicNet.IPv4.IP.String())
+	}
+	for _, network := range server.PrivateNet {
+		if network.IP != nil {
+			addrs = append(addrs, network.IP.String())
+			challengeEndpoints = append(challengeEndpoints, net.JoinHostPort(network.IP.String(), strconv.Itoa(wellknownports.NodeupChallenge)))
+		}
+	}
+	return addrs, challengeEndpoints
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetzner
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func TestVerifyServer(t *testing.T) {
+	grid := []struct {
+		desc        string
+		server      *hcloud.Server
+		expectError bool
+	}{
+		{
+			desc: "running server of the cluster",
+			server: &hcloud.Server{
+				ID:     1,
+				Status: hcloud.ServerStatusRunning,
+				Labels: map[string]string{TagKubernetesClusterName: "example.k8s.local"},
+			},
+		},
+		{
+			desc: "server of another cluster",
+			server: &hcloud.Server{
+				ID:     2,
+				Status: hcloud.ServerStatusRunning,
+				Labels: map[string]string{TagKubernetesClusterName: "other.k8s.local"},
+			},
+			expectError: true,
+		},
+		{
+			desc: "server without cluster label",
+			server: &hcloud.Server{
+				ID:     3,
+				Status: hcloud.ServerStatusRunning,
+			},
+			expectError: true,
+		},
+		{
+			desc: "server that is not running",
+			server: &hcloud.Server{
+				ID:     4,
+				Status: hcloud.ServerStatusOff,
+				Labels: map[string]string{TagKubernetesClusterName: "example.k8s.local"},
+			},
+			expectError: true,
+		},
+		{
+			desc: "NAT gateway of the cluster",
+			server: &hcloud.Server{
+				ID:     5,
+				Status: hcloud.ServerStatusRunning,
+				Labels: map[string]string{
+					TagKubernetesClusterName:  "example.k8s.local",
+					TagKubernetesInstanceRole: InstanceRoleNATGateway,
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			err := verifyServer(g.server, "example.k8s.local")
+			if g.expectError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !g.expectError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestServerAddresses(t *testing.T) {
+	grid := []struct {
+		desc                       string
+		server                     *hcloud.Server
+		expectedAddrs              []string
+		expectedChallengeEndpoints []string
+	}{
+		{
+			desc: "server with public and private IPs",
+			server: &hcloud.Server{
+				PublicNet: hcloud.ServerPublicNet{
+					IPv4: hcloud.ServerPublicNetIPv4{IP: net.ParseIP("192.0.2.10")},
+				},
+				PrivateNet: []hcloud.ServerPrivateNet{
+					{IP: net.ParseIP("10.0.0.2")},
+				},
+			},
+			expectedAddrs:              []string{"192.0.2.10", "10.0.0.2"},
+			expectedChallengeEndpoints: []string{"10.0.0.2:3987"},
+		},
+		{
+			desc: "server without public IPs",
+			server: &hcloud.Server{
+				PrivateNet: []hcloud.ServerPrivateNet{
+					{IP: net.ParseIP("10.0.0.3")},
+				},
+			},
+			expectedAddrs:              []string{"10.0.0.3"},
+			expectedChallengeEndpoints: []string{"10.0.0.3:3987"},
+		},
+		{
+			desc: "server without private IPs",
+			server: &hcloud.Server{
+				PublicNet: hcloud.ServerPublicNet{
+					IPv4: hcloud.ServerPublicNetIPv4{IP: net.ParseIP("192.0.2.11")},
+				},
+			},
+			expectedAddrs: []string{"192.0.2.11"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			addrs, challengeEndpoints := serverAddresses(g.server)
+			if !reflect.DeepEqual(addrs, g.expectedAddrs) {
+				t.Errorf("expected addresses %v, got %v", g.expectedAddrs, addrs)
+			}
+			if !reflect.DeepEqual(challengeEndpoints, g.expectedChallengeEndpoints) {
+				t.Errorf("expected challenge endpoints %v, got %v", g.expectedChallengeEndpoints, challengeEndpoints)
+			}
+		})
+	}
+}
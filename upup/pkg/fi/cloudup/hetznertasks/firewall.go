@@ -50,7 +50,7 @@ func (v *Firewall) Find(c *fi.CloudupContext) (*Firewall, error) {
 	client := cloud.FirewallClient()
 
 	// TODO(hakman): Find using label selector
-	firewalls, err := client.All(context.TODO())
+	firewalls, err := client.All(c.Context())
 	if err != nil {
 		return nil, err
 	}
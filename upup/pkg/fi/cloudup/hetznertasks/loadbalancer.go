@@ -73,7 +73,7 @@ func (v *LoadBalancer) FindAddresses(c *fi.CloudupContext) ([]string, error) {
 		return nil, nil
 	}
 
-	ctx := context.TODO()
+	ctx := c.Context()
 	cloud := c.T.Cloud.(hetzner.HetznerCloud)
 	client := cloud.LoadBalancerClient()
 
@@ -104,7 +104,7 @@ func (v *LoadBalancer) FindAddresses(c *fi.CloudupContext) ([]string, error) {
 }
 
 func (v *LoadBalancer) Find(c *fi.CloudupContext) (*LoadBalancer, error) {
-	ctx := context.TODO()
+	ctx := c.Context()
 	cloud := c.T.Cloud.(hetzner.HetznerCloud)
 	client := cloud.LoadBalancerClient()
 
@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetznertasks
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/hetzner"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+)
+
+// NATGateway is a server with a public IP, which masquerades the traffic of the servers without public IPs in the network.
+// +kops:fitask
+type NATGateway struct {
+	Name      *string
+	Lifecycle fi.Lifecycle
+	SSHKeys   []*SSHKey
+	Network   *Network
+
+	Location string
+	Size     string
+	Image    string
+	// IP is the address of the server in the network, which is the gateway of the default route of the network
+	IP string
+
+	UserData fi.Resource
+
+	Labels map[string]string
+}
+
+func (v *NATGateway) Find(c *fi.CloudupContext) (*NATGateway, error) {
+	cloud := c.T.Cloud.(hetzner.HetznerCloud)
+	client := cloud.ServerClient()
+
+	server, _, err := client.GetByName(c.Context(), fi.ValueOf(v.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find NAT gateway %q: %w", fi.ValueOf(v.Name), err)
+	}
+	if server == nil {
+		return nil, nil
+	}
+
+	matches := &NATGateway{
+		Name:      v.Name,
+		Lifecycle: v.Lifecycle,
+		SSHKeys:   v.SSHKeys,
+		Network:   v.Network,
+		Labels:    server.Labels,
+		// The user-data cannot be read back
+		UserData: v.UserData,
+	}
+	if server.Datacenter != nil && server.Datacenter.Location != nil {
+		matches.Location = server.Datacenter.Location.Name
+	}
+	if server.ServerType != nil {
+		matches.Size = server.ServerType.Name
+	}
+	if server.Image != nil {
+		matches.Image = server.Image.Name
+	} else {
+		// The image was deleted after the server was created
+		matches.Image = v.Image
+	}
+	for _, privateNet := range server.PrivateNet {
+		if privateNet.Network != nil && v.Network != nil && strconv.FormatInt(privateNet.Network.ID, 10) == fi.ValueOf(v.Network.ID) {
+			matches.IP = privateNet.IP.String()
+		}
+	}
+
+	return matches, nil
+}
+
+func (v *NATGateway) Run(c *fi.CloudupContext) error {
+	return fi.CloudupDefaultDeltaRunMethod(v, c)
+}
+
+func (_ *NATGateway) CheckChanges(a, e, changes *NATGateway) error {
+	if a != nil {
+		if changes.Location != "" {
+			return fi.CannotChangeField("Location")
+		}
+		if changes.Size != "" {
+			return fi.CannotChangeField("Size")
+		}
+		if changes.Image != "" {
+			return fi.CannotChangeField("Image")
+		}
+		if changes.IP != "" && a.IP != "" {
+			return fi.CannotChangeField("IP")
+		}
+	} else {
+		if e.Name == nil {
+			return fi.RequiredField("Name")
+		}
+		if e.Location == "" {
+			return fi.RequiredField("Location")
+		}
+		if e.Size == "" {
+			return fi.RequiredField("Size")
+		}
+		if e.Image == "" {
+			return fi.RequiredField("Image")
+		}
+		if e.IP == "" {
+			return fi.RequiredField("IP")
+		}
+		if e.UserData == nil {
+			return fi.RequiredField("UserData")
+		}
+	}
+	return nil
+}
+
+func (_ *NATGateway) RenderHetzner(c *fi.CloudupContext, t *hetzner.HetznerAPITarget, a, e, changes *NATGateway) error {
+	ctx := c.Context()
+	client := t.Cloud.ServerClient()
+
+	if e.Network == nil {
+		return fmt.Errorf("failed to find network for NAT gateway %q", fi.ValueOf(e.Name))
+	}
+	networkID, err := strconv.ParseInt(fi.ValueOf(e.Network.ID), 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to convert network ID %q to int: %w", fi.ValueOf(e.Network.ID), err)
+	}
+	ip := net.ParseIP(e.IP)
+	if ip == nil {
+		return fmt.Errorf("invalid IP %q for NAT gateway %q", e.IP, fi.ValueOf(e.Name))
+	}
+
+	var server *hcloud.Server
+	if a == nil {
+		if len(e.SSHKeys) == 0 {
+			return fmt.Errorf("failed to find ssh keys for NAT gateway %q", fi.ValueOf(e.Name))
+		}
+
+		userData, err := fi.ResourceAsString(e.UserData)
+		if err != nil {
+			return err
+		}
+
+		opts := hcloud.ServerCreateOpts{
+			Name:             fi.ValueOf(e.Name),
+			StartAfterCreate: new(true),
+			Location: &hcloud.Location{
+				Name: e.Location,
+			},
+			ServerType: &hcloud.ServerType{
+				Name: e.Size,
+			},
+			Image: &hcloud.Image{
+				Name: e.Image,
+			},
+			UserData: userData,
+			Labels:   e.Labels,
+			PublicNet: &hcloud.ServerCreatePublicNet{
+				EnableIPv4: true,
+				EnableIPv6: false,
+			},
+		}
+		for _, sshkey := range e.SSHKeys {
+			opts.SSHKeys = append(opts.SSHKeys, &hcloud.SSHKey{ID: fi.ValueOf(sshkey.ID)})
+		}
+
+		result, _, err := client.Create(ctx, opts)
+		if err != nil {
+			return err
+		}
+		actionClient := t.Cloud.ActionClient()
+		if err := actionClient.WaitFor(ctx, append(result.NextActions, result.Action)...); err != nil {
+			return fmt.Errorf("failed to create NAT gateway %q: %w", fi.ValueOf(e.Name), err)
+		}
+		server = result.Server
+	} else {
+		server, _, err = client.GetByName(ctx, fi.ValueOf(e.Name))
+		if err != nil {
+			return err
+		}
+		if server == nil {
+			return fmt.Errorf("failed to find NAT gateway %q", fi.ValueOf(e.Name))
+		}
+
+		if len(changes.Labels) != 0 {
+			_, _, err := client.Update(ctx, server, hcloud.ServerUpdateOpts{
+				Name:   server.Name,
+				Labels: e.Labels,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// The server is attached to the network separately, as the IP cannot be chosen when creating it
+	if a == nil || a.IP == "" {
+		action, _, err := client.AttachToNetwork(ctx, server, hcloud.ServerAttachToNetworkOpts{
+			Network: &hcloud.Network{ID: networkID},
+			IP:      ip,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to attach NAT gateway %q to network: %w", fi.ValueOf(e.Name), err)
+		}
+		actionClient := t.Cloud.ActionClient()
+		if err := actionClient.WaitFor(ctx, action); err != nil {
+			return fmt.Errorf("failed to attach NAT gateway %q to network: %w", fi.ValueOf(e.Name), err)
+		}
+	}
+
+	return nil
+}
+
+func (_ *NATGateway) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *NATGateway) error {
+	tf := &terraformServer{
+		Name:       terraformWriter.LiteralFromStringValue(fi.ValueOf(e.Name)),
+		Location:   new(e.Location),
+		ServerType: new(e.Size),
+		Image:      new(e.Image),
+		Network: []*terraformServerNetwork{
+			{
+				ID: e.Network.TerraformLink(),
+				IP: new(e.IP),
+			},
+		},
+		PublicNet: &terraformServerPublicNet{
+			EnableIPv4: new(true),
+			EnableIPv6: new(false),
+		},
+		Labels: e.Labels,
+	}
+
+	for _, sshkey := range e.SSHKeys {
+		tf.SSHKeys = append(tf.SSHKeys, sshkey.TerraformLink())
+	}
+
+	data, err := fi.ResourceAsBytes(e.UserData)
+	if err != nil {
+		return err
+	}
+	tf.UserData, err = t.AddFileBytes("hcloud_server", fi.ValueOf(e.Name), "user_data", data, true)
+	if err != nil {
+		return err
+	}
+
+	return t.RenderResource("hcloud_server", fi.ValueOf(e.Name), tf)
+}
@@ -17,13 +17,13 @@ limitations under the License.
 package hetznertasks
 
 import (
-	"context"
 	"fmt"
 	"net"
 	"strconv"
 	"time"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/hetzner"
 	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
@@ -39,6 +39,8 @@ type Network struct {
 	Region  string
 	IPRange string
 	Subnets []string
+	// Routes maps the destination IP ranges of the routes of the network to their gateways
+	Routes map[string]string
 
 	Labels map[string]string
 }
@@ -58,7 +60,7 @@ func (v *Network) Find(c *fi.CloudupContext) (*Network, error) {
 		idOrName = fi.ValueOf(v.ID)
 	}
 
-	network, _, err := client.Get(context.TODO(), idOrName)
+	network, _, err := client.Get(c.Context(), idOrName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find network %q: %w", idOrName, err)
 	}
@@ -85,6 +87,14 @@ func (v *Network) Find(c *fi.CloudupContext) (*Network, error) {
 				matches.Subnets = append(matches.Subnets, subnet.IPRange.String())
 			}
 		}
+		for _, route := range network.Routes {
+			if route.Destination != nil && route.Gateway != nil {
+				if matches.Routes == nil {
+					matches.Routes = make(map[string]string)
+				}
+				matches.Routes[route.Destination.String()] = route.Gateway.String()
+			}
+		}
 		// Make sure the ID is set (used by other tasks)
 		v.ID = matches.ID
 	} else {
@@ -116,6 +126,11 @@ func (_ *Network) CheckChanges(a, e, changes *Network) error {
 		if len(changes.Subnets) > 0 && len(a.Subnets) > 0 {
 			return fi.CannotChangeField("Subnets")
 		}
+		for destination, gateway := range a.Routes {
+			if e.Routes[destination] != gateway {
+				return fi.CannotChangeField("Routes")
+			}
+		}
 	} else {
 		if e.Name == nil {
 			return fi.RequiredField("Name")
@@ -133,7 +148,8 @@ func (_ *Network) CheckChanges(a, e, changes *Network) error {
 	return nil
 }
 
-func (_ *Network) RenderHetzner(t *hetzner.HetznerAPITarget, a, e, changes *Network) error {
+func (_ *Network) RenderHetzner(c *fi.CloudupContext, t *hetzner.HetznerAPITarget, a, e, changes *Network) error {
+	ctx := c.Context()
 	client := t.Cloud.NetworkClient()
 
 	var network *hcloud.Network
@@ -147,7 +163,7 @@ func (_ *Network) RenderHetzner(t *hetzner.HetznerAPITarget, a, e, changes *Netw
 			IPRange: ipRange,
 			Labels:  e.Labels,
 		}
-		network, _, err = client.Create(context.TODO(), opts)
+		network, _, err = client.Create(ctx, opts)
 		if err != nil {
 			return err
 		}
@@ -155,14 +171,14 @@ func (_ *Network) RenderHetzner(t *hetzner.HetznerAPITarget, a, e, changes *Netw
 
 	} else {
 		var err error
-		network, _, err = client.Get(context.TODO(), fi.ValueOf(e.Name))
+		network, _, err = client.Get(ctx, fi.ValueOf(e.Name))
 		if err != nil {
 			return err
 		}
 
 		// Update the labels
 		if changes.Name != nil || len(changes.Labels) != 0 {
-			_, _, err := client.Update(context.TODO(), network, hcloud.NetworkUpdateOpts{
+			_, _, err := client.Update(ctx, network, hcloud.NetworkUpdateOpts{
 				Name:   fi.ValueOf(e.Name),
 				Labels: e.Labels,
 			})
@@ -179,7 +195,7 @@ func (_ *Network) RenderHetzner(t *hetzner.HetznerAPITarget, a, e, changes *Netw
 			if err != nil {
 				return err
 			}
-			action, _, err := client.AddSubnet(context.TODO(), network, hcloud.NetworkAddSubnetOpts{
+			action, _, err := client.AddSubnet(ctx, network, hcloud.NetworkAddSubnetOpts{
 				Subnet: hcloud.NetworkSubnet{
 					Type:        hcloud.NetworkSubnetTypeCloud,
 					NetworkZone: hcloud.NetworkZone(e.Region),
@@ -193,7 +209,7 @@ func (_ *Network) RenderHetzner(t *hetzner.HetznerAPITarget, a, e, changes *Netw
 			for action.Progress < 100 {
 				time.Sleep(5 * time.Second)
 				actionClient := t.Cloud.ActionClient()
-				action, _, err = actionClient.GetByID(context.TODO(), action.ID)
+				action, _, err = actionClient.GetByID(ctx, action.ID)
 				if err != nil {
 					return err
 				}
@@ -201,6 +217,34 @@ func (_ *Network) RenderHetzner(t *hetzner.HetznerAPITarget, a, e, changes *Netw
 		}
 	}
 
+	// Add the missing routes
+	for _, destination := range sets.List(sets.KeySet(e.Routes)) {
+		if a != nil && a.Routes[destination] != "" {
+			continue
+		}
+		_, routeDestination, err := net.ParseCIDR(destination)
+		if err != nil {
+			return err
+		}
+		gateway := net.ParseIP(e.Routes[destination])
+		if gateway == nil {
+			return fmt.Errorf("invalid gateway %q for route to %q", e.Routes[destination], destination)
+		}
+		action, _, err := client.AddRoute(ctx, network, hcloud.NetworkAddRouteOpts{
+			Route: hcloud.NetworkRoute{
+				Destination: routeDestination,
+				Gateway:     gateway,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("adding route to %q via %q: %w", destination, e.Routes[destination], err)
+		}
+		actionClient := t.Cloud.ActionClient()
+		if err := actionClient.WaitFor(ctx, action); err != nil {
+			return fmt.Errorf("adding route to %q via %q: %w", destination, e.Routes[destination], err)
+		}
+	}
+
 	return nil
 }
 
@@ -210,6 +254,12 @@ type terraformNetwork struct {
 	Labels  map[string]string `cty:"labels"`
 }
 
+type terraformNetworkRoute struct {
+	NetworkID   *terraformWriter.Literal `cty:"network_id"`
+	Destination *string                  `cty:"destination"`
+	Gateway     *string                  `cty:"gateway"`
+}
+
 type terraformNetworkSubnet struct {
 	NetworkID   *terraformWriter.Literal `cty:"network_id"`
 	Type        *string                  `cty:"type"`
@@ -250,6 +300,19 @@ func (_ *Network) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *N
 		}
 	}
 
+	for _, destination := range sets.List(sets.KeySet(e.Routes)) {
+		tf := &terraformNetworkRoute{
+			NetworkID:   e.TerraformLink(),
+			Destination: new(destination),
+			Gateway:     new(e.Routes[destination]),
+		}
+
+		err := t.RenderResource("hcloud_network_route", *e.Name+"-"+destination, tf)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -67,7 +67,7 @@ func (v *ServerGroup) Find(c *fi.CloudupContext) (*ServerGroup, error) {
 		LabelSelector: strings.Join(labelSelector, ","),
 	}
 	serverListOptions := hcloud.ServerListOpts{ListOpts: listOptions}
-	servers, err := client.AllWithOpts(context.TODO(), serverListOptions)
+	servers, err := client.AllWithOpts(c.Context(), serverListOptions)
 	if err != nil {
 		return nil, err
 	}
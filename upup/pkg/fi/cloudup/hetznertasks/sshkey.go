@@ -51,7 +51,7 @@ func (v *SSHKey) Find(c *fi.CloudupContext) (*SSHKey, error) {
 	cloud := c.T.Cloud.(hetzner.HetznerCloud)
 	client := cloud.SSHKeyClient()
 
-	sshkeys, err := client.All(context.TODO())
+	sshkeys, err := client.All(c.Context())
 	if err != nil {
 		return nil, err
 	}
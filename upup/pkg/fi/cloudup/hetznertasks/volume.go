@@ -48,7 +48,7 @@ func (v *Volume) Find(c *fi.CloudupContext) (*Volume, error) {
 	cloud := c.T.Cloud.(hetzner.HetznerCloud)
 	client := cloud.VolumeClient()
 
-	volumes, err := client.All(context.TODO())
+	volumes, err := client.All(c.Context())
 	if err != nil {
 		return nil, err
 	}
@@ -125,11 +125,11 @@ func (c *Cloud) Region() string {
 	return c.region
 }
 
-func (c *Cloud) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+func (c *Cloud) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
 	return &kops.ClusterStatus{}, nil
 }
 
-func (c *Cloud) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+func (c *Cloud) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
 	return nil, nil
 }
 
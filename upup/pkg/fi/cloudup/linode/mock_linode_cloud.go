@@ -74,11 +74,11 @@ func (c *MockLinodeCloud) Region() string {
 	return c.Region_
 }
 
-func (c *MockLinodeCloud) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+func (c *MockLinodeCloud) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
 	return &kops.ClusterStatus{}, nil
 }
 
-func (c *MockLinodeCloud) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+func (c *MockLinodeCloud) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
 	return nil, nil
 }
 
@@ -17,6 +17,7 @@ limitations under the License.
 package metal
 
 import (
+	"context"
 	"fmt"
 	"net"
 
@@ -123,7 +124,7 @@ func (c *Cloud) Region() string {
 }
 
 // FindClusterStatus discovers the status of the cluster, by inspecting the cloud objects
-func (c *Cloud) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+func (c *Cloud) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
 	// etcdStatus, err := findEtcdStatus(c, cluster)
 	// if err != nil {
 	//      return nil, err
@@ -134,7 +135,7 @@ func (c *Cloud) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, e
 	}, nil
 }
 
-func (c *Cloud) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+func (c *Cloud) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
 	var ret []fi.ApiIngressStatus
 	publicName := cluster.Spec.API.PublicName
 	if publicName == "" {
@@ -26,6 +26,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -43,6 +44,7 @@ import (
 	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
 	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
 	"k8s.io/kops/upup/pkg/fi/cloudup/openstack"
+	"k8s.io/kops/upup/pkg/fi/cloudup/scaleway"
 	"k8s.io/kops/upup/pkg/fi/fitasks"
 	"k8s.io/kops/util/pkg/architectures"
 
@@ -70,6 +72,9 @@ type NewClusterOptions struct {
 	// PublicDiscoveryServiceURL indicates that we should use a public discovery service URL for OIDC discovery.
 	// We create a discovery ID CA, and append the universe ID to the URL.
 	PublicDiscoveryServiceURL string
+	// PodIdentityWebhook deploys the EKS Pod Identity Webhook, so that Pods of annotated ServiceAccounts use IRSA credentials.
+	// It requires a DiscoveryStore or PublicDiscoveryServiceURL, and enables cert-manager.
+	PodIdentityWebhook bool
 
 	// KubernetesVersion is the version of Kubernetes to deploy. It defaults to the version recommended by the channel.
 	KubernetesVersion string
@@ -467,6 +472,22 @@ func NewCluster(opt *NewClusterOptions, clientset simple.Clientset) (*NewCluster
 		}
 	}
 
+	if opt.PodIdentityWebhook {
+		if cluster.GetCloudProvider() != api.CloudProviderAWS {
+			return nil, fmt.Errorf("--pod-identity-webhook is only supported on AWS")
+		}
+		if cluster.Spec.ServiceAccountIssuerDiscovery == nil {
+			return nil, fmt.Errorf("--pod-identity-webhook requires --discovery-store")
+		}
+		// The webhook is served with a certificate issued by cert-manager
+		cluster.Spec.CertManager = &api.CertManagerConfig{
+			Enabled: new(true),
+		}
+		cluster.Spec.CloudProvider.AWS.PodIdentityWebhook = &api.PodIdentityWebhookSpec{
+			Enabled: true,
+		}
+	}
+
 	var nodes []*api.InstanceGroup
 
 	switch opt.InstanceManager {
@@ -835,8 +856,17 @@ func setupZones(opt *NewClusterOptions, cluster *api.Cluster, allZones sets.Stri
 		}
 
 	case api.CloudProviderScaleway:
-		if len(opt.Zones) > 1 {
-			return nil, fmt.Errorf("scaleway cloud provider currently supports only one availability zone")
+		// Instance groups can span several zones, but the cluster must stay in a single region
+		var region scw.Region
+		for _, zone := range allZones.List() {
+			zoneRegion, err := scaleway.ParseRegionFromZone(scw.Zone(zone))
+			if err != nil {
+				return nil, err
+			}
+			if region != "" && zoneRegion != region {
+				return nil, fmt.Errorf("scaleway cloud provider does not support clusters spanning multiple regions (found zone %q, but region is %q)", zone, region)
+			}
+			region = zoneRegion
 		}
 	}
 
@@ -1433,6 +1463,9 @@ func setupTopology(opt *NewClusterOptions, cluster *api.Cluster, allZones sets.S
 		case api.CloudProviderGCE:
 			// GCE does not need utility subnets
 			addUtilitySubnets = false
+		case api.CloudProviderHetzner:
+			// Hetzner Cloud networks have no route tables per subnet, the NAT gateway is in the private subnet
+			addUtilitySubnets = false
 		}
 
 		if addUtilitySubnets {
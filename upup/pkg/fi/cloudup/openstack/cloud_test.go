@@ -17,6 +17,7 @@ limitations under the License.
 package openstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -382,7 +383,7 @@ func Test_OpenstackCloud_GetApiIngressStatus(t *testing.T) {
 				neutronClient:   serviceClient(testServer.URL),
 			}
 
-			ingress, err := cloud.GetApiIngressStatus(testCase.cluster)
+			ingress, err := cloud.GetApiIngressStatus(context.Background(), testCase.cluster)
 
 			compareErrors(t, testCase.expectedError, err)
 
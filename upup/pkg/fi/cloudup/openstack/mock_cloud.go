@@ -17,6 +17,7 @@ limitations under the License.
 package openstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
@@ -313,7 +314,7 @@ func (c *MockCloud) DeleteVolume(volumeID string) error {
 	return deleteVolume(c, volumeID)
 }
 
-func (c *MockCloud) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+func (c *MockCloud) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
 	return findClusterStatus(c, cluster)
 }
 
@@ -321,7 +322,7 @@ func (c *MockCloud) FindNetworkBySubnetID(subnetID string) (*networks.Network, e
 	return findNetworkBySubnetID(c, subnetID)
 }
 
-func (c *MockCloud) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+func (c *MockCloud) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
 	return getApiIngressStatus(c, cluster)
 }
 
@@ -30,6 +30,7 @@ limitations under the License.
 package openstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -41,7 +42,7 @@ import (
 )
 
 // FindClusterStatus discovers the status of the cluster, by looking for the tagged etcd volumes
-func (c *openstackCloud) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+func (c *openstackCloud) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
 	return findClusterStatus(c, cluster)
 }
 
@@ -212,6 +212,9 @@ func (_ *LB) RenderOpenstack(t *openstack.OpenstackAPITarget, a, e, changes *LB)
 		if e.FlavorID != nil {
 			lbopts.FlavorID = fi.ValueOf(e.FlavorID)
 		}
+		if e.Provider != nil {
+			lbopts.Provider = fi.ValueOf(e.Provider)
+		}
 		lb, err := t.Cloud.CreateLB(lbopts)
 		if err != nil {
 			return fmt.Errorf("error creating LB: %v", err)
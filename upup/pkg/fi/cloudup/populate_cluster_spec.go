@@ -78,7 +78,7 @@ func PopulateClusterSpec(ctx context.Context, clientset simple.Clientset, cluste
 // (it may have been set by a user).
 func (c *populateClusterSpec) run(ctx context.Context, clientset simple.Clientset) error {
 	if errs := validation.ValidateCluster(c.InputCluster, false, clientset.VFSContext()); len(errs) != 0 {
-		return errs.ToAggregate()
+		return fi.NewUserConfigError(errs.ToAggregate(), "Fix the cluster spec with `kops edit cluster`.")
 	}
 
 	cloud := c.cloud
@@ -121,6 +121,9 @@ func (c *populateClusterSpec) run(ctx context.Context, clientset simple.Clientse
 				if etcd.Name == "" {
 					return fmt.Errorf("EtcdClusters #%d did not specify a Name", i)
 				}
+				if etcd.IsExternal() {
+					continue
+				}
 
 				for i, m := range etcd.Members {
 					if m.Name == "" {
@@ -218,6 +221,18 @@ func (c *populateClusterSpec) run(ctx context.Context, clientset simple.Clientse
 		cluster.Spec.KubernetesVersion = versionWithoutV
 	}
 
+	// Apply the tags of the tag policy to every resource, along with the other cloud labels
+	policyTags, err := cluster.RenderPolicyTags()
+	if err != nil {
+		return err
+	}
+	for k, v := range policyTags {
+		if cluster.Spec.CloudLabels == nil {
+			cluster.Spec.CloudLabels = make(map[string]string)
+		}
+		cluster.Spec.CloudLabels[k] = v
+	}
+
 	if cluster.Spec.CloudProvider.Openstack == nil {
 		if cluster.Spec.API.DNS == nil && cluster.Spec.API.LoadBalancer == nil {
 			subnetTypesByName := map[string]kopsapi.SubnetType{}
@@ -305,10 +320,16 @@ func (c *populateClusterSpec) run(ctx context.Context, clientset simple.Clientse
 		{
 			// Note: DefaultOptionsBuilder comes first
 			codeModels = append(codeModels, &components.DefaultsOptionsBuilder{Context: optionsContext})
+			// FIPS defaults the cluster's TLS settings, which take precedence over the broader hardening profile ones
+			codeModels = append(codeModels, &components.FIPSOptionsBuilder{OptionsContext: optionsContext})
+			codeModels = append(codeModels, &components.TLSOptionsBuilder{OptionsContext: optionsContext})
+			codeModels = append(codeModels, &components.HardeningOptionsBuilder{OptionsContext: optionsContext})
 			codeModels = append(codeModels, &components.EtcdOptionsBuilder{OptionsContext: optionsContext})
 			codeModels = append(codeModels, &etcdmanager.EtcdManagerOptionsBuilder{OptionsContext: optionsContext})
 			codeModels = append(codeModels, &components.KubeAPIServerOptionsBuilder{OptionsContext: optionsContext})
+			codeModels = append(codeModels, &components.KMSEncryptionOptionsBuilder{OptionsContext: optionsContext})
 			codeModels = append(codeModels, &components.ContainerdOptionsBuilder{OptionsContext: optionsContext})
+			codeModels = append(codeModels, &components.CRIOOptionsBuilder{OptionsContext: optionsContext})
 			codeModels = append(codeModels, &components.NetworkingOptionsBuilder{Context: optionsContext})
 			codeModels = append(codeModels, &components.KubeDnsOptionsBuilder{Context: optionsContext})
 			codeModels = append(codeModels, &components.KubeletOptionsBuilder{OptionsContext: optionsContext})
@@ -324,6 +345,9 @@ func (c *populateClusterSpec) run(ctx context.Context, clientset simple.Clientse
 			codeModels = append(codeModels, &components.ClusterAutoscalerOptionsBuilder{OptionsContext: optionsContext})
 			codeModels = append(codeModels, &components.NodeTerminationHandlerOptionsBuilder{OptionsContext: optionsContext})
 			codeModels = append(codeModels, &components.NodeProblemDetectorOptionsBuilder{OptionsContext: optionsContext})
+			codeModels = append(codeModels, &components.InterruptionHandlerOptionsBuilder{OptionsContext: optionsContext})
+			codeModels = append(codeModels, &components.MetricsServerOptionsBuilder{OptionsContext: optionsContext})
+			codeModels = append(codeModels, &components.VerticalPodAutoscalerOptionsBuilder{OptionsContext: optionsContext})
 			codeModels = append(codeModels, &components.AWSOptionsBuilder{OptionsContext: optionsContext})
 			codeModels = append(codeModels, &components.AWSEBSCSIDriverOptionsBuilder{OptionsContext: optionsContext})
 			codeModels = append(codeModels, &components.AWSCloudControllerManagerOptionsBuilder{OptionsContext: optionsContext})
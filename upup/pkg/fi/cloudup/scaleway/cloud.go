@@ -17,10 +17,13 @@ limitations under the License.
 package scaleway
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
+	block "github.com/scaleway/scaleway-sdk-go/api/block/v1alpha1"
 	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
 	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
@@ -44,6 +47,7 @@ const (
 	TagInstanceGroup         = "noprefix=kops.k8s.io/instance-group"
 	TagNameEtcdClusterPrefix = "noprefix=kops.k8s.io/etcd"
 	TagNeedsUpdate           = "noprefix=kops.k8s.io/needs-update"
+	TagNodeKey               = "noprefix=kops.k8s.io/node-key"
 	TagNameRolePrefix        = "noprefix=kops.k8s.io/role"
 	TagRoleControlPlane      = "ControlPlane"
 	TagRoleWorker            = "Node"
@@ -59,7 +63,9 @@ type ScwCloud interface {
 	ProviderID() kops.CloudProviderID
 	Region() string
 	Zone() string
+	Zones() []scw.Zone
 
+	BlockService() *block.API
 	DomainService() *domain.API
 	IamService() *iam.API
 	InstanceService() *instance.API
@@ -71,9 +77,9 @@ type ScwCloud interface {
 	DeleteInstance(i *cloudinstances.CloudInstance) error
 	DeregisterInstance(instance *cloudinstances.CloudInstance) error
 	DetachInstance(instance *cloudinstances.CloudInstance) error
-	FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error)
+	FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error)
 	FindVPCInfo(id string) (*fi.VPCInfo, error)
-	GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error)
+	GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error)
 	GetCloudGroups(cluster *kops.Cluster, instancegroups []*kops.InstanceGroup, warnUnmatched bool, nodes []v1.Node) (map[string]*cloudinstances.CloudInstanceGroup, error)
 
 	GetClusterDNSRecords(clusterName string) ([]*domain.Record, error)
@@ -81,6 +87,7 @@ type ScwCloud interface {
 	GetClusterServers(clusterName string, instanceGroupName *string) ([]*instance.Server, error)
 	GetClusterSSHKeys(clusterName string) ([]*iam.SSHKey, error)
 	GetClusterVolumes(clusterName string) ([]*instance.Volume, error)
+	GetClusterBlockVolumes(clusterName string) ([]*block.Volume, error)
 	GetServerIP(serverID string, zone scw.Zone) (string, error)
 
 	DeleteDNSRecord(record *domain.Record, clusterName string) error
@@ -88,6 +95,7 @@ type ScwCloud interface {
 	DeleteServer(server *instance.Server) error
 	DeleteSSHKey(sshkey *iam.SSHKey) error
 	DeleteVolume(volume *instance.Volume) error
+	DeleteBlockVolume(volume *block.Volume) error
 }
 
 // static compile time check to validate ScwCloud's fi.Cloud Interface.
@@ -101,6 +109,7 @@ type scwCloudImplementation struct {
 	dns    dnsprovider.Interface
 	tags   map[string]string
 
+	blockAPI       *block.API
 	domainAPI      *domain.API
 	iamAPI         *iam.API
 	instanceAPI    *instance.API
@@ -156,6 +165,7 @@ func NewScwCloud(tags map[string]string) (ScwCloud, error) {
 		zone:           zone,
 		dns:            dns.NewProvider(domain.NewAPI(scwClient)),
 		tags:           tags,
+		blockAPI:       block.NewAPI(scwClient),
 		domainAPI:      domain.NewAPI(scwClient),
 		iamAPI:         iam.NewAPI(scwClient),
 		instanceAPI:    instance.NewAPI(scwClient),
@@ -195,6 +205,18 @@ func (s *scwCloudImplementation) Zone() string {
 	return string(s.zone)
 }
 
+// Zones returns the zones of the region of the cluster, as instance groups can span all the zones of the region
+func (s *scwCloudImplementation) Zones() []scw.Zone {
+	if s.region == "" {
+		return []scw.Zone{s.zone}
+	}
+	return s.region.GetZones()
+}
+
+func (s *scwCloudImplementation) BlockService() *block.API {
+	return s.blockAPI
+}
+
 func (s *scwCloudImplementation) DomainService() *domain.API {
 	return s.domainAPI
 }
@@ -230,9 +252,23 @@ func (s *scwCloudImplementation) DeleteGroup(group *cloudinstances.CloudInstance
 	return nil
 }
 
+// serverZone returns the zone of the server of the cloud instance, which is in the servers of its group
+func (s *scwCloudImplementation) serverZone(i *cloudinstances.CloudInstance) scw.Zone {
+	if i.CloudInstanceGroup != nil {
+		if servers, ok := i.CloudInstanceGroup.Raw.([]*instance.Server); ok {
+			for _, server := range servers {
+				if server.ID == i.ID {
+					return server.Zone
+				}
+			}
+		}
+	}
+	return s.zone
+}
+
 func (s *scwCloudImplementation) DeleteInstance(i *cloudinstances.CloudInstance) error {
 	server, err := s.instanceAPI.GetServer(&instance.GetServerRequest{
-		Zone:     s.zone,
+		Zone:     s.serverZone(i),
 		ServerID: i.ID,
 	})
 	if err != nil {
@@ -253,7 +289,7 @@ func (s *scwCloudImplementation) DeleteInstance(i *cloudinstances.CloudInstance)
 
 func (s *scwCloudImplementation) DeregisterInstance(i *cloudinstances.CloudInstance) error {
 	server, err := s.instanceAPI.GetServer(&instance.GetServerRequest{
-		Zone:     s.zone,
+		Zone:     s.serverZone(i),
 		ServerID: i.ID,
 	})
 	if err != nil {
@@ -302,7 +338,7 @@ func (s *scwCloudImplementation) DetachInstance(i *cloudinstances.CloudInstance)
 }
 
 // FindClusterStatus was used before etcd-manager to check the etcd cluster status and prevent unsupported changes.
-func (s *scwCloudImplementation) FindClusterStatus(cluster *kops.Cluster) (*kops.ClusterStatus, error) {
+func (s *scwCloudImplementation) FindClusterStatus(ctx context.Context, cluster *kops.Cluster) (*kops.ClusterStatus, error) {
 	klog.V(8).Info("Scaleway FindClusterStatus is not implemented")
 	return nil, nil
 }
@@ -313,7 +349,7 @@ func (s *scwCloudImplementation) FindVPCInfo(id string) (*fi.VPCInfo, error) {
 	return nil, fmt.Errorf("FindVPCInfo is not implemented yet for Scaleway")
 }
 
-func (s *scwCloudImplementation) GetApiIngressStatus(cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
+func (s *scwCloudImplementation) GetApiIngressStatus(ctx context.Context, cluster *kops.Cluster) ([]fi.ApiIngressStatus, error) {
 	var ingresses []fi.ApiIngressStatus
 	name := "api." + cluster.Name
 
@@ -401,6 +437,10 @@ func buildCloudGroup(s *scwCloudImplementation, ig *kops.InstanceGroup, sg []*in
 				status = cloudinstances.CloudInstanceStatusNeedsUpdate
 			}
 		}
+		// Servers left in zones which were removed from the instance group are replaced
+		if !slices.Contains(ig.Spec.Subnets, server.Zone.String()) {
+			status = cloudinstances.CloudInstanceStatusNeedsUpdate
+		}
 		cloudInstance, err := cloudInstanceGroup.NewCloudInstance(server.ID, status, nodeMap[server.ID])
 		if err != nil {
 			return nil, fmt.Errorf("failed to create cloud instance for server %s(%s): %w", server.Name, server.ID, err)
@@ -456,19 +496,23 @@ func (s *scwCloudImplementation) GetClusterServers(clusterName string, instanceG
 	if instanceGroupName != nil {
 		tags = append(tags, fmt.Sprintf("%s=%s", TagInstanceGroup, *instanceGroupName))
 	}
-	request := &instance.ListServersRequest{
-		Zone: s.zone,
-		Name: instanceGroupName,
-		Tags: tags,
-	}
-	servers, err := s.instanceAPI.ListServers(request, scw.WithAllPages())
-	if err != nil {
-		if instanceGroupName != nil {
-			return nil, fmt.Errorf("failed to list cluster servers named %q: %w", *instanceGroupName, err)
+	var servers []*instance.Server
+	for _, zone := range s.Zones() {
+		request := &instance.ListServersRequest{
+			Zone: zone,
+			Name: instanceGroupName,
+			Tags: tags,
 		}
-		return nil, fmt.Errorf("failed to list cluster servers: %w", err)
+		response, err := s.instanceAPI.ListServers(request, scw.WithAllPages())
+		if err != nil {
+			if instanceGroupName != nil {
+				return nil, fmt.Errorf("failed to list cluster servers named %q in zone %s: %w", *instanceGroupName, zone, err)
+			}
+			return nil, fmt.Errorf("failed to list cluster servers in zone %s: %w", zone, err)
+		}
+		servers = append(servers, response.Servers...)
 	}
-	return servers.Servers, nil
+	return servers, nil
 }
 
 func (s *scwCloudImplementation) GetClusterSSHKeys(clusterName string) ([]*iam.SSHKey, error) {
@@ -486,14 +530,33 @@ func (s *scwCloudImplementation) GetClusterSSHKeys(clusterName string) ([]*iam.S
 }
 
 func (s *scwCloudImplementation) GetClusterVolumes(clusterName string) ([]*instance.Volume, error) {
-	volumes, err := s.instanceAPI.ListVolumes(&instance.ListVolumesRequest{
-		Zone: s.zone,
-		Tags: []string{TagClusterName + "=" + clusterName},
-	}, scw.WithAllPages())
-	if err != nil {
-		return nil, fmt.Errorf("failed to list cluster volumes: %w", err)
+	var volumes []*instance.Volume
+	for _, zone := range s.Zones() {
+		response, err := s.instanceAPI.ListVolumes(&instance.ListVolumesRequest{
+			Zone: zone,
+			Tags: []string{TagClusterName + "=" + clusterName},
+		}, scw.WithAllPages())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list cluster volumes in zone %s: %w", zone, err)
+		}
+		volumes = append(volumes, response.Volumes...)
+	}
+	return volumes, nil
+}
+
+func (s *scwCloudImplementation) GetClusterBlockVolumes(clusterName string) ([]*block.Volume, error) {
+	var volumes []*block.Volume
+	for _, zone := range s.Zones() {
+		response, err := s.blockAPI.ListVolumes(&block.ListVolumesRequest{
+			Zone: zone,
+			Tags: []string{TagClusterName + "=" + clusterName},
+		}, scw.WithAllPages())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list cluster block volumes in zone %s: %w", zone, err)
+		}
+		volumes = append(volumes, response.Volumes...)
 	}
-	return volumes.Volumes, nil
+	return volumes, nil
 }
 
 func (s *scwCloudImplementation) GetServerIP(serverID string, zone scw.Zone) (string, error) {
@@ -589,8 +652,13 @@ func (s *scwCloudImplementation) DeleteLoadBalancer(loadBalancer *lb.LB) error {
 }
 
 func (s *scwCloudImplementation) DeleteServer(server *instance.Server) error {
+	zone := server.Zone
+	if zone == "" {
+		zone = s.zone
+	}
+
 	srv, err := s.instanceAPI.GetServer(&instance.GetServerRequest{
-		Zone:     s.zone,
+		Zone:     zone,
 		ServerID: server.ID,
 	})
 	if err != nil {
@@ -603,29 +671,45 @@ func (s *scwCloudImplementation) DeleteServer(server *instance.Server) error {
 
 	// We detach the etcd volumes
 	for _, volume := range srv.Server.Volumes {
-		volumeResponse, err := s.instanceAPI.GetVolume(&instance.GetVolumeRequest{
-			Zone:     s.zone,
-			VolumeID: volume.ID,
-		})
-		if err != nil {
-			return fmt.Errorf("delete server %s: getting infos for volume %s", server.ID, volume.ID)
+		var volumeTags []string
+		isBlockVolume := volume.VolumeType == instance.VolumeServerVolumeTypeSbsVolume
+		if isBlockVolume {
+			blockVolume, err := s.blockAPI.GetVolume(&block.GetVolumeRequest{
+				Zone:     zone,
+				VolumeID: volume.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("delete server %s: getting infos for volume %s: %w", server.ID, volume.ID, err)
+			}
+			volumeTags = blockVolume.Tags
+		} else {
+			volumeResponse, err := s.instanceAPI.GetVolume(&instance.GetVolumeRequest{
+				Zone:     zone,
+				VolumeID: volume.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("delete server %s: getting infos for volume %s: %w", server.ID, volume.ID, err)
+			}
+			volumeTags = volumeResponse.Volume.Tags
 		}
-		for _, tag := range volumeResponse.Volume.Tags {
+		for _, tag := range volumeTags {
 			if strings.HasPrefix(tag, TagNameEtcdClusterPrefix) {
 				_, err = s.instanceAPI.DetachVolume(&instance.DetachVolumeRequest{
-					Zone:     s.zone,
-					VolumeID: volume.ID,
+					Zone:          zone,
+					VolumeID:      volume.ID,
+					IsBlockVolume: new(isBlockVolume),
 				})
 				if err != nil {
-					return fmt.Errorf("delete server %s: detaching volume %s", server.ID, volume.ID)
+					return fmt.Errorf("delete server %s: detaching volume %s: %w", server.ID, volume.ID, err)
 				}
+				break
 			}
 		}
 	}
 
 	// We terminate the server. This stops and deletes the machine immediately
 	_, err = s.instanceAPI.ServerAction(&instance.ServerActionRequest{
-		Zone:     s.zone,
+		Zone:     zone,
 		ServerID: server.ID,
 		Action:   instance.ServerActionTerminate,
 	})
@@ -635,7 +719,7 @@ func (s *scwCloudImplementation) DeleteServer(server *instance.Server) error {
 
 	_, err = s.instanceAPI.WaitForServer(&instance.WaitForServerRequest{
 		ServerID: server.ID,
-		Zone:     s.zone,
+		Zone:     zone,
 	})
 	if err != nil && !is404Error(err) {
 		return fmt.Errorf("delete server %s: waiting for instance after termination: %w", server.ID, err)
@@ -661,7 +745,7 @@ func (s *scwCloudImplementation) DeleteSSHKey(sshkey *iam.SSHKey) error {
 func (s *scwCloudImplementation) DeleteVolume(volume *instance.Volume) error {
 	err := s.instanceAPI.DeleteVolume(&instance.DeleteVolumeRequest{
 		VolumeID: volume.ID,
-		Zone:     s.zone,
+		Zone:     volume.Zone,
 	})
 	if err != nil {
 		if is404Error(err) {
@@ -673,7 +757,7 @@ func (s *scwCloudImplementation) DeleteVolume(volume *instance.Volume) error {
 
 	_, err = s.instanceAPI.WaitForVolume(&instance.WaitForVolumeRequest{
 		VolumeID: volume.ID,
-		Zone:     s.zone,
+		Zone:     volume.Zone,
 	})
 	if !is404Error(err) {
 		return fmt.Errorf("delete volume %s: error waiting for volume after deletion: %w", volume.ID, err)
@@ -681,3 +765,27 @@ func (s *scwCloudImplementation) DeleteVolume(volume *instance.Volume) error {
 
 	return nil
 }
+
+func (s *scwCloudImplementation) DeleteBlockVolume(volume *block.Volume) error {
+	err := s.blockAPI.DeleteVolume(&block.DeleteVolumeRequest{
+		VolumeID: volume.ID,
+		Zone:     volume.Zone,
+	})
+	if err != nil {
+		if is404Error(err) {
+			klog.V(8).Infof("Block volume %q (%s) was already deleted", volume.Name, volume.ID)
+			return nil
+		}
+		return fmt.Errorf("failed to delete block volume %s: %w", volume.ID, err)
+	}
+
+	_, err = s.blockAPI.WaitForVolume(&block.WaitForVolumeRequest{
+		VolumeID: volume.ID,
+		Zone:     volume.Zone,
+	})
+	if !is404Error(err) {
+		return fmt.Errorf("delete block volume %s: error waiting for volume after deletion: %w", volume.ID, err)
+	}
+
+	return nil
+}
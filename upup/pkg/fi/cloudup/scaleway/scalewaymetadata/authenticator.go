@@ -20,20 +20,32 @@ limitations under the License.
 package scalewaymetadata
 
 import (
+	"crypto"
 	"fmt"
 
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"k8s.io/kops/pkg/bootstrap"
+	"k8s.io/kops/pkg/bootstrap/pkibootstrap"
 )
 
 const ScalewayAuthenticationTokenPrefix = "x-scaleway-instance-server-id " //nolint:gosec // This is an authentication scheme prefix, not a credential.
 
-type scalewayAuthenticator struct{}
+// ScalewaySignedAuthenticationTokenPrefix is the prefix of tokens signed with the machine key of the server.
+const ScalewaySignedAuthenticationTokenPrefix = "x-scaleway-signed " //nolint:gosec // This is an authentication scheme prefix, not a credential.
+
+type scalewayAuthenticator struct {
+	signer crypto.Signer
+}
 
 var _ bootstrap.Authenticator = (*scalewayAuthenticator)(nil)
 
 func NewScalewayAuthenticator() (bootstrap.Authenticator, error) {
-	return &scalewayAuthenticator{}, nil
+	// Requests are signed with the machine key, which stands in for the signed identity documents Scaleway doesn't offer.
+	signer, err := pkibootstrap.LoadOrCreateMachineKey(pkibootstrap.MachineKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	return &scalewayAuthenticator{signer: signer}, nil
 }
 
 func (a *scalewayAuthenticator) CreateToken(body []byte) (string, error) {
@@ -42,5 +54,5 @@ func (a *scalewayAuthenticator) CreateToken(body []byte) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("failed to retrieve server metadata: %w", err)
 	}
-	return ScalewayAuthenticationTokenPrefix + metadata.ID, nil
+	return pkibootstrap.CreateSignedToken(ScalewaySignedAuthenticationTokenPrefix, metadata.ID, a.signer, body)
 }
@@ -45,13 +45,21 @@ func is404Error(err error) bool {
 	return isHTTPCodeError(err, http.StatusNotFound) || errors.As(err, &notFoundError)
 }
 
+// ParseZoneFromClusterSpec returns the zone of the first subnet of the cluster, which is the zone of the shared resources
+// such as the load-balancer. The subnets of the cluster can be in several zones, as long as they are in the same region.
 func ParseZoneFromClusterSpec(clusterSpec kops.ClusterSpec) (scw.Zone, error) {
 	zone := ""
+	var region scw.Region
 	for _, subnet := range clusterSpec.Networking.Subnets {
+		subnetRegion, err := ParseRegionFromZone(scw.Zone(subnet.Zone))
+		if err != nil {
+			return "", err
+		}
 		if zone == "" {
 			zone = subnet.Zone
-		} else if zone != subnet.Zone {
-			return "", fmt.Errorf("scaleway currently only supports clusters in the same zone")
+			region = subnetRegion
+		} else if region != subnetRegion {
+			return "", fmt.Errorf("scaleway only supports clusters in the same region, found zones %s and %s", zone, subnet.Zone)
 		}
 	}
 	return scw.Zone(zone), nil
@@ -83,6 +91,16 @@ func InstanceGroupNameFromTags(tags []string) string {
 	return ""
 }
 
+// NodeKeyFromTags returns the fingerprint of the key pinned to a server, if any.
+func NodeKeyFromTags(tags []string) string {
+	for _, tag := range tags {
+		if strings.HasPrefix(tag, TagNodeKey+"=") {
+			return strings.TrimPrefix(tag, TagNodeKey+"=")
+		}
+	}
+	return ""
+}
+
 func InstanceRoleFromTags(tags []string) string {
 	for _, tag := range tags {
 		if strings.HasPrefix(tag, TagNameRolePrefix) {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -29,19 +30,31 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	kopsv "k8s.io/kops"
 	"k8s.io/kops/pkg/bootstrap"
+	"k8s.io/kops/pkg/bootstrap/pkibootstrap"
 	"k8s.io/kops/pkg/wellknownports"
 	"k8s.io/kops/upup/pkg/fi/cloudup/scaleway/scalewaymetadata"
 )
 
-type ScalewayVerifierOptions struct{}
+type ScalewayVerifierOptions struct {
+	// ClusterName is the name of the cluster, used to check that servers belong to the cluster.
+	ClusterName string `json:"clusterName,omitempty"`
+	// AllowUnsignedTokens accepts the unsigned tokens of nodes from before kOps 1.37, for servers without a pinned key.
+	// Deprecated: set from the AllowUnsignedBootstrapTokens feature flag, only while such nodes still bootstrap.
+	AllowUnsignedTokens bool `json:"allowUnsignedTokens,omitempty"`
+}
 
 type scalewayVerifier struct {
+	opt       ScalewayVerifierOptions
 	scwClient *scw.Client
 }
 
 var _ bootstrap.Verifier = (*scalewayVerifier)(nil)
 
 func NewScalewayVerifier(ctx context.Context, opt *ScalewayVerifierOptions) (bootstrap.Verifier, error) {
+	if opt == nil || opt.ClusterName == "" {
+		return nil, fmt.Errorf("determining cluster name")
+	}
+
 	profile, err := scalewaymetadata.CreateValidScalewayProfile()
 	if err != nil {
 		return nil, fmt.Errorf("creating client for Scaleway Verifier: %w", err)
@@ -54,15 +67,29 @@ func NewScalewayVerifier(ctx context.Context, opt *ScalewayVerifierOptions) (boo
 		return nil, err
 	}
 	return &scalewayVerifier{
+		opt:       *opt,
 		scwClient: scwClient,
 	}, nil
 }
 
 func (v scalewayVerifier) VerifyToken(ctx context.Context, rawRequest *http.Request, token string, body []byte) (*bootstrap.VerifyResult, error) {
-	if !strings.HasPrefix(token, scalewaymetadata.ScalewayAuthenticationTokenPrefix) {
+	var serverID, keyFingerprint string
+	switch {
+	case strings.HasPrefix(token, scalewaymetadata.ScalewaySignedAuthenticationTokenPrefix):
+		tokenData, fingerprint, err := pkibootstrap.VerifySignedToken(scalewaymetadata.ScalewaySignedAuthenticationTokenPrefix, token, body, 0)
+		if err != nil {
+			return nil, err
+		}
+		serverID = tokenData.Instance
+		keyFingerprint = fingerprint
+	case strings.HasPrefix(token, scalewaymetadata.ScalewayAuthenticationTokenPrefix):
+		if !v.opt.AllowUnsignedTokens {
+			return nil, pkibootstrap.ErrUnsignedToken
+		}
+		serverID = strings.TrimPrefix(token, scalewaymetadata.ScalewayAuthenticationTokenPrefix)
+	default:
 		return nil, bootstrap.ErrNotThisVerifier
 	}
-	serverID := strings.TrimPrefix(token, scalewaymetadata.ScalewayAuthenticationTokenPrefix)
 
 	metadataAPI := instance.NewMetadataAPI()
 	metadata, err := metadataAPI.GetMetadata()
@@ -78,19 +105,7 @@ func (v scalewayVerifier) VerifyToken(ctx context.Context, rawRequest *http.Requ
 		return nil, fmt.Errorf("unable to determine region from zone %s", zone)
 	}
 
-	profile, err := scalewaymetadata.CreateValidScalewayProfile()
-	if err != nil {
-		return nil, err
-	}
-	scwClient, err := scw.NewClient(
-		scw.WithProfile(profile),
-		scw.WithUserAgent(KopsUserAgentPrefix+kopsv.Version),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("creating client for Scaleway Verifier: %w", err)
-	}
-
-	serverResponse, err := instance.NewAPI(scwClient).GetServer(&instance.GetServerRequest{
+	serverResponse, err := instance.NewAPI(v.scwClient).GetServer(&instance.GetServerRequest{
 		ServerID: serverID,
 		Zone:     zone,
 	}, scw.WithContext(ctx))
@@ -99,7 +114,17 @@ func (v scalewayVerifier) VerifyToken(ctx context.Context, rawRequest *http.Requ
 	}
 	server := serverResponse.Server
 
-	ips, err := ipam.NewAPI(scwClient).ListIPs(&ipam.ListIPsRequest{
+	// Scaleway does not provide signed instance identity documents, so we only trust servers of this cluster,
+	// and the node challenge then verifies that the caller controls the server.
+	if err := verifyServer(server, v.opt.ClusterName); err != nil {
+		return nil, err
+	}
+	pinnedKey := NodeKeyFromTags(server.Tags)
+	if err := pkibootstrap.VerifyPinnedKey(serverID, pinnedKey, keyFingerprint); err != nil {
+		return nil, err
+	}
+
+	ips, err := ipam.NewAPI(v.scwClient).ListIPs(&ipam.ListIPsRequest{
 		Region:     region,
 		ResourceID: new(server.ID),
 		IsIPv6:     new(false),
@@ -126,5 +151,27 @@ func (v scalewayVerifier) VerifyToken(ctx context.Context, rawRequest *http.Requ
 		ChallengeEndpoint: challengeEndPoints[0],
 	}
 
+	if keyFingerprint != "" && pinnedKey == "" {
+		result.PinKey = func(ctx context.Context) error {
+			_, err := instance.NewAPI(v.scwClient).UpdateServer(&instance.UpdateServerRequest{
+				Zone:     zone,
+				ServerID: server.ID,
+				Tags:     scw.StringsPtr(append(slices.Clone(server.Tags), TagNodeKey+"="+keyFingerprint)),
+			}, scw.WithContext(ctx))
+			return err
+		}
+	}
+
 	return result, nil
 }
+
+// verifyServer checks that the server is a running server of the cluster.
+func verifyServer(server *instance.Server, clusterName string) error {
+	if ClusterNameFromTags(server.Tags) != clusterName {
+		return fmt.Errorf("server %s is not part of cluster %q", server.ID, clusterName)
+	}
+	if server.State != instance.ServerStateRunning {
+		return fmt.Errorf("server %s is not running (state %q)", server.ID, server.State)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+)
+
+func TestVerifyServer(t *testing.T) {
+	grid := []struct {
+		desc        string
+		server      *instance.Server
+		expectError bool
+	}{
+		{
+			desc: "running server of the cluster",
+			server: &instance.Server{
+				ID:    "1",
+				State: instance.ServerStateRunning,
+				Tags:  []string{TagClusterName + "=example.k8s.local", TagInstanceGroup + "=nodes"},
+			},
+		},
+		{
+			desc: "server of another cluster",
+			server: &instance.Server{
+				ID:    "2",
+				State: instance.ServerStateRunning,
+				Tags:  []string{TagClusterName + "=other.k8s.local"},
+			},
+			expectError: true,
+		},
+		{
+			desc: "server without cluster tag",
+			server: &instance.Server{
+				ID:    "3",
+				State: instance.ServerStateRunning,
+			},
+			expectError: true,
+		},
+		{
+			desc: "server that is not running",
+			server: &instance.Server{
+				ID:    "4",
+				State: instance.ServerStateStopped,
+				Tags:  []string{TagClusterName + "=example.k8s.local"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			err := verifyServer(g.server, "example.k8s.local")
+			if g.expectError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !g.expectError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
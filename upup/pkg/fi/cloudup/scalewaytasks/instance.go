@@ -21,6 +21,8 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"io"
+	"math"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -38,7 +40,8 @@ type Instance struct {
 	Name      *string
 	Lifecycle fi.Lifecycle
 
-	Zone           *string
+	// Zones are the zones the servers of the group are spread across
+	Zones          []string
 	Role           *string
 	CommercialType *string
 	Image          *string
@@ -96,6 +99,12 @@ func (s *Instance) Find(c *fi.CloudupContext) (*Instance, error) {
 			continue
 		}
 
+		// Check if the server is in a zone that was removed from the group
+		if !slices.Contains(s.Zones, server.Zone.String()) {
+			needsUpdate = append(needsUpdate, server.ID)
+			continue
+		}
+
 		// Check commercial type differences
 		if server.CommercialType != *s.CommercialType {
 			needsUpdate = append(needsUpdate, server.ID)
@@ -134,14 +143,15 @@ func (s *Instance) Find(c *fi.CloudupContext) (*Instance, error) {
 	return &Instance{
 		Name:           new(igName),
 		Lifecycle:      s.Lifecycle,
-		Zone:           new(server.Zone.String()),
+		Zones:          s.Zones,
 		Role:           new(role),
 		CommercialType: new(server.CommercialType),
 		Image:          new(imageLabel),
-		Tags:           server.Tags,
-		Count:          len(servers),
-		NeedsUpdate:    needsUpdate,
-		UserData:       s.UserData,
+		// The key kops-controller pins to a server isn't part of the spec
+		Tags:        slices.DeleteFunc(slices.Clone(server.Tags), func(tag string) bool { return strings.HasPrefix(tag, scaleway.TagNodeKey+"=") }),
+		Count:       len(servers),
+		NeedsUpdate: needsUpdate,
+		UserData:    s.UserData,
 	}, nil
 }
 
@@ -154,15 +164,12 @@ func (_ *Instance) CheckChanges(actual, expected, changes *Instance) error {
 		if changes.Name != nil {
 			return fi.CannotChangeField("Name")
 		}
-		if changes.Zone != nil {
-			return fi.CannotChangeField("Zone")
-		}
 	} else {
 		if expected.Name == nil {
 			return fi.RequiredField("Name")
 		}
-		if expected.Zone == nil {
-			return fi.RequiredField("Zone")
+		if len(expected.Zones) == 0 {
+			return fi.RequiredField("Zones")
 		}
 		if expected.CommercialType == nil {
 			return fi.RequiredField("CommercialType")
@@ -176,18 +183,28 @@ func (_ *Instance) CheckChanges(actual, expected, changes *Instance) error {
 
 func (_ *Instance) RenderScw(t *scaleway.ScwAPITarget, actual, expected, changes *Instance) error {
 	instanceService := t.Cloud.InstanceService()
-	zone := scw.Zone(fi.ValueOf(expected.Zone))
 
 	userData, err := fi.ResourceAsBytes(*expected.UserData)
 	if err != nil {
 		return fmt.Errorf("error rendering instances: %w", err)
 	}
 
+	igServers, err := t.Cloud.GetClusterServers(scaleway.ClusterNameFromTags(expected.Tags), expected.Name)
+	if err != nil {
+		return fmt.Errorf("rendering server group: listing existing servers: %w", err)
+	}
+
 	newInstanceCount := expected.Count
 	if actual != nil {
 
 		// Add "kops.k8s.io/needs-update" label to servers needing update
 		for _, serverID := range actual.NeedsUpdate {
+			var zone scw.Zone
+			for _, igServer := range igServers {
+				if igServer.ID == serverID {
+					zone = igServer.Zone
+				}
+			}
 			server, err := instanceService.GetServer(&instance.GetServerRequest{
 				Zone:     zone,
 				ServerID: serverID,
@@ -220,6 +237,9 @@ func (_ *Instance) RenderScw(t *scaleway.ScwAPITarget, actual, expected, changes
 			return fmt.Errorf("error rendering server group %s: computing unique name for server: %w", fi.ValueOf(expected.Name), err)
 		}
 
+		// We spread the servers of the group across its zones
+		zone := scw.Zone(zoneWithFewestServers(expected.Zones, igServers))
+
 		createServerRequest := instance.CreateServerRequest{
 			Zone:            zone,
 			Name:            uniqueName,
@@ -282,18 +302,13 @@ func (_ *Instance) RenderScw(t *scaleway.ScwAPITarget, actual, expected, changes
 		if err != nil {
 			return fmt.Errorf("error waiting for instance %s of group %q: %w", srv.Server.ID, fi.ValueOf(expected.Name), err)
 		}
+
+		igServers = append(igServers, srv.Server)
 	}
 
 	// If newInstanceCount < 0, we need to delete instances of this group
 	if newInstanceCount < 0 {
-
-		igInstances, err := t.Cloud.GetClusterServers(t.Cloud.ClusterName(actual.Tags), actual.Name)
-		if err != nil {
-			return fmt.Errorf("error deleting instance: %w", err)
-		}
-
-		for i := 0; i > newInstanceCount; i-- {
-			toDelete := igInstances[i*-1]
+		for _, toDelete := range serversToDelete(expected.Zones, igServers, -newInstanceCount) {
 			err = t.Cloud.DeleteServer(toDelete)
 			if err != nil {
 				return fmt.Errorf("error deleting instance of group %s: %w", toDelete.Name, err)
@@ -305,6 +320,7 @@ func (_ *Instance) RenderScw(t *scaleway.ScwAPITarget, actual, expected, changes
 }
 
 type terraformInstanceIP struct {
+	Zone *string  `cty:"zone"`
 	Tags []string `cty:"tags"`
 }
 
@@ -312,6 +328,7 @@ type terraformInstance struct {
 	Name                *string                             `cty:"name"`
 	IPID                *terraformWriter.Literal            `cty:"ip_id"`
 	Type                *string                             `cty:"type"`
+	Zone                *string                             `cty:"zone"`
 	Tags                []string                            `cty:"tags"`
 	Image               *string                             `cty:"image"`
 	UserData            map[string]*terraformWriter.Literal `cty:"user_data"`
@@ -326,11 +343,13 @@ func (_ *Instance) RenderTerraform(t *terraform.TerraformTarget, actual, expecte
 		// We create a unique name for each server
 		uniqueName := fmt.Sprintf("%s-%d", fi.ValueOf(expected.Name), i)
 		tfName := strings.ReplaceAll(uniqueName, ".", "-")
+		zone := expected.Zones[i%len(expected.Zones)]
 
 		tfInstance := terraformInstance{
 			Name:                &uniqueName,
 			IPID:                terraformWriter.LiteralProperty("scaleway_instance_ip", tfName, "id"),
 			Type:                expected.CommercialType,
+			Zone:                &zone,
 			Tags:                expected.Tags,
 			Image:               expected.Image,
 			EnableDynamicIP:     new(true),
@@ -374,7 +393,9 @@ func (_ *Instance) RenderTerraform(t *terraform.TerraformTarget, actual, expecte
 		}
 
 		// We create an IP for the server (we only render it now to avoid duplicates if Instance task fails)
-		tfInstanceIP := terraformInstanceIP{}
+		tfInstanceIP := terraformInstanceIP{
+			Zone: &zone,
+		}
 		for _, tag := range expected.Tags {
 			if strings.HasPrefix(tag, scaleway.TagClusterName) {
 				tfInstanceIP.Tags = []string{tag}
@@ -445,6 +466,55 @@ func imageLabelFromID(c *fi.CloudupContext, cloud scaleway.ScwCloud, id string)
 	return localImage.Label, nil
 }
 
+// zoneWithFewestServers returns the zone with the fewest servers of the group, the first one in case of a tie
+func zoneWithFewestServers(zones []string, servers []*instance.Server) string {
+	serversPerZone := countServersPerZone(servers)
+	zone := zones[0]
+	for _, z := range zones[1:] {
+		if serversPerZone[z] < serversPerZone[zone] {
+			zone = z
+		}
+	}
+	return zone
+}
+
+// serversToDelete returns the servers to delete to scale the group down by count, starting with
+// the servers outside the zones of the group, then the servers in the zones with the most servers
+func serversToDelete(zones []string, servers []*instance.Server, count int) []*instance.Server {
+	remaining := slices.Clone(servers)
+	serversPerZone := countServersPerZone(servers)
+
+	deletionPriority := func(server *instance.Server) int {
+		if !slices.Contains(zones, server.Zone.String()) {
+			return math.MaxInt
+		}
+		return serversPerZone[server.Zone.String()]
+	}
+
+	var toDelete []*instance.Server
+	for len(toDelete) < count && len(remaining) > 0 {
+		index := 0
+		for i, server := range remaining {
+			if deletionPriority(server) > deletionPriority(remaining[index]) {
+				index = i
+			}
+		}
+		server := remaining[index]
+		toDelete = append(toDelete, server)
+		serversPerZone[server.Zone.String()]--
+		remaining = slices.Delete(remaining, index, index+1)
+	}
+	return toDelete
+}
+
+func countServersPerZone(servers []*instance.Server) map[string]int {
+	serversPerZone := make(map[string]int)
+	for _, server := range servers {
+		serversPerZone[server.Zone.String()]++
+	}
+	return serversPerZone
+}
+
 func findFirstFreeIndex(existing []*instance.Server) int {
 	index := 0
 	for {
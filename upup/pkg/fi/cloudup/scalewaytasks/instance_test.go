@@ -18,9 +18,11 @@ package scalewaytasks
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
 func TestFindFirstFreeIndex(t *testing.T) {
@@ -63,3 +65,94 @@ func TestFindFirstFreeIndex(t *testing.T) {
 		}
 	}
 }
+
+func TestZoneWithFewestServers(t *testing.T) {
+	zones := []string{"fr-par-1", "fr-par-2", "fr-par-3"}
+	type TestCase struct {
+		Actual   []string
+		Expected string
+	}
+	testCases := []TestCase{
+		{
+			Actual:   []string{},
+			Expected: "fr-par-1",
+		},
+		{
+			Actual:   []string{"fr-par-1"},
+			Expected: "fr-par-2",
+		},
+		{
+			Actual:   []string{"fr-par-1", "fr-par-2", "fr-par-1"},
+			Expected: "fr-par-3",
+		},
+		{
+			Actual:   []string{"fr-par-1", "fr-par-2", "fr-par-3"},
+			Expected: "fr-par-1",
+		},
+		{
+			Actual:   []string{"nl-ams-1", "fr-par-2", "fr-par-3"},
+			Expected: "fr-par-1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var existing []*instance.Server
+		for i, zone := range testCase.Actual {
+			existing = append(existing, &instance.Server{ID: strconv.Itoa(i), Zone: scw.Zone(zone)})
+		}
+		zone := zoneWithFewestServers(zones, existing)
+		if zone != testCase.Expected {
+			t.Errorf("Expected %s, got %s", testCase.Expected, zone)
+		}
+	}
+}
+
+func TestServersToDelete(t *testing.T) {
+	zones := []string{"fr-par-1", "fr-par-2"}
+	type TestCase struct {
+		Actual   []string
+		Count    int
+		Expected []string
+	}
+	testCases := []TestCase{
+		{
+			Actual:   []string{"fr-par-1", "fr-par-2"},
+			Count:    1,
+			Expected: []string{"0"},
+		},
+		{
+			Actual:   []string{"fr-par-1", "fr-par-2", "fr-par-2"},
+			Count:    1,
+			Expected: []string{"1"},
+		},
+		{
+			Actual:   []string{"fr-par-1", "fr-par-2", "fr-par-2", "fr-par-2"},
+			Count:    2,
+			Expected: []string{"1", "2"},
+		},
+		{
+			Actual:   []string{"fr-par-1", "fr-par-1", "fr-par-3"},
+			Count:    2,
+			Expected: []string{"2", "0"},
+		},
+		{
+			Actual:   []string{"fr-par-1"},
+			Count:    2,
+			Expected: []string{"0"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		var existing []*instance.Server
+		for i, zone := range testCase.Actual {
+			existing = append(existing, &instance.Server{ID: strconv.Itoa(i), Zone: scw.Zone(zone)})
+		}
+		var deleted []string
+		for _, server := range serversToDelete(zones, existing, testCase.Count) {
+			deleted = append(deleted, server.ID)
+		}
+		if strings.Join(deleted, ",") != strings.Join(testCase.Expected, ",") {
+			t.Errorf("Expected %v, got %v", testCase.Expected, deleted)
+		}
+	}
+}
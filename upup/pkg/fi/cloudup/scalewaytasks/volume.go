@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	block "github.com/scaleway/scaleway-sdk-go/api/block/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"k8s.io/kops/upup/pkg/fi"
@@ -37,6 +38,8 @@ type Volume struct {
 	Zone *string
 	Tags []string
 	Type *string
+	// IOPS is the performance class of Block Storage volumes (5000 or 15000)
+	IOPS *int32
 }
 
 var _ fi.CompareWithID = (*Volume)(nil)
@@ -47,12 +50,38 @@ func (v *Volume) CompareWithID() *string {
 
 func (v *Volume) Find(c *fi.CloudupContext) (*Volume, error) {
 	cloud := c.T.Cloud.(scaleway.ScwCloud)
-	instanceService := cloud.InstanceService()
-	zone := cloud.Zone()
+	zone := scw.Zone(fi.ValueOf(v.Zone))
 
-	volumes, err := instanceService.ListVolumes(&instance.ListVolumesRequest{
+	if fi.ValueOf(v.Type) == string(instance.VolumeVolumeTypeSbsVolume) {
+		blockVolumes, err := cloud.BlockService().ListVolumes(&block.ListVolumesRequest{
+			Name: v.Name,
+			Zone: zone,
+		}, scw.WithAllPages())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, volume := range blockVolumes.Volumes {
+			if volume.Name == fi.ValueOf(v.Name) {
+				actual := &Volume{
+					Name:      new(volume.Name),
+					ID:        new(volume.ID),
+					Lifecycle: v.Lifecycle,
+					Size:      new(int64(volume.Size)),
+					Zone:      new(string(volume.Zone)),
+					Type:      new(string(instance.VolumeVolumeTypeSbsVolume)),
+				}
+				if volume.Specs != nil && volume.Specs.PerfIops != nil {
+					actual.IOPS = new(int32(*volume.Specs.PerfIops))
+				}
+				return actual, nil
+			}
+		}
+	}
+
+	volumes, err := cloud.InstanceService().ListVolumes(&instance.ListVolumesRequest{
 		Name: v.Name,
-		Zone: scw.Zone(zone),
+		Zone: zone,
 	}, scw.WithAllPages())
 	if err != nil {
 		return nil, err
@@ -60,6 +89,9 @@ func (v *Volume) Find(c *fi.CloudupContext) (*Volume, error) {
 
 	for _, volume := range volumes.Volumes {
 		if volume.Name == fi.ValueOf(v.Name) {
+			// Volumes created before Block Storage was used are kept, as they cannot be migrated without losing the etcd data
+			v.Type = new(string(volume.VolumeType))
+			v.IOPS = nil
 			return &Volume{
 				Name:      new(volume.Name),
 				ID:        new(volume.ID),
@@ -89,6 +121,9 @@ func (_ *Volume) CheckChanges(actual, expected, changes *Volume) error {
 		if changes.Zone != nil {
 			return fi.CannotChangeField("Zone")
 		}
+		if changes.Type != nil {
+			return fi.CannotChangeField("Type")
+		}
 	} else {
 		if expected.Name == nil {
 			return fi.RequiredField("Name")
@@ -100,13 +135,20 @@ func (_ *Volume) CheckChanges(actual, expected, changes *Volume) error {
 			return fi.RequiredField("Zone")
 		}
 	}
+	if expected.IOPS != nil && fi.ValueOf(expected.Type) != string(instance.VolumeVolumeTypeSbsVolume) {
+		return fmt.Errorf("IOPS can only be set for volumes of type %s", instance.VolumeVolumeTypeSbsVolume)
+	}
 	return nil
 }
 
 func (_ *Volume) RenderScw(t *scaleway.ScwAPITarget, actual, expected, changes *Volume) error {
-	instanceService := t.Cloud.InstanceService()
 	zone := scw.Zone(fi.ValueOf(expected.Zone))
 
+	if fi.ValueOf(expected.Type) == string(instance.VolumeVolumeTypeSbsVolume) {
+		return renderBlockVolume(t.Cloud.BlockService(), zone, actual, expected)
+	}
+
+	instanceService := t.Cloud.InstanceService()
 	if actual != nil {
 		_, err := instanceService.UpdateVolume(&instance.UpdateVolumeRequest{
 			Zone:     zone,
@@ -135,21 +177,87 @@ func (_ *Volume) RenderScw(t *scaleway.ScwAPITarget, actual, expected, changes *
 	return nil
 }
 
+func renderBlockVolume(blockService *block.API, zone scw.Zone, actual, expected *Volume) error {
+	var iops *uint32
+	if expected.IOPS != nil {
+		iops = new(uint32(fi.ValueOf(expected.IOPS)))
+	}
+
+	if actual != nil {
+		_, err := blockService.UpdateVolume(&block.UpdateVolumeRequest{
+			Zone:     zone,
+			VolumeID: fi.ValueOf(actual.ID),
+			Name:     expected.Name,
+			Tags:     new(expected.Tags),
+			Size:     scw.SizePtr(scw.Size(fi.ValueOf(expected.Size))),
+			PerfIops: iops,
+		})
+		if err != nil {
+			return fmt.Errorf("updating block volume %s (%s): %w", *actual.Name, *actual.ID, err)
+		}
+
+	} else {
+		volume, err := blockService.CreateVolume(&block.CreateVolumeRequest{
+			Zone:     zone,
+			Name:     fi.ValueOf(expected.Name),
+			PerfIops: iops,
+			FromEmpty: &block.CreateVolumeRequestFromEmpty{
+				Size: scw.Size(fi.ValueOf(expected.Size)),
+			},
+			Tags: expected.Tags,
+		})
+		if err != nil {
+			return fmt.Errorf("rendering block volume: %w", err)
+		}
+		_, err = blockService.WaitForVolume(&block.WaitForVolumeRequest{
+			VolumeID: volume.ID,
+			Zone:     zone,
+		})
+		if err != nil {
+			return fmt.Errorf("waiting for block volume %s: %w", volume.ID, err)
+		}
+	}
+
+	return nil
+}
+
 type terraformVolume struct {
 	Name     *string  `cty:"name"`
 	SizeInGB *int     `cty:"size_in_gb"`
 	Type     *string  `cty:"type"`
 	Tags     []string `cty:"tags"`
 	Boot     *bool    `cty:"boot"`
+	Zone     *string  `cty:"zone"`
+}
+
+type terraformBlockVolume struct {
+	Name     *string  `cty:"name"`
+	SizeInGB *int     `cty:"size_in_gb"`
+	IOPS     *int32   `cty:"iops"`
+	Tags     []string `cty:"tags"`
+	Zone     *string  `cty:"zone"`
 }
 
 func (_ *Volume) RenderTerraform(t *terraform.TerraformTarget, actual, expected, changes *Volume) error {
 	tfName := strings.ReplaceAll(fi.ValueOf(expected.Name), ".", "-")
+
+	if fi.ValueOf(expected.Type) == string(instance.VolumeVolumeTypeSbsVolume) {
+		tf := &terraformBlockVolume{
+			Name:     expected.Name,
+			SizeInGB: new(int(fi.ValueOf(expected.Size) / 1e9)),
+			IOPS:     expected.IOPS,
+			Tags:     expected.Tags,
+			Zone:     expected.Zone,
+		}
+		return t.RenderResource("scaleway_block_volume", tfName, tf)
+	}
+
 	tf := &terraformVolume{
 		Name:     expected.Name,
 		SizeInGB: new(int(fi.ValueOf(expected.Size) / 1e9)),
 		Type:     expected.Type,
 		Tags:     expected.Tags,
+		Zone:     expected.Zone,
 	}
 
 	return t.RenderResource("scaleway_instance_volume", tfName, tf)
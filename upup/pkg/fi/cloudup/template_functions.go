@@ -36,14 +36,17 @@ import (
 	"net"
 	"os"
 	"path"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/Masterminds/sprig/v3"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	kopsroot "k8s.io/kops"
@@ -60,6 +63,7 @@ import (
 	"k8s.io/kops/pkg/model/components/kopscontroller"
 	"k8s.io/kops/pkg/model/gcemodel"
 	"k8s.io/kops/pkg/model/iam"
+	"k8s.io/kops/pkg/nodeidentity"
 	"k8s.io/kops/pkg/nodelabels"
 	"k8s.io/kops/pkg/resources/spotinst"
 	"k8s.io/kops/pkg/truncate"
@@ -78,6 +82,7 @@ import (
 	"k8s.io/kops/upup/pkg/fi/cloudup/scaleway/scalewaymetadata"
 	"k8s.io/kops/util/pkg/env"
 	"k8s.io/kops/util/pkg/maps"
+	"k8s.io/kops/util/pkg/vfs"
 	"sigs.k8s.io/yaml"
 )
 
@@ -220,6 +225,10 @@ func (tf *TemplateFunctions) AddTo(dest template.FuncMap, secretStore fi.SecretS
 
 	dest["KopsControllerArgv"] = tf.KopsControllerArgv
 	dest["KopsControllerConfig"] = tf.KopsControllerConfig
+	dest["InterruptionHandlerArgv"] = tf.InterruptionHandlerArgv
+	dest["InterruptionHandlerMetricsPort"] = func() int {
+		return wellknownports.KopsInterruptionHandlerMetrics
+	}
 	kopscontroller.AddTemplateFunctions(cluster, dest)
 	dest["DnsControllerArgv"] = tf.DNSControllerArgv
 	dest["ExternalDnsArgv"] = tf.ExternalDNSArgv
@@ -393,6 +402,12 @@ func (tf *TemplateFunctions) AddTo(dest template.FuncMap, secretStore fi.SecretS
 		}
 
 		dest["CiliumSecret"] = func() string { return ciliumsecretString }
+		dest["CiliumNativeRoutingCIDR"] = func() string {
+			if cluster.Spec.CloudProvider.DO != nil && cluster.Spec.CloudProvider.DO.VPCNativeRouting {
+				return cluster.Spec.Networking.PodCIDR
+			}
+			return ""
+		}
 	}
 
 	if cluster.Spec.Networking.Flannel != nil {
@@ -420,17 +435,23 @@ func (tf *TemplateFunctions) AddTo(dest template.FuncMap, secretStore fi.SecretS
 	dest["UseServiceAccountExternalPermissions"] = tf.UseServiceAccountExternalPermissions
 
 	if cluster.Spec.ClusterAutoscaler != nil {
-		dest["ClusterAutoscalerPriorities"] = func() string {
+		dest["ClusterAutoscalerPriorities"] = func() (string, error) {
 			priorities := make(map[string][]string)
 			if cluster.Spec.ClusterAutoscaler.CustomPriorityExpanderConfig != nil {
 				priorities = cluster.Spec.ClusterAutoscaler.CustomPriorityExpanderConfig
 			} else {
-				igNames := maps.SortedKeys(tf.GetNodeInstanceGroups())
-				for _, name := range igNames {
-					spec := tf.GetNodeInstanceGroups()[name]
-					if spec.Autoscale != nil {
-						priorities[strconv.Itoa(int(spec.AutoscalePriority))] = append(priorities[strconv.Itoa(int(spec.AutoscalePriority))], fmt.Sprintf("%s.%s", name, tf.ClusterName()))
+				instanceGroups := slices.Clone(tf.KopsModelContext.InstanceGroups)
+				sort.Slice(instanceGroups, func(i, j int) bool { return instanceGroups[i].Name < instanceGroups[j].Name })
+				for _, ig := range instanceGroups {
+					if !ig.Spec.Role.HasNode() || ig.Spec.Autoscale == nil {
+						continue
+					}
+					names, err := tf.clusterAutoscalerNodeGroupNames(ig)
+					if err != nil {
+						return "", err
 					}
+					priority := strconv.Itoa(int(ig.Spec.AutoscalePriority))
+					priorities[priority] = append(priorities[priority], names...)
 				}
 			}
 
@@ -441,7 +462,7 @@ func (tf *TemplateFunctions) AddTo(dest template.FuncMap, secretStore fi.SecretS
 					prioritiesStr = append(prioritiesStr, fmt.Sprintf("- %s", value))
 				}
 			}
-			return strings.Join(prioritiesStr, "\n")
+			return strings.Join(prioritiesStr, "\n"), nil
 		}
 		dest["CreateClusterAutoscalerPriorityConfig"] = func() bool {
 			return fi.ValueOf(cluster.Spec.ClusterAutoscaler.CreatePriorityExpenderConfig)
@@ -886,6 +907,48 @@ func (tf *TemplateFunctions) KopsControllerConfig() (string, error) {
 		}
 	}
 
+	if nodeIdentityLabels := cluster.Spec.NodeIdentityLabels; nodeIdentityLabels != nil {
+		config.NodeIdentityLabels = &nodeidentity.LabelOptions{
+			Metadata:  nodeIdentityLabels.Metadata,
+			CloudTags: nodeIdentityLabels.CloudTags,
+		}
+	}
+
+	config.SignKubeletServingCertificates = apiModel.UseKubeletServerTLSBootstrap(cluster)
+
+	if cluster.Spec.KopsController != nil {
+		config.TracesEndpoint = cluster.Spec.KopsController.TracesEndpoint
+	}
+
+	if cluster.Spec.KopsController != nil && fi.ValueOf(cluster.Spec.KopsController.EnforceAddons) {
+		configBase, err := vfs.Context.BuildVfsPath(cluster.Spec.ConfigStore.Base)
+		if err != nil {
+			return "", fmt.Errorf("parsing configStore.base %q: %w", cluster.Spec.ConfigStore.Base, err)
+		}
+		config.Addons = &kopscontrollerconfig.AddonsOptions{
+			Channel: configBase.Join("addons", "bootstrap-channel.yaml").Path(),
+		}
+	}
+
+	if cluster.Spec.KopsController != nil && cluster.Spec.KopsController.ContinuousApply != nil {
+		// The state store holds the cluster under its name
+		stateStore, found := strings.CutSuffix(strings.TrimSuffix(cluster.Spec.ConfigStore.Base, "/"), "/"+cluster.ObjectMeta.Name)
+		if !found {
+			return "", fmt.Errorf("continuous apply requires configStore.base %q to be the state store followed by the cluster name", cluster.Spec.ConfigStore.Base)
+		}
+		config.Apply = &kopscontrollerconfig.ApplyOptions{
+			StateStore: stateStore,
+			Interval:   metav1.Duration{Duration: cluster.Spec.KopsController.ContinuousApply.GetInterval()},
+		}
+	}
+
+	if cluster.GetCloudProvider() == kops.CloudProviderAWS && cluster.Spec.CloudProvider.AWS.GracefulNodeDrain.IsEnabled() {
+		config.NodeDrain = &kopscontrollerconfig.NodeDrainOptions{
+			Region:       tf.Region,
+			DrainTimeout: metav1.Duration{Duration: cluster.Spec.CloudProvider.AWS.GracefulNodeDrain.GetDrainTimeout()},
+		}
+	}
+
 	{
 		certNames := []string{"kubelet", "kubelet-server"}
 		signingCAs := []string{fi.CertificateIDCA}
@@ -899,19 +962,32 @@ func (tf *TemplateFunctions) KopsControllerConfig() (string, error) {
 		if cluster.Spec.Networking.KubeRouter != nil {
 			certNames = append(certNames, "kube-router")
 		}
+		requireClientCertificates := cluster.Spec.KopsController != nil && fi.ValueOf(cluster.Spec.KopsController.RequireClientCertificates)
+		if requireClientCertificates {
+			certNames = append(certNames, "kops-controller-client")
+		}
 
 		pkiDir := "/etc/kubernetes/kops-controller/pki"
 		config.Server = &kopscontrollerconfig.ServerOptions{
-			Listen:                fmt.Sprintf(":%d", wellknownports.KopsControllerPort),
-			ServerCertificatePath: path.Join(pkiDir, "kops-controller.crt"),
-			ServerKeyPath:         path.Join(pkiDir, "kops-controller.key"),
-			CABasePath:            pkiDir,
-			SigningCAs:            signingCAs,
-			CertNames:             certNames,
+			Listen:                    fmt.Sprintf(":%d", wellknownports.KopsControllerPort),
+			ServerCertificatePath:     path.Join(pkiDir, "kops-controller.crt"),
+			ServerKeyPath:             path.Join(pkiDir, "kops-controller.key"),
+			CABasePath:                pkiDir,
+			SigningCAs:                signingCAs,
+			CertNames:                 certNames,
+			RequireClientCertificates: requireClientCertificates,
+		}
+		if cluster.Spec.TLS != nil {
+			config.Server.TLSMinVersion = cluster.Spec.TLS.MinVersion
+			config.Server.TLSCipherSuites = cluster.Spec.TLS.CipherSuites
 		}
 
 		if featureflag.Metal.Enabled() {
 			config.Server.PKI = &pkibootstrap.Options{}
+			config.HostGC = &kopscontrollerconfig.HostGCOptions{
+				TTL:         metav1.Duration{Duration: 24 * time.Hour},
+				DeleteStale: featureflag.MetalDeleteStaleHosts.Enabled(),
+			}
 		}
 
 		switch cluster.GetCloudProvider() {
@@ -945,6 +1021,7 @@ func (tf *TemplateFunctions) KopsControllerConfig() (string, error) {
 				NodesRoles: nodesRoles.List(),
 				Region:     tf.Region,
 			}
+			config.Server.ScaleInstanceGroups = apiModel.UseInstanceGroupScaleAPI(cluster)
 
 		case kops.CloudProviderGCE:
 			c := tf.cloud.(gce.GCECloud)
@@ -957,16 +1034,25 @@ func (tf *TemplateFunctions) KopsControllerConfig() (string, error) {
 			}
 
 		case kops.CloudProviderHetzner:
-			config.Server.Provider.Hetzner = &hetzner.HetznerVerifierOptions{}
+			config.Server.Provider.Hetzner = &hetzner.HetznerVerifierOptions{
+				ClusterName:         tf.ClusterName(),
+				AllowUnsignedTokens: featureflag.AllowUnsignedBootstrapTokens.Enabled(),
+			}
 
 		case kops.CloudProviderOpenstack:
 			config.Server.Provider.OpenStack = &openstack.OpenStackVerifierOptions{}
 
 		case kops.CloudProviderDO:
-			config.Server.Provider.DigitalOcean = &do.DigitalOceanVerifierOptions{}
+			config.Server.Provider.DigitalOcean = &do.DigitalOceanVerifierOptions{
+				ClusterName:         tf.ClusterName(),
+				AllowUnsignedTokens: featureflag.AllowUnsignedBootstrapTokens.Enabled(),
+			}
 
 		case kops.CloudProviderScaleway:
-			config.Server.Provider.Scaleway = &scaleway.ScalewayVerifierOptions{}
+			config.Server.Provider.Scaleway = &scaleway.ScalewayVerifierOptions{
+				ClusterName:         tf.ClusterName(),
+				AllowUnsignedTokens: featureflag.AllowUnsignedBootstrapTokens.Enabled(),
+			}
 
 		case kops.CloudProviderAzure:
 			config.Server.Provider.Azure = &azure.AzureVerifierOptions{
@@ -1013,6 +1099,26 @@ func (tf *TemplateFunctions) KopsControllerArgv() ([]string, error) {
 	return argv, nil
 }
 
+// InterruptionHandlerArgv returns the args to kops-controller running as the interruption handler
+func (tf *TemplateFunctions) InterruptionHandlerArgv() ([]string, error) {
+	ih := tf.Cluster.Spec.InterruptionHandler
+
+	var argv []string
+
+	argv = append(argv, "--v=2")
+	argv = append(argv, "--interruption-handler")
+	argv = append(argv, "--interruption-handler-cloud="+string(tf.Cluster.GetCloudProvider()))
+	argv = append(argv, fmt.Sprintf("--interruption-handler-drain-maintenance-events=%t", fi.ValueOf(ih.DrainMaintenanceEvents)))
+	if ih.DrainTimeout != nil {
+		argv = append(argv, "--interruption-handler-drain-timeout="+ih.DrainTimeout.Duration.String())
+	}
+	if fi.ValueOf(ih.EnablePrometheusMetrics) {
+		argv = append(argv, fmt.Sprintf("--interruption-handler-metrics-listen=:%d", wellknownports.KopsInterruptionHandlerMetrics))
+	}
+
+	return argv, nil
+}
+
 func (tf *TemplateFunctions) ExternalDNSArgv() ([]string, error) {
 	cluster := tf.Cluster
 	externalDNS := tf.Cluster.Spec.ExternalDNS
@@ -1223,6 +1329,29 @@ func (tf *TemplateFunctions) GetClusterAutoscalerNodeGroups() (map[string]Cluste
 	return groups, nil
 }
 
+// clusterAutoscalerNodeGroupNames returns the names by which the cluster autoscaler knows the node groups of an instance group,
+// which the priority expander matches against.
+func (tf *TemplateFunctions) clusterAutoscalerNodeGroupNames(ig *kops.InstanceGroup) ([]string, error) {
+	cluster := tf.Cluster
+	switch cluster.GetCloudProvider() {
+	case kops.CloudProviderGCE:
+		// On GCE, the node groups are the zonal InstanceGroupManagers of the instance group
+		zones, err := apiModel.FindZonesForInstanceGroup(cluster, ig)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, zone := range zones {
+			names = append(names, gce.NameForInstanceGroupManager(cluster.ObjectMeta.Name, ig.ObjectMeta.Name, zone))
+		}
+		return names, nil
+	case kops.CloudProviderHetzner:
+		return []string{ig.Name}, nil
+	default:
+		return []string{ig.Name + "." + cluster.Name}, nil
+	}
+}
+
 // HCloudClusterConfig returns HCLOUD_CLUSTER_CONFIG as JSON.
 func (tf *TemplateFunctions) HCloudClusterConfig() (string, error) {
 	type hcloudNodeConfig struct {
@@ -36,6 +36,7 @@ const (
 	karpenterNodePoolAPIGroup  = "karpenter.sh"
 	karpenterNodePoolLabel     = "karpenter.sh/nodepool"
 	karpenterCapacityTypeLabel = "karpenter.sh/capacity-type"
+	karpenterInstanceFamilyKey = "karpenter.k8s.aws/instance-family"
 )
 
 type karpenterObjectMeta struct {
@@ -372,6 +373,14 @@ func (tf *TemplateFunctions) karpenterRequirements(ig *kops.InstanceGroup) []kar
 		},
 	}
 
+	if ig.Spec.Karpenter != nil && len(ig.Spec.Karpenter.Architectures) != 0 {
+		requirements = append(requirements, karpenterRequirement{
+			Key:      "kubernetes.io/arch",
+			Operator: "In",
+			Values:   ig.Spec.Karpenter.Architectures,
+		})
+	}
+
 	instanceTypes := karpenterInstanceTypes(ig)
 	if len(instanceTypes) != 0 {
 		requirements = append(requirements, karpenterRequirement{
@@ -381,6 +390,14 @@ func (tf *TemplateFunctions) karpenterRequirements(ig *kops.InstanceGroup) []kar
 		})
 	}
 
+	if ig.Spec.Karpenter != nil && len(ig.Spec.Karpenter.InstanceFamilies) != 0 {
+		requirements = append(requirements, karpenterRequirement{
+			Key:      karpenterInstanceFamilyKey,
+			Operator: "In",
+			Values:   ig.Spec.Karpenter.InstanceFamilies,
+		})
+	}
+
 	requirements = append(requirements, karpenterRequirement{
 		Key:      karpenterCapacityTypeLabel,
 		Operator: "In",
@@ -416,6 +433,9 @@ func karpenterInstanceTypes(ig *kops.InstanceGroup) []string {
 }
 
 func karpenterCapacityTypes(ig *kops.InstanceGroup) []string {
+	if ig.Spec.Karpenter != nil && len(ig.Spec.Karpenter.CapacityTypes) != 0 {
+		return ig.Spec.Karpenter.CapacityTypes
+	}
 	if ig.Spec.MaxPrice != nil || ig.Spec.SpotDurationInMinutes != nil {
 		return []string{"spot"}
 	}
@@ -232,6 +232,31 @@ func TestKarpenterCapacityTypes(t *testing.T) {
 	}
 }
 
+func TestKarpenterRequirements(t *testing.T) {
+	tf := &TemplateFunctions{}
+	ig := &kops.InstanceGroup{
+		Spec: kops.InstanceGroupSpec{
+			MaxPrice: new("0.10"),
+			Karpenter: &kops.KarpenterInstanceGroupSpec{
+				CapacityTypes:    []string{"on-demand", "spot"},
+				Architectures:    []string{"arm64"},
+				InstanceFamilies: []string{"m7g", "c7g"},
+			},
+		},
+	}
+	expected := []karpenterRequirement{
+		{Key: "kubernetes.io/os", Operator: "In", Values: []string{"linux"}},
+		{Key: "kubernetes.io/arch", Operator: "In", Values: []string{"arm64"}},
+		{Key: "karpenter.k8s.aws/instance-family", Operator: "In", Values: []string{"m7g", "c7g"}},
+		{Key: "karpenter.sh/capacity-type", Operator: "In", Values: []string{"on-demand", "spot"}},
+	}
+
+	actual := tf.karpenterRequirements(ig)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %#v, got %#v", expected, actual)
+	}
+}
+
 func TestBuildKarpenterKubeletConfiguration(t *testing.T) {
 	grid := []struct {
 		desc     string
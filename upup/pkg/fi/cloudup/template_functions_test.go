@@ -586,3 +586,55 @@ func TestGetClusterAutoscalerNodeGroupsGCE(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterAutoscalerNodeGroupNames(t *testing.T) {
+	ig := &kops.InstanceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "nodes"},
+		Spec: kops.InstanceGroupSpec{
+			Role:  kops.InstanceGroupRoleNode,
+			Zones: []string{"us-test1-a", "us-test1-b"},
+		},
+	}
+
+	grid := []struct {
+		desc          string
+		cloudProvider kops.CloudProviderSpec
+		expected      []string
+	}{
+		{
+			desc:          "AWS",
+			cloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			expected:      []string{"nodes.minimal.example.com"},
+		},
+		{
+			desc:          "GCE",
+			cloudProvider: kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+			expected:      []string{"a-nodes-minimal-example-com", "b-nodes-minimal-example-com"},
+		},
+		{
+			desc:          "Hetzner",
+			cloudProvider: kops.CloudProviderSpec{Hetzner: &kops.HetznerSpec{}},
+			expected:      []string{"nodes"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.desc, func(t *testing.T) {
+			tf := &TemplateFunctions{}
+			tf.Cluster = &kops.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "minimal.example.com"},
+				Spec: kops.ClusterSpec{
+					CloudProvider: g.cloudProvider,
+				},
+			}
+
+			actual, err := tf.clusterAutoscalerNodeGroupNames(ig)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(actual, g.expected) {
+				t.Errorf("expected %v, got %v", g.expected, actual)
+			}
+		})
+	}
+}
@@ -37,6 +37,9 @@ import (
 	"k8s.io/kops/upup/pkg/fi/cloudup/scaleway"
 )
 
+// CloudCache, if set, caches the results of expensive cloud lookups on disk, sharing them between kops commands.
+var CloudCache *fi.DiskCache
+
 func BuildCloud(cluster *kops.Cluster) (fi.Cloud, error) {
 	var cloud fi.Cloud
 	ctx := context.TODO()
@@ -225,6 +228,10 @@ func BuildCloud(cluster *kops.Cluster) (fi.Cloud, error) {
 	default:
 		return nil, fmt.Errorf("unknown CloudProvider %q", cluster.GetCloudProvider())
 	}
+	if hasDescribeCache, ok := cloud.(fi.HasDescribeCache); ok && CloudCache != nil && hasDescribeCache.DescribeCache() == nil {
+		hasDescribeCache.SetDescribeCache(fi.NewDescribeCache(CloudCache))
+	}
+
 	return cloud, nil
 }
 
@@ -23,6 +23,7 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"sync"
 
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/apis/kops"
@@ -35,8 +36,9 @@ type Context[T SubContext] struct {
 
 	Target Target[T]
 
-	tasks    map[string]Task[T]
-	warnings []*Warning[T]
+	tasks map[string]Task[T]
+	// warnings is shared with the copies of the context made for running each task
+	warnings *contextWarnings[T]
 
 	deletionProcessingMode DeletionProcessingMode
 
@@ -70,6 +72,18 @@ func (c *Context[T]) Context() context.Context {
 	return c.ctx
 }
 
+// withContext returns a copy of the context that uses ctx, so that the work done by a task is traced under the span of the task.
+func (c *Context[T]) withContext(ctx context.Context) *Context[T] {
+	taskContext := *c
+	taskContext.ctx = ctx
+	return &taskContext
+}
+
+type contextWarnings[T SubContext] struct {
+	mutex    sync.Mutex
+	warnings []*Warning[T]
+}
+
 // Warning holds the details of a warning encountered during validation/creation
 type Warning[T SubContext] struct {
 	Task    Task[T]
@@ -78,10 +92,11 @@ type Warning[T SubContext] struct {
 
 func newContext[T SubContext](ctx context.Context, deletionProcessingMode DeletionProcessingMode, target Target[T], sub T, tasks map[string]Task[T]) (*Context[T], error) {
 	c := &Context[T]{
-		ctx:    ctx,
-		Target: target,
-		tasks:  tasks,
-		T:      sub,
+		ctx:      ctx,
+		Target:   target,
+		tasks:    tasks,
+		warnings: &contextWarnings[T]{},
+		T:        sub,
 
 		deletionProcessingMode: deletionProcessingMode,
 	}
@@ -262,7 +277,9 @@ func (c *Context[T]) AddWarning(task Task[T], message string) {
 	}
 	// We don't actually do anything with these warnings yet, other than log them to glog below.
 	// In future we might produce a structured warning report.
-	c.warnings = append(c.warnings, warning)
+	c.warnings.mutex.Lock()
+	c.warnings.warnings = append(c.warnings.warnings, warning)
+	c.warnings.mutex.Unlock()
 	klog.Warningf("warning during task %s: %s", task, message)
 }
 
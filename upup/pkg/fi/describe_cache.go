@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// DescribeCache memoizes read-only cloud API calls during a single apply,
+// so that tasks looking up the same object (e.g. the image of several instance groups) make the call only once.
+// Concurrent lookups of the same key wait for the first one to complete.
+// If it has a DiskCache, persistent lookups are also shared with later kops commands, and expire after the TTL of the DiskCache.
+type DescribeCache struct {
+	disk *DiskCache
+
+	mutex   sync.Mutex
+	entries map[string]*describeCacheEntry
+}
+
+type describeCacheEntry struct {
+	done  chan struct{}
+	value any
+	err   error
+
+	// created is when the value was described, set once the lookup completed
+	created time.Time
+}
+
+// HasDescribeCache is implemented by clouds that can memoize their read-only API calls.
+type HasDescribeCache interface {
+	// DescribeCache returns the cache in use, or nil if calls are not cached.
+	DescribeCache() *DescribeCache
+	// SetDescribeCache sets the cache to use, or nil to stop caching.
+	SetDescribeCache(cache *DescribeCache)
+}
+
+// NewDescribeCache builds an empty DescribeCache, backed by disk if not nil.
+func NewDescribeCache(disk *DiskCache) *DescribeCache {
+	return &DescribeCache{
+		disk:    disk,
+		entries: make(map[string]*describeCacheEntry),
+	}
+}
+
+// CachedDescribe returns the result of describe, calling it only the first time key is looked up in cache.
+// Errors are not cached, so that a failed call is retried the next time. If cache is nil, describe is always called.
+func CachedDescribe[V any](cache *DescribeCache, key string, describe func() (V, error)) (V, error) {
+	return cachedDescribe(cache, key, false, describe)
+}
+
+// PersistentCachedDescribe is like CachedDescribe, but also caches the result on disk if the cache has a DiskCache.
+// It is meant for lookups of objects that kOps doesn't manage, and that rarely change, such as images.
+// V must round-trip through JSON.
+func PersistentCachedDescribe[V any](cache *DescribeCache, key string, describe func() (V, error)) (V, error) {
+	return cachedDescribe(cache, key, true, describe)
+}
+
+func cachedDescribe[V any](cache *DescribeCache, key string, persistent bool, describe func() (V, error)) (V, error) {
+	if cache == nil {
+		return describe()
+	}
+
+	for {
+		cache.mutex.Lock()
+		entry := cache.entries[key]
+		if entry != nil && !entry.created.IsZero() && cache.disk != nil && cache.disk.expired(entry.created) {
+			entry = nil
+		}
+		if entry == nil {
+			entry = &describeCacheEntry{done: make(chan struct{})}
+			cache.entries[key] = entry
+			cache.mutex.Unlock()
+
+			var value V
+			var err error
+			created, found := time.Time{}, false
+			if persistent && cache.disk != nil {
+				created, found = cache.disk.get(key, &value)
+			}
+			if !found {
+				created = cache.now()
+				value, err = describe()
+				if err == nil && persistent && cache.disk != nil {
+					cache.disk.put(key, created, value)
+				}
+			}
+
+			cache.mutex.Lock()
+			entry.value, entry.err, entry.created = value, err, created
+			if err != nil {
+				delete(cache.entries, key)
+			}
+			cache.mutex.Unlock()
+			close(entry.done)
+			return value, err
+		}
+		cache.mutex.Unlock()
+
+		<-entry.done
+		if entry.err != nil {
+			// The call failed; make our own attempt
+			continue
+		}
+		value, _ := entry.value.(V)
+		return value, nil
+	}
+}
+
+// now returns the current time, according to the clock of the DiskCache if any.
+func (c *DescribeCache) now() time.Time {
+	if c.disk != nil {
+		return c.disk.now()
+	}
+	return time.Now()
+}
+
+// DiskCache stores the results of cloud API calls on disk, so that they are shared by successive kops commands.
+type DiskCache struct {
+	dir string
+	ttl time.Duration
+
+	// now is the clock, replaced in tests
+	now func() time.Time
+}
+
+// diskCacheEntry is the content of a file in a DiskCache.
+type diskCacheEntry struct {
+	Key     string          `json:"key"`
+	Created time.Time       `json:"created"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// NewDiskCache builds a DiskCache storing results in dir, for ttl.
+func NewDiskCache(dir string, ttl time.Duration) *DiskCache {
+	return &DiskCache{
+		dir: dir,
+		ttl: ttl,
+		now: time.Now,
+	}
+}
+
+// expired returns true if a result created at the given time should no longer be used.
+func (c *DiskCache) expired(created time.Time) bool {
+	return c.now().Sub(created) > c.ttl
+}
+
+// path returns the path of the file storing the result for key.
+func (c *DiskCache) path(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(hash[:])+".json")
+}
+
+// get reads the result for key into value, returning when it was created and true if it was found and has not expired.
+// Errors are logged and treated as cache misses.
+func (c *DiskCache) get(key string, value any) (time.Time, bool) {
+	b, err := os.ReadFile(c.path(key))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			klog.Warningf("error reading cloud cache: %v", err)
+		}
+		return time.Time{}, false
+	}
+
+	entry := &diskCacheEntry{}
+	if err := json.Unmarshal(b, entry); err != nil {
+		klog.Warningf("error parsing cloud cache entry %q: %v", key, err)
+		return time.Time{}, false
+	}
+	if entry.Key != key || c.expired(entry.Created) {
+		return time.Time{}, false
+	}
+	if err := json.Unmarshal(entry.Value, value); err != nil {
+		klog.Warningf("error parsing cloud cache entry %q: %v", key, err)
+		return time.Time{}, false
+	}
+	klog.V(2).Infof("using cached result for %q from %s", key, entry.Created.Format(time.RFC3339))
+	return entry.Created, true
+}
+
+// put stores the result for key. Errors are logged, as the cache is only an optimization.
+func (c *DiskCache) put(key string, created time.Time, value any) {
+	if err := c.write(key, created, value); err != nil {
+		klog.Warningf("error writing cloud cache entry %q: %v", key, err)
+	}
+}
+
+func (c *DiskCache) write(key string, created time.Time, value any) error {
+	v, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(&diskCacheEntry{Key: key, Created: created, Value: v})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return err
+	}
+	// Write then rename, so that concurrent kops commands never read a partial file
+	f, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(f.Name(), c.path(key)); err != nil {
+		return fmt.Errorf("error renaming %s: %w", f.Name(), err)
+	}
+	return nil
+}
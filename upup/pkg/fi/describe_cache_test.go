@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCachedDescribe(t *testing.T) {
+	cache := NewDescribeCache(nil)
+
+	var mutex sync.Mutex
+	calls := 0
+	describe := func() (string, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		calls++
+		return "ami-12345678", nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := CachedDescribe(cache, "image", describe)
+			if err != nil || value != "ami-12345678" {
+				t.Errorf("unexpected result %q, %v", value, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+
+	// errors are not cached
+	failures := 0
+	fail := func() (string, error) {
+		failures++
+		return "", errors.New("throttled")
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := CachedDescribe(cache, "zones", fail); err == nil {
+			t.Errorf("expected error")
+		}
+	}
+	if failures != 2 {
+		t.Errorf("expected failed calls to be retried, got %d calls", failures)
+	}
+
+	// without a cache, every lookup makes the call
+	calls = 0
+	for i := 0; i < 2; i++ {
+		if _, err := CachedDescribe(nil, "image", describe); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls without a cache, got %d", calls)
+	}
+}
+
+func TestPersistentCachedDescribe(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	disk := NewDiskCache(t.TempDir(), 10*time.Minute)
+	disk.now = func() time.Time { return now }
+
+	type image struct {
+		ID string
+	}
+	calls := 0
+	describe := func() (*image, error) {
+		calls++
+		return &image{ID: fmt.Sprintf("ami-%d", calls)}, nil
+	}
+
+	lookup := func(cache *DescribeCache, persistent bool) string {
+		t.Helper()
+		var img *image
+		var err error
+		if persistent {
+			img, err = PersistentCachedDescribe(cache, "image", describe)
+		} else {
+			img, err = CachedDescribe(cache, "image", describe)
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return img.ID
+	}
+
+	// the first command describes the image, and a later command reads it from disk
+	if id := lookup(NewDescribeCache(disk), true); id != "ami-1" {
+		t.Errorf("expected ami-1, got %q", id)
+	}
+	if id := lookup(NewDescribeCache(disk), true); id != "ami-1" || calls != 1 {
+		t.Errorf("expected ami-1 from disk, got %q after %d calls", id, calls)
+	}
+
+	// lookups that are not persistent don't use the disk
+	if id := lookup(NewDescribeCache(disk), false); id != "ami-2" {
+		t.Errorf("expected ami-2, got %q", id)
+	}
+
+	// results expire, in memory and on disk
+	cache := NewDescribeCache(disk)
+	if id := lookup(cache, true); id != "ami-1" {
+		t.Errorf("expected ami-1, got %q", id)
+	}
+	now = now.Add(11 * time.Minute)
+	if id := lookup(cache, true); id != "ami-3" {
+		t.Errorf("expected expired result to be described again, got %q", id)
+	}
+	if id := lookup(NewDescribeCache(disk), true); id != "ami-3" {
+		t.Errorf("expected ami-3 from disk, got %q", id)
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/util/pkg/hashing"
+)
+
+// DefaultDownloadConcurrency is the number of assets downloaded at the same time by default.
+const DefaultDownloadConcurrency = 4
+
+// downloadAttempts is the number of consecutive attempts of a download that may fail without making progress.
+const downloadAttempts = 5
+
+// downloadRetryDelay is how long we wait before resuming an interrupted download.
+var downloadRetryDelay = 2 * time.Second
+
+// DownloadOptions configures how the AssetStore downloads assets.
+type DownloadOptions struct {
+	// Concurrency is the number of assets downloaded at the same time.
+	Concurrency int
+	// BandwidthLimit is the maximum total download rate of HTTP assets, in bytes per second.
+	// Zero means unlimited.
+	BandwidthLimit int64
+	// CacheDirectory is a shared cache that is checked for assets before they are downloaded.
+	// Downloaded assets are added to it.
+	CacheDirectory string
+}
+
+// newBandwidthLimiter returns a limiter shared by all downloads, or nil if bandwidth is unlimited.
+func newBandwidthLimiter(bytesPerSecond int64) *rate.Limiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	burst := int(min(bytesPerSecond, 256*1024))
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), burst)
+}
+
+// rateLimitedWriter throttles writes to the rate of the limiter.
+type rateLimitedWriter struct {
+	ctx     context.Context
+	w       io.Writer
+	limiter *rate.Limiter
+}
+
+func (w *rateLimitedWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), w.limiter.Burst())
+		if err := w.limiter.WaitN(w.ctx, n); err != nil {
+			return written, err
+		}
+		m, err := w.w.Write(p[:n])
+		written += m
+		if err != nil {
+			return written, err
+		}
+		p = p[n:]
+	}
+	return written, nil
+}
+
+// downloadURLResumable downloads the file at the given url to destPath, like DownloadURL.
+// HTTP downloads that are interrupted are resumed with a ranged request, and are written
+// at no more than the rate of limiter, if non-nil.
+func downloadURLResumable(ctx context.Context, url string, destPath string, hash *hashing.Hash, limiter *rate.Limiter) (*hashing.Hash, error) {
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return downloadURLToFile(ctx, url, destPath, hash)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(destPath), 0o755); err != nil {
+		return nil, fmt.Errorf("error creating directories for destination file %q: %v", destPath, err)
+	}
+
+	// The partial file is kept across attempts (and nodeup runs), so that we can resume from it.
+	partialPath := destPath + ".partial"
+
+	start := time.Now()
+	klog.V(2).Infof("Downloading %q", url)
+
+	failures := 0
+	for {
+		n, err := fetchURLToPartialFile(ctx, url, partialPath, limiter)
+		if err == nil {
+			break
+		}
+		if n == 0 {
+			failures++
+		} else {
+			failures = 0
+		}
+		if failures >= downloadAttempts || ctx.Err() != nil {
+			return nil, err
+		}
+		klog.Warningf("download of %q interrupted, will resume: %v", url, err)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(downloadRetryDelay):
+		}
+	}
+
+	klog.V(2).Infof("Downloading %q took %q", url, time.Since(start))
+
+	algorithm := hashing.HashAlgorithmSHA256
+	if hash != nil {
+		algorithm = hash.Algorithm
+	}
+	actual, err := algorithm.HashFile(partialPath)
+	if err != nil {
+		return nil, err
+	}
+	if hash != nil && !actual.Equal(hash) {
+		// Don't resume from corrupt data.
+		os.Remove(partialPath)
+		return nil, fmt.Errorf("downloaded from %q but hash did not match expected %q", url, hash)
+	}
+
+	if err := os.Chmod(partialPath, 0o644); err != nil {
+		return nil, fmt.Errorf("error setting mode on downloaded file %q: %v", partialPath, err)
+	}
+	if err := os.Rename(partialPath, destPath); err != nil {
+		return nil, fmt.Errorf("error moving downloaded file %q to %q: %v", partialPath, destPath, err)
+	}
+	return actual, nil
+}
+
+// fetchURLToPartialFile appends the remainder of url to partialPath, returning the number of bytes written.
+func fetchURLToPartialFile(ctx context.Context, url string, partialPath string, limiter *rate.Limiter) (int64, error) {
+	f, err := os.OpenFile(partialPath, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return 0, fmt.Errorf("error opening %q: %v", partialPath, err)
+	}
+	defer f.Close()
+
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, fmt.Errorf("error seeking in %q: %v", partialPath, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, downloadTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, fmt.Errorf("cannot create request: %v", err)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	response, err := newDownloadHTTPClient().Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error doing HTTP fetch of %q: %v", url, err)
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == http.StatusPartialContent && strings.HasPrefix(response.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", offset)):
+		klog.V(2).Infof("Resuming download of %q at %d bytes", url, offset)
+
+	case response.StatusCode == http.StatusPartialContent:
+		// Not the range we asked for; discard what we have and start again.
+		if err := f.Truncate(0); err != nil {
+			return 0, fmt.Errorf("error truncating %q: %v", partialPath, err)
+		}
+		return 0, fmt.Errorf("unexpected Content-Range %q from %q", response.Header.Get("Content-Range"), url)
+
+	case response.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		// We already have the whole file; the hash check will tell us if it is valid.
+		return 0, nil
+
+	case response.StatusCode >= 200 && response.StatusCode <= 299:
+		// The server sent the whole file, so start again from the beginning.
+		if offset > 0 {
+			klog.V(2).Infof("Server does not support resuming download of %q, restarting", url)
+			if err := f.Truncate(0); err != nil {
+				return 0, fmt.Errorf("error truncating %q: %v", partialPath, err)
+			}
+			if _, err := f.Seek(0, io.SeekStart); err != nil {
+				return 0, fmt.Errorf("error seeking in %q: %v", partialPath, err)
+			}
+		}
+
+	default:
+		return 0, fmt.Errorf("unexpected response from %q: HTTP %s", url, response.Status)
+	}
+
+	var w io.Writer = f
+	if limiter != nil {
+		w = &rateLimitedWriter{ctx: ctx, w: f, limiter: limiter}
+	}
+	n, err := io.Copy(w, response.Body)
+	if err != nil {
+		return n, fmt.Errorf("error downloading HTTP content from %q: %v", url, err)
+	}
+	if err := f.Close(); err != nil {
+		return n, fmt.Errorf("error writing %q: %v", partialPath, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8s.io/kops/util/pkg/hashing"
+)
+
+func TestDownloadURLResumable(t *testing.T) {
+	downloadRetryDelay = 0
+	defer func() { downloadRetryDelay = 2 * time.Second }()
+
+	body := bytes.Repeat([]byte("0123456789"), 10000)
+	var requests []string
+	var mutex sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		requests = append(requests, r.Header.Get("Range"))
+		first := len(requests) == 1
+		mutex.Unlock()
+
+		if first {
+			// Send half the file, then drop the connection.
+			w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+			_, _ = w.Write(body[:len(body)/2])
+			w.(http.Flusher).Flush()
+			panic(http.ErrAbortHandler)
+		}
+		http.ServeContent(w, r, "file", time.Time{}, bytes.NewReader(body))
+	}))
+	defer server.Close()
+
+	hash, err := hashing.HashAlgorithmSHA256.Hash(bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+
+	dest := filepath.Join(t.TempDir(), "download")
+	if _, err := downloadURLResumable(context.TODO(), server.URL, dest, hash, newBandwidthLimiter(10*1024*1024)); err != nil {
+		t.Fatalf("downloadURLResumable() error = %v", err)
+	}
+
+	actual, err := os.ReadFile(dest)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !bytes.Equal(actual, body) {
+		t.Fatalf("downloaded %d bytes, expected %d", len(actual), len(body))
+	}
+	if _, err := os.Stat(dest + ".partial"); !os.IsNotExist(err) {
+		t.Errorf("expected partial file to be removed, got %v", err)
+	}
+
+	expectedRequests := []string{"", fmt.Sprintf("bytes=%d-", len(body)/2)}
+	if fmt.Sprint(requests) != fmt.Sprint(expectedRequests) {
+		t.Errorf("requests = %q, expected %q", requests, expectedRequests)
+	}
+}
+
+func TestDownloadURLResumableRestartsWithoutRangeSupport(t *testing.T) {
+	body := []byte("payload")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	hash, err := hashing.HashAlgorithmSHA256.Hash(bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+
+	dest := filepath.Join(t.TempDir(), "download")
+	if err := os.WriteFile(dest+".partial", []byte("pay"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if _, err := downloadURLResumable(context.TODO(), server.URL, dest, hash, nil); err != nil {
+		t.Fatalf("downloadURLResumable() error = %v", err)
+	}
+
+	actual, err := os.ReadFile(dest)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !bytes.Equal(actual, body) {
+		t.Fatalf("downloaded %q, expected %q", actual, body)
+	}
+}
+
+func TestDownloadURLResumableRejectsHashMismatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("payload"))
+	}))
+	defer server.Close()
+
+	wrongHash, err := hashing.HashAlgorithmSHA256.Hash(bytes.NewReader([]byte("different")))
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+
+	dest := filepath.Join(t.TempDir(), "download")
+	if _, err := downloadURLResumable(context.TODO(), server.URL, dest, wrongHash, nil); err == nil {
+		t.Fatalf("downloadURLResumable() expected hash mismatch error")
+	}
+	if _, err := os.Stat(dest + ".partial"); !os.IsNotExist(err) {
+		t.Errorf("expected partial file to be removed, got %v", err)
+	}
+}
+
+func TestAssetStoreAddAll(t *testing.T) {
+	var running, maxRunning atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	defer server.Close()
+
+	var ids []string
+	for i := range 6 {
+		p := fmt.Sprintf("/asset-%d", i)
+		hash, err := hashing.HashAlgorithmSHA256.Hash(bytes.NewReader([]byte(p)))
+		if err != nil {
+			t.Fatalf("Hash() error = %v", err)
+		}
+		ids = append(ids, hash.Hex()+"@"+server.URL+p)
+	}
+
+	store := NewAssetStore(t.TempDir())
+	store.SetDownloadOptions(DownloadOptions{Concurrency: 2})
+	if err := store.AddAll(context.TODO(), ids); err != nil {
+		t.Fatalf("AddAll() error = %v", err)
+	}
+
+	if maxRunning.Load() != 2 {
+		t.Errorf("ran %d downloads at the same time, expected 2", maxRunning.Load())
+	}
+	if len(store.assets) != len(ids) {
+		t.Fatalf("added %d assets, expected %d", len(store.assets), len(ids))
+	}
+	for i, asset := range store.assets {
+		if expected := fmt.Sprintf("asset-%d", i); asset.Key != expected {
+			t.Errorf("asset %d has key %q, expected %q", i, asset.Key, expected)
+		}
+	}
+}
@@ -453,6 +453,11 @@ func (t *DryRunTarget[T]) Deletions() []string {
 	return deletions
 }
 
+// DeletionTasks returns the deletions which are going to be performed
+func (t *DryRunTarget[T]) DeletionTasks() []Deletion[T] {
+	return t.deletions
+}
+
 // Changes returns tasks which is going to be created or updated
 func (t *DryRunTarget[T]) Changes() (map[string]Task[T], map[string]Task[T]) {
 	creates := make(map[string]Task[T])
@@ -467,7 +472,108 @@ func (t *DryRunTarget[T]) Changes() (map[string]Task[T], map[string]Task[T]) {
 	return creates, updates
 }
 
+// ActualTasks returns the tasks as they currently exist, keyed like taskMap:
+// tasks that would be modified are replaced by their actual state, and tasks that would be created are omitted.
+func (t *DryRunTarget[T]) ActualTasks(taskMap map[string]Task[T]) map[string]Task[T] {
+	rendered := make(map[Task[T]]*render[T])
+	for _, r := range t.changes {
+		rendered[r.e] = r
+	}
+
+	actual := make(map[string]Task[T])
+	for k, e := range taskMap {
+		r := rendered[e]
+		switch {
+		case r == nil:
+			actual[k] = e
+		case !r.aIsNil:
+			actual[k] = r.a
+		}
+	}
+	return actual
+}
+
 // HasChanges returns true iff any changes would have been made
 func (t *DryRunTarget[T]) HasChanges() bool {
 	return len(t.changes)+len(t.deletions) != 0
 }
+
+const (
+	// DriftActionCreate is the action for resources that do not exist, and would be created.
+	DriftActionCreate = "Create"
+	// DriftActionModify is the action for resources that differ from the spec, and would be modified.
+	DriftActionModify = "Modify"
+	// DriftActionDelete is the action for resources that would be deleted.
+	DriftActionDelete = "Delete"
+	// DriftActionPrune is the action for resources that would be deleted only when pruning.
+	DriftActionPrune = "Prune"
+)
+
+// ResourceDrift describes a resource that differs from the spec.
+type ResourceDrift struct {
+	// Type is the type of the task that manages the resource.
+	Type string `json:"type"`
+	// Name is the name of the resource.
+	Name string `json:"name"`
+	// Action is the action that applying the spec would take on the resource.
+	Action string `json:"action"`
+	// Fields lists the fields that differ, for resources that would be modified.
+	Fields []FieldDrift `json:"fields,omitempty"`
+}
+
+// FieldDrift describes a field of a resource that differs from the spec.
+type FieldDrift struct {
+	// Name is the name of the field.
+	Name string `json:"name"`
+	// Description describes the difference between the actual and the expected value.
+	Description string `json:"description"`
+}
+
+// Drift returns the resources that would be created, modified or deleted, in a consistent order.
+func (t *DryRunTarget[T]) Drift(taskMap map[string]Task[T]) ([]*ResourceDrift, error) {
+	var drift []*ResourceDrift
+
+	for _, r := range t.changes {
+		d := &ResourceDrift{
+			Type: getTaskName(r.changes),
+			Name: idForTask(taskMap, r.e),
+		}
+		if r.aIsNil {
+			d.Action = DriftActionCreate
+		} else {
+			d.Action = DriftActionModify
+			changeList, err := buildChangeList(r.a, r.e, r.changes)
+			if err != nil {
+				return nil, err
+			}
+			for _, change := range changeList {
+				d.Fields = append(d.Fields, FieldDrift{
+					Name:        change.FieldName,
+					Description: strings.TrimSpace(change.Description),
+				})
+			}
+		}
+		drift = append(drift, d)
+	}
+
+	for _, deletion := range t.deletions {
+		d := &ResourceDrift{
+			Type:   deletion.TaskName(),
+			Name:   deletion.Item(),
+			Action: DriftActionDelete,
+		}
+		if deletion.DeferDeletion() {
+			d.Action = DriftActionPrune
+		}
+		drift = append(drift, d)
+	}
+
+	sort.SliceStable(drift, func(i, j int) bool {
+		if drift[i].Type != drift[j].Type {
+			return drift[i].Type < drift[j].Type
+		}
+		return drift[i].Name < drift[j].Name
+	})
+
+	return drift, nil
+}
@@ -92,3 +92,95 @@ func Test_DryrunTarget_PrintReport(t *testing.T) {
 	err = target.PrintReport(tasks, &out)
 	assert.NoError(t, err, "target.PrintReport()")
 }
+
+func Test_DryrunTarget_Drift(t *testing.T) {
+	builder := assets.NewAssetBuilder(vfs.Context, nil, false)
+	target := newDryRunTarget[CloudupSubContext](builder, true, &bytes.Buffer{})
+	tasks := map[string]CloudupTask{}
+
+	{
+		var a *testTask
+		e := &testTask{
+			Name:      new("created"),
+			Lifecycle: LifecycleSync,
+		}
+		changes := reflect.New(reflect.TypeOf(e).Elem()).Interface().(CloudupTask)
+		_ = BuildChanges(a, e, changes)
+		assert.NoError(t, target.Render(a, e, changes), "target.Render()")
+		tasks["testTask/"+*e.Name] = e
+	}
+	{
+		a := &testTask{
+			Name:      new("modified"),
+			Lifecycle: LifecycleSync,
+			Tags:      map[string]string{"key": "old"},
+		}
+		e := &testTask{
+			Name:      new("modified"),
+			Lifecycle: LifecycleSync,
+			Tags:      map[string]string{"key": "new"},
+		}
+		changes := reflect.New(reflect.TypeOf(e).Elem()).Interface().(CloudupTask)
+		_ = BuildChanges(a, e, changes)
+		assert.NoError(t, target.Render(a, e, changes), "target.Render()")
+		tasks["testTask/"+*e.Name] = e
+	}
+
+	drift, err := target.Drift(tasks)
+	assert.NoError(t, err, "target.Drift()")
+	assert.Equal(t, []*ResourceDrift{
+		{
+			Type:   "testTask",
+			Name:   "created",
+			Action: DriftActionCreate,
+		},
+		{
+			Type:   "testTask",
+			Name:   "modified",
+			Action: DriftActionModify,
+			Fields: []FieldDrift{
+				{Name: "Tags", Description: "{key: old} -> {key: new}"},
+			},
+		},
+	}, drift)
+}
+
+func Test_DryrunTarget_ActualTasks(t *testing.T) {
+	builder := assets.NewAssetBuilder(vfs.Context, nil, false)
+	target := newDryRunTarget[CloudupSubContext](builder, true, &bytes.Buffer{})
+
+	unchanged := &testTask{
+		Name:      new("unchanged"),
+		Lifecycle: LifecycleSync,
+	}
+	created := &testTask{
+		Name:      new("created"),
+		Lifecycle: LifecycleSync,
+	}
+	modifiedActual := &testTask{
+		Name:      new("modified"),
+		Lifecycle: LifecycleSync,
+		Tags:      map[string]string{"key": "old"},
+	}
+	modified := &testTask{
+		Name:      new("modified"),
+		Lifecycle: LifecycleSync,
+		Tags:      map[string]string{"key": "new"},
+	}
+	tasks := map[string]CloudupTask{
+		"testTask/unchanged": unchanged,
+		"testTask/created":   created,
+		"testTask/modified":  modified,
+	}
+
+	for _, r := range []struct{ a, e *testTask }{{nil, created}, {modifiedActual, modified}} {
+		changes := reflect.New(reflect.TypeOf(r.e).Elem()).Interface().(CloudupTask)
+		_ = BuildChanges(r.a, r.e, changes)
+		assert.NoError(t, target.Render(r.a, r.e, changes), "target.Render()")
+	}
+
+	assert.Equal(t, map[string]CloudupTask{
+		"testTask/unchanged": unchanged,
+		"testTask/modified":  modifiedActual,
+	}, target.ActualTasks(tasks))
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package fi
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 
 	"k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -37,3 +39,95 @@ func FieldIsImmutable(newVal, oldVal interface{}, fldPath *field.Path) *field.Er
 	details := fmt.Sprintf("%s: old=%v new=%v", validation.FieldImmutableErrorMsg, reflectutils.FormatValue(oldVal), reflectutils.FormatValue(newVal))
 	return field.Forbidden(fldPath, details)
 }
+
+// ErrorReason classifies an error, so that callers can branch on it instead of matching error strings.
+type ErrorReason string
+
+const (
+	// ErrorReasonAuth is used when the cloud rejected our credentials or their permissions
+	ErrorReasonAuth ErrorReason = "AuthFailure"
+	// ErrorReasonQuota is used when a cloud quota, limit or rate limit was exceeded
+	ErrorReasonQuota ErrorReason = "QuotaExceeded"
+	// ErrorReasonEventualConsistency is used when a resource is not yet visible or ready; retrying may succeed
+	ErrorReasonEventualConsistency ErrorReason = "EventualConsistency"
+	// ErrorReasonUserConfig is used when the cluster or instance group configuration is invalid
+	ErrorReasonUserConfig ErrorReason = "UserConfig"
+	// ErrorReasonStateStore is used when the state store could not be found, read or written
+	ErrorReasonStateStore ErrorReason = "StateStore"
+	// ErrorReasonTransient is used when the cloud failed a request because of a temporary problem on its side; retrying may succeed
+	ErrorReasonTransient ErrorReason = "TransientCloudError"
+)
+
+// ClassifiedError is an error with a reason and a hint on how to resolve it
+type ClassifiedError struct {
+	Reason ErrorReason
+	// Remediation is a hint for the user on how to resolve the error; it may be empty
+	Remediation string
+
+	err error
+}
+
+// NewClassifiedError wraps err with the given reason and remediation hint
+func NewClassifiedError(reason ErrorReason, err error, remediation string) *ClassifiedError {
+	return &ClassifiedError{
+		Reason:      reason,
+		Remediation: remediation,
+		err:         err,
+	}
+}
+
+// NewUserConfigError wraps err as a configuration error, which the user needs to fix in the cluster spec
+func NewUserConfigError(err error, remediation string) *ClassifiedError {
+	return NewClassifiedError(ErrorReasonUserConfig, err, remediation)
+}
+
+// NewStateStoreError wraps err as a state store error, which the user needs to fix in the --state flag or in the state store itself
+func NewStateStoreError(err error, remediation string) *ClassifiedError {
+	return NewClassifiedError(ErrorReasonStateStore, err, remediation)
+}
+
+func (e *ClassifiedError) Error() string { return e.err.Error() }
+
+func (e *ClassifiedError) Unwrap() error { return e.err }
+
+// ErrorClassifier classifies errors from a cloud provider's API, returning nil for errors it doesn't recognize
+type ErrorClassifier func(err error) *ClassifiedError
+
+var (
+	errorClassifiersMutex sync.Mutex
+	errorClassifiers      []ErrorClassifier
+)
+
+// RegisterErrorClassifier registers a classifier used by ClassifyError for errors that weren't explicitly classified
+func RegisterErrorClassifier(classifier ErrorClassifier) {
+	errorClassifiersMutex.Lock()
+	defer errorClassifiersMutex.Unlock()
+	errorClassifiers = append(errorClassifiers, classifier)
+}
+
+// ClassifyError returns the classification of the first classified error in err's chain,
+// falling back to the registered classifiers. It returns nil if the error is not recognized.
+func ClassifyError(err error) *ClassifiedError {
+	if err == nil {
+		return nil
+	}
+
+	var classified *ClassifiedError
+	if errors.As(err, &classified) {
+		return classified
+	}
+
+	var tryAgainLater *TryAgainLaterError
+	if errors.As(err, &tryAgainLater) {
+		return NewClassifiedError(ErrorReasonEventualConsistency, err, "Wait a few minutes and run the command again.")
+	}
+
+	errorClassifiersMutex.Lock()
+	defer errorClassifiersMutex.Unlock()
+	for _, classifier := range errorClassifiers {
+		if classified := classifier(err); classified != nil {
+			return classified
+		}
+	}
+	return nil
+}
@@ -18,12 +18,13 @@ package fi
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
-	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/klog/v2"
 )
 
@@ -35,16 +36,33 @@ type executor[T SubContext] struct {
 
 type taskState[T SubContext] struct {
 	done         bool
+	running      bool
 	key          string
 	task         Task[T]
 	deadline     time.Time
 	lastError    error
 	dependencies []*taskState[T]
+
+	// failedAt is how many tasks were done when the task last failed, or -1 if it can run.
+	// A failed task is retried once another task is done, or after waiting if no other task makes progress.
+	failedAt int
+}
+
+type taskResult[T SubContext] struct {
+	ts  *taskState[T]
+	err error
 }
 
 type RunTasksOptions struct {
 	MaxTaskDuration         time.Duration
 	WaitAfterAllTasksFailed time.Duration
+
+	// MaxConcurrentTasks limits how many tasks run at the same time, to stay within the rate limits of cloud APIs.
+	// Zero means no limit.
+	MaxConcurrentTasks int
+
+	// Timings, if not nil, records how long each task took to run.
+	Timings *TaskTimings
 }
 
 func (o *RunTasksOptions) InitDefaults() {
@@ -52,7 +70,8 @@ func (o *RunTasksOptions) InitDefaults() {
 	o.WaitAfterAllTasksFailed = 10 * time.Second
 }
 
-// RunTasks executes all the tasks, considering their dependencies
+// RunTasks executes all the tasks, considering their dependencies.
+// Each task starts as soon as its dependencies are done, so independent tasks run concurrently.
 // It will perform some re-execution on error, retrying as long as progress is still being made
 func (e *executor[T]) RunTasks(ctx context.Context, taskMap map[string]Task[T]) error {
 	dependencies := FindTaskDependencies(taskMap)
@@ -69,8 +88,9 @@ func (e *executor[T]) RunTasks(ctx context.Context, taskMap map[string]Task[T])
 
 	for k, task := range taskMap {
 		ts := &taskState[T]{
-			key:  k,
-			task: task,
+			key:      k,
+			task:     task,
+			failedAt: -1,
 		}
 		taskStates[k] = ts
 	}
@@ -85,12 +105,26 @@ func (e *executor[T]) RunTasks(ctx context.Context, taskMap map[string]Task[T])
 		}
 	}
 
+	results := make(chan taskResult[T], len(taskStates))
+	running := 0
+	// Wait for the running tasks before returning, so that no task is still changing the cloud
+	defer func() {
+		for ; running > 0; running-- {
+			<-results
+		}
+	}()
+
+	doneCount := 0
+	var lastLogged time.Time
 	for {
-		var canRun []*taskState[T]
-		doneCount := 0
+		// Stop as soon as the command is interrupted or times out, rather than retrying tasks that can no longer succeed
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("stopped running tasks: %w", context.Cause(ctx))
+		}
+
+		waiting := 0
 		for _, ts := range taskStates {
-			if ts.done {
-				doneCount++
+			if ts.done || ts.running {
 				continue
 			}
 			ready := true
@@ -100,79 +134,77 @@ func (e *executor[T]) RunTasks(ctx context.Context, taskMap map[string]Task[T])
 					break
 				}
 			}
-			if ready {
-				if ts.deadline.IsZero() {
-					ts.deadline = time.Now().Add(e.options.MaxTaskDuration)
-				} else if time.Now().After(ts.deadline) {
-					return fmt.Errorf("deadline exceeded executing task %v. Example error: %v", ts.key, ts.lastError)
-				}
-				canRun = append(canRun, ts)
+			if !ready {
+				continue
+			}
+			if ts.failedAt == doneCount {
+				waiting++
+				continue
 			}
+			if e.options.MaxConcurrentTasks > 0 && running >= e.options.MaxConcurrentTasks {
+				continue
+			}
+
+			if ts.deadline.IsZero() {
+				ts.deadline = time.Now().Add(e.options.MaxTaskDuration)
+			} else if time.Now().After(ts.deadline) {
+				return fmt.Errorf("deadline exceeded executing task %v. Example error: %w", ts.key, ts.lastError)
+			}
+			ts.running = true
+			running++
+			go func(ts *taskState[T]) {
+				results <- taskResult[T]{ts: ts, err: e.runTask(ctx, ts)}
+			}(ts)
 		}
 
-		klog.Infof("Tasks: %d done / %d total; %d can run", doneCount, len(taskStates), len(canRun))
-		if len(canRun) == 0 {
-			break
+		if time.Since(lastLogged) >= 10*time.Second || running == 0 {
+			klog.Infof("Tasks: %d done / %d total; %d running", doneCount, len(taskStates), running)
+			lastLogged = time.Now()
 		}
 
-		progress := false
-
-		var tasks []*taskState[T]
-		tasks = append(tasks, canRun...)
-
-		taskErrors := e.forkJoin(ctx, tasks)
-		var errs []error
-		for i, err := range taskErrors {
-			ts := tasks[i]
-			if err != nil {
-				//  print warning message and continue like the task succeeded
-				if _, ok := err.(*ExistsAndWarnIfChangesError); ok {
-					klog.Warning(err.Error())
-					ts.done = true
-					ts.lastError = nil
-					progress = true
-					continue
-				}
+		if running == 0 {
+			if waiting == 0 {
+				break
+			}
 
-				remaining := time.Second * time.Duration(int(time.Until(ts.deadline).Seconds()))
-				if _, ok := err.(*TryAgainLaterError); ok {
-					klog.V(2).Infof("Task %q not ready: %v", ts.key, err)
-				} else {
-					klog.Warningf("error running task %q (%v remaining to succeed): %v", ts.key, remaining, err)
-				}
-				errs = append(errs, err)
-				ts.lastError = err
-			} else {
-				ts.done = true
-				ts.lastError = nil
-				progress = true
+			klog.Infof("No progress made, sleeping before retrying %d task(s)", waiting)
+			select {
+			case <-ctx.Done():
+			case <-time.After(e.options.WaitAfterAllTasksFailed):
+			}
+			for _, ts := range taskStates {
+				ts.failedAt = -1
 			}
+			continue
 		}
 
-		if !progress {
-			n := len(errs)
+		result := <-results
+		running--
 
-			if n == 0 {
-				// Logic error!
-				panic("did not make progress executing tasks; but no errors reported")
+		ts := result.ts
+		ts.running = false
+		if err := result.err; err != nil {
+			//  print warning message and continue like the task succeeded
+			if _, ok := err.(*ExistsAndWarnIfChangesError); ok {
+				klog.Warning(err.Error())
+				ts.done = true
+				ts.lastError = nil
+				doneCount++
+				continue
 			}
 
-			tryAgainLaterCount := 0
-			for _, err := range errs {
-				var tryAgainLaterError TryAgainLaterError
-				if !errors.Is(err, &tryAgainLaterError) {
-					tryAgainLaterCount++
-				}
-			}
-			formatTaskCount := func(n int) string {
-				return fmt.Sprintf("%d task(s)", n)
-			}
-			if tryAgainLaterCount == n {
-				klog.Infof("Continuing to run %s", formatTaskCount(tryAgainLaterCount))
+			remaining := time.Second * time.Duration(int(time.Until(ts.deadline).Seconds()))
+			if _, ok := err.(*TryAgainLaterError); ok {
+				klog.V(2).Infof("Task %q not ready: %v", ts.key, err)
 			} else {
-				klog.Infof("No progress made, sleeping before retrying %s", formatTaskCount(n))
+				klog.Warningf("error running task %q (%v remaining to succeed): %v", ts.key, remaining, err)
 			}
-			time.Sleep(e.options.WaitAfterAllTasksFailed)
+			ts.lastError = err
+			ts.failedAt = doneCount
+		} else {
+			ts.done = true
+			ts.lastError = nil
+			doneCount++
 		}
 	}
 
@@ -190,45 +222,32 @@ func (e *executor[T]) RunTasks(ctx context.Context, taskMap map[string]Task[T])
 	return nil
 }
 
-func (e *executor[T]) forkJoin(ctx context.Context, tasks []*taskState[T]) []error {
-	if len(tasks) == 0 {
-		return nil
-	}
-
-	results := make([]error, len(tasks))
-	var resultsMutex sync.Mutex
-
-	var wg sync.WaitGroup
-	for i := 0; i < len(tasks); i++ {
-		wg.Add(1)
-		go func(ts *taskState[T], index int) {
-			defer wg.Done()
-
-			_, span := tracer.Start(ctx, "task-"+ts.key)
-			defer span.End()
-
-			resultsMutex.Lock()
-			results[index] = fmt.Errorf("function panic")
-			resultsMutex.Unlock()
-
-			klog.V(2).Infof("Executing task %q: %v\n", ts.key, ts.task)
-
-			if taskNormalize, ok := ts.task.(TaskNormalize[T]); ok {
-				if err := taskNormalize.Normalize(e.context); err != nil {
-					results[index] = err
-					return
-				}
-			}
+// runTask runs a single task, normalizing it first if needed.
+func (e *executor[T]) runTask(ctx context.Context, ts *taskState[T]) (err error) {
+	ctx, span := tracer.Start(ctx, "task-"+ts.key, trace.WithAttributes(attribute.String("task.type", fmt.Sprintf("%T", ts.task))))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
 
-			result := ts.task.Run(e.context)
+	klog.V(2).Infof("Executing task %q: %v\n", ts.key, ts.task)
+	taskContext := e.context.withContext(ctx)
 
-			resultsMutex.Lock()
-			results[index] = result
-			resultsMutex.Unlock()
-		}(tasks[i], i)
+	if timings := e.options.Timings; timings != nil {
+		start := time.Now()
+		defer func() {
+			timings.record(ts.key, start, time.Now(), err)
+		}()
 	}
 
-	wg.Wait()
+	if taskNormalize, ok := ts.task.(TaskNormalize[T]); ok {
+		if err := taskNormalize.Normalize(taskContext); err != nil {
+			return err
+		}
+	}
 
-	return results
+	return ts.task.Run(taskContext)
 }
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// cancellingTask cancels the command context while it runs, as Ctrl-C would, and then fails.
+type cancellingTask struct {
+	cancel context.CancelFunc
+	runs   int
+}
+
+func (t *cancellingTask) Run(c *InstallContext) error {
+	t.runs++
+	t.cancel()
+	return errors.New("interrupted")
+}
+
+func TestRunTasksStopsWhenContextIsCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	task := &cancellingTask{cancel: cancel}
+	tasks := map[string]InstallTask{"task": task}
+	c := &InstallContext{ctx: ctx, tasks: tasks}
+
+	// The task would otherwise be retried for an hour
+	options := RunTasksOptions{
+		MaxTaskDuration:         time.Hour,
+		WaitAfterAllTasksFailed: time.Hour,
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.RunTasks(options)
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected error to wrap context.Canceled, got %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("RunTasks did not stop after the context was cancelled")
+	}
+
+	if task.runs != 1 {
+		t.Errorf("expected the task to run once, ran %d times", task.runs)
+	}
+}
+
+// concurrencyTask records the maximum number of tasks running at the same time.
+type concurrencyTask struct {
+	mutex   *sync.Mutex
+	current *int
+	max     *int
+}
+
+func (t *concurrencyTask) Run(c *InstallContext) error {
+	t.mutex.Lock()
+	*t.current++
+	if *t.current > *t.max {
+		*t.max = *t.current
+	}
+	t.mutex.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	t.mutex.Lock()
+	*t.current--
+	t.mutex.Unlock()
+	return nil
+}
+
+func TestRunTasksLimitsConcurrency(t *testing.T) {
+	var mutex sync.Mutex
+	current, maxRunning := 0, 0
+
+	tasks := make(map[string]InstallTask)
+	for i := 0; i < 10; i++ {
+		tasks[fmt.Sprintf("task-%d", i)] = &concurrencyTask{mutex: &mutex, current: &current, max: &maxRunning}
+	}
+	c := &InstallContext{ctx: context.Background(), tasks: tasks}
+
+	options := RunTasksOptions{}
+	options.InitDefaults()
+	options.MaxConcurrentTasks = 3
+	if err := c.RunTasks(options); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if maxRunning > 3 {
+		t.Errorf("expected at most 3 tasks to run concurrently, got %d", maxRunning)
+	}
+}
+
+// channelTask closes start when it runs, then waits for wait to be closed, if set.
+type channelTask struct {
+	graphTask
+	start chan struct{}
+	wait  chan struct{}
+}
+
+func (t *channelTask) Run(c *InstallContext) error {
+	if t.start != nil {
+		close(t.start)
+	}
+	if t.wait != nil {
+		<-t.wait
+	}
+	return nil
+}
+
+func TestRunTasksStartsTasksOnceDependenciesAreDone(t *testing.T) {
+	dependentStarted := make(chan struct{})
+
+	// The dependent task must start while the slow task, which does not depend on anything, is still running
+	tasks := map[string]InstallTask{
+		"slow":      &channelTask{wait: dependentStarted},
+		"fast":      &channelTask{},
+		"dependent": &channelTask{graphTask: graphTask{dependencies: []string{"fast"}}, start: dependentStarted},
+	}
+	c := &InstallContext{ctx: context.Background(), tasks: tasks}
+
+	options := RunTasksOptions{}
+	options.InitDefaults()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.RunTasks(options)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("dependent task did not start while an unrelated task was running")
+	}
+}
@@ -38,8 +38,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 	"k8s.io/klog/v2"
+	"k8s.io/kops"
 	"k8s.io/kops/nodeup/pkg/model"
 	"k8s.io/kops/nodeup/pkg/model/networking"
 	api "k8s.io/kops/pkg/apis/kops"
@@ -51,6 +56,7 @@ import (
 	"k8s.io/kops/pkg/bootstrap/pkibootstrap"
 	"k8s.io/kops/pkg/configserver"
 	"k8s.io/kops/pkg/kopscontrollerclient"
+	"k8s.io/kops/pkg/otel/otelsetup"
 	"k8s.io/kops/pkg/wellknownports"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/azure/azuremetadata"
@@ -73,6 +79,8 @@ import (
 // MaxTaskDuration is the amount of time to keep trying for; we retry for a long time - there is not really any great fallback
 const MaxTaskDuration = 365 * 24 * time.Hour
 
+var tracer = otel.Tracer("k8s.io/kops/upup/pkg/fi/nodeup")
+
 // NodeUpCommand is the configuration for nodeup
 type NodeUpCommand struct {
 	CacheDir       string
@@ -81,7 +89,7 @@ type NodeUpCommand struct {
 }
 
 // Run is responsible for perform the nodeup process
-func (c *NodeUpCommand) Run(out io.Writer) error {
+func (c *NodeUpCommand) Run(out io.Writer) (err error) {
 	ctx := context.Background()
 
 	var bootConfig nodeup.BootConfig
@@ -99,6 +107,31 @@ func (c *NodeUpCommand) Run(out io.Writer) error {
 		return fmt.Errorf("ConfigLocation is required")
 	}
 
+	if bootConfig.TracesEndpoint != "" {
+		// Tracing is best effort; nodeup must not fail because the collector is unreachable
+		otelShutdown, err := otelsetup.SetupOTelSDK(ctx, "nodeup", kops.Version, bootConfig.TracesEndpoint)
+		if err != nil {
+			klog.Warningf("error setting up OpenTelemetry: %v", err)
+		} else {
+			defer func() {
+				if err := otelShutdown(context.Background()); err != nil {
+					klog.Warningf("error shutting down OpenTelemetry: %v", err)
+				}
+			}()
+		}
+	}
+	ctx, span := tracer.Start(ctx, "nodeup", trace.WithAttributes(
+		attribute.String("kops.cluster_name", bootConfig.ClusterName),
+		attribute.String("kops.instance_group", bootConfig.InstanceGroupName),
+	))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	if c.CacheDir == "" {
 		return fmt.Errorf("CacheDir is required")
 	}
@@ -180,11 +213,19 @@ func (c *NodeUpCommand) Run(out io.Writer) error {
 
 	configAssets := nodeupConfig.Assets[architecture]
 	assetStore := fi.NewAssetStore(c.CacheDir)
-	for _, asset := range configAssets {
-		err := assetStore.Add(ctx, asset)
-		if err != nil {
-			return fmt.Errorf("error adding asset %q: %v", asset, err)
+	if downloads := nodeupConfig.AssetDownloads; downloads != nil {
+		options := fi.DownloadOptions{}
+		if downloads.Concurrency != nil {
+			options.Concurrency = int(*downloads.Concurrency)
+		}
+		if downloads.BandwidthLimit != nil {
+			options.BandwidthLimit = downloads.BandwidthLimit.Value()
 		}
+		options.CacheDirectory = downloads.CacheDirectory
+		assetStore.SetDownloadOptions(options)
+	}
+	if err := assetStore.AddAll(ctx, configAssets); err != nil {
+		return err
 	}
 
 	// cloud holds the AWS clients, on AWS only.
@@ -299,6 +340,7 @@ func (c *NodeUpCommand) Run(out io.Writer) error {
 	loader.Builders = append(loader.Builders, &model.UpdateServiceBuilder{NodeupModelContext: modelContext})
 	loader.Builders = append(loader.Builders, &model.VolumesBuilder{NodeupModelContext: modelContext})
 	loader.Builders = append(loader.Builders, &model.ContainerdBuilder{NodeupModelContext: modelContext})
+	loader.Builders = append(loader.Builders, &model.CRIOBuilder{NodeupModelContext: modelContext})
 	loader.Builders = append(loader.Builders, &model.ProtokubeBuilder{NodeupModelContext: modelContext})
 	loader.Builders = append(loader.Builders, &model.ChannelsBuilder{NodeupModelContext: modelContext})
 	loader.Builders = append(loader.Builders, &model.CloudConfigBuilder{NodeupModelContext: modelContext})
@@ -314,6 +356,8 @@ func (c *NodeUpCommand) Run(out io.Writer) error {
 	loader.Builders = append(loader.Builders, &model.SecretBuilder{NodeupModelContext: modelContext})
 	loader.Builders = append(loader.Builders, &model.FirewallBuilder{NodeupModelContext: modelContext})
 	loader.Builders = append(loader.Builders, &model.SysctlBuilder{NodeupModelContext: modelContext})
+	loader.Builders = append(loader.Builders, &model.SELinuxBuilder{NodeupModelContext: modelContext})
+	loader.Builders = append(loader.Builders, &model.FIPSBuilder{NodeupModelContext: modelContext})
 	loader.Builders = append(loader.Builders, &model.KubeAPIServerBuilder{NodeupModelContext: modelContext})
 	loader.Builders = append(loader.Builders, &model.KubeControllerManagerBuilder{NodeupModelContext: modelContext})
 	loader.Builders = append(loader.Builders, &model.KubeSchedulerBuilder{NodeupModelContext: modelContext})
@@ -336,6 +380,8 @@ func (c *NodeUpCommand) Run(out io.Writer) error {
 	loader.Builders = append(loader.Builders, &networking.KuberouterBuilder{NodeupModelContext: modelContext})
 
 	loader.Builders = append(loader.Builders, &model.BootstrapClientBuilder{NodeupModelContext: modelContext})
+	// HardeningBuilder restricts the permissions of files created by the other builders, so it must run last.
+	loader.Builders = append(loader.Builders, &model.HardeningBuilder{NodeupModelContext: modelContext})
 	taskMap, err := loader.Build()
 	if err != nil {
 		return fmt.Errorf("error building loader: %v", err)
@@ -384,6 +430,9 @@ func (c *NodeUpCommand) Run(out io.Writer) error {
 
 	err = context.RunTasks(options)
 	if err != nil {
+		if classified := fi.ClassifyError(err); classified != nil && classified.Remediation != "" {
+			klog.Errorf("%s: %s", classified.Reason, classified.Remediation)
+		}
 		klog.Exitf("error running tasks: %v", err)
 	}
 
@@ -400,6 +449,16 @@ func (c *NodeUpCommand) Run(out io.Writer) error {
 			}
 		}
 	}
+
+	// Instances in a running or hibernated warm pool do not reboot when they enter the ASG,
+	// so we wait here and complete the configuration once they do.
+	if modelContext.ConfigurationMode == model.ConfigurationModeWarming && nodeupConfig.WarmPoolState != "" {
+		klog.Infof("warm pool instances are kept %s, waiting for instance to enter the ASG", nodeupConfig.WarmPoolState)
+		if err := waitForAWSInService(ctx); err != nil {
+			return err
+		}
+		return c.Run(out)
+	}
 	return nil
 }
 
@@ -684,7 +743,7 @@ func getNodeConfigFromServers(ctx context.Context, bootConfig *nodeup.BootConfig
 		authenticator = a
 
 	case "metal":
-		a, err := pkibootstrap.NewAuthenticatorFromFile("/etc/kubernetes/kops/pki/machine/private.pem")
+		a, err := pkibootstrap.NewAuthenticatorFromFile(pkibootstrap.MachineKeyPath)
 		if err != nil {
 			return nil, err
 		}
@@ -718,6 +777,8 @@ func getNodeConfigFromServers(ctx context.Context, bootConfig *nodeup.BootConfig
 
 	// Note: The url is overridden in every iteration of the loop below.
 	client := kopscontrollerclient.NewWithTLSServerName(authenticator, []byte(bootConfig.ConfigServer.CACertificates), url.URL{}, bootConfig.ConfigServer.TLSServerName)
+	client.PinCAs(bootConfig.ConfigServer.CAPins)
+	client.UseClientCertificate(kopscontrollerclient.ClientCertificatePath, kopscontrollerclient.ClientKeyPath)
 	defer client.Close()
 
 	var merr error
@@ -749,6 +810,40 @@ func getNodeConfigFromServers(ctx context.Context, bootConfig *nodeup.BootConfig
 	return nil, merr
 }
 
+// getAWSTargetLifecycleState returns the ASG lifecycle state the instance is transitioning to,
+// or an empty string if the instance is not part of an ASG.
+func getAWSTargetLifecycleState() (string, error) {
+	targetLifecycleState, err := vfs.Context.ReadFile("metadata://aws/meta-data/autoscaling/target-lifecycle-state")
+	if err != nil {
+		var awsErr *awshttp.ResponseError
+		if errors.As(err, &awsErr) && awsErr.HTTPStatusCode() == http.StatusNotFound {
+			return "", nil
+		}
+		return "", fmt.Errorf("error reading target-lifecycle-state from instance metadata: %v", err)
+	}
+	return string(targetLifecycleState), nil
+}
+
+// waitForAWSInService blocks until the instance leaves the warm pool.
+// A hibernated instance is suspended while waiting and resumes polling when it is started.
+func waitForAWSInService(ctx context.Context) error {
+	for {
+		targetLifecycleState, err := getAWSTargetLifecycleState()
+		if err != nil {
+			klog.Warningf("%v", err)
+		} else if !strings.HasPrefix(targetLifecycleState, "Warmed:") {
+			klog.Infof("instance is entering the ASG (%s)", targetLifecycleState)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Second):
+		}
+	}
+}
+
 func getAWSConfigurationMode(ctx context.Context, c *model.NodeupModelContext) (string, error) {
 	// Check if WarmPool is enabled first, to avoid additional API calls
 	if len(c.NodeupConfig.WarmPoolImages) == 0 {
@@ -762,16 +857,12 @@ func getAWSConfigurationMode(ctx context.Context, c *model.NodeupModelContext) (
 		return "", nil
 	}
 
-	targetLifecycleState, err := vfs.Context.ReadFile("metadata://aws/meta-data/autoscaling/target-lifecycle-state")
+	targetLifecycleState, err := getAWSTargetLifecycleState()
 	if err != nil {
-		var awsErr *awshttp.ResponseError
-		if errors.As(err, &awsErr) && awsErr.HTTPStatusCode() == http.StatusNotFound {
-			return "", nil
-		}
-		return "", fmt.Errorf("error reading target-lifecycle-state from instance metadata: %v", err)
+		return "", err
 	}
 
-	if strings.HasPrefix(string(targetLifecycleState), "Warmed:") {
+	if strings.HasPrefix(targetLifecycleState, "Warmed:") {
 		klog.Info("instance is entering warm pool")
 		return model.ConfigurationModeWarming, nil
 	} else {
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetasks
+
+import (
+	"debug/buildinfo"
+	"errors"
+	"fmt"
+	"go/version"
+	"os"
+	"slices"
+	"strings"
+
+	"k8s.io/klog/v2"
+
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/local"
+)
+
+const kernelFIPSEnabledPath = "/proc/sys/crypto/fips_enabled"
+
+// minFIPSGoVersion is the first Go release that includes the FIPS 140-3 module.
+const minFIPSGoVersion = "go1.24"
+
+// fipsGoExperiments are the GOEXPERIMENTs that link Go binaries against an external FIPS validated library.
+var fipsGoExperiments = []string{"boringcrypto", "systemcrypto", "opensslcrypto", "cngcrypto"}
+
+// GoFIPSCheck verifies that Go binaries installed on the node can restrict themselves to FIPS 140-3 approved cryptography.
+// Services are not started until the binaries are verified.
+type GoFIPSCheck struct {
+	// Paths are the Go binaries to verify.
+	Paths []string `json:"paths"`
+}
+
+var _ fi.NodeupTask = (*GoFIPSCheck)(nil)
+
+func (e *GoFIPSCheck) String() string {
+	return fmt.Sprintf("GoFIPSCheck: %s", strings.Join(e.Paths, ", "))
+}
+
+var _ fi.HasName = (*GoFIPSCheck)(nil)
+
+func (e *GoFIPSCheck) GetName() *string {
+	return new("GoFIPSCheck")
+}
+
+var _ fi.NodeupHasDependencies = (*GoFIPSCheck)(nil)
+
+// GetDependencies implements HasDependencies::GetDependencies
+func (e *GoFIPSCheck) GetDependencies(tasks map[string]fi.NodeupTask) []fi.NodeupTask {
+	// The binaries must be installed before they are verified
+	var deps []fi.NodeupTask
+	for _, v := range tasks {
+		if file, ok := v.(*File); ok && slices.Contains(e.Paths, file.Path) {
+			deps = append(deps, v)
+		}
+	}
+	return deps
+}
+
+func (e *GoFIPSCheck) Find(c *fi.NodeupContext) (*GoFIPSCheck, error) {
+	enabled, err := os.ReadFile(kernelFIPSEnabledPath)
+	if err != nil || strings.TrimSpace(string(enabled)) != "1" {
+		klog.Warningf("the kernel is not running in FIPS mode, only the cryptography of Go binaries managed by kOps is restricted")
+	}
+
+	if err := e.check(); err != nil {
+		klog.V(2).Infof("Go binaries are not verified for FIPS mode: %v", err)
+		return nil, nil
+	}
+
+	return &GoFIPSCheck{
+		Paths: e.Paths,
+	}, nil
+}
+
+func (e *GoFIPSCheck) Run(c *fi.NodeupContext) error {
+	return fi.NodeupDefaultDeltaRunMethod(e, c)
+}
+
+func (s *GoFIPSCheck) CheckChanges(a, e, changes *GoFIPSCheck) error {
+	return nil
+}
+
+func (_ *GoFIPSCheck) RenderLocal(t *local.LocalTarget, a, e, changes *GoFIPSCheck) error {
+	return e.check()
+}
+
+// check returns an error describing every binary that cannot run in FIPS mode.
+func (e *GoFIPSCheck) check() error {
+	var errs []error
+	for _, path := range e.Paths {
+		info, err := buildinfo.ReadFile(path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to read the Go build information of %s, only Go binaries can be verified: %w", path, err))
+			continue
+		}
+		if err := checkGoFIPSBuildInfo(path, info); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("binaries cannot run in FIPS mode: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// checkGoFIPSBuildInfo returns an error if a Go binary was built without FIPS 140-3 approved cryptography.
+func checkGoFIPSBuildInfo(path string, info *buildinfo.BuildInfo) error {
+	for _, setting := range info.Settings {
+		if setting.Key != "GOEXPERIMENT" {
+			continue
+		}
+		for experiment := range strings.SplitSeq(setting.Value, ",") {
+			if slices.Contains(fipsGoExperiments, experiment) {
+				return nil
+			}
+		}
+	}
+
+	// The version may be followed by the GOEXPERIMENTs, e.g. "go1.23.4 X:nocoverageredesign"
+	goVersion, _, _ := strings.Cut(info.GoVersion, " ")
+	if !version.IsValid(goVersion) {
+		return fmt.Errorf("%s was built with an unknown Go version %q", path, info.GoVersion)
+	}
+	if version.Compare(goVersion, minFIPSGoVersion) < 0 {
+		return fmt.Errorf("%s was built with %s, which does not include the FIPS 140-3 module; Go 1.24 or later is required", path, goVersion)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetasks
+
+import (
+	"debug/buildinfo"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"testing"
+)
+
+func TestCheckGoFIPSBuildInfo(t *testing.T) {
+	grid := []struct {
+		Name          string
+		Info          *buildinfo.BuildInfo
+		ExpectedError string
+	}{
+		{
+			Name: "go1.24",
+			Info: &buildinfo.BuildInfo{GoVersion: "go1.24.4"},
+		},
+		{
+			Name: "go1.25 with experiments",
+			Info: &buildinfo.BuildInfo{GoVersion: "go1.25.1 X:nocoverageredesign"},
+		},
+		{
+			Name:          "go1.23",
+			Info:          &buildinfo.BuildInfo{GoVersion: "go1.23.10"},
+			ExpectedError: "was built with go1.23.10, which does not include the FIPS 140-3 module",
+		},
+		{
+			Name: "go1.23 with boringcrypto",
+			Info: &buildinfo.BuildInfo{
+				GoVersion: "go1.23.10 X:boringcrypto",
+				Settings:  []debug.BuildSetting{{Key: "GOEXPERIMENT", Value: "boringcrypto"}},
+			},
+		},
+		{
+			Name: "go1.22 with systemcrypto",
+			Info: &buildinfo.BuildInfo{
+				GoVersion: "go1.22.12",
+				Settings:  []debug.BuildSetting{{Key: "GOEXPERIMENT", Value: "loopvar,systemcrypto"}},
+			},
+		},
+		{
+			Name:          "devel",
+			Info:          &buildinfo.BuildInfo{GoVersion: "devel +abcdef"},
+			ExpectedError: "was built with an unknown Go version",
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			err := checkGoFIPSBuildInfo("/usr/bin/example", g.Info)
+			if g.ExpectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), g.ExpectedError) {
+				t.Errorf("expected error containing %q, got %v", g.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestGoFIPSCheck(t *testing.T) {
+	self, err := os.Executable()
+	if err != nil {
+		t.Fatalf("unable to find the test binary: %v", err)
+	}
+	notGo := filepath.Join(t.TempDir(), "script.sh")
+	if err := os.WriteFile(notGo, []byte("#!/bin/sh\n"), 0o755); err != nil {
+		t.Fatalf("unable to write %s: %v", notGo, err)
+	}
+
+	if err := (&GoFIPSCheck{Paths: []string{self}}).check(); err != nil {
+		t.Errorf("unexpected error checking the test binary: %v", err)
+	}
+
+	err = (&GoFIPSCheck{Paths: []string{self, notGo}}).check()
+	if err == nil || !strings.Contains(err.Error(), "unable to read the Go build information of "+notGo) {
+		t.Errorf("expected an error for %s, got %v", notGo, err)
+	}
+}
@@ -28,6 +28,8 @@ import (
 // PullImageTask is responsible for pulling a docker image
 type PullImageTask struct {
 	Name string
+	// CRIO pulls the image into CRI-O rather than containerd.
+	CRIO bool
 }
 
 var (
@@ -41,7 +43,7 @@ func (t *PullImageTask) GetDependencies(tasks map[string]fi.NodeupTask) []fi.Nod
 	// configured.
 	var deps []fi.NodeupTask
 	for _, v := range tasks {
-		if svc, ok := v.(*Service); ok && svc.Name == containerdService {
+		if svc, ok := v.(*Service); ok && (svc.Name == containerdService || svc.Name == crioService) {
 			deps = append(deps, v)
 		}
 	}
@@ -58,6 +60,9 @@ func (t *PullImageTask) GetName() *string {
 func (e *PullImageTask) Run(c *fi.NodeupContext) error {
 	// Pull the container image
 	args := []string{"ctr", "--namespace", "k8s.io", "images", "pull", e.Name}
+	if e.CRIO {
+		args = []string{"crictl", "pull", e.Name}
+	}
 	human := strings.Join(args, " ")
 
 	klog.Infof("running command %s", human)
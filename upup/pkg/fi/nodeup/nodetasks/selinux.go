@@ -0,0 +1,332 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetasks
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/local"
+)
+
+const selinuxConfigPath = "/etc/selinux/config"
+
+var selinuxConfigModeRegexp = regexp.MustCompile(`(?m)^SELINUX=(.*)$`)
+
+// SELinuxFileContext labels a directory tree with an SELinux type.
+// The label is added to the local SELinux policy, so that it survives relabeling the file system.
+type SELinuxFileContext struct {
+	// Path is the directory to label, along with everything below it.
+	Path string `json:"path"`
+	// Type is the SELinux type of the label.
+	Type string `json:"type"`
+}
+
+var _ fi.NodeupTask = (*SELinuxFileContext)(nil)
+
+func (e *SELinuxFileContext) String() string {
+	return fmt.Sprintf("SELinuxFileContext: %s -> %s", e.Path, e.Type)
+}
+
+var _ fi.HasName = (*SELinuxFileContext)(nil)
+
+func (e *SELinuxFileContext) GetName() *string {
+	return new("SELinuxFileContext-" + e.Path)
+}
+
+var _ fi.NodeupHasDependencies = (*SELinuxFileContext)(nil)
+
+// GetDependencies implements HasDependencies::GetDependencies
+func (e *SELinuxFileContext) GetDependencies(tasks map[string]fi.NodeupTask) []fi.NodeupTask {
+	// The SELinux policy and tools are installed by packages
+	var deps []fi.NodeupTask
+	for _, v := range tasks {
+		if _, ok := v.(*Package); ok {
+			deps = append(deps, v)
+		}
+	}
+	return deps
+}
+
+func (e *SELinuxFileContext) Find(c *fi.NodeupContext) (*SELinuxFileContext, error) {
+	output, err := (&local.LocalTarget{}).CombinedOutput([]string{"matchpathcon", "-n", e.Path})
+	if err != nil {
+		klog.V(2).Infof("unable to find the SELinux file context of %q: %v: %s", e.Path, err, string(output))
+		return nil, nil
+	}
+	if selinuxType(string(output)) != e.Type {
+		return nil, nil
+	}
+
+	label := make([]byte, 256)
+	n, err := unix.Lgetxattr(e.Path, "security.selinux", label)
+	if err != nil {
+		klog.V(2).Infof("unable to read the SELinux label of %q: %v", e.Path, err)
+		return nil, nil
+	}
+	if selinuxType(string(label[:n])) != e.Type {
+		return nil, nil
+	}
+
+	return &SELinuxFileContext{
+		Path: e.Path,
+		Type: e.Type,
+	}, nil
+}
+
+func (e *SELinuxFileContext) Run(c *fi.NodeupContext) error {
+	return fi.NodeupDefaultDeltaRunMethod(e, c)
+}
+
+func (s *SELinuxFileContext) CheckChanges(a, e, changes *SELinuxFileContext) error {
+	return nil
+}
+
+func (_ *SELinuxFileContext) RenderLocal(t *local.LocalTarget, a, e, changes *SELinuxFileContext) error {
+	if err := os.MkdirAll(e.Path, 0o755); err != nil {
+		return fmt.Errorf("error creating directory %q: %w", e.Path, err)
+	}
+	return e.execute(t)
+}
+
+func (e *SELinuxFileContext) execute(t Executor) error {
+	pattern := e.Path + "(/.*)?"
+
+	args := []string{"semanage", "fcontext", "-a", "-t", e.Type, pattern}
+	klog.Infof("running command %s", args)
+	if output, err := t.CombinedOutput(args); err != nil {
+		if !strings.Contains(string(output), "already defined") {
+			return fmt.Errorf("error doing %q: %v: %s", strings.Join(args, " "), err, string(output))
+		}
+		// The file context exists with a different type
+		args = []string{"semanage", "fcontext", "-m", "-t", e.Type, pattern}
+		klog.Infof("running command %s", args)
+		if output, err := t.CombinedOutput(args); err != nil {
+			return fmt.Errorf("error doing %q: %v: %s", strings.Join(args, " "), err, string(output))
+		}
+	}
+
+	args = []string{"restorecon", "-R", "-F", e.Path}
+	klog.Infof("running command %s", args)
+	if output, err := t.CombinedOutput(args); err != nil {
+		return fmt.Errorf("error doing %q: %v: %s", strings.Join(args, " "), err, string(output))
+	}
+
+	return nil
+}
+
+// selinuxType returns the type of an SELinux label like system_u:object_r:container_file_t:s0.
+func selinuxType(label string) string {
+	tokens := strings.Split(strings.TrimSpace(strings.TrimRight(label, "\x00")), ":")
+	if len(tokens) < 3 {
+		return ""
+	}
+	return tokens[2]
+}
+
+// SELinuxMode sets the SELinux mode of the node, both for the running system and across reboots.
+type SELinuxMode struct {
+	// Mode is the SELinux mode: Enforcing or Permissive.
+	Mode string `json:"mode"`
+	// MinContainerSELinuxVersion is the oldest accepted version of the container-selinux policy.
+	// Older versions are upgraded before the mode is set.
+	MinContainerSELinuxVersion string `json:"minContainerSELinuxVersion,omitempty"`
+}
+
+var _ fi.NodeupTask = (*SELinuxMode)(nil)
+
+func (e *SELinuxMode) String() string {
+	return fmt.Sprintf("SELinuxMode: %s", e.Mode)
+}
+
+var _ fi.HasName = (*SELinuxMode)(nil)
+
+func (e *SELinuxMode) GetName() *string {
+	return new("SELinuxMode")
+}
+
+var _ fi.NodeupHasDependencies = (*SELinuxMode)(nil)
+
+// GetDependencies implements HasDependencies::GetDependencies
+func (e *SELinuxMode) GetDependencies(tasks map[string]fi.NodeupTask) []fi.NodeupTask {
+	// The node should be labeled before SELinux is enforced
+	var deps []fi.NodeupTask
+	for _, v := range tasks {
+		switch v.(type) {
+		case *Package, *SELinuxFileContext:
+			deps = append(deps, v)
+		}
+	}
+	return deps
+}
+
+func (e *SELinuxMode) Find(c *fi.NodeupContext) (*SELinuxMode, error) {
+	t := &local.LocalTarget{}
+
+	output, err := t.CombinedOutput([]string{"getenforce"})
+	if err != nil {
+		klog.V(2).Infof("unable to get the SELinux mode: %v: %s", err, string(output))
+		return nil, nil
+	}
+	if !strings.EqualFold(strings.TrimSpace(string(output)), e.Mode) {
+		return nil, nil
+	}
+
+	config, err := os.ReadFile(selinuxConfigPath)
+	if err != nil {
+		klog.V(2).Infof("unable to read %s: %v", selinuxConfigPath, err)
+		return nil, nil
+	}
+	if !strings.EqualFold(configuredSELinuxMode(config), e.Mode) {
+		return nil, nil
+	}
+
+	if e.MinContainerSELinuxVersion != "" {
+		ok, _, err := containerSELinuxVersionAtLeast(t, e.MinContainerSELinuxVersion)
+		if err != nil {
+			klog.V(2).Infof("unable to check the container-selinux version: %v", err)
+			return nil, nil
+		}
+		if !ok {
+			return nil, nil
+		}
+	}
+
+	return &SELinuxMode{
+		Mode:                       e.Mode,
+		MinContainerSELinuxVersion: e.MinContainerSELinuxVersion,
+	}, nil
+}
+
+func (e *SELinuxMode) Run(c *fi.NodeupContext) error {
+	return fi.NodeupDefaultDeltaRunMethod(e, c)
+}
+
+func (s *SELinuxMode) CheckChanges(a, e, changes *SELinuxMode) error {
+	return nil
+}
+
+func (_ *SELinuxMode) RenderLocal(t *local.LocalTarget, a, e, changes *SELinuxMode) error {
+	packageManager := "/usr/bin/dnf"
+	if _, err := os.Stat(packageManager); err != nil {
+		packageManager = "/usr/bin/yum"
+	}
+	if err := e.execute(t, packageManager); err != nil {
+		return err
+	}
+
+	config, err := os.ReadFile(selinuxConfigPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error reading %s: %w", selinuxConfigPath, err)
+	}
+	if err := os.WriteFile(selinuxConfigPath, setSELinuxConfigMode(config, e.Mode), 0o644); err != nil {
+		return fmt.Errorf("error writing %s: %w", selinuxConfigPath, err)
+	}
+
+	return nil
+}
+
+func (e *SELinuxMode) execute(t Executor, packageManager string) error {
+	if e.MinContainerSELinuxVersion != "" {
+		ok, installed, err := containerSELinuxVersionAtLeast(t, e.MinContainerSELinuxVersion)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			args := []string{packageManager, "upgrade", "-y", "container-selinux"}
+			klog.Infof("container-selinux %s is older than %s, running command %s", installed, e.MinContainerSELinuxVersion, args)
+			if output, err := t.CombinedOutput(args); err != nil {
+				return fmt.Errorf("error doing %q: %v: %s", strings.Join(args, " "), err, string(output))
+			}
+			ok, installed, err = containerSELinuxVersionAtLeast(t, e.MinContainerSELinuxVersion)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("SELinux mode %s requires container-selinux %s or later, but only %s is available", e.Mode, e.MinContainerSELinuxVersion, installed)
+			}
+		}
+	}
+
+	output, err := t.CombinedOutput([]string{"getenforce"})
+	if err != nil {
+		return fmt.Errorf("error getting the SELinux mode: %v: %s", err, string(output))
+	}
+	if strings.TrimSpace(string(output)) == "Disabled" {
+		return fmt.Errorf("SELinux is disabled on this node; enabling it requires relabeling the file system and rebooting, so use an image with SELinux enabled")
+	}
+
+	enforce := "0"
+	if strings.EqualFold(e.Mode, "Enforcing") {
+		enforce = "1"
+	}
+	args := []string{"setenforce", enforce}
+	klog.Infof("running command %s", args)
+	if output, err := t.CombinedOutput(args); err != nil {
+		return fmt.Errorf("error doing %q: %v: %s", strings.Join(args, " "), err, string(output))
+	}
+
+	return nil
+}
+
+// containerSELinuxVersionAtLeast returns true if the installed container-selinux is at least minVersion,
+// along with the installed version.
+func containerSELinuxVersionAtLeast(t Executor, minVersion string) (bool, string, error) {
+	args := []string{"rpm", "-q", "container-selinux", "--queryformat", "%{VERSION}"}
+	output, err := t.CombinedOutput(args)
+	if err != nil {
+		return false, "", fmt.Errorf("error doing %q: %v: %s", strings.Join(args, " "), err, string(output))
+	}
+	installed := strings.TrimSpace(string(output))
+	installedVersion, err := semver.ParseTolerant(installed)
+	if err != nil {
+		return false, installed, fmt.Errorf("error parsing container-selinux version %q: %w", installed, err)
+	}
+	min, err := semver.ParseTolerant(minVersion)
+	if err != nil {
+		return false, installed, fmt.Errorf("error parsing container-selinux version %q: %w", minVersion, err)
+	}
+	return installedVersion.GTE(min), installed, nil
+}
+
+// configuredSELinuxMode returns the mode SELinux is configured to start in.
+func configuredSELinuxMode(config []byte) string {
+	match := selinuxConfigModeRegexp.FindSubmatch(config)
+	if match == nil {
+		return ""
+	}
+	return strings.TrimSpace(string(match[1]))
+}
+
+// setSELinuxConfigMode sets the mode SELinux is configured to start in.
+func setSELinuxConfigMode(config []byte, mode string) []byte {
+	line := []byte("SELINUX=" + strings.ToLower(mode))
+	if selinuxConfigModeRegexp.Match(config) {
+		return selinuxConfigModeRegexp.ReplaceAllLiteral(config, line)
+	}
+	if len(config) > 0 && config[len(config)-1] != '\n' {
+		config = append(config, '\n')
+	}
+	return append(append(config, line...), '\n')
+}
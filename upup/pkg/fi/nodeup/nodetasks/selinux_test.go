@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetasks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSELinuxFileContextCommands(t *testing.T) {
+	grid := []struct {
+		fileContext *SELinuxFileContext
+		executor    *MockExecutor
+	}{
+		{
+			fileContext: &SELinuxFileContext{Path: "/var/log/pods", Type: "container_log_t"},
+			executor: &MockExecutor{
+				Commands: []*MockCommand{
+					{Args: []string{"semanage", "fcontext", "-a", "-t", "container_log_t", "/var/log/pods(/.*)?"}},
+					{Args: []string{"restorecon", "-R", "-F", "/var/log/pods"}},
+				},
+			},
+		},
+		{
+			fileContext: &SELinuxFileContext{Path: "/mnt/containerd", Type: "container_var_lib_t"},
+			executor: &MockExecutor{
+				Commands: []*MockCommand{
+					{
+						Args:   []string{"semanage", "fcontext", "-a", "-t", "container_var_lib_t", "/mnt/containerd(/.*)?"},
+						Result: []byte("ValueError: File context for /mnt/containerd(/.*)? already defined"),
+						Error:  errors.New("exit status 1"),
+					},
+					{Args: []string{"semanage", "fcontext", "-m", "-t", "container_var_lib_t", "/mnt/containerd(/.*)?"}},
+					{Args: []string{"restorecon", "-R", "-F", "/mnt/containerd"}},
+				},
+			},
+		},
+	}
+
+	for _, g := range grid {
+		err := g.fileContext.execute(g.executor)
+		if err != nil {
+			t.Errorf("unexpected error from %v: %v", g.fileContext, err)
+		}
+		if len(g.executor.Commands) != 0 {
+			t.Errorf("not all expected commands were called: %s", g.executor.Commands)
+		}
+	}
+}
+
+func TestSELinuxModeCommands(t *testing.T) {
+	grid := []struct {
+		mode          *SELinuxMode
+		executor      *MockExecutor
+		expectedError string
+	}{
+		{
+			mode: &SELinuxMode{Mode: "Enforcing", MinContainerSELinuxVersion: "2.167.0"},
+			executor: &MockExecutor{
+				Commands: []*MockCommand{
+					{Args: []string{"rpm", "-q", "container-selinux", "--queryformat", "%{VERSION}"}, Result: []byte("2.229.0")},
+					{Args: []string{"getenforce"}, Result: []byte("Permissive\n")},
+					{Args: []string{"setenforce", "1"}},
+				},
+			},
+		},
+		{
+			mode: &SELinuxMode{Mode: "Permissive", MinContainerSELinuxVersion: "2.167.0"},
+			executor: &MockExecutor{
+				Commands: []*MockCommand{
+					{Args: []string{"rpm", "-q", "container-selinux", "--queryformat", "%{VERSION}"}, Result: []byte("2.160.0")},
+					{Args: []string{"/usr/bin/dnf", "upgrade", "-y", "container-selinux"}},
+					{Args: []string{"rpm", "-q", "container-selinux", "--queryformat", "%{VERSION}"}, Result: []byte("2.189.0")},
+					{Args: []string{"getenforce"}, Result: []byte("Enforcing\n")},
+					{Args: []string{"setenforce", "0"}},
+				},
+			},
+		},
+		{
+			mode: &SELinuxMode{Mode: "Enforcing", MinContainerSELinuxVersion: "2.167.0"},
+			executor: &MockExecutor{
+				Commands: []*MockCommand{
+					{Args: []string{"rpm", "-q", "container-selinux", "--queryformat", "%{VERSION}"}, Result: []byte("2.160.0")},
+					{Args: []string{"/usr/bin/dnf", "upgrade", "-y", "container-selinux"}},
+					{Args: []string{"rpm", "-q", "container-selinux", "--queryformat", "%{VERSION}"}, Result: []byte("2.160.0")},
+				},
+			},
+			expectedError: "requires container-selinux 2.167.0 or later",
+		},
+		{
+			mode: &SELinuxMode{Mode: "Enforcing"},
+			executor: &MockExecutor{
+				Commands: []*MockCommand{
+					{Args: []string{"getenforce"}, Result: []byte("Disabled\n")},
+				},
+			},
+			expectedError: "SELinux is disabled",
+		},
+	}
+
+	for _, g := range grid {
+		err := g.mode.execute(g.executor, "/usr/bin/dnf")
+		if g.expectedError == "" {
+			if err != nil {
+				t.Errorf("unexpected error from %v: %v", g.mode, err)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), g.expectedError) {
+			t.Errorf("expected error containing %q from %v, got %v", g.expectedError, g.mode, err)
+		}
+		if len(g.executor.Commands) != 0 {
+			t.Errorf("not all expected commands were called: %s", g.executor.Commands)
+		}
+	}
+}
+
+func TestSetSELinuxConfigMode(t *testing.T) {
+	grid := []struct {
+		config   string
+		mode     string
+		expected string
+	}{
+		{
+			config:   "# comment\nSELINUX=permissive\nSELINUXTYPE=targeted\n",
+			mode:     "Enforcing",
+			expected: "# comment\nSELINUX=enforcing\nSELINUXTYPE=targeted\n",
+		},
+		{
+			config:   "SELINUXTYPE=targeted",
+			mode:     "Permissive",
+			expected: "SELINUXTYPE=targeted\nSELINUX=permissive\n",
+		},
+		{
+			config:   "",
+			mode:     "Enforcing",
+			expected: "SELINUX=enforcing\n",
+		},
+	}
+
+	for _, g := range grid {
+		actual := string(setSELinuxConfigMode([]byte(g.config), g.mode))
+		if actual != g.expected {
+			t.Errorf("unexpected config for mode %s from %q: expected %q, got %q", g.mode, g.config, g.expected, actual)
+		}
+		if mode := configuredSELinuxMode([]byte(actual)); !strings.EqualFold(mode, g.mode) {
+			t.Errorf("expected configured mode %s, got %q", g.mode, mode)
+		}
+	}
+}
+
+func TestSELinuxType(t *testing.T) {
+	grid := map[string]string{
+		"system_u:object_r:container_file_t:s0\x00":             "container_file_t",
+		"/var/log/pods\tsystem_u:object_r:container_log_t:s0\n": "container_log_t",
+		"": "",
+	}
+	for label, expected := range grid {
+		if actual := selinuxType(label); actual != expected {
+			t.Errorf("expected type %q for label %q, got %q", expected, label, actual)
+		}
+	}
+}
@@ -42,6 +42,7 @@ const (
 	containerosSystemdSystemPath = "/etc/systemd/system"
 
 	containerdService = "containerd.service"
+	crioService       = "crio.service"
 	dockerService     = "docker.service"
 	kubeletService    = "kubelet.service"
 	protokubeService  = "protokube.service"
@@ -88,7 +89,7 @@ func (s *Service) GetDependencies(tasks map[string]fi.NodeupTask) []fi.NodeupTas
 		// launching a custom Kubernetes build), they all depend on
 		// the "docker.service" Service task.
 		switch v := v.(type) {
-		case *Package, *AptSource, *UserTask, *GroupTask, *Chattr, *BindMount, *Prefix, *UpdateEtcHostsTask:
+		case *Package, *AptSource, *UserTask, *GroupTask, *Chattr, *BindMount, *Prefix, *UpdateEtcHostsTask, *SELinuxFileContext, *SELinuxMode, *GoFIPSCheck:
 			deps = append(deps, v)
 		case *Service, *PullImageTask, *IssueCert, *BootstrapClientTask, *KubeConfig:
 			// ignore
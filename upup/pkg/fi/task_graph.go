@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+// WriteTaskGraph writes the dependency graph of the tasks in Graphviz DOT format.
+// Edges point from a dependency to the task depending on it, in the order the tasks run.
+func WriteTaskGraph[T SubContext](w io.Writer, tasks map[string]Task[T]) error {
+	dependencies := FindTaskDependencies(tasks)
+
+	keys := make([]string, 0, len(tasks))
+	for k := range tasks {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	if _, err := fmt.Fprintf(w, "digraph tasks {\n\trankdir=LR;\n\tnode [shape=box];\n"); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if _, err := fmt.Fprintf(w, "\t%q;\n", k); err != nil {
+			return err
+		}
+	}
+	for _, k := range keys {
+		deps := append([]string(nil), dependencies[k]...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if _, err := fmt.Fprintf(w, "\t%q -> %q;\n", dep, k); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := fmt.Fprintf(w, "}\n")
+	return err
+}
+
+// TaskTiming is how long a task took to run.
+type TaskTiming struct {
+	// Key is the key of the task in the task map.
+	Key string
+	// Start is when the task first ran.
+	Start time.Time
+	// End is when the task last finished running.
+	End time.Time
+	// Duration is the time spent running the task, over all attempts.
+	Duration time.Duration
+	// Attempts is how many times the task ran, including retries.
+	Attempts int
+	// Succeeded is true if the last attempt succeeded.
+	Succeeded bool
+}
+
+// TaskTimings records how long each task took to run.
+type TaskTimings struct {
+	mutex   sync.Mutex
+	timings map[string]*TaskTiming
+}
+
+// record adds an attempt at running a task.
+func (t *TaskTimings) record(key string, start, end time.Time, err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.timings == nil {
+		t.timings = make(map[string]*TaskTiming)
+	}
+	timing := t.timings[key]
+	if timing == nil {
+		timing = &TaskTiming{Key: key, Start: start}
+		t.timings[key] = timing
+	}
+	timing.End = end
+	timing.Duration += end.Sub(start)
+	timing.Attempts++
+	timing.Succeeded = err == nil
+}
+
+// List returns the timings of the tasks, slowest first.
+func (t *TaskTimings) List() []*TaskTiming {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var timings []*TaskTiming
+	for _, timing := range t.timings {
+		c := *timing
+		timings = append(timings, &c)
+	}
+	sort.Slice(timings, func(i, j int) bool {
+		if timings[i].Duration != timings[j].Duration {
+			return timings[i].Duration > timings[j].Duration
+		}
+		return timings[i].Key < timings[j].Key
+	})
+	return timings
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// graphTask is a task with explicit dependencies, failing the first failures times it runs.
+type graphTask struct {
+	dependencies []string
+	failures     int
+}
+
+func (t *graphTask) Run(c *InstallContext) error {
+	if t.failures > 0 {
+		t.failures--
+		return errors.New("not yet")
+	}
+	return nil
+}
+
+func (t *graphTask) GetDependencies(tasks map[string]InstallTask) []InstallTask {
+	var deps []InstallTask
+	for _, dep := range t.dependencies {
+		deps = append(deps, tasks[dep])
+	}
+	return deps
+}
+
+func TestWriteTaskGraph(t *testing.T) {
+	tasks := map[string]InstallTask{
+		"Network/main":  &graphTask{},
+		"Subnet/a":      &graphTask{dependencies: []string{"Network/main"}},
+		"Instance/node": &graphTask{dependencies: []string{"Subnet/a", "Network/main"}},
+	}
+
+	var b bytes.Buffer
+	if err := WriteTaskGraph(&b, tasks); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `digraph tasks {
+	rankdir=LR;
+	node [shape=box];
+	"Instance/node";
+	"Network/main";
+	"Subnet/a";
+	"Network/main" -> "Instance/node";
+	"Subnet/a" -> "Instance/node";
+	"Network/main" -> "Subnet/a";
+}
+`
+	if b.String() != expected {
+		t.Errorf("unexpected graph; expected:\n%s\ngot:\n%s", expected, b.String())
+	}
+}
+
+func TestRunTasksRecordsTimings(t *testing.T) {
+	tasks := map[string]InstallTask{
+		"Network/main": &graphTask{},
+		"Subnet/a":     &graphTask{dependencies: []string{"Network/main"}, failures: 1},
+	}
+	c := &InstallContext{ctx: context.Background(), tasks: tasks}
+
+	timings := &TaskTimings{}
+	options := RunTasksOptions{
+		MaxTaskDuration:         time.Minute,
+		WaitAfterAllTasksFailed: time.Millisecond,
+		Timings:                 timings,
+	}
+	if err := c.RunTasks(options); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	attempts := make(map[string]int)
+	for _, timing := range timings.List() {
+		if !timing.Succeeded {
+			t.Errorf("expected task %q to have succeeded", timing.Key)
+		}
+		if timing.End.Before(timing.Start) {
+			t.Errorf("task %q ended before it started", timing.Key)
+		}
+		attempts[timing.Key] = timing.Attempts
+	}
+	if len(attempts) != 2 || attempts["Network/main"] != 1 || attempts["Subnet/a"] != 2 {
+		t.Errorf("unexpected attempts: %v", attempts)
+	}
+}
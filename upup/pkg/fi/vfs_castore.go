@@ -19,6 +19,7 @@ package fi
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"sort"
@@ -118,25 +119,39 @@ func (k *Keyset) ToAPIObject(name string) (*kops.Keyset, error) {
 	return o, nil
 }
 
+// KeysetFromAPIObject converts a Keyset API object, as built by ToAPIObject, back to a Keyset.
+func KeysetFromAPIObject(o *kops.Keyset) (*Keyset, error) {
+	return parseKeyset(o)
+}
+
 // writeKeysetBundle writes a Keyset bundle to VFS.
 func writeKeysetBundle(ctx context.Context, cluster *kops.Cluster, p vfs.Path, name string, keyset *Keyset) error {
 	p = p.Join("keyset.yaml")
 
-	o, err := keyset.ToAPIObject(name)
+	objectData, acl, err := buildKeysetBundle(ctx, cluster, p, name, keyset)
 	if err != nil {
 		return err
 	}
+	return p.WriteFile(ctx, bytes.NewReader(objectData), acl)
+}
+
+// buildKeysetBundle returns the serialized Keyset bundle, and the ACL for writing it to p.
+func buildKeysetBundle(ctx context.Context, cluster *kops.Cluster, p vfs.Path, name string, keyset *Keyset) ([]byte, vfs.ACL, error) {
+	o, err := keyset.ToAPIObject(name)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	objectData, err := serializeKeysetBundle(o)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	acl, err := acls.GetACL(ctx, p, cluster)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	return p.WriteFile(ctx, bytes.NewReader(objectData), acl)
+	return objectData, acl, nil
 }
 
 // serializeKeysetBundle converts a Keyset bundle to yaml, for writing to VFS.
@@ -273,11 +288,31 @@ func (c *VFSCAStore) StoreKeyset(ctx context.Context, name string, keyset *Keyse
 		return fmt.Errorf("keyset's primary id %q must have a certificate", primaryId)
 	}
 
-	{
-		p := c.buildPrivateKeyPoolPath(name)
-		if err := writeKeysetBundle(ctx, c.cluster, p, name, keyset); err != nil {
-			return fmt.Errorf("writing private bundle: %v", err)
+	p := c.buildPrivateKeyPoolPath(name).Join("keyset.yaml")
+	objectData, acl, err := buildKeysetBundle(ctx, c.cluster, p, name, keyset)
+	if err != nil {
+		return fmt.Errorf("writing private bundle: %v", err)
+	}
+
+	// Only replace the bundle the keyset was loaded from; a keyset that was not loaded from this path must not overwrite an existing bundle.
+	// This stops concurrent changes to the same keyset from silently discarding each other.
+	expectedVersion := ""
+	if keyset.stored != nil && keyset.stored.path == p.Path() {
+		expectedVersion = keyset.stored.version
+	}
+	version, err := vfs.WriteFileIfVersion(ctx, p, bytes.NewReader(objectData), acl, expectedVersion)
+	if err != nil {
+		if errors.Is(err, vfs.ErrVersionConflict) {
+			return fmt.Errorf("keyset %q was modified concurrently; reload it and retry: %w", name, err)
 		}
+		return fmt.Errorf("writing private bundle: %v", err)
+	}
+	keyset.stored = &storedKeysetVersion{path: p.Path(), version: version}
+
+	if name == CertificateIDCA {
+		c.mutex.Lock()
+		c.cachedCA = nil
+		c.mutex.Unlock()
 	}
 
 	return nil
@@ -18,6 +18,8 @@ package fi
 
 import (
 	"context"
+	"crypto/x509/pkix"
+	"errors"
 	"math/big"
 	"math/rand"
 	"strings"
@@ -157,3 +159,94 @@ spec:
 		}
 	}
 }
+
+func TestVFSCAStoreConcurrentModification(t *testing.T) {
+	ctx := context.TODO()
+
+	vfs.Context.ResetMemfsContext(true)
+
+	basePath, err := vfs.Context.BuildVfsPath("memfs://tests")
+	if err != nil {
+		t.Fatalf("error building vfspath: %v", err)
+	}
+
+	s := &VFSCAStore{
+		VFSKeystoreReader: VFSKeystoreReader{
+			basedir: basePath,
+		},
+	}
+
+	issueCA := func(serial int64) (*pki.Certificate, *pki.PrivateKey) {
+		cert, privateKey, _, err := pki.IssueCert(ctx, &pki.IssueCertRequest{
+			Type:    "ca",
+			Subject: pkix.Name{CommonName: "service-account"},
+			Serial:  pki.BuildPKISerial(serial),
+		}, nil)
+		if err != nil {
+			t.Fatalf("error issuing certificate: %v", err)
+		}
+		return cert, privateKey
+	}
+
+	cert, privateKey := issueCA(1)
+	keyset, err := NewKeyset(cert, privateKey)
+	if err != nil {
+		t.Fatalf("error creating keyset: %v", err)
+	}
+	if err := s.StoreKeyset(ctx, "service-account", keyset); err != nil {
+		t.Fatalf("error from StoreKeyset: %v", err)
+	}
+
+	// A keyset that was not loaded from the store must not replace the stored one
+	cert, privateKey = issueCA(2)
+	other, err := NewKeyset(cert, privateKey)
+	if err != nil {
+		t.Fatalf("error creating keyset: %v", err)
+	}
+	if err := s.StoreKeyset(ctx, "service-account", other); !errors.Is(err, vfs.ErrVersionConflict) {
+		t.Fatalf("expected conflict storing a new keyset over an existing one, got %v", err)
+	}
+
+	// Two operators load the keyset and both add a key; the second write must fail rather than lose the first key
+	first, err := s.FindKeyset(ctx, "service-account")
+	if err != nil {
+		t.Fatalf("error from FindKeyset: %v", err)
+	}
+	second, err := s.FindKeyset(ctx, "service-account")
+	if err != nil {
+		t.Fatalf("error from FindKeyset: %v", err)
+	}
+
+	cert, privateKey = issueCA(3)
+	if _, err := first.AddItem(cert, privateKey, false); err != nil {
+		t.Fatalf("error adding item: %v", err)
+	}
+	if err := s.StoreKeyset(ctx, "service-account", first); err != nil {
+		t.Fatalf("error from StoreKeyset: %v", err)
+	}
+
+	cert, privateKey = issueCA(4)
+	if _, err := second.AddItem(cert, privateKey, false); err != nil {
+		t.Fatalf("error adding item: %v", err)
+	}
+	if err := s.StoreKeyset(ctx, "service-account", second); !errors.Is(err, vfs.ErrVersionConflict) {
+		t.Fatalf("expected conflict storing a stale keyset, got %v", err)
+	}
+
+	// The keyset that was written can be modified again without reloading
+	cert, privateKey = issueCA(5)
+	if _, err := first.AddItem(cert, privateKey, false); err != nil {
+		t.Fatalf("error adding item: %v", err)
+	}
+	if err := s.StoreKeyset(ctx, "service-account", first); err != nil {
+		t.Fatalf("error from StoreKeyset: %v", err)
+	}
+
+	reloaded, err := s.FindKeyset(ctx, "service-account")
+	if err != nil {
+		t.Fatalf("error from FindKeyset: %v", err)
+	}
+	if len(reloaded.Items) != 3 {
+		t.Errorf("expected 3 keys in stored keyset, got %d", len(reloaded.Items))
+	}
+}
@@ -80,7 +80,7 @@ func (c *VFSKeystoreReader) parseKeysetYaml(data []byte) (*kops.Keyset, bool, er
 // Bundles avoid the need for a list-files permission, which can be tricky on e.g. GCE
 func (c *VFSKeystoreReader) loadKeyset(ctx context.Context, p vfs.Path) (*Keyset, error) {
 	bundlePath := p.Join("keyset.yaml")
-	data, err := bundlePath.ReadFile(ctx)
+	data, version, err := vfs.ReadFileVersion(ctx, bundlePath)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -99,6 +99,7 @@ func (c *VFSKeystoreReader) loadKeyset(ctx context.Context, p vfs.Path) (*Keyset
 	}
 
 	keyset.LegacyFormat = legacyFormat
+	keyset.stored = &storedKeysetVersion{path: bundlePath.Path(), version: version}
 	return keyset, nil
 }
 
@@ -98,15 +98,86 @@ func (t *Table) findColumns(columnNames ...string) ([]*TableColumn, error) {
 
 // Render writes the items in a table, to out
 func (t *Table) Render(items interface{}, out io.Writer, columnNames ...string) error {
-	itemsValue := reflect.ValueOf(items)
-	if itemsValue.Kind() != reflect.Slice {
-		klog.Fatal("unexpected kind for items: ", itemsValue.Kind())
+	columns, err := t.findColumns(columnNames...)
+	if err != nil {
+		return err
 	}
 
+	w := new(tabwriter.Writer)
+
+	// Format in tab-separated columns with a tab stop of 8.
+	w.Init(out, 0, 8, 1, '\t', tabwriter.StripEscape)
+
+	if err := writeHeader(w, columns); err != nil {
+		return err
+	}
+	if err := writeRows(w, buildRows(items, columns)); err != nil {
+		return err
+	}
+	w.Flush()
+
+	return nil
+}
+
+// TableStream writes a table in batches of items, so that the rows can be written as they become available.
+// The columns are aligned within each batch; because they are padded to tab stops, they usually also line up across batches.
+type TableStream struct {
+	out           io.Writer
+	columns       []*TableColumn
+	headerWritten bool
+}
+
+// Stream returns a TableStream that writes the table to out.
+func (t *Table) Stream(out io.Writer, columnNames ...string) (*TableStream, error) {
 	columns, err := t.findColumns(columnNames...)
 	if err != nil {
+		return nil, err
+	}
+	return &TableStream{out: out, columns: columns}, nil
+}
+
+// Write sorts a batch of items and writes them to out, preceded by the header for the first batch.
+func (s *TableStream) Write(items interface{}) error {
+	rows := buildRows(items, s.columns)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(s.out, 0, 8, 1, '\t', tabwriter.StripEscape)
+
+	if !s.headerWritten {
+		if err := writeHeader(w, s.columns); err != nil {
+			return err
+		}
+		s.headerWritten = true
+	}
+	if err := writeRows(w, rows); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// Close writes the header if no rows were written.
+func (s *TableStream) Close() error {
+	if s.headerWritten {
+		return nil
+	}
+	w := new(tabwriter.Writer)
+	w.Init(s.out, 0, 8, 1, '\t', tabwriter.StripEscape)
+	if err := writeHeader(w, s.columns); err != nil {
 		return err
 	}
+	s.headerWritten = true
+	return w.Flush()
+}
+
+// buildRows returns the sorted rows for the items.
+func buildRows(items interface{}, columns []*TableColumn) [][]string {
+	itemsValue := reflect.ValueOf(items)
+	if itemsValue.Kind() != reflect.Slice {
+		klog.Fatal("unexpected kind for items: ", itemsValue.Kind())
+	}
 
 	n := itemsValue.Len()
 
@@ -137,31 +208,30 @@ func (t *Table) Render(items interface{}, out io.Writer, columnNames ...string)
 		return false
 	})
 
-	var b bytes.Buffer
-	w := new(tabwriter.Writer)
-
-	// Format in tab-separated columns with a tab stop of 8.
-	w.Init(out, 0, 8, 1, '\t', tabwriter.StripEscape)
+	return rows
+}
 
-	writeHeader := true
-	if writeHeader {
-		for i, c := range columns {
-			if i != 0 {
-				b.WriteByte('\t')
-			}
-			b.WriteByte(tabwriter.Escape)
-			b.WriteString(c.Name)
-			b.WriteByte(tabwriter.Escape)
+func writeHeader(w io.Writer, columns []*TableColumn) error {
+	var b bytes.Buffer
+	for i, c := range columns {
+		if i != 0 {
+			b.WriteByte('\t')
 		}
-		b.WriteByte('\n')
+		b.WriteByte(tabwriter.Escape)
+		b.WriteString(c.Name)
+		b.WriteByte(tabwriter.Escape)
+	}
+	b.WriteByte('\n')
 
-		_, err := w.Write(b.Bytes())
-		if err != nil {
-			return fmt.Errorf("error writing to output: %v", err)
-		}
-		b.Reset()
+	_, err := w.Write(b.Bytes())
+	if err != nil {
+		return fmt.Errorf("error writing to output: %v", err)
 	}
+	return nil
+}
 
+func writeRows(w io.Writer, rows [][]string) error {
+	var b bytes.Buffer
 	for _, row := range rows {
 		for i, col := range row {
 			if i != 0 {
@@ -180,7 +250,5 @@ func (t *Table) Render(items interface{}, out io.Writer, columnNames ...string)
 		}
 		b.Reset()
 	}
-	w.Flush()
-
 	return nil
 }
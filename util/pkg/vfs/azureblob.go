@@ -30,6 +30,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/util/pkg/hashing"
 )
@@ -126,6 +128,9 @@ func (p *AzureBlobPath) Join(relativePath ...string) Path {
 
 // ReadFile returns the content of the blob.
 func (p *AzureBlobPath) ReadFile(ctx context.Context) ([]byte, error) {
+	ctx, span := tracer.Start(ctx, "AzureBlobPath::ReadFile", trace.WithAttributes(attribute.String("path", p.String())))
+	defer span.End()
+
 	klog.V(8).Infof("Reading file: %s", p)
 
 	client, err := p.getClient(ctx)
@@ -176,6 +181,9 @@ var createFileLockAzureBlob sync.Mutex
 
 // CreateFile writes the file contents only if the file does not already exist.
 func (p *AzureBlobPath) CreateFile(ctx context.Context, data io.ReadSeeker, acl ACL) error {
+	ctx, span := tracer.Start(ctx, "AzureBlobPath::CreateFile", trace.WithAttributes(attribute.String("path", p.String())))
+	defer span.End()
+
 	klog.V(8).Infof("Creating file: %s", p)
 
 	createFileLockAzureBlob.Lock()
@@ -194,6 +202,9 @@ func (p *AzureBlobPath) CreateFile(ctx context.Context, data io.ReadSeeker, acl
 
 // WriteFile writes the blob to the reader.
 func (p *AzureBlobPath) WriteFile(ctx context.Context, data io.ReadSeeker, acl ACL) error {
+	ctx, span := tracer.Start(ctx, "AzureBlobPath::WriteFile", trace.WithAttributes(attribute.String("path", p.String())))
+	defer span.End()
+
 	klog.V(8).Infof("Writing file: %s", p)
 
 	client, err := p.getClient(ctx)
@@ -212,6 +223,9 @@ func (p *AzureBlobPath) WriteFile(ctx context.Context, data io.ReadSeeker, acl A
 
 // Remove deletes the blob.
 func (p *AzureBlobPath) Remove(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "AzureBlobPath::Remove", trace.WithAttributes(attribute.String("path", p.String())))
+	defer span.End()
+
 	klog.V(8).Infof("Removing file: %s", p)
 
 	client, err := p.getClient(ctx)
@@ -287,6 +301,9 @@ func (p *AzureBlobPath) ReadDir() ([]Path, error) {
 
 // ReadTree lists all blobs (recursively) in the subtree rooted at the current Path.
 func (p *AzureBlobPath) ReadTree(ctx context.Context) ([]Path, error) {
+	ctx, span := tracer.Start(ctx, "AzureBlobPath::ReadTree", trace.WithAttributes(attribute.String("path", p.String())))
+	defer span.End()
+
 	klog.V(8).Infof("Reading tree: %s", p)
 
 	client, err := p.getClient(ctx)
@@ -17,7 +17,10 @@ limitations under the License.
 package vfs
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -36,8 +39,9 @@ type FSPath struct {
 }
 
 var (
-	_ Path    = &FSPath{}
-	_ HasHash = &FSPath{}
+	_ Path              = &FSPath{}
+	_ HasHash           = &FSPath{}
+	_ HasVersionedWrite = &FSPath{}
 )
 
 func NewFSPath(location string) *FSPath {
@@ -123,6 +127,48 @@ func (p *FSPath) ReadFile(ctx context.Context) ([]byte, error) {
 	return file, err
 }
 
+// ReadFileVersion implements HasVersionedWrite::ReadFileVersion
+func (p *FSPath) ReadFileVersion(ctx context.Context) ([]byte, string, error) {
+	data, err := p.ReadFile(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, fsFileVersion(data), nil
+}
+
+// WriteFileIfVersion implements HasVersionedWrite::WriteFileIfVersion
+// Like CreateFile, this only guards against concurrent writes from the same process.
+func (p *FSPath) WriteFileIfVersion(ctx context.Context, data io.ReadSeeker, acl ACL, version string) (string, error) {
+	createFileLock.Lock()
+	defer createFileLock.Unlock()
+
+	current := ""
+	existing, err := p.ReadFile(ctx)
+	if err == nil {
+		current = fsFileVersion(existing)
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+	if current != version {
+		return "", ErrVersionConflict
+	}
+
+	b, err := io.ReadAll(data)
+	if err != nil {
+		return "", fmt.Errorf("error reading data: %v", err)
+	}
+	if err := p.WriteFile(ctx, bytes.NewReader(b), acl); err != nil {
+		return "", err
+	}
+	return fsFileVersion(b), nil
+}
+
+// fsFileVersion identifies a version of a local file by the hash of its contents
+func fsFileVersion(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
 // WriteTo implements io.WriterTo
 func (p *FSPath) WriteTo(out io.Writer) (int64, error) {
 	f, err := os.Open(p.location)
@@ -25,10 +25,13 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/api/googleapi"
 	storage "google.golang.org/api/storage/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -52,6 +55,8 @@ var (
 	_ Path          = &GSPath{}
 	_ TerraformPath = &GSPath{}
 	_ HasHash       = &GSPath{}
+
+	_ HasVersionedWrite = &GSPath{}
 )
 
 // gcsReadBackoff is the backoff strategy for GCS read retries
@@ -119,6 +124,9 @@ func (p *GSPath) String() string {
 }
 
 func (p *GSPath) Remove(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "GSPath::Remove", trace.WithAttributes(attribute.String("path", p.String())))
+	defer span.End()
+
 	done, err := RetryWithBackoff(gcsWriteBackoff, func() (bool, error) {
 		client, err := p.getStorageClient(ctx)
 		if err != nil {
@@ -173,11 +181,42 @@ func (p *GSPath) Join(relativePath ...string) Path {
 }
 
 func (p *GSPath) WriteFile(ctx context.Context, data io.ReadSeeker, acl ACL) error {
+	ctx, span := tracer.Start(ctx, "GSPath::WriteFile", trace.WithAttributes(attribute.String("path", p.String())))
+	defer span.End()
+
+	_, err := p.insert(ctx, data, acl, nil)
+	return err
+}
+
+// WriteFileIfVersion implements HasVersionedWrite::WriteFileIfVersion, using the object generation as the version
+func (p *GSPath) WriteFileIfVersion(ctx context.Context, data io.ReadSeeker, acl ACL, version string) (string, error) {
+	ctx, span := tracer.Start(ctx, "GSPath::WriteFileIfVersion", trace.WithAttributes(attribute.String("path", p.String())))
+	defer span.End()
+
+	// Generation 0 matches only if the object does not exist
+	var generation int64
+	if version != "" {
+		var err error
+		generation, err = strconv.ParseInt(version, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid version %q for %s: %v", version, p, err)
+		}
+	}
+	obj, err := p.insert(ctx, data, acl, &generation)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(obj.Generation, 10), nil
+}
+
+// insert writes the object, only if it is at ifGenerationMatch when that is not nil
+func (p *GSPath) insert(ctx context.Context, data io.ReadSeeker, acl ACL, ifGenerationMatch *int64) (*storage.Object, error) {
 	md5Hash, err := hashing.HashAlgorithmMD5.Hash(data)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	var written *storage.Object
 	done, err := RetryWithBackoff(gcsWriteBackoff, func() (bool, error) {
 		obj := &storage.Object{
 			Name:    p.key,
@@ -204,20 +243,28 @@ func (p *GSPath) WriteFile(ctx context.Context, data io.ReadSeeker, acl ACL) err
 			return false, err
 		}
 
-		_, err = client.Objects.Insert(p.bucket, obj).Context(ctx).Media(data).Do()
+		call := client.Objects.Insert(p.bucket, obj).Context(ctx).Media(data)
+		if ifGenerationMatch != nil {
+			call = call.IfGenerationMatch(*ifGenerationMatch)
+		}
+		written, err = call.Do()
 		if err != nil {
+			if isGCSPreconditionFailed(err) {
+				// Not recoverable
+				return true, ErrVersionConflict
+			}
 			return false, fmt.Errorf("error writing %s: %v", p, err)
 		}
 
 		return true, nil
 	})
 	if err != nil {
-		return err
+		return nil, err
 	} else if done {
-		return nil
+		return written, nil
 	} else {
 		// Shouldn't happen - we always return a non-nil error with false
-		return wait.ErrWaitTimeout
+		return nil, wait.ErrWaitTimeout
 	}
 }
 
@@ -246,6 +293,9 @@ func (p *GSPath) CreateFile(ctx context.Context, data io.ReadSeeker, acl ACL) er
 
 // ReadFile implements Path::ReadFile
 func (p *GSPath) ReadFile(ctx context.Context) ([]byte, error) {
+	_, span := tracer.Start(ctx, "GSPath::ReadFile", trace.WithAttributes(attribute.String("path", p.String())))
+	defer span.End()
+
 	var b bytes.Buffer
 	done, err := RetryWithBackoff(gcsReadBackoff, func() (bool, error) {
 		b.Reset()
@@ -270,30 +320,65 @@ func (p *GSPath) ReadFile(ctx context.Context) ([]byte, error) {
 	}
 }
 
+// ReadFileVersion implements HasVersionedWrite::ReadFileVersion, using the object generation as the version
+func (p *GSPath) ReadFileVersion(ctx context.Context) ([]byte, string, error) {
+	ctx, span := tracer.Start(ctx, "GSPath::ReadFileVersion", trace.WithAttributes(attribute.String("path", p.String())))
+	defer span.End()
+
+	var b bytes.Buffer
+	var generation string
+	done, err := RetryWithBackoff(gcsReadBackoff, func() (bool, error) {
+		b.Reset()
+		header, _, err := p.download(ctx, &b)
+		if err != nil {
+			if os.IsNotExist(err) {
+				// Not recoverable
+				return true, err
+			}
+			return false, err
+		}
+		generation = header.Get("X-Goog-Generation")
+		return true, nil
+	})
+	if err != nil {
+		return nil, "", err
+	} else if done {
+		return b.Bytes(), generation, nil
+	} else {
+		// Shouldn't happen - we always return a non-nil error with false
+		return nil, "", wait.ErrWaitTimeout
+	}
+}
+
 // WriteTo implements io.WriterTo::WriteTo
 func (p *GSPath) WriteTo(out io.Writer) (int64, error) {
-	ctx := context.TODO()
+	_, n, err := p.download(context.TODO(), out)
+	return n, err
+}
 
+// download copies the contents of the object to out, returning the response headers and the number of bytes copied
+func (p *GSPath) download(ctx context.Context, out io.Writer) (http.Header, int64, error) {
 	klog.V(4).Infof("Reading file %q", p)
 
 	client, err := p.getStorageClient(ctx)
 	if err != nil {
-		return 0, err
+		return nil, 0, err
 	}
 
 	response, err := client.Objects.Get(p.bucket, p.key).Context(ctx).Download()
 	if err != nil {
 		if isGCSNotFound(err) {
-			return 0, os.ErrNotExist
+			return nil, 0, os.ErrNotExist
 		}
-		return 0, fmt.Errorf("error reading %s: %v", p, err)
+		return nil, 0, fmt.Errorf("error reading %s: %v", p, err)
 	}
 	if response == nil {
-		return 0, fmt.Errorf("no response returned from reading %s", p)
+		return nil, 0, fmt.Errorf("no response returned from reading %s", p)
 	}
 	defer response.Body.Close()
 
-	return io.Copy(out, response.Body)
+	n, err := io.Copy(out, response.Body)
+	return response.Header, n, err
 }
 
 // ReadDir implements Path::ReadDir
@@ -346,6 +431,9 @@ func (p *GSPath) ReadDir() ([]Path, error) {
 
 // ReadTree implements Path::ReadTree
 func (p *GSPath) ReadTree(ctx context.Context) ([]Path, error) {
+	ctx, span := tracer.Start(ctx, "GSPath::ReadTree", trace.WithAttributes(attribute.String("path", p.String())))
+	defer span.End()
+
 	var ret []Path
 	done, err := RetryWithBackoff(gcsReadBackoff, func() (bool, error) {
 		// No delimiter for recursive search
@@ -525,6 +613,11 @@ func isGCSNotFound(err error) bool {
 	return ok && ae.Code == http.StatusNotFound
 }
 
+func isGCSPreconditionFailed(err error) bool {
+	ae, ok := err.(*googleapi.Error)
+	return ok && ae.Code == http.StatusPreconditionFailed
+}
+
 func (p *GSPath) getStorageClient(ctx context.Context) (*storage.Service, error) {
 	return p.vfsContext.getGCSClient(ctx)
 }
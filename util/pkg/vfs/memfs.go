@@ -22,6 +22,7 @@ import (
 	"io"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -33,14 +34,16 @@ type MemFSPath struct {
 	location string
 	acl      ACL
 
-	mutex    sync.Mutex
-	contents []byte
-	children map[string]*MemFSPath
+	mutex      sync.Mutex
+	contents   []byte
+	generation int64
+	children   map[string]*MemFSPath
 }
 
 var (
-	_ Path          = &MemFSPath{}
-	_ TerraformPath = &MemFSPath{}
+	_ Path              = &MemFSPath{}
+	_ TerraformPath     = &MemFSPath{}
+	_ HasVersionedWrite = &MemFSPath{}
 )
 
 type MemFSContext struct {
@@ -112,6 +115,7 @@ func (p *MemFSPath) WriteFile(ctx context.Context, r io.ReadSeeker, acl ACL) err
 	}
 	p.contents = data
 	p.acl = acl
+	p.generation++
 	return nil
 }
 
@@ -133,6 +137,35 @@ func (p *MemFSPath) ReadFile(ctx context.Context) ([]byte, error) {
 	return p.contents, nil
 }
 
+// ReadFileVersion implements HasVersionedWrite::ReadFileVersion
+func (p *MemFSPath) ReadFileVersion(ctx context.Context) ([]byte, string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.contents == nil {
+		return nil, "", os.ErrNotExist
+	}
+	return p.contents, strconv.FormatInt(p.generation, 10), nil
+}
+
+// WriteFileIfVersion implements HasVersionedWrite::WriteFileIfVersion
+func (p *MemFSPath) WriteFileIfVersion(ctx context.Context, data io.ReadSeeker, acl ACL, version string) (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	current := ""
+	if p.contents != nil {
+		current = strconv.FormatInt(p.generation, 10)
+	}
+	if current != version {
+		return "", ErrVersionConflict
+	}
+	if err := p.WriteFile(ctx, data, acl); err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(p.generation, 10), nil
+}
+
 // WriteTo implements io.WriterTo
 func (p *MemFSPath) WriteTo(out io.Writer) (int64, error) {
 	if p.contents == nil {
@@ -65,6 +65,47 @@ func TestMemFsCreateFile(t *testing.T) {
 	}
 }
 
+func TestMemFsWriteFileIfVersion(t *testing.T) {
+	ctx := testcontext.ForTest(t)
+
+	p := NewMemFSPath(NewMemFSContext(), "/root/test.data")
+
+	v1, err := p.WriteFileIfVersion(ctx, bytes.NewReader([]byte("v1")), nil, "")
+	if err != nil {
+		t.Fatalf("error creating file: %v", err)
+	}
+	if _, err := p.WriteFileIfVersion(ctx, bytes.NewReader([]byte("other")), nil, ""); err != ErrVersionConflict {
+		t.Errorf("expected ErrVersionConflict creating an existing file, got %v", err)
+	}
+
+	data, version, err := p.ReadFileVersion(ctx)
+	if err != nil {
+		t.Fatalf("error reading file: %v", err)
+	}
+	if string(data) != "v1" || version != v1 {
+		t.Errorf("unexpected data %q with version %q, expected version %q", data, version, v1)
+	}
+
+	v2, err := p.WriteFileIfVersion(ctx, bytes.NewReader([]byte("v2")), nil, v1)
+	if err != nil {
+		t.Fatalf("error updating file: %v", err)
+	}
+	if v2 == v1 {
+		t.Errorf("expected version to change on write")
+	}
+	if _, err := p.WriteFileIfVersion(ctx, bytes.NewReader([]byte("stale")), nil, v1); err != ErrVersionConflict {
+		t.Errorf("expected ErrVersionConflict writing with a stale version, got %v", err)
+	}
+
+	data, err = p.ReadFile(ctx)
+	if err != nil {
+		t.Fatalf("error reading file: %v", err)
+	}
+	if string(data) != "v2" {
+		t.Errorf("unexpected data %q", data)
+	}
+}
+
 func TestMemFsReadDir(t *testing.T) {
 	tests := []struct {
 		path     string
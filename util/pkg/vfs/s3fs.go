@@ -59,6 +59,8 @@ var (
 	_ Path          = &S3Path{}
 	_ TerraformPath = &S3Path{}
 	_ HasHash       = &S3Path{}
+
+	_ HasVersionedWrite = &S3Path{}
 )
 
 // S3Acl is an ACL implementation for objects on S3
@@ -319,9 +321,32 @@ func (p *S3Path) WriteFile(ctx context.Context, data io.ReadSeeker, aclObj ACL)
 	ctx, span := tracer.Start(ctx, "S3Path::WriteFile", trace.WithAttributes(attribute.String("path", p.String())))
 	defer span.End()
 
+	_, err := p.putObject(ctx, data, aclObj, func(request *s3.PutObjectInput) {})
+	return err
+}
+
+// WriteFileIfVersion implements HasVersionedWrite::WriteFileIfVersion, using the ETag as the version
+func (p *S3Path) WriteFileIfVersion(ctx context.Context, data io.ReadSeeker, aclObj ACL, version string) (string, error) {
+	ctx, span := tracer.Start(ctx, "S3Path::WriteFileIfVersion", trace.WithAttributes(attribute.String("path", p.String())))
+	defer span.End()
+
+	response, err := p.putObject(ctx, data, aclObj, func(request *s3.PutObjectInput) {
+		if version == "" {
+			request.IfNoneMatch = aws.String("*")
+		} else {
+			request.IfMatch = aws.String(version)
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(response.ETag), nil
+}
+
+func (p *S3Path) putObject(ctx context.Context, data io.ReadSeeker, aclObj ACL, configure func(request *s3.PutObjectInput)) (*s3.PutObjectOutput, error) {
 	client, err := p.client(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	klog.V(4).Infof("Writing file %q", p)
@@ -336,25 +361,30 @@ func (p *S3Path) WriteFile(ctx context.Context, data io.ReadSeeker, aclObj ACL)
 
 	acl, err := p.getRequestACL(aclObj)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if acl != nil {
 		request.ACL = *acl
 	}
+	configure(request)
 
 	// We don't need Content-MD5: https://github.com/aws/aws-sdk-go/issues/208
 
 	klog.V(8).Infof("Calling S3 PutObject Bucket=%q Key=%q SSE=%q ACL=%q", p.bucket, p.key, sseLog, request.ACL)
 
-	_, err = client.PutObject(ctx, request)
+	response, err := client.PutObject(ctx, request)
 	if err != nil {
+		switch AWSErrorCode(err) {
+		case "PreconditionFailed", "ConditionalRequestConflict":
+			return nil, ErrVersionConflict
+		}
 		if len(request.ACL) > 0 {
-			return fmt.Errorf("error writing %s (with ACL=%q): %v", p, request.ACL, err)
+			return nil, fmt.Errorf("error writing %s (with ACL=%q): %v", p, request.ACL, err)
 		}
-		return fmt.Errorf("error writing %s: %v", p, err)
+		return nil, fmt.Errorf("error writing %s: %v", p, err)
 	}
 
-	return nil
+	return response, nil
 }
 
 // To prevent concurrent creates on the same file while maintaining atomicity of writes,
@@ -393,6 +423,19 @@ func (p *S3Path) ReadFile(ctx context.Context) ([]byte, error) {
 	return b.Bytes(), nil
 }
 
+// ReadFileVersion implements HasVersionedWrite::ReadFileVersion, using the ETag as the version
+func (p *S3Path) ReadFileVersion(ctx context.Context) ([]byte, string, error) {
+	ctx, span := tracer.Start(ctx, "S3Path::ReadFileVersion", trace.WithAttributes(attribute.String("path", p.String())))
+	defer span.End()
+
+	var b bytes.Buffer
+	response, _, err := p.getObject(ctx, &b)
+	if err != nil {
+		return nil, "", err
+	}
+	return b.Bytes(), aws.ToString(response.ETag), nil
+}
+
 // WriteTo implements io.WriterTo
 func (p *S3Path) WriteTo(out io.Writer) (int64, error) {
 	ctx := context.TODO()
@@ -401,9 +444,15 @@ func (p *S3Path) WriteTo(out io.Writer) (int64, error) {
 
 // WriteToWithContext implements io.WriterTo, but adds a context
 func (p *S3Path) WriteToWithContext(ctx context.Context, out io.Writer) (int64, error) {
+	_, n, err := p.getObject(ctx, out)
+	return n, err
+}
+
+// getObject copies the contents of the file to out, returning the response metadata and the number of bytes copied
+func (p *S3Path) getObject(ctx context.Context, out io.Writer) (*s3.GetObjectOutput, int64, error) {
 	client, err := p.client(ctx)
 	if err != nil {
-		return 0, err
+		return nil, 0, err
 	}
 
 	klog.V(4).Infof("Reading file %q", p)
@@ -415,17 +464,17 @@ func (p *S3Path) WriteToWithContext(ctx context.Context, out io.Writer) (int64,
 	response, err := client.GetObject(ctx, request)
 	if err != nil {
 		if AWSErrorCode(err) == "NoSuchKey" {
-			return 0, os.ErrNotExist
+			return nil, 0, os.ErrNotExist
 		}
-		return 0, fmt.Errorf("error fetching %s: %v", p, err)
+		return nil, 0, fmt.Errorf("error fetching %s: %v", p, err)
 	}
 	defer response.Body.Close()
 
 	n, err := io.Copy(out, response.Body)
 	if err != nil {
-		return n, fmt.Errorf("error reading %s: %v", p, err)
+		return nil, n, fmt.Errorf("error reading %s: %v", p, err)
 	}
-	return n, nil
+	return response, n, nil
 }
 
 func (p *S3Path) ReadDir() ([]Path, error) {
@@ -592,6 +641,65 @@ func (p *S3Path) GetHTTPsUrl(dualstack bool) (string, error) {
 	return endpoint.URI.String(), nil
 }
 
+// CreatePublicBucket creates the bucket of the path in region, allowing anonymous reads of its objects,
+// as needed to serve OIDC discovery documents. An existing bucket is left unchanged, and false is returned.
+func (p *S3Path) CreatePublicBucket(ctx context.Context, region string, partition string) (bool, error) {
+	client, err := p.s3Context.getClient(ctx, region, p.optFn)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = client.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(p.bucket),
+	})
+	if err == nil {
+		return false, nil
+	}
+	var notFound *types.NotFound
+	if !errors.As(err, &notFound) {
+		return false, fmt.Errorf("checking if bucket %q exists: %w", p.bucket, err)
+	}
+
+	request := &s3.CreateBucketInput{
+		Bucket:          aws.String(p.bucket),
+		ObjectOwnership: types.ObjectOwnershipBucketOwnerEnforced,
+	}
+	// us-east-1 is the default location, which can't be specified
+	if region != "us-east-1" {
+		request.CreateBucketConfiguration = &types.CreateBucketConfiguration{
+			LocationConstraint: types.BucketLocationConstraint(region),
+		}
+	}
+	if _, err := client.CreateBucket(ctx, request); err != nil {
+		return false, fmt.Errorf("creating bucket %q: %w", p.bucket, err)
+	}
+
+	// Objects are made public by the bucket policy, object ACLs stay blocked
+	_, err = client.PutPublicAccessBlock(ctx, &s3.PutPublicAccessBlockInput{
+		Bucket: aws.String(p.bucket),
+		PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(true),
+			IgnorePublicAcls:      aws.Bool(true),
+			BlockPublicPolicy:     aws.Bool(false),
+			RestrictPublicBuckets: aws.Bool(false),
+		},
+	})
+	if err != nil {
+		return true, fmt.Errorf("configuring public access of bucket %q: %w", p.bucket, err)
+	}
+
+	policy := fmt.Sprintf(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:%s:s3:::%s/*"}]}`, partition, p.bucket)
+	_, err = client.PutBucketPolicy(ctx, &s3.PutBucketPolicyInput{
+		Bucket: aws.String(p.bucket),
+		Policy: aws.String(policy),
+	})
+	if err != nil {
+		return true, fmt.Errorf("setting policy of bucket %q: %w", p.bucket, err)
+	}
+
+	return true, nil
+}
+
 func (p *S3Path) IsBucketPublic(ctx context.Context) (bool, error) {
 	client, err := p.client(ctx)
 	if err != nil {
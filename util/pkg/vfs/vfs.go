@@ -18,6 +18,7 @@ package vfs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -92,6 +93,40 @@ type HasHash interface {
 	Hash(algorithm hashing.HashAlgorithm) (*hashing.Hash, error)
 }
 
+// ErrVersionConflict is returned by WriteFileIfVersion when the file no longer has the expected version
+var ErrVersionConflict = errors.New("file was modified concurrently")
+
+// HasVersionedWrite is implemented by Paths that support compare-and-swap writes
+type HasVersionedWrite interface {
+	// ReadFileVersion returns the contents of the file, along with an opaque token identifying this version of the file.
+	// If the file did not exist, err = os.ErrNotExist
+	ReadFileVersion(ctx context.Context) ([]byte, string, error)
+
+	// WriteFileIfVersion writes the file only if it is still at version, or if version is empty, only if the file does not exist.
+	// It returns the version of the written file, or ErrVersionConflict if the file was changed.
+	WriteFileIfVersion(ctx context.Context, data io.ReadSeeker, acl ACL, version string) (string, error)
+}
+
+// ReadFileVersion reads the file and its version.
+// The version is empty if p does not support versioned writes.
+func ReadFileVersion(ctx context.Context, p Path) ([]byte, string, error) {
+	if v, ok := p.(HasVersionedWrite); ok {
+		return v.ReadFileVersion(ctx)
+	}
+	data, err := p.ReadFile(ctx)
+	return data, "", err
+}
+
+// WriteFileIfVersion writes the file only if it has not changed since version was read by ReadFileVersion.
+// If p does not support versioned writes, the file is written unconditionally.
+func WriteFileIfVersion(ctx context.Context, p Path, data io.ReadSeeker, acl ACL, version string) (string, error) {
+	if v, ok := p.(HasVersionedWrite); ok {
+		return v.WriteFileIfVersion(ctx, data, acl, version)
+	}
+	klog.V(2).Infof("%s does not support versioned writes; writing unconditionally", p)
+	return "", p.WriteFile(ctx, data, acl)
+}
+
 func RelativePath(base Path, child Path) (string, error) {
 	basePath := base.Path()
 	childPath := child.Path()